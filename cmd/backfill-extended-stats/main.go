@@ -0,0 +1,242 @@
+// backfill-extended-stats recomputes the extended profile stats (openings,
+// leaves, bingos by blanks and S's and scores by game phase) from the
+// history of every finished rated game, and writes them into the existing
+// profile stats. The other stats are left alone, so it can be re-run safely.
+//
+// Games that end while a user is being backfilled may be counted twice or
+// not at all for that user; re-running the backfill for them fixes this.
+//
+// Requires the same env vars as liwords-api, including GAMEHISTORY_UPLOAD_BUCKET
+// for games whose history has been archived to S3.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/stats"
+	gamestore "github.com/woogles-io/liwords/pkg/stores/game"
+	userstore "github.com/woogles-io/liwords/pkg/stores/user"
+	"github.com/woogles-io/liwords/pkg/utilities"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+type userRow struct {
+	id       int64
+	uuid     string
+	username string
+}
+
+type gameRow struct {
+	uuid string
+	req  entity.GameRequest
+}
+
+func main() {
+	username := flag.String("username", "", "Only backfill this user (default: all users with stats)")
+	dryRun := flag.Bool("dry-run", false, "Compute the stats without writing them to the DB")
+	flag.Parse()
+
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg := &config.Config{}
+	cfg.Load(nil)
+
+	pool, err := pgxpool.New(ctx, cfg.DBConnDSN)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect to database")
+	}
+	defer pool.Close()
+
+	userStore, err := userstore.NewDBStore(pool)
+	if err != nil {
+		log.Fatal().Err(err).Msg("user-store-failed")
+	}
+	gameStore, err := gamestore.NewDBStore(cfg, userStore, pool)
+	if err != nil {
+		log.Fatal().Err(err).Msg("game-store-failed")
+	}
+	if bucket := os.Getenv("GAMEHISTORY_UPLOAD_BUCKET"); bucket != "" {
+		awscfg, err := awsconfig.LoadDefaultConfig(ctx)
+		if err != nil {
+			log.Fatal().Err(err).Msg("aws-config-failed")
+		}
+		s3Client := s3.NewFromConfig(awscfg, utilities.CustomClientOptions)
+		gameStore.SetHistoryFetcher(gamestore.NewHistoryArchiver(bucket, s3Client, gameStore))
+	} else {
+		log.Warn().Msg("GAMEHISTORY_UPLOAD_BUCKET not set, games archived to S3 will be skipped")
+	}
+
+	users, err := listUsers(ctx, pool, *username)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to list users")
+	}
+	log.Info().Int("users", len(users)).Bool("dry_run", *dryRun).Msg("backfilling extended stats")
+
+	for _, u := range users {
+		if ctx.Err() != nil {
+			log.Info().Msg("backfill-interrupted")
+			break
+		}
+		games, err := listGames(ctx, pool, u.id)
+		if err != nil {
+			log.Fatal().Err(err).Str("username", u.username).Msg("failed to list games")
+		}
+
+		byVariant := map[entity.VariantKey]*entity.Stats{}
+		skipped := 0
+		for _, g := range games {
+			variantKey, userStats, err := gameStats(ctx, cfg, gameStore, g, u.uuid)
+			if err != nil {
+				log.Error().Err(err).Str("game_id", g.uuid).Msg("failed to compute stats, skipping")
+				skipped++
+				continue
+			}
+			if userStats == nil {
+				continue
+			}
+			if byVariant[variantKey] == nil {
+				byVariant[variantKey] = stats.InstantiateExtendedStats(u.uuid, "")
+			}
+			if err := stats.AddStats(byVariant[variantKey], userStats); err != nil {
+				log.Error().Err(err).Str("game_id", g.uuid).Msg("failed to add stats, skipping")
+				skipped++
+			}
+		}
+
+		for variantKey, s := range byVariant {
+			if *dryRun {
+				log.Info().Str("username", u.username).Str("variant", string(variantKey)).
+					Interface("stats", s.PlayerOneData).Msg("would-write")
+				continue
+			}
+			if err := writeStats(ctx, pool, u.id, variantKey, s); err != nil {
+				log.Fatal().Err(err).Str("username", u.username).Msg("failed to write stats")
+			}
+		}
+		log.Info().Str("username", u.username).Int("games", len(games)).
+			Int("skipped", skipped).Int("variants", len(byVariant)).Msg("backfilled-user")
+	}
+}
+
+func listUsers(ctx context.Context, pool *pgxpool.Pool, username string) ([]userRow, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT u.id, u.uuid, u.username
+		FROM users u
+		JOIN profiles p ON p.user_id = u.id
+		WHERE p.stats IS NOT NULL
+		  AND ($1::text = '' OR lower(u.username) = lower($1::text))
+		ORDER BY u.id
+	`, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []userRow
+	for rows.Next() {
+		var u userRow
+		if err := rows.Scan(&u.id, &u.uuid, &u.username); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+// listGames lists the user's finished games. Aborted and cancelled games
+// never counted towards profile stats.
+func listGames(ctx context.Context, pool *pgxpool.Pool, userID int64) ([]gameRow, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT g.uuid, g.game_request
+		FROM game_players gp
+		JOIN games g ON g.uuid = gp.game_uuid
+		WHERE gp.player_id = $1
+		  AND gp.game_end_reason NOT IN ($2, $3, $4)
+		ORDER BY gp.created_at
+	`, userID, int(pb.GameEndReason_NONE), int(pb.GameEndReason_ABORTED), int(pb.GameEndReason_CANCELLED))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var games []gameRow
+	for rows.Next() {
+		var g gameRow
+		if err := rows.Scan(&g.uuid, &g.req); err != nil {
+			return nil, err
+		}
+		games = append(games, g)
+	}
+	return games, rows.Err()
+}
+
+// gameStats computes the extended stats of a game. It returns nil stats for
+// games that don't count towards profile stats.
+func gameStats(ctx context.Context, cfg *config.Config, gameStore *gamestore.DBStore,
+	g gameRow, userUUID string) (entity.VariantKey, *entity.Stats, error) {
+
+	req := g.req.GameRequest
+	if req == nil || req.RatingMode != pb.RatingMode_RATED {
+		return "", nil, nil
+	}
+	timefmt, variant, err := entity.VariantFromGameReq(req)
+	if err != nil {
+		return "", nil, err
+	}
+	history, err := gameStore.GetHistory(ctx, g.uuid)
+	if err != nil {
+		return "", nil, err
+	}
+	if history.ChallengeRule == macondopb.ChallengeRule_TRIPLE || len(history.Players) != 2 {
+		return "", nil, nil
+	}
+	// Here, p0 went first and p1 went second, no matter what.
+	gameStats := stats.InstantiateExtendedStats(history.Players[0].UserId, history.Players[1].UserId)
+	err = stats.AddGame(ctx, gameStats, nil, history, req, cfg.WGLConfig(), &pb.GameEndedEvent{}, g.uuid)
+	if err != nil {
+		return "", nil, err
+	}
+	userStats := stats.InstantiateExtendedStats(userUUID, "")
+	if err := stats.AddStats(userStats, gameStats); err != nil {
+		return "", nil, err
+	}
+	return entity.ToVariantKey(req.Lexicon, variant, timefmt), userStats, nil
+}
+
+// writeStats replaces the extended stats of a variant the user already has
+// stats for.
+func writeStats(ctx context.Context, pool *pgxpool.Pool, userID int64,
+	variantKey entity.VariantKey, s *entity.Stats) error {
+
+	items, err := json.Marshal(s.PlayerOneData)
+	if err != nil {
+		return err
+	}
+	_, err = pool.Exec(ctx, `
+		UPDATE profiles
+		SET stats = jsonb_set(stats, array['Data', $1::text, 'd1'],
+			(stats->'Data'->$1::text->'d1') || $2::jsonb)
+		WHERE user_id = $3
+		  AND stats->'Data'->$1::text->'d1' IS NOT NULL
+	`, string(variantKey), string(items), userID)
+	return err
+}
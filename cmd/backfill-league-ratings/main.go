@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/league"
+	leaguestore "github.com/woogles-io/liwords/pkg/stores/league"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+)
+
+// backfill-league-ratings recalculates league ratings from scratch by
+// replaying every finished league game in order. It is safe to run again;
+// each run replaces the leagues' ratings and rating history.
+func main() {
+	leagueFlag := flag.String("league", "", "UUID or slug of the league to backfill (default: all leagues)")
+	dryRun := flag.Bool("dry-run", false, "Print the number of games that would be rated without changing anything")
+	flag.Parse()
+
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+
+	ctx := context.Background()
+
+	cfg := &config.Config{}
+	cfg.Load(nil)
+
+	pool, err := pgxpool.New(ctx, cfg.DBConnDSN)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to connect to database")
+	}
+	defer pool.Close()
+
+	store, err := leaguestore.NewDBStore(cfg, pool)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to create league store")
+	}
+
+	var leagues []models.League
+	if *leagueFlag == "" {
+		leagues, err = store.GetAllLeagues(ctx, false)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to get leagues")
+		}
+	} else {
+		var l models.League
+		if id, parseErr := uuid.Parse(*leagueFlag); parseErr == nil {
+			l, err = store.GetLeagueByUUID(ctx, id)
+		} else {
+			l, err = store.GetLeagueBySlug(ctx, *leagueFlag)
+		}
+		if err != nil {
+			log.Fatal().Err(err).Str("league", *leagueFlag).Msg("league not found")
+		}
+		leagues = []models.League{l}
+	}
+
+	ratingMgr := league.NewLeagueRatingManager(store)
+	total := 0
+	for _, l := range leagues {
+		if *dryRun {
+			games, err := store.GetLeagueRatedGames(ctx, l.Uuid)
+			if err != nil {
+				log.Fatal().Err(err).Str("league", l.Slug).Msg("failed to get league games")
+			}
+			fmt.Printf("%s: %d games to rate\n", l.Slug, len(games))
+			total += len(games)
+			continue
+		}
+		rated, err := ratingMgr.ReplayLeague(ctx, l.Uuid)
+		if err != nil {
+			log.Fatal().Err(err).Str("league", l.Slug).Msg("failed to replay league ratings")
+		}
+		fmt.Printf("%s: rated %d games\n", l.Slug, rated)
+		total += rated
+	}
+
+	fmt.Printf("leagues: %d  |  games: %d\n", len(leagues), total)
+}
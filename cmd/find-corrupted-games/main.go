@@ -23,6 +23,8 @@ import (
 	macondogame "github.com/domino14/macondo/game"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/lexica"
 	"github.com/woogles-io/liwords/pkg/stores/models"
 )
 
@@ -53,6 +55,7 @@ func main() {
 	defer pool.Close()
 
 	queries := models.New(pool)
+	lexica.UsePrivateLexica(queries, cfg.WGLConfig())
 
 	var (
 		mu           sync.Mutex
@@ -114,6 +117,8 @@ func main() {
 					variant = "classic"
 				}
 
+				// Loads a private lexicon's word graph for macondo.
+				entity.GetPrivateLexicon(lexicon)
 				rules, err := macondogame.NewBasicGameRules(
 					cfg.MacondoConfig(), lexicon, boardLayout,
 					letterDist, macondogame.CrossScoreOnly,
@@ -57,6 +57,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/gameplay"
 	"github.com/woogles-io/liwords/pkg/integrations"
 	"github.com/woogles-io/liwords/pkg/league"
+	"github.com/woogles-io/liwords/pkg/lexica"
 	"github.com/woogles-io/liwords/pkg/memento"
 	"github.com/woogles-io/liwords/pkg/mod"
 	"github.com/woogles-io/liwords/pkg/omgwords"
@@ -221,6 +222,10 @@ func main() {
 	// Wire up the league standings updater to avoid circular dependencies
 	stores.SetLeagueStandingsUpdater(league.NewStandingsUpdaterImpl(stores.LeagueStore))
 
+	// Private lexica may have been uploaded through another process, so they
+	// are loaded from the database the first time a game asks for one.
+	lexica.UsePrivateLexica(stores.Queries, cfg.WGLConfig())
+
 	middlewares := alice.New(
 		WithTiming("hlog", hlog.NewHandler(log.With().Str("service", "liwords").Logger())),
 		WithTiming("exposeRW", apiserver.ExposeResponseWriterMiddleware),
@@ -280,13 +285,14 @@ func main() {
 
 	mementoService := memento.NewMementoService(stores.UserStore, stores.GameStore,
 		stores.GameDocumentStore, cfg)
-	embedService := embed.NewEmbedService(stores.GameDocumentStore)
-	oauthIntegrationService := integrations.NewOAuthIntegrationService(stores.SessionStore, stores.Queries, cfg)
+	embedService := embed.NewEmbedService(stores.GameDocumentStore, stores.GameStore, stores.UserStore, cfg)
+	oauthIntegrationService := integrations.NewOAuthIntegrationService(stores.SessionStore, stores.UserStore, stores.Queries, cfg)
 	integrationService := integrations.NewIntegrationService(stores.Queries)
 	authenticationService := auth.NewAuthenticationService(stores.UserStore, stores.SessionStore, stores.ConfigStore,
 		cfg.SecretKey, cfg.EmailDebugMode, cfg.DiscordToken, cfg.ArgonConfig, cfg.SecureCookies, stores.Queries)
 	authorizationService := auth.NewAuthorizationService(stores.UserStore, stores.Queries)
-	registrationService := registration.NewRegistrationService(stores.UserStore, cfg.ArgonConfig, cfg.EmailDebugMode, cfg.SkipEmailVerification)
+	registrationService := registration.NewRegistrationService(stores.UserStore, cfg.ArgonConfig, cfg.EmailDebugMode, cfg.SkipEmailVerification,
+		stores.SessionStore, stores.Queries, dbPool, cfg.SecretKey, cfg.SecureCookies)
 	gameService := gameplay.NewGameService(stores.UserStore, stores.GameStore, stores.GameDocumentStore, cfg, stores.Queries)
 	profileService := pkgprofile.NewProfileService(stores.UserStore, userservices.NewS3Uploader(os.Getenv("AVATAR_UPLOAD_BUCKET"), s3Client), stores.Queries)
 
@@ -295,7 +301,7 @@ func main() {
 	verificationService := verification.NewVerificationService(stores.Queries, verificationS3Uploader)
 	organizationService := organization.NewOrganizationService(stores.UserStore, stores.Queries, verificationService)
 
-	wordService := words.NewWordService(cfg)
+	wordService := words.NewWordService(cfg, stores.GameStore, stores.UserStore, stores.Queries, dbPool)
 	autocompleteService := userservices.NewAutocompleteService(stores.UserStore)
 	socializeService := userservices.NewSocializeService(stores.UserStore, stores.ChatStore, stores.PresenceStore, stores.Queries)
 	configService := config.NewConfigService(stores.ConfigStore, stores.UserStore, stores.Queries)
@@ -306,7 +312,7 @@ func main() {
 		eventChan: nil, // Set later after pubsubBus is created
 	}
 	leagueService := league.NewLeagueService(stores.LeagueStore, stores.UserStore, cfg, stores.Queries, stores, gameCreatorAdapter)
-	modService := mod.NewModService(stores.UserStore, stores.ChatStore, stores.Queries)
+	modService := mod.NewModService(stores.UserStore, stores.ChatStore, stores.Queries, dbPool)
 	puzzleService := puzzles.NewPuzzleService(stores.PuzzleStore, stores.UserStore, cfg.PuzzleGenerationSecretKey, cfg.ECSClusterName, cfg.PuzzleGenerationTaskDefinition, stores.Queries)
 	omgwordsService := omgwords.NewOMGWordsService(stores.UserStore, cfg, stores.GameDocumentStore, stores.AnnotatedGameStore)
 	commentService := comments.NewCommentsService(stores.UserStore, stores.GameStore, stores.CommentsStore, stores.Queries)
@@ -316,6 +322,7 @@ func main() {
 	pairService := pair.NewPairService(cfg, lambdaClient)
 	vdoWebhookService := vdowebhook.NewVDOWebhookService(stores.TournamentStore, cfg.VDOPollingIntervalSeconds)
 	analysisService := analysis.NewAnalysisService(stores.UserStore, stores.GameStore, stores.Queries, dbPool)
+	analysisService.SetNotorietyStore(stores.NotorietyStore)
 	analysisAdminService := analysis.NewAnalysisAdminService(stores.UserStore, stores.Queries)
 	router.Handle("/ping", http.HandlerFunc(pingEndpoint))
 
@@ -486,6 +493,8 @@ func main() {
 	omgwordsService.SetEventChannel(pubsubBus.GameEventChannel())
 	omgwordsService.SetNatsConn(natsconn)
 	analysisService.SetNatsConn(natsconn)
+	embedService.SetNatsConn(natsconn)
+	modService.SetChatFilter(pubsubBus.ChatFilter())
 	gameCreatorAdapter.eventChan = pubsubBus.GameEventChannel()
 	broadcastService.SetEventChannel(pubsubBus.GameEventChannel())
 	broadcastService.SetNatsConn(natsconn)
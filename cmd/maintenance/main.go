@@ -192,7 +192,7 @@ func IntegrationsRefresher() error {
 
 	q := models.New(dbPool)
 
-	oauthIntegrationService := integrations.NewOAuthIntegrationService(nil, q, cfg)
+	oauthIntegrationService := integrations.NewOAuthIntegrationService(nil, nil, q, cfg)
 
 	refreshPatreonIntegrationTokens(ctx, q, oauthIntegrationService)
 
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	omgstores "github.com/woogles-io/liwords/pkg/omgwords/stores"
+	"github.com/woogles-io/liwords/pkg/puzzles"
+	commondb "github.com/woogles-io/liwords/pkg/stores/common"
+	gamestore "github.com/woogles-io/liwords/pkg/stores/game"
+	puzzlesstore "github.com/woogles-io/liwords/pkg/stores/puzzles"
+	"github.com/woogles-io/liwords/pkg/stores/user"
+)
+
+// puzzle-worker runs queued puzzle generation jobs, such as the ones
+// queued from annotated games, or from StartPuzzleGenJob when there is no
+// ECS cluster. Several workers can run at once.
+//
+// docker compose run --rm -w /opt/program/cmd/puzzle-worker app go run .
+
+func main() {
+	pollInterval := flag.Duration("poll", 10*time.Second, "how often to check for queued jobs")
+	once := flag.Bool("once", false, "run the queued jobs and exit")
+	flag.Parse()
+
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	cfg := &config.Config{}
+	// Only load config from environment variables:
+	cfg.Load(nil)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := commondb.OpenDB(cfg.DBHost, cfg.DBPort, cfg.DBName, cfg.DBUser, cfg.DBPassword, cfg.DBSSLMode)
+	if err != nil {
+		panic(err)
+	}
+	us, err := user.NewDBStore(pool)
+	if err != nil {
+		panic(err)
+	}
+	tempgs, err := gamestore.NewDBStore(cfg, us, pool)
+	if err != nil {
+		panic(err)
+	}
+	gs := gamestore.NewCache(tempgs)
+	ds, err := omgstores.NewGameDocumentStore(cfg, pool)
+	if err != nil {
+		panic(err)
+	}
+	ps, err := puzzlesstore.NewDBStore(pool)
+	if err != nil {
+		panic(err)
+	}
+
+	for {
+		genId, err := puzzles.RunQueuedGeneration(ctx, cfg, gs, ds, ps)
+		if err != nil {
+			log.Err(err).Int("genId", genId).Msg("puzzle-worker")
+		} else if genId != -1 {
+			info, err := puzzles.GetJobInfoString(ctx, ps, genId)
+			if err != nil {
+				log.Err(err).Int("genId", genId).Msg("puzzle-worker-job-info")
+			} else {
+				fmt.Println(info)
+			}
+			// Look for the next job right away.
+			continue
+		}
+		if *once {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(*pollInterval):
+		}
+	}
+}
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- External identities (Google, Discord, a generic OIDC issuer...) that a user
+-- can sign in with. Unlike the integrations table, which stores tokens for
+-- services linked to an existing account (Patreon, Twitch), a row here is a
+-- way to log in: (provider, subject) identifies the account.
+--
+-- A user may link at most one identity per provider, and a given external
+-- identity can only ever belong to one user.
+CREATE TABLE user_identities (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    email TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_login_at TIMESTAMPTZ,
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS mod_appeals;
+DROP TABLE IF EXISTS mod_case_actions;
+DROP TABLE IF EXISTS mod_case_evidence;
+DROP TABLE IF EXISTS mod_reports;
+DROP TABLE IF EXISTS mod_cases;
+
+COMMIT;
@@ -0,0 +1,87 @@
+BEGIN;
+
+-- Moderation cases. Every user report lands in a case about the reported
+-- user; while a case is open (status 0) or assigned (status 1) new reports
+-- about the same user are merged into it. Status values mirror the
+-- mod_service.CaseStatus enum.
+CREATE TABLE mod_cases (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID DEFAULT gen_random_uuid () NOT NULL UNIQUE,
+    subject_id BIGINT NOT NULL,
+    status SMALLINT NOT NULL DEFAULT 0,
+    assignee_id BIGINT,
+    resolution_note TEXT NOT NULL DEFAULT '',
+    resolver_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    FOREIGN KEY (subject_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (assignee_id) REFERENCES users (id) ON DELETE SET NULL,
+    FOREIGN KEY (resolver_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX mod_cases_active_subject_idx ON mod_cases (subject_id)
+    WHERE status IN (0, 1);
+CREATE INDEX mod_cases_status_idx ON mod_cases (status, updated_at);
+
+CREATE TABLE mod_reports (
+    id BIGSERIAL PRIMARY KEY,
+    case_id BIGINT NOT NULL,
+    reporter_id BIGINT NOT NULL,
+    target_type SMALLINT NOT NULL,
+    reason SMALLINT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (case_id) REFERENCES mod_cases (id) ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX mod_reports_case_idx ON mod_reports (case_id);
+CREATE INDEX mod_reports_reporter_idx ON mod_reports (reporter_id, created_at);
+
+CREATE TABLE mod_case_evidence (
+    id BIGSERIAL PRIMARY KEY,
+    case_id BIGINT NOT NULL,
+    evidence_type SMALLINT NOT NULL,
+    game_id TEXT NOT NULL DEFAULT '',
+    channel TEXT NOT NULL DEFAULT '',
+    message_id TEXT NOT NULL DEFAULT '',
+    snapshot TEXT NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    added_by BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (case_id) REFERENCES mod_cases (id) ON DELETE CASCADE,
+    FOREIGN KEY (added_by) REFERENCES users (id) ON DELETE SET NULL,
+    UNIQUE (case_id, evidence_type, game_id, channel, message_id)
+);
+
+-- The mod actions (user_actions rows) that resolved a case.
+CREATE TABLE mod_case_actions (
+    case_id BIGINT NOT NULL,
+    action_id BIGINT NOT NULL,
+    PRIMARY KEY (case_id, action_id),
+    FOREIGN KEY (case_id) REFERENCES mod_cases (id) ON DELETE CASCADE,
+    FOREIGN KEY (action_id) REFERENCES user_actions (id) ON DELETE CASCADE
+);
+
+CREATE TABLE mod_appeals (
+    id BIGSERIAL PRIMARY KEY,
+    uuid UUID DEFAULT gen_random_uuid () NOT NULL UNIQUE,
+    case_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    message TEXT NOT NULL,
+    status SMALLINT NOT NULL DEFAULT 0,
+    response TEXT NOT NULL DEFAULT '',
+    reviewer_id BIGINT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    decided_at TIMESTAMPTZ,
+    FOREIGN KEY (case_id) REFERENCES mod_cases (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+-- One pending appeal per case at a time.
+CREATE UNIQUE INDEX mod_appeals_pending_idx ON mod_appeals (case_id)
+    WHERE status = 0;
+
+COMMIT;
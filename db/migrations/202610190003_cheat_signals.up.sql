@@ -0,0 +1,24 @@
+BEGIN;
+
+-- Per-player cheat detection signals for analyzed rated games. A row is
+-- written (or rewritten on reanalysis) whenever an analysis job completes.
+-- move_time_cv is 0 when there weren't enough timed turns to compute it.
+CREATE TABLE game_cheat_signals (
+    game_uuid TEXT NOT NULL,
+    user_id INTEGER NOT NULL,
+    rating DOUBLE PRECISION NOT NULL,
+    turns INTEGER NOT NULL,
+    optimal_moves INTEGER NOT NULL,
+    mistake_index DOUBLE PRECISION NOT NULL,
+    estimated_elo DOUBLE PRECISION NOT NULL,
+    move_time_cv DOUBLE PRECISION NOT NULL DEFAULT 0,
+    flagged BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (game_uuid, user_id),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX game_cheat_signals_user_idx ON game_cheat_signals (user_id, created_at DESC);
+CREATE INDEX game_cheat_signals_rating_idx ON game_cheat_signals (rating, created_at);
+
+COMMIT;
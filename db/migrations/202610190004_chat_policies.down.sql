@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS chat_blocklist;
+DROP TABLE IF EXISTS chat_policies;
+
+COMMIT;
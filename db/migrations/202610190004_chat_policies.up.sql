@@ -0,0 +1,31 @@
+BEGIN;
+
+-- Chat policies per channel type (mod_service.ChatChannelType). Channel
+-- types without a row use the defaults in pkg/mod/chatpolicy.go.
+CREATE TABLE chat_policies (
+    channel_type SMALLINT PRIMARY KEY,
+    slow_mode_seconds INTEGER NOT NULL DEFAULT 0,
+    flood_max_messages INTEGER NOT NULL DEFAULT 0,
+    flood_window_seconds INTEGER NOT NULL DEFAULT 0,
+    flood_action SMALLINT NOT NULL DEFAULT 0,
+    block_links_days INTEGER NOT NULL DEFAULT 0,
+    blocklist_languages TEXT[] NOT NULL DEFAULT '{}',
+    blocklist_action SMALLINT NOT NULL DEFAULT 0,
+    mute_duration_seconds INTEGER NOT NULL DEFAULT 0,
+    updated_by INTEGER,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (updated_by) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE TABLE chat_blocklist (
+    id BIGSERIAL PRIMARY KEY,
+    language TEXT NOT NULL,
+    pattern TEXT NOT NULL,
+    is_regex BOOLEAN NOT NULL DEFAULT FALSE,
+    created_by INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL,
+    UNIQUE (language, pattern)
+);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS pm_read_state;
+DROP TABLE IF EXISTS pm_messages;
+DROP TABLE IF EXISTS pm_conversations;
+
+COMMIT;
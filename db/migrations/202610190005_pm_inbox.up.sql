@@ -0,0 +1,47 @@
+BEGIN;
+
+-- Private messages are still published through the Redis chat streams, but
+-- are also kept here so that conversations outlive the streams and read
+-- state is shared across devices.
+CREATE TABLE pm_conversations (
+    id BIGSERIAL PRIMARY KEY,
+    -- chat.pm.<uuid>_<uuid>
+    channel TEXT NOT NULL UNIQUE,
+    user1_id INTEGER NOT NULL,
+    user2_id INTEGER NOT NULL,
+    last_message_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (user1_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (user2_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX pm_conversations_user1_idx ON pm_conversations (user1_id, last_message_at DESC);
+CREATE INDEX pm_conversations_user2_idx ON pm_conversations (user2_id, last_message_at DESC);
+
+CREATE TABLE pm_messages (
+    id BIGSERIAL PRIMARY KEY,
+    conversation_id BIGINT NOT NULL,
+    -- The ID of the message in the Redis chat stream.
+    chat_id TEXT NOT NULL,
+    sender_id INTEGER NOT NULL,
+    message TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (conversation_id) REFERENCES pm_conversations (id) ON DELETE CASCADE,
+    FOREIGN KEY (sender_id) REFERENCES users (id) ON DELETE CASCADE,
+    UNIQUE (conversation_id, chat_id)
+);
+
+CREATE INDEX pm_messages_conversation_idx ON pm_messages (conversation_id, id DESC);
+CREATE INDEX pm_messages_search_idx ON pm_messages USING GIN (to_tsvector('simple', message));
+
+CREATE TABLE pm_read_state (
+    conversation_id BIGINT NOT NULL,
+    user_id INTEGER NOT NULL,
+    last_read_message_id BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (conversation_id, user_id),
+    FOREIGN KEY (conversation_id) REFERENCES pm_conversations (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+-- Games of the end-of-season division playoffs. These games belong to the
+-- season but not to a division, so they don't count towards the standings.
+CREATE TABLE league_playoff_games (
+    game_uuid TEXT PRIMARY KEY,
+    division_id UUID NOT NULL REFERENCES league_divisions(uuid) ON DELETE CASCADE,
+    -- 0 is the first round of the playoff.
+    round INTEGER NOT NULL,
+    -- Position of the game within its round, in bracket order.
+    slot INTEGER NOT NULL,
+    high_seed INTEGER NOT NULL,
+    low_seed INTEGER NOT NULL,
+    high_seed_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    low_seed_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (division_id, round, slot)
+);
+
+COMMIT;
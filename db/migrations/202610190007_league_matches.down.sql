@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS league_match_proposals;
+DROP TABLE IF EXISTS league_matches;
+
+COMMIT;
@@ -0,0 +1,43 @@
+BEGIN;
+
+-- Matchups of live leagues. Instead of creating every game when the season
+-- starts, live leagues give each pairing a window to be played in; the
+-- players agree on a time and the game is created when they start it.
+CREATE TABLE league_matches (
+    uuid UUID PRIMARY KEY,
+    season_id UUID NOT NULL REFERENCES league_seasons(uuid) ON DELETE CASCADE,
+    division_id UUID NOT NULL REFERENCES league_divisions(uuid) ON DELETE CASCADE,
+    -- Windows are numbered from 1.
+    window_number INTEGER NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    -- player0 goes first.
+    player0_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    player1_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- 0 pending, 1 scheduled, 2 started, 4 forfeited. A started match whose
+    -- game is over has been played.
+    status INTEGER NOT NULL DEFAULT 0,
+    scheduled_time TIMESTAMPTZ,
+    game_uuid TEXT,
+    window_reminder_sent_at TIMESTAMPTZ,
+    slot_reminder_sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_league_matches_season ON league_matches(season_id, window_number);
+CREATE INDEX idx_league_matches_division ON league_matches(division_id);
+
+-- Times proposed by one player of a match for the other to accept.
+CREATE TABLE league_match_proposals (
+    id BIGSERIAL PRIMARY KEY,
+    match_id UUID NOT NULL REFERENCES league_matches(uuid) ON DELETE CASCADE,
+    proposer_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    slot TIMESTAMPTZ NOT NULL,
+    -- 0 open, 1 accepted, 2 declined, 3 superseded
+    status INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_league_match_proposals_match ON league_match_proposals(match_id);
+
+COMMIT;
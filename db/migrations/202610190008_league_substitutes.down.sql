@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS league_substitutions;
+DROP TABLE IF EXISTS league_substitutes;
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN;
+
+-- Players who are available to substitute for league players who drop out
+-- mid-season.
+CREATE TABLE league_substitutes (
+    league_id UUID NOT NULL REFERENCES leagues(uuid) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (league_id, user_id)
+);
+
+-- Players who replaced a dropped player in a division. handling decides how
+-- the dropped player's completed games count in the standings:
+-- 0 keep, 1 void, 2 transfer to the substitute.
+CREATE TABLE league_substitutions (
+    id BIGSERIAL PRIMARY KEY,
+    season_id UUID NOT NULL REFERENCES league_seasons(uuid) ON DELETE CASCADE,
+    division_id UUID NOT NULL REFERENCES league_divisions(uuid) ON DELETE CASCADE,
+    dropped_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    substitute_user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    handling INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (season_id, dropped_user_id)
+);
+
+CREATE INDEX idx_league_substitutions_division ON league_substitutions(division_id);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS league_rating_history;
+DROP TABLE IF EXISTS league_ratings;
+
+COMMIT;
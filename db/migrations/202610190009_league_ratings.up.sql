@@ -0,0 +1,34 @@
+BEGIN;
+
+-- Each league keeps its own Glicko rating for its players, updated only by
+-- the league's games.
+CREATE TABLE league_ratings (
+    league_id UUID NOT NULL REFERENCES leagues(uuid) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating DOUBLE PRECISION NOT NULL,
+    rating_deviation DOUBLE PRECISION NOT NULL,
+    volatility DOUBLE PRECISION NOT NULL,
+    games_played INTEGER NOT NULL DEFAULT 0,
+    last_game_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (league_id, user_id)
+);
+
+-- A player's league rating after each of their league games.
+CREATE TABLE league_rating_history (
+    id BIGSERIAL PRIMARY KEY,
+    league_id UUID NOT NULL REFERENCES leagues(uuid) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    season_id UUID REFERENCES league_seasons(uuid) ON DELETE SET NULL,
+    game_uuid TEXT NOT NULL,
+    opponent_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating_before DOUBLE PRECISION NOT NULL,
+    rating_after DOUBLE PRECISION NOT NULL,
+    rating_deviation DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- A game is only rated once
+    UNIQUE (game_uuid, user_id)
+);
+
+CREATE INDEX idx_league_rating_history_user ON league_rating_history(league_id, user_id, created_at);
+
+COMMIT;
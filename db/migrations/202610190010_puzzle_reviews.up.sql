@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Spaced-repetition schedule of the puzzles a user failed or solved slowly.
+-- Reviews are kept per user and puzzle, like puzzle_attempts.
+CREATE TABLE IF NOT EXISTS puzzle_reviews (
+    puzzle_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    ease_factor double precision NOT NULL,
+    interval_days integer NOT NULL DEFAULT 0,
+    repetitions integer NOT NULL DEFAULT 0,
+    lapses integer NOT NULL DEFAULT 0,
+    due_at timestamptz NOT NULL,
+    last_reviewed_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, puzzle_id),
+    FOREIGN KEY (puzzle_id) REFERENCES puzzles (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_puzzle_reviews_due ON puzzle_reviews (user_id, due_at);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS puzzle_set_puzzles;
+DROP TABLE IF EXISTS puzzle_sets;
+DROP TABLE IF EXISTS puzzle_tag_ratings;
+
+COMMIT;
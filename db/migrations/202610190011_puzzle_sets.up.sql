@@ -0,0 +1,41 @@
+BEGIN;
+
+-- A user's puzzle rating in each puzzle tag, so they can see which themes
+-- they are strongest in. Tags are stored by name, as in puzzle_tag_titles.
+CREATE TABLE IF NOT EXISTS puzzle_tag_ratings (
+    user_id bigint NOT NULL,
+    lexicon text NOT NULL,
+    tag_title text NOT NULL,
+    rating jsonb NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, lexicon, tag_title),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+-- Curated, ordered sets of puzzles on a theme.
+CREATE TABLE IF NOT EXISTS puzzle_sets (
+    id BIGSERIAL PRIMARY KEY,
+    uuid text UNIQUE NOT NULL,
+    title text NOT NULL,
+    description text NOT NULL DEFAULT '',
+    lexicon text NOT NULL,
+    creator_id integer,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    updated_at timestamptz NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (creator_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_puzzle_sets_lexicon ON puzzle_sets (lexicon);
+
+CREATE TABLE IF NOT EXISTS puzzle_set_puzzles (
+    set_id bigint NOT NULL,
+    puzzle_id bigint NOT NULL,
+    position integer NOT NULL,
+    PRIMARY KEY (set_id, puzzle_id),
+    FOREIGN KEY (set_id) REFERENCES puzzle_sets (id) ON DELETE CASCADE,
+    FOREIGN KEY (puzzle_id) REFERENCES puzzles (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_puzzle_set_puzzles_position ON puzzle_set_puzzles (set_id, position);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS puzzle_rush_puzzles;
+DROP TABLE IF EXISTS puzzle_rush_sessions;
+
+COMMIT;
@@ -0,0 +1,36 @@
+BEGIN;
+
+-- Timed puzzle sessions. The server keeps the whole session so that scores
+-- on the leaderboards can't be forged.
+CREATE TABLE IF NOT EXISTS puzzle_rush_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    uuid text UNIQUE NOT NULL,
+    user_id integer NOT NULL,
+    lexicon text NOT NULL,
+    duration_seconds integer NOT NULL,
+    started_at timestamptz NOT NULL DEFAULT NOW(),
+    ends_at timestamptz NOT NULL,
+    finished_at timestamptz,
+    score integer NOT NULL DEFAULT 0,
+    strikes integer NOT NULL DEFAULT 0,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_puzzle_rush_sessions_leaderboard
+    ON puzzle_rush_sessions (lexicon, duration_seconds, started_at);
+CREATE INDEX IF NOT EXISTS idx_puzzle_rush_sessions_user ON puzzle_rush_sessions (user_id);
+
+-- The puzzles served in a session, in order. correct is NULL until the
+-- puzzle is answered.
+CREATE TABLE IF NOT EXISTS puzzle_rush_puzzles (
+    session_id bigint NOT NULL,
+    position integer NOT NULL,
+    puzzle_id bigint NOT NULL,
+    correct boolean,
+    answered_at timestamptz,
+    PRIMARY KEY (session_id, position),
+    FOREIGN KEY (session_id) REFERENCES puzzle_rush_sessions (id) ON DELETE CASCADE,
+    FOREIGN KEY (puzzle_id) REFERENCES puzzles (id) ON DELETE CASCADE
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_puzzle_generation_logs_queued;
+ALTER TABLE puzzle_generation_logs DROP COLUMN IF EXISTS started_at;
+
+COMMIT;
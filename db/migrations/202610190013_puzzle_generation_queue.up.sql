@@ -0,0 +1,13 @@
+BEGIN;
+
+-- Generation jobs can now be queued for a local worker. A job is queued
+-- until a worker starts it.
+ALTER TABLE puzzle_generation_logs ADD COLUMN IF NOT EXISTS started_at timestamptz;
+
+-- Every existing job was started as soon as it was created.
+UPDATE puzzle_generation_logs SET started_at = created_at WHERE started_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_puzzle_generation_logs_queued
+    ON puzzle_generation_logs (id) WHERE started_at IS NULL;
+
+COMMIT;
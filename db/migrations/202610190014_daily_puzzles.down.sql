@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS daily_puzzle_attempts;
+DROP TABLE IF EXISTS daily_puzzles;
+
+COMMIT;
@@ -0,0 +1,34 @@
+BEGIN;
+
+-- One puzzle of the day per lexicon. Days are UTC dates.
+CREATE TABLE IF NOT EXISTS daily_puzzles (
+    lexicon text NOT NULL,
+    puzzle_date date NOT NULL,
+    puzzle_id bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (lexicon, puzzle_date),
+    FOREIGN KEY (puzzle_id) REFERENCES puzzles (id) ON DELETE CASCADE
+);
+
+-- A puzzle is only ever the daily puzzle once.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_daily_puzzles_puzzle ON daily_puzzles (puzzle_id);
+
+-- Each user's one attempt at a daily puzzle. The attempt starts when the
+-- user first views the puzzle; correct is NULL until they answer it.
+-- answer_key describes a wrong answer, so the most common ones can be shown.
+CREATE TABLE IF NOT EXISTS daily_puzzle_attempts (
+    lexicon text NOT NULL,
+    puzzle_date date NOT NULL,
+    user_id integer NOT NULL,
+    correct boolean,
+    answer_key text NOT NULL DEFAULT '',
+    viewed_at timestamptz NOT NULL DEFAULT NOW(),
+    answered_at timestamptz,
+    PRIMARY KEY (lexicon, puzzle_date, user_id),
+    FOREIGN KEY (lexicon, puzzle_date) REFERENCES daily_puzzles (lexicon, puzzle_date) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_daily_puzzle_attempts_user ON daily_puzzle_attempts (user_id, lexicon, puzzle_date);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS word_cardbox;
+DROP TABLE IF EXISTS word_quiz_questions;
+DROP TABLE IF EXISTS word_quiz_sessions;
+
+COMMIT;
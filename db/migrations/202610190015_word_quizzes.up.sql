@@ -0,0 +1,47 @@
+BEGIN;
+
+-- Timed anagram quizzes. Each question is an alphagram; it is solved once
+-- all of its anagrams are found.
+CREATE TABLE IF NOT EXISTS word_quiz_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    uuid text UNIQUE NOT NULL,
+    user_id integer NOT NULL,
+    lexicon text NOT NULL,
+    duration_seconds integer NOT NULL,
+    started_at timestamptz NOT NULL DEFAULT NOW(),
+    ends_at timestamptz NOT NULL,
+    finished_at timestamptz,
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_word_quiz_sessions_user ON word_quiz_sessions (user_id);
+
+CREATE TABLE IF NOT EXISTS word_quiz_questions (
+    session_id bigint NOT NULL,
+    position integer NOT NULL,
+    alphagram text NOT NULL,
+    num_answers integer NOT NULL,
+    found text[] NOT NULL DEFAULT '{}',
+    PRIMARY KEY (session_id, position),
+    FOREIGN KEY (session_id) REFERENCES word_quiz_sessions (id) ON DELETE CASCADE
+);
+
+-- Each user's cardbox per lexicon. Alphagrams move up a cardbox when they
+-- are solved and back to cardbox 0 when they are missed; higher cardboxes
+-- are quizzed less often.
+CREATE TABLE IF NOT EXISTS word_cardbox (
+    user_id integer NOT NULL,
+    lexicon text NOT NULL,
+    alphagram text NOT NULL,
+    cardbox integer NOT NULL DEFAULT 0,
+    correct integer NOT NULL DEFAULT 0,
+    incorrect integer NOT NULL DEFAULT 0,
+    last_quizzed_at timestamptz NOT NULL DEFAULT NOW(),
+    next_scheduled timestamptz NOT NULL,
+    PRIMARY KEY (user_id, lexicon, alphagram),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_word_cardbox_due ON word_cardbox (user_id, lexicon, next_scheduled);
+
+COMMIT;
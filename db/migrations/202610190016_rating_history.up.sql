@@ -0,0 +1,34 @@
+BEGIN;
+
+-- One row per player per rated game. The breakdown columns are the terms of
+-- the Glicko-225 calculation: the rating change is multiplier times
+-- (actual_result - expected_result).
+CREATE TABLE IF NOT EXISTS rating_history (
+    id BIGSERIAL PRIMARY KEY,
+    user_id integer NOT NULL,
+    variant text NOT NULL,
+    game_uuid text NOT NULL,
+    opponent_id integer,
+    rating_before double precision NOT NULL,
+    rating_after double precision NOT NULL,
+    rd_before double precision NOT NULL,
+    rd_after double precision NOT NULL,
+    volatility_before double precision NOT NULL,
+    volatility_after double precision NOT NULL,
+    opponent_rating double precision NOT NULL,
+    opponent_rd double precision NOT NULL,
+    spread integer NOT NULL,
+    expected_result double precision NOT NULL,
+    actual_result double precision NOT NULL,
+    win_boost double precision NOT NULL,
+    inactive_rd double precision NOT NULL,
+    multiplier double precision NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    FOREIGN KEY (opponent_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rating_history_user_variant ON rating_history (user_id, variant, created_at);
+CREATE INDEX IF NOT EXISTS idx_rating_history_game ON rating_history (game_uuid);
+
+COMMIT;
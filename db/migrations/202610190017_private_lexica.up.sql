@@ -0,0 +1,21 @@
+BEGIN;
+
+-- Word lists uploaded by directors. The word graph is built once, on upload,
+-- and every server loads it from here lazily, the first time a game asks for
+-- it. A private lexicon may only be played in the tournament or club it was
+-- uploaded for.
+CREATE TABLE IF NOT EXISTS private_lexica (
+    id BIGSERIAL PRIMARY KEY,
+    name text NOT NULL UNIQUE,
+    tournament_uuid text NOT NULL,
+    uploader_id integer,
+    letter_distribution text NOT NULL,
+    word_count integer NOT NULL,
+    kwg bytea NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (uploader_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_private_lexica_tournament ON private_lexica (tournament_uuid);
+
+COMMIT;
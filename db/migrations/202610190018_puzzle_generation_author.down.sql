@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_puzzle_generation_logs_author;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Users can queue a limited number of jobs from their annotated games.
+CREATE INDEX IF NOT EXISTS idx_puzzle_generation_logs_author
+    ON puzzle_generation_logs ((request->>'author_id'), created_at);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- OAuth signup tokens (see pkg/integrations/oidc.go) that were already used
+-- to create an account. A token can only create one account; rows are kept
+-- until the token expires.
+CREATE TABLE used_signup_tokens (
+    token_id TEXT PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+COMMIT;
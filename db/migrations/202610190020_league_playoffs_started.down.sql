@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE league_seasons DROP COLUMN IF EXISTS playoffs_started_at;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- When the division playoffs started, ending the regular season. Unfinished
+-- regular-season games are force-finished exactly once, at that point.
+ALTER TABLE league_seasons ADD COLUMN playoffs_started_at TIMESTAMP WITH TIME ZONE;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS league_substitution_games;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- Games a substitution has to replace. Each game is aborted and, outside
+-- live leagues, recreated with the substitute in the dropped player's seat.
+-- Rows are marked done once the replacement has started, so a substitution
+-- that failed part way can be resumed without replacing a game twice.
+CREATE TABLE league_substitution_games (
+    game_uuid TEXT PRIMARY KEY,
+    substitution_id BIGINT NOT NULL REFERENCES league_substitutions(id) ON DELETE CASCADE,
+    replacement_game_uuid TEXT,
+    done BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_league_substitution_games_substitution
+    ON league_substitution_games(substitution_id);
+
+COMMIT;
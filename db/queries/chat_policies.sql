@@ -0,0 +1,51 @@
+-- name: GetChatPolicies :many
+SELECT channel_type, slow_mode_seconds, flood_max_messages, flood_window_seconds,
+    flood_action, block_links_days, blocklist_languages, blocklist_action,
+    mute_duration_seconds
+FROM chat_policies
+ORDER BY channel_type;
+
+-- name: UpsertChatPolicy :exec
+INSERT INTO chat_policies (channel_type, slow_mode_seconds, flood_max_messages,
+    flood_window_seconds, flood_action, block_links_days, blocklist_languages,
+    blocklist_action, mute_duration_seconds, updated_by, updated_at)
+VALUES (
+  @channel_type,
+  @slow_mode_seconds,
+  @flood_max_messages,
+  @flood_window_seconds,
+  @flood_action,
+  @block_links_days,
+  @blocklist_languages,
+  @blocklist_action,
+  @mute_duration_seconds,
+  (SELECT id FROM users WHERE users.uuid = @updated_by_uuid),
+  NOW()
+)
+ON CONFLICT (channel_type) DO UPDATE SET
+  slow_mode_seconds = EXCLUDED.slow_mode_seconds,
+  flood_max_messages = EXCLUDED.flood_max_messages,
+  flood_window_seconds = EXCLUDED.flood_window_seconds,
+  flood_action = EXCLUDED.flood_action,
+  block_links_days = EXCLUDED.block_links_days,
+  blocklist_languages = EXCLUDED.blocklist_languages,
+  blocklist_action = EXCLUDED.blocklist_action,
+  mute_duration_seconds = EXCLUDED.mute_duration_seconds,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = NOW();
+
+-- name: GetChatBlocklist :many
+SELECT id, language, pattern, is_regex
+FROM chat_blocklist
+ORDER BY language, pattern;
+
+-- name: AddChatBlocklistEntry :one
+INSERT INTO chat_blocklist (language, pattern, is_regex, created_by)
+VALUES (@language, @pattern, @is_regex, (SELECT id FROM users WHERE users.uuid = @created_by_uuid))
+RETURNING id;
+
+-- name: RemoveChatBlocklistEntry :execrows
+DELETE FROM chat_blocklist WHERE id = @id;
+
+-- name: GetUserCreatedAt :one
+SELECT created_at FROM users WHERE uuid = @uuid;
@@ -0,0 +1,48 @@
+-- name: UpsertGameCheatSignals :exec
+INSERT INTO game_cheat_signals (game_uuid, user_id, rating, turns, optimal_moves,
+    mistake_index, estimated_elo, move_time_cv)
+VALUES (
+  @game_uuid,
+  (SELECT id FROM users WHERE users.uuid = @user_uuid),
+  @rating,
+  @turns,
+  @optimal_moves,
+  @mistake_index,
+  @estimated_elo,
+  @move_time_cv
+)
+ON CONFLICT (game_uuid, user_id) DO UPDATE SET
+  rating = EXCLUDED.rating,
+  turns = EXCLUDED.turns,
+  optimal_moves = EXCLUDED.optimal_moves,
+  mistake_index = EXCLUDED.mistake_index,
+  estimated_elo = EXCLUDED.estimated_elo,
+  move_time_cv = EXCLUDED.move_time_cv;
+
+-- name: GetRecentCheatSignals :many
+SELECT s.game_uuid, s.rating, s.turns, s.optimal_moves, s.mistake_index,
+    s.estimated_elo, s.move_time_cv, s.flagged, s.created_at
+FROM game_cheat_signals s
+JOIN users u ON u.id = s.user_id
+WHERE u.uuid = @user_uuid
+ORDER BY s.created_at DESC
+LIMIT @lim;
+
+-- name: GetCheatSignalBaseline :one
+-- Per-game engine agreement and mistake index statistics for all players
+-- within a rating band.
+SELECT COUNT(*) AS games,
+    COALESCE(AVG(optimal_moves::float8 / turns), 0)::float8 AS mean_agreement,
+    COALESCE(STDDEV_SAMP(optimal_moves::float8 / turns), 0)::float8 AS stddev_agreement,
+    COALESCE(AVG(mistake_index), 0)::float8 AS mean_mistake_index,
+    COALESCE(STDDEV_SAMP(mistake_index), 0)::float8 AS stddev_mistake_index
+FROM game_cheat_signals
+WHERE rating BETWEEN @min_rating AND @max_rating
+  AND turns > 0
+  AND created_at >= @since;
+
+-- name: FlagGameCheatSignals :exec
+UPDATE game_cheat_signals
+SET flagged = TRUE
+WHERE game_uuid = @game_uuid
+  AND user_id = (SELECT id FROM users WHERE users.uuid = @user_uuid);
@@ -0,0 +1,98 @@
+-- name: GetDailyPuzzle :one
+SELECT p.uuid
+FROM daily_puzzles d
+JOIN puzzles p ON p.id = d.puzzle_id
+WHERE d.lexicon = @lexicon AND d.puzzle_date = @puzzle_date;
+
+-- name: PickDailyPuzzle :one
+-- The best voted valid puzzle that has not been a daily puzzle yet.
+SELECT p.id
+FROM puzzles p
+JOIN puzzle_votes v ON v.puzzle_id = p.id
+WHERE p.lexicon = @lexicon::text AND p.valid
+    AND NOT EXISTS (SELECT 1 FROM daily_puzzles d WHERE d.puzzle_id = p.id)
+GROUP BY p.id
+HAVING SUM(v.vote) >= @min_score::int
+ORDER BY SUM(v.vote) DESC, COUNT(*) DESC, p.id
+LIMIT 1;
+
+-- name: PickRandomDailyPuzzle :one
+-- Any valid puzzle that has not been a daily puzzle yet, for lexicons
+-- without enough votes.
+SELECT p.id
+FROM puzzles p
+WHERE p.lexicon = @lexicon::text AND p.valid
+    AND NOT EXISTS (SELECT 1 FROM daily_puzzles d WHERE d.puzzle_id = p.id)
+ORDER BY random()
+LIMIT 1;
+
+-- name: AddDailyPuzzle :exec
+INSERT INTO daily_puzzles (lexicon, puzzle_date, puzzle_id)
+VALUES (@lexicon, @puzzle_date, @puzzle_id)
+ON CONFLICT (lexicon, puzzle_date) DO NOTHING;
+
+-- name: SetDailyPuzzle :execrows
+-- Schedules a puzzle of the lexicon that has not been a daily puzzle yet,
+-- replacing any puzzle already scheduled for the day.
+INSERT INTO daily_puzzles (lexicon, puzzle_date, puzzle_id)
+SELECT @lexicon::text, @puzzle_date::date, p.id
+FROM puzzles p
+WHERE p.uuid = @puzzle_uuid AND p.lexicon = @lexicon::text
+    AND NOT EXISTS (SELECT 1 FROM daily_puzzles d WHERE d.puzzle_id = p.id)
+ON CONFLICT (lexicon, puzzle_date) DO UPDATE SET puzzle_id = EXCLUDED.puzzle_id, created_at = NOW();
+
+-- name: StartDailyPuzzleAttempt :exec
+INSERT INTO daily_puzzle_attempts (lexicon, puzzle_date, user_id)
+VALUES (@lexicon, @puzzle_date, @user_id)
+ON CONFLICT (lexicon, puzzle_date, user_id) DO NOTHING;
+
+-- name: GetDailyPuzzleAttempt :one
+SELECT correct, answer_key, viewed_at, answered_at
+FROM daily_puzzle_attempts
+WHERE lexicon = @lexicon AND puzzle_date = @puzzle_date AND user_id = @user_id;
+
+-- name: AnswerDailyPuzzle :execrows
+UPDATE daily_puzzle_attempts
+SET correct = @correct, answer_key = @answer_key, answered_at = NOW()
+WHERE lexicon = @lexicon AND puzzle_date = @puzzle_date AND user_id = @user_id
+    AND answered_at IS NULL;
+
+-- name: GetDailyPuzzleStats :one
+SELECT COUNT(*) AS answers,
+    COUNT(*) FILTER (WHERE correct) AS solves,
+    COALESCE(AVG(EXTRACT(EPOCH FROM answered_at - viewed_at)) FILTER (WHERE correct), 0)::float AS average_solve_seconds
+FROM daily_puzzle_attempts
+WHERE lexicon = @lexicon AND puzzle_date = @puzzle_date AND answered_at IS NOT NULL;
+
+-- name: GetDailyPuzzleWrongAnswers :many
+SELECT answer_key, COUNT(*) AS times
+FROM daily_puzzle_attempts
+WHERE lexicon = @lexicon AND puzzle_date = @puzzle_date
+    AND correct = FALSE AND answer_key <> ''
+GROUP BY answer_key
+ORDER BY times DESC, answer_key
+LIMIT @lim;
+
+-- name: GetDailyPuzzleSolveDates :many
+-- The days on which the user solved the daily puzzle, latest first.
+SELECT puzzle_date
+FROM daily_puzzle_attempts
+WHERE user_id = @user_id AND lexicon = @lexicon AND correct
+ORDER BY puzzle_date DESC;
+
+-- name: GetDailyPuzzleArchive :many
+-- Daily puzzles before the given date, latest first, with the user's
+-- result on each.
+SELECT d.puzzle_date, p.uuid,
+    (SELECT COUNT(*) FROM daily_puzzle_attempts a
+        WHERE a.lexicon = d.lexicon AND a.puzzle_date = d.puzzle_date AND a.answered_at IS NOT NULL) AS answers,
+    (SELECT COUNT(*) FROM daily_puzzle_attempts a
+        WHERE a.lexicon = d.lexicon AND a.puzzle_date = d.puzzle_date AND a.correct) AS solves,
+    ua.correct
+FROM daily_puzzles d
+JOIN puzzles p ON p.id = d.puzzle_id
+LEFT JOIN daily_puzzle_attempts ua ON ua.lexicon = d.lexicon AND ua.puzzle_date = d.puzzle_date
+    AND ua.user_id = @user_id
+WHERE d.lexicon = @lexicon AND d.puzzle_date < @before
+ORDER BY d.puzzle_date DESC
+LIMIT @lim OFFSET @off;
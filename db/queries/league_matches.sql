@@ -0,0 +1,106 @@
+-- name: CreateLeagueMatch :exec
+INSERT INTO league_matches (uuid, season_id, division_id, window_number,
+    window_start, window_end, player0_id, player1_id)
+VALUES (@uuid, @season_id, @division_id, @window_number,
+    @window_start, @window_end, @player0_id, @player1_id);
+
+-- name: GetSeasonLeagueMatches :many
+SELECT m.uuid, m.season_id, m.division_id, m.window_number, m.window_start,
+    m.window_end, m.player0_id, u0.uuid AS player0_uuid,
+    u0.username AS player0_username, m.player1_id, u1.uuid AS player1_uuid,
+    u1.username AS player1_username, m.status, m.scheduled_time, m.game_uuid,
+    m.window_reminder_sent_at, m.slot_reminder_sent_at, g.game_end_reason
+FROM league_matches m
+JOIN users u0 ON u0.id = m.player0_id
+JOIN users u1 ON u1.id = m.player1_id
+LEFT JOIN games g ON g.uuid = m.game_uuid
+WHERE m.season_id = @season_id
+ORDER BY m.window_number, m.division_id, m.created_at;
+
+-- name: GetLeagueMatch :one
+SELECT m.uuid, m.season_id, m.division_id, m.window_number, m.window_start,
+    m.window_end, m.player0_id, u0.uuid AS player0_uuid,
+    u0.username AS player0_username, m.player1_id, u1.uuid AS player1_uuid,
+    u1.username AS player1_username, m.status, m.scheduled_time, m.game_uuid,
+    m.window_reminder_sent_at, m.slot_reminder_sent_at, g.game_end_reason
+FROM league_matches m
+JOIN users u0 ON u0.id = m.player0_id
+JOIN users u1 ON u1.id = m.player1_id
+LEFT JOIN games g ON g.uuid = m.game_uuid
+WHERE m.uuid = @uuid;
+
+-- name: GetPendingLeagueMatches :many
+-- Matches without a game yet; their games are still to be played.
+SELECT division_id, player0_id, player1_id
+FROM league_matches
+WHERE division_id = ANY(@division_ids::uuid[])
+  AND game_uuid IS NULL
+  AND status <> 4;
+
+-- name: ScheduleLeagueMatch :exec
+UPDATE league_matches
+SET status = 1, scheduled_time = @scheduled_time, slot_reminder_sent_at = NULL
+WHERE uuid = @uuid AND status IN (0, 1);
+
+-- name: ClaimLeagueMatch :execrows
+-- Marks a match as started before its game is created, so that it can only
+-- be started once.
+UPDATE league_matches
+SET status = @status
+WHERE uuid = @uuid AND status IN (0, 1) AND game_uuid IS NULL;
+
+-- name: ReleaseLeagueMatch :exec
+-- Undoes ClaimLeagueMatch when the match's game could not be started. The
+-- game is unlinked if it was already recorded.
+UPDATE league_matches
+SET status = @status, game_uuid = NULL
+WHERE uuid = @uuid AND (game_uuid IS NULL OR game_uuid = @game_uuid);
+
+-- name: SetLeagueMatchGame :exec
+UPDATE league_matches
+SET game_uuid = @game_uuid
+WHERE uuid = @uuid;
+
+-- name: MarkMatchWindowReminderSent :exec
+UPDATE league_matches
+SET window_reminder_sent_at = NOW()
+WHERE uuid = @uuid;
+
+-- name: MarkMatchSlotReminderSent :exec
+UPDATE league_matches
+SET slot_reminder_sent_at = NOW()
+WHERE uuid = @uuid;
+
+-- name: AddMatchProposal :one
+INSERT INTO league_match_proposals (match_id, proposer_id, slot)
+VALUES (@match_id, @proposer_id, @slot)
+RETURNING id;
+
+-- name: GetMatchProposal :one
+SELECT id, match_id, proposer_id, slot, status
+FROM league_match_proposals
+WHERE id = @id;
+
+-- name: GetMatchProposals :many
+SELECT p.id, p.match_id, p.proposer_id, u.uuid AS proposer_uuid,
+    u.username AS proposer_username, p.slot, p.status
+FROM league_match_proposals p
+JOIN users u ON u.id = p.proposer_id
+WHERE p.match_id = ANY(@match_ids::uuid[])
+ORDER BY p.match_id, p.created_at;
+
+-- name: AcceptMatchProposal :exec
+UPDATE league_match_proposals
+SET status = 1
+WHERE id = @id AND status = 0;
+
+-- name: DeclineMatchProposal :exec
+UPDATE league_match_proposals
+SET status = 2
+WHERE id = @id AND status = 0;
+
+-- name: SupersedeMatchProposals :exec
+-- Closes a match's other open proposals once one has been accepted.
+UPDATE league_match_proposals
+SET status = 3
+WHERE match_id = @match_id AND id <> @accepted_id AND status = 0;
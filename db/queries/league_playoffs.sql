@@ -0,0 +1,20 @@
+-- name: AddLeaguePlayoffGame :exec
+INSERT INTO league_playoff_games (game_uuid, division_id, round, slot,
+    high_seed, low_seed, high_seed_user_id, low_seed_user_id)
+VALUES (@game_uuid, @division_id, @round, @slot,
+    @high_seed, @low_seed, @high_seed_user_id, @low_seed_user_id);
+
+-- name: GetLeaguePlayoffGames :many
+SELECT p.division_id, p.round, p.slot, p.game_uuid, p.high_seed, p.low_seed,
+    p.high_seed_user_id, p.low_seed_user_id,
+    g.game_end_reason, g.winner_idx, g.player0_id
+FROM league_playoff_games p
+JOIN games g ON g.uuid = p.game_uuid
+WHERE p.division_id = ANY(@division_ids::uuid[])
+ORDER BY p.division_id, p.round, p.slot;
+
+-- name: CountSeasonPlayoffGames :one
+SELECT COUNT(*)
+FROM league_playoff_games p
+JOIN league_divisions d ON d.uuid = p.division_id
+WHERE d.season_id = @season_id;
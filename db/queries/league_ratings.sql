@@ -0,0 +1,62 @@
+-- name: GetLeagueRatings :many
+SELECT user_id, rating, rating_deviation, volatility, games_played, last_game_at
+FROM league_ratings
+WHERE league_id = @league_id AND user_id = ANY(@user_ids::int[]);
+
+-- name: UpsertLeagueRating :exec
+INSERT INTO league_ratings (league_id, user_id, rating, rating_deviation,
+    volatility, games_played, last_game_at)
+VALUES (@league_id, @user_id, @rating, @rating_deviation, @volatility, 1,
+    @last_game_at)
+ON CONFLICT (league_id, user_id) DO UPDATE SET
+    rating = EXCLUDED.rating,
+    rating_deviation = EXCLUDED.rating_deviation,
+    volatility = EXCLUDED.volatility,
+    games_played = league_ratings.games_played + 1,
+    last_game_at = EXCLUDED.last_game_at;
+
+-- name: AddLeagueRatingHistory :execrows
+INSERT INTO league_rating_history (league_id, user_id, season_id, game_uuid,
+    opponent_id, rating_before, rating_after, rating_deviation, created_at)
+VALUES (@league_id, @user_id, @season_id, @game_uuid, @opponent_id,
+    @rating_before, @rating_after, @rating_deviation, @created_at)
+ON CONFLICT (game_uuid, user_id) DO NOTHING;
+
+-- name: GetLeagueRatingHistory :many
+SELECT h.game_uuid, h.season_id, s.season_number, u.uuid AS opponent_uuid,
+    u.username AS opponent_username, h.rating_before, h.rating_after,
+    h.rating_deviation, h.created_at
+FROM league_rating_history h
+JOIN users u ON u.id = h.opponent_id
+LEFT JOIN league_seasons s ON s.uuid = h.season_id
+WHERE h.league_id = @league_id AND h.user_id = @user_id
+ORDER BY h.created_at, h.id;
+
+-- name: DeleteLeagueRatings :exec
+DELETE FROM league_ratings
+WHERE league_id = @league_id;
+
+-- name: DeleteLeagueRatingHistory :exec
+DELETE FROM league_rating_history
+WHERE league_id = @league_id;
+
+-- name: GetLeagueRatedGames :many
+-- Finished games of a league in the order they ended, for replaying the
+-- league's ratings.
+SELECT g.uuid, g.season_id, g.player0_id, g.player1_id,
+    gp0.score AS player0_score, gp1.score AS player1_score,
+    gp0.won AS player0_won, gp0.game_end_reason, gp0.created_at AS ended_at
+FROM games g
+INNER JOIN game_players gp0 ON g.uuid = gp0.game_uuid AND gp0.player_index = 0
+INNER JOIN game_players gp1 ON g.uuid = gp1.game_uuid AND gp1.player_index = 1
+WHERE g.league_id = @league_id
+  AND gp0.game_end_reason NOT IN (0, 5, 7)
+ORDER BY gp0.created_at, g.id;
+
+-- name: GetPlayerLeagueRatings :many
+SELECT r.league_id, l.name AS league_name, l.slug AS league_slug, r.rating,
+    r.rating_deviation, r.games_played, r.last_game_at
+FROM league_ratings r
+JOIN leagues l ON l.uuid = r.league_id
+WHERE r.user_id = @user_id
+ORDER BY r.last_game_at DESC;
@@ -0,0 +1,85 @@
+-- name: AddLeagueSubstitute :exec
+INSERT INTO league_substitutes (league_id, user_id)
+VALUES (@league_id, @user_id)
+ON CONFLICT (league_id, user_id) DO NOTHING;
+
+-- name: RemoveLeagueSubstitute :exec
+DELETE FROM league_substitutes
+WHERE league_id = @league_id AND user_id = @user_id;
+
+-- name: GetLeagueSubstitutes :many
+SELECT s.user_id, u.uuid AS user_uuid, u.username, s.created_at
+FROM league_substitutes s
+JOIN users u ON u.id = s.user_id
+WHERE s.league_id = @league_id
+ORDER BY s.created_at;
+
+-- name: WithdrawRegistration :exec
+-- Takes a dropped player out of their division; the registration is kept so
+-- the season history still shows them.
+UPDATE league_registrations
+SET division_id = NULL, status = 'WITHDRAWN', updated_at = NOW()
+WHERE season_id = @season_id AND user_id = @user_id;
+
+-- name: DeletePlayerStanding :exec
+DELETE FROM league_standings
+WHERE division_id = @division_id AND user_id = @user_id;
+
+-- name: AddLeagueSubstitution :one
+INSERT INTO league_substitutions (season_id, division_id, dropped_user_id,
+    substitute_user_id, handling)
+VALUES (@season_id, @division_id, @dropped_user_id, @substitute_user_id,
+    @handling)
+RETURNING id;
+
+-- name: GetSubstitution :one
+SELECT id, division_id, substitute_user_id, handling
+FROM league_substitutions
+WHERE season_id = @season_id AND dropped_user_id = @dropped_user_id;
+
+-- name: AddSubstitutionGames :exec
+INSERT INTO league_substitution_games (game_uuid, substitution_id)
+SELECT unnest(@game_uuids::text[]), @substitution_id
+ON CONFLICT (game_uuid) DO NOTHING;
+
+-- name: GetPendingSubstitutionGames :many
+SELECT game_uuid, replacement_game_uuid
+FROM league_substitution_games
+WHERE substitution_id = @substitution_id AND NOT done
+ORDER BY game_uuid;
+
+-- name: SetSubstitutionReplacementGame :exec
+UPDATE league_substitution_games
+SET replacement_game_uuid = @replacement_game_uuid
+WHERE game_uuid = @game_uuid;
+
+-- name: FinishSubstitutionGame :exec
+UPDATE league_substitution_games
+SET done = TRUE
+WHERE game_uuid = @game_uuid;
+
+-- name: GetDivisionSubstitutions :many
+SELECT dropped_user_id, substitute_user_id, handling
+FROM league_substitutions
+WHERE division_id = @division_id
+ORDER BY created_at;
+
+-- name: SubstituteLeagueMatchPlayer :many
+-- Hands the dropped player's unplayed matches to the substitute. Matches
+-- whose game is still in progress are reset too; their games are aborted.
+UPDATE league_matches m
+SET player0_id = CASE WHEN m.player0_id = @dropped_user_id THEN @substitute_user_id ELSE m.player0_id END,
+    player1_id = CASE WHEN m.player1_id = @dropped_user_id THEN @substitute_user_id ELSE m.player1_id END,
+    status = 0, scheduled_time = NULL, game_uuid = NULL,
+    window_reminder_sent_at = NULL, slot_reminder_sent_at = NULL
+WHERE m.season_id = @season_id
+  AND (m.player0_id = @dropped_user_id OR m.player1_id = @dropped_user_id)
+  AND m.status <> 4
+  AND (m.game_uuid IS NULL OR EXISTS (
+    SELECT 1 FROM games g WHERE g.uuid = m.game_uuid AND g.game_end_reason = 0))
+RETURNING m.uuid;
+
+-- name: SupersedeOpenMatchProposals :exec
+UPDATE league_match_proposals
+SET status = 3
+WHERE match_id = ANY(@match_ids::uuid[]) AND status = 0;
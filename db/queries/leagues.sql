@@ -103,6 +103,11 @@ UPDATE league_seasons
 SET divisions_prepared_at = NOW(), updated_at = NOW()
 WHERE uuid = $1;
 
+-- name: MarkPlayoffsStarted :exec
+UPDATE league_seasons
+SET playoffs_started_at = NOW(), updated_at = NOW()
+WHERE uuid = $1;
+
 -- name: MarkSeasonStarted :exec
 UPDATE league_seasons
 SET started_at = NOW(), updated_at = NOW()
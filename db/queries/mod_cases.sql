@@ -0,0 +1,167 @@
+-- name: OpenCaseForSubject :one
+-- Returns the active case about a user, creating one if there isn't any.
+INSERT INTO mod_cases (subject_id)
+VALUES ((SELECT id FROM users WHERE users.uuid = @subject_uuid))
+ON CONFLICT (subject_id) WHERE status IN (0, 1)
+DO UPDATE SET updated_at = NOW()
+RETURNING id, uuid;
+
+-- name: AddReport :exec
+INSERT INTO mod_reports (case_id, reporter_id, target_type, reason, description)
+VALUES (
+  @case_id,
+  (SELECT id FROM users WHERE users.uuid = @reporter_uuid),
+  @target_type,
+  @reason,
+  @description
+);
+
+-- name: CountRecentReportsByReporter :one
+SELECT COUNT(*) FROM mod_reports
+WHERE reporter_id = (SELECT id FROM users WHERE users.uuid = @reporter_uuid)
+  AND created_at > @since;
+
+-- name: IsPlayerInGame :one
+SELECT EXISTS (
+  SELECT 1 FROM games
+  JOIN users ON users.id = games.player0_id OR users.id = games.player1_id
+  WHERE games.uuid = @game_uuid AND users.uuid = @user_uuid
+);
+
+-- name: AddCaseEvidence :exec
+INSERT INTO mod_case_evidence (case_id, evidence_type, game_id, channel, message_id, snapshot, note, added_by)
+VALUES (
+  @case_id,
+  @evidence_type,
+  @game_id,
+  @channel,
+  @message_id,
+  @snapshot,
+  @note,
+  (SELECT id FROM users WHERE users.uuid = @added_by_uuid)
+)
+ON CONFLICT (case_id, evidence_type, game_id, channel, message_id) DO NOTHING;
+
+-- name: GetCase :one
+SELECT mod_cases.id, mod_cases.uuid, mod_cases.status, mod_cases.resolution_note,
+  mod_cases.created_at, mod_cases.updated_at, mod_cases.resolved_at,
+  subject.uuid AS subject_uuid, subject.username AS subject_username,
+  COALESCE(assignee.uuid, '') AS assignee_uuid,
+  COALESCE(assignee.username, '') AS assignee_username,
+  COALESCE(resolver.username, '') AS resolver_username,
+  COUNT(mod_reports.id) AS num_reports
+FROM mod_cases
+JOIN users subject ON subject.id = mod_cases.subject_id
+LEFT JOIN users assignee ON assignee.id = mod_cases.assignee_id
+LEFT JOIN users resolver ON resolver.id = mod_cases.resolver_id
+LEFT JOIN mod_reports ON mod_reports.case_id = mod_cases.id
+WHERE mod_cases.uuid = @case_uuid
+GROUP BY mod_cases.id, subject.id, assignee.id, resolver.id;
+
+-- name: ListCases :many
+SELECT mod_cases.id, mod_cases.uuid, mod_cases.status, mod_cases.resolution_note,
+  mod_cases.created_at, mod_cases.updated_at, mod_cases.resolved_at,
+  subject.uuid AS subject_uuid, subject.username AS subject_username,
+  COALESCE(assignee.uuid, '') AS assignee_uuid,
+  COALESCE(assignee.username, '') AS assignee_username,
+  COALESCE(resolver.username, '') AS resolver_username,
+  COUNT(mod_reports.id) AS num_reports
+FROM mod_cases
+JOIN users subject ON subject.id = mod_cases.subject_id
+LEFT JOIN users assignee ON assignee.id = mod_cases.assignee_id
+LEFT JOIN users resolver ON resolver.id = mod_cases.resolver_id
+LEFT JOIN mod_reports ON mod_reports.case_id = mod_cases.id
+WHERE mod_cases.status = ANY(@statuses::smallint[])
+  AND (@assignee_uuid::text = '' OR assignee.uuid = @assignee_uuid::text)
+GROUP BY mod_cases.id, subject.id, assignee.id, resolver.id
+ORDER BY mod_cases.updated_at DESC
+LIMIT @lim::integer OFFSET @off::integer;
+
+-- name: GetCaseReports :many
+SELECT users.uuid AS reporter_uuid, users.username AS reporter_username,
+  mod_reports.target_type, mod_reports.reason, mod_reports.description, mod_reports.created_at
+FROM mod_reports
+JOIN users ON users.id = mod_reports.reporter_id
+WHERE mod_reports.case_id = @case_id
+ORDER BY mod_reports.created_at;
+
+-- name: GetCaseEvidence :many
+SELECT mod_case_evidence.evidence_type, mod_case_evidence.game_id, mod_case_evidence.channel,
+  mod_case_evidence.message_id, mod_case_evidence.snapshot, mod_case_evidence.note,
+  COALESCE(users.username, '') AS added_by_username, mod_case_evidence.created_at
+FROM mod_case_evidence
+LEFT JOIN users ON users.id = mod_case_evidence.added_by
+WHERE mod_case_evidence.case_id = @case_id
+ORDER BY mod_case_evidence.created_at;
+
+-- name: AssignCase :execrows
+UPDATE mod_cases
+SET assignee_id = (SELECT id FROM users WHERE users.uuid = @assignee_uuid),
+    status = 1,
+    updated_at = NOW()
+WHERE uuid = @case_uuid AND status IN (0, 1);
+
+-- name: ResolveCase :execrows
+UPDATE mod_cases
+SET status = @status,
+    resolution_note = @resolution_note,
+    resolver_id = (SELECT id FROM users WHERE users.uuid = @resolver_uuid),
+    resolved_at = NOW(),
+    updated_at = NOW()
+WHERE id = @case_id AND status IN (0, 1);
+
+-- name: SetCaseStatus :exec
+UPDATE mod_cases SET status = @status, updated_at = NOW()
+WHERE id = @case_id;
+
+-- name: LinkCaseAction :exec
+-- user_actions rows are unique on (user_id, start_time, action_type).
+INSERT INTO mod_case_actions (case_id, action_id)
+SELECT @case_id::bigint, user_actions.id
+FROM user_actions
+JOIN users ON users.id = user_actions.user_id
+WHERE users.uuid = @user_uuid
+  AND user_actions.action_type = @action_type
+  AND user_actions.start_time = @start_time
+ON CONFLICT DO NOTHING;
+
+-- name: GetCaseActions :many
+SELECT users.uuid AS user_uuid, user_actions.action_type, user_actions.start_time,
+  user_actions.end_time, user_actions.removed_time, user_actions.note,
+  COALESCE(applier.uuid, '') AS applier_uuid
+FROM mod_case_actions
+JOIN user_actions ON user_actions.id = mod_case_actions.action_id
+JOIN users ON users.id = user_actions.user_id
+LEFT JOIN users applier ON applier.id = user_actions.applier_id
+WHERE mod_case_actions.case_id = @case_id
+ORDER BY user_actions.start_time;
+
+-- name: CreateAppeal :one
+INSERT INTO mod_appeals (case_id, user_id, message)
+VALUES (@case_id, (SELECT id FROM users WHERE users.uuid = @user_uuid), @message)
+RETURNING uuid;
+
+-- name: GetAppeal :one
+SELECT mod_appeals.id, mod_appeals.case_id, mod_appeals.status,
+  mod_cases.uuid AS case_uuid, users.uuid AS user_uuid
+FROM mod_appeals
+JOIN mod_cases ON mod_cases.id = mod_appeals.case_id
+JOIN users ON users.id = mod_appeals.user_id
+WHERE mod_appeals.uuid = @appeal_uuid;
+
+-- name: GetCaseAppeals :many
+SELECT mod_appeals.uuid, mod_appeals.message, mod_appeals.status, mod_appeals.response,
+  COALESCE(reviewer.username, '') AS reviewer_username,
+  mod_appeals.created_at, mod_appeals.decided_at
+FROM mod_appeals
+LEFT JOIN users reviewer ON reviewer.id = mod_appeals.reviewer_id
+WHERE mod_appeals.case_id = @case_id
+ORDER BY mod_appeals.created_at;
+
+-- name: DecideAppeal :execrows
+UPDATE mod_appeals
+SET status = @status,
+    response = @response,
+    reviewer_id = (SELECT id FROM users WHERE users.uuid = @reviewer_uuid),
+    decided_at = NOW()
+WHERE id = @appeal_id AND status = 0;
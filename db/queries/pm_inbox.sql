@@ -0,0 +1,106 @@
+-- name: UpsertPMConversation :one
+INSERT INTO pm_conversations (channel, user1_id, user2_id)
+VALUES (
+  @channel,
+  (SELECT id FROM users WHERE users.uuid = @user1_uuid),
+  (SELECT id FROM users WHERE users.uuid = @user2_uuid)
+)
+ON CONFLICT (channel) DO UPDATE SET last_message_at = NOW()
+RETURNING id;
+
+-- name: AddPMMessage :one
+INSERT INTO pm_messages (conversation_id, chat_id, sender_id, message)
+VALUES (
+  @conversation_id,
+  @chat_id,
+  (SELECT id FROM users WHERE users.uuid = @sender_uuid),
+  @message
+)
+RETURNING id;
+
+-- name: DeletePMMessage :execrows
+DELETE FROM pm_messages
+USING pm_conversations c
+WHERE c.id = pm_messages.conversation_id
+  AND c.channel = @channel
+  AND pm_messages.chat_id = @chat_id;
+
+-- name: GetPMMessages :many
+-- Newest first. A blank before_chat_id starts from the latest message.
+SELECT m.id, m.chat_id, u.uuid AS sender_uuid, u.username AS sender_username,
+    m.message, m.created_at
+FROM pm_messages m
+JOIN pm_conversations c ON c.id = m.conversation_id
+JOIN users u ON u.id = m.sender_id
+WHERE c.channel = @channel
+  AND (@before_chat_id::text = '' OR m.id < (
+    SELECT b.id FROM pm_messages b WHERE b.conversation_id = c.id AND b.chat_id = @before_chat_id::text))
+ORDER BY m.id DESC
+LIMIT @lim;
+
+-- name: MarkPMConversationRead :execrows
+-- Marks everything in the conversation as read by the user.
+INSERT INTO pm_read_state (conversation_id, user_id, last_read_message_id)
+SELECT c.id, u.id, COALESCE((SELECT MAX(m.id) FROM pm_messages m WHERE m.conversation_id = c.id), 0)
+FROM pm_conversations c, users u
+WHERE c.channel = @channel
+  AND u.uuid = @user_uuid
+  AND u.id IN (c.user1_id, c.user2_id)
+ON CONFLICT (conversation_id, user_id) DO UPDATE SET
+  last_read_message_id = GREATEST(pm_read_state.last_read_message_id, EXCLUDED.last_read_message_id),
+  updated_at = NOW();
+
+-- name: GetPMInbox :many
+-- The user's conversations, most recent first, leaving out anyone the user
+-- blocks or is blocked by.
+SELECT c.channel, me.username AS my_username, other.uuid AS other_uuid,
+    other.username AS other_username, c.last_message_at,
+    COALESCE(lm.message, '')::text AS last_message,
+    (SELECT COUNT(*) FROM pm_messages m
+     WHERE m.conversation_id = c.id
+       AND m.sender_id <> me.id
+       AND m.id > COALESCE(rs.last_read_message_id, 0)) AS unread
+FROM users me
+JOIN pm_conversations c ON me.id IN (c.user1_id, c.user2_id)
+JOIN users other ON other.id = CASE WHEN c.user1_id = me.id THEN c.user2_id ELSE c.user1_id END
+LEFT JOIN pm_read_state rs ON rs.conversation_id = c.id AND rs.user_id = me.id
+LEFT JOIN LATERAL (
+  SELECT m.message FROM pm_messages m WHERE m.conversation_id = c.id ORDER BY m.id DESC LIMIT 1
+) lm ON TRUE
+WHERE me.uuid = @user_uuid
+  AND NOT EXISTS (
+    SELECT 1 FROM blockings b
+    WHERE (b.blocker_id = me.id AND b.user_id = other.id)
+       OR (b.blocker_id = other.id AND b.user_id = me.id))
+ORDER BY c.last_message_at DESC
+LIMIT @lim OFFSET @offs;
+
+-- name: GetPMUnreadCount :one
+SELECT COUNT(*)
+FROM users me
+JOIN pm_conversations c ON me.id IN (c.user1_id, c.user2_id)
+JOIN pm_messages m ON m.conversation_id = c.id AND m.sender_id <> me.id
+LEFT JOIN pm_read_state rs ON rs.conversation_id = c.id AND rs.user_id = me.id
+WHERE me.uuid = @user_uuid
+  AND m.id > COALESCE(rs.last_read_message_id, 0)
+  AND NOT EXISTS (
+    SELECT 1 FROM blockings b
+    WHERE (b.blocker_id = me.id AND b.user_id = m.sender_id)
+       OR (b.blocker_id = m.sender_id AND b.user_id = me.id));
+
+-- name: SearchPMMessages :many
+-- Searches the user's own conversations, newest first.
+SELECT c.channel, m.chat_id, u.uuid AS sender_uuid, u.username AS sender_username,
+    m.message, m.created_at
+FROM users me
+JOIN pm_conversations c ON me.id IN (c.user1_id, c.user2_id)
+JOIN pm_messages m ON m.conversation_id = c.id
+JOIN users u ON u.id = m.sender_id
+WHERE me.uuid = @user_uuid
+  AND to_tsvector('simple', m.message) @@ plainto_tsquery('simple', @query)
+  AND NOT EXISTS (
+    SELECT 1 FROM blockings b
+    WHERE (b.blocker_id = me.id AND b.user_id IN (c.user1_id, c.user2_id))
+       OR (b.user_id = me.id AND b.blocker_id IN (c.user1_id, c.user2_id)))
+ORDER BY m.id DESC
+LIMIT @lim OFFSET @offs;
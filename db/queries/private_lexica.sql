@@ -0,0 +1,14 @@
+-- name: CreatePrivateLexicon :exec
+INSERT INTO private_lexica (name, tournament_uuid, uploader_id, letter_distribution, word_count, kwg)
+VALUES (@name, @tournament_uuid, @uploader_id, @letter_distribution, @word_count, @kwg);
+
+-- name: GetPrivateLexicon :one
+SELECT name, tournament_uuid, letter_distribution, kwg
+FROM private_lexica
+WHERE name = @name;
+
+-- name: GetTournamentPrivateLexica :many
+SELECT name, letter_distribution, word_count, created_at
+FROM private_lexica
+WHERE tournament_uuid = ANY(@tournament_uuids::text[])
+ORDER BY created_at;
@@ -0,0 +1,21 @@
+-- name: QueuePuzzleGenerationJob :one
+INSERT INTO puzzle_generation_logs (request, created_at) VALUES (@request, NOW()) RETURNING id;
+
+-- name: ClaimPuzzleGenerationJob :one
+-- Starts the oldest queued job. Several workers can claim jobs at once.
+UPDATE puzzle_generation_logs SET started_at = NOW()
+WHERE id = (
+    SELECT id FROM puzzle_generation_logs
+    WHERE started_at IS NULL
+    ORDER BY id
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED)
+RETURNING id, request;
+
+-- name: CountAuthorPuzzleGenerationJobsSince :one
+SELECT COUNT(*) FROM puzzle_generation_logs
+WHERE request->>'author_id' = @author_id::text AND created_at >= @since;
+
+-- name: ApprovePuzzleGenerationJob :execrows
+UPDATE puzzles SET valid = TRUE
+WHERE generation_id = @generation_id AND NOT valid;
@@ -0,0 +1,37 @@
+-- name: GetPuzzleReview :one
+SELECT ease_factor, interval_days, repetitions, lapses, due_at, last_reviewed_at
+FROM puzzle_reviews
+WHERE user_id = @user_id AND puzzle_id = @puzzle_id;
+
+-- name: UpsertPuzzleReview :exec
+INSERT INTO puzzle_reviews (puzzle_id, user_id, ease_factor, interval_days,
+    repetitions, lapses, due_at, last_reviewed_at)
+VALUES (@puzzle_id, @user_id, @ease_factor, @interval_days, @repetitions,
+    @lapses, @due_at, @last_reviewed_at)
+ON CONFLICT (user_id, puzzle_id) DO UPDATE SET
+    ease_factor = EXCLUDED.ease_factor,
+    interval_days = EXCLUDED.interval_days,
+    repetitions = EXCLUDED.repetitions,
+    lapses = EXCLUDED.lapses,
+    due_at = EXCLUDED.due_at,
+    last_reviewed_at = EXCLUDED.last_reviewed_at;
+
+-- name: DeletePuzzleReview :execrows
+DELETE FROM puzzle_reviews WHERE user_id = @user_id AND puzzle_id = @puzzle_id;
+
+-- name: GetPuzzleReviewQueue :many
+-- A user's reviews in a lexicon, soonest due first.
+SELECT p.uuid, r.ease_factor, r.interval_days, r.repetitions, r.lapses,
+    r.due_at, r.last_reviewed_at
+FROM puzzle_reviews r
+JOIN puzzles p ON p.id = r.puzzle_id
+WHERE r.user_id = @user_id AND p.lexicon = @lexicon::text
+ORDER BY r.due_at, r.puzzle_id
+LIMIT @lim;
+
+-- name: CountPuzzleReviews :one
+SELECT COUNT(*) AS total,
+    COUNT(*) FILTER (WHERE r.due_at <= @due_before::timestamptz) AS due
+FROM puzzle_reviews r
+JOIN puzzles p ON p.id = r.puzzle_id
+WHERE r.user_id = @user_id AND p.lexicon = @lexicon::text;
@@ -0,0 +1,78 @@
+-- name: CreatePuzzleRushSession :one
+INSERT INTO puzzle_rush_sessions (uuid, user_id, lexicon, duration_seconds, started_at, ends_at)
+VALUES (@uuid, @user_id, @lexicon, @duration_seconds, @started_at, @ends_at)
+RETURNING id;
+
+-- name: GetPuzzleRushSession :one
+SELECT s.id, s.uuid, u.uuid AS user_uuid, s.lexicon, s.duration_seconds,
+    s.started_at, s.ends_at, s.finished_at, s.score, s.strikes
+FROM puzzle_rush_sessions s
+JOIN users u ON u.id = s.user_id
+WHERE s.uuid = @uuid;
+
+-- name: GetCurrentPuzzleRushPuzzle :one
+-- The last puzzle served in a session, if it has not been answered yet.
+SELECT rp.position, p.uuid
+FROM puzzle_rush_puzzles rp
+JOIN puzzles p ON p.id = rp.puzzle_id
+WHERE rp.session_id = @session_id AND rp.answered_at IS NULL
+ORDER BY rp.position DESC
+LIMIT 1;
+
+-- name: CountPuzzleRushPuzzles :one
+SELECT COUNT(*) FROM puzzle_rush_puzzles WHERE session_id = @session_id;
+
+-- name: GetNextPuzzleRushPuzzle :one
+-- The valid puzzle closest to the target rating that has not been served in
+-- the session yet.
+SELECT id, uuid
+FROM ((SELECT id, uuid, (rating->>'r')::float AS puzzle_rating
+        FROM puzzles
+        WHERE lexicon = @lexicon::text AND valid
+            AND (rating->>'r')::float >= @target_rating::float
+            AND id NOT IN (SELECT puzzle_id FROM puzzle_rush_puzzles WHERE session_id = @session_id)
+        ORDER BY (rating->>'r')::float
+        LIMIT 1)
+    UNION ALL
+    (SELECT id, uuid, (rating->>'r')::float AS puzzle_rating
+        FROM puzzles
+        WHERE lexicon = @lexicon::text AND valid
+            AND (rating->>'r')::float < @target_rating::float
+            AND id NOT IN (SELECT puzzle_id FROM puzzle_rush_puzzles WHERE session_id = @session_id)
+        ORDER BY (rating->>'r')::float DESC
+        LIMIT 1)) AS closest
+ORDER BY ABS(@target_rating::float - puzzle_rating)
+LIMIT 1;
+
+-- name: AddPuzzleRushPuzzle :exec
+INSERT INTO puzzle_rush_puzzles (session_id, position, puzzle_id)
+VALUES (@session_id, @position, @puzzle_id);
+
+-- name: AnswerPuzzleRushPuzzle :execrows
+UPDATE puzzle_rush_puzzles
+SET correct = @correct, answered_at = NOW()
+WHERE session_id = @session_id AND position = @position AND answered_at IS NULL;
+
+-- name: UpdatePuzzleRushSession :exec
+UPDATE puzzle_rush_sessions
+SET score = @score, strikes = @strikes, finished_at = @finished_at
+WHERE id = @id;
+
+-- name: FinishPuzzleRushSession :exec
+UPDATE puzzle_rush_sessions
+SET finished_at = @finished_at
+WHERE id = @id AND finished_at IS NULL;
+
+-- name: GetPuzzleRushLeaderboard :many
+-- Each user's best score in sessions started since the given time. A
+-- session counts once it is finished or its time is up.
+SELECT u.username, best.score, best.started_at
+FROM (SELECT DISTINCT ON (user_id) user_id, score, started_at
+    FROM puzzle_rush_sessions
+    WHERE lexicon = @lexicon AND duration_seconds = @duration_seconds
+        AND started_at >= @since AND score > 0
+        AND (finished_at IS NOT NULL OR ends_at < NOW())
+    ORDER BY user_id, score DESC, started_at) AS best
+JOIN users u ON u.id = best.user_id
+ORDER BY best.score DESC, best.started_at
+LIMIT @lim;
@@ -0,0 +1,48 @@
+-- name: CreatePuzzleSet :one
+INSERT INTO puzzle_sets (uuid, title, description, lexicon, creator_id)
+VALUES (@uuid, @title, @description, @lexicon, @creator_id)
+RETURNING id;
+
+-- name: UpdatePuzzleSet :execrows
+UPDATE puzzle_sets SET title = @title, description = @description, updated_at = NOW()
+WHERE id = @id;
+
+-- name: DeletePuzzleSet :execrows
+DELETE FROM puzzle_sets WHERE uuid = @uuid;
+
+-- name: GetPuzzleSet :one
+SELECT ps.id, ps.uuid, ps.title, ps.description, ps.lexicon,
+    COALESCE(u.username, '')::text AS creator, ps.updated_at
+FROM puzzle_sets ps
+LEFT JOIN users u ON u.id = ps.creator_id
+WHERE ps.uuid = @uuid;
+
+-- name: GetPuzzleSets :many
+SELECT ps.uuid, ps.title, ps.description, ps.lexicon,
+    COALESCE(u.username, '')::text AS creator, ps.updated_at,
+    (SELECT COUNT(*) FROM puzzle_set_puzzles psp WHERE psp.set_id = ps.id)::int AS puzzle_count
+FROM puzzle_sets ps
+LEFT JOIN users u ON u.id = ps.creator_id
+WHERE ps.lexicon = @lexicon::text
+ORDER BY ps.updated_at DESC;
+
+-- name: ClearPuzzleSetPuzzles :exec
+DELETE FROM puzzle_set_puzzles WHERE set_id = @set_id;
+
+-- name: AddPuzzleSetPuzzles :execrows
+-- Puzzles are added in the given order. Puzzles that do not exist or are in
+-- another lexicon are skipped, so callers compare the row count.
+INSERT INTO puzzle_set_puzzles (set_id, puzzle_id, position)
+SELECT @set_id::bigint, p.id, u.ord::int
+FROM unnest(@puzzle_uuids::text[]) WITH ORDINALITY AS u(uuid, ord)
+JOIN puzzles p ON p.uuid = u.uuid
+WHERE p.lexicon = @lexicon::text;
+
+-- name: GetPuzzleSetPuzzles :many
+-- The set's puzzles in order, with the given user's result on each.
+SELECT p.uuid, pa.correct
+FROM puzzle_set_puzzles psp
+JOIN puzzles p ON p.id = psp.puzzle_id
+LEFT JOIN puzzle_attempts pa ON pa.puzzle_id = p.id AND pa.user_id = @user_id
+WHERE psp.set_id = @set_id
+ORDER BY psp.position;
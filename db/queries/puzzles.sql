@@ -50,7 +50,7 @@ WHERE puzzles.id IS NULL
 SELECT id FROM puzzles WHERE uuid = @uuid;
 
 -- name: CreatePuzzleGenerationLog :one
-INSERT INTO puzzle_generation_logs (request, created_at) VALUES (@request, NOW()) RETURNING id;
+INSERT INTO puzzle_generation_logs (request, created_at, started_at) VALUES (@request, NOW(), NOW()) RETURNING id;
 
 -- name: UpdateGenerationLogStatus :execrows
 UPDATE puzzle_generation_logs SET completed_at = NOW(), error_status = @error_status, fulfilled = @fulfilled WHERE id = @id;
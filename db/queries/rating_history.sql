@@ -0,0 +1,36 @@
+-- name: AddRatingHistory :exec
+INSERT INTO rating_history (
+    user_id, variant, game_uuid, opponent_id,
+    rating_before, rating_after, rd_before, rd_after, volatility_before, volatility_after,
+    opponent_rating, opponent_rd, spread,
+    expected_result, actual_result, win_boost, inactive_rd, multiplier, created_at
+) VALUES (
+    @user_id, @variant, @game_uuid, @opponent_id,
+    @rating_before, @rating_after, @rd_before, @rd_after, @volatility_before, @volatility_after,
+    @opponent_rating, @opponent_rd, @spread,
+    @expected_result, @actual_result, @win_boost, @inactive_rd, @multiplier, @created_at
+);
+
+-- name: GetRatingHistory :many
+-- Get a user's most recent rating changes in a variant since a time, most
+-- recent first.
+SELECT rh.game_uuid, u.username AS opponent_username,
+    rh.rating_before, rh.rating_after, rh.rd_before, rh.rd_after,
+    rh.volatility_before, rh.volatility_after,
+    rh.opponent_rating, rh.opponent_rd, rh.spread,
+    rh.expected_result, rh.actual_result, rh.win_boost, rh.inactive_rd, rh.multiplier,
+    rh.created_at
+FROM rating_history rh
+LEFT JOIN users u ON u.id = rh.opponent_id
+WHERE rh.user_id = @user_id
+  AND rh.variant = @variant
+  AND rh.created_at >= @since
+ORDER BY rh.created_at DESC
+LIMIT @lim::integer;
+
+-- name: GetRatingHistoryVariants :many
+-- Get the variants a user has a rating history in.
+SELECT DISTINCT variant
+FROM rating_history
+WHERE user_id = @user_id
+ORDER BY variant;
@@ -0,0 +1,22 @@
+-- name: GetHeadToHeadGames :many
+-- Get every finished rated game between two players, from the first
+-- player's point of view. Uses idx_game_players_opponents.
+SELECT gp.game_uuid, gp.score, gp.opponent_score, gp.won, gp.created_at, g.game_request
+FROM game_players gp
+JOIN games g ON g.uuid = gp.game_uuid
+WHERE gp.player_id = @player_id
+  AND gp.opponent_id = @opponent_id
+  AND gp.game_end_reason NOT IN (0, 5, 7) -- NONE, ABORTED, CANCELLED
+  AND COALESCE(g.game_request->>'ratingMode', 'RATED') = 'RATED'
+ORDER BY gp.created_at DESC;
+
+-- name: GetRecentRatedGames :many
+-- Get a player's most recent finished rated games against anyone.
+SELECT gp.game_uuid, gp.score, gp.opponent_score, gp.won, gp.created_at, g.game_request
+FROM game_players gp
+JOIN games g ON g.uuid = gp.game_uuid
+WHERE gp.player_id = @player_id
+  AND gp.game_end_reason NOT IN (0, 5, 7) -- NONE, ABORTED, CANCELLED
+  AND COALESCE(g.game_request->>'ratingMode', 'RATED') = 'RATED'
+ORDER BY gp.created_at DESC
+LIMIT @num_games::integer;
@@ -0,0 +1,44 @@
+-- name: GetUserByIdentity :one
+SELECT users.uuid, users.username
+FROM user_identities
+JOIN users ON users.id = user_identities.user_id
+WHERE user_identities.provider = @provider
+  AND user_identities.subject = @subject;
+
+-- name: LinkIdentity :execrows
+-- Links nothing if the account already has an identity with this provider,
+-- or the identity belongs to another account.
+INSERT INTO user_identities (user_id, provider, subject, email)
+VALUES (
+  (SELECT id FROM users WHERE users.uuid = @user_uuid),
+  @provider,
+  @subject,
+  @email
+)
+ON CONFLICT DO NOTHING;
+
+-- name: TouchIdentityLogin :exec
+UPDATE user_identities
+SET last_login_at = NOW()
+WHERE provider = @provider AND subject = @subject;
+
+-- name: GetIdentitiesForUser :many
+SELECT provider, email, created_at, last_login_at
+FROM user_identities
+WHERE user_id = (SELECT id FROM users WHERE users.uuid = @user_uuid)
+ORDER BY provider;
+
+-- name: UnlinkIdentity :execrows
+DELETE FROM user_identities
+WHERE provider = @provider
+  AND user_id = (SELECT id FROM users WHERE users.uuid = @user_uuid);
+
+-- name: UseSignupToken :execrows
+-- Uses nothing if the token was already used.
+INSERT INTO used_signup_tokens (token_id, expires_at)
+VALUES (@token_id, @expires_at)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteExpiredSignupTokens :exec
+DELETE FROM used_signup_tokens
+WHERE expires_at < NOW();
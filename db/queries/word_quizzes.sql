@@ -0,0 +1,67 @@
+-- name: CreateWordQuizSession :one
+INSERT INTO word_quiz_sessions (uuid, user_id, lexicon, duration_seconds, started_at, ends_at)
+VALUES (@uuid, @user_id, @lexicon, @duration_seconds, @started_at, @ends_at)
+RETURNING id;
+
+-- name: AddWordQuizQuestion :exec
+INSERT INTO word_quiz_questions (session_id, position, alphagram, num_answers)
+VALUES (@session_id, @position, @alphagram, @num_answers);
+
+-- name: GetWordQuizSession :one
+SELECT id, uuid, user_id, lexicon, duration_seconds, started_at, ends_at, finished_at
+FROM word_quiz_sessions
+WHERE uuid = @uuid;
+
+-- name: GetWordQuizQuestions :many
+SELECT position, alphagram, num_answers, found
+FROM word_quiz_questions
+WHERE session_id = @session_id
+ORDER BY position;
+
+-- name: AddWordQuizAnswer :execrows
+UPDATE word_quiz_questions
+SET found = array_append(found, @word::text)
+WHERE session_id = @session_id AND position = @position
+    AND NOT (@word::text = ANY(found));
+
+-- name: FinishWordQuizSession :execrows
+-- Only the first call finishes the session, so the cardbox is only updated
+-- once.
+UPDATE word_quiz_sessions
+SET finished_at = @finished_at
+WHERE id = @id AND finished_at IS NULL;
+
+-- name: GetDueCardboxAlphagrams :many
+SELECT alphagram
+FROM word_cardbox
+WHERE user_id = @user_id AND lexicon = @lexicon AND next_scheduled <= @now
+ORDER BY next_scheduled
+LIMIT @lim;
+
+-- name: GetCardboxAlphagrams :many
+SELECT alphagram
+FROM word_cardbox
+WHERE user_id = @user_id AND lexicon = @lexicon;
+
+-- name: GetCardboxLevels :many
+SELECT alphagram, cardbox
+FROM word_cardbox
+WHERE user_id = @user_id AND lexicon = @lexicon AND alphagram = ANY(@alphagrams::text[]);
+
+-- name: UpsertCardbox :exec
+INSERT INTO word_cardbox (user_id, lexicon, alphagram, cardbox, correct, incorrect, last_quizzed_at, next_scheduled)
+VALUES (@user_id, @lexicon, @alphagram, @cardbox, @correct, @incorrect, @last_quizzed_at, @next_scheduled)
+ON CONFLICT (user_id, lexicon, alphagram) DO UPDATE
+SET cardbox = EXCLUDED.cardbox,
+    correct = word_cardbox.correct + EXCLUDED.correct,
+    incorrect = word_cardbox.incorrect + EXCLUDED.incorrect,
+    last_quizzed_at = EXCLUDED.last_quizzed_at,
+    next_scheduled = EXCLUDED.next_scheduled;
+
+-- name: GetCardboxStats :many
+SELECT cardbox, COUNT(*) AS alphagrams,
+    COUNT(*) FILTER (WHERE next_scheduled <= @now) AS due
+FROM word_cardbox
+WHERE user_id = @user_id AND lexicon = @lexicon
+GROUP BY cardbox
+ORDER BY cardbox;
@@ -32,6 +32,7 @@ require (
 	github.com/justinas/alice v1.2.0
 	github.com/lithammer/shortuuid/v4 v4.2.0
 	github.com/matryer/is v1.4.1
+	github.com/mattn/go-sqlite3 v1.14.22
 	github.com/mmcdole/gofeed v1.3.0
 	github.com/namsral/flag v1.7.4-pre
 	github.com/nats-io/nats.go v1.52.0
@@ -52,6 +53,7 @@ require (
 	go.opentelemetry.io/otel/trace v1.45.0
 	golang.org/x/crypto v0.54.0
 	golang.org/x/exp v0.0.0-20260611194520-c48552f49976
+	golang.org/x/image v0.25.0
 	golang.org/x/sync v0.22.0
 	golang.org/x/sys v0.47.0
 	gonum.org/v1/gonum v0.17.0
@@ -1,24 +1,17 @@
 import React from "react";
 import ReactDOM from "react-dom/client";
-import { StandaloneEmbed } from "./standalone-embed";
-import { GameDocument } from "../gen/api/proto/ipc/omgwords_pb";
+import { EmbedGame, EmbedOptions, StandaloneEmbed } from "./standalone-embed";
 
-// Global interface for embed data
+// Global interface for embed data, which pkg/embed/page.go writes into the
+// page.
 declare global {
   interface Window {
     WooglesEmbed?: {
       gameId: string;
       containerId: string;
-      // eslint-disable-next-line @typescript-eslint/no-explicit-any
-      gameDocument: any; // Will be serialized JSON
-      options?: {
-        width?: number;
-        height?: number;
-        showControls?: boolean;
-        showScores?: boolean;
-        showMoveList?: boolean;
-        theme?: "light" | "dark";
-      };
+      game: EmbedGame;
+      eventsUrl?: string;
+      options?: EmbedOptions;
     };
   }
 }
@@ -30,7 +23,7 @@ function initEmbed() {
     return;
   }
 
-  const { containerId, gameDocument, options } = window.WooglesEmbed;
+  const { containerId, game, eventsUrl, options } = window.WooglesEmbed;
 
   // Find the container element
   const container = document.getElementById(containerId);
@@ -39,30 +32,12 @@ function initEmbed() {
     return;
   }
 
-  // Parse the game document if it's a string
-  let parsedGameDocument: GameDocument;
-  try {
-    if (typeof gameDocument === "string") {
-      parsedGameDocument = JSON.parse(gameDocument);
-    } else {
-      parsedGameDocument = gameDocument;
-    }
-    // Log the structure to debug
-    console.log("Parsed game document:", parsedGameDocument);
-    if (parsedGameDocument.events && parsedGameDocument.events.length > 0) {
-      console.log("First event structure:", parsedGameDocument.events[0]);
-    }
-  } catch (error) {
-    console.error("Failed to parse game document:", error);
-    return;
-  }
-
   // Create React root and render the embed
   try {
     const root = ReactDOM.createRoot(container);
     root.render(
       <React.StrictMode>
-        <StandaloneEmbed gameDocument={parsedGameDocument} options={options} />
+        <StandaloneEmbed game={game} eventsUrl={eventsUrl} options={options} />
       </React.StrictMode>,
     );
   } catch (error) {
@@ -1,165 +1,128 @@
-import React, { useState, useMemo, useCallback } from "react";
-import { DndProvider } from "react-dnd";
-import { TouchBackend } from "react-dnd-touch-backend";
-import { StaticPlayerCards } from "../puzzles/static_player_cards";
-import { Alphabet, alphabetFromName } from "../constants/alphabets";
-import {
-  CrosswordGameGridLayout,
-  SuperCrosswordGameGridLayout,
-} from "../constants/board_layout";
-import { Board as BoardClass } from "../utils/cwgame/board";
-import { MachineLetter } from "../utils/cwgame/common";
-import {
-  GameDocument,
-  GameEvent as OMGWordsGameEvent,
-  GameEvent_Type as OMGWordsGameEventType,
-} from "../gen/api/proto/ipc/omgwords_pb";
+import React, {
+  useState,
+  useMemo,
+  useCallback,
+  useEffect,
+  useRef,
+} from "react";
+import { MiniPlayerCard } from "../puzzles/static_player_cards";
 import "./standalone-embed.scss";
 import "../gameroom/scss/gameroom.scss";
 
-export interface StandaloneEmbedProps {
-  gameDocument: GameDocument;
-  options?: {
-    width?: number;
-    height?: number;
-    showControls?: boolean;
-    showScores?: boolean;
-    showMoveList?: boolean;
-    theme?: "light" | "dark";
-  };
-}
+// These types match the JSON that pkg/embed/game.go sends, both in the page
+// and on the event stream. The server does the tile mapping and move
+// formatting, so the embed doesn't need the letter distribution or the
+// store.
 
-// Simple event structure for board operations
-type SimpleGameEvent = {
-  type: number;
+// EmbedTile is a tile that a turn puts on the board.
+export type EmbedTile = {
   row: number;
-  column: number;
-  direction: number;
-  playedTiles: Uint8Array;
+  col: number;
+  letter: string;
+  blank?: boolean;
+  value: number;
+};
+
+// EmbedTurn is one event of the game.
+export type EmbedTurn = {
+  player: number;
+  summary: string;
   score: number;
-  playerIndex: number;
-  isBingo: boolean;
-  exchanged: Uint8Array;
   cumulative: number;
+  // The rack the turn was made from, if known.
+  rack?: string;
+  note?: string;
+  tiles?: EmbedTile[];
+  // Undo takes the tiles of the last placement back off the board.
+  undo?: boolean;
 };
 
-// Convert OMGWords GameEvent to simple format for board operations
-// eslint-disable-next-line @typescript-eslint/no-explicit-any
-const convertToSimpleEvt = (oevt: any): SimpleGameEvent => {
-  // The JSON uses lowercase field names
-  // Determine event type based on fields present
-  let type = OMGWordsGameEventType.TILE_PLACEMENT_MOVE; // default
-  if (oevt.exchanged) {
-    type = OMGWordsGameEventType.EXCHANGE;
-  } else if (oevt.pass !== undefined) {
-    type = OMGWordsGameEventType.PASS;
-  } else if (oevt.challengedPhony !== undefined) {
-    type = OMGWordsGameEventType.PHONY_TILES_RETURNED;
-  }
-
-  // Parse playedTiles from base64 if it's a string
-  let playedTiles = oevt.playedTiles;
-  if (typeof playedTiles === "string") {
-    // Decode base64 to Uint8Array
-    const binaryString = atob(playedTiles);
-    playedTiles = new Uint8Array(binaryString.length);
-    for (let i = 0; i < binaryString.length; i++) {
-      playedTiles[i] = binaryString.charCodeAt(i);
-    }
-  }
-
-  // Parse direction
-  const direction = oevt.direction === "VERTICAL" ? 1 : 0;
-
-  // console.log("Converting event - type:", type, "player:", oevt.playerIndex ?? 0, "cumulative:", oevt.cumulative, "tiles:", playedTiles?.length);
+export type EmbedGame = {
+  gameId: string;
+  players: string[];
+  // One string per row, with macondo's bonus square symbols.
+  layout: string[];
+  turns: EmbedTurn[];
+  // The current racks. They are empty while they are secret.
+  racks: string[] | null;
+  playerOnTurn: number;
+  // Set while the game or annotation is in progress.
+  live: boolean;
+};
 
-  return {
-    type,
-    row: oevt.row ?? 0,
-    column: oevt.column ?? 0,
-    direction,
-    playedTiles: playedTiles ?? new Uint8Array(),
-    score: oevt.score ?? 0,
-    playerIndex: oevt.playerIndex ?? 0, // Default to player 0 if not specified
-    isBingo: oevt.isBingo ?? false,
-    exchanged: oevt.exchanged ?? new Uint8Array(),
-    cumulative: oevt.cumulative ?? 0,
-  };
+export type EmbedOptions = {
+  width?: number;
+  height?: number;
+  showControls?: boolean;
+  showScores?: boolean;
+  showMoveList?: boolean;
+  showRacks?: boolean;
+  theme?: "light" | "dark";
 };
 
-// Place tiles on board (adapted from game_reducer.ts)
-const placeOnBoard = (
-  board: BoardClass,
-  pool: Uint8Array,
-  evt: SimpleGameEvent,
-  alphabet: Alphabet,
-): [{ [key: string]: boolean }, Uint8Array] => {
-  const lastPlayedTiles: { [key: string]: boolean } = {};
-  const newPool = [...pool];
+export interface StandaloneEmbedProps {
+  game: EmbedGame;
+  // The event stream, which sends the whole game again after every change.
+  eventsUrl?: string;
+  options?: EmbedOptions;
+}
 
-  if (evt.type === OMGWordsGameEventType.TILE_PLACEMENT_MOVE) {
-    const row = evt.row;
-    const col = evt.column;
-    const dir = evt.direction;
-    const playedTiles = evt.playedTiles;
+const bonusClasses: { [key: string]: string } = {
+  "~": "qw",
+  "=": "tw",
+  "-": "dw",
+  "^": "ql",
+  '"': "tl",
+  "'": "dl",
+};
 
-    for (let i = 0; i < playedTiles.length; i++) {
-      const ml = playedTiles[i] as MachineLetter;
-      if (dir === 0) {
-        // HORIZONTAL
-        board.addTile({ row, col: col + i, ml });
-        lastPlayedTiles[`${row},${col + i}`] = true;
-      } else {
-        // VERTICAL
-        board.addTile({ row: row + i, col, ml });
-        lastPlayedTiles[`${row + i},${col}`] = true;
-      }
+type Position = {
+  tiles: Map<string, EmbedTile>;
+  lastPlayed: Set<string>;
+  scores: number[];
+};
 
-      // Update pool
-      const tileIdx = newPool.indexOf(ml);
-      if (tileIdx !== -1) {
-        newPool.splice(tileIdx, 1);
-      }
+const squareKey = (row: number, col: number) => `${row},${col}`;
+
+// replay returns the position after the first n turns.
+const replay = (game: EmbedGame, n: number): Position => {
+  const tiles = new Map<string, EmbedTile>();
+  let placed: string[] = [];
+  const scores = game.players.map(() => 0);
+  for (let i = 0; i < n; i++) {
+    const turn = game.turns[i];
+    if (turn.undo) {
+      placed.forEach((k) => tiles.delete(k));
+      placed = [];
+    } else if (turn.tiles) {
+      placed = turn.tiles.map((tile) => {
+        const k = squareKey(tile.row, tile.col);
+        tiles.set(k, tile);
+        return k;
+      });
+    }
+    if (turn.player < scores.length) {
+      scores[turn.player] = turn.cumulative;
     }
   }
-
-  return [lastPlayedTiles, Uint8Array.from(newPool)];
+  const lastPlayed = new Set<string>();
+  if (n > 0 && game.turns[n - 1].tiles) {
+    placed.forEach((k) => lastPlayed.add(k));
+  }
+  return { tiles, lastPlayed, scores };
 };
 
-// Unplace tiles from board (for handling challenges)
-const unplaceOnBoard = (
-  board: BoardClass,
-  pool: Uint8Array,
-  evt: SimpleGameEvent,
-  alphabet: Alphabet,
-): Uint8Array => {
-  const newPool = [...pool];
-
-  if (evt.type === OMGWordsGameEventType.TILE_PLACEMENT_MOVE) {
-    const row = evt.row;
-    const col = evt.column;
-    const dir = evt.direction;
-    const playedTiles = evt.playedTiles;
-
-    for (let i = 0; i < playedTiles.length; i++) {
-      const ml = playedTiles[i] as MachineLetter;
-      if (dir === 0) {
-        // HORIZONTAL
-        board.removeTile({ row, col: col + i, ml });
-      } else {
-        // VERTICAL
-        board.removeTile({ row: row + i, col, ml });
-      }
-      // Return tile to pool
-      newPool.push(ml);
-    }
+const describeTurn = (game: EmbedGame, turn: EmbedTurn) => {
+  let s = `${game.players[turn.player] ?? ""}: ${turn.summary}`;
+  if (turn.score) {
+    s += ` ${turn.score > 0 ? "+" : ""}${turn.score}`;
   }
-
-  return Uint8Array.from(newPool);
+  return `${s} (${turn.cumulative})`;
 };
 
 export const StandaloneEmbed: React.FC<StandaloneEmbedProps> = ({
-  gameDocument,
+  game: initialGame,
+  eventsUrl,
   options = {},
 }) => {
   const {
@@ -168,337 +131,224 @@ export const StandaloneEmbed: React.FC<StandaloneEmbedProps> = ({
     showControls = true,
     showScores = true,
     showMoveList = false,
+    showRacks = true,
     theme = "light",
   } = options;
 
-  const [currentEventIndex, setCurrentEventIndex] = useState(0);
+  const [game, setGame] = useState(initialGame);
+  const [currentTurn, setCurrentTurn] = useState(initialGame.turns.length);
+  const numTurns = game.turns.length;
+  const numTurnsRef = useRef(numTurns);
 
-  // Debug logging
-  // console.log("Embed options:", options);
-  // console.log("showControls:", showControls, "showScores:", showScores);
-  // console.log("About to render controls section:", showControls, "currentEventIndex:", currentEventIndex, "events length:", gameDocument.events.length);
+  // Viewers at the latest turn follow the game; others stay where they are.
+  useEffect(() => {
+    if (!initialGame.live || !eventsUrl || !window.EventSource) {
+      return;
+    }
+    const es = new EventSource(eventsUrl);
+    es.onmessage = (e) => {
+      const updated: EmbedGame = JSON.parse(e.data);
+      const prevTurns = numTurnsRef.current;
+      numTurnsRef.current = updated.turns.length;
+      setCurrentTurn((turn) =>
+        turn >= prevTurns || turn > updated.turns.length
+          ? updated.turns.length
+          : turn,
+      );
+      setGame(updated);
+      if (!updated.live) {
+        es.close();
+      }
+    };
+    return () => es.close();
+  }, [initialGame.live, eventsUrl]);
 
-  const alphabet = useMemo(
-    () =>
-      alphabetFromName(
-        gameDocument.letterDistribution?.toLowerCase() || "english",
-      ),
-    [gameDocument.letterDistribution],
+  const position = useMemo(
+    () => replay(game, currentTurn),
+    [game, currentTurn],
   );
 
-  const boardLayout =
-    gameDocument.boardLayout === "SuperCrosswordGame"
-      ? SuperCrosswordGameGridLayout
-      : CrosswordGameGridLayout;
-  const gridSize = gameDocument.boardLayout === "SuperCrosswordGame" ? 21 : 15;
-  void gridSize;
+  const goTo = useCallback(
+    (turn: number) => setCurrentTurn(Math.max(0, Math.min(numTurns, turn))),
+    [numTurns],
+  );
 
-  // Initialize board and replay events up to current index
-  const { boardState, lastPlayedTiles, playerOfTileAt, currentScores } =
-    useMemo(() => {
-      const board = new BoardClass(boardLayout);
-      let pool: Uint8Array;
-      if (gameDocument.bag && "tiles" in gameDocument.bag) {
-        pool = gameDocument.bag.tiles;
-      } else {
-        pool = new Uint8Array();
+  useEffect(() => {
+    const onKeyDown = (e: KeyboardEvent) => {
+      switch (e.key) {
+        case "ArrowLeft":
+          goTo(currentTurn - 1);
+          break;
+        case "ArrowRight":
+          goTo(currentTurn + 1);
+          break;
+        case "Home":
+          goTo(0);
+          break;
+        case "End":
+          goTo(numTurns);
+          break;
       }
-      let lastPlayed: { [key: string]: boolean } = {};
-      const playerOfTile: { [key: string]: number } = {};
-      const scores = [0, 0];
-      const events = gameDocument.events;
+    };
+    document.addEventListener("keydown", onKeyDown);
+    return () => document.removeEventListener("keydown", onKeyDown);
+  }, [goTo, currentTurn, numTurns]);
+
+  const currentMoveRef = useRef<HTMLLIElement>(null);
+  useEffect(() => {
+    currentMoveRef.current?.scrollIntoView({ block: "nearest" });
+  }, [currentTurn]);
+
+  const lastTurn = currentTurn > 0 ? game.turns[currentTurn - 1] : null;
+  let playerOnTurn = -1;
+  if (currentTurn < numTurns) {
+    playerOnTurn = game.turns[currentTurn].player;
+  } else if (game.live) {
+    playerOnTurn = game.playerOnTurn;
+  }
 
-      // Replay events up to current index
-      for (let i = 0; i < currentEventIndex && i < events.length; i++) {
-        const evt = convertToSimpleEvt(events[i]);
-        const onturn = evt.playerIndex;
+  let rack = "";
+  if (showRacks) {
+    if (lastTurn?.rack) {
+      rack = `Rack: ${lastTurn.rack}`;
+    } else if (!lastTurn && game.turns[0]?.rack) {
+      rack = `Rack: ${game.turns[0].rack}`;
+    }
+    // The rack of the player on turn, while the game is going.
+    const onRack = game.racks?.[game.playerOnTurn];
+    if (game.live && currentTurn === numTurns && onRack) {
+      rack = `On rack: ${onRack}`;
+    }
+  }
 
-        switch (evt.type) {
-          case OMGWordsGameEventType.TILE_PLACEMENT_MOVE:
-            [lastPlayed, pool] = placeOnBoard(board, pool, evt, alphabet);
-            for (const k in lastPlayed) {
-              playerOfTile[k] = onturn;
-            }
-            // Update score for the player who made the move
-            scores[onturn] = events[i].cumulative;
-            break;
-          case OMGWordsGameEventType.PHONY_TILES_RETURNED:
-            // Unplace the move BEFORE this one
-            if (i > 0) {
-              const toUnplace = convertToSimpleEvt(events[i - 1]);
-              pool = unplaceOnBoard(board, pool, toUnplace, alphabet);
-              // Clear last played tiles
-              lastPlayed = {};
-            }
-            break;
-          case OMGWordsGameEventType.EXCHANGE:
-          case OMGWordsGameEventType.PASS:
-            // These don't change the score but might update cumulative
-            if (events[i].cumulative > 0) {
-              scores[onturn] = events[i].cumulative;
-            }
-            break;
-        }
+  const squares = game.layout.flatMap((row, r) =>
+    Array.from(row).map((bonus, c) => {
+      const k = squareKey(r, c);
+      const tile = position.tiles.get(k);
+      if (!tile) {
+        return (
+          <div
+            key={k}
+            className={`standalone-embed__square ${bonusClasses[bonus] ?? ""}`}
+          />
+        );
       }
-
-      // console.log("Board state after replay:", {
-      //   currentEventIndex,
-      //   boardLetters: board.letters.filter(l => l !== 0).length,
-      //   boardArray: board.letters,
-      //   scores,
-      //   lastPlayed,
-      //   playerOfTile
-      // });
-
-      return {
-        boardState: board.letters,
-        lastPlayedTiles: lastPlayed,
-        playerOfTileAt: playerOfTile,
-        currentScores: scores,
-      };
-    }, [
-      currentEventIndex,
-      gameDocument.events,
-      gameDocument.bag,
-      alphabet,
-      boardLayout,
-    ]);
-  void boardState;
-  void lastPlayedTiles;
-  void playerOfTileAt;
-
-  const handlePrevEvent = useCallback(() => {
-    setCurrentEventIndex((prev) => Math.max(0, prev - 1));
-  }, []);
-
-  const handleNextEvent = useCallback(() => {
-    setCurrentEventIndex((prev) =>
-      Math.min(gameDocument.events.length, prev + 1),
-    );
-  }, [gameDocument.events.length]);
-
-  const handleFirstEvent = useCallback(() => {
-    setCurrentEventIndex(0);
-  }, []);
-
-  const handleLastEvent = useCallback(() => {
-    setCurrentEventIndex(gameDocument.events.length);
-  }, [gameDocument.events.length]);
-
-  // Get player names for display
-
-  const currentEvent =
-    currentEventIndex > 0 ? gameDocument.events[currentEventIndex - 1] : null;
-  const playerOnTurn = currentEvent ? currentEvent.playerIndex : 0;
-
-  // Format event for display
-  const formatEvent = (event: OMGWordsGameEvent) => {
-    if (event.type === OMGWordsGameEventType.TILE_PLACEMENT_MOVE) {
-      const col = String.fromCharCode(65 + event.column);
-      const row = event.row + 1;
-      const pos = `${row}${col}`;
-
-      // Convert played tiles to readable format
-      const tiles = Array.from(event.playedTiles)
-        .map((t: number) => {
-          const ml = t as MachineLetter;
-          const isBlank = (ml & 0x80) !== 0;
-          if (isBlank) {
-            const letter = String.fromCharCode(64 + (ml & 0x7f));
-            return letter.toLowerCase();
-          }
-          return alphabet.letters?.[ml] || "?";
-        })
-        .join("");
-
-      return `${pos} ${tiles} (${event.score} pts)${event.isBingo ? " BINGO!" : ""}`;
-    } else if (event.type === OMGWordsGameEventType.EXCHANGE) {
-      return `Exchanged ${event.exchanged.length} tiles`;
-    } else if (event.type === OMGWordsGameEventType.PASS) {
-      return "Passed";
-    } else if (event.type === OMGWordsGameEventType.CHALLENGE_BONUS) {
-      return `Challenge bonus: +${event.bonus}`;
-    }
-    return "";
-  };
+      const classes = ["standalone-embed__square", "tile"];
+      if (tile.blank) {
+        classes.push("blank");
+      }
+      if (position.lastPlayed.has(k)) {
+        classes.push("last");
+      }
+      return (
+        <div key={k} className={classes.join(" ")}>
+          <span className="letter">
+            {tile.blank ? tile.letter.toLowerCase() : tile.letter}
+          </span>
+          {tile.value > 0 && <span className="value">{tile.value}</span>}
+        </div>
+      );
+    }),
+  );
 
   return (
-    <DndProvider backend={TouchBackend}>
-      <div
-        className={`standalone-embed standalone-embed--${theme}`}
-        style={{
-          width,
-          maxWidth: "100%",
-          height,
-          display: "flex",
-          flexDirection: "column",
-          border: "1px solid #e0e0e0",
-          borderRadius: "8px",
-          overflow: "hidden",
-          backgroundColor: theme === "dark" ? "#1a1a1a" : "#fff",
-        }}
-      >
-        {/* Player scores at the top */}
-        {showScores && (
-          <div
-            style={{
-              padding: "8px",
-              borderBottom: "1px solid #e0e0e0",
-              backgroundColor: theme === "dark" ? "#2a2a2a" : "#f5f5f5",
-            }}
-          >
-            <StaticPlayerCards
-              p0Score={currentScores[0]}
-              p1Score={currentScores[1]}
-              playerOnTurn={playerOnTurn}
+    <div
+      className={`standalone-embed standalone-embed--${theme}`}
+      style={{ width, maxWidth: "100%", height }}
+    >
+      {showScores && (
+        <div className="standalone-embed__scores player-cards horizontal">
+          {game.players.map((name, i) => (
+            <MiniPlayerCard
+              key={i}
+              iconName={String(i + 1)}
+              playerName={name}
+              score={position.scores[i]}
+              onTurn={i === playerOnTurn}
             />
-          </div>
-        )}
+          ))}
+        </div>
+      )}
 
-        {/* Board in the middle */}
+      <div className="standalone-embed__main">
         <div className="standalone-embed__board-container">
-          {/* Board placeholder - need to implement without store dependency */}
           <div
+            className="standalone-embed__board"
             style={{
-              width: "400px",
-              height: "400px",
-              background: "#f0f0f0",
-              display: "flex",
-              alignItems: "center",
-              justifyContent: "center",
+              gridTemplateColumns: `repeat(${game.layout[0]?.length ?? 15}, 1fr)`,
             }}
           >
-            Board requires store context - see comment
+            {squares}
           </div>
         </div>
+        {showMoveList && (
+          <ol className="standalone-embed__move-list">
+            {game.turns.map((turn, i) => (
+              <li
+                key={i}
+                ref={i === currentTurn - 1 ? currentMoveRef : undefined}
+                className={i === currentTurn - 1 ? "current" : undefined}
+                onClick={() => goTo(i + 1)}
+              >
+                {describeTurn(game, turn)}
+              </li>
+            ))}
+          </ol>
+        )}
+      </div>
 
-        {/* Controls at the bottom */}
-        {showControls && (
-          <div
-            style={{
-              display: "flex",
-              alignItems: "center",
-              justifyContent: "center",
-              gap: "12px",
-              padding: "12px",
-              borderTop: "1px solid #e0e0e0",
-              backgroundColor: theme === "dark" ? "#2a2a2a" : "#f5f5f5",
-            }}
-          >
-            <button
-              onClick={handleFirstEvent}
-              disabled={currentEventIndex === 0}
-              style={{
-                width: "36px",
-                height: "36px",
-                border: "1px solid #d0d0d0",
-                borderRadius: "4px",
-                backgroundColor: "#fff",
-                cursor: currentEventIndex === 0 ? "not-allowed" : "pointer",
-                opacity: currentEventIndex === 0 ? 0.4 : 1,
-              }}
-              title="Go to beginning"
-            >
-              ⏮
-            </button>
-            <button
-              onClick={handlePrevEvent}
-              disabled={currentEventIndex === 0}
-              style={{
-                width: "36px",
-                height: "36px",
-                border: "1px solid #d0d0d0",
-                borderRadius: "4px",
-                backgroundColor: "#fff",
-                cursor: currentEventIndex === 0 ? "not-allowed" : "pointer",
-                opacity: currentEventIndex === 0 ? 0.4 : 1,
-              }}
-              title="Previous move"
-            >
-              ◀
-            </button>
-            <div
-              style={{
-                padding: "0 16px",
-                fontSize: "14px",
-                fontWeight: 500,
-                color: theme === "dark" ? "#aaa" : "#666",
-              }}
-            >
-              Move {currentEventIndex} / {gameDocument.events.length}
-            </div>
-            <button
-              onClick={handleNextEvent}
-              disabled={currentEventIndex >= gameDocument.events.length}
-              style={{
-                width: "36px",
-                height: "36px",
-                border: "1px solid #d0d0d0",
-                borderRadius: "4px",
-                backgroundColor: "#fff",
-                cursor:
-                  currentEventIndex >= gameDocument.events.length
-                    ? "not-allowed"
-                    : "pointer",
-                opacity:
-                  currentEventIndex >= gameDocument.events.length ? 0.4 : 1,
-              }}
-              title="Next move"
-            >
-              ▶
-            </button>
-            <button
-              onClick={handleLastEvent}
-              disabled={currentEventIndex >= gameDocument.events.length}
-              style={{
-                width: "36px",
-                height: "36px",
-                border: "1px solid #d0d0d0",
-                borderRadius: "4px",
-                backgroundColor: "#fff",
-                cursor:
-                  currentEventIndex >= gameDocument.events.length
-                    ? "not-allowed"
-                    : "pointer",
-                opacity:
-                  currentEventIndex >= gameDocument.events.length ? 0.4 : 1,
-              }}
-              title="Go to end"
-            >
-              ⏭
-            </button>
-          </div>
+      <div className="standalone-embed__move-info">
+        <div className="standalone-embed__move-player">
+          {lastTurn ? describeTurn(game, lastTurn) : "Start of game"}
+        </div>
+        {rack && <div className="standalone-embed__rack">{rack}</div>}
+        {lastTurn?.note && (
+          <div className="standalone-embed__move-details">{lastTurn.note}</div>
         )}
+      </div>
 
-        {/* Move details (optional) */}
-        {showMoveList && currentEvent && (
-          <div
-            style={{
-              padding: "12px",
-              borderTop: "1px solid #e0e0e0",
-              backgroundColor: theme === "dark" ? "#252525" : "#fafafa",
-            }}
+      {showControls && (
+        <div className="standalone-embed__controls">
+          <button
+            className="standalone-embed__control-btn"
+            onClick={() => goTo(0)}
+            disabled={currentTurn === 0}
+            title="Go to beginning"
           >
-            <div
-              style={{
-                fontWeight: 600,
-                marginBottom: "4px",
-                color: theme === "dark" ? "#fff" : "#333",
-              }}
-            >
-              {gameDocument.players[currentEvent.playerIndex].nickname}
-            </div>
-            <div
-              style={{
-                fontSize: "14px",
-                color: theme === "dark" ? "#bbb" : "#666",
-              }}
-            >
-              {formatEvent(currentEvent)}
-            </div>
+            ⏮
+          </button>
+          <button
+            className="standalone-embed__control-btn"
+            onClick={() => goTo(currentTurn - 1)}
+            disabled={currentTurn === 0}
+            title="Previous move"
+          >
+            ◀
+          </button>
+          <div className="standalone-embed__turn-info">
+            Move {currentTurn} / {numTurns}
           </div>
-        )}
-      </div>
-    </DndProvider>
+          <button
+            className="standalone-embed__control-btn"
+            onClick={() => goTo(currentTurn + 1)}
+            disabled={currentTurn >= numTurns}
+            title="Next move"
+          >
+            ▶
+          </button>
+          <button
+            className="standalone-embed__control-btn"
+            onClick={() => goTo(numTurns)}
+            disabled={currentTurn >= numTurns}
+            title="Go to end"
+          >
+            ⏭
+          </button>
+          {game.live && (
+            <span className="standalone-embed__live">● LIVE</span>
+          )}
+        </div>
+      )}
+    </div>
   );
 };
@@ -64,3 +64,11 @@ export const getRecentCorrespondenceGames = GameMetadataService.method.getRecent
  * @generated from rpc game_service.GameMetadataService.UnfreezeBot
  */
 export const unfreezeBot = GameMetadataService.method.unfreezeBot;
+
+/**
+ * GetScoutingReport gets a summary of a user's rated games and, if an
+ * opponent is given, their head-to-head record against that opponent.
+ *
+ * @generated from rpc game_service.GameMetadataService.GetScoutingReport
+ */
+export const getScoutingReport = GameMetadataService.method.getScoutingReport;
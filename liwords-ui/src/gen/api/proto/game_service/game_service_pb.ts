@@ -8,13 +8,15 @@ import type { GameDocument, GameInfoResponseSchema, GameInfoResponsesSchema } fr
 import { file_proto_ipc_omgwords } from "../ipc/omgwords_pb";
 import type { GameHistory } from "../vendored/macondo/macondo_pb";
 import { file_proto_vendored_macondo_macondo } from "../vendored/macondo/macondo_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file proto/game_service/game_service.proto.
  */
 export const file_proto_game_service_game_service: GenFile = /*@__PURE__*/
-  fileDesc("CiVwcm90by9nYW1lX3NlcnZpY2UvZ2FtZV9zZXJ2aWNlLnByb3RvEgxnYW1lX3NlcnZpY2UiIgoPR2FtZUluZm9SZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkiHQoKR0NHUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIiUKEkdhbWVIaXN0b3J5UmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIiYKE0dhbWVEb2N1bWVudFJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCSIaCgtHQ0dSZXNwb25zZRILCgNnY2cYASABKAkiPAoTR2FtZUhpc3RvcnlSZXNwb25zZRIlCgdoaXN0b3J5GAEgASgLMhQubWFjb25kby5HYW1lSGlzdG9yeSI7ChRHYW1lRG9jdW1lbnRSZXNwb25zZRIjCghkb2N1bWVudBgBIAEoCzIRLmlwYy5HYW1lRG9jdW1lbnQiSQoSUmVjZW50R2FtZXNSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhEKCW51bV9nYW1lcxgCIAEoBRIOCgZvZmZzZXQYAyABKAUi+AEKElN0cmVha0luZm9SZXNwb25zZRI/CgZzdHJlYWsYASADKAsyLy5nYW1lX3NlcnZpY2UuU3RyZWFrSW5mb1Jlc3BvbnNlLlNpbmdsZUdhbWVJbmZvEkAKC3BsYXllcnNJbmZvGAMgAygLMisuZ2FtZV9zZXJ2aWNlLlN0cmVha0luZm9SZXNwb25zZS5QbGF5ZXJJbmZvGjEKDlNpbmdsZUdhbWVJbmZvEg8KB2dhbWVfaWQYASABKAkSDgoGd2lubmVyGAMgASgFGiwKClBsYXllckluZm8SEAoIbmlja25hbWUYASABKAkSDAoEdXVpZBgCIAEoCSIzChRSZW1hdGNoU3RyZWFrUmVxdWVzdBIbChNvcmlnaW5hbF9yZXF1ZXN0X2lkGAEgASgJIiIKIEFjdGl2ZUNvcnJlc3BvbmRlbmNlR2FtZXNSZXF1ZXN0IkcKIFJlY2VudENvcnJlc3BvbmRlbmNlR2FtZXNSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhEKCW51bV9nYW1lcxgCIAEoBSJSChJVbmZyZWV6ZUJvdFJlcXVlc3QSKwoEbW9kZRgBIAEoDjIdLmdhbWVfc2VydmljZS5VbmZyZWV6ZUJvdE1vZGUSDwoHZ2FtZV9pZBgCIAEoCSJVChNVbmZyZWV6ZUJvdFJlc3BvbnNlEhcKD2dhbWVzX3Byb2Nlc3NlZBgBIAEoBRIVCg1yZXF1ZXN0c19zZW50GAIgASgFEg4KBmVycm9ycxgDIAEoBSqnAQoPVW5mcmVlemVCb3RNb2RlEiEKHVVORlJFRVpFX0JPVF9NT0RFX1VOU1BFQ0lGSUVEEAASKAokVU5GUkVFWkVfQk9UX01PREVfQUxMX0NPUlJFU1BPTkRFTkNFEAESIgoeVU5GUkVFWkVfQk9UX01PREVfQUxMX1JFQUxUSU1FEAISIwofVU5GUkVFWkVfQk9UX01PREVfU1BFQ0lGSUNfR0FNRRADMpQGChNHYW1lTWV0YWRhdGFTZXJ2aWNlEkMKC0dldE1ldGFkYXRhEh0uZ2FtZV9zZXJ2aWNlLkdhbWVJbmZvUmVxdWVzdBoVLmlwYy5HYW1lSW5mb1Jlc3BvbnNlEj0KBkdldEdDRxIYLmdhbWVfc2VydmljZS5HQ0dSZXF1ZXN0GhkuZ2FtZV9zZXJ2aWNlLkdDR1Jlc3BvbnNlElUKDkdldEdhbWVIaXN0b3J5EiAuZ2FtZV9zZXJ2aWNlLkdhbWVIaXN0b3J5UmVxdWVzdBohLmdhbWVfc2VydmljZS5HYW1lSGlzdG9yeVJlc3BvbnNlEkoKDkdldFJlY2VudEdhbWVzEiAuZ2FtZV9zZXJ2aWNlLlJlY2VudEdhbWVzUmVxdWVzdBoWLmlwYy5HYW1lSW5mb1Jlc3BvbnNlcxJYChBHZXRSZW1hdGNoU3RyZWFrEiIuZ2FtZV9zZXJ2aWNlLlJlbWF0Y2hTdHJlYWtSZXF1ZXN0GiAuZ2FtZV9zZXJ2aWNlLlN0cmVha0luZm9SZXNwb25zZRJYCg9HZXRHYW1lRG9jdW1lbnQSIS5nYW1lX3NlcnZpY2UuR2FtZURvY3VtZW50UmVxdWVzdBoiLmdhbWVfc2VydmljZS5HYW1lRG9jdW1lbnRSZXNwb25zZRJmChxHZXRBY3RpdmVDb3JyZXNwb25kZW5jZUdhbWVzEi4uZ2FtZV9zZXJ2aWNlLkFjdGl2ZUNvcnJlc3BvbmRlbmNlR2FtZXNSZXF1ZXN0GhYuaXBjLkdhbWVJbmZvUmVzcG9uc2VzEmYKHEdldFJlY2VudENvcnJlc3BvbmRlbmNlR2FtZXMSLi5nYW1lX3NlcnZpY2UuUmVjZW50Q29ycmVzcG9uZGVuY2VHYW1lc1JlcXVlc3QaFi5pcGMuR2FtZUluZm9SZXNwb25zZXMSUgoLVW5mcmVlemVCb3QSIC5nYW1lX3NlcnZpY2UuVW5mcmVlemVCb3RSZXF1ZXN0GiEuZ2FtZV9zZXJ2aWNlLlVuZnJlZXplQm90UmVzcG9uc2VCqgEKEGNvbS5nYW1lX3NlcnZpY2VCEEdhbWVTZXJ2aWNlUHJvdG9QAVo4Z2l0aHViLmNvbS93b29nbGVzLWlvL2xpd29yZHMvcnBjL2FwaS9wcm90by9nYW1lX3NlcnZpY2WiAgNHWFiqAgtHYW1lU2VydmljZcoCC0dhbWVTZXJ2aWNl4gIXR2FtZVNlcnZpY2VcR1BCTWV0YWRhdGHqAgtHYW1lU2VydmljZWIGcHJvdG8z", [file_proto_ipc_omgwords, file_proto_vendored_macondo_macondo]);
+  fileDesc("CiVwcm90by9nYW1lX3NlcnZpY2UvZ2FtZV9zZXJ2aWNlLnByb3RvEgxnYW1lX3NlcnZpY2UiIgoPR2FtZUluZm9SZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkiHQoKR0NHUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIiUKEkdhbWVIaXN0b3J5UmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIiYKE0dhbWVEb2N1bWVudFJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCSIaCgtHQ0dSZXNwb25zZRILCgNnY2cYASABKAkiPAoTR2FtZUhpc3RvcnlSZXNwb25zZRIlCgdoaXN0b3J5GAEgASgLMhQubWFjb25kby5HYW1lSGlzdG9yeSI7ChRHYW1lRG9jdW1lbnRSZXNwb25zZRIjCghkb2N1bWVudBgBIAEoCzIRLmlwYy5HYW1lRG9jdW1lbnQiSQoSUmVjZW50R2FtZXNSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhEKCW51bV9nYW1lcxgCIAEoBRIOCgZvZmZzZXQYAyABKAUi+AEKElN0cmVha0luZm9SZXNwb25zZRI/CgZzdHJlYWsYASADKAsyLy5nYW1lX3NlcnZpY2UuU3RyZWFrSW5mb1Jlc3BvbnNlLlNpbmdsZUdhbWVJbmZvEkAKC3BsYXllcnNJbmZvGAMgAygLMisuZ2FtZV9zZXJ2aWNlLlN0cmVha0luZm9SZXNwb25zZS5QbGF5ZXJJbmZvGjEKDlNpbmdsZUdhbWVJbmZvEg8KB2dhbWVfaWQYASABKAkSDgoGd2lubmVyGAMgASgFGiwKClBsYXllckluZm8SEAoIbmlja25hbWUYASABKAkSDAoEdXVpZBgCIAEoCSIzChRSZW1hdGNoU3RyZWFrUmVxdWVzdBIbChNvcmlnaW5hbF9yZXF1ZXN0X2lkGAEgASgJIiIKIEFjdGl2ZUNvcnJlc3BvbmRlbmNlR2FtZXNSZXF1ZXN0IkcKIFJlY2VudENvcnJlc3BvbmRlbmNlR2FtZXNSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhEKCW51bV9nYW1lcxgCIAEoBSJSChJVbmZyZWV6ZUJvdFJlcXVlc3QSKwoEbW9kZRgBIAEoDjIdLmdhbWVfc2VydmljZS5VbmZyZWV6ZUJvdE1vZGUSDwoHZ2FtZV9pZBgCIAEoCSJVChNVbmZyZWV6ZUJvdFJlc3BvbnNlEhcKD2dhbWVzX3Byb2Nlc3NlZBgBIAEoBRIVCg1yZXF1ZXN0c19zZW50GAIgASgFEg4KBmVycm9ycxgDIAEoBSJYChVTY291dGluZ1JlcG9ydFJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSGQoRb3Bwb25lbnRfdXNlcm5hbWUYAiABKAkSEgoKbnVtX3JlY2VudBgDIAEoBSKPAQoKR2FtZVJlY29yZBINCgVnYW1lcxgBIAEoBRIMCgR3aW5zGAIgASgFEg4KBmxvc3NlcxgDIAEoBRINCgVkcmF3cxgEIAEoBRIVCg1hdmVyYWdlX3Njb3JlGAUgASgBEh4KFmF2ZXJhZ2Vfb3Bwb25lbnRfc2NvcmUYBiABKAESDgoGc3ByZWFkGAcgASgFIlMKEVRpbWVDb250cm9sUmVjb3JkEhQKDHRpbWVfY29udHJvbBgBIAEoCRIoCgZyZWNvcmQYAiABKAsyGC5nYW1lX3NlcnZpY2UuR2FtZVJlY29yZCKEAQoLTm90YWJsZUdhbWUSDwoHZ2FtZV9pZBgBIAEoCRIOCgZyZWFzb24YAiABKAkSDQoFc2NvcmUYAyABKAUSFgoOb3Bwb25lbnRfc2NvcmUYBCABKAUSLQoJcGxheWVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLMAQoKSGVhZFRvSGVhZBIqCghsaWZldGltZRgBIAEoCzIYLmdhbWVfc2VydmljZS5HYW1lUmVjb3JkEigKBnJlY2VudBgCIAEoCzIYLmdhbWVfc2VydmljZS5HYW1lUmVjb3JkEjYKDXRpbWVfY29udHJvbHMYAyADKAsyHy5nYW1lX3NlcnZpY2UuVGltZUNvbnRyb2xSZWNvcmQSMAoNbm90YWJsZV9nYW1lcxgEIAMoCzIZLmdhbWVfc2VydmljZS5Ob3RhYmxlR2FtZSIuCgxPcGVuaW5nQ291bnQSDwoHb3BlbmluZxgBIAEoCRINCgVjb3VudBgCIAEoBSL1AgoPU2NvdXRpbmdTdW1tYXJ5EigKBnJlY2VudBgBIAEoCzIYLmdhbWVfc2VydmljZS5HYW1lUmVjb3JkEg0KBWdhbWVzGAIgASgFEjMKD29wZW5pbmdfbGVuZ3RocxgDIAMoCzIaLmdhbWVfc2VydmljZS5PcGVuaW5nQ291bnQSNQoRb3BlbmluZ19wb3NpdGlvbnMYBCADKAsyGi5nYW1lX3NlcnZpY2UuT3BlbmluZ0NvdW50Eh0KFWF2ZXJhZ2Vfb3BlbmluZ19zY29yZRgFIAEoARIVCg1leGNoYW5nZV9yYXRlGAYgASgBEhYKDmNoYWxsZW5nZXNfd29uGAcgASgFEhcKD2NoYWxsZW5nZXNfbG9zdBgIIAEoBRIWCg5waG9uaWVzX3BsYXllZBgJIAEoBRIeChZwaG9uaWVzX2NoYWxsZW5nZWRfb2ZmGAogASgFEh4KFnZhbGlkX3BsYXlzX2NoYWxsZW5nZWQYCyABKAUieAoWU2NvdXRpbmdSZXBvcnRSZXNwb25zZRIuCgdzdW1tYXJ5GAEgASgLMh0uZ2FtZV9zZXJ2aWNlLlNjb3V0aW5nU3VtbWFyeRIuCgxoZWFkX3RvX2hlYWQYAiABKAsyGC5nYW1lX3NlcnZpY2UuSGVhZFRvSGVhZCqnAQoPVW5mcmVlemVCb3RNb2RlEiEKHVVORlJFRVpFX0JPVF9NT0RFX1VOU1BFQ0lGSUVEEAASKAokVU5GUkVFWkVfQk9UX01PREVfQUxMX0NPUlJFU1BPTkRFTkNFEAESIgoeVU5GUkVFWkVfQk9UX01PREVfQUxMX1JFQUxUSU1FEAISIwofVU5GUkVFWkVfQk9UX01PREVfU1BFQ0lGSUNfR0FNRRADMvQGChNHYW1lTWV0YWRhdGFTZXJ2aWNlEkMKC0dldE1ldGFkYXRhEh0uZ2FtZV9zZXJ2aWNlLkdhbWVJbmZvUmVxdWVzdBoVLmlwYy5HYW1lSW5mb1Jlc3BvbnNlEj0KBkdldEdDRxIYLmdhbWVfc2VydmljZS5HQ0dSZXF1ZXN0GhkuZ2FtZV9zZXJ2aWNlLkdDR1Jlc3BvbnNlElUKDkdldEdhbWVIaXN0b3J5EiAuZ2FtZV9zZXJ2aWNlLkdhbWVIaXN0b3J5UmVxdWVzdBohLmdhbWVfc2VydmljZS5HYW1lSGlzdG9yeVJlc3BvbnNlEkoKDkdldFJlY2VudEdhbWVzEiAuZ2FtZV9zZXJ2aWNlLlJlY2VudEdhbWVzUmVxdWVzdBoWLmlwYy5HYW1lSW5mb1Jlc3BvbnNlcxJYChBHZXRSZW1hdGNoU3RyZWFrEiIuZ2FtZV9zZXJ2aWNlLlJlbWF0Y2hTdHJlYWtSZXF1ZXN0GiAuZ2FtZV9zZXJ2aWNlLlN0cmVha0luZm9SZXNwb25zZRJYCg9HZXRHYW1lRG9jdW1lbnQSIS5nYW1lX3NlcnZpY2UuR2FtZURvY3VtZW50UmVxdWVzdBoiLmdhbWVfc2VydmljZS5HYW1lRG9jdW1lbnRSZXNwb25zZRJmChxHZXRBY3RpdmVDb3JyZXNwb25kZW5jZUdhbWVzEi4uZ2FtZV9zZXJ2aWNlLkFjdGl2ZUNvcnJlc3BvbmRlbmNlR2FtZXNSZXF1ZXN0GhYuaXBjLkdhbWVJbmZvUmVzcG9uc2VzEmYKHEdldFJlY2VudENvcnJlc3BvbmRlbmNlR2FtZXMSLi5nYW1lX3NlcnZpY2UuUmVjZW50Q29ycmVzcG9uZGVuY2VHYW1lc1JlcXVlc3QaFi5pcGMuR2FtZUluZm9SZXNwb25zZXMSUgoLVW5mcmVlemVCb3QSIC5nYW1lX3NlcnZpY2UuVW5mcmVlemVCb3RSZXF1ZXN0GiEuZ2FtZV9zZXJ2aWNlLlVuZnJlZXplQm90UmVzcG9uc2USXgoRR2V0U2NvdXRpbmdSZXBvcnQSIy5nYW1lX3NlcnZpY2UuU2NvdXRpbmdSZXBvcnRSZXF1ZXN0GiQuZ2FtZV9zZXJ2aWNlLlNjb3V0aW5nUmVwb3J0UmVzcG9uc2VCqgEKEGNvbS5nYW1lX3NlcnZpY2VCEEdhbWVTZXJ2aWNlUHJvdG9QAVo4Z2l0aHViLmNvbS93b29nbGVzLWlvL2xpd29yZHMvcnBjL2FwaS9wcm90by9nYW1lX3NlcnZpY2WiAgNHWFiqAgtHYW1lU2VydmljZcoCC0dhbWVTZXJ2aWNl4gIXR2FtZVNlcnZpY2VcR1BCTWV0YWRhdGHqAgtHYW1lU2VydmljZWIGcHJvdG8z", [file_proto_ipc_omgwords, file_proto_vendored_macondo_macondo, file_google_protobuf_timestamp]);
 
 /**
  * Meta information about a game, including its players.
@@ -341,6 +343,309 @@ export type UnfreezeBotResponse = Message<"game_service.UnfreezeBotResponse"> &
 export const UnfreezeBotResponseSchema: GenMessage<UnfreezeBotResponse> = /*@__PURE__*/
   messageDesc(file_proto_game_service_game_service, 13);
 
+/**
+ * @generated from message game_service.ScoutingReportRequest
+ */
+export type ScoutingReportRequest = Message<"game_service.ScoutingReportRequest"> & {
+  /**
+   * The user being scouted.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * If set, the head-to-head record between the scouted user and this
+   * user is returned too, from the scouted user's point of view.
+   *
+   * @generated from field: string opponent_username = 2;
+   */
+  opponentUsername: string;
+
+  /**
+   * The number of most recent games in the recent records. Defaults to 10.
+   *
+   * @generated from field: int32 num_recent = 3;
+   */
+  numRecent: number;
+};
+
+/**
+ * Describes the message game_service.ScoutingReportRequest.
+ * Use `create(ScoutingReportRequestSchema)` to create a new message.
+ */
+export const ScoutingReportRequestSchema: GenMessage<ScoutingReportRequest> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 14);
+
+/**
+ * A record over a set of rated games, from one player's point of view.
+ *
+ * @generated from message game_service.GameRecord
+ */
+export type GameRecord = Message<"game_service.GameRecord"> & {
+  /**
+   * @generated from field: int32 games = 1;
+   */
+  games: number;
+
+  /**
+   * @generated from field: int32 wins = 2;
+   */
+  wins: number;
+
+  /**
+   * @generated from field: int32 losses = 3;
+   */
+  losses: number;
+
+  /**
+   * @generated from field: int32 draws = 4;
+   */
+  draws: number;
+
+  /**
+   * @generated from field: double average_score = 5;
+   */
+  averageScore: number;
+
+  /**
+   * @generated from field: double average_opponent_score = 6;
+   */
+  averageOpponentScore: number;
+
+  /**
+   * @generated from field: int32 spread = 7;
+   */
+  spread: number;
+};
+
+/**
+ * Describes the message game_service.GameRecord.
+ * Use `create(GameRecordSchema)` to create a new message.
+ */
+export const GameRecordSchema: GenMessage<GameRecord> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 15);
+
+/**
+ * @generated from message game_service.TimeControlRecord
+ */
+export type TimeControlRecord = Message<"game_service.TimeControlRecord"> & {
+  /**
+   * ultrablitz, blitz, rapid, regular, corres
+   *
+   * @generated from field: string time_control = 1;
+   */
+  timeControl: string;
+
+  /**
+   * @generated from field: game_service.GameRecord record = 2;
+   */
+  record?: GameRecord | undefined;
+};
+
+/**
+ * Describes the message game_service.TimeControlRecord.
+ * Use `create(TimeControlRecordSchema)` to create a new message.
+ */
+export const TimeControlRecordSchema: GenMessage<TimeControlRecord> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 16);
+
+/**
+ * @generated from message game_service.NotableGame
+ */
+export type NotableGame = Message<"game_service.NotableGame"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * What makes this game notable, e.g. "highest_score" or "biggest_win".
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: int32 score = 3;
+   */
+  score: number;
+
+  /**
+   * @generated from field: int32 opponent_score = 4;
+   */
+  opponentScore: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp played_at = 5;
+   */
+  playedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message game_service.NotableGame.
+ * Use `create(NotableGameSchema)` to create a new message.
+ */
+export const NotableGameSchema: GenMessage<NotableGame> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 17);
+
+/**
+ * @generated from message game_service.HeadToHead
+ */
+export type HeadToHead = Message<"game_service.HeadToHead"> & {
+  /**
+   * @generated from field: game_service.GameRecord lifetime = 1;
+   */
+  lifetime?: GameRecord | undefined;
+
+  /**
+   * @generated from field: game_service.GameRecord recent = 2;
+   */
+  recent?: GameRecord | undefined;
+
+  /**
+   * @generated from field: repeated game_service.TimeControlRecord time_controls = 3;
+   */
+  timeControls: TimeControlRecord[];
+
+  /**
+   * @generated from field: repeated game_service.NotableGame notable_games = 4;
+   */
+  notableGames: NotableGame[];
+};
+
+/**
+ * Describes the message game_service.HeadToHead.
+ * Use `create(HeadToHeadSchema)` to create a new message.
+ */
+export const HeadToHeadSchema: GenMessage<HeadToHead> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 18);
+
+/**
+ * @generated from message game_service.OpeningCount
+ */
+export type OpeningCount = Message<"game_service.OpeningCount"> & {
+  /**
+   * @generated from field: string opening = 1;
+   */
+  opening: string;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message game_service.OpeningCount.
+ * Use `create(OpeningCountSchema)` to create a new message.
+ */
+export const OpeningCountSchema: GenMessage<OpeningCount> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 19);
+
+/**
+ * A summary of a user's rated games, from their profile stats across all
+ * variants.
+ *
+ * @generated from message game_service.ScoutingSummary
+ */
+export type ScoutingSummary = Message<"game_service.ScoutingSummary"> & {
+  /**
+   * The recent record against anyone.
+   *
+   * @generated from field: game_service.GameRecord recent = 1;
+   */
+  recent?: GameRecord | undefined;
+
+  /**
+   * @generated from field: int32 games = 2;
+   */
+  games: number;
+
+  /**
+   * The most common opening lengths ("5" for a five tile opening, or
+   * "exchange" or "pass") and positions ("8D"), most common first.
+   *
+   * @generated from field: repeated game_service.OpeningCount opening_lengths = 3;
+   */
+  openingLengths: OpeningCount[];
+
+  /**
+   * @generated from field: repeated game_service.OpeningCount opening_positions = 4;
+   */
+  openingPositions: OpeningCount[];
+
+  /**
+   * @generated from field: double average_opening_score = 5;
+   */
+  averageOpeningScore: number;
+
+  /**
+   * Exchanges per turn.
+   *
+   * @generated from field: double exchange_rate = 6;
+   */
+  exchangeRate: number;
+
+  /**
+   * @generated from field: int32 challenges_won = 7;
+   */
+  challengesWon: number;
+
+  /**
+   * @generated from field: int32 challenges_lost = 8;
+   */
+  challengesLost: number;
+
+  /**
+   * @generated from field: int32 phonies_played = 9;
+   */
+  phoniesPlayed: number;
+
+  /**
+   * @generated from field: int32 phonies_challenged_off = 10;
+   */
+  phoniesChallengedOff: number;
+
+  /**
+   * Valid plays the user made that their opponent challenged.
+   *
+   * @generated from field: int32 valid_plays_challenged = 11;
+   */
+  validPlaysChallenged: number;
+};
+
+/**
+ * Describes the message game_service.ScoutingSummary.
+ * Use `create(ScoutingSummarySchema)` to create a new message.
+ */
+export const ScoutingSummarySchema: GenMessage<ScoutingSummary> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 20);
+
+/**
+ * @generated from message game_service.ScoutingReportResponse
+ */
+export type ScoutingReportResponse = Message<"game_service.ScoutingReportResponse"> & {
+  /**
+   * @generated from field: game_service.ScoutingSummary summary = 1;
+   */
+  summary?: ScoutingSummary | undefined;
+
+  /**
+   * Only set if an opponent_username was given.
+   *
+   * @generated from field: game_service.HeadToHead head_to_head = 2;
+   */
+  headToHead?: HeadToHead | undefined;
+};
+
+/**
+ * Describes the message game_service.ScoutingReportResponse.
+ * Use `create(ScoutingReportResponseSchema)` to create a new message.
+ */
+export const ScoutingReportResponseSchema: GenMessage<ScoutingReportResponse> = /*@__PURE__*/
+  messageDesc(file_proto_game_service_game_service, 21);
+
 /**
  * @generated from enum game_service.UnfreezeBotMode
  */
@@ -464,6 +769,17 @@ export const GameMetadataService: GenService<{
     input: typeof UnfreezeBotRequestSchema;
     output: typeof UnfreezeBotResponseSchema;
   },
+  /**
+   * GetScoutingReport gets a summary of a user's rated games and, if an
+   * opponent is given, their head-to-head record against that opponent.
+   *
+   * @generated from rpc game_service.GameMetadataService.GetScoutingReport
+   */
+  getScoutingReport: {
+    methodKind: "unary";
+    input: typeof ScoutingReportRequestSchema;
+    output: typeof ScoutingReportResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_game_service_game_service, 0);
 
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/ipc/errors.proto.
  */
 export const file_proto_ipc_errors: GenFile = /*@__PURE__*/
-  fileDesc("ChZwcm90by9pcGMvZXJyb3JzLnByb3RvEgNpcGMiHwoMRXJyb3JNZXNzYWdlEg8KB21lc3NhZ2UYASABKAkqtSAKDFdvb2dsZXNFcnJvchILCgdERUZBVUxUEAASKgolVE9VUk5BTUVOVF9ORUdBVElWRV9NQVhfQllFX1BMQUNFTUVOVBDpBxImCiFUT1VSTkFNRU5UX05FR0FUSVZFX01JTl9QTEFDRU1FTlQQ6gcSJgohVE9VUk5BTUVOVF9ORUdBVElWRV9HSUJTT05fU1BSRUFEEOsHEiQKH1RPVVJOQU1FTlRfRU1QVFlfUk9VTkRfQ09OVFJPTFMQ7AcSLgopVE9VUk5BTUVOVF9TRVRfUk9VTkRfQ09OVFJPTFNfQUZURVJfU1RBUlQQ7QcSKAojVE9VUk5BTUVOVF9FTElNSU5BVElPTl9QQUlSSU5HU19NSVgQ7gcSLAonVE9VUk5BTUVOVF9ESVNDT05USU5VT1VTX0lOSVRJQUxfRk9OVEVTEO8HEi0KKFRPVVJOQU1FTlRfSU5WQUxJRF9JTklUSUFMX0ZPTlRFU19ST1VORFMQ8AcSKwomVE9VUk5BTUVOVF9JTlZBTElEX0VMSU1JTkFUSU9OX1BMQVlFUlMQ8QcSKQokVE9VUk5BTUVOVF9ST1VORF9OVU1CRVJfT1VUX09GX1JBTkdFEPIHEiIKHVRPVVJOQU1FTlRfTk9ORVhJU1RFTlRfUExBWUVSEPMHEigKI1RPVVJOQU1FTlRfTk9OQU1FTkRNRU5UX1BBU1RfUkVTVUxUEPQHEiQKH1RPVVJOQU1FTlRfRlVUVVJFX05PTkJZRV9SRVNVTFQQ9QcSIgodVE9VUk5BTUVOVF9OSUxfUExBWUVSX1BBSVJJTkcQ9gcSHAoXVE9VUk5BTUVOVF9OT05PUFBPTkVOVFMQ9wcSLgopVE9VUk5BTUVOVF9NSVhFRF9WT0lEX0FORF9OT05WT0lEX1JFU1VMVFMQ+AcSIwoeVE9VUk5BTUVOVF9OT05FWElTVEVOVF9QQUlSSU5HEPkHEiMKHlRPVVJOQU1FTlRfVU5JTklUSUFMSVpFRF9HQU1FUxD6BxIrCiZUT1VSTkFNRU5UX1RJRUJSRUFLX0lOVkFMSURfR0FNRV9JTkRFWBD7BxInCiJUT1VSTkFNRU5UX0dBTUVfSU5ERVhfT1VUX09GX1JBTkdFEPwHEigKI1RPVVJOQU1FTlRfUkVTVUxUX0FMUkVBRFlfU1VCTUlUVEVEEP0HEiwKJ1RPVVJOQU1FTlRfTk9ORVhJU1RFTlRfUkVTVUxUX0FNRU5ETUVOVBD+BxIgChtUT1VSTkFNRU5UX0dJQlNPTl9DQU5fQ0FUQ0gQ/wcSIQocVE9VUk5BTUVOVF9DQU5OT1RfQVNTSUdOX0JZRRCACBInCiJUT1VSTkFNRU5UX0lOVEVSTkFMX0JZRV9BU1NJR05NRU5UEIEIEikKJFRPVVJOQU1FTlRfSU5DT1JSRUNUX1BBSVJJTkdTX0xFTkdUSBCCCBIlCiBUT1VSTkFNRU5UX1BBSVJJTkdTX0FTU0lHTkVEX0JZRRCDCBIqCiVUT1VSTkFNRU5UX1NVU1BFTkRFRF9QTEFZRVJfVU5SRU1PVkVEEIQIEioKJVRPVVJOQU1FTlRfUEFJUklOR19JTkRFWF9PVVRfT0ZfUkFOR0UQhQgSJwoiVE9VUk5BTUVOVF9TVVNQRU5ERURfUExBWUVSX1BBSVJFRBCGCBIhChxUT1VSTkFNRU5UX1BMQVlFUl9OT1RfUEFJUkVEEIcIEiUKIFRPVVJOQU1FTlRfUExBWUVSX0FMUkVBRFlfRVhJU1RTEIgIEiYKIVRPVVJOQU1FTlRfQUREX1BMQVlFUlNfTEFTVF9ST1VORBCJCBIpCiRUT1VSTkFNRU5UX1BMQVlFUl9JTkRFWF9PVVRfT0ZfUkFOR0UQiggSJgohVE9VUk5BTUVOVF9QTEFZRVJfQUxSRUFEWV9SRU1PVkVEEIsIEi4KKVRPVVJOQU1FTlRfUkVNT1ZBTF9DUkVBVEVTX0VNUFRZX0RJVklTSU9OEIwIEiUKIFRPVVJOQU1FTlRfTkVHQVRJVkVfR0lCU09OX1JPVU5EEI0IEiIKHVRPVVJOQU1FTlRfUk9VTkRfTk9UX0NPTVBMRVRFEI4IEhgKE1RPVVJOQU1FTlRfRklOSVNIRUQQjwgSHQoYVE9VUk5BTUVOVF9OT1RfU1RBUlRBQkxFEJAIEh8KGlRPVVJOQU1FTlRfUk9VTkRfTk9UX1JFQURZEJEIEiUKIFRPVVJOQU1FTlRfU0VUX0dBTUVfUk9VTkRfTlVNQkVSEJIIEh0KGFRPVVJOQU1FTlRfQUxSRUFEWV9SRUFEWRCTCBImCiFUT1VSTkFNRU5UX1NFVF9SRUFEWV9NVUxUSVBMRV9JRFMQlAgSKgolVE9VUk5BTUVOVF9TRVRfUkVBRFlfUExBWUVSX05PVF9GT1VORBCVCBIYChNUT1VSTkFNRU5UX05PX0xPU0VSEJYIEhkKFFRPVVJOQU1FTlRfTk9fV0lOTkVSEJcIEh8KGlRPVVJOQU1FTlRfVU5QQUlSRURfUExBWUVSEJgIEh8KGlRPVVJOQU1FTlRfSU5WQUxJRF9QQUlSSU5HEJkIEh0KGFRPVVJOQU1FTlRfSU5WQUxJRF9TV0lTUxCaCBIkCh9UT1VSTkFNRU5UX1pFUk9fR0FNRVNfUEVSX1JPVU5EEJsIEhoKFVRPVVJOQU1FTlRfRU1QVFlfTkFNRRCcCBIbChZUT1VSTkFNRU5UX05PVF9TVEFSVEVEEJ0IEiQKH1RPVVJOQU1FTlRfTk9ORVhJU1RFTlRfRElWSVNJT04QnggSJAofVE9VUk5BTUVOVF9OSUxfRElWSVNJT05fTUFOQUdFUhCfCBItCihUT1VSTkFNRU5UX1NFVF9OT05fRlVUVVJFX1JPVU5EX0NPTlRST0xTEKAIEigKI1RPVVJOQU1FTlRfQUREX0RJVklTSU9OX0FGVEVSX1NUQVJUEKEIEiUKIFRPVVJOQU1FTlRfSU5WQUxJRF9ESVZJU0lPTl9OQU1FEKIIEicKIlRPVVJOQU1FTlRfRElWSVNJT05fQUxSRUFEWV9FWElTVFMQowgSLAonVE9VUk5BTUVOVF9ESVZJU0lPTl9SRU1PVkFMX0FGVEVSX1NUQVJUEKQIEjEKLFRPVVJOQU1FTlRfRElWSVNJT05fUkVNT1ZBTF9FWElTVElOR19QTEFZRVJTEKUIEiYKIVRPVVJOQU1FTlRfUExBWUVSX0lEX0NPTlNUUlVDVElPThCmCBIpCiRUT1VSTkFNRU5UX0VYRUNVVElWRV9ESVJFQ1RPUl9FWElTVFMQpwgSHwoaVE9VUk5BTUVOVF9ESVJFQ1RPUl9FWElTVFMQqAgSHAoXVE9VUk5BTUVOVF9OT19ESVZJU0lPTlMQqQgSJQogVE9VUk5BTUVOVF9HQU1FX0NPTlRST0xTX05PVF9TRVQQqggSJQogVE9VUk5BTUVOVF9JTkNPUlJFQ1RfU1RBUlRfUk9VTkQQqwgSJQogVE9VUk5BTUVOVF9QQUlSX05PTl9GVVRVUkVfUk9VTkQQrAgSJwoiVE9VUk5BTUVOVF9ERUxFVEVfTk9OX0ZVVFVSRV9ST1VORBCtCBIlCiBUT1VSTkFNRU5UX0RJVklTSU9OX05PVF9GSU5JU0hFRBCuCBIyCi1UT1VSTkFNRU5UX05PVF9FWEFDVExZX09ORV9FWEVDVVRJVkVfRElSRUNUT1IQrwgSKgolVE9VUk5BTUVOVF9FWEVDVVRJVkVfRElSRUNUT1JfUkVNT1ZBTBCwCBIlCiBUT1VSTkFNRU5UX0lOVkFMSURfRlVUVVJFX1JFU1VMVBCxCBIpCiRUT1VSTkFNRU5UX1NDSEVEVUxFRF9TVEFSVF9BRlRFUl9FTkQQwggSHAoXVE9VUk5BTUVOVF9OT1RfRklOSVNIRUQQwwgSKAojVE9VUk5BTUVOVF9PUEVOQ0hFQ0tJTlNfQUZURVJfU1RBUlQQxAgSHwoaVE9VUk5BTUVOVF9DSEVDS0lOU19DTE9TRUQQxQgSHgoZVE9VUk5BTUVOVF9OT1RfUkVHSVNURVJFRBDGCBIkCh9UT1VSTkFNRU5UX1JFR0lTVFJBVElPTlNfQ0xPU0VEEMcIEh8KGlRPVVJOQU1FTlRfQUxSRUFEWV9TVEFSVEVEEMgIEi0KKFRPVVJOQU1FTlRfT1BFTlJFR0lTVFJBVElPTlNfQUZURVJfU1RBUlQQyQgSOwo2VE9VUk5BTUVOVF9DQU5OT1RfU1RBUlRfQ0hFQ0tJTlNfT1JfUkVHSVNUUkFUSU9OU19PUEVOEMoIEjsKNlRPVVJOQU1FTlRfQ0FOTk9UX1JFTU9WRV9VTkNIRUNLRURfSU5fSUZfQ0hFQ0tJTlNfT1BFThDLCBIhChxUT1VSTkFNRU5UX0NPUF9JTl9GSVJTVF9IQUxGEMwIEicKIlRPVVJOQU1FTlRfQ09QX0lOVkFMSURfU0lNVUxBVElPTlMQzQgSKAojVE9VUk5BTUVOVF9DT1BfSU5WQUxJRF9QTEFDRV9QUklaRVMQzggSJgohVE9VUk5BTUVOVF9DT1BfSU5WQUxJRF9QQVJBTUVURVJTEM8IEiEKHFRPVVJOQU1FTlRfTk9OX0NPUF9BRlRFUl9DT1AQ0AgSGAoTUFVaWkxFX1ZPVEVfSU5WQUxJRBCyCBIqCiVQVVpaTEVfR0VUX1JBTkRPTV9QVVpaTEVfSURfTk9UX0ZPVU5EELMIEicKIlBVWlpMRV9HRVRfUkFORE9NX1BVWlpMRV9OT1RfRk9VTkQQtAgSJQogUFVaWkxFX0dFVF9QVVpaTEVfVVVJRF9OT1RfRk9VTkQQtQgSKwomUFVaWkxFX0dFVF9QUkVWSU9VU19QVVpaTEVfTk9fQVRURU1QVFMQtggSMQosUFVaWkxFX0dFVF9QUkVWSU9VU19QVVpaTEVfQVRURU1QVF9OT1RfRk9VTkQQtwgSLAonUFVaWkxFX0dFVF9BTlNXRVJfUFVaWkxFX1VVSURfTk9UX0ZPVU5EELgIEi0KKFBVWlpMRV9TVUJNSVRfQU5TV0VSX1BVWlpMRV9JRF9OT1RfRk9VTkQQuQgSJQogUFVaWkxFX1NVQk1JVF9BTlNXRVJfU0VUX0NPUlJFQ1QQuggSJgohUFVaWkxFX1NVQk1JVF9BTlNXRVJfU0VUX0FUVEVNUFRTELsIEigKI1BVWlpMRV9TRVRfUFVaWkxFX1ZPVEVfSURfTk9UX0ZPVU5EELwIEjIKLVBVWlpMRV9TVUJNSVRfQU5TV0VSX1BVWlpMRV9BVFRFTVBUX05PVF9GT1VORBC9CBIlCiBQVVpaTEVfR0VUX1BVWlpMRV9VUERBVEVfQVRURU1QVBC+CBIkCh9QVVpaTEVfR0VUX0FOU1dFUl9OT1RfWUVUX1JBVEVEEL8IEhoKFVVTRVJfVVBEQVRFX05PVF9GT1VORBDACBIdChhHQU1FX05PX0xPTkdFUl9BVkFJTEFCTEUQwQhCcwoHY29tLmlwY0ILRXJyb3JzUHJvdG9QAVovZ2l0aHViLmNvbS93b29nbGVzLWlvL2xpd29yZHMvcnBjL2FwaS9wcm90by9pcGOiAgNJWFiqAgNJcGPKAgNJcGPiAg9JcGNcR1BCTWV0YWRhdGHqAgNJcGNiBnByb3RvMw");
+  fileDesc("ChZwcm90by9pcGMvZXJyb3JzLnByb3RvEgNpcGMiHwoMRXJyb3JNZXNzYWdlEg8KB21lc3NhZ2UYASABKAkqqSMKDFdvb2dsZXNFcnJvchILCgdERUZBVUxUEAASKgolVE9VUk5BTUVOVF9ORUdBVElWRV9NQVhfQllFX1BMQUNFTUVOVBDpBxImCiFUT1VSTkFNRU5UX05FR0FUSVZFX01JTl9QTEFDRU1FTlQQ6gcSJgohVE9VUk5BTUVOVF9ORUdBVElWRV9HSUJTT05fU1BSRUFEEOsHEiQKH1RPVVJOQU1FTlRfRU1QVFlfUk9VTkRfQ09OVFJPTFMQ7AcSLgopVE9VUk5BTUVOVF9TRVRfUk9VTkRfQ09OVFJPTFNfQUZURVJfU1RBUlQQ7QcSKAojVE9VUk5BTUVOVF9FTElNSU5BVElPTl9QQUlSSU5HU19NSVgQ7gcSLAonVE9VUk5BTUVOVF9ESVNDT05USU5VT1VTX0lOSVRJQUxfRk9OVEVTEO8HEi0KKFRPVVJOQU1FTlRfSU5WQUxJRF9JTklUSUFMX0ZPTlRFU19ST1VORFMQ8AcSKwomVE9VUk5BTUVOVF9JTlZBTElEX0VMSU1JTkFUSU9OX1BMQVlFUlMQ8QcSKQokVE9VUk5BTUVOVF9ST1VORF9OVU1CRVJfT1VUX09GX1JBTkdFEPIHEiIKHVRPVVJOQU1FTlRfTk9ORVhJU1RFTlRfUExBWUVSEPMHEigKI1RPVVJOQU1FTlRfTk9OQU1FTkRNRU5UX1BBU1RfUkVTVUxUEPQHEiQKH1RPVVJOQU1FTlRfRlVUVVJFX05PTkJZRV9SRVNVTFQQ9QcSIgodVE9VUk5BTUVOVF9OSUxfUExBWUVSX1BBSVJJTkcQ9gcSHAoXVE9VUk5BTUVOVF9OT05PUFBPTkVOVFMQ9wcSLgopVE9VUk5BTUVOVF9NSVhFRF9WT0lEX0FORF9OT05WT0lEX1JFU1VMVFMQ+AcSIwoeVE9VUk5BTUVOVF9OT05FWElTVEVOVF9QQUlSSU5HEPkHEiMKHlRPVVJOQU1FTlRfVU5JTklUSUFMSVpFRF9HQU1FUxD6BxIrCiZUT1VSTkFNRU5UX1RJRUJSRUFLX0lOVkFMSURfR0FNRV9JTkRFWBD7BxInCiJUT1VSTkFNRU5UX0dBTUVfSU5ERVhfT1VUX09GX1JBTkdFEPwHEigKI1RPVVJOQU1FTlRfUkVTVUxUX0FMUkVBRFlfU1VCTUlUVEVEEP0HEiwKJ1RPVVJOQU1FTlRfTk9ORVhJU1RFTlRfUkVTVUxUX0FNRU5ETUVOVBD+BxIgChtUT1VSTkFNRU5UX0dJQlNPTl9DQU5fQ0FUQ0gQ/wcSIQocVE9VUk5BTUVOVF9DQU5OT1RfQVNTSUdOX0JZRRCACBInCiJUT1VSTkFNRU5UX0lOVEVSTkFMX0JZRV9BU1NJR05NRU5UEIEIEikKJFRPVVJOQU1FTlRfSU5DT1JSRUNUX1BBSVJJTkdTX0xFTkdUSBCCCBIlCiBUT1VSTkFNRU5UX1BBSVJJTkdTX0FTU0lHTkVEX0JZRRCDCBIqCiVUT1VSTkFNRU5UX1NVU1BFTkRFRF9QTEFZRVJfVU5SRU1PVkVEEIQIEioKJVRPVVJOQU1FTlRfUEFJUklOR19JTkRFWF9PVVRfT0ZfUkFOR0UQhQgSJwoiVE9VUk5BTUVOVF9TVVNQRU5ERURfUExBWUVSX1BBSVJFRBCGCBIhChxUT1VSTkFNRU5UX1BMQVlFUl9OT1RfUEFJUkVEEIcIEiUKIFRPVVJOQU1FTlRfUExBWUVSX0FMUkVBRFlfRVhJU1RTEIgIEiYKIVRPVVJOQU1FTlRfQUREX1BMQVlFUlNfTEFTVF9ST1VORBCJCBIpCiRUT1VSTkFNRU5UX1BMQVlFUl9JTkRFWF9PVVRfT0ZfUkFOR0UQiggSJgohVE9VUk5BTUVOVF9QTEFZRVJfQUxSRUFEWV9SRU1PVkVEEIsIEi4KKVRPVVJOQU1FTlRfUkVNT1ZBTF9DUkVBVEVTX0VNUFRZX0RJVklTSU9OEIwIEiUKIFRPVVJOQU1FTlRfTkVHQVRJVkVfR0lCU09OX1JPVU5EEI0IEiIKHVRPVVJOQU1FTlRfUk9VTkRfTk9UX0NPTVBMRVRFEI4IEhgKE1RPVVJOQU1FTlRfRklOSVNIRUQQjwgSHQoYVE9VUk5BTUVOVF9OT1RfU1RBUlRBQkxFEJAIEh8KGlRPVVJOQU1FTlRfUk9VTkRfTk9UX1JFQURZEJEIEiUKIFRPVVJOQU1FTlRfU0VUX0dBTUVfUk9VTkRfTlVNQkVSEJIIEh0KGFRPVVJOQU1FTlRfQUxSRUFEWV9SRUFEWRCTCBImCiFUT1VSTkFNRU5UX1NFVF9SRUFEWV9NVUxUSVBMRV9JRFMQlAgSKgolVE9VUk5BTUVOVF9TRVRfUkVBRFlfUExBWUVSX05PVF9GT1VORBCVCBIYChNUT1VSTkFNRU5UX05PX0xPU0VSEJYIEhkKFFRPVVJOQU1FTlRfTk9fV0lOTkVSEJcIEh8KGlRPVVJOQU1FTlRfVU5QQUlSRURfUExBWUVSEJgIEh8KGlRPVVJOQU1FTlRfSU5WQUxJRF9QQUlSSU5HEJkIEh0KGFRPVVJOQU1FTlRfSU5WQUxJRF9TV0lTUxCaCBIkCh9UT1VSTkFNRU5UX1pFUk9fR0FNRVNfUEVSX1JPVU5EEJsIEhoKFVRPVVJOQU1FTlRfRU1QVFlfTkFNRRCcCBIbChZUT1VSTkFNRU5UX05PVF9TVEFSVEVEEJ0IEiQKH1RPVVJOQU1FTlRfTk9ORVhJU1RFTlRfRElWSVNJT04QnggSJAofVE9VUk5BTUVOVF9OSUxfRElWSVNJT05fTUFOQUdFUhCfCBItCihUT1VSTkFNRU5UX1NFVF9OT05fRlVUVVJFX1JPVU5EX0NPTlRST0xTEKAIEigKI1RPVVJOQU1FTlRfQUREX0RJVklTSU9OX0FGVEVSX1NUQVJUEKEIEiUKIFRPVVJOQU1FTlRfSU5WQUxJRF9ESVZJU0lPTl9OQU1FEKIIEicKIlRPVVJOQU1FTlRfRElWSVNJT05fQUxSRUFEWV9FWElTVFMQowgSLAonVE9VUk5BTUVOVF9ESVZJU0lPTl9SRU1PVkFMX0FGVEVSX1NUQVJUEKQIEjEKLFRPVVJOQU1FTlRfRElWSVNJT05fUkVNT1ZBTF9FWElTVElOR19QTEFZRVJTEKUIEiYKIVRPVVJOQU1FTlRfUExBWUVSX0lEX0NPTlNUUlVDVElPThCmCBIpCiRUT1VSTkFNRU5UX0VYRUNVVElWRV9ESVJFQ1RPUl9FWElTVFMQpwgSHwoaVE9VUk5BTUVOVF9ESVJFQ1RPUl9FWElTVFMQqAgSHAoXVE9VUk5BTUVOVF9OT19ESVZJU0lPTlMQqQgSJQogVE9VUk5BTUVOVF9HQU1FX0NPTlRST0xTX05PVF9TRVQQqggSJQogVE9VUk5BTUVOVF9JTkNPUlJFQ1RfU1RBUlRfUk9VTkQQqwgSJQogVE9VUk5BTUVOVF9QQUlSX05PTl9GVVRVUkVfUk9VTkQQrAgSJwoiVE9VUk5BTUVOVF9ERUxFVEVfTk9OX0ZVVFVSRV9ST1VORBCtCBIlCiBUT1VSTkFNRU5UX0RJVklTSU9OX05PVF9GSU5JU0hFRBCuCBIyCi1UT1VSTkFNRU5UX05PVF9FWEFDVExZX09ORV9FWEVDVVRJVkVfRElSRUNUT1IQrwgSKgolVE9VUk5BTUVOVF9FWEVDVVRJVkVfRElSRUNUT1JfUkVNT1ZBTBCwCBIlCiBUT1VSTkFNRU5UX0lOVkFMSURfRlVUVVJFX1JFU1VMVBCxCBIpCiRUT1VSTkFNRU5UX1NDSEVEVUxFRF9TVEFSVF9BRlRFUl9FTkQQwggSHAoXVE9VUk5BTUVOVF9OT1RfRklOSVNIRUQQwwgSKAojVE9VUk5BTUVOVF9PUEVOQ0hFQ0tJTlNfQUZURVJfU1RBUlQQxAgSHwoaVE9VUk5BTUVOVF9DSEVDS0lOU19DTE9TRUQQxQgSHgoZVE9VUk5BTUVOVF9OT1RfUkVHSVNURVJFRBDGCBIkCh9UT1VSTkFNRU5UX1JFR0lTVFJBVElPTlNfQ0xPU0VEEMcIEh8KGlRPVVJOQU1FTlRfQUxSRUFEWV9TVEFSVEVEEMgIEi0KKFRPVVJOQU1FTlRfT1BFTlJFR0lTVFJBVElPTlNfQUZURVJfU1RBUlQQyQgSOwo2VE9VUk5BTUVOVF9DQU5OT1RfU1RBUlRfQ0hFQ0tJTlNfT1JfUkVHSVNUUkFUSU9OU19PUEVOEMoIEjsKNlRPVVJOQU1FTlRfQ0FOTk9UX1JFTU9WRV9VTkNIRUNLRURfSU5fSUZfQ0hFQ0tJTlNfT1BFThDLCBIhChxUT1VSTkFNRU5UX0NPUF9JTl9GSVJTVF9IQUxGEMwIEicKIlRPVVJOQU1FTlRfQ09QX0lOVkFMSURfU0lNVUxBVElPTlMQzQgSKAojVE9VUk5BTUVOVF9DT1BfSU5WQUxJRF9QTEFDRV9QUklaRVMQzggSJgohVE9VUk5BTUVOVF9DT1BfSU5WQUxJRF9QQVJBTUVURVJTEM8IEiEKHFRPVVJOQU1FTlRfTk9OX0NPUF9BRlRFUl9DT1AQ0AgSGAoTUFVaWkxFX1ZPVEVfSU5WQUxJRBCyCBIqCiVQVVpaTEVfR0VUX1JBTkRPTV9QVVpaTEVfSURfTk9UX0ZPVU5EELMIEicKIlBVWlpMRV9HRVRfUkFORE9NX1BVWlpMRV9OT1RfRk9VTkQQtAgSJQogUFVaWkxFX0dFVF9QVVpaTEVfVVVJRF9OT1RfRk9VTkQQtQgSKwomUFVaWkxFX0dFVF9QUkVWSU9VU19QVVpaTEVfTk9fQVRURU1QVFMQtggSMQosUFVaWkxFX0dFVF9QUkVWSU9VU19QVVpaTEVfQVRURU1QVF9OT1RfRk9VTkQQtwgSLAonUFVaWkxFX0dFVF9BTlNXRVJfUFVaWkxFX1VVSURfTk9UX0ZPVU5EELgIEi0KKFBVWlpMRV9TVUJNSVRfQU5TV0VSX1BVWlpMRV9JRF9OT1RfRk9VTkQQuQgSJQogUFVaWkxFX1NVQk1JVF9BTlNXRVJfU0VUX0NPUlJFQ1QQuggSJgohUFVaWkxFX1NVQk1JVF9BTlNXRVJfU0VUX0FUVEVNUFRTELsIEigKI1BVWlpMRV9TRVRfUFVaWkxFX1ZPVEVfSURfTk9UX0ZPVU5EELwIEjIKLVBVWlpMRV9TVUJNSVRfQU5TV0VSX1BVWlpMRV9BVFRFTVBUX05PVF9GT1VORBC9CBIlCiBQVVpaTEVfR0VUX1BVWlpMRV9VUERBVEVfQVRURU1QVBC+CBIkCh9QVVpaTEVfR0VUX0FOU1dFUl9OT1RfWUVUX1JBVEVEEL8IEhoKFVVTRVJfVVBEQVRFX05PVF9GT1VORBDACBIdChhHQU1FX05PX0xPTkdFUl9BVkFJTEFCTEUQwQgSHAoXUFVaWkxFX1JFVklFV19OT1RfRk9VTkQQ0QgSGQoUUFVaWkxFX1NFVF9OT1RfRk9VTkQQ0ggSHwoaUFVaWkxFX1NFVF9JTlZBTElEX1BVWlpMRVMQ0wgSGgoVUFVaWkxFX1JVU0hfTk9UX0ZPVU5EENQIEhYKEVBVWlpMRV9SVVNIX0VOREVEENUIEiEKHFBVWlpMRV9SVVNIX0lOVkFMSURfRFVSQVRJT04Q1ggSGwoWUFVaWkxFX1JVU0hfTk9fUFVaWkxFUxDXCBIhChxQVVpaTEVfUlVTSF9BTFJFQURZX0FOU1dFUkVEENgIEhsKFkRBSUxZX1BVWlpMRV9OT1RfRk9VTkQQ2QgSIgodREFJTFlfUFVaWkxFX0FMUkVBRFlfQU5TV0VSRUQQ2ggSHgoZREFJTFlfUFVaWkxFX0lOVkFMSURfREFURRDbCBIgChtEQUlMWV9QVVpaTEVfSU5WQUxJRF9QVVpaTEUQ3AhCcwoHY29tLmlwY0ILRXJyb3JzUHJvdG9QAVovZ2l0aHViLmNvbS93b29nbGVzLWlvL2xpd29yZHMvcnBjL2FwaS9wcm90by9pcGOiAgNJWFiqAgNJcGPKAgNJcGPiAg9JcGNcR1BCTWV0YWRhdGHqAgNJcGNiBnByb3RvMw");
 
 /**
  * @generated from message ipc.ErrorMessage
@@ -557,6 +557,66 @@ export enum WooglesError {
    * @generated from enum value: GAME_NO_LONGER_AVAILABLE = 1089;
    */
   GAME_NO_LONGER_AVAILABLE = 1089,
+
+  /**
+   * @generated from enum value: PUZZLE_REVIEW_NOT_FOUND = 1105;
+   */
+  PUZZLE_REVIEW_NOT_FOUND = 1105,
+
+  /**
+   * @generated from enum value: PUZZLE_SET_NOT_FOUND = 1106;
+   */
+  PUZZLE_SET_NOT_FOUND = 1106,
+
+  /**
+   * @generated from enum value: PUZZLE_SET_INVALID_PUZZLES = 1107;
+   */
+  PUZZLE_SET_INVALID_PUZZLES = 1107,
+
+  /**
+   * @generated from enum value: PUZZLE_RUSH_NOT_FOUND = 1108;
+   */
+  PUZZLE_RUSH_NOT_FOUND = 1108,
+
+  /**
+   * @generated from enum value: PUZZLE_RUSH_ENDED = 1109;
+   */
+  PUZZLE_RUSH_ENDED = 1109,
+
+  /**
+   * @generated from enum value: PUZZLE_RUSH_INVALID_DURATION = 1110;
+   */
+  PUZZLE_RUSH_INVALID_DURATION = 1110,
+
+  /**
+   * @generated from enum value: PUZZLE_RUSH_NO_PUZZLES = 1111;
+   */
+  PUZZLE_RUSH_NO_PUZZLES = 1111,
+
+  /**
+   * @generated from enum value: PUZZLE_RUSH_ALREADY_ANSWERED = 1112;
+   */
+  PUZZLE_RUSH_ALREADY_ANSWERED = 1112,
+
+  /**
+   * @generated from enum value: DAILY_PUZZLE_NOT_FOUND = 1113;
+   */
+  DAILY_PUZZLE_NOT_FOUND = 1113,
+
+  /**
+   * @generated from enum value: DAILY_PUZZLE_ALREADY_ANSWERED = 1114;
+   */
+  DAILY_PUZZLE_ALREADY_ANSWERED = 1114,
+
+  /**
+   * @generated from enum value: DAILY_PUZZLE_INVALID_DATE = 1115;
+   */
+  DAILY_PUZZLE_INVALID_DATE = 1115,
+
+  /**
+   * @generated from enum value: DAILY_PUZZLE_INVALID_PUZZLE = 1116;
+   */
+  DAILY_PUZZLE_INVALID_PUZZLE = 1116,
 }
 
 /**
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/ipc/league.proto.
  */
 export const file_proto_ipc_league: GenFile = /*@__PURE__*/
-  fileDesc("ChZwcm90by9pcGMvbGVhZ3VlLnByb3RvEgNpcGMinAEKBkxlYWd1ZRIMCgR1dWlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDAoEc2x1ZxgEIAEoCRIlCghzZXR0aW5ncxgFIAEoCzITLmlwYy5MZWFndWVTZXR0aW5ncxIZChFjdXJyZW50X3NlYXNvbl9pZBgGIAEoCRIRCglpc19hY3RpdmUYByABKAgivwEKDkxlYWd1ZVNldHRpbmdzEhoKEnNlYXNvbl9sZW5ndGhfZGF5cxgBIAEoBRImCgx0aW1lX2NvbnRyb2wYAyABKAsyEC5pcGMuVGltZUNvbnRyb2wSDwoHbGV4aWNvbhgEIAEoCRIPCgd2YXJpYW50GAUgASgJEhsKE2lkZWFsX2RpdmlzaW9uX3NpemUYBiABKAUSKgoOY2hhbGxlbmdlX3J1bGUYCSABKA4yEi5pcGMuQ2hhbGxlbmdlUnVsZSJDCgtUaW1lQ29udHJvbBIZChFpbmNyZW1lbnRfc2Vjb25kcxgBIAEoBRIZChF0aW1lX2JhbmtfbWludXRlcxgCIAEoBSLKAgoGU2Vhc29uEgwKBHV1aWQYASABKAkSEQoJbGVhZ3VlX2lkGAIgASgJEhUKDXNlYXNvbl9udW1iZXIYAyABKAUSLgoKc3RhcnRfZGF0ZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX2RhdGUYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKD2FjdHVhbF9lbmRfZGF0ZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASIQoGc3RhdHVzGAcgASgOMhEuaXBjLlNlYXNvblN0YXR1cxIgCglkaXZpc2lvbnMYCCADKAsyDS5pcGMuRGl2aXNpb24SMAoRcHJvbW90aW9uX2Zvcm11bGEYCSABKA4yFS5pcGMuUHJvbW90aW9uRm9ybXVsYSLaAQoIRGl2aXNpb24SDAoEdXVpZBgBIAEoCRIRCglzZWFzb25faWQYAiABKAkSFwoPZGl2aXNpb25fbnVtYmVyGAMgASgFEhUKDWRpdmlzaW9uX25hbWUYBCABKAkSKAoHcGxheWVycxgFIAMoCzIXLmlwYy5QbGF5ZXJSZWdpc3RyYXRpb24SEAoIZ2FtZV9pZHMYBiADKAkSLAoJc3RhbmRpbmdzGAcgAygLMhkuaXBjLkxlYWd1ZVBsYXllclN0YW5kaW5nEhMKC2lzX2NvbXBsZXRlGAggASgIIqkBChJQbGF5ZXJSZWdpc3RyYXRpb24SDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRITCgtkaXZpc2lvbl9pZBgDIAEoCRI1ChFyZWdpc3RyYXRpb25fZGF0ZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFAoMZmlyc3RzX2NvdW50GAUgASgFEg4KBnN0YXR1cxgGIAEoCSLvBAoUTGVhZ3VlUGxheWVyU3RhbmRpbmcSDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIMCgRyYW5rGAMgASgFEgwKBHdpbnMYBCABKAUSDgoGbG9zc2VzGAUgASgFEg0KBWRyYXdzGAYgASgFEg4KBnNwcmVhZBgHIAEoBRIUCgxnYW1lc19wbGF5ZWQYCCABKAUSFwoPZ2FtZXNfcmVtYWluaW5nGAkgASgFEiMKBnJlc3VsdBgKIAEoDjITLmlwYy5TdGFuZGluZ1Jlc3VsdBITCgt0b3RhbF9zY29yZRgLIAEoBRIcChR0b3RhbF9vcHBvbmVudF9zY29yZRgMIAEoBRIUCgx0b3RhbF9iaW5nb3MYDSABKAUSHQoVdG90YWxfb3Bwb25lbnRfYmluZ29zGA4gASgFEhMKC3RvdGFsX3R1cm5zGA8gASgFEhEKCWhpZ2hfdHVybhgQIAEoBRIRCgloaWdoX2dhbWUYESABKAUSEAoIdGltZW91dHMYEiABKAUSFQoNYmxhbmtzX3BsYXllZBgTIAEoBRIaChJ0b3RhbF90aWxlc19wbGF5ZWQYFCABKAUSIwobdG90YWxfb3Bwb25lbnRfdGlsZXNfcGxheWVkGBUgASgFEi4KEHBsYWNlbWVudF9zdGF0dXMYFiABKA4yFC5pcGMuUGxhY2VtZW50U3RhdHVzEhkKEWF2Z19taXN0YWtlX2luZGV4GBcgASgBEhYKDmdhbWVzX2FuYWx5emVkGBggASgFEhEKCWJlc3RfcmFuaxgZIAEoBRISCgp3b3JzdF9yYW5rGBogASgFIlUKD1RpbWVCYW5rV2FybmluZxIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEh8KF2xvd190aW1lYmFua19nYW1lX2NvdW50GAMgASgFIlIKIkdldERpdmlzaW9uVGltZUJhbmtXYXJuaW5nc1JlcXVlc3QSEwoLZGl2aXNpb25faWQYASABKAkSFwoPdGhyZXNob2xkX2hvdXJzGAIgASgFIk0KI0dldERpdmlzaW9uVGltZUJhbmtXYXJuaW5nc1Jlc3BvbnNlEiYKCHdhcm5pbmdzGAEgAygLMhQuaXBjLlRpbWVCYW5rV2FybmluZyqBAQoMU2Vhc29uU3RhdHVzEhQKEFNFQVNPTl9TQ0hFRFVMRUQQABIRCg1TRUFTT05fQUNUSVZFEAESFAoQU0VBU09OX0NPTVBMRVRFRBACEhQKEFNFQVNPTl9DQU5DRUxMRUQQAxIcChhTRUFTT05fUkVHSVNUUkFUSU9OX09QRU4QBCplChBQcm9tb3Rpb25Gb3JtdWxhEhEKDVBST01PX05fRElWXzYQABIYChRQUk9NT19OX1BMVVNfMV9ESVZfNRABEhEKDVBST01PX05fRElWXzUQAhIRCg1QUk9NT19OX0RJVl8zEAMqdAoOU3RhbmRpbmdSZXN1bHQSDwoLUkVTVUxUX05PTkUQABITCg9SRVNVTFRfUFJPTU9URUQQARIUChBSRVNVTFRfUkVMRUdBVEVEEAISEQoNUkVTVUxUX1NUQVlFRBADEhMKD1JFU1VMVF9DSEFNUElPThAEKsoBCg9QbGFjZW1lbnRTdGF0dXMSEgoOUExBQ0VNRU5UX05PTkUQABIRCg1QTEFDRU1FTlRfTkVXEAESFgoSUExBQ0VNRU5UX1BST01PVEVEEAMSFwoTUExBQ0VNRU5UX1JFTEVHQVRFRBAEEhQKEFBMQUNFTUVOVF9TVEFZRUQQBRIkCiBQTEFDRU1FTlRfU0hPUlRfSElBVFVTX1JFVFVSTklORxAGEiMKH1BMQUNFTUVOVF9MT05HX0hJQVRVU19SRVRVUk5JTkcQB0JzCgdjb20uaXBjQgtMZWFndWVQcm90b1ABWi9naXRodWIuY29tL3dvb2dsZXMtaW8vbGl3b3Jkcy9ycGMvYXBpL3Byb3RvL2lwY6ICA0lYWKoCA0lwY8oCA0lwY+ICD0lwY1xHUEJNZXRhZGF0YeoCA0lwY2IGcHJvdG8z", [file_proto_ipc_omgwords, file_google_protobuf_timestamp]);
+  fileDesc("ChZwcm90by9pcGMvbGVhZ3VlLnByb3RvEgNpcGMinAEKBkxlYWd1ZRIMCgR1dWlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDAoEc2x1ZxgEIAEoCRIlCghzZXR0aW5ncxgFIAEoCzITLmlwYy5MZWFndWVTZXR0aW5ncxIZChFjdXJyZW50X3NlYXNvbl9pZBgGIAEoCRIRCglpc19hY3RpdmUYByABKAgivQIKDkxlYWd1ZVNldHRpbmdzEhoKEnNlYXNvbl9sZW5ndGhfZGF5cxgBIAEoBRImCgx0aW1lX2NvbnRyb2wYAyABKAsyEC5pcGMuVGltZUNvbnRyb2wSDwoHbGV4aWNvbhgEIAEoCRIPCgd2YXJpYW50GAUgASgJEhsKE2lkZWFsX2RpdmlzaW9uX3NpemUYBiABKAUSKgoOY2hhbGxlbmdlX3J1bGUYCSABKA4yEi5pcGMuQ2hhbGxlbmdlUnVsZRIUCgxyb3VuZF9yb2JpbnMYCiABKAUSGQoRZ2FtZXNfcGVyX3BhaXJpbmcYCyABKAUSFAoMcGxheW9mZl9zaXplGAwgASgFEhQKDHBsYXlvZmZfZGF5cxgNIAEoBRIfCgRsaXZlGA4gASgLMhEuaXBjLkxpdmVTZXR0aW5ncyKYAQoMTGl2ZVNldHRpbmdzEhwKFGluaXRpYWxfdGltZV9taW51dGVzGAEgASgFEhkKEWluY3JlbWVudF9zZWNvbmRzGAIgASgFEhwKFG1heF9vdmVydGltZV9taW51dGVzGAMgASgFEhkKEW1hdGNoX3dpbmRvd19kYXlzGAQgASgFEhYKDnJlbWluZGVyX2hvdXJzGAUgASgFIkMKC1RpbWVDb250cm9sEhkKEWluY3JlbWVudF9zZWNvbmRzGAEgASgFEhkKEXRpbWVfYmFua19taW51dGVzGAIgASgFIsoCCgZTZWFzb24SDAoEdXVpZBgBIAEoCRIRCglsZWFndWVfaWQYAiABKAkSFQoNc2Vhc29uX251bWJlchgDIAEoBRIuCgpzdGFydF9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoPYWN0dWFsX2VuZF9kYXRlGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIhCgZzdGF0dXMYByABKA4yES5pcGMuU2Vhc29uU3RhdHVzEiAKCWRpdmlzaW9ucxgIIAMoCzINLmlwYy5EaXZpc2lvbhIwChFwcm9tb3Rpb25fZm9ybXVsYRgJIAEoDjIVLmlwYy5Qcm9tb3Rpb25Gb3JtdWxhItoBCghEaXZpc2lvbhIMCgR1dWlkGAEgASgJEhEKCXNlYXNvbl9pZBgCIAEoCRIXCg9kaXZpc2lvbl9udW1iZXIYAyABKAUSFQoNZGl2aXNpb25fbmFtZRgEIAEoCRIoCgdwbGF5ZXJzGAUgAygLMhcuaXBjLlBsYXllclJlZ2lzdHJhdGlvbhIQCghnYW1lX2lkcxgGIAMoCRIsCglzdGFuZGluZ3MYByADKAsyGS5pcGMuTGVhZ3VlUGxheWVyU3RhbmRpbmcSEwoLaXNfY29tcGxldGUYCCABKAgiqQEKElBsYXllclJlZ2lzdHJhdGlvbhIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhMKC2RpdmlzaW9uX2lkGAMgASgJEjUKEXJlZ2lzdHJhdGlvbl9kYXRlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIUCgxmaXJzdHNfY291bnQYBSABKAUSDgoGc3RhdHVzGAYgASgJIpgGChRMZWFndWVQbGF5ZXJTdGFuZGluZxIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEgwKBHJhbmsYAyABKAUSDAoEd2lucxgEIAEoBRIOCgZsb3NzZXMYBSABKAUSDQoFZHJhd3MYBiABKAUSDgoGc3ByZWFkGAcgASgFEhQKDGdhbWVzX3BsYXllZBgIIAEoBRIXCg9nYW1lc19yZW1haW5pbmcYCSABKAUSIwoGcmVzdWx0GAogASgOMhMuaXBjLlN0YW5kaW5nUmVzdWx0EhMKC3RvdGFsX3Njb3JlGAsgASgFEhwKFHRvdGFsX29wcG9uZW50X3Njb3JlGAwgASgFEhQKDHRvdGFsX2JpbmdvcxgNIAEoBRIdChV0b3RhbF9vcHBvbmVudF9iaW5nb3MYDiABKAUSEwoLdG90YWxfdHVybnMYDyABKAUSEQoJaGlnaF90dXJuGBAgASgFEhEKCWhpZ2hfZ2FtZRgRIAEoBRIQCgh0aW1lb3V0cxgSIAEoBRIVCg1ibGFua3NfcGxheWVkGBMgASgFEhoKEnRvdGFsX3RpbGVzX3BsYXllZBgUIAEoBRIjCht0b3RhbF9vcHBvbmVudF90aWxlc19wbGF5ZWQYFSABKAUSLgoQcGxhY2VtZW50X3N0YXR1cxgWIAEoDjIULmlwYy5QbGFjZW1lbnRTdGF0dXMSGQoRYXZnX21pc3Rha2VfaW5kZXgYFyABKAESFgoOZ2FtZXNfYW5hbHl6ZWQYGCABKAUSEQoJYmVzdF9yYW5rGBkgASgFEhIKCndvcnN0X3JhbmsYGiABKAUSFAoMcGxheW9mZl9zZWVkGBsgASgFEhoKEnBsYXlvZmZfZWxpbWluYXRlZBgcIAEoCBIdChVwcm9tb3Rpb25fcHJvYmFiaWxpdHkYHSABKAESGAoQc3RheV9wcm9iYWJpbGl0eRgeIAEoARIeChZyZWxlZ2F0aW9uX3Byb2JhYmlsaXR5GB8gASgBEhwKFGNoYW1waW9uX3Byb2JhYmlsaXR5GCAgASgBIlUKD1RpbWVCYW5rV2FybmluZxIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEh8KF2xvd190aW1lYmFua19nYW1lX2NvdW50GAMgASgFIlIKIkdldERpdmlzaW9uVGltZUJhbmtXYXJuaW5nc1JlcXVlc3QSEwoLZGl2aXNpb25faWQYASABKAkSFwoPdGhyZXNob2xkX2hvdXJzGAIgASgFIk0KI0dldERpdmlzaW9uVGltZUJhbmtXYXJuaW5nc1Jlc3BvbnNlEiYKCHdhcm5pbmdzGAEgAygLMhQuaXBjLlRpbWVCYW5rV2FybmluZyqBAQoMU2Vhc29uU3RhdHVzEhQKEFNFQVNPTl9TQ0hFRFVMRUQQABIRCg1TRUFTT05fQUNUSVZFEAESFAoQU0VBU09OX0NPTVBMRVRFRBACEhQKEFNFQVNPTl9DQU5DRUxMRUQQAxIcChhTRUFTT05fUkVHSVNUUkFUSU9OX09QRU4QBCplChBQcm9tb3Rpb25Gb3JtdWxhEhEKDVBST01PX05fRElWXzYQABIYChRQUk9NT19OX1BMVVNfMV9ESVZfNRABEhEKDVBST01PX05fRElWXzUQAhIRCg1QUk9NT19OX0RJVl8zEAMqdAoOU3RhbmRpbmdSZXN1bHQSDwoLUkVTVUxUX05PTkUQABITCg9SRVNVTFRfUFJPTU9URUQQARIUChBSRVNVTFRfUkVMRUdBVEVEEAISEQoNUkVTVUxUX1NUQVlFRBADEhMKD1JFU1VMVF9DSEFNUElPThAEKsoBCg9QbGFjZW1lbnRTdGF0dXMSEgoOUExBQ0VNRU5UX05PTkUQABIRCg1QTEFDRU1FTlRfTkVXEAESFgoSUExBQ0VNRU5UX1BST01PVEVEEAMSFwoTUExBQ0VNRU5UX1JFTEVHQVRFRBAEEhQKEFBMQUNFTUVOVF9TVEFZRUQQBRIkCiBQTEFDRU1FTlRfU0hPUlRfSElBVFVTX1JFVFVSTklORxAGEiMKH1BMQUNFTUVOVF9MT05HX0hJQVRVU19SRVRVUk5JTkcQBypgChhTdWJzdGl0dXRlUmVzdWx0SGFuZGxpbmcSFAoQU1VCX0tFRVBfUkVTVUxUUxAAEhQKEFNVQl9WT0lEX1JFU1VMVFMQARIYChRTVUJfVFJBTlNGRVJfUkVTVUxUUxACQnMKB2NvbS5pcGNCC0xlYWd1ZVByb3RvUAFaL2dpdGh1Yi5jb20vd29vZ2xlcy1pby9saXdvcmRzL3JwYy9hcGkvcHJvdG8vaXBjogIDSVhYqgIDSXBjygIDSXBj4gIPSXBjXEdQQk1ldGFkYXRh6gIDSXBjYgZwcm90bzM", [file_proto_ipc_omgwords, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message ipc.League
@@ -96,6 +96,46 @@ export type LeagueSettings = Message<"ipc.LeagueSettings"> & {
    * @generated from field: ipc.ChallengeRule challenge_rule = 9;
    */
   challengeRule: ChallengeRule;
+
+  /**
+   * How many times the division's round robin is played; 0 or 1 is a single
+   * round robin and 2 a double round robin. Later cycles swap who goes first.
+   *
+   * @generated from field: int32 round_robins = 10;
+   */
+  roundRobins: number;
+
+  /**
+   * How many games each pairing plays per round robin, alternating who goes
+   * first. 0 means 1.
+   *
+   * @generated from field: int32 games_per_pairing = 11;
+   */
+  gamesPerPairing: number;
+
+  /**
+   * If set, the top playoff_size players of each division (2, 4 or 8) play
+   * a single-elimination playoff that decides their final order.
+   *
+   * @generated from field: int32 playoff_size = 12;
+   */
+  playoffSize: number;
+
+  /**
+   * How many days at the end of the season are set aside for the playoff.
+   *
+   * @generated from field: int32 playoff_days = 13;
+   */
+  playoffDays: number;
+
+  /**
+   * If set, the league is played live: instead of creating every game at
+   * the start of the season as a correspondence game, players are given
+   * matchups in weekly windows and schedule a time to play each one.
+   *
+   * @generated from field: ipc.LiveSettings live = 14;
+   */
+  live?: LiveSettings | undefined;
 };
 
 /**
@@ -105,6 +145,48 @@ export type LeagueSettings = Message<"ipc.LeagueSettings"> & {
 export const LeagueSettingsSchema: GenMessage<LeagueSettings> = /*@__PURE__*/
   messageDesc(file_proto_ipc_league, 1);
 
+/**
+ * @generated from message ipc.LiveSettings
+ */
+export type LiveSettings = Message<"ipc.LiveSettings"> & {
+  /**
+   * @generated from field: int32 initial_time_minutes = 1;
+   */
+  initialTimeMinutes: number;
+
+  /**
+   * @generated from field: int32 increment_seconds = 2;
+   */
+  incrementSeconds: number;
+
+  /**
+   * @generated from field: int32 max_overtime_minutes = 3;
+   */
+  maxOvertimeMinutes: number;
+
+  /**
+   * Length of each match window; 0 means 7 days.
+   *
+   * @generated from field: int32 match_window_days = 4;
+   */
+  matchWindowDays: number;
+
+  /**
+   * How many hours before a window closes, or before an agreed time, the
+   * players are reminded; 0 means 24.
+   *
+   * @generated from field: int32 reminder_hours = 5;
+   */
+  reminderHours: number;
+};
+
+/**
+ * Describes the message ipc.LiveSettings.
+ * Use `create(LiveSettingsSchema)` to create a new message.
+ */
+export const LiveSettingsSchema: GenMessage<LiveSettings> = /*@__PURE__*/
+  messageDesc(file_proto_ipc_league, 2);
+
 /**
  * @generated from message ipc.TimeControl
  */
@@ -125,7 +207,7 @@ export type TimeControl = Message<"ipc.TimeControl"> & {
  * Use `create(TimeControlSchema)` to create a new message.
  */
 export const TimeControlSchema: GenMessage<TimeControl> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 2);
+  messageDesc(file_proto_ipc_league, 3);
 
 /**
  * @generated from message ipc.Season
@@ -184,7 +266,7 @@ export type Season = Message<"ipc.Season"> & {
  * Use `create(SeasonSchema)` to create a new message.
  */
 export const SeasonSchema: GenMessage<Season> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 3);
+  messageDesc(file_proto_ipc_league, 4);
 
 /**
  * @generated from message ipc.Division
@@ -236,7 +318,7 @@ export type Division = Message<"ipc.Division"> & {
  * Use `create(DivisionSchema)` to create a new message.
  */
 export const DivisionSchema: GenMessage<Division> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 4);
+  messageDesc(file_proto_ipc_league, 5);
 
 /**
  * @generated from message ipc.PlayerRegistration
@@ -278,7 +360,7 @@ export type PlayerRegistration = Message<"ipc.PlayerRegistration"> & {
  * Use `create(PlayerRegistrationSchema)` to create a new message.
  */
 export const PlayerRegistrationSchema: GenMessage<PlayerRegistration> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 5);
+  messageDesc(file_proto_ipc_league, 6);
 
 /**
  * @generated from message ipc.LeaguePlayerStanding
@@ -447,6 +529,45 @@ export type LeaguePlayerStanding = Message<"ipc.LeaguePlayerStanding"> & {
    * @generated from field: int32 worst_rank = 26;
    */
   worstRank: number;
+
+  /**
+   * Seed in the division playoff; 0 if not in it
+   *
+   * @generated from field: int32 playoff_seed = 27;
+   */
+  playoffSeed: number;
+
+  /**
+   * Whether the player is out of the playoff
+   *
+   * @generated from field: bool playoff_eliminated = 28;
+   */
+  playoffEliminated: boolean;
+
+  /**
+   * Forecast chances of each season outcome, simulated over the remaining
+   * games. They add up to 1 and are all 0 for a finished season.
+   *
+   * @generated from field: double promotion_probability = 29;
+   */
+  promotionProbability: number;
+
+  /**
+   * @generated from field: double stay_probability = 30;
+   */
+  stayProbability: number;
+
+  /**
+   * @generated from field: double relegation_probability = 31;
+   */
+  relegationProbability: number;
+
+  /**
+   * Only in the top division
+   *
+   * @generated from field: double champion_probability = 32;
+   */
+  championProbability: number;
 };
 
 /**
@@ -454,7 +575,7 @@ export type LeaguePlayerStanding = Message<"ipc.LeaguePlayerStanding"> & {
  * Use `create(LeaguePlayerStandingSchema)` to create a new message.
  */
 export const LeaguePlayerStandingSchema: GenMessage<LeaguePlayerStanding> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 6);
+  messageDesc(file_proto_ipc_league, 7);
 
 /**
  * @generated from message ipc.TimeBankWarning
@@ -483,7 +604,7 @@ export type TimeBankWarning = Message<"ipc.TimeBankWarning"> & {
  * Use `create(TimeBankWarningSchema)` to create a new message.
  */
 export const TimeBankWarningSchema: GenMessage<TimeBankWarning> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 7);
+  messageDesc(file_proto_ipc_league, 8);
 
 /**
  * @generated from message ipc.GetDivisionTimeBankWarningsRequest
@@ -507,7 +628,7 @@ export type GetDivisionTimeBankWarningsRequest = Message<"ipc.GetDivisionTimeBan
  * Use `create(GetDivisionTimeBankWarningsRequestSchema)` to create a new message.
  */
 export const GetDivisionTimeBankWarningsRequestSchema: GenMessage<GetDivisionTimeBankWarningsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 8);
+  messageDesc(file_proto_ipc_league, 9);
 
 /**
  * @generated from message ipc.GetDivisionTimeBankWarningsResponse
@@ -524,7 +645,7 @@ export type GetDivisionTimeBankWarningsResponse = Message<"ipc.GetDivisionTimeBa
  * Use `create(GetDivisionTimeBankWarningsResponseSchema)` to create a new message.
  */
 export const GetDivisionTimeBankWarningsResponseSchema: GenMessage<GetDivisionTimeBankWarningsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_ipc_league, 9);
+  messageDesc(file_proto_ipc_league, 10);
 
 /**
  * @generated from enum ipc.SeasonStatus
@@ -699,3 +820,38 @@ export enum PlacementStatus {
 export const PlacementStatusSchema: GenEnum<PlacementStatus> = /*@__PURE__*/
   enumDesc(file_proto_ipc_league, 3);
 
+/**
+ * SubstituteResultHandling decides how the completed games of a player who
+ * was replaced by a substitute count in the division standings
+ *
+ * @generated from enum ipc.SubstituteResultHandling
+ */
+export enum SubstituteResultHandling {
+  /**
+   * Opponents keep their results; the substitute starts afresh
+   *
+   * @generated from enum value: SUB_KEEP_RESULTS = 0;
+   */
+  SUB_KEEP_RESULTS = 0,
+
+  /**
+   * The games no longer count for anyone
+   *
+   * @generated from enum value: SUB_VOID_RESULTS = 1;
+   */
+  SUB_VOID_RESULTS = 1,
+
+  /**
+   * The substitute inherits the dropped player's record
+   *
+   * @generated from enum value: SUB_TRANSFER_RESULTS = 2;
+   */
+  SUB_TRANSFER_RESULTS = 2,
+}
+
+/**
+ * Describes the enum ipc.SubstituteResultHandling.
+ */
+export const SubstituteResultHandlingSchema: GenEnum<SubstituteResultHandling> = /*@__PURE__*/
+  enumDesc(file_proto_ipc_league, 4);
+
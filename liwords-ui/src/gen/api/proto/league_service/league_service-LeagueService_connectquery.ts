@@ -194,3 +194,59 @@ export const addSeasonTimeBank = LeagueService.method.addSeasonTimeBank;
  * @generated from rpc league_service.LeagueService.CancelPlayerResults
  */
 export const cancelPlayerResults = LeagueService.method.cancelPlayerResults;
+
+/**
+ * Live league scheduling
+ *
+ * @generated from rpc league_service.LeagueService.GetLeagueMatches
+ */
+export const getLeagueMatches = LeagueService.method.getLeagueMatches;
+
+/**
+ * @generated from rpc league_service.LeagueService.ProposeMatchTime
+ */
+export const proposeMatchTime = LeagueService.method.proposeMatchTime;
+
+/**
+ * @generated from rpc league_service.LeagueService.RespondToMatchProposal
+ */
+export const respondToMatchProposal = LeagueService.method.respondToMatchProposal;
+
+/**
+ * @generated from rpc league_service.LeagueService.StartLeagueMatch
+ */
+export const startLeagueMatch = LeagueService.method.startLeagueMatch;
+
+/**
+ * Substitutes
+ *
+ * @generated from rpc league_service.LeagueService.JoinSubstitutePool
+ */
+export const joinSubstitutePool = LeagueService.method.joinSubstitutePool;
+
+/**
+ * @generated from rpc league_service.LeagueService.LeaveSubstitutePool
+ */
+export const leaveSubstitutePool = LeagueService.method.leaveSubstitutePool;
+
+/**
+ * @generated from rpc league_service.LeagueService.GetSubstitutePool
+ */
+export const getSubstitutePool = LeagueService.method.getSubstitutePool;
+
+/**
+ * @generated from rpc league_service.LeagueService.SubstitutePlayer
+ */
+export const substitutePlayer = LeagueService.method.substitutePlayer;
+
+/**
+ * League ratings
+ *
+ * @generated from rpc league_service.LeagueService.GetLeagueRatingHistory
+ */
+export const getLeagueRatingHistory = LeagueService.method.getLeagueRatingHistory;
+
+/**
+ * @generated from rpc league_service.LeagueService.GetPlayerLeagueRatings
+ */
+export const getPlayerLeagueRatings = LeagueService.method.getPlayerLeagueRatings;
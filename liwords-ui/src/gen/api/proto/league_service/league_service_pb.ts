@@ -6,7 +6,7 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { GameEndReason } from "../ipc/omgwords_pb";
 import { file_proto_ipc_omgwords } from "../ipc/omgwords_pb";
-import type { Division, GetDivisionTimeBankWarningsRequestSchema, GetDivisionTimeBankWarningsResponseSchema, League, LeaguePlayerStanding, LeagueSettings, PromotionFormula, Season, SeasonStatus, StandingResult } from "../ipc/league_pb";
+import type { Division, GetDivisionTimeBankWarningsRequestSchema, GetDivisionTimeBankWarningsResponseSchema, League, LeaguePlayerStanding, LeagueSettings, PromotionFormula, Season, SeasonStatus, StandingResult, SubstituteResultHandling } from "../ipc/league_pb";
 import { file_proto_ipc_league } from "../ipc/league_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/league_service/league_service.proto.
  */
 export const file_proto_league_service_league_service: GenFile = /*@__PURE__*/
-  fileDesc("Cilwcm90by9sZWFndWVfc2VydmljZS9sZWFndWVfc2VydmljZS5wcm90bxIObGVhZ3VlX3NlcnZpY2UibQoTQ3JlYXRlTGVhZ3VlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEgwKBHNsdWcYAyABKAkSJQoIc2V0dGluZ3MYBCABKAsyEy5pcGMuTGVhZ3VlU2V0dGluZ3MiIgoNTGVhZ3VlUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkiKwoUR2V0QWxsTGVhZ3Vlc1JlcXVlc3QSEwoLYWN0aXZlX29ubHkYASABKAgiNQoVR2V0QWxsTGVhZ3Vlc1Jlc3BvbnNlEhwKB2xlYWd1ZXMYASADKAsyCy5pcGMuTGVhZ3VlIi0KDkxlYWd1ZVJlc3BvbnNlEhsKBmxlYWd1ZRgBIAEoCzILLmlwYy5MZWFndWUiVwobVXBkYXRlTGVhZ3VlU2V0dGluZ3NSZXF1ZXN0EhEKCWxlYWd1ZV9pZBgBIAEoCRIlCghzZXR0aW5ncxgCIAEoCzITLmlwYy5MZWFndWVTZXR0aW5ncyJTChtVcGRhdGVMZWFndWVNZXRhZGF0YVJlcXVlc3QSEQoJbGVhZ3VlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkiIgoNU2Vhc29uUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkiLQoOU2Vhc29uUmVzcG9uc2USGwoGc2Vhc29uGAEgASgLMgsuaXBjLlNlYXNvbiIzChNQYXN0U2Vhc29uc1Jlc3BvbnNlEhwKB3NlYXNvbnMYASADKAsyCy5pcGMuU2Vhc29uIjIKEkFsbFNlYXNvbnNSZXNwb25zZRIcCgdzZWFzb25zGAEgAygLMgsuaXBjLlNlYXNvbiI7ChdHZXRSZWNlbnRTZWFzb25zUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkSDQoFbGltaXQYAiABKAUiNQoVUmVjZW50U2Vhc29uc1Jlc3BvbnNlEhwKB3NlYXNvbnMYASADKAsyCy5pcGMuU2Vhc29uIqwBChZCb290c3RyYXBTZWFzb25SZXF1ZXN0EhEKCWxlYWd1ZV9pZBgBIAEoCRIuCgpzdGFydF9kYXRlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASIQoGc3RhdHVzGAQgASgOMhEuaXBjLlNlYXNvblN0YXR1cyI/ChdPcGVuUmVnaXN0cmF0aW9uUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkSEQoJc2Vhc29uX2lkGAIgASgJIiYKD0RpdmlzaW9uUmVxdWVzdBITCgtkaXZpc2lvbl9pZBgBIAEoCSI8ChlEaXZpc2lvblN0YW5kaW5nc1Jlc3BvbnNlEh8KCGRpdmlzaW9uGAEgASgLMg0uaXBjLkRpdmlzaW9uIkAKHEFsbERpdmlzaW9uU3RhbmRpbmdzUmVzcG9uc2USIAoJZGl2aXNpb25zGAEgAygLMg0uaXBjLkRpdmlzaW9uIkgKD1JlZ2lzdGVyUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglzZWFzb25faWQYAyABKAkiNgoQUmVnaXN0ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhEKCXNlYXNvbl9pZBgCIAEoCSI3ChFVbnJlZ2lzdGVyUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSIlChJVbnJlZ2lzdGVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJYChtTZWFzb25SZWdpc3RyYXRpb25zUmVzcG9uc2USOQoNcmVnaXN0cmF0aW9ucxgBIAMoCzIiLmxlYWd1ZV9zZXJ2aWNlLlNlYXNvblJlZ2lzdHJhdGlvbiJ4ChJTZWFzb25SZWdpc3RyYXRpb24SDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIRCglzZWFzb25faWQYAyABKAkSEwoLZGl2aXNpb25faWQYBCABKAkSFwoPZGl2aXNpb25fbnVtYmVyGAUgASgFIjoKFFBsYXllckhpc3RvcnlSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJbGVhZ3VlX2lkGAIgASgJIkcKFVBsYXllckhpc3RvcnlSZXNwb25zZRIuCgdzZWFzb25zGAEgAygLMh0ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uU3VtbWFyeSKUAQoNU2Vhc29uU3VtbWFyeRIRCglzZWFzb25faWQYASABKAkSFQoNc2Vhc29uX251bWJlchgCIAEoBRITCgtsZWFndWVfbmFtZRgDIAEoCRIXCg9kaXZpc2lvbl9udW1iZXIYBCABKAUSKwoIc3RhbmRpbmcYBSABKAsyGS5pcGMuTGVhZ3VlUGxheWVyU3RhbmRpbmciYwoUTGVhZ3VlUm9zdGVyUmVzcG9uc2USMwoHcGxheWVycxgBIAMoCzIiLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJvc3RlclBsYXllchIWCg5zZWFzb25fbnVtYmVycxgCIAMoBSJsChJMZWFndWVSb3N0ZXJQbGF5ZXISDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIzCgdzZWFzb25zGAMgAygLMiIubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlUm9zdGVyU2Vhc29uIrQBChJMZWFndWVSb3N0ZXJTZWFzb24SFQoNc2Vhc29uX251bWJlchgBIAEoBRIXCg9kaXZpc2lvbl9udW1iZXIYAiABKAUSDAoEcmFuaxgDIAEoBRIMCgR3aW5zGAQgASgFEg4KBmxvc3NlcxgFIAEoBRINCgVkcmF3cxgGIAEoBRIOCgZzcHJlYWQYByABKAUSIwoGcmVzdWx0GAggASgOMhMuaXBjLlN0YW5kaW5nUmVzdWx0IkUKGExlYWd1ZVN0YXRpc3RpY3NSZXNwb25zZRIpCgVzdGF0cxgBIAMoCzIaLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVN0YXQiewoKTGVhZ3VlU3RhdBIRCglzdGF0X3R5cGUYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRINCgV2YWx1ZRgEIAEoBRIPCgdnYW1lX2lkGAUgASgJEhcKD2RpdmlzaW9uX251bWJlchgGIAEoBSJBChtHZXRQbGF5ZXJTZWFzb25HYW1lc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIRCglzZWFzb25faWQYAiABKAkiTwocR2V0UGxheWVyU2Vhc29uR2FtZXNSZXNwb25zZRIvCgVnYW1lcxgBIAMoCzIgLmxlYWd1ZV9zZXJ2aWNlLlBsYXllclNlYXNvbkdhbWUilgMKEFBsYXllclNlYXNvbkdhbWUSDwoHZ2FtZV9pZBgBIAEoCRIYChBvcHBvbmVudF91c2VyX2lkGAIgASgJEhkKEW9wcG9uZW50X3VzZXJuYW1lGAMgASgJEhQKDHBsYXllcl9zY29yZRgEIAEoBRIWCg5vcHBvbmVudF9zY29yZRgFIAEoBRIOCgZyZXN1bHQYBiABKAkSLQoJZ2FtZV9kYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVyb3VuZBgIIAEoBRIrCg9nYW1lX2VuZF9yZWFzb24YCSABKA4yEi5pcGMuR2FtZUVuZFJlYXNvbhIvCgtsYXN0X3VwZGF0ZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFgoOaW5jcmVtZW50X3NlY3MYCyABKAUSHAoUb25fdHVybl90aW1lX2JhbmtfbXMYDCABKAMSGgoNbWlzdGFrZV9pbmRleBgNIAEoAUgAiAEBQhAKDl9taXN0YWtlX2luZGV4IiQKEUludml0ZVVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiNgoSSW52aXRlVXNlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJzChtNb3ZlUGxheWVyVG9EaXZpc2lvblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIRCglzZWFzb25faWQYAiABKAkSGAoQZnJvbV9kaXZpc2lvbl9pZBgDIAEoCRIWCg50b19kaXZpc2lvbl9pZBgEIAEoCSJAChxNb3ZlUGxheWVyVG9EaXZpc2lvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJaChVDcmVhdGVEaXZpc2lvblJlcXVlc3QSEQoJc2Vhc29uX2lkGAEgASgJEhcKD2RpdmlzaW9uX251bWJlchgCIAEoBRIVCg1kaXZpc2lvbl9uYW1lGAMgASgJIk8KFkNyZWF0ZURpdmlzaW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhMKC2RpdmlzaW9uX2lkGAMgASgJIj8KFURlbGV0ZURpdmlzaW9uUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSEwoLZGl2aXNpb25faWQYAiABKAkiWAoWRGVsZXRlRGl2aXNpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSHAoUZGl2aXNpb25zX3JlbnVtYmVyZWQYAyABKAUiSgobU2Vhc29uWmVyb01vdmVHYW1lc1Jlc3BvbnNlEisKBWdhbWVzGAEgAygLMhwubGVhZ3VlX3NlcnZpY2UuWmVyb01vdmVHYW1lIsABCgxaZXJvTW92ZUdhbWUSDwoHZ2FtZV9pZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpwbGF5ZXIwX2lkGAMgASgJEhgKEHBsYXllcjBfdXNlcm5hbWUYBCABKAkSEgoKcGxheWVyMV9pZBgFIAEoCRIYChBwbGF5ZXIxX3VzZXJuYW1lGAYgASgJEhMKC2RpdmlzaW9uX2lkGAcgASgJImQKJ1NlYXNvblBsYXllcnNXaXRoVW5zdGFydGVkR2FtZXNSZXNwb25zZRI5CgdwbGF5ZXJzGAEgAygLMigubGVhZ3VlX3NlcnZpY2UuUGxheWVyV2l0aFVuc3RhcnRlZEdhbWVzIlsKGFBsYXllcldpdGhVbnN0YXJ0ZWRHYW1lcxIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhwKFHVuc3RhcnRlZF9nYW1lX2NvdW50GAMgASgFIosBChhVcGRhdGVTZWFzb25EYXRlc1JlcXVlc3QSEQoJc2Vhc29uX2lkGAEgASgJEi4KCnN0YXJ0X2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJqCiNVcGRhdGVTZWFzb25Qcm9tb3Rpb25Gb3JtdWxhUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSMAoRcHJvbW90aW9uX2Zvcm11bGEYAiABKA4yFS5pcGMuUHJvbW90aW9uRm9ybXVsYSJhCiBSZWNhbGN1bGF0ZUV4dGVuZGVkU3RhdHNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhsKE2RpdmlzaW9uc19wcm9jZXNzZWQYAiABKAUSDwoHbWVzc2FnZRgDIAEoCSKIAQoYQWRkU2Vhc29uVGltZUJhbmtSZXF1ZXN0EhEKCXNlYXNvbl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhoKEmFkZGl0aW9uYWxfbWludXRlcxgDIAEoBRIsCgVzY29wZRgEIAEoDjIdLmxlYWd1ZV9zZXJ2aWNlLlRpbWVCYW5rU2NvcGUiVAoZQWRkU2Vhc29uVGltZUJhbmtSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWdhbWVzX3VwZGF0ZWQYAiABKAUSDwoHbWVzc2FnZRgDIAEoCSJAChpDYW5jZWxQbGF5ZXJSZXN1bHRzUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSJxChtDYW5jZWxQbGF5ZXJSZXN1bHRzUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIXCg9nYW1lc19mb3JmZWl0ZWQYAiABKAUSFwoPZ2FtZXNfcGVuYWxpemVkGAMgASgFEg8KB21lc3NhZ2UYBCABKAkiPwoZR2V0UGxheWVyTGVhZ3VlSDJIUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCWxlYWd1ZV9pZBgCIAEoCSJIChpHZXRQbGF5ZXJMZWFndWVIMkhSZXNwb25zZRIqCgdyZWNvcmRzGAEgAygLMhkubGVhZ3VlX3NlcnZpY2UuSDJIUmVjb3JkIrIBCglIMkhSZWNvcmQSGAoQb3Bwb25lbnRfdXNlcl9pZBgBIAEoCRIZChFvcHBvbmVudF91c2VybmFtZRgCIAEoCRIMCgR3aW5zGAMgASgFEg4KBmxvc3NlcxgEIAEoBRINCgVkcmF3cxgFIAEoBRIOCgZzcHJlYWQYBiABKAUSMwoMc2Vhc29uX2dhbWVzGAcgAygLMh0ubGVhZ3VlX3NlcnZpY2UuSDJIU2Vhc29uR2FtZSKIAQoNSDJIU2Vhc29uR2FtZRIVCg1zZWFzb25fbnVtYmVyGAEgASgFEgsKA3dvbhgCIAEoCBIMCgRkcmF3GAMgASgIEhQKDHBsYXllcl9zY29yZRgEIAEoBRIWCg5vcHBvbmVudF9zY29yZRgFIAEoBRIXCg9nYW1lX2VuZF9yZWFzb24YBiABKAUqeQoNVGltZUJhbmtTY29wZRIgChxUSU1FQkFOS19TQ09QRV9TSU5HTEVfUExBWUVSEAASJgoiVElNRUJBTktfU0NPUEVfUExBWUVSX0FORF9PUFBPTkVOVBABEh4KGlRJTUVCQU5LX1NDT1BFX0FMTF9QTEFZRVJTEAIyjxsKDUxlYWd1ZVNlcnZpY2USUwoMQ3JlYXRlTGVhZ3VlEiMubGVhZ3VlX3NlcnZpY2UuQ3JlYXRlTGVhZ3VlUmVxdWVzdBoeLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlc3BvbnNlEkoKCUdldExlYWd1ZRIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5MZWFndWVSZXNwb25zZRJcCg1HZXRBbGxMZWFndWVzEiQubGVhZ3VlX3NlcnZpY2UuR2V0QWxsTGVhZ3Vlc1JlcXVlc3QaJS5sZWFndWVfc2VydmljZS5HZXRBbGxMZWFndWVzUmVzcG9uc2USYwoUVXBkYXRlTGVhZ3VlU2V0dGluZ3MSKy5sZWFndWVfc2VydmljZS5VcGRhdGVMZWFndWVTZXR0aW5nc1JlcXVlc3QaHi5sZWFndWVfc2VydmljZS5MZWFndWVSZXNwb25zZRJjChRVcGRhdGVMZWFndWVNZXRhZGF0YRIrLmxlYWd1ZV9zZXJ2aWNlLlVwZGF0ZUxlYWd1ZU1ldGFkYXRhUmVxdWVzdBoeLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlc3BvbnNlElkKD0Jvb3RzdHJhcFNlYXNvbhImLmxlYWd1ZV9zZXJ2aWNlLkJvb3RzdHJhcFNlYXNvblJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5TZWFzb25SZXNwb25zZRJKCglHZXRTZWFzb24SHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0Gh4ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVzcG9uc2USUQoQR2V0Q3VycmVudFNlYXNvbhIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5TZWFzb25SZXNwb25zZRJUCg5HZXRQYXN0U2Vhc29ucxIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaIy5sZWFndWVfc2VydmljZS5QYXN0U2Vhc29uc1Jlc3BvbnNlElIKDUdldEFsbFNlYXNvbnMSHS5sZWFndWVfc2VydmljZS5MZWFndWVSZXF1ZXN0GiIubGVhZ3VlX3NlcnZpY2UuQWxsU2Vhc29uc1Jlc3BvbnNlEmIKEEdldFJlY2VudFNlYXNvbnMSJy5sZWFndWVfc2VydmljZS5HZXRSZWNlbnRTZWFzb25zUmVxdWVzdBolLmxlYWd1ZV9zZXJ2aWNlLlJlY2VudFNlYXNvbnNSZXNwb25zZRJbChBPcGVuUmVnaXN0cmF0aW9uEicubGVhZ3VlX3NlcnZpY2UuT3BlblJlZ2lzdHJhdGlvblJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5TZWFzb25SZXNwb25zZRJiChRHZXREaXZpc2lvblN0YW5kaW5ncxIfLmxlYWd1ZV9zZXJ2aWNlLkRpdmlzaW9uUmVxdWVzdBopLmxlYWd1ZV9zZXJ2aWNlLkRpdmlzaW9uU3RhbmRpbmdzUmVzcG9uc2USZgoXR2V0QWxsRGl2aXNpb25TdGFuZGluZ3MSHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0GiwubGVhZ3VlX3NlcnZpY2UuQWxsRGl2aXNpb25TdGFuZGluZ3NSZXNwb25zZRJwChtHZXREaXZpc2lvblRpbWVCYW5rV2FybmluZ3MSJy5pcGMuR2V0RGl2aXNpb25UaW1lQmFua1dhcm5pbmdzUmVxdWVzdBooLmlwYy5HZXREaXZpc2lvblRpbWVCYW5rV2FybmluZ3NSZXNwb25zZRJWChFSZWdpc3RlckZvclNlYXNvbhIfLmxlYWd1ZV9zZXJ2aWNlLlJlZ2lzdGVyUmVxdWVzdBogLmxlYWd1ZV9zZXJ2aWNlLlJlZ2lzdGVyUmVzcG9uc2USXQoUVW5yZWdpc3RlckZyb21TZWFzb24SIS5sZWFndWVfc2VydmljZS5VbnJlZ2lzdGVyUmVxdWVzdBoiLmxlYWd1ZV9zZXJ2aWNlLlVucmVnaXN0ZXJSZXNwb25zZRJkChZHZXRTZWFzb25SZWdpc3RyYXRpb25zEh0ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVxdWVzdBorLmxlYWd1ZV9zZXJ2aWNlLlNlYXNvblJlZ2lzdHJhdGlvbnNSZXNwb25zZRJlChZHZXRQbGF5ZXJMZWFndWVIaXN0b3J5EiQubGVhZ3VlX3NlcnZpY2UuUGxheWVySGlzdG9yeVJlcXVlc3QaJS5sZWFndWVfc2VydmljZS5QbGF5ZXJIaXN0b3J5UmVzcG9uc2UScQoUR2V0UGxheWVyU2Vhc29uR2FtZXMSKy5sZWFndWVfc2VydmljZS5HZXRQbGF5ZXJTZWFzb25HYW1lc1JlcXVlc3QaLC5sZWFndWVfc2VydmljZS5HZXRQbGF5ZXJTZWFzb25HYW1lc1Jlc3BvbnNlElwKE0ludml0ZVVzZXJUb0xlYWd1ZXMSIS5sZWFndWVfc2VydmljZS5JbnZpdGVVc2VyUmVxdWVzdBoiLmxlYWd1ZV9zZXJ2aWNlLkludml0ZVVzZXJSZXNwb25zZRJeChVSZXZva2VVc2VyRnJvbUxlYWd1ZXMSIS5sZWFndWVfc2VydmljZS5JbnZpdGVVc2VyUmVxdWVzdBoiLmxlYWd1ZV9zZXJ2aWNlLkludml0ZVVzZXJSZXNwb25zZRJWCg9HZXRMZWFndWVSb3N0ZXISHS5sZWFndWVfc2VydmljZS5MZWFndWVSZXF1ZXN0GiQubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlUm9zdGVyUmVzcG9uc2USawoSR2V0UGxheWVyTGVhZ3VlSDJIEikubGVhZ3VlX3NlcnZpY2UuR2V0UGxheWVyTGVhZ3VlSDJIUmVxdWVzdBoqLmxlYWd1ZV9zZXJ2aWNlLkdldFBsYXllckxlYWd1ZUgySFJlc3BvbnNlEl4KE0dldExlYWd1ZVN0YXRpc3RpY3MSHS5sZWFndWVfc2VydmljZS5MZWFndWVSZXF1ZXN0GigubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlU3RhdGlzdGljc1Jlc3BvbnNlEnEKFE1vdmVQbGF5ZXJUb0RpdmlzaW9uEisubGVhZ3VlX3NlcnZpY2UuTW92ZVBsYXllclRvRGl2aXNpb25SZXF1ZXN0GiwubGVhZ3VlX3NlcnZpY2UuTW92ZVBsYXllclRvRGl2aXNpb25SZXNwb25zZRJfCg5DcmVhdGVEaXZpc2lvbhIlLmxlYWd1ZV9zZXJ2aWNlLkNyZWF0ZURpdmlzaW9uUmVxdWVzdBomLmxlYWd1ZV9zZXJ2aWNlLkNyZWF0ZURpdmlzaW9uUmVzcG9uc2USXwoORGVsZXRlRGl2aXNpb24SJS5sZWFndWVfc2VydmljZS5EZWxldGVEaXZpc2lvblJlcXVlc3QaJi5sZWFndWVfc2VydmljZS5EZWxldGVEaXZpc2lvblJlc3BvbnNlEmQKFkdldFNlYXNvblplcm9Nb3ZlR2FtZXMSHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0GisubGVhZ3VlX3NlcnZpY2UuU2Vhc29uWmVyb01vdmVHYW1lc1Jlc3BvbnNlEnwKIkdldFNlYXNvblBsYXllcnNXaXRoVW5zdGFydGVkR2FtZXMSHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0GjcubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUGxheWVyc1dpdGhVbnN0YXJ0ZWRHYW1lc1Jlc3BvbnNlEl0KEVVwZGF0ZVNlYXNvbkRhdGVzEigubGVhZ3VlX3NlcnZpY2UuVXBkYXRlU2Vhc29uRGF0ZXNSZXF1ZXN0Gh4ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVzcG9uc2UScwocVXBkYXRlU2Vhc29uUHJvbW90aW9uRm9ybXVsYRIzLmxlYWd1ZV9zZXJ2aWNlLlVwZGF0ZVNlYXNvblByb21vdGlvbkZvcm11bGFSZXF1ZXN0Gh4ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVzcG9uc2UScQoeUmVjYWxjdWxhdGVTZWFzb25FeHRlbmRlZFN0YXRzEh0ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVxdWVzdBowLmxlYWd1ZV9zZXJ2aWNlLlJlY2FsY3VsYXRlRXh0ZW5kZWRTdGF0c1Jlc3BvbnNlEmgKEUFkZFNlYXNvblRpbWVCYW5rEigubGVhZ3VlX3NlcnZpY2UuQWRkU2Vhc29uVGltZUJhbmtSZXF1ZXN0GikubGVhZ3VlX3NlcnZpY2UuQWRkU2Vhc29uVGltZUJhbmtSZXNwb25zZRJuChNDYW5jZWxQbGF5ZXJSZXN1bHRzEioubGVhZ3VlX3NlcnZpY2UuQ2FuY2VsUGxheWVyUmVzdWx0c1JlcXVlc3QaKy5sZWFndWVfc2VydmljZS5DYW5jZWxQbGF5ZXJSZXN1bHRzUmVzcG9uc2VCuAEKEmNvbS5sZWFndWVfc2VydmljZUISTGVhZ3VlU2VydmljZVByb3RvUAFaOmdpdGh1Yi5jb20vd29vZ2xlcy1pby9saXdvcmRzL3JwYy9hcGkvcHJvdG8vbGVhZ3VlX3NlcnZpY2WiAgNMWFiqAg1MZWFndWVTZXJ2aWNlygINTGVhZ3VlU2VydmljZeICGUxlYWd1ZVNlcnZpY2VcR1BCTWV0YWRhdGHqAg1MZWFndWVTZXJ2aWNlYgZwcm90bzM", [file_proto_ipc_omgwords, file_proto_ipc_league, file_google_protobuf_timestamp]);
+  fileDesc("Cilwcm90by9sZWFndWVfc2VydmljZS9sZWFndWVfc2VydmljZS5wcm90bxIObGVhZ3VlX3NlcnZpY2UibQoTQ3JlYXRlTGVhZ3VlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEgwKBHNsdWcYAyABKAkSJQoIc2V0dGluZ3MYBCABKAsyEy5pcGMuTGVhZ3VlU2V0dGluZ3MiIgoNTGVhZ3VlUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkiKwoUR2V0QWxsTGVhZ3Vlc1JlcXVlc3QSEwoLYWN0aXZlX29ubHkYASABKAgiNQoVR2V0QWxsTGVhZ3Vlc1Jlc3BvbnNlEhwKB2xlYWd1ZXMYASADKAsyCy5pcGMuTGVhZ3VlIi0KDkxlYWd1ZVJlc3BvbnNlEhsKBmxlYWd1ZRgBIAEoCzILLmlwYy5MZWFndWUiVwobVXBkYXRlTGVhZ3VlU2V0dGluZ3NSZXF1ZXN0EhEKCWxlYWd1ZV9pZBgBIAEoCRIlCghzZXR0aW5ncxgCIAEoCzITLmlwYy5MZWFndWVTZXR0aW5ncyJTChtVcGRhdGVMZWFndWVNZXRhZGF0YVJlcXVlc3QSEQoJbGVhZ3VlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkiIgoNU2Vhc29uUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkiLQoOU2Vhc29uUmVzcG9uc2USGwoGc2Vhc29uGAEgASgLMgsuaXBjLlNlYXNvbiIzChNQYXN0U2Vhc29uc1Jlc3BvbnNlEhwKB3NlYXNvbnMYASADKAsyCy5pcGMuU2Vhc29uIjIKEkFsbFNlYXNvbnNSZXNwb25zZRIcCgdzZWFzb25zGAEgAygLMgsuaXBjLlNlYXNvbiI7ChdHZXRSZWNlbnRTZWFzb25zUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkSDQoFbGltaXQYAiABKAUiNQoVUmVjZW50U2Vhc29uc1Jlc3BvbnNlEhwKB3NlYXNvbnMYASADKAsyCy5pcGMuU2Vhc29uIqwBChZCb290c3RyYXBTZWFzb25SZXF1ZXN0EhEKCWxlYWd1ZV9pZBgBIAEoCRIuCgpzdGFydF9kYXRlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCghlbmRfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASIQoGc3RhdHVzGAQgASgOMhEuaXBjLlNlYXNvblN0YXR1cyI/ChdPcGVuUmVnaXN0cmF0aW9uUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkSEQoJc2Vhc29uX2lkGAIgASgJIiYKD0RpdmlzaW9uUmVxdWVzdBITCgtkaXZpc2lvbl9pZBgBIAEoCSI8ChlEaXZpc2lvblN0YW5kaW5nc1Jlc3BvbnNlEh8KCGRpdmlzaW9uGAEgASgLMg0uaXBjLkRpdmlzaW9uIkAKHEFsbERpdmlzaW9uU3RhbmRpbmdzUmVzcG9uc2USIAoJZGl2aXNpb25zGAEgAygLMg0uaXBjLkRpdmlzaW9uIkgKD1JlZ2lzdGVyUmVxdWVzdBIRCglsZWFndWVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglzZWFzb25faWQYAyABKAkiNgoQUmVnaXN0ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhEKCXNlYXNvbl9pZBgCIAEoCSI3ChFVbnJlZ2lzdGVyUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSIlChJVbnJlZ2lzdGVyUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCJYChtTZWFzb25SZWdpc3RyYXRpb25zUmVzcG9uc2USOQoNcmVnaXN0cmF0aW9ucxgBIAMoCzIiLmxlYWd1ZV9zZXJ2aWNlLlNlYXNvblJlZ2lzdHJhdGlvbiJ4ChJTZWFzb25SZWdpc3RyYXRpb24SDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIRCglzZWFzb25faWQYAyABKAkSEwoLZGl2aXNpb25faWQYBCABKAkSFwoPZGl2aXNpb25fbnVtYmVyGAUgASgFIjoKFFBsYXllckhpc3RvcnlSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkSEQoJbGVhZ3VlX2lkGAIgASgJIkcKFVBsYXllckhpc3RvcnlSZXNwb25zZRIuCgdzZWFzb25zGAEgAygLMh0ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uU3VtbWFyeSKUAQoNU2Vhc29uU3VtbWFyeRIRCglzZWFzb25faWQYASABKAkSFQoNc2Vhc29uX251bWJlchgCIAEoBRITCgtsZWFndWVfbmFtZRgDIAEoCRIXCg9kaXZpc2lvbl9udW1iZXIYBCABKAUSKwoIc3RhbmRpbmcYBSABKAsyGS5pcGMuTGVhZ3VlUGxheWVyU3RhbmRpbmciYwoUTGVhZ3VlUm9zdGVyUmVzcG9uc2USMwoHcGxheWVycxgBIAMoCzIiLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJvc3RlclBsYXllchIWCg5zZWFzb25fbnVtYmVycxgCIAMoBSJsChJMZWFndWVSb3N0ZXJQbGF5ZXISDwoHdXNlcl9pZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIzCgdzZWFzb25zGAMgAygLMiIubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlUm9zdGVyU2Vhc29uIrQBChJMZWFndWVSb3N0ZXJTZWFzb24SFQoNc2Vhc29uX251bWJlchgBIAEoBRIXCg9kaXZpc2lvbl9udW1iZXIYAiABKAUSDAoEcmFuaxgDIAEoBRIMCgR3aW5zGAQgASgFEg4KBmxvc3NlcxgFIAEoBRINCgVkcmF3cxgGIAEoBRIOCgZzcHJlYWQYByABKAUSIwoGcmVzdWx0GAggASgOMhMuaXBjLlN0YW5kaW5nUmVzdWx0IkUKGExlYWd1ZVN0YXRpc3RpY3NSZXNwb25zZRIpCgVzdGF0cxgBIAMoCzIaLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVN0YXQiewoKTGVhZ3VlU3RhdBIRCglzdGF0X3R5cGUYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRINCgV2YWx1ZRgEIAEoBRIPCgdnYW1lX2lkGAUgASgJEhcKD2RpdmlzaW9uX251bWJlchgGIAEoBSJBChtHZXRQbGF5ZXJTZWFzb25HYW1lc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIRCglzZWFzb25faWQYAiABKAkiTwocR2V0UGxheWVyU2Vhc29uR2FtZXNSZXNwb25zZRIvCgVnYW1lcxgBIAMoCzIgLmxlYWd1ZV9zZXJ2aWNlLlBsYXllclNlYXNvbkdhbWUilgMKEFBsYXllclNlYXNvbkdhbWUSDwoHZ2FtZV9pZBgBIAEoCRIYChBvcHBvbmVudF91c2VyX2lkGAIgASgJEhkKEW9wcG9uZW50X3VzZXJuYW1lGAMgASgJEhQKDHBsYXllcl9zY29yZRgEIAEoBRIWCg5vcHBvbmVudF9zY29yZRgFIAEoBRIOCgZyZXN1bHQYBiABKAkSLQoJZ2FtZV9kYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBINCgVyb3VuZBgIIAEoBRIrCg9nYW1lX2VuZF9yZWFzb24YCSABKA4yEi5pcGMuR2FtZUVuZFJlYXNvbhIvCgtsYXN0X3VwZGF0ZRgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFgoOaW5jcmVtZW50X3NlY3MYCyABKAUSHAoUb25fdHVybl90aW1lX2JhbmtfbXMYDCABKAMSGgoNbWlzdGFrZV9pbmRleBgNIAEoAUgAiAEBQhAKDl9taXN0YWtlX2luZGV4IiQKEUludml0ZVVzZXJSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkiNgoSSW52aXRlVXNlclJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJzChtNb3ZlUGxheWVyVG9EaXZpc2lvblJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCRIRCglzZWFzb25faWQYAiABKAkSGAoQZnJvbV9kaXZpc2lvbl9pZBgDIAEoCRIWCg50b19kaXZpc2lvbl9pZBgEIAEoCSJAChxNb3ZlUGxheWVyVG9EaXZpc2lvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSJaChVDcmVhdGVEaXZpc2lvblJlcXVlc3QSEQoJc2Vhc29uX2lkGAEgASgJEhcKD2RpdmlzaW9uX251bWJlchgCIAEoBRIVCg1kaXZpc2lvbl9uYW1lGAMgASgJIk8KFkNyZWF0ZURpdmlzaW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEhMKC2RpdmlzaW9uX2lkGAMgASgJIj8KFURlbGV0ZURpdmlzaW9uUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSEwoLZGl2aXNpb25faWQYAiABKAkiWAoWRGVsZXRlRGl2aXNpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkSHAoUZGl2aXNpb25zX3JlbnVtYmVyZWQYAyABKAUiSgobU2Vhc29uWmVyb01vdmVHYW1lc1Jlc3BvbnNlEisKBWdhbWVzGAEgAygLMhwubGVhZ3VlX3NlcnZpY2UuWmVyb01vdmVHYW1lIsABCgxaZXJvTW92ZUdhbWUSDwoHZ2FtZV9pZBgBIAEoCRIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpwbGF5ZXIwX2lkGAMgASgJEhgKEHBsYXllcjBfdXNlcm5hbWUYBCABKAkSEgoKcGxheWVyMV9pZBgFIAEoCRIYChBwbGF5ZXIxX3VzZXJuYW1lGAYgASgJEhMKC2RpdmlzaW9uX2lkGAcgASgJImQKJ1NlYXNvblBsYXllcnNXaXRoVW5zdGFydGVkR2FtZXNSZXNwb25zZRI5CgdwbGF5ZXJzGAEgAygLMigubGVhZ3VlX3NlcnZpY2UuUGxheWVyV2l0aFVuc3RhcnRlZEdhbWVzIlsKGFBsYXllcldpdGhVbnN0YXJ0ZWRHYW1lcxIPCgd1c2VyX2lkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhwKFHVuc3RhcnRlZF9nYW1lX2NvdW50GAMgASgFIosBChhVcGRhdGVTZWFzb25EYXRlc1JlcXVlc3QSEQoJc2Vhc29uX2lkGAEgASgJEi4KCnN0YXJ0X2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF9kYXRlGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJqCiNVcGRhdGVTZWFzb25Qcm9tb3Rpb25Gb3JtdWxhUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSMAoRcHJvbW90aW9uX2Zvcm11bGEYAiABKA4yFS5pcGMuUHJvbW90aW9uRm9ybXVsYSJhCiBSZWNhbGN1bGF0ZUV4dGVuZGVkU3RhdHNSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhsKE2RpdmlzaW9uc19wcm9jZXNzZWQYAiABKAUSDwoHbWVzc2FnZRgDIAEoCSKIAQoYQWRkU2Vhc29uVGltZUJhbmtSZXF1ZXN0EhEKCXNlYXNvbl9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhoKEmFkZGl0aW9uYWxfbWludXRlcxgDIAEoBRIsCgVzY29wZRgEIAEoDjIdLmxlYWd1ZV9zZXJ2aWNlLlRpbWVCYW5rU2NvcGUiVAoZQWRkU2Vhc29uVGltZUJhbmtSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhUKDWdhbWVzX3VwZGF0ZWQYAiABKAUSDwoHbWVzc2FnZRgDIAEoCSJAChpDYW5jZWxQbGF5ZXJSZXN1bHRzUmVxdWVzdBIRCglzZWFzb25faWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSJxChtDYW5jZWxQbGF5ZXJSZXN1bHRzUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIXCg9nYW1lc19mb3JmZWl0ZWQYAiABKAUSFwoPZ2FtZXNfcGVuYWxpemVkGAMgASgFEg8KB21lc3NhZ2UYBCABKAkiPwoZR2V0UGxheWVyTGVhZ3VlSDJIUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEhEKCWxlYWd1ZV9pZBgCIAEoCSJIChpHZXRQbGF5ZXJMZWFndWVIMkhSZXNwb25zZRIqCgdyZWNvcmRzGAEgAygLMhkubGVhZ3VlX3NlcnZpY2UuSDJIUmVjb3JkIrIBCglIMkhSZWNvcmQSGAoQb3Bwb25lbnRfdXNlcl9pZBgBIAEoCRIZChFvcHBvbmVudF91c2VybmFtZRgCIAEoCRIMCgR3aW5zGAMgASgFEg4KBmxvc3NlcxgEIAEoBRINCgVkcmF3cxgFIAEoBRIOCgZzcHJlYWQYBiABKAUSMwoMc2Vhc29uX2dhbWVzGAcgAygLMh0ubGVhZ3VlX3NlcnZpY2UuSDJIU2Vhc29uR2FtZSKIAQoNSDJIU2Vhc29uR2FtZRIVCg1zZWFzb25fbnVtYmVyGAEgASgFEgsKA3dvbhgCIAEoCBIMCgRkcmF3GAMgASgIEhQKDHBsYXllcl9zY29yZRgEIAEoBRIWCg5vcHBvbmVudF9zY29yZRgFIAEoBRIXCg9nYW1lX2VuZF9yZWFzb24YBiABKAUirgEKEU1hdGNoVGltZVByb3Bvc2FsEgoKAmlkGAEgASgDEhMKC3Byb3Bvc2VyX2lkGAIgASgJEhkKEXByb3Bvc2VyX3VzZXJuYW1lGAMgASgJEigKBHNsb3QYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKBnN0YXR1cxgFIAEoDjIjLmxlYWd1ZV9zZXJ2aWNlLk1hdGNoUHJvcG9zYWxTdGF0dXMixgMKC0xlYWd1ZU1hdGNoEgwKBHV1aWQYASABKAkSEQoJc2Vhc29uX2lkGAIgASgJEhMKC2RpdmlzaW9uX2lkGAMgASgJEhUKDXdpbmRvd19udW1iZXIYBCABKAUSMAoMd2luZG93X3N0YXJ0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp3aW5kb3dfZW5kGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgpwbGF5ZXIwX2lkGAcgASgJEhgKEHBsYXllcjBfdXNlcm5hbWUYCCABKAkSEgoKcGxheWVyMV9pZBgJIAEoCRIYChBwbGF5ZXIxX3VzZXJuYW1lGAogASgJEjEKBnN0YXR1cxgLIAEoDjIhLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZU1hdGNoU3RhdHVzEjIKDnNjaGVkdWxlZF90aW1lGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdnYW1lX2lkGA0gASgJEjQKCXByb3Bvc2FscxgOIAMoCzIhLmxlYWd1ZV9zZXJ2aWNlLk1hdGNoVGltZVByb3Bvc2FsImkKF0dldExlYWd1ZU1hdGNoZXNSZXF1ZXN0EhEKCXNlYXNvbl9pZBgBIAEoCRITCgtkaXZpc2lvbl9pZBgCIAEoCRIPCgd1c2VyX2lkGAMgASgJEhUKDXdpbmRvd19udW1iZXIYBCABKAUiRQoVTGVhZ3VlTWF0Y2hlc1Jlc3BvbnNlEiwKB21hdGNoZXMYASADKAsyGy5sZWFndWVfc2VydmljZS5MZWFndWVNYXRjaCImChJMZWFndWVNYXRjaFJlcXVlc3QSEAoIbWF0Y2hfaWQYASABKAkiQQoTTGVhZ3VlTWF0Y2hSZXNwb25zZRIqCgVtYXRjaBgBIAEoCzIbLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZU1hdGNoIlUKF1Byb3Bvc2VNYXRjaFRpbWVSZXF1ZXN0EhAKCG1hdGNoX2lkGAEgASgJEigKBHNsb3QYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkQKHVJlc3BvbmRUb01hdGNoUHJvcG9zYWxSZXF1ZXN0EhMKC3Byb3Bvc2FsX2lkGAEgASgDEg4KBmFjY2VwdBgCIAEoCCIrChhTdGFydExlYWd1ZU1hdGNoUmVzcG9uc2USDwoHZ2FtZV9pZBgBIAEoCSJkChBMZWFndWVTdWJzdGl0dXRlEg8KB3VzZXJfaWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSLQoJam9pbmVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJPChZTdWJzdGl0dXRlUG9vbFJlc3BvbnNlEjUKC3N1YnN0aXR1dGVzGAEgAygLMiAubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlU3Vic3RpdHV0ZSKSAQoXU3Vic3RpdHV0ZVBsYXllclJlcXVlc3QSEQoJc2Vhc29uX2lkGAEgASgJEhcKD2Ryb3BwZWRfdXNlcl9pZBgCIAEoCRIaChJzdWJzdGl0dXRlX3VzZXJfaWQYAyABKAkSLwoIaGFuZGxpbmcYBCABKA4yHS5pcGMuU3Vic3RpdHV0ZVJlc3VsdEhhbmRsaW5nIlQKGFN1YnN0aXR1dGVQbGF5ZXJSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEhYKDmdhbWVzX3JlcGxhY2VkGAIgASgFEg8KB21lc3NhZ2UYAyABKAkiQwodR2V0TGVhZ3VlUmF0aW5nSGlzdG9yeVJlcXVlc3QSEQoJbGVhZ3VlX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAki5gEKEUxlYWd1ZVJhdGluZ1BvaW50Eg8KB2dhbWVfaWQYASABKAkSFQoNc2Vhc29uX251bWJlchgCIAEoBRIYChBvcHBvbmVudF91c2VyX2lkGAMgASgJEhkKEW9wcG9uZW50X3VzZXJuYW1lGAQgASgJEhUKDXJhdGluZ19iZWZvcmUYBSABKAESFAoMcmF0aW5nX2FmdGVyGAYgASgBEhgKEHJhdGluZ19kZXZpYXRpb24YByABKAESLQoJcGxheWVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKRAQobTGVhZ3VlUmF0aW5nSGlzdG9yeVJlc3BvbnNlEg4KBnJhdGluZxgBIAEoARIYChByYXRpbmdfZGV2aWF0aW9uGAIgASgBEhQKDGdhbWVzX3BsYXllZBgDIAEoBRIyCgdoaXN0b3J5GAQgAygLMiEubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlUmF0aW5nUG9pbnQiMAodR2V0UGxheWVyTGVhZ3VlUmF0aW5nc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSLDAQoSUGxheWVyTGVhZ3VlUmF0aW5nEhEKCWxlYWd1ZV9pZBgBIAEoCRITCgtsZWFndWVfbmFtZRgCIAEoCRITCgtsZWFndWVfc2x1ZxgDIAEoCRIOCgZyYXRpbmcYBCABKAESGAoQcmF0aW5nX2RldmlhdGlvbhgFIAEoARIUCgxnYW1lc19wbGF5ZWQYBiABKAUSMAoMbGFzdF9nYW1lX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJSChtQbGF5ZXJMZWFndWVSYXRpbmdzUmVzcG9uc2USMwoHcmF0aW5ncxgBIAMoCzIiLmxlYWd1ZV9zZXJ2aWNlLlBsYXllckxlYWd1ZVJhdGluZyp5Cg1UaW1lQmFua1Njb3BlEiAKHFRJTUVCQU5LX1NDT1BFX1NJTkdMRV9QTEFZRVIQABImCiJUSU1FQkFOS19TQ09QRV9QTEFZRVJfQU5EX09QUE9ORU5UEAESHgoaVElNRUJBTktfU0NPUEVfQUxMX1BMQVlFUlMQAip1ChFMZWFndWVNYXRjaFN0YXR1cxIRCg1NQVRDSF9QRU5ESU5HEAASEwoPTUFUQ0hfU0NIRURVTEVEEAESEQoNTUFUQ0hfU1RBUlRFRBACEhAKDE1BVENIX1BMQVlFRBADEhMKD01BVENIX0ZPUkZFSVRFRBAEKm8KE01hdGNoUHJvcG9zYWxTdGF0dXMSEQoNUFJPUE9TQUxfT1BFThAAEhUKEVBST1BPU0FMX0FDQ0VQVEVEEAESFQoRUFJPUE9TQUxfREVDTElORUQQAhIXChNQUk9QT1NBTF9TVVBFUlNFREVEEAMyjyMKDUxlYWd1ZVNlcnZpY2USUwoMQ3JlYXRlTGVhZ3VlEiMubGVhZ3VlX3NlcnZpY2UuQ3JlYXRlTGVhZ3VlUmVxdWVzdBoeLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlc3BvbnNlEkoKCUdldExlYWd1ZRIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5MZWFndWVSZXNwb25zZRJcCg1HZXRBbGxMZWFndWVzEiQubGVhZ3VlX3NlcnZpY2UuR2V0QWxsTGVhZ3Vlc1JlcXVlc3QaJS5sZWFndWVfc2VydmljZS5HZXRBbGxMZWFndWVzUmVzcG9uc2USYwoUVXBkYXRlTGVhZ3VlU2V0dGluZ3MSKy5sZWFndWVfc2VydmljZS5VcGRhdGVMZWFndWVTZXR0aW5nc1JlcXVlc3QaHi5sZWFndWVfc2VydmljZS5MZWFndWVSZXNwb25zZRJjChRVcGRhdGVMZWFndWVNZXRhZGF0YRIrLmxlYWd1ZV9zZXJ2aWNlLlVwZGF0ZUxlYWd1ZU1ldGFkYXRhUmVxdWVzdBoeLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlc3BvbnNlElkKD0Jvb3RzdHJhcFNlYXNvbhImLmxlYWd1ZV9zZXJ2aWNlLkJvb3RzdHJhcFNlYXNvblJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5TZWFzb25SZXNwb25zZRJKCglHZXRTZWFzb24SHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0Gh4ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVzcG9uc2USUQoQR2V0Q3VycmVudFNlYXNvbhIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5TZWFzb25SZXNwb25zZRJUCg5HZXRQYXN0U2Vhc29ucxIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaIy5sZWFndWVfc2VydmljZS5QYXN0U2Vhc29uc1Jlc3BvbnNlElIKDUdldEFsbFNlYXNvbnMSHS5sZWFndWVfc2VydmljZS5MZWFndWVSZXF1ZXN0GiIubGVhZ3VlX3NlcnZpY2UuQWxsU2Vhc29uc1Jlc3BvbnNlEmIKEEdldFJlY2VudFNlYXNvbnMSJy5sZWFndWVfc2VydmljZS5HZXRSZWNlbnRTZWFzb25zUmVxdWVzdBolLmxlYWd1ZV9zZXJ2aWNlLlJlY2VudFNlYXNvbnNSZXNwb25zZRJbChBPcGVuUmVnaXN0cmF0aW9uEicubGVhZ3VlX3NlcnZpY2UuT3BlblJlZ2lzdHJhdGlvblJlcXVlc3QaHi5sZWFndWVfc2VydmljZS5TZWFzb25SZXNwb25zZRJiChRHZXREaXZpc2lvblN0YW5kaW5ncxIfLmxlYWd1ZV9zZXJ2aWNlLkRpdmlzaW9uUmVxdWVzdBopLmxlYWd1ZV9zZXJ2aWNlLkRpdmlzaW9uU3RhbmRpbmdzUmVzcG9uc2USZgoXR2V0QWxsRGl2aXNpb25TdGFuZGluZ3MSHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0GiwubGVhZ3VlX3NlcnZpY2UuQWxsRGl2aXNpb25TdGFuZGluZ3NSZXNwb25zZRJwChtHZXREaXZpc2lvblRpbWVCYW5rV2FybmluZ3MSJy5pcGMuR2V0RGl2aXNpb25UaW1lQmFua1dhcm5pbmdzUmVxdWVzdBooLmlwYy5HZXREaXZpc2lvblRpbWVCYW5rV2FybmluZ3NSZXNwb25zZRJWChFSZWdpc3RlckZvclNlYXNvbhIfLmxlYWd1ZV9zZXJ2aWNlLlJlZ2lzdGVyUmVxdWVzdBogLmxlYWd1ZV9zZXJ2aWNlLlJlZ2lzdGVyUmVzcG9uc2USXQoUVW5yZWdpc3RlckZyb21TZWFzb24SIS5sZWFndWVfc2VydmljZS5VbnJlZ2lzdGVyUmVxdWVzdBoiLmxlYWd1ZV9zZXJ2aWNlLlVucmVnaXN0ZXJSZXNwb25zZRJkChZHZXRTZWFzb25SZWdpc3RyYXRpb25zEh0ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVxdWVzdBorLmxlYWd1ZV9zZXJ2aWNlLlNlYXNvblJlZ2lzdHJhdGlvbnNSZXNwb25zZRJlChZHZXRQbGF5ZXJMZWFndWVIaXN0b3J5EiQubGVhZ3VlX3NlcnZpY2UuUGxheWVySGlzdG9yeVJlcXVlc3QaJS5sZWFndWVfc2VydmljZS5QbGF5ZXJIaXN0b3J5UmVzcG9uc2UScQoUR2V0UGxheWVyU2Vhc29uR2FtZXMSKy5sZWFndWVfc2VydmljZS5HZXRQbGF5ZXJTZWFzb25HYW1lc1JlcXVlc3QaLC5sZWFndWVfc2VydmljZS5HZXRQbGF5ZXJTZWFzb25HYW1lc1Jlc3BvbnNlElwKE0ludml0ZVVzZXJUb0xlYWd1ZXMSIS5sZWFndWVfc2VydmljZS5JbnZpdGVVc2VyUmVxdWVzdBoiLmxlYWd1ZV9zZXJ2aWNlLkludml0ZVVzZXJSZXNwb25zZRJeChVSZXZva2VVc2VyRnJvbUxlYWd1ZXMSIS5sZWFndWVfc2VydmljZS5JbnZpdGVVc2VyUmVxdWVzdBoiLmxlYWd1ZV9zZXJ2aWNlLkludml0ZVVzZXJSZXNwb25zZRJWCg9HZXRMZWFndWVSb3N0ZXISHS5sZWFndWVfc2VydmljZS5MZWFndWVSZXF1ZXN0GiQubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlUm9zdGVyUmVzcG9uc2USawoSR2V0UGxheWVyTGVhZ3VlSDJIEikubGVhZ3VlX3NlcnZpY2UuR2V0UGxheWVyTGVhZ3VlSDJIUmVxdWVzdBoqLmxlYWd1ZV9zZXJ2aWNlLkdldFBsYXllckxlYWd1ZUgySFJlc3BvbnNlEl4KE0dldExlYWd1ZVN0YXRpc3RpY3MSHS5sZWFndWVfc2VydmljZS5MZWFndWVSZXF1ZXN0GigubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlU3RhdGlzdGljc1Jlc3BvbnNlEnEKFE1vdmVQbGF5ZXJUb0RpdmlzaW9uEisubGVhZ3VlX3NlcnZpY2UuTW92ZVBsYXllclRvRGl2aXNpb25SZXF1ZXN0GiwubGVhZ3VlX3NlcnZpY2UuTW92ZVBsYXllclRvRGl2aXNpb25SZXNwb25zZRJfCg5DcmVhdGVEaXZpc2lvbhIlLmxlYWd1ZV9zZXJ2aWNlLkNyZWF0ZURpdmlzaW9uUmVxdWVzdBomLmxlYWd1ZV9zZXJ2aWNlLkNyZWF0ZURpdmlzaW9uUmVzcG9uc2USXwoORGVsZXRlRGl2aXNpb24SJS5sZWFndWVfc2VydmljZS5EZWxldGVEaXZpc2lvblJlcXVlc3QaJi5sZWFndWVfc2VydmljZS5EZWxldGVEaXZpc2lvblJlc3BvbnNlEmQKFkdldFNlYXNvblplcm9Nb3ZlR2FtZXMSHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0GisubGVhZ3VlX3NlcnZpY2UuU2Vhc29uWmVyb01vdmVHYW1lc1Jlc3BvbnNlEnwKIkdldFNlYXNvblBsYXllcnNXaXRoVW5zdGFydGVkR2FtZXMSHS5sZWFndWVfc2VydmljZS5TZWFzb25SZXF1ZXN0GjcubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUGxheWVyc1dpdGhVbnN0YXJ0ZWRHYW1lc1Jlc3BvbnNlEl0KEVVwZGF0ZVNlYXNvbkRhdGVzEigubGVhZ3VlX3NlcnZpY2UuVXBkYXRlU2Vhc29uRGF0ZXNSZXF1ZXN0Gh4ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVzcG9uc2UScwocVXBkYXRlU2Vhc29uUHJvbW90aW9uRm9ybXVsYRIzLmxlYWd1ZV9zZXJ2aWNlLlVwZGF0ZVNlYXNvblByb21vdGlvbkZvcm11bGFSZXF1ZXN0Gh4ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVzcG9uc2UScQoeUmVjYWxjdWxhdGVTZWFzb25FeHRlbmRlZFN0YXRzEh0ubGVhZ3VlX3NlcnZpY2UuU2Vhc29uUmVxdWVzdBowLmxlYWd1ZV9zZXJ2aWNlLlJlY2FsY3VsYXRlRXh0ZW5kZWRTdGF0c1Jlc3BvbnNlEmgKEUFkZFNlYXNvblRpbWVCYW5rEigubGVhZ3VlX3NlcnZpY2UuQWRkU2Vhc29uVGltZUJhbmtSZXF1ZXN0GikubGVhZ3VlX3NlcnZpY2UuQWRkU2Vhc29uVGltZUJhbmtSZXNwb25zZRJuChNDYW5jZWxQbGF5ZXJSZXN1bHRzEioubGVhZ3VlX3NlcnZpY2UuQ2FuY2VsUGxheWVyUmVzdWx0c1JlcXVlc3QaKy5sZWFndWVfc2VydmljZS5DYW5jZWxQbGF5ZXJSZXN1bHRzUmVzcG9uc2USYgoQR2V0TGVhZ3VlTWF0Y2hlcxInLmxlYWd1ZV9zZXJ2aWNlLkdldExlYWd1ZU1hdGNoZXNSZXF1ZXN0GiUubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlTWF0Y2hlc1Jlc3BvbnNlEmAKEFByb3Bvc2VNYXRjaFRpbWUSJy5sZWFndWVfc2VydmljZS5Qcm9wb3NlTWF0Y2hUaW1lUmVxdWVzdBojLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZU1hdGNoUmVzcG9uc2USbAoWUmVzcG9uZFRvTWF0Y2hQcm9wb3NhbBItLmxlYWd1ZV9zZXJ2aWNlLlJlc3BvbmRUb01hdGNoUHJvcG9zYWxSZXF1ZXN0GiMubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlTWF0Y2hSZXNwb25zZRJgChBTdGFydExlYWd1ZU1hdGNoEiIubGVhZ3VlX3NlcnZpY2UuTGVhZ3VlTWF0Y2hSZXF1ZXN0GigubGVhZ3VlX3NlcnZpY2UuU3RhcnRMZWFndWVNYXRjaFJlc3BvbnNlElsKEkpvaW5TdWJzdGl0dXRlUG9vbBIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaJi5sZWFndWVfc2VydmljZS5TdWJzdGl0dXRlUG9vbFJlc3BvbnNlElwKE0xlYXZlU3Vic3RpdHV0ZVBvb2wSHS5sZWFndWVfc2VydmljZS5MZWFndWVSZXF1ZXN0GiYubGVhZ3VlX3NlcnZpY2UuU3Vic3RpdHV0ZVBvb2xSZXNwb25zZRJaChFHZXRTdWJzdGl0dXRlUG9vbBIdLmxlYWd1ZV9zZXJ2aWNlLkxlYWd1ZVJlcXVlc3QaJi5sZWFndWVfc2VydmljZS5TdWJzdGl0dXRlUG9vbFJlc3BvbnNlEmUKEFN1YnN0aXR1dGVQbGF5ZXISJy5sZWFndWVfc2VydmljZS5TdWJzdGl0dXRlUGxheWVyUmVxdWVzdBooLmxlYWd1ZV9zZXJ2aWNlLlN1YnN0aXR1dGVQbGF5ZXJSZXNwb25zZRJ0ChZHZXRMZWFndWVSYXRpbmdIaXN0b3J5Ei0ubGVhZ3VlX3NlcnZpY2UuR2V0TGVhZ3VlUmF0aW5nSGlzdG9yeVJlcXVlc3QaKy5sZWFndWVfc2VydmljZS5MZWFndWVSYXRpbmdIaXN0b3J5UmVzcG9uc2USdAoWR2V0UGxheWVyTGVhZ3VlUmF0aW5ncxItLmxlYWd1ZV9zZXJ2aWNlLkdldFBsYXllckxlYWd1ZVJhdGluZ3NSZXF1ZXN0GisubGVhZ3VlX3NlcnZpY2UuUGxheWVyTGVhZ3VlUmF0aW5nc1Jlc3BvbnNlQrgBChJjb20ubGVhZ3VlX3NlcnZpY2VCEkxlYWd1ZVNlcnZpY2VQcm90b1ABWjpnaXRodWIuY29tL3dvb2dsZXMtaW8vbGl3b3Jkcy9ycGMvYXBpL3Byb3RvL2xlYWd1ZV9zZXJ2aWNlogIDTFhYqgINTGVhZ3VlU2VydmljZcoCDUxlYWd1ZVNlcnZpY2XiAhlMZWFndWVTZXJ2aWNlXEdQQk1ldGFkYXRh6gINTGVhZ3VlU2VydmljZWIGcHJvdG8z", [file_proto_ipc_omgwords, file_proto_ipc_league, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message league_service.CreateLeagueRequest
@@ -1586,6 +1586,584 @@ export type H2HSeasonGame = Message<"league_service.H2HSeasonGame"> & {
 export const H2HSeasonGameSchema: GenMessage<H2HSeasonGame> = /*@__PURE__*/
   messageDesc(file_proto_league_service_league_service, 57);
 
+/**
+ * @generated from message league_service.MatchTimeProposal
+ */
+export type MatchTimeProposal = Message<"league_service.MatchTimeProposal"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string proposer_id = 2;
+   */
+  proposerId: string;
+
+  /**
+   * @generated from field: string proposer_username = 3;
+   */
+  proposerUsername: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp slot = 4;
+   */
+  slot?: Timestamp | undefined;
+
+  /**
+   * @generated from field: league_service.MatchProposalStatus status = 5;
+   */
+  status: MatchProposalStatus;
+};
+
+/**
+ * Describes the message league_service.MatchTimeProposal.
+ * Use `create(MatchTimeProposalSchema)` to create a new message.
+ */
+export const MatchTimeProposalSchema: GenMessage<MatchTimeProposal> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 58);
+
+/**
+ * A matchup in a live league that has to be played within its window.
+ * player0 goes first.
+ *
+ * @generated from message league_service.LeagueMatch
+ */
+export type LeagueMatch = Message<"league_service.LeagueMatch"> & {
+  /**
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * @generated from field: string season_id = 2;
+   */
+  seasonId: string;
+
+  /**
+   * @generated from field: string division_id = 3;
+   */
+  divisionId: string;
+
+  /**
+   * @generated from field: int32 window_number = 4;
+   */
+  windowNumber: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 5;
+   */
+  windowStart?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 6;
+   */
+  windowEnd?: Timestamp | undefined;
+
+  /**
+   * @generated from field: string player0_id = 7;
+   */
+  player0Id: string;
+
+  /**
+   * @generated from field: string player0_username = 8;
+   */
+  player0Username: string;
+
+  /**
+   * @generated from field: string player1_id = 9;
+   */
+  player1Id: string;
+
+  /**
+   * @generated from field: string player1_username = 10;
+   */
+  player1Username: string;
+
+  /**
+   * @generated from field: league_service.LeagueMatchStatus status = 11;
+   */
+  status: LeagueMatchStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_time = 12;
+   */
+  scheduledTime?: Timestamp | undefined;
+
+  /**
+   * @generated from field: string game_id = 13;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: repeated league_service.MatchTimeProposal proposals = 14;
+   */
+  proposals: MatchTimeProposal[];
+};
+
+/**
+ * Describes the message league_service.LeagueMatch.
+ * Use `create(LeagueMatchSchema)` to create a new message.
+ */
+export const LeagueMatchSchema: GenMessage<LeagueMatch> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 59);
+
+/**
+ * @generated from message league_service.GetLeagueMatchesRequest
+ */
+export type GetLeagueMatchesRequest = Message<"league_service.GetLeagueMatchesRequest"> & {
+  /**
+   * @generated from field: string season_id = 1;
+   */
+  seasonId: string;
+
+  /**
+   * Optional filters
+   *
+   * @generated from field: string division_id = 2;
+   */
+  divisionId: string;
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId: string;
+
+  /**
+   * 0 means all windows; windows are numbered from 1
+   *
+   * @generated from field: int32 window_number = 4;
+   */
+  windowNumber: number;
+};
+
+/**
+ * Describes the message league_service.GetLeagueMatchesRequest.
+ * Use `create(GetLeagueMatchesRequestSchema)` to create a new message.
+ */
+export const GetLeagueMatchesRequestSchema: GenMessage<GetLeagueMatchesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 60);
+
+/**
+ * @generated from message league_service.LeagueMatchesResponse
+ */
+export type LeagueMatchesResponse = Message<"league_service.LeagueMatchesResponse"> & {
+  /**
+   * @generated from field: repeated league_service.LeagueMatch matches = 1;
+   */
+  matches: LeagueMatch[];
+};
+
+/**
+ * Describes the message league_service.LeagueMatchesResponse.
+ * Use `create(LeagueMatchesResponseSchema)` to create a new message.
+ */
+export const LeagueMatchesResponseSchema: GenMessage<LeagueMatchesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 61);
+
+/**
+ * @generated from message league_service.LeagueMatchRequest
+ */
+export type LeagueMatchRequest = Message<"league_service.LeagueMatchRequest"> & {
+  /**
+   * @generated from field: string match_id = 1;
+   */
+  matchId: string;
+};
+
+/**
+ * Describes the message league_service.LeagueMatchRequest.
+ * Use `create(LeagueMatchRequestSchema)` to create a new message.
+ */
+export const LeagueMatchRequestSchema: GenMessage<LeagueMatchRequest> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 62);
+
+/**
+ * @generated from message league_service.LeagueMatchResponse
+ */
+export type LeagueMatchResponse = Message<"league_service.LeagueMatchResponse"> & {
+  /**
+   * @generated from field: league_service.LeagueMatch match = 1;
+   */
+  match?: LeagueMatch | undefined;
+};
+
+/**
+ * Describes the message league_service.LeagueMatchResponse.
+ * Use `create(LeagueMatchResponseSchema)` to create a new message.
+ */
+export const LeagueMatchResponseSchema: GenMessage<LeagueMatchResponse> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 63);
+
+/**
+ * @generated from message league_service.ProposeMatchTimeRequest
+ */
+export type ProposeMatchTimeRequest = Message<"league_service.ProposeMatchTimeRequest"> & {
+  /**
+   * @generated from field: string match_id = 1;
+   */
+  matchId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp slot = 2;
+   */
+  slot?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message league_service.ProposeMatchTimeRequest.
+ * Use `create(ProposeMatchTimeRequestSchema)` to create a new message.
+ */
+export const ProposeMatchTimeRequestSchema: GenMessage<ProposeMatchTimeRequest> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 64);
+
+/**
+ * @generated from message league_service.RespondToMatchProposalRequest
+ */
+export type RespondToMatchProposalRequest = Message<"league_service.RespondToMatchProposalRequest"> & {
+  /**
+   * @generated from field: int64 proposal_id = 1;
+   */
+  proposalId: bigint;
+
+  /**
+   * @generated from field: bool accept = 2;
+   */
+  accept: boolean;
+};
+
+/**
+ * Describes the message league_service.RespondToMatchProposalRequest.
+ * Use `create(RespondToMatchProposalRequestSchema)` to create a new message.
+ */
+export const RespondToMatchProposalRequestSchema: GenMessage<RespondToMatchProposalRequest> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 65);
+
+/**
+ * @generated from message league_service.StartLeagueMatchResponse
+ */
+export type StartLeagueMatchResponse = Message<"league_service.StartLeagueMatchResponse"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message league_service.StartLeagueMatchResponse.
+ * Use `create(StartLeagueMatchResponseSchema)` to create a new message.
+ */
+export const StartLeagueMatchResponseSchema: GenMessage<StartLeagueMatchResponse> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 66);
+
+/**
+ * A player who is available to replace league players who drop out
+ *
+ * @generated from message league_service.LeagueSubstitute
+ */
+export type LeagueSubstitute = Message<"league_service.LeagueSubstitute"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp joined_at = 3;
+   */
+  joinedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message league_service.LeagueSubstitute.
+ * Use `create(LeagueSubstituteSchema)` to create a new message.
+ */
+export const LeagueSubstituteSchema: GenMessage<LeagueSubstitute> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 67);
+
+/**
+ * @generated from message league_service.SubstitutePoolResponse
+ */
+export type SubstitutePoolResponse = Message<"league_service.SubstitutePoolResponse"> & {
+  /**
+   * @generated from field: repeated league_service.LeagueSubstitute substitutes = 1;
+   */
+  substitutes: LeagueSubstitute[];
+};
+
+/**
+ * Describes the message league_service.SubstitutePoolResponse.
+ * Use `create(SubstitutePoolResponseSchema)` to create a new message.
+ */
+export const SubstitutePoolResponseSchema: GenMessage<SubstitutePoolResponse> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 68);
+
+/**
+ * @generated from message league_service.SubstitutePlayerRequest
+ */
+export type SubstitutePlayerRequest = Message<"league_service.SubstitutePlayerRequest"> & {
+  /**
+   * @generated from field: string season_id = 1;
+   */
+  seasonId: string;
+
+  /**
+   * UUID of the player who dropped out
+   *
+   * @generated from field: string dropped_user_id = 2;
+   */
+  droppedUserId: string;
+
+  /**
+   * UUID of a player in the substitute pool
+   *
+   * @generated from field: string substitute_user_id = 3;
+   */
+  substituteUserId: string;
+
+  /**
+   * @generated from field: ipc.SubstituteResultHandling handling = 4;
+   */
+  handling: SubstituteResultHandling;
+};
+
+/**
+ * Describes the message league_service.SubstitutePlayerRequest.
+ * Use `create(SubstitutePlayerRequestSchema)` to create a new message.
+ */
+export const SubstitutePlayerRequestSchema: GenMessage<SubstitutePlayerRequest> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 69);
+
+/**
+ * @generated from message league_service.SubstitutePlayerResponse
+ */
+export type SubstitutePlayerResponse = Message<"league_service.SubstitutePlayerResponse"> & {
+  /**
+   * @generated from field: bool success = 1;
+   */
+  success: boolean;
+
+  /**
+   * Unplayed games handed to the substitute
+   *
+   * @generated from field: int32 games_replaced = 2;
+   */
+  gamesReplaced: number;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message league_service.SubstitutePlayerResponse.
+ * Use `create(SubstitutePlayerResponseSchema)` to create a new message.
+ */
+export const SubstitutePlayerResponseSchema: GenMessage<SubstitutePlayerResponse> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 70);
+
+/**
+ * @generated from message league_service.GetLeagueRatingHistoryRequest
+ */
+export type GetLeagueRatingHistoryRequest = Message<"league_service.GetLeagueRatingHistoryRequest"> & {
+  /**
+   * UUID or slug
+   *
+   * @generated from field: string league_id = 1;
+   */
+  leagueId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message league_service.GetLeagueRatingHistoryRequest.
+ * Use `create(GetLeagueRatingHistoryRequestSchema)` to create a new message.
+ */
+export const GetLeagueRatingHistoryRequestSchema: GenMessage<GetLeagueRatingHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 71);
+
+/**
+ * A player's league rating after one of their league games
+ *
+ * @generated from message league_service.LeagueRatingPoint
+ */
+export type LeagueRatingPoint = Message<"league_service.LeagueRatingPoint"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * 0 if the season has been deleted
+   *
+   * @generated from field: int32 season_number = 2;
+   */
+  seasonNumber: number;
+
+  /**
+   * @generated from field: string opponent_user_id = 3;
+   */
+  opponentUserId: string;
+
+  /**
+   * @generated from field: string opponent_username = 4;
+   */
+  opponentUsername: string;
+
+  /**
+   * @generated from field: double rating_before = 5;
+   */
+  ratingBefore: number;
+
+  /**
+   * @generated from field: double rating_after = 6;
+   */
+  ratingAfter: number;
+
+  /**
+   * @generated from field: double rating_deviation = 7;
+   */
+  ratingDeviation: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp played_at = 8;
+   */
+  playedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message league_service.LeagueRatingPoint.
+ * Use `create(LeagueRatingPointSchema)` to create a new message.
+ */
+export const LeagueRatingPointSchema: GenMessage<LeagueRatingPoint> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 72);
+
+/**
+ * @generated from message league_service.LeagueRatingHistoryResponse
+ */
+export type LeagueRatingHistoryResponse = Message<"league_service.LeagueRatingHistoryResponse"> & {
+  /**
+   * @generated from field: double rating = 1;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: double rating_deviation = 2;
+   */
+  ratingDeviation: number;
+
+  /**
+   * @generated from field: int32 games_played = 3;
+   */
+  gamesPlayed: number;
+
+  /**
+   * Oldest first
+   *
+   * @generated from field: repeated league_service.LeagueRatingPoint history = 4;
+   */
+  history: LeagueRatingPoint[];
+};
+
+/**
+ * Describes the message league_service.LeagueRatingHistoryResponse.
+ * Use `create(LeagueRatingHistoryResponseSchema)` to create a new message.
+ */
+export const LeagueRatingHistoryResponseSchema: GenMessage<LeagueRatingHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 73);
+
+/**
+ * @generated from message league_service.GetPlayerLeagueRatingsRequest
+ */
+export type GetPlayerLeagueRatingsRequest = Message<"league_service.GetPlayerLeagueRatingsRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message league_service.GetPlayerLeagueRatingsRequest.
+ * Use `create(GetPlayerLeagueRatingsRequestSchema)` to create a new message.
+ */
+export const GetPlayerLeagueRatingsRequestSchema: GenMessage<GetPlayerLeagueRatingsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 74);
+
+/**
+ * A player's current rating in one league
+ *
+ * @generated from message league_service.PlayerLeagueRating
+ */
+export type PlayerLeagueRating = Message<"league_service.PlayerLeagueRating"> & {
+  /**
+   * @generated from field: string league_id = 1;
+   */
+  leagueId: string;
+
+  /**
+   * @generated from field: string league_name = 2;
+   */
+  leagueName: string;
+
+  /**
+   * @generated from field: string league_slug = 3;
+   */
+  leagueSlug: string;
+
+  /**
+   * @generated from field: double rating = 4;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: double rating_deviation = 5;
+   */
+  ratingDeviation: number;
+
+  /**
+   * @generated from field: int32 games_played = 6;
+   */
+  gamesPlayed: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_game_at = 7;
+   */
+  lastGameAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message league_service.PlayerLeagueRating.
+ * Use `create(PlayerLeagueRatingSchema)` to create a new message.
+ */
+export const PlayerLeagueRatingSchema: GenMessage<PlayerLeagueRating> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 75);
+
+/**
+ * @generated from message league_service.PlayerLeagueRatingsResponse
+ */
+export type PlayerLeagueRatingsResponse = Message<"league_service.PlayerLeagueRatingsResponse"> & {
+  /**
+   * @generated from field: repeated league_service.PlayerLeagueRating ratings = 1;
+   */
+  ratings: PlayerLeagueRating[];
+};
+
+/**
+ * Describes the message league_service.PlayerLeagueRatingsResponse.
+ * Use `create(PlayerLeagueRatingsResponseSchema)` to create a new message.
+ */
+export const PlayerLeagueRatingsResponseSchema: GenMessage<PlayerLeagueRatingsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_league_service_league_service, 76);
+
 /**
  * Scope for time bank additions
  *
@@ -1620,6 +2198,85 @@ export enum TimeBankScope {
 export const TimeBankScopeSchema: GenEnum<TimeBankScope> = /*@__PURE__*/
   enumDesc(file_proto_league_service_league_service, 0);
 
+/**
+ * @generated from enum league_service.LeagueMatchStatus
+ */
+export enum LeagueMatchStatus {
+  /**
+   * No time agreed yet
+   *
+   * @generated from enum value: MATCH_PENDING = 0;
+   */
+  MATCH_PENDING = 0,
+
+  /**
+   * A time slot was agreed
+   *
+   * @generated from enum value: MATCH_SCHEDULED = 1;
+   */
+  MATCH_SCHEDULED = 1,
+
+  /**
+   * The game was created
+   *
+   * @generated from enum value: MATCH_STARTED = 2;
+   */
+  MATCH_STARTED = 2,
+
+  /**
+   * The game is over
+   *
+   * @generated from enum value: MATCH_PLAYED = 3;
+   */
+  MATCH_PLAYED = 3,
+
+  /**
+   * The window closed before the game was played
+   *
+   * @generated from enum value: MATCH_FORFEITED = 4;
+   */
+  MATCH_FORFEITED = 4,
+}
+
+/**
+ * Describes the enum league_service.LeagueMatchStatus.
+ */
+export const LeagueMatchStatusSchema: GenEnum<LeagueMatchStatus> = /*@__PURE__*/
+  enumDesc(file_proto_league_service_league_service, 1);
+
+/**
+ * @generated from enum league_service.MatchProposalStatus
+ */
+export enum MatchProposalStatus {
+  /**
+   * @generated from enum value: PROPOSAL_OPEN = 0;
+   */
+  PROPOSAL_OPEN = 0,
+
+  /**
+   * @generated from enum value: PROPOSAL_ACCEPTED = 1;
+   */
+  PROPOSAL_ACCEPTED = 1,
+
+  /**
+   * @generated from enum value: PROPOSAL_DECLINED = 2;
+   */
+  PROPOSAL_DECLINED = 2,
+
+  /**
+   * Another proposal for the match was accepted
+   *
+   * @generated from enum value: PROPOSAL_SUPERSEDED = 3;
+   */
+  PROPOSAL_SUPERSEDED = 3,
+}
+
+/**
+ * Describes the enum league_service.MatchProposalStatus.
+ */
+export const MatchProposalStatusSchema: GenEnum<MatchProposalStatus> = /*@__PURE__*/
+  enumDesc(file_proto_league_service_league_service, 2);
+
 /**
  * @generated from service league_service.LeagueService
  */
@@ -1920,6 +2577,92 @@ export const LeagueService: GenService<{
     input: typeof CancelPlayerResultsRequestSchema;
     output: typeof CancelPlayerResultsResponseSchema;
   },
+  /**
+   * Live league scheduling
+   *
+   * @generated from rpc league_service.LeagueService.GetLeagueMatches
+   */
+  getLeagueMatches: {
+    methodKind: "unary";
+    input: typeof GetLeagueMatchesRequestSchema;
+    output: typeof LeagueMatchesResponseSchema;
+  },
+  /**
+   * @generated from rpc league_service.LeagueService.ProposeMatchTime
+   */
+  proposeMatchTime: {
+    methodKind: "unary";
+    input: typeof ProposeMatchTimeRequestSchema;
+    output: typeof LeagueMatchResponseSchema;
+  },
+  /**
+   * @generated from rpc league_service.LeagueService.RespondToMatchProposal
+   */
+  respondToMatchProposal: {
+    methodKind: "unary";
+    input: typeof RespondToMatchProposalRequestSchema;
+    output: typeof LeagueMatchResponseSchema;
+  },
+  /**
+   * @generated from rpc league_service.LeagueService.StartLeagueMatch
+   */
+  startLeagueMatch: {
+    methodKind: "unary";
+    input: typeof LeagueMatchRequestSchema;
+    output: typeof StartLeagueMatchResponseSchema;
+  },
+  /**
+   * Substitutes
+   *
+   * @generated from rpc league_service.LeagueService.JoinSubstitutePool
+   */
+  joinSubstitutePool: {
+    methodKind: "unary";
+    input: typeof LeagueRequestSchema;
+    output: typeof SubstitutePoolResponseSchema;
+  },
+  /**
+   * @generated from rpc league_service.LeagueService.LeaveSubstitutePool
+   */
+  leaveSubstitutePool: {
+    methodKind: "unary";
+    input: typeof LeagueRequestSchema;
+    output: typeof SubstitutePoolResponseSchema;
+  },
+  /**
+   * @generated from rpc league_service.LeagueService.GetSubstitutePool
+   */
+  getSubstitutePool: {
+    methodKind: "unary";
+    input: typeof LeagueRequestSchema;
+    output: typeof SubstitutePoolResponseSchema;
+  },
+  /**
+   * @generated from rpc league_service.LeagueService.SubstitutePlayer
+   */
+  substitutePlayer: {
+    methodKind: "unary";
+    input: typeof SubstitutePlayerRequestSchema;
+    output: typeof SubstitutePlayerResponseSchema;
+  },
+  /**
+   * League ratings
+   *
+   * @generated from rpc league_service.LeagueService.GetLeagueRatingHistory
+   */
+  getLeagueRatingHistory: {
+    methodKind: "unary";
+    input: typeof GetLeagueRatingHistoryRequestSchema;
+    output: typeof LeagueRatingHistoryResponseSchema;
+  },
+  /**
+   * @generated from rpc league_service.LeagueService.GetPlayerLeagueRatings
+   */
+  getPlayerLeagueRatings: {
+    methodKind: "unary";
+    input: typeof GetPlayerLeagueRatingsRequestSchema;
+    output: typeof PlayerLeagueRatingsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_league_service_league_service, 0);
 
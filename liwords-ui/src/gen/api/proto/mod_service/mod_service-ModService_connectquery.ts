@@ -33,3 +33,73 @@ export const getNotorietyReport = ModService.method.getNotorietyReport;
  * @generated from rpc mod_service.ModService.ResetNotoriety
  */
 export const resetNotoriety = ModService.method.resetNotoriety;
+
+/**
+ * @generated from rpc mod_service.ModService.FileReport
+ */
+export const fileReport = ModService.method.fileReport;
+
+/**
+ * @generated from rpc mod_service.ModService.ListCases
+ */
+export const listCases = ModService.method.listCases;
+
+/**
+ * @generated from rpc mod_service.ModService.GetCase
+ */
+export const getCase = ModService.method.getCase;
+
+/**
+ * @generated from rpc mod_service.ModService.AssignCase
+ */
+export const assignCase = ModService.method.assignCase;
+
+/**
+ * @generated from rpc mod_service.ModService.AddCaseEvidence
+ */
+export const addCaseEvidence = ModService.method.addCaseEvidence;
+
+/**
+ * @generated from rpc mod_service.ModService.ResolveCase
+ */
+export const resolveCase = ModService.method.resolveCase;
+
+/**
+ * @generated from rpc mod_service.ModService.SubmitAppeal
+ */
+export const submitAppeal = ModService.method.submitAppeal;
+
+/**
+ * @generated from rpc mod_service.ModService.DecideAppeal
+ */
+export const decideAppeal = ModService.method.decideAppeal;
+
+/**
+ * @generated from rpc mod_service.ModService.GetCheatSignalReport
+ */
+export const getCheatSignalReport = ModService.method.getCheatSignalReport;
+
+/**
+ * @generated from rpc mod_service.ModService.GetChatPolicies
+ */
+export const getChatPolicies = ModService.method.getChatPolicies;
+
+/**
+ * @generated from rpc mod_service.ModService.SetChatPolicy
+ */
+export const setChatPolicy = ModService.method.setChatPolicy;
+
+/**
+ * @generated from rpc mod_service.ModService.GetChatBlocklist
+ */
+export const getChatBlocklist = ModService.method.getChatBlocklist;
+
+/**
+ * @generated from rpc mod_service.ModService.AddChatBlocklistEntry
+ */
+export const addChatBlocklistEntry = ModService.method.addChatBlocklistEntry;
+
+/**
+ * @generated from rpc mod_service.ModService.RemoveChatBlocklistEntry
+ */
+export const removeChatBlocklistEntry = ModService.method.removeChatBlocklistEntry;
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/mod_service/mod_service.proto.
  */
 export const file_proto_mod_service_mod_service: GenFile = /*@__PURE__*/
-  fileDesc("CiNwcm90by9tb2Rfc2VydmljZS9tb2Rfc2VydmljZS5wcm90bxILbW9kX3NlcnZpY2UijAMKCU1vZEFjdGlvbhIPCgd1c2VyX2lkGAEgASgJEigKBHR5cGUYAiABKA4yGi5tb2Rfc2VydmljZS5Nb2RBY3Rpb25UeXBlEhAKCGR1cmF0aW9uGAMgASgFEi4KCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxyZW1vdmVkX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB2NoYW5uZWwYByABKAkSEgoKbWVzc2FnZV9pZBgIIAEoCRIXCg9hcHBsaWVyX3VzZXJfaWQYCSABKAkSFwoPcmVtb3Zlcl91c2VyX2lkGAogASgJEhEKCWNoYXRfdGV4dBgLIAEoCRIMCgRub3RlGAwgASgJEioKCmVtYWlsX3R5cGUYDSABKA4yFi5tb2Rfc2VydmljZS5FbWFpbFR5cGUikQEKDU1vZEFjdGlvbnNNYXASOAoHYWN0aW9ucxgBIAMoCzInLm1vZF9zZXJ2aWNlLk1vZEFjdGlvbnNNYXAuQWN0aW9uc0VudHJ5GkYKDEFjdGlvbnNFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5tb2Rfc2VydmljZS5Nb2RBY3Rpb246AjgBIjkKDk1vZEFjdGlvbnNMaXN0EicKB2FjdGlvbnMYASADKAsyFi5tb2Rfc2VydmljZS5Nb2RBY3Rpb24iJAoRR2V0QWN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSITChFNb2RBY3Rpb25SZXNwb25zZSJ5Cg1Ob3RvcmlvdXNHYW1lEgoKAmlkGAEgASgJEiwKBHR5cGUYAiABKA4yHi5tb2Rfc2VydmljZS5Ob3RvcmlvdXNHYW1lVHlwZRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIoChVSZXNldE5vdG9yaWV0eVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIYChZSZXNldE5vdG9yaWV0eVJlc3BvbnNlIiwKGUdldE5vdG9yaWV0eVJlcG9ydFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJLCg9Ob3RvcmlldHlSZXBvcnQSDQoFc2NvcmUYASABKAUSKQoFZ2FtZXMYAiADKAsyGi5tb2Rfc2VydmljZS5Ob3RvcmlvdXNHYW1lKsABCg1Nb2RBY3Rpb25UeXBlEggKBE1VVEUQABITCg9TVVNQRU5EX0FDQ09VTlQQARIXChNTVVNQRU5EX1JBVEVEX0dBTUVTEAISEQoNU1VTUEVORF9HQU1FUxADEhEKDVJFU0VUX1JBVElOR1MQBBIPCgtSRVNFVF9TVEFUUxAFEhsKF1JFU0VUX1NUQVRTX0FORF9SQVRJTkdTEAYSDwoLUkVNT1ZFX0NIQVQQBxISCg5ERUxFVEVfQUNDT1VOVBAIKjQKCUVtYWlsVHlwZRILCgdERUZBVUxUEAASDAoIQ0hFQVRJTkcQARIMCghERUxFVElPThACKnUKEU5vdG9yaW91c0dhbWVUeXBlEggKBEdPT0QQABILCgdOT19QTEFZEAESCwoHU0lUVElORxACEgsKB1NBTkRCQUcQAxIYChROT19QTEFZX0RFTklFRF9OVURHRRAEEhUKEUVYQ0VTU0lWRV9QSE9OSUVTEAUy+QMKCk1vZFNlcnZpY2USSwoMQXBwbHlBY3Rpb25zEhsubW9kX3NlcnZpY2UuTW9kQWN0aW9uc0xpc3QaHi5tb2Rfc2VydmljZS5Nb2RBY3Rpb25SZXNwb25zZRJMCg1SZW1vdmVBY3Rpb25zEhsubW9kX3NlcnZpY2UuTW9kQWN0aW9uc0xpc3QaHi5tb2Rfc2VydmljZS5Nb2RBY3Rpb25SZXNwb25zZRJICgpHZXRBY3Rpb25zEh4ubW9kX3NlcnZpY2UuR2V0QWN0aW9uc1JlcXVlc3QaGi5tb2Rfc2VydmljZS5Nb2RBY3Rpb25zTWFwEk8KEEdldEFjdGlvbkhpc3RvcnkSHi5tb2Rfc2VydmljZS5HZXRBY3Rpb25zUmVxdWVzdBobLm1vZF9zZXJ2aWNlLk1vZEFjdGlvbnNMaXN0EloKEkdldE5vdG9yaWV0eVJlcG9ydBImLm1vZF9zZXJ2aWNlLkdldE5vdG9yaWV0eVJlcG9ydFJlcXVlc3QaHC5tb2Rfc2VydmljZS5Ob3RvcmlldHlSZXBvcnQSWQoOUmVzZXROb3RvcmlldHkSIi5tb2Rfc2VydmljZS5SZXNldE5vdG9yaWV0eVJlcXVlc3QaIy5tb2Rfc2VydmljZS5SZXNldE5vdG9yaWV0eVJlc3BvbnNlQqMBCg9jb20ubW9kX3NlcnZpY2VCD01vZFNlcnZpY2VQcm90b1ABWjdnaXRodWIuY29tL3dvb2dsZXMtaW8vbGl3b3Jkcy9ycGMvYXBpL3Byb3RvL21vZF9zZXJ2aWNlogIDTVhYqgIKTW9kU2VydmljZcoCCk1vZFNlcnZpY2XiAhZNb2RTZXJ2aWNlXEdQQk1ldGFkYXRh6gIKTW9kU2VydmljZWIGcHJvdG8z", [file_google_protobuf_timestamp]);
+  fileDesc("CiNwcm90by9tb2Rfc2VydmljZS9tb2Rfc2VydmljZS5wcm90bxILbW9kX3NlcnZpY2UijAMKCU1vZEFjdGlvbhIPCgd1c2VyX2lkGAEgASgJEigKBHR5cGUYAiABKA4yGi5tb2Rfc2VydmljZS5Nb2RBY3Rpb25UeXBlEhAKCGR1cmF0aW9uGAMgASgFEi4KCnN0YXJ0X3RpbWUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiwKCGVuZF90aW1lGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxyZW1vdmVkX3RpbWUYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB2NoYW5uZWwYByABKAkSEgoKbWVzc2FnZV9pZBgIIAEoCRIXCg9hcHBsaWVyX3VzZXJfaWQYCSABKAkSFwoPcmVtb3Zlcl91c2VyX2lkGAogASgJEhEKCWNoYXRfdGV4dBgLIAEoCRIMCgRub3RlGAwgASgJEioKCmVtYWlsX3R5cGUYDSABKA4yFi5tb2Rfc2VydmljZS5FbWFpbFR5cGUikQEKDU1vZEFjdGlvbnNNYXASOAoHYWN0aW9ucxgBIAMoCzInLm1vZF9zZXJ2aWNlLk1vZEFjdGlvbnNNYXAuQWN0aW9uc0VudHJ5GkYKDEFjdGlvbnNFbnRyeRILCgNrZXkYASABKAkSJQoFdmFsdWUYAiABKAsyFi5tb2Rfc2VydmljZS5Nb2RBY3Rpb246AjgBIjkKDk1vZEFjdGlvbnNMaXN0EicKB2FjdGlvbnMYASADKAsyFi5tb2Rfc2VydmljZS5Nb2RBY3Rpb24iJAoRR2V0QWN0aW9uc1JlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSITChFNb2RBY3Rpb25SZXNwb25zZSJ5Cg1Ob3RvcmlvdXNHYW1lEgoKAmlkGAEgASgJEiwKBHR5cGUYAiABKA4yHi5tb2Rfc2VydmljZS5Ob3RvcmlvdXNHYW1lVHlwZRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIoChVSZXNldE5vdG9yaWV0eVJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSIYChZSZXNldE5vdG9yaWV0eVJlc3BvbnNlIiwKGUdldE5vdG9yaWV0eVJlcG9ydFJlcXVlc3QSDwoHdXNlcl9pZBgBIAEoCSJLCg9Ob3RvcmlldHlSZXBvcnQSDQoFc2NvcmUYASABKAUSKQoFZ2FtZXMYAiADKAsyGi5tb2Rfc2VydmljZS5Ob3RvcmlvdXNHYW1lIs4BChFGaWxlUmVwb3J0UmVxdWVzdBIyCgt0YXJnZXRfdHlwZRgBIAEoDjIdLm1vZF9zZXJ2aWNlLlJlcG9ydFRhcmdldFR5cGUSDwoHdXNlcl9pZBgCIAEoCRIPCgdnYW1lX2lkGAMgASgJEg8KB2NoYW5uZWwYBCABKAkSEgoKbWVzc2FnZV9pZBgFIAEoCRIpCgZyZWFzb24YBiABKA4yGS5tb2Rfc2VydmljZS5SZXBvcnRSZWFzb24SEwoLZGVzY3JpcHRpb24YByABKAkiFAoSRmlsZVJlcG9ydFJlc3BvbnNlIuEBCgZSZXBvcnQSGAoQcmVwb3J0ZXJfdXNlcl9pZBgBIAEoCRIZChFyZXBvcnRlcl91c2VybmFtZRgCIAEoCRIyCgt0YXJnZXRfdHlwZRgDIAEoDjIdLm1vZF9zZXJ2aWNlLlJlcG9ydFRhcmdldFR5cGUSKQoGcmVhc29uGAQgASgOMhkubW9kX3NlcnZpY2UuUmVwb3J0UmVhc29uEhMKC2Rlc2NyaXB0aW9uGAUgASgJEi4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wItQBCghFdmlkZW5jZRInCgR0eXBlGAEgASgOMhkubW9kX3NlcnZpY2UuRXZpZGVuY2VUeXBlEg8KB2dhbWVfaWQYAiABKAkSDwoHY2hhbm5lbBgDIAEoCRISCgptZXNzYWdlX2lkGAQgASgJEhAKCHNuYXBzaG90GAUgASgJEgwKBG5vdGUYBiABKAkSGQoRYWRkZWRfYnlfdXNlcm5hbWUYByABKAkSLgoKY3JlYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi3QEKBkFwcGVhbBIKCgJpZBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEikKBnN0YXR1cxgDIAEoDjIZLm1vZF9zZXJ2aWNlLkFwcGVhbFN0YXR1cxIQCghyZXNwb25zZRgEIAEoCRIZChFyZXZpZXdlcl91c2VybmFtZRgFIAEoCRIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpkZWNpZGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKOBAoHTW9kQ2FzZRIKCgJpZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhAKCHVzZXJuYW1lGAMgASgJEicKBnN0YXR1cxgEIAEoDjIXLm1vZF9zZXJ2aWNlLkNhc2VTdGF0dXMSGAoQYXNzaWduZWVfdXNlcl9pZBgFIAEoCRIZChFhc3NpZ25lZV91c2VybmFtZRgGIAEoCRITCgtudW1fcmVwb3J0cxgHIAEoBRIXCg9yZXNvbHV0aW9uX25vdGUYCCABKAkSGQoRcmVzb2x2ZXJfdXNlcm5hbWUYCSABKAkSLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLcmVzb2x2ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiQKB3JlcG9ydHMYDSADKAsyEy5tb2Rfc2VydmljZS5SZXBvcnQSJwoIZXZpZGVuY2UYDiADKAsyFS5tb2Rfc2VydmljZS5FdmlkZW5jZRInCgdhY3Rpb25zGA8gAygLMhYubW9kX3NlcnZpY2UuTW9kQWN0aW9uEiQKB2FwcGVhbHMYECADKAsyEy5tb2Rfc2VydmljZS5BcHBlYWwidgoQTGlzdENhc2VzUmVxdWVzdBIpCghzdGF0dXNlcxgBIAMoDjIXLm1vZF9zZXJ2aWNlLkNhc2VTdGF0dXMSGAoQYXNzaWduZWVfdXNlcl9pZBgCIAEoCRINCgVsaW1pdBgDIAEoBRIOCgZvZmZzZXQYBCABKAUiOAoRTGlzdENhc2VzUmVzcG9uc2USIwoFY2FzZXMYASADKAsyFC5tb2Rfc2VydmljZS5Nb2RDYXNlIiEKDkdldENhc2VSZXF1ZXN0Eg8KB2Nhc2VfaWQYASABKAkiPgoRQXNzaWduQ2FzZVJlcXVlc3QSDwoHY2FzZV9pZBgBIAEoCRIYChBhc3NpZ25lZV91c2VyX2lkGAIgASgJIhQKEkFzc2lnbkNhc2VSZXNwb25zZSKWAQoWQWRkQ2FzZUV2aWRlbmNlUmVxdWVzdBIPCgdjYXNlX2lkGAEgASgJEicKBHR5cGUYAiABKA4yGS5tb2Rfc2VydmljZS5FdmlkZW5jZVR5cGUSDwoHZ2FtZV9pZBgDIAEoCRIPCgdjaGFubmVsGAQgASgJEhIKCm1lc3NhZ2VfaWQYBSABKAkSDAoEbm90ZRgGIAEoCSIZChdBZGRDYXNlRXZpZGVuY2VSZXNwb25zZSJcChJSZXNvbHZlQ2FzZVJlcXVlc3QSDwoHY2FzZV9pZBgBIAEoCRInCgdhY3Rpb25zGAIgAygLMhYubW9kX3NlcnZpY2UuTW9kQWN0aW9uEgwKBG5vdGUYAyABKAkiFQoTUmVzb2x2ZUNhc2VSZXNwb25zZSI3ChNTdWJtaXRBcHBlYWxSZXF1ZXN0Eg8KB2Nhc2VfaWQYASABKAkSDwoHbWVzc2FnZRgCIAEoCSIpChRTdWJtaXRBcHBlYWxSZXNwb25zZRIRCglhcHBlYWxfaWQYASABKAkiSQoTRGVjaWRlQXBwZWFsUmVxdWVzdBIRCglhcHBlYWxfaWQYASABKAkSDQoFZ3JhbnQYAiABKAgSEAoIcmVzcG9uc2UYAyABKAkiFgoURGVjaWRlQXBwZWFsUmVzcG9uc2Ui5AEKEEdhbWVDaGVhdFNpZ25hbHMSDwoHZ2FtZV9pZBgBIAEoCRIYChBlbmdpbmVfYWdyZWVtZW50GAIgASgBEg0KBXR1cm5zGAMgASgFEhUKDW1pc3Rha2VfaW5kZXgYBCABKAESGAoQZXN0aW1hdGVkX3JhdGluZxgFIAEoARIOCgZyYXRpbmcYBiABKAESFAoMbW92ZV90aW1lX2N2GAcgASgBEg8KB2ZsYWdnZWQYCCABKAgSLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiLgobR2V0Q2hlYXRTaWduYWxSZXBvcnRSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAkisgIKEUNoZWF0U2lnbmFsUmVwb3J0Eg8KB3VzZXJfaWQYASABKAkSFgoOZ2FtZXNfYW5hbHl6ZWQYAiABKAUSGAoQZW5naW5lX2FncmVlbWVudBgDIAEoARIYChBhZ3JlZW1lbnRfenNjb3JlGAQgASgBEhUKDW1pc3Rha2VfaW5kZXgYBSABKAESFwoPYWNjdXJhY3lfenNjb3JlGAYgASgBEhIKCnJhdGluZ19nYXAYByABKAESFAoMbW92ZV90aW1lX2N2GAggASgBEhYKDmJhc2VsaW5lX2dhbWVzGAkgASgFEg8KB291dGxpZXIYCiABKAgSDwoHcmVhc29ucxgLIAMoCRIsCgVnYW1lcxgMIAMoCzIdLm1vZF9zZXJ2aWNlLkdhbWVDaGVhdFNpZ25hbHMi2QIKCkNoYXRQb2xpY3kSMgoMY2hhbm5lbF90eXBlGAEgASgOMhwubW9kX3NlcnZpY2UuQ2hhdENoYW5uZWxUeXBlEhkKEXNsb3dfbW9kZV9zZWNvbmRzGAIgASgFEhoKEmZsb29kX21heF9tZXNzYWdlcxgDIAEoBRIcChRmbG9vZF93aW5kb3dfc2Vjb25kcxgEIAEoBRIzCgxmbG9vZF9hY3Rpb24YBSABKA4yHS5tb2Rfc2VydmljZS5DaGF0RmlsdGVyQWN0aW9uEhgKEGJsb2NrX2xpbmtzX2RheXMYBiABKAUSGwoTYmxvY2tsaXN0X2xhbmd1YWdlcxgHIAMoCRI3ChBibG9ja2xpc3RfYWN0aW9uGAggASgOMh0ubW9kX3NlcnZpY2UuQ2hhdEZpbHRlckFjdGlvbhIdChVtdXRlX2R1cmF0aW9uX3NlY29uZHMYCSABKAUiGAoWR2V0Q2hhdFBvbGljaWVzUmVxdWVzdCI5CgxDaGF0UG9saWNpZXMSKQoIcG9saWNpZXMYASADKAsyFy5tb2Rfc2VydmljZS5DaGF0UG9saWN5IhcKFVNldENoYXRQb2xpY3lSZXNwb25zZSJVChJDaGF0QmxvY2tsaXN0RW50cnkSCgoCaWQYASABKAMSEAoIbGFuZ3VhZ2UYAiABKAkSDwoHcGF0dGVybhgDIAEoCRIQCghpc19yZWdleBgEIAEoCCIrChdHZXRDaGF0QmxvY2tsaXN0UmVxdWVzdBIQCghsYW5ndWFnZRgBIAEoCSJBCg1DaGF0QmxvY2tsaXN0EjAKB2VudHJpZXMYASADKAsyHy5tb2Rfc2VydmljZS5DaGF0QmxvY2tsaXN0RW50cnkiKwodQWRkQ2hhdEJsb2NrbGlzdEVudHJ5UmVzcG9uc2USCgoCaWQYASABKAMiLQofUmVtb3ZlQ2hhdEJsb2NrbGlzdEVudHJ5UmVxdWVzdBIKCgJpZBgBIAEoAyIiCiBSZW1vdmVDaGF0QmxvY2tsaXN0RW50cnlSZXNwb25zZSrRAQoNTW9kQWN0aW9uVHlwZRIICgRNVVRFEAASEwoPU1VTUEVORF9BQ0NPVU5UEAESFwoTU1VTUEVORF9SQVRFRF9HQU1FUxACEhEKDVNVU1BFTkRfR0FNRVMQAxIRCg1SRVNFVF9SQVRJTkdTEAQSDwoLUkVTRVRfU1RBVFMQBRIbChdSRVNFVF9TVEFUU19BTkRfUkFUSU5HUxAGEg8KC1JFTU9WRV9DSEFUEAcSEgoOREVMRVRFX0FDQ09VTlQQCBIPCgtTSEFET1dfTVVURRAJKjQKCUVtYWlsVHlwZRILCgdERUZBVUxUEAASDAoIQ0hFQVRJTkcQARIMCghERUxFVElPThACKo0BChFOb3RvcmlvdXNHYW1lVHlwZRIICgRHT09EEAASCwoHTk9fUExBWRABEgsKB1NJVFRJTkcQAhILCgdTQU5EQkFHEAMSGAoUTk9fUExBWV9ERU5JRURfTlVER0UQBBIVChFFWENFU1NJVkVfUEhPTklFUxAFEhYKEkVOR0lORV9DT1JSRUxBVElPThAGKkcKEFJlcG9ydFRhcmdldFR5cGUSEQoNUkVQT1JUX1BMQVlFUhAAEg8KC1JFUE9SVF9HQU1FEAESDwoLUkVQT1JUX0NIQVQQAiqpAQoMUmVwb3J0UmVhc29uEhAKDFJFQVNPTl9PVEhFUhAAEhMKD1JFQVNPTl9DSEVBVElORxABEhUKEVJFQVNPTl9IQVJBU1NNRU5UEAISHQoZUkVBU09OX09GRkVOU0lWRV9VU0VSTkFNRRADEg8KC1JFQVNPTl9TUEFNEAQSEwoPUkVBU09OX1NUQUxMSU5HEAUSFgoSUkVBU09OX1NBTkRCQUdHSU5HEAYqgQEKCkNhc2VTdGF0dXMSDQoJQ0FTRV9PUEVOEAASEQoNQ0FTRV9BU1NJR05FRBABEhUKEUNBU0VfQUNUSU9OX1RBS0VOEAISEgoOQ0FTRV9ESVNNSVNTRUQQAxIRCg1DQVNFX0FQUEVBTEVEEAQSEwoPQ0FTRV9PVkVSVFVSTkVEEAUqNAoMRXZpZGVuY2VUeXBlEhEKDUVWSURFTkNFX0dBTUUQABIRCg1FVklERU5DRV9DSEFUEAEqSQoMQXBwZWFsU3RhdHVzEhIKDkFQUEVBTF9QRU5ESU5HEAASEgoOQVBQRUFMX0dSQU5URUQQARIRCg1BUFBFQUxfREVOSUVEEAIqXgoPQ2hhdENoYW5uZWxUeXBlEhEKDUNIQU5ORUxfTE9CQlkQABIWChJDSEFOTkVMX1RPVVJOQU1FTlQQARIQCgxDSEFOTkVMX0dBTUUQAhIOCgpDSEFOTkVMX1BNEAMqSAoQQ2hhdEZpbHRlckFjdGlvbhIPCgtDSEFUX1JFSkVDVBAAEhQKEENIQVRfU0hBRE9XX01VVEUQARINCglDSEFUX01VVEUQAjKzDQoKTW9kU2VydmljZRJLCgxBcHBseUFjdGlvbnMSGy5tb2Rfc2VydmljZS5Nb2RBY3Rpb25zTGlzdBoeLm1vZF9zZXJ2aWNlLk1vZEFjdGlvblJlc3BvbnNlEkwKDVJlbW92ZUFjdGlvbnMSGy5tb2Rfc2VydmljZS5Nb2RBY3Rpb25zTGlzdBoeLm1vZF9zZXJ2aWNlLk1vZEFjdGlvblJlc3BvbnNlEkgKCkdldEFjdGlvbnMSHi5tb2Rfc2VydmljZS5HZXRBY3Rpb25zUmVxdWVzdBoaLm1vZF9zZXJ2aWNlLk1vZEFjdGlvbnNNYXASTwoQR2V0QWN0aW9uSGlzdG9yeRIeLm1vZF9zZXJ2aWNlLkdldEFjdGlvbnNSZXF1ZXN0GhsubW9kX3NlcnZpY2UuTW9kQWN0aW9uc0xpc3QSWgoSR2V0Tm90b3JpZXR5UmVwb3J0EiYubW9kX3NlcnZpY2UuR2V0Tm90b3JpZXR5UmVwb3J0UmVxdWVzdBocLm1vZF9zZXJ2aWNlLk5vdG9yaWV0eVJlcG9ydBJZCg5SZXNldE5vdG9yaWV0eRIiLm1vZF9zZXJ2aWNlLlJlc2V0Tm90b3JpZXR5UmVxdWVzdBojLm1vZF9zZXJ2aWNlLlJlc2V0Tm90b3JpZXR5UmVzcG9uc2USTQoKRmlsZVJlcG9ydBIeLm1vZF9zZXJ2aWNlLkZpbGVSZXBvcnRSZXF1ZXN0Gh8ubW9kX3NlcnZpY2UuRmlsZVJlcG9ydFJlc3BvbnNlEkoKCUxpc3RDYXNlcxIdLm1vZF9zZXJ2aWNlLkxpc3RDYXNlc1JlcXVlc3QaHi5tb2Rfc2VydmljZS5MaXN0Q2FzZXNSZXNwb25zZRI8CgdHZXRDYXNlEhsubW9kX3NlcnZpY2UuR2V0Q2FzZVJlcXVlc3QaFC5tb2Rfc2VydmljZS5Nb2RDYXNlEk0KCkFzc2lnbkNhc2USHi5tb2Rfc2VydmljZS5Bc3NpZ25DYXNlUmVxdWVzdBofLm1vZF9zZXJ2aWNlLkFzc2lnbkNhc2VSZXNwb25zZRJcCg9BZGRDYXNlRXZpZGVuY2USIy5tb2Rfc2VydmljZS5BZGRDYXNlRXZpZGVuY2VSZXF1ZXN0GiQubW9kX3NlcnZpY2UuQWRkQ2FzZUV2aWRlbmNlUmVzcG9uc2USUAoLUmVzb2x2ZUNhc2USHy5tb2Rfc2VydmljZS5SZXNvbHZlQ2FzZVJlcXVlc3QaIC5tb2Rfc2VydmljZS5SZXNvbHZlQ2FzZVJlc3BvbnNlElMKDFN1Ym1pdEFwcGVhbBIgLm1vZF9zZXJ2aWNlLlN1Ym1pdEFwcGVhbFJlcXVlc3QaIS5tb2Rfc2VydmljZS5TdWJtaXRBcHBlYWxSZXNwb25zZRJTCgxEZWNpZGVBcHBlYWwSIC5tb2Rfc2VydmljZS5EZWNpZGVBcHBlYWxSZXF1ZXN0GiEubW9kX3NlcnZpY2UuRGVjaWRlQXBwZWFsUmVzcG9uc2USYAoUR2V0Q2hlYXRTaWduYWxSZXBvcnQSKC5tb2Rfc2VydmljZS5HZXRDaGVhdFNpZ25hbFJlcG9ydFJlcXVlc3QaHi5tb2Rfc2VydmljZS5DaGVhdFNpZ25hbFJlcG9ydBJRCg9HZXRDaGF0UG9saWNpZXMSIy5tb2Rfc2VydmljZS5HZXRDaGF0UG9saWNpZXNSZXF1ZXN0GhkubW9kX3NlcnZpY2UuQ2hhdFBvbGljaWVzEkwKDVNldENoYXRQb2xpY3kSFy5tb2Rfc2VydmljZS5DaGF0UG9saWN5GiIubW9kX3NlcnZpY2UuU2V0Q2hhdFBvbGljeVJlc3BvbnNlElQKEEdldENoYXRCbG9ja2xpc3QSJC5tb2Rfc2VydmljZS5HZXRDaGF0QmxvY2tsaXN0UmVxdWVzdBoaLm1vZF9zZXJ2aWNlLkNoYXRCbG9ja2xpc3QSZAoVQWRkQ2hhdEJsb2NrbGlzdEVudHJ5Eh8ubW9kX3NlcnZpY2UuQ2hhdEJsb2NrbGlzdEVudHJ5GioubW9kX3NlcnZpY2UuQWRkQ2hhdEJsb2NrbGlzdEVudHJ5UmVzcG9uc2USdwoYUmVtb3ZlQ2hhdEJsb2NrbGlzdEVudHJ5EiwubW9kX3NlcnZpY2UuUmVtb3ZlQ2hhdEJsb2NrbGlzdEVudHJ5UmVxdWVzdBotLm1vZF9zZXJ2aWNlLlJlbW92ZUNoYXRCbG9ja2xpc3RFbnRyeVJlc3BvbnNlQqMBCg9jb20ubW9kX3NlcnZpY2VCD01vZFNlcnZpY2VQcm90b1ABWjdnaXRodWIuY29tL3dvb2dsZXMtaW8vbGl3b3Jkcy9ycGMvYXBpL3Byb3RvL21vZF9zZXJ2aWNlogIDTVhYqgIKTW9kU2VydmljZcoCCk1vZFNlcnZpY2XiAhZNb2RTZXJ2aWNlXEdQQk1ldGFkYXRh6gIKTW9kU2VydmljZWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message mod_service.ModAction
@@ -253,6 +253,997 @@ export type NotorietyReport = Message<"mod_service.NotorietyReport"> & {
 export const NotorietyReportSchema: GenMessage<NotorietyReport> = /*@__PURE__*/
   messageDesc(file_proto_mod_service_mod_service, 9);
 
+/**
+ * A report filed by a user. Reports about the same player are merged into
+ * that player's open case.
+ *
+ * @generated from message mod_service.FileReportRequest
+ */
+export type FileReportRequest = Message<"mod_service.FileReportRequest"> & {
+  /**
+   * @generated from field: mod_service.ReportTargetType target_type = 1;
+   */
+  targetType: ReportTargetType;
+
+  /**
+   * The reported player. For chat reports this is derived from the message.
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string game_id = 3;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string channel = 4;
+   */
+  channel: string;
+
+  /**
+   * @generated from field: string message_id = 5;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: mod_service.ReportReason reason = 6;
+   */
+  reason: ReportReason;
+
+  /**
+   * @generated from field: string description = 7;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message mod_service.FileReportRequest.
+ * Use `create(FileReportRequestSchema)` to create a new message.
+ */
+export const FileReportRequestSchema: GenMessage<FileReportRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 10);
+
+/**
+ * @generated from message mod_service.FileReportResponse
+ */
+export type FileReportResponse = Message<"mod_service.FileReportResponse"> & {
+};
+
+/**
+ * Describes the message mod_service.FileReportResponse.
+ * Use `create(FileReportResponseSchema)` to create a new message.
+ */
+export const FileReportResponseSchema: GenMessage<FileReportResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 11);
+
+/**
+ * @generated from message mod_service.Report
+ */
+export type Report = Message<"mod_service.Report"> & {
+  /**
+   * @generated from field: string reporter_user_id = 1;
+   */
+  reporterUserId: string;
+
+  /**
+   * @generated from field: string reporter_username = 2;
+   */
+  reporterUsername: string;
+
+  /**
+   * @generated from field: mod_service.ReportTargetType target_type = 3;
+   */
+  targetType: ReportTargetType;
+
+  /**
+   * @generated from field: mod_service.ReportReason reason = 4;
+   */
+  reason: ReportReason;
+
+  /**
+   * @generated from field: string description = 5;
+   */
+  description: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message mod_service.Report.
+ * Use `create(ReportSchema)` to create a new message.
+ */
+export const ReportSchema: GenMessage<Report> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 12);
+
+/**
+ * @generated from message mod_service.Evidence
+ */
+export type Evidence = Message<"mod_service.Evidence"> & {
+  /**
+   * @generated from field: mod_service.EvidenceType type = 1;
+   */
+  type: EvidenceType;
+
+  /**
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string channel = 3;
+   */
+  channel: string;
+
+  /**
+   * @generated from field: string message_id = 4;
+   */
+  messageId: string;
+
+  /**
+   * For chat evidence, the message text at the time it was added, since
+   * chat messages expire or may be deleted.
+   *
+   * @generated from field: string snapshot = 5;
+   */
+  snapshot: string;
+
+  /**
+   * @generated from field: string note = 6;
+   */
+  note: string;
+
+  /**
+   * @generated from field: string added_by_username = 7;
+   */
+  addedByUsername: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message mod_service.Evidence.
+ * Use `create(EvidenceSchema)` to create a new message.
+ */
+export const EvidenceSchema: GenMessage<Evidence> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 13);
+
+/**
+ * @generated from message mod_service.Appeal
+ */
+export type Appeal = Message<"mod_service.Appeal"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * @generated from field: mod_service.AppealStatus status = 3;
+   */
+  status: AppealStatus;
+
+  /**
+   * @generated from field: string response = 4;
+   */
+  response: string;
+
+  /**
+   * @generated from field: string reviewer_username = 5;
+   */
+  reviewerUsername: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp decided_at = 7;
+   */
+  decidedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message mod_service.Appeal.
+ * Use `create(AppealSchema)` to create a new message.
+ */
+export const AppealSchema: GenMessage<Appeal> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 14);
+
+/**
+ * @generated from message mod_service.ModCase
+ */
+export type ModCase = Message<"mod_service.ModCase"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: mod_service.CaseStatus status = 4;
+   */
+  status: CaseStatus;
+
+  /**
+   * @generated from field: string assignee_user_id = 5;
+   */
+  assigneeUserId: string;
+
+  /**
+   * @generated from field: string assignee_username = 6;
+   */
+  assigneeUsername: string;
+
+  /**
+   * @generated from field: int32 num_reports = 7;
+   */
+  numReports: number;
+
+  /**
+   * @generated from field: string resolution_note = 8;
+   */
+  resolutionNote: string;
+
+  /**
+   * @generated from field: string resolver_username = 9;
+   */
+  resolverUsername: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 11;
+   */
+  updatedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp resolved_at = 12;
+   */
+  resolvedAt?: Timestamp | undefined;
+
+  /**
+   * The following are only filled in by GetCase.
+   *
+   * @generated from field: repeated mod_service.Report reports = 13;
+   */
+  reports: Report[];
+
+  /**
+   * @generated from field: repeated mod_service.Evidence evidence = 14;
+   */
+  evidence: Evidence[];
+
+  /**
+   * @generated from field: repeated mod_service.ModAction actions = 15;
+   */
+  actions: ModAction[];
+
+  /**
+   * @generated from field: repeated mod_service.Appeal appeals = 16;
+   */
+  appeals: Appeal[];
+};
+
+/**
+ * Describes the message mod_service.ModCase.
+ * Use `create(ModCaseSchema)` to create a new message.
+ */
+export const ModCaseSchema: GenMessage<ModCase> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 15);
+
+/**
+ * @generated from message mod_service.ListCasesRequest
+ */
+export type ListCasesRequest = Message<"mod_service.ListCasesRequest"> & {
+  /**
+   * If empty, cases that need attention (open, assigned or appealed) are
+   * returned.
+   *
+   * @generated from field: repeated mod_service.CaseStatus statuses = 1;
+   */
+  statuses: CaseStatus[];
+
+  /**
+   * Only cases assigned to this moderator, if set.
+   *
+   * @generated from field: string assignee_user_id = 2;
+   */
+  assigneeUserId: string;
+
+  /**
+   * @generated from field: int32 limit = 3;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 4;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message mod_service.ListCasesRequest.
+ * Use `create(ListCasesRequestSchema)` to create a new message.
+ */
+export const ListCasesRequestSchema: GenMessage<ListCasesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 16);
+
+/**
+ * @generated from message mod_service.ListCasesResponse
+ */
+export type ListCasesResponse = Message<"mod_service.ListCasesResponse"> & {
+  /**
+   * @generated from field: repeated mod_service.ModCase cases = 1;
+   */
+  cases: ModCase[];
+};
+
+/**
+ * Describes the message mod_service.ListCasesResponse.
+ * Use `create(ListCasesResponseSchema)` to create a new message.
+ */
+export const ListCasesResponseSchema: GenMessage<ListCasesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 17);
+
+/**
+ * @generated from message mod_service.GetCaseRequest
+ */
+export type GetCaseRequest = Message<"mod_service.GetCaseRequest"> & {
+  /**
+   * @generated from field: string case_id = 1;
+   */
+  caseId: string;
+};
+
+/**
+ * Describes the message mod_service.GetCaseRequest.
+ * Use `create(GetCaseRequestSchema)` to create a new message.
+ */
+export const GetCaseRequestSchema: GenMessage<GetCaseRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 18);
+
+/**
+ * @generated from message mod_service.AssignCaseRequest
+ */
+export type AssignCaseRequest = Message<"mod_service.AssignCaseRequest"> & {
+  /**
+   * @generated from field: string case_id = 1;
+   */
+  caseId: string;
+
+  /**
+   * Defaults to the requesting moderator.
+   *
+   * @generated from field: string assignee_user_id = 2;
+   */
+  assigneeUserId: string;
+};
+
+/**
+ * Describes the message mod_service.AssignCaseRequest.
+ * Use `create(AssignCaseRequestSchema)` to create a new message.
+ */
+export const AssignCaseRequestSchema: GenMessage<AssignCaseRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 19);
+
+/**
+ * @generated from message mod_service.AssignCaseResponse
+ */
+export type AssignCaseResponse = Message<"mod_service.AssignCaseResponse"> & {
+};
+
+/**
+ * Describes the message mod_service.AssignCaseResponse.
+ * Use `create(AssignCaseResponseSchema)` to create a new message.
+ */
+export const AssignCaseResponseSchema: GenMessage<AssignCaseResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 20);
+
+/**
+ * @generated from message mod_service.AddCaseEvidenceRequest
+ */
+export type AddCaseEvidenceRequest = Message<"mod_service.AddCaseEvidenceRequest"> & {
+  /**
+   * @generated from field: string case_id = 1;
+   */
+  caseId: string;
+
+  /**
+   * @generated from field: mod_service.EvidenceType type = 2;
+   */
+  type: EvidenceType;
+
+  /**
+   * @generated from field: string game_id = 3;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string channel = 4;
+   */
+  channel: string;
+
+  /**
+   * @generated from field: string message_id = 5;
+   */
+  messageId: string;
+
+  /**
+   * @generated from field: string note = 6;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message mod_service.AddCaseEvidenceRequest.
+ * Use `create(AddCaseEvidenceRequestSchema)` to create a new message.
+ */
+export const AddCaseEvidenceRequestSchema: GenMessage<AddCaseEvidenceRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 21);
+
+/**
+ * @generated from message mod_service.AddCaseEvidenceResponse
+ */
+export type AddCaseEvidenceResponse = Message<"mod_service.AddCaseEvidenceResponse"> & {
+};
+
+/**
+ * Describes the message mod_service.AddCaseEvidenceResponse.
+ * Use `create(AddCaseEvidenceResponseSchema)` to create a new message.
+ */
+export const AddCaseEvidenceResponseSchema: GenMessage<AddCaseEvidenceResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 22);
+
+/**
+ * @generated from message mod_service.ResolveCaseRequest
+ */
+export type ResolveCaseRequest = Message<"mod_service.ResolveCaseRequest"> & {
+  /**
+   * @generated from field: string case_id = 1;
+   */
+  caseId: string;
+
+  /**
+   * If no actions are given the case is dismissed.
+   *
+   * @generated from field: repeated mod_service.ModAction actions = 2;
+   */
+  actions: ModAction[];
+
+  /**
+   * @generated from field: string note = 3;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message mod_service.ResolveCaseRequest.
+ * Use `create(ResolveCaseRequestSchema)` to create a new message.
+ */
+export const ResolveCaseRequestSchema: GenMessage<ResolveCaseRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 23);
+
+/**
+ * @generated from message mod_service.ResolveCaseResponse
+ */
+export type ResolveCaseResponse = Message<"mod_service.ResolveCaseResponse"> & {
+};
+
+/**
+ * Describes the message mod_service.ResolveCaseResponse.
+ * Use `create(ResolveCaseResponseSchema)` to create a new message.
+ */
+export const ResolveCaseResponseSchema: GenMessage<ResolveCaseResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 24);
+
+/**
+ * @generated from message mod_service.SubmitAppealRequest
+ */
+export type SubmitAppealRequest = Message<"mod_service.SubmitAppealRequest"> & {
+  /**
+   * @generated from field: string case_id = 1;
+   */
+  caseId: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message mod_service.SubmitAppealRequest.
+ * Use `create(SubmitAppealRequestSchema)` to create a new message.
+ */
+export const SubmitAppealRequestSchema: GenMessage<SubmitAppealRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 25);
+
+/**
+ * @generated from message mod_service.SubmitAppealResponse
+ */
+export type SubmitAppealResponse = Message<"mod_service.SubmitAppealResponse"> & {
+  /**
+   * @generated from field: string appeal_id = 1;
+   */
+  appealId: string;
+};
+
+/**
+ * Describes the message mod_service.SubmitAppealResponse.
+ * Use `create(SubmitAppealResponseSchema)` to create a new message.
+ */
+export const SubmitAppealResponseSchema: GenMessage<SubmitAppealResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 26);
+
+/**
+ * @generated from message mod_service.DecideAppealRequest
+ */
+export type DecideAppealRequest = Message<"mod_service.DecideAppealRequest"> & {
+  /**
+   * @generated from field: string appeal_id = 1;
+   */
+  appealId: string;
+
+  /**
+   * @generated from field: bool grant = 2;
+   */
+  grant: boolean;
+
+  /**
+   * Sent to the user by email.
+   *
+   * @generated from field: string response = 3;
+   */
+  response: string;
+};
+
+/**
+ * Describes the message mod_service.DecideAppealRequest.
+ * Use `create(DecideAppealRequestSchema)` to create a new message.
+ */
+export const DecideAppealRequestSchema: GenMessage<DecideAppealRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 27);
+
+/**
+ * @generated from message mod_service.DecideAppealResponse
+ */
+export type DecideAppealResponse = Message<"mod_service.DecideAppealResponse"> & {
+};
+
+/**
+ * Describes the message mod_service.DecideAppealResponse.
+ * Use `create(DecideAppealResponseSchema)` to create a new message.
+ */
+export const DecideAppealResponseSchema: GenMessage<DecideAppealResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 28);
+
+/**
+ * GameCheatSignals are the signals computed for one player in one analyzed,
+ * rated game.
+ *
+ * @generated from message mod_service.GameCheatSignals
+ */
+export type GameCheatSignals = Message<"mod_service.GameCheatSignals"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * Fraction of the player's turns where they played the engine's best move.
+   *
+   * @generated from field: double engine_agreement = 2;
+   */
+  engineAgreement: number;
+
+  /**
+   * @generated from field: int32 turns = 3;
+   */
+  turns: number;
+
+  /**
+   * @generated from field: double mistake_index = 4;
+   */
+  mistakeIndex: number;
+
+  /**
+   * The rating the analyzer estimates from the player's accuracy.
+   *
+   * @generated from field: double estimated_rating = 5;
+   */
+  estimatedRating: number;
+
+  /**
+   * The player's rating when the game started.
+   *
+   * @generated from field: double rating = 6;
+   */
+  rating: number;
+
+  /**
+   * Coefficient of variation of the player's think times; 0 if unknown.
+   *
+   * @generated from field: double move_time_cv = 7;
+   */
+  moveTimeCv: number;
+
+  /**
+   * @generated from field: bool flagged = 8;
+   */
+  flagged: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message mod_service.GameCheatSignals.
+ * Use `create(GameCheatSignalsSchema)` to create a new message.
+ */
+export const GameCheatSignalsSchema: GenMessage<GameCheatSignals> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 29);
+
+/**
+ * @generated from message mod_service.GetCheatSignalReportRequest
+ */
+export type GetCheatSignalReportRequest = Message<"mod_service.GetCheatSignalReportRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message mod_service.GetCheatSignalReportRequest.
+ * Use `create(GetCheatSignalReportRequestSchema)` to create a new message.
+ */
+export const GetCheatSignalReportRequestSchema: GenMessage<GetCheatSignalReportRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 30);
+
+/**
+ * CheatSignalReport summarizes a player's most recent analyzed rated games
+ * and compares them to players of a similar rating.
+ *
+ * @generated from message mod_service.CheatSignalReport
+ */
+export type CheatSignalReport = Message<"mod_service.CheatSignalReport"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: int32 games_analyzed = 2;
+   */
+  gamesAnalyzed: number;
+
+  /**
+   * @generated from field: double engine_agreement = 3;
+   */
+  engineAgreement: number;
+
+  /**
+   * How many standard errors the player's engine agreement is above
+   * players of a similar rating.
+   *
+   * @generated from field: double agreement_zscore = 4;
+   */
+  agreementZscore: number;
+
+  /**
+   * @generated from field: double mistake_index = 5;
+   */
+  mistakeIndex: number;
+
+  /**
+   * How many standard errors the player's mistake index is below players
+   * of a similar rating.
+   *
+   * @generated from field: double accuracy_zscore = 6;
+   */
+  accuracyZscore: number;
+
+  /**
+   * Mean of the estimated rating minus the actual rating.
+   *
+   * @generated from field: double rating_gap = 7;
+   */
+  ratingGap: number;
+
+  /**
+   * @generated from field: double move_time_cv = 8;
+   */
+  moveTimeCv: number;
+
+  /**
+   * The number of games of similar-rated players used for comparison.
+   *
+   * @generated from field: int32 baseline_games = 9;
+   */
+  baselineGames: number;
+
+  /**
+   * @generated from field: bool outlier = 10;
+   */
+  outlier: boolean;
+
+  /**
+   * @generated from field: repeated string reasons = 11;
+   */
+  reasons: string[];
+
+  /**
+   * @generated from field: repeated mod_service.GameCheatSignals games = 12;
+   */
+  games: GameCheatSignals[];
+};
+
+/**
+ * Describes the message mod_service.CheatSignalReport.
+ * Use `create(CheatSignalReportSchema)` to create a new message.
+ */
+export const CheatSignalReportSchema: GenMessage<CheatSignalReport> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 31);
+
+/**
+ * ChatPolicy configures how chat is regulated in one type of channel.
+ * Moderators and tournament directors are exempt.
+ *
+ * @generated from message mod_service.ChatPolicy
+ */
+export type ChatPolicy = Message<"mod_service.ChatPolicy"> & {
+  /**
+   * @generated from field: mod_service.ChatChannelType channel_type = 1;
+   */
+  channelType: ChatChannelType;
+
+  /**
+   * Minimum number of seconds between a user's messages; 0 disables slow
+   * mode. Slow mode also refuses repeating the previous message.
+   *
+   * @generated from field: int32 slow_mode_seconds = 2;
+   */
+  slowModeSeconds: number;
+
+  /**
+   * Sending more than flood_max_messages within flood_window_seconds is
+   * flooding; 0 disables flood detection.
+   *
+   * @generated from field: int32 flood_max_messages = 3;
+   */
+  floodMaxMessages: number;
+
+  /**
+   * @generated from field: int32 flood_window_seconds = 4;
+   */
+  floodWindowSeconds: number;
+
+  /**
+   * @generated from field: mod_service.ChatFilterAction flood_action = 5;
+   */
+  floodAction: ChatFilterAction;
+
+  /**
+   * Accounts younger than this many days can't post links; 0 allows links.
+   *
+   * @generated from field: int32 block_links_days = 6;
+   */
+  blockLinksDays: number;
+
+  /**
+   * Which languages' blocklists apply; empty means all of them.
+   *
+   * @generated from field: repeated string blocklist_languages = 7;
+   */
+  blocklistLanguages: string[];
+
+  /**
+   * @generated from field: mod_service.ChatFilterAction blocklist_action = 8;
+   */
+  blocklistAction: ChatFilterAction;
+
+  /**
+   * How long automatic mutes and shadow-mutes last.
+   *
+   * @generated from field: int32 mute_duration_seconds = 9;
+   */
+  muteDurationSeconds: number;
+};
+
+/**
+ * Describes the message mod_service.ChatPolicy.
+ * Use `create(ChatPolicySchema)` to create a new message.
+ */
+export const ChatPolicySchema: GenMessage<ChatPolicy> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 32);
+
+/**
+ * @generated from message mod_service.GetChatPoliciesRequest
+ */
+export type GetChatPoliciesRequest = Message<"mod_service.GetChatPoliciesRequest"> & {
+};
+
+/**
+ * Describes the message mod_service.GetChatPoliciesRequest.
+ * Use `create(GetChatPoliciesRequestSchema)` to create a new message.
+ */
+export const GetChatPoliciesRequestSchema: GenMessage<GetChatPoliciesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 33);
+
+/**
+ * @generated from message mod_service.ChatPolicies
+ */
+export type ChatPolicies = Message<"mod_service.ChatPolicies"> & {
+  /**
+   * @generated from field: repeated mod_service.ChatPolicy policies = 1;
+   */
+  policies: ChatPolicy[];
+};
+
+/**
+ * Describes the message mod_service.ChatPolicies.
+ * Use `create(ChatPoliciesSchema)` to create a new message.
+ */
+export const ChatPoliciesSchema: GenMessage<ChatPolicies> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 34);
+
+/**
+ * @generated from message mod_service.SetChatPolicyResponse
+ */
+export type SetChatPolicyResponse = Message<"mod_service.SetChatPolicyResponse"> & {
+};
+
+/**
+ * Describes the message mod_service.SetChatPolicyResponse.
+ * Use `create(SetChatPolicyResponseSchema)` to create a new message.
+ */
+export const SetChatPolicyResponseSchema: GenMessage<SetChatPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 35);
+
+/**
+ * @generated from message mod_service.ChatBlocklistEntry
+ */
+export type ChatBlocklistEntry = Message<"mod_service.ChatBlocklistEntry"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string language = 2;
+   */
+  language: string;
+
+  /**
+   * @generated from field: string pattern = 3;
+   */
+  pattern: string;
+
+  /**
+   * Patterns are matched as whole words unless they are regular expressions.
+   *
+   * @generated from field: bool is_regex = 4;
+   */
+  isRegex: boolean;
+};
+
+/**
+ * Describes the message mod_service.ChatBlocklistEntry.
+ * Use `create(ChatBlocklistEntrySchema)` to create a new message.
+ */
+export const ChatBlocklistEntrySchema: GenMessage<ChatBlocklistEntry> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 36);
+
+/**
+ * @generated from message mod_service.GetChatBlocklistRequest
+ */
+export type GetChatBlocklistRequest = Message<"mod_service.GetChatBlocklistRequest"> & {
+  /**
+   * Empty for all languages.
+   *
+   * @generated from field: string language = 1;
+   */
+  language: string;
+};
+
+/**
+ * Describes the message mod_service.GetChatBlocklistRequest.
+ * Use `create(GetChatBlocklistRequestSchema)` to create a new message.
+ */
+export const GetChatBlocklistRequestSchema: GenMessage<GetChatBlocklistRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 37);
+
+/**
+ * @generated from message mod_service.ChatBlocklist
+ */
+export type ChatBlocklist = Message<"mod_service.ChatBlocklist"> & {
+  /**
+   * @generated from field: repeated mod_service.ChatBlocklistEntry entries = 1;
+   */
+  entries: ChatBlocklistEntry[];
+};
+
+/**
+ * Describes the message mod_service.ChatBlocklist.
+ * Use `create(ChatBlocklistSchema)` to create a new message.
+ */
+export const ChatBlocklistSchema: GenMessage<ChatBlocklist> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 38);
+
+/**
+ * @generated from message mod_service.AddChatBlocklistEntryResponse
+ */
+export type AddChatBlocklistEntryResponse = Message<"mod_service.AddChatBlocklistEntryResponse"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message mod_service.AddChatBlocklistEntryResponse.
+ * Use `create(AddChatBlocklistEntryResponseSchema)` to create a new message.
+ */
+export const AddChatBlocklistEntryResponseSchema: GenMessage<AddChatBlocklistEntryResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 39);
+
+/**
+ * @generated from message mod_service.RemoveChatBlocklistEntryRequest
+ */
+export type RemoveChatBlocklistEntryRequest = Message<"mod_service.RemoveChatBlocklistEntryRequest"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+};
+
+/**
+ * Describes the message mod_service.RemoveChatBlocklistEntryRequest.
+ * Use `create(RemoveChatBlocklistEntryRequestSchema)` to create a new message.
+ */
+export const RemoveChatBlocklistEntryRequestSchema: GenMessage<RemoveChatBlocklistEntryRequest> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 40);
+
+/**
+ * @generated from message mod_service.RemoveChatBlocklistEntryResponse
+ */
+export type RemoveChatBlocklistEntryResponse = Message<"mod_service.RemoveChatBlocklistEntryResponse"> & {
+};
+
+/**
+ * Describes the message mod_service.RemoveChatBlocklistEntryResponse.
+ * Use `create(RemoveChatBlocklistEntryResponseSchema)` to create a new message.
+ */
+export const RemoveChatBlocklistEntryResponseSchema: GenMessage<RemoveChatBlocklistEntryResponse> = /*@__PURE__*/
+  messageDesc(file_proto_mod_service_mod_service, 41);
+
 /**
  * @generated from enum mod_service.ModActionType
  */
@@ -301,6 +1292,13 @@ export enum ModActionType {
    * @generated from enum value: DELETE_ACCOUNT = 8;
    */
   DELETE_ACCOUNT = 8,
+
+  /**
+   * The user's chat messages are only shown to themselves.
+   *
+   * @generated from enum value: SHADOW_MUTE = 9;
+   */
+  SHADOW_MUTE = 9,
 }
 
 /**
@@ -368,6 +1366,13 @@ export enum NotoriousGameType {
    * @generated from enum value: EXCESSIVE_PHONIES = 5;
    */
   EXCESSIVE_PHONIES = 5,
+
+  /**
+   * Flagged by the statistical cheat detection; needs moderator review.
+   *
+   * @generated from enum value: ENGINE_CORRELATION = 6;
+   */
+  ENGINE_CORRELATION = 6,
 }
 
 /**
@@ -376,6 +1381,235 @@ export enum NotoriousGameType {
 export const NotoriousGameTypeSchema: GenEnum<NotoriousGameType> = /*@__PURE__*/
   enumDesc(file_proto_mod_service_mod_service, 2);
 
+/**
+ * @generated from enum mod_service.ReportTargetType
+ */
+export enum ReportTargetType {
+  /**
+   * @generated from enum value: REPORT_PLAYER = 0;
+   */
+  REPORT_PLAYER = 0,
+
+  /**
+   * @generated from enum value: REPORT_GAME = 1;
+   */
+  REPORT_GAME = 1,
+
+  /**
+   * @generated from enum value: REPORT_CHAT = 2;
+   */
+  REPORT_CHAT = 2,
+}
+
+/**
+ * Describes the enum mod_service.ReportTargetType.
+ */
+export const ReportTargetTypeSchema: GenEnum<ReportTargetType> = /*@__PURE__*/
+  enumDesc(file_proto_mod_service_mod_service, 3);
+
+/**
+ * @generated from enum mod_service.ReportReason
+ */
+export enum ReportReason {
+  /**
+   * @generated from enum value: REASON_OTHER = 0;
+   */
+  REASON_OTHER = 0,
+
+  /**
+   * @generated from enum value: REASON_CHEATING = 1;
+   */
+  REASON_CHEATING = 1,
+
+  /**
+   * @generated from enum value: REASON_HARASSMENT = 2;
+   */
+  REASON_HARASSMENT = 2,
+
+  /**
+   * @generated from enum value: REASON_OFFENSIVE_USERNAME = 3;
+   */
+  REASON_OFFENSIVE_USERNAME = 3,
+
+  /**
+   * @generated from enum value: REASON_SPAM = 4;
+   */
+  REASON_SPAM = 4,
+
+  /**
+   * @generated from enum value: REASON_STALLING = 5;
+   */
+  REASON_STALLING = 5,
+
+  /**
+   * @generated from enum value: REASON_SANDBAGGING = 6;
+   */
+  REASON_SANDBAGGING = 6,
+}
+
+/**
+ * Describes the enum mod_service.ReportReason.
+ */
+export const ReportReasonSchema: GenEnum<ReportReason> = /*@__PURE__*/
+  enumDesc(file_proto_mod_service_mod_service, 4);
+
+/**
+ * @generated from enum mod_service.CaseStatus
+ */
+export enum CaseStatus {
+  /**
+   * @generated from enum value: CASE_OPEN = 0;
+   */
+  CASE_OPEN = 0,
+
+  /**
+   * @generated from enum value: CASE_ASSIGNED = 1;
+   */
+  CASE_ASSIGNED = 1,
+
+  /**
+   * @generated from enum value: CASE_ACTION_TAKEN = 2;
+   */
+  CASE_ACTION_TAKEN = 2,
+
+  /**
+   * @generated from enum value: CASE_DISMISSED = 3;
+   */
+  CASE_DISMISSED = 3,
+
+  /**
+   * The sanctioned user has a pending appeal.
+   *
+   * @generated from enum value: CASE_APPEALED = 4;
+   */
+  CASE_APPEALED = 4,
+
+  /**
+   * An appeal was granted and the case's actions were lifted.
+   *
+   * @generated from enum value: CASE_OVERTURNED = 5;
+   */
+  CASE_OVERTURNED = 5,
+}
+
+/**
+ * Describes the enum mod_service.CaseStatus.
+ */
+export const CaseStatusSchema: GenEnum<CaseStatus> = /*@__PURE__*/
+  enumDesc(file_proto_mod_service_mod_service, 5);
+
+/**
+ * @generated from enum mod_service.EvidenceType
+ */
+export enum EvidenceType {
+  /**
+   * @generated from enum value: EVIDENCE_GAME = 0;
+   */
+  EVIDENCE_GAME = 0,
+
+  /**
+   * @generated from enum value: EVIDENCE_CHAT = 1;
+   */
+  EVIDENCE_CHAT = 1,
+}
+
+/**
+ * Describes the enum mod_service.EvidenceType.
+ */
+export const EvidenceTypeSchema: GenEnum<EvidenceType> = /*@__PURE__*/
+  enumDesc(file_proto_mod_service_mod_service, 6);
+
+/**
+ * @generated from enum mod_service.AppealStatus
+ */
+export enum AppealStatus {
+  /**
+   * @generated from enum value: APPEAL_PENDING = 0;
+   */
+  APPEAL_PENDING = 0,
+
+  /**
+   * @generated from enum value: APPEAL_GRANTED = 1;
+   */
+  APPEAL_GRANTED = 1,
+
+  /**
+   * @generated from enum value: APPEAL_DENIED = 2;
+   */
+  APPEAL_DENIED = 2,
+}
+
+/**
+ * Describes the enum mod_service.AppealStatus.
+ */
+export const AppealStatusSchema: GenEnum<AppealStatus> = /*@__PURE__*/
+  enumDesc(file_proto_mod_service_mod_service, 7);
+
+/**
+ * @generated from enum mod_service.ChatChannelType
+ */
+export enum ChatChannelType {
+  /**
+   * @generated from enum value: CHANNEL_LOBBY = 0;
+   */
+  CHANNEL_LOBBY = 0,
+
+  /**
+   * Tournament and league channels.
+   *
+   * @generated from enum value: CHANNEL_TOURNAMENT = 1;
+   */
+  CHANNEL_TOURNAMENT = 1,
+
+  /**
+   * @generated from enum value: CHANNEL_GAME = 2;
+   */
+  CHANNEL_GAME = 2,
+
+  /**
+   * @generated from enum value: CHANNEL_PM = 3;
+   */
+  CHANNEL_PM = 3,
+}
+
+/**
+ * Describes the enum mod_service.ChatChannelType.
+ */
+export const ChatChannelTypeSchema: GenEnum<ChatChannelType> = /*@__PURE__*/
+  enumDesc(file_proto_mod_service_mod_service, 8);
+
+/**
+ * @generated from enum mod_service.ChatFilterAction
+ */
+export enum ChatFilterAction {
+  /**
+   * The message is refused and the user is told why.
+   *
+   * @generated from enum value: CHAT_REJECT = 0;
+   */
+  CHAT_REJECT = 0,
+
+  /**
+   * The message is only shown to the sender, and they are shadow-muted.
+   *
+   * @generated from enum value: CHAT_SHADOW_MUTE = 1;
+   */
+  CHAT_SHADOW_MUTE = 1,
+
+  /**
+   * The message is refused and the user is muted.
+   *
+   * @generated from enum value: CHAT_MUTE = 2;
+   */
+  CHAT_MUTE = 2,
+}
+
+/**
+ * Describes the enum mod_service.ChatFilterAction.
+ */
+export const ChatFilterActionSchema: GenEnum<ChatFilterAction> = /*@__PURE__*/
+  enumDesc(file_proto_mod_service_mod_service, 9);
+
 /**
  * @generated from service mod_service.ModService
  */
@@ -428,6 +1662,118 @@ export const ModService: GenService<{
     input: typeof ResetNotorietyRequestSchema;
     output: typeof ResetNotorietyResponseSchema;
   },
+  /**
+   * @generated from rpc mod_service.ModService.FileReport
+   */
+  fileReport: {
+    methodKind: "unary";
+    input: typeof FileReportRequestSchema;
+    output: typeof FileReportResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.ListCases
+   */
+  listCases: {
+    methodKind: "unary";
+    input: typeof ListCasesRequestSchema;
+    output: typeof ListCasesResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.GetCase
+   */
+  getCase: {
+    methodKind: "unary";
+    input: typeof GetCaseRequestSchema;
+    output: typeof ModCaseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.AssignCase
+   */
+  assignCase: {
+    methodKind: "unary";
+    input: typeof AssignCaseRequestSchema;
+    output: typeof AssignCaseResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.AddCaseEvidence
+   */
+  addCaseEvidence: {
+    methodKind: "unary";
+    input: typeof AddCaseEvidenceRequestSchema;
+    output: typeof AddCaseEvidenceResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.ResolveCase
+   */
+  resolveCase: {
+    methodKind: "unary";
+    input: typeof ResolveCaseRequestSchema;
+    output: typeof ResolveCaseResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.SubmitAppeal
+   */
+  submitAppeal: {
+    methodKind: "unary";
+    input: typeof SubmitAppealRequestSchema;
+    output: typeof SubmitAppealResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.DecideAppeal
+   */
+  decideAppeal: {
+    methodKind: "unary";
+    input: typeof DecideAppealRequestSchema;
+    output: typeof DecideAppealResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.GetCheatSignalReport
+   */
+  getCheatSignalReport: {
+    methodKind: "unary";
+    input: typeof GetCheatSignalReportRequestSchema;
+    output: typeof CheatSignalReportSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.GetChatPolicies
+   */
+  getChatPolicies: {
+    methodKind: "unary";
+    input: typeof GetChatPoliciesRequestSchema;
+    output: typeof ChatPoliciesSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.SetChatPolicy
+   */
+  setChatPolicy: {
+    methodKind: "unary";
+    input: typeof ChatPolicySchema;
+    output: typeof SetChatPolicyResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.GetChatBlocklist
+   */
+  getChatBlocklist: {
+    methodKind: "unary";
+    input: typeof GetChatBlocklistRequestSchema;
+    output: typeof ChatBlocklistSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.AddChatBlocklistEntry
+   */
+  addChatBlocklistEntry: {
+    methodKind: "unary";
+    input: typeof ChatBlocklistEntrySchema;
+    output: typeof AddChatBlocklistEntryResponseSchema;
+  },
+  /**
+   * @generated from rpc mod_service.ModService.RemoveChatBlocklistEntry
+   */
+  removeChatBlocklistEntry: {
+    methodKind: "unary";
+    input: typeof RemoveChatBlocklistEntryRequestSchema;
+    output: typeof RemoveChatBlocklistEntryResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_mod_service_mod_service, 0);
 
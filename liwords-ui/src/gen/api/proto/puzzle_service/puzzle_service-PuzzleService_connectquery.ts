@@ -56,3 +56,133 @@ export const startPuzzleGenJob = PuzzleService.method.startPuzzleGenJob;
  * @generated from rpc puzzle_service.PuzzleService.GetPuzzleJobLogs
  */
 export const getPuzzleJobLogs = PuzzleService.method.getPuzzleJobLogs;
+
+/**
+ * QueuePuzzleGeneration queues generation from the user's own annotated
+ * games for the local puzzle worker (cmd/puzzle-worker). Unless the user
+ * can create puzzles, the job needs approval.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.QueuePuzzleGeneration
+ */
+export const queuePuzzleGeneration = PuzzleService.method.queuePuzzleGeneration;
+
+/**
+ * ApprovePuzzleGenerationJob makes the puzzles of a job that needed
+ * approval available to everyone.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.ApprovePuzzleGenerationJob
+ */
+export const approvePuzzleGenerationJob = PuzzleService.method.approvePuzzleGenerationJob;
+
+/**
+ * Spaced-repetition training over the puzzles a user failed or was slow
+ * to solve. Reviews do not change puzzle or user ratings.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.GetReviewQueue
+ */
+export const getReviewQueue = PuzzleService.method.getReviewQueue;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.StartStudySession
+ */
+export const startStudySession = PuzzleService.method.startStudySession;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.SubmitReview
+ */
+export const submitReview = PuzzleService.method.submitReview;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.RemoveFromReviewQueue
+ */
+export const removeFromReviewQueue = PuzzleService.method.removeFromReviewQueue;
+
+/**
+ * Curated puzzle sets. Only puzzle creators can create, update or
+ * delete them.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.CreatePuzzleSet
+ */
+export const createPuzzleSet = PuzzleService.method.createPuzzleSet;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.UpdatePuzzleSet
+ */
+export const updatePuzzleSet = PuzzleService.method.updatePuzzleSet;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.DeletePuzzleSet
+ */
+export const deletePuzzleSet = PuzzleService.method.deletePuzzleSet;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.GetPuzzleSets
+ */
+export const getPuzzleSets = PuzzleService.method.getPuzzleSets;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.GetPuzzleSet
+ */
+export const getPuzzleSet = PuzzleService.method.getPuzzleSet;
+
+/**
+ * The user's rating on each puzzle tag they have attempted.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.GetPuzzleTagRatings
+ */
+export const getPuzzleTagRatings = PuzzleService.method.getPuzzleTagRatings;
+
+/**
+ * The daily puzzle is the same for everyone playing a lexicon. Each user
+ * gets one attempt, which does not change any ratings.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.GetDailyPuzzle
+ */
+export const getDailyPuzzle = PuzzleService.method.getDailyPuzzle;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.SubmitDailyPuzzleAnswer
+ */
+export const submitDailyPuzzleAnswer = PuzzleService.method.submitDailyPuzzleAnswer;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.GetDailyPuzzleArchive
+ */
+export const getDailyPuzzleArchive = PuzzleService.method.getDailyPuzzleArchive;
+
+/**
+ * Schedules a puzzle as a future daily puzzle. Days without a scheduled
+ * puzzle get the best voted puzzle that hasn't been a daily puzzle yet.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.SetDailyPuzzle
+ */
+export const setDailyPuzzle = PuzzleService.method.setDailyPuzzle;
+
+/**
+ * Puzzle rush: solve as many puzzles as possible before time runs out or
+ * the user makes too many mistakes. Puzzles get harder as the score goes
+ * up, and rush puzzles do not change any ratings.
+ *
+ * @generated from rpc puzzle_service.PuzzleService.StartPuzzleRush
+ */
+export const startPuzzleRush = PuzzleService.method.startPuzzleRush;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.GetPuzzleRush
+ */
+export const getPuzzleRush = PuzzleService.method.getPuzzleRush;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.SubmitPuzzleRushAnswer
+ */
+export const submitPuzzleRushAnswer = PuzzleService.method.submitPuzzleRushAnswer;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.EndPuzzleRush
+ */
+export const endPuzzleRush = PuzzleService.method.endPuzzleRush;
+
+/**
+ * @generated from rpc puzzle_service.PuzzleService.GetPuzzleRushLeaderboard
+ */
+export const getPuzzleRushLeaderboard = PuzzleService.method.getPuzzleRushLeaderboard;
@@ -4,7 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { GameEvent, GameHistory, PuzzleGenerationRequest } from "../vendored/macondo/macondo_pb";
+import type { GameEvent, GameHistory, PuzzleGenerationRequest, PuzzleTag } from "../vendored/macondo/macondo_pb";
 import { file_proto_vendored_macondo_macondo } from "../vendored/macondo/macondo_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/puzzle_service/puzzle_service.proto.
  */
 export const file_proto_puzzle_service_puzzle_service: GenFile = /*@__PURE__*/
-  fileDesc("Cilwcm90by9wdXp6bGVfc2VydmljZS9wdXp6bGVfc2VydmljZS5wcm90bxIOcHV6emxlX3NlcnZpY2UiJwoUU3RhcnRQdXp6bGVJZFJlcXVlc3QSDwoHbGV4aWNvbhgBIAEoCSJjChVTdGFydFB1enpsZUlkUmVzcG9uc2USEQoJcHV6emxlX2lkGAEgASgJEjcKDHF1ZXJ5X3Jlc3VsdBgCIAEoDjIhLnB1enpsZV9zZXJ2aWNlLlB1enpsZVF1ZXJ5UmVzdWx0IiYKE05leHRQdXp6bGVJZFJlcXVlc3QSDwoHbGV4aWNvbhgBIAEoCSJiChROZXh0UHV6emxlSWRSZXNwb25zZRIRCglwdXp6bGVfaWQYASABKAkSNwoMcXVlcnlfcmVzdWx0GAIgASgOMiEucHV6emxlX3NlcnZpY2UuUHV6emxlUXVlcnlSZXN1bHQiMwogTmV4dENsb3Nlc3RSYXRpbmdQdXp6bGVJZFJlcXVlc3QSDwoHbGV4aWNvbhgBIAEoCSJvCiFOZXh0Q2xvc2VzdFJhdGluZ1B1enpsZUlkUmVzcG9uc2USEQoJcHV6emxlX2lkGAEgASgJEjcKDHF1ZXJ5X3Jlc3VsdBgCIAEoDjIhLnB1enpsZV9zZXJ2aWNlLlB1enpsZVF1ZXJ5UmVzdWx0IiIKDVB1enpsZVJlcXVlc3QSEQoJcHV6emxlX2lkGAEgASgJItkCCg5BbnN3ZXJSZXNwb25zZRIqCg5jb3JyZWN0X2Fuc3dlchgBIAEoCzISLm1hY29uZG8uR2FtZUV2ZW50EiwKBnN0YXR1cxgCIAEoDjIcLnB1enpsZV9zZXJ2aWNlLlB1enpsZVN0YXR1cxIQCghhdHRlbXB0cxgDIAEoBRIPCgdnYW1lX2lkGAQgASgJEhMKC3R1cm5fbnVtYmVyGAUgASgFEhIKCmFmdGVyX3RleHQYBiABKAkSFwoPbmV3X3VzZXJfcmF0aW5nGAcgASgFEhkKEW5ld19wdXp6bGVfcmF0aW5nGAggASgFEjYKEmZpcnN0X2F0dGVtcHRfdGltZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoRbGFzdF9hdHRlbXB0X3RpbWUYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInwKDlB1enpsZVJlc3BvbnNlEiUKB2hpc3RvcnkYASABKAsyFC5tYWNvbmRvLkdhbWVIaXN0b3J5EhMKC2JlZm9yZV90ZXh0GAIgASgJEi4KBmFuc3dlchgDIAEoCzIeLnB1enpsZV9zZXJ2aWNlLkFuc3dlclJlc3BvbnNlImcKEVN1Ym1pc3Npb25SZXF1ZXN0EhEKCXB1enpsZV9pZBgBIAEoCRIoCgZhbnN3ZXIYAiABKAsyGC5pcGMuQ2xpZW50R2FtZXBsYXlFdmVudBIVCg1zaG93X3NvbHV0aW9uGAMgASgIIl0KElN1Ym1pc3Npb25SZXNwb25zZRIXCg91c2VyX2lzX2NvcnJlY3QYASABKAgSLgoGYW5zd2VyGAIgASgLMh4ucHV6emxlX3NlcnZpY2UuQW5zd2VyUmVzcG9uc2UiKgoVUHJldmlvdXNQdXp6bGVSZXF1ZXN0EhEKCXB1enpsZV9pZBgBIAEoCSIrChZQcmV2aW91c1B1enpsZVJlc3BvbnNlEhEKCXB1enpsZV9pZBgBIAEoCSI0ChFQdXp6bGVWb3RlUmVxdWVzdBIRCglwdXp6bGVfaWQYASABKAkSDAoEdm90ZRgCIAEoBSIUChJQdXp6bGVWb3RlUmVzcG9uc2UizgIKGlB1enpsZUdlbmVyYXRpb25Kb2JSZXF1ZXN0EhIKCmJvdF92c19ib3QYASABKAgSDwoHbGV4aWNvbhgCIAEoCRIbChNsZXR0ZXJfZGlzdHJpYnV0aW9uGAMgASgJEhYKCnNxbF9vZmZzZXQYBCABKAVCAhgBEiAKGGdhbWVfY29uc2lkZXJhdGlvbl9saW1pdBgFIAEoBRIbChNnYW1lX2NyZWF0aW9uX2xpbWl0GAYgASgFEjEKB3JlcXVlc3QYByABKAsyIC5tYWNvbmRvLlB1enpsZUdlbmVyYXRpb25SZXF1ZXN0EhIKCnN0YXJ0X2RhdGUYCCABKAkSHwoXZXF1aXR5X2xvc3NfdG90YWxfbGltaXQYCSABKA0SFwoPYXZvaWRfYm90X2dhbWVzGAogASgIEhYKDmRheXNfcGVyX2NodW5rGAsgASgNIjEKHkFQSVB1enpsZUdlbmVyYXRpb25Kb2JSZXNwb25zZRIPCgdzdGFydGVkGAEgASgIInAKHUFQSVB1enpsZUdlbmVyYXRpb25Kb2JSZXF1ZXN0EjsKB3JlcXVlc3QYASABKAsyKi5wdXp6bGVfc2VydmljZS5QdXp6bGVHZW5lcmF0aW9uSm9iUmVxdWVzdBISCgpzZWNyZXRfa2V5GAIgASgJIjUKFFB1enpsZUpvYkxvZ3NSZXF1ZXN0Eg4KBm9mZnNldBgBIAEoBRINCgVsaW1pdBgCIAEoBSLiAQoMUHV6emxlSm9iTG9nEgoKAmlkGAEgASgDEjsKB3JlcXVlc3QYAiABKAsyKi5wdXp6bGVfc2VydmljZS5QdXp6bGVHZW5lcmF0aW9uSm9iUmVxdWVzdBIRCglmdWxmaWxsZWQYAyABKAgSFAoMZXJyb3Jfc3RhdHVzGAQgASgJEi4KCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGNvbXBsZXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiQwoVUHV6emxlSm9iTG9nc1Jlc3BvbnNlEioKBGxvZ3MYASADKAsyHC5wdXp6bGVfc2VydmljZS5QdXp6bGVKb2JMb2cqYgoRUHV6emxlUXVlcnlSZXN1bHQSCgoGVU5TRUVOEAASCwoHVU5SQVRFRBABEg4KClVORklOSVNIRUQQAhINCglFWEhBVVNURUQQAxIKCgZSQU5ET00QBBIJCgVTVEFSVBAFKjoKDFB1enpsZVN0YXR1cxIOCgpVTkFOU1dFUkVEEAASCwoHQ09SUkVDVBABEg0KCUlOQ09SUkVDVBACMtwHCg1QdXp6bGVTZXJ2aWNlEl8KEEdldFN0YXJ0UHV6emxlSWQSJC5wdXp6bGVfc2VydmljZS5TdGFydFB1enpsZUlkUmVxdWVzdBolLnB1enpsZV9zZXJ2aWNlLlN0YXJ0UHV6emxlSWRSZXNwb25zZRJcCg9HZXROZXh0UHV6emxlSWQSIy5wdXp6bGVfc2VydmljZS5OZXh0UHV6emxlSWRSZXF1ZXN0GiQucHV6emxlX3NlcnZpY2UuTmV4dFB1enpsZUlkUmVzcG9uc2USgwEKHEdldE5leHRDbG9zZXN0UmF0aW5nUHV6emxlSWQSMC5wdXp6bGVfc2VydmljZS5OZXh0Q2xvc2VzdFJhdGluZ1B1enpsZUlkUmVxdWVzdBoxLnB1enpsZV9zZXJ2aWNlLk5leHRDbG9zZXN0UmF0aW5nUHV6emxlSWRSZXNwb25zZRJKCglHZXRQdXp6bGUSHS5wdXp6bGVfc2VydmljZS5QdXp6bGVSZXF1ZXN0Gh4ucHV6emxlX3NlcnZpY2UuUHV6emxlUmVzcG9uc2USVQoMU3VibWl0QW5zd2VyEiEucHV6emxlX3NlcnZpY2UuU3VibWlzc2lvblJlcXVlc3QaIi5wdXp6bGVfc2VydmljZS5TdWJtaXNzaW9uUmVzcG9uc2USUAoPR2V0UHV6emxlQW5zd2VyEh0ucHV6emxlX3NlcnZpY2UuUHV6emxlUmVxdWVzdBoeLnB1enpsZV9zZXJ2aWNlLkFuc3dlclJlc3BvbnNlEmQKE0dldFByZXZpb3VzUHV6emxlSWQSJS5wdXp6bGVfc2VydmljZS5QcmV2aW91c1B1enpsZVJlcXVlc3QaJi5wdXp6bGVfc2VydmljZS5QcmV2aW91c1B1enpsZVJlc3BvbnNlElYKDVNldFB1enpsZVZvdGUSIS5wdXp6bGVfc2VydmljZS5QdXp6bGVWb3RlUmVxdWVzdBoiLnB1enpsZV9zZXJ2aWNlLlB1enpsZVZvdGVSZXNwb25zZRJyChFTdGFydFB1enpsZUdlbkpvYhItLnB1enpsZV9zZXJ2aWNlLkFQSVB1enpsZUdlbmVyYXRpb25Kb2JSZXF1ZXN0Gi4ucHV6emxlX3NlcnZpY2UuQVBJUHV6emxlR2VuZXJhdGlvbkpvYlJlc3BvbnNlEl8KEEdldFB1enpsZUpvYkxvZ3MSJC5wdXp6bGVfc2VydmljZS5QdXp6bGVKb2JMb2dzUmVxdWVzdBolLnB1enpsZV9zZXJ2aWNlLlB1enpsZUpvYkxvZ3NSZXNwb25zZUK4AQoSY29tLnB1enpsZV9zZXJ2aWNlQhJQdXp6bGVTZXJ2aWNlUHJvdG9QAVo6Z2l0aHViLmNvbS93b29nbGVzLWlvL2xpd29yZHMvcnBjL2FwaS9wcm90by9wdXp6bGVfc2VydmljZaICA1BYWKoCDVB1enpsZVNlcnZpY2XKAg1QdXp6bGVTZXJ2aWNl4gIZUHV6emxlU2VydmljZVxHUEJNZXRhZGF0YeoCDVB1enpsZVNlcnZpY2ViBnByb3RvMw", [file_proto_vendored_macondo_macondo, file_google_protobuf_timestamp, file_proto_ipc_omgwords]);
+  fileDesc("Cilwcm90by9wdXp6bGVfc2VydmljZS9wdXp6bGVfc2VydmljZS5wcm90bxIOcHV6emxlX3NlcnZpY2UiSQoUU3RhcnRQdXp6bGVJZFJlcXVlc3QSDwoHbGV4aWNvbhgBIAEoCRIgCgR0YWdzGAIgAygOMhIubWFjb25kby5QdXp6bGVUYWciYwoVU3RhcnRQdXp6bGVJZFJlc3BvbnNlEhEKCXB1enpsZV9pZBgBIAEoCRI3CgxxdWVyeV9yZXN1bHQYAiABKA4yIS5wdXp6bGVfc2VydmljZS5QdXp6bGVRdWVyeVJlc3VsdCJIChNOZXh0UHV6emxlSWRSZXF1ZXN0Eg8KB2xleGljb24YASABKAkSIAoEdGFncxgCIAMoDjISLm1hY29uZG8uUHV6emxlVGFnImIKFE5leHRQdXp6bGVJZFJlc3BvbnNlEhEKCXB1enpsZV9pZBgBIAEoCRI3CgxxdWVyeV9yZXN1bHQYAiABKA4yIS5wdXp6bGVfc2VydmljZS5QdXp6bGVRdWVyeVJlc3VsdCJVCiBOZXh0Q2xvc2VzdFJhdGluZ1B1enpsZUlkUmVxdWVzdBIPCgdsZXhpY29uGAEgASgJEiAKBHRhZ3MYAiADKA4yEi5tYWNvbmRvLlB1enpsZVRhZyJvCiFOZXh0Q2xvc2VzdFJhdGluZ1B1enpsZUlkUmVzcG9uc2USEQoJcHV6emxlX2lkGAEgASgJEjcKDHF1ZXJ5X3Jlc3VsdBgCIAEoDjIhLnB1enpsZV9zZXJ2aWNlLlB1enpsZVF1ZXJ5UmVzdWx0IiIKDVB1enpsZVJlcXVlc3QSEQoJcHV6emxlX2lkGAEgASgJItkCCg5BbnN3ZXJSZXNwb25zZRIqCg5jb3JyZWN0X2Fuc3dlchgBIAEoCzISLm1hY29uZG8uR2FtZUV2ZW50EiwKBnN0YXR1cxgCIAEoDjIcLnB1enpsZV9zZXJ2aWNlLlB1enpsZVN0YXR1cxIQCghhdHRlbXB0cxgDIAEoBRIPCgdnYW1lX2lkGAQgASgJEhMKC3R1cm5fbnVtYmVyGAUgASgFEhIKCmFmdGVyX3RleHQYBiABKAkSFwoPbmV3X3VzZXJfcmF0aW5nGAcgASgFEhkKEW5ld19wdXp6bGVfcmF0aW5nGAggASgFEjYKEmZpcnN0X2F0dGVtcHRfdGltZRgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoRbGFzdF9hdHRlbXB0X3RpbWUYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wInwKDlB1enpsZVJlc3BvbnNlEiUKB2hpc3RvcnkYASABKAsyFC5tYWNvbmRvLkdhbWVIaXN0b3J5EhMKC2JlZm9yZV90ZXh0GAIgASgJEi4KBmFuc3dlchgDIAEoCzIeLnB1enpsZV9zZXJ2aWNlLkFuc3dlclJlc3BvbnNlImcKEVN1Ym1pc3Npb25SZXF1ZXN0EhEKCXB1enpsZV9pZBgBIAEoCRIoCgZhbnN3ZXIYAiABKAsyGC5pcGMuQ2xpZW50R2FtZXBsYXlFdmVudBIVCg1zaG93X3NvbHV0aW9uGAMgASgIIl0KElN1Ym1pc3Npb25SZXNwb25zZRIXCg91c2VyX2lzX2NvcnJlY3QYASABKAgSLgoGYW5zd2VyGAIgASgLMh4ucHV6emxlX3NlcnZpY2UuQW5zd2VyUmVzcG9uc2UiKgoVUHJldmlvdXNQdXp6bGVSZXF1ZXN0EhEKCXB1enpsZV9pZBgBIAEoCSIrChZQcmV2aW91c1B1enpsZVJlc3BvbnNlEhEKCXB1enpsZV9pZBgBIAEoCSI0ChFQdXp6bGVWb3RlUmVxdWVzdBIRCglwdXp6bGVfaWQYASABKAkSDAoEdm90ZRgCIAEoBSIUChJQdXp6bGVWb3RlUmVzcG9uc2UilQMKGlB1enpsZUdlbmVyYXRpb25Kb2JSZXF1ZXN0EhIKCmJvdF92c19ib3QYASABKAgSDwoHbGV4aWNvbhgCIAEoCRIbChNsZXR0ZXJfZGlzdHJpYnV0aW9uGAMgASgJEhYKCnNxbF9vZmZzZXQYBCABKAVCAhgBEiAKGGdhbWVfY29uc2lkZXJhdGlvbl9saW1pdBgFIAEoBRIbChNnYW1lX2NyZWF0aW9uX2xpbWl0GAYgASgFEjEKB3JlcXVlc3QYByABKAsyIC5tYWNvbmRvLlB1enpsZUdlbmVyYXRpb25SZXF1ZXN0EhIKCnN0YXJ0X2RhdGUYCCABKAkSHwoXZXF1aXR5X2xvc3NfdG90YWxfbGltaXQYCSABKA0SFwoPYXZvaWRfYm90X2dhbWVzGAogASgIEhYKDmRheXNfcGVyX2NodW5rGAsgASgNEhoKEmFubm90YXRlZF9nYW1lX2lkcxgMIAMoCRIRCglhdXRob3JfaWQYDSABKAkSFgoObmVlZHNfYXBwcm92YWwYDiABKAgiMQoeQVBJUHV6emxlR2VuZXJhdGlvbkpvYlJlc3BvbnNlEg8KB3N0YXJ0ZWQYASABKAgicAodQVBJUHV6emxlR2VuZXJhdGlvbkpvYlJlcXVlc3QSOwoHcmVxdWVzdBgBIAEoCzIqLnB1enpsZV9zZXJ2aWNlLlB1enpsZUdlbmVyYXRpb25Kb2JSZXF1ZXN0EhIKCnNlY3JldF9rZXkYAiABKAkiNQoUUHV6emxlSm9iTG9nc1JlcXVlc3QSDgoGb2Zmc2V0GAEgASgFEg0KBWxpbWl0GAIgASgFIuIBCgxQdXp6bGVKb2JMb2cSCgoCaWQYASABKAMSOwoHcmVxdWVzdBgCIAEoCzIqLnB1enpsZV9zZXJ2aWNlLlB1enpsZUdlbmVyYXRpb25Kb2JSZXF1ZXN0EhEKCWZ1bGZpbGxlZBgDIAEoCBIUCgxlcnJvcl9zdGF0dXMYBCABKAkSLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMY29tcGxldGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJDChVQdXp6bGVKb2JMb2dzUmVzcG9uc2USKgoEbG9ncxgBIAMoCzIcLnB1enpsZV9zZXJ2aWNlLlB1enpsZUpvYkxvZyK/AQoMUHV6emxlUmV2aWV3EhEKCXB1enpsZV9pZBgBIAEoCRIqCgZkdWVfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWludGVydmFsX2RheXMYAyABKAUSEwoLcmVwZXRpdGlvbnMYBCABKAUSDgoGbGFwc2VzGAUgASgFEjQKEGxhc3RfcmV2aWV3ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjQKElJldmlld1F1ZXVlUmVxdWVzdBIPCgdsZXhpY29uGAEgASgJEg0KBWxpbWl0GAIgASgFImwKE1Jldmlld1F1ZXVlUmVzcG9uc2USLQoHcmV2aWV3cxgBIAMoCzIcLnB1enpsZV9zZXJ2aWNlLlB1enpsZVJldmlldxIRCglkdWVfY291bnQYAiABKAUSEwoLdG90YWxfY291bnQYAyABKAUiNAoTU3R1ZHlTZXNzaW9uUmVxdWVzdBIPCgdsZXhpY29uGAEgASgJEgwKBHNpemUYAiABKAUibgoUU3R1ZHlTZXNzaW9uUmVzcG9uc2USEgoKcHV6emxlX2lkcxgBIAMoCRIRCglkdWVfY291bnQYAiABKAUSLwoLbmV4dF9kdWVfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoQBChdSZXZpZXdTdWJtaXNzaW9uUmVxdWVzdBIRCglwdXp6bGVfaWQYASABKAkSKAoGYW5zd2VyGAIgASgLMhguaXBjLkNsaWVudEdhbWVwbGF5RXZlbnQSFQoNc2Vjb25kc190YWtlbhgDIAEoBRIVCg1zaG93X3NvbHV0aW9uGAQgASgIIo0BChhSZXZpZXdTdWJtaXNzaW9uUmVzcG9uc2USFwoPdXNlcl9pc19jb3JyZWN0GAEgASgIEioKDmNvcnJlY3RfYW5zd2VyGAIgASgLMhIubWFjb25kby5HYW1lRXZlbnQSLAoGcmV2aWV3GAMgASgLMhwucHV6emxlX3NlcnZpY2UuUHV6emxlUmV2aWV3Ih8KHVJlbW92ZUZyb21SZXZpZXdRdWV1ZVJlc3BvbnNlIlEKDlB1enpsZVNldEVudHJ5EhEKCXB1enpsZV9pZBgBIAEoCRIsCgZzdGF0dXMYAiABKA4yHC5wdXp6bGVfc2VydmljZS5QdXp6bGVTdGF0dXMi2AEKCVB1enpsZVNldBIOCgZzZXRfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDwoHbGV4aWNvbhgEIAEoCRIPCgdjcmVhdG9yGAUgASgJEhQKDHB1enpsZV9jb3VudBgGIAEoBRIvCgdwdXp6bGVzGAcgAygLMh4ucHV6emxlX3NlcnZpY2UuUHV6emxlU2V0RW50cnkSLgoKdXBkYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiYQoWQ3JlYXRlUHV6emxlU2V0UmVxdWVzdBINCgV0aXRsZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIPCgdsZXhpY29uGAMgASgJEhIKCnB1enpsZV9pZHMYBCADKAkiYAoWVXBkYXRlUHV6emxlU2V0UmVxdWVzdBIOCgZzZXRfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSEgoKcHV6emxlX2lkcxgEIAMoCSIiChBQdXp6bGVTZXRSZXF1ZXN0Eg4KBnNldF9pZBgBIAEoCSIkChFQdXp6bGVTZXRzUmVxdWVzdBIPCgdsZXhpY29uGAEgASgJIkIKEVB1enpsZVNldFJlc3BvbnNlEi0KCnB1enpsZV9zZXQYASABKAsyGS5wdXp6bGVfc2VydmljZS5QdXp6bGVTZXQiRAoSUHV6emxlU2V0c1Jlc3BvbnNlEi4KC3B1enpsZV9zZXRzGAEgAygLMhkucHV6emxlX3NlcnZpY2UuUHV6emxlU2V0IhkKF0RlbGV0ZVB1enpsZVNldFJlc3BvbnNlIioKF1B1enpsZVRhZ1JhdGluZ3NSZXF1ZXN0Eg8KB2xleGljb24YASABKAkiXAoPUHV6emxlVGFnUmF0aW5nEh8KA3RhZxgBIAEoDjISLm1hY29uZG8uUHV6emxlVGFnEg4KBnJhdGluZxgCIAEoBRIYChByYXRpbmdfZGV2aWF0aW9uGAMgASgFIkwKGFB1enpsZVRhZ1JhdGluZ3NSZXNwb25zZRIwCgdyYXRpbmdzGAEgAygLMh8ucHV6emxlX3NlcnZpY2UuUHV6emxlVGFnUmF0aW5nInsKHFF1ZXVlUHV6emxlR2VuZXJhdGlvblJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIXCg9jb2xsZWN0aW9uX3V1aWQYAiABKAkSMQoHcmVxdWVzdBgDIAEoCzIgLm1hY29uZG8uUHV6emxlR2VuZXJhdGlvblJlcXVlc3QiQgodUXVldWVQdXp6bGVHZW5lcmF0aW9uUmVzcG9uc2USDgoGam9iX2lkGAEgASgDEhEKCW51bV9nYW1lcxgCIAEoBSIzCiFBcHByb3ZlUHV6emxlR2VuZXJhdGlvbkpvYlJlcXVlc3QSDgoGam9iX2lkGAEgASgDIjkKIkFwcHJvdmVQdXp6bGVHZW5lcmF0aW9uSm9iUmVzcG9uc2USEwoLbnVtX3B1enpsZXMYASABKAUiJQoSRGFpbHlQdXp6bGVSZXF1ZXN0Eg8KB2xleGljb24YASABKAkiNwoWRGFpbHlQdXp6bGVXcm9uZ0Fuc3dlchIOCgZhbnN3ZXIYASABKAkSDQoFdGltZXMYAiABKAUirAEKEERhaWx5UHV6emxlU3RhdHMSDwoHYW5zd2VycxgBIAEoBRIOCgZzb2x2ZXMYAiABKAUSEgoKc29sdmVfcmF0ZRgDIAEoARIdChVhdmVyYWdlX3NvbHZlX3NlY29uZHMYBCABKAESRAoUY29tbW9uX3dyb25nX2Fuc3dlcnMYBSADKAsyJi5wdXp6bGVfc2VydmljZS5EYWlseVB1enpsZVdyb25nQW5zd2VyIjIKEURhaWx5UHV6emxlU3RyZWFrEg8KB2N1cnJlbnQYASABKAUSDAoEYmVzdBgCIAEoBSKwAgoTRGFpbHlQdXp6bGVSZXNwb25zZRIMCgRkYXRlGAEgASgJEhEKCXB1enpsZV9pZBgCIAEoCRIlCgdoaXN0b3J5GAMgASgLMhQubWFjb25kby5HYW1lSGlzdG9yeRITCgtiZWZvcmVfdGV4dBgEIAEoCRIsCgZzdGF0dXMYBSABKA4yHC5wdXp6bGVfc2VydmljZS5QdXp6bGVTdGF0dXMSKgoOY29ycmVjdF9hbnN3ZXIYBiABKAsyEi5tYWNvbmRvLkdhbWVFdmVudBIvCgVzdGF0cxgHIAEoCzIgLnB1enpsZV9zZXJ2aWNlLkRhaWx5UHV6emxlU3RhdHMSMQoGc3RyZWFrGAggASgLMiEucHV6emxlX3NlcnZpY2UuRGFpbHlQdXp6bGVTdHJlYWsiVQoYRGFpbHlQdXp6bGVBbnN3ZXJSZXF1ZXN0Eg8KB2xleGljb24YASABKAkSKAoGYW5zd2VyGAIgASgLMhguaXBjLkNsaWVudEdhbWVwbGF5RXZlbnQixAEKGURhaWx5UHV6emxlQW5zd2VyUmVzcG9uc2USFwoPdXNlcl9pc19jb3JyZWN0GAEgASgIEioKDmNvcnJlY3RfYW5zd2VyGAIgASgLMhIubWFjb25kby5HYW1lRXZlbnQSLwoFc3RhdHMYAyABKAsyIC5wdXp6bGVfc2VydmljZS5EYWlseVB1enpsZVN0YXRzEjEKBnN0cmVhaxgEIAEoCzIhLnB1enpsZV9zZXJ2aWNlLkRhaWx5UHV6emxlU3RyZWFrIksKGURhaWx5UHV6emxlQXJjaGl2ZVJlcXVlc3QSDwoHbGV4aWNvbhgBIAEoCRINCgVsaW1pdBgCIAEoBRIOCgZvZmZzZXQYAyABKAUiiQEKF0RhaWx5UHV6emxlQXJjaGl2ZUVudHJ5EgwKBGRhdGUYASABKAkSEQoJcHV6emxlX2lkGAIgASgJEg8KB2Fuc3dlcnMYAyABKAUSDgoGc29sdmVzGAQgASgFEiwKBnN0YXR1cxgFIAEoDjIcLnB1enpsZV9zZXJ2aWNlLlB1enpsZVN0YXR1cyJWChpEYWlseVB1enpsZUFyY2hpdmVSZXNwb25zZRI4CgdwdXp6bGVzGAEgAygLMicucHV6emxlX3NlcnZpY2UuRGFpbHlQdXp6bGVBcmNoaXZlRW50cnkiSQoVU2V0RGFpbHlQdXp6bGVSZXF1ZXN0Eg8KB2xleGljb24YASABKAkSDAoEZGF0ZRgCIAEoCRIRCglwdXp6bGVfaWQYAyABKAkiGAoWU2V0RGFpbHlQdXp6bGVSZXNwb25zZSK6AgoPUHV6emxlUnVzaFN0YXRlEhIKCnNlc3Npb25faWQYASABKAkSDwoHbGV4aWNvbhgCIAEoCRIPCgdtaW51dGVzGAMgASgFEi4KCnN0YXJ0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKB2VuZHNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBXNjb3JlGAYgASgFEg8KB3N0cmlrZXMYByABKAUSEwoLbWF4X3N0cmlrZXMYCCABKAUSEAoIZmluaXNoZWQYCSABKAgSEQoJcHV6emxlX2lkGAogASgJEiUKB2hpc3RvcnkYCyABKAsyFC5tYWNvbmRvLkdhbWVIaXN0b3J5EhMKC2JlZm9yZV90ZXh0GAwgASgJIjoKFlN0YXJ0UHV6emxlUnVzaFJlcXVlc3QSDwoHbGV4aWNvbhgBIAEoCRIPCgdtaW51dGVzGAIgASgFIicKEVB1enpsZVJ1c2hSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkiRAoSUHV6emxlUnVzaFJlc3BvbnNlEi4KBXN0YXRlGAEgASgLMh8ucHV6emxlX3NlcnZpY2UuUHV6emxlUnVzaFN0YXRlIlcKF1B1enpsZVJ1c2hBbnN3ZXJSZXF1ZXN0EhIKCnNlc3Npb25faWQYASABKAkSKAoGYW5zd2VyGAIgASgLMhguaXBjLkNsaWVudEdhbWVwbGF5RXZlbnQijwEKGFB1enpsZVJ1c2hBbnN3ZXJSZXNwb25zZRIXCg91c2VyX2lzX2NvcnJlY3QYASABKAgSKgoOY29ycmVjdF9hbnN3ZXIYAiABKAsyEi5tYWNvbmRvLkdhbWVFdmVudBIuCgVzdGF0ZRgDIAEoCzIfLnB1enpsZV9zZXJ2aWNlLlB1enpsZVJ1c2hTdGF0ZSKBAQocUHV6emxlUnVzaExlYWRlcmJvYXJkUmVxdWVzdBIPCgdsZXhpY29uGAEgASgJEg8KB21pbnV0ZXMYAiABKAUSMAoGcGVyaW9kGAMgASgOMiAucHV6emxlX3NlcnZpY2UuUHV6emxlUnVzaFBlcmlvZBINCgVsaW1pdBgEIAEoBSJ7ChpQdXp6bGVSdXNoTGVhZGVyYm9hcmRFbnRyeRIMCgRyYW5rGAEgASgFEhAKCHVzZXJuYW1lGAIgASgJEg0KBXNjb3JlGAMgASgFEi4KCnN0YXJ0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIlwKHVB1enpsZVJ1c2hMZWFkZXJib2FyZFJlc3BvbnNlEjsKB2VudHJpZXMYASADKAsyKi5wdXp6bGVfc2VydmljZS5QdXp6bGVSdXNoTGVhZGVyYm9hcmRFbnRyeSpiChFQdXp6bGVRdWVyeVJlc3VsdBIKCgZVTlNFRU4QABILCgdVTlJBVEVEEAESDgoKVU5GSU5JU0hFRBACEg0KCUVYSEFVU1RFRBADEgoKBlJBTkRPTRAEEgkKBVNUQVJUEAUqOgoMUHV6emxlU3RhdHVzEg4KClVOQU5TV0VSRUQQABILCgdDT1JSRUNUEAESDQoJSU5DT1JSRUNUEAIqNwoQUHV6emxlUnVzaFBlcmlvZBIJCgVEQUlMWRAAEgoKBldFRUtMWRABEgwKCEFMTF9USU1FEAIynxgKDVB1enpsZVNlcnZpY2USXwoQR2V0U3RhcnRQdXp6bGVJZBIkLnB1enpsZV9zZXJ2aWNlLlN0YXJ0UHV6emxlSWRSZXF1ZXN0GiUucHV6emxlX3NlcnZpY2UuU3RhcnRQdXp6bGVJZFJlc3BvbnNlElwKD0dldE5leHRQdXp6bGVJZBIjLnB1enpsZV9zZXJ2aWNlLk5leHRQdXp6bGVJZFJlcXVlc3QaJC5wdXp6bGVfc2VydmljZS5OZXh0UHV6emxlSWRSZXNwb25zZRKDAQocR2V0TmV4dENsb3Nlc3RSYXRpbmdQdXp6bGVJZBIwLnB1enpsZV9zZXJ2aWNlLk5leHRDbG9zZXN0UmF0aW5nUHV6emxlSWRSZXF1ZXN0GjEucHV6emxlX3NlcnZpY2UuTmV4dENsb3Nlc3RSYXRpbmdQdXp6bGVJZFJlc3BvbnNlEkoKCUdldFB1enpsZRIdLnB1enpsZV9zZXJ2aWNlLlB1enpsZVJlcXVlc3QaHi5wdXp6bGVfc2VydmljZS5QdXp6bGVSZXNwb25zZRJVCgxTdWJtaXRBbnN3ZXISIS5wdXp6bGVfc2VydmljZS5TdWJtaXNzaW9uUmVxdWVzdBoiLnB1enpsZV9zZXJ2aWNlLlN1Ym1pc3Npb25SZXNwb25zZRJQCg9HZXRQdXp6bGVBbnN3ZXISHS5wdXp6bGVfc2VydmljZS5QdXp6bGVSZXF1ZXN0Gh4ucHV6emxlX3NlcnZpY2UuQW5zd2VyUmVzcG9uc2USZAoTR2V0UHJldmlvdXNQdXp6bGVJZBIlLnB1enpsZV9zZXJ2aWNlLlByZXZpb3VzUHV6emxlUmVxdWVzdBomLnB1enpsZV9zZXJ2aWNlLlByZXZpb3VzUHV6emxlUmVzcG9uc2USVgoNU2V0UHV6emxlVm90ZRIhLnB1enpsZV9zZXJ2aWNlLlB1enpsZVZvdGVSZXF1ZXN0GiIucHV6emxlX3NlcnZpY2UuUHV6emxlVm90ZVJlc3BvbnNlEnIKEVN0YXJ0UHV6emxlR2VuSm9iEi0ucHV6emxlX3NlcnZpY2UuQVBJUHV6emxlR2VuZXJhdGlvbkpvYlJlcXVlc3QaLi5wdXp6bGVfc2VydmljZS5BUElQdXp6bGVHZW5lcmF0aW9uSm9iUmVzcG9uc2USXwoQR2V0UHV6emxlSm9iTG9ncxIkLnB1enpsZV9zZXJ2aWNlLlB1enpsZUpvYkxvZ3NSZXF1ZXN0GiUucHV6emxlX3NlcnZpY2UuUHV6emxlSm9iTG9nc1Jlc3BvbnNlEnQKFVF1ZXVlUHV6emxlR2VuZXJhdGlvbhIsLnB1enpsZV9zZXJ2aWNlLlF1ZXVlUHV6emxlR2VuZXJhdGlvblJlcXVlc3QaLS5wdXp6bGVfc2VydmljZS5RdWV1ZVB1enpsZUdlbmVyYXRpb25SZXNwb25zZRKDAQoaQXBwcm92ZVB1enpsZUdlbmVyYXRpb25Kb2ISMS5wdXp6bGVfc2VydmljZS5BcHByb3ZlUHV6emxlR2VuZXJhdGlvbkpvYlJlcXVlc3QaMi5wdXp6bGVfc2VydmljZS5BcHByb3ZlUHV6emxlR2VuZXJhdGlvbkpvYlJlc3BvbnNlElkKDkdldFJldmlld1F1ZXVlEiIucHV6emxlX3NlcnZpY2UuUmV2aWV3UXVldWVSZXF1ZXN0GiMucHV6emxlX3NlcnZpY2UuUmV2aWV3UXVldWVSZXNwb25zZRJeChFTdGFydFN0dWR5U2Vzc2lvbhIjLnB1enpsZV9zZXJ2aWNlLlN0dWR5U2Vzc2lvblJlcXVlc3QaJC5wdXp6bGVfc2VydmljZS5TdHVkeVNlc3Npb25SZXNwb25zZRJhCgxTdWJtaXRSZXZpZXcSJy5wdXp6bGVfc2VydmljZS5SZXZpZXdTdWJtaXNzaW9uUmVxdWVzdBooLnB1enpsZV9zZXJ2aWNlLlJldmlld1N1Ym1pc3Npb25SZXNwb25zZRJlChVSZW1vdmVGcm9tUmV2aWV3UXVldWUSHS5wdXp6bGVfc2VydmljZS5QdXp6bGVSZXF1ZXN0Gi0ucHV6emxlX3NlcnZpY2UuUmVtb3ZlRnJvbVJldmlld1F1ZXVlUmVzcG9uc2USXAoPQ3JlYXRlUHV6emxlU2V0EiYucHV6emxlX3NlcnZpY2UuQ3JlYXRlUHV6emxlU2V0UmVxdWVzdBohLnB1enpsZV9zZXJ2aWNlLlB1enpsZVNldFJlc3BvbnNlElwKD1VwZGF0ZVB1enpsZVNldBImLnB1enpsZV9zZXJ2aWNlLlVwZGF0ZVB1enpsZVNldFJlcXVlc3QaIS5wdXp6bGVfc2VydmljZS5QdXp6bGVTZXRSZXNwb25zZRJcCg9EZWxldGVQdXp6bGVTZXQSIC5wdXp6bGVfc2VydmljZS5QdXp6bGVTZXRSZXF1ZXN0GicucHV6emxlX3NlcnZpY2UuRGVsZXRlUHV6emxlU2V0UmVzcG9uc2USVgoNR2V0UHV6emxlU2V0cxIhLnB1enpsZV9zZXJ2aWNlLlB1enpsZVNldHNSZXF1ZXN0GiIucHV6emxlX3NlcnZpY2UuUHV6emxlU2V0c1Jlc3BvbnNlElMKDEdldFB1enpsZVNldBIgLnB1enpsZV9zZXJ2aWNlLlB1enpsZVNldFJlcXVlc3QaIS5wdXp6bGVfc2VydmljZS5QdXp6bGVTZXRSZXNwb25zZRJoChNHZXRQdXp6bGVUYWdSYXRpbmdzEicucHV6emxlX3NlcnZpY2UuUHV6emxlVGFnUmF0aW5nc1JlcXVlc3QaKC5wdXp6bGVfc2VydmljZS5QdXp6bGVUYWdSYXRpbmdzUmVzcG9uc2USWQoOR2V0RGFpbHlQdXp6bGUSIi5wdXp6bGVfc2VydmljZS5EYWlseVB1enpsZVJlcXVlc3QaIy5wdXp6bGVfc2VydmljZS5EYWlseVB1enpsZVJlc3BvbnNlEm4KF1N1Ym1pdERhaWx5UHV6emxlQW5zd2VyEigucHV6emxlX3NlcnZpY2UuRGFpbHlQdXp6bGVBbnN3ZXJSZXF1ZXN0GikucHV6emxlX3NlcnZpY2UuRGFpbHlQdXp6bGVBbnN3ZXJSZXNwb25zZRJuChVHZXREYWlseVB1enpsZUFyY2hpdmUSKS5wdXp6bGVfc2VydmljZS5EYWlseVB1enpsZUFyY2hpdmVSZXF1ZXN0GioucHV6emxlX3NlcnZpY2UuRGFpbHlQdXp6bGVBcmNoaXZlUmVzcG9uc2USXwoOU2V0RGFpbHlQdXp6bGUSJS5wdXp6bGVfc2VydmljZS5TZXREYWlseVB1enpsZVJlcXVlc3QaJi5wdXp6bGVfc2VydmljZS5TZXREYWlseVB1enpsZVJlc3BvbnNlEl0KD1N0YXJ0UHV6emxlUnVzaBImLnB1enpsZV9zZXJ2aWNlLlN0YXJ0UHV6emxlUnVzaFJlcXVlc3QaIi5wdXp6bGVfc2VydmljZS5QdXp6bGVSdXNoUmVzcG9uc2USVgoNR2V0UHV6emxlUnVzaBIhLnB1enpsZV9zZXJ2aWNlLlB1enpsZVJ1c2hSZXF1ZXN0GiIucHV6emxlX3NlcnZpY2UuUHV6emxlUnVzaFJlc3BvbnNlEmsKFlN1Ym1pdFB1enpsZVJ1c2hBbnN3ZXISJy5wdXp6bGVfc2VydmljZS5QdXp6bGVSdXNoQW5zd2VyUmVxdWVzdBooLnB1enpsZV9zZXJ2aWNlLlB1enpsZVJ1c2hBbnN3ZXJSZXNwb25zZRJWCg1FbmRQdXp6bGVSdXNoEiEucHV6emxlX3NlcnZpY2UuUHV6emxlUnVzaFJlcXVlc3QaIi5wdXp6bGVfc2VydmljZS5QdXp6bGVSdXNoUmVzcG9uc2USdwoYR2V0UHV6emxlUnVzaExlYWRlcmJvYXJkEiwucHV6emxlX3NlcnZpY2UuUHV6emxlUnVzaExlYWRlcmJvYXJkUmVxdWVzdBotLnB1enpsZV9zZXJ2aWNlLlB1enpsZVJ1c2hMZWFkZXJib2FyZFJlc3BvbnNlQrgBChJjb20ucHV6emxlX3NlcnZpY2VCElB1enpsZVNlcnZpY2VQcm90b1ABWjpnaXRodWIuY29tL3dvb2dsZXMtaW8vbGl3b3Jkcy9ycGMvYXBpL3Byb3RvL3B1enpsZV9zZXJ2aWNlogIDUFhYqgINUHV6emxlU2VydmljZcoCDVB1enpsZVNlcnZpY2XiAhlQdXp6bGVTZXJ2aWNlXEdQQk1ldGFkYXRh6gINUHV6emxlU2VydmljZWIGcHJvdG8z", [file_proto_vendored_macondo_macondo, file_google_protobuf_timestamp, file_proto_ipc_omgwords]);
 
 /**
  * @generated from message puzzle_service.StartPuzzleIdRequest
@@ -26,6 +26,13 @@ export type StartPuzzleIdRequest = Message<"puzzle_service.StartPuzzleIdRequest"
    * @generated from field: string lexicon = 1;
    */
   lexicon: string;
+
+  /**
+   * Only puzzles that have all of these tags.
+   *
+   * @generated from field: repeated macondo.PuzzleTag tags = 2;
+   */
+  tags: PuzzleTag[];
 };
 
 /**
@@ -65,6 +72,13 @@ export type NextPuzzleIdRequest = Message<"puzzle_service.NextPuzzleIdRequest">
    * @generated from field: string lexicon = 1;
    */
   lexicon: string;
+
+  /**
+   * Only puzzles that have all of these tags.
+   *
+   * @generated from field: repeated macondo.PuzzleTag tags = 2;
+   */
+  tags: PuzzleTag[];
 };
 
 /**
@@ -104,6 +118,13 @@ export type NextClosestRatingPuzzleIdRequest = Message<"puzzle_service.NextClose
    * @generated from field: string lexicon = 1;
    */
   lexicon: string;
+
+  /**
+   * Only puzzles that have all of these tags.
+   *
+   * @generated from field: repeated macondo.PuzzleTag tags = 2;
+   */
+  tags: PuzzleTag[];
 };
 
 /**
@@ -422,6 +443,27 @@ export type PuzzleGenerationJobRequest = Message<"puzzle_service.PuzzleGeneratio
    * @generated from field: uint32 days_per_chunk = 11;
    */
   daysPerChunk: number;
+
+  /**
+   * If set, puzzles are generated from these annotated games instead, and
+   * attributed to author_id.
+   *
+   * @generated from field: repeated string annotated_game_ids = 12;
+   */
+  annotatedGameIds: string[];
+
+  /**
+   * @generated from field: string author_id = 13;
+   */
+  authorId: string;
+
+  /**
+   * Set for jobs queued by users who can't create puzzles for the site.
+   * Their puzzles aren't served until the job is approved.
+   *
+   * @generated from field: bool needs_approval = 14;
+   */
+  needsApproval: boolean;
 };
 
 /**
@@ -552,158 +594,1574 @@ export const PuzzleJobLogsResponseSchema: GenMessage<PuzzleJobLogsResponse> = /*
   messageDesc(file_proto_puzzle_service_puzzle_service, 20);
 
 /**
- * @generated from enum puzzle_service.PuzzleQueryResult
+ * The spaced-repetition schedule of a puzzle in a user's review queue.
+ *
+ * @generated from message puzzle_service.PuzzleReview
  */
-export enum PuzzleQueryResult {
+export type PuzzleReview = Message<"puzzle_service.PuzzleReview"> & {
   /**
-   * @generated from enum value: UNSEEN = 0;
+   * @generated from field: string puzzle_id = 1;
    */
-  UNSEEN = 0,
+  puzzleId: string;
 
   /**
-   * @generated from enum value: UNRATED = 1;
+   * @generated from field: google.protobuf.Timestamp due_at = 2;
    */
-  UNRATED = 1,
+  dueAt?: Timestamp | undefined;
 
   /**
-   * @generated from enum value: UNFINISHED = 2;
+   * @generated from field: int32 interval_days = 3;
    */
-  UNFINISHED = 2,
+  intervalDays: number;
 
   /**
-   * @generated from enum value: EXHAUSTED = 3;
+   * @generated from field: int32 repetitions = 4;
    */
-  EXHAUSTED = 3,
+  repetitions: number;
 
   /**
-   * @generated from enum value: RANDOM = 4;
+   * @generated from field: int32 lapses = 5;
    */
-  RANDOM = 4,
+  lapses: number;
 
   /**
-   * @generated from enum value: START = 5;
+   * @generated from field: google.protobuf.Timestamp last_reviewed_at = 6;
    */
-  START = 5,
-}
+  lastReviewedAt?: Timestamp | undefined;
+};
 
 /**
- * Describes the enum puzzle_service.PuzzleQueryResult.
+ * Describes the message puzzle_service.PuzzleReview.
+ * Use `create(PuzzleReviewSchema)` to create a new message.
  */
-export const PuzzleQueryResultSchema: GenEnum<PuzzleQueryResult> = /*@__PURE__*/
-  enumDesc(file_proto_puzzle_service_puzzle_service, 0);
+export const PuzzleReviewSchema: GenMessage<PuzzleReview> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 21);
 
 /**
- * @generated from enum puzzle_service.PuzzleStatus
+ * @generated from message puzzle_service.ReviewQueueRequest
  */
-export enum PuzzleStatus {
+export type ReviewQueueRequest = Message<"puzzle_service.ReviewQueueRequest"> & {
   /**
-   * @generated from enum value: UNANSWERED = 0;
+   * @generated from field: string lexicon = 1;
    */
-  UNANSWERED = 0,
+  lexicon: string;
 
   /**
-   * @generated from enum value: CORRECT = 1;
+   * @generated from field: int32 limit = 2;
    */
-  CORRECT = 1,
+  limit: number;
+};
+
+/**
+ * Describes the message puzzle_service.ReviewQueueRequest.
+ * Use `create(ReviewQueueRequestSchema)` to create a new message.
+ */
+export const ReviewQueueRequestSchema: GenMessage<ReviewQueueRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 22);
 
+/**
+ * @generated from message puzzle_service.ReviewQueueResponse
+ */
+export type ReviewQueueResponse = Message<"puzzle_service.ReviewQueueResponse"> & {
   /**
-   * @generated from enum value: INCORRECT = 2;
+   * @generated from field: repeated puzzle_service.PuzzleReview reviews = 1;
    */
-  INCORRECT = 2,
-}
+  reviews: PuzzleReview[];
+
+  /**
+   * @generated from field: int32 due_count = 2;
+   */
+  dueCount: number;
+
+  /**
+   * @generated from field: int32 total_count = 3;
+   */
+  totalCount: number;
+};
 
 /**
- * Describes the enum puzzle_service.PuzzleStatus.
+ * Describes the message puzzle_service.ReviewQueueResponse.
+ * Use `create(ReviewQueueResponseSchema)` to create a new message.
  */
-export const PuzzleStatusSchema: GenEnum<PuzzleStatus> = /*@__PURE__*/
-  enumDesc(file_proto_puzzle_service_puzzle_service, 1);
+export const ReviewQueueResponseSchema: GenMessage<ReviewQueueResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 23);
 
 /**
- * @generated from service puzzle_service.PuzzleService
+ * @generated from message puzzle_service.StudySessionRequest
  */
-export const PuzzleService: GenService<{
+export type StudySessionRequest = Message<"puzzle_service.StudySessionRequest"> & {
   /**
-   * @generated from rpc puzzle_service.PuzzleService.GetStartPuzzleId
+   * @generated from field: string lexicon = 1;
    */
-  getStartPuzzleId: {
-    methodKind: "unary";
-    input: typeof StartPuzzleIdRequestSchema;
-    output: typeof StartPuzzleIdResponseSchema;
-  },
+  lexicon: string;
+
   /**
-   * @generated from rpc puzzle_service.PuzzleService.GetNextPuzzleId
+   * The maximum number of puzzles in the session.
+   *
+   * @generated from field: int32 size = 2;
    */
-  getNextPuzzleId: {
-    methodKind: "unary";
-    input: typeof NextPuzzleIdRequestSchema;
-    output: typeof NextPuzzleIdResponseSchema;
-  },
+  size: number;
+};
+
+/**
+ * Describes the message puzzle_service.StudySessionRequest.
+ * Use `create(StudySessionRequestSchema)` to create a new message.
+ */
+export const StudySessionRequestSchema: GenMessage<StudySessionRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 24);
+
+/**
+ * @generated from message puzzle_service.StudySessionResponse
+ */
+export type StudySessionResponse = Message<"puzzle_service.StudySessionResponse"> & {
   /**
-   * @generated from rpc puzzle_service.PuzzleService.GetNextClosestRatingPuzzleId
+   * The due puzzles, most overdue first.
+   *
+   * @generated from field: repeated string puzzle_ids = 1;
    */
-  getNextClosestRatingPuzzleId: {
-    methodKind: "unary";
-    input: typeof NextClosestRatingPuzzleIdRequestSchema;
-    output: typeof NextClosestRatingPuzzleIdResponseSchema;
-  },
+  puzzleIds: string[];
+
   /**
-   * @generated from rpc puzzle_service.PuzzleService.GetPuzzle
+   * @generated from field: int32 due_count = 2;
    */
-  getPuzzle: {
-    methodKind: "unary";
-    input: typeof PuzzleRequestSchema;
-    output: typeof PuzzleResponseSchema;
-  },
+  dueCount: number;
+
   /**
-   * @generated from rpc puzzle_service.PuzzleService.SubmitAnswer
+   * When the next puzzle is due, if none are due now.
+   *
+   * @generated from field: google.protobuf.Timestamp next_due_at = 3;
    */
-  submitAnswer: {
-    methodKind: "unary";
-    input: typeof SubmissionRequestSchema;
-    output: typeof SubmissionResponseSchema;
-  },
+  nextDueAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.StudySessionResponse.
+ * Use `create(StudySessionResponseSchema)` to create a new message.
+ */
+export const StudySessionResponseSchema: GenMessage<StudySessionResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 25);
+
+/**
+ * @generated from message puzzle_service.ReviewSubmissionRequest
+ */
+export type ReviewSubmissionRequest = Message<"puzzle_service.ReviewSubmissionRequest"> & {
   /**
-   * GetPuzzleAnswer just gets the answer of the puzzle without a submission.
-   * It will not work if the user has not tried the puzzle at least once.
+   * @generated from field: string puzzle_id = 1;
+   */
+  puzzleId: string;
+
+  /**
+   * @generated from field: ipc.ClientGameplayEvent answer = 2;
+   */
+  answer?: ClientGameplayEvent | undefined;
+
+  /**
+   * @generated from field: int32 seconds_taken = 3;
+   */
+  secondsTaken: number;
+
+  /**
+   * @generated from field: bool show_solution = 4;
+   */
+  showSolution: boolean;
+};
+
+/**
+ * Describes the message puzzle_service.ReviewSubmissionRequest.
+ * Use `create(ReviewSubmissionRequestSchema)` to create a new message.
+ */
+export const ReviewSubmissionRequestSchema: GenMessage<ReviewSubmissionRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 26);
+
+/**
+ * @generated from message puzzle_service.ReviewSubmissionResponse
+ */
+export type ReviewSubmissionResponse = Message<"puzzle_service.ReviewSubmissionResponse"> & {
+  /**
+   * @generated from field: bool user_is_correct = 1;
+   */
+  userIsCorrect: boolean;
+
+  /**
+   * @generated from field: macondo.GameEvent correct_answer = 2;
+   */
+  correctAnswer?: GameEvent | undefined;
+
+  /**
+   * @generated from field: puzzle_service.PuzzleReview review = 3;
+   */
+  review?: PuzzleReview | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.ReviewSubmissionResponse.
+ * Use `create(ReviewSubmissionResponseSchema)` to create a new message.
+ */
+export const ReviewSubmissionResponseSchema: GenMessage<ReviewSubmissionResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 27);
+
+/**
+ * @generated from message puzzle_service.RemoveFromReviewQueueResponse
+ */
+export type RemoveFromReviewQueueResponse = Message<"puzzle_service.RemoveFromReviewQueueResponse"> & {
+};
+
+/**
+ * Describes the message puzzle_service.RemoveFromReviewQueueResponse.
+ * Use `create(RemoveFromReviewQueueResponseSchema)` to create a new message.
+ */
+export const RemoveFromReviewQueueResponseSchema: GenMessage<RemoveFromReviewQueueResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 28);
+
+/**
+ * @generated from message puzzle_service.PuzzleSetEntry
+ */
+export type PuzzleSetEntry = Message<"puzzle_service.PuzzleSetEntry"> & {
+  /**
+   * @generated from field: string puzzle_id = 1;
+   */
+  puzzleId: string;
+
+  /**
+   * The requesting user's result on this puzzle.
    *
-   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleAnswer
+   * @generated from field: puzzle_service.PuzzleStatus status = 2;
    */
-  getPuzzleAnswer: {
-    methodKind: "unary";
-    input: typeof PuzzleRequestSchema;
-    output: typeof AnswerResponseSchema;
-  },
+  status: PuzzleStatus;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleSetEntry.
+ * Use `create(PuzzleSetEntrySchema)` to create a new message.
+ */
+export const PuzzleSetEntrySchema: GenMessage<PuzzleSetEntry> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 29);
+
+/**
+ * @generated from message puzzle_service.PuzzleSet
+ */
+export type PuzzleSet = Message<"puzzle_service.PuzzleSet"> & {
   /**
-   * @generated from rpc puzzle_service.PuzzleService.GetPreviousPuzzleId
+   * @generated from field: string set_id = 1;
    */
-  getPreviousPuzzleId: {
-    methodKind: "unary";
-    input: typeof PreviousPuzzleRequestSchema;
-    output: typeof PreviousPuzzleResponseSchema;
-  },
+  setId: string;
+
   /**
-   * @generated from rpc puzzle_service.PuzzleService.SetPuzzleVote
+   * @generated from field: string title = 2;
    */
-  setPuzzleVote: {
-    methodKind: "unary";
-    input: typeof PuzzleVoteRequestSchema;
-    output: typeof PuzzleVoteResponseSchema;
-  },
+  title: string;
+
   /**
-   * @generated from rpc puzzle_service.PuzzleService.StartPuzzleGenJob
+   * @generated from field: string description = 3;
    */
-  startPuzzleGenJob: {
-    methodKind: "unary";
-    input: typeof APIPuzzleGenerationJobRequestSchema;
-    output: typeof APIPuzzleGenerationJobResponseSchema;
-  },
+  description: string;
+
   /**
-   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleJobLogs
+   * @generated from field: string lexicon = 4;
    */
-  getPuzzleJobLogs: {
-    methodKind: "unary";
-    input: typeof PuzzleJobLogsRequestSchema;
-    output: typeof PuzzleJobLogsResponseSchema;
+  lexicon: string;
+
+  /**
+   * @generated from field: string creator = 5;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int32 puzzle_count = 6;
+   */
+  puzzleCount: number;
+
+  /**
+   * The puzzles in order. Only set when getting a single puzzle set.
+   *
+   * @generated from field: repeated puzzle_service.PuzzleSetEntry puzzles = 7;
+   */
+  puzzles: PuzzleSetEntry[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleSet.
+ * Use `create(PuzzleSetSchema)` to create a new message.
+ */
+export const PuzzleSetSchema: GenMessage<PuzzleSet> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 30);
+
+/**
+ * @generated from message puzzle_service.CreatePuzzleSetRequest
+ */
+export type CreatePuzzleSetRequest = Message<"puzzle_service.CreatePuzzleSetRequest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string lexicon = 3;
+   */
+  lexicon: string;
+
+  /**
+   * @generated from field: repeated string puzzle_ids = 4;
+   */
+  puzzleIds: string[];
+};
+
+/**
+ * Describes the message puzzle_service.CreatePuzzleSetRequest.
+ * Use `create(CreatePuzzleSetRequestSchema)` to create a new message.
+ */
+export const CreatePuzzleSetRequestSchema: GenMessage<CreatePuzzleSetRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 31);
+
+/**
+ * @generated from message puzzle_service.UpdatePuzzleSetRequest
+ */
+export type UpdatePuzzleSetRequest = Message<"puzzle_service.UpdatePuzzleSetRequest"> & {
+  /**
+   * @generated from field: string set_id = 1;
+   */
+  setId: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * Replaces the puzzles in the set, in this order.
+   *
+   * @generated from field: repeated string puzzle_ids = 4;
+   */
+  puzzleIds: string[];
+};
+
+/**
+ * Describes the message puzzle_service.UpdatePuzzleSetRequest.
+ * Use `create(UpdatePuzzleSetRequestSchema)` to create a new message.
+ */
+export const UpdatePuzzleSetRequestSchema: GenMessage<UpdatePuzzleSetRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 32);
+
+/**
+ * @generated from message puzzle_service.PuzzleSetRequest
+ */
+export type PuzzleSetRequest = Message<"puzzle_service.PuzzleSetRequest"> & {
+  /**
+   * @generated from field: string set_id = 1;
+   */
+  setId: string;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleSetRequest.
+ * Use `create(PuzzleSetRequestSchema)` to create a new message.
+ */
+export const PuzzleSetRequestSchema: GenMessage<PuzzleSetRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 33);
+
+/**
+ * @generated from message puzzle_service.PuzzleSetsRequest
+ */
+export type PuzzleSetsRequest = Message<"puzzle_service.PuzzleSetsRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleSetsRequest.
+ * Use `create(PuzzleSetsRequestSchema)` to create a new message.
+ */
+export const PuzzleSetsRequestSchema: GenMessage<PuzzleSetsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 34);
+
+/**
+ * @generated from message puzzle_service.PuzzleSetResponse
+ */
+export type PuzzleSetResponse = Message<"puzzle_service.PuzzleSetResponse"> & {
+  /**
+   * @generated from field: puzzle_service.PuzzleSet puzzle_set = 1;
+   */
+  puzzleSet?: PuzzleSet | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleSetResponse.
+ * Use `create(PuzzleSetResponseSchema)` to create a new message.
+ */
+export const PuzzleSetResponseSchema: GenMessage<PuzzleSetResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 35);
+
+/**
+ * @generated from message puzzle_service.PuzzleSetsResponse
+ */
+export type PuzzleSetsResponse = Message<"puzzle_service.PuzzleSetsResponse"> & {
+  /**
+   * @generated from field: repeated puzzle_service.PuzzleSet puzzle_sets = 1;
+   */
+  puzzleSets: PuzzleSet[];
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleSetsResponse.
+ * Use `create(PuzzleSetsResponseSchema)` to create a new message.
+ */
+export const PuzzleSetsResponseSchema: GenMessage<PuzzleSetsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 36);
+
+/**
+ * @generated from message puzzle_service.DeletePuzzleSetResponse
+ */
+export type DeletePuzzleSetResponse = Message<"puzzle_service.DeletePuzzleSetResponse"> & {
+};
+
+/**
+ * Describes the message puzzle_service.DeletePuzzleSetResponse.
+ * Use `create(DeletePuzzleSetResponseSchema)` to create a new message.
+ */
+export const DeletePuzzleSetResponseSchema: GenMessage<DeletePuzzleSetResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 37);
+
+/**
+ * @generated from message puzzle_service.PuzzleTagRatingsRequest
+ */
+export type PuzzleTagRatingsRequest = Message<"puzzle_service.PuzzleTagRatingsRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleTagRatingsRequest.
+ * Use `create(PuzzleTagRatingsRequestSchema)` to create a new message.
+ */
+export const PuzzleTagRatingsRequestSchema: GenMessage<PuzzleTagRatingsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 38);
+
+/**
+ * @generated from message puzzle_service.PuzzleTagRating
+ */
+export type PuzzleTagRating = Message<"puzzle_service.PuzzleTagRating"> & {
+  /**
+   * @generated from field: macondo.PuzzleTag tag = 1;
+   */
+  tag: PuzzleTag;
+
+  /**
+   * @generated from field: int32 rating = 2;
+   */
+  rating: number;
+
+  /**
+   * @generated from field: int32 rating_deviation = 3;
+   */
+  ratingDeviation: number;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleTagRating.
+ * Use `create(PuzzleTagRatingSchema)` to create a new message.
+ */
+export const PuzzleTagRatingSchema: GenMessage<PuzzleTagRating> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 39);
+
+/**
+ * @generated from message puzzle_service.PuzzleTagRatingsResponse
+ */
+export type PuzzleTagRatingsResponse = Message<"puzzle_service.PuzzleTagRatingsResponse"> & {
+  /**
+   * @generated from field: repeated puzzle_service.PuzzleTagRating ratings = 1;
+   */
+  ratings: PuzzleTagRating[];
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleTagRatingsResponse.
+ * Use `create(PuzzleTagRatingsResponseSchema)` to create a new message.
+ */
+export const PuzzleTagRatingsResponseSchema: GenMessage<PuzzleTagRatingsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 40);
+
+/**
+ * @generated from message puzzle_service.QueuePuzzleGenerationRequest
+ */
+export type QueuePuzzleGenerationRequest = Message<"puzzle_service.QueuePuzzleGenerationRequest"> & {
+  /**
+   * Exactly one of game_id or collection_uuid must be set. A collection
+   * queues all of its annotated games.
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string collection_uuid = 2;
+   */
+  collectionUuid: string;
+
+  /**
+   * Defaults to every puzzle found in the games.
+   *
+   * @generated from field: macondo.PuzzleGenerationRequest request = 3;
+   */
+  request?: PuzzleGenerationRequest | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.QueuePuzzleGenerationRequest.
+ * Use `create(QueuePuzzleGenerationRequestSchema)` to create a new message.
+ */
+export const QueuePuzzleGenerationRequestSchema: GenMessage<QueuePuzzleGenerationRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 41);
+
+/**
+ * @generated from message puzzle_service.QueuePuzzleGenerationResponse
+ */
+export type QueuePuzzleGenerationResponse = Message<"puzzle_service.QueuePuzzleGenerationResponse"> & {
+  /**
+   * @generated from field: int64 job_id = 1;
+   */
+  jobId: bigint;
+
+  /**
+   * @generated from field: int32 num_games = 2;
+   */
+  numGames: number;
+};
+
+/**
+ * Describes the message puzzle_service.QueuePuzzleGenerationResponse.
+ * Use `create(QueuePuzzleGenerationResponseSchema)` to create a new message.
+ */
+export const QueuePuzzleGenerationResponseSchema: GenMessage<QueuePuzzleGenerationResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 42);
+
+/**
+ * @generated from message puzzle_service.ApprovePuzzleGenerationJobRequest
+ */
+export type ApprovePuzzleGenerationJobRequest = Message<"puzzle_service.ApprovePuzzleGenerationJobRequest"> & {
+  /**
+   * @generated from field: int64 job_id = 1;
+   */
+  jobId: bigint;
+};
+
+/**
+ * Describes the message puzzle_service.ApprovePuzzleGenerationJobRequest.
+ * Use `create(ApprovePuzzleGenerationJobRequestSchema)` to create a new message.
+ */
+export const ApprovePuzzleGenerationJobRequestSchema: GenMessage<ApprovePuzzleGenerationJobRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 43);
+
+/**
+ * @generated from message puzzle_service.ApprovePuzzleGenerationJobResponse
+ */
+export type ApprovePuzzleGenerationJobResponse = Message<"puzzle_service.ApprovePuzzleGenerationJobResponse"> & {
+  /**
+   * @generated from field: int32 num_puzzles = 1;
+   */
+  numPuzzles: number;
+};
+
+/**
+ * Describes the message puzzle_service.ApprovePuzzleGenerationJobResponse.
+ * Use `create(ApprovePuzzleGenerationJobResponseSchema)` to create a new message.
+ */
+export const ApprovePuzzleGenerationJobResponseSchema: GenMessage<ApprovePuzzleGenerationJobResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 44);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleRequest
+ */
+export type DailyPuzzleRequest = Message<"puzzle_service.DailyPuzzleRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleRequest.
+ * Use `create(DailyPuzzleRequestSchema)` to create a new message.
+ */
+export const DailyPuzzleRequestSchema: GenMessage<DailyPuzzleRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 45);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleWrongAnswer
+ */
+export type DailyPuzzleWrongAnswer = Message<"puzzle_service.DailyPuzzleWrongAnswer"> & {
+  /**
+   * The answer, e.g. "8H QI" or "-EIU" for an exchange.
+   *
+   * @generated from field: string answer = 1;
+   */
+  answer: string;
+
+  /**
+   * @generated from field: int32 times = 2;
+   */
+  times: number;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleWrongAnswer.
+ * Use `create(DailyPuzzleWrongAnswerSchema)` to create a new message.
+ */
+export const DailyPuzzleWrongAnswerSchema: GenMessage<DailyPuzzleWrongAnswer> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 46);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleStats
+ */
+export type DailyPuzzleStats = Message<"puzzle_service.DailyPuzzleStats"> & {
+  /**
+   * @generated from field: int32 answers = 1;
+   */
+  answers: number;
+
+  /**
+   * @generated from field: int32 solves = 2;
+   */
+  solves: number;
+
+  /**
+   * The fraction of answers that were correct, from 0 to 1.
+   *
+   * @generated from field: double solve_rate = 3;
+   */
+  solveRate: number;
+
+  /**
+   * @generated from field: double average_solve_seconds = 4;
+   */
+  averageSolveSeconds: number;
+
+  /**
+   * @generated from field: repeated puzzle_service.DailyPuzzleWrongAnswer common_wrong_answers = 5;
+   */
+  commonWrongAnswers: DailyPuzzleWrongAnswer[];
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleStats.
+ * Use `create(DailyPuzzleStatsSchema)` to create a new message.
+ */
+export const DailyPuzzleStatsSchema: GenMessage<DailyPuzzleStats> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 47);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleStreak
+ */
+export type DailyPuzzleStreak = Message<"puzzle_service.DailyPuzzleStreak"> & {
+  /**
+   * Consecutive days up to today (or yesterday, if today's puzzle is not
+   * solved yet) on which the daily puzzle was solved.
+   *
+   * @generated from field: int32 current = 1;
+   */
+  current: number;
+
+  /**
+   * @generated from field: int32 best = 2;
+   */
+  best: number;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleStreak.
+ * Use `create(DailyPuzzleStreakSchema)` to create a new message.
+ */
+export const DailyPuzzleStreakSchema: GenMessage<DailyPuzzleStreak> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 48);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleResponse
+ */
+export type DailyPuzzleResponse = Message<"puzzle_service.DailyPuzzleResponse"> & {
+  /**
+   * The UTC date of the puzzle, as YYYY-MM-DD.
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string puzzle_id = 2;
+   */
+  puzzleId: string;
+
+  /**
+   * @generated from field: macondo.GameHistory history = 3;
+   */
+  history?: GameHistory | undefined;
+
+  /**
+   * @generated from field: string before_text = 4;
+   */
+  beforeText: string;
+
+  /**
+   * The user's result. The answer and stats are only sent once the user
+   * has answered.
+   *
+   * @generated from field: puzzle_service.PuzzleStatus status = 5;
+   */
+  status: PuzzleStatus;
+
+  /**
+   * @generated from field: macondo.GameEvent correct_answer = 6;
+   */
+  correctAnswer?: GameEvent | undefined;
+
+  /**
+   * @generated from field: puzzle_service.DailyPuzzleStats stats = 7;
+   */
+  stats?: DailyPuzzleStats | undefined;
+
+  /**
+   * @generated from field: puzzle_service.DailyPuzzleStreak streak = 8;
+   */
+  streak?: DailyPuzzleStreak | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleResponse.
+ * Use `create(DailyPuzzleResponseSchema)` to create a new message.
+ */
+export const DailyPuzzleResponseSchema: GenMessage<DailyPuzzleResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 49);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleAnswerRequest
+ */
+export type DailyPuzzleAnswerRequest = Message<"puzzle_service.DailyPuzzleAnswerRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * A nil answer gives up on the puzzle.
+   *
+   * @generated from field: ipc.ClientGameplayEvent answer = 2;
+   */
+  answer?: ClientGameplayEvent | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleAnswerRequest.
+ * Use `create(DailyPuzzleAnswerRequestSchema)` to create a new message.
+ */
+export const DailyPuzzleAnswerRequestSchema: GenMessage<DailyPuzzleAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 50);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleAnswerResponse
+ */
+export type DailyPuzzleAnswerResponse = Message<"puzzle_service.DailyPuzzleAnswerResponse"> & {
+  /**
+   * @generated from field: bool user_is_correct = 1;
+   */
+  userIsCorrect: boolean;
+
+  /**
+   * @generated from field: macondo.GameEvent correct_answer = 2;
+   */
+  correctAnswer?: GameEvent | undefined;
+
+  /**
+   * @generated from field: puzzle_service.DailyPuzzleStats stats = 3;
+   */
+  stats?: DailyPuzzleStats | undefined;
+
+  /**
+   * @generated from field: puzzle_service.DailyPuzzleStreak streak = 4;
+   */
+  streak?: DailyPuzzleStreak | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleAnswerResponse.
+ * Use `create(DailyPuzzleAnswerResponseSchema)` to create a new message.
+ */
+export const DailyPuzzleAnswerResponseSchema: GenMessage<DailyPuzzleAnswerResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 51);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleArchiveRequest
+ */
+export type DailyPuzzleArchiveRequest = Message<"puzzle_service.DailyPuzzleArchiveRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 3;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleArchiveRequest.
+ * Use `create(DailyPuzzleArchiveRequestSchema)` to create a new message.
+ */
+export const DailyPuzzleArchiveRequestSchema: GenMessage<DailyPuzzleArchiveRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 52);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleArchiveEntry
+ */
+export type DailyPuzzleArchiveEntry = Message<"puzzle_service.DailyPuzzleArchiveEntry"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string puzzle_id = 2;
+   */
+  puzzleId: string;
+
+  /**
+   * @generated from field: int32 answers = 3;
+   */
+  answers: number;
+
+  /**
+   * @generated from field: int32 solves = 4;
+   */
+  solves: number;
+
+  /**
+   * @generated from field: puzzle_service.PuzzleStatus status = 5;
+   */
+  status: PuzzleStatus;
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleArchiveEntry.
+ * Use `create(DailyPuzzleArchiveEntrySchema)` to create a new message.
+ */
+export const DailyPuzzleArchiveEntrySchema: GenMessage<DailyPuzzleArchiveEntry> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 53);
+
+/**
+ * @generated from message puzzle_service.DailyPuzzleArchiveResponse
+ */
+export type DailyPuzzleArchiveResponse = Message<"puzzle_service.DailyPuzzleArchiveResponse"> & {
+  /**
+   * @generated from field: repeated puzzle_service.DailyPuzzleArchiveEntry puzzles = 1;
+   */
+  puzzles: DailyPuzzleArchiveEntry[];
+};
+
+/**
+ * Describes the message puzzle_service.DailyPuzzleArchiveResponse.
+ * Use `create(DailyPuzzleArchiveResponseSchema)` to create a new message.
+ */
+export const DailyPuzzleArchiveResponseSchema: GenMessage<DailyPuzzleArchiveResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 54);
+
+/**
+ * @generated from message puzzle_service.SetDailyPuzzleRequest
+ */
+export type SetDailyPuzzleRequest = Message<"puzzle_service.SetDailyPuzzleRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * A future UTC date, as YYYY-MM-DD.
+   *
+   * @generated from field: string date = 2;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string puzzle_id = 3;
+   */
+  puzzleId: string;
+};
+
+/**
+ * Describes the message puzzle_service.SetDailyPuzzleRequest.
+ * Use `create(SetDailyPuzzleRequestSchema)` to create a new message.
+ */
+export const SetDailyPuzzleRequestSchema: GenMessage<SetDailyPuzzleRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 55);
+
+/**
+ * @generated from message puzzle_service.SetDailyPuzzleResponse
+ */
+export type SetDailyPuzzleResponse = Message<"puzzle_service.SetDailyPuzzleResponse"> & {
+};
+
+/**
+ * Describes the message puzzle_service.SetDailyPuzzleResponse.
+ * Use `create(SetDailyPuzzleResponseSchema)` to create a new message.
+ */
+export const SetDailyPuzzleResponseSchema: GenMessage<SetDailyPuzzleResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 56);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushState
+ */
+export type PuzzleRushState = Message<"puzzle_service.PuzzleRushState"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string lexicon = 2;
+   */
+  lexicon: string;
+
+  /**
+   * @generated from field: int32 minutes = 3;
+   */
+  minutes: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 4;
+   */
+  startedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ends_at = 5;
+   */
+  endsAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: int32 score = 6;
+   */
+  score: number;
+
+  /**
+   * @generated from field: int32 strikes = 7;
+   */
+  strikes: number;
+
+  /**
+   * @generated from field: int32 max_strikes = 8;
+   */
+  maxStrikes: number;
+
+  /**
+   * @generated from field: bool finished = 9;
+   */
+  finished: boolean;
+
+  /**
+   * The puzzle to solve now. Empty once the session is finished.
+   *
+   * @generated from field: string puzzle_id = 10;
+   */
+  puzzleId: string;
+
+  /**
+   * @generated from field: macondo.GameHistory history = 11;
+   */
+  history?: GameHistory | undefined;
+
+  /**
+   * @generated from field: string before_text = 12;
+   */
+  beforeText: string;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushState.
+ * Use `create(PuzzleRushStateSchema)` to create a new message.
+ */
+export const PuzzleRushStateSchema: GenMessage<PuzzleRushState> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 57);
+
+/**
+ * @generated from message puzzle_service.StartPuzzleRushRequest
+ */
+export type StartPuzzleRushRequest = Message<"puzzle_service.StartPuzzleRushRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * @generated from field: int32 minutes = 2;
+   */
+  minutes: number;
+};
+
+/**
+ * Describes the message puzzle_service.StartPuzzleRushRequest.
+ * Use `create(StartPuzzleRushRequestSchema)` to create a new message.
+ */
+export const StartPuzzleRushRequestSchema: GenMessage<StartPuzzleRushRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 58);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushRequest
+ */
+export type PuzzleRushRequest = Message<"puzzle_service.PuzzleRushRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushRequest.
+ * Use `create(PuzzleRushRequestSchema)` to create a new message.
+ */
+export const PuzzleRushRequestSchema: GenMessage<PuzzleRushRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 59);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushResponse
+ */
+export type PuzzleRushResponse = Message<"puzzle_service.PuzzleRushResponse"> & {
+  /**
+   * @generated from field: puzzle_service.PuzzleRushState state = 1;
+   */
+  state?: PuzzleRushState | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushResponse.
+ * Use `create(PuzzleRushResponseSchema)` to create a new message.
+ */
+export const PuzzleRushResponseSchema: GenMessage<PuzzleRushResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 60);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushAnswerRequest
+ */
+export type PuzzleRushAnswerRequest = Message<"puzzle_service.PuzzleRushAnswerRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * A nil answer skips the puzzle, which counts as a strike.
+   *
+   * @generated from field: ipc.ClientGameplayEvent answer = 2;
+   */
+  answer?: ClientGameplayEvent | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushAnswerRequest.
+ * Use `create(PuzzleRushAnswerRequestSchema)` to create a new message.
+ */
+export const PuzzleRushAnswerRequestSchema: GenMessage<PuzzleRushAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 61);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushAnswerResponse
+ */
+export type PuzzleRushAnswerResponse = Message<"puzzle_service.PuzzleRushAnswerResponse"> & {
+  /**
+   * @generated from field: bool user_is_correct = 1;
+   */
+  userIsCorrect: boolean;
+
+  /**
+   * @generated from field: macondo.GameEvent correct_answer = 2;
+   */
+  correctAnswer?: GameEvent | undefined;
+
+  /**
+   * @generated from field: puzzle_service.PuzzleRushState state = 3;
+   */
+  state?: PuzzleRushState | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushAnswerResponse.
+ * Use `create(PuzzleRushAnswerResponseSchema)` to create a new message.
+ */
+export const PuzzleRushAnswerResponseSchema: GenMessage<PuzzleRushAnswerResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 62);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushLeaderboardRequest
+ */
+export type PuzzleRushLeaderboardRequest = Message<"puzzle_service.PuzzleRushLeaderboardRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * @generated from field: int32 minutes = 2;
+   */
+  minutes: number;
+
+  /**
+   * @generated from field: puzzle_service.PuzzleRushPeriod period = 3;
+   */
+  period: PuzzleRushPeriod;
+
+  /**
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushLeaderboardRequest.
+ * Use `create(PuzzleRushLeaderboardRequestSchema)` to create a new message.
+ */
+export const PuzzleRushLeaderboardRequestSchema: GenMessage<PuzzleRushLeaderboardRequest> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 63);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushLeaderboardEntry
+ */
+export type PuzzleRushLeaderboardEntry = Message<"puzzle_service.PuzzleRushLeaderboardEntry"> & {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: int32 score = 3;
+   */
+  score: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 4;
+   */
+  startedAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushLeaderboardEntry.
+ * Use `create(PuzzleRushLeaderboardEntrySchema)` to create a new message.
+ */
+export const PuzzleRushLeaderboardEntrySchema: GenMessage<PuzzleRushLeaderboardEntry> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 64);
+
+/**
+ * @generated from message puzzle_service.PuzzleRushLeaderboardResponse
+ */
+export type PuzzleRushLeaderboardResponse = Message<"puzzle_service.PuzzleRushLeaderboardResponse"> & {
+  /**
+   * @generated from field: repeated puzzle_service.PuzzleRushLeaderboardEntry entries = 1;
+   */
+  entries: PuzzleRushLeaderboardEntry[];
+};
+
+/**
+ * Describes the message puzzle_service.PuzzleRushLeaderboardResponse.
+ * Use `create(PuzzleRushLeaderboardResponseSchema)` to create a new message.
+ */
+export const PuzzleRushLeaderboardResponseSchema: GenMessage<PuzzleRushLeaderboardResponse> = /*@__PURE__*/
+  messageDesc(file_proto_puzzle_service_puzzle_service, 65);
+
+/**
+ * @generated from enum puzzle_service.PuzzleQueryResult
+ */
+export enum PuzzleQueryResult {
+  /**
+   * @generated from enum value: UNSEEN = 0;
+   */
+  UNSEEN = 0,
+
+  /**
+   * @generated from enum value: UNRATED = 1;
+   */
+  UNRATED = 1,
+
+  /**
+   * @generated from enum value: UNFINISHED = 2;
+   */
+  UNFINISHED = 2,
+
+  /**
+   * @generated from enum value: EXHAUSTED = 3;
+   */
+  EXHAUSTED = 3,
+
+  /**
+   * @generated from enum value: RANDOM = 4;
+   */
+  RANDOM = 4,
+
+  /**
+   * @generated from enum value: START = 5;
+   */
+  START = 5,
+}
+
+/**
+ * Describes the enum puzzle_service.PuzzleQueryResult.
+ */
+export const PuzzleQueryResultSchema: GenEnum<PuzzleQueryResult> = /*@__PURE__*/
+  enumDesc(file_proto_puzzle_service_puzzle_service, 0);
+
+/**
+ * @generated from enum puzzle_service.PuzzleStatus
+ */
+export enum PuzzleStatus {
+  /**
+   * @generated from enum value: UNANSWERED = 0;
+   */
+  UNANSWERED = 0,
+
+  /**
+   * @generated from enum value: CORRECT = 1;
+   */
+  CORRECT = 1,
+
+  /**
+   * @generated from enum value: INCORRECT = 2;
+   */
+  INCORRECT = 2,
+}
+
+/**
+ * Describes the enum puzzle_service.PuzzleStatus.
+ */
+export const PuzzleStatusSchema: GenEnum<PuzzleStatus> = /*@__PURE__*/
+  enumDesc(file_proto_puzzle_service_puzzle_service, 1);
+
+/**
+ * @generated from enum puzzle_service.PuzzleRushPeriod
+ */
+export enum PuzzleRushPeriod {
+  /**
+   * @generated from enum value: DAILY = 0;
+   */
+  DAILY = 0,
+
+  /**
+   * @generated from enum value: WEEKLY = 1;
+   */
+  WEEKLY = 1,
+
+  /**
+   * @generated from enum value: ALL_TIME = 2;
+   */
+  ALL_TIME = 2,
+}
+
+/**
+ * Describes the enum puzzle_service.PuzzleRushPeriod.
+ */
+export const PuzzleRushPeriodSchema: GenEnum<PuzzleRushPeriod> = /*@__PURE__*/
+  enumDesc(file_proto_puzzle_service_puzzle_service, 2);
+
+/**
+ * @generated from service puzzle_service.PuzzleService
+ */
+export const PuzzleService: GenService<{
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetStartPuzzleId
+   */
+  getStartPuzzleId: {
+    methodKind: "unary";
+    input: typeof StartPuzzleIdRequestSchema;
+    output: typeof StartPuzzleIdResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetNextPuzzleId
+   */
+  getNextPuzzleId: {
+    methodKind: "unary";
+    input: typeof NextPuzzleIdRequestSchema;
+    output: typeof NextPuzzleIdResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetNextClosestRatingPuzzleId
+   */
+  getNextClosestRatingPuzzleId: {
+    methodKind: "unary";
+    input: typeof NextClosestRatingPuzzleIdRequestSchema;
+    output: typeof NextClosestRatingPuzzleIdResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzle
+   */
+  getPuzzle: {
+    methodKind: "unary";
+    input: typeof PuzzleRequestSchema;
+    output: typeof PuzzleResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.SubmitAnswer
+   */
+  submitAnswer: {
+    methodKind: "unary";
+    input: typeof SubmissionRequestSchema;
+    output: typeof SubmissionResponseSchema;
+  },
+  /**
+   * GetPuzzleAnswer just gets the answer of the puzzle without a submission.
+   * It will not work if the user has not tried the puzzle at least once.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleAnswer
+   */
+  getPuzzleAnswer: {
+    methodKind: "unary";
+    input: typeof PuzzleRequestSchema;
+    output: typeof AnswerResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetPreviousPuzzleId
+   */
+  getPreviousPuzzleId: {
+    methodKind: "unary";
+    input: typeof PreviousPuzzleRequestSchema;
+    output: typeof PreviousPuzzleResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.SetPuzzleVote
+   */
+  setPuzzleVote: {
+    methodKind: "unary";
+    input: typeof PuzzleVoteRequestSchema;
+    output: typeof PuzzleVoteResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.StartPuzzleGenJob
+   */
+  startPuzzleGenJob: {
+    methodKind: "unary";
+    input: typeof APIPuzzleGenerationJobRequestSchema;
+    output: typeof APIPuzzleGenerationJobResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleJobLogs
+   */
+  getPuzzleJobLogs: {
+    methodKind: "unary";
+    input: typeof PuzzleJobLogsRequestSchema;
+    output: typeof PuzzleJobLogsResponseSchema;
+  },
+  /**
+   * QueuePuzzleGeneration queues generation from the user's own annotated
+   * games for the local puzzle worker (cmd/puzzle-worker). Unless the user
+   * can create puzzles, the job needs approval.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.QueuePuzzleGeneration
+   */
+  queuePuzzleGeneration: {
+    methodKind: "unary";
+    input: typeof QueuePuzzleGenerationRequestSchema;
+    output: typeof QueuePuzzleGenerationResponseSchema;
+  },
+  /**
+   * ApprovePuzzleGenerationJob makes the puzzles of a job that needed
+   * approval available to everyone.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.ApprovePuzzleGenerationJob
+   */
+  approvePuzzleGenerationJob: {
+    methodKind: "unary";
+    input: typeof ApprovePuzzleGenerationJobRequestSchema;
+    output: typeof ApprovePuzzleGenerationJobResponseSchema;
+  },
+  /**
+   * Spaced-repetition training over the puzzles a user failed or was slow
+   * to solve. Reviews do not change puzzle or user ratings.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.GetReviewQueue
+   */
+  getReviewQueue: {
+    methodKind: "unary";
+    input: typeof ReviewQueueRequestSchema;
+    output: typeof ReviewQueueResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.StartStudySession
+   */
+  startStudySession: {
+    methodKind: "unary";
+    input: typeof StudySessionRequestSchema;
+    output: typeof StudySessionResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.SubmitReview
+   */
+  submitReview: {
+    methodKind: "unary";
+    input: typeof ReviewSubmissionRequestSchema;
+    output: typeof ReviewSubmissionResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.RemoveFromReviewQueue
+   */
+  removeFromReviewQueue: {
+    methodKind: "unary";
+    input: typeof PuzzleRequestSchema;
+    output: typeof RemoveFromReviewQueueResponseSchema;
+  },
+  /**
+   * Curated puzzle sets. Only puzzle creators can create, update or
+   * delete them.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.CreatePuzzleSet
+   */
+  createPuzzleSet: {
+    methodKind: "unary";
+    input: typeof CreatePuzzleSetRequestSchema;
+    output: typeof PuzzleSetResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.UpdatePuzzleSet
+   */
+  updatePuzzleSet: {
+    methodKind: "unary";
+    input: typeof UpdatePuzzleSetRequestSchema;
+    output: typeof PuzzleSetResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.DeletePuzzleSet
+   */
+  deletePuzzleSet: {
+    methodKind: "unary";
+    input: typeof PuzzleSetRequestSchema;
+    output: typeof DeletePuzzleSetResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleSets
+   */
+  getPuzzleSets: {
+    methodKind: "unary";
+    input: typeof PuzzleSetsRequestSchema;
+    output: typeof PuzzleSetsResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleSet
+   */
+  getPuzzleSet: {
+    methodKind: "unary";
+    input: typeof PuzzleSetRequestSchema;
+    output: typeof PuzzleSetResponseSchema;
+  },
+  /**
+   * The user's rating on each puzzle tag they have attempted.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleTagRatings
+   */
+  getPuzzleTagRatings: {
+    methodKind: "unary";
+    input: typeof PuzzleTagRatingsRequestSchema;
+    output: typeof PuzzleTagRatingsResponseSchema;
+  },
+  /**
+   * The daily puzzle is the same for everyone playing a lexicon. Each user
+   * gets one attempt, which does not change any ratings.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.GetDailyPuzzle
+   */
+  getDailyPuzzle: {
+    methodKind: "unary";
+    input: typeof DailyPuzzleRequestSchema;
+    output: typeof DailyPuzzleResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.SubmitDailyPuzzleAnswer
+   */
+  submitDailyPuzzleAnswer: {
+    methodKind: "unary";
+    input: typeof DailyPuzzleAnswerRequestSchema;
+    output: typeof DailyPuzzleAnswerResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetDailyPuzzleArchive
+   */
+  getDailyPuzzleArchive: {
+    methodKind: "unary";
+    input: typeof DailyPuzzleArchiveRequestSchema;
+    output: typeof DailyPuzzleArchiveResponseSchema;
+  },
+  /**
+   * Schedules a puzzle as a future daily puzzle. Days without a scheduled
+   * puzzle get the best voted puzzle that hasn't been a daily puzzle yet.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.SetDailyPuzzle
+   */
+  setDailyPuzzle: {
+    methodKind: "unary";
+    input: typeof SetDailyPuzzleRequestSchema;
+    output: typeof SetDailyPuzzleResponseSchema;
+  },
+  /**
+   * Puzzle rush: solve as many puzzles as possible before time runs out or
+   * the user makes too many mistakes. Puzzles get harder as the score goes
+   * up, and rush puzzles do not change any ratings.
+   *
+   * @generated from rpc puzzle_service.PuzzleService.StartPuzzleRush
+   */
+  startPuzzleRush: {
+    methodKind: "unary";
+    input: typeof StartPuzzleRushRequestSchema;
+    output: typeof PuzzleRushResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleRush
+   */
+  getPuzzleRush: {
+    methodKind: "unary";
+    input: typeof PuzzleRushRequestSchema;
+    output: typeof PuzzleRushResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.SubmitPuzzleRushAnswer
+   */
+  submitPuzzleRushAnswer: {
+    methodKind: "unary";
+    input: typeof PuzzleRushAnswerRequestSchema;
+    output: typeof PuzzleRushAnswerResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.EndPuzzleRush
+   */
+  endPuzzleRush: {
+    methodKind: "unary";
+    input: typeof PuzzleRushRequestSchema;
+    output: typeof PuzzleRushResponseSchema;
+  },
+  /**
+   * @generated from rpc puzzle_service.PuzzleService.GetPuzzleRushLeaderboard
+   */
+  getPuzzleRushLeaderboard: {
+    methodKind: "unary";
+    input: typeof PuzzleRushLeaderboardRequestSchema;
+    output: typeof PuzzleRushLeaderboardResponseSchema;
   },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_puzzle_service_puzzle_service, 0);
@@ -236,3 +236,20 @@ export const resetMonitoringStream = TournamentService.method.resetMonitoringStr
  * @generated from rpc tournament_service.TournamentService.GetTournamentMonitoring
  */
 export const getTournamentMonitoring = TournamentService.method.getTournamentMonitoring;
+
+/**
+ * UploadPrivateLexicon builds a lexicon out of a word list. It can only be
+ * played in the tournament or club it was uploaded for, and in the sessions
+ * of that club.
+ *
+ * @generated from rpc tournament_service.TournamentService.UploadPrivateLexicon
+ */
+export const uploadPrivateLexicon = TournamentService.method.uploadPrivateLexicon;
+
+/**
+ * GetPrivateLexica returns the private lexica that can be played in a
+ * tournament.
+ *
+ * @generated from rpc tournament_service.TournamentService.GetPrivateLexica
+ */
+export const getPrivateLexica = TournamentService.method.getPrivateLexica;
@@ -18,7 +18,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/tournament_service/tournament_service.proto.
  */
 export const file_proto_tournament_service_tournament_service: GenFile = /*@__PURE__*/
-  fileDesc("CjFwcm90by90b3VybmFtZW50X3NlcnZpY2UvdG91cm5hbWVudF9zZXJ2aWNlLnByb3RvEhJ0b3VybmFtZW50X3NlcnZpY2UiOQoRU3RhcnRSb3VuZFJlcXVlc3QSFQoNdG91cm5hbWVudF9pZBgBIAEoCRINCgVyb3VuZBgCIAEoBSKQAgoUTmV3VG91cm5hbWVudFJlcXVlc3QSDAoEc2x1ZxgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhoKEmRpcmVjdG9yX3VzZXJuYW1lcxgEIAMoCRInCgR0eXBlGAUgASgOMhkudG91cm5hbWVudF9zZXJ2aWNlLlRUeXBlEjgKFHNjaGVkdWxlZF9zdGFydF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI2ChJzY2hlZHVsZWRfZW5kX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGlybF9tb2RlGAggASgIIpQFChJUb3VybmFtZW50TWV0YWRhdGESCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIMCgRzbHVnGAQgASgJEicKBHR5cGUYBSABKA4yGS50b3VybmFtZW50X3NlcnZpY2UuVFR5cGUSEgoKZGlzY2xhaW1lchgGIAEoCRISCgp0aWxlX3N0eWxlGAcgASgJEhMKC2JvYXJkX3N0eWxlGAggASgJEi8KFWRlZmF1bHRfY2x1Yl9zZXR0aW5ncxgJIAEoCzIQLmlwYy5HYW1lUmVxdWVzdBIkChxmcmVlZm9ybV9jbHViX3NldHRpbmdfZmllbGRzGAogAygJEhAKCHBhc3N3b3JkGAsgASgJEgwKBGxvZ28YDCABKAkSDQoFY29sb3IYDSABKAkSGAoQcHJpdmF0ZV9hbmFseXNpcxgOIAEoCBIQCghpcmxfbW9kZRgPIAEoCBI4ChRzY2hlZHVsZWRfc3RhcnRfdGltZRgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNgoSc2NoZWR1bGVkX2VuZF90aW1lGBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1jaGVja2luc19vcGVuGBIgASgIEhkKEXJlZ2lzdHJhdGlvbl9vcGVuGBMgASgIEhEKCW1vbml0b3JlZBgUIAEoCBIWCg5maXJzdF9kaXJlY3RvchgVIAEoCRIYChByZWdpc3RyYW50X2NvdW50GBYgASgFEkAKCWRpdmlzaW9ucxgXIAMoCzItLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50RGl2aXNpb25TdW1tYXJ5InwKGVRvdXJuYW1lbnREaXZpc2lvblN1bW1hcnkSDAoEbmFtZRgBIAEoCRImCgxnYW1lX3JlcXVlc3QYAiABKAsyEC5pcGMuR2FtZVJlcXVlc3QSKQoOcm91bmRfY29udHJvbHMYAyADKAsyES5pcGMuUm91bmRDb250cm9sInQKHFNldFRvdXJuYW1lbnRNZXRhZGF0YVJlcXVlc3QSOAoIbWV0YWRhdGEYASABKAsyJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudE1ldGFkYXRhEhoKEnNldF9vbmx5X3NwZWNpZmllZBgCIAEoCCJlChpTaW5nbGVSb3VuZENvbnRyb2xzUmVxdWVzdBIKCgJpZBgBIAEoCRIQCghkaXZpc2lvbhgCIAEoCRIpCg5yb3VuZF9jb250cm9scxgDIAEoCzIRLmlwYy5Sb3VuZENvbnRyb2wibwoQUGFpclJvdW5kUmVxdWVzdBIKCgJpZBgBIAEoCRIQCghkaXZpc2lvbhgCIAEoCRINCgVyb3VuZBgDIAEoBRIVCg1wcmVzZXJ2ZV9ieWVzGAQgASgIEhcKD2RlbGV0ZV9wYWlyaW5ncxgFIAEoCCI5ChlUb3VybmFtZW50RGl2aXNpb25SZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJIowBChhUb3VybmFtZW50UGFpcmluZ1JlcXVlc3QSFQoNcGxheWVyX29uZV9pZBgBIAEoCRIVCg1wbGF5ZXJfdHdvX2lkGAIgASgJEg0KBXJvdW5kGAMgASgFEjMKEHNlbGZfcGxheV9yZXN1bHQYBCABKA4yGS5pcGMuVG91cm5hbWVudEdhbWVSZXN1bHQiRwoVRGl2aXNpb25SZW5hbWVSZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJEhAKCG5ld19uYW1lGAMgASgJImQKEU1vdmVQbGF5ZXJSZXF1ZXN0EgoKAmlkGAEgASgJEhcKD3NvdXJjZV9kaXZpc2lvbhgCIAEoCRIXCg90YXJnZXRfZGl2aXNpb24YAyABKAkSEQoJcGxheWVyX2lkGAQgASgJInkKGVRvdXJuYW1lbnRQYWlyaW5nc1JlcXVlc3QSCgoCaWQYASABKAkSEAoIZGl2aXNpb24YAiABKAkSPgoIcGFpcmluZ3MYAyADKAsyLC50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFBhaXJpbmdSZXF1ZXN0IvACCh9Ub3VybmFtZW50UmVzdWx0T3ZlcnJpZGVSZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJEhUKDXBsYXllcl9vbmVfaWQYAyABKAkSFQoNcGxheWVyX3R3b19pZBgEIAEoCRINCgVyb3VuZBgFIAEoBRIYChBwbGF5ZXJfb25lX3Njb3JlGAYgASgFEhgKEHBsYXllcl90d29fc2NvcmUYByABKAUSNAoRcGxheWVyX29uZV9yZXN1bHQYCCABKA4yGS5pcGMuVG91cm5hbWVudEdhbWVSZXN1bHQSNAoRcGxheWVyX3R3b19yZXN1bHQYCSABKA4yGS5pcGMuVG91cm5hbWVudEdhbWVSZXN1bHQSKwoPZ2FtZV9lbmRfcmVhc29uGAogASgOMhIuaXBjLkdhbWVFbmRSZWFzb24SEQoJYW1lbmRtZW50GAsgASgIEhIKCmdhbWVfaW5kZXgYDCABKAUibQokVG91cm5hbWVudFN0YXJ0Um91bmRDb3VudGRvd25SZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJEg0KBXJvdW5kGAMgASgFEhgKEHN0YXJ0X2FsbF9yb3VuZHMYBCABKAgiFAoSVG91cm5hbWVudFJlc3BvbnNlIjEKFU5ld1RvdXJuYW1lbnRSZXNwb25zZRIKCgJpZBgBIAEoCRIMCgRzbHVnGAIgASgJIjgKHEdldFRvdXJuYW1lbnRNZXRhZGF0YVJlcXVlc3QSCgoCaWQYASABKAkSDAoEc2x1ZxgCIAEoCSIiChRHZXRUb3VybmFtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSIlChdGaW5pc2hUb3VybmFtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSInChlVbmZpbmlzaFRvdXJuYW1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJImkKGlRvdXJuYW1lbnRNZXRhZGF0YVJlc3BvbnNlEjgKCG1ldGFkYXRhGAEgASgLMiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRNZXRhZGF0YRIRCglkaXJlY3RvcnMYAiADKAkiQwoSUmVjZW50R2FtZXNSZXF1ZXN0EgoKAmlkGAEgASgJEhEKCW51bV9nYW1lcxgCIAEoBRIOCgZvZmZzZXQYAyABKAUiQwoTUmVjZW50R2FtZXNSZXNwb25zZRIsCgVnYW1lcxgBIAMoCzIdLmlwYy5Ub3VybmFtZW50R2FtZUVuZGVkRXZlbnQiJgoYVW5zdGFydFRvdXJuYW1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJIiEKE1VuY2hlY2tBbGxJblJlcXVlc3QSCgoCaWQYASABKAkiMQojUmVtb3ZlQWxsUGxheWVyc05vdENoZWNrZWRJblJlcXVlc3QSCgoCaWQYASABKAkiLQoOQ2hlY2tpblJlcXVlc3QSCgoCaWQYASABKAkSDwoHY2hlY2tpbhgCIAEoCCJBCg9SZWdpc3RlclJlcXVlc3QSCgoCaWQYASABKAkSEAoIZGl2aXNpb24YAiABKAkSEAoIcmVnaXN0ZXIYAyABKAgiJQoXT3BlblJlZ2lzdHJhdGlvblJlcXVlc3QSCgoCaWQYASABKAkiJgoYQ2xvc2VSZWdpc3RyYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIiEKE09wZW5DaGVja2luc1JlcXVlc3QSCgoCaWQYASABKAkiQQoUQ2xvc2VDaGVja2luc1JlcXVlc3QSCgoCaWQYASABKAkSHQoVZGVsZXRlX25vbl9jaGVja2VkX2luGAIgASgIImoKGlRvdXJuYW1lbnRTY29yZWNhcmRSZXF1ZXN0EgoKAmlkGAEgASgJEhYKDnNob3dfb3Bwb25lbnRzGAIgASgIEhIKCnNob3dfc2VlZHMYAyABKAgSFAoMc2hvd19xcl9jb2RlGAQgASgIIi4KG1RvdXJuYW1lbnRTY29yZWNhcmRSZXNwb25zZRIPCgdwZGZfemlwGAEgASgMIigKJkdldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXF1ZXN0ImYKJ0dldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXNwb25zZRI7Cgt0b3VybmFtZW50cxgBIAMoCzImLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50TWV0YWRhdGEiKgoZR2V0UGFzdFRvdXJuYW1lbnRzUmVxdWVzdBINCgVsaW1pdBgBIAEoBSJZChpHZXRQYXN0VG91cm5hbWVudHNSZXNwb25zZRI7Cgt0b3VybmFtZW50cxgBIAMoCzImLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50TWV0YWRhdGEiGQoXR2V0TXlUb3VybmFtZW50c1JlcXVlc3QiVwoYR2V0TXlUb3VybmFtZW50c1Jlc3BvbnNlEjsKC3RvdXJuYW1lbnRzGAEgAygLMiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRNZXRhZGF0YSLKAgoNUnVuQ29wUmVxdWVzdBIKCgJpZBgBIAEoCRIQCghkaXZpc2lvbhgCIAEoCRINCgVyb3VuZBgDIAEoBRIWCg5wbGF5ZXJfY2xhc3NlcxgEIAMoBRIUCgxjbGFzc19wcml6ZXMYBSADKAUSHgoWY29udHJvbF9sb3NzX3RocmVzaG9sZBgGIAEoARIdChVob3BlZnVsbmVzc190aHJlc2hvbGQYByABKAESFAoMcGxhY2VfcHJpemVzGAggASgFEhUKDWRpdmlzaW9uX3NpbXMYCSABKAUSGQoRY29udHJvbF9sb3NzX3NpbXMYCiABKAUSJQodY29udHJvbF9sb3NzX2FjdGl2YXRpb25fcm91bmQYCyABKAUSGQoRYWxsb3dfcmVwZWF0X2J5ZXMYDCABKAgSFQoNZ2lic29uX3NwcmVhZBgNIAEoBSJNChdFeHBvcnRUb3VybmFtZW50UmVxdWVzdBIKCgJpZBgBIAEoCRIOCgZmb3JtYXQYAiABKAkSFgoOdXNlX3JlYWxfbmFtZXMYAyABKAgiLAoYRXhwb3J0VG91cm5hbWVudFJlc3BvbnNlEhAKCGV4cG9ydGVkGAEgASgJIlIKFU5ld0NsdWJTZXNzaW9uUmVxdWVzdBIoCgRkYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjbHViX2lkGAIgASgJIjoKE0NsdWJTZXNzaW9uUmVzcG9uc2USFQoNdG91cm5hbWVudF9pZBgBIAEoCRIMCgRzbHVnGAIgASgJIkYKGVJlY2VudENsdWJTZXNzaW9uc1JlcXVlc3QSCgoCaWQYASABKAkSDQoFY291bnQYAiABKAUSDgoGb2Zmc2V0GAMgASgFIlEKFENsdWJTZXNzaW9uc1Jlc3BvbnNlEjkKCHNlc3Npb25zGAEgAygLMicudG91cm5hbWVudF9zZXJ2aWNlLkNsdWJTZXNzaW9uUmVzcG9uc2UiOAofSW5pdGlhbGl6ZU1vbml0b3JpbmdLZXlzUmVxdWVzdBIVCg10b3VybmFtZW50X2lkGAEgASgJIkwKHlJlcXVlc3RNb25pdG9yaW5nU3RyZWFtUmVxdWVzdBIVCg10b3VybmFtZW50X2lkGAEgASgJEhMKC3N0cmVhbV90eXBlGAIgASgJIlsKHFJlc2V0TW9uaXRvcmluZ1N0cmVhbVJlcXVlc3QSFQoNdG91cm5hbWVudF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhMKC3N0cmVhbV90eXBlGAMgASgJIjcKHkdldFRvdXJuYW1lbnRNb25pdG9yaW5nUmVxdWVzdBIVCg10b3VybmFtZW50X2lkGAEgASgJIkwKH0dldFRvdXJuYW1lbnRNb25pdG9yaW5nUmVzcG9uc2USKQoMcGFydGljaXBhbnRzGAEgAygLMhMuaXBjLk1vbml0b3JpbmdEYXRhKjYKBVRUeXBlEgwKCFNUQU5EQVJEEAASCAoEQ0xVQhABEgkKBUNISUxEEAISCgoGTEVHQUNZEAMywiMKEVRvdXJuYW1lbnRTZXJ2aWNlEmQKDU5ld1RvdXJuYW1lbnQSKC50b3VybmFtZW50X3NlcnZpY2UuTmV3VG91cm5hbWVudFJlcXVlc3QaKS50b3VybmFtZW50X3NlcnZpY2UuTmV3VG91cm5hbWVudFJlc3BvbnNlEn4KFUdldFRvdXJuYW1lbnRNZXRhZGF0YRIwLnRvdXJuYW1lbnRfc2VydmljZS5HZXRUb3VybmFtZW50TWV0YWRhdGFSZXF1ZXN0Gi4udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRNZXRhZGF0YVJlc3BvbnNlIgOQAgESXAoNR2V0VG91cm5hbWVudBIoLnRvdXJuYW1lbnRfc2VydmljZS5HZXRUb3VybmFtZW50UmVxdWVzdBocLmlwYy5GdWxsVG91cm5hbWVudERpdmlzaW9ucyIDkAIBEmsKElVuZmluaXNoVG91cm5hbWVudBItLnRvdXJuYW1lbnRfc2VydmljZS5VbmZpbmlzaFRvdXJuYW1lbnRSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJnChBGaW5pc2hUb3VybmFtZW50EisudG91cm5hbWVudF9zZXJ2aWNlLkZpbmlzaFRvdXJuYW1lbnRSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJxChVTZXRUb3VybmFtZW50TWV0YWRhdGESMC50b3VybmFtZW50X3NlcnZpY2UuU2V0VG91cm5hbWVudE1ldGFkYXRhUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USWQoJUGFpclJvdW5kEiQudG91cm5hbWVudF9zZXJ2aWNlLlBhaXJSb3VuZFJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEnAKFlNldFNpbmdsZVJvdW5kQ29udHJvbHMSLi50b3VybmFtZW50X3NlcnZpY2UuU2luZ2xlUm91bmRDb250cm9sc1JlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlElYKEFNldFJvdW5kQ29udHJvbHMSGi5pcGMuRGl2aXNpb25Sb3VuZENvbnRyb2xzGiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJUChNTZXREaXZpc2lvbkNvbnRyb2xzEhUuaXBjLkRpdmlzaW9uQ29udHJvbHMaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEk4KDEFkZERpcmVjdG9ycxIWLmlwYy5Ub3VybmFtZW50UGVyc29ucxomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USUQoPUmVtb3ZlRGlyZWN0b3JzEhYuaXBjLlRvdXJuYW1lbnRQZXJzb25zGiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJkCgtBZGREaXZpc2lvbhItLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50RGl2aXNpb25SZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJjCg5SZW5hbWVEaXZpc2lvbhIpLnRvdXJuYW1lbnRfc2VydmljZS5EaXZpc2lvblJlbmFtZVJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEmcKDlJlbW92ZURpdmlzaW9uEi0udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnREaXZpc2lvblJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEkwKCkFkZFBsYXllcnMSFi5pcGMuVG91cm5hbWVudFBlcnNvbnMaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEk8KDVJlbW92ZVBsYXllcnMSFi5pcGMuVG91cm5hbWVudFBlcnNvbnMaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlElsKCk1vdmVQbGF5ZXISJS50b3VybmFtZW50X3NlcnZpY2UuTW92ZVBsYXllclJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEmMKClNldFBhaXJpbmcSLS50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFBhaXJpbmdzUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USaAoJU2V0UmVzdWx0EjMudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXN1bHRPdmVycmlkZVJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEncKE1N0YXJ0Um91bmRDb3VudGRvd24SOC50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFN0YXJ0Um91bmRDb3VudGRvd25SZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJjCgtSZWNlbnRHYW1lcxImLnRvdXJuYW1lbnRfc2VydmljZS5SZWNlbnRHYW1lc1JlcXVlc3QaJy50b3VybmFtZW50X3NlcnZpY2UuUmVjZW50R2FtZXNSZXNwb25zZSIDkAIBEmcKEUNyZWF0ZUNsdWJTZXNzaW9uEikudG91cm5hbWVudF9zZXJ2aWNlLk5ld0NsdWJTZXNzaW9uUmVxdWVzdBonLnRvdXJuYW1lbnRfc2VydmljZS5DbHViU2Vzc2lvblJlc3BvbnNlEnUKFUdldFJlY2VudENsdWJTZXNzaW9ucxItLnRvdXJuYW1lbnRfc2VydmljZS5SZWNlbnRDbHViU2Vzc2lvbnNSZXF1ZXN0GigudG91cm5hbWVudF9zZXJ2aWNlLkNsdWJTZXNzaW9uc1Jlc3BvbnNlIgOQAgESaQoRVW5zdGFydFRvdXJuYW1lbnQSLC50b3VybmFtZW50X3NlcnZpY2UuVW5zdGFydFRvdXJuYW1lbnRSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJnChBPcGVuUmVnaXN0cmF0aW9uEisudG91cm5hbWVudF9zZXJ2aWNlLk9wZW5SZWdpc3RyYXRpb25SZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJpChFDbG9zZVJlZ2lzdHJhdGlvbhIsLnRvdXJuYW1lbnRfc2VydmljZS5DbG9zZVJlZ2lzdHJhdGlvblJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEl8KDE9wZW5DaGVja2lucxInLnRvdXJuYW1lbnRfc2VydmljZS5PcGVuQ2hlY2tpbnNSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJhCg1DbG9zZUNoZWNraW5zEigudG91cm5hbWVudF9zZXJ2aWNlLkNsb3NlQ2hlY2tpbnNSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJfCgxVbmNoZWNrQWxsSW4SJy50b3VybmFtZW50X3NlcnZpY2UuVW5jaGVja0FsbEluUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USfwocUmVtb3ZlQWxsUGxheWVyc05vdENoZWNrZWRJbhI3LnRvdXJuYW1lbnRfc2VydmljZS5SZW1vdmVBbGxQbGF5ZXJzTm90Q2hlY2tlZEluUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USVQoHQ2hlY2tJbhIiLnRvdXJuYW1lbnRfc2VydmljZS5DaGVja2luUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USVwoIUmVnaXN0ZXISIy50b3VybmFtZW50X3NlcnZpY2UuUmVnaXN0ZXJSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJyChBFeHBvcnRUb3VybmFtZW50EisudG91cm5hbWVudF9zZXJ2aWNlLkV4cG9ydFRvdXJuYW1lbnRSZXF1ZXN0GiwudG91cm5hbWVudF9zZXJ2aWNlLkV4cG9ydFRvdXJuYW1lbnRSZXNwb25zZSIDkAIBEn8KF0dldFRvdXJuYW1lbnRTY29yZWNhcmRzEi4udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRTY29yZWNhcmRSZXF1ZXN0Gi8udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRTY29yZWNhcmRSZXNwb25zZSIDkAIBEp8BCh9HZXRSZWNlbnRBbmRVcGNvbWluZ1RvdXJuYW1lbnRzEjoudG91cm5hbWVudF9zZXJ2aWNlLkdldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXF1ZXN0GjsudG91cm5hbWVudF9zZXJ2aWNlLkdldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXNwb25zZSIDkAIBEngKEkdldFBhc3RUb3VybmFtZW50cxItLnRvdXJuYW1lbnRfc2VydmljZS5HZXRQYXN0VG91cm5hbWVudHNSZXF1ZXN0Gi4udG91cm5hbWVudF9zZXJ2aWNlLkdldFBhc3RUb3VybmFtZW50c1Jlc3BvbnNlIgOQAgEScgoQR2V0TXlUb3VybmFtZW50cxIrLnRvdXJuYW1lbnRfc2VydmljZS5HZXRNeVRvdXJuYW1lbnRzUmVxdWVzdBosLnRvdXJuYW1lbnRfc2VydmljZS5HZXRNeVRvdXJuYW1lbnRzUmVzcG9uc2UiA5ACARI+CgZSdW5DT1ASIS50b3VybmFtZW50X3NlcnZpY2UuUnVuQ29wUmVxdWVzdBoRLmlwYy5QYWlyUmVzcG9uc2USdwoYSW5pdGlhbGl6ZU1vbml0b3JpbmdLZXlzEjMudG91cm5hbWVudF9zZXJ2aWNlLkluaXRpYWxpemVNb25pdG9yaW5nS2V5c1JlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEnUKF1JlcXVlc3RNb25pdG9yaW5nU3RyZWFtEjIudG91cm5hbWVudF9zZXJ2aWNlLlJlcXVlc3RNb25pdG9yaW5nU3RyZWFtUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2UScQoVUmVzZXRNb25pdG9yaW5nU3RyZWFtEjAudG91cm5hbWVudF9zZXJ2aWNlLlJlc2V0TW9uaXRvcmluZ1N0cmVhbVJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEocBChdHZXRUb3VybmFtZW50TW9uaXRvcmluZxIyLnRvdXJuYW1lbnRfc2VydmljZS5HZXRUb3VybmFtZW50TW9uaXRvcmluZ1JlcXVlc3QaMy50b3VybmFtZW50X3NlcnZpY2UuR2V0VG91cm5hbWVudE1vbml0b3JpbmdSZXNwb25zZSIDkAIBQtQBChZjb20udG91cm5hbWVudF9zZXJ2aWNlQhZUb3VybmFtZW50U2VydmljZVByb3RvUAFaPmdpdGh1Yi5jb20vd29vZ2xlcy1pby9saXdvcmRzL3JwYy9hcGkvcHJvdG8vdG91cm5hbWVudF9zZXJ2aWNlogIDVFhYqgIRVG91cm5hbWVudFNlcnZpY2XKAhFUb3VybmFtZW50U2VydmljZeICHVRvdXJuYW1lbnRTZXJ2aWNlXEdQQk1ldGFkYXRh6gIRVG91cm5hbWVudFNlcnZpY2ViBnByb3RvMw", [file_proto_ipc_omgwords, file_proto_ipc_tournament, file_google_protobuf_timestamp, file_proto_ipc_pair]);
+  fileDesc("CjFwcm90by90b3VybmFtZW50X3NlcnZpY2UvdG91cm5hbWVudF9zZXJ2aWNlLnByb3RvEhJ0b3VybmFtZW50X3NlcnZpY2UiOQoRU3RhcnRSb3VuZFJlcXVlc3QSFQoNdG91cm5hbWVudF9pZBgBIAEoCRINCgVyb3VuZBgCIAEoBSKQAgoUTmV3VG91cm5hbWVudFJlcXVlc3QSDAoEc2x1ZxgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEhoKEmRpcmVjdG9yX3VzZXJuYW1lcxgEIAMoCRInCgR0eXBlGAUgASgOMhkudG91cm5hbWVudF9zZXJ2aWNlLlRUeXBlEjgKFHNjaGVkdWxlZF9zdGFydF90aW1lGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI2ChJzY2hlZHVsZWRfZW5kX3RpbWUYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGlybF9tb2RlGAggASgIIpQFChJUb3VybmFtZW50TWV0YWRhdGESCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIMCgRzbHVnGAQgASgJEicKBHR5cGUYBSABKA4yGS50b3VybmFtZW50X3NlcnZpY2UuVFR5cGUSEgoKZGlzY2xhaW1lchgGIAEoCRISCgp0aWxlX3N0eWxlGAcgASgJEhMKC2JvYXJkX3N0eWxlGAggASgJEi8KFWRlZmF1bHRfY2x1Yl9zZXR0aW5ncxgJIAEoCzIQLmlwYy5HYW1lUmVxdWVzdBIkChxmcmVlZm9ybV9jbHViX3NldHRpbmdfZmllbGRzGAogAygJEhAKCHBhc3N3b3JkGAsgASgJEgwKBGxvZ28YDCABKAkSDQoFY29sb3IYDSABKAkSGAoQcHJpdmF0ZV9hbmFseXNpcxgOIAEoCBIQCghpcmxfbW9kZRgPIAEoCBI4ChRzY2hlZHVsZWRfc3RhcnRfdGltZRgQIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNgoSc2NoZWR1bGVkX2VuZF90aW1lGBEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1jaGVja2luc19vcGVuGBIgASgIEhkKEXJlZ2lzdHJhdGlvbl9vcGVuGBMgASgIEhEKCW1vbml0b3JlZBgUIAEoCBIWCg5maXJzdF9kaXJlY3RvchgVIAEoCRIYChByZWdpc3RyYW50X2NvdW50GBYgASgFEkAKCWRpdmlzaW9ucxgXIAMoCzItLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50RGl2aXNpb25TdW1tYXJ5InwKGVRvdXJuYW1lbnREaXZpc2lvblN1bW1hcnkSDAoEbmFtZRgBIAEoCRImCgxnYW1lX3JlcXVlc3QYAiABKAsyEC5pcGMuR2FtZVJlcXVlc3QSKQoOcm91bmRfY29udHJvbHMYAyADKAsyES5pcGMuUm91bmRDb250cm9sInQKHFNldFRvdXJuYW1lbnRNZXRhZGF0YVJlcXVlc3QSOAoIbWV0YWRhdGEYASABKAsyJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudE1ldGFkYXRhEhoKEnNldF9vbmx5X3NwZWNpZmllZBgCIAEoCCJlChpTaW5nbGVSb3VuZENvbnRyb2xzUmVxdWVzdBIKCgJpZBgBIAEoCRIQCghkaXZpc2lvbhgCIAEoCRIpCg5yb3VuZF9jb250cm9scxgDIAEoCzIRLmlwYy5Sb3VuZENvbnRyb2wibwoQUGFpclJvdW5kUmVxdWVzdBIKCgJpZBgBIAEoCRIQCghkaXZpc2lvbhgCIAEoCRINCgVyb3VuZBgDIAEoBRIVCg1wcmVzZXJ2ZV9ieWVzGAQgASgIEhcKD2RlbGV0ZV9wYWlyaW5ncxgFIAEoCCI5ChlUb3VybmFtZW50RGl2aXNpb25SZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJIowBChhUb3VybmFtZW50UGFpcmluZ1JlcXVlc3QSFQoNcGxheWVyX29uZV9pZBgBIAEoCRIVCg1wbGF5ZXJfdHdvX2lkGAIgASgJEg0KBXJvdW5kGAMgASgFEjMKEHNlbGZfcGxheV9yZXN1bHQYBCABKA4yGS5pcGMuVG91cm5hbWVudEdhbWVSZXN1bHQiRwoVRGl2aXNpb25SZW5hbWVSZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJEhAKCG5ld19uYW1lGAMgASgJImQKEU1vdmVQbGF5ZXJSZXF1ZXN0EgoKAmlkGAEgASgJEhcKD3NvdXJjZV9kaXZpc2lvbhgCIAEoCRIXCg90YXJnZXRfZGl2aXNpb24YAyABKAkSEQoJcGxheWVyX2lkGAQgASgJInkKGVRvdXJuYW1lbnRQYWlyaW5nc1JlcXVlc3QSCgoCaWQYASABKAkSEAoIZGl2aXNpb24YAiABKAkSPgoIcGFpcmluZ3MYAyADKAsyLC50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFBhaXJpbmdSZXF1ZXN0IvACCh9Ub3VybmFtZW50UmVzdWx0T3ZlcnJpZGVSZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJEhUKDXBsYXllcl9vbmVfaWQYAyABKAkSFQoNcGxheWVyX3R3b19pZBgEIAEoCRINCgVyb3VuZBgFIAEoBRIYChBwbGF5ZXJfb25lX3Njb3JlGAYgASgFEhgKEHBsYXllcl90d29fc2NvcmUYByABKAUSNAoRcGxheWVyX29uZV9yZXN1bHQYCCABKA4yGS5pcGMuVG91cm5hbWVudEdhbWVSZXN1bHQSNAoRcGxheWVyX3R3b19yZXN1bHQYCSABKA4yGS5pcGMuVG91cm5hbWVudEdhbWVSZXN1bHQSKwoPZ2FtZV9lbmRfcmVhc29uGAogASgOMhIuaXBjLkdhbWVFbmRSZWFzb24SEQoJYW1lbmRtZW50GAsgASgIEhIKCmdhbWVfaW5kZXgYDCABKAUibQokVG91cm5hbWVudFN0YXJ0Um91bmRDb3VudGRvd25SZXF1ZXN0EgoKAmlkGAEgASgJEhAKCGRpdmlzaW9uGAIgASgJEg0KBXJvdW5kGAMgASgFEhgKEHN0YXJ0X2FsbF9yb3VuZHMYBCABKAgiFAoSVG91cm5hbWVudFJlc3BvbnNlIjEKFU5ld1RvdXJuYW1lbnRSZXNwb25zZRIKCgJpZBgBIAEoCRIMCgRzbHVnGAIgASgJIjgKHEdldFRvdXJuYW1lbnRNZXRhZGF0YVJlcXVlc3QSCgoCaWQYASABKAkSDAoEc2x1ZxgCIAEoCSIiChRHZXRUb3VybmFtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSIlChdGaW5pc2hUb3VybmFtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSInChlVbmZpbmlzaFRvdXJuYW1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJImkKGlRvdXJuYW1lbnRNZXRhZGF0YVJlc3BvbnNlEjgKCG1ldGFkYXRhGAEgASgLMiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRNZXRhZGF0YRIRCglkaXJlY3RvcnMYAiADKAkiQwoSUmVjZW50R2FtZXNSZXF1ZXN0EgoKAmlkGAEgASgJEhEKCW51bV9nYW1lcxgCIAEoBRIOCgZvZmZzZXQYAyABKAUiQwoTUmVjZW50R2FtZXNSZXNwb25zZRIsCgVnYW1lcxgBIAMoCzIdLmlwYy5Ub3VybmFtZW50R2FtZUVuZGVkRXZlbnQiJgoYVW5zdGFydFRvdXJuYW1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJIiEKE1VuY2hlY2tBbGxJblJlcXVlc3QSCgoCaWQYASABKAkiMQojUmVtb3ZlQWxsUGxheWVyc05vdENoZWNrZWRJblJlcXVlc3QSCgoCaWQYASABKAkiLQoOQ2hlY2tpblJlcXVlc3QSCgoCaWQYASABKAkSDwoHY2hlY2tpbhgCIAEoCCJBCg9SZWdpc3RlclJlcXVlc3QSCgoCaWQYASABKAkSEAoIZGl2aXNpb24YAiABKAkSEAoIcmVnaXN0ZXIYAyABKAgiJQoXT3BlblJlZ2lzdHJhdGlvblJlcXVlc3QSCgoCaWQYASABKAkiJgoYQ2xvc2VSZWdpc3RyYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIiEKE09wZW5DaGVja2luc1JlcXVlc3QSCgoCaWQYASABKAkiQQoUQ2xvc2VDaGVja2luc1JlcXVlc3QSCgoCaWQYASABKAkSHQoVZGVsZXRlX25vbl9jaGVja2VkX2luGAIgASgIImoKGlRvdXJuYW1lbnRTY29yZWNhcmRSZXF1ZXN0EgoKAmlkGAEgASgJEhYKDnNob3dfb3Bwb25lbnRzGAIgASgIEhIKCnNob3dfc2VlZHMYAyABKAgSFAoMc2hvd19xcl9jb2RlGAQgASgIIi4KG1RvdXJuYW1lbnRTY29yZWNhcmRSZXNwb25zZRIPCgdwZGZfemlwGAEgASgMIigKJkdldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXF1ZXN0ImYKJ0dldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXNwb25zZRI7Cgt0b3VybmFtZW50cxgBIAMoCzImLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50TWV0YWRhdGEiKgoZR2V0UGFzdFRvdXJuYW1lbnRzUmVxdWVzdBINCgVsaW1pdBgBIAEoBSJZChpHZXRQYXN0VG91cm5hbWVudHNSZXNwb25zZRI7Cgt0b3VybmFtZW50cxgBIAMoCzImLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50TWV0YWRhdGEiGQoXR2V0TXlUb3VybmFtZW50c1JlcXVlc3QiVwoYR2V0TXlUb3VybmFtZW50c1Jlc3BvbnNlEjsKC3RvdXJuYW1lbnRzGAEgAygLMiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRNZXRhZGF0YSLKAgoNUnVuQ29wUmVxdWVzdBIKCgJpZBgBIAEoCRIQCghkaXZpc2lvbhgCIAEoCRINCgVyb3VuZBgDIAEoBRIWCg5wbGF5ZXJfY2xhc3NlcxgEIAMoBRIUCgxjbGFzc19wcml6ZXMYBSADKAUSHgoWY29udHJvbF9sb3NzX3RocmVzaG9sZBgGIAEoARIdChVob3BlZnVsbmVzc190aHJlc2hvbGQYByABKAESFAoMcGxhY2VfcHJpemVzGAggASgFEhUKDWRpdmlzaW9uX3NpbXMYCSABKAUSGQoRY29udHJvbF9sb3NzX3NpbXMYCiABKAUSJQodY29udHJvbF9sb3NzX2FjdGl2YXRpb25fcm91bmQYCyABKAUSGQoRYWxsb3dfcmVwZWF0X2J5ZXMYDCABKAgSFQoNZ2lic29uX3NwcmVhZBgNIAEoBSJNChdFeHBvcnRUb3VybmFtZW50UmVxdWVzdBIKCgJpZBgBIAEoCRIOCgZmb3JtYXQYAiABKAkSFgoOdXNlX3JlYWxfbmFtZXMYAyABKAgiLAoYRXhwb3J0VG91cm5hbWVudFJlc3BvbnNlEhAKCGV4cG9ydGVkGAEgASgJIlIKFU5ld0NsdWJTZXNzaW9uUmVxdWVzdBIoCgRkYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjbHViX2lkGAIgASgJIjoKE0NsdWJTZXNzaW9uUmVzcG9uc2USFQoNdG91cm5hbWVudF9pZBgBIAEoCRIMCgRzbHVnGAIgASgJIkYKGVJlY2VudENsdWJTZXNzaW9uc1JlcXVlc3QSCgoCaWQYASABKAkSDQoFY291bnQYAiABKAUSDgoGb2Zmc2V0GAMgASgFIlEKFENsdWJTZXNzaW9uc1Jlc3BvbnNlEjkKCHNlc3Npb25zGAEgAygLMicudG91cm5hbWVudF9zZXJ2aWNlLkNsdWJTZXNzaW9uUmVzcG9uc2UiOAofSW5pdGlhbGl6ZU1vbml0b3JpbmdLZXlzUmVxdWVzdBIVCg10b3VybmFtZW50X2lkGAEgASgJIkwKHlJlcXVlc3RNb25pdG9yaW5nU3RyZWFtUmVxdWVzdBIVCg10b3VybmFtZW50X2lkGAEgASgJEhMKC3N0cmVhbV90eXBlGAIgASgJIlsKHFJlc2V0TW9uaXRvcmluZ1N0cmVhbVJlcXVlc3QSFQoNdG91cm5hbWVudF9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhMKC3N0cmVhbV90eXBlGAMgASgJIjcKHkdldFRvdXJuYW1lbnRNb25pdG9yaW5nUmVxdWVzdBIVCg10b3VybmFtZW50X2lkGAEgASgJIkwKH0dldFRvdXJuYW1lbnRNb25pdG9yaW5nUmVzcG9uc2USKQoMcGFydGljaXBhbnRzGAEgAygLMhMuaXBjLk1vbml0b3JpbmdEYXRhInIKG1VwbG9hZFByaXZhdGVMZXhpY29uUmVxdWVzdBIVCg10b3VybmFtZW50X2lkGAEgASgJEgwKBG5hbWUYAiABKAkSGwoTbGV0dGVyX2Rpc3RyaWJ1dGlvbhgDIAEoCRIRCgl3b3JkX2xpc3QYBCABKAkifwoOUHJpdmF0ZUxleGljb24SDAoEbmFtZRgBIAEoCRIbChNsZXR0ZXJfZGlzdHJpYnV0aW9uGAIgASgJEhIKCndvcmRfY291bnQYAyABKAUSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiMAoXR2V0UHJpdmF0ZUxleGljYVJlcXVlc3QSFQoNdG91cm5hbWVudF9pZBgBIAEoCSJOChhHZXRQcml2YXRlTGV4aWNhUmVzcG9uc2USMgoGbGV4aWNhGAEgAygLMiIudG91cm5hbWVudF9zZXJ2aWNlLlByaXZhdGVMZXhpY29uKjYKBVRUeXBlEgwKCFNUQU5EQVJEEAASCAoEQ0xVQhABEgkKBUNISUxEEAISCgoGTEVHQUNZEAMyoyUKEVRvdXJuYW1lbnRTZXJ2aWNlEmQKDU5ld1RvdXJuYW1lbnQSKC50b3VybmFtZW50X3NlcnZpY2UuTmV3VG91cm5hbWVudFJlcXVlc3QaKS50b3VybmFtZW50X3NlcnZpY2UuTmV3VG91cm5hbWVudFJlc3BvbnNlEn4KFUdldFRvdXJuYW1lbnRNZXRhZGF0YRIwLnRvdXJuYW1lbnRfc2VydmljZS5HZXRUb3VybmFtZW50TWV0YWRhdGFSZXF1ZXN0Gi4udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRNZXRhZGF0YVJlc3BvbnNlIgOQAgESXAoNR2V0VG91cm5hbWVudBIoLnRvdXJuYW1lbnRfc2VydmljZS5HZXRUb3VybmFtZW50UmVxdWVzdBocLmlwYy5GdWxsVG91cm5hbWVudERpdmlzaW9ucyIDkAIBEmsKElVuZmluaXNoVG91cm5hbWVudBItLnRvdXJuYW1lbnRfc2VydmljZS5VbmZpbmlzaFRvdXJuYW1lbnRSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJnChBGaW5pc2hUb3VybmFtZW50EisudG91cm5hbWVudF9zZXJ2aWNlLkZpbmlzaFRvdXJuYW1lbnRSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJxChVTZXRUb3VybmFtZW50TWV0YWRhdGESMC50b3VybmFtZW50X3NlcnZpY2UuU2V0VG91cm5hbWVudE1ldGFkYXRhUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USWQoJUGFpclJvdW5kEiQudG91cm5hbWVudF9zZXJ2aWNlLlBhaXJSb3VuZFJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEnAKFlNldFNpbmdsZVJvdW5kQ29udHJvbHMSLi50b3VybmFtZW50X3NlcnZpY2UuU2luZ2xlUm91bmRDb250cm9sc1JlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlElYKEFNldFJvdW5kQ29udHJvbHMSGi5pcGMuRGl2aXNpb25Sb3VuZENvbnRyb2xzGiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJUChNTZXREaXZpc2lvbkNvbnRyb2xzEhUuaXBjLkRpdmlzaW9uQ29udHJvbHMaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEk4KDEFkZERpcmVjdG9ycxIWLmlwYy5Ub3VybmFtZW50UGVyc29ucxomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USUQoPUmVtb3ZlRGlyZWN0b3JzEhYuaXBjLlRvdXJuYW1lbnRQZXJzb25zGiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJkCgtBZGREaXZpc2lvbhItLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50RGl2aXNpb25SZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJjCg5SZW5hbWVEaXZpc2lvbhIpLnRvdXJuYW1lbnRfc2VydmljZS5EaXZpc2lvblJlbmFtZVJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEmcKDlJlbW92ZURpdmlzaW9uEi0udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnREaXZpc2lvblJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEkwKCkFkZFBsYXllcnMSFi5pcGMuVG91cm5hbWVudFBlcnNvbnMaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEk8KDVJlbW92ZVBsYXllcnMSFi5pcGMuVG91cm5hbWVudFBlcnNvbnMaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlElsKCk1vdmVQbGF5ZXISJS50b3VybmFtZW50X3NlcnZpY2UuTW92ZVBsYXllclJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEmMKClNldFBhaXJpbmcSLS50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFBhaXJpbmdzUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USaAoJU2V0UmVzdWx0EjMudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXN1bHRPdmVycmlkZVJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEncKE1N0YXJ0Um91bmRDb3VudGRvd24SOC50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFN0YXJ0Um91bmRDb3VudGRvd25SZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJjCgtSZWNlbnRHYW1lcxImLnRvdXJuYW1lbnRfc2VydmljZS5SZWNlbnRHYW1lc1JlcXVlc3QaJy50b3VybmFtZW50X3NlcnZpY2UuUmVjZW50R2FtZXNSZXNwb25zZSIDkAIBEmcKEUNyZWF0ZUNsdWJTZXNzaW9uEikudG91cm5hbWVudF9zZXJ2aWNlLk5ld0NsdWJTZXNzaW9uUmVxdWVzdBonLnRvdXJuYW1lbnRfc2VydmljZS5DbHViU2Vzc2lvblJlc3BvbnNlEnUKFUdldFJlY2VudENsdWJTZXNzaW9ucxItLnRvdXJuYW1lbnRfc2VydmljZS5SZWNlbnRDbHViU2Vzc2lvbnNSZXF1ZXN0GigudG91cm5hbWVudF9zZXJ2aWNlLkNsdWJTZXNzaW9uc1Jlc3BvbnNlIgOQAgESaQoRVW5zdGFydFRvdXJuYW1lbnQSLC50b3VybmFtZW50X3NlcnZpY2UuVW5zdGFydFRvdXJuYW1lbnRSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJnChBPcGVuUmVnaXN0cmF0aW9uEisudG91cm5hbWVudF9zZXJ2aWNlLk9wZW5SZWdpc3RyYXRpb25SZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJpChFDbG9zZVJlZ2lzdHJhdGlvbhIsLnRvdXJuYW1lbnRfc2VydmljZS5DbG9zZVJlZ2lzdHJhdGlvblJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEl8KDE9wZW5DaGVja2lucxInLnRvdXJuYW1lbnRfc2VydmljZS5PcGVuQ2hlY2tpbnNSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJhCg1DbG9zZUNoZWNraW5zEigudG91cm5hbWVudF9zZXJ2aWNlLkNsb3NlQ2hlY2tpbnNSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJfCgxVbmNoZWNrQWxsSW4SJy50b3VybmFtZW50X3NlcnZpY2UuVW5jaGVja0FsbEluUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USfwocUmVtb3ZlQWxsUGxheWVyc05vdENoZWNrZWRJbhI3LnRvdXJuYW1lbnRfc2VydmljZS5SZW1vdmVBbGxQbGF5ZXJzTm90Q2hlY2tlZEluUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USVQoHQ2hlY2tJbhIiLnRvdXJuYW1lbnRfc2VydmljZS5DaGVja2luUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2USVwoIUmVnaXN0ZXISIy50b3VybmFtZW50X3NlcnZpY2UuUmVnaXN0ZXJSZXF1ZXN0GiYudG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRSZXNwb25zZRJyChBFeHBvcnRUb3VybmFtZW50EisudG91cm5hbWVudF9zZXJ2aWNlLkV4cG9ydFRvdXJuYW1lbnRSZXF1ZXN0GiwudG91cm5hbWVudF9zZXJ2aWNlLkV4cG9ydFRvdXJuYW1lbnRSZXNwb25zZSIDkAIBEn8KF0dldFRvdXJuYW1lbnRTY29yZWNhcmRzEi4udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRTY29yZWNhcmRSZXF1ZXN0Gi8udG91cm5hbWVudF9zZXJ2aWNlLlRvdXJuYW1lbnRTY29yZWNhcmRSZXNwb25zZSIDkAIBEp8BCh9HZXRSZWNlbnRBbmRVcGNvbWluZ1RvdXJuYW1lbnRzEjoudG91cm5hbWVudF9zZXJ2aWNlLkdldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXF1ZXN0GjsudG91cm5hbWVudF9zZXJ2aWNlLkdldFJlY2VudEFuZFVwY29taW5nVG91cm5hbWVudHNSZXNwb25zZSIDkAIBEngKEkdldFBhc3RUb3VybmFtZW50cxItLnRvdXJuYW1lbnRfc2VydmljZS5HZXRQYXN0VG91cm5hbWVudHNSZXF1ZXN0Gi4udG91cm5hbWVudF9zZXJ2aWNlLkdldFBhc3RUb3VybmFtZW50c1Jlc3BvbnNlIgOQAgEScgoQR2V0TXlUb3VybmFtZW50cxIrLnRvdXJuYW1lbnRfc2VydmljZS5HZXRNeVRvdXJuYW1lbnRzUmVxdWVzdBosLnRvdXJuYW1lbnRfc2VydmljZS5HZXRNeVRvdXJuYW1lbnRzUmVzcG9uc2UiA5ACARI+CgZSdW5DT1ASIS50b3VybmFtZW50X3NlcnZpY2UuUnVuQ29wUmVxdWVzdBoRLmlwYy5QYWlyUmVzcG9uc2USdwoYSW5pdGlhbGl6ZU1vbml0b3JpbmdLZXlzEjMudG91cm5hbWVudF9zZXJ2aWNlLkluaXRpYWxpemVNb25pdG9yaW5nS2V5c1JlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEnUKF1JlcXVlc3RNb25pdG9yaW5nU3RyZWFtEjIudG91cm5hbWVudF9zZXJ2aWNlLlJlcXVlc3RNb25pdG9yaW5nU3RyZWFtUmVxdWVzdBomLnRvdXJuYW1lbnRfc2VydmljZS5Ub3VybmFtZW50UmVzcG9uc2UScQoVUmVzZXRNb25pdG9yaW5nU3RyZWFtEjAudG91cm5hbWVudF9zZXJ2aWNlLlJlc2V0TW9uaXRvcmluZ1N0cmVhbVJlcXVlc3QaJi50b3VybmFtZW50X3NlcnZpY2UuVG91cm5hbWVudFJlc3BvbnNlEocBChdHZXRUb3VybmFtZW50TW9uaXRvcmluZxIyLnRvdXJuYW1lbnRfc2VydmljZS5HZXRUb3VybmFtZW50TW9uaXRvcmluZ1JlcXVlc3QaMy50b3VybmFtZW50X3NlcnZpY2UuR2V0VG91cm5hbWVudE1vbml0b3JpbmdSZXNwb25zZSIDkAIBEmsKFFVwbG9hZFByaXZhdGVMZXhpY29uEi8udG91cm5hbWVudF9zZXJ2aWNlLlVwbG9hZFByaXZhdGVMZXhpY29uUmVxdWVzdBoiLnRvdXJuYW1lbnRfc2VydmljZS5Qcml2YXRlTGV4aWNvbhJyChBHZXRQcml2YXRlTGV4aWNhEisudG91cm5hbWVudF9zZXJ2aWNlLkdldFByaXZhdGVMZXhpY2FSZXF1ZXN0GiwudG91cm5hbWVudF9zZXJ2aWNlLkdldFByaXZhdGVMZXhpY2FSZXNwb25zZSIDkAIBQtQBChZjb20udG91cm5hbWVudF9zZXJ2aWNlQhZUb3VybmFtZW50U2VydmljZVByb3RvUAFaPmdpdGh1Yi5jb20vd29vZ2xlcy1pby9saXdvcmRzL3JwYy9hcGkvcHJvdG8vdG91cm5hbWVudF9zZXJ2aWNlogIDVFhYqgIRVG91cm5hbWVudFNlcnZpY2XKAhFUb3VybmFtZW50U2VydmljZeICHVRvdXJuYW1lbnRTZXJ2aWNlXEdQQk1ldGFkYXRh6gIRVG91cm5hbWVudFNlcnZpY2ViBnByb3RvMw", [file_proto_ipc_omgwords, file_proto_ipc_tournament, file_google_protobuf_timestamp, file_proto_ipc_pair]);
 
 /**
  * @generated from message tournament_service.StartRoundRequest
@@ -1460,6 +1460,110 @@ export type GetTournamentMonitoringResponse = Message<"tournament_service.GetTou
 export const GetTournamentMonitoringResponseSchema: GenMessage<GetTournamentMonitoringResponse> = /*@__PURE__*/
   messageDesc(file_proto_tournament_service_tournament_service, 51);
 
+/**
+ * @generated from message tournament_service.UploadPrivateLexiconRequest
+ */
+export type UploadPrivateLexiconRequest = Message<"tournament_service.UploadPrivateLexiconRequest"> & {
+  /**
+   * @generated from field: string tournament_id = 1;
+   */
+  tournamentId: string;
+
+  /**
+   * name may have up to 16 letters and digits. The lexicon is called
+   * PRIV_<name>.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string letter_distribution = 3;
+   */
+  letterDistribution: string;
+
+  /**
+   * word_list has one word per line. Anything after the word on a line is
+   * ignored, as are empty lines and lines starting with #.
+   *
+   * @generated from field: string word_list = 4;
+   */
+  wordList: string;
+};
+
+/**
+ * Describes the message tournament_service.UploadPrivateLexiconRequest.
+ * Use `create(UploadPrivateLexiconRequestSchema)` to create a new message.
+ */
+export const UploadPrivateLexiconRequestSchema: GenMessage<UploadPrivateLexiconRequest> = /*@__PURE__*/
+  messageDesc(file_proto_tournament_service_tournament_service, 52);
+
+/**
+ * @generated from message tournament_service.PrivateLexicon
+ */
+export type PrivateLexicon = Message<"tournament_service.PrivateLexicon"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string letter_distribution = 2;
+   */
+  letterDistribution: string;
+
+  /**
+   * @generated from field: int32 word_count = 3;
+   */
+  wordCount: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message tournament_service.PrivateLexicon.
+ * Use `create(PrivateLexiconSchema)` to create a new message.
+ */
+export const PrivateLexiconSchema: GenMessage<PrivateLexicon> = /*@__PURE__*/
+  messageDesc(file_proto_tournament_service_tournament_service, 53);
+
+/**
+ * @generated from message tournament_service.GetPrivateLexicaRequest
+ */
+export type GetPrivateLexicaRequest = Message<"tournament_service.GetPrivateLexicaRequest"> & {
+  /**
+   * @generated from field: string tournament_id = 1;
+   */
+  tournamentId: string;
+};
+
+/**
+ * Describes the message tournament_service.GetPrivateLexicaRequest.
+ * Use `create(GetPrivateLexicaRequestSchema)` to create a new message.
+ */
+export const GetPrivateLexicaRequestSchema: GenMessage<GetPrivateLexicaRequest> = /*@__PURE__*/
+  messageDesc(file_proto_tournament_service_tournament_service, 54);
+
+/**
+ * @generated from message tournament_service.GetPrivateLexicaResponse
+ */
+export type GetPrivateLexicaResponse = Message<"tournament_service.GetPrivateLexicaResponse"> & {
+  /**
+   * @generated from field: repeated tournament_service.PrivateLexicon lexica = 1;
+   */
+  lexica: PrivateLexicon[];
+};
+
+/**
+ * Describes the message tournament_service.GetPrivateLexicaResponse.
+ * Use `create(GetPrivateLexicaResponseSchema)` to create a new message.
+ */
+export const GetPrivateLexicaResponseSchema: GenMessage<GetPrivateLexicaResponse> = /*@__PURE__*/
+  messageDesc(file_proto_tournament_service_tournament_service, 55);
+
 /**
  * @generated from enum tournament_service.TType
  */
@@ -1865,6 +1969,29 @@ export const TournamentService: GenService<{
     input: typeof GetTournamentMonitoringRequestSchema;
     output: typeof GetTournamentMonitoringResponseSchema;
   },
+  /**
+   * UploadPrivateLexicon builds a lexicon out of a word list. It can only be
+   * played in the tournament or club it was uploaded for, and in the sessions
+   * of that club.
+   *
+   * @generated from rpc tournament_service.TournamentService.UploadPrivateLexicon
+   */
+  uploadPrivateLexicon: {
+    methodKind: "unary";
+    input: typeof UploadPrivateLexiconRequestSchema;
+    output: typeof PrivateLexiconSchema;
+  },
+  /**
+   * GetPrivateLexica returns the private lexica that can be played in a
+   * tournament.
+   *
+   * @generated from rpc tournament_service.TournamentService.GetPrivateLexica
+   */
+  getPrivateLexica: {
+    methodKind: "unary";
+    input: typeof GetPrivateLexicaRequestSchema;
+    output: typeof GetPrivateLexicaResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_tournament_service_tournament_service, 0);
 
@@ -13,3 +13,13 @@ export const getIntegrations = IntegrationService.method.getIntegrations;
  * @generated from rpc user_service.IntegrationService.DeleteIntegration
  */
 export const deleteIntegration = IntegrationService.method.deleteIntegration;
+
+/**
+ * @generated from rpc user_service.IntegrationService.GetLoginIdentities
+ */
+export const getLoginIdentities = IntegrationService.method.getLoginIdentities;
+
+/**
+ * @generated from rpc user_service.IntegrationService.UnlinkLoginIdentity
+ */
+export const unlinkLoginIdentity = IntegrationService.method.unlinkLoginIdentity;
@@ -14,6 +14,14 @@ export const getRatings = ProfileService.method.getRatings;
  */
 export const getStats = ProfileService.method.getStats;
 
+/**
+ * GetRatingHistory gets a user's rating changes in a variant, with the
+ * breakdown of each change.
+ *
+ * @generated from rpc user_service.ProfileService.GetRatingHistory
+ */
+export const getRatingHistory = ProfileService.method.getRatingHistory;
+
 /**
  * @generated from rpc user_service.ProfileService.GetProfile
  */
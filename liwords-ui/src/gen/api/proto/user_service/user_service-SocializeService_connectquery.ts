@@ -51,3 +51,20 @@ export const getActiveChatChannels = SocializeService.method.getActiveChatChanne
  * @generated from rpc user_service.SocializeService.GetChatsForChannel
  */
 export const getChatsForChannel = SocializeService.method.getChatsForChannel;
+
+/**
+ * @generated from rpc user_service.SocializeService.MarkChatChannelRead
+ */
+export const markChatChannelRead = SocializeService.method.markChatChannelRead;
+
+/**
+ * @generated from rpc user_service.SocializeService.GetUnreadMessageCount
+ */
+export const getUnreadMessageCount = SocializeService.method.getUnreadMessageCount;
+
+/**
+ * SearchPrivateMessages searches the user's own private messages.
+ *
+ * @generated from rpc user_service.SocializeService.SearchPrivateMessages
+ */
+export const searchPrivateMessages = SocializeService.method.searchPrivateMessages;
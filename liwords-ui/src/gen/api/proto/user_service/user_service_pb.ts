@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file proto/user_service/user_service.proto.
  */
 export const file_proto_user_service_user_service: GenFile = /*@__PURE__*/
-  fileDesc("CiVwcm90by91c2VyX3NlcnZpY2UvdXNlcl9zZXJ2aWNlLnByb3RvEgx1c2VyX3NlcnZpY2UiNgoQVXNlckxvZ2luUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSJDChVDaGFuZ2VQYXNzd29yZFJlcXVlc3QSFAoMb2xkX3Bhc3N3b3JkGAEgASgJEhQKDG5ld19wYXNzd29yZBgCIAEoCSI0Cg1Mb2dpblJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCSIYChZDaGFuZ2VQYXNzd29yZFJlc3BvbnNlIioKGVJlc2V0UGFzc3dvcmRSZXF1ZXN0U3RlcDESDQoFZW1haWwYASABKAkiQQoZUmVzZXRQYXNzd29yZFJlcXVlc3RTdGVwMhIQCghwYXNzd29yZBgBIAEoCRISCgpyZXNldF9jb2RlGAIgASgJIhcKFVJlc2V0UGFzc3dvcmRSZXNwb25zZSIoCgtDb3VudHJ5RmxhZxILCgN1cmwYASABKAkSDAoEbmFtZRgCIAEoCSIUChJTb2NrZXRUb2tlblJlcXVlc3QiTAoTU29ja2V0VG9rZW5SZXNwb25zZRINCgV0b2tlbhgBIAEoCRILCgNjaWQYAiABKAkSGQoRZnJvbnRfZW5kX3ZlcnNpb24YAyABKAkiEwoRVXNlckxvZ291dFJlcXVlc3QiEAoOTG9nb3V0UmVzcG9uc2UiLwobTm90aWZ5QWNjb3VudENsb3N1cmVSZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIh4KHE5vdGlmeUFjY291bnRDbG9zdXJlUmVzcG9uc2UiIQoQR2V0QVBJS2V5UmVxdWVzdBINCgVyZXNldBgBIAEoCCIgChFHZXRBUElLZXlSZXNwb25zZRILCgNrZXkYASABKAkiGAoWR2V0U2lnbmVkQ29va2llUmVxdWVzdCIjChRTaWduZWRDb29raWVSZXNwb25zZRILCgNqd3QYASABKAkiHQobSW5zdGFsbFNpZ25lZENvb2tpZVJlc3BvbnNlIrgBChdVc2VyUmVnaXN0cmF0aW9uUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCRINCgVlbWFpbBgDIAEoCRIZChFyZWdpc3RyYXRpb25fY29kZRgEIAEoCRISCgpiaXJ0aF9kYXRlGAUgASgJEhIKCmZpcnN0X25hbWUYBiABKAkSEQoJbGFzdF9uYW1lGAcgASgJEhQKDGNvdW50cnlfY29kZRgIIAEoCSInChRSZWdpc3RyYXRpb25SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIiMKElZlcmlmeUVtYWlsUmVxdWVzdBINCgV0b2tlbhgBIAEoCSImChNWZXJpZnlFbWFpbFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiLwoeUmVzZW5kVmVyaWZpY2F0aW9uRW1haWxSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJIjIKH1Jlc2VuZFZlcmlmaWNhdGlvbkVtYWlsUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSIiCg5SYXRpbmdzUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCSIfCg9SYXRpbmdzUmVzcG9uc2USDAoEanNvbhgBIAEoCSIgCgxTdGF0c1JlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkiHQoNU3RhdHNSZXNwb25zZRIMCgRqc29uGAEgASgJIvkBChFPcmdhbml6YXRpb25UaXRsZRIZChFvcmdhbml6YXRpb25fY29kZRgBIAEoCRIZChFvcmdhbml6YXRpb25fbmFtZRgCIAEoCRIRCgltZW1iZXJfaWQYAyABKAkSEQoJZnVsbF9uYW1lGAQgASgJEhEKCXJhd190aXRsZRgFIAEoCRIYChBub3JtYWxpemVkX3RpdGxlGAYgASgJEjAKDGxhc3RfZmV0Y2hlZBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIdmVyaWZpZWQYCCABKAgSFwoPdGl0bGVfZnVsbF9uYW1lGAkgASgJIiIKDlByb2ZpbGVSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJIs8CCg9Qcm9maWxlUmVzcG9uc2USEgoKZmlyc3RfbmFtZRgBIAEoCRIRCglsYXN0X25hbWUYAiABKAkSFAoMY291bnRyeV9jb2RlGAMgASgJEg0KBXRpdGxlGAQgASgJEg0KBWFib3V0GAUgASgJEhQKDHJhdGluZ3NfanNvbhgGIAEoCRISCgpzdGF0c19qc29uGAcgASgJEg8KB3VzZXJfaWQYCCABKAkSEgoKYXZhdGFyX3VybBgJIAEoCRIRCglmdWxsX25hbWUYCiABKAkSGAoQYXZhdGFyc19lZGl0YWJsZRgLIAEoCBISCgpiaXJ0aF9kYXRlGAwgASgJEhMKC2JhZGdlX2NvZGVzGA0gAygJEjwKE29yZ2FuaXphdGlvbl90aXRsZXMYDyADKAsyHy51c2VyX3NlcnZpY2UuT3JnYW5pemF0aW9uVGl0bGUiFQoTUGVyc29uYWxJbmZvUmVxdWVzdCKsAQoUUGVyc29uYWxJbmZvUmVzcG9uc2USDQoFZW1haWwYASABKAkSEgoKZmlyc3RfbmFtZRgCIAEoCRIRCglsYXN0X25hbWUYAyABKAkSFAoMY291bnRyeV9jb2RlGAQgASgJEhIKCmF2YXRhcl91cmwYBSABKAkSEQoJZnVsbF9uYW1lGAYgASgJEg0KBWFib3V0GAcgASgJEhIKCmJpcnRoX2RhdGUYCCABKAkisQEKGVVwZGF0ZVBlcnNvbmFsSW5mb1JlcXVlc3QSDQoFZW1haWwYASABKAkSEgoKZmlyc3RfbmFtZRgCIAEoCRIRCglsYXN0X25hbWUYAyABKAkSFAoMY291bnRyeV9jb2RlGAQgASgJEhIKCmF2YXRhcl91cmwYBSABKAkSEQoJZnVsbF9uYW1lGAYgASgJEg0KBWFib3V0GAcgASgJEhIKCmJpcnRoX2RhdGUYCCABKAkiHAoaVXBkYXRlUGVyc29uYWxJbmZvUmVzcG9uc2UiJwoTVXBkYXRlQXZhdGFyUmVxdWVzdBIQCghqcGdfZGF0YRgBIAEoDCIqChRVcGRhdGVBdmF0YXJSZXNwb25zZRISCgphdmF0YXJfdXJsGAEgASgJIhUKE1JlbW92ZUF2YXRhclJlcXVlc3QiFgoUUmVtb3ZlQXZhdGFyUmVzcG9uc2UiKAoUQnJpZWZQcm9maWxlc1JlcXVlc3QSEAoIdXNlcl9pZHMYASADKAkivgEKDEJyaWVmUHJvZmlsZRIQCgh1c2VybmFtZRgBIAEoCRIRCglmdWxsX25hbWUYAiABKAkSFAoMY291bnRyeV9jb2RlGAMgASgJEhIKCmF2YXRhcl91cmwYCSABKAkSEwoLYmFkZ2VfY29kZXMYDSADKAkSDQoFdGl0bGUYDiABKAkSHwoXdGl0bGVfb3JnYW5pemF0aW9uX2NvZGUYDyABKAkSGgoSdGl0bGVfYWJicmV2aWF0aW9uGBAgASgJIqkBChVCcmllZlByb2ZpbGVzUmVzcG9uc2USQwoIcmVzcG9uc2UYASADKAsyMS51c2VyX3NlcnZpY2UuQnJpZWZQcm9maWxlc1Jlc3BvbnNlLlJlc3BvbnNlRW50cnkaSwoNUmVzcG9uc2VFbnRyeRILCgNrZXkYASABKAkSKQoFdmFsdWUYAiABKAsyGi51c2VyX3NlcnZpY2UuQnJpZWZQcm9maWxlOgI4ASIWChRCYWRnZU1ldGFkYXRhUmVxdWVzdCKHAQoVQmFkZ2VNZXRhZGF0YVJlc3BvbnNlEj8KBmJhZGdlcxgBIAMoCzIvLnVzZXJfc2VydmljZS5CYWRnZU1ldGFkYXRhUmVzcG9uc2UuQmFkZ2VzRW50cnkaLQoLQmFkZ2VzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASInChVVc2VybmFtZVNlYXJjaFJlcXVlc3QSDgoGcHJlZml4GAEgASgJIkAKFlVzZXJuYW1lU2VhcmNoUmVzcG9uc2USJgoFdXNlcnMYAiADKAsyFy51c2VyX3NlcnZpY2UuQmFzaWNVc2VyIiAKEEFkZEZvbGxvd1JlcXVlc3QSDAoEdXVpZBgBIAEoCSIjChNSZW1vdmVGb2xsb3dSZXF1ZXN0EgwKBHV1aWQYASABKAkiEwoRR2V0Rm9sbG93c1JlcXVlc3QiHwoPQWRkQmxvY2tSZXF1ZXN0EgwKBHV1aWQYASABKAkiIgoSUmVtb3ZlQmxvY2tSZXF1ZXN0EgwKBHV1aWQYASABKAkiEgoQR2V0QmxvY2tzUmVxdWVzdCIWChRHZXRGdWxsQmxvY2tzUmVxdWVzdCIMCgpPS1Jlc3BvbnNlIisKCUJhc2ljVXNlchIMCgR1dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJIkQKEUJhc2ljRm9sbG93ZWRVc2VyEgwKBHV1aWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDwoHY2hhbm5lbBgDIAMoCSJoChxHZXRBY3RpdmVDaGF0Q2hhbm5lbHNSZXF1ZXN0Eg4KBm51bWJlchgBIAEoBRIOCgZvZmZzZXQYAiABKAUSFQoNdG91cm5hbWVudF9pZBgDIAEoCRIRCglsZWFndWVfaWQYBCABKAkivgEKEkFjdGl2ZUNoYXRDaGFubmVscxI6CghjaGFubmVscxgBIAMoCzIoLnVzZXJfc2VydmljZS5BY3RpdmVDaGF0Q2hhbm5lbHMuQ2hhbm5lbBpsCgdDaGFubmVsEgwKBG5hbWUYASABKAkSFAoMZGlzcGxheV9uYW1lGAIgASgJEhMKC2xhc3RfdXBkYXRlGAMgASgDEhIKCmhhc191cGRhdGUYBCABKAgSFAoMbGFzdF9tZXNzYWdlGAUgASgJIiIKD0dldENoYXRzUmVxdWVzdBIPCgdjaGFubmVsGAEgASgJIkQKEkdldEZvbGxvd3NSZXNwb25zZRIuCgV1c2VycxgBIAMoCzIfLnVzZXJfc2VydmljZS5CYXNpY0ZvbGxvd2VkVXNlciI7ChFHZXRCbG9ja3NSZXNwb25zZRImCgV1c2VycxgBIAMoCzIXLnVzZXJfc2VydmljZS5CYXNpY1VzZXIiKQoVR2V0RnVsbEJsb2Nrc1Jlc3BvbnNlEhAKCHVzZXJfaWRzGAEgAygJIsABCgtJbnRlZ3JhdGlvbhIMCgR1dWlkGAEgASgJEhgKEGludGVncmF0aW9uX25hbWUYAiABKAkSTgoTaW50ZWdyYXRpb25fZGV0YWlscxgDIAMoCzIxLnVzZXJfc2VydmljZS5JbnRlZ3JhdGlvbi5JbnRlZ3JhdGlvbkRldGFpbHNFbnRyeRo5ChdJbnRlZ3JhdGlvbkRldGFpbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIhgKFkdldEludGVncmF0aW9uc1JlcXVlc3QiRwoUSW50ZWdyYXRpb25zUmVzcG9uc2USLwoMaW50ZWdyYXRpb25zGAEgAygLMhkudXNlcl9zZXJ2aWNlLkludGVncmF0aW9uIigKGERlbGV0ZUludGVncmF0aW9uUmVxdWVzdBIMCgR1dWlkGAEgASgJIhsKGURlbGV0ZUludGVncmF0aW9uUmVzcG9uc2UiIAoeR2V0U3Vic2NyaXB0aW9uQ3JpdGVyaWFSZXF1ZXN0IokBCh9HZXRTdWJzY3JpcHRpb25Dcml0ZXJpYVJlc3BvbnNlEhEKCXRpZXJfbmFtZRgBIAEoCRIdChVlbnRpdGxlZF90b19ib3RfZ2FtZXMYAiABKAgSNAoQbGFzdF9jaGFyZ2VfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiEwoRR2V0TW9kTGlzdFJlcXVlc3QiQgoSR2V0TW9kTGlzdFJlc3BvbnNlEhYKDmFkbWluX3VzZXJfaWRzGAEgAygJEhQKDG1vZF91c2VyX2lkcxgCIAMoCSIzCg5BZGRSb2xlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJIhEKD0FkZFJvbGVSZXNwb25zZSI5ChRBZGRQZXJtaXNzaW9uUmVxdWVzdBIMCgRjb2RlGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJIhcKFUFkZFBlcm1pc3Npb25SZXNwb25zZSJKChxMaW5rUm9sZUFuZFBlcm1pc3Npb25SZXF1ZXN0EhEKCXJvbGVfbmFtZRgBIAEoCRIXCg9wZXJtaXNzaW9uX2NvZGUYAiABKAkiHwodTGlua1JvbGVBbmRQZXJtaXNzaW9uUmVzcG9uc2UiFAoSQXNzaWduUm9sZVJlc3BvbnNlIjIKC1VzZXJBbmRSb2xlEhAKCHVzZXJuYW1lGAEgASgJEhEKCXJvbGVfbmFtZRgCIAEoCSIWChRVbmFzc2lnblJvbGVSZXNwb25zZSInChNHZXRVc2VyUm9sZXNSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJIiIKEVVzZXJSb2xlc1Jlc3BvbnNlEg0KBXJvbGVzGAEgAygJIhUKE0dldFNlbGZSb2xlc1JlcXVlc3QiGwoZR2V0U2VsZlBlcm1pc3Npb25zUmVxdWVzdCIuChdTZWxmUGVybWlzc2lvbnNSZXNwb25zZRITCgtwZXJtaXNzaW9ucxgBIAMoCSIpChhHZXRVc2Vyc1dpdGhSb2xlc1JlcXVlc3QSDQoFcm9sZXMYASADKAkiUgoZR2V0VXNlcnNXaXRoUm9sZXNSZXNwb25zZRI1ChJ1c2VyX2FuZF9yb2xlX29ianMYASADKAsyGS51c2VyX3NlcnZpY2UuVXNlckFuZFJvbGUiGAoWR2V0Um9sZU1ldGFkYXRhUmVxdWVzdCI9ChNSb2xlV2l0aFBlcm1pc3Npb25zEhEKCXJvbGVfbmFtZRgBIAEoCRITCgtwZXJtaXNzaW9ucxgCIAMoCSJZChRSb2xlTWV0YWRhdGFSZXNwb25zZRJBChZyb2xlc193aXRoX3Blcm1pc3Npb25zGAEgAygLMiEudXNlcl9zZXJ2aWNlLlJvbGVXaXRoUGVybWlzc2lvbnMizgEKGkNvbm5lY3RPcmdhbml6YXRpb25SZXF1ZXN0EhkKEW9yZ2FuaXphdGlvbl9jb2RlGAEgASgJEhEKCW1lbWJlcl9pZBgCIAEoCRJOCgtjcmVkZW50aWFscxgDIAMoCzI5LnVzZXJfc2VydmljZS5Db25uZWN0T3JnYW5pemF0aW9uUmVxdWVzdC5DcmVkZW50aWFsc0VudHJ5GjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJvChtDb25uZWN0T3JnYW5pemF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEi4KBXRpdGxlGAMgASgLMh8udXNlcl9zZXJ2aWNlLk9yZ2FuaXphdGlvblRpdGxlIl4KHURpc2Nvbm5lY3RPcmdhbml6YXRpb25SZXF1ZXN0EhkKEW9yZ2FuaXphdGlvbl9jb2RlGAEgASgJEhUKCHVzZXJuYW1lGAIgASgJSACIAQFCCwoJX3VzZXJuYW1lIjEKHkRpc2Nvbm5lY3RPcmdhbml6YXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIhYKFFJlZnJlc2hUaXRsZXNSZXF1ZXN0IlkKFVJlZnJlc2hUaXRsZXNSZXNwb25zZRIvCgZ0aXRsZXMYASADKAsyHy51c2VyX3NlcnZpY2UuT3JnYW5pemF0aW9uVGl0bGUSDwoHbWVzc2FnZRgCIAEoCSIbChlHZXRNeU9yZ2FuaXphdGlvbnNSZXF1ZXN0Ik0KGkdldE15T3JnYW5pemF0aW9uc1Jlc3BvbnNlEi8KBnRpdGxlcxgBIAMoCzIfLnVzZXJfc2VydmljZS5Pcmdhbml6YXRpb25UaXRsZSIxCh1HZXRQdWJsaWNPcmdhbml6YXRpb25zUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCSJRCh5HZXRQdWJsaWNPcmdhbml6YXRpb25zUmVzcG9uc2USLwoGdGl0bGVzGAEgAygLMh8udXNlcl9zZXJ2aWNlLk9yZ2FuaXphdGlvblRpdGxlInYKGVN1Ym1pdFZlcmlmaWNhdGlvblJlcXVlc3QSGQoRb3JnYW5pemF0aW9uX2NvZGUYASABKAkSEQoJbWVtYmVyX2lkGAIgASgJEhIKCmltYWdlX2RhdGEYAyABKAwSFwoPaW1hZ2VfZXh0ZW5zaW9uGAQgASgJIlIKGlN1Ym1pdFZlcmlmaWNhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCRISCgpyZXF1ZXN0X2lkGAMgASgDIiAKHkdldFBlbmRpbmdWZXJpZmljYXRpb25zUmVxdWVzdCKGAgoXVmVyaWZpY2F0aW9uUmVxdWVzdEluZm8SEgoKcmVxdWVzdF9pZBgBIAEoAxIRCgl1c2VyX3V1aWQYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSGQoRb3JnYW5pemF0aW9uX2NvZGUYBCABKAkSEQoJbWVtYmVyX2lkGAUgASgJEhEKCWZ1bGxfbmFtZRgGIAEoCRIRCglpbWFnZV91cmwYByABKAkSMAoMc3VibWl0dGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZzdGF0dXMYCSABKAkSDQoFdGl0bGUYCiABKAkSDQoFbm90ZXMYCyABKAkiWgofR2V0UGVuZGluZ1ZlcmlmaWNhdGlvbnNSZXNwb25zZRI3CghyZXF1ZXN0cxgBIAMoCzIlLnVzZXJfc2VydmljZS5WZXJpZmljYXRpb25SZXF1ZXN0SW5mbyI/ChpBcHByb3ZlVmVyaWZpY2F0aW9uUmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgDEg0KBW5vdGVzGAIgASgJIj8KG0FwcHJvdmVWZXJpZmljYXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiPgoZUmVqZWN0VmVyaWZpY2F0aW9uUmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgDEg0KBW5vdGVzGAIgASgJIj4KGlJlamVjdFZlcmlmaWNhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSI0Ch5HZXRWZXJpZmljYXRpb25JbWFnZVVybFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoAyI0Ch9HZXRWZXJpZmljYXRpb25JbWFnZVVybFJlc3BvbnNlEhEKCWltYWdlX3VybBgBIAEoCSLqAQofTWFudWFsbHlTZXRPcmdNZW1iZXJzaGlwUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIZChFvcmdhbml6YXRpb25fY29kZRgCIAEoCRIRCgltZW1iZXJfaWQYAyABKAkSUwoLY3JlZGVudGlhbHMYBCADKAsyPi51c2VyX3NlcnZpY2UuTWFudWFsbHlTZXRPcmdNZW1iZXJzaGlwUmVxdWVzdC5DcmVkZW50aWFsc0VudHJ5GjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJECiBNYW51YWxseVNldE9yZ01lbWJlcnNoaXBSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiMQodQWRtaW5SZWZyZXNoVXNlclRpdGxlc1JlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkiYgoeQWRtaW5SZWZyZXNoVXNlclRpdGxlc1Jlc3BvbnNlEi8KBnRpdGxlcxgBIAMoCzIfLnVzZXJfc2VydmljZS5Pcmdhbml6YXRpb25UaXRsZRIPCgdtZXNzYWdlGAIgASgJMqIHChVBdXRoZW50aWNhdGlvblNlcnZpY2USRAoFTG9naW4SHi51c2VyX3NlcnZpY2UuVXNlckxvZ2luUmVxdWVzdBobLnVzZXJfc2VydmljZS5Mb2dpblJlc3BvbnNlEkcKBkxvZ291dBIfLnVzZXJfc2VydmljZS5Vc2VyTG9nb3V0UmVxdWVzdBocLnVzZXJfc2VydmljZS5Mb2dvdXRSZXNwb25zZRJVCg5HZXRTb2NrZXRUb2tlbhIgLnVzZXJfc2VydmljZS5Tb2NrZXRUb2tlblJlcXVlc3QaIS51c2VyX3NlcnZpY2UuU29ja2V0VG9rZW5SZXNwb25zZRJiChJSZXNldFBhc3N3b3JkU3RlcDESJy51c2VyX3NlcnZpY2UuUmVzZXRQYXNzd29yZFJlcXVlc3RTdGVwMRojLnVzZXJfc2VydmljZS5SZXNldFBhc3N3b3JkUmVzcG9uc2USYgoSUmVzZXRQYXNzd29yZFN0ZXAyEicudXNlcl9zZXJ2aWNlLlJlc2V0UGFzc3dvcmRSZXF1ZXN0U3RlcDIaIy51c2VyX3NlcnZpY2UuUmVzZXRQYXNzd29yZFJlc3BvbnNlElsKDkNoYW5nZVBhc3N3b3JkEiMudXNlcl9zZXJ2aWNlLkNoYW5nZVBhc3N3b3JkUmVxdWVzdBokLnVzZXJfc2VydmljZS5DaGFuZ2VQYXNzd29yZFJlc3BvbnNlEm0KFE5vdGlmeUFjY291bnRDbG9zdXJlEikudXNlcl9zZXJ2aWNlLk5vdGlmeUFjY291bnRDbG9zdXJlUmVxdWVzdBoqLnVzZXJfc2VydmljZS5Ob3RpZnlBY2NvdW50Q2xvc3VyZVJlc3BvbnNlElsKD0dldFNpZ25lZENvb2tpZRIkLnVzZXJfc2VydmljZS5HZXRTaWduZWRDb29raWVSZXF1ZXN0GiIudXNlcl9zZXJ2aWNlLlNpZ25lZENvb2tpZVJlc3BvbnNlEmQKE0luc3RhbGxTaWduZWRDb29raWUSIi51c2VyX3NlcnZpY2UuU2lnbmVkQ29va2llUmVzcG9uc2UaKS51c2VyX3NlcnZpY2UuSW5zdGFsbFNpZ25lZENvb2tpZVJlc3BvbnNlEkwKCUdldEFQSUtleRIeLnVzZXJfc2VydmljZS5HZXRBUElLZXlSZXF1ZXN0Gh8udXNlcl9zZXJ2aWNlLkdldEFQSUtleVJlc3BvbnNlMrgCChNSZWdpc3RyYXRpb25TZXJ2aWNlElUKCFJlZ2lzdGVyEiUudXNlcl9zZXJ2aWNlLlVzZXJSZWdpc3RyYXRpb25SZXF1ZXN0GiIudXNlcl9zZXJ2aWNlLlJlZ2lzdHJhdGlvblJlc3BvbnNlElIKC1ZlcmlmeUVtYWlsEiAudXNlcl9zZXJ2aWNlLlZlcmlmeUVtYWlsUmVxdWVzdBohLnVzZXJfc2VydmljZS5WZXJpZnlFbWFpbFJlc3BvbnNlEnYKF1Jlc2VuZFZlcmlmaWNhdGlvbkVtYWlsEiwudXNlcl9zZXJ2aWNlLlJlc2VuZFZlcmlmaWNhdGlvbkVtYWlsUmVxdWVzdBotLnVzZXJfc2VydmljZS5SZXNlbmRWZXJpZmljYXRpb25FbWFpbFJlc3BvbnNlMqEGCg5Qcm9maWxlU2VydmljZRJJCgpHZXRSYXRpbmdzEhwudXNlcl9zZXJ2aWNlLlJhdGluZ3NSZXF1ZXN0Gh0udXNlcl9zZXJ2aWNlLlJhdGluZ3NSZXNwb25zZRJDCghHZXRTdGF0cxIaLnVzZXJfc2VydmljZS5TdGF0c1JlcXVlc3QaGy51c2VyX3NlcnZpY2UuU3RhdHNSZXNwb25zZRJJCgpHZXRQcm9maWxlEhwudXNlcl9zZXJ2aWNlLlByb2ZpbGVSZXF1ZXN0Gh0udXNlcl9zZXJ2aWNlLlByb2ZpbGVSZXNwb25zZRJYCg9HZXRQZXJzb25hbEluZm8SIS51c2VyX3NlcnZpY2UuUGVyc29uYWxJbmZvUmVxdWVzdBoiLnVzZXJfc2VydmljZS5QZXJzb25hbEluZm9SZXNwb25zZRJnChJVcGRhdGVQZXJzb25hbEluZm8SJy51c2VyX3NlcnZpY2UuVXBkYXRlUGVyc29uYWxJbmZvUmVxdWVzdBooLnVzZXJfc2VydmljZS5VcGRhdGVQZXJzb25hbEluZm9SZXNwb25zZRJVCgxVcGRhdGVBdmF0YXISIS51c2VyX3NlcnZpY2UuVXBkYXRlQXZhdGFyUmVxdWVzdBoiLnVzZXJfc2VydmljZS5VcGRhdGVBdmF0YXJSZXNwb25zZRJVCgxSZW1vdmVBdmF0YXISIS51c2VyX3NlcnZpY2UuUmVtb3ZlQXZhdGFyUmVxdWVzdBoiLnVzZXJfc2VydmljZS5SZW1vdmVBdmF0YXJSZXNwb25zZRJgChBHZXRCcmllZlByb2ZpbGVzEiIudXNlcl9zZXJ2aWNlLkJyaWVmUHJvZmlsZXNSZXF1ZXN0GiMudXNlcl9zZXJ2aWNlLkJyaWVmUHJvZmlsZXNSZXNwb25zZSIDkAIBEmEKEUdldEJhZGdlc01ldGFkYXRhEiIudXNlcl9zZXJ2aWNlLkJhZGdlTWV0YWRhdGFSZXF1ZXN0GiMudXNlcl9zZXJ2aWNlLkJhZGdlTWV0YWRhdGFSZXNwb25zZSIDkAIBMnEKE0F1dG9jb21wbGV0ZVNlcnZpY2USWgoNR2V0Q29tcGxldGlvbhIjLnVzZXJfc2VydmljZS5Vc2VybmFtZVNlYXJjaFJlcXVlc3QaJC51c2VyX3NlcnZpY2UuVXNlcm5hbWVTZWFyY2hSZXNwb25zZTLeBQoQU29jaWFsaXplU2VydmljZRJFCglBZGRGb2xsb3cSHi51c2VyX3NlcnZpY2UuQWRkRm9sbG93UmVxdWVzdBoYLnVzZXJfc2VydmljZS5PS1Jlc3BvbnNlEksKDFJlbW92ZUZvbGxvdxIhLnVzZXJfc2VydmljZS5SZW1vdmVGb2xsb3dSZXF1ZXN0GhgudXNlcl9zZXJ2aWNlLk9LUmVzcG9uc2USTwoKR2V0Rm9sbG93cxIfLnVzZXJfc2VydmljZS5HZXRGb2xsb3dzUmVxdWVzdBogLnVzZXJfc2VydmljZS5HZXRGb2xsb3dzUmVzcG9uc2USQwoIQWRkQmxvY2sSHS51c2VyX3NlcnZpY2UuQWRkQmxvY2tSZXF1ZXN0GhgudXNlcl9zZXJ2aWNlLk9LUmVzcG9uc2USSQoLUmVtb3ZlQmxvY2sSIC51c2VyX3NlcnZpY2UuUmVtb3ZlQmxvY2tSZXF1ZXN0GhgudXNlcl9zZXJ2aWNlLk9LUmVzcG9uc2USTAoJR2V0QmxvY2tzEh4udXNlcl9zZXJ2aWNlLkdldEJsb2Nrc1JlcXVlc3QaHy51c2VyX3NlcnZpY2UuR2V0QmxvY2tzUmVzcG9uc2USWAoNR2V0RnVsbEJsb2NrcxIiLnVzZXJfc2VydmljZS5HZXRGdWxsQmxvY2tzUmVxdWVzdBojLnVzZXJfc2VydmljZS5HZXRGdWxsQmxvY2tzUmVzcG9uc2USZQoVR2V0QWN0aXZlQ2hhdENoYW5uZWxzEioudXNlcl9zZXJ2aWNlLkdldEFjdGl2ZUNoYXRDaGFubmVsc1JlcXVlc3QaIC51c2VyX3NlcnZpY2UuQWN0aXZlQ2hhdENoYW5uZWxzEkYKEkdldENoYXRzRm9yQ2hhbm5lbBIdLnVzZXJfc2VydmljZS5HZXRDaGF0c1JlcXVlc3QaES5pcGMuQ2hhdE1lc3NhZ2VzMtwBChJJbnRlZ3JhdGlvblNlcnZpY2USYAoPR2V0SW50ZWdyYXRpb25zEiQudXNlcl9zZXJ2aWNlLkdldEludGVncmF0aW9uc1JlcXVlc3QaIi51c2VyX3NlcnZpY2UuSW50ZWdyYXRpb25zUmVzcG9uc2UiA5ACARJkChFEZWxldGVJbnRlZ3JhdGlvbhImLnVzZXJfc2VydmljZS5EZWxldGVJbnRlZ3JhdGlvblJlcXVlc3QaJy51c2VyX3NlcnZpY2UuRGVsZXRlSW50ZWdyYXRpb25SZXNwb25zZTLwCQoUQXV0aG9yaXphdGlvblNlcnZpY2USVAoKR2V0TW9kTGlzdBIfLnVzZXJfc2VydmljZS5HZXRNb2RMaXN0UmVxdWVzdBogLnVzZXJfc2VydmljZS5HZXRNb2RMaXN0UmVzcG9uc2UiA5ACARJ2ChdHZXRTdWJzY3JpcHRpb25Dcml0ZXJpYRIsLnVzZXJfc2VydmljZS5HZXRTdWJzY3JpcHRpb25Dcml0ZXJpYVJlcXVlc3QaLS51c2VyX3NlcnZpY2UuR2V0U3Vic2NyaXB0aW9uQ3JpdGVyaWFSZXNwb25zZRJGCgdBZGRSb2xlEhwudXNlcl9zZXJ2aWNlLkFkZFJvbGVSZXF1ZXN0Gh0udXNlcl9zZXJ2aWNlLkFkZFJvbGVSZXNwb25zZRJYCg1BZGRQZXJtaXNzaW9uEiIudXNlcl9zZXJ2aWNlLkFkZFBlcm1pc3Npb25SZXF1ZXN0GiMudXNlcl9zZXJ2aWNlLkFkZFBlcm1pc3Npb25SZXNwb25zZRJwChVMaW5rUm9sZUFuZFBlcm1pc3Npb24SKi51c2VyX3NlcnZpY2UuTGlua1JvbGVBbmRQZXJtaXNzaW9uUmVxdWVzdBorLnVzZXJfc2VydmljZS5MaW5rUm9sZUFuZFBlcm1pc3Npb25SZXNwb25zZRJyChdVbmxpbmtSb2xlQW5kUGVybWlzc2lvbhIqLnVzZXJfc2VydmljZS5MaW5rUm9sZUFuZFBlcm1pc3Npb25SZXF1ZXN0GisudXNlcl9zZXJ2aWNlLkxpbmtSb2xlQW5kUGVybWlzc2lvblJlc3BvbnNlEkkKCkFzc2lnblJvbGUSGS51c2VyX3NlcnZpY2UuVXNlckFuZFJvbGUaIC51c2VyX3NlcnZpY2UuQXNzaWduUm9sZVJlc3BvbnNlEk0KDFVuYXNzaWduUm9sZRIZLnVzZXJfc2VydmljZS5Vc2VyQW5kUm9sZRoiLnVzZXJfc2VydmljZS5VbmFzc2lnblJvbGVSZXNwb25zZRJXCgxHZXRVc2VyUm9sZXMSIS51c2VyX3NlcnZpY2UuR2V0VXNlclJvbGVzUmVxdWVzdBofLnVzZXJfc2VydmljZS5Vc2VyUm9sZXNSZXNwb25zZSIDkAIBElcKDEdldFNlbGZSb2xlcxIhLnVzZXJfc2VydmljZS5HZXRTZWxmUm9sZXNSZXF1ZXN0Gh8udXNlcl9zZXJ2aWNlLlVzZXJSb2xlc1Jlc3BvbnNlIgOQAgESaQoSR2V0U2VsZlBlcm1pc3Npb25zEicudXNlcl9zZXJ2aWNlLkdldFNlbGZQZXJtaXNzaW9uc1JlcXVlc3QaJS51c2VyX3NlcnZpY2UuU2VsZlBlcm1pc3Npb25zUmVzcG9uc2UiA5ACARJpChFHZXRVc2Vyc1dpdGhSb2xlcxImLnVzZXJfc2VydmljZS5HZXRVc2Vyc1dpdGhSb2xlc1JlcXVlc3QaJy51c2VyX3NlcnZpY2UuR2V0VXNlcnNXaXRoUm9sZXNSZXNwb25zZSIDkAIBEmAKD0dldFJvbGVNZXRhZGF0YRIkLnVzZXJfc2VydmljZS5HZXRSb2xlTWV0YWRhdGFSZXF1ZXN0GiIudXNlcl9zZXJ2aWNlLlJvbGVNZXRhZGF0YVJlc3BvbnNlIgOQAgEy4AoKE09yZ2FuaXphdGlvblNlcnZpY2USagoTQ29ubmVjdE9yZ2FuaXphdGlvbhIoLnVzZXJfc2VydmljZS5Db25uZWN0T3JnYW5pemF0aW9uUmVxdWVzdBopLnVzZXJfc2VydmljZS5Db25uZWN0T3JnYW5pemF0aW9uUmVzcG9uc2UScwoWRGlzY29ubmVjdE9yZ2FuaXphdGlvbhIrLnVzZXJfc2VydmljZS5EaXNjb25uZWN0T3JnYW5pemF0aW9uUmVxdWVzdBosLnVzZXJfc2VydmljZS5EaXNjb25uZWN0T3JnYW5pemF0aW9uUmVzcG9uc2USWAoNUmVmcmVzaFRpdGxlcxIiLnVzZXJfc2VydmljZS5SZWZyZXNoVGl0bGVzUmVxdWVzdBojLnVzZXJfc2VydmljZS5SZWZyZXNoVGl0bGVzUmVzcG9uc2USbAoSR2V0TXlPcmdhbml6YXRpb25zEicudXNlcl9zZXJ2aWNlLkdldE15T3JnYW5pemF0aW9uc1JlcXVlc3QaKC51c2VyX3NlcnZpY2UuR2V0TXlPcmdhbml6YXRpb25zUmVzcG9uc2UiA5ACARJ4ChZHZXRQdWJsaWNPcmdhbml6YXRpb25zEisudXNlcl9zZXJ2aWNlLkdldFB1YmxpY09yZ2FuaXphdGlvbnNSZXF1ZXN0GiwudXNlcl9zZXJ2aWNlLkdldFB1YmxpY09yZ2FuaXphdGlvbnNSZXNwb25zZSIDkAIBEmcKElN1Ym1pdFZlcmlmaWNhdGlvbhInLnVzZXJfc2VydmljZS5TdWJtaXRWZXJpZmljYXRpb25SZXF1ZXN0GigudXNlcl9zZXJ2aWNlLlN1Ym1pdFZlcmlmaWNhdGlvblJlc3BvbnNlEnsKF0dldFBlbmRpbmdWZXJpZmljYXRpb25zEiwudXNlcl9zZXJ2aWNlLkdldFBlbmRpbmdWZXJpZmljYXRpb25zUmVxdWVzdBotLnVzZXJfc2VydmljZS5HZXRQZW5kaW5nVmVyaWZpY2F0aW9uc1Jlc3BvbnNlIgOQAgESewoXR2V0VmVyaWZpY2F0aW9uSW1hZ2VVcmwSLC51c2VyX3NlcnZpY2UuR2V0VmVyaWZpY2F0aW9uSW1hZ2VVcmxSZXF1ZXN0Gi0udXNlcl9zZXJ2aWNlLkdldFZlcmlmaWNhdGlvbkltYWdlVXJsUmVzcG9uc2UiA5ACARJqChNBcHByb3ZlVmVyaWZpY2F0aW9uEigudXNlcl9zZXJ2aWNlLkFwcHJvdmVWZXJpZmljYXRpb25SZXF1ZXN0GikudXNlcl9zZXJ2aWNlLkFwcHJvdmVWZXJpZmljYXRpb25SZXNwb25zZRJnChJSZWplY3RWZXJpZmljYXRpb24SJy51c2VyX3NlcnZpY2UuUmVqZWN0VmVyaWZpY2F0aW9uUmVxdWVzdBooLnVzZXJfc2VydmljZS5SZWplY3RWZXJpZmljYXRpb25SZXNwb25zZRJ5ChhNYW51YWxseVNldE9yZ01lbWJlcnNoaXASLS51c2VyX3NlcnZpY2UuTWFudWFsbHlTZXRPcmdNZW1iZXJzaGlwUmVxdWVzdBouLnVzZXJfc2VydmljZS5NYW51YWxseVNldE9yZ01lbWJlcnNoaXBSZXNwb25zZRJzChZBZG1pblJlZnJlc2hVc2VyVGl0bGVzEisudXNlcl9zZXJ2aWNlLkFkbWluUmVmcmVzaFVzZXJUaXRsZXNSZXF1ZXN0GiwudXNlcl9zZXJ2aWNlLkFkbWluUmVmcmVzaFVzZXJUaXRsZXNSZXNwb25zZUKqAQoQY29tLnVzZXJfc2VydmljZUIQVXNlclNlcnZpY2VQcm90b1ABWjhnaXRodWIuY29tL3dvb2dsZXMtaW8vbGl3b3Jkcy9ycGMvYXBpL3Byb3RvL3VzZXJfc2VydmljZaICA1VYWKoCC1VzZXJTZXJ2aWNlygILVXNlclNlcnZpY2XiAhdVc2VyU2VydmljZVxHUEJNZXRhZGF0YeoCC1VzZXJTZXJ2aWNlYgZwcm90bzM", [file_proto_ipc_chat, file_google_protobuf_timestamp]);
+  fileDesc("CiVwcm90by91c2VyX3NlcnZpY2UvdXNlcl9zZXJ2aWNlLnByb3RvEgx1c2VyX3NlcnZpY2UiNgoQVXNlckxvZ2luUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSJDChVDaGFuZ2VQYXNzd29yZFJlcXVlc3QSFAoMb2xkX3Bhc3N3b3JkGAEgASgJEhQKDG5ld19wYXNzd29yZBgCIAEoCSI0Cg1Mb2dpblJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkSEgoKc2Vzc2lvbl9pZBgCIAEoCSIYChZDaGFuZ2VQYXNzd29yZFJlc3BvbnNlIioKGVJlc2V0UGFzc3dvcmRSZXF1ZXN0U3RlcDESDQoFZW1haWwYASABKAkiQQoZUmVzZXRQYXNzd29yZFJlcXVlc3RTdGVwMhIQCghwYXNzd29yZBgBIAEoCRISCgpyZXNldF9jb2RlGAIgASgJIhcKFVJlc2V0UGFzc3dvcmRSZXNwb25zZSIoCgtDb3VudHJ5RmxhZxILCgN1cmwYASABKAkSDAoEbmFtZRgCIAEoCSIUChJTb2NrZXRUb2tlblJlcXVlc3QiTAoTU29ja2V0VG9rZW5SZXNwb25zZRINCgV0b2tlbhgBIAEoCRILCgNjaWQYAiABKAkSGQoRZnJvbnRfZW5kX3ZlcnNpb24YAyABKAkiEwoRVXNlckxvZ291dFJlcXVlc3QiEAoOTG9nb3V0UmVzcG9uc2UiLwobTm90aWZ5QWNjb3VudENsb3N1cmVSZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIh4KHE5vdGlmeUFjY291bnRDbG9zdXJlUmVzcG9uc2UiIQoQR2V0QVBJS2V5UmVxdWVzdBINCgVyZXNldBgBIAEoCCIgChFHZXRBUElLZXlSZXNwb25zZRILCgNrZXkYASABKAkiGAoWR2V0U2lnbmVkQ29va2llUmVxdWVzdCIjChRTaWduZWRDb29raWVSZXNwb25zZRILCgNqd3QYASABKAkiHQobSW5zdGFsbFNpZ25lZENvb2tpZVJlc3BvbnNlIrgBChdVc2VyUmVnaXN0cmF0aW9uUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIQCghwYXNzd29yZBgCIAEoCRINCgVlbWFpbBgDIAEoCRIZChFyZWdpc3RyYXRpb25fY29kZRgEIAEoCRISCgpiaXJ0aF9kYXRlGAUgASgJEhIKCmZpcnN0X25hbWUYBiABKAkSEQoJbGFzdF9uYW1lGAcgASgJEhQKDGNvdW50cnlfY29kZRgIIAEoCSInChRSZWdpc3RyYXRpb25SZXNwb25zZRIPCgdtZXNzYWdlGAEgASgJIiMKElZlcmlmeUVtYWlsUmVxdWVzdBINCgV0b2tlbhgBIAEoCSImChNWZXJpZnlFbWFpbFJlc3BvbnNlEg8KB21lc3NhZ2UYASABKAkiLwoeUmVzZW5kVmVyaWZpY2F0aW9uRW1haWxSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJIjIKH1Jlc2VuZFZlcmlmaWNhdGlvbkVtYWlsUmVzcG9uc2USDwoHbWVzc2FnZRgBIAEoCSJuChpDb21wbGV0ZU9BdXRoU2lnbnVwUmVxdWVzdBIUCgxzaWdudXBfdG9rZW4YASABKAkSEAoIdXNlcm5hbWUYAiABKAkSEgoKYmlydGhfZGF0ZRgDIAEoCRIUCgxjb3VudHJ5X2NvZGUYBCABKAkiHQobQ29tcGxldGVPQXV0aFNpZ251cFJlc3BvbnNlIiIKDlJhdGluZ3NSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJIh8KD1JhdGluZ3NSZXNwb25zZRIMCgRqc29uGAEgASgJIiAKDFN0YXRzUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCSIdCg1TdGF0c1Jlc3BvbnNlEgwKBGpzb24YASABKAkicwoUUmF0aW5nSGlzdG9yeVJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSDwoHdmFyaWFudBgCIAEoCRIpCgVzaW5jZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGltaXQYBCABKAUikQEKFVJhdGluZ0NoYW5nZUJyZWFrZG93bhIXCg9leHBlY3RlZF9yZXN1bHQYASABKAESFQoNYWN0dWFsX3Jlc3VsdBgCIAEoARIRCgl3aW5fYm9vc3QYAyABKAESIQoZaW5hY3RpdmVfcmF0aW5nX2RldmlhdGlvbhgEIAEoARISCgptdWx0aXBsaWVyGAUgASgBIpYDChJSYXRpbmdIaXN0b3J5UG9pbnQSDwoHZ2FtZV9pZBgBIAEoCRIZChFvcHBvbmVudF91c2VybmFtZRgCIAEoCRItCglwbGF5ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDXJhdGluZ19iZWZvcmUYBCABKAESFAoMcmF0aW5nX2FmdGVyGAUgASgBEh8KF3JhdGluZ19kZXZpYXRpb25fYmVmb3JlGAYgASgBEh4KFnJhdGluZ19kZXZpYXRpb25fYWZ0ZXIYByABKAESGQoRdm9sYXRpbGl0eV9iZWZvcmUYCCABKAESGAoQdm9sYXRpbGl0eV9hZnRlchgJIAEoARIXCg9vcHBvbmVudF9yYXRpbmcYCiABKAESIQoZb3Bwb25lbnRfcmF0aW5nX2RldmlhdGlvbhgLIAEoARIOCgZzcHJlYWQYDCABKAUSNgoJYnJlYWtkb3duGA0gASgLMiMudXNlcl9zZXJ2aWNlLlJhdGluZ0NoYW5nZUJyZWFrZG93biJbChVSYXRpbmdIaXN0b3J5UmVzcG9uc2USMAoGcG9pbnRzGAEgAygLMiAudXNlcl9zZXJ2aWNlLlJhdGluZ0hpc3RvcnlQb2ludBIQCgh2YXJpYW50cxgCIAMoCSL5AQoRT3JnYW5pemF0aW9uVGl0bGUSGQoRb3JnYW5pemF0aW9uX2NvZGUYASABKAkSGQoRb3JnYW5pemF0aW9uX25hbWUYAiABKAkSEQoJbWVtYmVyX2lkGAMgASgJEhEKCWZ1bGxfbmFtZRgEIAEoCRIRCglyYXdfdGl0bGUYBSABKAkSGAoQbm9ybWFsaXplZF90aXRsZRgGIAEoCRIwCgxsYXN0X2ZldGNoZWQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCHZlcmlmaWVkGAggASgIEhcKD3RpdGxlX2Z1bGxfbmFtZRgJIAEoCSIiCg5Qcm9maWxlUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCSLPAgoPUHJvZmlsZVJlc3BvbnNlEhIKCmZpcnN0X25hbWUYASABKAkSEQoJbGFzdF9uYW1lGAIgASgJEhQKDGNvdW50cnlfY29kZRgDIAEoCRINCgV0aXRsZRgEIAEoCRINCgVhYm91dBgFIAEoCRIUCgxyYXRpbmdzX2pzb24YBiABKAkSEgoKc3RhdHNfanNvbhgHIAEoCRIPCgd1c2VyX2lkGAggASgJEhIKCmF2YXRhcl91cmwYCSABKAkSEQoJZnVsbF9uYW1lGAogASgJEhgKEGF2YXRhcnNfZWRpdGFibGUYCyABKAgSEgoKYmlydGhfZGF0ZRgMIAEoCRITCgtiYWRnZV9jb2RlcxgNIAMoCRI8ChNvcmdhbml6YXRpb25fdGl0bGVzGA8gAygLMh8udXNlcl9zZXJ2aWNlLk9yZ2FuaXphdGlvblRpdGxlIhUKE1BlcnNvbmFsSW5mb1JlcXVlc3QirAEKFFBlcnNvbmFsSW5mb1Jlc3BvbnNlEg0KBWVtYWlsGAEgASgJEhIKCmZpcnN0X25hbWUYAiABKAkSEQoJbGFzdF9uYW1lGAMgASgJEhQKDGNvdW50cnlfY29kZRgEIAEoCRISCgphdmF0YXJfdXJsGAUgASgJEhEKCWZ1bGxfbmFtZRgGIAEoCRINCgVhYm91dBgHIAEoCRISCgpiaXJ0aF9kYXRlGAggASgJIrEBChlVcGRhdGVQZXJzb25hbEluZm9SZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhIKCmZpcnN0X25hbWUYAiABKAkSEQoJbGFzdF9uYW1lGAMgASgJEhQKDGNvdW50cnlfY29kZRgEIAEoCRISCgphdmF0YXJfdXJsGAUgASgJEhEKCWZ1bGxfbmFtZRgGIAEoCRINCgVhYm91dBgHIAEoCRISCgpiaXJ0aF9kYXRlGAggASgJIhwKGlVwZGF0ZVBlcnNvbmFsSW5mb1Jlc3BvbnNlIicKE1VwZGF0ZUF2YXRhclJlcXVlc3QSEAoIanBnX2RhdGEYASABKAwiKgoUVXBkYXRlQXZhdGFyUmVzcG9uc2USEgoKYXZhdGFyX3VybBgBIAEoCSIVChNSZW1vdmVBdmF0YXJSZXF1ZXN0IhYKFFJlbW92ZUF2YXRhclJlc3BvbnNlIigKFEJyaWVmUHJvZmlsZXNSZXF1ZXN0EhAKCHVzZXJfaWRzGAEgAygJIr4BCgxCcmllZlByb2ZpbGUSEAoIdXNlcm5hbWUYASABKAkSEQoJZnVsbF9uYW1lGAIgASgJEhQKDGNvdW50cnlfY29kZRgDIAEoCRISCgphdmF0YXJfdXJsGAkgASgJEhMKC2JhZGdlX2NvZGVzGA0gAygJEg0KBXRpdGxlGA4gASgJEh8KF3RpdGxlX29yZ2FuaXphdGlvbl9jb2RlGA8gASgJEhoKEnRpdGxlX2FiYnJldmlhdGlvbhgQIAEoCSKpAQoVQnJpZWZQcm9maWxlc1Jlc3BvbnNlEkMKCHJlc3BvbnNlGAEgAygLMjEudXNlcl9zZXJ2aWNlLkJyaWVmUHJvZmlsZXNSZXNwb25zZS5SZXNwb25zZUVudHJ5GksKDVJlc3BvbnNlRW50cnkSCwoDa2V5GAEgASgJEikKBXZhbHVlGAIgASgLMhoudXNlcl9zZXJ2aWNlLkJyaWVmUHJvZmlsZToCOAEiFgoUQmFkZ2VNZXRhZGF0YVJlcXVlc3QihwEKFUJhZGdlTWV0YWRhdGFSZXNwb25zZRI/CgZiYWRnZXMYASADKAsyLy51c2VyX3NlcnZpY2UuQmFkZ2VNZXRhZGF0YVJlc3BvbnNlLkJhZGdlc0VudHJ5Gi0KC0JhZGdlc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiJwoVVXNlcm5hbWVTZWFyY2hSZXF1ZXN0Eg4KBnByZWZpeBgBIAEoCSJAChZVc2VybmFtZVNlYXJjaFJlc3BvbnNlEiYKBXVzZXJzGAIgAygLMhcudXNlcl9zZXJ2aWNlLkJhc2ljVXNlciIgChBBZGRGb2xsb3dSZXF1ZXN0EgwKBHV1aWQYASABKAkiIwoTUmVtb3ZlRm9sbG93UmVxdWVzdBIMCgR1dWlkGAEgASgJIhMKEUdldEZvbGxvd3NSZXF1ZXN0Ih8KD0FkZEJsb2NrUmVxdWVzdBIMCgR1dWlkGAEgASgJIiIKElJlbW92ZUJsb2NrUmVxdWVzdBIMCgR1dWlkGAEgASgJIhIKEEdldEJsb2Nrc1JlcXVlc3QiFgoUR2V0RnVsbEJsb2Nrc1JlcXVlc3QiDAoKT0tSZXNwb25zZSIrCglCYXNpY1VzZXISDAoEdXVpZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCSJEChFCYXNpY0ZvbGxvd2VkVXNlchIMCgR1dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEg8KB2NoYW5uZWwYAyADKAkiaAocR2V0QWN0aXZlQ2hhdENoYW5uZWxzUmVxdWVzdBIOCgZudW1iZXIYASABKAUSDgoGb2Zmc2V0GAIgASgFEhUKDXRvdXJuYW1lbnRfaWQYAyABKAkSEQoJbGVhZ3VlX2lkGAQgASgJItUBChJBY3RpdmVDaGF0Q2hhbm5lbHMSOgoIY2hhbm5lbHMYASADKAsyKC51c2VyX3NlcnZpY2UuQWN0aXZlQ2hhdENoYW5uZWxzLkNoYW5uZWwaggEKB0NoYW5uZWwSDAoEbmFtZRgBIAEoCRIUCgxkaXNwbGF5X25hbWUYAiABKAkSEwoLbGFzdF91cGRhdGUYAyABKAMSEgoKaGFzX3VwZGF0ZRgEIAEoCBIUCgxsYXN0X21lc3NhZ2UYBSABKAkSFAoMdW5yZWFkX2NvdW50GAYgASgFIjUKD0dldENoYXRzUmVxdWVzdBIPCgdjaGFubmVsGAEgASgJEhEKCWJlZm9yZV9pZBgCIAEoCSItChpNYXJrQ2hhdENoYW5uZWxSZWFkUmVxdWVzdBIPCgdjaGFubmVsGAEgASgJIh4KHEdldFVucmVhZE1lc3NhZ2VDb3VudFJlcXVlc3QiIwoSVW5yZWFkTWVzc2FnZUNvdW50Eg0KBWNvdW50GAEgASgFIkwKHFNlYXJjaFByaXZhdGVNZXNzYWdlc1JlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKAUSDgoGb2Zmc2V0GAMgASgFIkQKEkdldEZvbGxvd3NSZXNwb25zZRIuCgV1c2VycxgBIAMoCzIfLnVzZXJfc2VydmljZS5CYXNpY0ZvbGxvd2VkVXNlciI7ChFHZXRCbG9ja3NSZXNwb25zZRImCgV1c2VycxgBIAMoCzIXLnVzZXJfc2VydmljZS5CYXNpY1VzZXIiKQoVR2V0RnVsbEJsb2Nrc1Jlc3BvbnNlEhAKCHVzZXJfaWRzGAEgAygJIsABCgtJbnRlZ3JhdGlvbhIMCgR1dWlkGAEgASgJEhgKEGludGVncmF0aW9uX25hbWUYAiABKAkSTgoTaW50ZWdyYXRpb25fZGV0YWlscxgDIAMoCzIxLnVzZXJfc2VydmljZS5JbnRlZ3JhdGlvbi5JbnRlZ3JhdGlvbkRldGFpbHNFbnRyeRo5ChdJbnRlZ3JhdGlvbkRldGFpbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIhgKFkdldEludGVncmF0aW9uc1JlcXVlc3QiRwoUSW50ZWdyYXRpb25zUmVzcG9uc2USLwoMaW50ZWdyYXRpb25zGAEgAygLMhkudXNlcl9zZXJ2aWNlLkludGVncmF0aW9uIigKGERlbGV0ZUludGVncmF0aW9uUmVxdWVzdBIMCgR1dWlkGAEgASgJIhsKGURlbGV0ZUludGVncmF0aW9uUmVzcG9uc2UikgEKDUxvZ2luSWRlbnRpdHkSEAoIcHJvdmlkZXIYASABKAkSDQoFZW1haWwYAiABKAkSLQoJbGlua2VkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg1sYXN0X2xvZ2luX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIbChlHZXRMb2dpbklkZW50aXRpZXNSZXF1ZXN0IkoKF0xvZ2luSWRlbnRpdGllc1Jlc3BvbnNlEi8KCmlkZW50aXRpZXMYASADKAsyGy51c2VyX3NlcnZpY2UuTG9naW5JZGVudGl0eSIuChpVbmxpbmtMb2dpbklkZW50aXR5UmVxdWVzdBIQCghwcm92aWRlchgBIAEoCSIdChtVbmxpbmtMb2dpbklkZW50aXR5UmVzcG9uc2UiIAoeR2V0U3Vic2NyaXB0aW9uQ3JpdGVyaWFSZXF1ZXN0IokBCh9HZXRTdWJzY3JpcHRpb25Dcml0ZXJpYVJlc3BvbnNlEhEKCXRpZXJfbmFtZRgBIAEoCRIdChVlbnRpdGxlZF90b19ib3RfZ2FtZXMYAiABKAgSNAoQbGFzdF9jaGFyZ2VfZGF0ZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiEwoRR2V0TW9kTGlzdFJlcXVlc3QiQgoSR2V0TW9kTGlzdFJlc3BvbnNlEhYKDmFkbWluX3VzZXJfaWRzGAEgAygJEhQKDG1vZF91c2VyX2lkcxgCIAMoCSIzCg5BZGRSb2xlUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJIhEKD0FkZFJvbGVSZXNwb25zZSI5ChRBZGRQZXJtaXNzaW9uUmVxdWVzdBIMCgRjb2RlGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJIhcKFUFkZFBlcm1pc3Npb25SZXNwb25zZSJKChxMaW5rUm9sZUFuZFBlcm1pc3Npb25SZXF1ZXN0EhEKCXJvbGVfbmFtZRgBIAEoCRIXCg9wZXJtaXNzaW9uX2NvZGUYAiABKAkiHwodTGlua1JvbGVBbmRQZXJtaXNzaW9uUmVzcG9uc2UiFAoSQXNzaWduUm9sZVJlc3BvbnNlIjIKC1VzZXJBbmRSb2xlEhAKCHVzZXJuYW1lGAEgASgJEhEKCXJvbGVfbmFtZRgCIAEoCSIWChRVbmFzc2lnblJvbGVSZXNwb25zZSInChNHZXRVc2VyUm9sZXNSZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJIiIKEVVzZXJSb2xlc1Jlc3BvbnNlEg0KBXJvbGVzGAEgAygJIhUKE0dldFNlbGZSb2xlc1JlcXVlc3QiGwoZR2V0U2VsZlBlcm1pc3Npb25zUmVxdWVzdCIuChdTZWxmUGVybWlzc2lvbnNSZXNwb25zZRITCgtwZXJtaXNzaW9ucxgBIAMoCSIpChhHZXRVc2Vyc1dpdGhSb2xlc1JlcXVlc3QSDQoFcm9sZXMYASADKAkiUgoZR2V0VXNlcnNXaXRoUm9sZXNSZXNwb25zZRI1ChJ1c2VyX2FuZF9yb2xlX29ianMYASADKAsyGS51c2VyX3NlcnZpY2UuVXNlckFuZFJvbGUiGAoWR2V0Um9sZU1ldGFkYXRhUmVxdWVzdCI9ChNSb2xlV2l0aFBlcm1pc3Npb25zEhEKCXJvbGVfbmFtZRgBIAEoCRITCgtwZXJtaXNzaW9ucxgCIAMoCSJZChRSb2xlTWV0YWRhdGFSZXNwb25zZRJBChZyb2xlc193aXRoX3Blcm1pc3Npb25zGAEgAygLMiEudXNlcl9zZXJ2aWNlLlJvbGVXaXRoUGVybWlzc2lvbnMizgEKGkNvbm5lY3RPcmdhbml6YXRpb25SZXF1ZXN0EhkKEW9yZ2FuaXphdGlvbl9jb2RlGAEgASgJEhEKCW1lbWJlcl9pZBgCIAEoCRJOCgtjcmVkZW50aWFscxgDIAMoCzI5LnVzZXJfc2VydmljZS5Db25uZWN0T3JnYW5pemF0aW9uUmVxdWVzdC5DcmVkZW50aWFsc0VudHJ5GjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJvChtDb25uZWN0T3JnYW5pemF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEi4KBXRpdGxlGAMgASgLMh8udXNlcl9zZXJ2aWNlLk9yZ2FuaXphdGlvblRpdGxlIl4KHURpc2Nvbm5lY3RPcmdhbml6YXRpb25SZXF1ZXN0EhkKEW9yZ2FuaXphdGlvbl9jb2RlGAEgASgJEhUKCHVzZXJuYW1lGAIgASgJSACIAQFCCwoJX3VzZXJuYW1lIjEKHkRpc2Nvbm5lY3RPcmdhbml6YXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIIhYKFFJlZnJlc2hUaXRsZXNSZXF1ZXN0IlkKFVJlZnJlc2hUaXRsZXNSZXNwb25zZRIvCgZ0aXRsZXMYASADKAsyHy51c2VyX3NlcnZpY2UuT3JnYW5pemF0aW9uVGl0bGUSDwoHbWVzc2FnZRgCIAEoCSIbChlHZXRNeU9yZ2FuaXphdGlvbnNSZXF1ZXN0Ik0KGkdldE15T3JnYW5pemF0aW9uc1Jlc3BvbnNlEi8KBnRpdGxlcxgBIAMoCzIfLnVzZXJfc2VydmljZS5Pcmdhbml6YXRpb25UaXRsZSIxCh1HZXRQdWJsaWNPcmdhbml6YXRpb25zUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCSJRCh5HZXRQdWJsaWNPcmdhbml6YXRpb25zUmVzcG9uc2USLwoGdGl0bGVzGAEgAygLMh8udXNlcl9zZXJ2aWNlLk9yZ2FuaXphdGlvblRpdGxlInYKGVN1Ym1pdFZlcmlmaWNhdGlvblJlcXVlc3QSGQoRb3JnYW5pemF0aW9uX2NvZGUYASABKAkSEQoJbWVtYmVyX2lkGAIgASgJEhIKCmltYWdlX2RhdGEYAyABKAwSFwoPaW1hZ2VfZXh0ZW5zaW9uGAQgASgJIlIKGlN1Ym1pdFZlcmlmaWNhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCRISCgpyZXF1ZXN0X2lkGAMgASgDIiAKHkdldFBlbmRpbmdWZXJpZmljYXRpb25zUmVxdWVzdCKGAgoXVmVyaWZpY2F0aW9uUmVxdWVzdEluZm8SEgoKcmVxdWVzdF9pZBgBIAEoAxIRCgl1c2VyX3V1aWQYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSGQoRb3JnYW5pemF0aW9uX2NvZGUYBCABKAkSEQoJbWVtYmVyX2lkGAUgASgJEhEKCWZ1bGxfbmFtZRgGIAEoCRIRCglpbWFnZV91cmwYByABKAkSMAoMc3VibWl0dGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZzdGF0dXMYCSABKAkSDQoFdGl0bGUYCiABKAkSDQoFbm90ZXMYCyABKAkiWgofR2V0UGVuZGluZ1ZlcmlmaWNhdGlvbnNSZXNwb25zZRI3CghyZXF1ZXN0cxgBIAMoCzIlLnVzZXJfc2VydmljZS5WZXJpZmljYXRpb25SZXF1ZXN0SW5mbyI/ChpBcHByb3ZlVmVyaWZpY2F0aW9uUmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgDEg0KBW5vdGVzGAIgASgJIj8KG0FwcHJvdmVWZXJpZmljYXRpb25SZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiPgoZUmVqZWN0VmVyaWZpY2F0aW9uUmVxdWVzdBISCgpyZXF1ZXN0X2lkGAEgASgDEg0KBW5vdGVzGAIgASgJIj4KGlJlamVjdFZlcmlmaWNhdGlvblJlc3BvbnNlEg8KB3N1Y2Nlc3MYASABKAgSDwoHbWVzc2FnZRgCIAEoCSI0Ch5HZXRWZXJpZmljYXRpb25JbWFnZVVybFJlcXVlc3QSEgoKcmVxdWVzdF9pZBgBIAEoAyI0Ch9HZXRWZXJpZmljYXRpb25JbWFnZVVybFJlc3BvbnNlEhEKCWltYWdlX3VybBgBIAEoCSLqAQofTWFudWFsbHlTZXRPcmdNZW1iZXJzaGlwUmVxdWVzdBIQCgh1c2VybmFtZRgBIAEoCRIZChFvcmdhbml6YXRpb25fY29kZRgCIAEoCRIRCgltZW1iZXJfaWQYAyABKAkSUwoLY3JlZGVudGlhbHMYBCADKAsyPi51c2VyX3NlcnZpY2UuTWFudWFsbHlTZXRPcmdNZW1iZXJzaGlwUmVxdWVzdC5DcmVkZW50aWFsc0VudHJ5GjIKEENyZWRlbnRpYWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJECiBNYW51YWxseVNldE9yZ01lbWJlcnNoaXBSZXNwb25zZRIPCgdzdWNjZXNzGAEgASgIEg8KB21lc3NhZ2UYAiABKAkiMQodQWRtaW5SZWZyZXNoVXNlclRpdGxlc1JlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkiYgoeQWRtaW5SZWZyZXNoVXNlclRpdGxlc1Jlc3BvbnNlEi8KBnRpdGxlcxgBIAMoCzIfLnVzZXJfc2VydmljZS5Pcmdhbml6YXRpb25UaXRsZRIPCgdtZXNzYWdlGAIgASgJMqIHChVBdXRoZW50aWNhdGlvblNlcnZpY2USRAoFTG9naW4SHi51c2VyX3NlcnZpY2UuVXNlckxvZ2luUmVxdWVzdBobLnVzZXJfc2VydmljZS5Mb2dpblJlc3BvbnNlEkcKBkxvZ291dBIfLnVzZXJfc2VydmljZS5Vc2VyTG9nb3V0UmVxdWVzdBocLnVzZXJfc2VydmljZS5Mb2dvdXRSZXNwb25zZRJVCg5HZXRTb2NrZXRUb2tlbhIgLnVzZXJfc2VydmljZS5Tb2NrZXRUb2tlblJlcXVlc3QaIS51c2VyX3NlcnZpY2UuU29ja2V0VG9rZW5SZXNwb25zZRJiChJSZXNldFBhc3N3b3JkU3RlcDESJy51c2VyX3NlcnZpY2UuUmVzZXRQYXNzd29yZFJlcXVlc3RTdGVwMRojLnVzZXJfc2VydmljZS5SZXNldFBhc3N3b3JkUmVzcG9uc2USYgoSUmVzZXRQYXNzd29yZFN0ZXAyEicudXNlcl9zZXJ2aWNlLlJlc2V0UGFzc3dvcmRSZXF1ZXN0U3RlcDIaIy51c2VyX3NlcnZpY2UuUmVzZXRQYXNzd29yZFJlc3BvbnNlElsKDkNoYW5nZVBhc3N3b3JkEiMudXNlcl9zZXJ2aWNlLkNoYW5nZVBhc3N3b3JkUmVxdWVzdBokLnVzZXJfc2VydmljZS5DaGFuZ2VQYXNzd29yZFJlc3BvbnNlEm0KFE5vdGlmeUFjY291bnRDbG9zdXJlEikudXNlcl9zZXJ2aWNlLk5vdGlmeUFjY291bnRDbG9zdXJlUmVxdWVzdBoqLnVzZXJfc2VydmljZS5Ob3RpZnlBY2NvdW50Q2xvc3VyZVJlc3BvbnNlElsKD0dldFNpZ25lZENvb2tpZRIkLnVzZXJfc2VydmljZS5HZXRTaWduZWRDb29raWVSZXF1ZXN0GiIudXNlcl9zZXJ2aWNlLlNpZ25lZENvb2tpZVJlc3BvbnNlEmQKE0luc3RhbGxTaWduZWRDb29raWUSIi51c2VyX3NlcnZpY2UuU2lnbmVkQ29va2llUmVzcG9uc2UaKS51c2VyX3NlcnZpY2UuSW5zdGFsbFNpZ25lZENvb2tpZVJlc3BvbnNlEkwKCUdldEFQSUtleRIeLnVzZXJfc2VydmljZS5HZXRBUElLZXlSZXF1ZXN0Gh8udXNlcl9zZXJ2aWNlLkdldEFQSUtleVJlc3BvbnNlMqQDChNSZWdpc3RyYXRpb25TZXJ2aWNlElUKCFJlZ2lzdGVyEiUudXNlcl9zZXJ2aWNlLlVzZXJSZWdpc3RyYXRpb25SZXF1ZXN0GiIudXNlcl9zZXJ2aWNlLlJlZ2lzdHJhdGlvblJlc3BvbnNlElIKC1ZlcmlmeUVtYWlsEiAudXNlcl9zZXJ2aWNlLlZlcmlmeUVtYWlsUmVxdWVzdBohLnVzZXJfc2VydmljZS5WZXJpZnlFbWFpbFJlc3BvbnNlEnYKF1Jlc2VuZFZlcmlmaWNhdGlvbkVtYWlsEiwudXNlcl9zZXJ2aWNlLlJlc2VuZFZlcmlmaWNhdGlvbkVtYWlsUmVxdWVzdBotLnVzZXJfc2VydmljZS5SZXNlbmRWZXJpZmljYXRpb25FbWFpbFJlc3BvbnNlEmoKE0NvbXBsZXRlT0F1dGhTaWdudXASKC51c2VyX3NlcnZpY2UuQ29tcGxldGVPQXV0aFNpZ251cFJlcXVlc3QaKS51c2VyX3NlcnZpY2UuQ29tcGxldGVPQXV0aFNpZ251cFJlc3BvbnNlMv4GCg5Qcm9maWxlU2VydmljZRJJCgpHZXRSYXRpbmdzEhwudXNlcl9zZXJ2aWNlLlJhdGluZ3NSZXF1ZXN0Gh0udXNlcl9zZXJ2aWNlLlJhdGluZ3NSZXNwb25zZRJDCghHZXRTdGF0cxIaLnVzZXJfc2VydmljZS5TdGF0c1JlcXVlc3QaGy51c2VyX3NlcnZpY2UuU3RhdHNSZXNwb25zZRJbChBHZXRSYXRpbmdIaXN0b3J5EiIudXNlcl9zZXJ2aWNlLlJhdGluZ0hpc3RvcnlSZXF1ZXN0GiMudXNlcl9zZXJ2aWNlLlJhdGluZ0hpc3RvcnlSZXNwb25zZRJJCgpHZXRQcm9maWxlEhwudXNlcl9zZXJ2aWNlLlByb2ZpbGVSZXF1ZXN0Gh0udXNlcl9zZXJ2aWNlLlByb2ZpbGVSZXNwb25zZRJYCg9HZXRQZXJzb25hbEluZm8SIS51c2VyX3NlcnZpY2UuUGVyc29uYWxJbmZvUmVxdWVzdBoiLnVzZXJfc2VydmljZS5QZXJzb25hbEluZm9SZXNwb25zZRJnChJVcGRhdGVQZXJzb25hbEluZm8SJy51c2VyX3NlcnZpY2UuVXBkYXRlUGVyc29uYWxJbmZvUmVxdWVzdBooLnVzZXJfc2VydmljZS5VcGRhdGVQZXJzb25hbEluZm9SZXNwb25zZRJVCgxVcGRhdGVBdmF0YXISIS51c2VyX3NlcnZpY2UuVXBkYXRlQXZhdGFyUmVxdWVzdBoiLnVzZXJfc2VydmljZS5VcGRhdGVBdmF0YXJSZXNwb25zZRJVCgxSZW1vdmVBdmF0YXISIS51c2VyX3NlcnZpY2UuUmVtb3ZlQXZhdGFyUmVxdWVzdBoiLnVzZXJfc2VydmljZS5SZW1vdmVBdmF0YXJSZXNwb25zZRJgChBHZXRCcmllZlByb2ZpbGVzEiIudXNlcl9zZXJ2aWNlLkJyaWVmUHJvZmlsZXNSZXF1ZXN0GiMudXNlcl9zZXJ2aWNlLkJyaWVmUHJvZmlsZXNSZXNwb25zZSIDkAIBEmEKEUdldEJhZGdlc01ldGFkYXRhEiIudXNlcl9zZXJ2aWNlLkJhZGdlTWV0YWRhdGFSZXF1ZXN0GiMudXNlcl9zZXJ2aWNlLkJhZGdlTWV0YWRhdGFSZXNwb25zZSIDkAIBMnEKE0F1dG9jb21wbGV0ZVNlcnZpY2USWgoNR2V0Q29tcGxldGlvbhIjLnVzZXJfc2VydmljZS5Vc2VybmFtZVNlYXJjaFJlcXVlc3QaJC51c2VyX3NlcnZpY2UuVXNlcm5hbWVTZWFyY2hSZXNwb25zZTL4BwoQU29jaWFsaXplU2VydmljZRJFCglBZGRGb2xsb3cSHi51c2VyX3NlcnZpY2UuQWRkRm9sbG93UmVxdWVzdBoYLnVzZXJfc2VydmljZS5PS1Jlc3BvbnNlEksKDFJlbW92ZUZvbGxvdxIhLnVzZXJfc2VydmljZS5SZW1vdmVGb2xsb3dSZXF1ZXN0GhgudXNlcl9zZXJ2aWNlLk9LUmVzcG9uc2USTwoKR2V0Rm9sbG93cxIfLnVzZXJfc2VydmljZS5HZXRGb2xsb3dzUmVxdWVzdBogLnVzZXJfc2VydmljZS5HZXRGb2xsb3dzUmVzcG9uc2USQwoIQWRkQmxvY2sSHS51c2VyX3NlcnZpY2UuQWRkQmxvY2tSZXF1ZXN0GhgudXNlcl9zZXJ2aWNlLk9LUmVzcG9uc2USSQoLUmVtb3ZlQmxvY2sSIC51c2VyX3NlcnZpY2UuUmVtb3ZlQmxvY2tSZXF1ZXN0GhgudXNlcl9zZXJ2aWNlLk9LUmVzcG9uc2USTAoJR2V0QmxvY2tzEh4udXNlcl9zZXJ2aWNlLkdldEJsb2Nrc1JlcXVlc3QaHy51c2VyX3NlcnZpY2UuR2V0QmxvY2tzUmVzcG9uc2USWAoNR2V0RnVsbEJsb2NrcxIiLnVzZXJfc2VydmljZS5HZXRGdWxsQmxvY2tzUmVxdWVzdBojLnVzZXJfc2VydmljZS5HZXRGdWxsQmxvY2tzUmVzcG9uc2USZQoVR2V0QWN0aXZlQ2hhdENoYW5uZWxzEioudXNlcl9zZXJ2aWNlLkdldEFjdGl2ZUNoYXRDaGFubmVsc1JlcXVlc3QaIC51c2VyX3NlcnZpY2UuQWN0aXZlQ2hhdENoYW5uZWxzEkYKEkdldENoYXRzRm9yQ2hhbm5lbBIdLnVzZXJfc2VydmljZS5HZXRDaGF0c1JlcXVlc3QaES5pcGMuQ2hhdE1lc3NhZ2VzElkKE01hcmtDaGF0Q2hhbm5lbFJlYWQSKC51c2VyX3NlcnZpY2UuTWFya0NoYXRDaGFubmVsUmVhZFJlcXVlc3QaGC51c2VyX3NlcnZpY2UuT0tSZXNwb25zZRJlChVHZXRVbnJlYWRNZXNzYWdlQ291bnQSKi51c2VyX3NlcnZpY2UuR2V0VW5yZWFkTWVzc2FnZUNvdW50UmVxdWVzdBogLnVzZXJfc2VydmljZS5VbnJlYWRNZXNzYWdlQ291bnQSVgoVU2VhcmNoUHJpdmF0ZU1lc3NhZ2VzEioudXNlcl9zZXJ2aWNlLlNlYXJjaFByaXZhdGVNZXNzYWdlc1JlcXVlc3QaES5pcGMuQ2hhdE1lc3NhZ2VzMrMDChJJbnRlZ3JhdGlvblNlcnZpY2USYAoPR2V0SW50ZWdyYXRpb25zEiQudXNlcl9zZXJ2aWNlLkdldEludGVncmF0aW9uc1JlcXVlc3QaIi51c2VyX3NlcnZpY2UuSW50ZWdyYXRpb25zUmVzcG9uc2UiA5ACARJkChFEZWxldGVJbnRlZ3JhdGlvbhImLnVzZXJfc2VydmljZS5EZWxldGVJbnRlZ3JhdGlvblJlcXVlc3QaJy51c2VyX3NlcnZpY2UuRGVsZXRlSW50ZWdyYXRpb25SZXNwb25zZRJpChJHZXRMb2dpbklkZW50aXRpZXMSJy51c2VyX3NlcnZpY2UuR2V0TG9naW5JZGVudGl0aWVzUmVxdWVzdBolLnVzZXJfc2VydmljZS5Mb2dpbklkZW50aXRpZXNSZXNwb25zZSIDkAIBEmoKE1VubGlua0xvZ2luSWRlbnRpdHkSKC51c2VyX3NlcnZpY2UuVW5saW5rTG9naW5JZGVudGl0eVJlcXVlc3QaKS51c2VyX3NlcnZpY2UuVW5saW5rTG9naW5JZGVudGl0eVJlc3BvbnNlMvAJChRBdXRob3JpemF0aW9uU2VydmljZRJUCgpHZXRNb2RMaXN0Eh8udXNlcl9zZXJ2aWNlLkdldE1vZExpc3RSZXF1ZXN0GiAudXNlcl9zZXJ2aWNlLkdldE1vZExpc3RSZXNwb25zZSIDkAIBEnYKF0dldFN1YnNjcmlwdGlvbkNyaXRlcmlhEiwudXNlcl9zZXJ2aWNlLkdldFN1YnNjcmlwdGlvbkNyaXRlcmlhUmVxdWVzdBotLnVzZXJfc2VydmljZS5HZXRTdWJzY3JpcHRpb25Dcml0ZXJpYVJlc3BvbnNlEkYKB0FkZFJvbGUSHC51c2VyX3NlcnZpY2UuQWRkUm9sZVJlcXVlc3QaHS51c2VyX3NlcnZpY2UuQWRkUm9sZVJlc3BvbnNlElgKDUFkZFBlcm1pc3Npb24SIi51c2VyX3NlcnZpY2UuQWRkUGVybWlzc2lvblJlcXVlc3QaIy51c2VyX3NlcnZpY2UuQWRkUGVybWlzc2lvblJlc3BvbnNlEnAKFUxpbmtSb2xlQW5kUGVybWlzc2lvbhIqLnVzZXJfc2VydmljZS5MaW5rUm9sZUFuZFBlcm1pc3Npb25SZXF1ZXN0GisudXNlcl9zZXJ2aWNlLkxpbmtSb2xlQW5kUGVybWlzc2lvblJlc3BvbnNlEnIKF1VubGlua1JvbGVBbmRQZXJtaXNzaW9uEioudXNlcl9zZXJ2aWNlLkxpbmtSb2xlQW5kUGVybWlzc2lvblJlcXVlc3QaKy51c2VyX3NlcnZpY2UuTGlua1JvbGVBbmRQZXJtaXNzaW9uUmVzcG9uc2USSQoKQXNzaWduUm9sZRIZLnVzZXJfc2VydmljZS5Vc2VyQW5kUm9sZRogLnVzZXJfc2VydmljZS5Bc3NpZ25Sb2xlUmVzcG9uc2USTQoMVW5hc3NpZ25Sb2xlEhkudXNlcl9zZXJ2aWNlLlVzZXJBbmRSb2xlGiIudXNlcl9zZXJ2aWNlLlVuYXNzaWduUm9sZVJlc3BvbnNlElcKDEdldFVzZXJSb2xlcxIhLnVzZXJfc2VydmljZS5HZXRVc2VyUm9sZXNSZXF1ZXN0Gh8udXNlcl9zZXJ2aWNlLlVzZXJSb2xlc1Jlc3BvbnNlIgOQAgESVwoMR2V0U2VsZlJvbGVzEiEudXNlcl9zZXJ2aWNlLkdldFNlbGZSb2xlc1JlcXVlc3QaHy51c2VyX3NlcnZpY2UuVXNlclJvbGVzUmVzcG9uc2UiA5ACARJpChJHZXRTZWxmUGVybWlzc2lvbnMSJy51c2VyX3NlcnZpY2UuR2V0U2VsZlBlcm1pc3Npb25zUmVxdWVzdBolLnVzZXJfc2VydmljZS5TZWxmUGVybWlzc2lvbnNSZXNwb25zZSIDkAIBEmkKEUdldFVzZXJzV2l0aFJvbGVzEiYudXNlcl9zZXJ2aWNlLkdldFVzZXJzV2l0aFJvbGVzUmVxdWVzdBonLnVzZXJfc2VydmljZS5HZXRVc2Vyc1dpdGhSb2xlc1Jlc3BvbnNlIgOQAgESYAoPR2V0Um9sZU1ldGFkYXRhEiQudXNlcl9zZXJ2aWNlLkdldFJvbGVNZXRhZGF0YVJlcXVlc3QaIi51c2VyX3NlcnZpY2UuUm9sZU1ldGFkYXRhUmVzcG9uc2UiA5ACATLgCgoTT3JnYW5pemF0aW9uU2VydmljZRJqChNDb25uZWN0T3JnYW5pemF0aW9uEigudXNlcl9zZXJ2aWNlLkNvbm5lY3RPcmdhbml6YXRpb25SZXF1ZXN0GikudXNlcl9zZXJ2aWNlLkNvbm5lY3RPcmdhbml6YXRpb25SZXNwb25zZRJzChZEaXNjb25uZWN0T3JnYW5pemF0aW9uEisudXNlcl9zZXJ2aWNlLkRpc2Nvbm5lY3RPcmdhbml6YXRpb25SZXF1ZXN0GiwudXNlcl9zZXJ2aWNlLkRpc2Nvbm5lY3RPcmdhbml6YXRpb25SZXNwb25zZRJYCg1SZWZyZXNoVGl0bGVzEiIudXNlcl9zZXJ2aWNlLlJlZnJlc2hUaXRsZXNSZXF1ZXN0GiMudXNlcl9zZXJ2aWNlLlJlZnJlc2hUaXRsZXNSZXNwb25zZRJsChJHZXRNeU9yZ2FuaXphdGlvbnMSJy51c2VyX3NlcnZpY2UuR2V0TXlPcmdhbml6YXRpb25zUmVxdWVzdBooLnVzZXJfc2VydmljZS5HZXRNeU9yZ2FuaXphdGlvbnNSZXNwb25zZSIDkAIBEngKFkdldFB1YmxpY09yZ2FuaXphdGlvbnMSKy51c2VyX3NlcnZpY2UuR2V0UHVibGljT3JnYW5pemF0aW9uc1JlcXVlc3QaLC51c2VyX3NlcnZpY2UuR2V0UHVibGljT3JnYW5pemF0aW9uc1Jlc3BvbnNlIgOQAgESZwoSU3VibWl0VmVyaWZpY2F0aW9uEicudXNlcl9zZXJ2aWNlLlN1Ym1pdFZlcmlmaWNhdGlvblJlcXVlc3QaKC51c2VyX3NlcnZpY2UuU3VibWl0VmVyaWZpY2F0aW9uUmVzcG9uc2USewoXR2V0UGVuZGluZ1ZlcmlmaWNhdGlvbnMSLC51c2VyX3NlcnZpY2UuR2V0UGVuZGluZ1ZlcmlmaWNhdGlvbnNSZXF1ZXN0Gi0udXNlcl9zZXJ2aWNlLkdldFBlbmRpbmdWZXJpZmljYXRpb25zUmVzcG9uc2UiA5ACARJ7ChdHZXRWZXJpZmljYXRpb25JbWFnZVVybBIsLnVzZXJfc2VydmljZS5HZXRWZXJpZmljYXRpb25JbWFnZVVybFJlcXVlc3QaLS51c2VyX3NlcnZpY2UuR2V0VmVyaWZpY2F0aW9uSW1hZ2VVcmxSZXNwb25zZSIDkAIBEmoKE0FwcHJvdmVWZXJpZmljYXRpb24SKC51c2VyX3NlcnZpY2UuQXBwcm92ZVZlcmlmaWNhdGlvblJlcXVlc3QaKS51c2VyX3NlcnZpY2UuQXBwcm92ZVZlcmlmaWNhdGlvblJlc3BvbnNlEmcKElJlamVjdFZlcmlmaWNhdGlvbhInLnVzZXJfc2VydmljZS5SZWplY3RWZXJpZmljYXRpb25SZXF1ZXN0GigudXNlcl9zZXJ2aWNlLlJlamVjdFZlcmlmaWNhdGlvblJlc3BvbnNlEnkKGE1hbnVhbGx5U2V0T3JnTWVtYmVyc2hpcBItLnVzZXJfc2VydmljZS5NYW51YWxseVNldE9yZ01lbWJlcnNoaXBSZXF1ZXN0Gi4udXNlcl9zZXJ2aWNlLk1hbnVhbGx5U2V0T3JnTWVtYmVyc2hpcFJlc3BvbnNlEnMKFkFkbWluUmVmcmVzaFVzZXJUaXRsZXMSKy51c2VyX3NlcnZpY2UuQWRtaW5SZWZyZXNoVXNlclRpdGxlc1JlcXVlc3QaLC51c2VyX3NlcnZpY2UuQWRtaW5SZWZyZXNoVXNlclRpdGxlc1Jlc3BvbnNlQqoBChBjb20udXNlcl9zZXJ2aWNlQhBVc2VyU2VydmljZVByb3RvUAFaOGdpdGh1Yi5jb20vd29vZ2xlcy1pby9saXdvcmRzL3JwYy9hcGkvcHJvdG8vdXNlcl9zZXJ2aWNlogIDVVhYqgILVXNlclNlcnZpY2XKAgtVc2VyU2VydmljZeICF1VzZXJTZXJ2aWNlXEdQQk1ldGFkYXRh6gILVXNlclNlcnZpY2ViBnByb3RvMw", [file_proto_ipc_chat, file_google_protobuf_timestamp]);
 
 /**
  * UserLoginRequest is used for logging in.
@@ -490,6 +490,56 @@ export type ResendVerificationEmailResponse = Message<"user_service.ResendVerifi
 export const ResendVerificationEmailResponseSchema: GenMessage<ResendVerificationEmailResponse> = /*@__PURE__*/
   messageDesc(file_proto_user_service_user_service, 24);
 
+/**
+ * CompleteOAuthSignupRequest finishes creating an account for someone who
+ * signed in with an external identity provider that isn't linked to any
+ * Woogles account yet. The signup_token is issued by the OAuth login
+ * callback and carries the verified external identity.
+ *
+ * @generated from message user_service.CompleteOAuthSignupRequest
+ */
+export type CompleteOAuthSignupRequest = Message<"user_service.CompleteOAuthSignupRequest"> & {
+  /**
+   * @generated from field: string signup_token = 1;
+   */
+  signupToken: string;
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string birth_date = 3;
+   */
+  birthDate: string;
+
+  /**
+   * @generated from field: string country_code = 4;
+   */
+  countryCode: string;
+};
+
+/**
+ * Describes the message user_service.CompleteOAuthSignupRequest.
+ * Use `create(CompleteOAuthSignupRequestSchema)` to create a new message.
+ */
+export const CompleteOAuthSignupRequestSchema: GenMessage<CompleteOAuthSignupRequest> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 25);
+
+/**
+ * @generated from message user_service.CompleteOAuthSignupResponse
+ */
+export type CompleteOAuthSignupResponse = Message<"user_service.CompleteOAuthSignupResponse"> & {
+};
+
+/**
+ * Describes the message user_service.CompleteOAuthSignupResponse.
+ * Use `create(CompleteOAuthSignupResponseSchema)` to create a new message.
+ */
+export const CompleteOAuthSignupResponseSchema: GenMessage<CompleteOAuthSignupResponse> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 26);
+
 /**
  * @generated from message user_service.RatingsRequest
  */
@@ -505,7 +555,7 @@ export type RatingsRequest = Message<"user_service.RatingsRequest"> & {
  * Use `create(RatingsRequestSchema)` to create a new message.
  */
 export const RatingsRequestSchema: GenMessage<RatingsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 25);
+  messageDesc(file_proto_user_service_user_service, 27);
 
 /**
  * We just send the raw JSON from the db here for ease. Let the front-end
@@ -525,7 +575,7 @@ export type RatingsResponse = Message<"user_service.RatingsResponse"> & {
  * Use `create(RatingsResponseSchema)` to create a new message.
  */
 export const RatingsResponseSchema: GenMessage<RatingsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 26);
+  messageDesc(file_proto_user_service_user_service, 28);
 
 /**
  * @generated from message user_service.StatsRequest
@@ -542,7 +592,7 @@ export type StatsRequest = Message<"user_service.StatsRequest"> & {
  * Use `create(StatsRequestSchema)` to create a new message.
  */
 export const StatsRequestSchema: GenMessage<StatsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 27);
+  messageDesc(file_proto_user_service_user_service, 29);
 
 /**
  * See ratings JSON note above.
@@ -561,7 +611,199 @@ export type StatsResponse = Message<"user_service.StatsResponse"> & {
  * Use `create(StatsResponseSchema)` to create a new message.
  */
 export const StatsResponseSchema: GenMessage<StatsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 28);
+  messageDesc(file_proto_user_service_user_service, 30);
+
+/**
+ * @generated from message user_service.RatingHistoryRequest
+ */
+export type RatingHistoryRequest = Message<"user_service.RatingHistoryRequest"> & {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * The variant key, e.g. "NWL18.classic.rapid". If empty, no points are
+   * returned, only the variants the user has a rating history in.
+   *
+   * @generated from field: string variant = 2;
+   */
+  variant: string;
+
+  /**
+   * Only return rating changes from this time on.
+   *
+   * @generated from field: google.protobuf.Timestamp since = 3;
+   */
+  since?: Timestamp | undefined;
+
+  /**
+   * The maximum number of most recent rating changes. Defaults to and is
+   * capped at 1000.
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message user_service.RatingHistoryRequest.
+ * Use `create(RatingHistoryRequestSchema)` to create a new message.
+ */
+export const RatingHistoryRequestSchema: GenMessage<RatingHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 31);
+
+/**
+ * The terms of a rating calculation. The rating change is the multiplier
+ * times (actual_result - expected_result).
+ *
+ * @generated from message user_service.RatingChangeBreakdown
+ */
+export type RatingChangeBreakdown = Message<"user_service.RatingChangeBreakdown"> & {
+  /**
+   * From 0 for a certain loss to 1 for a certain win.
+   *
+   * @generated from field: double expected_result = 1;
+   */
+  expectedResult: number;
+
+  /**
+   * What the spread was worth on the same scale. Any win is worth at least
+   * 0.5 plus the win boost.
+   *
+   * @generated from field: double actual_result = 2;
+   */
+  actualResult: number;
+
+  /**
+   * @generated from field: double win_boost = 3;
+   */
+  winBoost: number;
+
+  /**
+   * The rating deviation once it was raised for the time since the
+   * previous game.
+   *
+   * @generated from field: double inactive_rating_deviation = 4;
+   */
+  inactiveRatingDeviation: number;
+
+  /**
+   * @generated from field: double multiplier = 5;
+   */
+  multiplier: number;
+};
+
+/**
+ * Describes the message user_service.RatingChangeBreakdown.
+ * Use `create(RatingChangeBreakdownSchema)` to create a new message.
+ */
+export const RatingChangeBreakdownSchema: GenMessage<RatingChangeBreakdown> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 32);
+
+/**
+ * @generated from message user_service.RatingHistoryPoint
+ */
+export type RatingHistoryPoint = Message<"user_service.RatingHistoryPoint"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string opponent_username = 2;
+   */
+  opponentUsername: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp played_at = 3;
+   */
+  playedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: double rating_before = 4;
+   */
+  ratingBefore: number;
+
+  /**
+   * @generated from field: double rating_after = 5;
+   */
+  ratingAfter: number;
+
+  /**
+   * @generated from field: double rating_deviation_before = 6;
+   */
+  ratingDeviationBefore: number;
+
+  /**
+   * @generated from field: double rating_deviation_after = 7;
+   */
+  ratingDeviationAfter: number;
+
+  /**
+   * @generated from field: double volatility_before = 8;
+   */
+  volatilityBefore: number;
+
+  /**
+   * @generated from field: double volatility_after = 9;
+   */
+  volatilityAfter: number;
+
+  /**
+   * @generated from field: double opponent_rating = 10;
+   */
+  opponentRating: number;
+
+  /**
+   * @generated from field: double opponent_rating_deviation = 11;
+   */
+  opponentRatingDeviation: number;
+
+  /**
+   * The spread used for rating, from this user's point of view. Resigned,
+   * timed out and forfeited games use the maximum spread.
+   *
+   * @generated from field: int32 spread = 12;
+   */
+  spread: number;
+
+  /**
+   * @generated from field: user_service.RatingChangeBreakdown breakdown = 13;
+   */
+  breakdown?: RatingChangeBreakdown | undefined;
+};
+
+/**
+ * Describes the message user_service.RatingHistoryPoint.
+ * Use `create(RatingHistoryPointSchema)` to create a new message.
+ */
+export const RatingHistoryPointSchema: GenMessage<RatingHistoryPoint> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 33);
+
+/**
+ * @generated from message user_service.RatingHistoryResponse
+ */
+export type RatingHistoryResponse = Message<"user_service.RatingHistoryResponse"> & {
+  /**
+   * Oldest first.
+   *
+   * @generated from field: repeated user_service.RatingHistoryPoint points = 1;
+   */
+  points: RatingHistoryPoint[];
+
+  /**
+   * @generated from field: repeated string variants = 2;
+   */
+  variants: string[];
+};
+
+/**
+ * Describes the message user_service.RatingHistoryResponse.
+ * Use `create(RatingHistoryResponseSchema)` to create a new message.
+ */
+export const RatingHistoryResponseSchema: GenMessage<RatingHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 34);
 
 /**
  * Organization Title (defined early for use in ProfileResponse)
@@ -626,7 +868,7 @@ export type OrganizationTitle = Message<"user_service.OrganizationTitle"> & {
  * Use `create(OrganizationTitleSchema)` to create a new message.
  */
 export const OrganizationTitleSchema: GenMessage<OrganizationTitle> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 29);
+  messageDesc(file_proto_user_service_user_service, 35);
 
 /**
  * @generated from message user_service.ProfileRequest
@@ -643,7 +885,7 @@ export type ProfileRequest = Message<"user_service.ProfileRequest"> & {
  * Use `create(ProfileRequestSchema)` to create a new message.
  */
 export const ProfileRequestSchema: GenMessage<ProfileRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 30);
+  messageDesc(file_proto_user_service_user_service, 36);
 
 /**
  * @generated from message user_service.ProfileResponse
@@ -729,7 +971,7 @@ export type ProfileResponse = Message<"user_service.ProfileResponse"> & {
  * Use `create(ProfileResponseSchema)` to create a new message.
  */
 export const ProfileResponseSchema: GenMessage<ProfileResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 31);
+  messageDesc(file_proto_user_service_user_service, 37);
 
 /**
  * @generated from message user_service.PersonalInfoRequest
@@ -742,7 +984,7 @@ export type PersonalInfoRequest = Message<"user_service.PersonalInfoRequest"> &
  * Use `create(PersonalInfoRequestSchema)` to create a new message.
  */
 export const PersonalInfoRequestSchema: GenMessage<PersonalInfoRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 32);
+  messageDesc(file_proto_user_service_user_service, 38);
 
 /**
  * @generated from message user_service.PersonalInfoResponse
@@ -794,7 +1036,7 @@ export type PersonalInfoResponse = Message<"user_service.PersonalInfoResponse">
  * Use `create(PersonalInfoResponseSchema)` to create a new message.
  */
 export const PersonalInfoResponseSchema: GenMessage<PersonalInfoResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 33);
+  messageDesc(file_proto_user_service_user_service, 39);
 
 /**
  * @generated from message user_service.UpdatePersonalInfoRequest
@@ -846,7 +1088,7 @@ export type UpdatePersonalInfoRequest = Message<"user_service.UpdatePersonalInfo
  * Use `create(UpdatePersonalInfoRequestSchema)` to create a new message.
  */
 export const UpdatePersonalInfoRequestSchema: GenMessage<UpdatePersonalInfoRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 34);
+  messageDesc(file_proto_user_service_user_service, 40);
 
 /**
  * @generated from message user_service.UpdatePersonalInfoResponse
@@ -859,7 +1101,7 @@ export type UpdatePersonalInfoResponse = Message<"user_service.UpdatePersonalInf
  * Use `create(UpdatePersonalInfoResponseSchema)` to create a new message.
  */
 export const UpdatePersonalInfoResponseSchema: GenMessage<UpdatePersonalInfoResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 35);
+  messageDesc(file_proto_user_service_user_service, 41);
 
 /**
  * @generated from message user_service.UpdateAvatarRequest
@@ -876,7 +1118,7 @@ export type UpdateAvatarRequest = Message<"user_service.UpdateAvatarRequest"> &
  * Use `create(UpdateAvatarRequestSchema)` to create a new message.
  */
 export const UpdateAvatarRequestSchema: GenMessage<UpdateAvatarRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 36);
+  messageDesc(file_proto_user_service_user_service, 42);
 
 /**
  * @generated from message user_service.UpdateAvatarResponse
@@ -893,7 +1135,7 @@ export type UpdateAvatarResponse = Message<"user_service.UpdateAvatarResponse">
  * Use `create(UpdateAvatarResponseSchema)` to create a new message.
  */
 export const UpdateAvatarResponseSchema: GenMessage<UpdateAvatarResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 37);
+  messageDesc(file_proto_user_service_user_service, 43);
 
 /**
  * @generated from message user_service.RemoveAvatarRequest
@@ -906,7 +1148,7 @@ export type RemoveAvatarRequest = Message<"user_service.RemoveAvatarRequest"> &
  * Use `create(RemoveAvatarRequestSchema)` to create a new message.
  */
 export const RemoveAvatarRequestSchema: GenMessage<RemoveAvatarRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 38);
+  messageDesc(file_proto_user_service_user_service, 44);
 
 /**
  * @generated from message user_service.RemoveAvatarResponse
@@ -919,7 +1161,7 @@ export type RemoveAvatarResponse = Message<"user_service.RemoveAvatarResponse">
  * Use `create(RemoveAvatarResponseSchema)` to create a new message.
  */
 export const RemoveAvatarResponseSchema: GenMessage<RemoveAvatarResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 39);
+  messageDesc(file_proto_user_service_user_service, 45);
 
 /**
  * @generated from message user_service.BriefProfilesRequest
@@ -936,7 +1178,7 @@ export type BriefProfilesRequest = Message<"user_service.BriefProfilesRequest">
  * Use `create(BriefProfilesRequestSchema)` to create a new message.
  */
 export const BriefProfilesRequestSchema: GenMessage<BriefProfilesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 40);
+  messageDesc(file_proto_user_service_user_service, 46);
 
 /**
  * this is a subset of ProfileResponse
@@ -998,7 +1240,7 @@ export type BriefProfile = Message<"user_service.BriefProfile"> & {
  * Use `create(BriefProfileSchema)` to create a new message.
  */
 export const BriefProfileSchema: GenMessage<BriefProfile> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 41);
+  messageDesc(file_proto_user_service_user_service, 47);
 
 /**
  * @generated from message user_service.BriefProfilesResponse
@@ -1015,7 +1257,7 @@ export type BriefProfilesResponse = Message<"user_service.BriefProfilesResponse"
  * Use `create(BriefProfilesResponseSchema)` to create a new message.
  */
 export const BriefProfilesResponseSchema: GenMessage<BriefProfilesResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 42);
+  messageDesc(file_proto_user_service_user_service, 48);
 
 /**
  * @generated from message user_service.BadgeMetadataRequest
@@ -1028,7 +1270,7 @@ export type BadgeMetadataRequest = Message<"user_service.BadgeMetadataRequest">
  * Use `create(BadgeMetadataRequestSchema)` to create a new message.
  */
 export const BadgeMetadataRequestSchema: GenMessage<BadgeMetadataRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 43);
+  messageDesc(file_proto_user_service_user_service, 49);
 
 /**
  * @generated from message user_service.BadgeMetadataResponse
@@ -1045,7 +1287,7 @@ export type BadgeMetadataResponse = Message<"user_service.BadgeMetadataResponse"
  * Use `create(BadgeMetadataResponseSchema)` to create a new message.
  */
 export const BadgeMetadataResponseSchema: GenMessage<BadgeMetadataResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 44);
+  messageDesc(file_proto_user_service_user_service, 50);
 
 /**
  * @generated from message user_service.UsernameSearchRequest
@@ -1062,7 +1304,7 @@ export type UsernameSearchRequest = Message<"user_service.UsernameSearchRequest"
  * Use `create(UsernameSearchRequestSchema)` to create a new message.
  */
 export const UsernameSearchRequestSchema: GenMessage<UsernameSearchRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 45);
+  messageDesc(file_proto_user_service_user_service, 51);
 
 /**
  * @generated from message user_service.UsernameSearchResponse
@@ -1079,7 +1321,7 @@ export type UsernameSearchResponse = Message<"user_service.UsernameSearchRespons
  * Use `create(UsernameSearchResponseSchema)` to create a new message.
  */
 export const UsernameSearchResponseSchema: GenMessage<UsernameSearchResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 46);
+  messageDesc(file_proto_user_service_user_service, 52);
 
 /**
  * @generated from message user_service.AddFollowRequest
@@ -1096,7 +1338,7 @@ export type AddFollowRequest = Message<"user_service.AddFollowRequest"> & {
  * Use `create(AddFollowRequestSchema)` to create a new message.
  */
 export const AddFollowRequestSchema: GenMessage<AddFollowRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 47);
+  messageDesc(file_proto_user_service_user_service, 53);
 
 /**
  * @generated from message user_service.RemoveFollowRequest
@@ -1113,7 +1355,7 @@ export type RemoveFollowRequest = Message<"user_service.RemoveFollowRequest"> &
  * Use `create(RemoveFollowRequestSchema)` to create a new message.
  */
 export const RemoveFollowRequestSchema: GenMessage<RemoveFollowRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 48);
+  messageDesc(file_proto_user_service_user_service, 54);
 
 /**
  * @generated from message user_service.GetFollowsRequest
@@ -1126,7 +1368,7 @@ export type GetFollowsRequest = Message<"user_service.GetFollowsRequest"> & {
  * Use `create(GetFollowsRequestSchema)` to create a new message.
  */
 export const GetFollowsRequestSchema: GenMessage<GetFollowsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 49);
+  messageDesc(file_proto_user_service_user_service, 55);
 
 /**
  * @generated from message user_service.AddBlockRequest
@@ -1143,7 +1385,7 @@ export type AddBlockRequest = Message<"user_service.AddBlockRequest"> & {
  * Use `create(AddBlockRequestSchema)` to create a new message.
  */
 export const AddBlockRequestSchema: GenMessage<AddBlockRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 50);
+  messageDesc(file_proto_user_service_user_service, 56);
 
 /**
  * @generated from message user_service.RemoveBlockRequest
@@ -1160,7 +1402,7 @@ export type RemoveBlockRequest = Message<"user_service.RemoveBlockRequest"> & {
  * Use `create(RemoveBlockRequestSchema)` to create a new message.
  */
 export const RemoveBlockRequestSchema: GenMessage<RemoveBlockRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 51);
+  messageDesc(file_proto_user_service_user_service, 57);
 
 /**
  * @generated from message user_service.GetBlocksRequest
@@ -1173,7 +1415,7 @@ export type GetBlocksRequest = Message<"user_service.GetBlocksRequest"> & {
  * Use `create(GetBlocksRequestSchema)` to create a new message.
  */
 export const GetBlocksRequestSchema: GenMessage<GetBlocksRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 52);
+  messageDesc(file_proto_user_service_user_service, 58);
 
 /**
  * @generated from message user_service.GetFullBlocksRequest
@@ -1186,7 +1428,7 @@ export type GetFullBlocksRequest = Message<"user_service.GetFullBlocksRequest">
  * Use `create(GetFullBlocksRequestSchema)` to create a new message.
  */
 export const GetFullBlocksRequestSchema: GenMessage<GetFullBlocksRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 53);
+  messageDesc(file_proto_user_service_user_service, 59);
 
 /**
  * @generated from message user_service.OKResponse
@@ -1199,7 +1441,7 @@ export type OKResponse = Message<"user_service.OKResponse"> & {
  * Use `create(OKResponseSchema)` to create a new message.
  */
 export const OKResponseSchema: GenMessage<OKResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 54);
+  messageDesc(file_proto_user_service_user_service, 60);
 
 /**
  * @generated from message user_service.BasicUser
@@ -1221,7 +1463,7 @@ export type BasicUser = Message<"user_service.BasicUser"> & {
  * Use `create(BasicUserSchema)` to create a new message.
  */
 export const BasicUserSchema: GenMessage<BasicUser> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 55);
+  messageDesc(file_proto_user_service_user_service, 61);
 
 /**
  * @generated from message user_service.BasicFollowedUser
@@ -1248,7 +1490,7 @@ export type BasicFollowedUser = Message<"user_service.BasicFollowedUser"> & {
  * Use `create(BasicFollowedUserSchema)` to create a new message.
  */
 export const BasicFollowedUserSchema: GenMessage<BasicFollowedUser> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 56);
+  messageDesc(file_proto_user_service_user_service, 62);
 
 /**
  * @generated from message user_service.GetActiveChatChannelsRequest
@@ -1286,7 +1528,7 @@ export type GetActiveChatChannelsRequest = Message<"user_service.GetActiveChatCh
  * Use `create(GetActiveChatChannelsRequestSchema)` to create a new message.
  */
 export const GetActiveChatChannelsRequestSchema: GenMessage<GetActiveChatChannelsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 57);
+  messageDesc(file_proto_user_service_user_service, 63);
 
 /**
  * @generated from message user_service.ActiveChatChannels
@@ -1303,7 +1545,7 @@ export type ActiveChatChannels = Message<"user_service.ActiveChatChannels"> & {
  * Use `create(ActiveChatChannelsSchema)` to create a new message.
  */
 export const ActiveChatChannelsSchema: GenMessage<ActiveChatChannels> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 58);
+  messageDesc(file_proto_user_service_user_service, 64);
 
 /**
  * @generated from message user_service.ActiveChatChannels.Channel
@@ -1335,6 +1577,13 @@ export type ActiveChatChannels_Channel = Message<"user_service.ActiveChatChannel
    * @generated from field: string last_message = 5;
    */
   lastMessage: string;
+
+  /**
+   * The number of unread messages, for private message channels.
+   *
+   * @generated from field: int32 unread_count = 6;
+   */
+  unreadCount: number;
 };
 
 /**
@@ -1342,7 +1591,7 @@ export type ActiveChatChannels_Channel = Message<"user_service.ActiveChatChannel
  * Use `create(ActiveChatChannels_ChannelSchema)` to create a new message.
  */
 export const ActiveChatChannels_ChannelSchema: GenMessage<ActiveChatChannels_Channel> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 58, 0);
+  messageDesc(file_proto_user_service_user_service, 64, 0);
 
 /**
  * @generated from message user_service.GetChatsRequest
@@ -1352,6 +1601,14 @@ export type GetChatsRequest = Message<"user_service.GetChatsRequest"> & {
    * @generated from field: string channel = 1;
    */
   channel: string;
+
+  /**
+   * If set, only messages older than this message ID are returned, for
+   * paging back through a channel's history.
+   *
+   * @generated from field: string before_id = 2;
+   */
+  beforeId: string;
 };
 
 /**
@@ -1359,7 +1616,81 @@ export type GetChatsRequest = Message<"user_service.GetChatsRequest"> & {
  * Use `create(GetChatsRequestSchema)` to create a new message.
  */
 export const GetChatsRequestSchema: GenMessage<GetChatsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 59);
+  messageDesc(file_proto_user_service_user_service, 65);
+
+/**
+ * @generated from message user_service.MarkChatChannelReadRequest
+ */
+export type MarkChatChannelReadRequest = Message<"user_service.MarkChatChannelReadRequest"> & {
+  /**
+   * @generated from field: string channel = 1;
+   */
+  channel: string;
+};
+
+/**
+ * Describes the message user_service.MarkChatChannelReadRequest.
+ * Use `create(MarkChatChannelReadRequestSchema)` to create a new message.
+ */
+export const MarkChatChannelReadRequestSchema: GenMessage<MarkChatChannelReadRequest> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 66);
+
+/**
+ * @generated from message user_service.GetUnreadMessageCountRequest
+ */
+export type GetUnreadMessageCountRequest = Message<"user_service.GetUnreadMessageCountRequest"> & {
+};
+
+/**
+ * Describes the message user_service.GetUnreadMessageCountRequest.
+ * Use `create(GetUnreadMessageCountRequestSchema)` to create a new message.
+ */
+export const GetUnreadMessageCountRequestSchema: GenMessage<GetUnreadMessageCountRequest> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 67);
+
+/**
+ * @generated from message user_service.UnreadMessageCount
+ */
+export type UnreadMessageCount = Message<"user_service.UnreadMessageCount"> & {
+  /**
+   * @generated from field: int32 count = 1;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message user_service.UnreadMessageCount.
+ * Use `create(UnreadMessageCountSchema)` to create a new message.
+ */
+export const UnreadMessageCountSchema: GenMessage<UnreadMessageCount> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 68);
+
+/**
+ * @generated from message user_service.SearchPrivateMessagesRequest
+ */
+export type SearchPrivateMessagesRequest = Message<"user_service.SearchPrivateMessagesRequest"> & {
+  /**
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 3;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message user_service.SearchPrivateMessagesRequest.
+ * Use `create(SearchPrivateMessagesRequestSchema)` to create a new message.
+ */
+export const SearchPrivateMessagesRequestSchema: GenMessage<SearchPrivateMessagesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 69);
 
 /**
  * @generated from message user_service.GetFollowsResponse
@@ -1376,7 +1707,7 @@ export type GetFollowsResponse = Message<"user_service.GetFollowsResponse"> & {
  * Use `create(GetFollowsResponseSchema)` to create a new message.
  */
 export const GetFollowsResponseSchema: GenMessage<GetFollowsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 60);
+  messageDesc(file_proto_user_service_user_service, 70);
 
 /**
  * @generated from message user_service.GetBlocksResponse
@@ -1393,7 +1724,7 @@ export type GetBlocksResponse = Message<"user_service.GetBlocksResponse"> & {
  * Use `create(GetBlocksResponseSchema)` to create a new message.
  */
 export const GetBlocksResponseSchema: GenMessage<GetBlocksResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 61);
+  messageDesc(file_proto_user_service_user_service, 71);
 
 /**
  * XXX: We should eventually obsolete this and handle blocks purely on
@@ -1413,7 +1744,7 @@ export type GetFullBlocksResponse = Message<"user_service.GetFullBlocksResponse"
  * Use `create(GetFullBlocksResponseSchema)` to create a new message.
  */
 export const GetFullBlocksResponseSchema: GenMessage<GetFullBlocksResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 62);
+  messageDesc(file_proto_user_service_user_service, 72);
 
 /**
  * @generated from message user_service.Integration
@@ -1440,7 +1771,7 @@ export type Integration = Message<"user_service.Integration"> & {
  * Use `create(IntegrationSchema)` to create a new message.
  */
 export const IntegrationSchema: GenMessage<Integration> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 63);
+  messageDesc(file_proto_user_service_user_service, 73);
 
 /**
  * @generated from message user_service.GetIntegrationsRequest
@@ -1453,7 +1784,7 @@ export type GetIntegrationsRequest = Message<"user_service.GetIntegrationsReques
  * Use `create(GetIntegrationsRequestSchema)` to create a new message.
  */
 export const GetIntegrationsRequestSchema: GenMessage<GetIntegrationsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 64);
+  messageDesc(file_proto_user_service_user_service, 74);
 
 /**
  * @generated from message user_service.IntegrationsResponse
@@ -1470,7 +1801,7 @@ export type IntegrationsResponse = Message<"user_service.IntegrationsResponse">
  * Use `create(IntegrationsResponseSchema)` to create a new message.
  */
 export const IntegrationsResponseSchema: GenMessage<IntegrationsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 65);
+  messageDesc(file_proto_user_service_user_service, 75);
 
 /**
  * @generated from message user_service.DeleteIntegrationRequest
@@ -1487,7 +1818,7 @@ export type DeleteIntegrationRequest = Message<"user_service.DeleteIntegrationRe
  * Use `create(DeleteIntegrationRequestSchema)` to create a new message.
  */
 export const DeleteIntegrationRequestSchema: GenMessage<DeleteIntegrationRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 66);
+  messageDesc(file_proto_user_service_user_service, 76);
 
 /**
  * @generated from message user_service.DeleteIntegrationResponse
@@ -1500,7 +1831,102 @@ export type DeleteIntegrationResponse = Message<"user_service.DeleteIntegrationR
  * Use `create(DeleteIntegrationResponseSchema)` to create a new message.
  */
 export const DeleteIntegrationResponseSchema: GenMessage<DeleteIntegrationResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 67);
+  messageDesc(file_proto_user_service_user_service, 77);
+
+/**
+ * LoginIdentity is an external identity (Google, Discord, ...) that can be
+ * used to log in to this account.
+ *
+ * @generated from message user_service.LoginIdentity
+ */
+export type LoginIdentity = Message<"user_service.LoginIdentity"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp linked_at = 3;
+   */
+  linkedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_login_at = 4;
+   */
+  lastLoginAt?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message user_service.LoginIdentity.
+ * Use `create(LoginIdentitySchema)` to create a new message.
+ */
+export const LoginIdentitySchema: GenMessage<LoginIdentity> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 78);
+
+/**
+ * @generated from message user_service.GetLoginIdentitiesRequest
+ */
+export type GetLoginIdentitiesRequest = Message<"user_service.GetLoginIdentitiesRequest"> & {
+};
+
+/**
+ * Describes the message user_service.GetLoginIdentitiesRequest.
+ * Use `create(GetLoginIdentitiesRequestSchema)` to create a new message.
+ */
+export const GetLoginIdentitiesRequestSchema: GenMessage<GetLoginIdentitiesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 79);
+
+/**
+ * @generated from message user_service.LoginIdentitiesResponse
+ */
+export type LoginIdentitiesResponse = Message<"user_service.LoginIdentitiesResponse"> & {
+  /**
+   * @generated from field: repeated user_service.LoginIdentity identities = 1;
+   */
+  identities: LoginIdentity[];
+};
+
+/**
+ * Describes the message user_service.LoginIdentitiesResponse.
+ * Use `create(LoginIdentitiesResponseSchema)` to create a new message.
+ */
+export const LoginIdentitiesResponseSchema: GenMessage<LoginIdentitiesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 80);
+
+/**
+ * @generated from message user_service.UnlinkLoginIdentityRequest
+ */
+export type UnlinkLoginIdentityRequest = Message<"user_service.UnlinkLoginIdentityRequest"> & {
+  /**
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+};
+
+/**
+ * Describes the message user_service.UnlinkLoginIdentityRequest.
+ * Use `create(UnlinkLoginIdentityRequestSchema)` to create a new message.
+ */
+export const UnlinkLoginIdentityRequestSchema: GenMessage<UnlinkLoginIdentityRequest> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 81);
+
+/**
+ * @generated from message user_service.UnlinkLoginIdentityResponse
+ */
+export type UnlinkLoginIdentityResponse = Message<"user_service.UnlinkLoginIdentityResponse"> & {
+};
+
+/**
+ * Describes the message user_service.UnlinkLoginIdentityResponse.
+ * Use `create(UnlinkLoginIdentityResponseSchema)` to create a new message.
+ */
+export const UnlinkLoginIdentityResponseSchema: GenMessage<UnlinkLoginIdentityResponse> = /*@__PURE__*/
+  messageDesc(file_proto_user_service_user_service, 82);
 
 /**
  * @generated from message user_service.GetSubscriptionCriteriaRequest
@@ -1513,7 +1939,7 @@ export type GetSubscriptionCriteriaRequest = Message<"user_service.GetSubscripti
  * Use `create(GetSubscriptionCriteriaRequestSchema)` to create a new message.
  */
 export const GetSubscriptionCriteriaRequestSchema: GenMessage<GetSubscriptionCriteriaRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 68);
+  messageDesc(file_proto_user_service_user_service, 83);
 
 /**
  * @generated from message user_service.GetSubscriptionCriteriaResponse
@@ -1540,7 +1966,7 @@ export type GetSubscriptionCriteriaResponse = Message<"user_service.GetSubscript
  * Use `create(GetSubscriptionCriteriaResponseSchema)` to create a new message.
  */
 export const GetSubscriptionCriteriaResponseSchema: GenMessage<GetSubscriptionCriteriaResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 69);
+  messageDesc(file_proto_user_service_user_service, 84);
 
 /**
  * @generated from message user_service.GetModListRequest
@@ -1553,7 +1979,7 @@ export type GetModListRequest = Message<"user_service.GetModListRequest"> & {
  * Use `create(GetModListRequestSchema)` to create a new message.
  */
 export const GetModListRequestSchema: GenMessage<GetModListRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 70);
+  messageDesc(file_proto_user_service_user_service, 85);
 
 /**
  * @generated from message user_service.GetModListResponse
@@ -1575,7 +2001,7 @@ export type GetModListResponse = Message<"user_service.GetModListResponse"> & {
  * Use `create(GetModListResponseSchema)` to create a new message.
  */
 export const GetModListResponseSchema: GenMessage<GetModListResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 71);
+  messageDesc(file_proto_user_service_user_service, 86);
 
 /**
  * @generated from message user_service.AddRoleRequest
@@ -1597,7 +2023,7 @@ export type AddRoleRequest = Message<"user_service.AddRoleRequest"> & {
  * Use `create(AddRoleRequestSchema)` to create a new message.
  */
 export const AddRoleRequestSchema: GenMessage<AddRoleRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 72);
+  messageDesc(file_proto_user_service_user_service, 87);
 
 /**
  * @generated from message user_service.AddRoleResponse
@@ -1610,7 +2036,7 @@ export type AddRoleResponse = Message<"user_service.AddRoleResponse"> & {
  * Use `create(AddRoleResponseSchema)` to create a new message.
  */
 export const AddRoleResponseSchema: GenMessage<AddRoleResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 73);
+  messageDesc(file_proto_user_service_user_service, 88);
 
 /**
  * @generated from message user_service.AddPermissionRequest
@@ -1632,7 +2058,7 @@ export type AddPermissionRequest = Message<"user_service.AddPermissionRequest">
  * Use `create(AddPermissionRequestSchema)` to create a new message.
  */
 export const AddPermissionRequestSchema: GenMessage<AddPermissionRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 74);
+  messageDesc(file_proto_user_service_user_service, 89);
 
 /**
  * @generated from message user_service.AddPermissionResponse
@@ -1645,7 +2071,7 @@ export type AddPermissionResponse = Message<"user_service.AddPermissionResponse"
  * Use `create(AddPermissionResponseSchema)` to create a new message.
  */
 export const AddPermissionResponseSchema: GenMessage<AddPermissionResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 75);
+  messageDesc(file_proto_user_service_user_service, 90);
 
 /**
  * @generated from message user_service.LinkRoleAndPermissionRequest
@@ -1667,7 +2093,7 @@ export type LinkRoleAndPermissionRequest = Message<"user_service.LinkRoleAndPerm
  * Use `create(LinkRoleAndPermissionRequestSchema)` to create a new message.
  */
 export const LinkRoleAndPermissionRequestSchema: GenMessage<LinkRoleAndPermissionRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 76);
+  messageDesc(file_proto_user_service_user_service, 91);
 
 /**
  * @generated from message user_service.LinkRoleAndPermissionResponse
@@ -1680,7 +2106,7 @@ export type LinkRoleAndPermissionResponse = Message<"user_service.LinkRoleAndPer
  * Use `create(LinkRoleAndPermissionResponseSchema)` to create a new message.
  */
 export const LinkRoleAndPermissionResponseSchema: GenMessage<LinkRoleAndPermissionResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 77);
+  messageDesc(file_proto_user_service_user_service, 92);
 
 /**
  * @generated from message user_service.AssignRoleResponse
@@ -1693,7 +2119,7 @@ export type AssignRoleResponse = Message<"user_service.AssignRoleResponse"> & {
  * Use `create(AssignRoleResponseSchema)` to create a new message.
  */
 export const AssignRoleResponseSchema: GenMessage<AssignRoleResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 78);
+  messageDesc(file_proto_user_service_user_service, 93);
 
 /**
  * @generated from message user_service.UserAndRole
@@ -1715,7 +2141,7 @@ export type UserAndRole = Message<"user_service.UserAndRole"> & {
  * Use `create(UserAndRoleSchema)` to create a new message.
  */
 export const UserAndRoleSchema: GenMessage<UserAndRole> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 79);
+  messageDesc(file_proto_user_service_user_service, 94);
 
 /**
  * @generated from message user_service.UnassignRoleResponse
@@ -1728,7 +2154,7 @@ export type UnassignRoleResponse = Message<"user_service.UnassignRoleResponse">
  * Use `create(UnassignRoleResponseSchema)` to create a new message.
  */
 export const UnassignRoleResponseSchema: GenMessage<UnassignRoleResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 80);
+  messageDesc(file_proto_user_service_user_service, 95);
 
 /**
  * @generated from message user_service.GetUserRolesRequest
@@ -1745,7 +2171,7 @@ export type GetUserRolesRequest = Message<"user_service.GetUserRolesRequest"> &
  * Use `create(GetUserRolesRequestSchema)` to create a new message.
  */
 export const GetUserRolesRequestSchema: GenMessage<GetUserRolesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 81);
+  messageDesc(file_proto_user_service_user_service, 96);
 
 /**
  * @generated from message user_service.UserRolesResponse
@@ -1762,7 +2188,7 @@ export type UserRolesResponse = Message<"user_service.UserRolesResponse"> & {
  * Use `create(UserRolesResponseSchema)` to create a new message.
  */
 export const UserRolesResponseSchema: GenMessage<UserRolesResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 82);
+  messageDesc(file_proto_user_service_user_service, 97);
 
 /**
  * @generated from message user_service.GetSelfRolesRequest
@@ -1775,7 +2201,7 @@ export type GetSelfRolesRequest = Message<"user_service.GetSelfRolesRequest"> &
  * Use `create(GetSelfRolesRequestSchema)` to create a new message.
  */
 export const GetSelfRolesRequestSchema: GenMessage<GetSelfRolesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 83);
+  messageDesc(file_proto_user_service_user_service, 98);
 
 /**
  * @generated from message user_service.GetSelfPermissionsRequest
@@ -1788,7 +2214,7 @@ export type GetSelfPermissionsRequest = Message<"user_service.GetSelfPermissions
  * Use `create(GetSelfPermissionsRequestSchema)` to create a new message.
  */
 export const GetSelfPermissionsRequestSchema: GenMessage<GetSelfPermissionsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 84);
+  messageDesc(file_proto_user_service_user_service, 99);
 
 /**
  * @generated from message user_service.SelfPermissionsResponse
@@ -1805,7 +2231,7 @@ export type SelfPermissionsResponse = Message<"user_service.SelfPermissionsRespo
  * Use `create(SelfPermissionsResponseSchema)` to create a new message.
  */
 export const SelfPermissionsResponseSchema: GenMessage<SelfPermissionsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 85);
+  messageDesc(file_proto_user_service_user_service, 100);
 
 /**
  * @generated from message user_service.GetUsersWithRolesRequest
@@ -1822,7 +2248,7 @@ export type GetUsersWithRolesRequest = Message<"user_service.GetUsersWithRolesRe
  * Use `create(GetUsersWithRolesRequestSchema)` to create a new message.
  */
 export const GetUsersWithRolesRequestSchema: GenMessage<GetUsersWithRolesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 86);
+  messageDesc(file_proto_user_service_user_service, 101);
 
 /**
  * @generated from message user_service.GetUsersWithRolesResponse
@@ -1839,7 +2265,7 @@ export type GetUsersWithRolesResponse = Message<"user_service.GetUsersWithRolesR
  * Use `create(GetUsersWithRolesResponseSchema)` to create a new message.
  */
 export const GetUsersWithRolesResponseSchema: GenMessage<GetUsersWithRolesResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 87);
+  messageDesc(file_proto_user_service_user_service, 102);
 
 /**
  * @generated from message user_service.GetRoleMetadataRequest
@@ -1852,7 +2278,7 @@ export type GetRoleMetadataRequest = Message<"user_service.GetRoleMetadataReques
  * Use `create(GetRoleMetadataRequestSchema)` to create a new message.
  */
 export const GetRoleMetadataRequestSchema: GenMessage<GetRoleMetadataRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 88);
+  messageDesc(file_proto_user_service_user_service, 103);
 
 /**
  * @generated from message user_service.RoleWithPermissions
@@ -1874,7 +2300,7 @@ export type RoleWithPermissions = Message<"user_service.RoleWithPermissions"> &
  * Use `create(RoleWithPermissionsSchema)` to create a new message.
  */
 export const RoleWithPermissionsSchema: GenMessage<RoleWithPermissions> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 89);
+  messageDesc(file_proto_user_service_user_service, 104);
 
 /**
  * @generated from message user_service.RoleMetadataResponse
@@ -1891,7 +2317,7 @@ export type RoleMetadataResponse = Message<"user_service.RoleMetadataResponse">
  * Use `create(RoleMetadataResponseSchema)` to create a new message.
  */
 export const RoleMetadataResponseSchema: GenMessage<RoleMetadataResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 90);
+  messageDesc(file_proto_user_service_user_service, 105);
 
 /**
  * @generated from message user_service.ConnectOrganizationRequest
@@ -1920,7 +2346,7 @@ export type ConnectOrganizationRequest = Message<"user_service.ConnectOrganizati
  * Use `create(ConnectOrganizationRequestSchema)` to create a new message.
  */
 export const ConnectOrganizationRequestSchema: GenMessage<ConnectOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 91);
+  messageDesc(file_proto_user_service_user_service, 106);
 
 /**
  * @generated from message user_service.ConnectOrganizationResponse
@@ -1947,7 +2373,7 @@ export type ConnectOrganizationResponse = Message<"user_service.ConnectOrganizat
  * Use `create(ConnectOrganizationResponseSchema)` to create a new message.
  */
 export const ConnectOrganizationResponseSchema: GenMessage<ConnectOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 92);
+  messageDesc(file_proto_user_service_user_service, 107);
 
 /**
  * @generated from message user_service.DisconnectOrganizationRequest
@@ -1971,7 +2397,7 @@ export type DisconnectOrganizationRequest = Message<"user_service.DisconnectOrga
  * Use `create(DisconnectOrganizationRequestSchema)` to create a new message.
  */
 export const DisconnectOrganizationRequestSchema: GenMessage<DisconnectOrganizationRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 93);
+  messageDesc(file_proto_user_service_user_service, 108);
 
 /**
  * @generated from message user_service.DisconnectOrganizationResponse
@@ -1988,7 +2414,7 @@ export type DisconnectOrganizationResponse = Message<"user_service.DisconnectOrg
  * Use `create(DisconnectOrganizationResponseSchema)` to create a new message.
  */
 export const DisconnectOrganizationResponseSchema: GenMessage<DisconnectOrganizationResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 94);
+  messageDesc(file_proto_user_service_user_service, 109);
 
 /**
  * @generated from message user_service.RefreshTitlesRequest
@@ -2001,7 +2427,7 @@ export type RefreshTitlesRequest = Message<"user_service.RefreshTitlesRequest">
  * Use `create(RefreshTitlesRequestSchema)` to create a new message.
  */
 export const RefreshTitlesRequestSchema: GenMessage<RefreshTitlesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 95);
+  messageDesc(file_proto_user_service_user_service, 110);
 
 /**
  * @generated from message user_service.RefreshTitlesResponse
@@ -2023,7 +2449,7 @@ export type RefreshTitlesResponse = Message<"user_service.RefreshTitlesResponse"
  * Use `create(RefreshTitlesResponseSchema)` to create a new message.
  */
 export const RefreshTitlesResponseSchema: GenMessage<RefreshTitlesResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 96);
+  messageDesc(file_proto_user_service_user_service, 111);
 
 /**
  * @generated from message user_service.GetMyOrganizationsRequest
@@ -2036,7 +2462,7 @@ export type GetMyOrganizationsRequest = Message<"user_service.GetMyOrganizations
  * Use `create(GetMyOrganizationsRequestSchema)` to create a new message.
  */
 export const GetMyOrganizationsRequestSchema: GenMessage<GetMyOrganizationsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 97);
+  messageDesc(file_proto_user_service_user_service, 112);
 
 /**
  * @generated from message user_service.GetMyOrganizationsResponse
@@ -2053,7 +2479,7 @@ export type GetMyOrganizationsResponse = Message<"user_service.GetMyOrganization
  * Use `create(GetMyOrganizationsResponseSchema)` to create a new message.
  */
 export const GetMyOrganizationsResponseSchema: GenMessage<GetMyOrganizationsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 98);
+  messageDesc(file_proto_user_service_user_service, 113);
 
 /**
  * @generated from message user_service.GetPublicOrganizationsRequest
@@ -2070,7 +2496,7 @@ export type GetPublicOrganizationsRequest = Message<"user_service.GetPublicOrgan
  * Use `create(GetPublicOrganizationsRequestSchema)` to create a new message.
  */
 export const GetPublicOrganizationsRequestSchema: GenMessage<GetPublicOrganizationsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 99);
+  messageDesc(file_proto_user_service_user_service, 114);
 
 /**
  * @generated from message user_service.GetPublicOrganizationsResponse
@@ -2087,7 +2513,7 @@ export type GetPublicOrganizationsResponse = Message<"user_service.GetPublicOrga
  * Use `create(GetPublicOrganizationsResponseSchema)` to create a new message.
  */
 export const GetPublicOrganizationsResponseSchema: GenMessage<GetPublicOrganizationsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 100);
+  messageDesc(file_proto_user_service_user_service, 115);
 
 /**
  * @generated from message user_service.SubmitVerificationRequest
@@ -2121,7 +2547,7 @@ export type SubmitVerificationRequest = Message<"user_service.SubmitVerification
  * Use `create(SubmitVerificationRequestSchema)` to create a new message.
  */
 export const SubmitVerificationRequestSchema: GenMessage<SubmitVerificationRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 101);
+  messageDesc(file_proto_user_service_user_service, 116);
 
 /**
  * @generated from message user_service.SubmitVerificationResponse
@@ -2148,7 +2574,7 @@ export type SubmitVerificationResponse = Message<"user_service.SubmitVerificatio
  * Use `create(SubmitVerificationResponseSchema)` to create a new message.
  */
 export const SubmitVerificationResponseSchema: GenMessage<SubmitVerificationResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 102);
+  messageDesc(file_proto_user_service_user_service, 117);
 
 /**
  * @generated from message user_service.GetPendingVerificationsRequest
@@ -2161,7 +2587,7 @@ export type GetPendingVerificationsRequest = Message<"user_service.GetPendingVer
  * Use `create(GetPendingVerificationsRequestSchema)` to create a new message.
  */
 export const GetPendingVerificationsRequestSchema: GenMessage<GetPendingVerificationsRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 103);
+  messageDesc(file_proto_user_service_user_service, 118);
 
 /**
  * @generated from message user_service.VerificationRequestInfo
@@ -2232,7 +2658,7 @@ export type VerificationRequestInfo = Message<"user_service.VerificationRequestI
  * Use `create(VerificationRequestInfoSchema)` to create a new message.
  */
 export const VerificationRequestInfoSchema: GenMessage<VerificationRequestInfo> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 104);
+  messageDesc(file_proto_user_service_user_service, 119);
 
 /**
  * @generated from message user_service.GetPendingVerificationsResponse
@@ -2249,7 +2675,7 @@ export type GetPendingVerificationsResponse = Message<"user_service.GetPendingVe
  * Use `create(GetPendingVerificationsResponseSchema)` to create a new message.
  */
 export const GetPendingVerificationsResponseSchema: GenMessage<GetPendingVerificationsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 105);
+  messageDesc(file_proto_user_service_user_service, 120);
 
 /**
  * @generated from message user_service.ApproveVerificationRequest
@@ -2271,7 +2697,7 @@ export type ApproveVerificationRequest = Message<"user_service.ApproveVerificati
  * Use `create(ApproveVerificationRequestSchema)` to create a new message.
  */
 export const ApproveVerificationRequestSchema: GenMessage<ApproveVerificationRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 106);
+  messageDesc(file_proto_user_service_user_service, 121);
 
 /**
  * @generated from message user_service.ApproveVerificationResponse
@@ -2293,7 +2719,7 @@ export type ApproveVerificationResponse = Message<"user_service.ApproveVerificat
  * Use `create(ApproveVerificationResponseSchema)` to create a new message.
  */
 export const ApproveVerificationResponseSchema: GenMessage<ApproveVerificationResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 107);
+  messageDesc(file_proto_user_service_user_service, 122);
 
 /**
  * @generated from message user_service.RejectVerificationRequest
@@ -2315,7 +2741,7 @@ export type RejectVerificationRequest = Message<"user_service.RejectVerification
  * Use `create(RejectVerificationRequestSchema)` to create a new message.
  */
 export const RejectVerificationRequestSchema: GenMessage<RejectVerificationRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 108);
+  messageDesc(file_proto_user_service_user_service, 123);
 
 /**
  * @generated from message user_service.RejectVerificationResponse
@@ -2337,7 +2763,7 @@ export type RejectVerificationResponse = Message<"user_service.RejectVerificatio
  * Use `create(RejectVerificationResponseSchema)` to create a new message.
  */
 export const RejectVerificationResponseSchema: GenMessage<RejectVerificationResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 109);
+  messageDesc(file_proto_user_service_user_service, 124);
 
 /**
  * @generated from message user_service.GetVerificationImageUrlRequest
@@ -2354,7 +2780,7 @@ export type GetVerificationImageUrlRequest = Message<"user_service.GetVerificati
  * Use `create(GetVerificationImageUrlRequestSchema)` to create a new message.
  */
 export const GetVerificationImageUrlRequestSchema: GenMessage<GetVerificationImageUrlRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 110);
+  messageDesc(file_proto_user_service_user_service, 125);
 
 /**
  * @generated from message user_service.GetVerificationImageUrlResponse
@@ -2373,7 +2799,7 @@ export type GetVerificationImageUrlResponse = Message<"user_service.GetVerificat
  * Use `create(GetVerificationImageUrlResponseSchema)` to create a new message.
  */
 export const GetVerificationImageUrlResponseSchema: GenMessage<GetVerificationImageUrlResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 111);
+  messageDesc(file_proto_user_service_user_service, 126);
 
 /**
  * @generated from message user_service.ManuallySetOrgMembershipRequest
@@ -2411,7 +2837,7 @@ export type ManuallySetOrgMembershipRequest = Message<"user_service.ManuallySetO
  * Use `create(ManuallySetOrgMembershipRequestSchema)` to create a new message.
  */
 export const ManuallySetOrgMembershipRequestSchema: GenMessage<ManuallySetOrgMembershipRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 112);
+  messageDesc(file_proto_user_service_user_service, 127);
 
 /**
  * @generated from message user_service.ManuallySetOrgMembershipResponse
@@ -2433,7 +2859,7 @@ export type ManuallySetOrgMembershipResponse = Message<"user_service.ManuallySet
  * Use `create(ManuallySetOrgMembershipResponseSchema)` to create a new message.
  */
 export const ManuallySetOrgMembershipResponseSchema: GenMessage<ManuallySetOrgMembershipResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 113);
+  messageDesc(file_proto_user_service_user_service, 128);
 
 /**
  * @generated from message user_service.AdminRefreshUserTitlesRequest
@@ -2452,7 +2878,7 @@ export type AdminRefreshUserTitlesRequest = Message<"user_service.AdminRefreshUs
  * Use `create(AdminRefreshUserTitlesRequestSchema)` to create a new message.
  */
 export const AdminRefreshUserTitlesRequestSchema: GenMessage<AdminRefreshUserTitlesRequest> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 114);
+  messageDesc(file_proto_user_service_user_service, 129);
 
 /**
  * @generated from message user_service.AdminRefreshUserTitlesResponse
@@ -2474,7 +2900,7 @@ export type AdminRefreshUserTitlesResponse = Message<"user_service.AdminRefreshU
  * Use `create(AdminRefreshUserTitlesResponseSchema)` to create a new message.
  */
 export const AdminRefreshUserTitlesResponseSchema: GenMessage<AdminRefreshUserTitlesResponse> = /*@__PURE__*/
-  messageDesc(file_proto_user_service_user_service, 115);
+  messageDesc(file_proto_user_service_user_service, 130);
 
 /**
  * @generated from service user_service.AuthenticationService
@@ -2593,6 +3019,14 @@ export const RegistrationService: GenService<{
     input: typeof ResendVerificationEmailRequestSchema;
     output: typeof ResendVerificationEmailResponseSchema;
   },
+  /**
+   * @generated from rpc user_service.RegistrationService.CompleteOAuthSignup
+   */
+  completeOAuthSignup: {
+    methodKind: "unary";
+    input: typeof CompleteOAuthSignupRequestSchema;
+    output: typeof CompleteOAuthSignupResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_user_service_user_service, 1);
 
@@ -2616,6 +3050,17 @@ export const ProfileService: GenService<{
     input: typeof StatsRequestSchema;
     output: typeof StatsResponseSchema;
   },
+  /**
+   * GetRatingHistory gets a user's rating changes in a variant, with the
+   * breakdown of each change.
+   *
+   * @generated from rpc user_service.ProfileService.GetRatingHistory
+   */
+  getRatingHistory: {
+    methodKind: "unary";
+    input: typeof RatingHistoryRequestSchema;
+    output: typeof RatingHistoryResponseSchema;
+  },
   /**
    * @generated from rpc user_service.ProfileService.GetProfile
    */
@@ -2771,6 +3216,32 @@ export const SocializeService: GenService<{
     input: typeof GetChatsRequestSchema;
     output: typeof ChatMessagesSchema;
   },
+  /**
+   * @generated from rpc user_service.SocializeService.MarkChatChannelRead
+   */
+  markChatChannelRead: {
+    methodKind: "unary";
+    input: typeof MarkChatChannelReadRequestSchema;
+    output: typeof OKResponseSchema;
+  },
+  /**
+   * @generated from rpc user_service.SocializeService.GetUnreadMessageCount
+   */
+  getUnreadMessageCount: {
+    methodKind: "unary";
+    input: typeof GetUnreadMessageCountRequestSchema;
+    output: typeof UnreadMessageCountSchema;
+  },
+  /**
+   * SearchPrivateMessages searches the user's own private messages.
+   *
+   * @generated from rpc user_service.SocializeService.SearchPrivateMessages
+   */
+  searchPrivateMessages: {
+    methodKind: "unary";
+    input: typeof SearchPrivateMessagesRequestSchema;
+    output: typeof ChatMessagesSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_user_service_user_service, 4);
 
@@ -2794,6 +3265,22 @@ export const IntegrationService: GenService<{
     input: typeof DeleteIntegrationRequestSchema;
     output: typeof DeleteIntegrationResponseSchema;
   },
+  /**
+   * @generated from rpc user_service.IntegrationService.GetLoginIdentities
+   */
+  getLoginIdentities: {
+    methodKind: "unary";
+    input: typeof GetLoginIdentitiesRequestSchema;
+    output: typeof LoginIdentitiesResponseSchema;
+  },
+  /**
+   * @generated from rpc user_service.IntegrationService.UnlinkLoginIdentity
+   */
+  unlinkLoginIdentity: {
+    methodKind: "unary";
+    input: typeof UnlinkLoginIdentityRequestSchema;
+    output: typeof UnlinkLoginIdentityResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_user_service_user_service, 5);
 
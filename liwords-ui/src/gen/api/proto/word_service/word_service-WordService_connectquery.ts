@@ -8,3 +8,52 @@ import { WordService } from "./word_service_pb";
  * @generated from rpc word_service.WordService.DefineWords
  */
 export const defineWords = WordService.method.defineWords;
+
+/**
+ * @generated from rpc word_service.WordService.SearchWords
+ */
+export const searchWords = WordService.method.searchWords;
+
+/**
+ * Lexicon diffs are computed once and cached.
+ *
+ * @generated from rpc word_service.WordService.GetLexiconDiff
+ */
+export const getLexiconDiff = WordService.method.getLexiconDiff;
+
+/**
+ * Finds the words played in past games whose validity would be different
+ * in another lexicon.
+ *
+ * @generated from rpc word_service.WordService.GetValidityChanges
+ */
+export const getValidityChanges = WordService.method.getValidityChanges;
+
+/**
+ * Anagram quizzes for the logged-in user. Finishing a quiz, either by
+ * ending it or running out of time, moves its alphagrams between
+ * cardboxes.
+ *
+ * @generated from rpc word_service.WordService.StartWordQuiz
+ */
+export const startWordQuiz = WordService.method.startWordQuiz;
+
+/**
+ * @generated from rpc word_service.WordService.GetWordQuiz
+ */
+export const getWordQuiz = WordService.method.getWordQuiz;
+
+/**
+ * @generated from rpc word_service.WordService.SubmitWordQuizGuess
+ */
+export const submitWordQuizGuess = WordService.method.submitWordQuizGuess;
+
+/**
+ * @generated from rpc word_service.WordService.EndWordQuiz
+ */
+export const endWordQuiz = WordService.method.endWordQuiz;
+
+/**
+ * @generated from rpc word_service.WordService.GetCardboxStats
+ */
+export const getCardboxStats = WordService.method.getCardboxStats;
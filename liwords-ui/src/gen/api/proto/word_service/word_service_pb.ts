@@ -2,15 +2,17 @@
 // @generated from file proto/word_service/word_service.proto (package word_service, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file proto/word_service/word_service.proto.
  */
 export const file_proto_word_service_word_service: GenFile = /*@__PURE__*/
-  fileDesc("CiVwcm90by93b3JkX3NlcnZpY2Uvd29yZF9zZXJ2aWNlLnByb3RvEgx3b3JkX3NlcnZpY2UiWwoSRGVmaW5lV29yZHNSZXF1ZXN0Eg8KB2xleGljb24YASABKAkSDQoFd29yZHMYAiADKAkSEwoLZGVmaW5pdGlvbnMYAyABKAgSEAoIYW5hZ3JhbXMYBCABKAgiKQoRRGVmaW5lV29yZHNSZXN1bHQSCQoBZBgBIAEoCRIJCgF2GAIgASgIIqcBChNEZWZpbmVXb3Jkc1Jlc3BvbnNlEj8KB3Jlc3VsdHMYASADKAsyLi53b3JkX3NlcnZpY2UuRGVmaW5lV29yZHNSZXNwb25zZS5SZXN1bHRzRW50cnkaTwoMUmVzdWx0c0VudHJ5EgsKA2tleRgBIAEoCRIuCgV2YWx1ZRgCIAEoCzIfLndvcmRfc2VydmljZS5EZWZpbmVXb3Jkc1Jlc3VsdDoCOAEyYQoLV29yZFNlcnZpY2USUgoLRGVmaW5lV29yZHMSIC53b3JkX3NlcnZpY2UuRGVmaW5lV29yZHNSZXF1ZXN0GiEud29yZF9zZXJ2aWNlLkRlZmluZVdvcmRzUmVzcG9uc2VCqgEKEGNvbS53b3JkX3NlcnZpY2VCEFdvcmRTZXJ2aWNlUHJvdG9QAVo4Z2l0aHViLmNvbS93b29nbGVzLWlvL2xpd29yZHMvcnBjL2FwaS9wcm90by93b3JkX3NlcnZpY2WiAgNXWFiqAgtXb3JkU2VydmljZcoCC1dvcmRTZXJ2aWNl4gIXV29yZFNlcnZpY2VcR1BCTWV0YWRhdGHqAgtXb3JkU2VydmljZWIGcHJvdG8z");
+  fileDesc("CiVwcm90by93b3JkX3NlcnZpY2Uvd29yZF9zZXJ2aWNlLnByb3RvEgx3b3JkX3NlcnZpY2UiWwoSRGVmaW5lV29yZHNSZXF1ZXN0Eg8KB2xleGljb24YASABKAkSDQoFd29yZHMYAiADKAkSEwoLZGVmaW5pdGlvbnMYAyABKAgSEAoIYW5hZ3JhbXMYBCABKAgiRwoKRGVmaW5pdGlvbhIMCgR3b3JkGAEgASgJEgwKBHRleHQYAiABKAkSDgoGc291cmNlGAMgASgJEg0KBWxlbW1hGAQgASgJIlgKEURlZmluZVdvcmRzUmVzdWx0EgkKAWQYASABKAkSCQoBdhgCIAEoCBItCgtkZWZpbml0aW9ucxgDIAMoCzIYLndvcmRfc2VydmljZS5EZWZpbml0aW9uIqcBChNEZWZpbmVXb3Jkc1Jlc3BvbnNlEj8KB3Jlc3VsdHMYASADKAsyLi53b3JkX3NlcnZpY2UuRGVmaW5lV29yZHNSZXNwb25zZS5SZXN1bHRzRW50cnkaTwoMUmVzdWx0c0VudHJ5EgsKA2tleRgBIAEoCRIuCgV2YWx1ZRgCIAEoCzIfLndvcmRfc2VydmljZS5EZWZpbmVXb3Jkc1Jlc3VsdDoCOAEimAIKElNlYXJjaFdvcmRzUmVxdWVzdBIPCgdsZXhpY29uGAEgASgJEg8KB3BhdHRlcm4YAiABKAkSLwoMbGV0dGVyX3F1ZXJ5GAMgASgOMhkud29yZF9zZXJ2aWNlLkxldHRlclF1ZXJ5Eg8KB2xldHRlcnMYBCABKAkSEgoKbWluX2xlbmd0aBgFIAEoBRISCgptYXhfbGVuZ3RoGAYgASgFEhwKFG1pbl9wcm9iYWJpbGl0eV9yYW5rGAcgASgFEhwKFG1heF9wcm9iYWJpbGl0eV9yYW5rGAggASgFEhsKE2xldHRlcl9kaXN0cmlidXRpb24YCSABKAkSDQoFbGltaXQYCiABKAUSDgoGb2Zmc2V0GAsgASgFImQKEVNlYXJjaFdvcmRzUmVzdWx0EgwKBHdvcmQYASABKAkSGAoQcHJvYmFiaWxpdHlfcmFuaxgCIAEoBRITCgtmcm9udF9ob29rcxgDIAEoCRISCgpiYWNrX2hvb2tzGAQgASgJIlYKE1NlYXJjaFdvcmRzUmVzcG9uc2USMAoHcmVzdWx0cxgBIAMoCzIfLndvcmRfc2VydmljZS5TZWFyY2hXb3Jkc1Jlc3VsdBINCgV0b3RhbBgCIAEoBSKFAQoSTGV4aWNvbkRpZmZSZXF1ZXN0EhQKDGZyb21fbGV4aWNvbhgBIAEoCRISCgp0b19sZXhpY29uGAIgASgJEhIKCm1pbl9sZW5ndGgYAyABKAUSEgoKbWF4X2xlbmd0aBgEIAEoBRINCgVsaW1pdBgFIAEoBRIOCgZvZmZzZXQYBiABKAUiYQoTTGV4aWNvbkRpZmZSZXNwb25zZRINCgVhZGRlZBgBIAMoCRIPCgdyZW1vdmVkGAIgAygJEhMKC3RvdGFsX2FkZGVkGAMgASgFEhUKDXRvdGFsX3JlbW92ZWQYBCABKAUibwoWVmFsaWRpdHlDaGFuZ2VzUmVxdWVzdBIPCgdsZXhpY29uGAEgASgJEg8KB2dhbWVfaWQYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSEQoJbnVtX2dhbWVzGAQgASgFEg4KBm9mZnNldBgFIAEoBSJ1ChJXb3JkVmFsaWRpdHlDaGFuZ2USDAoEd29yZBgBIAEoCRITCgtldmVudF9pbmRleBgCIAEoBRIXCg9wbGF5ZXJfbmlja25hbWUYAyABKAkSEQoJd2FzX3ZhbGlkGAQgASgIEhAKCGlzX3ZhbGlkGAUgASgIImoKE0dhbWVWYWxpZGl0eUNoYW5nZXMSDwoHZ2FtZV9pZBgBIAEoCRIPCgdsZXhpY29uGAIgASgJEjEKB2NoYW5nZXMYAyADKAsyIC53b3JkX3NlcnZpY2UuV29yZFZhbGlkaXR5Q2hhbmdlIksKF1ZhbGlkaXR5Q2hhbmdlc1Jlc3BvbnNlEjAKBWdhbWVzGAEgAygLMiEud29yZF9zZXJ2aWNlLkdhbWVWYWxpZGl0eUNoYW5nZXMiyAEKFFN0YXJ0V29yZFF1aXpSZXF1ZXN0Eg8KB2xleGljb24YASABKAkSEgoKbWluX2xlbmd0aBgCIAEoBRISCgptYXhfbGVuZ3RoGAMgASgFEhwKFG1pbl9wcm9iYWJpbGl0eV9yYW5rGAQgASgFEhwKFG1heF9wcm9iYWJpbGl0eV9yYW5rGAUgASgFEhUKDW51bV9xdWVzdGlvbnMYBiABKAUSDwoHbWludXRlcxgHIAEoBRITCgtpbmNsdWRlX2R1ZRgIIAEoCCJ9ChBXb3JkUXVpelF1ZXN0aW9uEhAKCHBvc2l0aW9uGAEgASgFEhEKCWFscGhhZ3JhbRgCIAEoCRITCgtudW1fYW5zd2VycxgDIAEoBRINCgVmb3VuZBgEIAMoCRIPCgdhbnN3ZXJzGAUgAygJEg8KB2NhcmRib3gYBiABKAUi5gEKDVdvcmRRdWl6U3RhdGUSEgoKc2Vzc2lvbl9pZBgBIAEoCRIPCgdsZXhpY29uGAIgASgJEi4KCnN0YXJ0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKB2VuZHNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCGZpbmlzaGVkGAUgASgIEjEKCXF1ZXN0aW9ucxgGIAMoCzIeLndvcmRfc2VydmljZS5Xb3JkUXVpelF1ZXN0aW9uEg4KBnNvbHZlZBgHIAEoBSIlCg9Xb3JkUXVpelJlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCSI+ChBXb3JkUXVpelJlc3BvbnNlEioKBXN0YXRlGAEgASgLMhsud29yZF9zZXJ2aWNlLldvcmRRdWl6U3RhdGUiOQoUV29yZFF1aXpHdWVzc1JlcXVlc3QSEgoKc2Vzc2lvbl9pZBgBIAEoCRINCgVndWVzcxgCIAEoCSLFAQoVV29yZFF1aXpHdWVzc1Jlc3BvbnNlEjoKBnJlc3VsdBgBIAEoDjIqLndvcmRfc2VydmljZS5Xb3JkUXVpekd1ZXNzUmVzcG9uc2UuUmVzdWx0EhAKCHBvc2l0aW9uGAIgASgFEhcKD3F1ZXN0aW9uX3NvbHZlZBgDIAEoCBIQCghmaW5pc2hlZBgEIAEoCCIzCgZSZXN1bHQSCQoFV1JPTkcQABILCgdDT1JSRUNUEAESEQoNQUxSRUFEWV9GT1VORBACIiYKE0NhcmRib3hTdGF0c1JlcXVlc3QSDwoHbGV4aWNvbhgBIAEoCSJACgxDYXJkYm94TGV2ZWwSDwoHY2FyZGJveBgBIAEoBRISCgphbHBoYWdyYW1zGAIgASgFEgsKA2R1ZRgDIAEoBSJPChRDYXJkYm94U3RhdHNSZXNwb25zZRIqCgZsZXZlbHMYASADKAsyGi53b3JkX3NlcnZpY2UuQ2FyZGJveExldmVsEgsKA2R1ZRgCIAEoBSpNCgtMZXR0ZXJRdWVyeRITCg9OT19MRVRURVJfUVVFUlkQABILCgdBTkFHUkFNEAESDgoKU1VCQU5BR1JBTRACEgwKCEJVSUxEX1VQEAMymgYKC1dvcmRTZXJ2aWNlElIKC0RlZmluZVdvcmRzEiAud29yZF9zZXJ2aWNlLkRlZmluZVdvcmRzUmVxdWVzdBohLndvcmRfc2VydmljZS5EZWZpbmVXb3Jkc1Jlc3BvbnNlElIKC1NlYXJjaFdvcmRzEiAud29yZF9zZXJ2aWNlLlNlYXJjaFdvcmRzUmVxdWVzdBohLndvcmRfc2VydmljZS5TZWFyY2hXb3Jkc1Jlc3BvbnNlElUKDkdldExleGljb25EaWZmEiAud29yZF9zZXJ2aWNlLkxleGljb25EaWZmUmVxdWVzdBohLndvcmRfc2VydmljZS5MZXhpY29uRGlmZlJlc3BvbnNlEmEKEkdldFZhbGlkaXR5Q2hhbmdlcxIkLndvcmRfc2VydmljZS5WYWxpZGl0eUNoYW5nZXNSZXF1ZXN0GiUud29yZF9zZXJ2aWNlLlZhbGlkaXR5Q2hhbmdlc1Jlc3BvbnNlElMKDVN0YXJ0V29yZFF1aXoSIi53b3JkX3NlcnZpY2UuU3RhcnRXb3JkUXVpelJlcXVlc3QaHi53b3JkX3NlcnZpY2UuV29yZFF1aXpSZXNwb25zZRJMCgtHZXRXb3JkUXVpehIdLndvcmRfc2VydmljZS5Xb3JkUXVpelJlcXVlc3QaHi53b3JkX3NlcnZpY2UuV29yZFF1aXpSZXNwb25zZRJeChNTdWJtaXRXb3JkUXVpekd1ZXNzEiIud29yZF9zZXJ2aWNlLldvcmRRdWl6R3Vlc3NSZXF1ZXN0GiMud29yZF9zZXJ2aWNlLldvcmRRdWl6R3Vlc3NSZXNwb25zZRJMCgtFbmRXb3JkUXVpehIdLndvcmRfc2VydmljZS5Xb3JkUXVpelJlcXVlc3QaHi53b3JkX3NlcnZpY2UuV29yZFF1aXpSZXNwb25zZRJYCg9HZXRDYXJkYm94U3RhdHMSIS53b3JkX3NlcnZpY2UuQ2FyZGJveFN0YXRzUmVxdWVzdBoiLndvcmRfc2VydmljZS5DYXJkYm94U3RhdHNSZXNwb25zZUKqAQoQY29tLndvcmRfc2VydmljZUIQV29yZFNlcnZpY2VQcm90b1ABWjhnaXRodWIuY29tL3dvb2dsZXMtaW8vbGl3b3Jkcy9ycGMvYXBpL3Byb3RvL3dvcmRfc2VydmljZaICA1dYWKoCC1dvcmRTZXJ2aWNlygILV29yZFNlcnZpY2XiAhdXb3JkU2VydmljZVxHUEJNZXRhZGF0YeoCC1dvcmRTZXJ2aWNlYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * @generated from message word_service.DefineWordsRequest
@@ -46,6 +48,46 @@ export type DefineWordsRequest = Message<"word_service.DefineWordsRequest"> & {
 export const DefineWordsRequestSchema: GenMessage<DefineWordsRequest> = /*@__PURE__*/
   messageDesc(file_proto_word_service_word_service, 0);
 
+/**
+ * @generated from message word_service.Definition
+ */
+export type Definition = Message<"word_service.Definition"> & {
+  /**
+   * word is the word that is defined. With anagrams, it is one of the
+   * anagrams of the query.
+   *
+   * @generated from field: string word = 1;
+   */
+  word: string;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text: string;
+
+  /**
+   * source names the dictionary that the definition is from.
+   *
+   * @generated from field: string source = 3;
+   */
+  source: string;
+
+  /**
+   * lemma is set if the word has no definitions of its own, and this is a
+   * definition of the word that it is an inflection of.
+   *
+   * @generated from field: string lemma = 4;
+   */
+  lemma: string;
+};
+
+/**
+ * Describes the message word_service.Definition.
+ * Use `create(DefinitionSchema)` to create a new message.
+ */
+export const DefinitionSchema: GenMessage<Definition> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 1);
+
 /**
  * @generated from message word_service.DefineWordsResult
  */
@@ -63,6 +105,13 @@ export type DefineWordsResult = Message<"word_service.DefineWordsResult"> & {
    * @generated from field: bool v = 2;
    */
   v: boolean;
+
+  /**
+   * definitions are what d is made of, one per sense, with their sources.
+   *
+   * @generated from field: repeated word_service.Definition definitions = 3;
+   */
+  definitions: Definition[];
 };
 
 /**
@@ -70,7 +119,7 @@ export type DefineWordsResult = Message<"word_service.DefineWordsResult"> & {
  * Use `create(DefineWordsResultSchema)` to create a new message.
  */
 export const DefineWordsResultSchema: GenMessage<DefineWordsResult> = /*@__PURE__*/
-  messageDesc(file_proto_word_service_word_service, 1);
+  messageDesc(file_proto_word_service_word_service, 2);
 
 /**
  * @generated from message word_service.DefineWordsResponse
@@ -87,7 +136,743 @@ export type DefineWordsResponse = Message<"word_service.DefineWordsResponse"> &
  * Use `create(DefineWordsResponseSchema)` to create a new message.
  */
 export const DefineWordsResponseSchema: GenMessage<DefineWordsResponse> = /*@__PURE__*/
-  messageDesc(file_proto_word_service_word_service, 2);
+  messageDesc(file_proto_word_service_word_service, 3);
+
+/**
+ * @generated from message word_service.SearchWordsRequest
+ */
+export type SearchWordsRequest = Message<"word_service.SearchWordsRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * A pattern the whole word must match. ? matches any letter, * matches
+   * any number of letters, and [AEIOU] or [^AEIOU] match one letter that
+   * is or isn't in the brackets. An empty pattern matches every word.
+   *
+   * @generated from field: string pattern = 2;
+   */
+  pattern: string;
+
+  /**
+   * @generated from field: word_service.LetterQuery letter_query = 3;
+   */
+  letterQuery: LetterQuery;
+
+  /**
+   * The letters for the letter query. ? is a blank.
+   *
+   * @generated from field: string letters = 4;
+   */
+  letters: string;
+
+  /**
+   * Lengths are not limited if 0.
+   *
+   * @generated from field: int32 min_length = 5;
+   */
+  minLength: number;
+
+  /**
+   * @generated from field: int32 max_length = 6;
+   */
+  maxLength: number;
+
+  /**
+   * Probability ranks are not limited if 0.
+   *
+   * @generated from field: int32 min_probability_rank = 7;
+   */
+  minProbabilityRank: number;
+
+  /**
+   * @generated from field: int32 max_probability_rank = 8;
+   */
+  maxProbabilityRank: number;
+
+  /**
+   * The letter distribution for probability ranks. Defaults to the
+   * lexicon's usual distribution.
+   *
+   * @generated from field: string letter_distribution = 9;
+   */
+  letterDistribution: string;
+
+  /**
+   * @generated from field: int32 limit = 10;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 11;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message word_service.SearchWordsRequest.
+ * Use `create(SearchWordsRequestSchema)` to create a new message.
+ */
+export const SearchWordsRequestSchema: GenMessage<SearchWordsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 4);
+
+/**
+ * @generated from message word_service.SearchWordsResult
+ */
+export type SearchWordsResult = Message<"word_service.SearchWordsResult"> & {
+  /**
+   * @generated from field: string word = 1;
+   */
+  word: string;
+
+  /**
+   * Words of the same length are ranked by how likely their letters are to
+   * be drawn from a full bag, not counting blanks. Anagrams share a rank.
+   *
+   * @generated from field: int32 probability_rank = 2;
+   */
+  probabilityRank: number;
+
+  /**
+   * @generated from field: string front_hooks = 3;
+   */
+  frontHooks: string;
+
+  /**
+   * @generated from field: string back_hooks = 4;
+   */
+  backHooks: string;
+};
+
+/**
+ * Describes the message word_service.SearchWordsResult.
+ * Use `create(SearchWordsResultSchema)` to create a new message.
+ */
+export const SearchWordsResultSchema: GenMessage<SearchWordsResult> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 5);
+
+/**
+ * @generated from message word_service.SearchWordsResponse
+ */
+export type SearchWordsResponse = Message<"word_service.SearchWordsResponse"> & {
+  /**
+   * Ordered by length, then probability rank, then alphabetically.
+   *
+   * @generated from field: repeated word_service.SearchWordsResult results = 1;
+   */
+  results: SearchWordsResult[];
+
+  /**
+   * The number of matches before paging
+   *
+   * @generated from field: int32 total = 2;
+   */
+  total: number;
+};
+
+/**
+ * Describes the message word_service.SearchWordsResponse.
+ * Use `create(SearchWordsResponseSchema)` to create a new message.
+ */
+export const SearchWordsResponseSchema: GenMessage<SearchWordsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 6);
+
+/**
+ * @generated from message word_service.LexiconDiffRequest
+ */
+export type LexiconDiffRequest = Message<"word_service.LexiconDiffRequest"> & {
+  /**
+   * @generated from field: string from_lexicon = 1;
+   */
+  fromLexicon: string;
+
+  /**
+   * @generated from field: string to_lexicon = 2;
+   */
+  toLexicon: string;
+
+  /**
+   * Lengths are not limited if 0.
+   *
+   * @generated from field: int32 min_length = 3;
+   */
+  minLength: number;
+
+  /**
+   * @generated from field: int32 max_length = 4;
+   */
+  maxLength: number;
+
+  /**
+   * Both added and removed are paged with these. The limit defaults to
+   * 1000 words and is at most 10000.
+   *
+   * @generated from field: int32 limit = 5;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 6;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message word_service.LexiconDiffRequest.
+ * Use `create(LexiconDiffRequestSchema)` to create a new message.
+ */
+export const LexiconDiffRequestSchema: GenMessage<LexiconDiffRequest> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 7);
+
+/**
+ * @generated from message word_service.LexiconDiffResponse
+ */
+export type LexiconDiffResponse = Message<"word_service.LexiconDiffResponse"> & {
+  /**
+   * Words in to_lexicon but not from_lexicon, in alphabetical order
+   *
+   * @generated from field: repeated string added = 1;
+   */
+  added: string[];
+
+  /**
+   * Words in from_lexicon but not to_lexicon, in alphabetical order
+   *
+   * @generated from field: repeated string removed = 2;
+   */
+  removed: string[];
+
+  /**
+   * The number of words of the requested lengths in each list
+   *
+   * @generated from field: int32 total_added = 3;
+   */
+  totalAdded: number;
+
+  /**
+   * @generated from field: int32 total_removed = 4;
+   */
+  totalRemoved: number;
+};
+
+/**
+ * Describes the message word_service.LexiconDiffResponse.
+ * Use `create(LexiconDiffResponseSchema)` to create a new message.
+ */
+export const LexiconDiffResponseSchema: GenMessage<LexiconDiffResponse> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 8);
+
+/**
+ * @generated from message word_service.ValidityChangesRequest
+ */
+export type ValidityChangesRequest = Message<"word_service.ValidityChangesRequest"> & {
+  /**
+   * The lexicon to check the words against
+   *
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * A single game to check. If empty, the user's recent games are checked.
+   *
+   * @generated from field: string game_id = 2;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: int32 num_games = 4;
+   */
+  numGames: number;
+
+  /**
+   * @generated from field: int32 offset = 5;
+   */
+  offset: number;
+};
+
+/**
+ * Describes the message word_service.ValidityChangesRequest.
+ * Use `create(ValidityChangesRequestSchema)` to create a new message.
+ */
+export const ValidityChangesRequestSchema: GenMessage<ValidityChangesRequest> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 9);
+
+/**
+ * @generated from message word_service.WordValidityChange
+ */
+export type WordValidityChange = Message<"word_service.WordValidityChange"> & {
+  /**
+   * @generated from field: string word = 1;
+   */
+  word: string;
+
+  /**
+   * The index of the event that formed the word
+   *
+   * @generated from field: int32 event_index = 2;
+   */
+  eventIndex: number;
+
+  /**
+   * @generated from field: string player_nickname = 3;
+   */
+  playerNickname: string;
+
+  /**
+   * Whether the word is valid in the game's lexicon
+   *
+   * @generated from field: bool was_valid = 4;
+   */
+  wasValid: boolean;
+
+  /**
+   * Whether the word is valid in the requested lexicon
+   *
+   * @generated from field: bool is_valid = 5;
+   */
+  isValid: boolean;
+};
+
+/**
+ * Describes the message word_service.WordValidityChange.
+ * Use `create(WordValidityChangeSchema)` to create a new message.
+ */
+export const WordValidityChangeSchema: GenMessage<WordValidityChange> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 10);
+
+/**
+ * @generated from message word_service.GameValidityChanges
+ */
+export type GameValidityChanges = Message<"word_service.GameValidityChanges"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string lexicon = 2;
+   */
+  lexicon: string;
+
+  /**
+   * @generated from field: repeated word_service.WordValidityChange changes = 3;
+   */
+  changes: WordValidityChange[];
+};
+
+/**
+ * Describes the message word_service.GameValidityChanges.
+ * Use `create(GameValidityChangesSchema)` to create a new message.
+ */
+export const GameValidityChangesSchema: GenMessage<GameValidityChanges> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 11);
+
+/**
+ * @generated from message word_service.ValidityChangesResponse
+ */
+export type ValidityChangesResponse = Message<"word_service.ValidityChangesResponse"> & {
+  /**
+   * Games in lexica with a different alphabet are left out, and so are
+   * games with no changes.
+   *
+   * @generated from field: repeated word_service.GameValidityChanges games = 1;
+   */
+  games: GameValidityChanges[];
+};
+
+/**
+ * Describes the message word_service.ValidityChangesResponse.
+ * Use `create(ValidityChangesResponseSchema)` to create a new message.
+ */
+export const ValidityChangesResponseSchema: GenMessage<ValidityChangesResponse> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 12);
+
+/**
+ * @generated from message word_service.StartWordQuizRequest
+ */
+export type StartWordQuizRequest = Message<"word_service.StartWordQuizRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+
+  /**
+   * New alphagrams are picked from words of these lengths and probability
+   * ranks (see SearchWordsResult). Lengths default to 7 and probability
+   * ranks are not limited if 0.
+   *
+   * @generated from field: int32 min_length = 2;
+   */
+  minLength: number;
+
+  /**
+   * @generated from field: int32 max_length = 3;
+   */
+  maxLength: number;
+
+  /**
+   * @generated from field: int32 min_probability_rank = 4;
+   */
+  minProbabilityRank: number;
+
+  /**
+   * @generated from field: int32 max_probability_rank = 5;
+   */
+  maxProbabilityRank: number;
+
+  /**
+   * @generated from field: int32 num_questions = 6;
+   */
+  numQuestions: number;
+
+  /**
+   * @generated from field: int32 minutes = 7;
+   */
+  minutes: number;
+
+  /**
+   * Quiz the alphagrams in the user's cardbox that are due before picking
+   * new ones.
+   *
+   * @generated from field: bool include_due = 8;
+   */
+  includeDue: boolean;
+};
+
+/**
+ * Describes the message word_service.StartWordQuizRequest.
+ * Use `create(StartWordQuizRequestSchema)` to create a new message.
+ */
+export const StartWordQuizRequestSchema: GenMessage<StartWordQuizRequest> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 13);
+
+/**
+ * @generated from message word_service.WordQuizQuestion
+ */
+export type WordQuizQuestion = Message<"word_service.WordQuizQuestion"> & {
+  /**
+   * @generated from field: int32 position = 1;
+   */
+  position: number;
+
+  /**
+   * @generated from field: string alphagram = 2;
+   */
+  alphagram: string;
+
+  /**
+   * @generated from field: int32 num_answers = 3;
+   */
+  numAnswers: number;
+
+  /**
+   * @generated from field: repeated string found = 4;
+   */
+  found: string[];
+
+  /**
+   * All of the answers, once the quiz is finished
+   *
+   * @generated from field: repeated string answers = 5;
+   */
+  answers: string[];
+
+  /**
+   * The alphagram's cardbox, or -1 if it was never quizzed
+   *
+   * @generated from field: int32 cardbox = 6;
+   */
+  cardbox: number;
+};
+
+/**
+ * Describes the message word_service.WordQuizQuestion.
+ * Use `create(WordQuizQuestionSchema)` to create a new message.
+ */
+export const WordQuizQuestionSchema: GenMessage<WordQuizQuestion> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 14);
+
+/**
+ * @generated from message word_service.WordQuizState
+ */
+export type WordQuizState = Message<"word_service.WordQuizState"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string lexicon = 2;
+   */
+  lexicon: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 3;
+   */
+  startedAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ends_at = 4;
+   */
+  endsAt?: Timestamp | undefined;
+
+  /**
+   * @generated from field: bool finished = 5;
+   */
+  finished: boolean;
+
+  /**
+   * @generated from field: repeated word_service.WordQuizQuestion questions = 6;
+   */
+  questions: WordQuizQuestion[];
+
+  /**
+   * The number of questions with every answer found
+   *
+   * @generated from field: int32 solved = 7;
+   */
+  solved: number;
+};
+
+/**
+ * Describes the message word_service.WordQuizState.
+ * Use `create(WordQuizStateSchema)` to create a new message.
+ */
+export const WordQuizStateSchema: GenMessage<WordQuizState> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 15);
+
+/**
+ * @generated from message word_service.WordQuizRequest
+ */
+export type WordQuizRequest = Message<"word_service.WordQuizRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message word_service.WordQuizRequest.
+ * Use `create(WordQuizRequestSchema)` to create a new message.
+ */
+export const WordQuizRequestSchema: GenMessage<WordQuizRequest> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 16);
+
+/**
+ * @generated from message word_service.WordQuizResponse
+ */
+export type WordQuizResponse = Message<"word_service.WordQuizResponse"> & {
+  /**
+   * @generated from field: word_service.WordQuizState state = 1;
+   */
+  state?: WordQuizState | undefined;
+};
+
+/**
+ * Describes the message word_service.WordQuizResponse.
+ * Use `create(WordQuizResponseSchema)` to create a new message.
+ */
+export const WordQuizResponseSchema: GenMessage<WordQuizResponse> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 17);
+
+/**
+ * @generated from message word_service.WordQuizGuessRequest
+ */
+export type WordQuizGuessRequest = Message<"word_service.WordQuizGuessRequest"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string guess = 2;
+   */
+  guess: string;
+};
+
+/**
+ * Describes the message word_service.WordQuizGuessRequest.
+ * Use `create(WordQuizGuessRequestSchema)` to create a new message.
+ */
+export const WordQuizGuessRequestSchema: GenMessage<WordQuizGuessRequest> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 18);
+
+/**
+ * @generated from message word_service.WordQuizGuessResponse
+ */
+export type WordQuizGuessResponse = Message<"word_service.WordQuizGuessResponse"> & {
+  /**
+   * @generated from field: word_service.WordQuizGuessResponse.Result result = 1;
+   */
+  result: WordQuizGuessResponse_Result;
+
+  /**
+   * The position of the guessed question if the guess is correct
+   *
+   * @generated from field: int32 position = 2;
+   */
+  position: number;
+
+  /**
+   * @generated from field: bool question_solved = 3;
+   */
+  questionSolved: boolean;
+
+  /**
+   * True if the quiz is finished, either because every question is solved
+   * or because the time ran out. Guesses after the time ran out are not
+   * counted.
+   *
+   * @generated from field: bool finished = 4;
+   */
+  finished: boolean;
+};
+
+/**
+ * Describes the message word_service.WordQuizGuessResponse.
+ * Use `create(WordQuizGuessResponseSchema)` to create a new message.
+ */
+export const WordQuizGuessResponseSchema: GenMessage<WordQuizGuessResponse> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 19);
+
+/**
+ * @generated from enum word_service.WordQuizGuessResponse.Result
+ */
+export enum WordQuizGuessResponse_Result {
+  /**
+   * @generated from enum value: WRONG = 0;
+   */
+  WRONG = 0,
+
+  /**
+   * @generated from enum value: CORRECT = 1;
+   */
+  CORRECT = 1,
+
+  /**
+   * @generated from enum value: ALREADY_FOUND = 2;
+   */
+  ALREADY_FOUND = 2,
+}
+
+/**
+ * Describes the enum word_service.WordQuizGuessResponse.Result.
+ */
+export const WordQuizGuessResponse_ResultSchema: GenEnum<WordQuizGuessResponse_Result> = /*@__PURE__*/
+  enumDesc(file_proto_word_service_word_service, 19, 0);
+
+/**
+ * @generated from message word_service.CardboxStatsRequest
+ */
+export type CardboxStatsRequest = Message<"word_service.CardboxStatsRequest"> & {
+  /**
+   * @generated from field: string lexicon = 1;
+   */
+  lexicon: string;
+};
+
+/**
+ * Describes the message word_service.CardboxStatsRequest.
+ * Use `create(CardboxStatsRequestSchema)` to create a new message.
+ */
+export const CardboxStatsRequestSchema: GenMessage<CardboxStatsRequest> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 20);
+
+/**
+ * @generated from message word_service.CardboxLevel
+ */
+export type CardboxLevel = Message<"word_service.CardboxLevel"> & {
+  /**
+   * @generated from field: int32 cardbox = 1;
+   */
+  cardbox: number;
+
+  /**
+   * @generated from field: int32 alphagrams = 2;
+   */
+  alphagrams: number;
+
+  /**
+   * @generated from field: int32 due = 3;
+   */
+  due: number;
+};
+
+/**
+ * Describes the message word_service.CardboxLevel.
+ * Use `create(CardboxLevelSchema)` to create a new message.
+ */
+export const CardboxLevelSchema: GenMessage<CardboxLevel> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 21);
+
+/**
+ * @generated from message word_service.CardboxStatsResponse
+ */
+export type CardboxStatsResponse = Message<"word_service.CardboxStatsResponse"> & {
+  /**
+   * @generated from field: repeated word_service.CardboxLevel levels = 1;
+   */
+  levels: CardboxLevel[];
+
+  /**
+   * The number of alphagrams due now across all cardboxes
+   *
+   * @generated from field: int32 due = 2;
+   */
+  due: number;
+};
+
+/**
+ * Describes the message word_service.CardboxStatsResponse.
+ * Use `create(CardboxStatsResponseSchema)` to create a new message.
+ */
+export const CardboxStatsResponseSchema: GenMessage<CardboxStatsResponse> = /*@__PURE__*/
+  messageDesc(file_proto_word_service_word_service, 22);
+
+/**
+ * @generated from enum word_service.LetterQuery
+ */
+export enum LetterQuery {
+  /**
+   * @generated from enum value: NO_LETTER_QUERY = 0;
+   */
+  NO_LETTER_QUERY = 0,
+
+  /**
+   * Words that use all of the letters
+   *
+   * @generated from enum value: ANAGRAM = 1;
+   */
+  ANAGRAM = 1,
+
+  /**
+   * Words that use some of the letters
+   *
+   * @generated from enum value: SUBANAGRAM = 2;
+   */
+  SUBANAGRAM = 2,
+
+  /**
+   * Words that use all of the letters and more
+   *
+   * @generated from enum value: BUILD_UP = 3;
+   */
+  BUILD_UP = 3,
+}
+
+/**
+ * Describes the enum word_service.LetterQuery.
+ */
+export const LetterQuerySchema: GenEnum<LetterQuery> = /*@__PURE__*/
+  enumDesc(file_proto_word_service_word_service, 0);
 
 /**
  * @generated from service word_service.WordService
@@ -101,6 +886,79 @@ export const WordService: GenService<{
     input: typeof DefineWordsRequestSchema;
     output: typeof DefineWordsResponseSchema;
   },
+  /**
+   * @generated from rpc word_service.WordService.SearchWords
+   */
+  searchWords: {
+    methodKind: "unary";
+    input: typeof SearchWordsRequestSchema;
+    output: typeof SearchWordsResponseSchema;
+  },
+  /**
+   * Lexicon diffs are computed once and cached.
+   *
+   * @generated from rpc word_service.WordService.GetLexiconDiff
+   */
+  getLexiconDiff: {
+    methodKind: "unary";
+    input: typeof LexiconDiffRequestSchema;
+    output: typeof LexiconDiffResponseSchema;
+  },
+  /**
+   * Finds the words played in past games whose validity would be different
+   * in another lexicon.
+   *
+   * @generated from rpc word_service.WordService.GetValidityChanges
+   */
+  getValidityChanges: {
+    methodKind: "unary";
+    input: typeof ValidityChangesRequestSchema;
+    output: typeof ValidityChangesResponseSchema;
+  },
+  /**
+   * Anagram quizzes for the logged-in user. Finishing a quiz, either by
+   * ending it or running out of time, moves its alphagrams between
+   * cardboxes.
+   *
+   * @generated from rpc word_service.WordService.StartWordQuiz
+   */
+  startWordQuiz: {
+    methodKind: "unary";
+    input: typeof StartWordQuizRequestSchema;
+    output: typeof WordQuizResponseSchema;
+  },
+  /**
+   * @generated from rpc word_service.WordService.GetWordQuiz
+   */
+  getWordQuiz: {
+    methodKind: "unary";
+    input: typeof WordQuizRequestSchema;
+    output: typeof WordQuizResponseSchema;
+  },
+  /**
+   * @generated from rpc word_service.WordService.SubmitWordQuizGuess
+   */
+  submitWordQuizGuess: {
+    methodKind: "unary";
+    input: typeof WordQuizGuessRequestSchema;
+    output: typeof WordQuizGuessResponseSchema;
+  },
+  /**
+   * @generated from rpc word_service.WordService.EndWordQuiz
+   */
+  endWordQuiz: {
+    methodKind: "unary";
+    input: typeof WordQuizRequestSchema;
+    output: typeof WordQuizResponseSchema;
+  },
+  /**
+   * @generated from rpc word_service.WordService.GetCardboxStats
+   */
+  getCardboxStats: {
+    methodKind: "unary";
+    input: typeof CardboxStatsRequestSchema;
+    output: typeof CardboxStatsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_proto_word_service_word_service, 0);
 
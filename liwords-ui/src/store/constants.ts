@@ -529,4 +529,22 @@ export const errorMap: Map<number, string> = new Map<number, string>([
   ],
   [1089, "Game is no longer available."],
   [1104, "You cannot use non-COP pairings after COP pairings."],
+  [1105, "Puzzle $2 is not in your review queue."],
+  [1106, "Cannot find puzzle set with ID $2."],
+  [
+    1107,
+    "Puzzle sets can only contain existing $2 puzzles, each at most once.",
+  ],
+  [1108, "Cannot find puzzle rush with ID $2."],
+  [1109, "This puzzle rush is over."],
+  [1110, "Puzzle rush cannot last $2 minutes."],
+  [1111, "There are no $2 puzzles left for this puzzle rush."],
+  [1112, "This puzzle was already answered."],
+  [1113, "There is no $2 daily puzzle yet."],
+  [1114, "You have already answered today's daily puzzle."],
+  [1115, "Daily puzzles can only be scheduled for future dates, not $2."],
+  [
+    1116,
+    "Puzzle $2 is not a $3 puzzle, or has already been a daily puzzle.",
+  ],
 ]);
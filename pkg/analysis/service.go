@@ -23,6 +23,7 @@ import (
 	macondo "github.com/domino14/macondo/gen/api/proto/macondo"
 	"github.com/woogles-io/liwords/pkg/apiserver"
 	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/mod"
 	"github.com/woogles-io/liwords/pkg/stores/models"
 	"github.com/woogles-io/liwords/pkg/user"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/analysis_service"
@@ -95,6 +96,8 @@ type AnalysisService struct {
 	queries   *models.Queries
 	natsconn  *nats.Conn
 	dbPool    *pgxpool.Pool
+
+	notorietyStore mod.NotorietyStore
 }
 
 func NewAnalysisService(userStore user.Store, gameStore GameStore, queries *models.Queries, dbPool *pgxpool.Pool) *AnalysisService {
@@ -110,6 +113,10 @@ func (s *AnalysisService) SetNatsConn(nc *nats.Conn) {
 	s.natsconn = nc
 }
 
+func (s *AnalysisService) SetNotorietyStore(ns mod.NotorietyStore) {
+	s.notorietyStore = ns
+}
+
 func (s *AnalysisService) ClaimJob(
 	ctx context.Context,
 	req *connect.Request[pb.ClaimJobRequest],
@@ -313,6 +320,7 @@ func (s *AnalysisService) SubmitResult(
 	// context.WithoutCancel preserves the otel trace context while detaching from
 	// the request cancellation (which fires as soon as we return a response).
 	go s.updateLeagueMistakeIndex(context.WithoutCancel(ctx), completedJob.GameID, result)
+	go s.updateCheatSignals(context.WithoutCancel(ctx), completedJob.GameID, result)
 
 	// Notify the requesting user via WebSocket if this was a user-requested analysis.
 	if s.natsconn != nil && completedJob.RequestedByUserUuid.Valid {
@@ -339,6 +347,27 @@ func (s *AnalysisService) updateLeagueMistakeIndex(ctx context.Context, gameID s
 	applyLeagueMistakeIndex(ctx, s.queries, gameID, result, false)
 }
 
+// updateCheatSignals records the players' cheat detection signals for a
+// completed analysis. Like the mistake index update it is best-effort.
+func (s *AnalysisService) updateCheatSignals(ctx context.Context, gameID string, result *macondo.GameAnalysisResult) {
+	ctx, span := tracer.Start(ctx, "analysis.updateCheatSignals",
+		trace.WithAttributes(attribute.String("game.id", gameID)),
+	)
+	defer span.End()
+	if len(result.Turns) == 0 {
+		return
+	}
+	g, err := s.gameStore.Get(ctx, gameID)
+	if err != nil {
+		log.Error().Err(err).Str("game_id", gameID).Msg("failed to get game for cheat signals")
+		return
+	}
+	err = mod.RecordCheatSignals(ctx, s.userStore, s.notorietyStore, s.queries, g, result)
+	if err != nil {
+		log.Error().Err(err).Str("game_id", gameID).Msg("failed to record cheat signals")
+	}
+}
+
 // applyLeagueMistakeIndex adds (decrement=false) or subtracts (decrement=true) a game's
 // mistake index contribution from league standings.
 func applyLeagueMistakeIndex(ctx context.Context, queries *models.Queries, gameID string, result *macondo.GameAnalysisResult, decrement bool) {
@@ -26,3 +26,8 @@ func InternalErr(err error) *connect.Error {
 func NotFound(str string) *connect.Error {
 	return connect.NewError(connect.CodeNotFound, errors.New(str))
 }
+
+// AlreadyExists sends a 409.
+func AlreadyExists(str string) *connect.Error {
+	return connect.NewError(connect.CodeAlreadyExists, errors.New(str))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/config"
 	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/pkg/gameplay"
+	"github.com/woogles-io/liwords/pkg/mod"
 	"github.com/woogles-io/liwords/pkg/omgwords"
 	"github.com/woogles-io/liwords/pkg/stores"
 	"github.com/woogles-io/liwords/pkg/tournament"
@@ -66,6 +67,8 @@ type Bus struct {
 
 	genericEventChan   chan *entity.EventWrapper
 	gameEventAPIServer *EventAPIServer
+
+	chatFilter *mod.ChatFilter
 }
 
 func NewBus(cfg *config.Config, natsconn *nats.Conn, stores *stores.Stores, redisPool *redis.Pool) (*Bus, error) {
@@ -83,6 +86,7 @@ func NewBus(cfg *config.Config, natsconn *nats.Conn, stores *stores.Stores, redi
 		genericEventChan:   make(chan *entity.EventWrapper, 512),
 		redisPool:          redisPool,
 		gameEventAPIServer: NewEventApiServer(stores.UserStore, stores.GameStore),
+		chatFilter:         mod.NewChatFilter(stores.Queries),
 	}
 	bus.stores.GameStore.SetGameEventChan(bus.gameEventChan)
 	bus.stores.TournamentStore.SetTournamentEventChan(bus.tournamentEventChan)
@@ -762,6 +766,12 @@ func (b *Bus) handleNatsPublish(ctx context.Context, subtopics []string, data []
 	}
 }
 
+// ChatFilter returns the filter that checks chat messages against the chat
+// policies.
+func (b *Bus) ChatFilter() *mod.ChatFilter {
+	return b.chatFilter
+}
+
 func (b *Bus) TournamentEventChannel() chan *entity.EventWrapper {
 	return b.tournamentEventChan
 }
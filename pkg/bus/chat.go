@@ -3,7 +3,9 @@ package bus
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/google/uuid"
@@ -13,6 +15,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/pkg/mod"
 	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/user"
 	userservices "github.com/woogles-io/liwords/pkg/user/services"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
 	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
@@ -35,7 +38,12 @@ func (b *Bus) chat(ctx context.Context, userID string, evt *pb.ChatMessage) erro
 		return err
 	}
 
-	_, err = mod.ActionExists(ctx, b.stores.UserStore, userID, false, []ms.ModActionType{ms.ModActionType_SUSPEND_ACCOUNT, ms.ModActionType_MUTE})
+	// The user's actions are looked up once for every check below.
+	currentActions, err := b.stores.UserStore.GetActions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	_, err = mod.CurrentActionExists(currentActions, false, []ms.ModActionType{ms.ModActionType_SUSPEND_ACCOUNT, ms.ModActionType_MUTE})
 	if err != nil {
 		return err
 	}
@@ -46,11 +54,9 @@ func (b *Bus) chat(ctx context.Context, userID string, evt *pb.ChatMessage) erro
 	if err != nil {
 		return err
 	}
-	// Regulate chat only if the user is not privileged and the
-	// chat is not a private chat or a game chat
-	regulateChat := !(privilegedUser ||
-		strings.HasPrefix(evt.Channel, "chat.pm.") ||
-		strings.HasPrefix(evt.Channel, "chat.game."))
+	// Regulate chat only if the user is not privileged. How each type of
+	// channel is regulated depends on its chat policy.
+	regulateChat := !privilegedUser
 
 	userFriendlyChannelName := ""
 	if strings.HasPrefix(evt.Channel, "chat.pm.") {
@@ -118,7 +124,36 @@ func (b *Bus) chat(ctx context.Context, userID string, evt *pb.ChatMessage) erro
 		// This would require adding a GetLeagueManagers query or including managers in the league model
 	}
 
-	chatMessage, err := b.stores.ChatStore.AddChat(ctx, sendingUser.Username, userID, evt.Message, evt.Channel, userFriendlyChannelName, regulateChat)
+	limits := user.ChatLimits{}
+	if regulateChat {
+		if mod.IsShadowMuted(currentActions) {
+			return b.shadowChat(sendingUser, evt)
+		}
+		policy, err := b.chatFilter.Policy(ctx, mod.ChatChannelType(evt.Channel))
+		if err != nil {
+			return err
+		}
+		violation, err := b.chatFilter.CheckMessage(ctx, policy, userID, evt.Message)
+		if err != nil {
+			return err
+		}
+		if violation != nil {
+			return b.chatViolation(ctx, sendingUser, evt, policy, violation)
+		}
+		limits = mod.ChatLimits(policy)
+	}
+
+	chatMessage, err := b.stores.ChatStore.AddChat(ctx, sendingUser.Username, userID, evt.Message, evt.Channel, userFriendlyChannelName, limits)
+	if errors.Is(err, user.ErrChatFlood) {
+		policy, perr := b.chatFilter.Policy(ctx, mod.ChatChannelType(evt.Channel))
+		if perr != nil {
+			return perr
+		}
+		return b.chatViolation(ctx, sendingUser, evt, policy, &mod.ChatViolation{
+			Action: policy.FloodAction,
+			Reason: err.Error(),
+		})
+	}
 	if err != nil {
 		return err
 	}
@@ -139,3 +174,39 @@ func (b *Bus) chat(ctx context.Context, userID string, evt *pb.ChatMessage) erro
 	log.Debug().Interface("chat-message", chatMessage).Msg("publish-chat")
 	return b.natsconn.Publish(evt.Channel, data)
 }
+
+// chatViolation enforces the policy's action for a message that breaks it.
+// A shadow-muted user still sees their own message.
+func (b *Bus) chatViolation(ctx context.Context, sendingUser *entity.User, evt *pb.ChatMessage,
+	policy *ms.ChatPolicy, violation *mod.ChatViolation) error {
+
+	log.Info().Str("user", sendingUser.Username).Str("channel", evt.Channel).
+		Str("action", violation.Action.String()).Str("reason", violation.Reason).Msg("chat-policy-violation")
+	err := mod.EnforceChatAction(ctx, b.stores.UserStore, b.stores.ChatStore, sendingUser.UUID,
+		policy, violation.Action, violation.Reason)
+	if err != nil {
+		return err
+	}
+	if violation.Action == ms.ChatFilterAction_CHAT_SHADOW_MUTE {
+		return b.shadowChat(sendingUser, evt)
+	}
+	return errors.New(violation.Reason)
+}
+
+// shadowChat sends a message back to its sender only, without storing it.
+func (b *Bus) shadowChat(sendingUser *entity.User, evt *pb.ChatMessage) error {
+	ts := time.Now().UnixMilli()
+	chatMessage := &pb.ChatMessage{
+		Username:  sendingUser.Username,
+		UserId:    sendingUser.UUID,
+		Channel:   evt.Channel,
+		Message:   evt.Message,
+		Timestamp: ts,
+		Id:        fmt.Sprintf("%d-0", ts),
+	}
+	if sendingUser.Profile != nil {
+		chatMessage.CountryCode = sendingUser.Profile.CountryCode
+		chatMessage.AvatarUrl = sendingUser.AvatarUrl()
+	}
+	return b.pubToUser(sendingUser.UUID, entity.WrapEvent(chatMessage, pb.MessageType_CHAT_MESSAGE), "")
+}
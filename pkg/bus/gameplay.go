@@ -95,6 +95,10 @@ func (b *Bus) instantiateAndStartGame(ctx context.Context, accUser *entity.User,
 			tournamentID = t.UUID
 		}
 	}
+	err = b.validateLexiconScope(ctx, gameReq.Lexicon, tournamentID)
+	if err != nil {
+		return err
+	}
 	// If tournamentID is defined, this is a clubhouse game, so there's no
 	// round/division/etc, just a simple "tournament ID"
 	trdata := &entity.TournamentData{
@@ -79,6 +79,24 @@ func (b *Bus) seekRequest(ctx context.Context, auth, userID, connID string,
 	return err
 }
 
+// validateLexiconScope returns an error if a game in the given tournament (or
+// outside of tournaments, if tournamentID is empty) may not use the lexicon.
+func (b *Bus) validateLexiconScope(ctx context.Context, lexicon, tournamentID string) error {
+	if !entity.IsPrivateLexicon(lexicon) {
+		return nil
+	}
+	var tournamentIDs []string
+	if tournamentID != "" {
+		t, err := b.stores.TournamentStore.Get(ctx, tournamentID)
+		if err != nil {
+			return errors.New("tournament not found")
+		}
+		// Private lexica uploaded for a club may be used in its sessions.
+		tournamentIDs = append(tournamentIDs, t.UUID, t.ParentID)
+	}
+	return entity.ValidateLexiconScope(lexicon, tournamentIDs...)
+}
+
 func ratingKey(gameRequest *pb.GameRequest) (entity.VariantKey, error) {
 	timefmt, variant, err := entity.VariantFromGameReq(gameRequest)
 	if err != nil {
@@ -109,6 +127,13 @@ func (b *Bus) newSeekRequest(ctx context.Context, auth, userID, connID string,
 	if err != nil {
 		return err
 	}
+	if gameRequest.PlayerVsBot && entity.IsPrivateLexicon(gameRequest.Lexicon) {
+		return errors.New("bots cannot play private lexica")
+	}
+	err = b.validateLexiconScope(ctx, gameRequest.Lexicon, req.TournamentId)
+	if err != nil {
+		return err
+	}
 
 	// Look up user.
 	ratingKey, err := ratingKey(gameRequest)
@@ -58,6 +58,24 @@ type Config struct {
 	TwitchClientSecret string
 	TwitchRedirectURI  string
 
+	// External login (OAuth2 / OpenID Connect) providers. A provider is
+	// enabled when its client ID is set.
+	GoogleClientID      string
+	GoogleClientSecret  string
+	DiscordClientID     string
+	DiscordClientSecret string
+	AppleClientID       string
+	AppleClientSecret   string
+	// A generic OIDC provider, mostly useful for testing against a local
+	// mock identity provider.
+	OIDCProviderName string
+	OIDCIssuer       string
+	OIDCClientID     string
+	OIDCClientSecret string
+	// OAuthLoginRedirectBase is the public base URL (e.g. https://woogles.io)
+	// that the providers redirect back to.
+	OAuthLoginRedirectBase string
+
 	// VDO.Ninja monitoring
 	VDOPollingIntervalSeconds int
 
@@ -119,6 +137,17 @@ func (c *Config) Load(args []string) error {
 	fs.StringVar(&c.TwitchClientID, "twitch-client-id", "", "The Twitch Integration Client ID")
 	fs.StringVar(&c.TwitchClientSecret, "twitch-client-secret", "", "The Twitch Integration Client secret")
 	fs.StringVar(&c.TwitchRedirectURI, "twitch-redirect-uri", "", "The Twitch redirect URI")
+	fs.StringVar(&c.GoogleClientID, "google-client-id", "", "The Google login Client ID")
+	fs.StringVar(&c.GoogleClientSecret, "google-client-secret", "", "The Google login Client secret")
+	fs.StringVar(&c.DiscordClientID, "discord-client-id", "", "The Discord login Client ID")
+	fs.StringVar(&c.DiscordClientSecret, "discord-client-secret", "", "The Discord login Client secret")
+	fs.StringVar(&c.AppleClientID, "apple-client-id", "", "The Sign in with Apple Services ID")
+	fs.StringVar(&c.AppleClientSecret, "apple-client-secret", "", "The Sign in with Apple client secret (a signed JWT)")
+	fs.StringVar(&c.OIDCProviderName, "oidc-provider-name", "oidc", "The name of the generic OIDC login provider")
+	fs.StringVar(&c.OIDCIssuer, "oidc-issuer", "", "The issuer URL of the generic OIDC login provider")
+	fs.StringVar(&c.OIDCClientID, "oidc-client-id", "", "The generic OIDC login Client ID")
+	fs.StringVar(&c.OIDCClientSecret, "oidc-client-secret", "", "The generic OIDC login Client secret")
+	fs.StringVar(&c.OAuthLoginRedirectBase, "oauth-login-redirect-base", "", "The public base URL that external login providers redirect back to")
 
 	// VDO.Ninja monitoring
 	fs.IntVar(&c.VDOPollingIntervalSeconds, "vdo-polling-interval-seconds", 60, "VDO.Ninja stream polling interval midpoint in seconds (with ±25% jitter)")
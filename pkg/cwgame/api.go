@@ -17,11 +17,11 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	wglconfig "github.com/domino14/word-golib/config"
-	"github.com/domino14/word-golib/kwg"
 	"github.com/domino14/word-golib/tilemapping"
 	"github.com/woogles-io/liwords/pkg/config"
 	"github.com/woogles-io/liwords/pkg/cwgame/board"
 	"github.com/woogles-io/liwords/pkg/cwgame/tiles"
+	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/pkg/omgwords/stores"
 	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
@@ -68,7 +68,7 @@ func NewGame(cfg *wglconfig.Config, rules *GameRules, playerinfo []*ipc.GameDocu
 	if err != nil {
 		return nil, err
 	}
-	_, err = kwg.GetKWG(cfg, rules.lexicon)
+	_, err = entity.GetLexiconKWG(cfg, rules.lexicon)
 	if err != nil {
 		return nil, err
 	}
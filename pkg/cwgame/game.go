@@ -15,6 +15,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/config"
 	"github.com/woogles-io/liwords/pkg/cwgame/board"
 	"github.com/woogles-io/liwords/pkg/cwgame/tiles"
+	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
 
@@ -177,7 +178,7 @@ func playTilePlacementMove(cfg *config.Config, gevt *ipc.GameEvent, gdoc *ipc.Ga
 	}
 
 	// validate the tile play move
-	gd, err := kwg.GetKWG(cfg.WGLConfig(), gdoc.Lexicon)
+	gd, err := entity.GetLexiconKWG(cfg.WGLConfig(), gdoc.Lexicon)
 	if err != nil {
 		return err
 	}
@@ -522,7 +523,7 @@ func challengeEvent(ctx context.Context, cfg *config.Config, gdoc *ipc.GameDocum
 	if err != nil {
 		return err
 	}
-	gd, err := kwg.GetKWG(cfg.WGLConfig(), gdoc.Lexicon)
+	gd, err := entity.GetLexiconKWG(cfg.WGLConfig(), gdoc.Lexicon)
 	if err != nil {
 		return err
 	}
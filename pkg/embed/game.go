@@ -0,0 +1,197 @@
+package embed
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/domino14/macondo/move"
+	"github.com/domino14/word-golib/tilemapping"
+
+	"github.com/woogles-io/liwords/pkg/cwgame/board"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// embedGame is what the embed is given, both in the page and in every update
+// on the event stream. The server does the tile mapping and move formatting
+// so that the embed only has to place and remove tiles. Keep it in sync with
+// the types in liwords-ui/src/embed/standalone-embed.tsx.
+type embedGame struct {
+	GameID  string   `json:"gameId"`
+	Players []string `json:"players"`
+	// Layout has one string per row, with macondo's bonus square symbols.
+	Layout []string    `json:"layout"`
+	Turns  []embedTurn `json:"turns"`
+	// Racks are the current racks. They are empty while they are secret.
+	Racks        []string `json:"racks"`
+	PlayerOnTurn int      `json:"playerOnTurn"`
+	// Live is set while the game or annotation is in progress, and the
+	// player should listen for updates.
+	Live bool `json:"live"`
+}
+
+// embedTurn is one event of the game.
+type embedTurn struct {
+	Player     int    `json:"player"`
+	Summary    string `json:"summary"`
+	Score      int32  `json:"score"`
+	Cumulative int32  `json:"cumulative"`
+	// Rack is the rack the turn was made from, if known.
+	Rack  string      `json:"rack,omitempty"`
+	Note  string      `json:"note,omitempty"`
+	Tiles []embedTile `json:"tiles,omitempty"`
+	// Undo takes the tiles of the last placement back off the board.
+	Undo bool `json:"undo,omitempty"`
+}
+
+// embedTile is a tile that a turn puts on the board.
+type embedTile struct {
+	Row    int    `json:"row"`
+	Col    int    `json:"col"`
+	Letter string `json:"letter"`
+	Blank  bool   `json:"blank,omitempty"`
+	Value  int    `json:"value"`
+}
+
+func boardLayout(name string) ([]string, error) {
+	switch name {
+	case "", board.CrosswordGameLayout:
+		return board.CrosswordGameBoard, nil
+	case board.SuperCrosswordGameLayout:
+		return board.SuperCrosswordGameBoard, nil
+	}
+	return nil, fmt.Errorf("unsupported board layout: %s", name)
+}
+
+func rackString(rack []byte, rm *tilemapping.TileMapping) string {
+	var sb strings.Builder
+	for _, ml := range rack {
+		sb.WriteString(tilemapping.MachineLetter(ml).UserVisible(rm, false))
+	}
+	return sb.String()
+}
+
+// playedTilesString shows played-through squares as "." like a GCG does.
+func playedTilesString(tiles []byte, rm *tilemapping.TileMapping) string {
+	var sb strings.Builder
+	for _, ml := range tiles {
+		sb.WriteString(tilemapping.MachineLetter(ml).UserVisible(rm, true))
+	}
+	return sb.String()
+}
+
+func playerName(doc *ipc.GameDocument, idx uint32) string {
+	if int(idx) >= len(doc.Players) {
+		return fmt.Sprintf("Player %d", idx+1)
+	}
+	if p := doc.Players[idx]; p.RealName != "" {
+		return p.RealName
+	} else {
+		return p.Nickname
+	}
+}
+
+// turnScore is what an event adds to the player's score.
+func turnScore(evt *ipc.GameEvent) int32 {
+	switch evt.Type {
+	case ipc.GameEvent_CHALLENGE_BONUS:
+		return evt.Bonus
+	case ipc.GameEvent_END_RACK_PTS:
+		return evt.EndRackPoints
+	case ipc.GameEvent_PHONY_TILES_RETURNED, ipc.GameEvent_END_RACK_PENALTY, ipc.GameEvent_TIME_PENALTY:
+		return -evt.LostScore
+	}
+	return evt.Score
+}
+
+func summarizeEvent(evt *ipc.GameEvent, dist *tilemapping.LetterDistribution) string {
+	rm := dist.TileMapping()
+	switch evt.Type {
+	case ipc.GameEvent_TILE_PLACEMENT_MOVE:
+		pos := evt.Position
+		if pos == "" {
+			pos = move.ToBoardGameCoords(int(evt.Row), int(evt.Column), evt.Direction == ipc.GameEvent_VERTICAL)
+		}
+		return fmt.Sprintf("%s %s", pos, playedTilesString(evt.PlayedTiles, rm))
+	case ipc.GameEvent_PHONY_TILES_RETURNED:
+		return "challenged off"
+	case ipc.GameEvent_PASS:
+		return "pass"
+	case ipc.GameEvent_EXCHANGE:
+		if len(evt.Exchanged) == 0 {
+			return "exchange"
+		}
+		return "exchange " + rackString(evt.Exchanged, rm)
+	case ipc.GameEvent_CHALLENGE_BONUS:
+		return "challenge bonus"
+	case ipc.GameEvent_UNSUCCESSFUL_CHALLENGE_TURN_LOSS:
+		return "unsuccessful challenge"
+	case ipc.GameEvent_END_RACK_PTS:
+		return "(" + rackString(evt.Rack, rm) + ")"
+	case ipc.GameEvent_END_RACK_PENALTY:
+		return "rack penalty"
+	case ipc.GameEvent_TIME_PENALTY:
+		return "time penalty"
+	case ipc.GameEvent_TIMED_OUT:
+		return "timed out"
+	case ipc.GameEvent_RESIGNED:
+		return "resigned"
+	}
+	return strings.ToLower(evt.Type.String())
+}
+
+// buildEmbedGame turns a GameDocument into what the embed shows.
+// Secret racks must be removed from doc before this is called.
+func buildEmbedGame(doc *ipc.GameDocument, dist *tilemapping.LetterDistribution) (*embedGame, error) {
+	layout, err := boardLayout(doc.BoardLayout)
+	if err != nil {
+		return nil, err
+	}
+	rm := dist.TileMapping()
+	g := &embedGame{
+		GameID:       doc.Uid,
+		Layout:       layout,
+		Turns:        make([]embedTurn, 0, len(doc.Events)),
+		PlayerOnTurn: int(doc.PlayerOnTurn),
+		Live:         doc.PlayState != ipc.PlayState_GAME_OVER,
+	}
+	for i := range doc.Players {
+		g.Players = append(g.Players, playerName(doc, uint32(i)))
+	}
+	for _, rack := range doc.Racks {
+		g.Racks = append(g.Racks, rackString(rack, rm))
+	}
+
+	for _, evt := range doc.Events {
+		turn := embedTurn{
+			Player:     int(evt.PlayerIndex),
+			Summary:    summarizeEvent(evt, dist),
+			Score:      turnScore(evt),
+			Cumulative: evt.Cumulative,
+			Rack:       rackString(evt.Rack, rm),
+			Note:       evt.Note,
+			Undo:       evt.Type == ipc.GameEvent_PHONY_TILES_RETURNED,
+		}
+		if evt.Type == ipc.GameEvent_TILE_PLACEMENT_MOVE {
+			r, c := int(evt.Row), int(evt.Column)
+			for _, b := range evt.PlayedTiles {
+				ml := tilemapping.MachineLetter(b)
+				if ml != 0 {
+					turn.Tiles = append(turn.Tiles, embedTile{
+						Row:    r,
+						Col:    c,
+						Letter: strings.ToUpper(rm.Letter(ml)),
+						Blank:  ml.IsBlanked(),
+						Value:  dist.Score(ml),
+					})
+				}
+				if evt.Direction == ipc.GameEvent_VERTICAL {
+					r++
+				} else {
+					c++
+				}
+			}
+		}
+		g.Turns = append(g.Turns, turn)
+	}
+	return g, nil
+}
@@ -0,0 +1,116 @@
+package embed
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/matryer/is"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+var DefaultConfig = config.DefaultConfig()
+
+func loadDocument(is *is.I) *ipc.GameDocument {
+	content, err := os.ReadFile("../cwgame/testdata/document-gameover.json")
+	is.NoErr(err)
+	doc := &ipc.GameDocument{}
+	is.NoErr(protojson.Unmarshal(content, doc))
+	return doc
+}
+
+func english(is *is.I) *tilemapping.LetterDistribution {
+	dist, err := tilemapping.GetDistribution(DefaultConfig.WGLConfig(), "english")
+	is.NoErr(err)
+	return dist
+}
+
+func TestBuildEmbedGame(t *testing.T) {
+	is := is.New(t)
+	doc := loadDocument(is)
+
+	g, err := buildEmbedGame(doc, english(is))
+	is.NoErr(err)
+	is.Equal(g.GameID, "9zaaSuN5")
+	is.Equal(g.Players, []string{"botty", "cesar"})
+	is.Equal(len(g.Layout), 15)
+	is.Equal(len(g.Turns), len(doc.Events))
+	is.True(!g.Live)
+
+	first := g.Turns[0]
+	is.Equal(first.Player, 0)
+	is.Equal(first.Score, int32(66))
+	is.Equal(first.Rack, "AEENORS")
+	is.Equal(first.Summary, "8D ARENOSE")
+	is.Equal(len(first.Tiles), 7)
+	is.Equal(first.Tiles[1], embedTile{Row: 7, Col: 4, Letter: "R", Value: 1})
+	// Played-through squares are shown as dots, and blanks in lower case.
+	is.Equal(g.Turns[1].Summary, "E6 OU.IE")
+	is.Equal(g.Turns[17].Summary, "M8 SAgOUIN")
+	is.Equal(g.Turns[17].Tiles[2], embedTile{Row: 9, Col: 12, Letter: "G", Blank: true})
+
+	// A challenged-off play is undone by the turn after it, which has the
+	// rack it was made from.
+	is.Equal(len(g.Turns[9].Tiles), 6)
+	is.Equal(g.Turns[9].Tiles[0], embedTile{Row: 0, Col: 13, Letter: "L", Value: 1})
+	is.Equal(g.Turns[10], embedTurn{Player: 1, Summary: "challenged off", Score: -52, Cumulative: 90, Rack: g.Turns[9].Rack, Undo: true})
+	last := g.Turns[len(g.Turns)-1]
+	is.Equal(last.Summary, "(CEPRT)")
+	is.Equal(last.Score, int32(18))
+
+	doc.BoardLayout = "Hexagonal"
+	_, err = buildEmbedGame(doc, english(is))
+	is.True(err != nil)
+}
+
+func TestPublicDocument(t *testing.T) {
+	is := is.New(t)
+	doc := loadDocument(is)
+	for _, p := range doc.Players {
+		p.UserId = ""
+	}
+	es := &EmbedService{}
+
+	// Finished games are shown in full.
+	is.True(proto.Equal(es.publicDocument(context.Background(), doc), doc))
+
+	doc.PlayState = ipc.PlayState_PLAYING
+	pub := es.publicDocument(context.Background(), doc)
+	is.Equal(len(pub.Racks), 0)
+	is.Equal(pub.Bag, nil)
+	for _, evt := range pub.Events {
+		is.Equal(len(evt.Rack), 0)
+	}
+	// The stored document is not changed.
+	is.True(len(doc.Events[0].Rack) > 0)
+
+	// Annotations are public while they are being made.
+	doc.Type = ipc.GameType_ANNOTATED
+	is.Equal(es.publicDocument(context.Background(), doc), doc)
+}
+
+func TestGenerateEmbedHTML(t *testing.T) {
+	is := is.New(t)
+	doc := loadDocument(is)
+	doc.Events[0].Note = "</script><script>alert(1)</script>"
+	doc.Players[0].Nickname = "<b>botty</b>"
+	g, err := buildEmbedGame(doc, english(is))
+	is.NoErr(err)
+
+	page, err := generateEmbedHTML(g, parseEmbedOptions(nil), oEmbedURL("https://woogles.io", "https://woogles.io/anno/9zaaSuN5"))
+	is.NoErr(err)
+	is.Equal(strings.Count(page, "</script>"), 2)
+	is.True(!strings.Contains(page, "<b>"))
+	is.True(strings.Contains(page, `<title>Woogles: &lt;b&gt;botty&lt;/b&gt; vs. cesar</title>`))
+	is.True(strings.Contains(page, `href="https://woogles.io/embed/oembed?format=json&amp;url=https%3A%2F%2Fwoogles.io%2Fanno%2F9zaaSuN5"`))
+	is.True(strings.Contains(page, `eventsUrl: "/embed/events/9zaaSuN5",`))
+	is.True(strings.Contains(page, `<div id="woogles-embed-9zaaSuN5"`))
+	is.True(strings.Contains(page, `<script src="/static/js/embed-standalone.js"></script>`))
+	is.True(strings.Contains(page, `width: 600px; height: 700px;`))
+}
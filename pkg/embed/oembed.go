@@ -0,0 +1,145 @@
+package embed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// oEmbedHosts are the hosts that oEmbed URLs may point to, besides the one
+// the request itself was made to.
+var oEmbedHosts = []string{"woogles.io", "www.woogles.io"}
+
+// gamePagePrefixes are the paths of the pages that show a single game.
+var gamePagePrefixes = []string{"/game/", "/anno/", "/embed/game/", EmbedServicePrefix + "generate/"}
+
+var errUnsupportedURL = errors.New("not a woogles game url")
+
+// oEmbedResponse is an oEmbed "rich" response, see https://oembed.com.
+type oEmbedResponse struct {
+	Version      string `json:"version"`
+	Type         string `json:"type"`
+	Title        string `json:"title"`
+	ProviderName string `json:"provider_name"`
+	ProviderURL  string `json:"provider_url"`
+	HTML         string `json:"html"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+}
+
+// oEmbedURL is the oEmbed endpoint on origin for the page at pageURL.
+func oEmbedURL(origin, pageURL string) string {
+	return origin + EmbedServicePrefix + "oembed?" + url.Values{
+		"url":    {pageURL},
+		"format": {"json"},
+	}.Encode()
+}
+
+// oEmbedGameID returns the game shown by u, which must be a Woogles page.
+// host is the host that the oEmbed request was made to.
+func oEmbedGameID(u *url.URL, host string) (string, error) {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", errUnsupportedURL
+	}
+	if u.Host != host && !slices.Contains(oEmbedHosts, u.Host) {
+		return "", errUnsupportedURL
+	}
+	for _, prefix := range gamePagePrefixes {
+		gameID, ok := strings.CutPrefix(u.Path, prefix)
+		if !ok {
+			continue
+		}
+		gameID = strings.TrimSuffix(gameID, "/")
+		if gameID == "" || strings.IndexFunc(gameID, func(c rune) bool {
+			return !strings.ContainsRune(shortuuid.DefaultAlphabet, c)
+		}) != -1 {
+			return "", errUnsupportedURL
+		}
+		return gameID, nil
+	}
+	return "", errUnsupportedURL
+}
+
+// query is the inverse of parseEmbedOptions.
+func (o EmbedOptions) query() url.Values {
+	return url.Values{
+		"width":        {strconv.Itoa(o.Width)},
+		"height":       {strconv.Itoa(o.Height)},
+		"showControls": {strconv.FormatBool(o.ShowControls)},
+		"showScores":   {strconv.FormatBool(o.ShowScores)},
+		"showMoveList": {strconv.FormatBool(o.ShowMoveList)},
+		"showRacks":    {strconv.FormatBool(o.ShowRacks)},
+		"theme":        {o.Theme},
+	}
+}
+
+// newOEmbedResponse embeds the player for the game at u. Options in u's query
+// are kept, and the size is limited to maxWidth and maxHeight if they are
+// positive.
+func newOEmbedResponse(u *url.URL, gameID string, game *embedGame, maxWidth, maxHeight int) *oEmbedResponse {
+	options := parseEmbedOptions(u.Query())
+	if maxWidth > 0 {
+		options.Width = min(options.Width, maxWidth)
+	}
+	if maxHeight > 0 {
+		options.Height = min(options.Height, maxHeight)
+	}
+	origin := u.Scheme + "://" + u.Host
+	src := origin + EmbedServicePrefix + "generate/" + gameID + "?" + options.query().Encode()
+	title := embedTitle(game)
+	return &oEmbedResponse{
+		Version:      "1.0",
+		Type:         "rich",
+		Title:        title,
+		ProviderName: "Woogles",
+		ProviderURL:  origin + "/",
+		HTML: fmt.Sprintf(`<iframe src="%s" width="%d" height="%d" title="%s" style="border: none;" allowfullscreen></iframe>`,
+			html.EscapeString(src), options.Width, options.Height, html.EscapeString(title)),
+		Width:  options.Width,
+		Height: options.Height,
+	}
+}
+
+func (es *EmbedService) oEmbedEndpoint(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if format := query.Get("format"); format != "" && format != "json" {
+		http.Error(w, "only json is supported", http.StatusNotImplemented)
+		return
+	}
+	u, err := url.Parse(query.Get("url"))
+	if err != nil {
+		http.Error(w, "invalid url", http.StatusBadRequest)
+		return
+	}
+	gameID, err := oEmbedGameID(u, r.Host)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	maxWidth, _ := strconv.Atoi(query.Get("maxwidth"))
+	maxHeight, _ := strconv.Atoi(query.Get("maxheight"))
+
+	game, err := es.loadEmbedGame(r.Context(), gameID)
+	if err != nil {
+		log.Err(err).Str("gameID", gameID).Msg("failed to get game document for oembed")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	err = json.NewEncoder(w).Encode(newOEmbedResponse(u, gameID, game, maxWidth, maxHeight))
+	if err != nil {
+		log.Err(err).Str("gameID", gameID).Msg("failed to write oembed response")
+	}
+}
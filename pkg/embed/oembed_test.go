@@ -0,0 +1,61 @@
+package embed
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestOEmbedGameID(t *testing.T) {
+	is := is.New(t)
+	for raw, gameID := range map[string]string{
+		"https://woogles.io/game/7aQ9m3dkXBPXCY5Lw4kMHk":                  "7aQ9m3dkXBPXCY5Lw4kMHk",
+		"https://www.woogles.io/anno/9zaaSuN5/":                           "9zaaSuN5",
+		"http://localhost:8001/embed/generate/9zaaSuN5?showMoveList=true": "9zaaSuN5",
+		"https://woogles.io/embed/game/9zaaSuN5":                          "9zaaSuN5",
+	} {
+		u, err := url.Parse(raw)
+		is.NoErr(err)
+		id, err := oEmbedGameID(u, "localhost:8001")
+		is.NoErr(err)
+		is.Equal(id, gameID)
+	}
+	for _, raw := range []string{
+		"https://evil.example/game/9zaaSuN5",
+		"https://woogles.io.evil.example/game/9zaaSuN5",
+		"ftp://woogles.io/game/9zaaSuN5",
+		"https://woogles.io/game/",
+		"https://woogles.io/game/9zaa/SuN5",
+		"https://woogles.io/puzzle/9zaaSuN5",
+		"woogles.io/game/9zaaSuN5",
+	} {
+		u, err := url.Parse(raw)
+		is.NoErr(err)
+		_, err = oEmbedGameID(u, "localhost:8001")
+		is.Equal(err, errUnsupportedURL)
+	}
+}
+
+func TestEmbedOptionsQuery(t *testing.T) {
+	is := is.New(t)
+	opts := parseEmbedOptions(url.Values{"width": {"320"}, "showRacks": {"false"}, "theme": {"dark"}})
+	is.Equal(opts, EmbedOptions{Width: 320, Height: 700, ShowControls: true, ShowScores: true, Theme: "dark"})
+	is.Equal(parseEmbedOptions(opts.query()), opts)
+}
+
+func TestNewOEmbedResponse(t *testing.T) {
+	is := is.New(t)
+	u, err := url.Parse("https://woogles.io/embed/generate/9zaaSuN5?showMoveList=true&width=800")
+	is.NoErr(err)
+	game := &embedGame{Players: []string{"botty", "cesar"}}
+
+	resp := newOEmbedResponse(u, "9zaaSuN5", game, 640, 0)
+	is.Equal(resp.Type, "rich")
+	is.Equal(resp.Version, "1.0")
+	is.Equal(resp.Title, "Woogles: botty vs. cesar")
+	is.Equal(resp.ProviderURL, "https://woogles.io/")
+	is.Equal(resp.Width, 640)
+	is.Equal(resp.Height, 700)
+	is.Equal(resp.HTML, `<iframe src="https://woogles.io/embed/generate/9zaaSuN5?height=700&amp;showControls=true&amp;showMoveList=true&amp;showRacks=true&amp;showScores=true&amp;theme=light&amp;width=640" width="640" height="700" title="Woogles: botty vs. cesar" style="border: none;" allowfullscreen></iframe>`)
+}
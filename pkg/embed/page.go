@@ -0,0 +1,79 @@
+package embed
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// embedScriptPath is the embed bundle, built from
+// liwords-ui/src/embed/embed-entry.tsx.
+const embedScriptPath = "/static/js/embed-standalone.js"
+
+// embedPageTmpl loads the embed bundle with the game it is given. If the game
+// is still going, the embed follows the event stream, which sends the whole
+// game again after every change.
+var embedPageTmpl = template.Must(template.New("embed").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <title>{{.Title}}</title>
+  <link rel="alternate" type="application/json+oembed" href="{{.OEmbedURL}}" title="{{.Title}}">
+  <style>
+    body { margin: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; }
+  </style>
+</head>
+<body>
+  <div id="{{.ContainerID}}" style="width: {{.Options.Width}}px; height: {{.Options.Height}}px;">
+    <div style="display: flex; align-items: center; justify-content: center; height: 100%; color: #666;">
+      Loading Woogles game...
+    </div>
+  </div>
+  <script>
+    window.WooglesEmbed = {
+      gameId: {{.Game.GameID}},
+      containerId: {{.ContainerID}},
+      game: {{.Game}},
+      eventsUrl: {{.EventsURL}},
+      options: {{.Options}}
+    };
+  </script>
+  <script src="{{.ScriptPath}}"></script>
+</body>
+</html>
+`))
+
+type embedPageData struct {
+	Title       string
+	ContainerID string
+	Options     EmbedOptions
+	Game        *embedGame
+	EventsURL   string
+	OEmbedURL   string
+	ScriptPath  string
+}
+
+func embedTitle(game *embedGame) string {
+	return "Woogles: " + strings.Join(game.Players, " vs. ")
+}
+
+// generateEmbedHTML renders the page for game. oEmbedURL is the oEmbed
+// endpoint for the page, which consumers discover from the page itself.
+func generateEmbedHTML(game *embedGame, options EmbedOptions, oEmbedURL string) (string, error) {
+	var b bytes.Buffer
+	err := embedPageTmpl.Execute(&b, embedPageData{
+		Title:       embedTitle(game),
+		ContainerID: "woogles-embed-" + game.GameID,
+		Options:     options,
+		Game:        game,
+		EventsURL:   EmbedServicePrefix + "events/" + game.GameID,
+		OEmbedURL:   oEmbedURL,
+		ScriptPath:  embedScriptPath,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render embed page: %w", err)
+	}
+	return b.String(), nil
+}
@@ -8,30 +8,55 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/nats-io/nats.go"
 	"github.com/rs/zerolog/log"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/woogles-io/liwords/pkg/config"
+	entityutils "github.com/woogles-io/liwords/pkg/entity/utilities"
+	"github.com/woogles-io/liwords/pkg/gameplay"
+	"github.com/woogles-io/liwords/pkg/mod"
+	"github.com/woogles-io/liwords/pkg/omgwords"
 	"github.com/woogles-io/liwords/pkg/omgwords/stores"
+	"github.com/woogles-io/liwords/pkg/user"
+	"github.com/woogles-io/liwords/pkg/utilities"
 	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
 
 type EmbedService struct {
 	gameDocumentStore *stores.GameDocumentStore
+	gameStore         gameplay.GameStore
+	userStore         user.Store
+	cfg               *config.Config
+	natsConn          *nats.Conn
 }
 
-func NewEmbedService(gds *stores.GameDocumentStore) *EmbedService {
+func NewEmbedService(gds *stores.GameDocumentStore, gs gameplay.GameStore, us user.Store,
+	cfg *config.Config) *EmbedService {
 	return &EmbedService{
 		gameDocumentStore: gds,
+		gameStore:         gs,
+		userStore:         us,
+		cfg:               cfg,
 	}
 }
 
+// SetNatsConn enables live updates. Without it, the event stream only sends
+// the current state of the game.
+func (es *EmbedService) SetNatsConn(nc *nats.Conn) {
+	es.natsConn = nc
+}
+
 type EmbedOptions struct {
 	Width        int    `json:"width"`
 	Height       int    `json:"height"`
 	ShowControls bool   `json:"showControls"`
 	ShowScores   bool   `json:"showScores"`
 	ShowMoveList bool   `json:"showMoveList"`
+	ShowRacks    bool   `json:"showRacks"`
 	Theme        string `json:"theme"`
 }
 
@@ -42,6 +67,7 @@ func parseEmbedOptions(query url.Values) EmbedOptions {
 		ShowControls: true,
 		ShowScores:   true,
 		ShowMoveList: false,
+		ShowRacks:    true,
 		Theme:        "light",
 	}
 
@@ -69,6 +95,10 @@ func parseEmbedOptions(query url.Values) EmbedOptions {
 		options.ShowMoveList = moveList == "true"
 	}
 
+	if racks := query.Get("showRacks"); racks != "" {
+		options.ShowRacks = racks == "true"
+	}
+
 	if theme := query.Get("theme"); theme == "dark" || theme == "light" {
 		options.Theme = theme
 	}
@@ -76,79 +106,77 @@ func parseEmbedOptions(query url.Values) EmbedOptions {
 	return options
 }
 
+// getGameDocument loads an annotated game, or a game played on Woogles, with
+// anything that viewers may not see removed.
 func (es *EmbedService) getGameDocument(ctx context.Context, gameID string) (*ipc.GameDocument, error) {
 	doc, err := es.gameDocumentStore.GetDocument(ctx, gameID)
-	if err != nil {
-		if err == stores.ErrDoesNotExist {
+	if err == stores.ErrDoesNotExist {
+		// Games played on Woogles are not in the document store yet.
+		g, gerr := es.gameStore.Get(ctx, gameID)
+		if gerr != nil {
 			return nil, fmt.Errorf("game does not exist")
 		}
+		g.RLock()
+		doc, err = entityutils.ToGameDocument(g, es.cfg)
+		g.RUnlock()
+	}
+	if err != nil {
 		return nil, err
 	}
+	return es.publicDocument(ctx, doc), nil
+}
 
-	// For now, only support annotated games (like the existing GetGameDocument service)
-	if doc.Type != ipc.GameType_ANNOTATED {
-		return nil, fmt.Errorf("only annotated games are supported for embedding")
+// publicDocument returns the document as anyone watching the game sees it.
+// Annotated games are public as they are being annotated, but the racks of
+// games being played are not.
+func (es *EmbedService) publicDocument(ctx context.Context, doc *ipc.GameDocument) *ipc.GameDocument {
+	if doc.Type == ipc.GameType_ANNOTATED {
+		return doc
 	}
-
-	return doc, nil
+	doc = proto.Clone(doc).(*ipc.GameDocument)
+	if doc.PlayState != ipc.PlayState_GAME_OVER {
+		doc.Racks = nil
+		// The bag gives away the unseen tiles on the racks.
+		doc.Bag = nil
+		for _, evt := range doc.Events {
+			evt.Rack = nil
+			evt.Exchanged = nil
+		}
+	}
+	censored := false
+	for _, p := range doc.Players {
+		if p.UserId == "" || !mod.IsCensorable(ctx, es.userStore, p.UserId) {
+			continue
+		}
+		name := utilities.CensoredUsername
+		if censored {
+			name = utilities.AnotherCensoredUsername
+		}
+		p.UserId, p.RealName, p.Nickname = name, name, name
+		censored = true
+	}
+	return doc
 }
 
-func (es *EmbedService) generateEmbedHTML(gameDoc *ipc.GameDocument, gameID string, options EmbedOptions) (string, error) {
-	// Serialize GameDocument to JSON
-	gameDocJSON, err := protojson.Marshal(gameDoc)
+func (es *EmbedService) loadEmbedGame(ctx context.Context, gameID string) (*embedGame, error) {
+	doc, err := es.getGameDocument(ctx, gameID)
 	if err != nil {
-		return "", fmt.Errorf("failed to serialize game document: %w", err)
+		return nil, err
 	}
-
-	// Serialize options to JSON
-	optionsJSON, err := json.Marshal(options)
+	dist, err := tilemapping.GetDistribution(es.cfg.WGLConfig(), doc.LetterDistribution)
 	if err != nil {
-		return "", fmt.Errorf("failed to serialize options: %w", err)
-	}
-
-	// Generate unique container ID
-	containerID := fmt.Sprintf("woogles-embed-%s", gameID)
-
-	// Build the embed HTML with complete HTML document structure
-	// Use relative path for the embed script so it works with any domain
-	html := fmt.Sprintf(`<!DOCTYPE html>
-<html lang="en">
-<head>
-  <meta charset="UTF-8">
-  <meta name="viewport" content="width=device-width, initial-scale=1.0">
-  <title>Woogles Game Embed</title>
-  <style>
-    body { margin: 0; padding: 20px; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; }
-  </style>
-</head>
-<body>
-  <div id="%s" style="width: %dpx; height: %dpx; border: 1px solid #e0e0e0; border-radius: 8px; overflow: hidden;">
-    <div style="display: flex; align-items: center; justify-content: center; height: 100%%; color: #666;">
-      Loading Woogles game...
-    </div>
-  </div>
-  <script>
-    window.WooglesEmbed = {
-      gameId: %q,
-      containerId: %q,
-      gameDocument: %s,
-      options: %s
-    };
-	console.log("testing something");
-  </script>
-  <script src="/static/js/embed-standalone.js"></script>
-</body>
-</html>`,
-		containerID,
-		options.Width,
-		options.Height,
-		gameID,
-		containerID,
-		string(gameDocJSON),
-		string(optionsJSON),
-	)
-
-	return html, nil
+		return nil, err
+	}
+	return buildEmbedGame(doc, dist)
+}
+
+// requestOrigin is the scheme and host that the request was made to.
+func requestOrigin(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
 }
 
 func (es *EmbedService) generateEmbedEndpoint(w http.ResponseWriter, r *http.Request, gameID string) {
@@ -162,8 +190,7 @@ func (es *EmbedService) generateEmbedEndpoint(w http.ResponseWriter, r *http.Req
 	// Parse embed options from query parameters
 	options := parseEmbedOptions(r.URL.Query())
 
-	// Get the game document
-	gameDoc, err := es.getGameDocument(ctx, gameID)
+	game, err := es.loadEmbedGame(ctx, gameID)
 	if err != nil {
 		log.Err(err).Str("gameID", gameID).Msg("failed to get game document for embed")
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -171,7 +198,9 @@ func (es *EmbedService) generateEmbedEndpoint(w http.ResponseWriter, r *http.Req
 	}
 
 	// Generate the embed HTML
-	embedHTML, err := es.generateEmbedHTML(gameDoc, gameID, options)
+	origin := requestOrigin(r)
+	oembed := oEmbedURL(origin, origin+r.URL.RequestURI())
+	embedHTML, err := generateEmbedHTML(game, options, oembed)
 	if err != nil {
 		log.Err(err).Str("gameID", gameID).Msg("failed to generate embed HTML")
 		http.Error(w, "Failed to generate embed code", http.StatusInternalServerError)
@@ -180,7 +209,12 @@ func (es *EmbedService) generateEmbedEndpoint(w http.ResponseWriter, r *http.Req
 
 	// Set appropriate headers
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	w.Header().Set("Cache-Control", "public, max-age=3600") // Cache for 1 hour
+	if game.Live {
+		w.Header().Set("Cache-Control", "no-cache")
+	} else {
+		w.Header().Set("Cache-Control", "public, max-age=3600") // Cache for 1 hour
+	}
+	w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="alternate"; type="application/json+oembed"`, oembed))
 
 	// Write the HTML response
 	_, err = w.Write([]byte(embedHTML))
@@ -189,6 +223,93 @@ func (es *EmbedService) generateEmbedEndpoint(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// liveSubjects are the NATS subjects that announce changes to a game:
+// annotation edits, and moves in games being played.
+func liveSubjects(gameID string) []string {
+	return []string{
+		"channel." + omgwords.AnnotatedChannelName(gameID),
+		"gametv." + gameID,
+	}
+}
+
+const sseHeartbeat = 20 * time.Second
+
+// eventsEndpoint streams the game as Server-Sent Events. The whole game is
+// sent every time, once at the start and then after every change, until the
+// game is over or the client goes away.
+func (es *EmbedService) eventsEndpoint(w http.ResponseWriter, r *http.Request, gameID string) {
+	ctx := r.Context()
+	game, err := es.loadEmbedGame(ctx, gameID)
+	if err != nil {
+		log.Err(err).Str("gameID", gameID).Msg("failed to get game document for embed events")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	rc.SetWriteDeadline(time.Time{})
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	send := func(game *embedGame) {
+		bts, err := json.Marshal(game)
+		if err != nil {
+			log.Err(err).Str("gameID", gameID).Msg("embed-sse-marshal")
+			return
+		}
+		fmt.Fprintf(w, "data: %s\n\n", bts)
+		flusher.Flush()
+	}
+	send(game)
+	if !game.Live || es.natsConn == nil {
+		return
+	}
+
+	msgs := make(chan *nats.Msg, 16)
+	for _, subject := range liveSubjects(gameID) {
+		sub, err := es.natsConn.ChanSubscribe(subject, msgs)
+		if err != nil {
+			log.Err(err).Str("subject", subject).Msg("embed-sse-subscribe")
+			return
+		}
+		defer sub.Unsubscribe()
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-msgs:
+			// The whole game is reloaded, so a burst of changes only needs
+			// one update.
+			for len(msgs) > 0 {
+				<-msgs
+			}
+			game, err := es.loadEmbedGame(ctx, gameID)
+			if err != nil {
+				log.Err(err).Str("gameID", gameID).Msg("embed-sse-reload")
+				continue
+			}
+			send(game)
+			if !game.Live {
+				return
+			}
+		case <-heartbeat.C:
+			fmt.Fprintf(w, ": heartbeat\n\n")
+			flusher.Flush()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // must end with /
 const EmbedServicePrefix = "/embed/"
 
@@ -197,10 +318,15 @@ func (es *EmbedService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if strings.HasPrefix(r.URL.Path, EmbedServicePrefix) {
 		path := strings.TrimPrefix(r.URL.Path, EmbedServicePrefix)
 
-		// Handle /embed/generate/:gameID
-		if strings.HasPrefix(path, "generate/") {
-			gameID := strings.TrimPrefix(path, "generate/")
+		if gameID, ok := strings.CutPrefix(path, "generate/"); ok {
+			// Handle /embed/generate/:gameID
 			es.generateEmbedEndpoint(w, r, gameID)
+		} else if gameID, ok := strings.CutPrefix(path, "events/"); ok {
+			// Handle /embed/events/:gameID
+			es.eventsEndpoint(w, r, gameID)
+		} else if path == "oembed" {
+			// Handle /embed/oembed?url=...
+			es.oEmbedEndpoint(w, r)
 		} else {
 			http.NotFound(w, r)
 		}
@@ -1,8 +1,15 @@
 package entity
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 
+	wglconfig "github.com/domino14/word-golib/config"
+	"github.com/domino14/word-golib/kwg"
 	"github.com/domino14/word-golib/tilemapping"
 )
 
@@ -25,6 +32,97 @@ func init() {
 	)
 }
 
+// PrivateLexiconPrefix starts the name of every lexicon that a director
+// uploaded. No public lexicon name starts with it, and its word graph is never
+// written to the data path, so the word lookup tools don't see it.
+const PrivateLexiconPrefix = "PRIV_"
+
+// PrivateLexicon is a word list that may only be played in the games of one
+// tournament or club.
+type PrivateLexicon struct {
+	Name               string
+	LetterDistribution string
+	TournamentID       string
+}
+
+var privateLexica sync.Map // name -> PrivateLexicon
+
+// PrivateLexiconLoader loads a private lexicon that isn't registered yet,
+// registering it and caching its word graph. It returns false if there is
+// no such lexicon.
+type PrivateLexiconLoader func(name string) (PrivateLexicon, bool)
+
+var privateLexiconLoader atomic.Pointer[PrivateLexiconLoader]
+
+// RegisterPrivateLexicon makes a private lexicon known to this process. Its
+// word graph has to be cached separately.
+func RegisterPrivateLexicon(lex PrivateLexicon) {
+	privateLexica.Store(lex.Name, lex)
+}
+
+// SetPrivateLexiconLoader sets how private lexica that this process hasn't
+// seen are loaded. Lexica are uploaded through a single process, so the
+// others find out about them only when a game asks for one. A nil loader
+// turns loading off.
+func SetPrivateLexiconLoader(loader PrivateLexiconLoader) {
+	if loader == nil {
+		privateLexiconLoader.Store(nil)
+		return
+	}
+	privateLexiconLoader.Store(&loader)
+}
+
+// IsPrivateLexicon returns whether the name is that of a private lexicon,
+// whether or not it is registered.
+func IsPrivateLexicon(lexicon string) bool {
+	return strings.HasPrefix(lexicon, PrivateLexiconPrefix)
+}
+
+// GetPrivateLexicon returns the private lexicon with this name, loading it
+// if it isn't registered yet.
+func GetPrivateLexicon(lexicon string) (PrivateLexicon, bool) {
+	lex, ok := privateLexica.Load(lexicon)
+	if ok {
+		return lex.(PrivateLexicon), true
+	}
+	if !IsPrivateLexicon(lexicon) {
+		return PrivateLexicon{}, false
+	}
+	loader := privateLexiconLoader.Load()
+	if loader == nil {
+		return PrivateLexicon{}, false
+	}
+	return (*loader)(lexicon)
+}
+
+// ValidateLexiconScope returns an error if the lexicon may not be used for a
+// game in the given tournaments, which are the game's tournament and the club
+// it belongs to, if any. Public lexica may be used anywhere, and private
+// lexica only in the tournament or club they were uploaded for.
+func ValidateLexiconScope(lexicon string, tournamentIDs ...string) error {
+	if !IsPrivateLexicon(lexicon) {
+		return nil
+	}
+	lex, ok := GetPrivateLexicon(lexicon)
+	if !ok {
+		return fmt.Errorf("%s is not a supported lexicon", lexicon)
+	}
+	if lex.TournamentID == "" || !slices.Contains(tournamentIDs, lex.TournamentID) {
+		return errors.New("this lexicon can only be used in the tournament or club it was uploaded for")
+	}
+	return nil
+}
+
+// GetLexiconKWG loads the word graph of a lexicon. The alphabet of a public
+// lexicon is guessed from its name, but a private lexicon's name says
+// nothing, so its registered distribution is used instead.
+func GetLexiconKWG(cfg *wglconfig.Config, lexicon string) (*kwg.KWG, error) {
+	if lex, ok := GetPrivateLexicon(lexicon); ok {
+		return kwg.GetKWG(cfg, lexicon, kwg.WithDistribution(lex.LetterDistribution))
+	}
+	return kwg.GetKWG(cfg, lexicon)
+}
+
 // LetterDistributionForLexicon returns the letter distribution that must be
 // used with the given lexicon. A lexicon's word graph and its letter
 // distribution have to agree on the alphabet: if they don't, consumers walk
@@ -36,6 +134,9 @@ func init() {
 // Because the distribution is fully determined by the lexicon, it should be
 // derived here rather than taken from a client.
 func LetterDistributionForLexicon(lexicon string) (string, error) {
+	if lex, ok := GetPrivateLexicon(lexicon); ok {
+		return lex.LetterDistribution, nil
+	}
 	ld, err := tilemapping.ProbableLetterDistributionName(lexicon)
 	if err != nil {
 		return "", fmt.Errorf("no letter distribution is known for lexicon %s", lexicon)
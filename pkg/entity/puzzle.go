@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+// PuzzleReview is the spaced-repetition schedule of a puzzle that a user
+// is studying.
+type PuzzleReview struct {
+	PuzzleID       string
+	EaseFactor     float64
+	IntervalDays   int
+	Repetitions    int
+	Lapses         int
+	DueAt          time.Time
+	LastReviewedAt time.Time
+}
+
+// PuzzleRushSession is a timed puzzle session. PuzzleID is the puzzle the
+// user is solving now, and is empty once the session is finished.
+type PuzzleRushSession struct {
+	UUID           string
+	UserID         string
+	Lexicon        string
+	Duration       time.Duration
+	StartedAt      time.Time
+	EndsAt         time.Time
+	FinishedAt     time.Time
+	Score          int
+	Strikes        int
+	PuzzleID       string
+	PuzzlePosition int
+}
+
+func (s *PuzzleRushSession) Finished() bool {
+	return !s.FinishedAt.IsZero()
+}
+
+// DailyPuzzleAttempt is a user's one attempt at a daily puzzle. Correct is
+// nil until the user answers.
+type DailyPuzzleAttempt struct {
+	Correct    *bool
+	AnswerKey  string
+	ViewedAt   time.Time
+	AnsweredAt time.Time
+}
+
+func (a *DailyPuzzleAttempt) Answered() bool {
+	return a.Correct != nil
+}
@@ -40,6 +40,20 @@ func NewDefaultRating(lastGameIsNow bool) *SingleRating {
 	}
 }
 
+// A RatingChange is one player's rating change from a rated game.
+type RatingChange struct {
+	UserUUID     string
+	OpponentUUID string
+	GameID       string
+	Before       SingleRating
+	After        SingleRating
+	// The opponent's rating before the game.
+	Opponent SingleRating
+	// The spread from this player's point of view, after any penalty.
+	Spread    int
+	Breakdown glicko.Breakdown
+}
+
 const PuzzleVariant = "puzzle"
 
 func ToVariantKey(lexiconName string, variantName game.Variant, timeControl TimeControl) VariantKey {
@@ -119,6 +119,9 @@ func getSeekRequest(sg *SoughtGame) (*pb.SeekRequest, error) {
 }
 
 func isEnglish(lexicon string) bool {
+	if lex, ok := GetPrivateLexicon(lexicon); ok {
+		return lex.LetterDistribution == "english"
+	}
 	return strings.HasPrefix(lexicon, "NWL") ||
 		strings.HasPrefix(lexicon, "CSW") ||
 		strings.HasPrefix(lexicon, "ECWL")
@@ -176,7 +179,10 @@ func ValidateGameRequest(ctx context.Context, req *pb.GameRequest) error {
 		}
 	}
 
-	if !slices.Contains(AllowedNewGameLexica, req.Lexicon) {
+	// Where a private lexicon may be used is up to the caller; see
+	// ValidateLexiconScope.
+	_, private := GetPrivateLexicon(req.Lexicon)
+	if !private && !slices.Contains(AllowedNewGameLexica, req.Lexicon) {
 		return fmt.Errorf("%s is not a supported lexicon", req.Lexicon)
 	}
 
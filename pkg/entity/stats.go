@@ -206,6 +206,12 @@ const (
 	ONE_PLAYER_PLAYS_EVERY_E_STAT          string = "One Player Plays Every E"
 	MANY_CHALLENGES_STAT                   string = "Many Challenges"
 	FOUR_OR_MORE_CONSECUTIVE_BINGOS_STAT   string = "Four or More Consecutive Bingos"
+	OPENINGS_STAT                          string = "Openings"
+	TILES_KEPT_STAT                        string = "Tiles Kept"
+	LEAVE_VALUES_STAT                      string = "Leave Values"
+	RACKS_BY_BLANKS_AND_SS_STAT            string = "Racks by Blanks and S's"
+	BINGOS_BY_BLANKS_AND_SS_STAT           string = "Bingos by Blanks and S's"
+	SCORES_BY_PHASE_STAT                   string = "Scores by Game Phase"
 )
 
 var StatName_value = map[string]int{
@@ -247,6 +253,12 @@ var StatName_value = map[string]int{
 	ONE_PLAYER_PLAYS_EVERY_E_STAT:          35,
 	MANY_CHALLENGES_STAT:                   36,
 	FOUR_OR_MORE_CONSECUTIVE_BINGOS_STAT:   37,
+	OPENINGS_STAT:                          38,
+	TILES_KEPT_STAT:                        39,
+	LEAVE_VALUES_STAT:                      40,
+	RACKS_BY_BLANKS_AND_SS_STAT:            41,
+	BINGOS_BY_BLANKS_AND_SS_STAT:           42,
+	SCORES_BY_PHASE_STAT:                   43,
 }
 
 func (ld *ListDatum) Value() (driver.Value, error) {
@@ -94,6 +94,9 @@ func InstantiateNewGame(ctx context.Context, gameStore GameStore, cfg *config.Co
 
 	log.Debug().Interface("req-rules", req.Rules).Msg("new-game-rules")
 
+	// Macondo looks for the word graph itself, so a private lexicon has to
+	// be loaded first.
+	entity.GetPrivateLexicon(req.Lexicon)
 	rules, err := game.NewBasicGameRules(
 		cfg.MacondoConfig(), req.Lexicon, req.Rules.BoardLayoutName,
 		req.Rules.LetterDistributionName, game.CrossScoreOnly,
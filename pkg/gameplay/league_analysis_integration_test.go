@@ -12,22 +12,18 @@ import (
 
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 	"github.com/woogles-io/liwords/pkg/gameplay"
+	"github.com/woogles-io/liwords/pkg/mod"
+	"github.com/woogles-io/liwords/pkg/stores"
 	"github.com/woogles-io/liwords/pkg/stores/models"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
 
-// TestLeagueGameEnqueuedForAnalysis verifies that when a league game ends,
-// it gets automatically enqueued for analysis.
-func TestLeagueGameEnqueuedForAnalysis(t *testing.T) {
+// createAnalysisLeague creates a league with an active season and one
+// division.
+func createAnalysisLeague(t *testing.T, ctx context.Context, stores *stores.Stores) (leagueID, divisionID uuid.UUID) {
 	is := is.New(t)
-	ctx := context.Background()
 
-	// Setup: create database and stores
-	pool, stores, _ := recreateDB()
-	defer stores.Disconnect()
-
-	// Create a minimal league setup
-	leagueID := uuid.New()
+	leagueID = uuid.New()
 	_, err := stores.LeagueStore.CreateLeague(ctx, models.CreateLeagueParams{
 		Uuid:        leagueID,
 		Name:        "Test League",
@@ -50,7 +46,7 @@ func TestLeagueGameEnqueuedForAnalysis(t *testing.T) {
 	})
 	is.NoErr(err)
 
-	divisionID := uuid.New()
+	divisionID = uuid.New()
 	_, err = stores.LeagueStore.CreateDivision(ctx, models.CreateDivisionParams{
 		Uuid:           divisionID,
 		SeasonID:       seasonID,
@@ -58,6 +54,20 @@ func TestLeagueGameEnqueuedForAnalysis(t *testing.T) {
 		DivisionName:   pgtype.Text{String: "Division 1", Valid: true},
 	})
 	is.NoErr(err)
+	return leagueID, divisionID
+}
+
+// TestLeagueGameEnqueuedForAnalysis verifies that when a league game ends,
+// it gets automatically enqueued for analysis.
+func TestLeagueGameEnqueuedForAnalysis(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	// Setup: create database and stores
+	pool, stores, _ := recreateDB()
+	defer stores.Disconnect()
+
+	leagueID, divisionID := createAnalysisLeague(t, ctx, stores)
 
 	// Create a minimal game history with players
 	minimalHistory := &macondopb.GameHistory{
@@ -116,6 +126,88 @@ func TestLeagueGameEnqueuedForAnalysis(t *testing.T) {
 	is.True(len(job.ConfigJson) > 0)
 }
 
+// TestLeagueGameCheatSignals follows a rated league game from its end through
+// its analysis to the players' cheat detection signals. League games are
+// correspondence games, and they are the only ones that get analyzed.
+func TestLeagueGameCheatSignals(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	pool, stores, _ := recreateDB()
+	defer stores.Disconnect()
+
+	leagueID, divisionID := createAnalysisLeague(t, ctx, stores)
+
+	histBytes, err := proto.Marshal(&macondopb.GameHistory{
+		Players: []*macondopb.PlayerInfo{
+			{Nickname: "cesar4", RealName: "Cesar", UserId: "xjCWug7EZtDxDHX5fRZTLo"},
+			{Nickname: "Mina", RealName: "Mina", UserId: "qUQkST8CendYA3baHNoPjk"},
+		},
+		FinalScores: []int32{400, 350},
+		Lexicon:     "CSW21",
+		IdAuth:      "xjCWug7EZtDxDHX5fRZTLo",
+	})
+	is.NoErr(err)
+
+	gameID := "cheatgame" + uuid.New().String()[:10]
+	_, err = pool.Exec(ctx, `
+		INSERT INTO games(uuid, player0_id, player1_id, started, game_end_reason, type, game_request,
+			              history, quickdata, timers, league_id, league_division_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		gameID,
+		1,
+		2,
+		true,
+		int(pb.GameEndReason_STANDARD),
+		0,
+		`{"lexicon": "CSW21", "rules": {"boardLayoutName": "CrosswordGame", "letterDistributionName": "english"}, "initialTimeSeconds": 432000, "gameMode": "CORRESPONDENCE", "ratingMode": "RATED"}`,
+		histBytes,
+		`{}`,
+		`{"mo": 0, "tr": [432000000, 432000000], "ts": 1700000000000}`,
+		leagueID,
+		divisionID,
+	)
+	is.NoErr(err)
+
+	g, err := stores.GameStore.Get(ctx, gameID)
+	is.NoErr(err)
+	is.NoErr(gameplay.PerformEndgameDuties(ctx, g, stores))
+
+	// The game is queued, a worker analyzes it, and the analysis service
+	// records the signals from the stored game.
+	job, err := stores.Queries.GetJobByGameID(ctx, g.GameID())
+	is.NoErr(err)
+	is.Equal(job.Status, "pending")
+
+	g, err = stores.GameStore.Get(ctx, g.GameID())
+	is.NoErr(err)
+	is.Equal(len(g.Quickdata.OriginalRatings), 2)
+	result := &macondopb.GameAnalysisResult{
+		PlayerSummaries: []*macondopb.PlayerSummary{
+			{PlayerName: "cesar4", TurnsPlayed: 12, OptimalMoves: 9, MistakeIndex: 1.5, EstimatedElo: 1900},
+			{PlayerName: "Mina", TurnsPlayed: 11, OptimalMoves: 4, MistakeIndex: 7.25, EstimatedElo: 1450},
+		},
+	}
+	err = mod.RecordCheatSignals(ctx, stores.UserStore, stores.NotorietyStore, stores.Queries, g, result)
+	is.NoErr(err)
+
+	for pidx, userUUID := range []string{"xjCWug7EZtDxDHX5fRZTLo", "qUQkST8CendYA3baHNoPjk"} {
+		signals, err := stores.Queries.GetRecentCheatSignals(ctx, models.GetRecentCheatSignalsParams{
+			UserUuid: userUUID,
+			Lim:      10,
+		})
+		is.NoErr(err)
+		is.Equal(len(signals), 1)
+		is.Equal(signals[0].GameUuid, g.GameID())
+		is.Equal(signals[0].Turns, result.PlayerSummaries[pidx].TurnsPlayed)
+		is.Equal(signals[0].OptimalMoves, result.PlayerSummaries[pidx].OptimalMoves)
+		is.Equal(signals[0].MistakeIndex, result.PlayerSummaries[pidx].MistakeIndex)
+		is.Equal(signals[0].Rating, g.Quickdata.OriginalRatings[pidx])
+		// Correspondence think times aren't a signal.
+		is.Equal(signals[0].MoveTimeCv, 0.0)
+	}
+}
+
 // TestNonLeagueGameNotEnqueued verifies that regular (non-league) games
 // do NOT get enqueued for analysis.
 func TestNonLeagueGameNotEnqueued(t *testing.T) {
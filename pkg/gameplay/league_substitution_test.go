@@ -0,0 +1,138 @@
+package gameplay_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/matryer/is"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/gameplay"
+	"github.com/woogles-io/liwords/pkg/league"
+	"github.com/woogles-io/liwords/pkg/stores"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// flakyGameCreator creates games the way the server does, but fails to start
+// them while fail is set.
+type flakyGameCreator struct {
+	stores *stores.Stores
+	cfg    *config.Config
+	fail   bool
+}
+
+func (c *flakyGameCreator) InstantiateNewGame(ctx context.Context, users [2]*entity.User,
+	req *pb.GameRequest, tdata *entity.TournamentData) (*entity.Game, error) {
+	return gameplay.InstantiateNewGame(ctx, c.stores.GameStore, c.cfg, users, req, tdata)
+}
+
+func (c *flakyGameCreator) StartGame(ctx context.Context, game *entity.Game) error {
+	if c.fail {
+		return errors.New("could not start game")
+	}
+	return gameplay.StartGame(ctx, c.stores, nil, game)
+}
+
+// TestSubstitutePlayerResumes checks that a substitution whose replacement
+// game failed to start can be finished by substituting the same players
+// again, without replacing any game twice.
+func TestSubstitutePlayerResumes(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+
+	_, stores, cfg := recreateDB()
+	defer stores.Disconnect()
+
+	leagueID, divisionID := createAnalysisLeague(t, ctx, stores)
+	division, err := stores.LeagueStore.GetDivision(ctx, divisionID)
+	is.NoErr(err)
+	seasonID := division.SeasonID
+
+	cesar, err := stores.UserStore.Get(ctx, "cesar4")
+	is.NoErr(err)
+	mina, err := stores.UserStore.Get(ctx, "Mina")
+	is.NoErr(err)
+	jesse, err := stores.UserStore.Get(ctx, "jesse")
+	is.NoErr(err)
+
+	for _, u := range []*entity.User{cesar, mina} {
+		_, err = stores.LeagueStore.RegisterPlayer(ctx, models.RegisterPlayerParams{
+			UserID:           int32(u.ID),
+			SeasonID:         seasonID,
+			DivisionID:       pgtype.UUID{Bytes: divisionID, Valid: true},
+			RegistrationDate: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			Status:           pgtype.Text{String: "REGISTERED", Valid: true},
+		})
+		is.NoErr(err)
+	}
+	err = stores.LeagueStore.AddLeagueSubstitute(ctx, models.AddLeagueSubstituteParams{
+		LeagueID: leagueID,
+		UserID:   int32(jesse.ID),
+	})
+	is.NoErr(err)
+
+	creator := &flakyGameCreator{stores: stores, cfg: cfg}
+	g, err := creator.InstantiateNewGame(ctx, [2]*entity.User{cesar, mina},
+		proto.Clone(gameReq).(*pb.GameRequest), nil)
+	is.NoErr(err)
+	g.LeagueID = &leagueID
+	g.SeasonID = &seasonID
+	g.LeagueDivisionID = &divisionID
+	is.NoErr(creator.StartGame(ctx, g))
+
+	mdm := league.NewManualDivisionManager(stores)
+	creator.fail = true
+	_, err = mdm.SubstitutePlayer(ctx, creator, seasonID, mina.UUID, jesse.UUID,
+		pb.SubstituteResultHandling_SUB_KEEP_RESULTS)
+	is.True(err != nil)
+
+	// The substitution stands and the game is aborted, but its replacement
+	// has not started yet.
+	substitution, err := stores.LeagueStore.GetSubstitution(ctx, models.GetSubstitutionParams{
+		SeasonID:      seasonID,
+		DroppedUserID: int32(mina.ID),
+	})
+	is.NoErr(err)
+	pending, err := stores.LeagueStore.GetPendingSubstitutionGames(ctx, substitution.ID)
+	is.NoErr(err)
+	is.Equal(len(pending), 1)
+	is.Equal(pending[0].GameUuid, g.GameID())
+	is.True(pending[0].ReplacementGameUuid.Valid)
+	old, err := stores.GameStore.Get(ctx, g.GameID())
+	is.NoErr(err)
+	is.Equal(old.GameEndReason, pb.GameEndReason_ABORTED)
+	replacementID := pending[0].ReplacementGameUuid.String
+
+	creator.fail = false
+	result, err := mdm.SubstitutePlayer(ctx, creator, seasonID, mina.UUID, jesse.UUID,
+		pb.SubstituteResultHandling_SUB_KEEP_RESULTS)
+	is.NoErr(err)
+	is.Equal(result.GamesReplaced, 1)
+	pending, err = stores.LeagueStore.GetPendingSubstitutionGames(ctx, substitution.ID)
+	is.NoErr(err)
+	is.Equal(len(pending), 0)
+
+	replacement, err := stores.GameStore.Get(ctx, replacementID)
+	is.NoErr(err)
+	is.True(replacement.Started)
+	is.Equal(replacement.Quickdata.PlayerInfo[0].UserId, cesar.UUID)
+	is.Equal(replacement.Quickdata.PlayerInfo[1].UserId, jesse.UUID)
+	is.Equal(*replacement.LeagueDivisionID, divisionID)
+
+	// Once every game is replaced, substituting again changes nothing
+	result, err = mdm.SubstitutePlayer(ctx, creator, seasonID, mina.UUID, jesse.UUID,
+		pb.SubstituteResultHandling_SUB_KEEP_RESULTS)
+	is.NoErr(err)
+	is.Equal(result.GamesReplaced, 0)
+
+	// A different substitute for the same player is refused
+	_, err = mdm.SubstitutePlayer(ctx, creator, seasonID, mina.UUID, cesar.UUID,
+		pb.SubstituteResultHandling_SUB_KEEP_RESULTS)
+	is.True(errors.Is(err, league.ErrInvalidSubstitution))
+}
@@ -76,12 +76,12 @@ func Rate(ctx context.Context, scores map[string]int32, g *entity.Game,
 		rat1.LastGameTimestamp = now
 	}
 	// Rate for each player separately.
-	p0rat, p0rd, p0v := glicko.Rate(
+	p0rat, p0rd, p0v, p0breakdown := glicko.RateWithBreakdown(
 		rat0.Rating, rat0.RatingDeviation, rat0.Volatility,
 		rat1.Rating, rat1.RatingDeviation,
 		spread, int(now-rat0.LastGameTimestamp),
 	)
-	p1rat, p1rd, p1v := glicko.Rate(
+	p1rat, p1rd, p1v, p1breakdown := glicko.RateWithBreakdown(
 		rat1.Rating, rat1.RatingDeviation, rat1.Volatility,
 		rat0.Rating, rat0.RatingDeviation,
 		-spread, int(now-rat1.LastGameTimestamp),
@@ -90,20 +90,41 @@ func Rate(ctx context.Context, scores map[string]int32, g *entity.Game,
 	g.Quickdata.OriginalRatings = []float64{rat0.Rating, rat1.Rating}
 	g.Quickdata.NewRatings = []float64{p0rat, p1rat}
 
-	p0SingleRating := &entity.SingleRating{
+	p0SingleRating := entity.SingleRating{
 		Rating:            p0rat,
 		RatingDeviation:   p0rd,
 		Volatility:        p0v,
 		LastGameTimestamp: now,
 	}
-	p1SingleRating := &entity.SingleRating{
+	p1SingleRating := entity.SingleRating{
 		Rating:            p1rat,
 		RatingDeviation:   p1rd,
 		Volatility:        p1v,
 		LastGameTimestamp: now,
 	}
 
-	err = userStore.SetRatings(ctx, users[0].UUID, users[1].UUID, ratingKey, p0SingleRating, p1SingleRating)
+	err = userStore.SetRatingsWithHistory(ctx, ratingKey, []*entity.RatingChange{
+		{
+			UserUUID:     users[0].UUID,
+			OpponentUUID: users[1].UUID,
+			GameID:       g.GameID(),
+			Before:       *rat0,
+			After:        p0SingleRating,
+			Opponent:     *rat1,
+			Spread:       spread,
+			Breakdown:    p0breakdown,
+		},
+		{
+			UserUUID:     users[1].UUID,
+			OpponentUUID: users[0].UUID,
+			GameID:       g.GameID(),
+			Before:       *rat1,
+			After:        p1SingleRating,
+			Opponent:     *rat0,
+			Spread:       -spread,
+			Breakdown:    p1breakdown,
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
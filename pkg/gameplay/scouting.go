@@ -0,0 +1,305 @@
+package gameplay
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/woogles-io/liwords/pkg/apiserver"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/mod"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/game_service"
+)
+
+const (
+	DefaultScoutingRecentGames = 10
+	MaxScoutingRecentGames     = 100
+	// The number of most common openings in a scouting summary.
+	scoutingOpenings = 5
+)
+
+// A scoutedGame is a finished rated game from one player's point of view.
+type scoutedGame struct {
+	id            string
+	score         int32
+	opponentScore int32
+	won           *bool
+	playedAt      *timestamppb.Timestamp
+	req           entity.GameRequest
+}
+
+// GetScoutingReport gets a summary of a user's rated games, and their
+// head-to-head record against an opponent if one is given.
+func (gs *GameService) GetScoutingReport(ctx context.Context, req *connect.Request[pb.ScoutingReportRequest],
+) (*connect.Response[pb.ScoutingReportResponse], error) {
+	numRecent := int(req.Msg.NumRecent)
+	if numRecent <= 0 {
+		numRecent = DefaultScoutingRecentGames
+	} else if numRecent > MaxScoutingRecentGames {
+		return nil, apiserver.InvalidArg("too many recent games requested")
+	}
+
+	u, err := gs.userStore.Get(ctx, req.Msg.Username)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	var opp *entity.User
+	if req.Msg.OpponentUsername != "" {
+		opp, err = gs.userStore.Get(ctx, req.Msg.OpponentUsername)
+		if err != nil {
+			return nil, apiserver.InvalidArg(err.Error())
+		}
+		if opp.UUID == u.UUID {
+			return nil, apiserver.InvalidArg("cannot scout a user against themselves")
+		}
+	}
+	if mod.IsCensorable(ctx, gs.userStore, u.UUID) ||
+		(opp != nil && mod.IsCensorable(ctx, gs.userStore, opp.UUID)) {
+		return connect.NewResponse(&pb.ScoutingReportResponse{}), nil
+	}
+
+	recentRows, err := gs.queries.GetRecentRatedGames(ctx, models.GetRecentRatedGamesParams{
+		PlayerID: int32(u.ID),
+		NumGames: int32(numRecent),
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	recent := make([]scoutedGame, len(recentRows))
+	for i, r := range recentRows {
+		recent[i] = toScoutedGame(r.GameUuid, r.Score, r.OpponentScore, r.Won, r.CreatedAt, r.GameRequest)
+	}
+	var profileStats *entity.ProfileStats
+	if u.Profile != nil {
+		profileStats = &u.Profile.Stats
+	}
+	summary := scoutingSummary(profileStats)
+	summary.Recent = gameRecord(recent)
+	resp := &pb.ScoutingReportResponse{Summary: summary}
+
+	if opp != nil {
+		h2hRows, err := gs.queries.GetHeadToHeadGames(ctx, models.GetHeadToHeadGamesParams{
+			PlayerID:   int32(u.ID),
+			OpponentID: int32(opp.ID),
+		})
+		if err != nil {
+			return nil, apiserver.InternalErr(err)
+		}
+		games := make([]scoutedGame, len(h2hRows))
+		for i, r := range h2hRows {
+			games[i] = toScoutedGame(r.GameUuid, r.Score, r.OpponentScore, r.Won, r.CreatedAt, r.GameRequest)
+		}
+		resp.HeadToHead = headToHead(games, numRecent)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func toScoutedGame(id string, score, oppScore int32, won pgtype.Bool, playedAt pgtype.Timestamptz,
+	req entity.GameRequest) scoutedGame {
+
+	g := scoutedGame{id: id, score: score, opponentScore: oppScore, req: req}
+	if won.Valid {
+		w := won.Bool
+		g.won = &w
+	}
+	if playedAt.Valid {
+		g.playedAt = timestamppb.New(playedAt.Time)
+	}
+	return g
+}
+
+// headToHead summarizes the games between two players. The games must be
+// sorted from most to least recent.
+func headToHead(games []scoutedGame, numRecent int) *pb.HeadToHead {
+	h2h := &pb.HeadToHead{
+		Lifetime: gameRecord(games),
+		Recent:   gameRecord(games[:min(numRecent, len(games))]),
+	}
+
+	byTimeControl := map[string][]scoutedGame{}
+	for _, g := range games {
+		tc := "unknown"
+		if g.req.GameRequest != nil {
+			if timefmt, _, err := entity.VariantFromGameReq(g.req.GameRequest); err == nil {
+				tc = string(timefmt)
+			}
+		}
+		byTimeControl[tc] = append(byTimeControl[tc], g)
+	}
+	for tc, tcGames := range byTimeControl {
+		h2h.TimeControls = append(h2h.TimeControls, &pb.TimeControlRecord{
+			TimeControl: tc,
+			Record:      gameRecord(tcGames),
+		})
+	}
+	sort.Slice(h2h.TimeControls, func(i, j int) bool {
+		if h2h.TimeControls[i].Record.Games != h2h.TimeControls[j].Record.Games {
+			return h2h.TimeControls[i].Record.Games > h2h.TimeControls[j].Record.Games
+		}
+		return h2h.TimeControls[i].TimeControl < h2h.TimeControls[j].TimeControl
+	})
+
+	h2h.NotableGames = notableGames(games)
+	return h2h
+}
+
+func gameRecord(games []scoutedGame) *pb.GameRecord {
+	rec := &pb.GameRecord{Games: int32(len(games))}
+	if len(games) == 0 {
+		return rec
+	}
+	var score, oppScore int32
+	for _, g := range games {
+		switch {
+		case g.won == nil:
+			rec.Draws++
+		case *g.won:
+			rec.Wins++
+		default:
+			rec.Losses++
+		}
+		score += g.score
+		oppScore += g.opponentScore
+	}
+	rec.Spread = score - oppScore
+	rec.AverageScore = float64(score) / float64(len(games))
+	rec.AverageOpponentScore = float64(oppScore) / float64(len(games))
+	return rec
+}
+
+// notableGames picks out the highest scoring, lowest scoring and closest
+// games, and the biggest win and loss. When several games tie, the most
+// recent one is picked.
+func notableGames(games []scoutedGame) []*pb.NotableGame {
+	if len(games) == 0 {
+		return nil
+	}
+	spread := func(g scoutedGame) int32 { return g.score - g.opponentScore }
+	abs := func(n int32) int32 {
+		if n < 0 {
+			return -n
+		}
+		return n
+	}
+	pickers := []struct {
+		reason string
+		better func(g, best scoutedGame) bool
+		valid  func(g scoutedGame) bool
+	}{
+		{"highest_score", func(g, best scoutedGame) bool { return g.score > best.score }, nil},
+		{"lowest_score", func(g, best scoutedGame) bool { return g.score < best.score }, nil},
+		{"biggest_win", func(g, best scoutedGame) bool { return spread(g) > spread(best) },
+			func(g scoutedGame) bool { return spread(g) > 0 }},
+		{"biggest_loss", func(g, best scoutedGame) bool { return spread(g) < spread(best) },
+			func(g scoutedGame) bool { return spread(g) < 0 }},
+		{"closest_game", func(g, best scoutedGame) bool { return abs(spread(g)) < abs(spread(best)) }, nil},
+	}
+
+	var notable []*pb.NotableGame
+	for _, p := range pickers {
+		var best *scoutedGame
+		for i := range games {
+			if p.valid != nil && !p.valid(games[i]) {
+				continue
+			}
+			if best == nil || p.better(games[i], *best) {
+				best = &games[i]
+			}
+		}
+		if best == nil {
+			continue
+		}
+		notable = append(notable, &pb.NotableGame{
+			GameId:        best.id,
+			Reason:        p.reason,
+			Score:         best.score,
+			OpponentScore: best.opponentScore,
+			PlayedAt:      best.playedAt,
+		})
+	}
+	return notable
+}
+
+// scoutingSummary summarizes a user's profile stats across all variants.
+// Profile stats only ever have the user's own data, as player one.
+func scoutingSummary(profileStats *entity.ProfileStats) *pb.ScoutingSummary {
+	summary := &pb.ScoutingSummary{}
+	if profileStats == nil {
+		return summary
+	}
+	totals := map[string]int{}
+	lengths := map[string]int{}
+	positions := map[string]int{}
+	for _, s := range profileStats.Data {
+		if s == nil {
+			continue
+		}
+		for name, item := range s.PlayerOneData {
+			if item == nil {
+				continue
+			}
+			totals[name] += item.Total
+			if name != entity.OPENINGS_STAT {
+				continue
+			}
+			for key, count := range item.Subitems {
+				switch {
+				case strings.HasPrefix(key, "length:"):
+					lengths[strings.TrimPrefix(key, "length:")] += count
+				case strings.HasPrefix(key, "position:"):
+					positions[strings.TrimPrefix(key, "position:")] += count
+				case key == "exchange" || key == "pass":
+					lengths[key] += count
+				case key == "points":
+					totals["opening points"] += count
+				}
+			}
+		}
+	}
+
+	summary.Games = int32(totals[entity.GAMES_STAT])
+	summary.OpeningLengths = mostCommon(lengths, scoutingOpenings)
+	summary.OpeningPositions = mostCommon(positions, scoutingOpenings)
+	if scoredOpenings := sumCounts(positions); scoredOpenings > 0 {
+		summary.AverageOpeningScore = float64(totals["opening points"]) / float64(scoredOpenings)
+	}
+	if totals[entity.TURNS_STAT] > 0 {
+		summary.ExchangeRate = float64(totals[entity.EXCHANGES_STAT]) / float64(totals[entity.TURNS_STAT])
+	}
+	summary.ChallengesWon = int32(totals[entity.CHALLENGES_WON_STAT])
+	summary.ChallengesLost = int32(totals[entity.CHALLENGES_LOST_STAT])
+	summary.PhoniesChallengedOff = int32(totals[entity.CHALLENGED_PHONIES_STAT])
+	summary.PhoniesPlayed = summary.PhoniesChallengedOff + int32(totals[entity.UNCHALLENGED_PHONIES_STAT])
+	summary.ValidPlaysChallenged = int32(totals[entity.VALID_PLAYS_THAT_WERE_CHALLENGED_STAT])
+	return summary
+}
+
+func sumCounts(counts map[string]int) int {
+	total := 0
+	for _, c := range counts {
+		total += c
+	}
+	return total
+}
+
+// mostCommon returns up to n of the counts, most common first.
+func mostCommon(counts map[string]int, n int) []*pb.OpeningCount {
+	oc := make([]*pb.OpeningCount, 0, len(counts))
+	for opening, count := range counts {
+		if count > 0 {
+			oc = append(oc, &pb.OpeningCount{Opening: opening, Count: int32(count)})
+		}
+	}
+	sort.Slice(oc, func(i, j int) bool {
+		if oc[i].Count != oc[j].Count {
+			return oc[i].Count > oc[j].Count
+		}
+		return oc[i].Opening < oc[j].Opening
+	})
+	return oc[:min(n, len(oc))]
+}
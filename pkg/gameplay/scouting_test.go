@@ -0,0 +1,103 @@
+package gameplay
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+func scouted(id string, score, oppScore int32, initialTime int32) scoutedGame {
+	g := scoutedGame{id: id, score: score, opponentScore: oppScore,
+		req: entity.GameRequest{GameRequest: &ipc.GameRequest{
+			Rules:              &ipc.GameRules{VariantName: "classic"},
+			InitialTimeSeconds: initialTime,
+		}}}
+	if score != oppScore {
+		won := score > oppScore
+		g.won = &won
+	}
+	return g
+}
+
+func TestHeadToHead(t *testing.T) {
+	is := is.New(t)
+
+	// Most recent first.
+	games := []scoutedGame{
+		scouted("g5", 400, 380, 20*60),
+		scouted("g4", 350, 350, 20*60),
+		scouted("g3", 300, 450, 3*60),
+		scouted("g2", 520, 310, 20*60),
+		scouted("g1", 410, 400, 3*60),
+	}
+	h2h := headToHead(games, 2)
+
+	is.Equal(h2h.Lifetime.Games, int32(5))
+	is.Equal(h2h.Lifetime.Wins, int32(3))
+	is.Equal(h2h.Lifetime.Losses, int32(1))
+	is.Equal(h2h.Lifetime.Draws, int32(1))
+	is.Equal(h2h.Lifetime.Spread, int32(90))
+	is.Equal(h2h.Lifetime.AverageScore, 396.0)
+
+	is.Equal(h2h.Recent.Games, int32(2))
+	is.Equal(h2h.Recent.Wins, int32(1))
+	is.Equal(h2h.Recent.Draws, int32(1))
+
+	is.Equal(len(h2h.TimeControls), 2)
+	is.Equal(h2h.TimeControls[0].TimeControl, "regular")
+	is.Equal(h2h.TimeControls[0].Record.Games, int32(3))
+	is.Equal(h2h.TimeControls[1].TimeControl, "blitz")
+	is.Equal(h2h.TimeControls[1].Record.Spread, int32(-140))
+
+	notable := map[string]string{}
+	for _, n := range h2h.NotableGames {
+		notable[n.Reason] = n.GameId
+	}
+	is.Equal(notable, map[string]string{
+		"highest_score": "g2",
+		"lowest_score":  "g3",
+		"biggest_win":   "g2",
+		"biggest_loss":  "g3",
+		"closest_game":  "g4",
+	})
+}
+
+func TestScoutingSummary(t *testing.T) {
+	is := is.New(t)
+
+	variantStats := func(games, turns, exchanges int, openings map[string]int) *entity.Stats {
+		return &entity.Stats{PlayerOneId: "u1", PlayerOneData: map[string]*entity.StatItem{
+			entity.GAMES_STAT:                {Total: games},
+			entity.TURNS_STAT:                {Total: turns},
+			entity.EXCHANGES_STAT:            {Total: exchanges},
+			entity.CHALLENGES_WON_STAT:       {Total: 2},
+			entity.CHALLENGED_PHONIES_STAT:   {Total: 1},
+			entity.UNCHALLENGED_PHONIES_STAT: {Total: 3},
+			entity.OPENINGS_STAT:             {Total: 3, Subitems: openings},
+		}}
+	}
+	summary := scoutingSummary(&entity.ProfileStats{Data: map[entity.VariantKey]*entity.Stats{
+		"NWL23.classic.rapid": variantStats(4, 40, 2, map[string]int{
+			"length:5": 2, "position:8D": 1, "position:8H": 1, "points": 60, "exchange": 1}),
+		"CSW24.classic.blitz": variantStats(2, 40, 6, map[string]int{
+			"length:4": 1, "length:5": 1, "position:8D": 2, "points": 40, "pass": 1}),
+	}})
+
+	is.Equal(summary.Games, int32(6))
+	is.Equal(summary.ExchangeRate, 0.1)
+	is.Equal(summary.ChallengesWon, int32(4))
+	is.Equal(summary.PhoniesChallengedOff, int32(2))
+	is.Equal(summary.PhoniesPlayed, int32(8))
+	is.Equal(summary.AverageOpeningScore, 25.0)
+
+	is.Equal(summary.OpeningLengths[0].Opening, "5")
+	is.Equal(summary.OpeningLengths[0].Count, int32(3))
+	is.Equal(len(summary.OpeningLengths), 4)
+	is.Equal(summary.OpeningPositions[0].Opening, "8D")
+	is.Equal(summary.OpeningPositions[0].Count, int32(3))
+
+	is.Equal(scoutingSummary(nil).Games, int32(0))
+}
@@ -21,6 +21,26 @@ const (
 	iterationMaximum            int     = 1000
 )
 
+// A Breakdown holds the intermediate terms of a rating calculation, to
+// explain it. The rating change is the Multiplier times the difference
+// between the ActualResult and the ExpectedResult.
+type Breakdown struct {
+	// ExpectedResult is the result the player was expected to get, from 0
+	// for a certain loss to 1 for a certain win.
+	ExpectedResult float64
+	// ActualResult is what the spread was worth on the same scale. Any win
+	// is worth at least 0.5 plus the WinBoost.
+	ActualResult float64
+	WinBoost     float64
+	// InactiveRatingDeviation is the player's rating deviation once it was
+	// raised for the time since their last game.
+	InactiveRatingDeviation float64
+	// Multiplier is the rating change a whole point of result is worth. It
+	// grows with the player's rating deviation and shrinks with their
+	// opponent's.
+	Multiplier float64
+}
+
 func Rate(
 	playerUnscaledRating float64,
 	playerUnscaledRatingDeviation float64,
@@ -30,6 +50,24 @@ func Rate(
 	spread int,
 	secondsSinceLastGame int) (float64, float64, float64) {
 
+	rating, ratingDeviation, volatility, _ := RateWithBreakdown(
+		playerUnscaledRating, playerUnscaledRatingDeviation, playerVolatility,
+		opponentUnscaledRating, opponentUnscaledRatingDeviation,
+		spread, secondsSinceLastGame)
+	return rating, ratingDeviation, volatility
+}
+
+// RateWithBreakdown rates like Rate, and also returns the terms that
+// explain the rating change.
+func RateWithBreakdown(
+	playerUnscaledRating float64,
+	playerUnscaledRatingDeviation float64,
+	playerVolatility float64,
+	opponentUnscaledRating float64,
+	opponentUnscaledRatingDeviation float64,
+	spread int,
+	secondsSinceLastGame int) (float64, float64, float64, Breakdown) {
+
 	// Step 1 of the Glicko-225 algorithm was performed upon account creation
 	// Step 2 of the Glicko-225 algorithm
 	playerRating := convertRatingToGlicko225(playerUnscaledRating)
@@ -88,6 +126,7 @@ func Rate(
 
 	// Step 6 of the Glicko-225 algorithm
 	newPlayerRatingDeviation := math.Sqrt(rdSquared + ((float64(secondsSinceLastGame) / float64(RatingPeriodinSeconds)) * math.Pow(newPlayerVolatility, 2)))
+	inactiveRatingDeviation := newPlayerRatingDeviation
 
 	// Step 7 of the Glicko-225 algorithm
 	newPlayerRatingDeviation = 1 / math.Sqrt((1/math.Pow(newPlayerRatingDeviation, 2))+1/variance)
@@ -96,9 +135,20 @@ func Rate(
 	// Step 8 of the Glicko-225 algorithm
 	newPlayerRating = convertRatingFromGlicko225(newPlayerRating)
 	newPlayerRatingDeviation = convertRatingDeviationFromGlicko225(newPlayerRatingDeviation)
+	breakdown := Breakdown{
+		ExpectedResult:          expectedValue,
+		ActualResult:            actualResult(awb, spread),
+		WinBoost:                awb,
+		InactiveRatingDeviation: convertRatingDeviationFromGlicko225(inactiveRatingDeviation),
+		// improvement is the opponent's adjusted rating deviation times
+		// the difference between the results. The rating deviation is
+		// already unscaled here.
+		Multiplier: newPlayerRatingDeviation * newPlayerRatingDeviation /
+			GlickoToGlicko225Conversion * opponentAdjustedRatingDeviation,
+	}
 	newPlayerRatingDeviation = math.Max(math.Min(newPlayerRatingDeviation, float64(MaximumRatingDeviation)), float64(MinimumRatingDeviation))
 
-	return newPlayerRating, newPlayerRatingDeviation, newPlayerVolatility
+	return newPlayerRating, newPlayerRatingDeviation, newPlayerVolatility, breakdown
 }
 
 func convertRatingToGlicko225(unscaledRating float64) float64 {
@@ -126,7 +176,11 @@ func variance(opponentAdjustedRatingDeviation float64, expectedValue float64) fl
 }
 
 func improvement(opponentAdjustedRatingDeviation float64, awb float64, expectedValue float64, spread int) float64 {
-	return opponentAdjustedRatingDeviation * ((boundedResult(float64(spread)/((2*float64(SpreadScaling))+kfunction(awb))+(float64(sign(spread))*awb)) + 0.5) - expectedValue)
+	return opponentAdjustedRatingDeviation * (actualResult(awb, spread) - expectedValue)
+}
+
+func actualResult(awb float64, spread int) float64 {
+	return boundedResult(float64(spread)/((2*float64(SpreadScaling))+kfunction(awb))+(float64(sign(spread))*awb)) + 0.5
 }
 
 func boundedResult(result float64) float64 {
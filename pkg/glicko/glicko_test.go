@@ -106,6 +106,37 @@ func TestRatingLoss(t *testing.T) {
 	is.True(volatility < InitialVolatility)
 }
 
+func TestRatingBreakdown(t *testing.T) {
+
+	is := is.New(t)
+
+	for _, spread := range []int{-300, -40, 0, 15, 250} {
+		rating, deviation, volatility, breakdown :=
+			RateWithBreakdown(
+				1600,
+				120,
+				InitialVolatility,
+				1450,
+				90,
+				spread,
+				RatingPeriodinSeconds)
+		r, d, v := Rate(1600, 120, InitialVolatility, 1450, 90, spread, RatingPeriodinSeconds)
+		is.Equal(rating, r)
+		is.Equal(deviation, d)
+		is.Equal(volatility, v)
+
+		// The breakdown explains the rating change.
+		is.True(withinEpsilon(rating-1600, breakdown.Multiplier*(breakdown.ActualResult-breakdown.ExpectedResult)))
+		is.True(breakdown.ExpectedResult > 0.5)
+		is.True(breakdown.InactiveRatingDeviation > 120)
+		if spread > 0 {
+			is.True(breakdown.ActualResult >= 0.5+breakdown.WinBoost)
+		} else if spread == 0 {
+			is.Equal(breakdown.ActualResult, 0.5)
+		}
+	}
+}
+
 func TestAverageRatingChange(t *testing.T) {
 
 	spreads := []int{SpreadScaling, SpreadScaling / 2, SpreadScaling / 4, 10, 1}
@@ -0,0 +1,285 @@
+package integrations
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/apiserver"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/mod"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/user"
+	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
+)
+
+// Login with external identity providers lives under
+// /integrations/login/{provider} and /integrations/login/{provider}/callback.
+const loginPathPrefix = "login/"
+
+const (
+	loginStateCookie = "oauth_login"
+	loginStateMaxAge = 10 * time.Minute
+	// oauthSignupPath is the frontend page where a user with a brand new
+	// external identity picks a username. It receives a signup token that
+	// must be passed back to RegistrationService.CompleteOAuthSignup.
+	oauthSignupPath = "/oauth/signup"
+)
+
+func (s *OAuthIntegrationService) loginEndpoint(w http.ResponseWriter, r *http.Request, rest string) {
+	providerName, action, _ := strings.Cut(rest, "/")
+	provider, ok := s.loginProviders[providerName]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch action {
+	case "":
+		s.startLogin(w, r, provider)
+	case "callback":
+		s.loginCallback(w, r, provider)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *OAuthIntegrationService) loginRedirectURI(provider *loginProvider) string {
+	return strings.TrimSuffix(s.cfg.OAuthLoginRedirectBase, "/") +
+		OAuthIntegrationServicePrefix + loginPathPrefix + provider.Name + "/callback"
+}
+
+// safeRedirect only allows redirecting to a path on our own site.
+func safeRedirect(to string) string {
+	if to == "" || !strings.HasPrefix(to, "/") || strings.HasPrefix(to, "//") || strings.Contains(to, "\\") {
+		return "/"
+	}
+	return to
+}
+
+// startLogin redirects the browser to the provider. Unlike the Patreon and
+// Twitch flows the user might not have a session yet, so the CSRF token and
+// nonce are kept in a short-lived cookie instead of on the session.
+func (s *OAuthIntegrationService) startLogin(w http.ResponseWriter, r *http.Request, provider *loginProvider) {
+	if err := provider.discover(r.Context(), s.httpClient); err != nil {
+		log.Err(err).Str("provider", provider.Name).Msg("oidc-discovery")
+		http.Error(w, "Login provider unavailable", http.StatusBadGateway)
+		return
+	}
+	state := &OAuthState{
+		CSRF:       shortuuid.New(),
+		RedirectTo: safeRedirect(r.URL.Query().Get("redirectTo")),
+		Nonce:      shortuuid.New(),
+	}
+	encoded, err := encodeOAuthState(state)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	cookie := &http.Cookie{
+		Name:     loginStateCookie,
+		Value:    state.CSRF + "." + state.Nonce,
+		Path:     OAuthIntegrationServicePrefix + loginPathPrefix,
+		MaxAge:   int(loginStateMaxAge.Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Secure:   s.cfg.SecureCookies,
+	}
+	if provider.FormPost && s.cfg.SecureCookies {
+		// The callback is a cross-site POST; a Lax cookie would not be sent.
+		cookie.SameSite = http.SameSiteNoneMode
+	}
+	http.SetCookie(w, cookie)
+	http.Redirect(w, r, provider.authCodeURL(s.loginRedirectURI(provider), encoded, state.Nonce), http.StatusFound)
+}
+
+func (s *OAuthIntegrationService) loginCallback(w http.ResponseWriter, r *http.Request, provider *loginProvider) {
+	ctx := r.Context()
+	state, err := parseOAuthState(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cookie, err := r.Cookie(loginStateCookie)
+	if err != nil {
+		http.Error(w, "Login session expired, please try again", http.StatusUnauthorized)
+		return
+	}
+	csrf, nonce, _ := strings.Cut(cookie.Value, ".")
+	if csrf == "" || state.CSRF != csrf || state.Nonce != nonce {
+		http.Error(w, "Invalid CSRF token", http.StatusUnauthorized)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   loginStateCookie,
+		Path:   OAuthIntegrationServicePrefix + loginPathPrefix,
+		MaxAge: -1,
+	})
+
+	if providerErr := r.FormValue("error"); providerErr != "" {
+		http.Error(w, "Login was cancelled: "+providerErr, http.StatusUnauthorized)
+		return
+	}
+	code := r.FormValue("code")
+	if code == "" {
+		http.Error(w, "Authorization code not found", http.StatusBadRequest)
+		return
+	}
+	ident, err := provider.exchange(ctx, s.httpClient, code, s.loginRedirectURI(provider), nonce)
+	if err != nil {
+		log.Err(err).Str("provider", provider.Name).Msg("login-code-exchange")
+		http.Error(w, "Could not verify your login with "+provider.Name, http.StatusUnauthorized)
+		return
+	}
+	logIdentity(ident)
+	redirectTo := safeRedirect(state.RedirectTo)
+
+	existing, err := s.queries.GetUserByIdentity(ctx, models.GetUserByIdentityParams{
+		Provider: ident.Provider,
+		Subject:  ident.Subject,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	identityKnown := err == nil
+
+	// Already logged in: this is a request to link the identity to the
+	// current account.
+	if sess, err := apiserver.GetSession(ctx); err == nil {
+		if identityKnown && existing.Uuid != sess.UserUUID {
+			http.Error(w, "This "+provider.Name+" account is already linked to another Woogles account", http.StatusConflict)
+			return
+		}
+		// Linking the same identity again changes nothing.
+		if !identityKnown {
+			if err := s.linkIdentity(r, sess.UserUUID, ident); err != nil {
+				http.Error(w, err.Error(), linkErrorStatus(err))
+				return
+			}
+		}
+		http.Redirect(w, r, redirectTo, http.StatusFound)
+		return
+	}
+
+	if identityKnown {
+		u, err := s.userStore.GetByUUID(ctx, existing.Uuid)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		s.completeLogin(w, r, u, ident, redirectTo)
+		return
+	}
+
+	// Link to an existing account only if both sides have verified the
+	// email address; otherwise anyone could pre-register a victim's address
+	// and wait for them to sign in with Google.
+	if ident.EmailVerified && ident.Email != "" {
+		u, err := s.userStore.GetByEmail(ctx, ident.Email)
+		if err != nil && !errors.Is(err, user.ErrUserNotFound) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err == nil && u.Verified {
+			if err := s.linkIdentity(r, u.UUID, ident); err != nil {
+				http.Error(w, err.Error(), linkErrorStatus(err))
+				return
+			}
+			s.completeLogin(w, r, u, ident, redirectTo)
+			return
+		} else if err == nil {
+			http.Error(w, "An unverified Woogles account already uses this email address. Please verify it or log in with your password first.", http.StatusConflict)
+			return
+		}
+	}
+
+	token, err := newSignupToken(s.cfg.SecretKey, ident)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	v := url.Values{}
+	v.Set("token", token)
+	v.Set("username", suggestUsername(ident))
+	v.Set("provider", ident.Provider)
+	v.Set("redirectTo", redirectTo)
+	http.Redirect(w, r, oauthSignupPath+"?"+v.Encode(), http.StatusFound)
+}
+
+// ErrIdentityLinked is returned when an identity can't be linked because the
+// account already has one with that provider, or the identity belongs to
+// another account. Existing links are never replaced, so that nobody can take
+// over an account by logging in with a different external account.
+var ErrIdentityLinked = errors.New("already linked to another account")
+
+func (s *OAuthIntegrationService) linkIdentity(r *http.Request, userUUID string, ident *ExternalIdentity) error {
+	log.Info().Str("userID", userUUID).Str("provider", ident.Provider).Msg("link-identity")
+	return LinkIdentity(r.Context(), s.queries, userUUID, ident)
+}
+
+// LinkIdentity links the external identity to the account.
+func LinkIdentity(ctx context.Context, q *models.Queries, userUUID string, ident *ExternalIdentity) error {
+	rows, err := q.LinkIdentity(ctx, models.LinkIdentityParams{
+		UserUuid: userUUID,
+		Provider: ident.Provider,
+		Subject:  ident.Subject,
+		Email:    ident.Email,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrIdentityLinked
+	}
+	return nil
+}
+
+func linkErrorStatus(err error) int {
+	if errors.Is(err, ErrIdentityLinked) {
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// completeLogin applies the same checks as a password login before creating
+// a session.
+func (s *OAuthIntegrationService) completeLogin(w http.ResponseWriter, r *http.Request, u *entity.User,
+	ident *ExternalIdentity, redirectTo string) {
+	ctx := r.Context()
+	if !u.Verified {
+		http.Error(w, "Please verify your email address before logging in", http.StatusUnauthorized)
+		return
+	}
+	_, err := mod.ActionExists(ctx, s.userStore, u.UUID, false, []ms.ModActionType{ms.ModActionType_SUSPEND_ACCOUNT})
+	if err != nil {
+		log.Err(err).Str("userID", u.UUID).Msg("action-exists-oauth-login")
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	sess, err := s.sessionStore.New(ctx, u)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	err = apiserver.SetDefaultCookie(ctx, sess.ID, s.cfg.SecureCookies)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	err = s.queries.TouchIdentityLogin(ctx, models.TouchIdentityLoginParams{
+		Provider: ident.Provider,
+		Subject:  ident.Subject,
+	})
+	if err != nil {
+		log.Err(err).Msg("touch-identity-login")
+	}
+	log.Info().Str("username", u.Username).Str("provider", ident.Provider).Msg("oauth-login")
+	http.Redirect(w, r, redirectTo, http.StatusFound)
+}
@@ -1,7 +1,9 @@
 package integrations
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -9,16 +11,28 @@ import (
 	"github.com/woogles-io/liwords/pkg/config"
 	"github.com/woogles-io/liwords/pkg/sessions"
 	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/user"
 )
 
 type OAuthIntegrationService struct {
 	sessionStore sessions.SessionStore
+	userStore    user.Store
 	queries      *models.Queries
 	cfg          *config.Config
+
+	loginProviders map[string]*loginProvider
+	httpClient     *http.Client
 }
 
-func NewOAuthIntegrationService(s sessions.SessionStore, q *models.Queries, cfg *config.Config) *OAuthIntegrationService {
-	return &OAuthIntegrationService{s, q, cfg}
+func NewOAuthIntegrationService(s sessions.SessionStore, u user.Store, q *models.Queries, cfg *config.Config) *OAuthIntegrationService {
+	return &OAuthIntegrationService{
+		sessionStore:   s,
+		userStore:      u,
+		queries:        q,
+		cfg:            cfg,
+		loginProviders: configuredLoginProviders(cfg),
+		httpClient:     &http.Client{Timeout: oauthHTTPTimeout},
+	}
 }
 
 type SaveCSRFRequest struct {
@@ -28,6 +42,39 @@ type SaveCSRFRequest struct {
 type OAuthState struct {
 	CSRF       string `json:"csrfToken"`
 	RedirectTo string `json:"redirectTo"`
+	// Nonce is only used by the login flow; it is echoed back inside the
+	// OpenID Connect ID token.
+	Nonce string `json:"nonce,omitempty"`
+}
+
+var errMissingState = errors.New("state parameter is missing")
+
+// parseOAuthState decodes the state parameter that the provider echoes back
+// to our callback (Base64 decode first, then JSON decode). Callers must still
+// compare the CSRF token against whatever they stored before the redirect.
+func parseOAuthState(r *http.Request) (*OAuthState, error) {
+	stateParam := r.FormValue("state")
+	if stateParam == "" {
+		return nil, errMissingState
+	}
+	stateBytes, err := base64.StdEncoding.DecodeString(stateParam)
+	if err != nil {
+		return nil, errors.New("invalid state parameter")
+	}
+	var state OAuthState
+	err = json.Unmarshal(stateBytes, &state)
+	if err != nil {
+		return nil, errors.New("invalid state JSON")
+	}
+	return &state, nil
+}
+
+func encodeOAuthState(state *OAuthState) (string, error) {
+	bts, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(bts), nil
 }
 
 func (s *OAuthIntegrationService) integrationsEndpoint(w http.ResponseWriter, r *http.Request, name string) {
@@ -55,6 +102,12 @@ func (s *OAuthIntegrationService) integrationsEndpoint(w http.ResponseWriter, r
 		s.patreonCallback(w, r)
 	case "twitch/callback":
 		s.twitchCallback(w, r)
+	default:
+		if strings.HasPrefix(name, loginPathPrefix) {
+			s.loginEndpoint(w, r, strings.TrimPrefix(name, loginPathPrefix))
+			return
+		}
+		http.NotFound(w, r)
 	}
 
 }
@@ -0,0 +1,442 @@
+package integrations
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/config"
+)
+
+const (
+	GoogleLoginProvider  = "google"
+	DiscordLoginProvider = "discord"
+	AppleLoginProvider   = "apple"
+
+	oauthHTTPTimeout = 10 * time.Second
+)
+
+// ExternalIdentity is what we learn about a user from an external login
+// provider after a successful code exchange.
+type ExternalIdentity struct {
+	Provider      string `json:"provider"`
+	Subject       string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	// Username is a hint (preferred username, display name) that we use to
+	// suggest a Woogles username on signup.
+	Username string `json:"username"`
+}
+
+// loginProvider describes an OAuth2 provider that can be used to log in.
+// OpenID Connect providers only need an Issuer; their endpoints are filled in
+// from the discovery document. Plain OAuth2 providers (Discord) specify their
+// endpoints and a userInfo function instead.
+type loginProvider struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	Issuer       string
+	// FormPost asks the provider to POST the code back to us. Apple requires
+	// this whenever the name or email scopes are requested.
+	FormPost bool
+
+	AuthURL  string
+	TokenURL string
+	JWKSURL  string
+
+	userInfo func(ctx context.Context, client *http.Client, accessToken string) (*ExternalIdentity, error)
+
+	mu         sync.Mutex
+	discovered bool
+	keys       map[string]*rsa.PublicKey
+}
+
+func (p *loginProvider) isOIDC() bool {
+	return p.Issuer != ""
+}
+
+func configuredLoginProviders(cfg *config.Config) map[string]*loginProvider {
+	providers := map[string]*loginProvider{}
+	if cfg == nil {
+		return providers
+	}
+	if cfg.GoogleClientID != "" {
+		providers[GoogleLoginProvider] = &loginProvider{
+			Name:         GoogleLoginProvider,
+			ClientID:     cfg.GoogleClientID,
+			ClientSecret: cfg.GoogleClientSecret,
+			Scopes:       []string{"openid", "email", "profile"},
+			Issuer:       "https://accounts.google.com",
+		}
+	}
+	if cfg.AppleClientID != "" {
+		providers[AppleLoginProvider] = &loginProvider{
+			Name:         AppleLoginProvider,
+			ClientID:     cfg.AppleClientID,
+			ClientSecret: cfg.AppleClientSecret,
+			Scopes:       []string{"openid", "email"},
+			Issuer:       "https://appleid.apple.com",
+			FormPost:     true,
+		}
+	}
+	if cfg.DiscordClientID != "" {
+		providers[DiscordLoginProvider] = &loginProvider{
+			Name:         DiscordLoginProvider,
+			ClientID:     cfg.DiscordClientID,
+			ClientSecret: cfg.DiscordClientSecret,
+			Scopes:       []string{"identify", "email"},
+			AuthURL:      "https://discord.com/oauth2/authorize",
+			TokenURL:     "https://discord.com/api/oauth2/token",
+			userInfo:     fetchDiscordIdentity,
+		}
+	}
+	if cfg.OIDCClientID != "" && cfg.OIDCIssuer != "" {
+		providers[cfg.OIDCProviderName] = &loginProvider{
+			Name:         cfg.OIDCProviderName,
+			ClientID:     cfg.OIDCClientID,
+			ClientSecret: cfg.OIDCClientSecret,
+			Scopes:       []string{"openid", "email", "profile"},
+			Issuer:       strings.TrimSuffix(cfg.OIDCIssuer, "/"),
+		}
+	}
+	return providers
+}
+
+// discover fetches the provider's OpenID configuration once.
+func (p *loginProvider) discover(ctx context.Context, client *http.Client) error {
+	if !p.isOIDC() {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovered {
+		return nil
+	}
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	err := getJSON(ctx, client, p.Issuer+"/.well-known/openid-configuration", "", &doc)
+	if err != nil {
+		return fmt.Errorf("discovery failed for %s: %w", p.Name, err)
+	}
+	if doc.Issuer != p.Issuer {
+		return fmt.Errorf("discovery issuer mismatch for %s: %s", p.Name, doc.Issuer)
+	}
+	p.AuthURL = doc.AuthorizationEndpoint
+	p.TokenURL = doc.TokenEndpoint
+	p.JWKSURL = doc.JWKSURI
+	p.discovered = true
+	return nil
+}
+
+// authCodeURL returns the URL that we send the user's browser to.
+func (p *loginProvider) authCodeURL(redirectURI, state, nonce string) string {
+	v := url.Values{}
+	v.Set("client_id", p.ClientID)
+	v.Set("redirect_uri", redirectURI)
+	v.Set("response_type", "code")
+	v.Set("scope", strings.Join(p.Scopes, " "))
+	v.Set("state", state)
+	if p.isOIDC() {
+		v.Set("nonce", nonce)
+	}
+	if p.FormPost {
+		v.Set("response_mode", "form_post")
+	}
+	sep := "?"
+	if strings.Contains(p.AuthURL, "?") {
+		sep = "&"
+	}
+	return p.AuthURL + sep + v.Encode()
+}
+
+// exchange trades an authorization code for the user's external identity.
+// For OIDC providers the ID token is verified against the provider's keys,
+// and its nonce must match the one we generated before the redirect.
+func (p *loginProvider) exchange(ctx context.Context, client *http.Client, code, redirectURI, nonce string) (*ExternalIdentity, error) {
+	if err := p.discover(ctx, client); err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURI)
+	form.Set("client_id", p.ClientID)
+	form.Set("client_secret", p.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, string(body))
+	}
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		IDToken     string `json:"id_token"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return nil, err
+	}
+
+	if !p.isOIDC() {
+		ident, err := p.userInfo(ctx, client, tokenResp.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		ident.Provider = p.Name
+		return ident, nil
+	}
+	if tokenResp.IDToken == "" {
+		return nil, errors.New("token endpoint did not return an id_token")
+	}
+	return p.verifyIDToken(ctx, client, tokenResp.IDToken, nonce)
+}
+
+func (p *loginProvider) verifyIDToken(ctx context.Context, client *http.Client, rawToken, nonce string) (*ExternalIdentity, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.publicKey(ctx, client, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(p.Issuer),
+		jwt.WithAudience(p.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %w", err)
+	}
+	if n, _ := claims["nonce"].(string); n == "" || n != nonce {
+		return nil, errors.New("invalid id token: nonce mismatch")
+	}
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return nil, errors.New("invalid id token: missing subject")
+	}
+	ident := &ExternalIdentity{Provider: p.Name, Subject: sub}
+	ident.Email, _ = claims["email"].(string)
+	// Apple sends email_verified as a string.
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		ident.EmailVerified = v
+	case string:
+		ident.EmailVerified = v == "true"
+	}
+	if u, ok := claims["preferred_username"].(string); ok {
+		ident.Username = u
+	} else if u, ok := claims["given_name"].(string); ok {
+		ident.Username = u
+	}
+	return ident, nil
+}
+
+// publicKey returns the provider's signing key with the given key ID,
+// refetching the key set if we haven't seen it (keys are rotated).
+func (p *loginProvider) publicKey(ctx context.Context, client *http.Client, kid string) (*rsa.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	err := getJSON(ctx, client, p.JWKSURL, "", &jwks)
+	if err != nil {
+		return nil, err
+	}
+	p.keys = make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		nb, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		eb, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		p.keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(nb),
+			E: int(new(big.Int).SetBytes(eb).Int64()),
+		}
+	}
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func fetchDiscordIdentity(ctx context.Context, client *http.Client, accessToken string) (*ExternalIdentity, error) {
+	var user struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Email    string `json:"email"`
+		Verified bool   `json:"verified"`
+	}
+	err := getJSON(ctx, client, "https://discord.com/api/users/@me", accessToken, &user)
+	if err != nil {
+		return nil, err
+	}
+	if user.ID == "" {
+		return nil, errors.New("discord did not return a user id")
+	}
+	return &ExternalIdentity{
+		Subject:       user.ID,
+		Email:         user.Email,
+		EmailVerified: user.Verified,
+		Username:      user.Username,
+	}, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, u, bearer string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return err
+	}
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d: %s", u, resp.StatusCode, string(body))
+	}
+	return json.Unmarshal(body, v)
+}
+
+// suggestUsername turns an external identity into something that is likely
+// to pass our username rules. The user can still change it on the signup
+// page; registration.RegisterUser has the final say.
+func suggestUsername(ident *ExternalIdentity) string {
+	base := ident.Username
+	if base == "" {
+		base, _, _ = strings.Cut(ident.Email, "@")
+	}
+	var sb strings.Builder
+	for _, c := range base {
+		if c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '.' || c == '_' {
+			sb.WriteRune(c)
+		}
+	}
+	name := strings.Trim(sb.String(), "-._")
+	if len(name) > 20 {
+		name = strings.TrimRight(name[:20], "-._")
+	}
+	if strings.HasSuffix(strings.ToLower(name), "bot") {
+		name = strings.TrimRight(name[:len(name)-3], "-._")
+	}
+	if len(name) < 3 {
+		name = "player" + shortuuid.New()[:6]
+	}
+	return name
+}
+
+// Signup tokens carry a verified external identity from the login callback to
+// the CompleteOAuthSignup RPC, where the user picks a username.
+const signupTokenExpiration = 30 * time.Minute
+
+// SignupToken is a validated signup token. Its ID lets CompleteOAuthSignup
+// refuse a token that was already used to create an account.
+type SignupToken struct {
+	ID        string
+	ExpiresAt time.Time
+	Identity  *ExternalIdentity
+}
+
+func newSignupToken(secretKey string, ident *ExternalIdentity) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp": time.Now().Add(signupTokenExpiration).Unix(),
+		"jti": shortuuid.New(),
+		"typ": "oauth-signup",
+		"prv": ident.Provider,
+		"sub": ident.Subject,
+		"eml": ident.Email,
+		"evf": ident.EmailVerified,
+	})
+	return token.SignedString([]byte(secretKey))
+}
+
+// ParseSignupToken validates a signup token issued by the login callback and
+// returns the external identity it was issued for.
+func ParseSignupToken(secretKey, tokenString string) (*SignupToken, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(secretKey), nil
+	}, jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, err
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid || claims["typ"] != "oauth-signup" {
+		return nil, errors.New("invalid signup token")
+	}
+	ident := &ExternalIdentity{}
+	ident.Provider, _ = claims["prv"].(string)
+	ident.Subject, _ = claims["sub"].(string)
+	ident.Email, _ = claims["eml"].(string)
+	ident.EmailVerified, _ = claims["evf"].(bool)
+	id, _ := claims["jti"].(string)
+	if id == "" || ident.Provider == "" || ident.Subject == "" {
+		return nil, errors.New("invalid signup token")
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		return nil, err
+	}
+	return &SignupToken{ID: id, ExpiresAt: exp.Time, Identity: ident}, nil
+}
+
+func logIdentity(ident *ExternalIdentity) {
+	log.Info().Str("provider", ident.Provider).Str("sub", ident.Subject).
+		Bool("email-verified", ident.EmailVerified).Msg("external-identity")
+}
@@ -0,0 +1,214 @@
+package integrations
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/config"
+)
+
+// mockOIDCProvider is a minimal OpenID Connect provider: discovery, a key
+// set, and a token endpoint that hands out an ID token for any code.
+type mockOIDCProvider struct {
+	srv      *httptest.Server
+	key      *rsa.PrivateKey
+	audience string
+	nonce    string
+	claims   jwt.MapClaims
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mockOIDCProvider{key: key, audience: "woogles-client"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 m.srv.URL,
+			"authorization_endpoint": m.srv.URL + "/authorize",
+			"token_endpoint":         m.srv.URL + "/token",
+			"jwks_uri":               m.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": "test-key",
+				"kty": "RSA",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "good-code" || r.FormValue("client_secret") != "shh" {
+			http.Error(w, "bad code", http.StatusBadRequest)
+			return
+		}
+		claims := jwt.MapClaims{
+			"iss":            m.srv.URL,
+			"aud":            m.audience,
+			"sub":            "12345",
+			"exp":            time.Now().Add(time.Hour).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          m.nonce,
+			"email":          "cesar@example.com",
+			"email_verified": "true",
+		}
+		for k, v := range m.claims {
+			claims[k] = v
+		}
+		tok := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		tok.Header["kid"] = "test-key"
+		signed, err := tok.SignedString(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "at",
+			"token_type":   "Bearer",
+			"id_token":     signed,
+		})
+	})
+	m.srv = httptest.NewServer(mux)
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+func (m *mockOIDCProvider) loginProvider() *loginProvider {
+	return configuredLoginProviders(&config.Config{
+		OIDCProviderName: "mock",
+		OIDCIssuer:       m.srv.URL,
+		OIDCClientID:     "woogles-client",
+		OIDCClientSecret: "shh",
+	})["mock"]
+}
+
+func TestOIDCExchange(t *testing.T) {
+	is := is.New(t)
+	m := newMockOIDCProvider(t)
+	p := m.loginProvider()
+	is.True(p != nil)
+	m.nonce = "the-nonce"
+
+	ident, err := p.exchange(context.Background(), m.srv.Client(), "good-code", "http://localhost/cb", "the-nonce")
+	is.NoErr(err)
+	is.Equal(ident.Provider, "mock")
+	is.Equal(ident.Subject, "12345")
+	is.Equal(ident.Email, "cesar@example.com")
+	is.True(ident.EmailVerified)
+	is.Equal(p.AuthURL, m.srv.URL+"/authorize")
+
+	_, err = p.exchange(context.Background(), m.srv.Client(), "bad-code", "http://localhost/cb", "the-nonce")
+	is.True(err != nil)
+}
+
+func TestOIDCRejectsBadTokens(t *testing.T) {
+	is := is.New(t)
+	m := newMockOIDCProvider(t)
+	p := m.loginProvider()
+
+	m.nonce = "someone-elses-nonce"
+	_, err := p.exchange(context.Background(), m.srv.Client(), "good-code", "http://localhost/cb", "the-nonce")
+	is.True(err != nil)
+
+	m.nonce = "the-nonce"
+	m.audience = "another-client"
+	_, err = p.exchange(context.Background(), m.srv.Client(), "good-code", "http://localhost/cb", "the-nonce")
+	is.True(err != nil)
+
+	m.audience = "woogles-client"
+	m.claims = jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}
+	_, err = p.exchange(context.Background(), m.srv.Client(), "good-code", "http://localhost/cb", "the-nonce")
+	is.True(err != nil)
+}
+
+func TestStartLoginRedirect(t *testing.T) {
+	is := is.New(t)
+	m := newMockOIDCProvider(t)
+	cfg := &config.Config{
+		OIDCProviderName:       "mock",
+		OIDCIssuer:             m.srv.URL,
+		OIDCClientID:           "woogles-client",
+		OIDCClientSecret:       "shh",
+		OAuthLoginRedirectBase: "https://woogles.example/",
+	}
+	s := NewOAuthIntegrationService(nil, nil, nil, cfg)
+	s.httpClient = m.srv.Client()
+
+	req := httptest.NewRequest("GET", "/integrations/login/mock?redirectTo=//evil.example", nil)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	is.Equal(rec.Code, http.StatusFound)
+
+	loc, err := url.Parse(rec.Header().Get("Location"))
+	is.NoErr(err)
+	is.True(strings.HasPrefix(loc.String(), m.srv.URL+"/authorize?"))
+	is.Equal(loc.Query().Get("redirect_uri"), "https://woogles.example/integrations/login/mock/callback")
+	is.Equal(loc.Query().Get("client_id"), "woogles-client")
+
+	stateReq := httptest.NewRequest("GET", "/?state="+url.QueryEscape(loc.Query().Get("state")), nil)
+	state, err := parseOAuthState(stateReq)
+	is.NoErr(err)
+	is.Equal(state.RedirectTo, "/")
+	is.Equal(state.Nonce, loc.Query().Get("nonce"))
+
+	cookies := rec.Result().Cookies()
+	is.Equal(len(cookies), 1)
+	is.Equal(cookies[0].Value, state.CSRF+"."+state.Nonce)
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", "/integrations/login/unknown", nil))
+	is.Equal(rec.Code, http.StatusNotFound)
+}
+
+func TestSuggestUsername(t *testing.T) {
+	is := is.New(t)
+	is.Equal(suggestUsername(&ExternalIdentity{Email: "cesar.del.solar@example.com"}), "cesar.del.solar")
+	is.Equal(suggestUsername(&ExternalIdentity{Username: "_Scrabble Fan!_"}), "ScrabbleFan")
+	is.Equal(suggestUsername(&ExternalIdentity{Username: "wordsmithbot"}), "wordsmith")
+	is.Equal(suggestUsername(&ExternalIdentity{Username: "averyveryverylongusername123"}), "averyveryverylonguse")
+	is.True(strings.HasPrefix(suggestUsername(&ExternalIdentity{Username: "é"}), "player"))
+}
+
+func TestSignupToken(t *testing.T) {
+	is := is.New(t)
+	ident := &ExternalIdentity{Provider: "google", Subject: "abc", Email: "a@b.com", EmailVerified: true}
+	tok, err := newSignupToken("secret", ident)
+	is.NoErr(err)
+	parsed, err := ParseSignupToken("secret", tok)
+	is.NoErr(err)
+	is.True(parsed.ID != "")
+	is.True(time.Until(parsed.ExpiresAt) > 29*time.Minute)
+	is.Equal(parsed.Identity.Provider, "google")
+	is.Equal(parsed.Identity.Subject, "abc")
+	is.Equal(parsed.Identity.Email, "a@b.com")
+	is.True(parsed.Identity.EmailVerified)
+
+	// Every token gets its own ID.
+	tok2, err := newSignupToken("secret", ident)
+	is.NoErr(err)
+	parsed2, err := ParseSignupToken("secret", tok2)
+	is.NoErr(err)
+	is.True(parsed2.ID != parsed.ID)
+
+	_, err = ParseSignupToken("other-secret", tok)
+	is.True(err != nil)
+}
@@ -2,7 +2,6 @@ package integrations
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -160,27 +159,11 @@ func (s *OAuthIntegrationService) patreonCallback(w http.ResponseWriter, r *http
 		http.Error(w, err.Error(), http.StatusUnauthorized)
 		return
 	}
-	// Get the state parameter
-	stateParam := r.URL.Query().Get("state")
-	if stateParam == "" {
-		http.Error(w, "State parameter is missing", http.StatusBadRequest)
-		return
-	}
-
-	// Decode the state parameter (Base64 decode first, then JSON decode)
-	stateBytes, err := base64.StdEncoding.DecodeString(stateParam)
-	if err != nil {
-		http.Error(w, "Invalid state parameter", http.StatusBadRequest)
-		return
-	}
-
-	var state OAuthState
-	err = json.Unmarshal(stateBytes, &state)
+	state, err := parseOAuthState(r)
 	if err != nil {
-		http.Error(w, "Invalid state JSON", http.StatusBadRequest)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	log.Debug().Bytes("stateBytes", stateBytes).Msg("state-bytes")
 	if state.CSRF != sess.CSRFToken {
 		log.Debug().Str("state-csrf", state.CSRF).Str("sess-csrf", sess.CSRFToken).Msg("bad-token")
 		http.Error(w, "Invalid CSRF token", http.StatusUnauthorized)
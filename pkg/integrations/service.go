@@ -7,6 +7,8 @@ import (
 	"connectrpc.com/connect"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/woogles-io/liwords/pkg/apiserver"
 	"github.com/woogles-io/liwords/pkg/stores/models"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/user_service"
@@ -77,3 +79,51 @@ func (s *IntegrationService) DeleteIntegration(ctx context.Context, req *connect
 
 	return connect.NewResponse(&pb.DeleteIntegrationResponse{}), nil
 }
+
+func (s *IntegrationService) GetLoginIdentities(ctx context.Context, req *connect.Request[pb.GetLoginIdentitiesRequest]) (
+	*connect.Response[pb.LoginIdentitiesResponse], error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		// Not authed.
+		return nil, apiserver.Unauthenticated("need auth for this endpoint")
+	}
+	identities, err := s.q.GetIdentitiesForUser(ctx, sess.UserUUID)
+	if err != nil {
+		return nil, err
+	}
+	msg := &pb.LoginIdentitiesResponse{Identities: make([]*pb.LoginIdentity, len(identities))}
+	for i := range identities {
+		msg.Identities[i] = &pb.LoginIdentity{
+			Provider: identities[i].Provider,
+			Email:    identities[i].Email,
+			LinkedAt: timestamppb.New(identities[i].CreatedAt.Time),
+		}
+		if identities[i].LastLoginAt.Valid {
+			msg.Identities[i].LastLoginAt = timestamppb.New(identities[i].LastLoginAt.Time)
+		}
+	}
+	return connect.NewResponse(msg), nil
+}
+
+// UnlinkLoginIdentity removes an external login. Accounts created through an
+// external provider have a random password; those users can still get back
+// in with a password reset.
+func (s *IntegrationService) UnlinkLoginIdentity(ctx context.Context, req *connect.Request[pb.UnlinkLoginIdentityRequest]) (
+	*connect.Response[pb.UnlinkLoginIdentityResponse], error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		// Not authed.
+		return nil, apiserver.Unauthenticated("need auth for this endpoint")
+	}
+	n, err := s.q.UnlinkIdentity(ctx, models.UnlinkIdentityParams{
+		Provider: req.Msg.Provider,
+		UserUuid: sess.UserUUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, apiserver.NotFound("no login linked for " + req.Msg.Provider)
+	}
+	return connect.NewResponse(&pb.UnlinkLoginIdentityResponse{}), nil
+}
@@ -78,6 +78,11 @@ func (em *EndOfSeasonManager) updateRegistrationRanks(
 
 	// Sort standings to determine rank (rank is calculated from position, not stored)
 	SortStandingsByRank(standings)
+	bracket, err := divisionPlayoffBracket(ctx, em.store, divisionID)
+	if err != nil {
+		return err
+	}
+	OrderStandingsByPlayoff(standings, bracket)
 
 	// Update each player's registration with their rank only
 	for i, standing := range standings {
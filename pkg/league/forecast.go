@@ -0,0 +1,381 @@
+package league
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/glicko"
+	"github.com/woogles-io/liwords/pkg/stores/league"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/user"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+const (
+	// ForecastIterations is the number of seasons simulated per forecast
+	ForecastIterations = 2000
+
+	// A simulated game's spread is drawn from a normal distribution whose
+	// mean grows with the rating difference. With these values a player
+	// rated 200 points higher wins about 74% of their games.
+	forecastSpreadPerRatingPoint = 0.3
+	forecastSpreadStdDev         = 95.0
+)
+
+// OutcomeForecast holds the chances of a player's possible season outcomes.
+type OutcomeForecast struct {
+	Promotion  float64
+	Stay       float64
+	Relegation float64
+	Champion   float64
+}
+
+// divisionForecastInput is everything needed to simulate the rest of a
+// division's season.
+type divisionForecastInput struct {
+	// standings has a row for every player in the division
+	standings       []models.GetStandingsRow
+	unfinished      []unfinishedGame
+	ratings         map[int32]float64
+	divisionNumber  int32
+	highestDivision int32
+	formula         pb.PromotionFormula
+	// playoffSize is the size of a playoff that has yet to start; bracket is
+	// the playoff once it has.
+	playoffSize int
+	bracket     *PlayoffBracket
+	seed        int64
+}
+
+// forecastDivision simulates the rest of the season the given number of
+// times and returns how often each player finished with each outcome. The
+// remaining games are decided by the players' ratings; a playoff, if any,
+// is simulated the same way and decides the top places, as it does at the
+// end of the season.
+func forecastDivision(in divisionForecastInput, iterations int) map[int32]OutcomeForecast {
+	n := len(in.standings)
+	forecasts := make(map[int32]OutcomeForecast, n)
+	if n == 0 || iterations <= 0 {
+		return forecasts
+	}
+
+	index := make(map[int32]int, n)
+	base := make([]PlayerStanding, n)
+	for i, s := range in.standings {
+		index[s.UserID] = i
+		base[i] = PlayerStanding{
+			UserID:   s.UserID,
+			Username: s.Username,
+			Wins:     int(s.Wins.Int32),
+			Losses:   int(s.Losses.Int32),
+			Draws:    int(s.Draws.Int32),
+			Spread:   int(s.Spread.Int32),
+		}
+	}
+	rating := func(userID int32) float64 {
+		if r, ok := in.ratings[userID]; ok {
+			return r
+		}
+		return float64(glicko.InitialRating)
+	}
+
+	rng := rand.New(rand.NewSource(in.seed))
+	// margin simulates a game and returns player a's spread against b
+	margin := func(a, b int32) int {
+		mean := (rating(a) - rating(b)) * forecastSpreadPerRatingPoint
+		return int(math.Round(rng.NormFloat64()*forecastSpreadStdDev + mean))
+	}
+
+	sm := &StandingsManager{}
+	counts := make(map[int32]*[4]int, n)
+	for _, s := range base {
+		counts[s.UserID] = &[4]int{}
+	}
+	sim := make([]PlayerStanding, n)
+	for range iterations {
+		copy(sim, base)
+		for _, g := range in.unfinished {
+			i, ok0 := index[g.player0ID]
+			j, ok1 := index[g.player1ID]
+			if !ok0 || !ok1 {
+				continue
+			}
+			m := margin(g.player0ID, g.player1ID)
+			switch {
+			case m > 0:
+				sim[i].Wins++
+				sim[j].Losses++
+			case m < 0:
+				sim[i].Losses++
+				sim[j].Wins++
+			default:
+				sim[i].Draws++
+				sim[j].Draws++
+			}
+			sim[i].Spread += m
+			sim[j].Spread -= m
+		}
+		sm.sortStandings(sim)
+
+		if bracket := simulatePlayoff(sim, in.playoffSize, in.bracket, margin); bracket != nil {
+			orderByPlayoff(sim, func(s PlayerStanding) int32 { return s.UserID }, bracket)
+		}
+		for i := range sim {
+			sim[i].Rank = i + 1
+		}
+		sm.markOutcomes(sim, in.divisionNumber, in.highestDivision, in.formula)
+
+		for _, s := range sim {
+			c := counts[s.UserID]
+			switch s.Outcome {
+			case pb.StandingResult_RESULT_PROMOTED:
+				c[0]++
+			case pb.StandingResult_RESULT_RELEGATED:
+				c[2]++
+			case pb.StandingResult_RESULT_CHAMPION:
+				c[3]++
+			default:
+				c[1]++
+			}
+		}
+	}
+
+	total := float64(iterations)
+	for userID, c := range counts {
+		forecasts[userID] = OutcomeForecast{
+			Promotion:  float64(c[0]) / total,
+			Stay:       float64(c[1]) / total,
+			Relegation: float64(c[2]) / total,
+			Champion:   float64(c[3]) / total,
+		}
+	}
+	return forecasts
+}
+
+// simulatePlayoff plays out the division playoff from the simulated final
+// regular-season standings, or from the current bracket if the playoff has
+// started. Drawn games go to the higher seed. It returns nil if there is no
+// playoff.
+func simulatePlayoff(sorted []PlayerStanding, playoffSize int, bracket *PlayoffBracket,
+	margin func(a, b int32) int) *PlayoffBracket {
+
+	var games []PlayoffGame
+	var pairings []PlayoffPairing
+	if bracket != nil {
+		games = bracket.Games()
+	} else {
+		if playoffSize == 0 || len(sorted) < playoffSize {
+			return nil
+		}
+		seeds := make([]int32, playoffSize)
+		for i := range seeds {
+			seeds[i] = sorted[i].UserID
+		}
+		pairings = FirstRoundPairings(seeds)
+	}
+	for _, p := range pairings {
+		games = append(games, PlayoffGame{PlayoffPairing: p})
+	}
+
+	for {
+		for i := range games {
+			if !games[i].Finished {
+				games[i].Finished = true
+				games[i].LowSeedWon = margin(games[i].LowSeedUserID, games[i].HighSeedUserID) > 0
+			}
+		}
+		next := NewPlayoffBracket(games).NextRoundPairings()
+		if len(next) == 0 {
+			return NewPlayoffBracket(games)
+		}
+		for _, p := range next {
+			games = append(games, PlayoffGame{PlayoffPairing: p})
+		}
+	}
+}
+
+// forecastRatings looks up each player's league rating, falling back to
+// their rating in the league's variant and time control for players who have
+// not played in the league yet. userUUIDs maps user IDs to UUIDs.
+func forecastRatings(ctx context.Context, store league.Store, userStore user.Store, leagueID uuid.UUID,
+	settings *pb.LeagueSettings, userUUIDs map[int32]string) (map[int32]float64, error) {
+
+	userIDs := make([]int32, 0, len(userUUIDs))
+	for userID := range userUUIDs {
+		userIDs = append(userIDs, userID)
+	}
+	leagueRatings, err := NewLeagueRatingManager(store).Ratings(ctx, leagueID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	gameReq, err := (&SeasonStartManager{}).buildGameRequest(settings)
+	if err != nil {
+		return nil, err
+	}
+	timeControl, variant, err := entity.VariantFromGameReq(gameReq)
+	if err != nil {
+		return nil, err
+	}
+	variantKey := entity.ToVariantKey(gameReq.Lexicon, variant, timeControl)
+
+	ratings := make(map[int32]float64, len(userUUIDs))
+	for userID, userUUID := range userUUIDs {
+		if r, ok := leagueRatings[userID]; ok {
+			ratings[userID] = r.Rating
+			continue
+		}
+		u, err := userStore.GetByUUID(ctx, userUUID)
+		if err != nil {
+			return nil, err
+		}
+		rating, err := u.GetRating(variantKey)
+		if err != nil {
+			continue
+		}
+		ratings[userID] = rating.Rating
+	}
+	return ratings, nil
+}
+
+// forecastKey identifies the state of a division's season that a forecast
+// was simulated from.
+type forecastKey struct {
+	gamesPlayed     int
+	gamesRemaining  int
+	playoffGames    int
+	playoffFinished int
+}
+
+func newForecastKey(standings []models.GetStandingsRow, gamesRemaining int, bracket *PlayoffBracket) forecastKey {
+	key := forecastKey{gamesRemaining: gamesRemaining}
+	for _, s := range standings {
+		key.gamesPlayed += int(s.GamesPlayed.Int32)
+	}
+	if bracket != nil {
+		for _, g := range bracket.games {
+			key.playoffGames++
+			if g.Finished {
+				key.playoffFinished++
+			}
+		}
+	}
+	return key
+}
+
+type cachedForecast struct {
+	key       forecastKey
+	forecasts map[int32]OutcomeForecast
+}
+
+// forecastCacheSize is the number of division forecasts kept in memory.
+// It is more than the number of divisions in all running seasons, so only
+// the divisions of finished seasons are normally evicted.
+const forecastCacheSize = 1000
+
+// forecastCache keeps each division's forecast until one of its games
+// finishes. Entries are also checked against the division's current state,
+// so games finished by another process are not missed.
+var forecastCache, _ = lru.New[uuid.UUID, cachedForecast](forecastCacheSize)
+
+func cachedDivisionForecast(divisionID uuid.UUID, key forecastKey) (map[int32]OutcomeForecast, bool) {
+	entry, ok := forecastCache.Get(divisionID)
+	if !ok || entry.key != key {
+		return nil, false
+	}
+	return entry.forecasts, true
+}
+
+func storeDivisionForecast(divisionID uuid.UUID, key forecastKey, forecasts map[int32]OutcomeForecast) {
+	forecastCache.Add(divisionID, cachedForecast{key: key, forecasts: forecasts})
+}
+
+// InvalidateDivisionForecast drops the cached forecast of a division. It is
+// called whenever one of the division's games finishes.
+func InvalidateDivisionForecast(divisionID uuid.UUID) {
+	forecastCache.Remove(divisionID)
+}
+
+// forecastSeed makes the simulation of a division's state repeatable.
+func forecastSeed(divisionID uuid.UUID, key forecastKey) int64 {
+	var buf [16 + 32]byte
+	copy(buf[:16], divisionID[:])
+	binary.BigEndian.PutUint64(buf[16:], uint64(key.gamesPlayed))
+	binary.BigEndian.PutUint64(buf[24:], uint64(key.gamesRemaining))
+	binary.BigEndian.PutUint64(buf[32:], uint64(key.playoffGames))
+	binary.BigEndian.PutUint64(buf[40:], uint64(key.playoffFinished))
+	hash := sha256.Sum256(buf[:])
+	return int64(binary.BigEndian.Uint64(hash[:8]))
+}
+
+// simulateDivisionForecast simulates a division's forecast and caches it.
+func simulateDivisionForecast(divisionID uuid.UUID, key forecastKey, in divisionForecastInput) map[int32]OutcomeForecast {
+	in.seed = forecastSeed(divisionID, key)
+	forecasts := forecastDivision(in, ForecastIterations)
+	storeDivisionForecast(divisionID, key, forecasts)
+	log.Debug().Str("divisionID", divisionID.String()).Msg("simulated-division-forecast")
+	return forecasts
+}
+
+// withRegisteredPlayers adds an empty standing for every registered player
+// who has not finished a game yet.
+func withRegisteredPlayers(standings []models.GetStandingsRow, registrations []models.GetDivisionRegistrationsRow) []models.GetStandingsRow {
+	seen := make(map[int32]bool, len(standings))
+	for _, s := range standings {
+		seen[s.UserID] = true
+	}
+	all := append([]models.GetStandingsRow(nil), standings...)
+	for _, reg := range registrations {
+		if !seen[reg.UserID] {
+			all = append(all, models.GetStandingsRow{
+				UserID:   reg.UserID,
+				UserUuid: reg.UserUuid,
+				Username: reg.Username,
+			})
+		}
+	}
+	return all
+}
+
+// registrationUUIDs maps the user IDs of registered players to their UUIDs.
+func registrationUUIDs(registrations []models.GetDivisionRegistrationsRow) map[int32]string {
+	uuids := make(map[int32]string, len(registrations))
+	for _, reg := range registrations {
+		uuids[reg.UserID] = reg.UserUuid
+	}
+	return uuids
+}
+
+// highestDivisionNumber returns the highest division number in the season.
+func highestDivisionNumber(divisions []models.LeagueDivision) int32 {
+	highest := int32(0)
+	for _, d := range divisions {
+		if d.DivisionNumber > highest {
+			highest = d.DivisionNumber
+		}
+	}
+	return highest
+}
+
+// applyForecasts fills in the forecast of each standing; userIDs[i] is the
+// user of standings[i].
+func applyForecasts(standings []*pb.LeaguePlayerStanding, userIDs []int32, forecasts map[int32]OutcomeForecast) {
+	for i, s := range standings {
+		f, ok := forecasts[userIDs[i]]
+		if !ok {
+			continue
+		}
+		s.PromotionProbability = f.Promotion
+		s.StayProbability = f.Stay
+		s.RelegationProbability = f.Relegation
+		s.ChampionProbability = f.Champion
+	}
+}
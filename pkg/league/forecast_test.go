@@ -0,0 +1,154 @@
+package league
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+func forecastStanding(userID int32, name string, wins, losses int32, spread int32) models.GetStandingsRow {
+	return models.GetStandingsRow{
+		UserID:      userID,
+		Username:    name,
+		Wins:        pgtype.Int4{Int32: wins, Valid: true},
+		Losses:      pgtype.Int4{Int32: losses, Valid: true},
+		Draws:       pgtype.Int4{Valid: true},
+		Spread:      pgtype.Int4{Int32: spread, Valid: true},
+		GamesPlayed: pgtype.Int4{Int32: wins + losses, Valid: true},
+	}
+}
+
+func TestForecastDivisionFinished(t *testing.T) {
+	// With no games left the forecast is the actual outcome
+	standings := []models.GetStandingsRow{
+		forecastStanding(1, "a", 5, 0, 300),
+		forecastStanding(2, "b", 4, 1, 200),
+		forecastStanding(3, "c", 3, 2, 100),
+		forecastStanding(4, "d", 2, 3, -100),
+		forecastStanding(5, "e", 1, 4, -200),
+		forecastStanding(6, "f", 0, 5, -300),
+	}
+	forecasts := forecastDivision(divisionForecastInput{
+		standings:       standings,
+		divisionNumber:  2,
+		highestDivision: 3,
+		formula:         ipc.PromotionFormula_PROMO_N_DIV_6,
+	}, 50)
+	assert.Equal(t, OutcomeForecast{Promotion: 1}, forecasts[1])
+	for _, u := range []int32{2, 3, 4, 5} {
+		assert.Equal(t, OutcomeForecast{Stay: 1}, forecasts[u])
+	}
+	assert.Equal(t, OutcomeForecast{Relegation: 1}, forecasts[6])
+}
+
+func TestForecastDivisionRatings(t *testing.T) {
+	// Four players level on record, with one round robin left to play
+	var standings []models.GetStandingsRow
+	var unfinished []unfinishedGame
+	for u := int32(1); u <= 4; u++ {
+		standings = append(standings, forecastStanding(u, string(rune('a'+u)), 1, 1, 0))
+		for v := u + 1; v <= 4; v++ {
+			unfinished = append(unfinished, unfinishedGame{player0ID: u, player1ID: v})
+		}
+	}
+	in := divisionForecastInput{
+		standings:       standings,
+		unfinished:      unfinished,
+		ratings:         map[int32]float64{1: 1500, 2: 1500, 3: 1500, 4: 1900},
+		divisionNumber:  2,
+		highestDivision: 3,
+		formula:         ipc.PromotionFormula_PROMO_N_DIV_6,
+		seed:            33,
+	}
+	forecasts := forecastDivision(in, 2000)
+	require.Len(t, forecasts, 4)
+	total := 0.0
+	for _, f := range forecasts {
+		assert.InDelta(t, 1, f.Promotion+f.Stay+f.Relegation+f.Champion, 1e-9)
+		total += f.Promotion
+	}
+	// One player is promoted in every simulated season
+	assert.InDelta(t, 1, total, 1e-9)
+	assert.Greater(t, forecasts[4].Promotion, 0.6)
+	assert.Greater(t, forecasts[1].Relegation, forecasts[4].Relegation)
+
+	// The same state always gives the same forecast
+	assert.Equal(t, forecasts, forecastDivision(in, 2000))
+}
+
+func TestForecastDivisionPlayoff(t *testing.T) {
+	standings := []models.GetStandingsRow{
+		forecastStanding(1, "a", 3, 0, 300),
+		forecastStanding(2, "b", 2, 1, 100),
+		forecastStanding(3, "c", 1, 2, -100),
+		forecastStanding(4, "d", 0, 3, -300),
+	}
+	in := divisionForecastInput{
+		standings:       standings,
+		ratings:         map[int32]float64{1: 1500, 2: 1500, 3: 1500, 4: 1500},
+		divisionNumber:  1,
+		highestDivision: 2,
+		formula:         ipc.PromotionFormula_PROMO_N_DIV_6,
+		playoffSize:     4,
+		seed:            31,
+	}
+	forecasts := forecastDivision(in, 2000)
+
+	// Anyone can win the playoff, even the last-placed player
+	total := 0.0
+	for u := int32(1); u <= 4; u++ {
+		assert.Greater(t, forecasts[u].Champion, 0.1)
+		total += forecasts[u].Champion
+	}
+	assert.InDelta(t, 1, total, 1e-9)
+
+	// Once the final is set, only the finalists can win it
+	var games []PlayoffGame
+	for _, p := range FirstRoundPairings([]int32{1, 2, 3, 4}) {
+		games = append(games, PlayoffGame{PlayoffPairing: p, Finished: true})
+	}
+	in.playoffSize = 0
+	in.bracket = NewPlayoffBracket(games)
+	forecasts = forecastDivision(in, 500)
+	assert.Greater(t, forecasts[1].Champion, 0.0)
+	assert.Greater(t, forecasts[2].Champion, 0.0)
+	assert.Zero(t, forecasts[3].Champion)
+	assert.Zero(t, forecasts[4].Champion)
+}
+
+func TestForecastCache(t *testing.T) {
+	divisionID := uuid.New()
+	key := forecastKey{gamesPlayed: 10, gamesRemaining: 5}
+	forecasts := map[int32]OutcomeForecast{1: {Stay: 1}}
+	storeDivisionForecast(divisionID, key, forecasts)
+
+	cached, ok := cachedDivisionForecast(divisionID, key)
+	require.True(t, ok)
+	assert.Equal(t, forecasts, cached)
+
+	// A game finishing changes the key
+	_, ok = cachedDivisionForecast(divisionID, forecastKey{gamesPlayed: 12, gamesRemaining: 4})
+	assert.False(t, ok)
+
+	InvalidateDivisionForecast(divisionID)
+	_, ok = cachedDivisionForecast(divisionID, key)
+	assert.False(t, ok)
+}
+
+func TestForecastCacheEviction(t *testing.T) {
+	first := uuid.New()
+	key := forecastKey{gamesPlayed: 1}
+	storeDivisionForecast(first, key, map[int32]OutcomeForecast{})
+	for i := 0; i < forecastCacheSize; i++ {
+		storeDivisionForecast(uuid.New(), key, map[int32]OutcomeForecast{})
+	}
+	assert.Equal(t, forecastCacheSize, forecastCache.Len())
+	_, ok := cachedDivisionForecast(first, key)
+	assert.False(t, ok)
+}
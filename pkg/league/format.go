@@ -0,0 +1,137 @@
+package league
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/woogles-io/liwords/pkg/stores/league"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+const (
+	// MaxGamesPerPairing bounds how many times two players can meet in a season.
+	MaxGamesPerPairing = 4
+	// DefaultPlayoffDays is used when a playoff is configured without a length.
+	DefaultPlayoffDays = 3
+)
+
+// RoundRobins returns how many times the division's round robin is played.
+func RoundRobins(settings *pb.LeagueSettings) int {
+	if settings == nil || settings.RoundRobins < 1 {
+		return 1
+	}
+	return int(settings.RoundRobins)
+}
+
+// GamesPerPairing returns how many games each pairing plays per round robin.
+func GamesPerPairing(settings *pb.LeagueSettings) int {
+	if settings == nil || settings.GamesPerPairing < 1 {
+		return 1
+	}
+	return int(settings.GamesPerPairing)
+}
+
+// MeetingsPerPairing returns the total number of games between two players
+// that are paired with each other.
+func MeetingsPerPairing(settings *pb.LeagueSettings) int {
+	return RoundRobins(settings) * GamesPerPairing(settings)
+}
+
+// PlayoffSize returns the number of players per division in the playoff, or
+// 0 if there is none.
+func PlayoffSize(settings *pb.LeagueSettings) int {
+	if settings == nil {
+		return 0
+	}
+	return int(settings.PlayoffSize)
+}
+
+// PlayoffDays returns how many days at the end of the season are set aside
+// for the playoff.
+func PlayoffDays(settings *pb.LeagueSettings) int {
+	if PlayoffSize(settings) == 0 {
+		return 0
+	}
+	if settings.PlayoffDays < 1 {
+		return DefaultPlayoffDays
+	}
+	return int(settings.PlayoffDays)
+}
+
+// CalculateExpectedGames returns the expected number of regular-season games
+// per player for the league's format. The round robin is capped as usual and
+// every pairing in it is then played MeetingsPerPairing times.
+func CalculateExpectedGames(numPlayers int, settings *pb.LeagueSettings) int {
+	return CalculateExpectedGamesPerPlayer(numPlayers) * MeetingsPerPairing(settings)
+}
+
+// ValidateLeagueFormat checks the format fields of league settings.
+func ValidateLeagueFormat(settings *pb.LeagueSettings) error {
+	if settings.RoundRobins < 0 || settings.GamesPerPairing < 0 || settings.PlayoffSize < 0 ||
+		settings.PlayoffDays < 0 {
+		return fmt.Errorf("league format values must not be negative")
+	}
+	if MeetingsPerPairing(settings) > MaxGamesPerPairing {
+		return fmt.Errorf("players can meet at most %d times in a season", MaxGamesPerPairing)
+	}
+	switch settings.PlayoffSize {
+	case 0, 2, 4, 8:
+	default:
+		return fmt.Errorf("playoff size must be 2, 4 or 8")
+	}
+	if PlayoffDays(settings) > 0 && settings.SeasonLengthDays > 0 &&
+		int32(PlayoffDays(settings)) >= settings.SeasonLengthDays {
+		return fmt.Errorf("the playoff must be shorter than the season")
+	}
+	if live := settings.Live; live != nil {
+		if live.InitialTimeMinutes < 0 || live.IncrementSeconds < 0 || live.MaxOvertimeMinutes < 0 ||
+			live.MatchWindowDays < 0 || live.ReminderHours < 0 {
+			return fmt.Errorf("live league settings must not be negative")
+		}
+		if settings.SeasonLengthDays > 0 && int32(MatchWindowDays(settings)) > settings.SeasonLengthDays {
+			return fmt.Errorf("the match window must not be longer than the season")
+		}
+		if settings.PlayoffSize > 0 {
+			return fmt.Errorf("playoffs are not supported for live leagues")
+		}
+	}
+	return nil
+}
+
+// pendingPlayoffSize returns the playoff size that the season's standings
+// should allow for: the league's playoff size while the season is active,
+// and 0 once it is over.
+func pendingPlayoffSize(season models.LeagueSeason, settings *pb.LeagueSettings) int {
+	if season.Status != int32(pb.SeasonStatus_SEASON_ACTIVE) {
+		return 0
+	}
+	return PlayoffSize(settings)
+}
+
+// divisionLeagueSettings looks up the settings of the league a division
+// belongs to.
+func divisionLeagueSettings(ctx context.Context, store league.Store, divisionID uuid.UUID) (*pb.LeagueSettings, error) {
+	division, err := store.GetDivision(ctx, divisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get division: %w", err)
+	}
+	_, settings, err := seasonLeagueSettings(ctx, store, division.SeasonID)
+	return settings, err
+}
+
+// seasonLeagueSettings looks up a season and the settings of its league.
+func seasonLeagueSettings(ctx context.Context, store league.Store, seasonID uuid.UUID) (models.LeagueSeason, *pb.LeagueSettings, error) {
+	season, err := store.GetSeason(ctx, seasonID)
+	if err != nil {
+		return season, nil, fmt.Errorf("failed to get season: %w", err)
+	}
+	dbLeague, err := store.GetLeagueByUUID(ctx, season.LeagueID)
+	if err != nil {
+		return season, nil, fmt.Errorf("failed to get league: %w", err)
+	}
+	settings, err := parseLeagueSettings(dbLeague.Settings)
+	return season, settings, err
+}
@@ -571,3 +571,100 @@ The Woogles Team
 	wg.Wait()
 	log.Info().Int("count", len(playersWithUnstartedGames)).Str("league", leagueName).Str("type", reminderType).Msg("completed-sending-unstarted-game-reminder-emails")
 }
+
+// SendMatchReminderEmails reminds live league players of their matches:
+// either that the window to play a match is closing without an agreed time,
+// or that the agreed time is coming up.
+func SendMatchReminderEmails(
+	ctx context.Context,
+	cfg *config.Config,
+	userStore user.Store,
+	leagueName, leagueSlug string,
+	seasonNumber int,
+	reminders []MatchReminder,
+) {
+	leagueURL := fmt.Sprintf("https://woogles.io/leagues/%s", leagueSlug)
+
+	log.Info().
+		Int("count", len(reminders)).
+		Str("league", leagueName).
+		Msg("sending-match-reminder-emails")
+
+	// Semaphore for concurrency control and WaitGroup to wait for completion
+	sem := make(chan struct{}, maxConcurrentEmails)
+	var wg sync.WaitGroup
+
+	for _, reminder := range reminders {
+		u, err := userStore.GetByUUID(ctx, reminder.UserUUID)
+		if err != nil {
+			log.Err(err).Str("userUUID", reminder.UserUUID).Msg("failed-to-fetch-user-for-match-reminder")
+			continue
+		}
+
+		if u.Email == "" {
+			log.Debug().Str("username", u.Username).Msg("user-has-no-email-skipping")
+			continue
+		}
+
+		var emailBody, emailSubject string
+		if !reminder.ScheduledTime.IsZero() {
+			emailSubject = fmt.Sprintf("%s Season %d - Your Match Against %s Is Coming Up", leagueName, seasonNumber, reminder.OpponentUsername)
+			emailBody = fmt.Sprintf(`Dear %s,
+
+Your %s Season %d match against %s is scheduled for:
+
+%s
+
+You can start the game from the league page up to %d minutes before the agreed time:
+%s
+
+Good luck and have fun!
+
+Sincerely,
+The Woogles Team
+`, u.Username, leagueName, seasonNumber, reminder.OpponentUsername,
+				formatTimeInTimezones(reminder.ScheduledTime), MatchStartEarlyMinutes, leagueURL)
+		} else {
+			emailSubject = fmt.Sprintf("⏰ %s Season %d - Schedule Your Match Against %s", leagueName, seasonNumber, reminder.OpponentUsername)
+			emailBody = fmt.Sprintf(`Dear %s,
+
+You have not yet agreed on a time for your %s Season %d match against %s. The window to play this match closes at:
+
+%s
+
+Please propose a time or accept one of your opponent's proposals on the league page:
+%s
+
+If the match is not played before the window closes, a player who did not respond to scheduling will forfeit it.
+
+Sincerely,
+The Woogles Team
+`, u.Username, leagueName, seasonNumber, reminder.OpponentUsername,
+				formatTimeInTimezones(reminder.WindowEnd), leagueURL)
+		}
+
+		// Acquire semaphore slot
+		wg.Add(1)
+		sem <- struct{}{}
+
+		// Send email asynchronously with rate limiting
+		go func(email, subject, body, username string) {
+			defer wg.Done()
+			defer func() { <-sem }() // Release semaphore
+
+			_, err := emailer.SendSimpleMessage(cfg.EmailDebugMode, email, subject, body)
+			if err != nil {
+				log.Err(err).Str("username", username).Msg("failed-to-send-match-reminder-email")
+			} else {
+				log.Info().Str("username", username).Str("league", leagueName).Msg("sent-match-reminder-email")
+			}
+		}(u.Email, emailSubject, emailBody, u.Username)
+
+		// Rate limit: delay before launching next goroutine
+		time.Sleep(emailSendDelay)
+	}
+
+	// Wait for all emails to complete
+	wg.Wait()
+	log.Info().Int("count", len(reminders)).Str("league", leagueName).Msg("completed-sending-match-reminder-emails")
+}
@@ -0,0 +1,296 @@
+package league
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/glicko"
+	"github.com/woogles-io/liwords/pkg/stores/league"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// LeagueRatingManager keeps each league's own rating of its players. It is
+// only updated by the league's games, so unlike the players' Woogles
+// ratings it is not mixed with their casual games.
+type LeagueRatingManager struct {
+	store league.Store
+}
+
+// NewLeagueRatingManager creates a new league rating manager
+func NewLeagueRatingManager(store league.Store) *LeagueRatingManager {
+	return &LeagueRatingManager{store: store}
+}
+
+// LeagueGameResult is a finished league game to be rated
+type LeagueGameResult struct {
+	LeagueID  uuid.UUID
+	SeasonID  pgtype.UUID
+	GameID    string
+	PlayerIDs [2]int32
+	Scores    [2]int32
+	WinnerIdx int // 0 or 1, -1 for a tie
+	EndReason pb.GameEndReason
+	EndedAt   time.Time
+}
+
+// initialLeagueRating is the rating of a player before their first game in
+// a league.
+func initialLeagueRating() entity.SingleRating {
+	return entity.SingleRating{
+		Rating:          float64(glicko.InitialRating),
+		RatingDeviation: float64(glicko.InitialRatingDeviation),
+		Volatility:      glicko.InitialVolatility,
+	}
+}
+
+// leagueGameSpread returns player 0's spread for rating purposes. As in
+// gameplay.Rate, games that were resigned, forfeited or lost on time count
+// with the maximum spread.
+func leagueGameSpread(res LeagueGameResult) int {
+	switch res.EndReason {
+	case pb.GameEndReason_RESIGNED, pb.GameEndReason_TIME,
+		pb.GameEndReason_FORCE_FORFEIT, pb.GameEndReason_TRIPLE_CHALLENGE:
+		switch res.WinnerIdx {
+		case 0:
+			return glicko.SpreadScaling
+		case 1:
+			return -glicko.SpreadScaling
+		}
+		return 0
+	}
+	return int(res.Scores[0] - res.Scores[1])
+}
+
+// unplayedLeagueGame reports whether a game was forfeited or adjudicated
+// before either player scored. Such games count in the standings but are not
+// rated.
+func unplayedLeagueGame(res LeagueGameResult) bool {
+	switch res.EndReason {
+	case pb.GameEndReason_FORCE_FORFEIT, pb.GameEndReason_ADJUDICATED:
+		return res.Scores[0] == 0 && res.Scores[1] == 0
+	}
+	return false
+}
+
+// rateLeagueGame returns both players' ratings after a game with the given
+// spread for player 0, played at now (in Unix seconds).
+func rateLeagueGame(before [2]entity.SingleRating, spread int, now int64) [2]entity.SingleRating {
+	var after [2]entity.SingleRating
+	for i := range before {
+		player, opponent := before[i], before[1-i]
+		if player.LastGameTimestamp == 0 {
+			player.LastGameTimestamp = now
+		}
+		playerSpread := spread
+		if i == 1 {
+			playerSpread = -spread
+		}
+		rating, deviation, volatility := glicko.Rate(
+			player.Rating, player.RatingDeviation, player.Volatility,
+			opponent.Rating, opponent.RatingDeviation,
+			playerSpread, int(now-player.LastGameTimestamp),
+		)
+		after[i] = entity.SingleRating{
+			Rating:            rating,
+			RatingDeviation:   deviation,
+			Volatility:        volatility,
+			LastGameTimestamp: now,
+		}
+	}
+	return after
+}
+
+// Ratings returns the league ratings of the given players. Players who have
+// not played a game in the league are left out.
+func (lrm *LeagueRatingManager) Ratings(ctx context.Context, leagueID uuid.UUID, userIDs []int32) (map[int32]entity.SingleRating, error) {
+	rows, err := lrm.store.GetLeagueRatings(ctx, models.GetLeagueRatingsParams{
+		LeagueID: leagueID,
+		UserIds:  userIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get league ratings: %w", err)
+	}
+	ratings := make(map[int32]entity.SingleRating, len(rows))
+	for _, row := range rows {
+		ratings[row.UserID] = entity.SingleRating{
+			Rating:            row.Rating,
+			RatingDeviation:   row.RatingDeviation,
+			Volatility:        row.Volatility,
+			LastGameTimestamp: row.LastGameAt.Time.Unix(),
+		}
+	}
+	return ratings, nil
+}
+
+// RateGame updates the players' league ratings after a league game. Games
+// that have already been rated, and games that were never played, are
+// ignored.
+func (lrm *LeagueRatingManager) RateGame(ctx context.Context, res LeagueGameResult) error {
+	if unplayedLeagueGame(res) {
+		return nil
+	}
+	current, err := lrm.Ratings(ctx, res.LeagueID, res.PlayerIDs[:])
+	if err != nil {
+		return err
+	}
+	var before [2]entity.SingleRating
+	for i, userID := range res.PlayerIDs {
+		r, ok := current[userID]
+		if !ok {
+			r = initialLeagueRating()
+		}
+		before[i] = r
+	}
+	_, _, err = lrm.record(ctx, res, before)
+	return err
+}
+
+// record rates a game from the players' ratings before it and saves the
+// result. It returns false if the game had already been rated.
+func (lrm *LeagueRatingManager) record(ctx context.Context, res LeagueGameResult,
+	before [2]entity.SingleRating) ([2]entity.SingleRating, bool, error) {
+
+	after := rateLeagueGame(before, leagueGameSpread(res), res.EndedAt.Unix())
+	endedAt := pgtype.Timestamptz{Time: res.EndedAt, Valid: true}
+
+	history := make([]models.AddLeagueRatingHistoryParams, 2)
+	ratings := make([]models.UpsertLeagueRatingParams, 2)
+	for i, userID := range res.PlayerIDs {
+		history[i] = models.AddLeagueRatingHistoryParams{
+			LeagueID:        res.LeagueID,
+			UserID:          userID,
+			SeasonID:        res.SeasonID,
+			GameUuid:        res.GameID,
+			OpponentID:      res.PlayerIDs[1-i],
+			RatingBefore:    before[i].Rating,
+			RatingAfter:     after[i].Rating,
+			RatingDeviation: after[i].RatingDeviation,
+			CreatedAt:       endedAt,
+		}
+		ratings[i] = models.UpsertLeagueRatingParams{
+			LeagueID:        res.LeagueID,
+			UserID:          userID,
+			Rating:          after[i].Rating,
+			RatingDeviation: after[i].RatingDeviation,
+			Volatility:      after[i].Volatility,
+			LastGameAt:      endedAt,
+		}
+	}
+	recorded, err := lrm.store.RecordLeagueGameRatings(ctx, history, ratings)
+	if err != nil {
+		return after, false, fmt.Errorf("failed to save league ratings for game %s: %w", res.GameID, err)
+	}
+	return after, recorded, nil
+}
+
+// ReplayLeague recalculates a league's ratings from scratch by rating all of
+// its finished games in the order they ended. It returns the number of
+// games rated.
+func (lrm *LeagueRatingManager) ReplayLeague(ctx context.Context, leagueID uuid.UUID) (int, error) {
+	games, err := lrm.store.GetLeagueRatedGames(ctx, leagueID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get league games: %w", err)
+	}
+	if err := lrm.store.ResetLeagueRatings(ctx, leagueID); err != nil {
+		return 0, fmt.Errorf("failed to reset league ratings: %w", err)
+	}
+
+	current := make(map[int32]entity.SingleRating)
+	rated := 0
+	for _, g := range games {
+		res := LeagueGameResult{
+			LeagueID:  leagueID,
+			SeasonID:  g.SeasonID,
+			GameID:    g.Uuid.String,
+			PlayerIDs: [2]int32{g.Player0ID.Int32, g.Player1ID.Int32},
+			Scores:    [2]int32{g.Player0Score, g.Player1Score},
+			WinnerIdx: -1,
+			EndReason: pb.GameEndReason(g.GameEndReason),
+			EndedAt:   g.EndedAt.Time,
+		}
+		if g.Player0Won.Valid {
+			res.WinnerIdx = 1
+			if g.Player0Won.Bool {
+				res.WinnerIdx = 0
+			}
+		}
+		if unplayedLeagueGame(res) {
+			continue
+		}
+		var before [2]entity.SingleRating
+		for i, userID := range res.PlayerIDs {
+			r, ok := current[userID]
+			if !ok {
+				r = initialLeagueRating()
+			}
+			before[i] = r
+		}
+		after, recorded, err := lrm.record(ctx, res, before)
+		if err != nil {
+			return rated, err
+		}
+		if !recorded {
+			continue
+		}
+		for i, userID := range res.PlayerIDs {
+			current[userID] = after[i]
+		}
+		rated++
+	}
+
+	log.Info().Str("leagueID", leagueID.String()).Int("gamesRated", rated).Msg("league-ratings-replayed")
+	return rated, nil
+}
+
+// rateLeagueGameEntity updates the league ratings after a finished league
+// game.
+func rateLeagueGameEntity(ctx context.Context, store league.Store, g *entity.Game, p0Score, p1Score int32) error {
+	var leagueID uuid.UUID
+	var seasonID pgtype.UUID
+	if g.SeasonID != nil {
+		seasonID = pgtype.UUID{Bytes: *g.SeasonID, Valid: true}
+	}
+	if g.LeagueID != nil {
+		leagueID = *g.LeagueID
+	} else {
+		division, err := store.GetDivision(ctx, *g.LeagueDivisionID)
+		if err != nil {
+			return fmt.Errorf("failed to get division: %w", err)
+		}
+		season, err := store.GetSeason(ctx, division.SeasonID)
+		if err != nil {
+			return fmt.Errorf("failed to get season: %w", err)
+		}
+		leagueID = season.LeagueID
+		seasonID = pgtype.UUID{Bytes: season.Uuid, Valid: true}
+	}
+
+	return NewLeagueRatingManager(store).RateGame(ctx, LeagueGameResult{
+		LeagueID:  leagueID,
+		SeasonID:  seasonID,
+		GameID:    g.GameID(),
+		PlayerIDs: [2]int32{int32(g.PlayerDBIDs[0]), int32(g.PlayerDBIDs[1])},
+		Scores:    [2]int32{p0Score, p1Score},
+		WinnerIdx: g.WinnerIdx,
+		EndReason: g.GameEndReason,
+		EndedAt:   time.Now(),
+	})
+}
+
+// seedingRating returns the rating used to seed a player into divisions:
+// their league rating once they have one, otherwise their average Woogles
+// rating.
+func seedingRating(leagueRatings map[int32]entity.SingleRating, userID int32, averageRating int32) int32 {
+	if r, ok := leagueRatings[userID]; ok {
+		return int32(math.Round(r.Rating))
+	}
+	return averageRating
+}
@@ -0,0 +1,65 @@
+package league
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/glicko"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+func TestLeagueGameSpread(t *testing.T) {
+	res := LeagueGameResult{Scores: [2]int32{420, 380}, WinnerIdx: 0, EndReason: ipc.GameEndReason_STANDARD}
+	assert.Equal(t, 40, leagueGameSpread(res))
+
+	// Resigning counts as the biggest possible loss, whatever the score
+	res = LeagueGameResult{Scores: [2]int32{300, 120}, WinnerIdx: 1, EndReason: ipc.GameEndReason_RESIGNED}
+	assert.Equal(t, -glicko.SpreadScaling, leagueGameSpread(res))
+
+	res = LeagueGameResult{Scores: [2]int32{0, 0}, WinnerIdx: 0, EndReason: ipc.GameEndReason_FORCE_FORFEIT}
+	assert.Equal(t, glicko.SpreadScaling, leagueGameSpread(res))
+}
+
+func TestUnplayedLeagueGame(t *testing.T) {
+	// Matches forfeited or adjudicated before a tile was played are not rated
+	res := LeagueGameResult{Scores: [2]int32{0, 0}, WinnerIdx: 0, EndReason: ipc.GameEndReason_FORCE_FORFEIT}
+	assert.True(t, unplayedLeagueGame(res))
+	res = LeagueGameResult{Scores: [2]int32{0, 0}, WinnerIdx: -1, EndReason: ipc.GameEndReason_ADJUDICATED}
+	assert.True(t, unplayedLeagueGame(res))
+
+	res = LeagueGameResult{Scores: [2]int32{0, 24}, WinnerIdx: 0, EndReason: ipc.GameEndReason_FORCE_FORFEIT}
+	assert.False(t, unplayedLeagueGame(res))
+	res = LeagueGameResult{Scores: [2]int32{212, 180}, WinnerIdx: 0, EndReason: ipc.GameEndReason_ADJUDICATED}
+	assert.False(t, unplayedLeagueGame(res))
+	res = LeagueGameResult{Scores: [2]int32{0, 0}, WinnerIdx: 1, EndReason: ipc.GameEndReason_RESIGNED}
+	assert.False(t, unplayedLeagueGame(res))
+}
+
+func TestRateLeagueGame(t *testing.T) {
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).Unix()
+	before := [2]entity.SingleRating{initialLeagueRating(), initialLeagueRating()}
+
+	after := rateLeagueGame(before, 50, now)
+	assert.Greater(t, after[0].Rating, before[0].Rating)
+	assert.Less(t, after[1].Rating, before[1].Rating)
+	assert.InDelta(t, after[0].Rating-before[0].Rating, before[1].Rating-after[1].Rating, 0.001)
+	assert.Less(t, after[0].RatingDeviation, before[0].RatingDeviation)
+	assert.Equal(t, now, after[0].LastGameTimestamp)
+	assert.Equal(t, now, after[1].LastGameTimestamp)
+
+	// A tie between equal players changes neither rating
+	after = rateLeagueGame(before, 0, now)
+	assert.InDelta(t, before[0].Rating, after[0].Rating, 0.001)
+	assert.InDelta(t, before[1].Rating, after[1].Rating, 0.001)
+}
+
+func TestSeedingRating(t *testing.T) {
+	leagueRatings := map[int32]entity.SingleRating{1: {Rating: 1612.6}}
+
+	assert.Equal(t, int32(1613), seedingRating(leagueRatings, 1, 1400))
+	// Players new to the league are seeded by their Woogles rating
+	assert.Equal(t, int32(1400), seedingRating(leagueRatings, 2, 1400))
+}
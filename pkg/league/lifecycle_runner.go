@@ -18,6 +18,9 @@ import (
 
 // parseLeagueSettings parses the JSONB settings from the database
 func parseLeagueSettings(settingsJSON []byte) (*pb.LeagueSettings, error) {
+	if len(settingsJSON) == 0 {
+		return &pb.LeagueSettings{}, nil
+	}
 	var settings pb.LeagueSettings
 	err := json.Unmarshal(settingsJSON, &settings)
 	if err != nil {
@@ -36,6 +39,9 @@ type LifecycleRunnerResult struct {
 	SeasonStarted            bool
 	NextSeasonCreated        bool
 	GamesCreated             int
+	PlayoffGamesCreated      int
+	MatchesForfeited         int
+	MatchRemindersSent       int
 }
 
 // RunLeagueLifecycleTasks executes all automated league lifecycle tasks for a single league
@@ -88,11 +94,78 @@ func RunLeagueLifecycleTasks(
 
 	lifecycleMgr := NewSeasonLifecycleManager(allStores, clock)
 
-	// TASK 1: Close current season (if end time has passed and not already closed)
+	// TASK 0: Forfeit unplayed matches of closed match windows and remind
+	// players of upcoming deadlines. This runs before the season is closed so
+	// that the last window's matches count towards the final standings.
+	if hasCurrentSeason && currentSeason.Status == int32(pb.SeasonStatus_SEASON_ACTIVE) &&
+		IsLiveLeague(leagueSettings) {
+		matchMgr := NewMatchScheduleManager(allStores, cfg, gameCreator, clock)
+		windowResult, err := matchMgr.ProcessMatchWindows(ctx, dbLeague.Uuid, currentSeason.Uuid, leagueSettings)
+		if err != nil {
+			log.Error().Err(err).Str("seasonID", currentSeason.Uuid.String()).Msg("Failed to process match windows")
+		} else {
+			for _, errMsg := range windowResult.Errors {
+				log.Warn().Str("seasonID", currentSeason.Uuid.String()).Msg(errMsg)
+			}
+			if windowResult.Forfeited > 0 {
+				log.Info().
+					Str("seasonID", currentSeason.Uuid.String()).
+					Int("forfeited", windowResult.Forfeited).
+					Msg("✓ Forfeited unplayed matches")
+				result.TasksRun++
+				result.MatchesForfeited = windowResult.Forfeited
+			}
+			if len(windowResult.Reminders) > 0 {
+				wg.Add(1)
+				go func(seasonNumber int32, reminders []MatchReminder) {
+					defer wg.Done()
+					SendMatchReminderEmails(ctx, cfg, allStores.UserStore, dbLeague.Name, dbLeague.Slug, int(seasonNumber), reminders)
+				}(currentSeason.SeasonNumber, windowResult.Reminders)
+				result.TasksRun++
+				result.MatchRemindersSent = len(windowResult.Reminders)
+			}
+		}
+	}
+
+	// TASK 0b: Start the division playoffs in the last days of the season,
+	// and keep advancing them until every bracket has a champion. The season
+	// is held open past its end date until then.
+	playoffsComplete := true
+	if hasCurrentSeason && currentSeason.Status == int32(pb.SeasonStatus_SEASON_ACTIVE) &&
+		PlayoffSize(leagueSettings) > 0 {
+		endTime := currentSeason.EndDate.Time
+		playoffStart := endTime.AddDate(0, 0, -PlayoffDays(leagueSettings))
+
+		playoffsComplete = false
+		if !now.Before(playoffStart) {
+			playoffMgr := NewPlayoffManager(allStores, cfg, gameCreator)
+			gamesCreated, complete, err := playoffMgr.AdvancePlayoffs(ctx, dbLeague.Uuid, currentSeason.Uuid, leagueSettings)
+			if err != nil {
+				log.Error().Err(err).Str("seasonID", currentSeason.Uuid.String()).Msg("Failed to advance playoffs")
+			}
+			playoffsComplete = complete
+			if gamesCreated > 0 {
+				log.Info().
+					Str("seasonID", currentSeason.Uuid.String()).
+					Int("gamesCreated", gamesCreated).
+					Msg("✓ Created playoff games")
+				result.TasksRun++
+				result.PlayoffGamesCreated = gamesCreated
+			}
+		}
+	}
+
+	// TASK 1: Close current season (if end time has passed, its playoffs are
+	// complete and it's not already closed)
 	if hasCurrentSeason && currentSeason.Status == int32(pb.SeasonStatus_SEASON_ACTIVE) {
 		endTime := currentSeason.EndDate.Time
 
-		if now.After(endTime) || now.Equal(endTime) {
+		if (now.After(endTime) || now.Equal(endTime)) && !playoffsComplete {
+			log.Info().
+				Str("seasonID", currentSeason.Uuid.String()).
+				Time("endTime", endTime).
+				Msg("Holding season open until its playoffs are complete")
+		} else if now.After(endTime) || now.Equal(endTime) {
 			// Check idempotency
 			if !currentSeason.ClosedAt.Valid {
 				log.Info().
@@ -4,13 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
 
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/gameplay"
 	"github.com/woogles-io/liwords/pkg/stores"
+	"github.com/woogles-io/liwords/pkg/stores/league"
 	"github.com/woogles-io/liwords/pkg/stores/models"
 	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
@@ -20,6 +26,10 @@ import (
 // e.g. via MovePlayer.
 var ErrDivisionNotEmpty = errors.New("division still has players registered")
 
+// ErrInvalidSubstitution is returned by SubstitutePlayer when the players
+// given cannot be swapped.
+var ErrInvalidSubstitution = errors.New("invalid substitution")
+
 // ManualDivisionManager provides tools for manual division management
 type ManualDivisionManager struct {
 	stores *stores.Stores
@@ -54,6 +64,12 @@ type DeleteDivisionResult struct {
 	DivisionsRenumbered int
 }
 
+// SubstitutionResult tracks the outcome of substituting a player
+type SubstitutionResult struct {
+	DivisionID    uuid.UUID
+	GamesReplaced int
+}
+
 // MergeDivisions merges all players from mergingDiv into receivingDiv,
 // deletes mergingDiv, and renumbers remaining divisions sequentially.
 //
@@ -415,3 +431,264 @@ func (mdm *ManualDivisionManager) CreateDivision(
 
 	return newDiv, nil
 }
+
+// SubstitutePlayer replaces a player who dropped out of an active season
+// with a player from the league's substitute pool. The substitute takes the
+// dropped player's place in their division and plays their unplayed games:
+// games in progress are aborted and recreated with the substitute in the
+// same seat, and in live leagues the unplayed matches are handed over. How
+// the dropped player's completed games count is decided by handling.
+//
+// The substitution is recorded along with the games it has to replace before
+// any game is touched. If replacing a game fails, calling SubstitutePlayer
+// again with the same players resumes with the games still pending.
+func (mdm *ManualDivisionManager) SubstitutePlayer(
+	ctx context.Context,
+	gameCreator GameCreator,
+	seasonID uuid.UUID,
+	droppedUserID string, // UUID string
+	substituteUserID string, // UUID string
+	handling ipc.SubstituteResultHandling,
+) (*SubstitutionResult, error) {
+	if droppedUserID == substituteUserID {
+		return nil, fmt.Errorf("%w: a player cannot substitute for themselves", ErrInvalidSubstitution)
+	}
+	season, settings, err := seasonLeagueSettings(ctx, mdm.stores.LeagueStore, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	dropped, err := mdm.stores.UserStore.GetByUUID(ctx, droppedUserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	substitute, err := mdm.stores.UserStore.GetByUUID(ctx, substituteUserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	result := &SubstitutionResult{}
+	substitution, err := mdm.stores.LeagueStore.GetSubstitution(ctx, models.GetSubstitutionParams{
+		SeasonID:      seasonID,
+		DroppedUserID: int32(dropped.ID),
+	})
+	switch {
+	case err == nil:
+		if substitution.SubstituteUserID != int32(substitute.ID) {
+			return nil, fmt.Errorf("%w: %s has already been substituted", ErrInvalidSubstitution, dropped.Username)
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		handedOver, err := mdm.recordSubstitution(ctx, season, dropped, substitute, handling)
+		if err != nil {
+			return nil, err
+		}
+		result.GamesReplaced = handedOver
+		substitution, err = mdm.stores.LeagueStore.GetSubstitution(ctx, models.GetSubstitutionParams{
+			SeasonID:      seasonID,
+			DroppedUserID: int32(dropped.ID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get substitution: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("failed to get substitution: %w", err)
+	}
+	divisionID := substitution.DivisionID
+	result.DivisionID = divisionID
+
+	pending, err := mdm.stores.LeagueStore.GetPendingSubstitutionGames(ctx, substitution.ID)
+	if err != nil {
+		return result, fmt.Errorf("failed to get substituted games: %w", err)
+	}
+	live := IsLiveLeague(settings)
+	msm := NewMatchScheduleManager(mdm.stores, nil, gameCreator, RealClock{})
+	for _, game := range pending {
+		err := mdm.replaceSubstitutedGame(ctx, msm, gameCreator, season, divisionID, settings,
+			droppedUserID, substituteUserID, game)
+		if err != nil {
+			return result, err
+		}
+		// Live games are replayed through their match, which has been reset
+		if !live {
+			result.GamesReplaced++
+		}
+	}
+
+	// Rebuild the division's standings with the substitution applied
+	standingsMgr := NewStandingsManager(mdm.stores.LeagueStore)
+	if err := standingsMgr.RecalculateAndSaveStandings(ctx, seasonID); err != nil {
+		return result, fmt.Errorf("failed to recalculate standings: %w", err)
+	}
+	if err := standingsMgr.RecalculateSeasonExtendedStats(ctx, seasonID); err != nil {
+		return result, fmt.Errorf("failed to recalculate extended stats: %w", err)
+	}
+	if err := standingsMgr.RecalculateSeasonMistakeIndex(ctx, seasonID); err != nil {
+		return result, fmt.Errorf("failed to recalculate mistake index: %w", err)
+	}
+	InvalidateDivisionForecast(divisionID)
+
+	log.Info().
+		Str("seasonID", seasonID.String()).
+		Str("divisionID", divisionID.String()).
+		Str("droppedUserID", droppedUserID).
+		Str("substituteUserID", substituteUserID).
+		Str("handling", handling.String()).
+		Int("gamesReplaced", result.GamesReplaced).
+		Msg("player-substituted")
+
+	return result, nil
+}
+
+// recordSubstitution checks that substitute can take dropped's place and
+// records the substitution, along with dropped's games in progress. It
+// returns the number of matches handed over to the substitute.
+func (mdm *ManualDivisionManager) recordSubstitution(
+	ctx context.Context,
+	season models.LeagueSeason,
+	dropped, substitute *entity.User,
+	handling ipc.SubstituteResultHandling,
+) (int, error) {
+	droppedDBID := int32(dropped.ID)
+	substituteDBID := int32(substitute.ID)
+
+	reg, err := mdm.stores.LeagueStore.GetPlayerRegistration(ctx, models.GetPlayerRegistrationParams{
+		SeasonID: season.Uuid,
+		UserID:   droppedDBID,
+	})
+	if err != nil || !reg.DivisionID.Valid {
+		return 0, fmt.Errorf("%w: %s is not playing in this season", ErrInvalidSubstitution, dropped.Username)
+	}
+
+	subReg, err := mdm.stores.LeagueStore.GetPlayerRegistration(ctx, models.GetPlayerRegistrationParams{
+		SeasonID: season.Uuid,
+		UserID:   substituteDBID,
+	})
+	if err == nil && subReg.DivisionID.Valid {
+		return 0, fmt.Errorf("%w: %s is already playing in this season", ErrInvalidSubstitution, substitute.Username)
+	}
+
+	pool, err := mdm.stores.LeagueStore.GetLeagueSubstitutes(ctx, season.LeagueID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get substitute pool: %w", err)
+	}
+	if !slices.ContainsFunc(pool, func(s models.GetLeagueSubstitutesRow) bool { return s.UserID == substituteDBID }) {
+		return 0, fmt.Errorf("%w: %s is not in the substitute pool", ErrInvalidSubstitution, substitute.Username)
+	}
+
+	playoffGames, err := mdm.stores.LeagueStore.CountSeasonPlayoffGames(ctx, season.Uuid)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count playoff games: %w", err)
+	}
+	if playoffGames > 0 {
+		return 0, fmt.Errorf("%w: the playoff has already started", ErrInvalidSubstitution)
+	}
+
+	// Find the games in progress before the matches holding them are reset
+	ongoingGames, err := mdm.stores.Queries.GetPlayerUnfinishedSeasonGames(ctx, models.GetPlayerUnfinishedSeasonGamesParams{
+		SeasonID: pgtype.UUID{Bytes: season.Uuid, Valid: true},
+		PlayerID: pgtype.Int4{Int32: droppedDBID, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get unfinished games: %w", err)
+	}
+	gameIDs := make([]string, 0, len(ongoingGames))
+	for _, g := range ongoingGames {
+		gameIDs = append(gameIDs, g.GameID.String)
+	}
+
+	matchIDs, err := mdm.stores.LeagueStore.SubstitutePlayer(ctx, league.SubstitutionParams{
+		SeasonID:      season.Uuid,
+		DivisionID:    uuid.UUID(reg.DivisionID.Bytes),
+		DroppedUserID: droppedDBID,
+		Substitute: models.RegisterPlayerParams{
+			UserID:               substituteDBID,
+			SeasonID:             season.Uuid,
+			DivisionID:           reg.DivisionID,
+			RegistrationDate:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			FirstsCount:          reg.FirstsCount,
+			Status:               pgtype.Text{String: "SUBSTITUTE", Valid: true},
+			PlacementStatus:      pgtype.Int4{Int32: int32(ipc.PlacementStatus_PLACEMENT_NONE), Valid: true},
+			PreviousDivisionRank: pgtype.Int4{Valid: false},
+			SeasonsAway:          pgtype.Int4{Int32: 0, Valid: true},
+		},
+		Handling: int32(handling),
+		GameIDs:  gameIDs,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to substitute player: %w", err)
+	}
+	return len(matchIDs), nil
+}
+
+// replaceSubstitutedGame aborts one of the dropped player's games and, outside
+// live leagues, starts its replacement with the substitute in the dropped
+// player's seat. Each step is skipped if an earlier attempt already did it, and
+// the game is only marked done once all of them have succeeded.
+func (mdm *ManualDivisionManager) replaceSubstitutedGame(
+	ctx context.Context,
+	msm *MatchScheduleManager,
+	gameCreator GameCreator,
+	season models.LeagueSeason,
+	divisionID uuid.UUID,
+	settings *ipc.LeagueSettings,
+	droppedUserID, substituteUserID string,
+	pending models.GetPendingSubstitutionGamesRow,
+) error {
+	gameID := pending.GameUuid
+	g, err := mdm.stores.GameStore.Get(ctx, gameID)
+	if err != nil {
+		return fmt.Errorf("failed to load game %s: %w", gameID, err)
+	}
+	if g.GameEndReason == ipc.GameEndReason_NONE {
+		mdm.stores.GameStore.LockGame(gameID)
+		g.Lock()
+		err = gameplay.AbortGame(ctx, mdm.stores, g, ipc.GameEndReason_ABORTED)
+		g.Unlock()
+		mdm.stores.GameStore.UnlockGame(gameID)
+		if err != nil {
+			return fmt.Errorf("failed to abort game %s: %w", gameID, err)
+		}
+	}
+
+	if !IsLiveLeague(settings) {
+		newGameID := pending.ReplacementGameUuid.String
+		if !pending.ReplacementGameUuid.Valid {
+			players := [2]string{g.Quickdata.PlayerInfo[0].UserId, g.Quickdata.PlayerInfo[1].UserId}
+			for i := range players {
+				if players[i] == droppedUserID {
+					players[i] = substituteUserID
+				}
+			}
+			newGame, err := msm.createMatchGame(ctx, season.LeagueID, season.Uuid, divisionID, players[0], players[1], settings)
+			if err != nil {
+				return err
+			}
+			newGameID = newGame.Uid()
+			err = mdm.stores.LeagueStore.SetSubstitutionReplacementGame(ctx, models.SetSubstitutionReplacementGameParams{
+				ReplacementGameUuid: pgtype.Text{String: newGameID, Valid: true},
+				GameUuid:            gameID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record replacement for game %s: %w", gameID, err)
+			}
+		}
+		newGame, err := mdm.stores.GameStore.Get(ctx, newGameID)
+		if err != nil {
+			return fmt.Errorf("failed to load game %s: %w", newGameID, err)
+		}
+		if !newGame.Started {
+			// The league fields are only written when the game starts
+			newGame.LeagueID = &season.LeagueID
+			newGame.SeasonID = &season.Uuid
+			newGame.LeagueDivisionID = &divisionID
+			if err := gameCreator.StartGame(ctx, newGame); err != nil {
+				return fmt.Errorf("failed to start game %s: %w", newGameID, err)
+			}
+		}
+	}
+
+	if err := mdm.stores.LeagueStore.FinishSubstitutionGame(ctx, gameID); err != nil {
+		return fmt.Errorf("failed to finish replacing game %s: %w", gameID, err)
+	}
+	return nil
+}
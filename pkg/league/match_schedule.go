@@ -0,0 +1,429 @@
+package league
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/gameplay"
+	"github.com/woogles-io/liwords/pkg/stores"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/league_service"
+)
+
+const (
+	// DefaultMatchWindowDays is the length of a live league match window
+	DefaultMatchWindowDays = 7
+	// DefaultMatchReminderHours is how long before a deadline players are reminded
+	DefaultMatchReminderHours = 24
+	// DefaultLiveInitialTimeMinutes is the initial time of live league games
+	DefaultLiveInitialTimeMinutes = 20
+	// MatchStartEarlyMinutes is how long before the agreed time a match can be started
+	MatchStartEarlyMinutes = 15
+)
+
+// ErrMatchAlreadyStarted is returned when a match's game has already been
+// created.
+var ErrMatchAlreadyStarted = errors.New("match has already been started")
+
+// IsLiveLeague returns whether the league plays live games in match windows
+// rather than correspondence games.
+func IsLiveLeague(settings *ipc.LeagueSettings) bool {
+	return settings != nil && settings.Live != nil
+}
+
+// MatchWindowDays returns the length of each match window in days.
+func MatchWindowDays(settings *ipc.LeagueSettings) int {
+	if !IsLiveLeague(settings) || settings.Live.MatchWindowDays < 1 {
+		return DefaultMatchWindowDays
+	}
+	return int(settings.Live.MatchWindowDays)
+}
+
+// MatchReminderHours returns how many hours before a deadline players are
+// reminded of their match.
+func MatchReminderHours(settings *ipc.LeagueSettings) int {
+	if !IsLiveLeague(settings) || settings.Live.ReminderHours < 1 {
+		return DefaultMatchReminderHours
+	}
+	return int(settings.Live.ReminderHours)
+}
+
+// matchWindow is the period a live league matchup has to be played in.
+type matchWindow struct {
+	start, end time.Time
+}
+
+// matchWindows splits a season into consecutive windows of the league's
+// window length. The last window runs until the end of the season.
+func matchWindows(start, end time.Time, settings *ipc.LeagueSettings) []matchWindow {
+	length := time.Duration(MatchWindowDays(settings)) * 24 * time.Hour
+	n := int(end.Sub(start) / length)
+	if n < 1 {
+		n = 1
+	}
+	windows := make([]matchWindow, n)
+	for i := range windows {
+		windows[i] = matchWindow{
+			start: start.Add(time.Duration(i) * length),
+			end:   start.Add(time.Duration(i+1) * length),
+		}
+	}
+	windows[n-1].end = end
+	return windows
+}
+
+// windowForRound spreads the pairing rounds evenly and in order over the
+// windows, returning the 0-based window of a round.
+func windowForRound(round, numRounds, numWindows int) int {
+	if numRounds <= 0 {
+		return 0
+	}
+	return round * numWindows / numRounds
+}
+
+// matchStatus returns the status of a match as shown to players. A started
+// match whose game is over has been played.
+func matchStatus(status int32, gameEndReason pgtype.Int4) pb.LeagueMatchStatus {
+	if status == int32(pb.LeagueMatchStatus_MATCH_STARTED) && gameEndReason.Valid &&
+		gameEndReason.Int32 != int32(ipc.GameEndReason_NONE) {
+		return pb.LeagueMatchStatus_MATCH_PLAYED
+	}
+	return pb.LeagueMatchStatus(status)
+}
+
+// forfeitLoser decides who forfeits a match that was not played in its
+// window. A player who proposed a time, or accepted their opponent's, did
+// their part; if only one of the players did, the other one forfeits.
+// Otherwise it returns -1, and the game is adjudicated as an unplayed draw.
+func forfeitLoser(player0ID, player1ID int32, proposals []models.GetMatchProposalsRow) int {
+	var responsive [2]bool
+	for _, p := range proposals {
+		proposer := 0
+		if p.ProposerID == player1ID {
+			proposer = 1
+		} else if p.ProposerID != player0ID {
+			continue
+		}
+		responsive[proposer] = true
+		if p.Status == int32(pb.MatchProposalStatus_PROPOSAL_ACCEPTED) {
+			responsive[1-proposer] = true
+		}
+	}
+	switch {
+	case responsive[0] && !responsive[1]:
+		return 1
+	case responsive[1] && !responsive[0]:
+		return 0
+	}
+	return -1
+}
+
+// MatchScheduleManager runs the match windows of live leagues: it creates the
+// season's matchups, starts their games and forfeits the ones that are not
+// played in time.
+type MatchScheduleManager struct {
+	stores       *stores.Stores
+	gameCreator  GameCreator
+	seasonStarts *SeasonStartManager
+	clock        Clock
+}
+
+// NewMatchScheduleManager creates a new match schedule manager
+func NewMatchScheduleManager(allStores *stores.Stores, cfg *config.Config, gameCreator GameCreator, clock Clock) *MatchScheduleManager {
+	return &MatchScheduleManager{
+		stores:       allStores,
+		gameCreator:  gameCreator,
+		seasonStarts: NewSeasonStartManager(allStores.LeagueStore, allStores, cfg, gameCreator),
+		clock:        clock,
+	}
+}
+
+// createMatchesForDivision creates a match for every pairing of a division,
+// assigning the pairing rounds to the season's match windows.
+func (msm *MatchScheduleManager) createMatchesForDivision(
+	ctx context.Context,
+	seasonID uuid.UUID,
+	division models.LeagueDivision,
+	registrations []models.GetDivisionRegistrationsRow,
+	pairings []*GamePairing,
+	leagueSettings *ipc.LeagueSettings,
+) (int, error) {
+	season, err := msm.stores.LeagueStore.GetSeason(ctx, seasonID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get season: %w", err)
+	}
+	windows := matchWindows(season.StartDate.Time, season.EndDate.Time, leagueSettings)
+
+	numRounds := 0
+	for _, p := range pairings {
+		numRounds = max(numRounds, p.Round+1)
+	}
+
+	for i, pairing := range pairings {
+		player0, player1 := registrations[pairing.Player1Index], registrations[pairing.Player2Index]
+		if !pairing.IsPlayer1First {
+			player0, player1 = player1, player0
+		}
+		w := windowForRound(pairing.Round, numRounds, len(windows))
+		err := msm.stores.LeagueStore.CreateLeagueMatch(ctx, models.CreateLeagueMatchParams{
+			Uuid:         uuid.New(),
+			SeasonID:     seasonID,
+			DivisionID:   division.Uuid,
+			WindowNumber: int32(w + 1),
+			WindowStart:  pgtype.Timestamptz{Time: windows[w].start, Valid: true},
+			WindowEnd:    pgtype.Timestamptz{Time: windows[w].end, Valid: true},
+			Player0ID:    player0.UserID,
+			Player1ID:    player1.UserID,
+		})
+		if err != nil {
+			return i, fmt.Errorf("failed to create match: %w", err)
+		}
+	}
+
+	log.Info().
+		Str("divisionID", division.Uuid.String()).
+		Int("matches", len(pairings)).
+		Int("windows", len(windows)).
+		Msg("created-live-matches-for-division")
+
+	return len(pairings), nil
+}
+
+// StartMatch creates and starts the game of a scheduled match. The match is
+// claimed first so that it can only be started once, and the game is linked
+// to the match before it starts. If the game cannot be started it is
+// cancelled and the match is released.
+func (msm *MatchScheduleManager) StartMatch(
+	ctx context.Context,
+	leagueID uuid.UUID,
+	match models.GetLeagueMatchRow,
+	leagueSettings *ipc.LeagueSettings,
+) (string, error) {
+	claimed, err := msm.stores.LeagueStore.ClaimLeagueMatch(ctx, models.ClaimLeagueMatchParams{
+		Status: int32(pb.LeagueMatchStatus_MATCH_STARTED),
+		Uuid:   match.Uuid,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to claim match: %w", err)
+	}
+	if claimed == 0 {
+		return "", ErrMatchAlreadyStarted
+	}
+
+	game, err := msm.createMatchGame(ctx, leagueID, match.SeasonID, match.DivisionID,
+		match.Player0Uuid, match.Player1Uuid, leagueSettings)
+	if err != nil {
+		msm.releaseMatch(ctx, match.Uuid, match.Status, "")
+		return "", err
+	}
+	err = msm.stores.LeagueStore.SetLeagueMatchGame(ctx, match.Uuid, game.GameID())
+	if err != nil {
+		err = fmt.Errorf("failed to record match game: %w", err)
+	} else {
+		err = msm.gameCreator.StartGame(ctx, game)
+	}
+	if err != nil {
+		if cerr := gameplay.AbortGame(ctx, msm.stores, game, ipc.GameEndReason_CANCELLED); cerr != nil {
+			log.Err(cerr).Str("gameID", game.GameID()).Msg("failed-to-cancel-league-match-game")
+		}
+		msm.releaseMatch(ctx, match.Uuid, match.Status, game.GameID())
+		return "", err
+	}
+	return game.GameID(), nil
+}
+
+// releaseMatch gives back a match claimed by ClaimLeagueMatch, unlinking
+// gameID if it was recorded.
+func (msm *MatchScheduleManager) releaseMatch(ctx context.Context, matchID uuid.UUID, status int32, gameID string) {
+	err := msm.stores.LeagueStore.ReleaseLeagueMatch(ctx, models.ReleaseLeagueMatchParams{
+		Status:   status,
+		Uuid:     matchID,
+		GameUuid: pgtype.Text{String: gameID, Valid: gameID != ""},
+	})
+	if err != nil {
+		log.Err(err).Str("matchID", matchID.String()).Msg("failed-to-release-league-match")
+	}
+}
+
+// createMatchGame creates, but does not start, a division game between the
+// two players; player0 goes first. The game is live in live leagues.
+func (msm *MatchScheduleManager) createMatchGame(
+	ctx context.Context,
+	leagueID, seasonID, divisionID uuid.UUID,
+	player0UUID, player1UUID string,
+	leagueSettings *ipc.LeagueSettings,
+) (*entity.Game, error) {
+	user0, err := msm.stores.UserStore.GetByUUID(ctx, player0UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", player0UUID, err)
+	}
+	user1, err := msm.stores.UserStore.GetByUUID(ctx, player1UUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", player1UUID, err)
+	}
+	gameReq, err := msm.seasonStarts.buildGameRequest(leagueSettings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build game request: %w", err)
+	}
+	game, err := msm.gameCreator.InstantiateNewGame(ctx, [2]*entity.User{user0, user1}, gameReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game: %w", err)
+	}
+	game.LeagueID = &leagueID
+	game.SeasonID = &seasonID
+	game.LeagueDivisionID = &divisionID
+	return game, nil
+}
+
+// MatchReminder is a reminder to send to one player of a match.
+type MatchReminder struct {
+	UserUUID         string
+	OpponentUsername string
+	WindowEnd        time.Time
+	// ScheduledTime is set for reminders of an agreed time; otherwise the
+	// player is reminded to schedule the match before the window closes.
+	ScheduledTime time.Time
+}
+
+// MatchWindowResult tracks the outcome of processing a season's match windows
+type MatchWindowResult struct {
+	Forfeited int
+	Reminders []MatchReminder
+	Errors    []string
+}
+
+// ProcessMatchWindows forfeits the matches whose window has closed without a
+// game and collects the reminders that are due: players with an unscheduled
+// match when its window is about to close, and players whose agreed time is
+// coming up. Each reminder is only collected once.
+func (msm *MatchScheduleManager) ProcessMatchWindows(
+	ctx context.Context,
+	leagueID uuid.UUID,
+	seasonID uuid.UUID,
+	leagueSettings *ipc.LeagueSettings,
+) (*MatchWindowResult, error) {
+	result := &MatchWindowResult{}
+	now := msm.clock.Now()
+	reminderLead := time.Duration(MatchReminderHours(leagueSettings)) * time.Hour
+
+	matches, err := msm.stores.LeagueStore.GetSeasonLeagueMatches(ctx, seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matches: %w", err)
+	}
+
+	var expired []models.GetSeasonLeagueMatchesRow
+	for _, m := range matches {
+		if m.GameUuid.Valid || m.Status == int32(pb.LeagueMatchStatus_MATCH_FORFEITED) {
+			continue
+		}
+		windowEnd := m.WindowEnd.Time
+		switch {
+		case !now.Before(windowEnd):
+			expired = append(expired, m)
+
+		case m.Status == int32(pb.LeagueMatchStatus_MATCH_PENDING) && !m.WindowReminderSentAt.Valid &&
+			!now.Before(m.WindowStart.Time) && !now.Before(windowEnd.Add(-reminderLead)):
+			if err := msm.stores.LeagueStore.MarkMatchWindowReminderSent(ctx, m.Uuid); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("match %s: %v", m.Uuid, err))
+				continue
+			}
+			result.Reminders = append(result.Reminders,
+				MatchReminder{UserUUID: m.Player0Uuid, OpponentUsername: m.Player1Username, WindowEnd: windowEnd},
+				MatchReminder{UserUUID: m.Player1Uuid, OpponentUsername: m.Player0Username, WindowEnd: windowEnd})
+
+		case m.Status == int32(pb.LeagueMatchStatus_MATCH_SCHEDULED) && !m.SlotReminderSentAt.Valid &&
+			now.Before(m.ScheduledTime.Time) && !now.Before(m.ScheduledTime.Time.Add(-reminderLead)):
+			if err := msm.stores.LeagueStore.MarkMatchSlotReminderSent(ctx, m.Uuid); err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("match %s: %v", m.Uuid, err))
+				continue
+			}
+			slot := m.ScheduledTime.Time
+			result.Reminders = append(result.Reminders,
+				MatchReminder{UserUUID: m.Player0Uuid, OpponentUsername: m.Player1Username, WindowEnd: windowEnd, ScheduledTime: slot},
+				MatchReminder{UserUUID: m.Player1Uuid, OpponentUsername: m.Player0Username, WindowEnd: windowEnd, ScheduledTime: slot})
+		}
+	}
+
+	if len(expired) == 0 {
+		return result, nil
+	}
+	matchIDs := make([]uuid.UUID, len(expired))
+	for i, m := range expired {
+		matchIDs[i] = m.Uuid
+	}
+	proposals, err := msm.stores.LeagueStore.GetMatchProposals(ctx, matchIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match proposals: %w", err)
+	}
+	byMatch := make(map[uuid.UUID][]models.GetMatchProposalsRow)
+	for _, p := range proposals {
+		byMatch[p.MatchID] = append(byMatch[p.MatchID], p)
+	}
+	for _, m := range expired {
+		loser := forfeitLoser(m.Player0ID, m.Player1ID, byMatch[m.Uuid])
+		if err := msm.forfeitMatch(ctx, leagueID, m, loser, leagueSettings); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("match %s: %v", m.Uuid, err))
+			continue
+		}
+		result.Forfeited++
+	}
+
+	return result, nil
+}
+
+// forfeitMatch records an unplayed match as a game that is over at once:
+// forfeited by loser, or adjudicated at 0-0 if loser is -1.
+func (msm *MatchScheduleManager) forfeitMatch(
+	ctx context.Context,
+	leagueID uuid.UUID,
+	m models.GetSeasonLeagueMatchesRow,
+	loser int,
+	leagueSettings *ipc.LeagueSettings,
+) error {
+	claimed, err := msm.stores.LeagueStore.ClaimLeagueMatch(ctx, models.ClaimLeagueMatchParams{
+		Status: int32(pb.LeagueMatchStatus_MATCH_FORFEITED),
+		Uuid:   m.Uuid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim match: %w", err)
+	}
+	if claimed == 0 {
+		// Started at the last minute
+		return nil
+	}
+
+	game, err := msm.createMatchGame(ctx, leagueID, m.SeasonID, m.DivisionID,
+		m.Player0Uuid, m.Player1Uuid, leagueSettings)
+	if err != nil {
+		msm.releaseMatch(ctx, m.Uuid, m.Status, "")
+		return err
+	}
+	if err := msm.stores.LeagueStore.SetLeagueMatchGame(ctx, m.Uuid, game.GameID()); err != nil {
+		return fmt.Errorf("failed to record match game: %w", err)
+	}
+
+	if loser >= 0 {
+		err = gameplay.ForfeitGame(ctx, game, loser, msm.stores)
+	} else {
+		err = gameplay.AdjudicateGame(ctx, game, msm.stores)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to end game %s: %w", game.GameID(), err)
+	}
+
+	log.Info().
+		Str("matchID", m.Uuid.String()).
+		Str("gameID", game.GameID()).
+		Int("loser", loser).
+		Msg("forfeited-league-match")
+	return nil
+}
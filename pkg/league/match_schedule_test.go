@@ -0,0 +1,84 @@
+package league
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/league_service"
+)
+
+func TestMatchWindows(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	live := &ipc.LeagueSettings{Live: &ipc.LiveSettings{}}
+
+	// 30 days make four weekly windows; the last one takes the extra days
+	windows := matchWindows(start, start.AddDate(0, 0, 30), live)
+	require.Len(t, windows, 4)
+	for i, w := range windows {
+		assert.Equal(t, start.AddDate(0, 0, 7*i), w.start)
+	}
+	assert.Equal(t, start.AddDate(0, 0, 14), windows[1].end)
+	assert.Equal(t, start.AddDate(0, 0, 30), windows[3].end)
+
+	// A season shorter than a window is a single window
+	windows = matchWindows(start, start.AddDate(0, 0, 3), live)
+	require.Len(t, windows, 1)
+	assert.Equal(t, start.AddDate(0, 0, 3), windows[0].end)
+
+	live.Live.MatchWindowDays = 3
+	assert.Len(t, matchWindows(start, start.AddDate(0, 0, 30), live), 10)
+}
+
+func TestWindowForRound(t *testing.T) {
+	// 13 rounds over 4 windows: rounds are spread evenly and in order
+	counts := make([]int, 4)
+	prev := 0
+	for round := range 13 {
+		w := windowForRound(round, 13, 4)
+		require.GreaterOrEqual(t, w, prev)
+		counts[w]++
+		prev = w
+	}
+	assert.Equal(t, []int{4, 3, 3, 3}, counts)
+
+	// More windows than rounds leaves some windows empty
+	assert.Equal(t, 0, windowForRound(0, 2, 4))
+	assert.Equal(t, 2, windowForRound(1, 2, 4))
+}
+
+func TestForfeitLoser(t *testing.T) {
+	proposal := func(proposer int32, status pb.MatchProposalStatus) models.GetMatchProposalsRow {
+		return models.GetMatchProposalsRow{ProposerID: proposer, Status: int32(status)}
+	}
+	open, declined, accepted := pb.MatchProposalStatus_PROPOSAL_OPEN,
+		pb.MatchProposalStatus_PROPOSAL_DECLINED, pb.MatchProposalStatus_PROPOSAL_ACCEPTED
+
+	// Nobody responded
+	assert.Equal(t, -1, forfeitLoser(1, 2, nil))
+	// Only player 0 proposed times
+	assert.Equal(t, 1, forfeitLoser(1, 2, []models.GetMatchProposalsRow{proposal(1, open), proposal(1, declined)}))
+	// Only player 1 proposed times
+	assert.Equal(t, 0, forfeitLoser(1, 2, []models.GetMatchProposalsRow{proposal(2, open)}))
+	// Both proposed
+	assert.Equal(t, -1, forfeitLoser(1, 2, []models.GetMatchProposalsRow{proposal(1, declined), proposal(2, open)}))
+	// Player 1 accepted player 0's proposal but they did not play
+	assert.Equal(t, -1, forfeitLoser(1, 2, []models.GetMatchProposalsRow{proposal(1, accepted)}))
+	// Proposals from other users are ignored
+	assert.Equal(t, -1, forfeitLoser(1, 2, []models.GetMatchProposalsRow{proposal(3, open)}))
+}
+
+func TestMatchStatus(t *testing.T) {
+	started := int32(pb.LeagueMatchStatus_MATCH_STARTED)
+	assert.Equal(t, pb.LeagueMatchStatus_MATCH_STARTED,
+		matchStatus(started, pgtype.Int4{Int32: int32(ipc.GameEndReason_NONE), Valid: true}))
+	assert.Equal(t, pb.LeagueMatchStatus_MATCH_PLAYED,
+		matchStatus(started, pgtype.Int4{Int32: int32(ipc.GameEndReason_STANDARD), Valid: true}))
+	assert.Equal(t, pb.LeagueMatchStatus_MATCH_SCHEDULED,
+		matchStatus(int32(pb.LeagueMatchStatus_MATCH_SCHEDULED), pgtype.Int4{}))
+}
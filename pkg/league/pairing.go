@@ -108,6 +108,45 @@ func GenerateAllLeaguePairings(numPlayers int, seed uint64, maxRounds int) ([]*G
 	return allPairings, nil
 }
 
+// ExpandLeaguePairings repeats a round-robin schedule for formats where
+// players meet more than once. Each pairing is played gamesPerPairing times
+// per round robin, and the round robin is played roundRobins times. Who goes
+// first alternates from one game between the pair to the next, so a double
+// round robin swaps the first player in its second cycle. Later cycles are
+// numbered after the rounds of the earlier ones.
+func ExpandLeaguePairings(base []*GamePairing, roundRobins, gamesPerPairing int) []*GamePairing {
+	if roundRobins < 1 {
+		roundRobins = 1
+	}
+	if gamesPerPairing < 1 {
+		gamesPerPairing = 1
+	}
+	if roundRobins == 1 && gamesPerPairing == 1 {
+		return base
+	}
+	numRounds := 0
+	for _, p := range base {
+		if p.Round+1 > numRounds {
+			numRounds = p.Round + 1
+		}
+	}
+	expanded := make([]*GamePairing, 0, len(base)*roundRobins*gamesPerPairing)
+	for cycle := 0; cycle < roundRobins; cycle++ {
+		for _, p := range base {
+			for g := 0; g < gamesPerPairing; g++ {
+				meeting := cycle*gamesPerPairing + g
+				expanded = append(expanded, &GamePairing{
+					Player1Index:   p.Player1Index,
+					Player2Index:   p.Player2Index,
+					IsPlayer1First: p.IsPlayer1First == (meeting%2 == 0),
+					Round:          cycle*numRounds + p.Round,
+				})
+			}
+		}
+	}
+	return expanded
+}
+
 // assignFirstPlayerGreedy assigns first-player using simple greedy algorithm
 func assignFirstPlayerGreedy(pairings []*GamePairing, numPlayers int, seed uint64) {
 	firstsCounts := make([]int, numPlayers)
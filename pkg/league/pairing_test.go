@@ -2,6 +2,8 @@ package league
 
 import (
 	"testing"
+
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
 
 func TestGenerateAllLeaguePairings(t *testing.T) {
@@ -329,3 +331,114 @@ func TestTiebreakFirstPlayer(t *testing.T) {
 	// We don't assert they're different because it's probabilistic,
 	// but this logs the behavior for manual verification
 }
+
+func TestExpandLeaguePairings(t *testing.T) {
+	base, err := GenerateAllLeaguePairings(10, 12345, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		roundRobins     int
+		gamesPerPairing int
+	}{
+		{"single round robin", 1, 1},
+		{"double round robin", 2, 1},
+		{"two games per pairing", 1, 2},
+		{"double round robin, two games per pairing", 2, 2},
+		{"three games per pairing", 1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairings := ExpandLeaguePairings(base, tt.roundRobins, tt.gamesPerPairing)
+			meetings := tt.roundRobins * tt.gamesPerPairing
+			if len(pairings) != len(base)*meetings {
+				t.Fatalf("expected %d games, got %d", len(base)*meetings, len(pairings))
+			}
+
+			// Every pair meets the same number of times and takes turns going first
+			type pair struct{ a, b int }
+			firsts := make(map[pair]int)
+			games := make(map[pair]int)
+			rounds := make(map[int]bool)
+			for _, p := range pairings {
+				key := pair{p.Player1Index, p.Player2Index}
+				games[key]++
+				if p.IsPlayer1First {
+					firsts[key]++
+				}
+				rounds[p.Round] = true
+			}
+			for key, n := range games {
+				if n != meetings {
+					t.Errorf("pair %v meets %d times, expected %d", key, n, meetings)
+				}
+				if f := firsts[key]; f < meetings/2 || f > (meetings+1)/2 {
+					t.Errorf("pair %v: player 1 goes first %d of %d times", key, f, n)
+				}
+			}
+			if len(rounds) != 9*tt.roundRobins {
+				t.Errorf("expected %d rounds, got %d", 9*tt.roundRobins, len(rounds))
+			}
+
+			// Each player's games stay balanced between going first and second
+			playerFirsts := make([]int, 10)
+			for _, p := range pairings {
+				if p.IsPlayer1First {
+					playerFirsts[p.Player1Index]++
+				} else {
+					playerFirsts[p.Player2Index]++
+				}
+			}
+			perPlayer := 9 * meetings
+			for i, f := range playerFirsts {
+				if f < perPlayer/2-1 || f > perPlayer/2+1 {
+					t.Errorf("player %d goes first %d of %d games", i, f, perPlayer)
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateExpectedGames(t *testing.T) {
+	if got := CalculateExpectedGames(10, nil); got != 9 {
+		t.Errorf("default format: expected 9, got %d", got)
+	}
+	settings := &pb.LeagueSettings{RoundRobins: 2, GamesPerPairing: 2}
+	if got := CalculateExpectedGames(10, settings); got != 36 {
+		t.Errorf("double round robin of two-game pairings: expected 36, got %d", got)
+	}
+	// The single round robin is capped before it is repeated
+	if got := CalculateExpectedGames(20, &pb.LeagueSettings{RoundRobins: 2}); got != 2*MaxLeagueGamesPerPlayer {
+		t.Errorf("capped double round robin: expected %d, got %d", 2*MaxLeagueGamesPerPlayer, got)
+	}
+}
+
+func TestValidateLeagueFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings *pb.LeagueSettings
+		valid    bool
+	}{
+		{"default", &pb.LeagueSettings{SeasonLengthDays: 28}, true},
+		{"double round robin with playoff", &pb.LeagueSettings{SeasonLengthDays: 28, RoundRobins: 2, PlayoffSize: 4}, true},
+		{"too many meetings", &pb.LeagueSettings{SeasonLengthDays: 28, RoundRobins: 3, GamesPerPairing: 2}, false},
+		{"negative", &pb.LeagueSettings{SeasonLengthDays: 28, GamesPerPairing: -1}, false},
+		{"bad playoff size", &pb.LeagueSettings{SeasonLengthDays: 28, PlayoffSize: 6}, false},
+		{"playoff as long as season", &pb.LeagueSettings{SeasonLengthDays: 7, PlayoffSize: 2, PlayoffDays: 7}, false},
+		{"live", &pb.LeagueSettings{SeasonLengthDays: 28, Live: &pb.LiveSettings{InitialTimeMinutes: 25}}, true},
+		{"live negative", &pb.LeagueSettings{SeasonLengthDays: 28, Live: &pb.LiveSettings{ReminderHours: -1}}, false},
+		{"live window longer than season", &pb.LeagueSettings{SeasonLengthDays: 5, Live: &pb.LiveSettings{}}, false},
+		{"live with playoff", &pb.LeagueSettings{SeasonLengthDays: 28, PlayoffSize: 2, Live: &pb.LiveSettings{}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLeagueFormat(tt.settings)
+			if (err == nil) != tt.valid {
+				t.Errorf("expected valid=%v, got err=%v", tt.valid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package league
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/stores"
+	"github.com/woogles-io/liwords/pkg/stores/league"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// PlayoffManager creates the games of the division playoffs
+type PlayoffManager struct {
+	stores       *stores.Stores
+	gameCreator  GameCreator
+	seasonStarts *SeasonStartManager
+}
+
+// NewPlayoffManager creates a new playoff manager
+func NewPlayoffManager(allStores *stores.Stores, cfg *config.Config, gameCreator GameCreator) *PlayoffManager {
+	return &PlayoffManager{
+		stores:       allStores,
+		gameCreator:  gameCreator,
+		seasonStarts: NewSeasonStartManager(allStores.LeagueStore, allStores, cfg, gameCreator),
+	}
+}
+
+// AdvancePlayoffs starts or advances the playoffs of every division in the
+// season. The first call ends the regular season: unfinished division games
+// are force-finished, which is recorded on the season so that it happens
+// only once. Every call seeds the top players of any division without a
+// bracket yet, and creates the next round of any bracket whose current round
+// is complete. It returns the number of games created and whether every
+// bracket is complete, and is safe to call repeatedly.
+func (pm *PlayoffManager) AdvancePlayoffs(
+	ctx context.Context,
+	leagueID uuid.UUID,
+	seasonID uuid.UUID,
+	leagueSettings *pb.LeagueSettings,
+) (int, bool, error) {
+	size := PlayoffSize(leagueSettings)
+	if size == 0 {
+		return 0, true, nil
+	}
+
+	season, err := pm.stores.LeagueStore.GetSeason(ctx, seasonID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get season: %w", err)
+	}
+	if !season.PlayoffsStartedAt.Valid {
+		ffResult, err := NewForceFinishManager(pm.stores).ForceFinishUnfinishedGames(ctx, seasonID)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to force-finish regular season games: %w", err)
+		}
+		for _, errMsg := range ffResult.Errors {
+			log.Warn().Msg(errMsg)
+		}
+		if err := pm.stores.LeagueStore.MarkPlayoffsStarted(ctx, seasonID); err != nil {
+			return 0, false, fmt.Errorf("failed to mark playoffs started: %w", err)
+		}
+	}
+
+	divisions, err := pm.stores.LeagueStore.GetDivisionsBySeason(ctx, seasonID)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get divisions: %w", err)
+	}
+	divIDs := make([]uuid.UUID, len(divisions))
+	for i, division := range divisions {
+		divIDs[i] = division.Uuid
+	}
+	rows, err := pm.stores.LeagueStore.GetLeaguePlayoffGames(ctx, divIDs)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get playoff games: %w", err)
+	}
+	brackets := playoffBrackets(rows)
+
+	created := 0
+	complete := true
+	for _, division := range divisions {
+		bracket, ok := brackets[division.Uuid]
+		if ok && bracket.Complete() {
+			continue
+		}
+		standings, err := pm.stores.LeagueStore.GetStandings(ctx, division.Uuid)
+		if err != nil {
+			return created, false, fmt.Errorf("failed to get standings for division %d: %w", division.DivisionNumber, err)
+		}
+		SortStandingsByRank(standings)
+
+		var pairings []PlayoffPairing
+		if ok {
+			complete = false
+			pairings = bracket.NextRoundPairings()
+		} else {
+			if len(standings) < size {
+				log.Warn().
+					Str("divisionID", division.Uuid.String()).
+					Int("players", len(standings)).
+					Int("playoffSize", size).
+					Msg("skipping-playoff-for-small-division")
+				continue
+			}
+			complete = false
+			seeds := make([]int32, size)
+			for i := range seeds {
+				seeds[i] = standings[i].UserID
+			}
+			pairings = FirstRoundPairings(seeds)
+		}
+		if len(pairings) == 0 {
+			continue
+		}
+
+		userUUIDs := make(map[int32]string, len(standings))
+		for _, s := range standings {
+			userUUIDs[s.UserID] = s.UserUuid
+		}
+		for _, pairing := range pairings {
+			err := pm.createPlayoffGame(ctx, leagueID, seasonID, division.Uuid, pairing, userUUIDs, leagueSettings)
+			if err != nil {
+				return created, false, fmt.Errorf("division %d: %w", division.DivisionNumber, err)
+			}
+			created++
+		}
+
+		log.Info().
+			Str("divisionID", division.Uuid.String()).
+			Int("round", pairings[0].Round).
+			Int("games", len(pairings)).
+			Msg("created-playoff-round")
+	}
+
+	return created, complete, nil
+}
+
+// createPlayoffGame creates and starts one playoff game. The higher seed goes
+// first. The game belongs to the season but not to the division, so it does
+// not affect division standings.
+func (pm *PlayoffManager) createPlayoffGame(
+	ctx context.Context,
+	leagueID uuid.UUID,
+	seasonID uuid.UUID,
+	divisionID uuid.UUID,
+	pairing PlayoffPairing,
+	userUUIDs map[int32]string,
+	leagueSettings *pb.LeagueSettings,
+) error {
+	high, err := pm.stores.UserStore.GetByUUID(ctx, userUUIDs[pairing.HighSeedUserID])
+	if err != nil {
+		return fmt.Errorf("failed to get user %d: %w", pairing.HighSeedUserID, err)
+	}
+	low, err := pm.stores.UserStore.GetByUUID(ctx, userUUIDs[pairing.LowSeedUserID])
+	if err != nil {
+		return fmt.Errorf("failed to get user %d: %w", pairing.LowSeedUserID, err)
+	}
+
+	gameReq, err := pm.seasonStarts.buildGameRequest(leagueSettings)
+	if err != nil {
+		return fmt.Errorf("failed to build game request: %w", err)
+	}
+	game, err := pm.gameCreator.InstantiateNewGame(ctx, [2]*entity.User{high, low}, gameReq, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create game: %w", err)
+	}
+	game.LeagueID = &leagueID
+	game.SeasonID = &seasonID
+
+	err = pm.gameCreator.StartGame(ctx, game)
+	if err != nil {
+		return fmt.Errorf("failed to start game %s: %w", game.GameID(), err)
+	}
+
+	return pm.stores.LeagueStore.AddLeaguePlayoffGame(ctx, models.AddLeaguePlayoffGameParams{
+		GameUuid:       game.GameID(),
+		DivisionID:     divisionID,
+		Round:          int32(pairing.Round),
+		Slot:           int32(pairing.Slot),
+		HighSeed:       int32(pairing.HighSeed),
+		LowSeed:        int32(pairing.LowSeed),
+		HighSeedUserID: pairing.HighSeedUserID,
+		LowSeedUserID:  pairing.LowSeedUserID,
+	})
+}
+
+// divisionPlayoffBracket returns the division's playoff bracket, or nil if it
+// has no playoff.
+func divisionPlayoffBracket(ctx context.Context, store league.Store, divisionID uuid.UUID) (*PlayoffBracket, error) {
+	rows, err := store.GetLeaguePlayoffGames(ctx, []uuid.UUID{divisionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get playoff games: %w", err)
+	}
+	return playoffBrackets(rows)[divisionID], nil
+}
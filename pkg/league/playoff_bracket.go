@@ -0,0 +1,382 @@
+package league
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// A division playoff is a single-elimination bracket among the top
+// PlayoffSize players of the regular season. Playoff games belong to the
+// season but not to the division, so they never count towards division
+// standings; instead the playoff result decides the order of the top of the
+// division once the playoff has started.
+
+// PlayoffPairing is a playoff game that should be created.
+type PlayoffPairing struct {
+	Round          int
+	Slot           int
+	HighSeed       int
+	LowSeed        int
+	HighSeedUserID int32
+	LowSeedUserID  int32
+}
+
+// PlayoffGame is a playoff game that has been created.
+type PlayoffGame struct {
+	PlayoffPairing
+	GameID     string
+	Finished   bool
+	LowSeedWon bool
+}
+
+// PlayoffGameFromRow converts a playoff game row. A drawn or aborted game is
+// won by the higher seed.
+func PlayoffGameFromRow(r models.GetLeaguePlayoffGamesRow) PlayoffGame {
+	g := PlayoffGame{
+		PlayoffPairing: PlayoffPairing{
+			Round:          int(r.Round),
+			Slot:           int(r.Slot),
+			HighSeed:       int(r.HighSeed),
+			LowSeed:        int(r.LowSeed),
+			HighSeedUserID: r.HighSeedUserID,
+			LowSeedUserID:  r.LowSeedUserID,
+		},
+		GameID:   r.GameUuid,
+		Finished: r.GameEndReason.Valid && r.GameEndReason.Int32 != int32(pb.GameEndReason_NONE),
+	}
+	if g.Finished && r.WinnerIdx.Valid && r.Player0ID.Valid {
+		switch r.WinnerIdx.Int32 {
+		case 0:
+			g.LowSeedWon = r.Player0ID.Int32 == r.LowSeedUserID
+		case 1:
+			g.LowSeedWon = r.Player0ID.Int32 == r.HighSeedUserID
+		}
+	}
+	return g
+}
+
+// bracketOrder returns the seeds of a bracket of the given size in slot
+// order, so that the top seeds can only meet in the late rounds. For 8 it is
+// 1 8 4 5 2 7 3 6.
+func bracketOrder(size int) []int {
+	order := []int{1, 2}
+	for n := 4; n <= size; n *= 2 {
+		next := make([]int, 0, n)
+		for _, s := range order {
+			next = append(next, s, n+1-s)
+		}
+		order = next
+	}
+	return order
+}
+
+// FirstRoundPairings pairs the seeded players for the first playoff round.
+// seeds holds user IDs, best seed first; its length is the bracket size.
+func FirstRoundPairings(seeds []int32) []PlayoffPairing {
+	order := bracketOrder(len(seeds))
+	pairings := make([]PlayoffPairing, 0, len(seeds)/2)
+	for i := 0; i+1 < len(order); i += 2 {
+		high, low := order[i], order[i+1]
+		if low < high {
+			high, low = low, high
+		}
+		pairings = append(pairings, PlayoffPairing{
+			Round:          0,
+			Slot:           i / 2,
+			HighSeed:       high,
+			LowSeed:        low,
+			HighSeedUserID: seeds[high-1],
+			LowSeedUserID:  seeds[low-1],
+		})
+	}
+	return pairings
+}
+
+// PlayoffBracket is the state of one division's playoff.
+type PlayoffBracket struct {
+	size  int
+	games []PlayoffGame
+	seeds map[int32]int
+	wins  map[int32]int
+	out   map[int32]bool
+}
+
+// NewPlayoffBracket builds the bracket from its games. It returns nil if the
+// playoff has not started.
+func NewPlayoffBracket(games []PlayoffGame) *PlayoffBracket {
+	b := &PlayoffBracket{
+		seeds: make(map[int32]int),
+		wins:  make(map[int32]int),
+		out:   make(map[int32]bool),
+	}
+	for _, g := range games {
+		if g.Round == 0 {
+			b.seeds[g.HighSeedUserID] = g.HighSeed
+			b.seeds[g.LowSeedUserID] = g.LowSeed
+		}
+		b.games = append(b.games, g)
+		if !g.Finished {
+			continue
+		}
+		winner, loser := g.HighSeedUserID, g.LowSeedUserID
+		if g.LowSeedWon {
+			winner, loser = loser, winner
+		}
+		b.wins[winner]++
+		b.out[loser] = true
+	}
+	b.size = len(b.seeds)
+	if b.size == 0 {
+		return nil
+	}
+	sort.Slice(b.games, func(i, j int) bool {
+		if b.games[i].Round != b.games[j].Round {
+			return b.games[i].Round < b.games[j].Round
+		}
+		return b.games[i].Slot < b.games[j].Slot
+	})
+	return b
+}
+
+// Games returns a copy of the bracket's games, in round and slot order.
+func (b *PlayoffBracket) Games() []PlayoffGame {
+	return append([]PlayoffGame(nil), b.games...)
+}
+
+// Size returns the number of players in the playoff.
+func (b *PlayoffBracket) Size() int {
+	return b.size
+}
+
+// numRounds returns how many rounds the bracket has.
+func (b *PlayoffBracket) numRounds() int {
+	return bits.Len(uint(b.size)) - 1
+}
+
+// Seed returns the player's playoff seed, or 0 if they are not in it.
+func (b *PlayoffBracket) Seed(userID int32) int {
+	return b.seeds[userID]
+}
+
+// Eliminated returns whether the player has lost a playoff game.
+func (b *PlayoffBracket) Eliminated(userID int32) bool {
+	return b.out[userID]
+}
+
+// roundGames returns the games of a round in slot order.
+func (b *PlayoffBracket) roundGames(round int) []PlayoffGame {
+	var games []PlayoffGame
+	for _, g := range b.games {
+		if g.Round == round {
+			games = append(games, g)
+		}
+	}
+	return games
+}
+
+// roundComplete returns whether every game of the round has been created and
+// finished.
+func (b *PlayoffBracket) roundComplete(round int) bool {
+	games := b.roundGames(round)
+	if len(games) != b.size>>(round+1) {
+		return false
+	}
+	for _, g := range games {
+		if !g.Finished {
+			return false
+		}
+	}
+	return true
+}
+
+// Complete returns whether the final has been played.
+func (b *PlayoffBracket) Complete() bool {
+	return b.roundComplete(b.numRounds() - 1)
+}
+
+// NextRoundPairings returns the pairings of the next round once the current
+// one is complete, or nil if there is nothing to create yet. The winners of
+// slots 2i and 2i+1 meet in slot i.
+func (b *PlayoffBracket) NextRoundPairings() []PlayoffPairing {
+	round := 0
+	for round < b.numRounds() && len(b.roundGames(round)) > 0 {
+		round++
+	}
+	if round == 0 || round == b.numRounds() || !b.roundComplete(round-1) {
+		return nil
+	}
+	prev := b.roundGames(round - 1)
+	pairings := make([]PlayoffPairing, 0, len(prev)/2)
+	for i := 0; i+1 < len(prev); i += 2 {
+		aSeed, aUser := prev[i].winner()
+		bSeed, bUser := prev[i+1].winner()
+		if bSeed < aSeed {
+			aSeed, aUser, bSeed, bUser = bSeed, bUser, aSeed, aUser
+		}
+		pairings = append(pairings, PlayoffPairing{
+			Round:          round,
+			Slot:           i / 2,
+			HighSeed:       aSeed,
+			LowSeed:        bSeed,
+			HighSeedUserID: aUser,
+			LowSeedUserID:  bUser,
+		})
+	}
+	return pairings
+}
+
+// winner returns the seed and user ID of the game's winner.
+func (g PlayoffGame) winner() (int, int32) {
+	if g.LowSeedWon {
+		return g.LowSeed, g.LowSeedUserID
+	}
+	return g.HighSeed, g.HighSeedUserID
+}
+
+// Order returns the playoff players in finishing order: by playoff wins,
+// players still alive ahead of those knocked out with as many wins, then by
+// seed. Once the bracket is complete this is the final order.
+func (b *PlayoffBracket) Order() []int32 {
+	users := make([]int32, 0, b.size)
+	for u := range b.seeds {
+		users = append(users, u)
+	}
+	progress := func(u int32) int {
+		p := 2 * b.wins[u]
+		if !b.out[u] {
+			p++
+		}
+		return p
+	}
+	sort.Slice(users, func(i, j int) bool {
+		if pi, pj := progress(users[i]), progress(users[j]); pi != pj {
+			return pi > pj
+		}
+		return b.seeds[users[i]] < b.seeds[users[j]]
+	})
+	return users
+}
+
+// RankBounds returns the best and worst rank the player can still finish
+// the playoff in. pos is the player's 1-based position in Order. A player
+// with w wins who is still alive finishes in the top size/2^w; one who lost
+// after w wins finishes between size/2^(w+1)+1 and size/2^w, and their place
+// is exact once everyone else in that round has finished.
+func (b *PlayoffBracket) RankBounds(userID int32, pos int) RankBounds {
+	w := b.wins[userID]
+	if b.Complete() {
+		return RankBounds{BestRank: pos, WorstRank: pos}
+	}
+	if !b.out[userID] {
+		return RankBounds{BestRank: 1, WorstRank: b.size >> w}
+	}
+	if b.roundComplete(w) {
+		return RankBounds{BestRank: pos, WorstRank: pos}
+	}
+	return RankBounds{BestRank: b.size>>(w+1) + 1, WorstRank: b.size >> w}
+}
+
+// PrePlayoffRankBounds maps regular-season rank bounds to final bounds when
+// the top playoffSize players will play a playoff: a player who can still
+// qualify can still win it.
+func PrePlayoffRankBounds(bounds RankBounds, playoffSize int) RankBounds {
+	if playoffSize == 0 || bounds.BestRank > playoffSize {
+		return bounds
+	}
+	return RankBounds{BestRank: 1, WorstRank: max(bounds.WorstRank, playoffSize)}
+}
+
+// OrderStandingsByPlayoff moves the playoff players to the top of sorted
+// standings in playoff order. Everyone else keeps their regular-season order.
+func OrderStandingsByPlayoff(standings []models.GetStandingsRow, b *PlayoffBracket) {
+	orderByPlayoff(standings, func(s models.GetStandingsRow) int32 { return s.UserID }, b)
+}
+
+// orderByPlayoff moves the playoff players of rows to the top in their
+// playoff finishing order, keeping everyone else in their current order.
+func orderByPlayoff[T any](rows []T, userID func(T) int32, b *PlayoffBracket) {
+	if b == nil {
+		return
+	}
+	byUser := make(map[int32]T, len(rows))
+	for _, r := range rows {
+		byUser[userID(r)] = r
+	}
+	ordered := make([]T, 0, len(rows))
+	for _, u := range b.Order() {
+		if r, ok := byUser[u]; ok {
+			ordered = append(ordered, r)
+		}
+	}
+	for _, r := range rows {
+		if b.Seed(userID(r)) == 0 {
+			ordered = append(ordered, r)
+		}
+	}
+	copy(rows, ordered)
+}
+
+// playoffBrackets builds each division's bracket from playoff game rows,
+// keyed by division ID. Divisions without a playoff have no entry.
+func playoffBrackets(rows []models.GetLeaguePlayoffGamesRow) map[uuid.UUID]*PlayoffBracket {
+	byDivision := make(map[uuid.UUID][]PlayoffGame)
+	for _, r := range rows {
+		byDivision[r.DivisionID] = append(byDivision[r.DivisionID], PlayoffGameFromRow(r))
+	}
+	brackets := make(map[uuid.UUID]*PlayoffBracket, len(byDivision))
+	for id, games := range byDivision {
+		if b := NewPlayoffBracket(games); b != nil {
+			brackets[id] = b
+		}
+	}
+	return brackets
+}
+
+// applyPlayoffStandings updates standings in regular-season order for the
+// division playoff and returns them in final order. userIDs holds each
+// standing's user ID. Before the playoff starts (bracket is nil) rank bounds
+// are widened for players who can still qualify for a playoff of
+// playoffSize; afterwards the playoff players move to the top with their
+// seeds and playoff rank bounds.
+func applyPlayoffStandings(standings []*pb.LeaguePlayerStanding, userIDs []int32,
+	bracket *PlayoffBracket, playoffSize int) []*pb.LeaguePlayerStanding {
+
+	if bracket == nil {
+		for _, s := range standings {
+			bounds := PrePlayoffRankBounds(RankBounds{BestRank: int(s.BestRank), WorstRank: int(s.WorstRank)}, playoffSize)
+			s.BestRank, s.WorstRank = int32(bounds.BestRank), int32(bounds.WorstRank)
+		}
+		return standings
+	}
+
+	byUser := make(map[int32]*pb.LeaguePlayerStanding, len(standings))
+	for i, s := range standings {
+		byUser[userIDs[i]] = s
+	}
+	ordered := make([]*pb.LeaguePlayerStanding, 0, len(standings))
+	for i, u := range bracket.Order() {
+		s, ok := byUser[u]
+		if !ok {
+			continue
+		}
+		bounds := bracket.RankBounds(u, i+1)
+		s.PlayoffSeed = int32(bracket.Seed(u))
+		s.PlayoffEliminated = bracket.Eliminated(u)
+		s.BestRank, s.WorstRank = int32(bounds.BestRank), int32(bounds.WorstRank)
+		ordered = append(ordered, s)
+	}
+	for i, s := range standings {
+		if bracket.Seed(userIDs[i]) == 0 {
+			ordered = append(ordered, s)
+		}
+	}
+	for i, s := range ordered {
+		s.Rank = int32(i + 1)
+	}
+	return ordered
+}
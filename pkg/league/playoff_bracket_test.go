@@ -0,0 +1,170 @@
+package league
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+func TestBracketOrder(t *testing.T) {
+	assert.Equal(t, []int{1, 2}, bracketOrder(2))
+	assert.Equal(t, []int{1, 4, 2, 3}, bracketOrder(4))
+	assert.Equal(t, []int{1, 8, 4, 5, 2, 7, 3, 6}, bracketOrder(8))
+}
+
+func TestFirstRoundPairings(t *testing.T) {
+	pairings := FirstRoundPairings([]int32{10, 20, 30, 40})
+	require.Len(t, pairings, 2)
+	assert.Equal(t, PlayoffPairing{Round: 0, Slot: 0, HighSeed: 1, LowSeed: 4, HighSeedUserID: 10, LowSeedUserID: 40}, pairings[0])
+	assert.Equal(t, PlayoffPairing{Round: 0, Slot: 1, HighSeed: 2, LowSeed: 3, HighSeedUserID: 20, LowSeedUserID: 30}, pairings[1])
+}
+
+func TestPlayoffGameFromRow(t *testing.T) {
+	row := models.GetLeaguePlayoffGamesRow{
+		HighSeed: 1, LowSeed: 2, HighSeedUserID: 10, LowSeedUserID: 20,
+		GameEndReason: pgtype.Int4{Int32: int32(ipc.GameEndReason_STANDARD), Valid: true},
+		Player0ID:     pgtype.Int4{Int32: 10, Valid: true},
+	}
+
+	row.WinnerIdx = pgtype.Int4{Int32: 1, Valid: true}
+	g := PlayoffGameFromRow(row)
+	assert.True(t, g.Finished)
+	assert.True(t, g.LowSeedWon)
+
+	row.WinnerIdx = pgtype.Int4{Int32: 0, Valid: true}
+	assert.False(t, PlayoffGameFromRow(row).LowSeedWon)
+
+	// A draw goes to the higher seed
+	row.WinnerIdx = pgtype.Int4{Int32: -1, Valid: true}
+	assert.False(t, PlayoffGameFromRow(row).LowSeedWon)
+
+	row.GameEndReason = pgtype.Int4{Int32: int32(ipc.GameEndReason_NONE), Valid: true}
+	assert.False(t, PlayoffGameFromRow(row).Finished)
+}
+
+// playBracket plays out a bracket of the given size with random results,
+// calling check after every game is created or finished.
+func playBracket(rng *rand.Rand, size int, check func(b *PlayoffBracket)) *PlayoffBracket {
+	seeds := make([]int32, size)
+	for i := range seeds {
+		seeds[i] = int32(100 + i)
+	}
+	var games []PlayoffGame
+	pairings := FirstRoundPairings(seeds)
+	for len(pairings) > 0 {
+		for _, p := range pairings {
+			games = append(games, PlayoffGame{PlayoffPairing: p})
+		}
+		check(NewPlayoffBracket(games))
+		for _, i := range rng.Perm(len(pairings)) {
+			idx := len(games) - len(pairings) + i
+			games[idx].Finished = true
+			games[idx].LowSeedWon = rng.Intn(2) == 0
+			check(NewPlayoffBracket(games))
+		}
+		pairings = NewPlayoffBracket(games).NextRoundPairings()
+	}
+	return NewPlayoffBracket(games)
+}
+
+func TestPlayoffBracketProgression(t *testing.T) {
+	rng := rand.New(rand.NewSource(31))
+	for _, size := range []int{2, 4, 8} {
+		for range 20 {
+			b := playBracket(rng, size, func(b *PlayoffBracket) {
+				require.NotNil(t, b)
+				assert.Equal(t, size, b.Size())
+			})
+			require.True(t, b.Complete())
+			assert.Empty(t, b.NextRoundPairings())
+
+			// The champion is the only player without a loss, and each
+			// round's losers finish in the band below the round's winners.
+			order := b.Order()
+			require.Len(t, order, size)
+			assert.False(t, b.Eliminated(order[0]))
+			for i, u := range order[1:] {
+				assert.True(t, b.Eliminated(u), "position %d", i+2)
+			}
+			for i, u := range order {
+				assert.Equal(t, RankBounds{BestRank: i + 1, WorstRank: i + 1}, b.RankBounds(u, i+1))
+			}
+		}
+	}
+}
+
+func TestPlayoffBracketNextRoundWaitsForRound(t *testing.T) {
+	var games []PlayoffGame
+	for _, p := range FirstRoundPairings([]int32{1, 2, 3, 4, 5, 6, 7, 8}) {
+		games = append(games, PlayoffGame{PlayoffPairing: p, Finished: true})
+	}
+	games[3].Finished = false
+	assert.Empty(t, NewPlayoffBracket(games).NextRoundPairings())
+
+	// Seeds 1, 5 (upset over 4), 2 and 3 advance; 1 meets 5 and 2 meets 3
+	games[1].LowSeedWon = true
+	games[3].Finished = true
+	next := NewPlayoffBracket(games).NextRoundPairings()
+	require.Len(t, next, 2)
+	assert.Equal(t, PlayoffPairing{Round: 1, Slot: 0, HighSeed: 1, LowSeed: 5, HighSeedUserID: 1, LowSeedUserID: 5}, next[0])
+	assert.Equal(t, PlayoffPairing{Round: 1, Slot: 1, HighSeed: 2, LowSeed: 3, HighSeedUserID: 2, LowSeedUserID: 3}, next[1])
+}
+
+func TestPlayoffRankBoundsMonotonic(t *testing.T) {
+	rng := rand.New(rand.NewSource(2031))
+	for _, size := range []int{2, 4, 8} {
+		for range 50 {
+			prev := make(map[int32]RankBounds)
+			playBracket(rng, size, func(b *PlayoffBracket) {
+				for i, u := range b.Order() {
+					bounds := b.RankBounds(u, i+1)
+					require.True(t, bounds.BestRank >= 1 && bounds.BestRank <= bounds.WorstRank && bounds.WorstRank <= size,
+						"invalid bounds %v", bounds)
+					require.True(t, bounds.BestRank <= i+1 && i+1 <= bounds.WorstRank,
+						"position %d outside bounds %v", i+1, bounds)
+					if p, ok := prev[u]; ok {
+						require.True(t, bounds.BestRank >= p.BestRank && bounds.WorstRank <= p.WorstRank,
+							"user %d widened: %v -> %v", u, p, bounds)
+					}
+					prev[u] = bounds
+				}
+			})
+		}
+	}
+}
+
+func TestPrePlayoffRankBounds(t *testing.T) {
+	assert.Equal(t, RankBounds{BestRank: 3, WorstRank: 9}, PrePlayoffRankBounds(RankBounds{BestRank: 3, WorstRank: 9}, 0))
+	assert.Equal(t, RankBounds{BestRank: 1, WorstRank: 9}, PrePlayoffRankBounds(RankBounds{BestRank: 3, WorstRank: 9}, 4))
+	assert.Equal(t, RankBounds{BestRank: 1, WorstRank: 4}, PrePlayoffRankBounds(RankBounds{BestRank: 2, WorstRank: 3}, 4))
+	assert.Equal(t, RankBounds{BestRank: 5, WorstRank: 9}, PrePlayoffRankBounds(RankBounds{BestRank: 5, WorstRank: 9}, 4))
+}
+
+func TestOrderStandingsByPlayoff(t *testing.T) {
+	standings := []models.GetStandingsRow{{UserID: 1}, {UserID: 2}, {UserID: 3}, {UserID: 4}, {UserID: 5}}
+	var games []PlayoffGame
+	for _, p := range FirstRoundPairings([]int32{1, 2, 3, 4}) {
+		games = append(games, PlayoffGame{PlayoffPairing: p, Finished: true, LowSeedWon: true})
+	}
+	// 4 beat 1 and 3 beat 2, then 3 wins the final as the higher seed
+	final := NewPlayoffBracket(games).NextRoundPairings()
+	require.Len(t, final, 1)
+	games = append(games, PlayoffGame{PlayoffPairing: final[0], Finished: true})
+
+	OrderStandingsByPlayoff(standings, NewPlayoffBracket(games))
+	ids := make([]int32, len(standings))
+	for i, s := range standings {
+		ids[i] = s.UserID
+	}
+	assert.Equal(t, []int32{3, 4, 1, 2, 5}, ids)
+
+	// Without a playoff the order is unchanged
+	OrderStandingsByPlayoff(standings, nil)
+	assert.Equal(t, int32(3), standings[0].UserID)
+}
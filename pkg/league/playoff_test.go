@@ -0,0 +1,75 @@
+package league
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// TestAdvancePlayoffsStartsOnce checks that the regular season is ended only
+// by the first call, and that a season whose divisions are all too small for
+// a playoff counts as complete.
+func TestAdvancePlayoffsStartsOnce(t *testing.T) {
+	is := is.New(t)
+	ctx := context.Background()
+	allStores, store, cleanup := setupTest(t)
+	defer cleanup()
+
+	leagueID := uuid.New()
+	_, err := store.CreateLeague(ctx, models.CreateLeagueParams{
+		Uuid:        leagueID,
+		Name:        "Playoff League",
+		Description: pgtype.Text{String: "Test", Valid: true},
+		Slug:        "playoff",
+		Settings:    []byte(`{}`),
+		IsActive:    pgtype.Bool{Bool: true, Valid: true},
+		CreatedBy:   pgtype.Int8{Int64: 1, Valid: true},
+	})
+	is.NoErr(err)
+
+	seasonID := uuid.New()
+	_, err = store.CreateSeason(ctx, models.CreateSeasonParams{
+		Uuid:         seasonID,
+		LeagueID:     leagueID,
+		SeasonNumber: 1,
+		StartDate:    pgtype.Timestamptz{Time: time.Now().AddDate(0, 0, -20), Valid: true},
+		EndDate:      pgtype.Timestamptz{Time: time.Now().AddDate(0, 0, 1), Valid: true},
+		Status:       int32(ipc.SeasonStatus_SEASON_ACTIVE),
+	})
+	is.NoErr(err)
+	_, err = store.CreateDivision(ctx, models.CreateDivisionParams{
+		Uuid:           uuid.New(),
+		SeasonID:       seasonID,
+		DivisionNumber: 1,
+		DivisionName:   pgtype.Text{String: "Division 1", Valid: true},
+	})
+	is.NoErr(err)
+
+	pm := NewPlayoffManager(allStores, config.DefaultConfig(), nil)
+	settings := &ipc.LeagueSettings{PlayoffSize: 4}
+
+	created, complete, err := pm.AdvancePlayoffs(ctx, leagueID, seasonID, settings)
+	is.NoErr(err)
+	is.Equal(created, 0)
+	is.True(complete)
+	season, err := store.GetSeason(ctx, seasonID)
+	is.NoErr(err)
+	is.True(season.PlayoffsStartedAt.Valid)
+	started := season.PlayoffsStartedAt.Time
+
+	created, complete, err = pm.AdvancePlayoffs(ctx, leagueID, seasonID, settings)
+	is.NoErr(err)
+	is.Equal(created, 0)
+	is.True(complete)
+	season, err = store.GetSeason(ctx, seasonID)
+	is.NoErr(err)
+	is.True(season.PlayoffsStartedAt.Time.Equal(started))
+}
@@ -536,12 +536,17 @@ func TestRankBoundsBestInversionSoundVsBrute(t *testing.T) {
 // tier; all pass through the brute tier as their cluster shrinks below 10 games.
 func TestRankBoundsSyntheticMonotonicReplay(t *testing.T) {
 	rng := rand.New(rand.NewSource(2026))
-	for _, n := range []int{6, 12, 15} {
+	// meetings > 1 covers double round robins and multi-game pairings, where
+	// the same pair has several unfinished games at once.
+	for _, tc := range []struct{ n, meetings int }{{6, 1}, {12, 1}, {15, 1}, {6, 2}, {8, 3}} {
+		n, meetings := tc.n, tc.meetings
 		type game struct{ a, b int }
 		var schedule []game
-		for i := range n {
-			for j := i + 1; j < n; j++ {
-				schedule = append(schedule, game{i, j})
+		for range meetings {
+			for i := range n {
+				for j := i + 1; j < n; j++ {
+					schedule = append(schedule, game{i, j})
+				}
 			}
 		}
 		rng.Shuffle(len(schedule), func(i, j int) { schedule[i], schedule[j] = schedule[j], schedule[i] })
@@ -585,7 +590,7 @@ func TestRankBoundsSyntheticMonotonicReplay(t *testing.T) {
 			pts, spr, played := tally(done)
 			st := make([]standingInfo, n)
 			for i := range st {
-				st[i] = standingInfo{userID: int32(i + 1), points: pts[i], spread: spr[i], gamesRemaining: (n - 1) - played[i]}
+				st[i] = standingInfo{userID: int32(i + 1), points: pts[i], spread: spr[i], gamesRemaining: (n-1)*meetings - played[i]}
 			}
 			var unf []unfinishedGame
 			for gi := done; gi < len(schedule); gi++ {
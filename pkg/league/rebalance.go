@@ -726,9 +726,14 @@ func (rm *RebalanceManager) CalculatePriorityScores(
 		}
 	}
 
-	// Sort by priority score (descending - highest priority first)
+	// Sort by priority score (descending - highest priority first). Ties go
+	// to the higher-rated player, which is their league rating once they
+	// have played in the league.
 	sort.Slice(result, func(i, j int) bool {
-		return result[i].PriorityScore > result[j].PriorityScore
+		if result[i].PriorityScore != result[j].PriorityScore {
+			return result[i].PriorityScore > result[j].PriorityScore
+		}
+		return result[i].Rating > result[j].Rating
 	})
 
 	return result
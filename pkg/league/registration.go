@@ -54,6 +54,31 @@ func (rm *RegistrationManager) RegisterPlayer(
 	return err
 }
 
+// JoinSubstitutePool makes a player available to replace players of the
+// league who drop out mid-season. Joining twice is not an error.
+func (rm *RegistrationManager) JoinSubstitutePool(
+	ctx context.Context,
+	userID int32,
+	leagueID uuid.UUID,
+) error {
+	return rm.store.AddLeagueSubstitute(ctx, models.AddLeagueSubstituteParams{
+		LeagueID: leagueID,
+		UserID:   userID,
+	})
+}
+
+// LeaveSubstitutePool removes a player from the league's substitute pool
+func (rm *RegistrationManager) LeaveSubstitutePool(
+	ctx context.Context,
+	userID int32,
+	leagueID uuid.UUID,
+) error {
+	return rm.store.RemoveLeagueSubstitute(ctx, models.RemoveLeagueSubstituteParams{
+		LeagueID: leagueID,
+		UserID:   userID,
+	})
+}
+
 // GetSeasonRegistrations returns all registrations for a season
 func (rm *RegistrationManager) GetSeasonRegistrations(
 	ctx context.Context,
@@ -138,6 +163,16 @@ func (rm *RegistrationManager) CategorizeRegistrations(
 ) ([]CategorizedPlayer, error) {
 	categorized := make([]CategorizedPlayer, 0, len(registrations))
 
+	// Players who have played in the league are seeded by their league rating
+	userIDs := make([]int32, len(registrations))
+	for i, reg := range registrations {
+		userIDs[i] = reg.UserID
+	}
+	leagueRatings, err := NewLeagueRatingManager(rm.store).Ratings(ctx, leagueID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, reg := range registrations {
 		// Check if player has history in this league (excluding current season)
 		history, err := rm.store.GetPlayerSeasonHistory(ctx, models.GetPlayerSeasonHistoryParams{
@@ -170,6 +205,7 @@ func (rm *RegistrationManager) CategorizeRegistrations(
 				avgRating = 0
 			}
 		}
+		avgRating = seedingRating(leagueRatings, reg.UserID, avgRating)
 
 		categorized = append(categorized, CategorizedPlayer{
 			Registration: reg,
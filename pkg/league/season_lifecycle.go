@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -280,6 +281,23 @@ func (slm *SeasonLifecycleManager) buildPlayerAssignments(ctx context.Context, s
 		}
 	}
 
+	// Live league games are only created when they are played, so their
+	// opponents come from the season's matches instead.
+	matchOpponents := make(map[string][]string)
+	matches, err := slm.stores.LeagueStore.GetSeasonLeagueMatches(ctx, seasonID)
+	if err != nil {
+		log.Warn().Err(err).Str("seasonID", seasonID.String()).Msg("failed-to-get-season-matches")
+	}
+	addOpponent := func(userUUID, opponent string) {
+		if !slices.Contains(matchOpponents[userUUID], opponent) {
+			matchOpponents[userUUID] = append(matchOpponents[userUUID], opponent)
+		}
+	}
+	for _, m := range matches {
+		addOpponent(m.Player0Uuid, m.Player1Username)
+		addOpponent(m.Player1Uuid, m.Player0Username)
+	}
+
 	// Build the player assignment map
 	assignments := make(map[string]*PlayerSeasonInfo)
 	for userUUID, divisionID := range playerDivisions {
@@ -289,6 +307,9 @@ func (slm *SeasonLifecycleManager) buildPlayerAssignments(ctx context.Context, s
 			log.Warn().Err(err).Str("userUUID", userUUID).Msg("failed-to-get-player-opponents")
 			opponents = []string{}
 		}
+		if len(opponents) == 0 {
+			opponents = matchOpponents[userUUID]
+		}
 
 		assignments[userUUID] = &PlayerSeasonInfo{
 			DivisionName:  divisionNames[divisionID],
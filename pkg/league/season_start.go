@@ -166,6 +166,7 @@ func (ssm *SeasonStartManager) createGamesForDivision(
 	if err != nil {
 		return 0, fmt.Errorf("failed to generate pairings: %w", err)
 	}
+	pairings = ExpandLeaguePairings(pairings, RoundRobins(leagueSettings), GamesPerPairing(leagueSettings))
 
 	log.Info().
 		Str("divisionID", division.Uuid.String()).
@@ -174,6 +175,13 @@ func (ssm *SeasonStartManager) createGamesForDivision(
 		Int("pairingsCount", len(pairings)).
 		Msg("generated-pairings-for-division")
 
+	// Live leagues play their games in match windows; the games are created
+	// when the players start them.
+	if IsLiveLeague(leagueSettings) {
+		msm := NewMatchScheduleManager(ssm.stores, ssm.cfg, ssm.gameCreator, RealClock{})
+		return msm.createMatchesForDivision(ctx, seasonID, division, registrations, pairings, leagueSettings)
+	}
+
 	// Create games for each pairing
 	gamesCreated := 0
 	for i, pairing := range pairings {
@@ -315,6 +323,29 @@ func (ssm *SeasonStartManager) buildGameRequest(settings *pb.LeagueSettings) (*p
 
 	reqID := shortuuid.New()
 
+	if IsLiveLeague(settings) {
+		initialMinutes := settings.Live.InitialTimeMinutes
+		if initialMinutes == 0 {
+			initialMinutes = DefaultLiveInitialTimeMinutes
+		}
+		return &pb.GameRequest{
+			Lexicon:       lexicon,
+			ChallengeRule: macondo.ChallengeRule(challengeRule),
+			Rules: &pb.GameRules{
+				BoardLayoutName:        "CrosswordGame",
+				LetterDistributionName: letterDistribution,
+				VariantName:            variant,
+			},
+			InitialTimeSeconds: initialMinutes * 60,
+			IncrementSeconds:   settings.Live.IncrementSeconds,
+			MaxOvertimeMinutes: settings.Live.MaxOvertimeMinutes,
+			RatingMode:         pb.RatingMode_RATED,
+			GameMode:           pb.GameMode_REAL_TIME,
+			RequestId:          reqID,
+			OriginalRequestId:  reqID,
+		}, nil
+	}
+
 	req := &pb.GameRequest{
 		Lexicon:       lexicon,
 		ChallengeRule: macondo.ChallengeRule(challengeRule),
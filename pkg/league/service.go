@@ -401,6 +401,9 @@ func (ls *LeagueService) CreateLeague(
 	if req.Msg.Settings == nil {
 		return nil, apiserver.InvalidArg("settings is required")
 	}
+	if err := ValidateLeagueFormat(req.Msg.Settings); err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
 
 	// Marshal settings to JSON
 	settingsJSON, err := json.Marshal(req.Msg.Settings)
@@ -582,6 +585,9 @@ func (ls *LeagueService) UpdateLeagueSettings(
 	if req.Msg.Settings == nil {
 		return nil, apiserver.InvalidArg("settings is required")
 	}
+	if err := ValidateLeagueFormat(req.Msg.Settings); err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
 
 	// Parse league ID
 	leagueID, err := uuid.Parse(req.Msg.LeagueId)
@@ -1085,12 +1091,67 @@ func (ls *LeagueService) GetDivisionStandings(
 	for i, uf := range unfinished {
 		ufGames[i] = UnfinishedGameFromRow(uf.Player0ID, uf.Player1ID)
 	}
+	// Live league matches have no game until they are played
+	pending, err := ls.store.GetPendingLeagueMatches(ctx, []uuid.UUID{divisionID})
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get pending matches: %w", err))
+	}
+	for _, m := range pending {
+		ufGames = append(ufGames, unfinishedGame{player0ID: m.Player0ID, player1ID: m.Player1ID})
+	}
 	rankBounds := CalculatePossibleRanks(standingInfos, ufGames)
 	for i := range protoStandings {
 		protoStandings[i].BestRank = int32(rankBounds[i].BestRank)
 		protoStandings[i].WorstRank = int32(rankBounds[i].WorstRank)
 	}
 
+	// A division playoff decides the top places
+	season, leagueSettings, err := seasonLeagueSettings(ctx, ls.store, division.SeasonID)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	bracket, err := divisionPlayoffBracket(ctx, ls.store, divisionID)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	userIDs := make([]int32, len(standings))
+	for i, s := range standings {
+		userIDs[i] = s.UserID
+	}
+
+	// Forecast the season outcomes while the season is being played
+	if season.Status == int32(ipc.SeasonStatus_SEASON_ACTIVE) {
+		key := newForecastKey(standings, len(ufGames), bracket)
+		forecasts, ok := cachedDivisionForecast(divisionID, key)
+		if !ok {
+			registrations, err := ls.store.GetDivisionRegistrations(ctx, divisionID)
+			if err != nil {
+				return nil, apiserver.InternalErr(fmt.Errorf("failed to get registrations: %w", err))
+			}
+			seasonDivisions, err := ls.store.GetDivisionsBySeason(ctx, division.SeasonID)
+			if err != nil {
+				return nil, apiserver.InternalErr(fmt.Errorf("failed to get divisions: %w", err))
+			}
+			ratings, err := forecastRatings(ctx, ls.store, ls.userStore, season.LeagueID, leagueSettings, registrationUUIDs(registrations))
+			if err != nil {
+				return nil, apiserver.InternalErr(fmt.Errorf("failed to get ratings: %w", err))
+			}
+			forecasts = simulateDivisionForecast(divisionID, key, divisionForecastInput{
+				standings:       withRegisteredPlayers(standings, registrations),
+				unfinished:      ufGames,
+				ratings:         ratings,
+				divisionNumber:  division.DivisionNumber,
+				highestDivision: highestDivisionNumber(seasonDivisions),
+				formula:         ipc.PromotionFormula(season.PromotionFormula),
+				playoffSize:     pendingPlayoffSize(season, leagueSettings),
+				bracket:         bracket,
+			})
+		}
+		applyForecasts(protoStandings, userIDs, forecasts)
+	}
+
+	protoStandings = applyPlayoffStandings(protoStandings, userIDs, bracket, pendingPlayoffSize(season, leagueSettings))
+
 	// Build division proto
 	divisionName := ""
 	if division.DivisionName.Valid {
@@ -1135,6 +1196,12 @@ func (ls *LeagueService) GetAllDivisionStandings(
 		return nil, apiserver.InternalErr(fmt.Errorf("failed to get season standings: %w", err))
 	}
 	divisions := snapshot.Divisions
+	season, leagueSettings, err := seasonLeagueSettings(ctx, ls.store, seasonID)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	playoffSize := pendingPlayoffSize(season, leagueSettings)
+	brackets := playoffBrackets(snapshot.Playoffs)
 
 	// Split the batched, division_number-ordered rows into per-division buckets
 	// aligned to divisions. The standings and registration row shapes match the
@@ -1161,6 +1228,30 @@ func (ls *LeagueService) GetAllDivisionStandings(
 		func(r models.GetDivisionRegistrationsRow) uuid.UUID { return uuid.UUID(r.DivisionID.Bytes) })
 	unfinishedByDivision := groupByDivision(divIDs, snapshot.Unfinished,
 		func(r models.GetUnfinishedGamesForDivisionsRow) uuid.UUID { return uuid.UUID(r.DivisionID.Bytes) })
+	pendingByDivision := groupByDivision(divIDs, snapshot.PendingMatches,
+		func(r models.GetPendingLeagueMatchesRow) uuid.UUID { return r.DivisionID })
+
+	// Forecast the season outcomes while the season is being played. Ratings
+	// are only looked up for divisions whose forecast is not cached.
+	forecastActive := season.Status == int32(ipc.SeasonStatus_SEASON_ACTIVE)
+	highestDivision := highestDivisionNumber(divisions)
+	forecastKeys := make([]forecastKey, len(divisions))
+	forecastRatingsByDivision := make([]map[int32]float64, len(divisions))
+	cachedForecasts := make([]map[int32]OutcomeForecast, len(divisions))
+	if forecastActive {
+		for i := range divisions {
+			forecastKeys[i] = newForecastKey(standingsByDivision[i],
+				len(unfinishedByDivision[i])+len(pendingByDivision[i]), brackets[divIDs[i]])
+			if forecasts, ok := cachedDivisionForecast(divIDs[i], forecastKeys[i]); ok {
+				cachedForecasts[i] = forecasts
+				continue
+			}
+			forecastRatingsByDivision[i], err = forecastRatings(ctx, ls.store, ls.userStore, season.LeagueID, leagueSettings, registrationUUIDs(registrationsByDivision[i]))
+			if err != nil {
+				return nil, apiserver.InternalErr(fmt.Errorf("failed to get ratings: %w", err))
+			}
+		}
+	}
 
 	// Divisions are independent, so run the per-division assembly concurrently;
 	// the CPU-bound CalculatePossibleRanks call dominates on large divisions near
@@ -1173,6 +1264,7 @@ func (ls *LeagueService) GetAllDivisionStandings(
 		standings := standingsByDivision[i]
 		registrations := registrationsByDivision[i]
 		unfinished := unfinishedByDivision[i]
+		pending := pendingByDivision[i]
 		eg.Go(func() error {
 			// Build a map of existing standings by user ID
 			standingsMap := make(map[int32]models.GetStandingsRow)
@@ -1187,7 +1279,7 @@ func (ls *LeagueService) GetAllDivisionStandings(
 			}
 
 			// Calculate expected games per player based on division size
-			expectedGames := CalculateExpectedGamesPerPlayer(len(registrations))
+			expectedGames := CalculateExpectedGames(len(registrations), leagueSettings)
 
 			// Merge standings with registrations - show all registered players
 			// Use actual standings where available, zeros for others
@@ -1282,12 +1374,38 @@ func (ls *LeagueService) GetAllDivisionStandings(
 			for j, uf := range unfinished {
 				ufGames[j] = UnfinishedGameFromRow(uf.Player0ID, uf.Player1ID)
 			}
+			for _, m := range pending {
+				ufGames = append(ufGames, unfinishedGame{player0ID: m.Player0ID, player1ID: m.Player1ID})
+			}
 			rankBounds := CalculatePossibleRanks(standingInfos, ufGames)
 			for j := range protoStandings {
 				protoStandings[j].BestRank = int32(rankBounds[j].BestRank)
 				protoStandings[j].WorstRank = int32(rankBounds[j].WorstRank)
 			}
 
+			// A division playoff decides the top places
+			userIDs := make([]int32, len(standings))
+			for j, s := range standings {
+				userIDs[j] = s.UserID
+			}
+			if forecastActive {
+				forecasts := cachedForecasts[i]
+				if forecasts == nil {
+					forecasts = simulateDivisionForecast(divisionUUID, forecastKeys[i], divisionForecastInput{
+						standings:       standings,
+						unfinished:      ufGames,
+						ratings:         forecastRatingsByDivision[i],
+						divisionNumber:  division.DivisionNumber,
+						highestDivision: highestDivision,
+						formula:         ipc.PromotionFormula(season.PromotionFormula),
+						playoffSize:     playoffSize,
+						bracket:         brackets[divisionUUID],
+					})
+				}
+				applyForecasts(protoStandings, userIDs, forecasts)
+			}
+			protoStandings = applyPlayoffStandings(protoStandings, userIDs, brackets[divisionUUID], playoffSize)
+
 			divisionName := ""
 			if division.DivisionName.Valid {
 				divisionName = division.DivisionName.String
@@ -2594,3 +2712,496 @@ func (ls *LeagueService) CancelPlayerResults(
 		),
 	}), nil
 }
+
+// leagueMatchToProto converts a match row and its time proposals to proto.
+func leagueMatchToProto(m models.GetSeasonLeagueMatchesRow, proposals []models.GetMatchProposalsRow) *pb.LeagueMatch {
+	match := &pb.LeagueMatch{
+		Uuid:            m.Uuid.String(),
+		SeasonId:        m.SeasonID.String(),
+		DivisionId:      m.DivisionID.String(),
+		WindowNumber:    m.WindowNumber,
+		WindowStart:     timestamppb.New(m.WindowStart.Time),
+		WindowEnd:       timestamppb.New(m.WindowEnd.Time),
+		Player0Id:       m.Player0Uuid,
+		Player0Username: m.Player0Username,
+		Player1Id:       m.Player1Uuid,
+		Player1Username: m.Player1Username,
+		Status:          matchStatus(m.Status, m.GameEndReason),
+		GameId:          m.GameUuid.String,
+	}
+	if m.ScheduledTime.Valid {
+		match.ScheduledTime = timestamppb.New(m.ScheduledTime.Time)
+	}
+	for _, p := range proposals {
+		match.Proposals = append(match.Proposals, &pb.MatchTimeProposal{
+			Id:               p.ID,
+			ProposerId:       p.ProposerUuid,
+			ProposerUsername: p.ProposerUsername,
+			Slot:             timestamppb.New(p.Slot.Time),
+			Status:           pb.MatchProposalStatus(p.Status),
+		})
+	}
+	return match
+}
+
+// leagueMatchResponse fetches a match with its proposals.
+func (ls *LeagueService) leagueMatchResponse(ctx context.Context, matchID uuid.UUID) (*connect.Response[pb.LeagueMatchResponse], error) {
+	m, err := ls.store.GetLeagueMatch(ctx, matchID)
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get match: %w", err))
+	}
+	proposals, err := ls.store.GetMatchProposals(ctx, []uuid.UUID{matchID})
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get match proposals: %w", err))
+	}
+	return connect.NewResponse(&pb.LeagueMatchResponse{
+		Match: leagueMatchToProto(models.GetSeasonLeagueMatchesRow(m), proposals),
+	}), nil
+}
+
+// playerLeagueMatch fetches a match that the given user plays in.
+func (ls *LeagueService) playerLeagueMatch(ctx context.Context, matchIDStr string, userID int32) (models.GetLeagueMatchRow, error) {
+	matchID, err := uuid.Parse(matchIDStr)
+	if err != nil {
+		return models.GetLeagueMatchRow{}, apiserver.InvalidArg("invalid match_id")
+	}
+	m, err := ls.store.GetLeagueMatch(ctx, matchID)
+	if err != nil {
+		return m, apiserver.NotFound("match not found")
+	}
+	if m.Player0ID != userID && m.Player1ID != userID {
+		return m, apiserver.PermissionDenied("you are not playing in this match")
+	}
+	return m, nil
+}
+
+// GetLeagueMatches returns the matches of a live league season, optionally
+// filtered by division, player and match window.
+func (ls *LeagueService) GetLeagueMatches(
+	ctx context.Context,
+	req *connect.Request[pb.GetLeagueMatchesRequest],
+) (*connect.Response[pb.LeagueMatchesResponse], error) {
+	seasonID, err := uuid.Parse(req.Msg.SeasonId)
+	if err != nil {
+		return nil, apiserver.InvalidArg("invalid season_id")
+	}
+	var divisionID uuid.UUID
+	if req.Msg.DivisionId != "" {
+		divisionID, err = uuid.Parse(req.Msg.DivisionId)
+		if err != nil {
+			return nil, apiserver.InvalidArg("invalid division_id")
+		}
+	}
+
+	rows, err := ls.store.GetSeasonLeagueMatches(ctx, seasonID)
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get matches: %w", err))
+	}
+	var matches []models.GetSeasonLeagueMatchesRow
+	for _, m := range rows {
+		if divisionID != uuid.Nil && m.DivisionID != divisionID {
+			continue
+		}
+		if req.Msg.UserId != "" && m.Player0Uuid != req.Msg.UserId && m.Player1Uuid != req.Msg.UserId {
+			continue
+		}
+		if req.Msg.WindowNumber > 0 && m.WindowNumber != req.Msg.WindowNumber {
+			continue
+		}
+		matches = append(matches, m)
+	}
+
+	matchIDs := make([]uuid.UUID, len(matches))
+	for i, m := range matches {
+		matchIDs[i] = m.Uuid
+	}
+	proposals, err := ls.store.GetMatchProposals(ctx, matchIDs)
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get match proposals: %w", err))
+	}
+	byMatch := make(map[uuid.UUID][]models.GetMatchProposalsRow)
+	for _, p := range proposals {
+		byMatch[p.MatchID] = append(byMatch[p.MatchID], p)
+	}
+
+	protoMatches := make([]*pb.LeagueMatch, len(matches))
+	for i, m := range matches {
+		protoMatches[i] = leagueMatchToProto(m, byMatch[m.Uuid])
+	}
+	return connect.NewResponse(&pb.LeagueMatchesResponse{Matches: protoMatches}), nil
+}
+
+// ProposeMatchTime proposes a time to play a match. The time must fall
+// within the match's window.
+func (ls *LeagueService) ProposeMatchTime(
+	ctx context.Context,
+	req *connect.Request[pb.ProposeMatchTimeRequest],
+) (*connect.Response[pb.LeagueMatchResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ls.userStore)
+	if err != nil {
+		return nil, err
+	}
+	m, err := ls.playerLeagueMatch(ctx, req.Msg.MatchId, int32(user.ID))
+	if err != nil {
+		return nil, err
+	}
+	if m.GameUuid.Valid || m.Status == int32(pb.LeagueMatchStatus_MATCH_FORFEITED) {
+		return nil, apiserver.InvalidArg("this match has already been played")
+	}
+	if req.Msg.Slot == nil {
+		return nil, apiserver.InvalidArg("slot is required")
+	}
+	slot := req.Msg.Slot.AsTime()
+	if !slot.After(time.Now()) {
+		return nil, apiserver.InvalidArg("the proposed time must be in the future")
+	}
+	if slot.Before(m.WindowStart.Time) || !slot.Before(m.WindowEnd.Time) {
+		return nil, apiserver.InvalidArg("the proposed time must be within the match window")
+	}
+
+	_, err = ls.store.AddMatchProposal(ctx, models.AddMatchProposalParams{
+		MatchID:    m.Uuid,
+		ProposerID: int32(user.ID),
+		Slot:       pgtype.Timestamptz{Time: slot, Valid: true},
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to add proposal: %w", err))
+	}
+
+	log.Info().
+		Str("matchID", m.Uuid.String()).
+		Str("userID", user.UUID).
+		Time("slot", slot).
+		Msg("match-time-proposed")
+
+	return ls.leagueMatchResponse(ctx, m.Uuid)
+}
+
+// RespondToMatchProposal accepts or declines an opponent's proposed time.
+// Accepting schedules the match and supersedes any other open proposals.
+func (ls *LeagueService) RespondToMatchProposal(
+	ctx context.Context,
+	req *connect.Request[pb.RespondToMatchProposalRequest],
+) (*connect.Response[pb.LeagueMatchResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ls.userStore)
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := ls.store.GetMatchProposal(ctx, req.Msg.ProposalId)
+	if err != nil {
+		return nil, apiserver.NotFound("proposal not found")
+	}
+	m, err := ls.playerLeagueMatch(ctx, proposal.MatchID.String(), int32(user.ID))
+	if err != nil {
+		return nil, err
+	}
+	if proposal.ProposerID == int32(user.ID) {
+		return nil, apiserver.InvalidArg("you cannot respond to your own proposal")
+	}
+	if proposal.Status != int32(pb.MatchProposalStatus_PROPOSAL_OPEN) {
+		return nil, apiserver.InvalidArg("this proposal is no longer open")
+	}
+	if m.GameUuid.Valid || m.Status == int32(pb.LeagueMatchStatus_MATCH_FORFEITED) {
+		return nil, apiserver.InvalidArg("this match has already been played")
+	}
+
+	if req.Msg.Accept {
+		if !proposal.Slot.Time.After(time.Now()) {
+			return nil, apiserver.InvalidArg("the proposed time has already passed")
+		}
+		err = ls.store.AcceptMatchProposal(ctx, proposal)
+	} else {
+		err = ls.store.DeclineMatchProposal(ctx, proposal.ID)
+	}
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to respond to proposal: %w", err))
+	}
+
+	log.Info().
+		Str("matchID", m.Uuid.String()).
+		Int64("proposalID", proposal.ID).
+		Bool("accepted", req.Msg.Accept).
+		Msg("match-proposal-answered")
+
+	return ls.leagueMatchResponse(ctx, m.Uuid)
+}
+
+// StartLeagueMatch creates the game of a scheduled match. Either player can
+// start it from shortly before the agreed time until the window closes.
+func (ls *LeagueService) StartLeagueMatch(
+	ctx context.Context,
+	req *connect.Request[pb.LeagueMatchRequest],
+) (*connect.Response[pb.StartLeagueMatchResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ls.userStore)
+	if err != nil {
+		return nil, err
+	}
+	m, err := ls.playerLeagueMatch(ctx, req.Msg.MatchId, int32(user.ID))
+	if err != nil {
+		return nil, err
+	}
+	if m.Status != int32(pb.LeagueMatchStatus_MATCH_SCHEDULED) || m.GameUuid.Valid {
+		return nil, apiserver.InvalidArg("only scheduled matches can be started")
+	}
+	now := time.Now()
+	if now.Before(m.ScheduledTime.Time.Add(-MatchStartEarlyMinutes * time.Minute)) {
+		return nil, apiserver.InvalidArg(fmt.Sprintf("the match can be started at most %d minutes before the agreed time", MatchStartEarlyMinutes))
+	}
+	if !now.Before(m.WindowEnd.Time) {
+		return nil, apiserver.InvalidArg("the match window has closed")
+	}
+
+	season, settings, err := seasonLeagueSettings(ctx, ls.store, m.SeasonID)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	if season.Status != int32(ipc.SeasonStatus_SEASON_ACTIVE) {
+		return nil, apiserver.InvalidArg("the season is not active")
+	}
+
+	msm := NewMatchScheduleManager(ls.stores, ls.cfg, ls.gameCreator, RealClock{})
+	gameID, err := msm.StartMatch(ctx, season.LeagueID, m, settings)
+	if errors.Is(err, ErrMatchAlreadyStarted) {
+		return nil, apiserver.InvalidArg(err.Error())
+	} else if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to start match: %w", err))
+	}
+
+	log.Info().
+		Str("matchID", m.Uuid.String()).
+		Str("gameID", gameID).
+		Str("userID", user.UUID).
+		Msg("league-match-started")
+
+	return connect.NewResponse(&pb.StartLeagueMatchResponse{GameId: gameID}), nil
+}
+
+// resolveLeagueID returns the UUID of a league given by UUID or slug.
+func (ls *LeagueService) resolveLeagueID(ctx context.Context, leagueID string) (uuid.UUID, error) {
+	leagueUUID, err := uuid.Parse(leagueID)
+	if err == nil {
+		return leagueUUID, nil
+	}
+	dbLeague, err := ls.store.GetLeagueBySlug(ctx, leagueID)
+	if err != nil {
+		return uuid.Nil, apiserver.InvalidArg(fmt.Sprintf("league not found: %s", leagueID))
+	}
+	return dbLeague.Uuid, nil
+}
+
+// substitutePoolResponse lists the league's substitute pool.
+func (ls *LeagueService) substitutePoolResponse(ctx context.Context, leagueID uuid.UUID) (*connect.Response[pb.SubstitutePoolResponse], error) {
+	rows, err := ls.store.GetLeagueSubstitutes(ctx, leagueID)
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get substitute pool: %w", err))
+	}
+	subs := make([]*pb.LeagueSubstitute, len(rows))
+	for i, row := range rows {
+		subs[i] = &pb.LeagueSubstitute{
+			UserId:   row.UserUuid,
+			Username: row.Username,
+			JoinedAt: timestamppb.New(row.CreatedAt.Time),
+		}
+	}
+	return connect.NewResponse(&pb.SubstitutePoolResponse{Substitutes: subs}), nil
+}
+
+// JoinSubstitutePool makes the logged-in player available as a substitute
+// in the league.
+func (ls *LeagueService) JoinSubstitutePool(
+	ctx context.Context,
+	req *connect.Request[pb.LeagueRequest],
+) (*connect.Response[pb.SubstitutePoolResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ls.userStore)
+	if err != nil {
+		return nil, err
+	}
+	hasPermission, err := rbac.HasPermission(ctx, ls.queries, uint(user.ID), rbac.CanPlayLeagues)
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to check league permissions: %w", err))
+	}
+	if !hasPermission {
+		return nil, apiserver.PermissionDenied("You need permission to play in leagues. Please contact a League Promoter for access.")
+	}
+
+	leagueID, err := ls.resolveLeagueID(ctx, req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+	regMgr := NewRegistrationManager(ls.store, RealClock{}, ls.stores)
+	if err := regMgr.JoinSubstitutePool(ctx, int32(user.ID), leagueID); err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to join substitute pool: %w", err))
+	}
+
+	log.Info().
+		Str("userID", user.UUID).
+		Str("leagueID", leagueID.String()).
+		Msg("player-joined-substitute-pool")
+
+	return ls.substitutePoolResponse(ctx, leagueID)
+}
+
+// LeaveSubstitutePool takes the logged-in player out of the league's
+// substitute pool.
+func (ls *LeagueService) LeaveSubstitutePool(
+	ctx context.Context,
+	req *connect.Request[pb.LeagueRequest],
+) (*connect.Response[pb.SubstitutePoolResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ls.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	leagueID, err := ls.resolveLeagueID(ctx, req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+	regMgr := NewRegistrationManager(ls.store, RealClock{}, ls.stores)
+	if err := regMgr.LeaveSubstitutePool(ctx, int32(user.ID), leagueID); err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to leave substitute pool: %w", err))
+	}
+
+	log.Info().
+		Str("userID", user.UUID).
+		Str("leagueID", leagueID.String()).
+		Msg("player-left-substitute-pool")
+
+	return ls.substitutePoolResponse(ctx, leagueID)
+}
+
+func (ls *LeagueService) GetSubstitutePool(
+	ctx context.Context,
+	req *connect.Request[pb.LeagueRequest],
+) (*connect.Response[pb.SubstitutePoolResponse], error) {
+	leagueID, err := ls.resolveLeagueID(ctx, req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+	return ls.substitutePoolResponse(ctx, leagueID)
+}
+
+// SubstitutePlayer replaces a player who dropped out of an active season
+// with a player from the league's substitute pool.
+func (ls *LeagueService) SubstitutePlayer(
+	ctx context.Context,
+	req *connect.Request[pb.SubstitutePlayerRequest],
+) (*connect.Response[pb.SubstitutePlayerResponse], error) {
+	// Authenticate - requires can_manage_leagues or league promoter
+	if err := ls.authenticateLeaguePromoterOrAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	seasonID, err := uuid.Parse(req.Msg.SeasonId)
+	if err != nil {
+		return nil, apiserver.InvalidArg("invalid season_id")
+	}
+	season, err := ls.store.GetSeason(ctx, seasonID)
+	if err != nil {
+		return nil, apiserver.InvalidArg(fmt.Sprintf("season not found: %s", req.Msg.SeasonId))
+	}
+	if season.Status != int32(ipc.SeasonStatus_SEASON_ACTIVE) {
+		return nil, apiserver.InvalidArg(fmt.Sprintf("can only substitute players when season is ACTIVE (current status: %s)", ipc.SeasonStatus(season.Status).String()))
+	}
+
+	mdm := NewManualDivisionManager(ls.stores)
+	result, err := mdm.SubstitutePlayer(ctx, ls.gameCreator, seasonID,
+		req.Msg.DroppedUserId, req.Msg.SubstituteUserId, req.Msg.Handling)
+	if errors.Is(err, ErrInvalidSubstitution) {
+		return nil, apiserver.InvalidArg(err.Error())
+	} else if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to substitute player: %w", err))
+	}
+
+	return connect.NewResponse(&pb.SubstitutePlayerResponse{
+		Success:       true,
+		GamesReplaced: int32(result.GamesReplaced),
+		Message:       fmt.Sprintf("Substitute placed: %d unplayed games handed over", result.GamesReplaced),
+	}), nil
+}
+
+// GetLeagueRatingHistory returns a player's league rating and how it changed
+// over their league games, for charting.
+func (ls *LeagueService) GetLeagueRatingHistory(
+	ctx context.Context,
+	req *connect.Request[pb.GetLeagueRatingHistoryRequest],
+) (*connect.Response[pb.LeagueRatingHistoryResponse], error) {
+	leagueID, err := ls.resolveLeagueID(ctx, req.Msg.LeagueId)
+	if err != nil {
+		return nil, err
+	}
+	u, err := ls.userStore.GetByUUID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, apiserver.InvalidArg(fmt.Sprintf("user not found: %s", req.Msg.UserId))
+	}
+	userID := int32(u.ID)
+
+	resp := &pb.LeagueRatingHistoryResponse{}
+	ratings, err := ls.store.GetLeagueRatings(ctx, models.GetLeagueRatingsParams{
+		LeagueID: leagueID,
+		UserIds:  []int32{userID},
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get league rating: %w", err))
+	}
+	if len(ratings) == 0 {
+		// The player has not played in the league yet
+		initial := initialLeagueRating()
+		resp.Rating = initial.Rating
+		resp.RatingDeviation = initial.RatingDeviation
+		return connect.NewResponse(resp), nil
+	}
+	resp.Rating = ratings[0].Rating
+	resp.RatingDeviation = ratings[0].RatingDeviation
+	resp.GamesPlayed = ratings[0].GamesPlayed
+
+	rows, err := ls.store.GetLeagueRatingHistory(ctx, models.GetLeagueRatingHistoryParams{
+		LeagueID: leagueID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get league rating history: %w", err))
+	}
+	resp.History = make([]*pb.LeagueRatingPoint, len(rows))
+	for i, row := range rows {
+		resp.History[i] = &pb.LeagueRatingPoint{
+			GameId:           row.GameUuid,
+			SeasonNumber:     row.SeasonNumber.Int32,
+			OpponentUserId:   row.OpponentUuid,
+			OpponentUsername: row.OpponentUsername,
+			RatingBefore:     row.RatingBefore,
+			RatingAfter:      row.RatingAfter,
+			RatingDeviation:  row.RatingDeviation,
+			PlayedAt:         timestamppb.New(row.CreatedAt.Time),
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetPlayerLeagueRatings returns a player's rating in every league they have
+// played in.
+func (ls *LeagueService) GetPlayerLeagueRatings(
+	ctx context.Context,
+	req *connect.Request[pb.GetPlayerLeagueRatingsRequest],
+) (*connect.Response[pb.PlayerLeagueRatingsResponse], error) {
+	u, err := ls.userStore.GetByUUID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, apiserver.InvalidArg(fmt.Sprintf("user not found: %s", req.Msg.UserId))
+	}
+	rows, err := ls.store.GetPlayerLeagueRatings(ctx, int32(u.ID))
+	if err != nil {
+		return nil, apiserver.InternalErr(fmt.Errorf("failed to get league ratings: %w", err))
+	}
+	ratings := make([]*pb.PlayerLeagueRating, len(rows))
+	for i, row := range rows {
+		ratings[i] = &pb.PlayerLeagueRating{
+			LeagueId:        row.LeagueID.String(),
+			LeagueName:      row.LeagueName,
+			LeagueSlug:      row.LeagueSlug,
+			Rating:          row.Rating,
+			RatingDeviation: row.RatingDeviation,
+			GamesPlayed:     row.GamesPlayed,
+			LastGameAt:      timestamppb.New(row.LastGameAt.Time),
+		}
+	}
+	return connect.NewResponse(&pb.PlayerLeagueRatingsResponse{Ratings: ratings}), nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
 
 	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/pkg/stores/league"
@@ -187,8 +188,13 @@ func (sm *StandingsManager) markDivisionOutcomes(
 		}
 	}
 
-	// Sort standings to determine rank
+	// Sort standings to determine rank; a division playoff decides the top places
 	SortStandingsByRank(standings)
+	bracket, err := divisionPlayoffBracket(ctx, sm.store, division.Uuid)
+	if err != nil {
+		return err
+	}
+	OrderStandingsByPlayoff(standings, bracket)
 
 	// Convert to PlayerStanding for markOutcomes
 	playerStandings := make([]PlayerStanding, len(standings))
@@ -288,6 +294,11 @@ func (sm *StandingsManager) calculateDivisionStandings(
 		return fmt.Errorf("failed to get game results: %w", err)
 	}
 
+	subs, err := sm.divisionSubstitutions(ctx, division.Uuid)
+	if err != nil {
+		return err
+	}
+
 	// Create a map to track player stats
 	playerStats := make(map[int32]*PlayerStanding)
 	for _, reg := range registrations {
@@ -304,18 +315,34 @@ func (sm *StandingsManager) calculateDivisionStandings(
 		}
 	}
 
+	// Games that no longer count for a player who holds a place in the
+	// division, because they were played by a dropped player or voided
+	excusedGames := make(map[int32]int)
+
 	// Process each game result
 	for _, game := range gameResults {
-		// Get player IDs
-		player0ID := game.Player0ID.Int32
-		player1ID := game.Player1ID.Int32
+		// Games of dropped players count for their substitutes, if at all
+		attr := subs.attribute(game.Player0ID.Int32, game.Player1ID.Int32)
 
 		// Skip if players not in this division (shouldn't happen, but be safe)
-		p0Stats, p0Exists := playerStats[player0ID]
-		p1Stats, p1Exists := playerStats[player1ID]
+		p0Stats, p0Exists := playerStats[attr.owners[0]]
+		p1Stats, p1Exists := playerStats[attr.owners[1]]
 		if !p0Exists || !p1Exists {
 			continue
 		}
+		for _, userID := range attr.excused {
+			excusedGames[userID]++
+		}
+		if !attr.counted[0] && !attr.counted[1] {
+			continue
+		}
+		// A side that does not count is tallied and thrown away
+		if !attr.counted[0] {
+			p0Stats = &PlayerStanding{}
+		}
+		if !attr.counted[1] {
+			p1Stats = &PlayerStanding{}
+		}
 
 		// Increment games played
 		p0Stats.GamesPlayed++
@@ -365,12 +392,12 @@ func (sm *StandingsManager) calculateDivisionStandings(
 		sm.markOutcomes(standings, division.DivisionNumber, highestRegularDivision, promotionFormula)
 	}
 
-	// Calculate expected games per player based on division size
-	expectedGames := CalculateExpectedGamesPerPlayer(len(registrations))
+	// Calculate expected games per player based on division size and format
+	expectedGames := CalculateExpectedGames(len(registrations), sm.formatSettings(ctx, division.Uuid))
 
 	// Save to database (rank is not saved - it's calculated on-demand when fetching)
 	for _, standing := range standings {
-		gamesRemaining := expectedGames - standing.GamesPlayed
+		gamesRemaining := expectedGames - standing.GamesPlayed - excusedGames[standing.UserID]
 		if gamesRemaining < 0 {
 			gamesRemaining = 0 // Safety check
 		}
@@ -411,6 +438,85 @@ func (sm *StandingsManager) calculateDivisionStandings(
 	return nil
 }
 
+// divisionSubstitutions records the players of a division who were replaced
+// by a substitute, keyed by the dropped player.
+type divisionSubstitutions map[int32]models.GetDivisionSubstitutionsRow
+
+func (sm *StandingsManager) divisionSubstitutions(ctx context.Context, divisionID uuid.UUID) (divisionSubstitutions, error) {
+	rows, err := sm.store.GetDivisionSubstitutions(ctx, divisionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get division substitutions: %w", err)
+	}
+	subs := make(divisionSubstitutions, len(rows))
+	for _, row := range rows {
+		subs[row.DroppedUserID] = row
+	}
+	return subs, nil
+}
+
+// gameAttribution says whose standings each side of a finished game counts
+// towards.
+type gameAttribution struct {
+	owners [2]int32
+	// counted is false for a side whose result does not count
+	counted [2]bool
+	// excused are the owners whose schedule no longer includes the game
+	excused []int32
+}
+
+// replacement follows a dropped player's substitutions to the player who
+// holds their place now. The handling of their games is decided by the
+// first substitution.
+func (ds divisionSubstitutions) replacement(userID int32) (int32, pb.SubstituteResultHandling, bool) {
+	sub, ok := ds[userID]
+	if !ok {
+		return userID, pb.SubstituteResultHandling_SUB_KEEP_RESULTS, false
+	}
+	current := sub.SubstituteUserID
+	// A substitute may drop out too; bounded in case of a cycle
+	for range len(ds) {
+		next, ok := ds[current]
+		if !ok {
+			break
+		}
+		current = next.SubstituteUserID
+	}
+	return current, pb.SubstituteResultHandling(sub.Handling), true
+}
+
+// attribute decides how a game between two players counts. Games of players
+// who were not substituted count as usual. A dropped player's games are
+// transferred to their substitute, kept for their opponents only, or voided
+// for both sides. The substitute is excused from the kept and voided games,
+// as is the opponent of a voided game.
+func (ds divisionSubstitutions) attribute(player0ID, player1ID int32) gameAttribution {
+	attr := gameAttribution{
+		owners:  [2]int32{player0ID, player1ID},
+		counted: [2]bool{true, true},
+	}
+	voided := false
+	for i, userID := range [2]int32{player0ID, player1ID} {
+		current, handling, ok := ds.replacement(userID)
+		if !ok {
+			continue
+		}
+		attr.owners[i] = current
+		switch handling {
+		case pb.SubstituteResultHandling_SUB_TRANSFER_RESULTS:
+		case pb.SubstituteResultHandling_SUB_VOID_RESULTS:
+			voided = true
+		default:
+			attr.counted[i] = false
+			attr.excused = append(attr.excused, current)
+		}
+	}
+	if voided {
+		attr.counted = [2]bool{false, false}
+		attr.excused = attr.owners[:]
+	}
+	return attr
+}
+
 // SortStandingsByRank sorts standings by points (wins*2+draws) desc, spread desc, then username asc
 // This is the canonical sorting function used everywhere for consistency
 func SortStandingsByRank(standings []models.GetStandingsRow) {
@@ -524,8 +630,8 @@ func (sm *StandingsManager) UpdateStandingsIncremental(
 		return fmt.Errorf("failed to get division registrations: %w", err)
 	}
 
-	// Calculate expected games per player based on division size
-	expectedGames := CalculateExpectedGamesPerPlayer(len(registrations))
+	// Calculate expected games per player based on division size and format
+	expectedGames := CalculateExpectedGames(len(registrations), sm.formatSettings(ctx, divisionID))
 	// When a player's first game completes, games_played will be 1, so games_remaining should be expectedGames - 1
 	initialGamesRemaining := expectedGames - 1
 
@@ -613,6 +719,8 @@ func (sm *StandingsManager) UpdateStandingsIncremental(
 	// Note: Rank is no longer stored in DB - it's calculated on-demand when fetching standings
 	// by sorting by (wins*2 + draws) DESC, spread DESC, username ASC
 
+	InvalidateDivisionForecast(divisionID)
+
 	return nil
 }
 
@@ -660,6 +768,11 @@ func (sm *StandingsManager) recalculateDivisionExtendedStats(
 		return fmt.Errorf("failed to get games with stats: %w", err)
 	}
 
+	subs, err := sm.divisionSubstitutions(ctx, divisionID)
+	if err != nil {
+		return err
+	}
+
 	// Create a map to track player extended stats
 	playerStats := make(map[int32]*PlayerStanding)
 	for _, reg := range registrations {
@@ -671,14 +784,19 @@ func (sm *StandingsManager) recalculateDivisionExtendedStats(
 
 	// Process each game
 	for _, game := range games {
-		player0ID := game.Player0ID.Int32
-		player1ID := game.Player1ID.Int32
+		attr := subs.attribute(game.Player0ID.Int32, game.Player1ID.Int32)
 
-		p0Standing, p0Exists := playerStats[player0ID]
-		p1Standing, p1Exists := playerStats[player1ID]
-		if !p0Exists || !p1Exists {
+		p0Standing, p0Exists := playerStats[attr.owners[0]]
+		p1Standing, p1Exists := playerStats[attr.owners[1]]
+		if !p0Exists || !p1Exists || (!attr.counted[0] && !attr.counted[1]) {
 			continue
 		}
+		if !attr.counted[0] {
+			p0Standing = &PlayerStanding{}
+		}
+		if !attr.counted[1] {
+			p1Standing = &PlayerStanding{}
+		}
 
 		// Extract scores
 		p0Score := int(game.Player0Score)
@@ -919,3 +1037,14 @@ func (sm *StandingsManager) recalculateDivisionMistakeIndex(
 
 	return nil
 }
+
+// formatSettings returns the league settings used for the division's format.
+// Standings are still updated with the default format if they can't be read.
+func (sm *StandingsManager) formatSettings(ctx context.Context, divisionID uuid.UUID) *pb.LeagueSettings {
+	settings, err := divisionLeagueSettings(ctx, sm.store, divisionID)
+	if err != nil {
+		log.Warn().Err(err).Str("divisionID", divisionID.String()).Msg("league-format-settings-unavailable")
+		return nil
+	}
+	return settings
+}
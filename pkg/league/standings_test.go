@@ -6,12 +6,14 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/woogles-io/liwords/pkg/stores/league"
 	"github.com/woogles-io/liwords/pkg/stores/models"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
 
 // mockLeagueStore implements league.Store for testing
@@ -22,6 +24,7 @@ type mockLeagueStore struct {
 	registrations  map[uuid.UUID][]models.GetDivisionRegistrationsRow
 	gamesWithStats map[uuid.UUID][]models.GetDivisionGamesWithStatsRow
 	analyzedGames  map[uuid.UUID][]models.GetDivisionAnalyzedGamesRow
+	substitutions  map[uuid.UUID][]models.GetDivisionSubstitutionsRow
 }
 
 func newMockLeagueStore() *mockLeagueStore {
@@ -32,6 +35,7 @@ func newMockLeagueStore() *mockLeagueStore {
 		registrations:  make(map[uuid.UUID][]models.GetDivisionRegistrationsRow),
 		gamesWithStats: make(map[uuid.UUID][]models.GetDivisionGamesWithStatsRow),
 		analyzedGames:  make(map[uuid.UUID][]models.GetDivisionAnalyzedGamesRow),
+		substitutions:  make(map[uuid.UUID][]models.GetDivisionSubstitutionsRow),
 	}
 }
 
@@ -78,6 +82,7 @@ func (m *mockLeagueStore) UpsertStanding(ctx context.Context, arg models.UpsertS
 			divStandings[i].Draws = arg.Draws
 			divStandings[i].Spread = arg.Spread
 			divStandings[i].GamesPlayed = arg.GamesPlayed
+			divStandings[i].GamesRemaining = arg.GamesRemaining
 			divStandings[i].Result = arg.Result
 			divStandings[i].TotalMistakeIndex = arg.TotalMistakeIndex
 			divStandings[i].GamesAnalyzed = arg.GamesAnalyzed
@@ -95,6 +100,7 @@ func (m *mockLeagueStore) UpsertStanding(ctx context.Context, arg models.UpsertS
 			Draws:             arg.Draws,
 			Spread:            arg.Spread,
 			GamesPlayed:       arg.GamesPlayed,
+			GamesRemaining:    arg.GamesRemaining,
 			Result:            arg.Result,
 			TotalMistakeIndex: arg.TotalMistakeIndex,
 			GamesAnalyzed:     arg.GamesAnalyzed,
@@ -168,6 +174,9 @@ func (m *mockLeagueStore) MarkSeasonClosed(ctx context.Context, uuid uuid.UUID)
 func (m *mockLeagueStore) MarkDivisionsPrepared(ctx context.Context, uuid uuid.UUID) error {
 	return nil
 }
+func (m *mockLeagueStore) MarkPlayoffsStarted(ctx context.Context, uuid uuid.UUID) error {
+	return nil
+}
 func (m *mockLeagueStore) MarkSeasonStarted(ctx context.Context, uuid uuid.UUID) error { return nil }
 func (m *mockLeagueStore) MarkRegistrationOpened(ctx context.Context, uuid uuid.UUID) error {
 	return nil
@@ -283,6 +292,102 @@ func (m *mockLeagueStore) GetForceFinishedGamesMissingPlayers(ctx context.Contex
 func (m *mockLeagueStore) GetPlayerSeasonOpponents(ctx context.Context, seasonID uuid.UUID, userUUID string) ([]string, error) {
 	return nil, nil
 }
+func (m *mockLeagueStore) AddLeaguePlayoffGame(ctx context.Context, arg models.AddLeaguePlayoffGameParams) error {
+	return nil
+}
+func (m *mockLeagueStore) GetLeaguePlayoffGames(ctx context.Context, divisionIDs []uuid.UUID) ([]models.GetLeaguePlayoffGamesRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) CountSeasonPlayoffGames(ctx context.Context, seasonID uuid.UUID) (int64, error) {
+	return 0, nil
+}
+func (m *mockLeagueStore) CreateLeagueMatch(ctx context.Context, arg models.CreateLeagueMatchParams) error {
+	return nil
+}
+func (m *mockLeagueStore) GetSeasonLeagueMatches(ctx context.Context, seasonID uuid.UUID) ([]models.GetSeasonLeagueMatchesRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) GetLeagueMatch(ctx context.Context, matchID uuid.UUID) (models.GetLeagueMatchRow, error) {
+	return models.GetLeagueMatchRow{}, nil
+}
+func (m *mockLeagueStore) GetPendingLeagueMatches(ctx context.Context, divisionIDs []uuid.UUID) ([]models.GetPendingLeagueMatchesRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) ClaimLeagueMatch(ctx context.Context, arg models.ClaimLeagueMatchParams) (int64, error) {
+	return 0, nil
+}
+func (m *mockLeagueStore) ReleaseLeagueMatch(ctx context.Context, arg models.ReleaseLeagueMatchParams) error {
+	return nil
+}
+func (m *mockLeagueStore) SetLeagueMatchGame(ctx context.Context, matchID uuid.UUID, gameUUID string) error {
+	return nil
+}
+func (m *mockLeagueStore) MarkMatchWindowReminderSent(ctx context.Context, matchID uuid.UUID) error {
+	return nil
+}
+func (m *mockLeagueStore) MarkMatchSlotReminderSent(ctx context.Context, matchID uuid.UUID) error {
+	return nil
+}
+func (m *mockLeagueStore) AddMatchProposal(ctx context.Context, arg models.AddMatchProposalParams) (int64, error) {
+	return 0, nil
+}
+func (m *mockLeagueStore) GetMatchProposal(ctx context.Context, id int64) (models.GetMatchProposalRow, error) {
+	return models.GetMatchProposalRow{}, nil
+}
+func (m *mockLeagueStore) GetMatchProposals(ctx context.Context, matchIDs []uuid.UUID) ([]models.GetMatchProposalsRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) DeclineMatchProposal(ctx context.Context, id int64) error {
+	return nil
+}
+func (m *mockLeagueStore) AcceptMatchProposal(ctx context.Context, proposal models.GetMatchProposalRow) error {
+	return nil
+}
+func (m *mockLeagueStore) AddLeagueSubstitute(ctx context.Context, arg models.AddLeagueSubstituteParams) error {
+	return nil
+}
+func (m *mockLeagueStore) RemoveLeagueSubstitute(ctx context.Context, arg models.RemoveLeagueSubstituteParams) error {
+	return nil
+}
+func (m *mockLeagueStore) GetLeagueSubstitutes(ctx context.Context, leagueID uuid.UUID) ([]models.GetLeagueSubstitutesRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) GetDivisionSubstitutions(ctx context.Context, divisionID uuid.UUID) ([]models.GetDivisionSubstitutionsRow, error) {
+	return m.substitutions[divisionID], nil
+}
+func (m *mockLeagueStore) SubstitutePlayer(ctx context.Context, arg league.SubstitutionParams) ([]uuid.UUID, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) GetSubstitution(ctx context.Context, arg models.GetSubstitutionParams) (models.GetSubstitutionRow, error) {
+	return models.GetSubstitutionRow{}, pgx.ErrNoRows
+}
+func (m *mockLeagueStore) GetPendingSubstitutionGames(ctx context.Context, substitutionID int64) ([]models.GetPendingSubstitutionGamesRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) SetSubstitutionReplacementGame(ctx context.Context, arg models.SetSubstitutionReplacementGameParams) error {
+	return nil
+}
+func (m *mockLeagueStore) FinishSubstitutionGame(ctx context.Context, gameUUID string) error {
+	return nil
+}
+func (m *mockLeagueStore) GetLeagueRatings(ctx context.Context, arg models.GetLeagueRatingsParams) ([]models.GetLeagueRatingsRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) GetLeagueRatingHistory(ctx context.Context, arg models.GetLeagueRatingHistoryParams) ([]models.GetLeagueRatingHistoryRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) GetLeagueRatedGames(ctx context.Context, leagueID uuid.UUID) ([]models.GetLeagueRatedGamesRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) GetPlayerLeagueRatings(ctx context.Context, userID int32) ([]models.GetPlayerLeagueRatingsRow, error) {
+	return nil, nil
+}
+func (m *mockLeagueStore) RecordLeagueGameRatings(ctx context.Context, history []models.AddLeagueRatingHistoryParams, ratings []models.UpsertLeagueRatingParams) (bool, error) {
+	return true, nil
+}
+func (m *mockLeagueStore) ResetLeagueRatings(ctx context.Context, leagueID uuid.UUID) error {
+	return nil
+}
 func (m *mockLeagueStore) GetPlayerSeasonGames(ctx context.Context, seasonID uuid.UUID, userUUID string) ([]models.GetPlayerSeasonGamesRow, error) {
 	return nil, nil
 }
@@ -445,6 +550,122 @@ func TestStandingsCalculation_SimpleWinsLosses(t *testing.T) {
 	assert.Equal(t, int32(3), bob.GamesPlayed.Int32)
 }
 
+func TestStandingsCalculation_Substitutions(t *testing.T) {
+	// Players 1, 2 and 3 stay; player 5 dropped out after two games and
+	// player 6 took their place.
+	// 5 beat 1 420-400, 2 beat 5 430-390 (before the substitution)
+	// 1 beat 2 450-400, 6 beat 3 400-350
+	gameResults := []models.GetDivisionGameResultsRow{
+		{Player0ID: pgtype.Int4{Int32: 5, Valid: true}, Player1ID: pgtype.Int4{Int32: 1, Valid: true},
+			Player0Score: 420, Player1Score: 400, Player0Won: pgtype.Bool{Bool: true, Valid: true}},
+		{Player0ID: pgtype.Int4{Int32: 2, Valid: true}, Player1ID: pgtype.Int4{Int32: 5, Valid: true},
+			Player0Score: 430, Player1Score: 390, Player0Won: pgtype.Bool{Bool: true, Valid: true}},
+		{Player0ID: pgtype.Int4{Int32: 1, Valid: true}, Player1ID: pgtype.Int4{Int32: 2, Valid: true},
+			Player0Score: 450, Player1Score: 400, Player0Won: pgtype.Bool{Bool: true, Valid: true}},
+		{Player0ID: pgtype.Int4{Int32: 6, Valid: true}, Player1ID: pgtype.Int4{Int32: 3, Valid: true},
+			Player0Score: 400, Player1Score: 350, Player0Won: pgtype.Bool{Bool: true, Valid: true}},
+	}
+
+	type record struct{ wins, losses, spread, played, remaining int32 }
+	tests := []struct {
+		name     string
+		handling ipc.SubstituteResultHandling
+		expected map[int32]record
+	}{
+		{
+			name:     "keep",
+			handling: ipc.SubstituteResultHandling_SUB_KEEP_RESULTS,
+			expected: map[int32]record{
+				1: {1, 1, 30, 2, 1},
+				2: {1, 1, -10, 2, 1},
+				3: {0, 1, -50, 1, 2},
+				6: {1, 0, 50, 1, 0},
+			},
+		},
+		{
+			name:     "void",
+			handling: ipc.SubstituteResultHandling_SUB_VOID_RESULTS,
+			expected: map[int32]record{
+				1: {1, 0, 50, 1, 1},
+				2: {0, 1, -50, 1, 1},
+				3: {0, 1, -50, 1, 2},
+				6: {1, 0, 50, 1, 0},
+			},
+		},
+		{
+			name:     "transfer",
+			handling: ipc.SubstituteResultHandling_SUB_TRANSFER_RESULTS,
+			expected: map[int32]record{
+				1: {1, 1, 30, 2, 1},
+				2: {1, 1, -10, 2, 1},
+				3: {0, 1, -50, 1, 2},
+				6: {2, 1, 30, 3, 0},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newMockLeagueStore()
+			mgr := NewStandingsManager(store)
+
+			divisionID := uuid.New()
+			seasonID := uuid.New()
+			store.divisions[divisionID] = models.LeagueDivision{
+				Uuid:           divisionID,
+				SeasonID:       seasonID,
+				DivisionNumber: 1,
+			}
+			for _, userID := range []int32{1, 2, 3, 6} {
+				store.registrations[divisionID] = append(store.registrations[divisionID],
+					models.GetDivisionRegistrationsRow{UserID: userID, DivisionID: pgtype.UUID{Bytes: divisionID, Valid: true}})
+			}
+			store.gameResults[divisionID] = gameResults
+			store.substitutions[divisionID] = []models.GetDivisionSubstitutionsRow{
+				{DroppedUserID: 5, SubstituteUserID: 6, Handling: int32(tc.handling)},
+			}
+
+			require.NoError(t, mgr.RecalculateAndSaveStandings(ctx, seasonID))
+
+			standings := store.standings[divisionID]
+			require.Len(t, standings, len(tc.expected))
+			for _, s := range standings {
+				exp, ok := tc.expected[s.UserID]
+				require.True(t, ok, "unexpected standing for user %d", s.UserID)
+				assert.Equal(t, exp.wins, s.Wins.Int32, "wins of user %d", s.UserID)
+				assert.Equal(t, exp.losses, s.Losses.Int32, "losses of user %d", s.UserID)
+				assert.Equal(t, exp.spread, s.Spread.Int32, "spread of user %d", s.UserID)
+				assert.Equal(t, exp.played, s.GamesPlayed.Int32, "games played by user %d", s.UserID)
+				assert.Equal(t, exp.remaining, s.GamesRemaining.Int32, "games remaining for user %d", s.UserID)
+			}
+		})
+	}
+}
+
+func TestDivisionSubstitutionsChain(t *testing.T) {
+	// 5 was replaced by 6, who was then replaced by 7. The first
+	// substitution's handling decides how 5's games count.
+	subs := divisionSubstitutions{
+		5: {DroppedUserID: 5, SubstituteUserID: 6, Handling: int32(ipc.SubstituteResultHandling_SUB_TRANSFER_RESULTS)},
+		6: {DroppedUserID: 6, SubstituteUserID: 7, Handling: int32(ipc.SubstituteResultHandling_SUB_KEEP_RESULTS)},
+	}
+
+	attr := subs.attribute(5, 1)
+	assert.Equal(t, [2]int32{7, 1}, attr.owners)
+	assert.Equal(t, [2]bool{true, true}, attr.counted)
+	assert.Empty(t, attr.excused)
+
+	attr = subs.attribute(1, 6)
+	assert.Equal(t, [2]int32{1, 7}, attr.owners)
+	assert.Equal(t, [2]bool{true, false}, attr.counted)
+	assert.Equal(t, []int32{7}, attr.excused)
+
+	attr = subs.attribute(1, 2)
+	assert.Equal(t, [2]int32{1, 2}, attr.owners)
+	assert.Equal(t, [2]bool{true, true}, attr.counted)
+}
+
 func TestStandingsCalculation_WithTies(t *testing.T) {
 	ctx := context.Background()
 	store := newMockLeagueStore()
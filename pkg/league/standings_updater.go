@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/rs/zerolog/log"
+
 	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/pkg/stores/league"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
@@ -127,5 +129,12 @@ func UpdateGameStandingsWithGame(ctx context.Context, store league.Store, g *ent
 	// Mark standings as processed to prevent double-counting
 	g.LeagueStandingsProcessed = true
 
+	// The league rating is secondary to the standings, so a failure to rate
+	// the game does not fail the update. It can be recalculated with
+	// cmd/backfill-league-ratings.
+	if err := rateLeagueGameEntity(ctx, store, g, p0Stats.Score, p1Stats.Score); err != nil {
+		log.Err(err).Str("gameID", g.GameID()).Msg("failed-to-update-league-ratings")
+	}
+
 	return nil
 }
@@ -0,0 +1,161 @@
+package lexica
+
+import (
+	"encoding/binary"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/domino14/word-golib/tilemapping"
+)
+
+// KWG node layout, see https://github.com/andy-k/wolges/blob/main/details.txt
+const (
+	kwgArcIndexMask = 0x3fffff
+	kwgIsEnd        = 0x400000
+	kwgAccepts      = 0x800000
+	kwgTileShift    = 24
+)
+
+// gaddagSeparator is the tile that separates the reversed prefix of a word
+// from the rest of it in the GADDAG.
+const gaddagSeparator = 0
+
+var errKWGTooBig = errors.New("word list is too big to build a word graph from")
+
+// trieNode is a node of an uncompressed trie. The node's own tile is kept by
+// its parent.
+type trieNode struct {
+	tiles    []tilemapping.MachineLetter
+	children []*trieNode
+	accepts  bool
+}
+
+func (n *trieNode) child(ml tilemapping.MachineLetter) *trieNode {
+	i, found := slices.BinarySearch(n.tiles, ml)
+	if found {
+		return n.children[i]
+	}
+	c := &trieNode{}
+	n.tiles = slices.Insert(n.tiles, i, ml)
+	n.children = slices.Insert(n.children, i, c)
+	return c
+}
+
+func (n *trieNode) insert(path []tilemapping.MachineLetter) {
+	for _, ml := range path {
+		n = n.child(ml)
+	}
+	n.accepts = true
+}
+
+// kwgArc is one arc of a deduplicated arc list. Its target is the index of
+// the arc list of the node it leads to, or -1 if the node has no arcs.
+type kwgArc struct {
+	tile    tilemapping.MachineLetter
+	accepts bool
+	target  int
+}
+
+// kwgBuilder merges identical arc lists, which makes the trie a DAWG.
+type kwgBuilder struct {
+	lists [][]kwgArc
+	ids   map[string]int
+}
+
+// add returns the index of n's arc list, or -1 if n has no arcs.
+func (b *kwgBuilder) add(n *trieNode) int {
+	if len(n.children) == 0 {
+		return -1
+	}
+	arcs := make([]kwgArc, len(n.children))
+	var key strings.Builder
+	for i, c := range n.children {
+		arcs[i] = kwgArc{tile: n.tiles[i], accepts: c.accepts, target: b.add(c)}
+		key.WriteString(strconv.Itoa(int(arcs[i].tile)))
+		if arcs[i].accepts {
+			key.WriteByte('!')
+		}
+		key.WriteByte(':')
+		key.WriteString(strconv.Itoa(arcs[i].target))
+		key.WriteByte(',')
+	}
+	if id, ok := b.ids[key.String()]; ok {
+		return id
+	}
+	b.ids[key.String()] = len(b.lists)
+	b.lists = append(b.lists, arcs)
+	return len(b.lists) - 1
+}
+
+// BuildKWG builds a KWG with both a DAWG and a GADDAG of the given words,
+// which must not contain blanks. Words shorter than 2 tiles are ignored, as
+// word-golib never accepts them.
+func BuildKWG(words []tilemapping.MachineWord) ([]byte, error) {
+	dawg := &trieNode{}
+	gaddag := &trieNode{}
+	path := make([]tilemapping.MachineLetter, 0, 32)
+	for _, w := range words {
+		if len(w) < 2 {
+			continue
+		}
+		dawg.insert(w)
+		// For every split point i, the GADDAG has the first i tiles
+		// reversed, then the separator and the rest of the word. The
+		// separator is left out when the whole word is reversed.
+		for i := 1; i <= len(w); i++ {
+			path = path[:0]
+			for j := i - 1; j >= 0; j-- {
+				path = append(path, w[j])
+			}
+			if i < len(w) {
+				path = append(path, gaddagSeparator)
+				path = append(path, w[i:]...)
+			}
+			gaddag.insert(path)
+		}
+	}
+
+	b := &kwgBuilder{ids: make(map[string]int)}
+	dawgRoot := b.add(dawg)
+	gaddagRoot := b.add(gaddag)
+
+	// Nodes 0 and 1 point to the DAWG and the GADDAG.
+	offsets := make([]int, len(b.lists))
+	numNodes := 2
+	for i, l := range b.lists {
+		offsets[i] = numNodes
+		numNodes += len(l)
+	}
+	if numNodes > kwgArcIndexMask {
+		return nil, errKWGTooBig
+	}
+	arcIndex := func(list int) uint32 {
+		if list < 0 {
+			return 0
+		}
+		return uint32(offsets[list])
+	}
+
+	nodes := make([]uint32, 0, numNodes)
+	nodes = append(nodes, kwgIsEnd|arcIndex(dawgRoot), kwgIsEnd|arcIndex(gaddagRoot))
+	for _, l := range b.lists {
+		for i, arc := range l {
+			node := uint32(arc.tile)<<kwgTileShift | arcIndex(arc.target)
+			if arc.accepts {
+				node |= kwgAccepts
+			}
+			if i == len(l)-1 {
+				node |= kwgIsEnd
+			}
+			nodes = append(nodes, node)
+		}
+	}
+
+	bts := make([]byte, 4*len(nodes))
+	for i, node := range nodes {
+		binary.LittleEndian.PutUint32(bts[4*i:], node)
+	}
+	return bts, nil
+}
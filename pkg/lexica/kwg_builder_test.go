@@ -0,0 +1,100 @@
+package lexica
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/matryer/is"
+)
+
+// mw makes a machine word out of an upper case word, with A as tile 1.
+func mw(word string) tilemapping.MachineWord {
+	w := make(tilemapping.MachineWord, len(word))
+	for i, c := range word {
+		w[i] = tilemapping.MachineLetter(c - 'A' + 1)
+	}
+	return w
+}
+
+// paths returns every accepted path from the arc list at idx.
+func paths(k *kwg.KWG, idx uint32, prefix []byte, out *[]string) {
+	if idx == 0 {
+		return
+	}
+	for i := idx; ; i++ {
+		p := append(slices.Clone(prefix), k.Tile(i))
+		if k.Accepts(i) {
+			*out = append(*out, string(p))
+		}
+		paths(k, k.ArcIndex(i), p, out)
+		if k.IsEnd(i) {
+			return
+		}
+	}
+}
+
+func buildTestKWG(is *is.I, words ...string) *kwg.KWG {
+	mws := make([]tilemapping.MachineWord, len(words))
+	for i, w := range words {
+		mws[i] = mw(w)
+	}
+	bts, err := BuildKWG(mws)
+	is.NoErr(err)
+	k, err := kwg.ScanKWG(bytes.NewReader(bts), len(bts))
+	is.NoErr(err)
+	return k
+}
+
+func TestBuildKWGDawg(t *testing.T) {
+	is := is.New(t)
+	words := []string{"AB", "ABS", "CAB", "CABS", "DABS", "QI"}
+	k := buildTestKWG(is, append(words, "A")...)
+	lex := kwg.Lexicon{KWG: *k}
+
+	for _, w := range words {
+		is.True(lex.HasWord(mw(w)))
+	}
+	for _, w := range []string{"A", "ABSS", "CA", "DAB", "IQ", "ZZ"} {
+		is.True(!lex.HasWord(mw(w)))
+	}
+}
+
+func TestBuildKWGGaddag(t *testing.T) {
+	is := is.New(t)
+	k := buildTestKWG(is, "CAB", "AB")
+
+	var got []string
+	paths(k, k.GetRootNodeIndex(), nil, &got)
+	slices.Sort(got)
+
+	var expected []string
+	for _, w := range []string{"CAB", "AB"} {
+		// Every split of the word, as it is laid out in the GADDAG.
+		for i := 1; i <= len(w); i++ {
+			var p []byte
+			for j := i - 1; j >= 0; j-- {
+				p = append(p, byte(w[j]-'A'+1))
+			}
+			if i < len(w) {
+				p = append(p, gaddagSeparator)
+				for j := i; j < len(w); j++ {
+					p = append(p, byte(w[j]-'A'+1))
+				}
+			}
+			expected = append(expected, string(p))
+		}
+	}
+	slices.Sort(expected)
+	is.Equal(got, expected)
+}
+
+func TestBuildKWGSharesSuffixes(t *testing.T) {
+	is := is.New(t)
+	// The S after every one of these words is the same arc list.
+	k := buildTestKWG(is, "BATS", "CATS", "HATS", "MATS", "RATS")
+	withoutSharing := buildTestKWG(is, "BATS")
+	is.True(len(k.Nodes()) < 5*len(withoutSharing.Nodes()))
+}
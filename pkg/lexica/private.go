@@ -0,0 +1,266 @@
+// Package lexica builds and loads the private lexica that directors upload
+// for their tournaments and clubs.
+package lexica
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/domino14/word-golib/cache"
+	wglconfig "github.com/domino14/word-golib/config"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+)
+
+const (
+	// MaxPrivateLexiconWords limits the size of an uploaded word list, as
+	// the GADDAG is built in memory.
+	MaxPrivateLexiconWords = 100000
+	// maxWordLength is the width of the biggest board.
+	maxWordLength = 21
+)
+
+var privateNameRegex = regexp.MustCompile(`^[A-Z0-9]{1,16}$`)
+
+var (
+	errInvalidName   = errors.New("lexicon names may only have up to 16 letters and digits")
+	errNameTaken     = errors.New("a lexicon with this name already exists")
+	errEmptyWordList = errors.New("the word list has no words")
+	errTooManyWords  = fmt.Errorf("the word list may have at most %d words", MaxPrivateLexiconWords)
+)
+
+// PrivateLexiconName returns the name that a lexicon uploaded as name is
+// known by.
+func PrivateLexiconName(name string) (string, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if !privateNameRegex.MatchString(name) {
+		return "", errInvalidName
+	}
+	return entity.PrivateLexiconPrefix + name, nil
+}
+
+// ParseWordList reads a word list with one word per line. Anything after the
+// word on a line, such as a definition, is ignored, as are empty lines and
+// lines starting with #. The words are returned sorted and without
+// duplicates.
+func ParseWordList(text string, tm *tilemapping.TileMapping) ([]tilemapping.MachineWord, error) {
+	seen := make(map[string]bool)
+	var words []string
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		word := strings.ToUpper(fields[0])
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+		words = append(words, word)
+		if len(words) > MaxPrivateLexiconWords {
+			return nil, errTooManyWords
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, errEmptyWordList
+	}
+	slices.Sort(words)
+
+	mws := make([]tilemapping.MachineWord, 0, len(words))
+	for _, word := range words {
+		mw, err := tilemapping.ToMachineWord(word, tm)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", word, err)
+		}
+		if len(mw) < 2 || len(mw) > maxWordLength {
+			return nil, fmt.Errorf("%s: words must be between 2 and %d tiles long", word, maxWordLength)
+		}
+		for _, ml := range mw {
+			if ml == 0 || ml.IsBlanked() {
+				return nil, fmt.Errorf("%s: words may not have blanks", word)
+			}
+		}
+		mws = append(mws, mw)
+	}
+	return mws, nil
+}
+
+// kwgFilename is where word-golib looks for the lexicon's word graph. Private
+// word graphs are never written there; they are put in word-golib's file
+// cache under this name instead.
+func kwgFilename(cfg *wglconfig.Config, lexicon string) string {
+	return filepath.Join(cfg.DataPath, "lexica", "gaddag", cfg.KWGPathPrefix, lexicon+".kwg")
+}
+
+// cachePrivateLexicon makes the lexicon playable in this process.
+func cachePrivateLexicon(cfg *wglconfig.Config, lex entity.PrivateLexicon, kwgBytes []byte) {
+	cache.Precache(kwgFilename(cfg, lex.Name), kwgBytes)
+	entity.RegisterPrivateLexicon(lex)
+}
+
+const (
+	// loadTimeout bounds the query that loads a private lexicon on first use.
+	loadTimeout = 10 * time.Second
+	// missTTL is how long a lexicon that wasn't found is not looked up again.
+	// A lexicon uploaded through another process may be refused here for
+	// that long.
+	missTTL = 30 * time.Second
+)
+
+// UsePrivateLexica makes this process load private lexica from the database
+// the first time a game asks for one. It must be called on startup, before
+// any game in a private lexicon is loaded.
+func UsePrivateLexica(queries *models.Queries, cfg *wglconfig.Config) {
+	l := newLexiconLoader(func(name string) (*entity.PrivateLexicon, error) {
+		return loadPrivateLexicon(queries, cfg, name)
+	})
+	entity.SetPrivateLexiconLoader(l.get)
+}
+
+// lexiconLoader loads each private lexicon once, however many games ask for
+// it at the same time, and remembers for a while the names that don't exist.
+type lexiconLoader struct {
+	load  func(name string) (*entity.PrivateLexicon, error)
+	group singleflight.Group
+	now   func() time.Time
+
+	mu     sync.Mutex
+	misses map[string]time.Time // name -> when to look it up again
+}
+
+func newLexiconLoader(load func(name string) (*entity.PrivateLexicon, error)) *lexiconLoader {
+	return &lexiconLoader{
+		load:   load,
+		now:    time.Now,
+		misses: make(map[string]time.Time),
+	}
+}
+
+func (l *lexiconLoader) get(name string) (entity.PrivateLexicon, bool) {
+	l.mu.Lock()
+	retry, missed := l.misses[name]
+	l.mu.Unlock()
+	if missed && l.now().Before(retry) {
+		return entity.PrivateLexicon{}, false
+	}
+
+	lex, _, _ := l.group.Do(name, func() (any, error) {
+		lex, err := l.load(name)
+		if err != nil {
+			// Not remembered, as the database may be back for the next game.
+			log.Err(err).Str("lexicon", name).Msg("load-private-lexicon")
+			return nil, nil
+		}
+		if lex == nil {
+			l.addMiss(name)
+			return nil, nil
+		}
+		return lex, nil
+	})
+	if lex == nil {
+		return entity.PrivateLexicon{}, false
+	}
+	return *lex.(*entity.PrivateLexicon), true
+}
+
+func (l *lexiconLoader) addMiss(name string) {
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for n, retry := range l.misses {
+		if !now.Before(retry) {
+			delete(l.misses, n)
+		}
+	}
+	l.misses[name] = now.Add(missTTL)
+}
+
+// loadPrivateLexicon loads and caches the lexicon with this name. It returns
+// nil if there is no such lexicon.
+func loadPrivateLexicon(queries *models.Queries, cfg *wglconfig.Config, name string) (*entity.PrivateLexicon, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+	defer cancel()
+	row, err := queries.GetPrivateLexicon(ctx, name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	lex := entity.PrivateLexicon{
+		Name:               row.Name,
+		LetterDistribution: row.LetterDistribution,
+		TournamentID:       row.TournamentUuid,
+	}
+	cachePrivateLexicon(cfg, lex, row.Kwg)
+	log.Info().Str("lexicon", lex.Name).Msg("loaded-private-lexicon")
+	return &lex, nil
+}
+
+// UploadPrivateLexicon builds a word graph from the word list and saves it as
+// a lexicon that may only be played in the given tournament or club. It
+// returns the lexicon and its number of words.
+func UploadPrivateLexicon(ctx context.Context, queries *models.Queries, cfg *wglconfig.Config,
+	tournamentID string, uploaderID uint, name, distName, wordList string) (entity.PrivateLexicon, int, error) {
+
+	lexName, err := PrivateLexiconName(name)
+	if err != nil {
+		return entity.PrivateLexicon{}, 0, err
+	}
+	if _, exists := entity.GetPrivateLexicon(lexName); exists {
+		return entity.PrivateLexicon{}, 0, errNameTaken
+	}
+	if strings.HasSuffix(distName, "_super") {
+		return entity.PrivateLexicon{}, 0, errors.New("use the regular letter distribution; the super variant picks its own")
+	}
+	dist, err := tilemapping.GetDistribution(cfg, distName)
+	if err != nil {
+		return entity.PrivateLexicon{}, 0, fmt.Errorf("unknown letter distribution %s", distName)
+	}
+	words, err := ParseWordList(wordList, dist.TileMapping())
+	if err != nil {
+		return entity.PrivateLexicon{}, 0, err
+	}
+	kwgBytes, err := BuildKWG(words)
+	if err != nil {
+		return entity.PrivateLexicon{}, 0, err
+	}
+
+	lex := entity.PrivateLexicon{
+		Name:               lexName,
+		LetterDistribution: strings.ToLower(distName),
+		TournamentID:       tournamentID,
+	}
+	err = queries.CreatePrivateLexicon(ctx, models.CreatePrivateLexiconParams{
+		Name:               lex.Name,
+		TournamentUuid:     lex.TournamentID,
+		UploaderID:         pgtype.Int4{Int32: int32(uploaderID), Valid: true},
+		LetterDistribution: lex.LetterDistribution,
+		WordCount:          int32(len(words)),
+		Kwg:                kwgBytes,
+	})
+	if err != nil {
+		return entity.PrivateLexicon{}, 0, err
+	}
+	cachePrivateLexicon(cfg, lex, kwgBytes)
+	log.Info().Str("lexicon", lex.Name).Str("tournament", tournamentID).
+		Int("words", len(words)).Int("kwg-bytes", len(kwgBytes)).Msg("uploaded-private-lexicon")
+	return lex, len(words), nil
+}
@@ -0,0 +1,178 @@
+package lexica
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/entity"
+)
+
+var DefaultConfig = config.DefaultConfig()
+
+func TestPrivateLexiconName(t *testing.T) {
+	is := is.New(t)
+	name, err := PrivateLexiconName(" clubwords2 ")
+	is.NoErr(err)
+	is.Equal(name, "PRIV_CLUBWORDS2")
+
+	for _, bad := range []string{"", "../etc", "CLUB WORDS", "ABCDEFGHIJKLMNOPQ"} {
+		_, err = PrivateLexiconName(bad)
+		is.Equal(err, errInvalidName)
+	}
+}
+
+func TestParseWordList(t *testing.T) {
+	is := is.New(t)
+	dist, err := tilemapping.GetDistribution(DefaultConfig.WGLConfig(), "english")
+	is.NoErr(err)
+	tm := dist.TileMapping()
+
+	words, err := ParseWordList("# our club's words\nzax\tan axe\n\nQI\nZAX\nAA some definition\n", tm)
+	is.NoErr(err)
+	var got []string
+	for _, w := range words {
+		got = append(got, w.UserVisible(tm))
+	}
+	is.Equal(got, []string{"AA", "QI", "ZAX"})
+
+	_, err = ParseWordList("# nothing\n\n", tm)
+	is.Equal(err, errEmptyWordList)
+	_, err = ParseWordList("QI\nQ\n", tm)
+	is.True(err != nil)
+	_, err = ParseWordList("QI\nC?T\n", tm)
+	is.True(err != nil)
+	_, err = ParseWordList("QI\nÉTÉ\n", tm)
+	is.True(err != nil)
+}
+
+func TestCachePrivateLexicon(t *testing.T) {
+	is := is.New(t)
+	cfg := DefaultConfig.WGLConfig()
+	dist, err := tilemapping.GetDistribution(cfg, "english")
+	is.NoErr(err)
+	words, err := ParseWordList("ZAX\nQI\nXU\n", dist.TileMapping())
+	is.NoErr(err)
+	bts, err := BuildKWG(words)
+	is.NoErr(err)
+
+	cachePrivateLexicon(cfg, entity.PrivateLexicon{
+		Name:               "PRIV_TESTCACHE",
+		LetterDistribution: "english",
+		TournamentID:       "club-uuid",
+	}, bts)
+
+	ld, err := entity.LetterDistributionForLexicon("PRIV_TESTCACHE")
+	is.NoErr(err)
+	is.Equal(ld, "english")
+
+	gd, err := entity.GetLexiconKWG(cfg, "PRIV_TESTCACHE")
+	is.NoErr(err)
+	zax, err := tilemapping.ToMachineWord("ZAX", gd.GetAlphabet())
+	is.NoErr(err)
+	za, err := tilemapping.ToMachineWord("ZA", gd.GetAlphabet())
+	is.NoErr(err)
+	lex := kwg.Lexicon{KWG: *gd}
+	is.True(lex.HasWord(zax))
+	is.True(!lex.HasWord(za))
+
+	is.NoErr(entity.ValidateLexiconScope("PRIV_TESTCACHE", "session-uuid", "club-uuid"))
+	is.True(entity.ValidateLexiconScope("PRIV_TESTCACHE", "other-uuid") != nil)
+	is.True(entity.ValidateLexiconScope("PRIV_TESTCACHE") != nil)
+	is.True(entity.ValidateLexiconScope("PRIV_UNKNOWN", "club-uuid") != nil)
+	is.NoErr(entity.ValidateLexiconScope("NWL23"))
+}
+
+func TestLoadPrivateLexiconOnFirstUse(t *testing.T) {
+	is := is.New(t)
+	cfg := DefaultConfig.WGLConfig()
+	dist, err := tilemapping.GetDistribution(cfg, "english")
+	is.NoErr(err)
+	words, err := ParseWordList("JO\nQAT\n", dist.TileMapping())
+	is.NoErr(err)
+	bts, err := BuildKWG(words)
+	is.NoErr(err)
+
+	// Stands in for the database, as if another process uploaded it.
+	loads := 0
+	entity.SetPrivateLexiconLoader(func(name string) (entity.PrivateLexicon, bool) {
+		loads++
+		if name != "PRIV_TESTLOAD" {
+			return entity.PrivateLexicon{}, false
+		}
+		lex := entity.PrivateLexicon{
+			Name:               name,
+			LetterDistribution: "english",
+			TournamentID:       "club-uuid",
+		}
+		cachePrivateLexicon(cfg, lex, bts)
+		return lex, true
+	})
+	t.Cleanup(func() { entity.SetPrivateLexiconLoader(nil) })
+
+	is.NoErr(entity.ValidateLexiconScope("PRIV_TESTLOAD", "club-uuid"))
+	gd, err := entity.GetLexiconKWG(cfg, "PRIV_TESTLOAD")
+	is.NoErr(err)
+	qat, err := tilemapping.ToMachineWord("QAT", gd.GetAlphabet())
+	is.NoErr(err)
+	lex := kwg.Lexicon{KWG: *gd}
+	is.True(lex.HasWord(qat))
+	// It is registered once loaded.
+	is.Equal(loads, 1)
+
+	is.True(entity.ValidateLexiconScope("PRIV_MISSING", "club-uuid") != nil)
+	is.Equal(loads, 2)
+	// Public lexica are never looked up.
+	_, ok := entity.GetPrivateLexicon("NWL23")
+	is.True(!ok)
+	is.Equal(loads, 2)
+}
+
+func TestLexiconLoaderRemembersMisses(t *testing.T) {
+	is := is.New(t)
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	loads := 0
+	var loadErr error
+	l := newLexiconLoader(func(name string) (*entity.PrivateLexicon, error) {
+		loads++
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		if name != "PRIV_FOUND" {
+			return nil, nil
+		}
+		return &entity.PrivateLexicon{Name: name, LetterDistribution: "english"}, nil
+	})
+	l.now = func() time.Time { return now }
+
+	lex, ok := l.get("PRIV_FOUND")
+	is.True(ok)
+	is.Equal(lex.Name, "PRIV_FOUND")
+	is.Equal(loads, 1)
+
+	// A lexicon that doesn't exist isn't looked up again for a while.
+	_, ok = l.get("PRIV_MISSING")
+	is.True(!ok)
+	_, ok = l.get("PRIV_MISSING")
+	is.True(!ok)
+	is.Equal(loads, 2)
+
+	now = now.Add(missTTL)
+	_, ok = l.get("PRIV_MISSING")
+	is.True(!ok)
+	is.Equal(loads, 3)
+
+	// Failed lookups are retried straight away.
+	loadErr = errors.New("connection refused")
+	_, ok = l.get("PRIV_DOWN")
+	is.True(!ok)
+	loadErr = nil
+	_, ok = l.get("PRIV_DOWN")
+	is.True(!ok)
+	is.Equal(loads, 5)
+}
@@ -0,0 +1,595 @@
+package memento
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/domino14/macondo/move"
+	"github.com/domino14/word-golib/tilemapping"
+
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// RenderOptions control how RenderDocument draws a GameDocument.
+type RenderOptions struct {
+	// FileType is "png", "gif", "webp", "animated-gif", "animated-webp" or
+	// "frames". Frames are a zip of PNGs, one per turn, with an ffconcat
+	// file so that ffmpeg can make an MP4 out of them.
+	FileType string
+	// The board is drawn after Turn events, or after all of them if HasTurn
+	// is false. Animations end there.
+	HasTurn bool
+	Turn    int
+	// HighlightLastPlay outlines the tiles of the last play on the board.
+	HighlightLastPlay bool
+	// CoordinateLabels draws the column letters and row numbers.
+	CoordinateLabels bool
+	// ShowRack draws the rack of the player on turn below the board.
+	ShowRack bool
+	// Theme is one of Themes. Defaults to "default".
+	Theme string
+	// Arrows are drawn over the board, e.g. where a puzzle's answer goes.
+	Arrows []Arrow
+	// Caption is drawn below the board. When it is empty and ShowNote is
+	// set, the annotator's note on the last event drawn is used instead.
+	Caption  string
+	ShowNote bool
+	// FrameDelay is how long each turn of an animation is shown for.
+	// Defaults to a second.
+	FrameDelay time.Duration
+}
+
+// An Arrow points right or down from a square, like the arrow used to
+// place tiles on the board.
+type Arrow struct {
+	Row      int
+	Column   int
+	Vertical bool
+}
+
+// ParseArrow parses a position such as "8D" (across) or "D8" (down).
+func ParseArrow(position string) (Arrow, error) {
+	row, col, vertical := move.FromBoardGameCoords(position, false)
+	if move.ToBoardGameCoords(row, col, vertical) != strings.ToUpper(position) {
+		return Arrow{}, fmt.Errorf("invalid position: %s", position)
+	}
+	return Arrow{Row: row, Column: col, Vertical: vertical}, nil
+}
+
+// A Theme recolors the images. All images share the palette of their
+// tiles, so a theme only changes the palette.
+type Theme struct {
+	// Recolor maps each color of the palette. nil keeps the palette.
+	Recolor   func(color.RGBA64) color.RGBA64
+	Highlight color.RGBA64
+	Arrow     color.RGBA64
+}
+
+var Themes = map[string]*Theme{
+	"default": {
+		Highlight: color.RGBA64{0xe5e5, 0x3939, 0x3535, 0xffff},
+		Arrow:     color.RGBA64{0x1e1e, 0x8888, 0xe5e5, 0xffff},
+	},
+	"grayscale": {
+		Recolor: func(c color.RGBA64) color.RGBA64 {
+			y := uint16((19595*uint32(c.R) + 38470*uint32(c.G) + 7471*uint32(c.B) + 1<<15) >> 16)
+			return color.RGBA64{y, y, y, c.A}
+		},
+		Highlight: color.RGBA64{0, 0, 0, 0xffff},
+		Arrow:     color.RGBA64{0x4040, 0x4040, 0x4040, 0xffff},
+	},
+}
+
+const (
+	defaultFrameDelay = time.Second
+	// The last frame of an animation stays for 2 more seconds.
+	finalFrameExtraDelay = 200
+	highlightWidth       = 4
+	maxCaptionLines      = 6
+)
+
+// docPosition is the state of a GameDocument after some of its events.
+type docPosition struct {
+	tiles [][]tilemapping.MachineLetter
+	// The player index of each tile, or -1.
+	owners [][]int
+	scores []int32
+	// The squares of the last play, if it is still on the board.
+	lastPlay []image.Point
+	onTurn   int
+	rack     []tilemapping.MachineLetter
+}
+
+func replayDocument(doc *ipc.GameDocument, nRows, nCols, turn int) *docPosition {
+	pos := &docPosition{
+		tiles:  make([][]tilemapping.MachineLetter, nRows),
+		owners: make([][]int, nRows),
+		scores: make([]int32, len(doc.Players)),
+	}
+	for r := range pos.tiles {
+		pos.tiles[r] = make([]tilemapping.MachineLetter, nCols)
+		pos.owners[r] = make([]int, nCols)
+		for c := range pos.owners[r] {
+			pos.owners[r][c] = -1
+		}
+	}
+	// The squares an event's tiles go to, and the tiles. Played-through
+	// tiles are skipped.
+	squares := func(evt *ipc.GameEvent) ([]image.Point, []tilemapping.MachineLetter) {
+		var pts []image.Point
+		var mls []tilemapping.MachineLetter
+		r, c := int(evt.Row), int(evt.Column)
+		for _, ml := range evt.PlayedTiles {
+			if ml != 0 && r >= 0 && r < nRows && c >= 0 && c < nCols {
+				pts = append(pts, image.Pt(c, r))
+				mls = append(mls, tilemapping.MachineLetter(ml))
+			}
+			if evt.Direction == ipc.GameEvent_VERTICAL {
+				r++
+			} else {
+				c++
+			}
+		}
+		return pts, mls
+	}
+
+	var lastPlace *ipc.GameEvent
+	for _, evt := range doc.Events[:turn] {
+		switch evt.Type {
+		case ipc.GameEvent_TILE_PLACEMENT_MOVE:
+			lastPlace = evt
+			var mls []tilemapping.MachineLetter
+			pos.lastPlay, mls = squares(evt)
+			for i, pt := range pos.lastPlay {
+				pos.tiles[pt.Y][pt.X] = mls[i]
+				pos.owners[pt.Y][pt.X] = int(evt.PlayerIndex)
+			}
+		case ipc.GameEvent_PHONY_TILES_RETURNED:
+			if lastPlace != nil {
+				pts, _ := squares(lastPlace)
+				for _, pt := range pts {
+					pos.tiles[pt.Y][pt.X] = 0
+					pos.owners[pt.Y][pt.X] = -1
+				}
+			}
+			lastPlace = nil
+			pos.lastPlay = nil
+		}
+		if int(evt.PlayerIndex) < len(pos.scores) {
+			pos.scores[evt.PlayerIndex] = evt.Cumulative
+		}
+	}
+
+	var rack []byte
+	if turn < len(doc.Events) {
+		pos.onTurn = int(doc.Events[turn].PlayerIndex)
+		rack = doc.Events[turn].Rack
+	} else {
+		pos.onTurn = int(doc.PlayerOnTurn)
+		if pos.onTurn < len(doc.Racks) {
+			rack = doc.Racks[pos.onTurn]
+		}
+	}
+	pos.rack = make([]tilemapping.MachineLetter, len(rack))
+	for i, ml := range rack {
+		pos.rack[i] = tilemapping.MachineLetter(ml)
+	}
+	sort.Slice(pos.rack, func(i, j int) bool {
+		// blanks at end
+		return (pos.rack[i] - 1) < (pos.rack[j] - 1)
+	})
+	return pos
+}
+
+// wrapCaption wraps the text into at most maxCaptionLines lines of width
+// characters.
+func wrapCaption(text string, width int) []string {
+	var lines []string
+	for _, para := range strings.Split(text, "\n") {
+		var line []rune
+		for _, field := range strings.Fields(para) {
+			word := []rune(field)
+			for len(word) > width {
+				if len(line) > 0 {
+					lines = append(lines, string(line))
+					line = nil
+				}
+				lines = append(lines, string(word[:width]))
+				word = word[width:]
+			}
+			if len(line) == 0 {
+				line = word
+			} else if len(line)+1+len(word) <= width {
+				line = append(append(line, ' '), word...)
+			} else {
+				lines = append(lines, string(line))
+				line = word
+			}
+		}
+		lines = append(lines, string(line))
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) > maxCaptionLines {
+		lines = lines[:maxCaptionLines]
+		last := []rune(lines[maxCaptionLines-1])
+		lines[maxCaptionLines-1] = string(last[:min(len(last), width-3)]) + "..."
+	}
+	return lines
+}
+
+// documentRenderer draws positions of one GameDocument. All its frames
+// have the same size, so the caption takes the same room in every frame.
+type documentRenderer struct {
+	bd        *BoardDrawer
+	doc       *ipc.GameDocument
+	opts      RenderOptions
+	palette   []color.Color
+	highlight byte
+	arrow     byte
+	// The player index whose tiles use the first color.
+	firstPlayer  int
+	bounds       image.Rectangle
+	boardOrigin  image.Point
+	headerTop    int
+	textTop      int
+	rackY        int
+	captionY     int
+	captionWidth int
+	captionLines int
+}
+
+func newDocumentRenderer(doc *ipc.GameDocument, opts RenderOptions) (*documentRenderer, error) {
+	lang := strings.ToLower(doc.LetterDistribution)
+	bd, ok := BoardDrawers[lang]
+	if !ok {
+		return nil, fmt.Errorf("missing boardDrawer: %s", lang)
+	}
+	themeName := opts.Theme
+	if themeName == "" {
+		themeName = "default"
+	}
+	theme, ok := Themes[themeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme: %s", opts.Theme)
+	}
+	if len(bd.Colors)+2 > 256 {
+		return nil, fmt.Errorf("no room in palette for %s", lang)
+	}
+	dr := &documentRenderer{bd: bd, doc: doc, opts: opts}
+	if len(doc.Events) > 0 {
+		dr.firstPlayer = int(doc.Events[0].PlayerIndex)
+	}
+
+	dr.palette = make([]color.Color, 0, len(bd.Colors)+2)
+	for _, c := range bd.Colors {
+		if theme.Recolor != nil {
+			if rgba, ok := c.(color.RGBA64); ok {
+				c = theme.Recolor(rgba)
+			}
+		}
+		dr.palette = append(dr.palette, c)
+	}
+	dr.highlight = byte(len(dr.palette))
+	dr.arrow = dr.highlight + 1
+	dr.palette = append(dr.palette, theme.Highlight, theme.Arrow)
+
+	labelWidth, labelHeight := 0, 0
+	if opts.CoordinateLabels {
+		labelWidth = 2 * monospacedFontDimX
+		labelHeight = monospacedFontDimY
+	}
+	headerHeight := max(bd.HeaderHeight, monospacedFontDimY)
+	dr.headerTop = bd.PadTop + (headerHeight-bd.HeaderHeight)/2
+	dr.textTop = bd.PadTop + (headerHeight-monospacedFontDimY)/2
+	dr.boardOrigin = image.Pt(bd.PadLeft+labelWidth, bd.PadTop+headerHeight+bd.PadHeader+labelHeight+1)
+	width := dr.boardOrigin.X + bd.EmptyBoardPalImg.Bounds().Dx() + bd.PadRight
+	y := dr.boardOrigin.Y - 1 + bd.EmptyBoardPalImg.Bounds().Dy()
+	if opts.ShowRack {
+		dr.rackY = y + bd.PadRack
+		y = dr.rackY + squareDim
+	}
+	dr.captionWidth = (width - bd.PadLeft - bd.PadRight) / monospacedFontDimX
+	firstTurn := dr.lastTurn()
+	if dr.isAnimated() {
+		// The notes change during an animation, but the image size cannot.
+		firstTurn = 0
+	}
+	for turn := firstTurn; turn <= dr.lastTurn(); turn++ {
+		dr.captionLines = max(dr.captionLines, len(dr.caption(turn)))
+	}
+	if dr.captionLines > 0 {
+		dr.captionY = y + bd.PadRack
+		y = dr.captionY + dr.captionLines*monospacedFontDimY
+	}
+	dr.bounds = image.Rect(0, 0, width, y+bd.PadBottom)
+	return dr, nil
+}
+
+func (dr *documentRenderer) isAnimated() bool {
+	return strings.HasPrefix(dr.opts.FileType, "animated-") || dr.opts.FileType == "frames"
+}
+
+func (dr *documentRenderer) lastTurn() int {
+	if dr.opts.HasTurn {
+		return dr.opts.Turn
+	}
+	return len(dr.doc.Events)
+}
+
+func (dr *documentRenderer) caption(turn int) []string {
+	text := dr.opts.Caption
+	if text == "" && dr.opts.ShowNote && turn > 0 {
+		text = dr.doc.Events[turn-1].Note
+	}
+	if text == "" {
+		return nil
+	}
+	return wrapCaption(text, dr.captionWidth)
+}
+
+func (dr *documentRenderer) tileSprites(player int) map[byte]*image.Paletted {
+	if player != dr.firstPlayer {
+		return dr.bd.Tile1Sprite
+	}
+	return dr.bd.Tile0Sprite
+}
+
+func (dr *documentRenderer) textSprites(player int) map[rune]*image.Paletted {
+	if player != dr.firstPlayer {
+		return dr.bd.Text1Sprite
+	}
+	return dr.bd.Text0Sprite
+}
+
+func drawText(dst *image.Paletted, pt image.Point, sprites map[rune]*image.Paletted, text string) image.Point {
+	for _, ch := range text {
+		fastSpriteDrawSrc(dst, pt, getSprite(sprites, ch, ' '))
+		pt.X += monospacedFontDimX
+	}
+	return pt
+}
+
+func (dr *documentRenderer) squareAt(r, c int) image.Point {
+	return image.Pt(dr.boardOrigin.X+c*squareDim, dr.boardOrigin.Y+r*squareDim)
+}
+
+// drawOutline draws a border of highlightWidth just inside the square.
+func (dr *documentRenderer) drawOutline(dst *image.Paletted, pt image.Point, c byte) {
+	sq := image.Rect(pt.X, pt.Y, pt.X+squareDim, pt.Y+squareDim)
+	fillPalettedRect(dst, image.Rect(sq.Min.X, sq.Min.Y, sq.Max.X, sq.Min.Y+highlightWidth), c)
+	fillPalettedRect(dst, image.Rect(sq.Min.X, sq.Max.Y-highlightWidth, sq.Max.X, sq.Max.Y), c)
+	fillPalettedRect(dst, image.Rect(sq.Min.X, sq.Min.Y, sq.Min.X+highlightWidth, sq.Max.Y), c)
+	fillPalettedRect(dst, image.Rect(sq.Max.X-highlightWidth, sq.Min.Y, sq.Max.X, sq.Max.Y), c)
+}
+
+// drawArrow draws a triangle pointing right (or down) in the middle of the
+// square.
+func (dr *documentRenderer) drawArrow(dst *image.Paletted, a Arrow) {
+	if a.Row < 0 || a.Row >= len(dr.bd.BoardConfig) || a.Column < 0 || a.Column >= len(dr.bd.BoardConfig[0]) {
+		return
+	}
+	pt := dr.squareAt(a.Row, a.Column)
+	half := squareDim / 4
+	mid := squareDim / 2
+	for i := 0; i <= 2*half; i++ {
+		// The triangle is 2*half long, and narrows from 2*half+1 to 1 wide.
+		w := half - i/2
+		for j := -w; j <= w; j++ {
+			x, y := mid-half+i, mid+j
+			if a.Vertical {
+				x, y = y, x
+			}
+			dst.Pix[dst.PixOffset(pt.X+x, pt.Y+y)] = dr.arrow
+		}
+	}
+}
+
+// render draws the position after turn events.
+func (dr *documentRenderer) render(turn int) *image.Paletted {
+	bd := dr.bd
+	nRows, nCols := len(bd.BoardConfig), len(bd.BoardConfig[0])
+	pos := replayDocument(dr.doc, nRows, nCols, turn)
+
+	canvas := image.NewPaletted(dr.bounds, dr.palette)
+	canvas.Pix[0] = bd.PaddingColorIndex
+	fillPaletted(canvas)
+
+	headerRight := min(bd.PadLeft+bd.HeaderPalImg.Bounds().Dx(), dr.bounds.Dx()-bd.PadRight)
+	fastDrawOver(canvas, image.Rect(bd.PadLeft, dr.headerTop, headerRight, dr.headerTop+bd.HeaderHeight), bd.HeaderPalImg, image.Point{})
+
+	// Scores at top right, in the colors of each player's tiles.
+	var scoreText []string
+	for _, s := range pos.scores {
+		scoreText = append(scoreText, " "+strconv.Itoa(int(s))+" ")
+	}
+	scoreWidth := len(strings.Join(scoreText, " "))
+	pt := image.Pt(dr.bounds.Dx()-bd.PadRight-scoreWidth*monospacedFontDimX, dr.textTop)
+	for p, s := range scoreText {
+		if p > 0 {
+			pt = drawText(canvas, pt, bd.TextXSprite, " ")
+		}
+		pt = drawText(canvas, pt, dr.textSprites(p), s)
+	}
+
+	fastSpriteDrawSrc(canvas, image.Pt(dr.boardOrigin.X, dr.boardOrigin.Y-1), bd.EmptyBoardPalImg)
+	if dr.opts.CoordinateLabels {
+		for c := 0; c < nCols; c++ {
+			x := dr.boardOrigin.X + c*squareDim + (squareDim-monospacedFontDimX)/2
+			drawText(canvas, image.Pt(x, dr.boardOrigin.Y-1-monospacedFontDimY), bd.TextXSprite, string(rune('A'+c)))
+		}
+		for r := 0; r < nRows; r++ {
+			label := strconv.Itoa(r + 1)
+			x := dr.boardOrigin.X - len(label)*monospacedFontDimX
+			y := dr.boardOrigin.Y + r*squareDim + (squareDim-monospacedFontDimY)/2
+			drawText(canvas, image.Pt(x, y), bd.TextXSprite, label)
+		}
+	}
+	for r := range pos.tiles {
+		for c, ml := range pos.tiles[r] {
+			if ml != 0 {
+				fastSpriteDrawOver(canvas, dr.squareAt(r, c), getTileSprite(dr.tileSprites(pos.owners[r][c]), ml))
+			}
+		}
+	}
+	if dr.opts.HighlightLastPlay {
+		for _, sq := range pos.lastPlay {
+			dr.drawOutline(canvas, dr.squareAt(sq.Y, sq.X), dr.highlight)
+		}
+	}
+	for _, a := range dr.opts.Arrows {
+		dr.drawArrow(canvas, a)
+	}
+
+	if dr.opts.ShowRack {
+		sprites := dr.tileSprites(pos.onTurn)
+		pt := image.Pt((bd.PadLeft+dr.bounds.Dx()-bd.PadRight-len(pos.rack)*(squareDim+bd.RackGap)+bd.RackGap)/2, dr.rackY)
+		for _, ml := range pos.rack {
+			fastSpriteDrawOver(canvas, pt, getTileSprite(sprites, ml))
+			pt.X += squareDim + bd.RackGap
+		}
+	}
+	for i, line := range dr.caption(turn) {
+		drawText(canvas, image.Pt(bd.PadLeft, dr.captionY+i*monospacedFontDimY), bd.TextXSprite, line)
+	}
+	return canvas
+}
+
+// RenderDocumentFrames renders a GameDocument after each turn up to the
+// last one, along with how long each frame is shown for in centiseconds.
+// For still images, it renders only the last turn.
+func RenderDocumentFrames(doc *ipc.GameDocument, opts RenderOptions) ([]*image.Paletted, []int, error) {
+	if opts.HasTurn && (opts.Turn < 0 || opts.Turn > len(doc.Events)) {
+		return nil, nil, fmt.Errorf("game only has %d events", len(doc.Events))
+	}
+	dr, err := newDocumentRenderer(doc, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	last := dr.lastTurn()
+	if !dr.isAnimated() {
+		return []*image.Paletted{dr.render(last)}, []int{0}, nil
+	}
+	delay := opts.FrameDelay
+	if delay <= 0 {
+		delay = defaultFrameDelay
+	}
+	frames := make([]*image.Paletted, 0, last+1)
+	delays := make([]int, 0, last+1)
+	for turn := 0; turn <= last; turn++ {
+		frames = append(frames, dr.render(turn))
+		delays = append(delays, int(delay/(10*time.Millisecond)))
+	}
+	delays[len(delays)-1] += finalFrameExtraDelay
+	return frames, delays, nil
+}
+
+// diffFrames crops each frame to where it differs from the one before, and
+// makes the pixels that did not change transparent. Frames that do not
+// change anything are merged into the previous one.
+func diffFrames(frames []*image.Paletted, delays []int) ([]*image.Paletted, []int) {
+	outFrames := []*image.Paletted{frames[0]}
+	outDelays := []int{delays[0]}
+	for i := 1; i < len(frames); i++ {
+		prev, cur := frames[i-1], frames[i]
+		bounds := croppedBoundsDiff(cur, cur.Rect, prev, cur.Rect.Min)
+		if bounds.Empty() {
+			outDelays[len(outDelays)-1] += delays[i]
+			continue
+		}
+		// Animated WebP frames must start at even coordinates.
+		bounds.Min.X &^= 1
+		bounds.Min.Y &^= 1
+		diff := image.NewPaletted(bounds, cur.Palette)
+		fastDrawSrc(diff, bounds, cur, bounds.Min)
+		fastUndrawOver(diff, bounds, prev, bounds.Min)
+		outFrames = append(outFrames, diff)
+		outDelays = append(outDelays, delays[i])
+	}
+	return outFrames, outDelays
+}
+
+// RenderDocument renders a GameDocument, such as an annotated game, as an
+// image, an animation or a zip of frames.
+func RenderDocument(doc *ipc.GameDocument, opts RenderOptions) ([]byte, error) {
+	frames, delays, err := RenderDocumentFrames(doc, opts)
+	if err != nil {
+		return nil, err
+	}
+	canvas := frames[0].Rect
+	var buf bytes.Buffer
+	switch opts.FileType {
+	case "png":
+		err = png.Encode(&buf, frames[0])
+	case "gif":
+		err = gif.Encode(&buf, frames[0], nil)
+	case "webp":
+		err = encodeWebP(&buf, frames[0])
+	case "animated-gif":
+		frames, delays = diffFrames(frames, delays)
+		err = gif.EncodeAll(&buf, &gif.GIF{
+			Image: frames,
+			Delay: delays,
+			Config: image.Config{
+				ColorModel: frames[0].Palette,
+				Width:      canvas.Dx(),
+				Height:     canvas.Dy(),
+			},
+		})
+	case "animated-webp":
+		frames, delays = diffFrames(frames, delays)
+		err = encodeAnimatedWebP(&buf, frames, delays, canvas)
+	case "frames":
+		err = writeFramesZip(&buf, frames, delays)
+	default:
+		return nil, fmt.Errorf("unsupported file type: %s", opts.FileType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FramesConcatFile is the name of the ffconcat file in a zip of frames. It
+// can be turned into an MP4 with e.g.
+//
+//	ffmpeg -f concat -i frames.ffconcat -vf fps=30 -pix_fmt yuv420p game.mp4
+const FramesConcatFile = "frames.ffconcat"
+
+func writeFramesZip(buf *bytes.Buffer, frames []*image.Paletted, delays []int) error {
+	zw := zip.NewWriter(buf)
+	var concat strings.Builder
+	concat.WriteString("ffconcat version 1.0\n")
+	for i, frame := range frames {
+		name := fmt.Sprintf("frame%04d.png", i)
+		w, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		if err := png.Encode(w, frame); err != nil {
+			return err
+		}
+		fmt.Fprintf(&concat, "file %s\nduration %d.%02d\n", name, delays[i]/100, delays[i]%100)
+	}
+	// The concat demuxer ignores the duration of the last file unless it is
+	// repeated.
+	fmt.Fprintf(&concat, "file frame%04d.png\n", len(frames)-1)
+	w, err := zw.Create(FramesConcatFile)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte(concat.String())); err != nil {
+		return err
+	}
+	return zw.Close()
+}
@@ -0,0 +1,148 @@
+package memento
+
+import (
+	"archive/zip"
+	"bytes"
+	"image/png"
+	"os"
+	"testing"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/matryer/is"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+func loadDocument(is *is.I) *ipc.GameDocument {
+	content, err := os.ReadFile("../cwgame/testdata/document-gameover.json")
+	is.NoErr(err)
+	doc := &ipc.GameDocument{}
+	is.NoErr(protojson.Unmarshal(content, doc))
+	return doc
+}
+
+func TestReplayDocument(t *testing.T) {
+	is := is.New(t)
+	doc := loadDocument(is)
+
+	// Event 9 is 1N LADLED, which is challenged off in event 10.
+	pos := replayDocument(doc, 15, 15, 10)
+	is.Equal(pos.scores, []int32{204, 142})
+	is.Equal(len(pos.lastPlay), 6)
+	is.Equal(pos.tiles[0][13], tilemapping.MachineLetter(12)) // L
+	is.Equal(pos.owners[0][13], 1)
+	is.Equal(pos.onTurn, 1)
+
+	pos = replayDocument(doc, 15, 15, 11)
+	is.Equal(pos.scores, []int32{204, 90})
+	is.Equal(len(pos.lastPlay), 0)
+	is.Equal(pos.tiles[0][13], tilemapping.MachineLetter(0))
+	is.Equal(pos.owners[0][13], -1)
+	is.Equal(pos.onTurn, 0)
+
+	pos = replayDocument(doc, 15, 15, len(doc.Events))
+	is.Equal(pos.scores, []int32{446, 322})
+	// The rack is sorted.
+	is.Equal(pos.rack, []tilemapping.MachineLetter{3, 5, 16, 18, 20})
+}
+
+func TestRenderDocument(t *testing.T) {
+	is := is.New(t)
+	doc := loadDocument(is)
+	arrow, err := ParseArrow("H12")
+	is.NoErr(err)
+	is.Equal(arrow, Arrow{Row: 11, Column: 7, Vertical: true})
+	_, err = ParseArrow("12")
+	is.True(err != nil)
+
+	plain, _, err := RenderDocumentFrames(doc, RenderOptions{FileType: "png", HasTurn: true, Turn: 10})
+	is.NoErr(err)
+	is.Equal(len(plain), 1)
+
+	doc.Events[9].Note = "Challenge this!"
+	opts := RenderOptions{
+		FileType:          "png",
+		HasTurn:           true,
+		Turn:              10,
+		HighlightLastPlay: true,
+		CoordinateLabels:  true,
+		ShowRack:          true,
+		ShowNote:          true,
+		Arrows:            []Arrow{arrow},
+	}
+	frames, delays, err := RenderDocumentFrames(doc, opts)
+	is.NoErr(err)
+	is.Equal(delays, []int{0})
+	img := frames[0]
+	is.True(img.Rect.Dx() > plain[0].Rect.Dx())
+	is.True(img.Rect.Dy() > plain[0].Rect.Dy())
+
+	dr, err := newDocumentRenderer(doc, opts)
+	is.NoErr(err)
+	is.Equal(dr.captionLines, 1)
+	// LADLED at 1N is outlined, and the arrow is in the middle of H12.
+	pt := dr.squareAt(0, 13)
+	is.Equal(img.ColorIndexAt(pt.X, pt.Y), dr.highlight)
+	pt = dr.squareAt(11, 7)
+	is.Equal(img.ColorIndexAt(pt.X+squareDim/2, pt.Y+squareDim/2), dr.arrow)
+
+	_, _, err = RenderDocumentFrames(doc, RenderOptions{FileType: "png", HasTurn: true, Turn: 28})
+	is.True(err != nil)
+	_, _, err = RenderDocumentFrames(doc, RenderOptions{FileType: "png", Theme: "neon"})
+	is.True(err != nil)
+	_, err = RenderDocument(doc, RenderOptions{FileType: "mp4"})
+	is.True(err != nil)
+
+	frames, delays, err = RenderDocumentFrames(doc, RenderOptions{FileType: "animated-webp", ShowNote: true})
+	is.NoErr(err)
+	is.Equal(len(frames), len(doc.Events)+1)
+	is.Equal(delays[0], 100)
+	is.Equal(delays[len(delays)-1], 300)
+	// Every frame makes room for the note.
+	is.Equal(frames[0].Rect, frames[len(frames)-1].Rect)
+}
+
+func TestRenderDocumentFileTypes(t *testing.T) {
+	is := is.New(t)
+	doc := loadDocument(is)
+
+	b, err := RenderDocument(doc, RenderOptions{FileType: "png", Theme: "grayscale"})
+	is.NoErr(err)
+	_, err = png.Decode(bytes.NewReader(b))
+	is.NoErr(err)
+
+	b, err = RenderDocument(doc, RenderOptions{FileType: "webp"})
+	is.NoErr(err)
+	is.Equal(string(b[:4]), "RIFF")
+	is.Equal(string(b[8:16]), "WEBPVP8L")
+
+	b, err = RenderDocument(doc, RenderOptions{FileType: "animated-webp", HasTurn: true, Turn: 5})
+	is.NoErr(err)
+	is.Equal(string(b[8:16]), "WEBPVP8X")
+	is.Equal(bytes.Count(b, []byte("ANMF")), 6)
+
+	b, err = RenderDocument(doc, RenderOptions{FileType: "animated-gif", HasTurn: true, Turn: 5})
+	is.NoErr(err)
+	is.Equal(string(b[:6]), "GIF89a")
+
+	b, err = RenderDocument(doc, RenderOptions{FileType: "frames", HasTurn: true, Turn: 3})
+	is.NoErr(err)
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	is.NoErr(err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	is.Equal(names, []string{"frame0000.png", "frame0001.png", "frame0002.png", "frame0003.png", FramesConcatFile})
+}
+
+func TestWrapCaption(t *testing.T) {
+	is := is.New(t)
+	is.Equal(wrapCaption("8D WINDY  is best\n\nby 3 points", 10),
+		[]string{"8D WINDY", "is best", "", "by 3", "points"})
+	is.Equal(wrapCaption("ABCDEFGHIJKLMNOPQRSTUVWXYZ", 10), []string{"ABCDEFGHIJ", "KLMNOPQRST", "UVWXYZ"})
+	is.Equal(len(wrapCaption("a\nb\nc\nd\ne\nf\ng\nh", 10)), maxCaptionLines)
+	is.Equal(wrapCaption("a\nb\nc\nd\ne\nf\ng\nh", 10)[maxCaptionLines-1], "f...")
+	is.Equal(len(wrapCaption("  \n", 10)), 0)
+}
@@ -6,6 +6,7 @@ import (
 	_ "embed"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,6 +21,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/mod"
 	"github.com/woogles-io/liwords/pkg/omgwords/stores"
 	"github.com/woogles-io/liwords/pkg/user"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
 )
 
 var RenderMutex sync.Mutex
@@ -190,12 +192,112 @@ func (ms *MementoService) GameimgEndpoint(w http.ResponseWriter, r *http.Request
 	http.NotFound(w, r)
 }
 
+var docimgFileTypes = map[string]string{
+	".png":    "png",
+	".gif":    "gif",
+	".webp":   "webp",
+	"-a.gif":  "animated-gif",
+	"-a.webp": "animated-webp",
+	".zip":    "frames",
+}
+
+// determineRenderOptions parses a GAMEID.png, GAMEID.gif, GAMEID.webp,
+// GAMEID-a.gif, GAMEID-a.webp or GAMEID.zip name, and the query options.
+func determineRenderOptions(name string, query url.Values) (string, RenderOptions, error) {
+	var opts RenderOptions
+	gameId := ""
+	for suffix, fileType := range docimgFileTypes {
+		id, ok := strings.CutSuffix(name, suffix)
+		// "-a" is not in the shortuuid alphabet, so only one suffix can match.
+		if ok && id != "" && strings.IndexFunc(id, func(c rune) bool {
+			return !strings.ContainsRune(shortuuid.DefaultAlphabet, c)
+		}) == -1 {
+			gameId, opts.FileType = id, fileType
+		}
+	}
+	if gameId == "" {
+		return "", opts, errInvalidFilename
+	}
+
+	flag := func(key string) bool {
+		v := query.Get(key)
+		return v == "1" || v == "true"
+	}
+	if query.Has("turn") {
+		turn, err := strconv.Atoi(query.Get("turn"))
+		if err != nil {
+			return "", opts, fmt.Errorf("invalid turn: %w", err)
+		}
+		opts.HasTurn, opts.Turn = true, turn
+	}
+	opts.HighlightLastPlay = flag("highlight")
+	opts.CoordinateLabels = flag("labels")
+	opts.ShowRack = flag("rack")
+	opts.ShowNote = flag("note")
+	opts.Theme = query.Get("theme")
+	opts.Caption = query.Get("caption")
+	for _, pos := range query["arrow"] {
+		a, err := ParseArrow(pos)
+		if err != nil {
+			return "", opts, err
+		}
+		opts.Arrows = append(opts.Arrows, a)
+	}
+	if query.Has("delay") {
+		ms, err := strconv.Atoi(query.Get("delay"))
+		if err != nil || ms < 100 || ms > 10000 {
+			return "", opts, fmt.Errorf("invalid delay: %s", query.Get("delay"))
+		}
+		opts.FrameDelay = time.Duration(ms) * time.Millisecond
+	}
+	return gameId, opts, nil
+}
+
+func (ms *MementoService) loadAndRenderDocument(name string, query url.Values) ([]byte, error) {
+	gameId, opts, err := determineRenderOptions(name, query)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	gdoc, err := ms.gameDocumentStore.GetDocument(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	// Annotated games are public as they are being annotated, but racks of
+	// games being played are not.
+	if gdoc.Type != ipc.GameType_ANNOTATED && gdoc.PlayState != ipc.PlayState_GAME_OVER {
+		return nil, fmt.Errorf("game is not over")
+	}
+	RenderMutex.Lock()
+	defer RenderMutex.Unlock()
+
+	return RenderDocument(gdoc, opts)
+}
+
+// DocimgEndpoint renders GameDocuments, including annotated games, with the
+// options in the query string.
+func (ms *MementoService) DocimgEndpoint(w http.ResponseWriter, r *http.Request, name string) {
+	b, err := ms.loadAndRenderDocument(name, r.URL.Query())
+	if err != nil {
+		log.Err(err).Str("name", name).Msg("memento-action-docimg")
+		http.NotFound(w, r)
+		return
+	}
+	http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(b))
+}
+
 // must end with /
 const GameimgPrefix = "/gameimg/"
 
+// Game ids cannot have a /, so this does not conflict with game images.
+const DocimgPrefix = GameimgPrefix + "doc/"
+
 // impl http.Handler
 func (ms *MementoService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if strings.HasPrefix(r.URL.Path, GameimgPrefix) {
+	if strings.HasPrefix(r.URL.Path, DocimgPrefix) {
+		ms.DocimgEndpoint(w, r, strings.TrimPrefix(r.URL.Path, DocimgPrefix))
+	} else if strings.HasPrefix(r.URL.Path, GameimgPrefix) {
 		ms.GameimgEndpoint(w, r, strings.TrimPrefix(r.URL.Path, GameimgPrefix))
 	} else {
 		http.NotFound(w, r)
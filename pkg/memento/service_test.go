@@ -0,0 +1,50 @@
+package memento
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestDetermineRenderOptions(t *testing.T) {
+	is := is.New(t)
+
+	query, err := url.ParseQuery("turn=4&highlight=1&labels=true&rack=1&theme=grayscale&arrow=8D&arrow=H1&delay=500")
+	is.NoErr(err)
+	id, opts, err := determineRenderOptions("7aQ9m3dkXBPXCY5Lw4kMHk-a.webp", query)
+	is.NoErr(err)
+	is.Equal(id, "7aQ9m3dkXBPXCY5Lw4kMHk")
+	is.Equal(opts, RenderOptions{
+		FileType:          "animated-webp",
+		HasTurn:           true,
+		Turn:              4,
+		HighlightLastPlay: true,
+		CoordinateLabels:  true,
+		ShowRack:          true,
+		Theme:             "grayscale",
+		Arrows:            []Arrow{{Row: 7, Column: 3}, {Row: 0, Column: 7, Vertical: true}},
+		FrameDelay:        500 * time.Millisecond,
+	})
+
+	for name, fileType := range map[string]string{
+		"abc.png": "png", "abc.gif": "gif", "abc.webp": "webp", "abc-a.gif": "animated-gif", "abc.zip": "frames",
+	} {
+		_, opts, err := determineRenderOptions(name, url.Values{})
+		is.NoErr(err)
+		is.Equal(opts.FileType, fileType)
+		is.Equal(opts.HasTurn, false)
+	}
+
+	for _, bad := range []string{"abc.jpg", ".png", "abc-b.gif", "a/b.png", "abc-a.png"} {
+		_, _, err := determineRenderOptions(bad, url.Values{})
+		is.Equal(err, errInvalidFilename)
+	}
+	_, _, err = determineRenderOptions("abc.png", url.Values{"turn": {"x"}})
+	is.True(err != nil)
+	_, _, err = determineRenderOptions("abc.png", url.Values{"delay": {"5"}})
+	is.True(err != nil)
+	_, _, err = determineRenderOptions("abc.png", url.Values{"arrow": {"8"}})
+	is.True(err != nil)
+}
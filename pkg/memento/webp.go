@@ -0,0 +1,599 @@
+package memento
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+)
+
+// This is a minimal lossless WebP (VP8L) encoder for simple paletted images.
+// Refer to https://developers.google.com/speed/webp/docs/webp_lossless_bitstream_specification
+// and https://developers.google.com/speed/webp/docs/riff_container.
+//
+// Only the color indexing transform is used, since every image here is
+// paletted. Pixels are then coded as literals or as backward references
+// found with a small hash chain. That is enough for board images, which are
+// mostly the same sprites over and over.
+
+const (
+	vp8lSignature          = 0x2f
+	vp8lColorIndexingXform = 3
+	vp8lNumLiteralCodes    = 256
+	vp8lNumLengthCodes     = 24
+	vp8lNumDistanceCodes   = 40
+	vp8lMaxCodeLength      = 15
+	vp8lMaxCodeLengthCode  = 7
+	vp8lMaxMatchLength     = 4096
+	vp8lMinMatchLength     = 4
+	vp8lMaxDistance        = (1 << 20) - 120
+	vp8lHashBits           = 16
+	vp8lMaxChain           = 16
+	vp8lPlaneCodes         = 120
+)
+
+// The order in which code length code lengths are written.
+var vp8lCodeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+type vp8lBitWriter struct {
+	buf []byte
+	acc uint64
+	n   uint
+}
+
+// writeBits writes the lowest n bits of v, least significant bit first.
+func (w *vp8lBitWriter) writeBits(v uint32, n uint) {
+	w.acc |= uint64(v) << w.n
+	w.n += n
+	for w.n >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.n -= 8
+	}
+}
+
+func (w *vp8lBitWriter) bytes() []byte {
+	if w.n > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.n = 0, 0
+	}
+	return w.buf
+}
+
+// A huffmanCode has a canonical code for each used symbol, stored bit
+// reversed so it can be written least significant bit first.
+type huffmanCode struct {
+	lengths []uint8
+	codes   []uint16
+	// A code with a single used symbol takes no bits at all.
+	single bool
+}
+
+func (h *huffmanCode) writeSymbol(w *vp8lBitWriter, sym int) {
+	if !h.single {
+		w.writeBits(uint32(h.codes[sym]), uint(h.lengths[sym]))
+	}
+}
+
+type huffmanNode struct {
+	count int
+	// The symbol for leaves, or -1.
+	sym         int
+	left, right *huffmanNode
+}
+
+type huffmanHeap []*huffmanNode
+
+func (h huffmanHeap) Len() int { return len(h) }
+func (h huffmanHeap) Less(i, j int) bool {
+	if h[i].count != h[j].count {
+		return h[i].count < h[j].count
+	}
+	return h[i].sym < h[j].sym
+}
+func (h huffmanHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *huffmanHeap) Push(x any)   { *h = append(*h, x.(*huffmanNode)) }
+func (h *huffmanHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// huffmanLengths returns code lengths of at most maxLen bits for the counts.
+// The code is complete whenever at least two symbols are used.
+func huffmanLengths(counts []int, maxLen int) []uint8 {
+	lengths := make([]uint8, len(counts))
+	used := 0
+	for _, c := range counts {
+		if c > 0 {
+			used++
+		}
+	}
+	if used == 0 {
+		return lengths
+	}
+	if used == 1 {
+		for s, c := range counts {
+			if c > 0 {
+				lengths[s] = 1
+			}
+		}
+		return lengths
+	}
+
+	// Flatten the counts until the tree is shallow enough.
+	for minCount := 1; ; minCount *= 2 {
+		h := make(huffmanHeap, 0, used)
+		for s, c := range counts {
+			if c > 0 {
+				h = append(h, &huffmanNode{count: max(c, minCount), sym: s})
+			}
+		}
+		heap.Init(&h)
+		for h.Len() > 1 {
+			a := heap.Pop(&h).(*huffmanNode)
+			b := heap.Pop(&h).(*huffmanNode)
+			heap.Push(&h, &huffmanNode{count: a.count + b.count, sym: -1, left: a, right: b})
+		}
+		tooDeep := false
+		var walk func(n *huffmanNode, depth int)
+		walk = func(n *huffmanNode, depth int) {
+			if n.left == nil {
+				if depth > maxLen {
+					tooDeep = true
+				}
+				lengths[n.sym] = uint8(depth)
+				return
+			}
+			walk(n.left, depth+1)
+			walk(n.right, depth+1)
+		}
+		walk(h[0], 0)
+		if !tooDeep {
+			return lengths
+		}
+	}
+}
+
+// newHuffmanCode assigns canonical codes to the lengths.
+func newHuffmanCode(lengths []uint8) *huffmanCode {
+	h := &huffmanCode{lengths: lengths, codes: make([]uint16, len(lengths))}
+	var blCount [vp8lMaxCodeLength + 1]int
+	used := 0
+	for _, l := range lengths {
+		if l > 0 {
+			blCount[l]++
+			used++
+		}
+	}
+	h.single = used <= 1
+	var nextCode [vp8lMaxCodeLength + 2]int
+	code := 0
+	for bits := 1; bits <= vp8lMaxCodeLength; bits++ {
+		code = (code + blCount[bits-1]) << 1
+		nextCode[bits] = code
+	}
+	for s, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		c := nextCode[l]
+		nextCode[l]++
+		// Reverse the code, since it is read one bit at a time.
+		rev := 0
+		for i := 0; i < int(l); i++ {
+			rev = rev<<1 | (c>>i)&1
+		}
+		h.codes[s] = uint16(rev)
+	}
+	return h
+}
+
+type codeLengthToken struct {
+	sym   int
+	extra uint32
+	nbits uint
+}
+
+// codeLengthTokens run-length encodes code lengths with the repeat codes
+// 16 (previous non-zero length 3-6 times), 17 (zero 3-10 times) and
+// 18 (zero 11-138 times).
+func codeLengthTokens(lengths []uint8) []codeLengthToken {
+	var tokens []codeLengthToken
+	for i := 0; i < len(lengths); {
+		l := lengths[i]
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == l {
+			run++
+		}
+		i += run
+		if l == 0 {
+			for run > 0 {
+				switch {
+				case run >= 11:
+					n := min(run, 138)
+					tokens = append(tokens, codeLengthToken{18, uint32(n - 11), 7})
+					run -= n
+				case run >= 3:
+					tokens = append(tokens, codeLengthToken{17, uint32(run - 3), 3})
+					run = 0
+				default:
+					tokens = append(tokens, codeLengthToken{0, 0, 0})
+					run--
+				}
+			}
+			continue
+		}
+		tokens = append(tokens, codeLengthToken{int(l), 0, 0})
+		run--
+		for run >= 3 {
+			n := min(run, 6)
+			tokens = append(tokens, codeLengthToken{16, uint32(n - 3), 2})
+			run -= n
+		}
+		for ; run > 0; run-- {
+			tokens = append(tokens, codeLengthToken{int(l), 0, 0})
+		}
+	}
+	return tokens
+}
+
+// buildAndWriteHuffmanCode builds a code for the symbol counts and writes it.
+func buildAndWriteHuffmanCode(w *vp8lBitWriter, counts []int) *huffmanCode {
+	var used []int
+	for s, c := range counts {
+		if c > 0 {
+			used = append(used, s)
+		}
+	}
+	if len(used) == 0 {
+		used = []int{0}
+	}
+	if len(used) <= 2 && used[len(used)-1] < 256 {
+		// Simple code. Its code lengths are all 1, or 0 for a single symbol.
+		lengths := make([]uint8, len(counts))
+		w.writeBits(1, 1)
+		w.writeBits(uint32(len(used)-1), 1)
+		if used[0] > 1 {
+			w.writeBits(1, 1)
+			w.writeBits(uint32(used[0]), 8)
+		} else {
+			w.writeBits(0, 1)
+			w.writeBits(uint32(used[0]), 1)
+		}
+		if len(used) == 2 {
+			w.writeBits(uint32(used[1]), 8)
+			lengths[used[0]], lengths[used[1]] = 1, 1
+		}
+		return newHuffmanCode(lengths)
+	}
+
+	lengths := huffmanLengths(counts, vp8lMaxCodeLength)
+	tokens := codeLengthTokens(lengths)
+	tokenCounts := make([]int, len(vp8lCodeLengthCodeOrder))
+	for _, t := range tokens {
+		tokenCounts[t.sym]++
+	}
+	clCode := newHuffmanCode(huffmanLengths(tokenCounts, vp8lMaxCodeLengthCode))
+	numCodes := len(vp8lCodeLengthCodeOrder)
+	for numCodes > 4 && clCode.lengths[vp8lCodeLengthCodeOrder[numCodes-1]] == 0 {
+		numCodes--
+	}
+	w.writeBits(0, 1)
+	w.writeBits(uint32(numCodes-4), 4)
+	for _, sym := range vp8lCodeLengthCodeOrder[:numCodes] {
+		w.writeBits(uint32(clCode.lengths[sym]), 3)
+	}
+	// All the code lengths are written, instead of stopping at a max_symbol.
+	w.writeBits(0, 1)
+	for _, t := range tokens {
+		clCode.writeSymbol(w, t.sym)
+		w.writeBits(t.extra, t.nbits)
+	}
+	return newHuffmanCode(lengths)
+}
+
+// vp8lPrefix splits a length or distance into its prefix code and extra bits.
+func vp8lPrefix(v int) (code int, extra uint32, nbits uint) {
+	d := v - 1
+	if d < 4 {
+		return d, 0, 0
+	}
+	hi := 0
+	for d>>(hi+1) != 0 {
+		hi++
+	}
+	second := (d >> (hi - 1)) & 1
+	nbits = uint(hi - 1)
+	return 2*hi + second, uint32(d) & (1<<nbits - 1), nbits
+}
+
+// A vp8lToken is either a literal ARGB pixel or a backward reference.
+type vp8lToken struct {
+	argb   uint32
+	length int // 0 for a literal
+	dist   int // the distance code, already mapped
+}
+
+// vp8lBackwardRefs finds backward references in the pixels. Candidates are
+// the previous pixel, the pixel above and a short hash chain of earlier
+// positions.
+func vp8lBackwardRefs(px []uint32, xsize int) []vp8lToken {
+	n := len(px)
+	hashOf := func(i int) uint32 {
+		h := px[i]*0x1e35a7bd ^ px[i+1]*0x9e3779b1 ^ px[i+2]*0x85ebca6b ^ px[i+3]*0xc2b2ae35
+		return h >> (32 - vp8lHashBits)
+	}
+	head := make([]int32, 1<<vp8lHashBits)
+	for i := range head {
+		head[i] = -1
+	}
+	prev := make([]int32, n)
+	insert := func(i int) {
+		if i+3 < n {
+			h := hashOf(i)
+			prev[i] = head[h]
+			head[h] = int32(i)
+		}
+	}
+	matchLen := func(i, j int) int {
+		limit := min(vp8lMaxMatchLength, n-i)
+		l := 0
+		for l < limit && px[i+l] == px[j+l] {
+			l++
+		}
+		return l
+	}
+
+	tokens := make([]vp8lToken, 0, n/8)
+	for i := 0; i < n; {
+		bestLen, bestDist := 0, 0
+		try := func(d int) {
+			if d <= 0 || d > i || d > vp8lMaxDistance {
+				return
+			}
+			if l := matchLen(i, i-d); l > bestLen {
+				bestLen, bestDist = l, d
+			}
+		}
+		try(1)
+		try(xsize)
+		if i+3 < n {
+			j := head[hashOf(i)]
+			for chain := 0; j >= 0 && chain < vp8lMaxChain; chain++ {
+				try(i - int(j))
+				j = prev[j]
+			}
+		}
+		if bestLen < vp8lMinMatchLength {
+			tokens = append(tokens, vp8lToken{argb: px[i]})
+			insert(i)
+			i++
+			continue
+		}
+		// The first two plane codes are the pixel above and the previous pixel.
+		distCode := bestDist + vp8lPlaneCodes
+		if bestDist == xsize {
+			distCode = 1
+		} else if bestDist == 1 {
+			distCode = 2
+		}
+		tokens = append(tokens, vp8lToken{length: bestLen, dist: distCode})
+		for k := 0; k < bestLen; k++ {
+			insert(i + k)
+		}
+		i += bestLen
+	}
+	return tokens
+}
+
+// writeVP8LImage writes an entropy coded image without a color cache. The
+// main image has a meta prefix bit, but sub-images such as the color table do
+// not.
+func writeVP8LImage(w *vp8lBitWriter, tokens []vp8lToken, isMain bool) {
+	w.writeBits(0, 1) // No color cache.
+	if isMain {
+		w.writeBits(0, 1) // No meta prefix codes.
+	}
+	green := make([]int, vp8lNumLiteralCodes+vp8lNumLengthCodes)
+	red := make([]int, vp8lNumLiteralCodes)
+	blue := make([]int, vp8lNumLiteralCodes)
+	alpha := make([]int, vp8lNumLiteralCodes)
+	dist := make([]int, vp8lNumDistanceCodes)
+	for _, t := range tokens {
+		if t.length == 0 {
+			alpha[t.argb>>24]++
+			red[(t.argb>>16)&0xff]++
+			green[(t.argb>>8)&0xff]++
+			blue[t.argb&0xff]++
+			continue
+		}
+		code, _, _ := vp8lPrefix(t.length)
+		green[vp8lNumLiteralCodes+code]++
+		code, _, _ = vp8lPrefix(t.dist)
+		dist[code]++
+	}
+	greenCode := buildAndWriteHuffmanCode(w, green)
+	redCode := buildAndWriteHuffmanCode(w, red)
+	blueCode := buildAndWriteHuffmanCode(w, blue)
+	alphaCode := buildAndWriteHuffmanCode(w, alpha)
+	distCode := buildAndWriteHuffmanCode(w, dist)
+	for _, t := range tokens {
+		if t.length == 0 {
+			greenCode.writeSymbol(w, int((t.argb>>8)&0xff))
+			redCode.writeSymbol(w, int((t.argb>>16)&0xff))
+			blueCode.writeSymbol(w, int(t.argb&0xff))
+			alphaCode.writeSymbol(w, int(t.argb>>24))
+			continue
+		}
+		code, extra, nbits := vp8lPrefix(t.length)
+		greenCode.writeSymbol(w, vp8lNumLiteralCodes+code)
+		w.writeBits(extra, nbits)
+		code, extra, nbits = vp8lPrefix(t.dist)
+		distCode.writeSymbol(w, code)
+		w.writeBits(extra, nbits)
+	}
+}
+
+func toARGB(c color.Color) uint32 {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return uint32(n.A)<<24 | uint32(n.R)<<16 | uint32(n.G)<<8 | uint32(n.B)
+}
+
+// encodeVP8L encodes a paletted image as a VP8L bitstream.
+func encodeVP8L(img *image.Paletted) ([]byte, error) {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	if width <= 0 || height <= 0 || width > 1<<14 || height > 1<<14 {
+		return nil, fmt.Errorf("cannot encode %dx%d image as webp", width, height)
+	}
+	pal := img.Palette
+	if len(pal) == 0 || len(pal) > 256 {
+		return nil, fmt.Errorf("webp cannot support %d colors", len(pal))
+	}
+	hasAlpha := false
+	argbPal := make([]uint32, len(pal))
+	for i, c := range pal {
+		argbPal[i] = toARGB(c)
+		if argbPal[i]>>24 != 0xff {
+			hasAlpha = true
+		}
+	}
+
+	w := &vp8lBitWriter{}
+	w.writeBits(vp8lSignature, 8)
+	w.writeBits(uint32(width-1), 14)
+	w.writeBits(uint32(height-1), 14)
+	if hasAlpha {
+		w.writeBits(1, 1)
+	} else {
+		w.writeBits(0, 1)
+	}
+	w.writeBits(0, 3) // Version.
+
+	// Color indexing transform, with the color table delta coded.
+	w.writeBits(1, 1)
+	w.writeBits(vp8lColorIndexingXform, 2)
+	w.writeBits(uint32(len(pal)-1), 8)
+	palTokens := make([]vp8lToken, len(argbPal))
+	var last uint32
+	for i, c := range argbPal {
+		var delta uint32
+		for shift := 0; shift < 32; shift += 8 {
+			delta |= ((c>>shift - last>>shift) & 0xff) << shift
+		}
+		palTokens[i] = vp8lToken{argb: delta}
+		last = c
+	}
+	writeVP8LImage(w, palTokens, false)
+	w.writeBits(0, 1) // No more transforms.
+
+	// Small palettes bundle several indexes into one pixel.
+	widthBits := 0
+	switch {
+	case len(pal) <= 2:
+		widthBits = 3
+	case len(pal) <= 4:
+		widthBits = 2
+	case len(pal) <= 16:
+		widthBits = 1
+	}
+	xsize := (width + 1<<widthBits - 1) >> widthBits
+	bitsPerIndex := 8 >> widthBits
+	px := make([]uint32, xsize*height)
+	for y := 0; y < height; y++ {
+		row := img.Pix[img.PixOffset(img.Rect.Min.X, img.Rect.Min.Y+y):][:width]
+		packed := px[y*xsize : (y+1)*xsize]
+		for x, idx := range row {
+			if int(idx) >= len(pal) {
+				return nil, fmt.Errorf("color index %d out of range", idx)
+			}
+			packed[x>>widthBits] |= uint32(idx) << (8 + bitsPerIndex*(x&(1<<widthBits-1)))
+		}
+		for x := range packed {
+			packed[x] |= 0xff000000
+		}
+	}
+	writeVP8LImage(w, vp8lBackwardRefs(px, xsize), true)
+	return w.bytes(), nil
+}
+
+func appendChunk(buf *bytes.Buffer, fourcc string, data []byte) {
+	buf.WriteString(fourcc)
+	binary.Write(buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+	if len(data)%2 == 1 {
+		buf.WriteByte(0)
+	}
+}
+
+func appendUint24(b []byte, v int) []byte {
+	return append(b, byte(v), byte(v>>8), byte(v>>16))
+}
+
+func writeRIFF(w io.Writer, chunks *bytes.Buffer) error {
+	var hdr bytes.Buffer
+	hdr.WriteString("RIFF")
+	binary.Write(&hdr, binary.LittleEndian, uint32(4+chunks.Len()))
+	hdr.WriteString("WEBP")
+	if _, err := w.Write(hdr.Bytes()); err != nil {
+		return err
+	}
+	_, err := w.Write(chunks.Bytes())
+	return err
+}
+
+// encodeWebP writes a paletted image as a lossless WebP.
+func encodeWebP(w io.Writer, img *image.Paletted) error {
+	data, err := encodeVP8L(img)
+	if err != nil {
+		return err
+	}
+	var chunks bytes.Buffer
+	appendChunk(&chunks, "VP8L", data)
+	return writeRIFF(w, &chunks)
+}
+
+// encodeAnimatedWebP writes the frames of an animation as a lossless WebP.
+// Like image/gif, delays are in centiseconds, and frames are drawn over the
+// previous frames where they are transparent. Frames must start at even
+// coordinates within the canvas.
+func encodeAnimatedWebP(w io.Writer, frames []*image.Paletted, delays []int, canvas image.Rectangle) error {
+	if len(frames) == 0 || len(frames) != len(delays) {
+		return fmt.Errorf("invalid animation: %d frames and %d delays", len(frames), len(delays))
+	}
+	var chunks bytes.Buffer
+
+	vp8x := []byte{0x10 | 0x02, 0, 0, 0} // Alpha and animation.
+	vp8x = appendUint24(vp8x, canvas.Dx()-1)
+	vp8x = appendUint24(vp8x, canvas.Dy()-1)
+	appendChunk(&chunks, "VP8X", vp8x)
+	// Transparent background, loop forever.
+	appendChunk(&chunks, "ANIM", []byte{0, 0, 0, 0, 0, 0})
+
+	for i, frame := range frames {
+		off := frame.Rect.Min.Sub(canvas.Min)
+		if off.X%2 != 0 || off.Y%2 != 0 {
+			return fmt.Errorf("frame %d has odd offset %v", i, off)
+		}
+		data, err := encodeVP8L(frame)
+		if err != nil {
+			return err
+		}
+		anmf := make([]byte, 0, 16+8+len(data)+1)
+		anmf = appendUint24(anmf, off.X/2)
+		anmf = appendUint24(anmf, off.Y/2)
+		anmf = appendUint24(anmf, frame.Rect.Dx()-1)
+		anmf = appendUint24(anmf, frame.Rect.Dy()-1)
+		anmf = appendUint24(anmf, delays[i]*10)
+		anmf = append(anmf, 0) // Blend with the previous frame, do not dispose.
+		var frameData bytes.Buffer
+		appendChunk(&frameData, "VP8L", data)
+		anmf = append(anmf, frameData.Bytes()...)
+		appendChunk(&chunks, "ANMF", anmf)
+	}
+	return writeRIFF(w, &chunks)
+}
@@ -0,0 +1,274 @@
+package memento
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/draw"
+	"math/rand"
+	"testing"
+
+	"github.com/matryer/is"
+	"golang.org/x/image/webp"
+)
+
+func TestHuffmanLengths(t *testing.T) {
+	is := is.New(t)
+	// Fibonacci counts make the deepest possible tree.
+	fib := make([]int, 30)
+	fib[0], fib[1] = 1, 1
+	for i := 2; i < len(fib); i++ {
+		fib[i] = fib[i-1] + fib[i-2]
+	}
+	for _, counts := range [][]int{
+		{5, 0, 3},
+		{1, 1, 1, 1, 1},
+		{0, 0, 7, 0, 0, 0, 1, 2, 100},
+		fib,
+	} {
+		for _, maxLen := range []int{vp8lMaxCodeLengthCode, vp8lMaxCodeLength} {
+			lengths := huffmanLengths(counts, maxLen)
+			// The code must be complete.
+			kraft := 0
+			for s, l := range lengths {
+				is.Equal(l == 0, counts[s] == 0)
+				is.True(int(l) <= maxLen)
+				if l > 0 {
+					kraft += 1 << (maxLen - int(l))
+				}
+			}
+			is.Equal(kraft, 1<<maxLen)
+		}
+	}
+	is.Equal(huffmanLengths([]int{0, 4, 0}, 15), []uint8{0, 1, 0})
+}
+
+func TestVP8LPrefix(t *testing.T) {
+	is := is.New(t)
+	for v := 1; v <= 1<<20; v++ {
+		code, extra, nbits := vp8lPrefix(v)
+		// Decode as in the spec.
+		got := code + 1
+		if code >= 4 {
+			extraBits := (code - 2) >> 1
+			is.Equal(int(nbits), extraBits)
+			got = (2+code&1)<<extraBits + int(extra) + 1
+		}
+		if got != v {
+			t.Fatalf("prefix of %d decodes to %d", v, got)
+		}
+		is.True(code < vp8lNumDistanceCodes)
+	}
+}
+
+func TestEncodeWebP(t *testing.T) {
+	is := is.New(t)
+	pal := []color.Color{color.Transparent, color.Black, color.White}
+	img := image.NewPaletted(image.Rect(0, 0, 37, 11), pal)
+	for i := range img.Pix {
+		img.Pix[i] = byte(i % 3)
+	}
+
+	var buf bytes.Buffer
+	is.NoErr(encodeWebP(&buf, img))
+	b := buf.Bytes()
+	is.Equal(string(b[:4]), "RIFF")
+	is.Equal(int(binary.LittleEndian.Uint32(b[4:])), len(b)-8)
+	is.Equal(string(b[8:16]), "WEBPVP8L")
+	is.Equal(b[20], byte(vp8lSignature))
+	header := binary.LittleEndian.Uint32(b[21:])
+	is.Equal(int(header&(1<<14-1))+1, 37)
+	is.Equal(int(header>>14&(1<<14-1))+1, 11)
+	is.Equal(header>>28&1, uint32(1)) // Alpha is used.
+
+	frame := image.NewPaletted(image.Rect(2, 4, 9, 7), pal)
+	buf.Reset()
+	is.NoErr(encodeAnimatedWebP(&buf, []*image.Paletted{img, frame}, []int{50, 100}, img.Rect))
+	b = buf.Bytes()
+	is.Equal(int(binary.LittleEndian.Uint32(b[4:])), len(b)-8)
+	is.Equal(string(b[8:16]), "WEBPVP8X")
+	anmf := bytes.LastIndex(b, []byte("ANMF")) + 8
+	is.Equal(b[anmf:anmf+15], []byte{1, 0, 0, 2, 0, 0, 6, 0, 0, 2, 0, 0, 0xe8, 0x03, 0})
+
+	odd := image.NewPaletted(image.Rect(1, 0, 3, 3), pal)
+	is.True(encodeAnimatedWebP(&buf, []*image.Paletted{img, odd}, []int{50, 100}, img.Rect) != nil)
+}
+
+// checkPixels fails unless got has the same pixels as want, wherever they
+// each start.
+func checkPixels(t *testing.T, got, want image.Image) {
+	t.Helper()
+	gb, wb := got.Bounds(), want.Bounds()
+	if gb.Size() != wb.Size() {
+		t.Fatalf("got %v image, want %v", gb.Size(), wb.Size())
+	}
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			g := color.NRGBAModel.Convert(got.At(gb.Min.X+x, gb.Min.Y+y))
+			w := color.NRGBAModel.Convert(want.At(wb.Min.X+x, wb.Min.Y+y))
+			if g != w {
+				t.Fatalf("pixel (%d, %d) is %v, want %v", x, y, g, w)
+			}
+		}
+	}
+}
+
+func randomPaletted(rng *rand.Rand, rect image.Rectangle, ncolors int) *image.Paletted {
+	pal := make(color.Palette, ncolors)
+	for i := range pal {
+		pal[i] = color.NRGBA{uint8(rng.Intn(256)), uint8(rng.Intn(256)), uint8(rng.Intn(256)), uint8(rng.Intn(256))}
+	}
+	img := image.NewPaletted(rect, pal)
+	for i := range img.Pix {
+		// Runs of the same color make backward references.
+		if i > 0 && rng.Intn(3) > 0 {
+			img.Pix[i] = img.Pix[i-1]
+		} else {
+			img.Pix[i] = uint8(rng.Intn(ncolors))
+		}
+	}
+	return img
+}
+
+func TestWebPRoundTrip(t *testing.T) {
+	is := is.New(t)
+	rng := rand.New(rand.NewSource(42))
+	for _, ncolors := range []int{1, 2, 3, 4, 5, 16, 17, 256} {
+		for _, size := range []image.Point{{1, 1}, {37, 11}, {8, 64}, {301, 7}} {
+			img := randomPaletted(rng, image.Rectangle{Min: image.Pt(3, 5), Max: image.Pt(3, 5).Add(size)}, ncolors)
+			var buf bytes.Buffer
+			is.NoErr(encodeWebP(&buf, img))
+			dec, err := webp.Decode(&buf)
+			is.NoErr(err)
+			checkPixels(t, dec, img)
+		}
+	}
+
+	// A board, which is mostly the same sprites over and over.
+	frames, _, err := RenderDocumentFrames(loadDocument(is), RenderOptions{FileType: "webp", ShowRack: true})
+	is.NoErr(err)
+	b, err := RenderDocument(loadDocument(is), RenderOptions{FileType: "webp", ShowRack: true})
+	is.NoErr(err)
+	dec, err := webp.Decode(bytes.NewReader(b))
+	is.NoErr(err)
+	checkPixels(t, dec, frames[0])
+}
+
+type webpFrame struct {
+	img      image.Image
+	offset   image.Point
+	duration int
+}
+
+func uint24(b []byte) int {
+	return int(b[0]) | int(b[1])<<8 | int(b[2])<<16
+}
+
+// decodeAnimatedWebP decodes each frame of an animated WebP on its own,
+// since golang.org/x/image/webp only decodes still images.
+func decodeAnimatedWebP(is *is.I, b []byte) (image.Rectangle, []webpFrame) {
+	is.Equal(string(b[:4]), "RIFF")
+	is.Equal(string(b[8:12]), "WEBP")
+	var canvas image.Rectangle
+	var frames []webpFrame
+	for p := 12; p < len(b); {
+		fourcc := string(b[p : p+4])
+		size := int(binary.LittleEndian.Uint32(b[p+4:]))
+		data := b[p+8 : p+8+size]
+		p += 8 + size + size&1
+		switch fourcc {
+		case "VP8X":
+			canvas = image.Rect(0, 0, uint24(data[4:])+1, uint24(data[7:])+1)
+		case "ANMF":
+			// Wrap the frame's bitstream in a container of its own.
+			var still bytes.Buffer
+			still.WriteString("RIFF")
+			binary.Write(&still, binary.LittleEndian, uint32(4+len(data)-16))
+			still.WriteString("WEBP")
+			still.Write(data[16:])
+			img, err := webp.Decode(&still)
+			is.NoErr(err)
+			is.Equal(img.Bounds().Dx(), uint24(data[6:])+1)
+			is.Equal(img.Bounds().Dy(), uint24(data[9:])+1)
+			frames = append(frames, webpFrame{
+				img:      img,
+				offset:   image.Pt(2*uint24(data[0:]), 2*uint24(data[3:])),
+				duration: uint24(data[12:]),
+			})
+		}
+	}
+	return canvas, frames
+}
+
+// composite draws the frames over each other like a WebP player, and
+// returns the canvas after each one along with the time it is shown, in
+// milliseconds.
+func composite(canvas image.Rectangle, frames []webpFrame) ([]image.Image, []int) {
+	var shown []image.Image
+	var starts []int
+	cur := image.NewNRGBA(canvas)
+	start := 0
+	for _, f := range frames {
+		r := f.img.Bounds().Sub(f.img.Bounds().Min).Add(canvas.Min.Add(f.offset))
+		draw.Draw(cur, r, f.img, f.img.Bounds().Min, draw.Over)
+		shown = append(shown, image.Image(image.NewNRGBA(canvas)))
+		draw.Draw(shown[len(shown)-1].(draw.Image), canvas, cur, canvas.Min, draw.Src)
+		starts = append(starts, start)
+		start += f.duration
+	}
+	return shown, starts
+}
+
+func TestAnimatedWebPRoundTrip(t *testing.T) {
+	is := is.New(t)
+	pal := color.Palette{color.Transparent, color.Black, color.White, color.NRGBA{200, 30, 40, 255}}
+	rng := rand.New(rand.NewSource(7))
+	first := image.NewPaletted(image.Rect(10, 20, 47, 31), pal)
+	for i := range first.Pix {
+		first.Pix[i] = uint8(1 + rng.Intn(3))
+	}
+	// The second frame only covers part of the canvas, and is transparent
+	// where it leaves the first frame showing.
+	second := image.NewPaletted(image.Rect(12, 24, 19, 27), pal)
+	for i := range second.Pix {
+		second.Pix[i] = uint8(rng.Intn(4))
+	}
+	var buf bytes.Buffer
+	is.NoErr(encodeAnimatedWebP(&buf, []*image.Paletted{first, second}, []int{50, 100}, first.Rect))
+
+	canvas, frames := decodeAnimatedWebP(is, buf.Bytes())
+	is.Equal(canvas.Size(), first.Rect.Size())
+	is.Equal(len(frames), 2)
+	is.Equal(frames[1].offset, image.Pt(2, 4))
+	is.Equal(frames[1].duration, 1000)
+	checkPixels(t, frames[0].img, first)
+	checkPixels(t, frames[1].img, second)
+
+	want := image.NewNRGBA(first.Rect)
+	draw.Draw(want, first.Rect, first, first.Rect.Min, draw.Src)
+	draw.Draw(want, second.Rect, second, second.Rect.Min, draw.Over)
+	shown, starts := composite(canvas, frames)
+	is.Equal(starts, []int{0, 500})
+	checkPixels(t, shown[1], want)
+
+	// A game, where each frame only has what changed since the one before.
+	opts := RenderOptions{FileType: "animated-webp", HasTurn: true, Turn: 5, ShowRack: true}
+	full, delays, err := RenderDocumentFrames(loadDocument(is), opts)
+	is.NoErr(err)
+	b, err := RenderDocument(loadDocument(is), opts)
+	is.NoErr(err)
+	canvas, frames = decodeAnimatedWebP(is, b)
+	is.Equal(canvas.Size(), full[0].Rect.Size())
+	shown, starts = composite(canvas, frames)
+	start := 0
+	for i, f := range full {
+		// Frames that don't change anything are merged into the one before.
+		for len(starts) > 1 && starts[1] <= start {
+			shown, starts = shown[1:], starts[1:]
+		}
+		is.Equal(starts[0] <= start, true)
+		checkPixels(t, shown[0], f)
+		start += delays[i] * 10
+	}
+}
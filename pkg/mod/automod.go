@@ -44,6 +44,7 @@ var BehaviorToString map[ms.NotoriousGameType]string = map[ms.NotoriousGameType]
 	ms.NotoriousGameType_NO_PLAY:              "No Play",
 	ms.NotoriousGameType_SITTING:              "Sitting",
 	ms.NotoriousGameType_SANDBAG:              "Premature Resignation",
+	ms.NotoriousGameType_ENGINE_CORRELATION:   "Engine Correlation",
 }
 
 var IsTesting = strings.HasSuffix(os.Args[0], ".test")
@@ -252,7 +253,7 @@ func updateNotoriety(ctx context.Context, us user.Store, ns NotorietyStore, user
 				log.Err(err).Str("error", err.Error()).Msg("notoriety-report-error")
 			}
 			moderatorMessage := fmt.Sprintf("\n### Notoriety Report:\n%s\nCurrent Notoriety: %d", notorietyReport, newNotoriety)
-			sendNotification(ctx, us, user, action, moderatorMessage)
+			sendNotification(ctx, us, user, action, moderatorMessage, "")
 		}
 	} else if newNotoriety > 0 {
 		newNotoriety -= NotorietyDecrement
@@ -309,7 +310,7 @@ func isPhonyEvent(event *pb.GameEvent,
 	history *pb.GameHistory,
 	cfg *wglconfig.Config) (bool, error) {
 	phony := false
-	gd, err := kwg.GetKWG(cfg, history.Lexicon)
+	gd, err := entity.GetLexiconKWG(cfg, history.Lexicon)
 	if err != nil {
 		return phony, err
 	}
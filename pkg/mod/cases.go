@@ -0,0 +1,604 @@
+package mod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/woogles-io/liwords/pkg/auth/rbac"
+	"github.com/woogles-io/liwords/pkg/stores/common"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	userstore "github.com/woogles-io/liwords/pkg/stores/user"
+	"github.com/woogles-io/liwords/pkg/user"
+	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
+)
+
+const (
+	// MaxReportsPerDay limits how many reports a single user can file.
+	MaxReportsPerDay = 20
+	// MaxAppealsPerCase limits how many times a case can be appealed.
+	MaxAppealsPerCase      = 2
+	MaxReportDescription   = 1000
+	MinAppealMessageLength = 20
+	MaxAppealMessageLength = 4000
+	DefaultCasesPageSize   = 50
+	MaxCasesPageSize       = 100
+)
+
+var (
+	errCaseNotFound     = errors.New("case not found")
+	errCaseNotActive    = errors.New("this case has already been resolved")
+	errAppealNotFound   = errors.New("appeal not found")
+	errCannotReportSelf = errors.New("you cannot report yourself")
+)
+
+// activeCaseStatuses are the statuses that need a moderator's attention.
+var activeCaseStatuses = []ms.CaseStatus{ms.CaseStatus_CASE_OPEN, ms.CaseStatus_CASE_ASSIGNED, ms.CaseStatus_CASE_APPEALED}
+
+// FileReport files a report from reporterUUID. The report is added to the
+// reported user's open case, which is created if needed. Games and chat
+// messages are attached to the case as evidence.
+func FileReport(ctx context.Context, us user.Store, cs user.ChatStore, q *models.Queries, reporterUUID string,
+	req *ms.FileReportRequest) error {
+
+	if _, ok := ms.ReportTargetType_name[int32(req.TargetType)]; !ok {
+		return errors.New("invalid report type")
+	}
+	if _, ok := ms.ReportReason_name[int32(req.Reason)]; !ok {
+		return errors.New("invalid report reason")
+	}
+	description := strings.TrimSpace(req.Description)
+	if len(description) > MaxReportDescription {
+		return fmt.Errorf("description must be at most %d characters", MaxReportDescription)
+	}
+
+	recent, err := q.CountRecentReportsByReporter(ctx, models.CountRecentReportsByReporterParams{
+		ReporterUuid: reporterUUID,
+		Since:        pgtype.Timestamptz{Time: time.Now().Add(-24 * time.Hour), Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if recent >= MaxReportsPerDay {
+		return errors.New("you have filed too many reports today, please try again later")
+	}
+
+	subjectUUID := req.UserId
+	var evidence *models.AddCaseEvidenceParams
+
+	switch req.TargetType {
+	case ms.ReportTargetType_REPORT_GAME:
+		if req.GameId == "" || subjectUUID == "" {
+			return errors.New("a game report needs a game and a player")
+		}
+		inGame, err := q.IsPlayerInGame(ctx, models.IsPlayerInGameParams{
+			GameUuid: pgtype.Text{String: req.GameId, Valid: true},
+			UserUuid: subjectUUID,
+		})
+		if err != nil {
+			return err
+		}
+		if !inGame {
+			return errors.New("the reported player did not play in this game")
+		}
+		evidence = &models.AddCaseEvidenceParams{
+			EvidenceType: int16(ms.EvidenceType_EVIDENCE_GAME),
+			GameID:       req.GameId,
+		}
+	case ms.ReportTargetType_REPORT_CHAT:
+		if req.Channel == "" || req.MessageId == "" {
+			return errors.New("a chat report needs a channel and a message")
+		}
+		chat, err := cs.GetChat(ctx, req.Channel, req.MessageId)
+		if err != nil {
+			return errors.New("that chat message could not be found")
+		}
+		subjectUUID = chat.UserId
+		evidence = &models.AddCaseEvidenceParams{
+			EvidenceType: int16(ms.EvidenceType_EVIDENCE_CHAT),
+			Channel:      req.Channel,
+			MessageID:    req.MessageId,
+			Snapshot:     chat.Message,
+		}
+	default:
+		if subjectUUID == "" {
+			return errors.New("no player was specified")
+		}
+	}
+
+	if subjectUUID == reporterUUID {
+		return errCannotReportSelf
+	}
+	if _, err := us.GetByUUID(ctx, subjectUUID); err != nil {
+		return errors.New("the reported player does not exist")
+	}
+
+	modCase, err := q.OpenCaseForSubject(ctx, subjectUUID)
+	if err != nil {
+		return err
+	}
+	err = q.AddReport(ctx, models.AddReportParams{
+		CaseID:       modCase.ID,
+		ReporterUuid: reporterUUID,
+		TargetType:   int16(req.TargetType),
+		Reason:       int16(req.Reason),
+		Description:  description,
+	})
+	if err != nil {
+		return err
+	}
+	if evidence != nil {
+		evidence.CaseID = modCase.ID
+		evidence.AddedByUuid = reporterUUID
+		err = q.AddCaseEvidence(ctx, *evidence)
+		if err != nil {
+			return err
+		}
+	}
+	log.Info().Str("reporter", reporterUUID).Str("subject", subjectUUID).
+		Str("case", modCase.Uuid.String()).Str("reason", req.Reason.String()).Msg("report-filed")
+	return nil
+}
+
+func getCaseRow(ctx context.Context, q *models.Queries, caseID string) (*models.GetCaseRow, error) {
+	cuuid, err := uuid.Parse(caseID)
+	if err != nil {
+		return nil, errCaseNotFound
+	}
+	row, err := q.GetCase(ctx, cuuid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errCaseNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func caseRowToProto(row models.GetCaseRow) *ms.ModCase {
+	c := &ms.ModCase{
+		Id:               row.Uuid.String(),
+		UserId:           row.SubjectUuid,
+		Username:         row.SubjectUsername,
+		Status:           ms.CaseStatus(row.Status),
+		AssigneeUserId:   row.AssigneeUuid,
+		AssigneeUsername: row.AssigneeUsername,
+		NumReports:       int32(row.NumReports),
+		ResolutionNote:   row.ResolutionNote,
+		ResolverUsername: row.ResolverUsername,
+		CreatedAt:        timestamppb.New(row.CreatedAt.Time),
+		UpdatedAt:        timestamppb.New(row.UpdatedAt.Time),
+	}
+	if row.ResolvedAt.Valid {
+		c.ResolvedAt = timestamppb.New(row.ResolvedAt.Time)
+	}
+	return c
+}
+
+// ListCases lists cases, most recently updated first.
+func ListCases(ctx context.Context, q *models.Queries, req *ms.ListCasesRequest) ([]*ms.ModCase, error) {
+	statuses := req.Statuses
+	if len(statuses) == 0 {
+		statuses = activeCaseStatuses
+	}
+	dbStatuses := make([]int16, len(statuses))
+	for i, s := range statuses {
+		dbStatuses[i] = int16(s)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = DefaultCasesPageSize
+	} else if limit > MaxCasesPageSize {
+		limit = MaxCasesPageSize
+	}
+	rows, err := q.ListCases(ctx, models.ListCasesParams{
+		Statuses:     dbStatuses,
+		AssigneeUuid: req.AssigneeUserId,
+		Lim:          limit,
+		Off:          max(req.Offset, 0),
+	})
+	if err != nil {
+		return nil, err
+	}
+	cases := make([]*ms.ModCase, len(rows))
+	for i, r := range rows {
+		cases[i] = caseRowToProto(models.GetCaseRow(r))
+	}
+	return cases, nil
+}
+
+// GetCase returns a case with its reports, evidence, actions and appeals.
+func GetCase(ctx context.Context, q *models.Queries, caseID string) (*ms.ModCase, error) {
+	row, err := getCaseRow(ctx, q, caseID)
+	if err != nil {
+		return nil, err
+	}
+	c := caseRowToProto(*row)
+
+	reports, err := q.GetCaseReports(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range reports {
+		c.Reports = append(c.Reports, &ms.Report{
+			ReporterUserId:   r.ReporterUuid,
+			ReporterUsername: r.ReporterUsername,
+			TargetType:       ms.ReportTargetType(r.TargetType),
+			Reason:           ms.ReportReason(r.Reason),
+			Description:      r.Description,
+			CreatedAt:        timestamppb.New(r.CreatedAt.Time),
+		})
+	}
+
+	evidence, err := q.GetCaseEvidence(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range evidence {
+		c.Evidence = append(c.Evidence, &ms.Evidence{
+			Type:            ms.EvidenceType(e.EvidenceType),
+			GameId:          e.GameID,
+			Channel:         e.Channel,
+			MessageId:       e.MessageID,
+			Snapshot:        e.Snapshot,
+			Note:            e.Note,
+			AddedByUsername: e.AddedByUsername,
+			CreatedAt:       timestamppb.New(e.CreatedAt.Time),
+		})
+	}
+
+	c.Actions, err = getCaseActions(ctx, q, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	appeals, err := q.GetCaseAppeals(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range appeals {
+		appeal := &ms.Appeal{
+			Id:               a.Uuid.String(),
+			Message:          a.Message,
+			Status:           ms.AppealStatus(a.Status),
+			Response:         a.Response,
+			ReviewerUsername: a.ReviewerUsername,
+			CreatedAt:        timestamppb.New(a.CreatedAt.Time),
+		}
+		if a.DecidedAt.Valid {
+			appeal.DecidedAt = timestamppb.New(a.DecidedAt.Time)
+		}
+		c.Appeals = append(c.Appeals, appeal)
+	}
+	return c, nil
+}
+
+func getCaseActions(ctx context.Context, q *models.Queries, caseDBID int64) ([]*ms.ModAction, error) {
+	rows, err := q.GetCaseActions(ctx, caseDBID)
+	if err != nil {
+		return nil, err
+	}
+	actions := make([]*ms.ModAction, len(rows))
+	for i, r := range rows {
+		action := &ms.ModAction{
+			UserId:        r.UserUuid,
+			Type:          ms.ModActionType(r.ActionType),
+			StartTime:     timestamppb.New(r.StartTime.Time),
+			Note:          r.Note.String,
+			ApplierUserId: r.ApplierUuid,
+		}
+		if r.EndTime.Valid {
+			action.EndTime = timestamppb.New(r.EndTime.Time)
+			action.Duration = int32(r.EndTime.Time.Sub(r.StartTime.Time).Seconds())
+		}
+		if r.RemovedTime.Valid {
+			action.RemovedTime = timestamppb.New(r.RemovedTime.Time)
+		}
+		actions[i] = action
+	}
+	return actions, nil
+}
+
+// AssignCase assigns an active case to a moderator.
+func AssignCase(ctx context.Context, us user.Store, q *models.Queries, caseID, assigneeUUID string) error {
+	assignee, err := us.GetByUUID(ctx, assigneeUUID)
+	if err != nil {
+		return errors.New("assignee not found")
+	}
+	isMod, err := q.HasPermission(ctx, models.HasPermissionParams{
+		UserID:     int32(assignee.ID),
+		Permission: string(rbac.CanModerateUsers),
+	})
+	if err != nil {
+		return err
+	}
+	if !isMod {
+		return errors.New("cases can only be assigned to moderators")
+	}
+	cuuid, err := uuid.Parse(caseID)
+	if err != nil {
+		return errCaseNotFound
+	}
+	n, err := q.AssignCase(ctx, models.AssignCaseParams{
+		AssigneeUuid: assigneeUUID,
+		CaseUuid:     cuuid,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errCaseNotActive
+	}
+	return nil
+}
+
+// AddCaseEvidence attaches a game or chat message to a case. Chat messages
+// are snapshotted, since they expire from the chat store.
+func AddCaseEvidence(ctx context.Context, cs user.ChatStore, q *models.Queries, modUUID string,
+	req *ms.AddCaseEvidenceRequest) error {
+	row, err := getCaseRow(ctx, q, req.CaseId)
+	if err != nil {
+		return err
+	}
+	params := models.AddCaseEvidenceParams{
+		CaseID:       row.ID,
+		EvidenceType: int16(req.Type),
+		Note:         req.Note,
+		AddedByUuid:  modUUID,
+	}
+	switch req.Type {
+	case ms.EvidenceType_EVIDENCE_GAME:
+		if req.GameId == "" {
+			return errors.New("game evidence needs a game ID")
+		}
+		params.GameID = req.GameId
+	case ms.EvidenceType_EVIDENCE_CHAT:
+		if req.Channel == "" || req.MessageId == "" {
+			return errors.New("chat evidence needs a channel and a message ID")
+		}
+		params.Channel = req.Channel
+		params.MessageID = req.MessageId
+		chat, err := cs.GetChat(ctx, req.Channel, req.MessageId)
+		if err != nil {
+			log.Err(err).Str("channel", req.Channel).Str("msgID", req.MessageId).Msg("case-evidence-chat-not-found")
+		} else {
+			params.Snapshot = chat.Message
+		}
+	default:
+		return errors.New("invalid evidence type")
+	}
+	return q.AddCaseEvidence(ctx, params)
+}
+
+// ResolveCase applies the given actions to the case's subject and closes the
+// case. With no actions, the case is dismissed. The notification emails for
+// the actions link to the case's appeal page.
+func ResolveCase(ctx context.Context, us user.Store, cs user.ChatStore, dbPool *pgxpool.Pool, q *models.Queries,
+	modUUID string, req *ms.ResolveCaseRequest) error {
+
+	tx, err := dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := q.WithTx(tx)
+
+	row, err := getCaseRow(ctx, qtx, req.CaseId)
+	if err != nil {
+		return err
+	}
+	status := ms.CaseStatus(row.Status)
+	if status != ms.CaseStatus_CASE_OPEN && status != ms.CaseStatus_CASE_ASSIGNED {
+		return errCaseNotActive
+	}
+	for _, action := range req.Actions {
+		if action.UserId == "" {
+			action.UserId = row.SubjectUuid
+		}
+		if action.UserId != row.SubjectUuid {
+			return errors.New("actions must apply to the reported user")
+		}
+	}
+
+	newStatus := ms.CaseStatus_CASE_DISMISSED
+	if len(req.Actions) > 0 {
+		newStatus = ms.CaseStatus_CASE_ACTION_TAKEN
+	}
+	// Close the case first; this also keeps another moderator from resolving
+	// it at the same time.
+	n, err := qtx.ResolveCase(ctx, models.ResolveCaseParams{
+		Status:         int16(newStatus),
+		ResolutionNote: req.Note,
+		ResolverUuid:   modUUID,
+		CaseID:         row.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errCaseNotActive
+	}
+
+	var prepared []preparedAction
+	if len(req.Actions) > 0 {
+		prepared, err = prepareActions(ctx, us, cs, modUUID, req.Actions)
+		if err != nil {
+			return err
+		}
+		err = userstore.ApplyActionsTx(ctx, tx, preparedModActions(prepared))
+		if err != nil {
+			return err
+		}
+		for _, action := range req.Actions {
+			err = qtx.LinkCaseAction(ctx, models.LinkCaseActionParams{
+				CaseID:     row.ID,
+				UserUuid:   action.UserId,
+				ActionType: int32(action.Type),
+				StartTime:  pgtype.Timestamptz{Time: action.StartTime.AsTime(), Valid: true},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	log.Info().Str("case", req.CaseId).Str("mod", modUUID).Str("status", newStatus.String()).Msg("case-resolved")
+
+	notifyActions(ctx, us, prepared, fmt.Sprintf(AppealURLFormat, row.Uuid.String()))
+	return nil
+}
+
+// SubmitAppeal lets a sanctioned user appeal the actions of a case about
+// them.
+func SubmitAppeal(ctx context.Context, q *models.Queries, userUUID, caseID, message string) (string, error) {
+	row, err := getCaseRow(ctx, q, caseID)
+	if err != nil {
+		return "", err
+	}
+	if row.SubjectUuid != userUUID {
+		// Don't leak the existence of cases about other users.
+		return "", errCaseNotFound
+	}
+	switch ms.CaseStatus(row.Status) {
+	case ms.CaseStatus_CASE_ACTION_TAKEN:
+	case ms.CaseStatus_CASE_APPEALED:
+		return "", errors.New("you already have a pending appeal for this case")
+	default:
+		return "", errors.New("this case cannot be appealed")
+	}
+	message = strings.TrimSpace(message)
+	if len(message) < MinAppealMessageLength || len(message) > MaxAppealMessageLength {
+		return "", fmt.Errorf("your appeal must be between %d and %d characters", MinAppealMessageLength, MaxAppealMessageLength)
+	}
+	appeals, err := q.GetCaseAppeals(ctx, row.ID)
+	if err != nil {
+		return "", err
+	}
+	if len(appeals) >= MaxAppealsPerCase {
+		return "", errors.New("this case has already been appealed the maximum number of times")
+	}
+	appealUUID, err := q.CreateAppeal(ctx, models.CreateAppealParams{
+		CaseID:   row.ID,
+		UserUuid: userUUID,
+		Message:  message,
+	})
+	if err != nil {
+		return "", err
+	}
+	err = q.SetCaseStatus(ctx, models.SetCaseStatusParams{
+		Status: int16(ms.CaseStatus_CASE_APPEALED),
+		CaseID: row.ID,
+	})
+	if err != nil {
+		return "", err
+	}
+	log.Info().Str("case", caseID).Str("user", userUUID).Msg("appeal-submitted")
+	return appealUUID.String(), nil
+}
+
+// DecideAppeal grants or denies a pending appeal. Granting an appeal lifts
+// the case's actions that are still in effect. Either way the user is
+// notified by email.
+func DecideAppeal(ctx context.Context, us user.Store, dbPool *pgxpool.Pool, q *models.Queries, modUUID string,
+	req *ms.DecideAppealRequest) error {
+
+	auuid, err := uuid.Parse(req.AppealId)
+	if err != nil {
+		return errAppealNotFound
+	}
+	tx, err := dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := q.WithTx(tx)
+
+	appeal, err := qtx.GetAppeal(ctx, auuid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return errAppealNotFound
+	} else if err != nil {
+		return err
+	}
+	if ms.AppealStatus(appeal.Status) != ms.AppealStatus_APPEAL_PENDING {
+		return errors.New("this appeal has already been decided")
+	}
+	actions, err := getCaseActions(ctx, qtx, appeal.CaseID)
+	if err != nil {
+		return err
+	}
+
+	appealStatus := ms.AppealStatus_APPEAL_DENIED
+	caseStatus := ms.CaseStatus_CASE_ACTION_TAKEN
+	if req.Grant {
+		appealStatus = ms.AppealStatus_APPEAL_GRANTED
+		caseStatus = ms.CaseStatus_CASE_OVERTURNED
+	}
+	n, err := qtx.DecideAppeal(ctx, models.DecideAppealParams{
+		Status:       int16(appealStatus),
+		Response:     req.Response,
+		ReviewerUuid: modUUID,
+		AppealID:     appeal.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("this appeal has already been decided")
+	}
+
+	if req.Grant {
+		toRemove := []*ms.ModAction{}
+		for _, action := range actions {
+			if actionInEffect(action) {
+				toRemove = append(toRemove, &ms.ModAction{
+					UserId:        action.UserId,
+					Type:          action.Type,
+					ApplierUserId: modUUID,
+					Note:          "APPEAL GRANTED: " + req.Response,
+				})
+			}
+		}
+		if len(toRemove) > 0 {
+			err = userstore.RemoveActionsTx(ctx, tx, toRemove)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = qtx.SetCaseStatus(ctx, models.SetCaseStatusParams{
+		Status: int16(caseStatus),
+		CaseID: appeal.CaseID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	log.Info().Str("appeal", req.AppealId).Str("mod", modUUID).Bool("granted", req.Grant).Msg("appeal-decided")
+
+	sendAppealDecision(ctx, us, appeal.UserUuid, actions, req.Grant, req.Response)
+	return nil
+}
+
+// actionInEffect returns whether a non-transient action has neither expired
+// nor been removed.
+func actionInEffect(action *ms.ModAction) bool {
+	if _, transient := ModActionDispatching[action.Type]; transient {
+		return false
+	}
+	if action.RemovedTime != nil {
+		return false
+	}
+	return action.EndTime == nil || action.EndTime.AsTime().After(time.Now())
+}
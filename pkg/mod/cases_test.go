@@ -0,0 +1,143 @@
+package mod
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/stores/common"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/stores/user"
+	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
+)
+
+func TestActionInEffect(t *testing.T) {
+	is := is.New(t)
+	now := time.Now()
+	is.True(actionInEffect(&ms.ModAction{Type: ms.ModActionType_SUSPEND_ACCOUNT, StartTime: timestamppb.New(now)}))
+	is.True(actionInEffect(&ms.ModAction{Type: ms.ModActionType_MUTE, StartTime: timestamppb.New(now),
+		EndTime: timestamppb.New(now.Add(time.Hour))}))
+	// expired
+	is.True(!actionInEffect(&ms.ModAction{Type: ms.ModActionType_MUTE, StartTime: timestamppb.New(now.Add(-2 * time.Hour)),
+		EndTime: timestamppb.New(now.Add(-time.Hour))}))
+	// removed
+	is.True(!actionInEffect(&ms.ModAction{Type: ms.ModActionType_SUSPEND_GAMES, StartTime: timestamppb.New(now),
+		RemovedTime: timestamppb.New(now)}))
+	// transient actions can't be lifted
+	is.True(!actionInEffect(&ms.ModAction{Type: ms.ModActionType_RESET_RATINGS, StartTime: timestamppb.New(now)}))
+}
+
+func TestCaseEmails(t *testing.T) {
+	is := is.New(t)
+	start := timestamppb.New(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2026, 10, 8, 0, 0, 0, 0, time.UTC))
+
+	content, _, err := instantiateEmail("Sandbagger", "Suspend Games", "", start, end, ms.EmailType_DEFAULT,
+		"https://woogles.io/appeals/abc")
+	is.NoErr(err)
+	is.True(strings.Contains(content, "submit an appeal at https://woogles.io/appeals/abc."))
+	is.True(!strings.Contains(content, "contact "+AddressToContact))
+
+	content, _, err = instantiateEmail("Sandbagger", "Suspend Games", "", start, end, ms.EmailType_DEFAULT, "")
+	is.NoErr(err)
+	is.True(strings.Contains(content, "would like to appeal, contact "+AddressToContact+"."))
+
+	content, subject, err := instantiateAppealEmail("Sandbagger", "Suspend Games", start, end, true, "Welcome back.")
+	is.NoErr(err)
+	is.Equal(subject, "Woogles Appeal Decision for Account Sandbagger")
+	is.True(strings.Contains(content, "Your appeal has been granted"))
+	is.True(strings.Contains(content, "Welcome back."))
+	is.True(!strings.Contains(content, "End Time"))
+
+	content, _, err = instantiateAppealEmail("Sandbagger", "Suspend Games", start, end, false, "")
+	is.NoErr(err)
+	is.True(strings.Contains(content, "your appeal has been denied"))
+	is.True(strings.Contains(content, "End Time:   Thu Oct  8 00:00:00 UTC 2026"))
+	is.True(!strings.Contains(content, "A note from the moderator"))
+}
+
+func TestResolveAndAppealCase(t *testing.T) {
+	is := is.New(t)
+	testcfg := &config.Config{EmailDebugMode: true}
+	ctx := testcfg.WithContext(context.Background())
+
+	recreateDB()
+	us := userStore()
+	defer us.(*user.DBStore).Disconnect()
+	pool, err := common.OpenTestingDB(pkg)
+	is.NoErr(err)
+	defer pool.Close()
+	q := models.New(pool)
+
+	err = FileReport(ctx, us, nil, q, "Cheater", &ms.FileReportRequest{
+		TargetType: ms.ReportTargetType_REPORT_PLAYER,
+		UserId:     "Sandbagger",
+		Reason:     ms.ReportReason_REASON_SANDBAGGING,
+	})
+	is.NoErr(err)
+	cases, err := q.ListCases(ctx, models.ListCasesParams{
+		Statuses: []int16{int16(ms.CaseStatus_CASE_OPEN)},
+		Lim:      10,
+	})
+	is.NoErr(err)
+	is.Equal(len(cases), 1)
+	caseID := cases[0].Uuid.String()
+
+	// A failed resolution leaves the case open and applies nothing.
+	err = ResolveCase(ctx, us, nil, pool, q, "Moderator", &ms.ResolveCaseRequest{
+		CaseId: caseID,
+		Actions: []*ms.ModAction{
+			{Type: ms.ModActionType_SUSPEND_GAMES, Duration: 3600},
+			{Type: ms.ModActionType_MUTE, Duration: -1},
+		},
+	})
+	is.True(err != nil)
+	row, err := getCaseRow(ctx, q, caseID)
+	is.NoErr(err)
+	is.Equal(ms.CaseStatus(row.Status), ms.CaseStatus_CASE_OPEN)
+	actions, err := us.GetActions(ctx, "Sandbagger")
+	is.NoErr(err)
+	is.Equal(len(actions), 0)
+
+	err = ResolveCase(ctx, us, nil, pool, q, "Moderator", &ms.ResolveCaseRequest{
+		CaseId:  caseID,
+		Note:    "sandbagging",
+		Actions: []*ms.ModAction{{Type: ms.ModActionType_SUSPEND_GAMES, Duration: 3600}},
+	})
+	is.NoErr(err)
+	row, err = getCaseRow(ctx, q, caseID)
+	is.NoErr(err)
+	is.Equal(ms.CaseStatus(row.Status), ms.CaseStatus_CASE_ACTION_TAKEN)
+	actions, err = us.GetActions(ctx, "Sandbagger")
+	is.NoErr(err)
+	is.True(actions[ms.ModActionType_SUSPEND_GAMES.String()] != nil)
+	caseActions, err := getCaseActions(ctx, q, row.ID)
+	is.NoErr(err)
+	is.Equal(len(caseActions), 1)
+
+	err = ResolveCase(ctx, us, nil, pool, q, "Moderator", &ms.ResolveCaseRequest{CaseId: caseID})
+	is.Equal(err, errCaseNotActive)
+
+	appealID, err := SubmitAppeal(ctx, q, "Sandbagger", caseID, "I was just having a bad week, honestly.")
+	is.NoErr(err)
+	err = DecideAppeal(ctx, us, pool, q, "Moderator", &ms.DecideAppealRequest{
+		AppealId: appealID,
+		Grant:    true,
+		Response: "Welcome back.",
+	})
+	is.NoErr(err)
+	row, err = getCaseRow(ctx, q, caseID)
+	is.NoErr(err)
+	is.Equal(ms.CaseStatus(row.Status), ms.CaseStatus_CASE_OVERTURNED)
+	actions, err = us.GetActions(ctx, "Sandbagger")
+	is.NoErr(err)
+	is.Equal(actions[ms.ModActionType_SUSPEND_GAMES.String()], nil)
+
+	err = DecideAppeal(ctx, us, pool, q, "Moderator", &ms.DecideAppealRequest{AppealId: appealID})
+	is.True(err != nil)
+}
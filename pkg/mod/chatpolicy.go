@@ -0,0 +1,421 @@
+package mod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/user"
+	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
+)
+
+// DefaultChatPolicies apply to channel types that moderators haven't
+// configured.
+var DefaultChatPolicies = map[ms.ChatChannelType]*ms.ChatPolicy{
+	ms.ChatChannelType_CHANNEL_LOBBY: {
+		ChannelType:         ms.ChatChannelType_CHANNEL_LOBBY,
+		SlowModeSeconds:     5,
+		FloodMaxMessages:    8,
+		FloodWindowSeconds:  30,
+		FloodAction:         ms.ChatFilterAction_CHAT_MUTE,
+		BlockLinksDays:      7,
+		BlocklistAction:     ms.ChatFilterAction_CHAT_REJECT,
+		MuteDurationSeconds: 60 * 60,
+	},
+	ms.ChatChannelType_CHANNEL_TOURNAMENT: {
+		ChannelType:         ms.ChatChannelType_CHANNEL_TOURNAMENT,
+		SlowModeSeconds:     5,
+		FloodMaxMessages:    8,
+		FloodWindowSeconds:  30,
+		FloodAction:         ms.ChatFilterAction_CHAT_MUTE,
+		BlockLinksDays:      7,
+		BlocklistAction:     ms.ChatFilterAction_CHAT_REJECT,
+		MuteDurationSeconds: 60 * 60,
+	},
+	ms.ChatChannelType_CHANNEL_GAME: {
+		ChannelType:         ms.ChatChannelType_CHANNEL_GAME,
+		FloodMaxMessages:    20,
+		FloodWindowSeconds:  30,
+		FloodAction:         ms.ChatFilterAction_CHAT_REJECT,
+		BlocklistAction:     ms.ChatFilterAction_CHAT_REJECT,
+		MuteDurationSeconds: 60 * 60,
+	},
+	ms.ChatChannelType_CHANNEL_PM: {
+		ChannelType:         ms.ChatChannelType_CHANNEL_PM,
+		FloodMaxMessages:    20,
+		FloodWindowSeconds:  30,
+		FloodAction:         ms.ChatFilterAction_CHAT_REJECT,
+		BlocklistAction:     ms.ChatFilterAction_CHAT_REJECT,
+		MuteDurationSeconds: 60 * 60,
+	},
+}
+
+// ChatPolicyCacheTTL is how long policies and blocklists are cached before
+// they are reloaded from the database.
+var ChatPolicyCacheTTL = time.Minute
+
+// DefaultChatMuteDuration is used when a policy doesn't set a mute duration,
+// so that automatic mutes are never permanent.
+var DefaultChatMuteDuration = 60 * 60
+
+const MaxBlocklistPatternLength = 200
+
+var linkRegex = regexp.MustCompile(`(?i)(https?://|www\.|\b[a-z0-9-]+\.(com|net|org|io|gg|ly|me|co|xyz|ru|info|biz|app|link|club|site)\b)`)
+
+var (
+	errInvalidChannelType = errors.New("invalid channel type")
+	errInvalidChatAction  = errors.New("invalid chat filter action")
+)
+
+// ChatViolation is a message that breaks a channel's chat policy.
+type ChatViolation struct {
+	Action ms.ChatFilterAction
+	Reason string
+}
+
+type blocklistPattern struct {
+	language string
+	re       *regexp.Regexp
+}
+
+// ChatFilter checks chat messages against the chat policies. It caches the
+// policies and blocklists for ChatPolicyCacheTTL.
+type ChatFilter struct {
+	sync.RWMutex
+	queries *models.Queries
+
+	policies  map[ms.ChatChannelType]*ms.ChatPolicy
+	blocklist []*blocklistPattern
+	loadedAt  time.Time
+}
+
+func NewChatFilter(q *models.Queries) *ChatFilter {
+	return &ChatFilter{queries: q}
+}
+
+// Invalidate makes the next check reload the policies and blocklists.
+func (f *ChatFilter) Invalidate() {
+	f.Lock()
+	defer f.Unlock()
+	f.loadedAt = time.Time{}
+}
+
+func (f *ChatFilter) load(ctx context.Context) error {
+	f.RLock()
+	fresh := time.Since(f.loadedAt) < ChatPolicyCacheTTL
+	f.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	policies := map[ms.ChatChannelType]*ms.ChatPolicy{}
+	for ct, p := range DefaultChatPolicies {
+		policies[ct] = p
+	}
+	rows, err := f.queries.GetChatPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range rows {
+		p := chatPolicyRowToProto(r)
+		policies[p.ChannelType] = p
+	}
+
+	entries, err := f.queries.GetChatBlocklist(ctx)
+	if err != nil {
+		return err
+	}
+	blocklist := make([]*blocklistPattern, 0, len(entries))
+	for _, e := range entries {
+		re, err := compileBlocklistPattern(e.Pattern, e.IsRegex)
+		if err != nil {
+			// Patterns are validated when they're added, so this shouldn't
+			// happen; don't let one bad pattern disable the whole list.
+			log.Err(err).Int64("id", e.ID).Msg("bad-blocklist-pattern")
+			continue
+		}
+		blocklist = append(blocklist, &blocklistPattern{language: e.Language, re: re})
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	f.policies = policies
+	f.blocklist = blocklist
+	f.loadedAt = time.Now()
+	return nil
+}
+
+// Policy returns the chat policy for a channel type.
+func (f *ChatFilter) Policy(ctx context.Context, ct ms.ChatChannelType) (*ms.ChatPolicy, error) {
+	if err := f.load(ctx); err != nil {
+		return nil, err
+	}
+	f.RLock()
+	defer f.RUnlock()
+	p, ok := f.policies[ct]
+	if !ok {
+		return nil, errInvalidChannelType
+	}
+	return p, nil
+}
+
+// Policies returns the chat policies of all channel types.
+func (f *ChatFilter) Policies(ctx context.Context) ([]*ms.ChatPolicy, error) {
+	policies := []*ms.ChatPolicy{}
+	for i := range ms.ChatChannelType_name {
+		p, err := f.Policy(ctx, ms.ChatChannelType(i))
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].ChannelType < policies[j].ChannelType })
+	return policies, nil
+}
+
+// CheckMessage returns the first way msg breaks the policy, or nil if it
+// doesn't.
+func (f *ChatFilter) CheckMessage(ctx context.Context, policy *ms.ChatPolicy, userUUID, msg string) (*ChatViolation, error) {
+	if err := f.load(ctx); err != nil {
+		return nil, err
+	}
+	f.RLock()
+	blocked := matchesBlocklist(f.blocklist, policy.BlocklistLanguages, msg)
+	f.RUnlock()
+	if blocked {
+		return &ChatViolation{
+			Action: policy.BlocklistAction,
+			Reason: "your message contains language that is not allowed in this channel",
+		}, nil
+	}
+
+	if policy.BlockLinksDays > 0 && linkRegex.MatchString(msg) {
+		createdAt, err := f.queries.GetUserCreatedAt(ctx, userUUID)
+		if err != nil {
+			return nil, err
+		}
+		if time.Since(createdAt.Time) < time.Duration(policy.BlockLinksDays)*24*time.Hour {
+			return &ChatViolation{
+				Action: ms.ChatFilterAction_CHAT_REJECT,
+				Reason: "new accounts cannot post links in this channel",
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+func matchesBlocklist(blocklist []*blocklistPattern, languages []string, msg string) bool {
+	for _, b := range blocklist {
+		if len(languages) > 0 && !slices.Contains(languages, b.language) {
+			continue
+		}
+		if b.re.MatchString(msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// compileBlocklistPattern compiles a case-insensitive pattern. Plain words
+// only match whole words, so that blocking a word doesn't block every word
+// that contains it.
+func compileBlocklistPattern(pattern string, isRegex bool) (*regexp.Regexp, error) {
+	if isRegex {
+		return regexp.Compile("(?i)" + pattern)
+	}
+	return regexp.Compile(`(?i)(^|[^\p{L}\p{N}])` + regexp.QuoteMeta(pattern) + `($|[^\p{L}\p{N}])`)
+}
+
+// ChatChannelType returns the policy type of a chat channel.
+func ChatChannelType(channel string) ms.ChatChannelType {
+	switch {
+	case strings.HasPrefix(channel, "chat.pm."):
+		return ms.ChatChannelType_CHANNEL_PM
+	case strings.HasPrefix(channel, "chat.tournament."), strings.HasPrefix(channel, "chat.league."):
+		return ms.ChatChannelType_CHANNEL_TOURNAMENT
+	case strings.HasPrefix(channel, "chat.game."), strings.HasPrefix(channel, "chat.gametv."):
+		return ms.ChatChannelType_CHANNEL_GAME
+	default:
+		return ms.ChatChannelType_CHANNEL_LOBBY
+	}
+}
+
+// ChatLimits returns the rate limits the chat store enforces for a policy.
+func ChatLimits(policy *ms.ChatPolicy) user.ChatLimits {
+	return user.ChatLimits{
+		SlowModeSeconds:    int(policy.SlowModeSeconds),
+		FloodMaxMessages:   int(policy.FloodMaxMessages),
+		FloodWindowSeconds: int(policy.FloodWindowSeconds),
+	}
+}
+
+// IsShadowMuted returns whether the messages of a user with these current
+// actions should only be shown to themselves.
+func IsShadowMuted(currentActions map[string]*ms.ModAction) bool {
+	_, ok := currentActions[ms.ModActionType_SHADOW_MUTE.String()]
+	return ok
+}
+
+// EnforceChatAction applies the mod action that goes with a chat filter
+// action. Rejecting a message doesn't need a mod action.
+func EnforceChatAction(ctx context.Context, us user.Store, cs user.ChatStore, userUUID string,
+	policy *ms.ChatPolicy, action ms.ChatFilterAction, reason string) error {
+
+	var actionType ms.ModActionType
+	switch action {
+	case ms.ChatFilterAction_CHAT_MUTE:
+		actionType = ms.ModActionType_MUTE
+	case ms.ChatFilterAction_CHAT_SHADOW_MUTE:
+		actionType = ms.ModActionType_SHADOW_MUTE
+	default:
+		return nil
+	}
+	duration := policy.MuteDurationSeconds
+	if duration <= 0 {
+		duration = int32(DefaultChatMuteDuration)
+	}
+	return ApplyActions(ctx, us, cs, AutomodUserId, []*ms.ModAction{{
+		UserId:   userUUID,
+		Type:     actionType,
+		Duration: duration,
+		Note:     fmt.Sprintf("AUTOMOD: %s (%s)", reason, policy.ChannelType),
+	}})
+}
+
+func chatPolicyRowToProto(r models.GetChatPoliciesRow) *ms.ChatPolicy {
+	return &ms.ChatPolicy{
+		ChannelType:         ms.ChatChannelType(r.ChannelType),
+		SlowModeSeconds:     r.SlowModeSeconds,
+		FloodMaxMessages:    r.FloodMaxMessages,
+		FloodWindowSeconds:  r.FloodWindowSeconds,
+		FloodAction:         ms.ChatFilterAction(r.FloodAction),
+		BlockLinksDays:      r.BlockLinksDays,
+		BlocklistLanguages:  r.BlocklistLanguages,
+		BlocklistAction:     ms.ChatFilterAction(r.BlocklistAction),
+		MuteDurationSeconds: r.MuteDurationSeconds,
+	}
+}
+
+func validateChatPolicy(p *ms.ChatPolicy) error {
+	if _, ok := ms.ChatChannelType_name[int32(p.ChannelType)]; !ok {
+		return errInvalidChannelType
+	}
+	for _, a := range []ms.ChatFilterAction{p.FloodAction, p.BlocklistAction} {
+		if _, ok := ms.ChatFilterAction_name[int32(a)]; !ok {
+			return errInvalidChatAction
+		}
+	}
+	if p.SlowModeSeconds < 0 || p.FloodMaxMessages < 0 || p.FloodWindowSeconds < 0 ||
+		p.BlockLinksDays < 0 || p.MuteDurationSeconds < 0 {
+		return errors.New("chat policy values cannot be negative")
+	}
+	if p.FloodMaxMessages > 0 && p.FloodWindowSeconds == 0 {
+		return errors.New("flood detection needs a time window")
+	}
+	for i, l := range p.BlocklistLanguages {
+		p.BlocklistLanguages[i] = strings.ToLower(strings.TrimSpace(l))
+	}
+	return nil
+}
+
+// SetChatPolicy saves a chat policy, replacing the policy for its channel
+// type.
+func SetChatPolicy(ctx context.Context, q *models.Queries, f *ChatFilter, modUUID string, p *ms.ChatPolicy) error {
+	if err := validateChatPolicy(p); err != nil {
+		return err
+	}
+	languages := p.BlocklistLanguages
+	if languages == nil {
+		languages = []string{}
+	}
+	err := q.UpsertChatPolicy(ctx, models.UpsertChatPolicyParams{
+		ChannelType:         int16(p.ChannelType),
+		SlowModeSeconds:     p.SlowModeSeconds,
+		FloodMaxMessages:    p.FloodMaxMessages,
+		FloodWindowSeconds:  p.FloodWindowSeconds,
+		FloodAction:         int16(p.FloodAction),
+		BlockLinksDays:      p.BlockLinksDays,
+		BlocklistLanguages:  languages,
+		BlocklistAction:     int16(p.BlocklistAction),
+		MuteDurationSeconds: p.MuteDurationSeconds,
+		UpdatedByUuid:       modUUID,
+	})
+	if err != nil {
+		return err
+	}
+	f.Invalidate()
+	return nil
+}
+
+// GetChatBlocklist returns the blocklist entries of a language, or of all
+// languages if language is empty.
+func GetChatBlocklist(ctx context.Context, q *models.Queries, language string) ([]*ms.ChatBlocklistEntry, error) {
+	rows, err := q.GetChatBlocklist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	language = strings.ToLower(strings.TrimSpace(language))
+	entries := []*ms.ChatBlocklistEntry{}
+	for _, r := range rows {
+		if language != "" && r.Language != language {
+			continue
+		}
+		entries = append(entries, &ms.ChatBlocklistEntry{
+			Id:       r.ID,
+			Language: r.Language,
+			Pattern:  r.Pattern,
+			IsRegex:  r.IsRegex,
+		})
+	}
+	return entries, nil
+}
+
+// AddChatBlocklistEntry adds a word or regular expression to a language's
+// blocklist.
+func AddChatBlocklistEntry(ctx context.Context, q *models.Queries, f *ChatFilter, modUUID string,
+	e *ms.ChatBlocklistEntry) (int64, error) {
+
+	language := strings.ToLower(strings.TrimSpace(e.Language))
+	pattern := strings.TrimSpace(e.Pattern)
+	if language == "" {
+		return 0, errors.New("a blocklist entry needs a language")
+	}
+	if pattern == "" || len(pattern) > MaxBlocklistPatternLength {
+		return 0, fmt.Errorf("a blocklist pattern must be between 1 and %d characters", MaxBlocklistPatternLength)
+	}
+	if _, err := compileBlocklistPattern(pattern, e.IsRegex); err != nil {
+		return 0, fmt.Errorf("invalid regular expression: %w", err)
+	}
+	id, err := q.AddChatBlocklistEntry(ctx, models.AddChatBlocklistEntryParams{
+		Language:      language,
+		Pattern:       pattern,
+		IsRegex:       e.IsRegex,
+		CreatedByUuid: modUUID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	f.Invalidate()
+	return id, nil
+}
+
+func RemoveChatBlocklistEntry(ctx context.Context, q *models.Queries, f *ChatFilter, id int64) error {
+	n, err := q.RemoveChatBlocklistEntry(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("blocklist entry not found")
+	}
+	f.Invalidate()
+	return nil
+}
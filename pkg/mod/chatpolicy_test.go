@@ -0,0 +1,91 @@
+package mod
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/user"
+	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
+)
+
+func TestChatChannelType(t *testing.T) {
+	is := is.New(t)
+	is.Equal(ChatChannelType("chat.lobby"), ms.ChatChannelType_CHANNEL_LOBBY)
+	is.Equal(ChatChannelType("chat.pm.abc_def"), ms.ChatChannelType_CHANNEL_PM)
+	is.Equal(ChatChannelType("chat.tournament.weto"), ms.ChatChannelType_CHANNEL_TOURNAMENT)
+	is.Equal(ChatChannelType("chat.league.1234"), ms.ChatChannelType_CHANNEL_TOURNAMENT)
+	is.Equal(ChatChannelType("chat.game.abcdef"), ms.ChatChannelType_CHANNEL_GAME)
+	is.Equal(ChatChannelType("chat.gametv.abcdef"), ms.ChatChannelType_CHANNEL_GAME)
+}
+
+func TestIsShadowMuted(t *testing.T) {
+	is := is.New(t)
+	is.True(!IsShadowMuted(nil))
+	is.True(!IsShadowMuted(map[string]*ms.ModAction{
+		ms.ModActionType_MUTE.String(): {Type: ms.ModActionType_MUTE},
+	}))
+	is.True(IsShadowMuted(map[string]*ms.ModAction{
+		ms.ModActionType_MUTE.String():        {Type: ms.ModActionType_MUTE},
+		ms.ModActionType_SHADOW_MUTE.String(): {Type: ms.ModActionType_SHADOW_MUTE},
+	}))
+}
+
+func TestBlocklist(t *testing.T) {
+	is := is.New(t)
+	mustCompile := func(language, pattern string, isRegex bool) *blocklistPattern {
+		re, err := compileBlocklistPattern(pattern, isRegex)
+		is.NoErr(err)
+		return &blocklistPattern{language: language, re: re}
+	}
+	blocklist := []*blocklistPattern{
+		mustCompile("en", "darn", false),
+		mustCompile("es", "caramba", false),
+		mustCompile("en", `fr[e3]+b`, true),
+		mustCompile("en", "a.b", false),
+	}
+
+	is.True(matchesBlocklist(blocklist, nil, "well DARN it"))
+	is.True(matchesBlocklist(blocklist, nil, "darn!"))
+	// Whole words only.
+	is.True(!matchesBlocklist(blocklist, nil, "darnedest"))
+	is.True(matchesBlocklist(blocklist, nil, "you fr33b"))
+	// Plain patterns aren't regular expressions.
+	is.True(!matchesBlocklist(blocklist, nil, "axb"))
+	is.True(matchesBlocklist(blocklist, nil, "a.b"))
+
+	// Only the policy's languages apply.
+	is.True(matchesBlocklist(blocklist, nil, "ay caramba"))
+	is.True(!matchesBlocklist(blocklist, []string{"en"}, "ay caramba"))
+	is.True(matchesBlocklist(blocklist, []string{"en", "es"}, "ay caramba"))
+
+	_, err := compileBlocklistPattern("(unclosed", true)
+	is.True(err != nil)
+}
+
+func TestLinkRegex(t *testing.T) {
+	is := is.New(t)
+	is.True(linkRegex.MatchString("check out https://example.org/foo"))
+	is.True(linkRegex.MatchString("go to www.example.de"))
+	is.True(linkRegex.MatchString("visit spam.xyz now"))
+	is.True(!linkRegex.MatchString("nice bingo, well played"))
+	is.True(!linkRegex.MatchString("i played QI... then ZA."))
+}
+
+func TestValidateChatPolicy(t *testing.T) {
+	is := is.New(t)
+	p := &ms.ChatPolicy{
+		ChannelType:        ms.ChatChannelType_CHANNEL_GAME,
+		FloodMaxMessages:   5,
+		FloodWindowSeconds: 10,
+		BlocklistLanguages: []string{" EN "},
+	}
+	is.NoErr(validateChatPolicy(p))
+	is.Equal(p.BlocklistLanguages, []string{"en"})
+	is.Equal(ChatLimits(p), user.ChatLimits{FloodMaxMessages: 5, FloodWindowSeconds: 10})
+
+	is.True(validateChatPolicy(&ms.ChatPolicy{ChannelType: 17}) != nil)
+	is.True(validateChatPolicy(&ms.ChatPolicy{FloodAction: 9}) != nil)
+	is.True(validateChatPolicy(&ms.ChatPolicy{SlowModeSeconds: -1}) != nil)
+	is.True(validateChatPolicy(&ms.ChatPolicy{FloodMaxMessages: 3}) != nil)
+}
@@ -0,0 +1,372 @@
+package mod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	macondo "github.com/domino14/macondo/gen/api/proto/macondo"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/user"
+	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
+)
+
+// Cheat detection looks at a rolling window of a player's most recent
+// analyzed rated games and compares them to all players within a rating band.
+// None of these signals is proof of anything on its own, so an outlier is
+// only flagged for moderator review and never punished automatically.
+var (
+	CheatSignalWindow = 20
+	// CheatSignalMinGames is the number of analyzed games needed before a
+	// player can be flagged.
+	CheatSignalMinGames = 8
+	// CheatSignalMinTurns is the number of turns a game needs to count.
+	CheatSignalMinTurns      = 5
+	CheatSignalRatingBand    = 100.0
+	CheatSignalBaselineDays  = 180
+	CheatSignalBaselineGames = 50
+	// A window mean this many standard errors better than the baseline is
+	// an outlier.
+	CheatSignalZScoreThreshold = 3.5
+	// Playing this many points above the rating is an outlier.
+	CheatSignalRatingGapThreshold = 400.0
+	// Think times that vary less than this (relative to their mean) look
+	// machine-like.
+	CheatSignalMoveTimeCVThreshold = 0.25
+	CheatSignalMinTimedMoves       = 5
+	// CheatSignalMinReasons is how many signals have to agree to flag a player.
+	CheatSignalMinReasons = 2
+)
+
+// playerCheatSignals are the signals for one player in one game.
+type playerCheatSignals struct {
+	userUUID     string
+	rating       float64
+	turns        int32
+	optimalMoves int32
+	mistakeIndex float64
+	estimatedElo float64
+	moveTimeCV   float64
+}
+
+// cheatBaseline summarizes the games of all players within a rating band.
+type cheatBaseline struct {
+	games              int64
+	meanAgreement      float64
+	stddevAgreement    float64
+	meanMistakeIndex   float64
+	stddevMistakeIndex float64
+}
+
+// RecordCheatSignals stores the cheat detection signals of both players of an
+// analyzed game and flags any player whose recent games are outliers.
+// Unrated and bot games are ignored.
+func RecordCheatSignals(ctx context.Context, us user.Store, ns NotorietyStore, q *models.Queries,
+	g *entity.Game, result *macondo.GameAnalysisResult) error {
+
+	if g.GameReq == nil || g.GameReq.RatingMode != ipc.RatingMode_RATED {
+		return nil
+	}
+	signals, err := gameCheatSignals(g, result)
+	if err != nil {
+		return err
+	}
+	for _, s := range signals {
+		u, err := us.GetByUUID(ctx, s.userUUID)
+		if err != nil {
+			return err
+		}
+		if u.IsBot {
+			continue
+		}
+		err = q.UpsertGameCheatSignals(ctx, models.UpsertGameCheatSignalsParams{
+			GameUuid:     g.GameID(),
+			UserUuid:     s.userUUID,
+			Rating:       s.rating,
+			Turns:        s.turns,
+			OptimalMoves: s.optimalMoves,
+			MistakeIndex: s.mistakeIndex,
+			EstimatedElo: s.estimatedElo,
+			MoveTimeCv:   s.moveTimeCV,
+		})
+		if err != nil {
+			return err
+		}
+		err = checkCheatSignals(ctx, ns, q, u, g.GameID())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gameCheatSignals computes the signals of both players. Players with too
+// few turns are left out.
+func gameCheatSignals(g *entity.Game, result *macondo.GameAnalysisResult) ([]*playerCheatSignals, error) {
+	history := g.History()
+	if len(result.PlayerSummaries) != 2 || len(history.Players) != 2 {
+		return nil, errors.New("expected two players")
+	}
+	if len(g.Quickdata.OriginalRatings) != 2 {
+		return nil, errors.New("game has no ratings")
+	}
+	// Correspondence players move whenever they get around to it, so their
+	// think times say nothing.
+	timed := g.GameReq.GameMode != ipc.GameMode_CORRESPONDENCE
+	signals := []*playerCheatSignals{}
+	for pidx, summary := range result.PlayerSummaries {
+		if summary.TurnsPlayed < int32(CheatSignalMinTurns) {
+			continue
+		}
+		s := &playerCheatSignals{
+			userUUID:     history.Players[pidx].UserId,
+			rating:       g.Quickdata.OriginalRatings[pidx],
+			turns:        summary.TurnsPlayed,
+			optimalMoves: summary.OptimalMoves,
+			mistakeIndex: summary.MistakeIndex,
+			estimatedElo: summary.EstimatedElo,
+		}
+		if timed {
+			s.moveTimeCV = moveTimeCV(history, pidx, 1000*g.GameReq.InitialTimeSeconds,
+				1000*g.GameReq.IncrementSeconds)
+		}
+		signals = append(signals, s)
+	}
+	return signals, nil
+}
+
+// moveTimeCV returns the coefficient of variation of a player's think times,
+// or 0 if the player didn't make enough timed moves.
+func moveTimeCV(history *macondo.GameHistory, pidx int, initialMillis, incrementMillis int32) float64 {
+	remaining := initialMillis
+	thinkTimes := []float64{}
+	for _, evt := range history.Events {
+		if evt.PlayerIndex != uint32(pidx) {
+			continue
+		}
+		switch evt.Type {
+		case macondo.GameEvent_TILE_PLACEMENT_MOVE, macondo.GameEvent_EXCHANGE:
+			think := remaining - evt.MillisRemaining + incrementMillis
+			if think > 0 {
+				thinkTimes = append(thinkTimes, float64(think))
+			}
+		case macondo.GameEvent_PASS, macondo.GameEvent_UNSUCCESSFUL_CHALLENGE_TURN_LOSS:
+		default:
+			continue
+		}
+		remaining = evt.MillisRemaining
+	}
+	if len(thinkTimes) < CheatSignalMinTimedMoves {
+		return 0
+	}
+	mean, stddev := meanStddev(thinkTimes)
+	if mean == 0 {
+		return 0
+	}
+	return stddev / mean
+}
+
+func meanStddev(xs []float64) (float64, float64) {
+	if len(xs) == 0 {
+		return 0, 0
+	}
+	sum := 0.0
+	for _, x := range xs {
+		sum += x
+	}
+	mean := sum / float64(len(xs))
+	if len(xs) == 1 {
+		return mean, 0
+	}
+	ss := 0.0
+	for _, x := range xs {
+		ss += (x - mean) * (x - mean)
+	}
+	return mean, math.Sqrt(ss / float64(len(xs)-1))
+}
+
+// checkCheatSignals flags the user if their current window of games is an
+// outlier and they haven't already been flagged within that window.
+func checkCheatSignals(ctx context.Context, ns NotorietyStore, q *models.Queries,
+	u *entity.User, gameID string) error {
+
+	report, err := GetCheatSignalReport(ctx, q, u.UUID)
+	if err != nil {
+		return err
+	}
+	if !report.Outlier {
+		return nil
+	}
+	for _, g := range report.Games {
+		if g.Flagged {
+			return nil
+		}
+	}
+	return flagCheatSignals(ctx, ns, q, u, gameID, report)
+}
+
+// flagCheatSignals marks the game as notorious and adds it, along with the
+// report, to the user's open moderation case.
+func flagCheatSignals(ctx context.Context, ns NotorietyStore, q *models.Queries, u *entity.User,
+	gameID string, report *ms.CheatSignalReport) error {
+
+	err := q.FlagGameCheatSignals(ctx, models.FlagGameCheatSignalsParams{
+		GameUuid: gameID,
+		UserUuid: u.UUID,
+	})
+	if err != nil {
+		return err
+	}
+	// Engine correlation has no notoriety score, so this never suspends
+	// anyone by itself. It only shows up in the notoriety report.
+	if ns != nil {
+		err = ns.AddNotoriousGame(ctx, u.UUID, gameID, int(ms.NotoriousGameType_ENGINE_CORRELATION), notoriousGameTimestamp())
+		if err != nil {
+			return err
+		}
+	}
+	modCase, err := q.OpenCaseForSubject(ctx, u.UUID)
+	if err != nil {
+		return err
+	}
+	err = q.AddCaseEvidence(ctx, models.AddCaseEvidenceParams{
+		CaseID:       modCase.ID,
+		EvidenceType: int16(ms.EvidenceType_EVIDENCE_GAME),
+		GameID:       gameID,
+		Note:         formatCheatSignalReport(report),
+	})
+	if err != nil {
+		return err
+	}
+	log.Info().Str("username", u.Username).Str("game_id", gameID).
+		Strs("reasons", report.Reasons).Msg("flagged-cheat-signals")
+	return nil
+}
+
+// GetCheatSignalReport summarizes the user's most recent analyzed rated games.
+func GetCheatSignalReport(ctx context.Context, q *models.Queries, userUUID string) (*ms.CheatSignalReport, error) {
+	rows, err := q.GetRecentCheatSignals(ctx, models.GetRecentCheatSignalsParams{
+		UserUuid: userUUID,
+		Lim:      int32(CheatSignalWindow),
+	})
+	if err != nil {
+		return nil, err
+	}
+	games := make([]*ms.GameCheatSignals, len(rows))
+	meanRating := 0.0
+	for i, r := range rows {
+		games[i] = &ms.GameCheatSignals{
+			GameId:          r.GameUuid,
+			Turns:           r.Turns,
+			MistakeIndex:    r.MistakeIndex,
+			EstimatedRating: r.EstimatedElo,
+			Rating:          r.Rating,
+			MoveTimeCv:      r.MoveTimeCv,
+			Flagged:         r.Flagged,
+			CreatedAt:       timestamppb.New(r.CreatedAt.Time),
+		}
+		if r.Turns > 0 {
+			games[i].EngineAgreement = float64(r.OptimalMoves) / float64(r.Turns)
+		}
+		meanRating += r.Rating / float64(len(rows))
+	}
+
+	baseline := &cheatBaseline{}
+	if len(rows) > 0 {
+		b, err := q.GetCheatSignalBaseline(ctx, models.GetCheatSignalBaselineParams{
+			MinRating: meanRating - CheatSignalRatingBand,
+			MaxRating: meanRating + CheatSignalRatingBand,
+			Since: pgtype.Timestamptz{
+				Time:  time.Now().AddDate(0, 0, -CheatSignalBaselineDays),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		baseline = &cheatBaseline{
+			games:              b.Games,
+			meanAgreement:      b.MeanAgreement,
+			stddevAgreement:    b.StddevAgreement,
+			meanMistakeIndex:   b.MeanMistakeIndex,
+			stddevMistakeIndex: b.StddevMistakeIndex,
+		}
+	}
+	report := evaluateCheatSignals(games, baseline)
+	report.UserId = userUUID
+	return report, nil
+}
+
+// evaluateCheatSignals computes the window statistics and decides whether
+// they are an outlier. Each signal that is out of line adds a reason; it
+// takes CheatSignalMinReasons reasons to be an outlier.
+func evaluateCheatSignals(games []*ms.GameCheatSignals, baseline *cheatBaseline) *ms.CheatSignalReport {
+	report := &ms.CheatSignalReport{
+		GamesAnalyzed: int32(len(games)),
+		BaselineGames: int32(baseline.games),
+		Games:         games,
+		Reasons:       []string{},
+	}
+	if len(games) == 0 {
+		return report
+	}
+	agreements := []float64{}
+	mistakeIndexes := []float64{}
+	timeCVs := []float64{}
+	ratingGap := 0.0
+	for _, g := range games {
+		agreements = append(agreements, g.EngineAgreement)
+		mistakeIndexes = append(mistakeIndexes, g.MistakeIndex)
+		if g.MoveTimeCv > 0 {
+			timeCVs = append(timeCVs, g.MoveTimeCv)
+		}
+		ratingGap += (g.EstimatedRating - g.Rating) / float64(len(games))
+	}
+	report.EngineAgreement, _ = meanStddev(agreements)
+	report.MistakeIndex, _ = meanStddev(mistakeIndexes)
+	report.MoveTimeCv, _ = meanStddev(timeCVs)
+	report.RatingGap = ratingGap
+
+	n := math.Sqrt(float64(len(games)))
+	if baseline.games >= int64(CheatSignalBaselineGames) {
+		if baseline.stddevAgreement > 0 {
+			report.AgreementZscore = (report.EngineAgreement - baseline.meanAgreement) / (baseline.stddevAgreement / n)
+		}
+		if baseline.stddevMistakeIndex > 0 {
+			report.AccuracyZscore = (baseline.meanMistakeIndex - report.MistakeIndex) / (baseline.stddevMistakeIndex / n)
+		}
+	}
+
+	if report.AgreementZscore >= CheatSignalZScoreThreshold {
+		report.Reasons = append(report.Reasons, fmt.Sprintf("engine agreement %.1f%% is %.1f standard errors above similarly rated players",
+			100*report.EngineAgreement, report.AgreementZscore))
+	}
+	if report.AccuracyZscore >= CheatSignalZScoreThreshold {
+		report.Reasons = append(report.Reasons, fmt.Sprintf("mistake index %.2f is %.1f standard errors below similarly rated players",
+			report.MistakeIndex, report.AccuracyZscore))
+	}
+	if report.RatingGap >= CheatSignalRatingGapThreshold {
+		report.Reasons = append(report.Reasons, fmt.Sprintf("plays %.0f points above their rating", report.RatingGap))
+	}
+	if len(timeCVs) >= CheatSignalMinGames && report.MoveTimeCv < CheatSignalMoveTimeCVThreshold {
+		report.Reasons = append(report.Reasons, fmt.Sprintf("think times are unusually uniform (CV %.2f)", report.MoveTimeCv))
+	}
+	report.Outlier = len(games) >= CheatSignalMinGames && len(report.Reasons) >= CheatSignalMinReasons
+	return report
+}
+
+func formatCheatSignalReport(report *ms.CheatSignalReport) string {
+	return fmt.Sprintf("%s: %s (last %d analyzed rated games)",
+		BehaviorToString[ms.NotoriousGameType_ENGINE_CORRELATION],
+		strings.Join(report.Reasons, "; "), report.GamesAnalyzed)
+}
@@ -0,0 +1,91 @@
+package mod
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	macondo "github.com/domino14/macondo/gen/api/proto/macondo"
+
+	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
+)
+
+func timedHistory(thinkMillis []int32) *macondo.GameHistory {
+	history := &macondo.GameHistory{}
+	remaining := int32(1500000)
+	for _, t := range thinkMillis {
+		remaining -= t
+		history.Events = append(history.Events,
+			&macondo.GameEvent{PlayerIndex: 0, Type: macondo.GameEvent_TILE_PLACEMENT_MOVE, MillisRemaining: remaining},
+			&macondo.GameEvent{PlayerIndex: 1, Type: macondo.GameEvent_TILE_PLACEMENT_MOVE, MillisRemaining: 1000000})
+	}
+	return history
+}
+
+func TestMoveTimeCV(t *testing.T) {
+	is := is.New(t)
+
+	uniform := timedHistory([]int32{10000, 10000, 10000, 10000, 10000, 10000})
+	is.Equal(moveTimeCV(uniform, 0, 1500000, 0), 0.0)
+
+	varied := timedHistory([]int32{2000, 30000, 5000, 60000, 8000, 15000})
+	is.True(moveTimeCV(varied, 0, 1500000, 0) > 0.8)
+
+	// Too few moves to say anything.
+	short := timedHistory([]int32{2000, 30000, 5000})
+	is.Equal(moveTimeCV(short, 0, 1500000, 0), 0.0)
+
+	// Increments are added back to the think time.
+	incremented := timedHistory([]int32{-4000, -1000, -4500, -500, -3000, -2000})
+	is.Equal(moveTimeCV(incremented, 0, 1500000, 0), 0.0)
+	is.True(moveTimeCV(incremented, 0, 1500000, 5000) > 0.3)
+}
+
+func cheatGames(n int, agreement, mistakeIndex, rating, estimated, timeCV float64) []*ms.GameCheatSignals {
+	games := []*ms.GameCheatSignals{}
+	for i := 0; i < n; i++ {
+		games = append(games, &ms.GameCheatSignals{
+			EngineAgreement: agreement,
+			MistakeIndex:    mistakeIndex,
+			Rating:          rating,
+			EstimatedRating: estimated,
+			MoveTimeCv:      timeCV,
+		})
+	}
+	return games
+}
+
+func TestEvaluateCheatSignals(t *testing.T) {
+	is := is.New(t)
+	baseline := &cheatBaseline{
+		games:              1000,
+		meanAgreement:      0.35,
+		stddevAgreement:    0.1,
+		meanMistakeIndex:   8,
+		stddevMistakeIndex: 4,
+	}
+
+	report := evaluateCheatSignals(cheatGames(20, 0.37, 7.5, 1500, 1550, 0.9), baseline)
+	is.True(!report.Outlier)
+	is.Equal(len(report.Reasons), 0)
+
+	report = evaluateCheatSignals(cheatGames(20, 0.7, 1, 1500, 2100, 0.9), baseline)
+	is.True(report.Outlier)
+	is.Equal(len(report.Reasons), 3)
+	is.True(report.AgreementZscore > 15)
+
+	// A single signal is not enough.
+	report = evaluateCheatSignals(cheatGames(20, 0.35, 8, 1500, 1500, 0.1), baseline)
+	is.True(!report.Outlier)
+	is.Equal(len(report.Reasons), 1)
+
+	// Too few games.
+	report = evaluateCheatSignals(cheatGames(5, 0.7, 1, 1500, 2100, 0.1), baseline)
+	is.True(!report.Outlier)
+
+	// Without enough games to compare against only the rating gap and
+	// timing can be used.
+	report = evaluateCheatSignals(cheatGames(20, 0.7, 1, 1500, 1500, 0.9), &cheatBaseline{games: 10})
+	is.Equal(report.AgreementZscore, 0.0)
+	is.True(!report.Outlier)
+}
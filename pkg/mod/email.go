@@ -20,15 +20,26 @@ type EmailInfo struct {
 	AddressToContact  string
 	IsCheater         bool
 	IsDeletion        bool
+	// AppealURL is set for actions that resolved a moderation case; the
+	// user can appeal through the site instead of by email.
+	AppealURL string
+	// Only used by the appeal decision email.
+	AppealGranted bool
+	Response      string
 }
 
 const TermsOfServiceURL = "https://woogles.io/terms"
 const AddressToContact = "conduct@woogles.io"
 const EmailTemplateName = "email"
+const AppealEmailTemplateName = "appeal_email"
+const AppealURLFormat = "https://woogles.io/appeals/%s"
 
 //go:embed email_template
 var EmailTemplate string
 
+//go:embed appeal_email_template
+var AppealEmailTemplate string
+
 var ModActionEmailMap = map[ms.ModActionType]string{
 	ms.ModActionType_MUTE:                    "Disable Chat",
 	ms.ModActionType_SUSPEND_ACCOUNT:         "Account Suspension",
@@ -39,14 +50,19 @@ var ModActionEmailMap = map[ms.ModActionType]string{
 	ms.ModActionType_RESET_STATS_AND_RATINGS: "Reset Ratings and Statistics",
 }
 
-func instantiateEmail(username, actionTaken, note string, starttime, endtime *timestamppb.Timestamp, emailType ms.EmailType) (string, string, error) {
-
+func formatEmailTimes(starttime, endtime *timestamppb.Timestamp) (string, string) {
 	golangStartTime := starttime.AsTime()
 	startTimeString := golangStartTime.UTC().Format(time.UnixDate)
 	endTimeString := ""
 	if endtime != nil {
 		endTimeString = endtime.AsTime().UTC().Format(time.UnixDate)
 	}
+	return startTimeString, endTimeString
+}
+
+func instantiateEmail(username, actionTaken, note string, starttime, endtime *timestamppb.Timestamp, emailType ms.EmailType, appealURL string) (string, string, error) {
+
+	startTimeString, endTimeString := formatEmailTimes(starttime, endtime)
 	emailTemplate, err := template.New(EmailTemplateName).Parse(EmailTemplate)
 	if err != nil {
 		return "", "", err
@@ -60,7 +76,8 @@ func instantiateEmail(username, actionTaken, note string, starttime, endtime *ti
 		EndTime:           endTimeString,
 		AddressToContact:  AddressToContact,
 		IsCheater:         emailType == ms.EmailType_CHEATING,
-		IsDeletion:        emailType == ms.EmailType_DELETION})
+		IsDeletion:        emailType == ms.EmailType_DELETION,
+		AppealURL:         appealURL})
 	if err != nil {
 		return "", "", err
 	}
@@ -74,3 +91,26 @@ func instantiateEmail(username, actionTaken, note string, starttime, endtime *ti
 
 	return emailContentBuffer.String(), emailSubject, nil
 }
+
+func instantiateAppealEmail(username, actionTaken string, starttime, endtime *timestamppb.Timestamp, granted bool, response string) (string, string, error) {
+	startTimeString, endTimeString := formatEmailTimes(starttime, endtime)
+	emailTemplate, err := template.New(AppealEmailTemplateName).Parse(AppealEmailTemplate)
+	if err != nil {
+		return "", "", err
+	}
+
+	emailContentBuffer := &bytes.Buffer{}
+	err = emailTemplate.Execute(emailContentBuffer, &EmailInfo{Username: username,
+		TermsOfServiceURL: TermsOfServiceURL,
+		Action:            actionTaken,
+		StartTime:         startTimeString,
+		EndTime:           endTimeString,
+		AddressToContact:  AddressToContact,
+		AppealGranted:     granted,
+		Response:          response})
+	if err != nil {
+		return "", "", err
+	}
+
+	return emailContentBuffer.String(), fmt.Sprintf("Woogles Appeal Decision for Account %s", username), nil
+}
@@ -59,6 +59,12 @@ func ActionExists(ctx context.Context, userStore user.Store, uuid string, forceI
 	if err != nil {
 		return false, err
 	}
+	return CurrentActionExists(currentActions, forceInsistLogout, actionTypes)
+}
+
+// CurrentActionExists is ActionExists for a user whose current actions have
+// already been looked up.
+func CurrentActionExists(currentActions map[string]*ms.ModAction, forceInsistLogout bool, actionTypes []ms.ModActionType) (bool, error) {
 	// We want to show the user longest ban out of all the actions,
 	// so we want the time furthest in the future. Initialize the latestTime
 	// to be the unix epoch. Any valid times that come from
@@ -132,7 +138,35 @@ func GetActionHistory(ctx context.Context, us user.Store, uuid string) ([]*ms.Mo
 }
 
 func ApplyActions(ctx context.Context, us user.Store, cs user.ChatStore, applierUserId string, actions []*ms.ModAction) error {
-	actionsToApply := []*ms.ModAction{}
+	return applyActions(ctx, us, cs, applierUserId, actions, "")
+}
+
+// applyActions applies the actions; if appealURL is set, the notification
+// emails point the user to it.
+func applyActions(ctx context.Context, us user.Store, cs user.ChatStore, applierUserId string, actions []*ms.ModAction, appealURL string) error {
+	prepared, err := prepareActions(ctx, us, cs, applierUserId, actions)
+	if err != nil {
+		return err
+	}
+	err = us.ApplyActions(ctx, preparedModActions(prepared))
+	if err != nil {
+		return err
+	}
+	notifyActions(ctx, us, prepared, appealURL)
+	return nil
+}
+
+// preparedAction is an action ready to be stored, with the user it applies
+// to as they were before it was applied.
+type preparedAction struct {
+	action *ms.ModAction
+	user   *entity.User
+}
+
+// prepareActions sets the times of the actions and carries out the
+// transient ones. It returns the actions to store.
+func prepareActions(ctx context.Context, us user.Store, cs user.ChatStore, applierUserId string, actions []*ms.ModAction) ([]preparedAction, error) {
+	prepared := []preparedAction{}
 	for _, action := range actions {
 		if action.Type == ms.ModActionType_DELETE_ACCOUNT {
 			// The DELETE_ACCOUNT action erases the profile,
@@ -144,34 +178,48 @@ func ApplyActions(ctx context.Context, us user.Store, cs user.ChatStore, applier
 				ApplierUserId: applierUserId,
 				EmailType:     ms.EmailType_DELETION,
 				Note:          "AUTOGENERATED ACTION: " + action.Note}
-			err := prepareAction(ctx, us, cs, suspendAccountAction)
+			p, err := prepareAction(ctx, us, cs, suspendAccountAction)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			actionsToApply = append(actionsToApply, suspendAccountAction)
+			prepared = append(prepared, p)
 		}
 		action.ApplierUserId = applierUserId
-		err := prepareAction(ctx, us, cs, action)
+		p, err := prepareAction(ctx, us, cs, action)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		actionsToApply = append(actionsToApply, action)
+		prepared = append(prepared, p)
 	}
+	return prepared, nil
+}
 
-	return us.ApplyActions(ctx, actionsToApply)
+func preparedModActions(prepared []preparedAction) []*ms.ModAction {
+	actions := make([]*ms.ModAction, len(prepared))
+	for i, p := range prepared {
+		actions[i] = p.action
+	}
+	return actions
 }
 
-func prepareAction(ctx context.Context, us user.Store, cs user.ChatStore, action *ms.ModAction) error {
+// notifyActions emails the users about the actions once they are stored.
+func notifyActions(ctx context.Context, us user.Store, prepared []preparedAction, appealURL string) {
+	for _, p := range prepared {
+		sendNotification(ctx, us, p.user, p.action, "", appealURL)
+	}
+}
+
+func prepareAction(ctx context.Context, us user.Store, cs user.ChatStore, action *ms.ModAction) (preparedAction, error) {
 	user, err := us.GetByUUID(ctx, action.UserId)
 	if err != nil {
-		return err
+		return preparedAction{}, err
 	}
 	action.StartTime = timestamppb.Now()
 	modActionFunc, actionExists := ModActionDispatching[action.Type]
 	if actionExists { // This ModAction is transient
 		err := modActionFunc(ctx, us, cs, action)
 		if err != nil {
-			return err
+			return preparedAction{}, err
 		}
 		action.Duration = 0
 		action.EndTime = action.StartTime
@@ -179,7 +227,7 @@ func prepareAction(ctx context.Context, us user.Store, cs user.ChatStore, action
 		action.RemoverUserId = ""
 	} else {
 		if action.Duration < 0 {
-			return fmt.Errorf("nontransient moderator action has a negative duration: %d", action.Duration)
+			return preparedAction{}, fmt.Errorf("nontransient moderator action has a negative duration: %d", action.Duration)
 		}
 		// A Duration of 0 seconds for nontransient
 		// actions is considered a permanent action
@@ -192,8 +240,7 @@ func prepareAction(ctx context.Context, us user.Store, cs user.ChatStore, action
 			action.EndTime = protoEndTime
 		}
 	}
-	sendNotification(ctx, us, user, action, "")
-	return nil
+	return preparedAction{action: action, user: user}, nil
 }
 
 func RemoveActions(ctx context.Context, userStore user.Store, removerUserId string, actions []*ms.ModAction) error {
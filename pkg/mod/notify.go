@@ -14,7 +14,7 @@ import (
 	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
 )
 
-func sendNotification(ctx context.Context, us user.Store, user *entity.User, action *ms.ModAction, notorietyReport string) {
+func sendNotification(ctx context.Context, us user.Store, user *entity.User, action *ms.ModAction, notorietyReport, appealURL string) {
 	actionEmailText, ok := ModActionEmailMap[action.Type]
 	if !ok {
 		return
@@ -32,7 +32,8 @@ func sendNotification(ctx context.Context, us user.Store, user *entity.User, act
 			action.Note,
 			action.StartTime,
 			action.EndTime,
-			action.EmailType)
+			action.EmailType,
+			appealURL)
 		if err == nil {
 			log.Debug().Str("email", user.Email).Msg("generated mod action email content")
 			go func() {
@@ -96,3 +97,41 @@ func sendNotification(ctx context.Context, us user.Store, user *entity.User, act
 		notify.Post(message+notorietyReport, config.DiscordToken)
 	}
 }
+
+// sendAppealDecision emails the user the outcome of their appeal. The email
+// describes the first action of the case that has an email description.
+func sendAppealDecision(ctx context.Context, us user.Store, userUUID string, actions []*ms.ModAction, granted bool, response string) {
+	config, err := config.Ctx(ctx)
+	if err != nil {
+		log.Err(err).Str("userID", userUUID).Msg("appeal-notification-nil-config")
+		return
+	}
+	u, err := us.GetByUUID(ctx, userUUID)
+	if err != nil {
+		log.Err(err).Str("userID", userUUID).Msg("appeal-notification-get-user")
+		return
+	}
+	var action *ms.ModAction
+	var actionEmailText string
+	for _, a := range actions {
+		if text, ok := ModActionEmailMap[a.Type]; ok {
+			action, actionEmailText = a, text
+			break
+		}
+	}
+	if action == nil {
+		return
+	}
+	emailContent, emailSubject, err := instantiateAppealEmail(u.Username, actionEmailText,
+		action.StartTime, action.EndTime, granted, response)
+	if err != nil {
+		log.Err(err).Str("userID", u.UUID).Msg("appeal-generate-user-email")
+		return
+	}
+	go func() {
+		_, err := emailer.SendSimpleMessage(config.EmailDebugMode, u.Email, emailSubject, emailContent)
+		if err != nil {
+			log.Err(err).Str("userID", u.UUID).Msg("appeal-send-user-email")
+		}
+	}()
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/woogles-io/liwords/pkg/apiserver"
 	"github.com/woogles-io/liwords/pkg/auth/rbac"
@@ -28,10 +29,18 @@ type ModService struct {
 	mailgunKey     string
 	discordToken   string
 	queries        *models.Queries
+	dbPool         *pgxpool.Pool
+	chatFilter     *ChatFilter
 }
 
-func NewModService(us user.Store, cs user.ChatStore, q *models.Queries) *ModService {
-	return &ModService{userStore: us, chatStore: cs, queries: q}
+func NewModService(us user.Store, cs user.ChatStore, q *models.Queries, dbPool *pgxpool.Pool) *ModService {
+	return &ModService{userStore: us, chatStore: cs, queries: q, dbPool: dbPool, chatFilter: NewChatFilter(q)}
+}
+
+// SetChatFilter shares the filter that checks chat messages, so that policy
+// changes take effect right away.
+func (ms *ModService) SetChatFilter(f *ChatFilter) {
+	ms.chatFilter = f
 }
 
 var AdminRequiredMap = map[pb.ModActionType]bool{
@@ -44,6 +53,7 @@ var AdminRequiredMap = map[pb.ModActionType]bool{
 	pb.ModActionType_RESET_STATS_AND_RATINGS: true,
 	pb.ModActionType_REMOVE_CHAT:             false,
 	pb.ModActionType_DELETE_ACCOUNT:          true,
+	pb.ModActionType_SHADOW_MUTE:             false,
 }
 
 func (ms *ModService) GetNotorietyReport(ctx context.Context, req *connect.Request[pb.GetNotorietyReportRequest],
@@ -157,3 +167,193 @@ func authenticateMod(ctx context.Context, ms *ModService, req *pb.ModActionsList
 	}
 	return user.UUID, nil
 }
+
+func (ms *ModService) FileReport(ctx context.Context, req *connect.Request[pb.FileReportRequest],
+) (*connect.Response[pb.FileReportResponse], error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = FileReport(ctx, ms.userStore, ms.chatStore, ms.queries, sess.UserUUID, req.Msg)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.FileReportResponse{}), nil
+}
+
+func (ms *ModService) ListCases(ctx context.Context, req *connect.Request[pb.ListCasesRequest],
+) (*connect.Response[pb.ListCasesResponse], error) {
+	_, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	cases, err := ListCases(ctx, ms.queries, req.Msg)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	return connect.NewResponse(&pb.ListCasesResponse{Cases: cases}), nil
+}
+
+func (ms *ModService) GetCase(ctx context.Context, req *connect.Request[pb.GetCaseRequest],
+) (*connect.Response[pb.ModCase], error) {
+	_, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	c, err := GetCase(ctx, ms.queries, req.Msg.CaseId)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(c), nil
+}
+
+func (ms *ModService) AssignCase(ctx context.Context, req *connect.Request[pb.AssignCaseRequest],
+) (*connect.Response[pb.AssignCaseResponse], error) {
+	modUserId, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	assignee := req.Msg.AssigneeUserId
+	if assignee == "" {
+		assignee = modUserId
+	}
+	err = AssignCase(ctx, ms.userStore, ms.queries, req.Msg.CaseId, assignee)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.AssignCaseResponse{}), nil
+}
+
+func (ms *ModService) AddCaseEvidence(ctx context.Context, req *connect.Request[pb.AddCaseEvidenceRequest],
+) (*connect.Response[pb.AddCaseEvidenceResponse], error) {
+	modUserId, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = AddCaseEvidence(ctx, ms.chatStore, ms.queries, modUserId, req.Msg)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.AddCaseEvidenceResponse{}), nil
+}
+
+func (ms *ModService) ResolveCase(ctx context.Context, req *connect.Request[pb.ResolveCaseRequest],
+) (*connect.Response[pb.ResolveCaseResponse], error) {
+	modUserId, err := authenticateMod(ctx, ms, &pb.ModActionsList{Actions: req.Msg.Actions})
+	if err != nil {
+		return nil, err
+	}
+	err = ResolveCase(ctx, ms.userStore, ms.chatStore, ms.dbPool, ms.queries, modUserId, req.Msg)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.ResolveCaseResponse{}), nil
+}
+
+func (ms *ModService) SubmitAppeal(ctx context.Context, req *connect.Request[pb.SubmitAppealRequest],
+) (*connect.Response[pb.SubmitAppealResponse], error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	appealId, err := SubmitAppeal(ctx, ms.queries, sess.UserUUID, req.Msg.CaseId, req.Msg.Message)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.SubmitAppealResponse{AppealId: appealId}), nil
+}
+
+func (ms *ModService) DecideAppeal(ctx context.Context, req *connect.Request[pb.DecideAppealRequest],
+) (*connect.Response[pb.DecideAppealResponse], error) {
+	modUserId, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = DecideAppeal(ctx, ms.userStore, ms.dbPool, ms.queries, modUserId, req.Msg)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.DecideAppealResponse{}), nil
+}
+
+func (ms *ModService) GetCheatSignalReport(ctx context.Context, req *connect.Request[pb.GetCheatSignalReportRequest],
+) (*connect.Response[pb.CheatSignalReport], error) {
+	_, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	u, err := ms.userStore.GetByUUID(ctx, req.Msg.UserId)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	report, err := GetCheatSignalReport(ctx, ms.queries, u.UUID)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	return connect.NewResponse(report), nil
+}
+
+func (ms *ModService) GetChatPolicies(ctx context.Context, req *connect.Request[pb.GetChatPoliciesRequest],
+) (*connect.Response[pb.ChatPolicies], error) {
+	_, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := ms.chatFilter.Policies(ctx)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	return connect.NewResponse(&pb.ChatPolicies{Policies: policies}), nil
+}
+
+func (ms *ModService) SetChatPolicy(ctx context.Context, req *connect.Request[pb.ChatPolicy],
+) (*connect.Response[pb.SetChatPolicyResponse], error) {
+	modUserId, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = SetChatPolicy(ctx, ms.queries, ms.chatFilter, modUserId, req.Msg)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.SetChatPolicyResponse{}), nil
+}
+
+func (ms *ModService) GetChatBlocklist(ctx context.Context, req *connect.Request[pb.GetChatBlocklistRequest],
+) (*connect.Response[pb.ChatBlocklist], error) {
+	_, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := GetChatBlocklist(ctx, ms.queries, req.Msg.Language)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	return connect.NewResponse(&pb.ChatBlocklist{Entries: entries}), nil
+}
+
+func (ms *ModService) AddChatBlocklistEntry(ctx context.Context, req *connect.Request[pb.ChatBlocklistEntry],
+) (*connect.Response[pb.AddChatBlocklistEntryResponse], error) {
+	modUserId, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	id, err := AddChatBlocklistEntry(ctx, ms.queries, ms.chatFilter, modUserId, req.Msg)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.AddChatBlocklistEntryResponse{Id: id}), nil
+}
+
+func (ms *ModService) RemoveChatBlocklistEntry(ctx context.Context, req *connect.Request[pb.RemoveChatBlocklistEntryRequest],
+) (*connect.Response[pb.RemoveChatBlocklistEntryResponse], error) {
+	_, err := authenticateMod(ctx, ms, nil)
+	if err != nil {
+		return nil, err
+	}
+	err = RemoveChatBlocklistEntry(ctx, ms.queries, ms.chatFilter, req.Msg.Id)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.RemoveChatBlocklistEntryResponse{}), nil
+}
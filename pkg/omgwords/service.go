@@ -191,6 +191,10 @@ func createAnnotatedGameDoc(
 	if err := entity.ValidateLetterDistribution(lexicon, letterDistributionName); err != nil {
 		return nil, apiserver.InvalidArg(err.Error())
 	}
+	// Private lexica are only for the games of their own tournament.
+	if err := entity.ValidateLexiconScope(lexicon); err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
 
 	// We can just make the user ID the same as the nickname, as it
 	// doesn't matter in this case
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/woogles-io/liwords/pkg/apiserver"
 	"github.com/woogles-io/liwords/pkg/auth/rbac"
 	"github.com/woogles-io/liwords/pkg/entity"
@@ -15,6 +16,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/stores/models"
 	"github.com/woogles-io/liwords/pkg/user"
 	userservices "github.com/woogles-io/liwords/pkg/user/services"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/rs/zerolog/log"
 
@@ -92,6 +94,70 @@ func (ps *ProfileService) GetStats(ctx context.Context, r *connect.Request[pb.St
 	}), nil
 }
 
+const MaxRatingHistoryPoints = 1000
+
+// GetRatingHistory gets a user's most recent rating changes in a variant.
+func (ps *ProfileService) GetRatingHistory(ctx context.Context, r *connect.Request[pb.RatingHistoryRequest],
+) (*connect.Response[pb.RatingHistoryResponse], error) {
+	user, err := ps.userStore.Get(ctx, r.Msg.Username)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	limit := r.Msg.Limit
+	if limit <= 0 || limit > MaxRatingHistoryPoints {
+		limit = MaxRatingHistoryPoints
+	}
+
+	variants, err := ps.queries.GetRatingHistoryVariants(ctx, int32(user.ID))
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	resp := &pb.RatingHistoryResponse{Variants: variants}
+	if r.Msg.Variant == "" {
+		return connect.NewResponse(resp), nil
+	}
+
+	since := pgtype.Timestamptz{Time: time.Unix(0, 0), Valid: true}
+	if r.Msg.Since != nil {
+		since.Time = r.Msg.Since.AsTime()
+	}
+	rows, err := ps.queries.GetRatingHistory(ctx, models.GetRatingHistoryParams{
+		UserID:  int32(user.ID),
+		Variant: r.Msg.Variant,
+		Since:   since,
+		Lim:     limit,
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	// The rows are most recent first.
+	resp.Points = make([]*pb.RatingHistoryPoint, len(rows))
+	for i, row := range rows {
+		resp.Points[len(rows)-1-i] = &pb.RatingHistoryPoint{
+			GameId:                  row.GameUuid,
+			OpponentUsername:        row.OpponentUsername.String,
+			PlayedAt:                timestamppb.New(row.CreatedAt.Time),
+			RatingBefore:            row.RatingBefore,
+			RatingAfter:             row.RatingAfter,
+			RatingDeviationBefore:   row.RdBefore,
+			RatingDeviationAfter:    row.RdAfter,
+			VolatilityBefore:        row.VolatilityBefore,
+			VolatilityAfter:         row.VolatilityAfter,
+			OpponentRating:          row.OpponentRating,
+			OpponentRatingDeviation: row.OpponentRd,
+			Spread:                  row.Spread,
+			Breakdown: &pb.RatingChangeBreakdown{
+				ExpectedResult:          row.ExpectedResult,
+				ActualResult:            row.ActualResult,
+				WinBoost:                row.WinBoost,
+				InactiveRatingDeviation: row.InactiveRd,
+				Multiplier:              row.Multiplier,
+			},
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (ps *ProfileService) GetProfile(ctx context.Context, r *connect.Request[pb.ProfileRequest],
 ) (*connect.Response[pb.ProfileResponse], error) {
 	sess, err := apiserver.GetSession(ctx)
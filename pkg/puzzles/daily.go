@@ -0,0 +1,220 @@
+package puzzles
+
+import (
+	"context"
+	"time"
+
+	"github.com/domino14/word-golib/tilemapping"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/utilities"
+	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/puzzle_service"
+)
+
+const (
+	// Unscheduled days get the best voted puzzle with at least this many
+	// net votes, or a random puzzle if there isn't one.
+	dailyPuzzleMinScore = 3
+	// The number of most common wrong answers shown with the stats
+	dailyPuzzleWrongAnswers = 5
+
+	dailyPuzzleDateLayout = "2006-01-02"
+
+	DefaultDailyPuzzleArchiveLimit = 30
+	MaxDailyPuzzleArchiveLimit     = 100
+)
+
+// dailyPuzzleDate returns the UTC day of t. Daily puzzles change at
+// midnight UTC.
+func dailyPuzzleDate(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// dailyPuzzleStreaks returns the user's current and best streaks of
+// consecutive daily puzzles solved, given the days they solved them on,
+// latest first. The current streak is kept until the end of the day after
+// the last solve, so users don't lose it before they solve today's puzzle.
+func dailyPuzzleStreaks(solveDates []time.Time, today time.Time) (int, int) {
+	latestRun, best, run := 0, 0, 0
+	for i, d := range solveDates {
+		if i > 0 && solveDates[i-1].AddDate(0, 0, -1).Equal(d) {
+			run++
+		} else {
+			run = 1
+		}
+		if run == i+1 {
+			latestRun = run
+		}
+		if run > best {
+			best = run
+		}
+	}
+	if len(solveDates) == 0 || solveDates[0].Before(today.AddDate(0, 0, -1)) {
+		return 0, best
+	}
+	return latestRun, best
+}
+
+// dailyPuzzleAnswerKey describes an answer so that identical wrong answers
+// can be counted together, e.g. "8H QI.", "-EIU" for an exchange, or
+// "(pass)". It is empty if the user gave up.
+func dailyPuzzleAnswerKey(userAnswer *ipc.ClientGameplayEvent, ld *tilemapping.LetterDistribution) string {
+	if userAnswer == nil {
+		return ""
+	}
+	converted := clientEventToGameEvent(userAnswer, ld)
+	if converted == nil {
+		return ""
+	}
+	switch userAnswer.Type {
+	case ipc.ClientGameplayEvent_TILE_PLACEMENT:
+		return userAnswer.PositionCoords + " " + converted.PlayedTiles
+	case ipc.ClientGameplayEvent_EXCHANGE:
+		return "-" + utilities.SortString(converted.Exchanged)
+	case ipc.ClientGameplayEvent_PASS:
+		return "(pass)"
+	}
+	return ""
+}
+
+func dailyPuzzleStatus(attempt *entity.DailyPuzzleAttempt) pb.PuzzleStatus {
+	if !attempt.Answered() {
+		return pb.PuzzleStatus_UNANSWERED
+	} else if *attempt.Correct {
+		return pb.PuzzleStatus_CORRECT
+	}
+	return pb.PuzzleStatus_INCORRECT
+}
+
+// GetDailyPuzzle returns today's daily puzzle for the lexicon. For a
+// logged-in user it also starts their attempt, and once they have answered
+// it includes the answer and everyone's results.
+func GetDailyPuzzle(ctx context.Context, ps PuzzleStore, userId string, lexicon string) (*pb.DailyPuzzleResponse, error) {
+	today := dailyPuzzleDate(time.Now())
+	puzzleId, err := ps.GetDailyPuzzle(ctx, lexicon, today, dailyPuzzleMinScore)
+	if err != nil {
+		return nil, err
+	}
+	// The puzzle is fetched without a user so that viewing it does not
+	// start a rated attempt.
+	hist, beforeText, _, _, _, _, _, _, err := ps.GetPuzzle(ctx, "", puzzleId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.DailyPuzzleResponse{
+		Date:       today.Format(dailyPuzzleDateLayout),
+		PuzzleId:   puzzleId,
+		History:    hist,
+		BeforeText: beforeText,
+	}
+	if userId == "" {
+		return resp, nil
+	}
+
+	attempt, err := ps.StartDailyPuzzleAttempt(ctx, userId, lexicon, today)
+	if err != nil {
+		return nil, err
+	}
+	resp.Status = dailyPuzzleStatus(attempt)
+	if attempt.Answered() {
+		resp.CorrectAnswer, _, _, _, _, _, err = ps.GetAnswer(ctx, puzzleId)
+		if err != nil {
+			return nil, err
+		}
+		resp.Stats, err = ps.GetDailyPuzzleStats(ctx, lexicon, today, dailyPuzzleWrongAnswers)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp.Streak, err = getDailyPuzzleStreak(ctx, ps, userId, lexicon, today)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SubmitDailyPuzzleAnswer records the user's one answer to today's daily
+// puzzle. Daily puzzles are not rated.
+func SubmitDailyPuzzleAnswer(ctx context.Context, ps PuzzleStore, userId string, lexicon string,
+	userAnswer *ipc.ClientGameplayEvent) (*pb.DailyPuzzleAnswerResponse, error) {
+
+	today := dailyPuzzleDate(time.Now())
+	puzzleId, err := ps.GetDailyPuzzle(ctx, lexicon, today, dailyPuzzleMinScore)
+	if err != nil {
+		return nil, err
+	}
+	attempt, err := ps.StartDailyPuzzleAttempt(ctx, userId, lexicon, today)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.Answered() {
+		return nil, entity.NewWooglesError(ipc.WooglesError_DAILY_PUZZLE_ALREADY_ANSWERED, userId)
+	}
+	correctAnswer, _, _, _, req, _, err := ps.GetAnswer(ctx, puzzleId)
+	if err != nil {
+		return nil, err
+	}
+	ld, err := puzzleLetterDistribution(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	userIsCorrect := answersAreEqual(userAnswer, correctAnswer, ld)
+	answerKey := ""
+	if !userIsCorrect {
+		answerKey = dailyPuzzleAnswerKey(userAnswer, ld)
+	}
+	err = ps.SaveDailyPuzzleAnswer(ctx, userId, lexicon, today, userIsCorrect, answerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := ps.GetDailyPuzzleStats(ctx, lexicon, today, dailyPuzzleWrongAnswers)
+	if err != nil {
+		return nil, err
+	}
+	streak, err := getDailyPuzzleStreak(ctx, ps, userId, lexicon, today)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DailyPuzzleAnswerResponse{
+		UserIsCorrect: userIsCorrect,
+		CorrectAnswer: correctAnswer,
+		Stats:         stats,
+		Streak:        streak,
+	}, nil
+}
+
+// GetDailyPuzzleArchive returns the lexicon's past daily puzzles, latest
+// first. Today's puzzle is left out so its answer can't be looked up.
+func GetDailyPuzzleArchive(ctx context.Context, ps PuzzleStore, userId string, lexicon string, limit int, offset int) ([]*pb.DailyPuzzleArchiveEntry, error) {
+	if limit <= 0 {
+		limit = DefaultDailyPuzzleArchiveLimit
+	} else if limit > MaxDailyPuzzleArchiveLimit {
+		limit = MaxDailyPuzzleArchiveLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return ps.GetDailyPuzzleArchive(ctx, userId, lexicon, dailyPuzzleDate(time.Now()), limit, offset)
+}
+
+// SetDailyPuzzle schedules a puzzle for a future day. Today's puzzle can't
+// be changed, since users may already have answered it.
+func SetDailyPuzzle(ctx context.Context, ps PuzzleStore, userId string, lexicon string, date string, puzzleUUID string) error {
+	day, err := time.Parse(dailyPuzzleDateLayout, date)
+	if err != nil || !day.After(dailyPuzzleDate(time.Now())) {
+		return entity.NewWooglesError(ipc.WooglesError_DAILY_PUZZLE_INVALID_DATE, userId, date)
+	}
+	return ps.SetDailyPuzzle(ctx, lexicon, day, puzzleUUID)
+}
+
+func getDailyPuzzleStreak(ctx context.Context, ps PuzzleStore, userId string, lexicon string, today time.Time) (*pb.DailyPuzzleStreak, error) {
+	solveDates, err := ps.GetDailyPuzzleSolveDates(ctx, userId, lexicon)
+	if err != nil {
+		return nil, err
+	}
+	current, best := dailyPuzzleStreaks(solveDates, today)
+	return &pb.DailyPuzzleStreak{Current: int32(current), Best: int32(best)}, nil
+}
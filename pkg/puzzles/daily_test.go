@@ -0,0 +1,63 @@
+package puzzles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+func TestDailyPuzzleDate(t *testing.T) {
+	is := is.New(t)
+	nyc, err := time.LoadLocation("America/New_York")
+	is.NoErr(err)
+	// 9pm in New York is already the next day in UTC
+	is.Equal(dailyPuzzleDate(time.Date(2026, 10, 18, 21, 0, 0, 0, nyc)),
+		time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+}
+
+func TestDailyPuzzleStreaks(t *testing.T) {
+	is := is.New(t)
+	today := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	day := func(daysAgo int) time.Time {
+		return today.AddDate(0, 0, -daysAgo)
+	}
+
+	current, best := dailyPuzzleStreaks(nil, today)
+	is.Equal(current, 0)
+	is.Equal(best, 0)
+
+	current, best = dailyPuzzleStreaks([]time.Time{day(0), day(1), day(2), day(4), day(5)}, today)
+	is.Equal(current, 3)
+	is.Equal(best, 3)
+
+	// Today's puzzle isn't solved yet, so the streak is still alive
+	current, best = dailyPuzzleStreaks([]time.Time{day(1), day(2), day(5), day(6), day(7), day(8)}, today)
+	is.Equal(current, 2)
+	is.Equal(best, 4)
+
+	current, best = dailyPuzzleStreaks([]time.Time{day(2), day(3)}, today)
+	is.Equal(current, 0)
+	is.Equal(best, 2)
+}
+
+func TestDailyPuzzleAnswerKey(t *testing.T) {
+	is := is.New(t)
+	ld, err := tilemapping.EnglishLetterDistribution(DefaultConfig.WGLConfig())
+	is.NoErr(err)
+
+	is.Equal(dailyPuzzleAnswerKey(nil, ld), "")
+	is.Equal(dailyPuzzleAnswerKey(&ipc.ClientGameplayEvent{
+		Type:           ipc.ClientGameplayEvent_TILE_PLACEMENT,
+		PositionCoords: "8H",
+		Tiles:          "QI",
+	}, ld), "8H QI")
+	is.Equal(dailyPuzzleAnswerKey(&ipc.ClientGameplayEvent{
+		Type:  ipc.ClientGameplayEvent_EXCHANGE,
+		Tiles: "UIE",
+	}, ld), "-EIU")
+	is.Equal(dailyPuzzleAnswerKey(&ipc.ClientGameplayEvent{Type: ipc.ClientGameplayEvent_PASS}, ld), "(pass)")
+}
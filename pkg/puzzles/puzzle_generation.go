@@ -20,6 +20,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/common"
 	"github.com/woogles-io/liwords/pkg/config"
 	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/entity/utilities"
 	"github.com/woogles-io/liwords/pkg/gameplay"
 	puzzlesstore "github.com/woogles-io/liwords/pkg/stores/puzzles"
 
@@ -27,9 +28,22 @@ import (
 	pb "github.com/woogles-io/liwords/rpc/api/proto/puzzle_service"
 
 	"github.com/domino14/macondo/automatic"
+	macondoconfig "github.com/domino14/macondo/config"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 )
 
+const (
+	MaxAnnotatedGamesPerJob = 100
+	// MaxAnnotatedGameJobsPerDay is the number of annotated game jobs a
+	// user without the can_create_puzzles permission can queue per day.
+	MaxAnnotatedGameJobsPerDay = 5
+	// Big enough to hold every puzzle in the games of a job.
+	annotatedGameBucketSize = 100000
+	// Annotated games are chosen by their author, so don't skip any of them
+	// for being played badly.
+	annotatedGameEquityLossLimit = 100000
+)
+
 var mockBotGameReq *ipc.GameRequest
 
 func init() {
@@ -48,12 +62,62 @@ func init() {
 	}
 }
 
+// GameDocumentStore gets the documents of annotated games.
+type GameDocumentStore interface {
+	GetDocument(ctx context.Context, uuid string) (*ipc.GameDocument, error)
+}
+
 func Generate(ctx context.Context, cfg *config.Config, gs gameplay.GameStore, ps PuzzleStore, req *pb.PuzzleGenerationJobRequest) (int, error) {
 	genId, err := ps.CreateGenerationLog(ctx, req)
 	if err != nil {
 		return -1, err
 	}
-	fulfilled, err := processJob(ctx, cfg, req, genId, gs, ps)
+	fulfilled, err := processJob(ctx, cfg, req, genId, gs, nil, ps)
+	return genId, ps.UpdateGenerationLogStatus(ctx, genId, fulfilled, err)
+}
+
+// QueueGeneration queues a generation job for a local puzzle worker, for
+// when there is no ECS cluster to run it on.
+func QueueGeneration(ctx context.Context, ps PuzzleStore, req *pb.PuzzleGenerationJobRequest) (int, error) {
+	return ps.QueueGenerationJob(ctx, req)
+}
+
+// QueueAnnotatedGameGeneration queues a job that creates puzzles from the
+// author's annotated games. Without buckets, every puzzle found in the
+// games is created. If the job needs approval, its puzzles aren't served
+// until it is approved.
+func QueueAnnotatedGameGeneration(ctx context.Context, ps PuzzleStore, authorId string, gameIds []string,
+	req *macondopb.PuzzleGenerationRequest, needsApproval bool) (int, error) {
+
+	if req == nil || len(req.Buckets) == 0 {
+		req = &macondopb.PuzzleGenerationRequest{
+			Buckets: []*macondopb.PuzzleBucket{{
+				Size:     annotatedGameBucketSize,
+				Includes: []macondopb.PuzzleTag{macondopb.PuzzleTag_EQUITY},
+			}},
+		}
+	}
+	return ps.QueueGenerationJob(ctx, &pb.PuzzleGenerationJobRequest{
+		Request:              req,
+		AnnotatedGameIds:     gameIds,
+		AuthorId:             authorId,
+		EquityLossTotalLimit: annotatedGameEquityLossLimit,
+		NeedsApproval:        needsApproval,
+	})
+}
+
+// RunQueuedGeneration runs the oldest queued generation job. It returns -1
+// if there are no queued jobs.
+func RunQueuedGeneration(ctx context.Context, cfg *config.Config, gs gameplay.GameStore, ds GameDocumentStore, ps PuzzleStore) (int, error) {
+	genId, req, err := ps.ClaimGenerationJob(ctx)
+	if genId == -1 {
+		return genId, err
+	}
+	if err != nil {
+		return genId, ps.UpdateGenerationLogStatus(ctx, genId, false, err)
+	}
+	log.Info().Int("genId", genId).Interface("req", req).Msg("running-queued-job")
+	fulfilled, err := processJob(ctx, cfg, req, genId, gs, ds, ps)
 	return genId, ps.UpdateGenerationLogStatus(ctx, genId, fulfilled, err)
 }
 
@@ -155,7 +219,7 @@ func processWithRealGames(ctx context.Context, cfg *config.Config, req *pb.Puzzl
 }
 
 func processJob(ctx context.Context, cfg *config.Config, req *pb.PuzzleGenerationJobRequest,
-	genId int, gs gameplay.GameStore, ps PuzzleStore) (bool, error) {
+	genId int, gs gameplay.GameStore, ds GameDocumentStore, ps PuzzleStore) (bool, error) {
 
 	if req == nil {
 		return false, errors.New("request is nil")
@@ -164,12 +228,21 @@ func processJob(ctx context.Context, cfg *config.Config, req *pb.PuzzleGeneratio
 	if err != nil {
 		return false, err
 	}
+	if len(req.AnnotatedGameIds) > 0 {
+		if ds == nil {
+			return false, errors.New("annotated games need a game document store")
+		}
+		return processAnnotatedGames(ctx, cfg, req, genId, gs, ds, ps)
+	}
 	if !req.BotVsBot {
 		return processWithRealGames(ctx, cfg, req, ps, gs, genId)
 	} else {
+		// Jobs are run one after another by the same worker, so the bots
+		// can't play in the lexicon of the worker's config.
+		mcfg := jobMacondoConfig(cfg, req.Lexicon, req.LetterDistribution)
 		gamesCreated := 0
 		for i := 0; i < int(req.GameConsiderationLimit); i++ {
-			r := automatic.NewGameRunner(nil, cfg.MacondoConfig())
+			r := automatic.NewGameRunner(nil, mcfg)
 			err := r.CompVsCompStatic(true)
 			if err != nil {
 				return false, err
@@ -194,6 +267,18 @@ func processJob(ctx context.Context, cfg *config.Config, req *pb.PuzzleGeneratio
 	return false, nil
 }
 
+// jobMacondoConfig copies the macondo config, with the job's lexicon and
+// letter distribution as the defaults.
+func jobMacondoConfig(cfg *config.Config, lexicon, letterDistribution string) *macondoconfig.Config {
+	mcfg := macondoconfig.DefaultConfig()
+	for k, v := range cfg.MacondoConfig().AllSettings() {
+		mcfg.Set(k, v)
+	}
+	mcfg.Set(macondoconfig.ConfigDefaultLexicon, lexicon)
+	mcfg.Set(macondoconfig.ConfigDefaultLetterDistribution, letterDistribution)
+	return mcfg
+}
+
 func processGame(ctx context.Context, eqLossLimit uint32, req *macondopb.PuzzleGenerationRequest, genId int, gs gameplay.GameStore, ps PuzzleStore,
 	g *entity.Game, authorId string, gameType ipc.GameType) (bool, bool, error) {
 
@@ -219,6 +304,86 @@ func processGame(ctx context.Context, eqLossLimit uint32, req *macondopb.PuzzleG
 	return true, fulfilled, nil
 }
 
+// processAnnotatedGames creates every puzzle it can from each of the
+// requested annotated games, attributed to the requesting author. The job
+// is fulfilled once every game has been processed.
+func processAnnotatedGames(ctx context.Context, cfg *config.Config, req *pb.PuzzleGenerationJobRequest, genId int,
+	gs gameplay.GameStore, ds GameDocumentStore, ps PuzzleStore) (bool, error) {
+
+	for _, gid := range req.AnnotatedGameIds {
+		doc, err := ds.GetDocument(ctx, gid)
+		if err != nil {
+			return false, fmt.Errorf("annotated game %s: %w", gid, err)
+		}
+		g, err := newAnnotatedPuzzleGame(cfg, doc)
+		if err != nil {
+			return false, fmt.Errorf("annotated game %s: %w", gid, err)
+		}
+		// An earlier job may already have added the game to the games
+		// table; it must only be added once.
+		exists, err := gs.Exists(ctx, gid)
+		if err != nil {
+			return false, err
+		}
+		pzls, err := createPuzzlesFromGame(ctx, req.EquityLossTotalLimit, req.Request, genId, gs, ps, g, req.AuthorId,
+			ipc.GameType_ANNOTATED, exists, true, !req.NeedsApproval)
+		if err != nil {
+			return false, fmt.Errorf("annotated game %s: %w", gid, err)
+		}
+		log.Info().Str("gameId", gid).Int("puzzles", len(pzls)).Msg("processed-annotated-game")
+	}
+	return true, nil
+}
+
+func newAnnotatedPuzzleGame(cfg *config.Config, doc *ipc.GameDocument) (*entity.Game, error) {
+	hist, err := utilities.ToGameHistory(doc, cfg)
+	if err != nil {
+		return nil, err
+	}
+	// Phonies can't be played under VOID, so annotated games that have them
+	// are replayed as SINGLE, like games loaded from the database.
+	if hist.ChallengeRule == macondopb.ChallengeRule_VOID {
+		hist.ChallengeRule = macondopb.ChallengeRule_SINGLE
+	}
+	variant := doc.Variant
+	if variant == "" {
+		variant = string(macondogame.VarClassic)
+	}
+	rules, err := macondogame.NewBasicGameRules(cfg.MacondoConfig(), doc.Lexicon, doc.BoardLayout,
+		doc.LetterDistribution, macondogame.CrossScoreAndSet, macondogame.Variant(variant))
+	if err != nil {
+		return nil, err
+	}
+	mcg, err := macondogame.NewFromHistory(hist, rules, 0)
+	if err != nil {
+		return nil, err
+	}
+	g := entity.NewGame(mcg, &ipc.GameRequest{
+		Lexicon: doc.Lexicon,
+		Rules: &ipc.GameRules{
+			BoardLayoutName:        doc.BoardLayout,
+			LetterDistributionName: doc.LetterDistribution,
+			VariantName:            variant,
+		},
+		ChallengeRule: hist.ChallengeRule,
+		GameMode:      ipc.GameMode_CORRESPONDENCE,
+		RatingMode:    ipc.RatingMode_CASUAL,
+	})
+	g.Started = true
+	g.GameEndReason = doc.EndReason
+	g.Quickdata.PlayerInfo = make([]*ipc.PlayerInfo, len(doc.Players))
+	for i, p := range doc.Players {
+		g.Quickdata.PlayerInfo[i] = &ipc.PlayerInfo{
+			UserId:   p.UserId,
+			Nickname: p.Nickname,
+			FullName: p.RealName,
+			First:    i == 0,
+		}
+	}
+	g.Quickdata.FinalScores = hist.FinalScores
+	return g, nil
+}
+
 func newBotvBotPuzzleGame(mcg *macondogame.Game, lexicon, letterdistribution string) *entity.Game {
 	mockBotGameReq.Lexicon = lexicon
 	mockBotGameReq.Rules.LetterDistributionName = letterdistribution
@@ -4,11 +4,18 @@ import (
 	"context"
 	"testing"
 
+	"github.com/domino14/macondo/board"
 	macondoconfig "github.com/domino14/macondo/config"
+	"github.com/domino14/macondo/game"
+	"github.com/domino14/macondo/gcgio"
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/lithammer/shortuuid/v4"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/matryer/is"
+	"github.com/woogles-io/liwords/pkg/common"
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/puzzle_service"
 )
 
@@ -173,3 +180,118 @@ func TestPuzzleGeneration(t *testing.T) {
 	is.Equal(totalGames, 20)
 
 }
+
+func TestQueuedPuzzleGeneration(t *testing.T) {
+	is := is.New(t)
+	dbc, _, _ := RecreateDB()
+	defer func() {
+		dbc.cleanup()
+	}()
+	gs, ps := dbc.gs, dbc.ps
+	cfg := DefaultConfig
+	cfg.MacondoConfig().Set(macondoconfig.ConfigDefaultLexicon, DefaultPuzzleGenerationJobRequest.Lexicon)
+	cfg.MacondoConfig().Set(macondoconfig.ConfigDefaultLetterDistribution, DefaultPuzzleGenerationJobRequest.LetterDistribution)
+	ctx := context.Background()
+
+	// Nothing is queued yet
+	genId, err := RunQueuedGeneration(ctx, cfg, gs, nil, ps)
+	is.NoErr(err)
+	is.Equal(genId, -1)
+
+	pgrjReq := proto.Clone(DefaultPuzzleGenerationJobRequest).(*pb.PuzzleGenerationJobRequest)
+	queuedId, err := QueueGeneration(ctx, ps, pgrjReq)
+	is.NoErr(err)
+
+	genId, err = RunQueuedGeneration(ctx, cfg, gs, nil, ps)
+	is.NoErr(err)
+	is.Equal(genId, queuedId)
+	_, _, _, fulfilledOption, errorStatusOption, totalPuzzles, _, _, err := GetJobInfo(ctx, ps, genId)
+	is.NoErr(err)
+	is.True(*fulfilledOption)
+	is.Equal(errorStatusOption, nil)
+	is.Equal(totalPuzzles, 50)
+
+	// Jobs only run once
+	genId, err = RunQueuedGeneration(ctx, cfg, gs, nil, ps)
+	is.NoErr(err)
+	is.Equal(genId, -1)
+
+	// Annotated games need a game document store
+	_, err = QueueAnnotatedGameGeneration(ctx, ps, PuzzleCreatorUUID, []string{"abc"}, nil, false)
+	is.NoErr(err)
+	genId, err = RunQueuedGeneration(ctx, cfg, gs, nil, ps)
+	is.NoErr(err)
+	_, _, _, fulfilledOption, errorStatusOption, _, _, _, err = GetJobInfo(ctx, ps, genId)
+	is.NoErr(err)
+	is.True(!*fulfilledOption)
+	is.Equal(*errorStatusOption, "annotated games need a game document store")
+}
+
+func TestJobMacondoConfig(t *testing.T) {
+	is := is.New(t)
+	cfg := DefaultConfig
+	cfg.MacondoConfig().Set(macondoconfig.ConfigDefaultLexicon, "NWL23")
+
+	mcfg := jobMacondoConfig(cfg, "FRA24", "french")
+	is.Equal(mcfg.GetString(macondoconfig.ConfigDefaultLexicon), "FRA24")
+	is.Equal(mcfg.GetString(macondoconfig.ConfigDefaultLetterDistribution), "french")
+	is.Equal(mcfg.GetString(macondoconfig.ConfigDataPath), cfg.MacondoConfig().GetString(macondoconfig.ConfigDataPath))
+	// The worker's own config is unchanged.
+	is.Equal(cfg.MacondoConfig().GetString(macondoconfig.ConfigDefaultLexicon), "NWL23")
+}
+
+func TestPuzzlesNeedingApproval(t *testing.T) {
+	is := is.New(t)
+	dbc, _, _ := RecreateDB()
+	defer func() {
+		dbc.cleanup()
+	}()
+	gs, ps := dbc.gs, dbc.ps
+	ctx := ctxForTests()
+	const pendingLexicon = "PENDING21"
+
+	req := &pb.PuzzleGenerationJobRequest{
+		AuthorId:      PuzzlerUUID,
+		NeedsApproval: true,
+		Request: &macondopb.PuzzleGenerationRequest{
+			Buckets: []*macondopb.PuzzleBucket{{
+				Size:     annotatedGameBucketSize,
+				Includes: []macondopb.PuzzleTag{macondopb.PuzzleTag_EQUITY},
+			}},
+		},
+	}
+	genId, err := ps.CreateGenerationLog(ctx, req)
+	is.NoErr(err)
+
+	rules, err := game.NewBasicGameRules(DefaultConfig.MacondoConfig(), common.DefaultLexicon, board.CrosswordGameLayout, "english", game.CrossScoreAndSet, game.VarClassic)
+	is.NoErr(err)
+	gameHistory, err := gcgio.ParseGCG(DefaultConfig.MacondoConfig(), "./testdata/r10_james.gcg")
+	is.NoErr(err)
+	gameHistory.ChallengeRule = macondopb.ChallengeRule_FIVE_POINT
+	gameHistory.Uid = shortuuid.New()
+	mcg, err := game.NewFromHistory(gameHistory, rules, 0)
+	is.NoErr(err)
+	gameReq := proto.Clone(common.DefaultGameReq).(*ipc.GameRequest)
+	gameReq.Lexicon = pendingLexicon
+
+	pzls, err := createPuzzlesFromGame(ctx, 1000, req.Request, genId, gs, ps, entity.NewGame(mcg, gameReq),
+		PuzzlerUUID, ipc.GameType_ANNOTATED, false, true, !req.NeedsApproval)
+	is.NoErr(err)
+	is.True(len(pzls) > 0)
+
+	// The puzzles aren't served until they are approved.
+	_, _, err = GetNextPuzzleId(ctx, ps, PuzzleCreatorUUID, pendingLexicon)
+	is.True(err != nil)
+
+	approved, err := ApproveGenerationJob(ctx, ps, genId)
+	is.NoErr(err)
+	is.Equal(approved, len(pzls))
+
+	_, pqr, err := GetNextPuzzleId(ctx, ps, PuzzleCreatorUUID, pendingLexicon)
+	is.NoErr(err)
+	is.Equal(pqr, pb.PuzzleQueryResult_UNSEEN)
+
+	approved, err = ApproveGenerationJob(ctx, ps, genId)
+	is.NoErr(err)
+	is.Equal(approved, 0)
+}
@@ -27,27 +27,65 @@ import (
 type PuzzleStore interface {
 	CreateGenerationLog(ctx context.Context, req *pb.PuzzleGenerationJobRequest) (int, error)
 	UpdateGenerationLogStatus(ctx context.Context, genId int, fulfilled bool, err error) error
+	QueueGenerationJob(ctx context.Context, req *pb.PuzzleGenerationJobRequest) (int, error)
+	ClaimGenerationJob(ctx context.Context) (int, *pb.PuzzleGenerationJobRequest, error)
 	CreatePuzzle(ctx context.Context, gameID string, turnNumber int32, answer *macondopb.GameEvent, authorID string,
-		lexicon string, beforeText string, afterText string, tags []macondopb.PuzzleTag, reqId int, bucketIndex int32) error
-	GetStartPuzzleId(ctx context.Context, userId string, lexicon string, ratingKey entity.VariantKey) (string, pb.PuzzleQueryResult, error)
-	GetNextPuzzleId(ctx context.Context, userId string, lexicon string) (string, pb.PuzzleQueryResult, error)
-	GetNextClosestRatingPuzzleId(ctx context.Context, userId string, lexicon string, ratingKey entity.VariantKey) (string, pb.PuzzleQueryResult, error)
+		lexicon string, beforeText string, afterText string, tags []macondopb.PuzzleTag, reqId int, bucketIndex int32, valid bool) error
+	ApproveGenerationJob(ctx context.Context, genId int) (int, error)
+	GetStartPuzzleId(ctx context.Context, userId string, lexicon string, ratingKey entity.VariantKey, tags []macondopb.PuzzleTag) (string, pb.PuzzleQueryResult, error)
+	GetNextPuzzleId(ctx context.Context, userId string, lexicon string, tags []macondopb.PuzzleTag) (string, pb.PuzzleQueryResult, error)
+	GetNextClosestRatingPuzzleId(ctx context.Context, userId string, lexicon string, ratingKey entity.VariantKey, tags []macondopb.PuzzleTag) (string, pb.PuzzleQueryResult, error)
 	GetPuzzle(ctx context.Context, userId string, puzzleUUID string) (*macondopb.GameHistory, string, int32, *bool, time.Time, time.Time, *entity.SingleRating, *entity.SingleRating, error)
 	GetPreviousPuzzleId(ctx context.Context, userId string, puzzleUUID string) (string, error)
 	GetAnswer(ctx context.Context, puzzleUUID string) (*macondopb.GameEvent, string, int32, string, *ipc.GameRequest, *entity.SingleRating, error)
 	SubmitAnswer(ctx context.Context, userId string, ratingKey entity.VariantKey, newUserRating *entity.SingleRating,
-		puzzleUUID string, newPuzzleRating *entity.SingleRating, userIsCorrect bool, userGaveUp bool) error
+		newTagRatings map[macondopb.PuzzleTag]*entity.SingleRating, puzzleUUID string, newPuzzleRating *entity.SingleRating, userIsCorrect bool, userGaveUp bool) error
 	GetAttempts(ctx context.Context, userId string, puzzleUUID string) (bool, bool, int32, *bool, time.Time, time.Time, *entity.SingleRating, *entity.SingleRating, error)
 	GetUserRating(ctx context.Context, userId string, ratingKey entity.VariantKey) (*entity.SingleRating, error)
 	SetPuzzleVote(ctx context.Context, userId string, puzzleUUID string, vote int) error
 	GetJobInfo(ctx context.Context, genId int) (time.Time, time.Time, time.Duration, *bool, *string, int, int, [][]int, error)
 	GetPotentialPuzzleGames(ctx context.Context, time1, time2 time.Time, limit int, lexicon string, avoidBots bool) ([]pgtype.Text, error)
 	GetJobLogs(ctx context.Context, limit, offset int) ([]*pb.PuzzleJobLog, error)
+	GetPuzzleReview(ctx context.Context, userId string, puzzleUUID string) (*entity.PuzzleReview, error)
+	SavePuzzleReview(ctx context.Context, userId string, review *entity.PuzzleReview) error
+	RemovePuzzleReview(ctx context.Context, userId string, puzzleUUID string) error
+	GetPuzzleReviewQueue(ctx context.Context, userId string, lexicon string, limit int) ([]*entity.PuzzleReview, error)
+	CountPuzzleReviews(ctx context.Context, userId string, lexicon string, dueBefore time.Time) (int, int, error)
+	GetPuzzleTags(ctx context.Context, puzzleUUID string) ([]macondopb.PuzzleTag, error)
+	GetPuzzleTagRatings(ctx context.Context, userId string, lexicon string) (map[macondopb.PuzzleTag]*entity.SingleRating, error)
+	CreatePuzzleSet(ctx context.Context, creatorId string, title string, description string, lexicon string, puzzleUUIDs []string) (string, error)
+	UpdatePuzzleSet(ctx context.Context, setUUID string, title string, description string, puzzleUUIDs []string) error
+	DeletePuzzleSet(ctx context.Context, setUUID string) error
+	GetPuzzleSets(ctx context.Context, lexicon string) ([]*pb.PuzzleSet, error)
+	GetPuzzleSet(ctx context.Context, setUUID string, userId string) (*pb.PuzzleSet, error)
+	CreatePuzzleRushSession(ctx context.Context, userId string, lexicon string, duration time.Duration, targetRating float64) (*entity.PuzzleRushSession, error)
+	GetPuzzleRushSession(ctx context.Context, sessionUUID string) (*entity.PuzzleRushSession, error)
+	SavePuzzleRushAnswer(ctx context.Context, session *entity.PuzzleRushSession, correct bool, nextTargetRating float64) (*entity.PuzzleRushSession, error)
+	FinishPuzzleRushSession(ctx context.Context, sessionUUID string, finishedAt time.Time) error
+	GetPuzzleRushLeaderboard(ctx context.Context, lexicon string, duration time.Duration, since time.Time, limit int) ([]*pb.PuzzleRushLeaderboardEntry, error)
+	GetDailyPuzzle(ctx context.Context, lexicon string, date time.Time, minScore int) (string, error)
+	SetDailyPuzzle(ctx context.Context, lexicon string, date time.Time, puzzleUUID string) error
+	StartDailyPuzzleAttempt(ctx context.Context, userId string, lexicon string, date time.Time) (*entity.DailyPuzzleAttempt, error)
+	SaveDailyPuzzleAnswer(ctx context.Context, userId string, lexicon string, date time.Time, correct bool, answerKey string) error
+	GetDailyPuzzleStats(ctx context.Context, lexicon string, date time.Time, wrongAnswerLimit int) (*pb.DailyPuzzleStats, error)
+	GetDailyPuzzleSolveDates(ctx context.Context, userId string, lexicon string) ([]time.Time, error)
+	GetDailyPuzzleArchive(ctx context.Context, userId string, lexicon string, before time.Time, limit int, offset int) ([]*pb.DailyPuzzleArchiveEntry, error)
 }
 
+// CreatePuzzlesFromGame creates puzzles from g. Native games are already
+// in the games table; games of any other type are added to it if they have
+// puzzles.
 func CreatePuzzlesFromGame(ctx context.Context, eqLossLimit uint32, req *macondopb.PuzzleGenerationRequest, reqId int, gs gameplay.GameStore, ps PuzzleStore,
 	g *entity.Game, authorId string, gt ipc.GameType, multiple bool) ([]*macondopb.PuzzleCreationResponse, error) {
 
+	return createPuzzlesFromGame(ctx, eqLossLimit, req, reqId, gs, ps, g, authorId, gt, gt == ipc.GameType_NATIVE, multiple, true)
+}
+
+// createPuzzlesFromGame creates puzzles from g. Puzzles that aren't valid
+// aren't served until they are approved.
+func createPuzzlesFromGame(ctx context.Context, eqLossLimit uint32, req *macondopb.PuzzleGenerationRequest, reqId int, gs gameplay.GameStore, ps PuzzleStore,
+	g *entity.Game, authorId string, gt ipc.GameType, gameExists bool, multiple bool, valid bool) ([]*macondopb.PuzzleCreationResponse, error) {
+
 	pzls, err := macondopuzzles.CreatePuzzlesFromGame(g.Config(), int(eqLossLimit), &g.Game, req)
 	if err != nil {
 		return nil, err
@@ -78,14 +116,14 @@ func CreatePuzzlesFromGame(ctx context.Context, eqLossLimit uint32, req *macondo
 				return nil, fmt.Errorf("bucket index does not exist in buckets: %d", pzl.BucketIndex)
 			}
 			if req.Buckets[arrIndex].Size > 0 {
-				if gt != ipc.GameType_NATIVE && !gameCreated {
+				if !gameExists && !gameCreated {
 					err = gs.CreateRaw(ctx, g, gt)
 					if err != nil {
 						return nil, err
 					}
 					gameCreated = true
 				}
-				err := ps.CreatePuzzle(ctx, pzl.GameId, pzl.TurnNumber, pzl.Answer, authorId, g.GameReq.Lexicon, "", "", pzl.Tags, reqId, pzl.BucketIndex)
+				err := ps.CreatePuzzle(ctx, pzl.GameId, pzl.TurnNumber, pzl.Answer, authorId, g.GameReq.Lexicon, "", "", pzl.Tags, reqId, pzl.BucketIndex, valid)
 				if err != nil {
 					return nil, err
 				}
@@ -96,16 +134,18 @@ func CreatePuzzlesFromGame(ctx context.Context, eqLossLimit uint32, req *macondo
 	return pzls, nil
 }
 
-func GetStartPuzzleId(ctx context.Context, ps PuzzleStore, userId string, lexicon string) (string, pb.PuzzleQueryResult, error) {
-	return ps.GetStartPuzzleId(ctx, userId, lexicon, entity.LexiconToPuzzleVariantKey(lexicon))
+// GetStartPuzzleId, GetNextPuzzleId and GetNextClosestRatingPuzzleId only
+// return puzzles that have all of the given tags.
+func GetStartPuzzleId(ctx context.Context, ps PuzzleStore, userId string, lexicon string, tags ...macondopb.PuzzleTag) (string, pb.PuzzleQueryResult, error) {
+	return ps.GetStartPuzzleId(ctx, userId, lexicon, entity.LexiconToPuzzleVariantKey(lexicon), tags)
 }
 
-func GetNextPuzzleId(ctx context.Context, ps PuzzleStore, userId string, lexicon string) (string, pb.PuzzleQueryResult, error) {
-	return ps.GetNextPuzzleId(ctx, userId, lexicon)
+func GetNextPuzzleId(ctx context.Context, ps PuzzleStore, userId string, lexicon string, tags ...macondopb.PuzzleTag) (string, pb.PuzzleQueryResult, error) {
+	return ps.GetNextPuzzleId(ctx, userId, lexicon, tags)
 }
 
-func GetNextClosestRatingPuzzleId(ctx context.Context, ps PuzzleStore, userId string, lexicon string) (string, pb.PuzzleQueryResult, error) {
-	return ps.GetNextClosestRatingPuzzleId(ctx, userId, lexicon, entity.LexiconToPuzzleVariantKey(lexicon))
+func GetNextClosestRatingPuzzleId(ctx context.Context, ps PuzzleStore, userId string, lexicon string, tags ...macondopb.PuzzleTag) (string, pb.PuzzleQueryResult, error) {
+	return ps.GetNextClosestRatingPuzzleId(ctx, userId, lexicon, entity.LexiconToPuzzleVariantKey(lexicon), tags)
 }
 
 func GetPuzzle(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID string) (*macondopb.GameHistory, string, int32, *bool, time.Time, time.Time, *entity.SingleRating, *entity.SingleRating, error) {
@@ -124,6 +164,12 @@ func GetPuzzleJobLogs(ctx context.Context, ps PuzzleStore, limit, offset int) ([
 	return ps.GetJobLogs(ctx, limit, offset)
 }
 
+// ApproveGenerationJob makes the puzzles of a job that needed approval
+// available to everyone. It returns the number of puzzles approved.
+func ApproveGenerationJob(ctx context.Context, ps PuzzleStore, genId int) (int, error) {
+	return ps.ApproveGenerationJob(ctx, genId)
+}
+
 func GetPuzzleAnswer(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID string) (*macondopb.GameEvent, error) {
 	rated, _, _, _, _, _, _, _, err := ps.GetAttempts(ctx, userId, puzzleUUID)
 	if err != nil {
@@ -143,20 +189,12 @@ func SubmitAnswer(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID
 	if err != nil {
 		return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
 	}
-	if req.Rules == nil {
-		return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, errors.New("nil-game-rules")
-	}
-	cfg, err := config.Ctx(ctx)
-	if err != nil {
-		return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
-	}
-	ld, err := tilemapping.GetDistribution(cfg.WGLConfig(), req.Rules.LetterDistributionName)
+	userIsCorrect, err := checkAnswer(ctx, userAnswer, correctAnswer, req)
 	if err != nil {
 		return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
 	}
-	userIsCorrect := answersAreEqual(userAnswer, correctAnswer, ld)
 	// Check if user has already seen this puzzle
-	rated, _, attempts, status, _, _, _, _, err := ps.GetAttempts(ctx, userId, puzzleUUID)
+	rated, _, attempts, status, firstViewTime, _, _, _, err := ps.GetAttempts(ctx, userId, puzzleUUID)
 	if err != nil {
 		return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
 	}
@@ -164,6 +202,7 @@ func SubmitAnswer(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID
 		Int32("attempts", attempts).Msg("equal")
 	var newPuzzleSingleRating *entity.SingleRating
 	var newUserSingleRating *entity.SingleRating
+	var newTagRatings map[macondopb.PuzzleTag]*entity.SingleRating
 	rk := entity.LexiconToPuzzleVariantKey(req.Lexicon)
 
 	if !rated {
@@ -173,44 +212,40 @@ func SubmitAnswer(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID
 			return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
 		}
 
-		spread := glicko.SpreadScaling + 1
-
-		if !userIsCorrect {
-			spread *= -1
-		}
+		now := time.Now().Unix()
+		newUserSingleRating, newPuzzleSingleRating = ratePuzzleAttempt(userRating, puzzleRating, userIsCorrect, now)
 
-		var now = time.Now().Unix()
-		newUserRating, newUserRatingDeviation, newUserVolatility := glicko.Rate(
-			userRating.Rating, userRating.RatingDeviation, userRating.Volatility,
-			puzzleRating.Rating, puzzleRating.RatingDeviation,
-			spread, int(now-userRating.LastGameTimestamp),
-		)
-		newPuzzleRating, newPuzzleRatingDeviation, newPuzzleVolatility := glicko.Rate(
-			puzzleRating.Rating, puzzleRating.RatingDeviation, puzzleRating.Volatility,
-			userRating.Rating, userRating.RatingDeviation,
-			-spread, int(now-puzzleRating.LastGameTimestamp),
-		)
-
-		newUserSingleRating = &entity.SingleRating{
-			Rating:            newUserRating,
-			RatingDeviation:   newUserRatingDeviation,
-			Volatility:        newUserVolatility,
-			LastGameTimestamp: now,
+		// Each of the puzzle's tags has its own user rating, rated against
+		// the puzzle's rating before this attempt.
+		tags, err := ps.GetPuzzleTags(ctx, puzzleUUID)
+		if err != nil {
+			return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
 		}
-
-		newPuzzleSingleRating = &entity.SingleRating{
-			Rating:            newPuzzleRating,
-			RatingDeviation:   newPuzzleRatingDeviation,
-			Volatility:        newPuzzleVolatility,
-			LastGameTimestamp: now,
+		if len(tags) > 0 {
+			tagRatings, err := ps.GetPuzzleTagRatings(ctx, userId, req.Lexicon)
+			if err != nil {
+				return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
+			}
+			newTagRatings = map[macondopb.PuzzleTag]*entity.SingleRating{}
+			for _, tag := range tags {
+				tagRating, ok := tagRatings[tag]
+				if !ok {
+					tagRating = entity.NewDefaultRating(true)
+				}
+				newTagRatings[tag], _ = ratePuzzleAttempt(tagRating, puzzleRating, userIsCorrect, now)
+			}
 		}
 	}
 
-	err = ps.SubmitAnswer(ctx, userId, rk, newUserSingleRating, puzzleUUID, newPuzzleSingleRating, userIsCorrect, showSolution)
+	err = ps.SubmitAnswer(ctx, userId, rk, newUserSingleRating, newTagRatings, puzzleUUID, newPuzzleSingleRating, userIsCorrect, showSolution)
 	if err != nil {
 		return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
 	}
 
+	if !rated {
+		addToReviewQueue(ctx, ps, userId, puzzleUUID, userIsCorrect, showSolution, firstViewTime)
+	}
+
 	_, _, attempts, status, firstAttemptTime, lastAttemptTime, newPuzzleSingleRating, newUserSingleRating, err := ps.GetAttempts(ctx, userId, puzzleUUID)
 	if err != nil {
 		return false, nil, nil, "", -1, "", -1, time.Time{}, time.Time{}, nil, nil, err
@@ -235,18 +270,96 @@ func GetJobInfo(ctx context.Context, ps PuzzleStore, genId int) (time.Time, time
 	return ps.GetJobInfo(ctx, genId)
 }
 
+// ratePuzzleAttempt returns the new ratings of the user and the puzzle after
+// the user's first attempt at it.
+func ratePuzzleAttempt(userRating *entity.SingleRating, puzzleRating *entity.SingleRating, userIsCorrect bool, now int64) (*entity.SingleRating, *entity.SingleRating) {
+	spread := glicko.SpreadScaling + 1
+
+	if !userIsCorrect {
+		spread *= -1
+	}
+
+	newUserRating, newUserRatingDeviation, newUserVolatility := glicko.Rate(
+		userRating.Rating, userRating.RatingDeviation, userRating.Volatility,
+		puzzleRating.Rating, puzzleRating.RatingDeviation,
+		spread, int(now-userRating.LastGameTimestamp),
+	)
+	newPuzzleRating, newPuzzleRatingDeviation, newPuzzleVolatility := glicko.Rate(
+		puzzleRating.Rating, puzzleRating.RatingDeviation, puzzleRating.Volatility,
+		userRating.Rating, userRating.RatingDeviation,
+		-spread, int(now-puzzleRating.LastGameTimestamp),
+	)
+
+	return &entity.SingleRating{
+		Rating:            newUserRating,
+		RatingDeviation:   newUserRatingDeviation,
+		Volatility:        newUserVolatility,
+		LastGameTimestamp: now,
+	}, &entity.SingleRating{
+		Rating:            newPuzzleRating,
+		RatingDeviation:   newPuzzleRatingDeviation,
+		Volatility:        newPuzzleVolatility,
+		LastGameTimestamp: now,
+	}
+}
+
+// checkAnswer checks the user's answer against the puzzle's answer
+func checkAnswer(ctx context.Context, userAnswer *ipc.ClientGameplayEvent, correctAnswer *macondopb.GameEvent, req *ipc.GameRequest) (bool, error) {
+	ld, err := puzzleLetterDistribution(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	return answersAreEqual(userAnswer, correctAnswer, ld), nil
+}
+
+func puzzleLetterDistribution(ctx context.Context, req *ipc.GameRequest) (*tilemapping.LetterDistribution, error) {
+	if req.Rules == nil {
+		return nil, errors.New("nil-game-rules")
+	}
+	cfg, err := config.Ctx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return tilemapping.GetDistribution(cfg.WGLConfig(), req.Rules.LetterDistributionName)
+}
+
 func answersAreEqual(userAnswer *ipc.ClientGameplayEvent, correctAnswer *macondopb.GameEvent, ld *tilemapping.LetterDistribution) bool {
 	if userAnswer == nil {
 		// The user answer is nil when they have given up
 		// and just want the answer without making an attempt
 		return false
 	}
-	// Convert the ClientGameplayEvent to a macondo GameEvent:
+	converted := clientEventToGameEvent(userAnswer, ld)
+	if converted == nil {
+		return false
+	}
+
+	if correctAnswer == nil {
+		log.Info().Msg("puzzle answer nil")
+		return false
+	}
+	log.Debug().Interface("converted", converted).Msg("converted-event")
+
+	if converted.Type == macondopb.GameEvent_TILE_PLACEMENT_MOVE &&
+		correctAnswer.Type == macondopb.GameEvent_TILE_PLACEMENT_MOVE &&
+		countPlayedTiles(converted, ld) == 1 && countPlayedTiles(correctAnswer, ld) == 1 {
+		return uniqueSingleTileKey(converted, ld) == uniqueSingleTileKey(correctAnswer, ld)
+	}
+
+	return converted.Type == correctAnswer.Type &&
+		positionsAreEqual(converted, correctAnswer, ld) &&
+		converted.PlayedTiles == correctAnswer.PlayedTiles &&
+		utilities.SortString(converted.Exchanged) == utilities.SortString(correctAnswer.Exchanged)
+}
+
+// clientEventToGameEvent converts the user's answer to a macondo GameEvent.
+// It returns nil if the answer is malformed.
+func clientEventToGameEvent(userAnswer *ipc.ClientGameplayEvent, ld *tilemapping.LetterDistribution) *macondopb.GameEvent {
 	converted := &macondopb.GameEvent{}
 
 	if len(userAnswer.Tiles) > 0 && len(userAnswer.MachineLetters) > 0 {
 		log.Error().Msg("puzzle-tiles-and-machineletters")
-		return false
+		return nil
 	}
 
 	switch userAnswer.Type {
@@ -276,23 +389,7 @@ func answersAreEqual(userAnswer *ipc.ClientGameplayEvent, correctAnswer *macondo
 	case ipc.ClientGameplayEvent_PASS:
 		converted.Type = macondopb.GameEvent_PASS
 	}
-
-	if correctAnswer == nil {
-		log.Info().Msg("puzzle answer nil")
-		return false
-	}
-	log.Debug().Interface("converted", converted).Msg("converted-event")
-
-	if converted.Type == macondopb.GameEvent_TILE_PLACEMENT_MOVE &&
-		correctAnswer.Type == macondopb.GameEvent_TILE_PLACEMENT_MOVE &&
-		countPlayedTiles(converted, ld) == 1 && countPlayedTiles(correctAnswer, ld) == 1 {
-		return uniqueSingleTileKey(converted, ld) == uniqueSingleTileKey(correctAnswer, ld)
-	}
-
-	return converted.Type == correctAnswer.Type &&
-		positionsAreEqual(converted, correctAnswer, ld) &&
-		converted.PlayedTiles == correctAnswer.PlayedTiles &&
-		utilities.SortString(converted.Exchanged) == utilities.SortString(correctAnswer.Exchanged)
+	return converted
 }
 
 func positionsAreEqual(userAnswer *macondopb.GameEvent, correctAnswer *macondopb.GameEvent, ld *tilemapping.LetterDistribution) bool {
@@ -0,0 +1,197 @@
+package puzzles
+
+import (
+	"context"
+	"math"
+	"time"
+
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
+)
+
+// Reviews are scheduled with the SM-2 algorithm. Each review is graded from
+// 0 to 5; a grade below reviewPassingGrade is a lapse and starts the puzzle
+// over.
+const (
+	initialEaseFactor  = 2.5
+	minimumEaseFactor  = 1.3
+	reviewPassingGrade = 3
+
+	// Puzzles solved faster than this get the best grade
+	fastSolveSeconds = 30
+	// Puzzles that took longer than this to solve are added to the user's
+	// review queue
+	slowSolveSeconds = 120
+
+	DefaultStudySessionSize = 20
+	MaxStudySessionSize     = 100
+	DefaultReviewQueueLimit = 50
+	MaxReviewQueueLimit     = 500
+)
+
+// reviewGrade grades a solving attempt for scheduling.
+func reviewGrade(correct bool, gaveUp bool, secondsTaken int) int {
+	switch {
+	case gaveUp:
+		return 0
+	case !correct:
+		return 1
+	case secondsTaken > slowSolveSeconds:
+		return 3
+	case secondsTaken > fastSolveSeconds:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// needsReview is true if a first attempt at a puzzle went badly enough
+// that the puzzle should be studied again.
+func needsReview(grade int) bool {
+	return grade <= reviewPassingGrade
+}
+
+// newPuzzleReview starts the schedule of a puzzle that has not been
+// reviewed yet.
+func newPuzzleReview(puzzleUUID string) entity.PuzzleReview {
+	return entity.PuzzleReview{
+		PuzzleID:   puzzleUUID,
+		EaseFactor: initialEaseFactor,
+	}
+}
+
+// scheduleReview returns the schedule of a puzzle after a review with the
+// given grade.
+func scheduleReview(r entity.PuzzleReview, grade int, now time.Time) entity.PuzzleReview {
+	if grade < reviewPassingGrade {
+		r.Repetitions = 0
+		r.IntervalDays = 1
+		r.Lapses++
+	} else {
+		r.Repetitions++
+		switch r.Repetitions {
+		case 1:
+			r.IntervalDays = 1
+		case 2:
+			r.IntervalDays = 6
+		default:
+			r.IntervalDays = int(math.Round(float64(r.IntervalDays) * r.EaseFactor))
+		}
+	}
+
+	miss := float64(5 - grade)
+	r.EaseFactor += 0.1 - miss*(0.08+miss*0.02)
+	if r.EaseFactor < minimumEaseFactor {
+		r.EaseFactor = minimumEaseFactor
+	}
+
+	r.LastReviewedAt = now
+	r.DueAt = now.AddDate(0, 0, r.IntervalDays)
+	return r
+}
+
+// addToReviewQueue schedules a puzzle that the user just attempted for the
+// first time, if they failed it or were slow to solve it.
+func addToReviewQueue(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID string,
+	correct bool, gaveUp bool, firstViewTime time.Time) {
+
+	secondsTaken := 0
+	if !firstViewTime.IsZero() {
+		secondsTaken = int(time.Since(firstViewTime).Seconds())
+	}
+	grade := reviewGrade(correct, gaveUp, secondsTaken)
+	if !needsReview(grade) {
+		return
+	}
+	review := scheduleReview(newPuzzleReview(puzzleUUID), grade, time.Now())
+	// The attempt has already been saved, so a failure here only means the
+	// puzzle is not added to the user's review queue.
+	if err := ps.SavePuzzleReview(ctx, userId, &review); err != nil {
+		log.Err(err).Str("userId", userId).Str("puzzleId", puzzleUUID).Msg("add-to-review-queue")
+	}
+}
+
+func GetReviewQueue(ctx context.Context, ps PuzzleStore, userId string, lexicon string, limit int) ([]*entity.PuzzleReview, int, int, error) {
+	if limit <= 0 {
+		limit = DefaultReviewQueueLimit
+	} else if limit > MaxReviewQueueLimit {
+		limit = MaxReviewQueueLimit
+	}
+	reviews, err := ps.GetPuzzleReviewQueue(ctx, userId, lexicon, limit)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	total, due, err := ps.CountPuzzleReviews(ctx, userId, lexicon, time.Now())
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return reviews, due, total, nil
+}
+
+// StartStudySession returns the user's due puzzles, most overdue first. If
+// none are due it returns when the next one will be.
+func StartStudySession(ctx context.Context, ps PuzzleStore, userId string, lexicon string, size int) ([]string, int, time.Time, error) {
+	if size <= 0 {
+		size = DefaultStudySessionSize
+	} else if size > MaxStudySessionSize {
+		size = MaxStudySessionSize
+	}
+	now := time.Now()
+	reviews, err := ps.GetPuzzleReviewQueue(ctx, userId, lexicon, size)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+	_, due, err := ps.CountPuzzleReviews(ctx, userId, lexicon, now)
+	if err != nil {
+		return nil, 0, time.Time{}, err
+	}
+
+	puzzleIds := []string{}
+	for _, r := range reviews {
+		if r.DueAt.After(now) {
+			break
+		}
+		puzzleIds = append(puzzleIds, r.PuzzleID)
+	}
+	var nextDueAt time.Time
+	if len(puzzleIds) == 0 && len(reviews) > 0 {
+		nextDueAt = reviews[0].DueAt
+	}
+	return puzzleIds, due, nextDueAt, nil
+}
+
+// SubmitReview grades a review of a puzzle in the user's review queue and
+// schedules the next one. Reviews do not change any ratings, and the answer
+// is always returned so the user can study it.
+func SubmitReview(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID string,
+	userAnswer *ipc.ClientGameplayEvent, secondsTaken int, showSolution bool) (bool, *macondopb.GameEvent, *entity.PuzzleReview, error) {
+
+	review, err := ps.GetPuzzleReview(ctx, userId, puzzleUUID)
+	if err != nil {
+		return false, nil, nil, err
+	}
+	if review == nil {
+		return false, nil, nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_REVIEW_NOT_FOUND, userId, puzzleUUID)
+	}
+	correctAnswer, _, _, _, req, _, err := ps.GetAnswer(ctx, puzzleUUID)
+	if err != nil {
+		return false, nil, nil, err
+	}
+	userIsCorrect, err := checkAnswer(ctx, userAnswer, correctAnswer, req)
+	if err != nil {
+		return false, nil, nil, err
+	}
+
+	updated := scheduleReview(*review, reviewGrade(userIsCorrect, showSolution, secondsTaken), time.Now())
+	if err := ps.SavePuzzleReview(ctx, userId, &updated); err != nil {
+		return false, nil, nil, err
+	}
+	return userIsCorrect, correctAnswer, &updated, nil
+}
+
+func RemoveFromReviewQueue(ctx context.Context, ps PuzzleStore, userId string, puzzleUUID string) error {
+	return ps.RemovePuzzleReview(ctx, userId, puzzleUUID)
+}
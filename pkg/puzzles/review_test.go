@@ -0,0 +1,61 @@
+package puzzles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestReviewGrade(t *testing.T) {
+	is := is.New(t)
+	is.Equal(reviewGrade(false, true, 10), 0)
+	is.Equal(reviewGrade(false, false, 10), 1)
+	is.Equal(reviewGrade(true, false, 10), 5)
+	is.Equal(reviewGrade(true, false, 60), 4)
+	is.Equal(reviewGrade(true, false, 300), 3)
+
+	is.True(needsReview(reviewGrade(false, false, 10)))
+	is.True(needsReview(reviewGrade(true, false, 300)))
+	is.True(!needsReview(reviewGrade(true, false, 60)))
+}
+
+func TestScheduleReview(t *testing.T) {
+	is := is.New(t)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	// A failed puzzle comes back the next day
+	r := scheduleReview(newPuzzleReview("abc"), 1, now)
+	is.Equal(r.PuzzleID, "abc")
+	is.Equal(r.IntervalDays, 1)
+	is.Equal(r.Repetitions, 0)
+	is.Equal(r.Lapses, 1)
+	is.Equal(r.DueAt, now.AddDate(0, 0, 1))
+	is.Equal(r.LastReviewedAt, now)
+
+	// Then after 1 day, 6 days, and growing intervals
+	r = scheduleReview(r, 5, now)
+	is.Equal(r.IntervalDays, 1)
+	r = scheduleReview(r, 5, now)
+	is.Equal(r.IntervalDays, 6)
+	r = scheduleReview(r, 4, now)
+	is.True(r.IntervalDays > 6)
+	is.Equal(r.Repetitions, 3)
+
+	// A lapse starts the puzzle over
+	r = scheduleReview(r, 0, now)
+	is.Equal(r.IntervalDays, 1)
+	is.Equal(r.Repetitions, 0)
+	is.Equal(r.Lapses, 2)
+}
+
+func TestScheduleReviewMinimumEase(t *testing.T) {
+	is := is.New(t)
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	r := newPuzzleReview("abc")
+	for range 10 {
+		r = scheduleReview(r, 0, now)
+	}
+	is.Equal(r.EaseFactor, minimumEaseFactor)
+}
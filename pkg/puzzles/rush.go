@@ -0,0 +1,197 @@
+package puzzles
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"lukechampine.com/frand"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/puzzle_service"
+)
+
+const (
+	PuzzleRushMaxStrikes = 3
+
+	// The first puzzle of a rush is rated around puzzleRushStartRating,
+	// and each solved puzzle makes the next one puzzleRushRatingStep
+	// harder. The target is moved randomly by up to puzzleRushRatingJitter
+	// so that every rush is different.
+	puzzleRushStartRating  = 1000
+	puzzleRushRatingStep   = 40
+	puzzleRushRatingJitter = 50
+
+	// Answers that arrive this long after the time is up still count, to
+	// allow for network latency.
+	puzzleRushGracePeriod = 3 * time.Second
+
+	DefaultPuzzleRushLeaderboardLimit = 50
+	MaxPuzzleRushLeaderboardLimit     = 100
+)
+
+// PuzzleRushMinutes are the lengths a puzzle rush can have. Each length has
+// its own leaderboards.
+var PuzzleRushMinutes = []int{3, 5, 10}
+
+func puzzleRushDuration(userId string, minutes int) (time.Duration, error) {
+	for _, m := range PuzzleRushMinutes {
+		if m == minutes {
+			return time.Duration(minutes) * time.Minute, nil
+		}
+	}
+	return 0, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_INVALID_DURATION, userId, strconv.Itoa(minutes))
+}
+
+func puzzleRushTargetRating(score int) float64 {
+	jitter := (frand.Float64()*2 - 1) * puzzleRushRatingJitter
+	return puzzleRushStartRating + float64(score*puzzleRushRatingStep) + jitter
+}
+
+func puzzleRushTimeIsUp(session *entity.PuzzleRushSession, now time.Time) bool {
+	return now.After(session.EndsAt.Add(puzzleRushGracePeriod))
+}
+
+// scorePuzzleRushAnswer returns the session after an answer to its current
+// puzzle.
+func scorePuzzleRushAnswer(session entity.PuzzleRushSession, correct bool, now time.Time) entity.PuzzleRushSession {
+	if correct {
+		session.Score++
+	} else {
+		session.Strikes++
+		if session.Strikes >= PuzzleRushMaxStrikes {
+			session.FinishedAt = now
+		}
+	}
+	return session
+}
+
+// puzzleRushPeriodStart returns when the leaderboard period containing now
+// started. Days and weeks start at midnight UTC, and weeks start on Monday.
+func puzzleRushPeriodStart(period pb.PuzzleRushPeriod, now time.Time) time.Time {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case pb.PuzzleRushPeriod_DAILY:
+		return day
+	case pb.PuzzleRushPeriod_WEEKLY:
+		daysSinceMonday := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -daysSinceMonday)
+	default:
+		return time.Time{}
+	}
+}
+
+func StartPuzzleRush(ctx context.Context, ps PuzzleStore, userId string, lexicon string, minutes int) (*entity.PuzzleRushSession, error) {
+	duration, err := puzzleRushDuration(userId, minutes)
+	if err != nil {
+		return nil, err
+	}
+	return ps.CreatePuzzleRushSession(ctx, userId, lexicon, duration, puzzleRushTargetRating(0))
+}
+
+// GetPuzzleRush returns one of the user's puzzle rush sessions, finishing it
+// first if its time is up.
+func GetPuzzleRush(ctx context.Context, ps PuzzleStore, userId string, sessionUUID string) (*entity.PuzzleRushSession, error) {
+	session, err := getUserPuzzleRush(ctx, ps, userId, sessionUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.Finished() && puzzleRushTimeIsUp(session, time.Now()) {
+		return finishPuzzleRush(ctx, ps, session, session.EndsAt)
+	}
+	return session, nil
+}
+
+// SubmitPuzzleRushAnswer checks the answer to the session's current puzzle
+// and serves the next one. The answer is always returned, since the puzzle
+// can't be attempted again in this session.
+func SubmitPuzzleRushAnswer(ctx context.Context, ps PuzzleStore, userId string, sessionUUID string,
+	userAnswer *ipc.ClientGameplayEvent) (bool, *macondopb.GameEvent, *entity.PuzzleRushSession, error) {
+
+	session, err := getUserPuzzleRush(ctx, ps, userId, sessionUUID)
+	if err != nil {
+		return false, nil, nil, err
+	}
+	if session.Finished() || session.PuzzleID == "" {
+		return false, nil, nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_ENDED, userId, sessionUUID)
+	}
+	if puzzleRushTimeIsUp(session, time.Now()) {
+		if _, err := finishPuzzleRush(ctx, ps, session, session.EndsAt); err != nil {
+			return false, nil, nil, err
+		}
+		return false, nil, nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_ENDED, userId, sessionUUID)
+	}
+	correctAnswer, _, _, _, req, _, err := ps.GetAnswer(ctx, session.PuzzleID)
+	if err != nil {
+		return false, nil, nil, err
+	}
+	userIsCorrect, err := checkAnswer(ctx, userAnswer, correctAnswer, req)
+	if err != nil {
+		return false, nil, nil, err
+	}
+
+	scored := scorePuzzleRushAnswer(*session, userIsCorrect, time.Now())
+	updated, err := ps.SavePuzzleRushAnswer(ctx, &scored, userIsCorrect, puzzleRushTargetRating(scored.Score))
+	if err != nil {
+		return false, nil, nil, err
+	}
+	return userIsCorrect, correctAnswer, updated, nil
+}
+
+// EndPuzzleRush ends a puzzle rush before its time is up. The score so far
+// counts for the leaderboards.
+func EndPuzzleRush(ctx context.Context, ps PuzzleStore, userId string, sessionUUID string) (*entity.PuzzleRushSession, error) {
+	session, err := getUserPuzzleRush(ctx, ps, userId, sessionUUID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Finished() {
+		return session, nil
+	}
+	finishedAt := time.Now()
+	if finishedAt.After(session.EndsAt) {
+		finishedAt = session.EndsAt
+	}
+	return finishPuzzleRush(ctx, ps, session, finishedAt)
+}
+
+func GetPuzzleRushLeaderboard(ctx context.Context, ps PuzzleStore, lexicon string, minutes int, period pb.PuzzleRushPeriod,
+	limit int) ([]*pb.PuzzleRushLeaderboardEntry, error) {
+
+	duration, err := puzzleRushDuration("", minutes)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultPuzzleRushLeaderboardLimit
+	} else if limit > MaxPuzzleRushLeaderboardLimit {
+		limit = MaxPuzzleRushLeaderboardLimit
+	}
+	return ps.GetPuzzleRushLeaderboard(ctx, lexicon, duration, puzzleRushPeriodStart(period, time.Now()), limit)
+}
+
+// getUserPuzzleRush returns a puzzle rush session if it belongs to the
+// user. Other users' sessions are reported as not found.
+func getUserPuzzleRush(ctx context.Context, ps PuzzleStore, userId string, sessionUUID string) (*entity.PuzzleRushSession, error) {
+	session, err := ps.GetPuzzleRushSession(ctx, sessionUUID)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != userId {
+		return nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_NOT_FOUND, userId, sessionUUID)
+	}
+	return session, nil
+}
+
+func finishPuzzleRush(ctx context.Context, ps PuzzleStore, session *entity.PuzzleRushSession, finishedAt time.Time) (*entity.PuzzleRushSession, error) {
+	if err := ps.FinishPuzzleRushSession(ctx, session.UUID, finishedAt); err != nil {
+		return nil, err
+	}
+	finished := *session
+	finished.FinishedAt = finishedAt
+	finished.PuzzleID = ""
+	return &finished, nil
+}
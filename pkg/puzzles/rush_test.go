@@ -0,0 +1,75 @@
+package puzzles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/puzzle_service"
+)
+
+func TestPuzzleRushDuration(t *testing.T) {
+	is := is.New(t)
+	d, err := puzzleRushDuration("abc", 5)
+	is.NoErr(err)
+	is.Equal(d, 5*time.Minute)
+	_, err = puzzleRushDuration("abc", 4)
+	is.True(err != nil)
+}
+
+func TestScorePuzzleRushAnswer(t *testing.T) {
+	is := is.New(t)
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	session := entity.PuzzleRushSession{StartedAt: start, EndsAt: start.Add(3 * time.Minute)}
+	now := start.Add(time.Minute)
+
+	session = scorePuzzleRushAnswer(session, true, now)
+	is.Equal(session.Score, 1)
+	is.Equal(session.Strikes, 0)
+
+	for i := 1; i < PuzzleRushMaxStrikes; i++ {
+		session = scorePuzzleRushAnswer(session, false, now)
+		is.Equal(session.Strikes, i)
+		is.True(!session.Finished())
+	}
+	session = scorePuzzleRushAnswer(session, false, now)
+	is.Equal(session.Strikes, PuzzleRushMaxStrikes)
+	is.True(session.Finished())
+	is.Equal(session.Score, 1)
+}
+
+func TestPuzzleRushTimeIsUp(t *testing.T) {
+	is := is.New(t)
+	start := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	session := &entity.PuzzleRushSession{StartedAt: start, EndsAt: start.Add(3 * time.Minute)}
+	is.True(!puzzleRushTimeIsUp(session, session.EndsAt))
+	// Late answers within the grace period still count
+	is.True(!puzzleRushTimeIsUp(session, session.EndsAt.Add(time.Second)))
+	is.True(puzzleRushTimeIsUp(session, session.EndsAt.Add(puzzleRushGracePeriod+time.Second)))
+}
+
+func TestPuzzleRushPeriodStart(t *testing.T) {
+	is := is.New(t)
+	// A Thursday
+	now := time.Date(2026, 10, 15, 18, 30, 0, 0, time.UTC)
+	is.Equal(puzzleRushPeriodStart(pb.PuzzleRushPeriod_DAILY, now), time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC))
+	is.Equal(puzzleRushPeriodStart(pb.PuzzleRushPeriod_WEEKLY, now), time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC))
+	is.True(puzzleRushPeriodStart(pb.PuzzleRushPeriod_ALL_TIME, now).IsZero())
+
+	// Weeks start on Monday
+	sunday := time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)
+	is.Equal(puzzleRushPeriodStart(pb.PuzzleRushPeriod_WEEKLY, sunday), time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC))
+	monday := time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC)
+	is.Equal(puzzleRushPeriodStart(pb.PuzzleRushPeriod_WEEKLY, monday), time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC))
+}
+
+func TestPuzzleRushTargetRating(t *testing.T) {
+	is := is.New(t)
+	for score := 0; score < 20; score++ {
+		r := puzzleRushTargetRating(score)
+		base := float64(puzzleRushStartRating + score*puzzleRushRatingStep)
+		is.True(r >= base-puzzleRushRatingJitter && r <= base+puzzleRushRatingJitter)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -12,9 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/smithy-go"
 	"github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
 	"github.com/woogles-io/liwords/pkg/apiserver"
 	"github.com/woogles-io/liwords/pkg/auth/rbac"
+	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/pkg/stores/models"
 	"github.com/woogles-io/liwords/pkg/user"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/puzzle_service"
@@ -47,7 +52,7 @@ func NewPuzzleService(ps PuzzleStore, us user.Store, k, c, td string, q *models.
 }
 
 func (ps *PuzzleService) GetStartPuzzleId(ctx context.Context, req *connect.Request[pb.StartPuzzleIdRequest]) (*connect.Response[pb.StartPuzzleIdResponse], error) {
-	puzzleId, pqr, err := GetStartPuzzleId(ctx, ps.puzzleStore, sessionUserUUIDOption(ctx, ps), req.Msg.Lexicon)
+	puzzleId, pqr, err := GetStartPuzzleId(ctx, ps.puzzleStore, sessionUserUUIDOption(ctx, ps), req.Msg.Lexicon, req.Msg.Tags...)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +60,7 @@ func (ps *PuzzleService) GetStartPuzzleId(ctx context.Context, req *connect.Requ
 }
 
 func (ps *PuzzleService) GetNextPuzzleId(ctx context.Context, req *connect.Request[pb.NextPuzzleIdRequest]) (*connect.Response[pb.NextPuzzleIdResponse], error) {
-	puzzleId, pqr, err := GetNextPuzzleId(ctx, ps.puzzleStore, sessionUserUUIDOption(ctx, ps), req.Msg.Lexicon)
+	puzzleId, pqr, err := GetNextPuzzleId(ctx, ps.puzzleStore, sessionUserUUIDOption(ctx, ps), req.Msg.Lexicon, req.Msg.Tags...)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +68,7 @@ func (ps *PuzzleService) GetNextPuzzleId(ctx context.Context, req *connect.Reque
 }
 
 func (ps *PuzzleService) GetNextClosestRatingPuzzleId(ctx context.Context, req *connect.Request[pb.NextClosestRatingPuzzleIdRequest]) (*connect.Response[pb.NextClosestRatingPuzzleIdResponse], error) {
-	puzzleId, pqr, err := GetNextClosestRatingPuzzleId(ctx, ps.puzzleStore, sessionUserUUIDOption(ctx, ps), req.Msg.Lexicon)
+	puzzleId, pqr, err := GetNextClosestRatingPuzzleId(ctx, ps.puzzleStore, sessionUserUUIDOption(ctx, ps), req.Msg.Lexicon, req.Msg.Tags...)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +217,15 @@ func (ps *PuzzleService) StartPuzzleGenJob(ctx context.Context, req *connect.Req
 	if err != nil {
 		return nil, err
 	}
+	if ps.ecsCluster == "" {
+		// Without ECS, the job is run by a local puzzle worker.
+		genId, err := QueueGeneration(ctx, ps.puzzleStore, req.Msg.Request)
+		if err != nil {
+			return nil, apiserver.InternalErr(err)
+		}
+		log.Info().Int("genId", genId).Msg("queued-puzzle-generation")
+		return connect.NewResponse(&pb.APIPuzzleGenerationJobResponse{Started: true}), nil
+	}
 	// This message is meant to be copy-pasted from the terminal
 	// when run locally. On production, though, it will try to execute
 	// an ECS task.
@@ -246,6 +260,436 @@ func (ps *PuzzleService) GetPuzzleJobLogs(ctx context.Context, req *connect.Requ
 	return connect.NewResponse(&pb.PuzzleJobLogsResponse{Logs: logs}), nil
 }
 
+func (ps *PuzzleService) QueuePuzzleGeneration(ctx context.Context, req *connect.Request[pb.QueuePuzzleGenerationRequest]) (*connect.Response[pb.QueuePuzzleGenerationResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	if (req.Msg.GameId == "") == (req.Msg.CollectionUuid == "") {
+		return nil, apiserver.InvalidArg("exactly one of game_id or collection_uuid is required")
+	}
+	// Each job can be up to MaxAnnotatedGamesPerJob games, so users who
+	// don't create puzzles for the site can only queue a few a day.
+	allowed, err := ps.queries.HasPermission(ctx, models.HasPermissionParams{
+		UserID:     int32(user.ID),
+		Permission: string(rbac.CanCreatePuzzles),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		recent, err := ps.queries.CountAuthorPuzzleGenerationJobsSince(ctx, models.CountAuthorPuzzleGenerationJobsSinceParams{
+			AuthorID: user.UUID,
+			Since:    pgtype.Timestamptz{Time: time.Now().Add(-24 * time.Hour), Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if recent >= MaxAnnotatedGameJobsPerDay {
+			return nil, apiserver.PermissionDenied(fmt.Sprintf("you can only queue %d puzzle generation jobs per day", MaxAnnotatedGameJobsPerDay))
+		}
+	}
+
+	var gameIds []string
+	if req.Msg.GameId != "" {
+		owned, err := ps.ownsAnnotatedGame(ctx, user, req.Msg.GameId)
+		if err != nil {
+			return nil, err
+		}
+		if !owned {
+			return nil, apiserver.PermissionDenied("you can only make puzzles from your own annotated games")
+		}
+		gameIds = []string{req.Msg.GameId}
+	} else {
+		collectionUUID, err := uuid.Parse(req.Msg.CollectionUuid)
+		if err != nil {
+			return nil, apiserver.InvalidArg("invalid collection UUID")
+		}
+		owns, err := ps.queries.CheckCollectionOwnership(ctx, models.CheckCollectionOwnershipParams{
+			Uuid:      collectionUUID,
+			CreatorID: int32(user.ID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !owns {
+			return nil, apiserver.PermissionDenied("you can only make puzzles from your own collections")
+		}
+		collection, err := ps.queries.GetCollectionWithGames(ctx, collectionUUID)
+		if err != nil {
+			return nil, err
+		}
+		games, err := ps.queries.GetCollectionGames(ctx, collection.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range games {
+			if !g.IsAnnotated.Bool {
+				continue
+			}
+			// Collections can hold other users' annotated games, but
+			// puzzles are only made from the user's own.
+			owned, err := ps.ownsAnnotatedGame(ctx, user, g.GameID)
+			if err != nil {
+				return nil, err
+			}
+			if owned {
+				gameIds = append(gameIds, g.GameID)
+			}
+		}
+		if len(gameIds) == 0 {
+			return nil, apiserver.InvalidArg("this collection has none of your annotated games")
+		}
+	}
+	if len(gameIds) > MaxAnnotatedGamesPerJob {
+		return nil, apiserver.InvalidArg(fmt.Sprintf("cannot make puzzles from more than %d games at once", MaxAnnotatedGamesPerJob))
+	}
+
+	// Puzzles from users who don't create puzzles for the site wait for
+	// someone who does to approve them.
+	jobId, err := QueueAnnotatedGameGeneration(ctx, ps.puzzleStore, user.UUID, gameIds, req.Msg.Request, !allowed)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.QueuePuzzleGenerationResponse{
+		JobId:    int64(jobId),
+		NumGames: int32(len(gameIds)),
+	}), nil
+}
+
+func (ps *PuzzleService) ApprovePuzzleGenerationJob(ctx context.Context, req *connect.Request[pb.ApprovePuzzleGenerationJobRequest]) (*connect.Response[pb.ApprovePuzzleGenerationJobResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := ps.queries.HasPermission(ctx, models.HasPermissionParams{
+		UserID:     int32(user.ID),
+		Permission: string(rbac.CanCreatePuzzles),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, apiserver.Unauthenticated(errNotAuthorized.Error())
+	}
+	n, err := ApproveGenerationJob(ctx, ps.puzzleStore, int(req.Msg.JobId))
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Str("approver", user.Username).Int64("job_id", req.Msg.JobId).Int("puzzles", n).Msg("approved-puzzle-generation-job")
+	return connect.NewResponse(&pb.ApprovePuzzleGenerationJobResponse{NumPuzzles: int32(n)}), nil
+}
+
+func (ps *PuzzleService) ownsAnnotatedGame(ctx context.Context, user *entity.User, gameId string) (bool, error) {
+	owner, err := ps.queries.GetGameOwner(ctx, gameId)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return owner.CreatorUuid == user.UUID, nil
+}
+
+func (ps *PuzzleService) GetReviewQueue(ctx context.Context, req *connect.Request[pb.ReviewQueueRequest]) (*connect.Response[pb.ReviewQueueResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	reviews, due, total, err := GetReviewQueue(ctx, ps.puzzleStore, user.UUID, req.Msg.Lexicon, int(req.Msg.Limit))
+	if err != nil {
+		return nil, err
+	}
+	pbReviews := make([]*pb.PuzzleReview, len(reviews))
+	for i, r := range reviews {
+		pbReviews[i] = puzzleReviewToProto(r)
+	}
+	return connect.NewResponse(&pb.ReviewQueueResponse{
+		Reviews:    pbReviews,
+		DueCount:   int32(due),
+		TotalCount: int32(total),
+	}), nil
+}
+
+func (ps *PuzzleService) StartStudySession(ctx context.Context, req *connect.Request[pb.StudySessionRequest]) (*connect.Response[pb.StudySessionResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	puzzleIds, due, nextDueAt, err := StartStudySession(ctx, ps.puzzleStore, user.UUID, req.Msg.Lexicon, int(req.Msg.Size))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.StudySessionResponse{PuzzleIds: puzzleIds, DueCount: int32(due)}
+	if !nextDueAt.IsZero() {
+		resp.NextDueAt = timestamppb.New(nextDueAt)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (ps *PuzzleService) SubmitReview(ctx context.Context, req *connect.Request[pb.ReviewSubmissionRequest]) (*connect.Response[pb.ReviewSubmissionResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	userIsCorrect, correctAnswer, review, err := SubmitReview(ctx, ps.puzzleStore, user.UUID, req.Msg.PuzzleId,
+		req.Msg.Answer, int(req.Msg.SecondsTaken), req.Msg.ShowSolution)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.ReviewSubmissionResponse{
+		UserIsCorrect: userIsCorrect,
+		CorrectAnswer: correctAnswer,
+		Review:        puzzleReviewToProto(review),
+	}), nil
+}
+
+func (ps *PuzzleService) RemoveFromReviewQueue(ctx context.Context, req *connect.Request[pb.PuzzleRequest]) (*connect.Response[pb.RemoveFromReviewQueueResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	err = RemoveFromReviewQueue(ctx, ps.puzzleStore, user.UUID, req.Msg.PuzzleId)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.RemoveFromReviewQueueResponse{}), nil
+}
+
+func (ps *PuzzleService) CreatePuzzleSet(ctx context.Context, req *connect.Request[pb.CreatePuzzleSetRequest]) (*connect.Response[pb.PuzzleSetResponse], error) {
+	user, err := ps.authPuzzleCreator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	set, err := CreatePuzzleSet(ctx, ps.puzzleStore, user.UUID, req.Msg.Title, req.Msg.Description, req.Msg.Lexicon, req.Msg.PuzzleIds)
+	if err != nil {
+		return nil, puzzleSetError(err)
+	}
+	return connect.NewResponse(&pb.PuzzleSetResponse{PuzzleSet: set}), nil
+}
+
+func (ps *PuzzleService) UpdatePuzzleSet(ctx context.Context, req *connect.Request[pb.UpdatePuzzleSetRequest]) (*connect.Response[pb.PuzzleSetResponse], error) {
+	user, err := ps.authPuzzleCreator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	set, err := UpdatePuzzleSet(ctx, ps.puzzleStore, user.UUID, req.Msg.SetId, req.Msg.Title, req.Msg.Description, req.Msg.PuzzleIds)
+	if err != nil {
+		return nil, puzzleSetError(err)
+	}
+	return connect.NewResponse(&pb.PuzzleSetResponse{PuzzleSet: set}), nil
+}
+
+func (ps *PuzzleService) DeletePuzzleSet(ctx context.Context, req *connect.Request[pb.PuzzleSetRequest]) (*connect.Response[pb.DeletePuzzleSetResponse], error) {
+	_, err := ps.authPuzzleCreator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = DeletePuzzleSet(ctx, ps.puzzleStore, req.Msg.SetId)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.DeletePuzzleSetResponse{}), nil
+}
+
+func (ps *PuzzleService) GetPuzzleSets(ctx context.Context, req *connect.Request[pb.PuzzleSetsRequest]) (*connect.Response[pb.PuzzleSetsResponse], error) {
+	sets, err := GetPuzzleSets(ctx, ps.puzzleStore, req.Msg.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleSetsResponse{PuzzleSets: sets}), nil
+}
+
+func (ps *PuzzleService) GetPuzzleSet(ctx context.Context, req *connect.Request[pb.PuzzleSetRequest]) (*connect.Response[pb.PuzzleSetResponse], error) {
+	set, err := GetPuzzleSet(ctx, ps.puzzleStore, sessionUserUUIDOption(ctx, ps), req.Msg.SetId)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleSetResponse{PuzzleSet: set}), nil
+}
+
+func (ps *PuzzleService) GetPuzzleTagRatings(ctx context.Context, req *connect.Request[pb.PuzzleTagRatingsRequest]) (*connect.Response[pb.PuzzleTagRatingsResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	ratings, err := GetPuzzleTagRatings(ctx, ps.puzzleStore, user.UUID, req.Msg.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleTagRatingsResponse{Ratings: ratings}), nil
+}
+
+func (ps *PuzzleService) StartPuzzleRush(ctx context.Context, req *connect.Request[pb.StartPuzzleRushRequest]) (*connect.Response[pb.PuzzleRushResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	session, err := StartPuzzleRush(ctx, ps.puzzleStore, user.UUID, req.Msg.Lexicon, int(req.Msg.Minutes))
+	if err != nil {
+		return nil, err
+	}
+	state, err := ps.puzzleRushState(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleRushResponse{State: state}), nil
+}
+
+func (ps *PuzzleService) GetPuzzleRush(ctx context.Context, req *connect.Request[pb.PuzzleRushRequest]) (*connect.Response[pb.PuzzleRushResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	session, err := GetPuzzleRush(ctx, ps.puzzleStore, user.UUID, req.Msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ps.puzzleRushState(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleRushResponse{State: state}), nil
+}
+
+func (ps *PuzzleService) SubmitPuzzleRushAnswer(ctx context.Context, req *connect.Request[pb.PuzzleRushAnswerRequest]) (*connect.Response[pb.PuzzleRushAnswerResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	userIsCorrect, correctAnswer, session, err := SubmitPuzzleRushAnswer(ctx, ps.puzzleStore, user.UUID, req.Msg.SessionId, req.Msg.Answer)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ps.puzzleRushState(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleRushAnswerResponse{
+		UserIsCorrect: userIsCorrect,
+		CorrectAnswer: correctAnswer,
+		State:         state,
+	}), nil
+}
+
+func (ps *PuzzleService) EndPuzzleRush(ctx context.Context, req *connect.Request[pb.PuzzleRushRequest]) (*connect.Response[pb.PuzzleRushResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	session, err := EndPuzzleRush(ctx, ps.puzzleStore, user.UUID, req.Msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ps.puzzleRushState(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleRushResponse{State: state}), nil
+}
+
+func (ps *PuzzleService) GetPuzzleRushLeaderboard(ctx context.Context, req *connect.Request[pb.PuzzleRushLeaderboardRequest]) (*connect.Response[pb.PuzzleRushLeaderboardResponse], error) {
+	entries, err := GetPuzzleRushLeaderboard(ctx, ps.puzzleStore, req.Msg.Lexicon, int(req.Msg.Minutes), req.Msg.Period, int(req.Msg.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.PuzzleRushLeaderboardResponse{Entries: entries}), nil
+}
+
+func (ps *PuzzleService) GetDailyPuzzle(ctx context.Context, req *connect.Request[pb.DailyPuzzleRequest]) (*connect.Response[pb.DailyPuzzleResponse], error) {
+	userId := sessionUserUUIDOption(ctx, ps)
+	resp, err := GetDailyPuzzle(ctx, ps.puzzleStore, userId, req.Msg.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (ps *PuzzleService) SubmitDailyPuzzleAnswer(ctx context.Context, req *connect.Request[pb.DailyPuzzleAnswerRequest]) (*connect.Response[pb.DailyPuzzleAnswerResponse], error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := SubmitDailyPuzzleAnswer(ctx, ps.puzzleStore, user.UUID, req.Msg.Lexicon, req.Msg.Answer)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (ps *PuzzleService) GetDailyPuzzleArchive(ctx context.Context, req *connect.Request[pb.DailyPuzzleArchiveRequest]) (*connect.Response[pb.DailyPuzzleArchiveResponse], error) {
+	userId := sessionUserUUIDOption(ctx, ps)
+	entries, err := GetDailyPuzzleArchive(ctx, ps.puzzleStore, userId, req.Msg.Lexicon, int(req.Msg.Limit), int(req.Msg.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.DailyPuzzleArchiveResponse{Puzzles: entries}), nil
+}
+
+func (ps *PuzzleService) SetDailyPuzzle(ctx context.Context, req *connect.Request[pb.SetDailyPuzzleRequest]) (*connect.Response[pb.SetDailyPuzzleResponse], error) {
+	user, err := ps.authPuzzleCreator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = SetDailyPuzzle(ctx, ps.puzzleStore, user.UUID, req.Msg.Lexicon, req.Msg.Date, req.Msg.PuzzleId)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.SetDailyPuzzleResponse{}), nil
+}
+
+// puzzleRushState includes the current puzzle of the session, so that
+// viewing it doesn't count as an attempt at the puzzle outside of the rush.
+func (ps *PuzzleService) puzzleRushState(ctx context.Context, session *entity.PuzzleRushSession) (*pb.PuzzleRushState, error) {
+	state := &pb.PuzzleRushState{
+		SessionId:  session.UUID,
+		Lexicon:    session.Lexicon,
+		Minutes:    int32(session.Duration.Minutes()),
+		StartedAt:  timestamppb.New(session.StartedAt),
+		EndsAt:     timestamppb.New(session.EndsAt),
+		Score:      int32(session.Score),
+		Strikes:    int32(session.Strikes),
+		MaxStrikes: PuzzleRushMaxStrikes,
+		Finished:   session.Finished(),
+		PuzzleId:   session.PuzzleID,
+	}
+	if session.PuzzleID != "" {
+		hist, beforeText, _, _, _, _, _, _, err := GetPuzzle(ctx, ps.puzzleStore, "", session.PuzzleID)
+		if err != nil {
+			return nil, err
+		}
+		state.History = hist
+		state.BeforeText = beforeText
+	}
+	return state, nil
+}
+
+// authPuzzleCreator returns the session user if they can create puzzles.
+func (ps *PuzzleService) authPuzzleCreator(ctx context.Context) (*entity.User, error) {
+	user, err := apiserver.AuthUser(ctx, ps.userStore)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := ps.queries.HasPermission(ctx, models.HasPermissionParams{
+		UserID:     int32(user.ID),
+		Permission: string(rbac.CanCreatePuzzles),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, apiserver.Unauthenticated(errNotAuthorized.Error())
+	}
+	return user, nil
+}
+
+func puzzleSetError(err error) error {
+	switch err {
+	case errPuzzleSetNoTitle, errPuzzleSetTitleTooLong, errPuzzleSetDescriptionTooLong, errPuzzleSetTooLarge:
+		return apiserver.InvalidArg(err.Error())
+	}
+	return err
+}
+
 func invokeECSPuzzleGen(ctx context.Context, arg, cluster, taskdef string) error {
 	cfg, err := config.LoadDefaultConfig(ctx, config.WithClientLogMode(aws.LogRetries|aws.LogRequestWithBody))
 	if err != nil {
@@ -304,3 +748,17 @@ func boolPtrToPuzzleStatus(b *bool) pb.PuzzleStatus {
 	}
 	return status
 }
+
+func puzzleReviewToProto(r *entity.PuzzleReview) *pb.PuzzleReview {
+	review := &pb.PuzzleReview{
+		PuzzleId:     r.PuzzleID,
+		DueAt:        timestamppb.New(r.DueAt),
+		IntervalDays: int32(r.IntervalDays),
+		Repetitions:  int32(r.Repetitions),
+		Lapses:       int32(r.Lapses),
+	}
+	if !r.LastReviewedAt.IsZero() {
+		review.LastReviewedAt = timestamppb.New(r.LastReviewedAt)
+	}
+	return review
+}
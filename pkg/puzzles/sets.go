@@ -0,0 +1,106 @@
+package puzzles
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/puzzle_service"
+)
+
+const (
+	MaxPuzzleSetTitleLength       = 100
+	MaxPuzzleSetDescriptionLength = 2000
+	MaxPuzzleSetSize              = 500
+)
+
+var (
+	errPuzzleSetNoTitle            = errors.New("puzzle set must have a title")
+	errPuzzleSetTitleTooLong       = errors.New("puzzle set title is too long")
+	errPuzzleSetDescriptionTooLong = errors.New("puzzle set description is too long")
+	errPuzzleSetTooLarge           = errors.New("puzzle set has too many puzzles")
+)
+
+func validatePuzzleSet(title string, description string, puzzleUUIDs []string) error {
+	if strings.TrimSpace(title) == "" {
+		return errPuzzleSetNoTitle
+	}
+	if len(title) > MaxPuzzleSetTitleLength {
+		return errPuzzleSetTitleTooLong
+	}
+	if len(description) > MaxPuzzleSetDescriptionLength {
+		return errPuzzleSetDescriptionTooLong
+	}
+	if len(puzzleUUIDs) > MaxPuzzleSetSize {
+		return errPuzzleSetTooLarge
+	}
+	return nil
+}
+
+func CreatePuzzleSet(ctx context.Context, ps PuzzleStore, creatorId string, title string, description string,
+	lexicon string, puzzleUUIDs []string) (*pb.PuzzleSet, error) {
+
+	if err := validatePuzzleSet(title, description, puzzleUUIDs); err != nil {
+		return nil, err
+	}
+	setUUID, err := ps.CreatePuzzleSet(ctx, creatorId, strings.TrimSpace(title), description, lexicon, puzzleUUIDs)
+	if err != nil {
+		return nil, err
+	}
+	return ps.GetPuzzleSet(ctx, setUUID, creatorId)
+}
+
+func UpdatePuzzleSet(ctx context.Context, ps PuzzleStore, userId string, setUUID string, title string,
+	description string, puzzleUUIDs []string) (*pb.PuzzleSet, error) {
+
+	if err := validatePuzzleSet(title, description, puzzleUUIDs); err != nil {
+		return nil, err
+	}
+	err := ps.UpdatePuzzleSet(ctx, setUUID, strings.TrimSpace(title), description, puzzleUUIDs)
+	if err != nil {
+		return nil, err
+	}
+	return ps.GetPuzzleSet(ctx, setUUID, userId)
+}
+
+func DeletePuzzleSet(ctx context.Context, ps PuzzleStore, setUUID string) error {
+	return ps.DeletePuzzleSet(ctx, setUUID)
+}
+
+func GetPuzzleSets(ctx context.Context, ps PuzzleStore, lexicon string) ([]*pb.PuzzleSet, error) {
+	return ps.GetPuzzleSets(ctx, lexicon)
+}
+
+// GetPuzzleSet returns a puzzle set with the user's result on each of its
+// puzzles. userId is empty for anonymous users.
+func GetPuzzleSet(ctx context.Context, ps PuzzleStore, userId string, setUUID string) (*pb.PuzzleSet, error) {
+	return ps.GetPuzzleSet(ctx, setUUID, userId)
+}
+
+func GetPuzzleTagRatings(ctx context.Context, ps PuzzleStore, userId string, lexicon string) ([]*pb.PuzzleTagRating, error) {
+	ratings, err := ps.GetPuzzleTagRatings(ctx, userId, lexicon)
+	if err != nil {
+		return nil, err
+	}
+	return puzzleTagRatings(ratings), nil
+}
+
+// puzzleTagRatings returns the tag ratings in tag order.
+func puzzleTagRatings(ratings map[macondopb.PuzzleTag]*entity.SingleRating) []*pb.PuzzleTagRating {
+	tagRatings := make([]*pb.PuzzleTagRating, 0, len(ratings))
+	for tag, r := range ratings {
+		tagRatings = append(tagRatings, &pb.PuzzleTagRating{
+			Tag:             tag,
+			Rating:          int32(r.Rating + 0.5),
+			RatingDeviation: int32(r.RatingDeviation + 0.5),
+		})
+	}
+	sort.Slice(tagRatings, func(i, j int) bool {
+		return tagRatings[i].Tag < tagRatings[j].Tag
+	})
+	return tagRatings
+}
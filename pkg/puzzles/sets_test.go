@@ -0,0 +1,50 @@
+package puzzles
+
+import (
+	"strings"
+	"testing"
+
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+)
+
+func TestValidatePuzzleSet(t *testing.T) {
+	is := is.New(t)
+	is.NoErr(validatePuzzleSet("Bingos", "", []string{"a", "b"}))
+	is.Equal(validatePuzzleSet("  ", "", nil), errPuzzleSetNoTitle)
+	is.Equal(validatePuzzleSet(strings.Repeat("x", MaxPuzzleSetTitleLength+1), "", nil), errPuzzleSetTitleTooLong)
+	is.Equal(validatePuzzleSet("Bingos", strings.Repeat("x", MaxPuzzleSetDescriptionLength+1), nil), errPuzzleSetDescriptionTooLong)
+	is.Equal(validatePuzzleSet("Bingos", "", make([]string, MaxPuzzleSetSize+1)), errPuzzleSetTooLarge)
+}
+
+func TestPuzzleTagRatings(t *testing.T) {
+	is := is.New(t)
+	ratings := puzzleTagRatings(map[macondopb.PuzzleTag]*entity.SingleRating{
+		macondopb.PuzzleTag_CEL_ONLY: {Rating: 1499.6, RatingDeviation: 80.2},
+		macondopb.PuzzleTag_BINGO:    {Rating: 1620.4, RatingDeviation: 120.5},
+	})
+	is.Equal(len(ratings), 2)
+	is.Equal(ratings[0].Tag, macondopb.PuzzleTag_BINGO)
+	is.Equal(ratings[0].Rating, int32(1620))
+	is.Equal(ratings[0].RatingDeviation, int32(121))
+	is.Equal(ratings[1].Tag, macondopb.PuzzleTag_CEL_ONLY)
+	is.Equal(ratings[1].Rating, int32(1500))
+}
+
+func TestRatePuzzleAttempt(t *testing.T) {
+	is := is.New(t)
+	user := entity.NewDefaultRating(true)
+	puzzle := entity.NewDefaultRating(true)
+	now := user.LastGameTimestamp
+
+	newUser, newPuzzle := ratePuzzleAttempt(user, puzzle, true, now)
+	is.True(newUser.Rating > user.Rating)
+	is.True(newPuzzle.Rating < puzzle.Rating)
+	is.Equal(newUser.LastGameTimestamp, now)
+
+	newUser, newPuzzle = ratePuzzleAttempt(user, puzzle, false, now)
+	is.True(newUser.Rating < user.Rating)
+	is.True(newPuzzle.Rating > puzzle.Rating)
+}
@@ -54,47 +54,66 @@ func generateVerificationToken() (string, error) {
 func RegisterUser(ctx context.Context, username string, password string, email string,
 	firstName string, lastName string, birthDate string, countryCode string,
 	userStore user.Store, bot bool, argonConfig config.ArgonConfig, emailDebugMode bool, skipEmailVerification bool) error {
+	u, err := newUser(username, password, email, firstName, lastName, birthDate, countryCode,
+		bot, argonConfig, skipEmailVerification)
+	if err != nil {
+		return err
+	}
+	if err := userStore.New(ctx, u); err != nil {
+		return registrationError(err)
+	}
+	// Send verification email for non-bot, non-skip users
+	if !u.Verified {
+		sendVerificationEmail(emailDebugMode, u)
+	}
+	return nil
+}
+
+// newUser validates a registration and returns the user to insert.
+func newUser(username string, password string, email string,
+	firstName string, lastName string, birthDate string, countryCode string,
+	bot bool, argonConfig config.ArgonConfig, skipEmailVerification bool) (*entity.User, error) {
 	// username = strings.Rep
 	if len(username) < 3 || len(username) > 20 {
-		return errors.New("username must be between 3 and 20 letters in length")
+		return nil, errors.New("username must be between 3 and 20 letters in length")
 	}
 	if strings.IndexFunc(username, func(c rune) bool {
 		return !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '.' || c == '_')
 	}) != -1 {
-		return errors.New("username can only contain letters, digits, period, hyphen or underscore")
+		return nil, errors.New("username can only contain letters, digits, period, hyphen or underscore")
 	}
 	// Should we have other unacceptable usernames?
 	if strings.EqualFold(username, "anonymous") ||
 		strings.EqualFold(username, utilities.CensoredUsername) ||
 		strings.EqualFold(username, utilities.AnotherCensoredUsername) ||
 		strings.EqualFold(username, utilities.YetAnotherCensoredUsername) {
-		return errors.New("username is not acceptable")
+		return nil, errors.New("username is not acceptable")
 	}
 	if strings.HasPrefix(username, "-") || strings.HasPrefix(username, ".") || strings.HasPrefix(username, "_") {
-		return errors.New("username must start with a number or a letter")
+		return nil, errors.New("username must start with a number or a letter")
 	}
 	if strings.HasSuffix(username, "-") || strings.HasSuffix(username, ".") || strings.HasSuffix(username, "_") {
-		return errors.New("username must end with a number or a letter")
+		return nil, errors.New("username must end with a number or a letter")
 	}
 	if strings.HasSuffix(strings.ToLower(username), "bot") {
-		return errors.New("username is not acceptable")
+		return nil, errors.New("username is not acceptable")
 	}
 	if goaway.IsProfane(username) {
-		return errors.New("username is not acceptable")
+		return nil, errors.New("username is not acceptable")
 	}
 
 	if len(password) < 8 {
-		return errors.New("your new password is too short, use 8 or more characters")
+		return nil, errors.New("your new password is too short, use 8 or more characters")
 	}
 	if len(email) < 3 {
-		return errors.New("please use a valid email address")
+		return nil, errors.New("please use a valid email address")
 	}
 	email = strings.TrimSpace(email)
 
 	config := auth.NewPasswordConfig(argonConfig.Time, argonConfig.Memory, argonConfig.Threads, argonConfig.Keylen)
 	hashPass, err := auth.GeneratePassword(config, password)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Generate verification token for non-bot, non-skip users
@@ -105,12 +124,12 @@ func RegisterUser(ctx context.Context, username string, password string, email s
 	if !verified {
 		verificationToken, err = generateVerificationToken()
 		if err != nil {
-			return fmt.Errorf("failed to generate verification token: %w", err)
+			return nil, fmt.Errorf("failed to generate verification token: %w", err)
 		}
 		verificationExpiresAt = time.Now().Add(VerificationTokenExpiration)
 	}
 
-	err = userStore.New(ctx, &entity.User{
+	return &entity.User{
 		Username: username,
 		Password: hashPass,
 		Email:    email,
@@ -124,36 +143,35 @@ func RegisterUser(ctx context.Context, username string, password string, email s
 		Verified:              verified,
 		VerificationToken:     verificationToken,
 		VerificationExpiresAt: verificationExpiresAt,
-	})
-	if err != nil {
-		if err, ok := err.(*pgconn.PgError); ok {
-			// https://www.postgresql.org/docs/current/errcodes-appendix.html
-			if err.Code == "23505" {
-				if err.ConstraintName == "username_idx" {
-					return errors.New("That username has already been signed up, please log in")
-				} else if err.ConstraintName == "email_idx" {
-					return errors.New("That email address has already been signed up, please log in with your existing username")
-				}
+	}, nil
+}
+
+// registrationError explains a failed user insert.
+func registrationError(err error) error {
+	if err, ok := err.(*pgconn.PgError); ok {
+		// https://www.postgresql.org/docs/current/errcodes-appendix.html
+		if err.Code == "23505" {
+			if err.ConstraintName == "username_idx" {
+				return errors.New("That username has already been signed up, please log in")
+			} else if err.ConstraintName == "email_idx" {
+				return errors.New("That email address has already been signed up, please log in with your existing username")
 			}
 		}
-		return err
 	}
+	return err
+}
 
-	// Send verification email for non-bot, non-skip users
-	if !verified {
-		verificationURL := fmt.Sprintf("https://woogles.io/verify-email?token=%s", verificationToken)
-		emailBody := fmt.Sprintf(VerificationEmailTemplate, username, verificationURL)
+func sendVerificationEmail(emailDebugMode bool, u *entity.User) {
+	verificationURL := fmt.Sprintf("https://woogles.io/verify-email?token=%s", u.VerificationToken)
+	emailBody := fmt.Sprintf(VerificationEmailTemplate, u.Username, verificationURL)
 
-		_, err = emailer.SendSimpleMessage(emailDebugMode, email, "Verify your Woogles.io email", emailBody)
-		if err != nil {
-			log.Error().Err(err).Str("email", email).Str("emailBody", emailBody).Msg("failed to send verification email")
-			// Don't fail registration if email sending fails - user can resend later
-		} else {
-			log.Info().Str("email", email).Str("username", username).Msg("verification email sent")
-		}
+	_, err := emailer.SendSimpleMessage(emailDebugMode, u.Email, "Verify your Woogles.io email", emailBody)
+	if err != nil {
+		log.Error().Err(err).Str("email", u.Email).Str("emailBody", emailBody).Msg("failed to send verification email")
+		// Don't fail registration if email sending fails - user can resend later
+	} else {
+		log.Info().Str("email", u.Email).Str("username", u.Username).Msg("verification email sent")
 	}
-
-	return nil
 }
 
 // VerifyUserEmail verifies a user's email using the token
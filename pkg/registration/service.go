@@ -2,13 +2,23 @@ package registration
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"os"
 
 	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog"
 
 	"github.com/woogles-io/liwords/pkg/apiserver"
 	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/integrations"
+	"github.com/woogles-io/liwords/pkg/sessions"
+	"github.com/woogles-io/liwords/pkg/stores/common"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	userstore "github.com/woogles-io/liwords/pkg/stores/user"
 	"github.com/woogles-io/liwords/pkg/user"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/user_service"
 )
@@ -18,14 +28,27 @@ type RegistrationService struct {
 	argonConfig           config.ArgonConfig
 	emailDebugMode        bool
 	skipEmailVerification bool
+
+	// Needed to finish signups that started with an external login.
+	sessionStore  sessions.SessionStore
+	queries       *models.Queries
+	dbPool        *pgxpool.Pool
+	secretKey     string
+	secureCookies bool
 }
 
-func NewRegistrationService(u user.Store, cfg config.ArgonConfig, emailDebugMode bool, skipEmailVerification bool) *RegistrationService {
+func NewRegistrationService(u user.Store, cfg config.ArgonConfig, emailDebugMode bool, skipEmailVerification bool,
+	s sessions.SessionStore, q *models.Queries, dbPool *pgxpool.Pool, secretKey string, secureCookies bool) *RegistrationService {
 	return &RegistrationService{
 		userStore:             u,
 		argonConfig:           cfg,
 		emailDebugMode:        emailDebugMode,
 		skipEmailVerification: skipEmailVerification,
+		sessionStore:          s,
+		queries:               q,
+		dbPool:                dbPool,
+		secretKey:             secretKey,
+		secureCookies:         secureCookies,
 	}
 }
 
@@ -83,3 +106,85 @@ func (rs *RegistrationService) ResendVerificationEmail(ctx context.Context, r *c
 		Message: "Verification email sent. Please check your inbox.",
 	}), nil
 }
+
+// CompleteOAuthSignup creates an account for a user who logged in with an
+// external identity that isn't linked to any account yet. The account goes
+// through the same rules as a regular registration, but gets a random
+// password; the user logs in through the provider (or resets the password).
+func (rs *RegistrationService) CompleteOAuthSignup(ctx context.Context, r *connect.Request[pb.CompleteOAuthSignupRequest],
+) (*connect.Response[pb.CompleteOAuthSignupResponse], error) {
+	log := zerolog.Ctx(ctx)
+
+	token, err := integrations.ParseSignupToken(rs.secretKey, r.Msg.SignupToken)
+	if err != nil {
+		return nil, apiserver.Unauthenticated("your sign-in has expired, please try again")
+	}
+	ident := token.Identity
+	if ident.Email == "" {
+		return nil, apiserver.InvalidArg("your " + ident.Provider + " account did not share an email address")
+	}
+	pwbytes := make([]byte, 32)
+	if _, err := rand.Read(pwbytes); err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	// The provider already verified the email address, so there's no need
+	// to send our own verification email.
+	u, err := newUser(r.Msg.Username, hex.EncodeToString(pwbytes), ident.Email,
+		"", "", r.Msg.BirthDate, r.Msg.CountryCode,
+		false, rs.argonConfig, rs.skipEmailVerification || ident.EmailVerified)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+
+	// Use up the token, create the user and link the identity all at once,
+	// so that a failure can't leave an account without its identity, and
+	// the token can't be replayed to create another account.
+	tx, err := rs.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := rs.queries.WithTx(tx)
+
+	if err := qtx.DeleteExpiredSignupTokens(ctx); err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	rows, err := qtx.UseSignupToken(ctx, models.UseSignupTokenParams{
+		TokenID:   token.ID,
+		ExpiresAt: pgtype.Timestamptz{Time: token.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	if rows == 0 {
+		return nil, apiserver.InvalidArg("this sign-up link was already used, please log in")
+	}
+	if err := userstore.InsertUser(ctx, tx, u); err != nil {
+		return nil, apiserver.InvalidArg(registrationError(err).Error())
+	}
+	err = integrations.LinkIdentity(ctx, qtx, u.UUID, ident)
+	if errors.Is(err, integrations.ErrIdentityLinked) {
+		return nil, apiserver.AlreadyExists("your " + ident.Provider + " account is " + err.Error())
+	} else if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	log.Info().Str("user", u.Username).Str("provider", ident.Provider).Msg("new-oauth-user")
+
+	if !u.Verified {
+		// Same as a regular registration: they need to verify first.
+		sendVerificationEmail(rs.emailDebugMode, u)
+		return connect.NewResponse(&pb.CompleteOAuthSignupResponse{}), nil
+	}
+	sess, err := rs.sessionStore.New(ctx, u)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	err = apiserver.SetDefaultCookie(ctx, sess.ID, rs.secureCookies)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	return connect.NewResponse(&pb.CompleteOAuthSignupResponse{}), nil
+}
@@ -0,0 +1,355 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/domino14/macondo/board"
+	"github.com/domino14/macondo/equity"
+	"github.com/domino14/macondo/game"
+	pb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/domino14/word-golib/cache"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/entity"
+)
+
+// The extended stats are computed from the game history alone, so they can
+// be recomputed for past games. Their subitems are not known in advance
+// (they depend on the alphabet, the board and the scores), so they start
+// out empty.
+var extendedStatNames = []string{
+	entity.OPENINGS_STAT,
+	entity.TILES_KEPT_STAT,
+	entity.LEAVE_VALUES_STAT,
+	entity.RACKS_BY_BLANKS_AND_SS_STAT,
+	entity.BINGOS_BY_BLANKS_AND_SS_STAT,
+	entity.SCORES_BY_PHASE_STAT,
+}
+
+const (
+	earlyPhase  = "early"
+	middlePhase = "middle"
+	endPhase    = "end"
+
+	// Turn scores are counted in buckets of this many points, up to the
+	// last bucket, which counts every score at least as high.
+	scoreBucketSize = 10
+	lastScoreBucket = 100
+)
+
+// InstantiateExtendedStats instantiates a stats object with only the
+// extended stats. playerOneId MUST have gone first in the game.
+func InstantiateExtendedStats(playerOneId string, playerTwoId string) *entity.Stats {
+	return &entity.Stats{
+		PlayerOneId:   playerOneId,
+		PlayerTwoId:   playerTwoId,
+		PlayerOneData: instantiateExtendedPlayerData(),
+		PlayerTwoData: instantiateExtendedPlayerData(),
+		NotableData:   map[string]*entity.StatItem{}}
+}
+
+func instantiateExtendedPlayerData() map[string]*entity.StatItem {
+	data := map[string]*entity.StatItem{}
+	for _, name := range extendedStatNames {
+		incrementType := entity.EventType
+		if name == entity.OPENINGS_STAT {
+			incrementType = entity.GameType
+		}
+		data[name] = &entity.StatItem{Name: name,
+			Total:         0,
+			IncrementType: incrementType,
+			Subitems:      map[string]int{}}
+	}
+	return data
+}
+
+func historyLetterDistribution(info *IncrementInfo) (*tilemapping.LetterDistribution, error) {
+	ldname := info.History.LetterDistribution
+	if ldname == "" {
+		ldname = "english"
+	}
+	return tilemapping.GetDistribution(info.Cfg, ldname)
+}
+
+// playWasReturned is true if the event is a phony whose tiles were taken
+// back.
+func playWasReturned(events []*pb.GameEvent, eventIndex int) bool {
+	return eventIndex+1 < len(events) &&
+		events[eventIndex+1].Type == pb.GameEvent_PHONY_TILES_RETURNED
+}
+
+func isTurn(event *pb.GameEvent) bool {
+	return event.Type == pb.GameEvent_TILE_PLACEMENT_MOVE ||
+		event.Type == pb.GameEvent_PASS ||
+		event.Type == pb.GameEvent_EXCHANGE ||
+		event.Type == pb.GameEvent_UNSUCCESSFUL_CHALLENGE_TURN_LOSS
+}
+
+// tilesPlaced returns the number of tiles the event put on the board.
+func tilesPlaced(event *pb.GameEvent, tm *tilemapping.TileMapping) int {
+	if event.Type != pb.GameEvent_TILE_PLACEMENT_MOVE {
+		return 0
+	}
+	mls, err := tilemapping.ToMachineLetters(event.PlayedTiles, tm)
+	if err != nil {
+		return 0
+	}
+	placed := 0
+	for _, ml := range mls {
+		if ml != 0 {
+			placed++
+		}
+	}
+	return placed
+}
+
+// tilesInBag returns the number of tiles in the bag before the event.
+func tilesInBag(events []*pb.GameEvent, eventIndex int, ld *tilemapping.LetterDistribution) int {
+	inBag := int(ld.NumTotalLetters()) - 2*game.RackTileLimit
+	for i := 0; i < eventIndex; i++ {
+		if !playWasReturned(events, i) {
+			inBag -= tilesPlaced(events[i], ld.TileMapping())
+		}
+	}
+	return max(inBag, 0)
+}
+
+// gamePhase returns the phase of the game given the tiles in the bag. The
+// early game lasts until a third of the bag has been drawn, and the end
+// game starts once the bag is empty.
+func gamePhase(inBag int, fullBag int) string {
+	if inBag <= 0 {
+		return endPhase
+	} else if 3*inBag > 2*fullBag {
+		return earlyPhase
+	}
+	return middlePhase
+}
+
+func scoreBucket(score int) string {
+	bucket := max(score, 0) / scoreBucketSize * scoreBucketSize
+	return strconv.Itoa(min(bucket, lastScoreBucket))
+}
+
+// blanksAndSsKey describes a rack by its blanks and S's, e.g. "1?2S".
+func blanksAndSsKey(rack tilemapping.MachineWord, tm *tilemapping.TileMapping) string {
+	s, err := tm.Val("S")
+	if err != nil {
+		// This alphabet has no S.
+		s = math.MaxUint8
+	}
+	blanks, esses := 0, 0
+	for _, ml := range rack {
+		if ml == 0 {
+			blanks++
+		} else if ml == s {
+			esses++
+		}
+	}
+	return fmt.Sprintf("%d?%dS", blanks, esses)
+}
+
+// leave returns the tiles the event kept on the rack. It is false if the
+// event's tiles can't be taken out of its rack.
+func leave(event *pb.GameEvent, tm *tilemapping.TileMapping) (tilemapping.MachineWord, bool) {
+	rack, err := tilemapping.ToMachineLetters(event.Rack, tm)
+	if err != nil {
+		return nil, false
+	}
+	var used []tilemapping.MachineLetter
+	switch event.Type {
+	case pb.GameEvent_TILE_PLACEMENT_MOVE:
+		used, err = tilemapping.ToMachineLetters(event.PlayedTiles, tm)
+	case pb.GameEvent_EXCHANGE:
+		used, err = tilemapping.ToMachineLetters(event.Exchanged, tm)
+	}
+	if err != nil {
+		return nil, false
+	}
+	for _, ml := range used {
+		if event.Type == pb.GameEvent_TILE_PLACEMENT_MOVE && ml == 0 {
+			// played through
+			continue
+		}
+		ml = ml.IntrinsicTileIdx()
+		idx := -1
+		for i, r := range rack {
+			if r == ml {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, false
+		}
+		rack = append(rack[:idx], rack[idx+1:]...)
+	}
+	return rack, true
+}
+
+func leaveValues(info *IncrementInfo) (*equity.KLV, error) {
+	leavefile := equity.LeavesFilename
+	if layout, _, _ := game.HistoryToVariant(info.History); layout == board.SuperCrosswordGameLayout {
+		leavefile = "super-" + equity.LeavesFilename
+	}
+	leaves, err := cache.Load(info.Cfg, "leavefile:"+info.History.Lexicon+":"+leavefile, equity.LeaveCacheLoadFunc)
+	if err != nil {
+		return nil, err
+	}
+	klv, ok := leaves.(*equity.KLV)
+	if !ok {
+		return nil, fmt.Errorf("unexpected leaves type %T", leaves)
+	}
+	return klv, nil
+}
+
+func addOpenings(info *IncrementInfo) error {
+	events := info.History.GetEvents()
+	if !info.IsPlayerOne || len(events) == 0 || events[0].PlayerIndex != 0 {
+		return nil
+	}
+	event := events[0]
+	info.StatItem.Total++
+	switch {
+	case event.Type == pb.GameEvent_TILE_PLACEMENT_MOVE && playWasReturned(events, 0):
+		info.StatItem.Subitems["phony"]++
+	case event.Type == pb.GameEvent_TILE_PLACEMENT_MOVE:
+		ld, err := historyLetterDistribution(info)
+		if err != nil {
+			return err
+		}
+		info.StatItem.Subitems["length:"+strconv.Itoa(tilesPlaced(event, ld.TileMapping()))]++
+		info.StatItem.Subitems["position:"+event.Position]++
+		info.StatItem.Subitems["points"] += int(event.Score)
+	case event.Type == pb.GameEvent_EXCHANGE:
+		info.StatItem.Subitems["exchange"]++
+	case event.Type == pb.GameEvent_PASS:
+		info.StatItem.Subitems["pass"]++
+	}
+	return nil
+}
+
+// addTilesKept counts the tiles kept after each play or exchange while
+// there are tiles in the bag. addLeaveValues adds up the values of those
+// leaves in hundredths of a point, so that the average value of the leaves
+// a tile was kept in is its leave value subitem over its tiles kept
+// subitem.
+func addTilesKept(info *IncrementInfo) error {
+	return addLeave(info, func(kept tilemapping.MachineWord, _ int, tm *tilemapping.TileMapping) {
+		info.StatItem.Total++
+		for _, ml := range kept {
+			info.StatItem.Subitems[tileName(ml, tm)]++
+		}
+	})
+}
+
+func addLeaveValues(info *IncrementInfo) error {
+	return addLeave(info, func(kept tilemapping.MachineWord, value int, tm *tilemapping.TileMapping) {
+		info.StatItem.Total += value
+		for _, ml := range kept {
+			info.StatItem.Subitems[tileName(ml, tm)] += value
+		}
+	})
+}
+
+func tileName(ml tilemapping.MachineLetter, tm *tilemapping.TileMapping) string {
+	if ml == 0 {
+		return string(tilemapping.BlankToken)
+	}
+	return tm.Letter(ml)
+}
+
+func addLeave(info *IncrementInfo, add func(kept tilemapping.MachineWord, value int, tm *tilemapping.TileMapping)) error {
+	events := info.History.GetEvents()
+	event := events[info.EventIndex]
+	if (event.Type != pb.GameEvent_TILE_PLACEMENT_MOVE && event.Type != pb.GameEvent_EXCHANGE) ||
+		playWasReturned(events, info.EventIndex) {
+		return nil
+	}
+	ld, err := historyLetterDistribution(info)
+	if err != nil {
+		return err
+	}
+	if tilesInBag(events, info.EventIndex, ld) == 0 {
+		return nil
+	}
+	kept, ok := leave(event, ld.TileMapping())
+	if !ok || len(kept) == 0 {
+		return nil
+	}
+	klv, err := leaveValues(info)
+	if err != nil {
+		// Not every lexicon has leave values.
+		log.Debug().Err(err).Str("lexicon", info.History.Lexicon).Msg("no-leave-values")
+		return nil
+	}
+	// LeaveValue sorts the leave it is given.
+	value := klv.LeaveValue(append(tilemapping.MachineWord(nil), kept...))
+	add(kept, int(math.Round(value*100)), ld.TileMapping())
+	return nil
+}
+
+func addRacksByBlanksAndSs(info *IncrementInfo) error {
+	return addByBlanksAndSs(info, false)
+}
+
+func addBingosByBlanksAndSs(info *IncrementInfo) error {
+	return addByBlanksAndSs(info, true)
+}
+
+func addByBlanksAndSs(info *IncrementInfo, onlyBingos bool) error {
+	events := info.History.GetEvents()
+	event := events[info.EventIndex]
+	if !isTurn(event) {
+		return nil
+	}
+	if onlyBingos && (!event.IsBingo || playWasReturned(events, info.EventIndex)) {
+		return nil
+	}
+	// Racks aren't known in every game, e.g. in annotated ones.
+	if event.Rack == "" {
+		return nil
+	}
+	ld, err := historyLetterDistribution(info)
+	if err != nil {
+		return err
+	}
+	rack, err := tilemapping.ToMachineLetters(event.Rack, ld.TileMapping())
+	if err != nil {
+		// A bad rack shouldn't stop the rest of the game's stats.
+		log.Debug().Err(err).Str("rack", event.Rack).Msg("unreadable-rack")
+		return nil
+	}
+	info.StatItem.Total++
+	info.StatItem.Subitems[blanksAndSsKey(rack, ld.TileMapping())]++
+	return nil
+}
+
+// addScoresByPhase counts each turn's score by the phase of the game it was
+// made in. Challenged-off phonies score 0.
+func addScoresByPhase(info *IncrementInfo) error {
+	events := info.History.GetEvents()
+	event := events[info.EventIndex]
+	if !isTurn(event) {
+		return nil
+	}
+	ld, err := historyLetterDistribution(info)
+	if err != nil {
+		return err
+	}
+	score := 0
+	if event.Type == pb.GameEvent_TILE_PLACEMENT_MOVE && !playWasReturned(events, info.EventIndex) {
+		score = int(event.Score)
+	}
+	fullBag := int(ld.NumTotalLetters()) - 2*game.RackTileLimit
+	phase := gamePhase(tilesInBag(events, info.EventIndex, ld), fullBag)
+
+	info.StatItem.Total++
+	info.StatItem.Subitems[phase+":turns"]++
+	info.StatItem.Subitems[phase+":points"] += score
+	info.StatItem.Subitems[phase+":"+scoreBucket(score)]++
+	return nil
+}
@@ -80,6 +80,12 @@ var StatNameToAddFunction = map[string]func(*IncrementInfo) error{
 	entity.ONE_PLAYER_PLAYS_EVERY_E_STAT:          addEveryE,
 	entity.MANY_CHALLENGES_STAT:                   addManyChallenges,
 	entity.FOUR_OR_MORE_CONSECUTIVE_BINGOS_STAT:   addConsecutiveBingos,
+	entity.OPENINGS_STAT:                          addOpenings,
+	entity.TILES_KEPT_STAT:                        addTilesKept,
+	entity.LEAVE_VALUES_STAT:                      addLeaveValues,
+	entity.RACKS_BY_BLANKS_AND_SS_STAT:            addRacksByBlanksAndSs,
+	entity.BINGOS_BY_BLANKS_AND_SS_STAT:           addBingosByBlanksAndSs,
+	entity.SCORES_BY_PHASE_STAT:                   addScoresByPhase,
 }
 
 var StatNameToDataType = map[string]entity.StatItemType{
@@ -121,6 +127,12 @@ var StatNameToDataType = map[string]entity.StatItemType{
 	entity.ONE_PLAYER_PLAYS_EVERY_E_STAT:          entity.ListType,
 	entity.MANY_CHALLENGES_STAT:                   entity.ListType,
 	entity.FOUR_OR_MORE_CONSECUTIVE_BINGOS_STAT:   entity.ListType,
+	entity.OPENINGS_STAT:                          entity.SingleType,
+	entity.TILES_KEPT_STAT:                        entity.SingleType,
+	entity.LEAVE_VALUES_STAT:                      entity.SingleType,
+	entity.RACKS_BY_BLANKS_AND_SS_STAT:            entity.SingleType,
+	entity.BINGOS_BY_BLANKS_AND_SS_STAT:           entity.SingleType,
+	entity.SCORES_BY_PHASE_STAT:                   entity.SingleType,
 }
 
 // InstantiateNewStats instantiates a new stats object. playerOneId MUST
@@ -367,8 +379,9 @@ func combineItems(statItem *entity.StatItem, otherStatItem *entity.StatItem) {
 	}
 
 	if statItem.Subitems != nil {
-		for key := range statItem.Subitems {
-			statItem.Subitems[key] += otherStatItem.Subitems[key]
+		// Some stats only have the subitems they have seen so far.
+		for key, value := range otherStatItem.Subitems {
+			statItem.Subitems[key] += value
 		}
 	}
 }
@@ -932,14 +945,9 @@ func getOccupiedIndexes(info *IncrementInfo, event *pb.GameEvent) [][]int {
 	row := int(event.Row)
 	column := int(event.Column)
 
-	ldname := info.History.LetterDistribution
-	if ldname == "" {
-		ldname = "english"
-	}
-
-	ld, err := tilemapping.GetDistribution(info.Cfg, ldname)
+	ld, err := historyLetterDistribution(info)
 	if err != nil {
-		log.Err(err).Str("dist", ldname).Msg("get-occupied-indexes-get-dist-err")
+		log.Err(err).Str("dist", info.History.LetterDistribution).Msg("get-occupied-indexes-get-dist-err")
 		return occupied
 	}
 	mls, err := tilemapping.ToMachineLetters(event.PlayedTiles, ld.TileMapping())
@@ -989,12 +997,12 @@ func isUnchallengedPhonyEvent(event *pb.GameEvent,
 	cfg *wglconfig.Config) (bool, error) {
 	phony := false
 	if event.Type == pb.GameEvent_TILE_PLACEMENT_MOVE {
-		kwg, err := kwg.GetKWG(cfg, history.Lexicon)
+		kwg, err := entity.GetLexiconKWG(cfg, history.Lexicon)
 		if err != nil {
 			return phony, err
 		}
 		for _, word := range event.WordsFormed {
-			phony, err := isPhony(kwg, word, history.Variant)
+			phony, err := IsPhony(kwg, word, history.Variant)
 			if err != nil {
 				return false, err
 			}
@@ -1007,7 +1015,9 @@ func isUnchallengedPhonyEvent(event *pb.GameEvent,
 	return false, nil
 }
 
-func isPhony(gd *kwg.KWG, word, variant string) (bool, error) {
+// IsPhony returns whether the word is not valid in the lexicon. In
+// WordSmog any anagram of a valid word is valid.
+func IsPhony(gd *kwg.KWG, word, variant string) (bool, error) {
 	lex := kwg.Lexicon{KWG: *gd}
 	machineWord, err := tilemapping.ToMachineWord(word, lex.GetAlphabet())
 	if err != nil {
@@ -1138,7 +1148,7 @@ func instantiatePlayerData() map[string]*entity.StatItem {
 		Total:         0,
 		IncrementType: entity.GameType}
 
-	return map[string]*entity.StatItem{entity.BINGOS_STAT: bingosStat,
+	playerData := map[string]*entity.StatItem{entity.BINGOS_STAT: bingosStat,
 		entity.CHALLENGED_PHONIES_STAT:               challengedPhoniesStat,
 		entity.CHALLENGES_LOST_STAT:                  challengesLostStat,
 		entity.CHALLENGES_WON_STAT:                   challengesWonStat,
@@ -1165,6 +1175,10 @@ func instantiatePlayerData() map[string]*entity.StatItem {
 		entity.VERTICAL_OPENINGS_STAT:                verticalOpeningsStat,
 		entity.WINS_STAT:                             winsStat,
 	}
+	for name, item := range instantiateExtendedPlayerData() {
+		playerData[name] = item
+	}
+	return playerData
 	/*
 		Missing stats:
 			Full rack per turn
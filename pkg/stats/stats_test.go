@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"testing"
 
@@ -311,6 +312,141 @@ func TestPhonyHooks(t *testing.T) {
 	listStatStore.Disconnect()
 }
 
+func TestExtendedStats(t *testing.T) {
+	is := is.New(t)
+
+	ctx := context.Background()
+	filename := "./testdata/doug_vs_emely.gcg"
+	history, err := gcgio.ParseGCG(DefaultConfig.MacondoConfig(), filename)
+	is.NoErr(err)
+
+	// The extended stats don't keep any lists, so they don't need a store.
+	stats := InstantiateExtendedStats("1", "2")
+	err = AddGame(ctx, stats, nil, history, &ipc.GameRequest{}, DefaultConfig.WGLConfig(),
+		&ipc.GameEndedEvent{}, filename)
+	is.NoErr(err)
+
+	openings := stats.PlayerOneData[entity.OPENINGS_STAT]
+	is.Equal(openings.Total, 1)
+	is.Equal(openings.Subitems["length:5"], 1)
+	is.Equal(openings.Subitems["position:8D"], 1)
+	is.Equal(openings.Subitems["points"], 32)
+	is.Equal(stats.PlayerTwoData[entity.OPENINGS_STAT].Total, 0)
+
+	racks := stats.PlayerOneData[entity.RACKS_BY_BLANKS_AND_SS_STAT]
+	is.Equal(racks.Total, 13)
+	total := 0
+	for _, n := range racks.Subitems {
+		total += n
+	}
+	is.Equal(total, racks.Total)
+
+	phases := stats.PlayerOneData[entity.SCORES_BY_PHASE_STAT]
+	is.Equal(phases.Total, racks.Total)
+	is.Equal(phases.Subitems["early:turns"]+phases.Subitems["middle:turns"]+phases.Subitems["end:turns"],
+		phases.Total)
+}
+
+func TestRacksByBlanksAndSs(t *testing.T) {
+	is := is.New(t)
+
+	history := &pb.GameHistory{
+		LetterDistribution: "english",
+		Events: []*pb.GameEvent{
+			{Type: pb.GameEvent_TILE_PLACEMENT_MOVE, Rack: "?ADESST"},
+			// Unknown and unreadable racks are left out.
+			{Type: pb.GameEvent_TILE_PLACEMENT_MOVE, Rack: ""},
+			{Type: pb.GameEvent_PASS, Rack: "AB3"},
+		},
+	}
+	item := &entity.StatItem{Subitems: map[string]int{}}
+	for i := range history.Events {
+		err := addRacksByBlanksAndSs(&IncrementInfo{
+			Cfg:        DefaultConfig.WGLConfig(),
+			History:    history,
+			EventIndex: i,
+			StatItem:   item,
+		})
+		is.NoErr(err)
+	}
+	is.Equal(item.Total, 1)
+	is.Equal(item.Subitems, map[string]int{"1?2S": 1})
+}
+
+func TestLeaveStats(t *testing.T) {
+	is := is.New(t)
+
+	// The repo's data directory has the leave values the front end uses.
+	t.Setenv("MACONDO_DATA_PATH", "../../data")
+	cfg := &config.Config{}
+	is.NoErr(cfg.Load(nil))
+	cfg.MacondoConfig().Set(macondoconfig.ConfigDefaultLexicon, "CSW19")
+
+	ctx := context.Background()
+	filename := "./testdata/doug_vs_emely.gcg"
+	history, err := gcgio.ParseGCG(cfg.MacondoConfig(), filename)
+	is.NoErr(err)
+
+	stats := InstantiateExtendedStats("1", "2")
+	err = AddGame(ctx, stats, nil, history, &ipc.GameRequest{}, cfg.WGLConfig(),
+		&ipc.GameEndedEvent{}, filename)
+	is.NoErr(err)
+
+	// doug's leaves while there were tiles in the bag. DONATES and
+	// REPLIGION kept nothing.
+	leaves := []string{"NV", "OS", "AINR", "R", "?DRU", "?INRU", "?EINR", "O", "HMOP", "HIMO"}
+	klv, err := leaveValues(&IncrementInfo{Cfg: cfg.WGLConfig(), History: history})
+	is.NoErr(err)
+	ld, err := tilemapping.GetDistribution(cfg.WGLConfig(), "english")
+	is.NoErr(err)
+
+	tilesKept := map[string]int{}
+	keptValues := map[string]int{}
+	total := 0
+	for _, l := range leaves {
+		mw, err := tilemapping.ToMachineWord(l, ld.TileMapping())
+		is.NoErr(err)
+		value := int(math.Round(klv.LeaveValue(mw) * 100))
+		is.True(value != 0)
+		total += value
+		for _, r := range l {
+			tilesKept[string(r)]++
+			keptValues[string(r)] += value
+		}
+	}
+
+	kept := stats.PlayerOneData[entity.TILES_KEPT_STAT]
+	is.Equal(kept.Total, len(leaves))
+	is.Equal(kept.Subitems, tilesKept)
+	is.Equal(kept.Subitems["?"], 3)
+	is.Equal(kept.Subitems["N"], 4)
+
+	values := stats.PlayerOneData[entity.LEAVE_VALUES_STAT]
+	is.Equal(values.Total, total)
+	is.Equal(values.Subitems, keptValues)
+	// A blank is worth keeping and a V isn't.
+	is.True(values.Subitems["?"] > 0)
+	is.True(values.Subitems["V"] < 0)
+
+	is.Equal(stats.PlayerTwoData[entity.TILES_KEPT_STAT].Total, 11)
+}
+
+func TestGamePhase(t *testing.T) {
+	is := is.New(t)
+
+	is.Equal(gamePhase(86, 86), earlyPhase)
+	is.Equal(gamePhase(58, 86), earlyPhase)
+	is.Equal(gamePhase(57, 86), middlePhase)
+	is.Equal(gamePhase(1, 86), middlePhase)
+	is.Equal(gamePhase(0, 86), endPhase)
+
+	is.Equal(scoreBucket(0), "0")
+	is.Equal(scoreBucket(-5), "0")
+	is.Equal(scoreBucket(39), "30")
+	is.Equal(scoreBucket(100), "100")
+	is.Equal(scoreBucket(167), "100")
+}
+
 func isEqual(statsOne *entity.Stats, statsTwo *entity.Stats) bool {
 	return statsOne.PlayerOneId == statsTwo.PlayerOneId &&
 		statsOne.PlayerTwoId == statsTwo.PlayerTwoId &&
@@ -269,6 +269,9 @@ func (s *DBStore) Get(ctx context.Context, id string) (*entity.Game, error) {
 			attribute.String("variant", variantName),
 		),
 	)
+	// Macondo looks for the word graph itself, so a private lexicon has to
+	// be loaded first.
+	entity.GetPrivateLexicon(lexicon)
 	rules, err := macondogame.NewBasicGameRules(
 		s.cfg.MacondoConfig(), lexicon, boardLayoutName,
 		letterDistributionName, macondogame.CrossScoreOnly,
@@ -430,6 +433,9 @@ func (s *DBStore) SpawnShadowCompare(ctx context.Context, g *entity.Game) {
 		variantName = "classic"
 	}
 
+	// Macondo looks for the word graph itself, so a private lexicon has to
+	// be loaded first.
+	entity.GetPrivateLexicon(lexicon)
 	rules, err := macondogame.NewBasicGameRules(
 		s.cfg.MacondoConfig(), lexicon, boardLayoutName,
 		letterDistributionName, macondogame.CrossScoreOnly,
@@ -39,6 +39,7 @@ type Store interface {
 	// Task tracking for hourly runner idempotency
 	MarkSeasonClosed(ctx context.Context, uuid uuid.UUID) error
 	MarkDivisionsPrepared(ctx context.Context, uuid uuid.UUID) error
+	MarkPlayoffsStarted(ctx context.Context, uuid uuid.UUID) error
 	MarkSeasonStarted(ctx context.Context, uuid uuid.UUID) error
 	MarkRegistrationOpened(ctx context.Context, uuid uuid.UUID) error
 	MarkStartingSoonNotificationSent(ctx context.Context, uuid uuid.UUID) error
@@ -92,6 +93,46 @@ type Store interface {
 	GetSeasonPlayersWithUnstartedGames(ctx context.Context, seasonID uuid.UUID) ([]models.GetSeasonPlayersWithUnstartedGamesRow, error)
 	GetPlayerSeasonOpponents(ctx context.Context, seasonID uuid.UUID, userUUID string) ([]string, error)
 
+	// Playoff operations
+	AddLeaguePlayoffGame(ctx context.Context, arg models.AddLeaguePlayoffGameParams) error
+	GetLeaguePlayoffGames(ctx context.Context, divisionIDs []uuid.UUID) ([]models.GetLeaguePlayoffGamesRow, error)
+	CountSeasonPlayoffGames(ctx context.Context, seasonID uuid.UUID) (int64, error)
+
+	// Live league match operations
+	CreateLeagueMatch(ctx context.Context, arg models.CreateLeagueMatchParams) error
+	GetSeasonLeagueMatches(ctx context.Context, seasonID uuid.UUID) ([]models.GetSeasonLeagueMatchesRow, error)
+	GetLeagueMatch(ctx context.Context, matchID uuid.UUID) (models.GetLeagueMatchRow, error)
+	GetPendingLeagueMatches(ctx context.Context, divisionIDs []uuid.UUID) ([]models.GetPendingLeagueMatchesRow, error)
+	ClaimLeagueMatch(ctx context.Context, arg models.ClaimLeagueMatchParams) (int64, error)
+	ReleaseLeagueMatch(ctx context.Context, arg models.ReleaseLeagueMatchParams) error
+	SetLeagueMatchGame(ctx context.Context, matchID uuid.UUID, gameUUID string) error
+	MarkMatchWindowReminderSent(ctx context.Context, matchID uuid.UUID) error
+	MarkMatchSlotReminderSent(ctx context.Context, matchID uuid.UUID) error
+	AddMatchProposal(ctx context.Context, arg models.AddMatchProposalParams) (int64, error)
+	GetMatchProposal(ctx context.Context, id int64) (models.GetMatchProposalRow, error)
+	GetMatchProposals(ctx context.Context, matchIDs []uuid.UUID) ([]models.GetMatchProposalsRow, error)
+	DeclineMatchProposal(ctx context.Context, id int64) error
+	AcceptMatchProposal(ctx context.Context, proposal models.GetMatchProposalRow) error
+
+	// Substitute operations
+	AddLeagueSubstitute(ctx context.Context, arg models.AddLeagueSubstituteParams) error
+	RemoveLeagueSubstitute(ctx context.Context, arg models.RemoveLeagueSubstituteParams) error
+	GetLeagueSubstitutes(ctx context.Context, leagueID uuid.UUID) ([]models.GetLeagueSubstitutesRow, error)
+	GetDivisionSubstitutions(ctx context.Context, divisionID uuid.UUID) ([]models.GetDivisionSubstitutionsRow, error)
+	SubstitutePlayer(ctx context.Context, arg SubstitutionParams) ([]uuid.UUID, error)
+	GetSubstitution(ctx context.Context, arg models.GetSubstitutionParams) (models.GetSubstitutionRow, error)
+	GetPendingSubstitutionGames(ctx context.Context, substitutionID int64) ([]models.GetPendingSubstitutionGamesRow, error)
+	SetSubstitutionReplacementGame(ctx context.Context, arg models.SetSubstitutionReplacementGameParams) error
+	FinishSubstitutionGame(ctx context.Context, gameUUID string) error
+
+	// League rating operations
+	GetLeagueRatings(ctx context.Context, arg models.GetLeagueRatingsParams) ([]models.GetLeagueRatingsRow, error)
+	GetLeagueRatingHistory(ctx context.Context, arg models.GetLeagueRatingHistoryParams) ([]models.GetLeagueRatingHistoryRow, error)
+	GetLeagueRatedGames(ctx context.Context, leagueID uuid.UUID) ([]models.GetLeagueRatedGamesRow, error)
+	GetPlayerLeagueRatings(ctx context.Context, userID int32) ([]models.GetPlayerLeagueRatingsRow, error)
+	RecordLeagueGameRatings(ctx context.Context, history []models.AddLeagueRatingHistoryParams, ratings []models.UpsertLeagueRatingParams) (bool, error)
+	ResetLeagueRatings(ctx context.Context, leagueID uuid.UUID) error
+
 	// Batched season snapshot for GetAllDivisionStandings, read in a single
 	// repeatable-read transaction so the rank-bounds inputs stay consistent.
 	GetSeasonStandingsSnapshot(ctx context.Context, seasonID uuid.UUID) (*SeasonStandingsSnapshot, error)
@@ -209,6 +250,10 @@ func (s *DBStore) MarkDivisionsPrepared(ctx context.Context, uuid uuid.UUID) err
 	return s.queries.MarkDivisionsPrepared(ctx, uuid)
 }
 
+func (s *DBStore) MarkPlayoffsStarted(ctx context.Context, uuid uuid.UUID) error {
+	return s.queries.MarkPlayoffsStarted(ctx, uuid)
+}
+
 func (s *DBStore) MarkSeasonStarted(ctx context.Context, uuid uuid.UUID) error {
 	return s.queries.MarkSeasonStarted(ctx, uuid)
 }
@@ -456,6 +501,9 @@ type SeasonStandingsSnapshot struct {
 	Standings     []models.GetStandingsForDivisionsRow
 	Registrations []models.GetDivisionRegistrationsForDivisionsRow
 	Unfinished    []models.GetUnfinishedGamesForDivisionsRow
+	Playoffs      []models.GetLeaguePlayoffGamesRow
+	// Live league matches whose games have not been created yet
+	PendingMatches []models.GetPendingLeagueMatchesRow
 }
 
 func (s *DBStore) GetSeasonStandingsSnapshot(ctx context.Context, seasonID uuid.UUID) (*SeasonStandingsSnapshot, error) {
@@ -495,12 +543,22 @@ func (s *DBStore) GetSeasonStandingsSnapshot(ctx context.Context, seasonID uuid.
 	if err != nil {
 		return nil, err
 	}
+	playoffs, err := q.GetLeaguePlayoffGames(ctx, divIDs)
+	if err != nil {
+		return nil, err
+	}
+	pendingMatches, err := q.GetPendingLeagueMatches(ctx, divIDs)
+	if err != nil {
+		return nil, err
+	}
 
 	return &SeasonStandingsSnapshot{
-		Divisions:     divisions,
-		Standings:     standings,
-		Registrations: registrations,
-		Unfinished:    unfinished,
+		Divisions:      divisions,
+		Standings:      standings,
+		Registrations:  registrations,
+		Unfinished:     unfinished,
+		Playoffs:       playoffs,
+		PendingMatches: pendingMatches,
 	}, nil
 }
 
@@ -527,6 +585,293 @@ func (s *DBStore) GetPlayerSeasonOpponents(ctx context.Context, seasonID uuid.UU
 	})
 }
 
+// Playoff operations
+
+func (s *DBStore) AddLeaguePlayoffGame(ctx context.Context, arg models.AddLeaguePlayoffGameParams) error {
+	return s.queries.AddLeaguePlayoffGame(ctx, arg)
+}
+
+func (s *DBStore) GetLeaguePlayoffGames(ctx context.Context, divisionIDs []uuid.UUID) ([]models.GetLeaguePlayoffGamesRow, error) {
+	return s.queries.GetLeaguePlayoffGames(ctx, divisionIDs)
+}
+
+func (s *DBStore) CountSeasonPlayoffGames(ctx context.Context, seasonID uuid.UUID) (int64, error) {
+	return s.queries.CountSeasonPlayoffGames(ctx, seasonID)
+}
+
+// Live league match operations
+
+func (s *DBStore) CreateLeagueMatch(ctx context.Context, arg models.CreateLeagueMatchParams) error {
+	return s.queries.CreateLeagueMatch(ctx, arg)
+}
+
+func (s *DBStore) GetSeasonLeagueMatches(ctx context.Context, seasonID uuid.UUID) ([]models.GetSeasonLeagueMatchesRow, error) {
+	return s.queries.GetSeasonLeagueMatches(ctx, seasonID)
+}
+
+func (s *DBStore) GetLeagueMatch(ctx context.Context, matchID uuid.UUID) (models.GetLeagueMatchRow, error) {
+	return s.queries.GetLeagueMatch(ctx, matchID)
+}
+
+func (s *DBStore) GetPendingLeagueMatches(ctx context.Context, divisionIDs []uuid.UUID) ([]models.GetPendingLeagueMatchesRow, error) {
+	return s.queries.GetPendingLeagueMatches(ctx, divisionIDs)
+}
+
+func (s *DBStore) ClaimLeagueMatch(ctx context.Context, arg models.ClaimLeagueMatchParams) (int64, error) {
+	return s.queries.ClaimLeagueMatch(ctx, arg)
+}
+
+func (s *DBStore) ReleaseLeagueMatch(ctx context.Context, arg models.ReleaseLeagueMatchParams) error {
+	return s.queries.ReleaseLeagueMatch(ctx, arg)
+}
+
+func (s *DBStore) SetLeagueMatchGame(ctx context.Context, matchID uuid.UUID, gameUUID string) error {
+	return s.queries.SetLeagueMatchGame(ctx, models.SetLeagueMatchGameParams{
+		GameUuid: pgtype.Text{String: gameUUID, Valid: true},
+		Uuid:     matchID,
+	})
+}
+
+func (s *DBStore) MarkMatchWindowReminderSent(ctx context.Context, matchID uuid.UUID) error {
+	return s.queries.MarkMatchWindowReminderSent(ctx, matchID)
+}
+
+func (s *DBStore) MarkMatchSlotReminderSent(ctx context.Context, matchID uuid.UUID) error {
+	return s.queries.MarkMatchSlotReminderSent(ctx, matchID)
+}
+
+func (s *DBStore) AddMatchProposal(ctx context.Context, arg models.AddMatchProposalParams) (int64, error) {
+	return s.queries.AddMatchProposal(ctx, arg)
+}
+
+func (s *DBStore) GetMatchProposal(ctx context.Context, id int64) (models.GetMatchProposalRow, error) {
+	return s.queries.GetMatchProposal(ctx, id)
+}
+
+func (s *DBStore) GetMatchProposals(ctx context.Context, matchIDs []uuid.UUID) ([]models.GetMatchProposalsRow, error) {
+	return s.queries.GetMatchProposals(ctx, matchIDs)
+}
+
+func (s *DBStore) DeclineMatchProposal(ctx context.Context, id int64) error {
+	return s.queries.DeclineMatchProposal(ctx, id)
+}
+
+// AcceptMatchProposal accepts a proposal, closes the match's other open
+// proposals and schedules the match at the proposed time.
+func (s *DBStore) AcceptMatchProposal(ctx context.Context, proposal models.GetMatchProposalRow) error {
+	tx, err := s.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	err = q.AcceptMatchProposal(ctx, proposal.ID)
+	if err != nil {
+		return err
+	}
+	err = q.SupersedeMatchProposals(ctx, models.SupersedeMatchProposalsParams{
+		MatchID:    proposal.MatchID,
+		AcceptedID: proposal.ID,
+	})
+	if err != nil {
+		return err
+	}
+	err = q.ScheduleLeagueMatch(ctx, models.ScheduleLeagueMatchParams{
+		ScheduledTime: proposal.Slot,
+		Uuid:          proposal.MatchID,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// Substitute operations
+
+func (s *DBStore) AddLeagueSubstitute(ctx context.Context, arg models.AddLeagueSubstituteParams) error {
+	return s.queries.AddLeagueSubstitute(ctx, arg)
+}
+
+func (s *DBStore) RemoveLeagueSubstitute(ctx context.Context, arg models.RemoveLeagueSubstituteParams) error {
+	return s.queries.RemoveLeagueSubstitute(ctx, arg)
+}
+
+func (s *DBStore) GetLeagueSubstitutes(ctx context.Context, leagueID uuid.UUID) ([]models.GetLeagueSubstitutesRow, error) {
+	return s.queries.GetLeagueSubstitutes(ctx, leagueID)
+}
+
+func (s *DBStore) GetDivisionSubstitutions(ctx context.Context, divisionID uuid.UUID) ([]models.GetDivisionSubstitutionsRow, error) {
+	return s.queries.GetDivisionSubstitutions(ctx, divisionID)
+}
+
+// SubstitutionParams describes a substitute replacing a dropped player in
+// their division.
+type SubstitutionParams struct {
+	SeasonID      uuid.UUID
+	DivisionID    uuid.UUID
+	DroppedUserID int32
+	// Substitute is the substitute's registration in the division
+	Substitute models.RegisterPlayerParams
+	Handling   int32
+	// GameIDs are the dropped player's games in progress, which the
+	// substitution has to replace
+	GameIDs []string
+}
+
+// SubstitutePlayer withdraws the dropped player from their division,
+// registers the substitute in their place, records the substitution along
+// with the games it has to replace, and hands the dropped player's unplayed
+// matches to the substitute. It returns the matches that were handed over.
+func (s *DBStore) SubstitutePlayer(ctx context.Context, arg SubstitutionParams) ([]uuid.UUID, error) {
+	tx, err := s.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	err = q.WithdrawRegistration(ctx, models.WithdrawRegistrationParams{
+		SeasonID: arg.SeasonID,
+		UserID:   arg.DroppedUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = q.DeletePlayerStanding(ctx, models.DeletePlayerStandingParams{
+		DivisionID: arg.DivisionID,
+		UserID:     arg.DroppedUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = q.RegisterPlayer(ctx, arg.Substitute); err != nil {
+		return nil, err
+	}
+	substitutionID, err := q.AddLeagueSubstitution(ctx, models.AddLeagueSubstitutionParams{
+		SeasonID:         arg.SeasonID,
+		DivisionID:       arg.DivisionID,
+		DroppedUserID:    arg.DroppedUserID,
+		SubstituteUserID: arg.Substitute.UserID,
+		Handling:         arg.Handling,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(arg.GameIDs) > 0 {
+		err = q.AddSubstitutionGames(ctx, models.AddSubstitutionGamesParams{
+			GameUuids:      arg.GameIDs,
+			SubstitutionID: substitutionID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	matchIDs, err := q.SubstituteLeagueMatchPlayer(ctx, models.SubstituteLeagueMatchPlayerParams{
+		DroppedUserID:    arg.DroppedUserID,
+		SubstituteUserID: arg.Substitute.UserID,
+		SeasonID:         arg.SeasonID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(matchIDs) > 0 {
+		if err = q.SupersedeOpenMatchProposals(ctx, matchIDs); err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return matchIDs, nil
+}
+
+func (s *DBStore) GetSubstitution(ctx context.Context, arg models.GetSubstitutionParams) (models.GetSubstitutionRow, error) {
+	return s.queries.GetSubstitution(ctx, arg)
+}
+
+func (s *DBStore) GetPendingSubstitutionGames(ctx context.Context, substitutionID int64) ([]models.GetPendingSubstitutionGamesRow, error) {
+	return s.queries.GetPendingSubstitutionGames(ctx, substitutionID)
+}
+
+func (s *DBStore) SetSubstitutionReplacementGame(ctx context.Context, arg models.SetSubstitutionReplacementGameParams) error {
+	return s.queries.SetSubstitutionReplacementGame(ctx, arg)
+}
+
+func (s *DBStore) FinishSubstitutionGame(ctx context.Context, gameUUID string) error {
+	return s.queries.FinishSubstitutionGame(ctx, gameUUID)
+}
+
+// League rating operations
+
+func (s *DBStore) GetLeagueRatings(ctx context.Context, arg models.GetLeagueRatingsParams) ([]models.GetLeagueRatingsRow, error) {
+	return s.queries.GetLeagueRatings(ctx, arg)
+}
+
+func (s *DBStore) GetLeagueRatingHistory(ctx context.Context, arg models.GetLeagueRatingHistoryParams) ([]models.GetLeagueRatingHistoryRow, error) {
+	return s.queries.GetLeagueRatingHistory(ctx, arg)
+}
+
+func (s *DBStore) GetLeagueRatedGames(ctx context.Context, leagueID uuid.UUID) ([]models.GetLeagueRatedGamesRow, error) {
+	return s.queries.GetLeagueRatedGames(ctx, pgtype.UUID{Bytes: leagueID, Valid: true})
+}
+
+func (s *DBStore) GetPlayerLeagueRatings(ctx context.Context, userID int32) ([]models.GetPlayerLeagueRatingsRow, error) {
+	return s.queries.GetPlayerLeagueRatings(ctx, userID)
+}
+
+// RecordLeagueGameRatings saves the players' league ratings after a game
+// along with their history entries. It returns false, and changes nothing,
+// if the game has already been rated.
+func (s *DBStore) RecordLeagueGameRatings(ctx context.Context, history []models.AddLeagueRatingHistoryParams,
+	ratings []models.UpsertLeagueRatingParams) (bool, error) {
+
+	tx, err := s.dbPool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	for _, h := range history {
+		n, err := q.AddLeagueRatingHistory(ctx, h)
+		if err != nil {
+			return false, err
+		}
+		if n == 0 {
+			return false, nil
+		}
+	}
+	for _, r := range ratings {
+		if err := q.UpsertLeagueRating(ctx, r); err != nil {
+			return false, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ResetLeagueRatings deletes all league ratings and their history, so they
+// can be replayed from the league's games.
+func (s *DBStore) ResetLeagueRatings(ctx context.Context, leagueID uuid.UUID) error {
+	tx, err := s.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	if err := q.DeleteLeagueRatingHistory(ctx, leagueID); err != nil {
+		return err
+	}
+	if err := q.DeleteLeagueRatings(ctx, leagueID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 // Time bank operations
 
 func (s *DBStore) AddTimeBankSinglePlayer(ctx context.Context, arg models.AddTimeBankSinglePlayerParams) (int64, error) {
@@ -212,7 +212,7 @@ const createSeason = `-- name: CreateSeason :one
 
 INSERT INTO league_seasons (uuid, league_id, season_number, start_date, end_date, status, promotion_formula)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
-RETURNING id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula
+RETURNING id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula, playoffs_started_at
 `
 
 type CreateSeasonParams struct {
@@ -254,6 +254,7 @@ func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) (Lea
 		&i.RegistrationOpenedAt,
 		&i.StartingSoonNotificationSentAt,
 		&i.PromotionFormula,
+		&i.PlayoffsStartedAt,
 	)
 	return i, err
 }
@@ -370,7 +371,7 @@ func (q *Queries) GetAllLeagues(ctx context.Context, activeOnly bool) ([]League,
 }
 
 const getCurrentSeason = `-- name: GetCurrentSeason :one
-SELECT ls.id, ls.uuid, ls.league_id, ls.season_number, ls.start_date, ls.end_date, ls.actual_end_date, ls.status, ls.created_at, ls.updated_at, ls.closed_at, ls.divisions_prepared_at, ls.started_at, ls.registration_opened_at, ls.starting_soon_notification_sent_at, ls.promotion_formula FROM league_seasons ls
+SELECT ls.id, ls.uuid, ls.league_id, ls.season_number, ls.start_date, ls.end_date, ls.actual_end_date, ls.status, ls.created_at, ls.updated_at, ls.closed_at, ls.divisions_prepared_at, ls.started_at, ls.registration_opened_at, ls.starting_soon_notification_sent_at, ls.promotion_formula, ls.playoffs_started_at FROM league_seasons ls
 JOIN leagues l ON l.current_season_id = ls.uuid
 WHERE l.uuid = $1
 `
@@ -395,6 +396,7 @@ func (q *Queries) GetCurrentSeason(ctx context.Context, argUuid uuid.UUID) (Leag
 		&i.RegistrationOpenedAt,
 		&i.StartingSoonNotificationSentAt,
 		&i.PromotionFormula,
+		&i.PlayoffsStartedAt,
 	)
 	return i, err
 }
@@ -1219,7 +1221,7 @@ func (q *Queries) GetLeagueRoster(ctx context.Context, leagueID uuid.UUID) ([]Ge
 }
 
 const getPastSeasons = `-- name: GetPastSeasons :many
-SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula FROM league_seasons
+SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula, playoffs_started_at FROM league_seasons
 WHERE league_id = $1 AND status = 2  -- SeasonStatus.SEASON_COMPLETED
 ORDER BY season_number DESC
 `
@@ -1250,6 +1252,7 @@ func (q *Queries) GetPastSeasons(ctx context.Context, leagueID uuid.UUID) ([]Lea
 			&i.RegistrationOpenedAt,
 			&i.StartingSoonNotificationSentAt,
 			&i.PromotionFormula,
+			&i.PlayoffsStartedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1822,7 +1825,7 @@ func (q *Queries) GetPreviousSeasonRegistrantsNotInCurrent(ctx context.Context,
 }
 
 const getRecentSeasons = `-- name: GetRecentSeasons :many
-SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula FROM league_seasons
+SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula, playoffs_started_at FROM league_seasons
 WHERE league_id = $1
 ORDER BY season_number DESC
 LIMIT $2
@@ -1859,6 +1862,7 @@ func (q *Queries) GetRecentSeasons(ctx context.Context, arg GetRecentSeasonsPara
 			&i.RegistrationOpenedAt,
 			&i.StartingSoonNotificationSentAt,
 			&i.PromotionFormula,
+			&i.PlayoffsStartedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -1910,7 +1914,7 @@ func (q *Queries) GetRegistrationsByDivision(ctx context.Context, divisionID pgt
 }
 
 const getSeason = `-- name: GetSeason :one
-SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula FROM league_seasons WHERE uuid = $1
+SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula, playoffs_started_at FROM league_seasons WHERE uuid = $1
 `
 
 func (q *Queries) GetSeason(ctx context.Context, argUuid uuid.UUID) (LeagueSeason, error) {
@@ -1933,13 +1937,14 @@ func (q *Queries) GetSeason(ctx context.Context, argUuid uuid.UUID) (LeagueSeaso
 		&i.RegistrationOpenedAt,
 		&i.StartingSoonNotificationSentAt,
 		&i.PromotionFormula,
+		&i.PlayoffsStartedAt,
 	)
 	return i, err
 }
 
 const getSeasonByLeagueAndNumber = `-- name: GetSeasonByLeagueAndNumber :one
 
-SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula FROM league_seasons
+SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula, playoffs_started_at FROM league_seasons
 WHERE league_id = $1 AND season_number = $2
 `
 
@@ -1969,6 +1974,7 @@ func (q *Queries) GetSeasonByLeagueAndNumber(ctx context.Context, arg GetSeasonB
 		&i.RegistrationOpenedAt,
 		&i.StartingSoonNotificationSentAt,
 		&i.PromotionFormula,
+		&i.PlayoffsStartedAt,
 	)
 	return i, err
 }
@@ -2174,7 +2180,7 @@ func (q *Queries) GetSeasonZeroMoveGames(ctx context.Context, seasonID pgtype.UU
 }
 
 const getSeasonsByLeague = `-- name: GetSeasonsByLeague :many
-SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula FROM league_seasons
+SELECT id, uuid, league_id, season_number, start_date, end_date, actual_end_date, status, created_at, updated_at, closed_at, divisions_prepared_at, started_at, registration_opened_at, starting_soon_notification_sent_at, promotion_formula, playoffs_started_at FROM league_seasons
 WHERE league_id = $1
 ORDER BY season_number DESC
 `
@@ -2205,6 +2211,7 @@ func (q *Queries) GetSeasonsByLeague(ctx context.Context, leagueID uuid.UUID) ([
 			&i.RegistrationOpenedAt,
 			&i.StartingSoonNotificationSentAt,
 			&i.PromotionFormula,
+			&i.PlayoffsStartedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -2629,6 +2636,17 @@ func (q *Queries) MarkDivisionsPrepared(ctx context.Context, argUuid uuid.UUID)
 	return err
 }
 
+const markPlayoffsStarted = `-- name: MarkPlayoffsStarted :exec
+UPDATE league_seasons
+SET playoffs_started_at = NOW(), updated_at = NOW()
+WHERE uuid = $1
+`
+
+func (q *Queries) MarkPlayoffsStarted(ctx context.Context, argUuid uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markPlayoffsStarted, argUuid)
+	return err
+}
+
 const markRegistrationOpened = `-- name: MarkRegistrationOpened :exec
 UPDATE league_seasons
 SET registration_opened_at = NOW(), updated_at = NOW()
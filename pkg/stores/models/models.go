@@ -149,6 +149,29 @@ type BroadcastSlot struct {
 	UpdatedAt   pgtype.Timestamptz
 }
 
+type ChatBlocklist struct {
+	ID        int64
+	Language  string
+	Pattern   string
+	IsRegex   bool
+	CreatedBy pgtype.Int4
+	CreatedAt pgtype.Timestamptz
+}
+
+type ChatPolicy struct {
+	ChannelType         int16
+	SlowModeSeconds     int32
+	FloodMaxMessages    int32
+	FloodWindowSeconds  int32
+	FloodAction         int16
+	BlockLinksDays      int32
+	BlocklistLanguages  []string
+	BlocklistAction     int16
+	MuteDurationSeconds int32
+	UpdatedBy           pgtype.Int4
+	UpdatedAt           pgtype.Timestamptz
+}
+
 type Collection struct {
 	ID          int32
 	Uuid        uuid.UUID
@@ -170,6 +193,23 @@ type CollectionGame struct {
 	AddedAt       pgtype.Timestamptz
 }
 
+type DailyPuzzle struct {
+	Lexicon    string
+	PuzzleDate pgtype.Date
+	PuzzleID   int64
+	CreatedAt  pgtype.Timestamptz
+}
+
+type DailyPuzzleAttempt struct {
+	Lexicon    string
+	PuzzleDate pgtype.Date
+	UserID     int32
+	Correct    pgtype.Bool
+	AnswerKey  string
+	ViewedAt   pgtype.Timestamptz
+	AnsweredAt pgtype.Timestamptz
+}
+
 type DbSession struct {
 	Uuid      string
 	ExpiresAt pgtype.Timestamptz
@@ -211,6 +251,19 @@ type Game struct {
 	LastKnownRacks   []string
 }
 
+type GameCheatSignal struct {
+	GameUuid     string
+	UserID       int32
+	Rating       float64
+	Turns        int32
+	OptimalMoves int32
+	MistakeIndex float64
+	EstimatedElo float64
+	MoveTimeCv   float64
+	Flagged      bool
+	CreatedAt    pgtype.Timestamptz
+}
+
 type GameComment struct {
 	ID          uuid.UUID
 	GameID      int64
@@ -291,6 +344,67 @@ type LeagueDivision struct {
 	UpdatedAt      pgtype.Timestamptz
 }
 
+type LeagueMatch struct {
+	Uuid                 uuid.UUID
+	SeasonID             uuid.UUID
+	DivisionID           uuid.UUID
+	WindowNumber         int32
+	WindowStart          pgtype.Timestamptz
+	WindowEnd            pgtype.Timestamptz
+	Player0ID            int32
+	Player1ID            int32
+	Status               int32
+	ScheduledTime        pgtype.Timestamptz
+	GameUuid             pgtype.Text
+	WindowReminderSentAt pgtype.Timestamptz
+	SlotReminderSentAt   pgtype.Timestamptz
+	CreatedAt            pgtype.Timestamptz
+}
+
+type LeagueMatchProposal struct {
+	ID         int64
+	MatchID    uuid.UUID
+	ProposerID int32
+	Slot       pgtype.Timestamptz
+	Status     int32
+	CreatedAt  pgtype.Timestamptz
+}
+
+type LeaguePlayoffGame struct {
+	GameUuid       string
+	DivisionID     uuid.UUID
+	Round          int32
+	Slot           int32
+	HighSeed       int32
+	LowSeed        int32
+	HighSeedUserID int32
+	LowSeedUserID  int32
+	CreatedAt      pgtype.Timestamptz
+}
+
+type LeagueRating struct {
+	LeagueID        uuid.UUID
+	UserID          int32
+	Rating          float64
+	RatingDeviation float64
+	Volatility      float64
+	GamesPlayed     int32
+	LastGameAt      pgtype.Timestamptz
+}
+
+type LeagueRatingHistory struct {
+	ID              int64
+	LeagueID        uuid.UUID
+	UserID          int32
+	SeasonID        pgtype.UUID
+	GameUuid        string
+	OpponentID      int32
+	RatingBefore    float64
+	RatingAfter     float64
+	RatingDeviation float64
+	CreatedAt       pgtype.Timestamptz
+}
+
 type LeagueRegistration struct {
 	ID                   int64
 	UserID               int32
@@ -323,6 +437,7 @@ type LeagueSeason struct {
 	RegistrationOpenedAt           pgtype.Timestamptz
 	StartingSoonNotificationSentAt pgtype.Timestamptz
 	PromotionFormula               int32
+	PlayoffsStartedAt              pgtype.Timestamptz
 }
 
 type LeagueStanding struct {
@@ -353,6 +468,29 @@ type LeagueStanding struct {
 	GamesAnalyzed            pgtype.Int4
 }
 
+type LeagueSubstitute struct {
+	LeagueID  uuid.UUID
+	UserID    int32
+	CreatedAt pgtype.Timestamptz
+}
+
+type LeagueSubstitution struct {
+	ID               int64
+	SeasonID         uuid.UUID
+	DivisionID       uuid.UUID
+	DroppedUserID    int32
+	SubstituteUserID int32
+	Handling         int32
+	CreatedAt        pgtype.Timestamptz
+}
+
+type LeagueSubstitutionGame struct {
+	GameUuid            string
+	SubstitutionID      int64
+	ReplacementGameUuid pgtype.Text
+	Done                bool
+}
+
 type Liststat struct {
 	GameID    pgtype.Text
 	PlayerID  pgtype.Text
@@ -361,6 +499,60 @@ type Liststat struct {
 	Item      []byte
 }
 
+type ModAppeal struct {
+	ID         int64
+	Uuid       uuid.UUID
+	CaseID     int64
+	UserID     int64
+	Message    string
+	Status     int16
+	Response   string
+	ReviewerID pgtype.Int8
+	CreatedAt  pgtype.Timestamptz
+	DecidedAt  pgtype.Timestamptz
+}
+
+type ModCase struct {
+	ID             int64
+	Uuid           uuid.UUID
+	SubjectID      int64
+	Status         int16
+	AssigneeID     pgtype.Int8
+	ResolutionNote string
+	ResolverID     pgtype.Int8
+	CreatedAt      pgtype.Timestamptz
+	UpdatedAt      pgtype.Timestamptz
+	ResolvedAt     pgtype.Timestamptz
+}
+
+type ModCaseAction struct {
+	CaseID   int64
+	ActionID int64
+}
+
+type ModCaseEvidence struct {
+	ID           int64
+	CaseID       int64
+	EvidenceType int16
+	GameID       string
+	Channel      string
+	MessageID    string
+	Snapshot     string
+	Note         string
+	AddedBy      pgtype.Int8
+	CreatedAt    pgtype.Timestamptz
+}
+
+type ModReport struct {
+	ID          int64
+	CaseID      int64
+	ReporterID  int64
+	TargetType  int16
+	Reason      int16
+	Description string
+	CreatedAt   pgtype.Timestamptz
+}
+
 type MonitoringStream struct {
 	TournamentID    string
 	UserID          string
@@ -385,6 +577,42 @@ type Permission struct {
 	Description string
 }
 
+type PmConversation struct {
+	ID            int64
+	Channel       string
+	User1ID       int32
+	User2ID       int32
+	LastMessageAt pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+}
+
+type PmMessage struct {
+	ID             int64
+	ConversationID int64
+	ChatID         string
+	SenderID       int32
+	Message        string
+	CreatedAt      pgtype.Timestamptz
+}
+
+type PmReadState struct {
+	ConversationID    int64
+	UserID            int32
+	LastReadMessageID int64
+	UpdatedAt         pgtype.Timestamptz
+}
+
+type PrivateLexicon struct {
+	ID                 int64
+	Name               string
+	TournamentUuid     string
+	UploaderID         pgtype.Int4
+	LetterDistribution string
+	WordCount          int32
+	Kwg                []byte
+	CreatedAt          pgtype.Timestamptz
+}
+
 type Profile struct {
 	ID                int32
 	CreatedAt         pgtype.Timestamptz
@@ -438,6 +666,57 @@ type PuzzleGenerationLog struct {
 	ErrorStatus pgtype.Text
 	CreatedAt   pgtype.Timestamptz
 	CompletedAt pgtype.Timestamptz
+	StartedAt   pgtype.Timestamptz
+}
+
+type PuzzleReview struct {
+	PuzzleID       int64
+	UserID         int64
+	EaseFactor     float64
+	IntervalDays   int32
+	Repetitions    int32
+	Lapses         int32
+	DueAt          pgtype.Timestamptz
+	LastReviewedAt pgtype.Timestamptz
+	CreatedAt      pgtype.Timestamptz
+}
+
+type PuzzleRushPuzzle struct {
+	SessionID  int64
+	Position   int32
+	PuzzleID   int64
+	Correct    pgtype.Bool
+	AnsweredAt pgtype.Timestamptz
+}
+
+type PuzzleRushSession struct {
+	ID              int64
+	Uuid            string
+	UserID          int32
+	Lexicon         string
+	DurationSeconds int32
+	StartedAt       pgtype.Timestamptz
+	EndsAt          pgtype.Timestamptz
+	FinishedAt      pgtype.Timestamptz
+	Score           int32
+	Strikes         int32
+}
+
+type PuzzleSet struct {
+	ID          int64
+	Uuid        string
+	Title       string
+	Description string
+	Lexicon     string
+	CreatorID   pgtype.Int4
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type PuzzleSetPuzzle struct {
+	SetID    int64
+	PuzzleID int64
+	Position int32
 }
 
 type PuzzleTag struct {
@@ -445,6 +724,14 @@ type PuzzleTag struct {
 	TagID    int64
 }
 
+type PuzzleTagRating struct {
+	UserID    int64
+	Lexicon   string
+	TagTitle  string
+	Rating    []byte
+	UpdatedAt pgtype.Timestamptz
+}
+
 type PuzzleTagTitle struct {
 	ID       int64
 	TagTitle string
@@ -457,6 +744,29 @@ type PuzzleVote struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type RatingHistory struct {
+	ID               int64
+	UserID           int32
+	Variant          string
+	GameUuid         string
+	OpponentID       pgtype.Int4
+	RatingBefore     float64
+	RatingAfter      float64
+	RdBefore         float64
+	RdAfter          float64
+	VolatilityBefore float64
+	VolatilityAfter  float64
+	OpponentRating   float64
+	OpponentRd       float64
+	Spread           int32
+	ExpectedResult   float64
+	ActualResult     float64
+	WinBoost         float64
+	InactiveRd       float64
+	Multiplier       float64
+	CreatedAt        pgtype.Timestamptz
+}
+
 type Registrant struct {
 	UserID       pgtype.Text
 	TournamentID pgtype.Text
@@ -518,6 +828,11 @@ type TournamentDirector struct {
 	CreatedAt    pgtype.Timestamptz
 }
 
+type UsedSignupToken struct {
+	TokenID   string
+	ExpiresAt pgtype.Timestamptz
+}
+
 type User struct {
 	ID                    int32
 	CreatedAt             pgtype.Timestamptz
@@ -565,6 +880,16 @@ type UserBadge struct {
 	BadgeID int32
 }
 
+type UserIdentity struct {
+	ID          int64
+	UserID      int64
+	Provider    string
+	Subject     string
+	Email       string
+	CreatedAt   pgtype.Timestamptz
+	LastLoginAt pgtype.Timestamptz
+}
+
 type UserObsSlot struct {
 	UserID            int64
 	SlotName          string
@@ -593,3 +918,33 @@ type VerificationRequest struct {
 	ReviewedAt      pgtype.Timestamptz
 	Notes           pgtype.Text
 }
+
+type WordCardbox struct {
+	UserID        int32
+	Lexicon       string
+	Alphagram     string
+	Cardbox       int32
+	Correct       int32
+	Incorrect     int32
+	LastQuizzedAt pgtype.Timestamptz
+	NextScheduled pgtype.Timestamptz
+}
+
+type WordQuizQuestion struct {
+	SessionID  int64
+	Position   int32
+	Alphagram  string
+	NumAnswers int32
+	Found      []string
+}
+
+type WordQuizSession struct {
+	ID              int64
+	Uuid            string
+	UserID          int32
+	Lexicon         string
+	DurationSeconds int32
+	StartedAt       pgtype.Timestamptz
+	EndsAt          pgtype.Timestamptz
+	FinishedAt      pgtype.Timestamptz
+}
@@ -12,7 +12,7 @@ import (
 )
 
 const createPuzzleGenerationLog = `-- name: CreatePuzzleGenerationLog :one
-INSERT INTO puzzle_generation_logs (request, created_at) VALUES ($1, NOW()) RETURNING id
+INSERT INTO puzzle_generation_logs (request, created_at, started_at) VALUES ($1, NOW(), NOW()) RETURNING id
 `
 
 func (q *Queries) CreatePuzzleGenerationLog(ctx context.Context, request []byte) (int64, error) {
@@ -44,6 +44,35 @@ var UnseenCondition = ""
 var UnratedCondition = " AND (correct IS NOT NULL OR attempts != 0)"
 var UnansweredCondition = " AND (correct IS NOT NULL)"
 
+// tagFilterTemplate restricts a puzzle query to puzzles that have all of
+// the tags in the given text[] parameter. An empty array matches every
+// puzzle.
+const tagFilterTemplate = `
+	AND (cardinality(%[1]s::text[]) = 0 OR id IN (
+		SELECT pt.puzzle_id FROM puzzle_tags pt
+		JOIN puzzle_tag_titles ptt ON ptt.id = pt.tag_id
+		WHERE ptt.tag_title = ANY(%[1]s::text[])
+		GROUP BY pt.puzzle_id
+		HAVING COUNT(DISTINCT ptt.tag_title) = cardinality(%[1]s::text[])))`
+
+func tagFilter(param string) string {
+	return fmt.Sprintf(tagFilterTemplate, param)
+}
+
+// tagTitles returns the distinct names of the given tags, as stored in
+// puzzle_tag_titles.
+func tagTitles(tags []macondopb.PuzzleTag) []string {
+	titles := []string{}
+	seen := map[macondopb.PuzzleTag]bool{}
+	for _, tag := range tags {
+		if !seen[tag] {
+			seen[tag] = true
+			titles = append(titles, tag.String())
+		}
+	}
+	return titles
+}
+
 func NewDBStore(p *pgxpool.Pool) (*DBStore, error) {
 	queries := models.New(p)
 	return &DBStore{dbPool: p, queries: queries}, nil
@@ -67,6 +96,49 @@ func (s *DBStore) CreateGenerationLog(ctx context.Context, req *puzzle_service.P
 	return int(id), nil
 }
 
+// QueueGenerationJob creates the log of a generation job that a worker
+// has not started yet.
+func (s *DBStore) QueueGenerationJob(ctx context.Context, req *puzzle_service.PuzzleGenerationJobRequest) (int, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return -1, err
+	}
+
+	id, err := s.queries.QueuePuzzleGenerationJob(ctx, data)
+	if err != nil {
+		return -1, err
+	}
+
+	return int(id), nil
+}
+
+// ClaimGenerationJob starts the oldest queued generation job. It returns -1
+// if there are no queued jobs.
+func (s *DBStore) ClaimGenerationJob(ctx context.Context) (int, *puzzle_service.PuzzleGenerationJobRequest, error) {
+	row, err := s.queries.ClaimPuzzleGenerationJob(ctx)
+	if err == pgx.ErrNoRows {
+		return -1, nil, nil
+	} else if err != nil {
+		return -1, nil, err
+	}
+	req := &puzzle_service.PuzzleGenerationJobRequest{}
+	if err := json.Unmarshal(row.Request, req); err != nil {
+		// The job was claimed, so return its id for it to be marked failed.
+		return int(row.ID), nil, err
+	}
+	return int(row.ID), req, nil
+}
+
+// ApproveGenerationJob makes the job's puzzles valid. It returns how many
+// weren't already.
+func (s *DBStore) ApproveGenerationJob(ctx context.Context, genId int) (int, error) {
+	rows, err := s.queries.ApprovePuzzleGenerationJob(ctx, int64(genId))
+	if err != nil {
+		return 0, err
+	}
+	return int(rows), nil
+}
+
 func (s *DBStore) UpdateGenerationLogStatus(ctx context.Context, id int, fulfilled bool, procErr error) error {
 	errorStatus := pgtype.Text{}
 	if procErr != nil {
@@ -136,7 +208,7 @@ func (s *DBStore) GetJobLogs(ctx context.Context, limit, offset int) ([]*puzzle_
 }
 
 func (s *DBStore) CreatePuzzle(ctx context.Context, gameUUID string, turnNumber int32, answer *macondopb.GameEvent, authorUUID string,
-	lexicon string, beforeText string, afterText string, tags []macondopb.PuzzleTag, generationId int, bucketIndex int32) error {
+	lexicon string, beforeText string, afterText string, tags []macondopb.PuzzleTag, generationId int, bucketIndex int32, valid bool) error {
 
 	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
 	if err != nil {
@@ -164,8 +236,8 @@ func (s *DBStore) CreatePuzzle(ctx context.Context, gameUUID string, turnNumber
 	uuid := shortuuid.New()
 
 	var id int
-	err = tx.QueryRow(ctx, `INSERT INTO puzzles (uuid, game_id, turn_number, author_id, answer, lexicon, before_text, after_text, rating, generation_id, bucket_index, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, NOW()) RETURNING id`,
-		uuid, gameID, turnNumber, authorId, gameEventToAnswer(answer), lexicon, beforeText, afterText, newRating, generationId, bucketIndex).Scan(&id)
+	err = tx.QueryRow(ctx, `INSERT INTO puzzles (uuid, game_id, turn_number, author_id, answer, lexicon, before_text, after_text, rating, generation_id, bucket_index, valid, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NOW()) RETURNING id`,
+		uuid, gameID, turnNumber, authorId, gameEventToAnswer(answer), lexicon, beforeText, afterText, newRating, generationId, bucketIndex, valid).Scan(&id)
 	if err != nil {
 		return err
 	}
@@ -197,7 +269,7 @@ func (s *DBStore) CreatePuzzle(ctx context.Context, gameUUID string, turnNumber
 	return err
 }
 
-func (s *DBStore) GetStartPuzzleId(ctx context.Context, userUUID string, lexicon string, ratingKey entity.VariantKey) (string, puzzle_service.PuzzleQueryResult, error) {
+func (s *DBStore) GetStartPuzzleId(ctx context.Context, userUUID string, lexicon string, ratingKey entity.VariantKey, tags []macondopb.PuzzleTag) (string, puzzle_service.PuzzleQueryResult, error) {
 	var pqr puzzle_service.PuzzleQueryResult
 	tx, err := s.dbPool.BeginTx(ctx, common.RepeatableReadTxOptions)
 	if err != nil {
@@ -207,7 +279,7 @@ func (s *DBStore) GetStartPuzzleId(ctx context.Context, userUUID string, lexicon
 
 	var startPuzzleUUID string
 	if userUUID == "" {
-		startPuzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil)
+		startPuzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil, tags)
 		pqr = puzzle_service.PuzzleQueryResult_RANDOM
 		if err != nil {
 			return "", pqr, err
@@ -225,8 +297,8 @@ func (s *DBStore) GetStartPuzzleId(ctx context.Context, userUUID string, lexicon
 		// This query gets the most recently updated puzzle for this lexicon.
 		err = tx.QueryRow(ctx, `
 			SELECT puzzle_id, correct FROM puzzle_attempts WHERE user_id = $1 AND
-			(SELECT lexicon FROM puzzles WHERE id = puzzle_id AND valid) = $2
-			ORDER BY updated_at DESC LIMIT 1`, uid, lexicon).Scan(&pid, status)
+			(SELECT lexicon FROM puzzles WHERE id = puzzle_id AND valid`+tagFilter("$3")+`) = $2
+			ORDER BY updated_at DESC LIMIT 1`, uid, lexicon, tagTitles(tags)).Scan(&pid, status)
 		if err == pgx.ErrNoRows {
 			// User has not seen any puzzles, just get the next puzzle
 			getNext = true
@@ -239,7 +311,7 @@ func (s *DBStore) GetStartPuzzleId(ctx context.Context, userUUID string, lexicon
 		// or they solved or gave up on the last puzzle,
 		// give the user a new puzzle
 		if getNext || status.Valid {
-			startPuzzleUUID, pqr, err = getNextClosestRatingPuzzleId(ctx, tx, userUUID, lexicon, ratingKey)
+			startPuzzleUUID, pqr, err = getNextClosestRatingPuzzleId(ctx, tx, userUUID, lexicon, ratingKey, tags)
 			if err != nil {
 				return "", pqr, err
 			}
@@ -261,7 +333,7 @@ func (s *DBStore) GetStartPuzzleId(ctx context.Context, userUUID string, lexicon
 	return startPuzzleUUID, pqr, nil
 }
 
-func (s *DBStore) GetNextPuzzleId(ctx context.Context, userUUID string, lexicon string) (string, puzzle_service.PuzzleQueryResult, error) {
+func (s *DBStore) GetNextPuzzleId(ctx context.Context, userUUID string, lexicon string, tags []macondopb.PuzzleTag) (string, puzzle_service.PuzzleQueryResult, error) {
 	var pqr puzzle_service.PuzzleQueryResult
 	tx, err := s.dbPool.BeginTx(ctx, common.RepeatableReadReadOnlyTxOptions)
 	if err != nil {
@@ -272,13 +344,13 @@ func (s *DBStore) GetNextPuzzleId(ctx context.Context, userUUID string, lexicon
 	var nextPuzzleUUID string
 
 	if userUUID == "" {
-		nextPuzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil)
+		nextPuzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil, tags)
 		pqr = puzzle_service.PuzzleQueryResult_RANDOM
 		if err != nil {
 			return "", pqr, err
 		}
 	} else {
-		nextPuzzleUUID, pqr, err = getNextPuzzleId(ctx, tx, userUUID, lexicon)
+		nextPuzzleUUID, pqr, err = getNextPuzzleId(ctx, tx, userUUID, lexicon, tags)
 		if err != nil {
 			return "", pqr, err
 		}
@@ -291,7 +363,7 @@ func (s *DBStore) GetNextPuzzleId(ctx context.Context, userUUID string, lexicon
 	return nextPuzzleUUID, pqr, nil
 }
 
-func (s *DBStore) GetNextClosestRatingPuzzleId(ctx context.Context, userId string, lexicon string, ratingKey entity.VariantKey) (string, puzzle_service.PuzzleQueryResult, error) {
+func (s *DBStore) GetNextClosestRatingPuzzleId(ctx context.Context, userId string, lexicon string, ratingKey entity.VariantKey, tags []macondopb.PuzzleTag) (string, puzzle_service.PuzzleQueryResult, error) {
 	var pqr puzzle_service.PuzzleQueryResult
 	tx, err := s.dbPool.BeginTx(ctx, common.RepeatableReadTxOptions)
 	if err != nil {
@@ -299,7 +371,7 @@ func (s *DBStore) GetNextClosestRatingPuzzleId(ctx context.Context, userId strin
 	}
 	defer tx.Rollback(ctx)
 
-	uuid, pqr, err := getNextClosestRatingPuzzleId(ctx, tx, userId, lexicon, ratingKey)
+	uuid, pqr, err := getNextClosestRatingPuzzleId(ctx, tx, userId, lexicon, ratingKey, tags)
 	if err != nil {
 		return "", pqr, err
 	}
@@ -478,7 +550,8 @@ func (s *DBStore) GetAnswer(ctx context.Context, puzzleUUID string) (*macondopb.
 }
 
 func (s *DBStore) SubmitAnswer(ctx context.Context, userUUID string, ratingKey entity.VariantKey,
-	newUserRating *entity.SingleRating, puzzleUUID string, newPuzzleRating *entity.SingleRating, userIsCorrect bool, showSolution bool) error {
+	newUserRating *entity.SingleRating, newTagRatings map[macondopb.PuzzleTag]*entity.SingleRating,
+	puzzleUUID string, newPuzzleRating *entity.SingleRating, userIsCorrect bool, showSolution bool) error {
 
 	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
 	if err != nil {
@@ -527,6 +600,16 @@ func (s *DBStore) SubmitAnswer(ctx context.Context, userUUID string, ratingKey e
 			return err
 		}
 
+		for tag, rating := range newTagRatings {
+			_, err = tx.Exec(ctx, `INSERT INTO puzzle_tag_ratings (user_id, lexicon, tag_title, rating, updated_at)
+				VALUES ($1, (SELECT lexicon FROM puzzles WHERE id = $2), $3, $4, NOW())
+				ON CONFLICT (user_id, lexicon, tag_title) DO UPDATE SET rating = EXCLUDED.rating, updated_at = NOW()`,
+				uid, pid, tag.String(), rating)
+			if err != nil {
+				return err
+			}
+		}
+
 		attempts := 1
 
 		if showSolution {
@@ -661,6 +744,778 @@ func (s *DBStore) GetAttempts(ctx context.Context, userUUID string, puzzleUUID s
 	return rated, attemptExists, attempts, status, firstAttemptTime, lastAttemptTime, newPuzzleRating, newUserRating, nil
 }
 
+func (s *DBStore) GetPuzzleTags(ctx context.Context, puzzleUUID string) ([]macondopb.PuzzleTag, error) {
+	rows, err := s.dbPool.Query(ctx, `
+		SELECT ptt.tag_title FROM puzzle_tags pt
+		JOIN puzzle_tag_titles ptt ON ptt.id = pt.tag_id
+		JOIN puzzles p ON p.id = pt.puzzle_id
+		WHERE p.uuid = $1`, puzzleUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := []macondopb.PuzzleTag{}
+	for rows.Next() {
+		var title string
+		if err := rows.Scan(&title); err != nil {
+			return nil, err
+		}
+		tag, ok := macondopb.PuzzleTag_value[title]
+		if !ok {
+			log.Warn().Str("tag", title).Str("puzzleId", puzzleUUID).Msg("unknown-puzzle-tag")
+			continue
+		}
+		tags = append(tags, macondopb.PuzzleTag(tag))
+	}
+	return tags, rows.Err()
+}
+
+// GetPuzzleTagRatings returns the user's rating in each puzzle tag they have
+// played in the lexicon.
+func (s *DBStore) GetPuzzleTagRatings(ctx context.Context, userUUID string, lexicon string) (map[macondopb.PuzzleTag]*entity.SingleRating, error) {
+	uid, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.dbPool.Query(ctx, `SELECT tag_title, rating FROM puzzle_tag_ratings WHERE user_id = $1 AND lexicon = $2`, uid, lexicon)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ratings := map[macondopb.PuzzleTag]*entity.SingleRating{}
+	for rows.Next() {
+		var title string
+		var rating *entity.SingleRating
+		if err := rows.Scan(&title, &rating); err != nil {
+			return nil, err
+		}
+		if tag, ok := macondopb.PuzzleTag_value[title]; ok {
+			ratings[macondopb.PuzzleTag(tag)] = rating
+		}
+	}
+	return ratings, rows.Err()
+}
+
+func (s *DBStore) CreatePuzzleSet(ctx context.Context, creatorUUID string, title string, description string,
+	lexicon string, puzzleUUIDs []string) (string, error) {
+
+	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	creatorID, err := q.GetUserDBIDFromUUID(ctx, creatorUUID)
+	if err != nil {
+		return "", err
+	}
+	setUUID := shortuuid.New()
+	setID, err := q.CreatePuzzleSet(ctx, models.CreatePuzzleSetParams{
+		Uuid:        setUUID,
+		Title:       title,
+		Description: description,
+		Lexicon:     lexicon,
+		CreatorID:   pgtype.Int4{Int32: creatorID, Valid: true},
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := setPuzzleSetPuzzles(ctx, q, setID, setUUID, lexicon, puzzleUUIDs); err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
+	return setUUID, nil
+}
+
+// UpdatePuzzleSet changes a puzzle set's title and description and replaces
+// its puzzles with the given ones, in order.
+func (s *DBStore) UpdatePuzzleSet(ctx context.Context, setUUID string, title string, description string, puzzleUUIDs []string) error {
+	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	set, err := q.GetPuzzleSet(ctx, setUUID)
+	if err == pgx.ErrNoRows {
+		return entity.NewWooglesError(ipc.WooglesError_PUZZLE_SET_NOT_FOUND, "", setUUID)
+	} else if err != nil {
+		return err
+	}
+	_, err = q.UpdatePuzzleSet(ctx, models.UpdatePuzzleSetParams{
+		Title:       title,
+		Description: description,
+		ID:          set.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := q.ClearPuzzleSetPuzzles(ctx, set.ID); err != nil {
+		return err
+	}
+	if err := setPuzzleSetPuzzles(ctx, q, set.ID, setUUID, set.Lexicon, puzzleUUIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (s *DBStore) DeletePuzzleSet(ctx context.Context, setUUID string) error {
+	rowsAffected, err := s.queries.DeletePuzzleSet(ctx, setUUID)
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return entity.NewWooglesError(ipc.WooglesError_PUZZLE_SET_NOT_FOUND, "", setUUID)
+	}
+	return nil
+}
+
+func (s *DBStore) GetPuzzleSets(ctx context.Context, lexicon string) ([]*puzzle_service.PuzzleSet, error) {
+	rows, err := s.queries.GetPuzzleSets(ctx, lexicon)
+	if err != nil {
+		return nil, err
+	}
+	sets := make([]*puzzle_service.PuzzleSet, len(rows))
+	for i, row := range rows {
+		sets[i] = &puzzle_service.PuzzleSet{
+			SetId:       row.Uuid,
+			Title:       row.Title,
+			Description: row.Description,
+			Lexicon:     row.Lexicon,
+			Creator:     row.Creator,
+			PuzzleCount: row.PuzzleCount,
+			UpdatedAt:   timestamppb.New(row.UpdatedAt.Time),
+		}
+	}
+	return sets, nil
+}
+
+// GetPuzzleSet returns a puzzle set with its puzzles in order. If userUUID
+// is not empty, each puzzle has the user's result on it.
+func (s *DBStore) GetPuzzleSet(ctx context.Context, setUUID string, userUUID string) (*puzzle_service.PuzzleSet, error) {
+	set, err := s.queries.GetPuzzleSet(ctx, setUUID)
+	if err == pgx.ErrNoRows {
+		return nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_SET_NOT_FOUND, userUUID, setUUID)
+	} else if err != nil {
+		return nil, err
+	}
+	var uid int64
+	if userUUID != "" {
+		uid32, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+		if err != nil {
+			return nil, err
+		}
+		uid = int64(uid32)
+	}
+	rows, err := s.queries.GetPuzzleSetPuzzles(ctx, models.GetPuzzleSetPuzzlesParams{
+		UserID: uid,
+		SetID:  set.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*puzzle_service.PuzzleSetEntry, len(rows))
+	for i, row := range rows {
+		status := puzzle_service.PuzzleStatus_UNANSWERED
+		if row.Correct.Valid {
+			status = puzzle_service.PuzzleStatus_INCORRECT
+			if row.Correct.Bool {
+				status = puzzle_service.PuzzleStatus_CORRECT
+			}
+		}
+		entries[i] = &puzzle_service.PuzzleSetEntry{PuzzleId: row.Uuid, Status: status}
+	}
+	return &puzzle_service.PuzzleSet{
+		SetId:       set.Uuid,
+		Title:       set.Title,
+		Description: set.Description,
+		Lexicon:     set.Lexicon,
+		Creator:     set.Creator,
+		PuzzleCount: int32(len(entries)),
+		Puzzles:     entries,
+		UpdatedAt:   timestamppb.New(set.UpdatedAt.Time),
+	}, nil
+}
+
+// setPuzzleSetPuzzles adds the puzzles to a set in the given order. Every
+// puzzle must exist and be in the set's lexicon.
+func setPuzzleSetPuzzles(ctx context.Context, q *models.Queries, setID int64, setUUID string, lexicon string, puzzleUUIDs []string) error {
+	seen := map[string]bool{}
+	for _, id := range puzzleUUIDs {
+		if seen[id] {
+			return entity.NewWooglesError(ipc.WooglesError_PUZZLE_SET_INVALID_PUZZLES, setUUID, lexicon)
+		}
+		seen[id] = true
+	}
+	rowsAffected, err := q.AddPuzzleSetPuzzles(ctx, models.AddPuzzleSetPuzzlesParams{
+		SetID:       setID,
+		PuzzleUuids: puzzleUUIDs,
+		Lexicon:     lexicon,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAffected != int64(len(puzzleUUIDs)) {
+		return entity.NewWooglesError(ipc.WooglesError_PUZZLE_SET_INVALID_PUZZLES, setUUID, lexicon)
+	}
+	return nil
+}
+
+// CreatePuzzleRushSession starts a puzzle rush and serves its first puzzle,
+// the one closest to the target rating.
+func (s *DBStore) CreatePuzzleRushSession(ctx context.Context, userUUID string, lexicon string, duration time.Duration,
+	targetRating float64) (*entity.PuzzleRushSession, error) {
+
+	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	userDBID, err := q.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	session := &entity.PuzzleRushSession{
+		UUID:      shortuuid.New(),
+		UserID:    userUUID,
+		Lexicon:   lexicon,
+		Duration:  duration,
+		StartedAt: now,
+		EndsAt:    now.Add(duration),
+	}
+	sessionID, err := q.CreatePuzzleRushSession(ctx, models.CreatePuzzleRushSessionParams{
+		Uuid:            session.UUID,
+		UserID:          userDBID,
+		Lexicon:         lexicon,
+		DurationSeconds: int32(duration.Seconds()),
+		StartedAt:       pgtype.Timestamptz{Time: session.StartedAt, Valid: true},
+		EndsAt:          pgtype.Timestamptz{Time: session.EndsAt, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	served, err := servePuzzleRushPuzzle(ctx, q, sessionID, session, targetRating)
+	if err != nil {
+		return nil, err
+	}
+	if !served {
+		return nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_NO_PUZZLES, userUUID, lexicon)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s *DBStore) GetPuzzleRushSession(ctx context.Context, sessionUUID string) (*entity.PuzzleRushSession, error) {
+	row, err := s.queries.GetPuzzleRushSession(ctx, sessionUUID)
+	if err == pgx.ErrNoRows {
+		return nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_NOT_FOUND, "", sessionUUID)
+	} else if err != nil {
+		return nil, err
+	}
+	session := puzzleRushSessionFromRow(row)
+	if session.Finished() {
+		return session, nil
+	}
+	current, err := s.queries.GetCurrentPuzzleRushPuzzle(ctx, row.ID)
+	if err != nil && err != pgx.ErrNoRows {
+		return nil, err
+	}
+	if err == nil {
+		session.PuzzleID = current.Uuid
+		session.PuzzlePosition = int(current.Position)
+	}
+	return session, nil
+}
+
+// SavePuzzleRushAnswer records the answer to the session's current puzzle
+// along with the session's new score, strikes and finish time. If the
+// session is not finished, the unserved puzzle closest to the target rating
+// is served next; the session finishes if there are none left.
+func (s *DBStore) SavePuzzleRushAnswer(ctx context.Context, session *entity.PuzzleRushSession, correct bool,
+	nextTargetRating float64) (*entity.PuzzleRushSession, error) {
+
+	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	row, err := q.GetPuzzleRushSession(ctx, session.UUID)
+	if err == pgx.ErrNoRows {
+		return nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_NOT_FOUND, session.UserID, session.UUID)
+	} else if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := q.AnswerPuzzleRushPuzzle(ctx, models.AnswerPuzzleRushPuzzleParams{
+		Correct:   pgtype.Bool{Bool: correct, Valid: true},
+		SessionID: row.ID,
+		Position:  int32(session.PuzzlePosition),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected != 1 {
+		return nil, entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_ALREADY_ANSWERED, session.UserID, session.UUID)
+	}
+
+	updated := *session
+	updated.PuzzleID = ""
+	if !updated.Finished() {
+		served, err := servePuzzleRushPuzzle(ctx, q, row.ID, &updated, nextTargetRating)
+		if err != nil {
+			return nil, err
+		}
+		if !served {
+			updated.FinishedAt = time.Now()
+		}
+	}
+	err = q.UpdatePuzzleRushSession(ctx, models.UpdatePuzzleRushSessionParams{
+		Score:      int32(updated.Score),
+		Strikes:    int32(updated.Strikes),
+		FinishedAt: pgtype.Timestamptz{Time: updated.FinishedAt, Valid: updated.Finished()},
+		ID:         row.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+func (s *DBStore) FinishPuzzleRushSession(ctx context.Context, sessionUUID string, finishedAt time.Time) error {
+	row, err := s.queries.GetPuzzleRushSession(ctx, sessionUUID)
+	if err == pgx.ErrNoRows {
+		return entity.NewWooglesError(ipc.WooglesError_PUZZLE_RUSH_NOT_FOUND, "", sessionUUID)
+	} else if err != nil {
+		return err
+	}
+	return s.queries.FinishPuzzleRushSession(ctx, models.FinishPuzzleRushSessionParams{
+		FinishedAt: pgtype.Timestamptz{Time: finishedAt, Valid: true},
+		ID:         row.ID,
+	})
+}
+
+func (s *DBStore) GetPuzzleRushLeaderboard(ctx context.Context, lexicon string, duration time.Duration, since time.Time,
+	limit int) ([]*puzzle_service.PuzzleRushLeaderboardEntry, error) {
+
+	rows, err := s.queries.GetPuzzleRushLeaderboard(ctx, models.GetPuzzleRushLeaderboardParams{
+		Lexicon:         lexicon,
+		DurationSeconds: int32(duration.Seconds()),
+		Since:           pgtype.Timestamptz{Time: since, Valid: true},
+		Lim:             int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*puzzle_service.PuzzleRushLeaderboardEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = &puzzle_service.PuzzleRushLeaderboardEntry{
+			Rank:      int32(i + 1),
+			Username:  row.Username,
+			Score:     row.Score,
+			StartedAt: timestamppb.New(row.StartedAt.Time),
+		}
+	}
+	return entries, nil
+}
+
+// servePuzzleRushPuzzle makes the unserved puzzle closest to the target
+// rating the session's current puzzle. It returns false if there are no
+// puzzles left to serve.
+func servePuzzleRushPuzzle(ctx context.Context, q *models.Queries, sessionID int64, session *entity.PuzzleRushSession,
+	targetRating float64) (bool, error) {
+
+	next, err := q.GetNextPuzzleRushPuzzle(ctx, models.GetNextPuzzleRushPuzzleParams{
+		Lexicon:      session.Lexicon,
+		TargetRating: targetRating,
+		SessionID:    sessionID,
+	})
+	if err == pgx.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	served, err := q.CountPuzzleRushPuzzles(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+	err = q.AddPuzzleRushPuzzle(ctx, models.AddPuzzleRushPuzzleParams{
+		SessionID: sessionID,
+		Position:  int32(served),
+		PuzzleID:  next.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+	session.PuzzleID = next.Uuid
+	session.PuzzlePosition = int(served)
+	return true, nil
+}
+
+func puzzleRushSessionFromRow(row models.GetPuzzleRushSessionRow) *entity.PuzzleRushSession {
+	session := &entity.PuzzleRushSession{
+		UUID:      row.Uuid,
+		UserID:    row.UserUuid,
+		Lexicon:   row.Lexicon,
+		Duration:  time.Duration(row.DurationSeconds) * time.Second,
+		StartedAt: row.StartedAt.Time,
+		EndsAt:    row.EndsAt.Time,
+		Score:     int(row.Score),
+		Strikes:   int(row.Strikes),
+	}
+	if row.FinishedAt.Valid {
+		session.FinishedAt = row.FinishedAt.Time
+	}
+	return session
+}
+
+// GetDailyPuzzle returns the lexicon's daily puzzle for the given date. If
+// none has been scheduled, the best voted puzzle with at least minScore
+// votes that has not been a daily puzzle yet is picked, or a random one if
+// there isn't one.
+func (s *DBStore) GetDailyPuzzle(ctx context.Context, lexicon string, date time.Time, minScore int) (string, error) {
+	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.queries.WithTx(tx)
+	day := pgtype.Date{Time: date, Valid: true}
+	puzzleUUID, err := q.GetDailyPuzzle(ctx, models.GetDailyPuzzleParams{Lexicon: lexicon, PuzzleDate: day})
+	if err == nil {
+		return puzzleUUID, nil
+	} else if err != pgx.ErrNoRows {
+		return "", err
+	}
+
+	puzzleID, err := q.PickDailyPuzzle(ctx, models.PickDailyPuzzleParams{Lexicon: lexicon, MinScore: int32(minScore)})
+	if err == pgx.ErrNoRows {
+		puzzleID, err = q.PickRandomDailyPuzzle(ctx, lexicon)
+	}
+	if err == pgx.ErrNoRows {
+		return "", entity.NewWooglesError(ipc.WooglesError_DAILY_PUZZLE_NOT_FOUND, "", lexicon)
+	} else if err != nil {
+		return "", err
+	}
+	err = q.AddDailyPuzzle(ctx, models.AddDailyPuzzleParams{Lexicon: lexicon, PuzzleDate: day, PuzzleID: puzzleID})
+	if err != nil {
+		return "", err
+	}
+	// Another request may have picked the day's puzzle first.
+	puzzleUUID, err = q.GetDailyPuzzle(ctx, models.GetDailyPuzzleParams{Lexicon: lexicon, PuzzleDate: day})
+	if err != nil {
+		return "", err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", err
+	}
+	return puzzleUUID, nil
+}
+
+func (s *DBStore) SetDailyPuzzle(ctx context.Context, lexicon string, date time.Time, puzzleUUID string) error {
+	rowsAffected, err := s.queries.SetDailyPuzzle(ctx, models.SetDailyPuzzleParams{
+		Lexicon:    lexicon,
+		PuzzleDate: pgtype.Date{Time: date, Valid: true},
+		PuzzleUuid: puzzleUUID,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return entity.NewWooglesError(ipc.WooglesError_DAILY_PUZZLE_INVALID_PUZZLE, "", puzzleUUID, lexicon)
+	}
+	return nil
+}
+
+// StartDailyPuzzleAttempt returns the user's attempt at the daily puzzle,
+// starting it if this is the first time they see the puzzle.
+func (s *DBStore) StartDailyPuzzleAttempt(ctx context.Context, userUUID string, lexicon string, date time.Time) (*entity.DailyPuzzleAttempt, error) {
+	userDBID, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	day := pgtype.Date{Time: date, Valid: true}
+	err = s.queries.StartDailyPuzzleAttempt(ctx, models.StartDailyPuzzleAttemptParams{
+		Lexicon:    lexicon,
+		PuzzleDate: day,
+		UserID:     userDBID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.queries.GetDailyPuzzleAttempt(ctx, models.GetDailyPuzzleAttemptParams{
+		Lexicon:    lexicon,
+		PuzzleDate: day,
+		UserID:     userDBID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	attempt := &entity.DailyPuzzleAttempt{
+		AnswerKey: row.AnswerKey,
+		ViewedAt:  row.ViewedAt.Time,
+	}
+	if row.Correct.Valid {
+		attempt.Correct = &row.Correct.Bool
+		attempt.AnsweredAt = row.AnsweredAt.Time
+	}
+	return attempt, nil
+}
+
+// SaveDailyPuzzleAnswer records the answer to an attempt started with
+// StartDailyPuzzleAttempt. Each attempt can only be answered once.
+func (s *DBStore) SaveDailyPuzzleAnswer(ctx context.Context, userUUID string, lexicon string, date time.Time,
+	correct bool, answerKey string) error {
+
+	userDBID, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := s.queries.AnswerDailyPuzzle(ctx, models.AnswerDailyPuzzleParams{
+		Correct:    pgtype.Bool{Bool: correct, Valid: true},
+		AnswerKey:  answerKey,
+		Lexicon:    lexicon,
+		PuzzleDate: pgtype.Date{Time: date, Valid: true},
+		UserID:     userDBID,
+	})
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return entity.NewWooglesError(ipc.WooglesError_DAILY_PUZZLE_ALREADY_ANSWERED, userUUID)
+	}
+	return nil
+}
+
+// GetDailyPuzzleStats returns how everyone did on the daily puzzle, with up
+// to wrongAnswerLimit of the most common wrong answers.
+func (s *DBStore) GetDailyPuzzleStats(ctx context.Context, lexicon string, date time.Time, wrongAnswerLimit int) (*puzzle_service.DailyPuzzleStats, error) {
+	day := pgtype.Date{Time: date, Valid: true}
+	row, err := s.queries.GetDailyPuzzleStats(ctx, models.GetDailyPuzzleStatsParams{Lexicon: lexicon, PuzzleDate: day})
+	if err != nil {
+		return nil, err
+	}
+	wrongAnswers, err := s.queries.GetDailyPuzzleWrongAnswers(ctx, models.GetDailyPuzzleWrongAnswersParams{
+		Lexicon:    lexicon,
+		PuzzleDate: day,
+		Lim:        int32(wrongAnswerLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats := &puzzle_service.DailyPuzzleStats{
+		Answers:             int32(row.Answers),
+		Solves:              int32(row.Solves),
+		AverageSolveSeconds: row.AverageSolveSeconds,
+		CommonWrongAnswers:  make([]*puzzle_service.DailyPuzzleWrongAnswer, len(wrongAnswers)),
+	}
+	if row.Answers > 0 {
+		stats.SolveRate = float64(row.Solves) / float64(row.Answers)
+	}
+	for i, wa := range wrongAnswers {
+		stats.CommonWrongAnswers[i] = &puzzle_service.DailyPuzzleWrongAnswer{
+			Answer: wa.AnswerKey,
+			Times:  int32(wa.Times),
+		}
+	}
+	return stats, nil
+}
+
+// GetDailyPuzzleSolveDates returns the dates of the daily puzzles the user
+// solved, latest first.
+func (s *DBStore) GetDailyPuzzleSolveDates(ctx context.Context, userUUID string, lexicon string) ([]time.Time, error) {
+	userDBID, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.queries.GetDailyPuzzleSolveDates(ctx, models.GetDailyPuzzleSolveDatesParams{
+		UserID:  userDBID,
+		Lexicon: lexicon,
+	})
+	if err != nil {
+		return nil, err
+	}
+	dates := make([]time.Time, len(days))
+	for i, d := range days {
+		dates[i] = d.Time
+	}
+	return dates, nil
+}
+
+// GetDailyPuzzleArchive returns the lexicon's daily puzzles before the given
+// date, latest first. The user's result on each is included if userUUID is
+// not empty.
+func (s *DBStore) GetDailyPuzzleArchive(ctx context.Context, userUUID string, lexicon string, before time.Time,
+	limit int, offset int) ([]*puzzle_service.DailyPuzzleArchiveEntry, error) {
+
+	var userDBID int32
+	if userUUID != "" {
+		var err error
+		userDBID, err = s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	rows, err := s.queries.GetDailyPuzzleArchive(ctx, models.GetDailyPuzzleArchiveParams{
+		UserID:  userDBID,
+		Lexicon: lexicon,
+		Before:  pgtype.Date{Time: before, Valid: true},
+		Lim:     int32(limit),
+		Off:     int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*puzzle_service.DailyPuzzleArchiveEntry, len(rows))
+	for i, row := range rows {
+		status := puzzle_service.PuzzleStatus_UNANSWERED
+		if row.Correct.Valid && row.Correct.Bool {
+			status = puzzle_service.PuzzleStatus_CORRECT
+		} else if row.Correct.Valid {
+			status = puzzle_service.PuzzleStatus_INCORRECT
+		}
+		entries[i] = &puzzle_service.DailyPuzzleArchiveEntry{
+			Date:     row.PuzzleDate.Time.Format("2006-01-02"),
+			PuzzleId: row.Uuid,
+			Answers:  int32(row.Answers),
+			Solves:   int32(row.Solves),
+			Status:   status,
+		}
+	}
+	return entries, nil
+}
+
+func (s *DBStore) GetPuzzleReview(ctx context.Context, userUUID string, puzzleUUID string) (*entity.PuzzleReview, error) {
+	pid, uid, err := s.puzzleAndUserDBIDs(ctx, userUUID, puzzleUUID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := s.queries.GetPuzzleReview(ctx, models.GetPuzzleReviewParams{UserID: uid, PuzzleID: pid})
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &entity.PuzzleReview{
+		PuzzleID:       puzzleUUID,
+		EaseFactor:     row.EaseFactor,
+		IntervalDays:   int(row.IntervalDays),
+		Repetitions:    int(row.Repetitions),
+		Lapses:         int(row.Lapses),
+		DueAt:          row.DueAt.Time,
+		LastReviewedAt: row.LastReviewedAt.Time,
+	}, nil
+}
+
+func (s *DBStore) SavePuzzleReview(ctx context.Context, userUUID string, review *entity.PuzzleReview) error {
+	pid, uid, err := s.puzzleAndUserDBIDs(ctx, userUUID, review.PuzzleID)
+	if err != nil {
+		return err
+	}
+	return s.queries.UpsertPuzzleReview(ctx, models.UpsertPuzzleReviewParams{
+		PuzzleID:       pid,
+		UserID:         uid,
+		EaseFactor:     review.EaseFactor,
+		IntervalDays:   int32(review.IntervalDays),
+		Repetitions:    int32(review.Repetitions),
+		Lapses:         int32(review.Lapses),
+		DueAt:          pgtype.Timestamptz{Time: review.DueAt, Valid: true},
+		LastReviewedAt: pgtype.Timestamptz{Time: review.LastReviewedAt, Valid: !review.LastReviewedAt.IsZero()},
+	})
+}
+
+func (s *DBStore) RemovePuzzleReview(ctx context.Context, userUUID string, puzzleUUID string) error {
+	pid, uid, err := s.puzzleAndUserDBIDs(ctx, userUUID, puzzleUUID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := s.queries.DeletePuzzleReview(ctx, models.DeletePuzzleReviewParams{UserID: uid, PuzzleID: pid})
+	if err != nil {
+		return err
+	}
+	if rowsAffected != 1 {
+		return entity.NewWooglesError(ipc.WooglesError_PUZZLE_REVIEW_NOT_FOUND, userUUID, puzzleUUID)
+	}
+	return nil
+}
+
+func (s *DBStore) GetPuzzleReviewQueue(ctx context.Context, userUUID string, lexicon string, limit int) ([]*entity.PuzzleReview, error) {
+	uid, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.GetPuzzleReviewQueue(ctx, models.GetPuzzleReviewQueueParams{
+		UserID:  int64(uid),
+		Lexicon: lexicon,
+		Lim:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]*entity.PuzzleReview, len(rows))
+	for i, row := range rows {
+		reviews[i] = &entity.PuzzleReview{
+			PuzzleID:       row.Uuid,
+			EaseFactor:     row.EaseFactor,
+			IntervalDays:   int(row.IntervalDays),
+			Repetitions:    int(row.Repetitions),
+			Lapses:         int(row.Lapses),
+			DueAt:          row.DueAt.Time,
+			LastReviewedAt: row.LastReviewedAt.Time,
+		}
+	}
+	return reviews, nil
+}
+
+func (s *DBStore) CountPuzzleReviews(ctx context.Context, userUUID string, lexicon string, dueBefore time.Time) (int, int, error) {
+	uid, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return 0, 0, err
+	}
+	row, err := s.queries.CountPuzzleReviews(ctx, models.CountPuzzleReviewsParams{
+		DueBefore: pgtype.Timestamptz{Time: dueBefore, Valid: true},
+		UserID:    int64(uid),
+		Lexicon:   lexicon,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(row.Total), int(row.Due), nil
+}
+
+func (s *DBStore) puzzleAndUserDBIDs(ctx context.Context, userUUID string, puzzleUUID string) (int64, int64, error) {
+	pid, err := s.queries.GetPuzzleDBIDFromUUID(ctx, puzzleUUID)
+	if err != nil {
+		return -1, -1, err
+	}
+	uid, err := s.queries.GetUserDBIDFromUUID(ctx, userUUID)
+	if err != nil {
+		return -1, -1, err
+	}
+	return pid, int64(uid), nil
+}
+
 func (s *DBStore) GetJobInfo(ctx context.Context, genId int) (time.Time, time.Time, time.Duration, *bool, *string, int, int, [][]int, error) {
 	tx, err := s.dbPool.BeginTx(ctx, common.RepeatableReadReadOnlyTxOptions)
 	if err != nil {
@@ -778,6 +1633,18 @@ func getUserRating(ctx context.Context, tx pgx.Tx, userID string, ratingKey enti
 	return sr, nil
 }
 
+// getPuzzleTagRating returns the user's rating in a puzzle tag, or nil if
+// they have not played a puzzle with that tag.
+func getPuzzleTagRating(ctx context.Context, tx pgx.Tx, userDBID int64, lexicon string, tagTitle string) (*entity.SingleRating, error) {
+	var rating *entity.SingleRating
+	err := tx.QueryRow(ctx, `SELECT rating FROM puzzle_tag_ratings WHERE user_id = $1 AND lexicon = $2 AND tag_title = $3`,
+		userDBID, lexicon, tagTitle).Scan(&rating)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return rating, err
+}
+
 func getAttempts(ctx context.Context, tx pgx.Tx, userUUID string, puzzleUUID string) (bool, bool, int32, *bool, time.Time, time.Time, *entity.SingleRating, *entity.SingleRating, int64, error) {
 	pid, err := models.New(tx).GetPuzzleDBIDFromUUID(ctx, puzzleUUID)
 	if err != nil {
@@ -836,12 +1703,12 @@ func getCurrentRatings(ctx context.Context, tx pgx.Tx, userDBID int64, puzzleUUI
 	return currentUserRating, currentPuzzleRating, nil
 }
 
-func getNextClosestRatingPuzzleId(ctx context.Context, tx pgx.Tx, userId string, lexicon string, ratingKey entity.VariantKey) (string, puzzle_service.PuzzleQueryResult, error) {
+func getNextClosestRatingPuzzleId(ctx context.Context, tx pgx.Tx, userId string, lexicon string, ratingKey entity.VariantKey, tags []macondopb.PuzzleTag) (string, puzzle_service.PuzzleQueryResult, error) {
 	var err error
 	var puzzleUUID string
 	var pqr puzzle_service.PuzzleQueryResult
 	if userId == "" {
-		puzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil)
+		puzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil, tags)
 		pqr = puzzle_service.PuzzleQueryResult_RANDOM
 		if err != nil {
 			return "", pqr, err
@@ -857,6 +1724,20 @@ func getNextClosestRatingPuzzleId(ctx context.Context, tx pgx.Tx, userId string,
 		}
 		userDBID := int64(userDBID32)
 
+		// When training on a single theme, match puzzles to the user's
+		// rating in that theme once they have one.
+		titles := tagTitles(tags)
+		if len(titles) == 1 {
+			tagRating, err := getPuzzleTagRating(ctx, tx, userDBID, lexicon, titles[0])
+			if err != nil {
+				return "", pqr, err
+			}
+			if tagRating != nil {
+				userRating = tagRating
+			}
+		}
+		tagCondition := tagFilter("$4")
+
 		queryTemplate := `SELECT uuid
 		FROM  ((SELECT uuid,
 					   rating -> 'r' AS puzzle_rating
@@ -866,7 +1747,7 @@ func getNextClosestRatingPuzzleId(ctx context.Context, tx pgx.Tx, userId string,
 									  FROM   puzzle_attempts
 									  WHERE  user_id = $1 %s)
 					   AND ( rating -> 'r' ) :: FLOAT >= $3
-					   AND valid
+					   AND valid %s
 				ORDER  BY ( rating -> 'r' ) :: FLOAT
 				LIMIT  1)
 			   UNION ALL
@@ -878,33 +1759,33 @@ func getNextClosestRatingPuzzleId(ctx context.Context, tx pgx.Tx, userId string,
 									  FROM   puzzle_attempts
 									  WHERE  user_id = $1 %s)
 					   AND ( rating -> 'r' ) :: FLOAT < $3
-					   AND valid
+					   AND valid %s
 				ORDER  BY ( rating -> 'r' ) :: FLOAT DESC
 				LIMIT  1)) AS rating_query
 		ORDER  BY ABS(( $3 ) :: FLOAT - ( puzzle_rating ) :: FLOAT)
 		LIMIT  1 `
 
-		unseenQuery := fmt.Sprintf(queryTemplate, UnseenCondition, UnseenCondition)
+		unseenQuery := fmt.Sprintf(queryTemplate, UnseenCondition, tagCondition, UnseenCondition, tagCondition)
 		pqr = puzzle_service.PuzzleQueryResult_UNSEEN
-		err = tx.QueryRow(ctx, unseenQuery, userDBID, lexicon, userRating.Rating).Scan(&puzzleUUID)
+		err = tx.QueryRow(ctx, unseenQuery, userDBID, lexicon, userRating.Rating, titles).Scan(&puzzleUUID)
 		if err == pgx.ErrNoRows {
 			// Get a puzzle that the user skipped
-			unratedQuery := fmt.Sprintf(queryTemplate, UnratedCondition, UnratedCondition)
+			unratedQuery := fmt.Sprintf(queryTemplate, UnratedCondition, tagCondition, UnratedCondition, tagCondition)
 			pqr = puzzle_service.PuzzleQueryResult_UNRATED
-			err = tx.QueryRow(ctx, unratedQuery, userDBID, lexicon, userRating.Rating).Scan(&puzzleUUID)
+			err = tx.QueryRow(ctx, unratedQuery, userDBID, lexicon, userRating.Rating, titles).Scan(&puzzleUUID)
 		}
 		if err == pgx.ErrNoRows {
 			// Get a puzzle that the user hasn't answered
-			unansweredQuery := fmt.Sprintf(queryTemplate, UnansweredCondition, UnansweredCondition)
+			unansweredQuery := fmt.Sprintf(queryTemplate, UnansweredCondition, tagCondition, UnansweredCondition, tagCondition)
 			pqr = puzzle_service.PuzzleQueryResult_UNFINISHED
-			err = tx.QueryRow(ctx, unansweredQuery, userDBID, lexicon, userRating.Rating).Scan(&puzzleUUID)
+			err = tx.QueryRow(ctx, unansweredQuery, userDBID, lexicon, userRating.Rating, titles).Scan(&puzzleUUID)
 		}
 
 		// The user has answered all available puzzles.
 		// Return any random puzzle
 
 		if err == pgx.ErrNoRows {
-			puzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil)
+			puzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, nil, tags)
 			pqr = puzzle_service.PuzzleQueryResult_EXHAUSTED
 		}
 		if err != nil {
@@ -915,7 +1796,7 @@ func getNextClosestRatingPuzzleId(ctx context.Context, tx pgx.Tx, userId string,
 	return puzzleUUID, pqr, nil
 }
 
-func getNextPuzzleId(ctx context.Context, tx pgx.Tx, userUUID string, lexicon string) (string, puzzle_service.PuzzleQueryResult, error) {
+func getNextPuzzleId(ctx context.Context, tx pgx.Tx, userUUID string, lexicon string, tags []macondopb.PuzzleTag) (string, puzzle_service.PuzzleQueryResult, error) {
 	var pqr puzzle_service.PuzzleQueryResult
 	uid, err := models.New(tx).GetUserDBIDFromUUID(ctx, userUUID)
 	if err != nil {
@@ -932,52 +1813,54 @@ func getNextPuzzleId(ctx context.Context, tx pgx.Tx, userUUID string, lexicon st
 
 	gtQueryTemplate := `
 		SELECT uuid FROM puzzles WHERE lexicon = $1
-			AND valid
+			AND valid %s
 			AND id NOT IN (SELECT puzzle_id FROM puzzle_attempts WHERE user_id = $2 %s)
 			AND id > $3
 			ORDER BY id LIMIT 1`
 	lteQueryTemplate := `
 		SELECT uuid FROM puzzles WHERE lexicon = $1
-			AND valid
+			AND valid %s
 			AND id NOT IN (SELECT puzzle_id FROM puzzle_attempts WHERE user_id = $2 %s)
 			AND id <= $3
 			ORDER BY id DESC LIMIT 1`
-	gtUnseenQuery := fmt.Sprintf(gtQueryTemplate, UnseenCondition)
+	tagCondition := tagFilter("$4")
+	titles := tagTitles(tags)
+	gtUnseenQuery := fmt.Sprintf(gtQueryTemplate, tagCondition, UnseenCondition)
 	var puzzleUUID string
-	err = tx.QueryRow(ctx, gtUnseenQuery, lexicon, uid, randomId.Int64).Scan(&puzzleUUID)
+	err = tx.QueryRow(ctx, gtUnseenQuery, lexicon, uid, randomId.Int64, titles).Scan(&puzzleUUID)
 	pqr = puzzle_service.PuzzleQueryResult_UNSEEN
 	if err == pgx.ErrNoRows {
 		// Try again, but looking before the id instead
-		lteUnseenQuery := fmt.Sprintf(lteQueryTemplate, UnseenCondition)
-		err = tx.QueryRow(ctx, lteUnseenQuery, lexicon, uid, randomId.Int64).Scan(&puzzleUUID)
+		lteUnseenQuery := fmt.Sprintf(lteQueryTemplate, tagCondition, UnseenCondition)
+		err = tx.QueryRow(ctx, lteUnseenQuery, lexicon, uid, randomId.Int64, titles).Scan(&puzzleUUID)
 	}
 	// Get a random puzzle that the user skipped
 	if err == pgx.ErrNoRows {
-		gtUnratedQuery := fmt.Sprintf(gtQueryTemplate, UnratedCondition)
-		err = tx.QueryRow(ctx, gtUnratedQuery, lexicon, uid, randomId.Int64).Scan(&puzzleUUID)
+		gtUnratedQuery := fmt.Sprintf(gtQueryTemplate, tagCondition, UnratedCondition)
+		err = tx.QueryRow(ctx, gtUnratedQuery, lexicon, uid, randomId.Int64, titles).Scan(&puzzleUUID)
 		pqr = puzzle_service.PuzzleQueryResult_UNRATED
 	}
 	if err == pgx.ErrNoRows {
-		lteUnratedQuery := fmt.Sprintf(lteQueryTemplate, UnratedCondition)
-		err = tx.QueryRow(ctx, lteUnratedQuery, lexicon, uid, randomId.Int64).Scan(&puzzleUUID)
+		lteUnratedQuery := fmt.Sprintf(lteQueryTemplate, tagCondition, UnratedCondition)
+		err = tx.QueryRow(ctx, lteUnratedQuery, lexicon, uid, randomId.Int64, titles).Scan(&puzzleUUID)
 		pqr = puzzle_service.PuzzleQueryResult_UNRATED
 	}
 	// Get a random puzzle that the user has not answered
 	if err == pgx.ErrNoRows {
-		gtUnansweredQuery := fmt.Sprintf(gtQueryTemplate, UnansweredCondition)
-		err = tx.QueryRow(ctx, gtUnansweredQuery, lexicon, uid, randomId.Int64).Scan(&puzzleUUID)
+		gtUnansweredQuery := fmt.Sprintf(gtQueryTemplate, tagCondition, UnansweredCondition)
+		err = tx.QueryRow(ctx, gtUnansweredQuery, lexicon, uid, randomId.Int64, titles).Scan(&puzzleUUID)
 		pqr = puzzle_service.PuzzleQueryResult_UNFINISHED
 	}
 	if err == pgx.ErrNoRows {
-		lteUnansweredQuery := fmt.Sprintf(lteQueryTemplate, UnansweredCondition)
-		err = tx.QueryRow(ctx, lteUnansweredQuery, lexicon, uid, randomId.Int64).Scan(&puzzleUUID)
+		lteUnansweredQuery := fmt.Sprintf(lteQueryTemplate, tagCondition, UnansweredCondition)
+		err = tx.QueryRow(ctx, lteUnansweredQuery, lexicon, uid, randomId.Int64, titles).Scan(&puzzleUUID)
 		pqr = puzzle_service.PuzzleQueryResult_UNFINISHED
 	}
 	// The user has answered all available puzzles.
 	// Return any random puzzle
 
 	if err == pgx.ErrNoRows {
-		puzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, randomId)
+		puzzleUUID, err = getRandomPuzzleUUID(ctx, tx, lexicon, randomId, tags)
 		pqr = puzzle_service.PuzzleQueryResult_EXHAUSTED
 		if err != nil {
 			return "", pqr, err
@@ -989,7 +1872,7 @@ func getNextPuzzleId(ctx context.Context, tx pgx.Tx, userUUID string, lexicon st
 	return puzzleUUID, pqr, nil
 }
 
-func getRandomPuzzleUUID(ctx context.Context, tx pgx.Tx, lexicon string, randomId *pgtype.Int8) (string, error) {
+func getRandomPuzzleUUID(ctx context.Context, tx pgx.Tx, lexicon string, randomId *pgtype.Int8, tags []macondopb.PuzzleTag) (string, error) {
 	var err error
 	if randomId == nil {
 		randomId, err = getRandomPuzzleDBID(ctx, tx)
@@ -998,14 +1881,15 @@ func getRandomPuzzleUUID(ctx context.Context, tx pgx.Tx, lexicon string, randomI
 		}
 	}
 	var puzzleUUID string
+	titles := tagTitles(tags)
 	err = tx.QueryRow(ctx, `
 		SELECT uuid FROM puzzles WHERE lexicon = $1
-			AND valid
+			AND valid`+tagFilter("$3")+`
 			AND id > $2
-			ORDER BY id LIMIT 1`, lexicon, randomId.Int64).Scan(&puzzleUUID)
+			ORDER BY id LIMIT 1`, lexicon, randomId.Int64, titles).Scan(&puzzleUUID)
 	if err == pgx.ErrNoRows {
 		err = tx.QueryRow(ctx, `
-			SELECT uuid FROM puzzles WHERE lexicon = $1 AND valid AND id <= $2 ORDER BY id DESC LIMIT 1`, lexicon, randomId.Int64).Scan(&puzzleUUID)
+			SELECT uuid FROM puzzles WHERE lexicon = $1 AND valid`+tagFilter("$3")+` AND id <= $2 ORDER BY id DESC LIMIT 1`, lexicon, randomId.Int64, titles).Scan(&puzzleUUID)
 	}
 	if err == pgx.ErrNoRows {
 		return "", entity.NewWooglesError(ipc.WooglesError_PUZZLE_GET_RANDOM_PUZZLE_NOT_FOUND, "", lexicon)
@@ -6,9 +6,10 @@ local msg = ARGV[3]
 local channel = ARGV[4]
 local channelFriendly = ARGV[5]
 local tsNow = tonumber(ARGV[6])
-local regulateChat = ARGV[7] -- "regulated", "unregulated"
+local slowModeSeconds = tonumber(ARGV[7]) -- 0 disables slow mode
+local floodMaxMessages = tonumber(ARGV[8]) -- 0 disables flood detection
+local floodWindowSeconds = tonumber(ARGV[9])
 
-local MessageCooldownTime = 5
 local DuplicateMessageCooldownTime = 30 * 60
 local LongChannelExpiration = 86400 * 14
 local GameChatChannelExpiration = 86400 * 14
@@ -35,8 +36,24 @@ local function trimPrefix(s, sub)
 end
 
 local userCooldownKey = "userchatcooldown:" .. senderUID
+local userFloodKey = "userchatflood:" .. senderUID .. ":" .. channel
 
-if regulateChat == "regulated" then
+if slowModeSeconds == nil or floodMaxMessages == nil or floodWindowSeconds == nil then
+  return { "err", "invalid parameter" }
+end
+
+if floodMaxMessages > 0 then
+  -- Every attempt counts, including the ones slow mode refuses.
+  redis.call("LPUSH", userFloodKey, tsNow)
+  redis.call("LTRIM", userFloodKey, 0, floodMaxMessages)
+  redis.call("EXPIRE", userFloodKey, floodWindowSeconds)
+  local oldest = redis.call("LINDEX", userFloodKey, floodMaxMessages)
+  if oldest and tonumber(oldest) > tsNow - floodWindowSeconds then
+    return { "flood" }
+  end
+end
+
+if slowModeSeconds > 0 then
   local hmgetRet = redis.call("HMGET", userCooldownKey,
     "ts",
     "msg")
@@ -54,7 +71,7 @@ if regulateChat == "regulated" then
     local lastMessageTime = tonumber(lastMessageTimeString)
 
     -- Check if the cooldown is over
-    local cooldownFinishedTime = lastMessageTime + MessageCooldownTime
+    local cooldownFinishedTime = lastMessageTime + slowModeSeconds
     if cooldownFinishedTime > tsNow then
       return { "err", "you cannot send messages that quickly" }
     end
@@ -64,9 +81,6 @@ if regulateChat == "regulated" then
     "ts", tsNow,
     "msg", msg)
   redis.call("EXPIRE", userCooldownKey, DuplicateMessageCooldownTime)
-elseif regulateChat == "unregulated" then
-else
-  return { "err", "invalid parameter" }
 end
 
 local redisKey = "chat:" .. trimPrefix(channel, "chat.")
@@ -1,10 +1,12 @@
 package redis
 
 import (
+	"cmp"
 	"context"
 	_ "embed"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/woogles-io/liwords/pkg/entity"
 	leaguestore "github.com/woogles-io/liwords/pkg/stores/league"
+	"github.com/woogles-io/liwords/pkg/stores/models"
 	"github.com/woogles-io/liwords/pkg/tournament"
 	"github.com/woogles-io/liwords/pkg/user"
 	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
@@ -43,30 +46,42 @@ const ChatsOnReload = 100
 const LatestChatSeparator = ":"
 const ChatPreviewLength = 40
 
+const PMChannelPrefix = "chat.pm."
+
 // RedisChatStore implements a Redis store for chats.
+// Private messages are also kept in Postgres, so that they outlive the Redis
+// streams and their read state is shared across devices.
 type RedisChatStore struct {
 	redisPool            *redis.Pool
 	presenceStore        user.PresenceStore
 	tournamentStore      tournament.TournamentStore
 	leagueStore          leaguestore.Store
+	queries              *models.Queries
 	addChatScript        *redis.Script
 	latestChannelsScript *redis.Script
 	eventChan            chan *entity.EventWrapper
 }
 
 // NewRedisChatStore instantiates a new store for chats, based on Redis.
-func NewRedisChatStore(r *redis.Pool, p user.PresenceStore, t tournament.TournamentStore, l leaguestore.Store) *RedisChatStore {
+// If q is nil, private messages are only kept in Redis.
+func NewRedisChatStore(r *redis.Pool, p user.PresenceStore, t tournament.TournamentStore, l leaguestore.Store,
+	q *models.Queries) *RedisChatStore {
 	return &RedisChatStore{
 		redisPool:            r,
 		presenceStore:        p,
 		tournamentStore:      t,
 		leagueStore:          l,
+		queries:              q,
 		addChatScript:        redis.NewScript(0, AddChatScript),
 		latestChannelsScript: redis.NewScript(0, LatestChannelsScript),
 		eventChan:            nil,
 	}
 }
 
+func (r *RedisChatStore) persistsPMs(channel string) bool {
+	return r.queries != nil && strings.HasPrefix(channel, PMChannelPrefix)
+}
+
 // redisStreamTS returns the timestamp of the stream data object, in MILLISECONDS.
 func redisStreamTS(key string) (int64, error) {
 	// note: inefficient
@@ -83,19 +98,16 @@ func redisStreamTS(key string) (int64, error) {
 
 // AddChat takes in sender information, the message, and the name of the channel.
 // Additionally, a user-readable name for the channel should be provided.
+// The limits are enforced atomically with adding the message.
 func (r *RedisChatStore) AddChat(ctx context.Context, senderUsername, senderUID, msg,
-	channel, channelFriendly string, regulateChat bool) (*pb.ChatMessage, error) {
+	channel, channelFriendly string, limits user.ChatLimits) (*pb.ChatMessage, error) {
 	conn := r.redisPool.Get()
 	defer conn.Close()
 
-	regulateChatString := "unregulated"
-	if regulateChat {
-		regulateChatString = "regulated"
-	}
-
 	tsNow := time.Now().Unix()
 
-	ret, err := r.addChatScript.Do(conn, senderUsername, senderUID, msg, channel, channelFriendly, tsNow, regulateChatString)
+	ret, err := r.addChatScript.Do(conn, senderUsername, senderUID, msg, channel, channelFriendly, tsNow,
+		limits.SlowModeSeconds, limits.FloodMaxMessages, limits.FloodWindowSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -119,6 +131,8 @@ func (r *RedisChatStore) AddChat(ctx context.Context, senderUsername, senderUID,
 			return nil, fmt.Errorf("unexpected type for arr[1]: %T", arr[1])
 		}
 		return nil, fmt.Errorf("%s", reasonBytes)
+	case "flood":
+		return nil, user.ErrChatFlood
 	case "ok":
 	default:
 		return nil, fmt.Errorf("unexpected value for arr[0]: %s", was_ok)
@@ -137,6 +151,15 @@ func (r *RedisChatStore) AddChat(ctx context.Context, senderUsername, senderUID,
 		return nil, fmt.Errorf("unexpected type for arr[2]: %T", arr[2])
 	}
 
+	if r.persistsPMs(channel) {
+		// The message is already in the Redis stream, so don't fail the send;
+		// it will just be missing from the durable history.
+		err = r.storePM(ctx, senderUID, msg, channel, string(retIdBytes))
+		if err != nil {
+			log.Err(err).Str("channel", channel).Msg("store-pm")
+		}
+	}
+
 	return &pb.ChatMessage{
 		Username:  senderUsername,
 		UserId:    senderUID,
@@ -147,14 +170,68 @@ func (r *RedisChatStore) AddChat(ctx context.Context, senderUsername, senderUID,
 	}, nil
 }
 
-func (r *RedisChatStore) OldChats(ctx context.Context, channel string, n int) ([]*pb.ChatMessage, error) {
+func (r *RedisChatStore) storePM(ctx context.Context, senderUID, msg, channel, chatID string) error {
+	users := strings.Split(strings.TrimPrefix(channel, PMChannelPrefix), "_")
+	if len(users) != 2 {
+		return fmt.Errorf("malformed pm chat channel: %v", channel)
+	}
+	convID, err := r.queries.UpsertPMConversation(ctx, models.UpsertPMConversationParams{
+		Channel:   channel,
+		User1Uuid: users[0],
+		User2Uuid: users[1],
+	})
+	if err != nil {
+		return err
+	}
+	_, err = r.queries.AddPMMessage(ctx, models.AddPMMessageParams{
+		ConversationID: convID,
+		ChatID:         chatID,
+		SenderUuid:     senderUID,
+		Message:        msg,
+	})
+	if err != nil {
+		return err
+	}
+	// Whoever sends a message has read the conversation up to it.
+	_, err = r.queries.MarkPMConversationRead(ctx, models.MarkPMConversationReadParams{
+		Channel:  channel,
+		UserUuid: senderUID,
+	})
+	return err
+}
+
+// OldChats returns the latest n chats in the channel, oldest first. If
+// beforeID is not blank, only chats older than that message are returned.
+func (r *RedisChatStore) OldChats(ctx context.Context, channel, beforeID string, n int) ([]*pb.ChatMessage, error) {
+	messages, err := r.oldRedisChats(channel, beforeID, n)
+	if err != nil {
+		return nil, err
+	}
+	if !r.persistsPMs(channel) {
+		return messages, nil
+	}
+	// Private messages from before they were kept in Postgres are only in
+	// Redis, until they expire, and the oldest ones may only be in Postgres.
+	// Both are paged through together.
+	pms, err := r.oldPMs(ctx, channel, beforeID, n)
+	if err != nil {
+		return nil, err
+	}
+	return mergeChats(pms, messages, n), nil
+}
+
+func (r *RedisChatStore) oldRedisChats(channel, beforeID string, n int) ([]*pb.ChatMessage, error) {
 	redisKey := "chat:" + strings.TrimPrefix(channel, "chat.")
 	log.Debug().Str("redisKey", redisKey).Msg("get-old-chats")
 	conn := r.redisPool.Get()
 	defer conn.Close()
 
+	end := "+"
+	if beforeID != "" {
+		end = "(" + beforeID
+	}
 	// Get the latest chats to display to the user.
-	vals, err := redis.Values(conn.Do("XREVRANGE", redisKey, "+", "-", "COUNT", n))
+	vals, err := redis.Values(conn.Do("XREVRANGE", redisKey, end, "-", "COUNT", n))
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +249,93 @@ func (r *RedisChatStore) OldChats(ctx context.Context, channel string, n int) ([
 	return messages, nil
 }
 
+func (r *RedisChatStore) oldPMs(ctx context.Context, channel, beforeID string, n int) ([]*pb.ChatMessage, error) {
+	rows, err := r.queries.GetPMMessages(ctx, models.GetPMMessagesParams{
+		Channel:      channel,
+		BeforeChatID: beforeID,
+		Lim:          int32(n),
+	})
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*pb.ChatMessage, len(rows))
+	for idx, row := range rows {
+		messages[len(rows)-1-idx] = &pb.ChatMessage{
+			Username:  row.SenderUsername,
+			UserId:    row.SenderUuid,
+			Channel:   channel,
+			Message:   row.Message,
+			Timestamp: row.CreatedAt.Time.UnixMilli(),
+			Id:        row.ChatID,
+		}
+	}
+	return messages, nil
+}
+
+// mergeChats merges two lists of chats that are each sorted oldest first,
+// leaving out the chats that are in both, and returns the latest n.
+func mergeChats(a, b []*pb.ChatMessage, n int) []*pb.ChatMessage {
+	merged := make([]*pb.ChatMessage, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b):
+			merged = append(merged, a[i])
+			i++
+		case i == len(a):
+			merged = append(merged, b[j])
+			j++
+		default:
+			switch c := compareStreamIDs(a[i].Id, b[j].Id); {
+			case c < 0:
+				merged = append(merged, a[i])
+				i++
+			case c > 0:
+				merged = append(merged, b[j])
+				j++
+			default:
+				merged = append(merged, a[i])
+				i++
+				j++
+			}
+		}
+	}
+	if len(merged) > n {
+		merged = merged[len(merged)-n:]
+	}
+	return merged
+}
+
+// compareStreamIDs orders two Redis stream IDs, which look like
+// <milliseconds>-<sequence number>.
+func compareStreamIDs(a, b string) int {
+	ams, aseq, aok := parseStreamID(a)
+	bms, bseq, bok := parseStreamID(b)
+	if !aok || !bok {
+		return strings.Compare(a, b)
+	}
+	if ams != bms {
+		return cmp.Compare(ams, bms)
+	}
+	return cmp.Compare(aseq, bseq)
+}
+
+func parseStreamID(id string) (int64, int64, bool) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseInt(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err := strconv.ParseInt(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
+
 func rstreamMsgToChatMsg(v interface{}) (*pb.ChatMessage, error) {
 	// This is kind of gross and fragile, but redigo doesn't have stream support yet 😥
 	msg := &pb.ChatMessage{}
@@ -209,6 +373,16 @@ func (r *RedisChatStore) DeleteChat(ctx context.Context, channel, msgID string)
 	if err != nil {
 		return err
 	}
+	if r.persistsPMs(channel) {
+		deleted, err := r.queries.DeletePMMessage(ctx, models.DeletePMMessageParams{
+			Channel: channel,
+			ChatID:  msgID,
+		})
+		if err != nil {
+			return err
+		}
+		val += int(deleted)
+	}
 	if val == 0 {
 		return errors.New("zero chats deleted")
 	}
@@ -260,7 +434,13 @@ func (r *RedisChatStore) LatestChannels(ctx context.Context, count, offset int,
 
 	ts := time.Now().Unix()
 
-	vals, err := redis.Strings(r.latestChannelsScript.Do(conn, uid, count, offset, ts))
+	// Private messages come from Postgres, so get enough channels from both
+	// places to merge them and page through the result.
+	redisCount, redisOffset := count, offset
+	if r.queries != nil {
+		redisCount, redisOffset = count+offset, 0
+	}
+	vals, err := redis.Strings(r.latestChannelsScript.Do(conn, uid, redisCount, redisOffset, ts))
 	if err != nil {
 		return nil, err
 	}
@@ -272,11 +452,11 @@ func (r *RedisChatStore) LatestChannels(ctx context.Context, count, offset int,
 	}
 
 	log.Debug().Interface("vals", vals).Msg("vals-from-redis")
-	chans := make([]*upb.ActiveChatChannels_Channel, len(vals)/3)
+	chans := make([]*upb.ActiveChatChannels_Channel, 0, len(vals)/3)
 	getTournament := tournamentID != ""
 	getLeague := leagueID != ""
 
-	for idx := 0; idx < len(chans); idx++ {
+	for idx := 0; idx < len(vals)/3; idx++ {
 
 		chanName := strings.SplitN(vals[idx*3], LatestChatSeparator, 2)
 		if len(chanName) != 2 {
@@ -295,13 +475,16 @@ func (r *RedisChatStore) LatestChannels(ctx context.Context, count, offset int,
 		lastMsg := maybeTrim(vals[idx*3+2])
 		lastUpdate := ts / 1000
 
-		chans[idx] = &upb.ActiveChatChannels_Channel{
+		if r.queries != nil && strings.HasPrefix(chanName[0], PMChannelPrefix) {
+			continue
+		}
+		chans = append(chans, &upb.ActiveChatChannels_Channel{
 			Name:        chanName[0],
 			DisplayName: chanName[1],
 			LastUpdate:  lastUpdate,
 			LastMessage: lastMsg,
 			HasUpdate:   lastUpdate > lastSeen,
-		}
+		})
 		if tournamentID != "" && chanName[0] == "chat.tournament."+tournamentID {
 			getTournament = false
 		}
@@ -310,6 +493,33 @@ func (r *RedisChatStore) LatestChannels(ctx context.Context, count, offset int,
 		}
 	}
 
+	if r.queries != nil {
+		inbox, err := r.queries.GetPMInbox(ctx, models.GetPMInboxParams{
+			UserUuid: uid,
+			Lim:      int32(count + offset),
+			Offs:     0,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pms := make([]*upb.ActiveChatChannels_Channel, len(inbox))
+		for idx, row := range inbox {
+			first, second := row.MyUsername, row.OtherUsername
+			if first > second {
+				first, second = second, first
+			}
+			pms[idx] = &upb.ActiveChatChannels_Channel{
+				Name:        row.Channel,
+				DisplayName: "pm:" + first + ":" + second,
+				LastUpdate:  row.LastMessageAt.Time.Unix(),
+				LastMessage: maybeTrim(row.LastMessage),
+				HasUpdate:   row.Unread > 0,
+				UnreadCount: int32(row.Unread),
+			}
+		}
+		chans = mergeChannels(chans, pms, offset, count)
+	}
+
 	// If a tournament ID is passed in, we should fetch the tournament channel
 	// as well as the latest chat for this tournament.
 	if getTournament {
@@ -319,7 +529,7 @@ func (r *RedisChatStore) LatestChannels(ctx context.Context, count, offset int,
 		}
 		// Get the last chat for this tournament channel.
 		chatChannel := "chat.tournament." + tournamentID
-		cm, err := r.OldChats(ctx, chatChannel, 1)
+		cm, err := r.OldChats(ctx, chatChannel, "", 1)
 		if err != nil {
 			return nil, err
 		}
@@ -352,7 +562,7 @@ func (r *RedisChatStore) LatestChannels(ctx context.Context, count, offset int,
 		}
 		// Get the last chat for this league channel.
 		chatChannel := "chat.league." + leagueID
-		cm, err := r.OldChats(ctx, chatChannel, 1)
+		cm, err := r.OldChats(ctx, chatChannel, "", 1)
 		if err != nil {
 			return nil, err
 		}
@@ -375,6 +585,25 @@ func (r *RedisChatStore) LatestChannels(ctx context.Context, count, offset int,
 	return &upb.ActiveChatChannels{Channels: chans}, nil
 }
 
+// mergeChannels merges two lists of channels that are each sorted with the
+// most recently updated first, and returns the requested page of the result.
+func mergeChannels(a, b []*upb.ActiveChatChannels_Channel, offset, count int) []*upb.ActiveChatChannels_Channel {
+	merged := make([]*upb.ActiveChatChannels_Channel, 0, len(a)+len(b))
+	merged = append(merged, a...)
+	merged = append(merged, b...)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].LastUpdate > merged[j].LastUpdate
+	})
+	if offset >= len(merged) {
+		return []*upb.ActiveChatChannels_Channel{}
+	}
+	merged = merged[offset:]
+	if len(merged) > count {
+		merged = merged[:count]
+	}
+	return merged
+}
+
 func (r *RedisChatStore) SetEventChan(c chan *entity.EventWrapper) {
 	r.eventChan = c
 }
@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	pb "github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	upb "github.com/woogles-io/liwords/rpc/api/proto/user_service"
+)
+
+func channelNames(chans []*upb.ActiveChatChannels_Channel) []string {
+	names := make([]string, len(chans))
+	for i, c := range chans {
+		names[i] = c.Name
+	}
+	return names
+}
+
+func TestMergeChannels(t *testing.T) {
+	is := is.New(t)
+	redisChans := []*upb.ActiveChatChannels_Channel{
+		{Name: "chat.tournament.a", LastUpdate: 500},
+		{Name: "chat.league.b", LastUpdate: 300},
+		{Name: "chat.tournament.c", LastUpdate: 100},
+	}
+	pms := []*upb.ActiveChatChannels_Channel{
+		{Name: "chat.pm.x_y", LastUpdate: 400},
+		{Name: "chat.pm.x_z", LastUpdate: 200},
+	}
+
+	is.Equal(channelNames(mergeChannels(redisChans, pms, 0, 10)), []string{
+		"chat.tournament.a", "chat.pm.x_y", "chat.league.b", "chat.pm.x_z", "chat.tournament.c"})
+	is.Equal(channelNames(mergeChannels(redisChans, pms, 0, 2)), []string{
+		"chat.tournament.a", "chat.pm.x_y"})
+	is.Equal(channelNames(mergeChannels(redisChans, pms, 2, 2)), []string{
+		"chat.league.b", "chat.pm.x_z"})
+	is.Equal(len(mergeChannels(redisChans, pms, 5, 2)), 0)
+	is.Equal(channelNames(mergeChannels(nil, pms, 0, 10)), []string{"chat.pm.x_y", "chat.pm.x_z"})
+}
+
+func chatIDs(chats []*pb.ChatMessage) []string {
+	ids := make([]string, len(chats))
+	for i, c := range chats {
+		ids[i] = c.Id
+	}
+	return ids
+}
+
+func TestMergeChats(t *testing.T) {
+	is := is.New(t)
+	// The oldest messages are only in Redis, and Redis has trimmed some of
+	// the ones that are in Postgres.
+	pms := []*pb.ChatMessage{{Id: "1700000000300-0"}, {Id: "1700000000400-0"}, {Id: "1700000000400-1"}}
+	redisChats := []*pb.ChatMessage{{Id: "1700000000100-0"}, {Id: "1700000000200-0"}, {Id: "1700000000400-1"}}
+
+	is.Equal(chatIDs(mergeChats(pms, redisChats, 10)), []string{
+		"1700000000100-0", "1700000000200-0", "1700000000300-0", "1700000000400-0", "1700000000400-1"})
+	is.Equal(chatIDs(mergeChats(pms, redisChats, 2)), []string{"1700000000400-0", "1700000000400-1"})
+	is.Equal(chatIDs(mergeChats(nil, redisChats, 2)), []string{"1700000000200-0", "1700000000400-1"})
+	is.Equal(len(mergeChats(nil, nil, 2)), 0)
+
+	// Sequence numbers are compared as numbers.
+	is.Equal(compareStreamIDs("1700000000400-10", "1700000000400-9"), 1)
+	is.Equal(compareStreamIDs("999-0", "1000-0"), -1)
+}
@@ -118,11 +118,11 @@ func NewInitializedStores(dbPool *pgxpool.Pool, redisPool *redigoredis.Pool, cfg
 		return nil, err
 	}
 
-	// Initialize ChatStore after LeagueStore is ready
-	stores.ChatStore = redis.NewRedisChatStore(redisPool, stores.PresenceStore, stores.TournamentStore, stores.LeagueStore)
-
 	stores.Queries = models.New(dbPool)
 
+	// Initialize ChatStore after LeagueStore and Queries are ready
+	stores.ChatStore = redis.NewRedisChatStore(redisPool, stores.PresenceStore, stores.TournamentStore, stores.LeagueStore, stores.Queries)
+
 	// Note: LeagueStandingsUpdater is set separately after initialization to avoid
 	// circular import dependencies. See SetLeagueStandingsUpdater().
 
@@ -19,6 +19,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/integrations/organizations"
 	"github.com/woogles-io/liwords/pkg/stores/common"
 	"github.com/woogles-io/liwords/pkg/stores/models"
+	pkguser "github.com/woogles-io/liwords/pkg/user"
 
 	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
 	ms "github.com/woogles-io/liwords/rpc/api/proto/mod_service"
@@ -112,7 +113,7 @@ func profileFromRow(firstName, lastName, birthDate, countryCode, title, about, a
 func (s *DBStore) Get(ctx context.Context, username string) (*entity.User, error) {
 	row, err := s.queries.GetUserWithProfileByUsername(ctx, username)
 	if err == pgx.ErrNoRows {
-		return nil, errors.New("user not found")
+		return nil, pkguser.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, err
@@ -140,7 +141,7 @@ func (s *DBStore) SetNotoriety(ctx context.Context, uuid string, notoriety int)
 func (s *DBStore) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
 	row, err := s.queries.GetUserByEmail(ctx, email)
 	if err == pgx.ErrNoRows {
-		return nil, errors.New("user not found")
+		return nil, pkguser.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, err
@@ -153,7 +154,7 @@ func (s *DBStore) GetByEmail(ctx context.Context, email string) (*entity.User, e
 func (s *DBStore) GetByUUID(ctx context.Context, uuid string) (*entity.User, error) {
 	row, err := s.queries.GetUserWithProfileByUUID(ctx, uuid)
 	if err == pgx.ErrNoRows {
-		return nil, errors.New("user not found")
+		return nil, pkguser.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, err
@@ -202,7 +203,7 @@ func (s *DBStore) GetByAPIKey(ctx context.Context, apikey string) (*entity.User,
 	}
 	row, err := s.queries.GetUserByAPIKey(ctx, pgtype.Text{String: apikey, Valid: true})
 	if err == pgx.ErrNoRows {
-		return nil, errors.New("user not found")
+		return nil, pkguser.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, err
@@ -218,7 +219,7 @@ func (s *DBStore) GetByVerificationToken(ctx context.Context, token string) (*en
 	}
 	row, err := s.queries.GetUserWithProfileByVerificationToken(ctx, pgtype.Text{String: token, Valid: true})
 	if err == pgx.ErrNoRows {
-		return nil, errors.New("user not found")
+		return nil, pkguser.ErrUserNotFound
 	}
 	if err != nil {
 		return nil, err
@@ -260,12 +261,26 @@ func (s *DBStore) New(ctx context.Context, u *entity.User) error {
 	}
 	defer tx.Rollback(ctx)
 
+	if err := InsertUser(ctx, tx, u); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InsertUser inserts the user and their profile in the given transaction, for
+// callers that need to do more work atomically with the signup.
+func InsertUser(ctx context.Context, tx pgx.Tx, u *entity.User) error {
 	if u.UUID == "" {
 		u.UUID = shortuuid.New()
 	}
 
 	var userId uint
-	err = tx.QueryRow(ctx, `INSERT INTO users (username, uuid, email, password, internal_bot, notoriety, verified, verification_token, verification_expires_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW()) RETURNING id`,
+	err := tx.QueryRow(ctx, `INSERT INTO users (username, uuid, email, password, internal_bot, notoriety, verified, verification_token, verification_expires_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW(), NOW()) RETURNING id`,
 		u.Username, u.UUID, u.Email, u.Password, u.IsBot, u.Notoriety, u.Verified, u.VerificationToken, u.VerificationExpiresAt).Scan(&userId)
 	if err != nil {
 		return err
@@ -278,15 +293,7 @@ func (s *DBStore) New(ctx context.Context, u *entity.User) error {
 
 	_, err = tx.Exec(ctx, `INSERT INTO profiles (user_id, first_name, last_name, country_code, title, about, ratings, stats, avatar_url, birth_date, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW(), NOW())`,
 		userId, prof.FirstName, prof.LastName, prof.CountryCode, prof.Title, prof.About, prof.Ratings, prof.Stats, prof.AvatarUrl, prof.BirthDate)
-	if err != nil {
-		return err
-	}
-
-	if err := tx.Commit(ctx); err != nil {
-		return err
-	}
-
-	return nil
+	return err
 }
 
 // SetPassword sets the password for the user. The password is already hashed.
@@ -418,6 +425,59 @@ func (s *DBStore) SetRatings(ctx context.Context, p0uuid string, p1uuid string,
 	return tx.Commit(ctx)
 }
 
+func (s *DBStore) SetRatingsWithHistory(ctx context.Context, variant entity.VariantKey,
+	changes []*entity.RatingChange) error {
+	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := s.queries.WithTx(tx)
+	for _, c := range changes {
+		uid, err := qtx.GetUserDBIDFromUUID(ctx, c.UserUUID)
+		if err != nil {
+			return err
+		}
+		var oppID pgtype.Int4
+		if c.OpponentUUID != "" {
+			id, err := qtx.GetUserDBIDFromUUID(ctx, c.OpponentUUID)
+			if err != nil {
+				return err
+			}
+			oppID = pgtype.Int4{Int32: id, Valid: true}
+		}
+		if err = common.UpdateUserRating(ctx, tx, int64(uid), variant, &c.After); err != nil {
+			return err
+		}
+		err = qtx.AddRatingHistory(ctx, models.AddRatingHistoryParams{
+			UserID:           uid,
+			Variant:          string(variant),
+			GameUuid:         c.GameID,
+			OpponentID:       oppID,
+			RatingBefore:     c.Before.Rating,
+			RatingAfter:      c.After.Rating,
+			RdBefore:         c.Before.RatingDeviation,
+			RdAfter:          c.After.RatingDeviation,
+			VolatilityBefore: c.Before.Volatility,
+			VolatilityAfter:  c.After.Volatility,
+			OpponentRating:   c.Opponent.Rating,
+			OpponentRd:       c.Opponent.RatingDeviation,
+			Spread:           int32(c.Spread),
+			ExpectedResult:   c.Breakdown.ExpectedResult,
+			ActualResult:     c.Breakdown.ActualResult,
+			WinBoost:         c.Breakdown.WinBoost,
+			InactiveRd:       c.Breakdown.InactiveRatingDeviation,
+			Multiplier:       c.Breakdown.Multiplier,
+			CreatedAt:        pgtype.Timestamptz{Time: time.Unix(c.After.LastGameTimestamp, 0), Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
 func (s *DBStore) SetStats(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
 	p0Stats *entity.Stats, p1Stats *entity.Stats) error {
 	tx, err := s.dbPool.BeginTx(ctx, common.DefaultTxOptions)
@@ -1059,7 +1119,29 @@ func applyOrRemoveActionsDB(ctx context.Context, s *DBStore, actions []*ms.ModAc
 	}
 	defer tx.Rollback(ctx)
 
-	qtx := s.queries.WithTx(tx)
+	if err := applyOrRemoveActionsTx(ctx, tx, actions, apply); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ApplyActionsTx applies the actions in the given transaction, for callers
+// that need to record more alongside them.
+func ApplyActionsTx(ctx context.Context, tx pgx.Tx, actions []*ms.ModAction) error {
+	return applyOrRemoveActionsTx(ctx, tx, actions, true)
+}
+
+// RemoveActionsTx removes the actions in the given transaction.
+func RemoveActionsTx(ctx context.Context, tx pgx.Tx, actions []*ms.ModAction) error {
+	return applyOrRemoveActionsTx(ctx, tx, actions, false)
+}
+
+func applyOrRemoveActionsTx(ctx context.Context, tx pgx.Tx, actions []*ms.ModAction, apply bool) error {
+	qtx := models.New(tx)
 	userDBIDs, err := getUserDBIDsFromActions(ctx, qtx, actions)
 	if err != nil {
 		return err
@@ -1109,10 +1191,6 @@ func applyOrRemoveActionsDB(ctx context.Context, s *DBStore, actions []*ms.ModAc
 			}
 		}
 	}
-
-	if err := tx.Commit(ctx); err != nil {
-		return err
-	}
 	return nil
 }
 
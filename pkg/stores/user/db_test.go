@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/matryer/is"
 	"github.com/rs/zerolog/log"
@@ -18,6 +19,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/entity"
 	"github.com/woogles-io/liwords/pkg/glicko"
 	"github.com/woogles-io/liwords/pkg/stores/common"
+	"github.com/woogles-io/liwords/pkg/stores/models"
 	"github.com/woogles-io/liwords/rpc/api/proto/mod_service"
 	"github.com/woogles-io/liwords/rpc/api/proto/user_service"
 )
@@ -376,6 +378,53 @@ func TestSet(t *testing.T) {
 	ustore.Disconnect()
 }
 
+func TestSetRatingsWithHistory(t *testing.T) {
+	is := is.New(t)
+	ustore, pool, ctx := recreateDB()
+
+	cesar, err := ustore.Get(ctx, "cesar")
+	is.NoErr(err)
+	mina, err := ustore.Get(ctx, "mina")
+	is.NoErr(err)
+
+	variantKey := entity.VariantKey("NWL18.classic.rapid")
+	before := *entity.NewDefaultRating(true)
+	cesarAfter := entity.SingleRating{Rating: 1550, RatingDeviation: 300, Volatility: 0.06,
+		LastGameTimestamp: before.LastGameTimestamp}
+	minaAfter := entity.SingleRating{Rating: 1450, RatingDeviation: 300, Volatility: 0.06,
+		LastGameTimestamp: before.LastGameTimestamp}
+	err = ustore.SetRatingsWithHistory(ctx, variantKey, []*entity.RatingChange{
+		{UserUUID: cesar.UUID, OpponentUUID: mina.UUID, GameID: "game1", Before: before,
+			After: cesarAfter, Opponent: before, Spread: 100,
+			Breakdown: glicko.Breakdown{ExpectedResult: 0.5, ActualResult: 0.9, Multiplier: 125}},
+		{UserUUID: mina.UUID, OpponentUUID: cesar.UUID, GameID: "game1", Before: before,
+			After: minaAfter, Opponent: before, Spread: -100,
+			Breakdown: glicko.Breakdown{ExpectedResult: 0.5, ActualResult: 0.1, Multiplier: 125}},
+	})
+	is.NoErr(err)
+
+	cesarDBID, err := common.GetUserDBIDByUUID(ctx, pool, cesar.UUID)
+	is.NoErr(err)
+	actualCesarRating, err := common.GetUserRatingWithPool(ctx, pool, cesarDBID, variantKey)
+	is.NoErr(err)
+	is.True(commontest.WithinEpsilon(actualCesarRating.Rating, 1550))
+
+	history, err := models.New(pool).GetRatingHistory(ctx, models.GetRatingHistoryParams{
+		UserID:  int32(cesarDBID),
+		Variant: string(variantKey),
+		Since:   pgtype.Timestamptz{Time: time.Unix(0, 0), Valid: true},
+		Lim:     10,
+	})
+	is.NoErr(err)
+	is.Equal(len(history), 1)
+	is.Equal(history[0].GameUuid, "game1")
+	is.Equal(history[0].OpponentUsername.String, "mina")
+	is.Equal(history[0].Spread, int32(100))
+	is.True(commontest.WithinEpsilon(history[0].RatingAfter-history[0].RatingBefore,
+		history[0].Multiplier*(history[0].ActualResult-history[0].ExpectedResult)))
+	ustore.Disconnect()
+}
+
 func TestMisc(t *testing.T) {
 	is := is.New(t)
 	ustore, _, ctx := recreateDB()
@@ -25,6 +25,7 @@ import (
 	"github.com/woogles-io/liwords/pkg/auth/rbac"
 	"github.com/woogles-io/liwords/pkg/config"
 	"github.com/woogles-io/liwords/pkg/entity"
+	"github.com/woogles-io/liwords/pkg/lexica"
 	"github.com/woogles-io/liwords/pkg/mod"
 	"github.com/woogles-io/liwords/pkg/notify"
 	"github.com/woogles-io/liwords/pkg/stores/models"
@@ -998,6 +999,62 @@ func (ts *TournamentService) ExportTournament(ctx context.Context, req *connect.
 	return connect.NewResponse(&pb.ExportTournamentResponse{Exported: ret}), nil
 }
 
+func (ts *TournamentService) UploadPrivateLexicon(ctx context.Context, req *connect.Request[pb.UploadPrivateLexiconRequest],
+) (*connect.Response[pb.PrivateLexicon], error) {
+	// The word list is left out of the request that gets logged.
+	err := authenticateDirector(ctx, ts, req.Msg.TournamentId, &pb.UploadPrivateLexiconRequest{
+		TournamentId:       req.Msg.TournamentId,
+		Name:               req.Msg.Name,
+		LetterDistribution: req.Msg.LetterDistribution,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	user, err := apiserver.AuthUser(ctx, ts.userStore)
+	if err != nil {
+		return nil, err
+	}
+	t, err := ts.tournamentStore.Get(ctx, req.Msg.TournamentId)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+
+	lex, wordCount, err := lexica.UploadPrivateLexicon(ctx, ts.queries, ts.cfg.WGLConfig(), t.UUID, user.ID,
+		req.Msg.Name, req.Msg.LetterDistribution, req.Msg.WordList)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return connect.NewResponse(&pb.PrivateLexicon{
+		Name:               lex.Name,
+		LetterDistribution: lex.LetterDistribution,
+		WordCount:          int32(wordCount),
+		CreatedAt:          timestamppb.Now(),
+	}), nil
+}
+
+func (ts *TournamentService) GetPrivateLexica(ctx context.Context, req *connect.Request[pb.GetPrivateLexicaRequest],
+) (*connect.Response[pb.GetPrivateLexicaResponse], error) {
+	t, err := ts.tournamentStore.Get(ctx, req.Msg.TournamentId)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	// A club session may also use the lexica of its club.
+	rows, err := ts.queries.GetTournamentPrivateLexica(ctx, []string{t.UUID, t.ParentID})
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	resp := &pb.GetPrivateLexicaResponse{}
+	for _, row := range rows {
+		resp.Lexica = append(resp.Lexica, &pb.PrivateLexicon{
+			Name:               row.Name,
+			LetterDistribution: row.LetterDistribution,
+			WordCount:          row.WordCount,
+			CreatedAt:          timestamppb.New(row.CreatedAt.Time),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (ts *TournamentService) GetTournamentScorecards(ctx context.Context, req *connect.Request[pb.TournamentScorecardRequest],
 ) (*connect.Response[pb.TournamentScorecardResponse], error) {
 
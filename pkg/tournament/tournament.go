@@ -400,6 +400,11 @@ func SetDivisionControls(ctx context.Context, ts TournamentStore, id string, div
 			return err
 		}
 	}
+	if controls.GameRequest != nil {
+		if err := entity.ValidateLexiconScope(controls.GameRequest.Lexicon, t.UUID, t.ParentID); err != nil {
+			return err
+		}
+	}
 
 	newDivisionControls, standings, err := divisionObject.DivisionManager.SetDivisionControls(controls)
 	if err != nil {
@@ -300,12 +300,19 @@ func (ss *SocializeService) GetChatsForChannel(ctx context.Context, req *connect
 		if sess == nil {
 			return nil, err
 		}
-		_, err := ChatChannelReceiver(sess.UserUUID, req.Msg.Channel)
+		receiver, err := ChatChannelReceiver(sess.UserUUID, req.Msg.Channel)
 		if err != nil {
 			return nil, err
 		}
+		blocked, err := ss.blocksEither(ctx, sess.UserUUID, receiver)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return connect.NewResponse(&ipc.ChatMessages{}), nil
+		}
 	}
-	chats, err := ss.chatStore.OldChats(ctx, req.Msg.Channel, 100)
+	chats, err := ss.chatStore.OldChats(ctx, req.Msg.Channel, req.Msg.BeforeId, 100)
 	if err != nil {
 		return nil, err
 	}
@@ -336,3 +343,95 @@ func (ss *SocializeService) GetChatsForChannel(ctx context.Context, req *connect
 	}
 	return connect.NewResponse(&ipc.ChatMessages{Messages: chats}), nil
 }
+
+// blocksEither returns whether either user blocks the other.
+func (ss *SocializeService) blocksEither(ctx context.Context, uid, otherUID string) (bool, error) {
+	u, err := ss.userStore.GetByUUID(ctx, uid)
+	if err != nil {
+		return false, err
+	}
+	blocks, err := ss.userStore.GetFullBlocks(ctx, u.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, b := range blocks {
+		if b.UUID == otherUID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (ss *SocializeService) MarkChatChannelRead(ctx context.Context, req *connect.Request[pb.MarkChatChannelReadRequest],
+) (*connect.Response[pb.OKResponse], error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Only private message channels keep track of what has been read.
+	if !strings.HasPrefix(req.Msg.Channel, "chat.pm.") {
+		return nil, apiserver.InvalidArg("only private message channels can be marked as read")
+	}
+	_, err = ChatChannelReceiver(sess.UserUUID, req.Msg.Channel)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	_, err = ss.queries.MarkPMConversationRead(ctx, models.MarkPMConversationReadParams{
+		Channel:  req.Msg.Channel,
+		UserUuid: sess.UserUUID,
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	return connect.NewResponse(&pb.OKResponse{}), nil
+}
+
+func (ss *SocializeService) GetUnreadMessageCount(ctx context.Context, req *connect.Request[pb.GetUnreadMessageCountRequest],
+) (*connect.Response[pb.UnreadMessageCount], error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count, err := ss.queries.GetPMUnreadCount(ctx, sess.UserUUID)
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	return connect.NewResponse(&pb.UnreadMessageCount{Count: int32(count)}), nil
+}
+
+func (ss *SocializeService) SearchPrivateMessages(ctx context.Context, req *connect.Request[pb.SearchPrivateMessagesRequest],
+) (*connect.Response[ipc.ChatMessages], error) {
+	sess, err := apiserver.GetSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := strings.TrimSpace(req.Msg.Query)
+	if query == "" {
+		return nil, apiserver.InvalidArg("search query must not be empty")
+	}
+	limit := req.Msg.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	rows, err := ss.queries.SearchPMMessages(ctx, models.SearchPMMessagesParams{
+		UserUuid: sess.UserUUID,
+		Query:    query,
+		Lim:      limit,
+		Offs:     max(req.Msg.Offset, 0),
+	})
+	if err != nil {
+		return nil, apiserver.InternalErr(err)
+	}
+	messages := make([]*ipc.ChatMessage, len(rows))
+	for i, row := range rows {
+		messages[i] = &ipc.ChatMessage{
+			Username:  row.SenderUsername,
+			UserId:    row.SenderUuid,
+			Channel:   row.Channel,
+			Message:   row.Message,
+			Timestamp: row.CreatedAt.Time.UnixMilli(),
+			Id:        row.ChatID,
+		}
+	}
+	return connect.NewResponse(&ipc.ChatMessages{Messages: messages}), nil
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/woogles-io/liwords/pkg/entity"
@@ -30,6 +31,10 @@ type Store interface {
 	SetPersonalInfo(ctx context.Context, uuid string, email string, firstName string, lastName string, birthDate string, countryCode string, about string) error
 	SetRatings(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
 		p1Rating *entity.SingleRating, p2Rating *entity.SingleRating) error
+	// SetRatingsWithHistory sets each player's rating to their rating after
+	// the change, like SetRatings, and adds the changes to their rating
+	// history.
+	SetRatingsWithHistory(ctx context.Context, variant entity.VariantKey, changes []*entity.RatingChange) error
 	SetStats(ctx context.Context, p0uuid string, p1uuid string, variant entity.VariantKey,
 		p0stats *entity.Stats, p1stats *entity.Stats) error
 	SetNotoriety(ctx context.Context, uuid string, notoriety int) error
@@ -99,10 +104,28 @@ type PresenceStore interface {
 	UpdateActiveGame(ctx context.Context, activeGameEntry *pb.ActiveGameEntry) ([][][]string, error)
 }
 
+// ErrUserNotFound is returned by the Store getters when there is no such user.
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrChatFlood is returned by AddChat when a user sends more messages than
+// their ChatLimits allow.
+var ErrChatFlood = errors.New("you are sending too many messages")
+
+// ChatLimits regulate how often a user can chat. The zero value doesn't
+// regulate chat at all.
+type ChatLimits struct {
+	// SlowModeSeconds is the minimum time between messages. Slow mode also
+	// refuses repeating the previous message.
+	SlowModeSeconds int
+	// Sending more than FloodMaxMessages within FloodWindowSeconds is flooding.
+	FloodMaxMessages   int
+	FloodWindowSeconds int
+}
+
 // ChatStore stores user and channel chats and messages
 type ChatStore interface {
-	AddChat(ctx context.Context, senderUsername, senderUID, msg, channel, channelFriendly string, regulateChat bool) (*pb.ChatMessage, error)
-	OldChats(ctx context.Context, channel string, n int) ([]*pb.ChatMessage, error)
+	AddChat(ctx context.Context, senderUsername, senderUID, msg, channel, channelFriendly string, limits ChatLimits) (*pb.ChatMessage, error)
+	OldChats(ctx context.Context, channel, beforeID string, n int) ([]*pb.ChatMessage, error)
 	LatestChannels(ctx context.Context, count, offset int, uid, tid, lid string) (*upb.ActiveChatChannels, error)
 
 	GetChat(ctx context.Context, channel string, msgID string) (*pb.ChatMessage, error)
@@ -1,18 +1,172 @@
 package words
 
 import (
+	"errors"
 	"io"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 	"sync"
+
+	"github.com/rs/zerolog/log"
 )
 
+// definition is one sense of a word.
+type definition struct {
+	text string
+	// source names the dictionary that the definition is from.
+	source string
+	// lemma is set if the word has no definitions of its own, and this is a
+	// definition of the word that it is an inflection of.
+	lemma string
+}
+
+// A definitionSource looks up the definitions of the words of one lexicon.
+// Words are spelled as word-golib shows them, in upper case.
+type definitionSource interface {
+	// bulkDefine looks up sortedWords, which are sorted and unique. Words
+	// without any definitions are left out of the result.
+	bulkDefine(sortedWords []string) (map[string][]definition, error)
+}
+
+// definitionLoaders open the definition files of a lexicon, which are named
+// after the lexicon, by extension. A lexicon may have several, and their
+// definitions are listed in this order.
+var definitionLoaders = []struct {
+	ext  string
+	load func(filename, lexicon string) (definitionSource, error)
+}{
+	{".txt", loadDefinitionSource},
+	{".tsv", loadTSVDefinitionSource},
+	{".sqlite", loadSQLiteDefinitionSource},
+}
+
+// loadDefinitionSources opens every definition file of the lexicon in dir. It
+// returns nil if there are none.
+func loadDefinitionSources(dir, lexicon string) (definitionSource, error) {
+	var sources multiSource
+	for _, loader := range definitionLoaders {
+		filename := filepath.Join(dir, lexicon+loader.ext)
+		if _, err := os.Stat(filename); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		source, err := loader.load(filename, lexicon)
+		if err != nil {
+			return nil, err
+		}
+		log.Info().Str("lexicon", lexicon).Str("filename", filename).Msg("found-definition-source")
+		sources = append(sources, source)
+	}
+	switch len(sources) {
+	case 0:
+		return nil, nil
+	case 1:
+		return sources[0], nil
+	}
+	return sources, nil
+}
+
+// multiSource lists the definitions of all of its sources.
+type multiSource []definitionSource
+
+func (ms multiSource) bulkDefine(sortedWords []string) (map[string][]definition, error) {
+	ret := make(map[string][]definition)
+	var errs []error
+	for _, source := range ms {
+		defs, err := source.bulkDefine(sortedWords)
+		if err != nil {
+			// The other sources may still have something.
+			errs = append(errs, err)
+			continue
+		}
+		for word, d := range defs {
+			ret[word] = append(ret[word], d...)
+		}
+	}
+	if len(errs) == len(ms) {
+		return nil, errors.Join(errs...)
+	}
+	return ret, nil
+}
+
+// lemmaSource is a source that knows which words are inflections of others.
+type lemmaSource interface {
+	// ownDefinitions is bulkDefine without lemma lookup.
+	ownDefinitions(sortedWords []string) (map[string][]definition, error)
+	// lemmas returns the lemmas of those sortedWords that are inflections.
+	lemmas(sortedWords []string) (map[string][]string, error)
+}
+
+// defineWithLemmas looks up the definitions of words, and gives those
+// without any of their own the definitions of their lemmas.
+func defineWithLemmas(ls lemmaSource, sortedWords []string) (map[string][]definition, error) {
+	ret, err := ls.ownDefinitions(sortedWords)
+	if err != nil {
+		return nil, err
+	}
+	var undefined []string
+	for _, word := range sortedWords {
+		if len(ret[word]) == 0 {
+			undefined = append(undefined, word)
+		}
+	}
+	if len(undefined) == 0 {
+		return ret, nil
+	}
+	lemmasOf, err := ls.lemmas(undefined)
+	if err != nil {
+		return nil, err
+	}
+	var lemmaWords []string
+	for _, lemmas := range lemmasOf {
+		lemmaWords = append(lemmaWords, lemmas...)
+	}
+	if len(lemmaWords) == 0 {
+		return ret, nil
+	}
+	slices.Sort(lemmaWords)
+	lemmaDefs, err := ls.ownDefinitions(slices.Compact(lemmaWords))
+	if err != nil {
+		return nil, err
+	}
+	for _, word := range undefined {
+		for _, lemma := range lemmasOf[word] {
+			for _, d := range lemmaDefs[lemma] {
+				d.lemma = lemma
+				ret[word] = append(ret[word], d)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// joinDefinitions puts definitions into the single string that older clients
+// show.
+func joinDefinitions(defs []definition) string {
+	var texts []string
+	for _, d := range defs {
+		text := d.text
+		if d.lemma != "" {
+			text = d.lemma + ": " + text
+		}
+		if !slices.Contains(texts, text) {
+			texts = append(texts, text)
+		}
+	}
+	return strings.Join(texts, "; ")
+}
+
+// defSource is a text file of WORD\tdefinition\n lines, sorted with
+// LC_ALL=C, which is searched without being read into memory.
 type defSource struct {
 	file     *os.File // opened at startup and never closed
 	fileSize int64
 	blkSize  int64
+	source   string
 }
 
-func loadDefinitionSource(filename string) (*defSource, error) {
+func loadDefinitionSource(filename, lexicon string) (definitionSource, error) {
 	// text file of WORD\tdefinition\n with LC_ALL=C sort
 	f, err := os.Open(filename)
 	if err != nil {
@@ -33,7 +187,7 @@ func loadDefinitionSource(filename string) (*defSource, error) {
 	blkSize := getBlkSize(&fileInfo, 4096)
 
 	fileOk = true
-	return &defSource{file: f, fileSize: fileSize, blkSize: blkSize}, err
+	return &defSource{file: f, fileSize: fileSize, blkSize: blkSize, source: lexicon}, err
 }
 
 var bufPool = sync.Pool{
@@ -43,7 +197,21 @@ var bufPool = sync.Pool{
 	},
 }
 
-func (ds *defSource) bulkDefine(sortedWords []string) (map[string]string, error) {
+func (ds *defSource) bulkDefine(sortedWords []string) (map[string][]definition, error) {
+	defs, err := ds.lookup(sortedWords)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string][]definition, len(defs))
+	for word, text := range defs {
+		if text != "" {
+			ret[word] = []definition{{text: text, source: ds.source}}
+		}
+	}
+	return ret, nil
+}
+
+func (ds *defSource) lookup(sortedWords []string) (map[string]string, error) {
 	f := ds.file
 	fileSize := ds.fileSize
 	blkSize := ds.blkSize
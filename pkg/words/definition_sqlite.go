@@ -0,0 +1,148 @@
+package words
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// sqliteSource is a dictionary in an SQLite database, for dictionaries that
+// are too big to keep in memory. The database has these tables:
+//
+//	CREATE TABLE definitions (word TEXT NOT NULL, definition TEXT NOT NULL, source TEXT);
+//	CREATE TABLE inflections (word TEXT NOT NULL, lemma TEXT NOT NULL);
+//	CREATE TABLE meta (key TEXT PRIMARY KEY, value TEXT NOT NULL);
+//
+// Only definitions is required, and both word columns should be indexed.
+// Words are in upper case. A word may have several definitions, which are
+// listed in rowid order. A definition without a source is from the source
+// named by the "source" key in meta, if any.
+type sqliteSource struct {
+	db             *sql.DB // opened at startup and never closed
+	source         string
+	hasInflections bool
+}
+
+// sqliteMaxParams stays well under SQLite's limit on the number of
+// parameters of a statement.
+const sqliteMaxParams = 500
+
+func loadSQLiteDefinitionSource(filename, lexicon string) (definitionSource, error) {
+	// The dictionary is never written to, so nothing needs to be locked.
+	db, err := sql.Open("sqlite3", "file:"+filename+"?mode=ro&immutable=1")
+	if err != nil {
+		return nil, err
+	}
+	dbOk := false
+	defer func() {
+		if !dbOk {
+			db.Close()
+		}
+	}()
+
+	ss := &sqliteSource{db: db, source: lexicon}
+	tables := make(map[string]bool)
+	rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'table'`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if !tables["definitions"] {
+		return nil, fmt.Errorf("%s has no definitions table", filename)
+	}
+	ss.hasInflections = tables["inflections"]
+	if tables["meta"] {
+		var source string
+		err := db.QueryRow(`SELECT value FROM meta WHERE key = 'source'`).Scan(&source)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		if source != "" {
+			ss.source = source
+		}
+	}
+
+	dbOk = true
+	return ss, nil
+}
+
+func (ss *sqliteSource) bulkDefine(sortedWords []string) (map[string][]definition, error) {
+	return defineWithLemmas(ss, sortedWords)
+}
+
+// queryWords runs query, which has one %s for a list of parameters, for
+// every chunk of words, and calls scan on each row.
+func (ss *sqliteSource) queryWords(query string, words []string, scan func(*sql.Rows) error) error {
+	for len(words) > 0 {
+		chunk := words[:min(len(words), sqliteMaxParams)]
+		words = words[len(chunk):]
+		args := make([]any, len(chunk))
+		for i, word := range chunk {
+			args[i] = word
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(chunk)), ",")
+		rows, err := ss.db.Query(fmt.Sprintf(query, placeholders), args...)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			if err := scan(rows); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ss *sqliteSource) ownDefinitions(sortedWords []string) (map[string][]definition, error) {
+	ret := make(map[string][]definition)
+	err := ss.queryWords(`SELECT word, definition, COALESCE(source, '') FROM definitions
+		WHERE word IN (%s) ORDER BY rowid`, sortedWords, func(rows *sql.Rows) error {
+		var word string
+		d := definition{}
+		if err := rows.Scan(&word, &d.text, &d.source); err != nil {
+			return err
+		}
+		if d.source == "" {
+			d.source = ss.source
+		}
+		ret[word] = append(ret[word], d)
+		return nil
+	})
+	return ret, err
+}
+
+func (ss *sqliteSource) lemmas(sortedWords []string) (map[string][]string, error) {
+	ret := make(map[string][]string)
+	if !ss.hasInflections {
+		return ret, nil
+	}
+	err := ss.queryWords(`SELECT word, lemma FROM inflections
+		WHERE word IN (%s) AND lemma != word ORDER BY rowid`, sortedWords, func(rows *sql.Rows) error {
+		var word, lemma string
+		if err := rows.Scan(&word, &lemma); err != nil {
+			return err
+		}
+		ret[word] = append(ret[word], lemma)
+		return nil
+	})
+	return ret, err
+}
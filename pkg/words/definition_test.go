@@ -0,0 +1,115 @@
+package words
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func writeFile(is *is.I, dir, name, contents string) {
+	is.NoErr(os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644))
+}
+
+func TestTSVDefinitionSource(t *testing.T) {
+	is := is.New(t)
+	dir := t.TempDir()
+	writeFile(is, dir, "FRA24.tsv", `# source: Wiktionnaire
+# words with several senses have several lines
+CHAT	mammifère carnivore
+CHAT	discussion en ligne
+chats		CHAT
+ÉTÉ	saison chaude
+ÉTÉS		ÉTÉ
+
+`)
+	ds, err := loadDefinitionSources(dir, "FRA24")
+	is.NoErr(err)
+
+	defs, err := ds.bulkDefine([]string{"CHAT", "CHATS", "CHIEN", "ÉTÉS"})
+	is.NoErr(err)
+	is.Equal(len(defs), 3)
+	is.Equal(defs["CHAT"], []definition{
+		{text: "mammifère carnivore", source: "Wiktionnaire"},
+		{text: "discussion en ligne", source: "Wiktionnaire"},
+	})
+	is.Equal(defs["CHATS"], []definition{
+		{text: "mammifère carnivore", source: "Wiktionnaire", lemma: "CHAT"},
+		{text: "discussion en ligne", source: "Wiktionnaire", lemma: "CHAT"},
+	})
+	is.Equal(joinDefinitions(defs["ÉTÉS"]), "ÉTÉ: saison chaude")
+
+	writeFile(is, dir, "BAD.tsv", "CHAT\n")
+	_, err = loadDefinitionSources(dir, "BAD")
+	is.True(err != nil)
+}
+
+func TestSQLiteDefinitionSource(t *testing.T) {
+	is := is.New(t)
+	dir := t.TempDir()
+	db, err := sql.Open("sqlite3", filepath.Join(dir, "RD29.sqlite"))
+	is.NoErr(err)
+	for _, stmt := range []string{
+		`CREATE TABLE definitions (word TEXT NOT NULL, definition TEXT NOT NULL, source TEXT)`,
+		`CREATE TABLE inflections (word TEXT NOT NULL, lemma TEXT NOT NULL)`,
+		`CREATE TABLE meta (key TEXT PRIMARY KEY, value TEXT NOT NULL)`,
+		`INSERT INTO meta VALUES ('source', 'Duden')`,
+		`INSERT INTO definitions VALUES ('HAUS', 'Gebäude', NULL), ('HAUS', 'Familie', 'Wiktionary'), ('BAUM', 'Pflanze', NULL)`,
+		`INSERT INTO inflections VALUES ('HÄUSER', 'HAUS'), ('HAUSES', 'HAUS'), ('BAUM', 'BAUM')`,
+	} {
+		_, err := db.Exec(stmt)
+		is.NoErr(err)
+	}
+	is.NoErr(db.Close())
+
+	ds, err := loadDefinitionSources(dir, "RD29")
+	is.NoErr(err)
+	defs, err := ds.bulkDefine([]string{"BAUM", "HAUS", "HÄUSER", "ZZZ"})
+	is.NoErr(err)
+	is.Equal(len(defs), 3)
+	is.Equal(defs["BAUM"], []definition{{text: "Pflanze", source: "Duden"}})
+	is.Equal(defs["HAUS"], []definition{
+		{text: "Gebäude", source: "Duden"},
+		{text: "Familie", source: "Wiktionary"},
+	})
+	is.Equal(defs["HÄUSER"], []definition{
+		{text: "Gebäude", source: "Duden", lemma: "HAUS"},
+		{text: "Familie", source: "Wiktionary", lemma: "HAUS"},
+	})
+
+	// More words than fit in one statement.
+	var many []string
+	for i := 0; i < 2*sqliteMaxParams; i++ {
+		many = append(many, "A"+string(rune('A'+i%26))+string(rune('A'+i/26)))
+	}
+	many = append(many, "BAUM")
+	defs, err = ds.bulkDefine(many)
+	is.NoErr(err)
+	is.Equal(len(defs), 1)
+}
+
+func TestCombinedDefinitionSources(t *testing.T) {
+	is := is.New(t)
+	dir := t.TempDir()
+	// The original format, sorted with LC_ALL=C.
+	writeFile(is, dir, "CSW24.txt", "CAT\ta feline [n CATS]\nDOG\ta canine [n DOGS]\n")
+	writeFile(is, dir, "CSW24.tsv", "# source: Example Dictionary\nCAT\ta jazz musician\nCATS\t\tCAT\n")
+
+	ds, err := loadDefinitionSources(dir, "CSW24")
+	is.NoErr(err)
+	defs, err := ds.bulkDefine([]string{"CAT", "CATS", "DOG"})
+	is.NoErr(err)
+	is.Equal(defs["CAT"], []definition{
+		{text: "a feline [n CATS]", source: "CSW24"},
+		{text: "a jazz musician", source: "Example Dictionary"},
+	})
+	is.Equal(joinDefinitions(defs["CAT"]), "a feline [n CATS]; a jazz musician")
+	is.Equal(defs["CATS"], []definition{{text: "a jazz musician", source: "Example Dictionary", lemma: "CAT"}})
+	is.Equal(defs["DOG"], []definition{{text: "a canine [n DOGS]", source: "CSW24"}})
+
+	none, err := loadDefinitionSources(dir, "NWL23")
+	is.NoErr(err)
+	is.True(none == nil)
+}
@@ -0,0 +1,96 @@
+package words
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// tsvSource is a dictionary that is read into memory. Each line of the file
+// is one of
+//
+//	WORD<TAB>definition
+//	WORD<TAB><TAB>LEMMA
+//
+// A word may have any number of definition lines, and the second form says
+// that WORD is an inflection of LEMMA, whose definitions are used for it if
+// it has none of its own. The lines may be in any order. Empty lines and
+// lines starting with # are skipped, except for a "# source: ..." line,
+// which names the dictionary.
+type tsvSource struct {
+	source      string
+	definitions map[string][]string
+	lemmasOf    map[string][]string
+}
+
+const tsvSourceDirective = "# source:"
+
+func loadTSVDefinitionSource(filename, lexicon string) (definitionSource, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ts := &tsvSource{
+		source:      lexicon,
+		definitions: make(map[string][]string),
+		lemmasOf:    make(map[string][]string),
+	}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if source, ok := strings.CutPrefix(line, tsvSourceDirective); ok {
+			ts.source = strings.TrimSpace(source)
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		word := strings.ToUpper(strings.TrimSpace(fields[0]))
+		if word == "" || len(fields) < 2 || len(fields) > 3 {
+			return nil, fmt.Errorf("%s:%d: expected WORD<TAB>definition or WORD<TAB><TAB>LEMMA", filename, lineNo)
+		}
+		if text := strings.TrimSpace(fields[1]); text != "" {
+			ts.definitions[word] = append(ts.definitions[word], text)
+		}
+		if len(fields) == 3 {
+			if lemma := strings.ToUpper(strings.TrimSpace(fields[2])); lemma != "" && lemma != word {
+				ts.lemmasOf[word] = append(ts.lemmasOf[word], lemma)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+func (ts *tsvSource) bulkDefine(sortedWords []string) (map[string][]definition, error) {
+	return defineWithLemmas(ts, sortedWords)
+}
+
+func (ts *tsvSource) ownDefinitions(sortedWords []string) (map[string][]definition, error) {
+	ret := make(map[string][]definition)
+	for _, word := range sortedWords {
+		for _, text := range ts.definitions[word] {
+			ret[word] = append(ret[word], definition{text: text, source: ts.source})
+		}
+	}
+	return ret, nil
+}
+
+func (ts *tsvSource) lemmas(sortedWords []string) (map[string][]string, error) {
+	ret := make(map[string][]string)
+	for _, word := range sortedWords {
+		if lemmas, ok := ts.lemmasOf[word]; ok {
+			ret[word] = lemmas
+		}
+	}
+	return ret, nil
+}
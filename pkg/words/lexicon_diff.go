@@ -0,0 +1,301 @@
+package words
+
+import (
+	"context"
+	"math"
+
+	"connectrpc.com/connect"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/rs/zerolog/log"
+
+	"github.com/woogles-io/liwords/pkg/apiserver"
+	"github.com/woogles-io/liwords/pkg/stats"
+	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/word_service"
+)
+
+const (
+	DefaultValidityChangesGames = 20
+	MaxValidityChangesGames     = 100
+
+	DefaultLexiconDiffLimit = 1000
+	MaxLexiconDiffLimit     = 10000
+
+	// A diff between two big lexica can be a hundred thousand words, so
+	// only the most recently used ones are kept.
+	lexiconDiffCacheSize = 8
+)
+
+// GameHistoryStore is the part of the game store that is needed to check
+// the words played in past games.
+type GameHistoryStore interface {
+	GetHistory(ctx context.Context, id string) (*macondopb.GameHistory, error)
+	GetRecentGames(ctx context.Context, username string, numGames int, offset int) (*ipc.GameInfoResponses, error)
+}
+
+type lexiconDiff struct {
+	added   []tilemapping.MachineWord
+	removed []tilemapping.MachineWord
+}
+
+func newLexiconDiffCache() *lru.Cache[string, *lexiconDiff] {
+	c, _ := lru.New[string, *lexiconDiff](lexiconDiffCacheSize)
+	return c
+}
+
+// allWords returns every word in the lexicon in machine letter order.
+func allWords(gd *kwg.KWG) ([]tilemapping.MachineWord, error) {
+	var words []tilemapping.MachineWord
+	s := &wordSearch{
+		gd:      gd,
+		pattern: anyWordPattern,
+		maxLen:  math.MaxInt,
+		found: func(w tilemapping.MachineWord) error {
+			words = append(words, append(tilemapping.MachineWord(nil), w...))
+			return nil
+		},
+	}
+	if err := s.run(); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+func compareMachineWords(a, b tilemapping.MachineWord) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return int(a[i]) - int(b[i])
+		}
+	}
+	return len(a) - len(b)
+}
+
+// diffLexica merges the sorted word lists of both lexica.
+func diffLexica(from, to *kwg.KWG) (*lexiconDiff, error) {
+	fromWords, err := allWords(from)
+	if err != nil {
+		return nil, err
+	}
+	toWords, err := allWords(to)
+	if err != nil {
+		return nil, err
+	}
+	diff := &lexiconDiff{}
+	i, j := 0, 0
+	for i < len(fromWords) || j < len(toWords) {
+		switch {
+		case j == len(toWords):
+			diff.removed = append(diff.removed, fromWords[i])
+			i++
+		case i == len(fromWords):
+			diff.added = append(diff.added, toWords[j])
+			j++
+		default:
+			c := compareMachineWords(fromWords[i], toWords[j])
+			if c < 0 {
+				diff.removed = append(diff.removed, fromWords[i])
+				i++
+			} else if c > 0 {
+				diff.added = append(diff.added, toWords[j])
+				j++
+			} else {
+				i++
+				j++
+			}
+		}
+	}
+	return diff, nil
+}
+
+// sameAlphabet is true if the lexica use the same letter distribution, so
+// that their words can be compared.
+func sameAlphabet(lexicon, otherLexicon string) bool {
+	dist, err := tilemapping.ProbableLetterDistributionName(lexicon)
+	if err != nil {
+		return false
+	}
+	otherDist, err := tilemapping.ProbableLetterDistributionName(otherLexicon)
+	return err == nil && dist == otherDist
+}
+
+func (ws *WordService) getKWG(lexicon string) (*kwg.KWG, error) {
+	if _, hasLexicon := ws.definitionSources[lexicon]; !hasLexicon {
+		return nil, apiserver.InvalidArg("no such lexicon: " + lexicon)
+	}
+	gd, err := kwg.GetKWG(ws.cfg.WGLConfig(), lexicon)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	return gd, nil
+}
+
+// lexiconDiff returns the cached diff between the lexica, computing it the
+// first time it is asked for.
+func (ws *WordService) lexiconDiff(fromLexicon, toLexicon string) (*lexiconDiff, error) {
+	key := fromLexicon + ">" + toLexicon
+	if diff, ok := ws.diffs.Get(key); ok {
+		return diff, nil
+	}
+
+	if !sameAlphabet(fromLexicon, toLexicon) {
+		return nil, apiserver.InvalidArg(fromLexicon + " and " + toLexicon + " have different alphabets")
+	}
+	from, err := ws.getKWG(fromLexicon)
+	if err != nil {
+		return nil, err
+	}
+	to, err := ws.getKWG(toLexicon)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := diffLexica(from, to)
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Str("from", fromLexicon).Str("to", toLexicon).Int("added", len(diff.added)).
+		Int("removed", len(diff.removed)).Msg("computed-lexicon-diff")
+
+	ws.diffs.Add(key, diff)
+	return diff, nil
+}
+
+func (ws *WordService) GetLexiconDiff(ctx context.Context, req *connect.Request[pb.LexiconDiffRequest],
+) (*connect.Response[pb.LexiconDiffResponse], error) {
+	diff, err := ws.lexiconDiff(req.Msg.FromLexicon, req.Msg.ToLexicon)
+	if err != nil {
+		return nil, err
+	}
+	gd, err := ws.getKWG(req.Msg.ToLexicon)
+	if err != nil {
+		return nil, err
+	}
+	alph := gd.GetAlphabet()
+	minLen, maxLen := int(req.Msg.MinLength), int(req.Msg.MaxLength)
+	limit, offset := int(req.Msg.Limit), max(int(req.Msg.Offset), 0)
+	if limit <= 0 {
+		limit = DefaultLexiconDiffLimit
+	} else if limit > MaxLexiconDiffLimit {
+		limit = MaxLexiconDiffLimit
+	}
+	// page returns the requested page of the words of the right lengths,
+	// and how many such words there are.
+	page := func(words []tilemapping.MachineWord) ([]string, int32) {
+		paged := []string{}
+		total := 0
+		for _, w := range words {
+			if len(w) < minLen || (maxLen > 0 && len(w) > maxLen) {
+				continue
+			}
+			if total >= offset && total < offset+limit {
+				paged = append(paged, w.UserVisible(alph))
+			}
+			total++
+		}
+		return paged, int32(total)
+	}
+	resp := &pb.LexiconDiffResponse{}
+	resp.Added, resp.TotalAdded = page(diff.added)
+	resp.Removed, resp.TotalRemoved = page(diff.removed)
+	return connect.NewResponse(resp), nil
+}
+
+// validityChanges returns the words formed in the game that are valid in
+// only one of the game's lexicon and gd.
+func validityChanges(hist *macondopb.GameHistory, gameKWG *kwg.KWG, gd *kwg.KWG) ([]*pb.WordValidityChange, error) {
+	changes := []*pb.WordValidityChange{}
+	for idx, evt := range hist.Events {
+		if evt.Type != macondopb.GameEvent_TILE_PLACEMENT_MOVE {
+			continue
+		}
+		for _, word := range evt.WordsFormed {
+			wasPhony, err := stats.IsPhony(gameKWG, word, hist.Variant)
+			if err != nil {
+				return nil, err
+			}
+			isPhony, err := stats.IsPhony(gd, word, hist.Variant)
+			if err != nil {
+				return nil, err
+			}
+			if wasPhony == isPhony {
+				continue
+			}
+			nickname := ""
+			if int(evt.PlayerIndex) < len(hist.Players) {
+				nickname = hist.Players[evt.PlayerIndex].Nickname
+			}
+			changes = append(changes, &pb.WordValidityChange{
+				Word:           word,
+				EventIndex:     int32(idx),
+				PlayerNickname: nickname,
+				WasValid:       !wasPhony,
+				IsValid:        !isPhony,
+			})
+		}
+	}
+	return changes, nil
+}
+
+func (ws *WordService) GetValidityChanges(ctx context.Context, req *connect.Request[pb.ValidityChangesRequest],
+) (*connect.Response[pb.ValidityChangesResponse], error) {
+	gd, err := ws.getKWG(req.Msg.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+
+	var gameIds []string
+	if req.Msg.GameId != "" {
+		gameIds = []string{req.Msg.GameId}
+	} else if req.Msg.Username != "" {
+		numGames := int(req.Msg.NumGames)
+		if numGames <= 0 {
+			numGames = DefaultValidityChangesGames
+		} else if numGames > MaxValidityChangesGames {
+			numGames = MaxValidityChangesGames
+		}
+		games, err := ws.gameStore.GetRecentGames(ctx, req.Msg.Username, numGames, int(req.Msg.Offset))
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range games.GameInfo {
+			gameIds = append(gameIds, g.GameId)
+		}
+	} else {
+		return nil, apiserver.InvalidArg("need a game or a username")
+	}
+
+	resp := &pb.ValidityChangesResponse{Games: []*pb.GameValidityChanges{}}
+	for _, gameId := range gameIds {
+		hist, err := ws.gameStore.GetHistory(ctx, gameId)
+		if err != nil {
+			if req.Msg.GameId != "" {
+				return nil, err
+			}
+			log.Warn().Err(err).Str("gameId", gameId).Msg("validity-changes-no-history")
+			continue
+		}
+		if hist.Lexicon == req.Msg.Lexicon || !sameAlphabet(hist.Lexicon, req.Msg.Lexicon) {
+			continue
+		}
+		// Games in lexica that are no longer available can't be checked.
+		gameKWG, err := kwg.GetKWG(ws.cfg.WGLConfig(), hist.Lexicon)
+		if err != nil {
+			log.Warn().Err(err).Str("gameId", gameId).Str("lexicon", hist.Lexicon).Msg("validity-changes-no-lexicon")
+			continue
+		}
+		changes, err := validityChanges(hist, gameKWG, gd)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) > 0 {
+			resp.Games = append(resp.Games, &pb.GameValidityChanges{
+				GameId:  gameId,
+				Lexicon: hist.Lexicon,
+				Changes: changes,
+			})
+		}
+	}
+	return connect.NewResponse(resp), nil
+}
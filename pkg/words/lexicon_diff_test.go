@@ -0,0 +1,212 @@
+package words
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	macondopb "github.com/domino14/macondo/gen/api/proto/macondo"
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/rpc/api/proto/ipc"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/word_service"
+)
+
+var (
+	diffTestOldLexicon = []string{"AA", "AB", "BOTH", "GONE", "OLD", "QI", "ZA"}
+	diffTestNewLexicon = []string{"AA", "AB", "BOTH", "NEW", "NEWER", "QI", "QIS", "ZA", "ZAS"}
+)
+
+type fakeHistoryStore struct {
+	histories map[string]*macondopb.GameHistory
+	recent    []string
+}
+
+func (s *fakeHistoryStore) GetHistory(ctx context.Context, id string) (*macondopb.GameHistory, error) {
+	hist, ok := s.histories[id]
+	if !ok {
+		return nil, errors.New("game not found")
+	}
+	return hist, nil
+}
+
+func (s *fakeHistoryStore) GetRecentGames(ctx context.Context, username string, numGames int, offset int) (*ipc.GameInfoResponses, error) {
+	resp := &ipc.GameInfoResponses{}
+	for _, id := range s.recent[min(offset, len(s.recent)):min(offset+numGames, len(s.recent))] {
+		resp.GameInfo = append(resp.GameInfo, &ipc.GameInfoResponse{GameId: id})
+	}
+	return resp, nil
+}
+
+func TestDiffLexica(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{
+		"NWLDIFFOLD": diffTestOldLexicon,
+		"NWLDIFFNEW": diffTestNewLexicon,
+	})
+	from, err := ws.getKWG("NWLDIFFOLD")
+	is.NoErr(err)
+	to, err := ws.getKWG("NWLDIFFNEW")
+	is.NoErr(err)
+	alph := to.GetAlphabet()
+
+	diff, err := diffLexica(from, to)
+	is.NoErr(err)
+	var added, removed []string
+	for _, w := range diff.added {
+		added = append(added, w.UserVisible(alph))
+	}
+	for _, w := range diff.removed {
+		removed = append(removed, w.UserVisible(alph))
+	}
+	is.Equal(added, []string{"NEW", "NEWER", "QIS", "ZAS"})
+	is.Equal(removed, []string{"GONE", "OLD"})
+
+	diff, err = diffLexica(to, to)
+	is.NoErr(err)
+	is.Equal(len(diff.added), 0)
+	is.Equal(len(diff.removed), 0)
+}
+
+func TestGetLexiconDiff(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{
+		"NWLPAGEOLD": diffTestOldLexicon,
+		"NWLPAGENEW": diffTestNewLexicon,
+	})
+	getDiff := func(req *pb.LexiconDiffRequest) (*pb.LexiconDiffResponse, error) {
+		req.FromLexicon, req.ToLexicon = "NWLPAGEOLD", "NWLPAGENEW"
+		resp, err := ws.GetLexiconDiff(context.Background(), connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	resp, err := getDiff(&pb.LexiconDiffRequest{})
+	is.NoErr(err)
+	is.Equal(resp.Added, []string{"NEW", "NEWER", "QIS", "ZAS"})
+	is.Equal(resp.Removed, []string{"GONE", "OLD"})
+	is.Equal(resp.TotalAdded, int32(4))
+	is.Equal(resp.TotalRemoved, int32(2))
+	is.True(ws.diffs.Contains("NWLPAGEOLD>NWLPAGENEW"))
+
+	// Lengths are filtered before paging.
+	resp, err = getDiff(&pb.LexiconDiffRequest{MaxLength: 3, Limit: 2, Offset: 1})
+	is.NoErr(err)
+	is.Equal(resp.Added, []string{"QIS", "ZAS"})
+	is.Equal(resp.Removed, []string{})
+	is.Equal(resp.TotalAdded, int32(3))
+	is.Equal(resp.TotalRemoved, int32(1))
+
+	resp, err = getDiff(&pb.LexiconDiffRequest{MinLength: 4})
+	is.NoErr(err)
+	is.Equal(resp.Added, []string{"NEWER"})
+	is.Equal(resp.Removed, []string{"GONE"})
+
+	resp, err = getDiff(&pb.LexiconDiffRequest{Limit: 1, Offset: 10})
+	is.NoErr(err)
+	is.Equal(resp.Added, []string{})
+	is.Equal(resp.TotalAdded, int32(4))
+
+	_, err = ws.GetLexiconDiff(context.Background(), connect.NewRequest(&pb.LexiconDiffRequest{
+		FromLexicon: "NWLPAGEOLD", ToLexicon: "NWL404"}))
+	is.True(err != nil)
+	_, err = ws.GetLexiconDiff(context.Background(), connect.NewRequest(&pb.LexiconDiffRequest{
+		FromLexicon: "NWLPAGEOLD", ToLexicon: "FRA20"}))
+	is.True(err != nil)
+}
+
+func TestLexiconDiffCacheIsBounded(t *testing.T) {
+	is := is.New(t)
+	c := newLexiconDiffCache()
+	for i := 0; i < 2*lexiconDiffCacheSize; i++ {
+		c.Add(string(rune('A'+i)), &lexiconDiff{})
+	}
+	is.Equal(c.Len(), lexiconDiffCacheSize)
+	is.True(!c.Contains("A"))
+}
+
+func TestGetValidityChanges(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{
+		"NWLVALIDOLD": diffTestOldLexicon,
+		"NWLVALIDNEW": diffTestNewLexicon,
+	})
+	players := []*macondopb.PlayerInfo{{Nickname: "alice"}, {Nickname: "bob"}}
+	store := &fakeHistoryStore{
+		histories: map[string]*macondopb.GameHistory{
+			"old": {
+				Lexicon: "NWLVALIDOLD",
+				Players: players,
+				Events: []*macondopb.GameEvent{
+					{Type: macondopb.GameEvent_TILE_PLACEMENT_MOVE, PlayerIndex: 0, WordsFormed: []string{"OLD", "BOTH"}},
+					{Type: macondopb.GameEvent_PASS, PlayerIndex: 1},
+					{Type: macondopb.GameEvent_TILE_PLACEMENT_MOVE, PlayerIndex: 1, WordsFormed: []string{"NEW"}},
+					{Type: macondopb.GameEvent_PHONY_TILES_RETURNED, PlayerIndex: 1},
+					{Type: macondopb.GameEvent_TILE_PLACEMENT_MOVE, PlayerIndex: 0, WordsFormed: []string{"QI", "ZA"}},
+				},
+			},
+			"new": {
+				Lexicon: "NWLVALIDNEW",
+				Players: players,
+				Events: []*macondopb.GameEvent{
+					{Type: macondopb.GameEvent_TILE_PLACEMENT_MOVE, PlayerIndex: 0, WordsFormed: []string{"OLD"}},
+				},
+			},
+			"unchanged": {
+				Lexicon: "NWLVALIDOLD",
+				Players: players,
+				Events: []*macondopb.GameEvent{
+					{Type: macondopb.GameEvent_TILE_PLACEMENT_MOVE, PlayerIndex: 0, WordsFormed: []string{"QI"}},
+				},
+			},
+		},
+		recent: []string{"new", "deleted", "unchanged", "old"},
+	}
+	ws.gameStore = store
+
+	expected := []*pb.WordValidityChange{
+		{Word: "OLD", EventIndex: 0, PlayerNickname: "alice", WasValid: true, IsValid: false},
+		{Word: "NEW", EventIndex: 2, PlayerNickname: "bob", WasValid: false, IsValid: true},
+	}
+	getChanges := func(req *pb.ValidityChangesRequest) (*pb.ValidityChangesResponse, error) {
+		req.Lexicon = "NWLVALIDNEW"
+		resp, err := ws.GetValidityChanges(context.Background(), connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	resp, err := getChanges(&pb.ValidityChangesRequest{GameId: "old"})
+	is.NoErr(err)
+	is.Equal(len(resp.Games), 1)
+	is.Equal(resp.Games[0].GameId, "old")
+	is.Equal(resp.Games[0].Lexicon, "NWLVALIDOLD")
+	is.Equal(len(resp.Games[0].Changes), len(expected))
+	for i, c := range resp.Games[0].Changes {
+		is.Equal(c.Word, expected[i].Word)
+		is.Equal(c.EventIndex, expected[i].EventIndex)
+		is.Equal(c.PlayerNickname, expected[i].PlayerNickname)
+		is.Equal(c.WasValid, expected[i].WasValid)
+		is.Equal(c.IsValid, expected[i].IsValid)
+	}
+
+	// Games in the requested lexicon, games with no changes and games
+	// that can't be loaded are left out.
+	resp, err = getChanges(&pb.ValidityChangesRequest{Username: "alice"})
+	is.NoErr(err)
+	is.Equal(len(resp.Games), 1)
+	is.Equal(resp.Games[0].GameId, "old")
+
+	resp, err = getChanges(&pb.ValidityChangesRequest{Username: "alice", NumGames: 2})
+	is.NoErr(err)
+	is.Equal(len(resp.Games), 0)
+
+	_, err = getChanges(&pb.ValidityChangesRequest{GameId: "deleted"})
+	is.True(err != nil)
+	_, err = getChanges(&pb.ValidityChangesRequest{})
+	is.True(err != nil)
+}
@@ -0,0 +1,599 @@
+package words
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"lukechampine.com/frand"
+
+	"github.com/woogles-io/liwords/pkg/apiserver"
+	"github.com/woogles-io/liwords/pkg/stores/common"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/word_service"
+)
+
+const (
+	DefaultQuizQuestions = 50
+	MaxQuizQuestions     = 200
+	DefaultQuizMinutes   = 5
+	MaxQuizMinutes       = 60
+	DefaultQuizLength    = 7
+
+	// Guesses sent just before the time runs out may arrive a little late.
+	quizGracePeriod = 3 * time.Second
+
+	maxCardbox = 10
+)
+
+// cardboxIntervals is the number of days until an alphagram in each
+// cardbox is due again.
+var cardboxIntervals = [maxCardbox + 1]int{1, 3, 7, 12, 20, 30, 60, 90, 150, 240, 365}
+
+// alphagram returns the word's letters in machine letter order.
+func alphagram(word tilemapping.MachineWord) tilemapping.MachineWord {
+	a := append(tilemapping.MachineWord(nil), word...)
+	slices.Sort(a)
+	return a
+}
+
+// nextCardbox returns the alphagram's cardbox after it was quizzed.
+// Solved alphagrams move up one cardbox and missed ones go back to 0.
+func nextCardbox(cardbox int, solved bool) int {
+	if !solved {
+		return 0
+	}
+	return min(cardbox+1, maxCardbox)
+}
+
+// anagrams returns every word in the lexicon that uses all of the
+// alphagram's letters.
+func anagrams(gd *kwg.KWG, alph string) ([]string, error) {
+	da := daPool.Get().(*kwg.KWGAnagrammer)
+	defer daPool.Put(da)
+	if err := da.InitForString(gd, alph); err != nil {
+		return nil, err
+	}
+	var words []string
+	err := da.Anagram(gd, func(word tilemapping.MachineWord) error {
+		words = append(words, word.UserVisible(gd.GetAlphabet()))
+		return nil
+	})
+	return words, err
+}
+
+// quizAlphagrams returns the alphagrams of the words with the lengths and
+// probability ranks, in random order.
+func quizAlphagrams(gd *kwg.KWG, ranker *probabilityRanker, minLen, maxLen, minRank, maxRank int) ([]string, error) {
+	alph := gd.GetAlphabet()
+	seen := map[string]bool{}
+	var alphagrams []string
+	search := &wordSearch{
+		gd:      gd,
+		pattern: anyWordPattern,
+		minLen:  minLen,
+		maxLen:  maxLen,
+		found: func(w tilemapping.MachineWord) error {
+			rank, err := ranker.rank(w)
+			if err != nil {
+				return err
+			}
+			if (minRank > 0 && rank < minRank) || (maxRank > 0 && rank > maxRank) {
+				return nil
+			}
+			a := alphagram(w).UserVisible(alph)
+			if !seen[a] {
+				seen[a] = true
+				alphagrams = append(alphagrams, a)
+			}
+			return nil
+		},
+	}
+	if err := search.run(); err != nil {
+		return nil, err
+	}
+	frand.Shuffle(len(alphagrams), func(i, j int) {
+		alphagrams[i], alphagrams[j] = alphagrams[j], alphagrams[i]
+	})
+	return alphagrams, nil
+}
+
+type quizOptions struct {
+	numQuestions     int
+	minutes          int
+	minLen, maxLen   int
+	minRank, maxRank int
+}
+
+// newQuizOptions fills in the defaults and limits of the request.
+func newQuizOptions(req *pb.StartWordQuizRequest) (quizOptions, error) {
+	opts := quizOptions{
+		numQuestions: int(req.NumQuestions),
+		minutes:      int(req.Minutes),
+		minLen:       int(req.MinLength),
+		maxLen:       int(req.MaxLength),
+		minRank:      int(req.MinProbabilityRank),
+		maxRank:      int(req.MaxProbabilityRank),
+	}
+	if opts.numQuestions <= 0 {
+		opts.numQuestions = DefaultQuizQuestions
+	} else if opts.numQuestions > MaxQuizQuestions {
+		opts.numQuestions = MaxQuizQuestions
+	}
+	if opts.minutes <= 0 {
+		opts.minutes = DefaultQuizMinutes
+	} else if opts.minutes > MaxQuizMinutes {
+		opts.minutes = MaxQuizMinutes
+	}
+	if opts.minLen <= 0 && opts.maxLen <= 0 {
+		opts.minLen, opts.maxLen = DefaultQuizLength, DefaultQuizLength
+	} else if opts.maxLen <= 0 {
+		opts.maxLen = opts.minLen
+	} else if opts.minLen <= 0 {
+		opts.minLen = opts.maxLen
+	}
+	if opts.minLen > opts.maxLen {
+		return opts, errors.New("min length is more than max length")
+	}
+	if opts.maxRank > 0 && opts.minRank > opts.maxRank {
+		return opts, errors.New("min probability rank is more than max probability rank")
+	}
+	return opts, nil
+}
+
+// newQuizQuestions makes the questions for the alphagrams, leaving out
+// the ones with no answers.
+func newQuizQuestions(gd *kwg.KWG, alphagrams []string) ([]models.GetWordQuizQuestionsRow, error) {
+	questions := make([]models.GetWordQuizQuestionsRow, 0, len(alphagrams))
+	for _, a := range alphagrams {
+		answers, err := anagrams(gd, a)
+		if err != nil {
+			return nil, err
+		}
+		// Due alphagrams may have no answers left after a lexicon update.
+		if len(answers) == 0 {
+			continue
+		}
+		questions = append(questions, models.GetWordQuizQuestionsRow{
+			Position:   int32(len(questions)),
+			Alphagram:  a,
+			NumAnswers: int32(len(answers)),
+			Found:      []string{},
+		})
+	}
+	return questions, nil
+}
+
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
+
+// quizUser returns the logged-in user's database ID.
+func (ws *WordService) quizUser(ctx context.Context) (int32, error) {
+	user, err := apiserver.AuthUser(ctx, ws.userStore)
+	if err != nil {
+		return 0, err
+	}
+	return int32(user.ID), nil
+}
+
+// quizSession returns the user's quiz session, finishing it if the time
+// has run out.
+func (ws *WordService) quizSession(ctx context.Context, userID int32, sessionID string) (*models.GetWordQuizSessionRow, []models.GetWordQuizQuestionsRow, error) {
+	session, err := ws.queries.GetWordQuizSession(ctx, sessionID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && session.UserID != userID) {
+		return nil, nil, apiserver.NotFound("quiz not found: " + sessionID)
+	} else if err != nil {
+		return nil, nil, err
+	}
+	questions, err := ws.queries.GetWordQuizQuestions(ctx, session.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	if !session.FinishedAt.Valid && now.After(session.EndsAt.Time.Add(quizGracePeriod)) {
+		if err := ws.finishQuiz(ctx, &session, questions, now); err != nil {
+			return nil, nil, err
+		}
+	}
+	return &session, questions, nil
+}
+
+// finishQuiz finishes the session and moves its alphagrams between
+// cardboxes. Both happen in one transaction, so that a session is never
+// finished without its cardboxes being updated.
+func (ws *WordService) finishQuiz(ctx context.Context, session *models.GetWordQuizSessionRow,
+	questions []models.GetWordQuizQuestionsRow, now time.Time) error {
+
+	tx, err := ws.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := ws.queries.WithTx(tx)
+
+	rows, err := qtx.FinishWordQuizSession(ctx, models.FinishWordQuizSessionParams{
+		FinishedAt: timestamptz(now),
+		ID:         session.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		// Someone else finished it first.
+		session.FinishedAt = timestamptz(now)
+		return nil
+	}
+
+	cardboxes, err := cardboxLevels(ctx, qtx, session.UserID, session.Lexicon, questions)
+	if err != nil {
+		return err
+	}
+	for _, q := range questions {
+		solved := len(q.Found) == int(q.NumAnswers)
+		cardbox := nextCardbox(int(cardboxes[q.Alphagram]), solved)
+		var correct, incorrect int32
+		if solved {
+			correct = 1
+		} else {
+			incorrect = 1
+		}
+		err := qtx.UpsertCardbox(ctx, models.UpsertCardboxParams{
+			UserID:        session.UserID,
+			Lexicon:       session.Lexicon,
+			Alphagram:     q.Alphagram,
+			Cardbox:       int32(cardbox),
+			Correct:       correct,
+			Incorrect:     incorrect,
+			LastQuizzedAt: timestamptz(now),
+			NextScheduled: timestamptz(now.AddDate(0, 0, cardboxIntervals[cardbox])),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	session.FinishedAt = timestamptz(now)
+	log.Info().Str("sessionId", session.Uuid).Int32("userId", session.UserID).
+		Int("questions", len(questions)).Msg("finished-word-quiz")
+	return nil
+}
+
+// cardboxLevels returns the cardboxes of the questions' alphagrams, or -1
+// for alphagrams that were never quizzed.
+func cardboxLevels(ctx context.Context, queries *models.Queries, userID int32, lexicon string,
+	questions []models.GetWordQuizQuestionsRow) (map[string]int32, error) {
+
+	alphagrams := make([]string, len(questions))
+	cardboxes := make(map[string]int32, len(questions))
+	for i, q := range questions {
+		alphagrams[i] = q.Alphagram
+		cardboxes[q.Alphagram] = -1
+	}
+	levels, err := queries.GetCardboxLevels(ctx, models.GetCardboxLevelsParams{
+		UserID:     userID,
+		Lexicon:    lexicon,
+		Alphagrams: alphagrams,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range levels {
+		cardboxes[l.Alphagram] = l.Cardbox
+	}
+	return cardboxes, nil
+}
+
+func (ws *WordService) quizState(ctx context.Context, session *models.GetWordQuizSessionRow,
+	questions []models.GetWordQuizQuestionsRow) (*pb.WordQuizState, error) {
+
+	finished := session.FinishedAt.Valid
+	var gd *kwg.KWG
+	if finished {
+		var err error
+		gd, err = ws.getKWG(session.Lexicon)
+		if err != nil {
+			return nil, err
+		}
+	}
+	cardboxes, err := cardboxLevels(ctx, ws.queries, session.UserID, session.Lexicon, questions)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &pb.WordQuizState{
+		SessionId: session.Uuid,
+		Lexicon:   session.Lexicon,
+		StartedAt: timestamppb.New(session.StartedAt.Time),
+		EndsAt:    timestamppb.New(session.EndsAt.Time),
+		Finished:  finished,
+		Questions: make([]*pb.WordQuizQuestion, len(questions)),
+	}
+	for i, q := range questions {
+		question := &pb.WordQuizQuestion{
+			Position:   q.Position,
+			Alphagram:  q.Alphagram,
+			NumAnswers: q.NumAnswers,
+			Found:      q.Found,
+			Cardbox:    cardboxes[q.Alphagram],
+		}
+		if finished {
+			question.Answers, err = anagrams(gd, q.Alphagram)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(q.Found) == int(q.NumAnswers) {
+			state.Solved++
+		}
+		state.Questions[i] = question
+	}
+	return state, nil
+}
+
+func (ws *WordService) StartWordQuiz(ctx context.Context, req *connect.Request[pb.StartWordQuizRequest],
+) (*connect.Response[pb.WordQuizResponse], error) {
+	userID, err := ws.quizUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gd, err := ws.getKWG(req.Msg.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := newQuizOptions(req.Msg)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	numQuestions := opts.numQuestions
+
+	now := time.Now()
+	var alphagrams []string
+	if req.Msg.IncludeDue {
+		alphagrams, err = ws.queries.GetDueCardboxAlphagrams(ctx, models.GetDueCardboxAlphagramsParams{
+			UserID:  userID,
+			Lexicon: req.Msg.Lexicon,
+			Now:     timestamptz(now),
+			Lim:     int32(numQuestions),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(alphagrams) < numQuestions {
+		ld, distName, err := ws.letterDistribution(req.Msg.Lexicon, "", gd.GetAlphabet())
+		if err != nil {
+			return nil, err
+		}
+		ranker := ws.probabilityRanker(gd, req.Msg.Lexicon, ld, distName)
+		candidates, err := quizAlphagrams(gd, ranker, opts.minLen, opts.maxLen, opts.minRank, opts.maxRank)
+		if err != nil {
+			return nil, apiserver.InvalidArg(err.Error())
+		}
+		// Alphagrams already in the cardbox are only quizzed when due.
+		inCardbox, err := ws.queries.GetCardboxAlphagrams(ctx, models.GetCardboxAlphagramsParams{
+			UserID:  userID,
+			Lexicon: req.Msg.Lexicon,
+		})
+		if err != nil {
+			return nil, err
+		}
+		known := make(map[string]bool, len(inCardbox))
+		for _, a := range inCardbox {
+			known[a] = true
+		}
+		for _, a := range candidates {
+			if len(alphagrams) == numQuestions {
+				break
+			}
+			if !known[a] {
+				alphagrams = append(alphagrams, a)
+			}
+		}
+	}
+	questions, err := newQuizQuestions(gd, alphagrams)
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) == 0 {
+		return nil, apiserver.InvalidArg("no alphagrams to quiz")
+	}
+
+	session := models.GetWordQuizSessionRow{
+		Uuid:            shortuuid.New(),
+		UserID:          userID,
+		Lexicon:         req.Msg.Lexicon,
+		DurationSeconds: int32(opts.minutes * 60),
+		StartedAt:       timestamptz(now),
+		EndsAt:          timestamptz(now.Add(time.Duration(opts.minutes) * time.Minute)),
+	}
+	// A session without its questions can't be played or finished.
+	tx, err := ws.dbPool.BeginTx(ctx, common.DefaultTxOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := ws.queries.WithTx(tx)
+	session.ID, err = qtx.CreateWordQuizSession(ctx, models.CreateWordQuizSessionParams{
+		Uuid:            session.Uuid,
+		UserID:          session.UserID,
+		Lexicon:         session.Lexicon,
+		DurationSeconds: session.DurationSeconds,
+		StartedAt:       session.StartedAt,
+		EndsAt:          session.EndsAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, q := range questions {
+		err = qtx.AddWordQuizQuestion(ctx, models.AddWordQuizQuestionParams{
+			SessionID:  session.ID,
+			Position:   q.Position,
+			Alphagram:  q.Alphagram,
+			NumAnswers: q.NumAnswers,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	state, err := ws.quizState(ctx, &session, questions)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.WordQuizResponse{State: state}), nil
+}
+
+func (ws *WordService) GetWordQuiz(ctx context.Context, req *connect.Request[pb.WordQuizRequest],
+) (*connect.Response[pb.WordQuizResponse], error) {
+	userID, err := ws.quizUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, questions, err := ws.quizSession(ctx, userID, req.Msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ws.quizState(ctx, session, questions)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.WordQuizResponse{State: state}), nil
+}
+
+func (ws *WordService) SubmitWordQuizGuess(ctx context.Context, req *connect.Request[pb.WordQuizGuessRequest],
+) (*connect.Response[pb.WordQuizGuessResponse], error) {
+	userID, err := ws.quizUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, questions, err := ws.quizSession(ctx, userID, req.Msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if session.FinishedAt.Valid {
+		return connect.NewResponse(&pb.WordQuizGuessResponse{Finished: true}), nil
+	}
+
+	gd, err := ws.getKWG(session.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	guess := strings.ToUpper(strings.TrimSpace(req.Msg.Guess))
+	if guess == "" {
+		return nil, apiserver.InvalidArg("guess cannot be empty")
+	}
+	mw, err := tilemapping.ToMachineWord(guess, gd.GetAlphabet())
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	resp := &pb.WordQuizGuessResponse{Result: pb.WordQuizGuessResponse_WRONG}
+	a := alphagram(mw).UserVisible(gd.GetAlphabet())
+	idx := slices.IndexFunc(questions, func(q models.GetWordQuizQuestionsRow) bool { return q.Alphagram == a })
+	if idx < 0 || !kwg.FindMachineWord(gd, mw) {
+		return connect.NewResponse(resp), nil
+	}
+
+	q := &questions[idx]
+	resp.Position = q.Position
+	rows, err := ws.queries.AddWordQuizAnswer(ctx, models.AddWordQuizAnswerParams{
+		Word:      guess,
+		SessionID: session.ID,
+		Position:  q.Position,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		resp.Result = pb.WordQuizGuessResponse_ALREADY_FOUND
+		return connect.NewResponse(resp), nil
+	}
+	resp.Result = pb.WordQuizGuessResponse_CORRECT
+	// Other guesses may have been answered since the questions were
+	// loaded, so they are loaded again to score the quiz.
+	questions, err = ws.queries.GetWordQuizQuestions(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	idx = slices.IndexFunc(questions, func(q models.GetWordQuizQuestionsRow) bool { return q.Position == resp.Position })
+	if idx < 0 {
+		return nil, errors.New("quiz question went missing")
+	}
+	q = &questions[idx]
+	resp.QuestionSolved = len(q.Found) == int(q.NumAnswers)
+
+	// The quiz is over once every question is solved.
+	if !slices.ContainsFunc(questions, func(q models.GetWordQuizQuestionsRow) bool {
+		return len(q.Found) < int(q.NumAnswers)
+	}) {
+		if err := ws.finishQuiz(ctx, session, questions, time.Now()); err != nil {
+			return nil, err
+		}
+		resp.Finished = true
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (ws *WordService) EndWordQuiz(ctx context.Context, req *connect.Request[pb.WordQuizRequest],
+) (*connect.Response[pb.WordQuizResponse], error) {
+	userID, err := ws.quizUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	session, questions, err := ws.quizSession(ctx, userID, req.Msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if !session.FinishedAt.Valid {
+		if err := ws.finishQuiz(ctx, session, questions, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	state, err := ws.quizState(ctx, session, questions)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&pb.WordQuizResponse{State: state}), nil
+}
+
+func (ws *WordService) GetCardboxStats(ctx context.Context, req *connect.Request[pb.CardboxStatsRequest],
+) (*connect.Response[pb.CardboxStatsResponse], error) {
+	userID, err := ws.quizUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	levels, err := ws.queries.GetCardboxStats(ctx, models.GetCardboxStatsParams{
+		Now:     timestamptz(time.Now()),
+		UserID:  userID,
+		Lexicon: req.Msg.Lexicon,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.CardboxStatsResponse{Levels: []*pb.CardboxLevel{}}
+	for _, l := range levels {
+		resp.Levels = append(resp.Levels, &pb.CardboxLevel{
+			Cardbox:    l.Cardbox,
+			Alphagrams: int32(l.Alphagrams),
+			Due:        int32(l.Due),
+		})
+		resp.Due += int32(l.Due)
+	}
+	return connect.NewResponse(resp), nil
+}
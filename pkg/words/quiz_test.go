@@ -0,0 +1,120 @@
+package words
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/matryer/is"
+
+	pb "github.com/woogles-io/liwords/rpc/api/proto/word_service"
+)
+
+func TestNextCardbox(t *testing.T) {
+	is := is.New(t)
+	for _, tc := range []struct {
+		cardbox  int
+		solved   bool
+		expected int
+	}{
+		{-1, true, 0},
+		{0, true, 1},
+		{4, true, 5},
+		{maxCardbox - 1, true, maxCardbox},
+		{maxCardbox, true, maxCardbox},
+		{-1, false, 0},
+		{0, false, 0},
+		{7, false, 0},
+		{maxCardbox, false, 0},
+	} {
+		is.Equal(nextCardbox(tc.cardbox, tc.solved), tc.expected)
+	}
+}
+
+func TestNewQuizOptions(t *testing.T) {
+	is := is.New(t)
+	for _, tc := range []struct {
+		req      *pb.StartWordQuizRequest
+		expected quizOptions
+	}{
+		{&pb.StartWordQuizRequest{},
+			quizOptions{DefaultQuizQuestions, DefaultQuizMinutes, DefaultQuizLength, DefaultQuizLength, 0, 0}},
+		{&pb.StartWordQuizRequest{NumQuestions: MaxQuizQuestions + 1, Minutes: MaxQuizMinutes + 1},
+			quizOptions{MaxQuizQuestions, MaxQuizMinutes, DefaultQuizLength, DefaultQuizLength, 0, 0}},
+		{&pb.StartWordQuizRequest{NumQuestions: 10, Minutes: 2, MinLength: 4},
+			quizOptions{10, 2, 4, 4, 0, 0}},
+		{&pb.StartWordQuizRequest{MaxLength: 8},
+			quizOptions{DefaultQuizQuestions, DefaultQuizMinutes, 8, 8, 0, 0}},
+		{&pb.StartWordQuizRequest{MinLength: 2, MaxLength: 15, MinProbabilityRank: 100, MaxProbabilityRank: 200},
+			quizOptions{DefaultQuizQuestions, DefaultQuizMinutes, 2, 15, 100, 200}},
+		{&pb.StartWordQuizRequest{MinProbabilityRank: 100},
+			quizOptions{DefaultQuizQuestions, DefaultQuizMinutes, DefaultQuizLength, DefaultQuizLength, 100, 0}},
+	} {
+		opts, err := newQuizOptions(tc.req)
+		is.NoErr(err)
+		is.Equal(opts, tc.expected)
+	}
+
+	_, err := newQuizOptions(&pb.StartWordQuizRequest{MinLength: 8, MaxLength: 7})
+	is.True(err != nil)
+	_, err = newQuizOptions(&pb.StartWordQuizRequest{MinProbabilityRank: 200, MaxProbabilityRank: 100})
+	is.True(err != nil)
+}
+
+func TestQuizAlphagrams(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{"NWLQUIZ": searchTestLexicon})
+	gd, err := ws.getKWG("NWLQUIZ")
+	is.NoErr(err)
+	ld, distName, err := ws.letterDistribution("NWLQUIZ", "", gd.GetAlphabet())
+	is.NoErr(err)
+	ranker := ws.probabilityRanker(gd, "NWLQUIZ", ld, distName)
+
+	for _, tc := range []struct {
+		minLen, maxLen   int
+		minRank, maxRank int
+		expected         []string
+	}{
+		// The five anagrams of AET are one alphagram.
+		{3, 3, 0, 0, []string{"AET", "AQT", "IQS", "ZZZ"}},
+		{3, 3, 2, 7, []string{"AQT", "IQS"}},
+		{3, 3, 0, 1, []string{"AET"}},
+		{2, 2, 0, 0, []string{"AA", "AB", "AE", "AN", "AT", "IQ"}},
+		{2, 3, 7, 0, []string{"IQ", "IQS", "ZZZ"}},
+		{9, 9, 0, 0, nil},
+	} {
+		alphagrams, err := quizAlphagrams(gd, ranker, tc.minLen, tc.maxLen, tc.minRank, tc.maxRank)
+		is.NoErr(err)
+		slices.Sort(alphagrams)
+		is.Equal(alphagrams, tc.expected)
+	}
+}
+
+func TestNewQuizQuestions(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{"NWLQUIZQUESTIONS": searchTestLexicon})
+	gd, err := ws.getKWG("NWLQUIZQUESTIONS")
+	is.NoErr(err)
+
+	answers, err := anagrams(gd, "AET")
+	is.NoErr(err)
+	slices.Sort(answers)
+	is.Equal(answers, []string{"ATE", "EAT", "ETA", "TAE", "TEA"})
+	answers, err = anagrams(gd, "EIGNB")
+	is.NoErr(err)
+	is.Equal(answers, []string{"BEING"})
+
+	// Alphagrams with no answers, such as due ones from an older
+	// lexicon, are left out.
+	questions, err := newQuizQuestions(gd, []string{"AET", "XYZ", "IQ", "AEST"})
+	is.NoErr(err)
+	is.Equal(len(questions), 3)
+	for i, expected := range []struct {
+		alphagram  string
+		numAnswers int32
+	}{{"AET", 5}, {"IQ", 1}, {"AEST", 3}} {
+		is.Equal(questions[i].Position, int32(i))
+		is.Equal(questions[i].Alphagram, expected.alphagram)
+		is.Equal(questions[i].NumAnswers, expected.numAnswers)
+		is.Equal(questions[i].Found, []string{})
+	}
+}
@@ -0,0 +1,493 @@
+package words
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/domino14/word-golib/kwg"
+	"github.com/domino14/word-golib/tilemapping"
+
+	"github.com/woogles-io/liwords/pkg/apiserver"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/word_service"
+)
+
+const (
+	DefaultSearchLimit = 100
+	MaxSearchLimit     = 1000
+	// Searches that match more words than this have to be narrowed down,
+	// since every match is ranked and sorted.
+	MaxSearchMatches = 50000
+
+	// The pattern's tokens are tracked in a bitmask.
+	maxPatternTokens = 63
+)
+
+var errTooManyMatches = fmt.Errorf("more than %d words match, please narrow down the search", MaxSearchMatches)
+
+type patternToken struct {
+	letters tilemapping.LetterSet
+	// star tokens match any number of their letters
+	star bool
+}
+
+// anyWordPattern matches every word.
+var anyWordPattern = wordPattern{{letters: ^tilemapping.LetterSet(0), star: true}}
+
+// wordPattern matches whole words against a pattern such as "?AT*" or
+// "[^AEIOU]*ING". It is a small NFA whose states are token positions, so
+// it can be stepped one letter at a time while walking the KWG.
+type wordPattern []patternToken
+
+func parseWordPattern(pattern string, alph *tilemapping.TileMapping) (wordPattern, error) {
+	var all tilemapping.LetterSet
+	for ml := 1; ml < int(alph.NumLetters()); ml++ {
+		all |= 1 << ml
+	}
+	toLetterSet := func(letters string) (tilemapping.LetterSet, error) {
+		mls, err := tilemapping.ToMachineLetters(letters, alph)
+		if err != nil {
+			return 0, err
+		}
+		var ls tilemapping.LetterSet
+		for _, ml := range mls {
+			if ml == 0 || ml.IsBlanked() {
+				return 0, fmt.Errorf("invalid letters: %s", letters)
+			}
+			ls |= 1 << ml
+		}
+		return ls, nil
+	}
+
+	pattern = strings.ToUpper(pattern)
+	if pattern == "" {
+		pattern = "*"
+	}
+	p := wordPattern{}
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		switch runes[i] {
+		case '?':
+			p = append(p, patternToken{letters: all})
+			i++
+		case '*':
+			// Consecutive stars are the same as one.
+			if len(p) == 0 || !p[len(p)-1].star || p[len(p)-1].letters != all {
+				p = append(p, patternToken{letters: all, star: true})
+			}
+			i++
+		case '[':
+			j := i + 1
+			for j < len(runes) && runes[j] != ']' {
+				j++
+			}
+			if j == len(runes) {
+				return nil, errors.New("unclosed [ in pattern")
+			}
+			set := string(runes[i+1 : j])
+			negate := strings.HasPrefix(set, "^")
+			ls, err := toLetterSet(strings.TrimPrefix(set, "^"))
+			if err != nil {
+				return nil, err
+			}
+			if negate {
+				ls = all &^ ls
+			}
+			p = append(p, patternToken{letters: ls})
+			i = j + 1
+		case ']':
+			return nil, errors.New("unopened ] in pattern")
+		default:
+			j := i
+			for j < len(runes) && !strings.ContainsRune("?*[]", runes[j]) {
+				j++
+			}
+			mls, err := tilemapping.ToMachineLetters(string(runes[i:j]), alph)
+			if err != nil {
+				return nil, err
+			}
+			for _, ml := range mls {
+				if ml == 0 || ml.IsBlanked() {
+					return nil, fmt.Errorf("invalid letters: %s", string(runes[i:j]))
+				}
+				p = append(p, patternToken{letters: 1 << ml})
+			}
+			i = j
+		}
+		if len(p) > maxPatternTokens {
+			return nil, errors.New("pattern is too long")
+		}
+	}
+	return p, nil
+}
+
+// closure adds the states reachable by skipping star tokens.
+func (p wordPattern) closure(states uint64) uint64 {
+	for i, t := range p {
+		if t.star && states&(1<<i) != 0 {
+			states |= 1 << (i + 1)
+		}
+	}
+	return states
+}
+
+func (p wordPattern) start() uint64 {
+	return p.closure(1)
+}
+
+// step returns the states after matching ml, or 0 if the pattern can't
+// match any word that starts with the letters so far.
+func (p wordPattern) step(states uint64, ml tilemapping.MachineLetter) uint64 {
+	next := uint64(0)
+	for i, t := range p {
+		if states&(1<<i) == 0 || t.letters&(1<<ml) == 0 {
+			continue
+		}
+		if t.star {
+			next |= 1 << i
+		} else {
+			next |= 1 << (i + 1)
+		}
+	}
+	return p.closure(next)
+}
+
+func (p wordPattern) accepts(states uint64) bool {
+	return states&(1<<len(p)) != 0
+}
+
+// wordSearch walks the KWG's DAWG, following only the arcs that can still
+// lead to a matching word.
+type wordSearch struct {
+	gd      *kwg.KWG
+	pattern wordPattern
+	minLen  int
+	maxLen  int
+
+	// For anagrams and subanagrams, the letters left to use; rack is nil
+	// otherwise.
+	rack   []int
+	blanks int
+	// For build-ups, the letters each word must contain.
+	required    []int
+	numRequired int
+
+	word  tilemapping.MachineWord
+	found func(tilemapping.MachineWord) error
+}
+
+func (s *wordSearch) run() error {
+	return s.walk(s.gd.ArcIndex(0), s.pattern.start())
+}
+
+func (s *wordSearch) walk(nodeIdx uint32, states uint64) error {
+	for i := nodeIdx; ; i++ {
+		ml := tilemapping.MachineLetter(s.gd.Tile(i))
+		if next := s.pattern.step(states, ml); next != 0 {
+			usedBlank, ok := s.use(ml)
+			if ok {
+				s.word = append(s.word, ml)
+				if s.gd.Accepts(i) && s.pattern.accepts(next) && len(s.word) >= s.minLen && s.hasRequired() {
+					if err := s.found(s.word); err != nil {
+						return err
+					}
+				}
+				if arc := s.gd.ArcIndex(i); arc != 0 && len(s.word) < s.maxLen {
+					if err := s.walk(arc, next); err != nil {
+						return err
+					}
+				}
+				s.word = s.word[:len(s.word)-1]
+				s.unuse(ml, usedBlank)
+			}
+		}
+		if s.gd.IsEnd(i) {
+			return nil
+		}
+	}
+}
+
+// use takes ml from the rack, or a blank if the rack doesn't have it. It
+// returns whether a blank was used and whether ml could be used at all.
+func (s *wordSearch) use(ml tilemapping.MachineLetter) (bool, bool) {
+	if s.rack == nil {
+		return false, true
+	}
+	if s.rack[ml] > 0 {
+		s.rack[ml]--
+		return false, true
+	}
+	if s.blanks > 0 {
+		s.blanks--
+		return true, true
+	}
+	return false, false
+}
+
+func (s *wordSearch) unuse(ml tilemapping.MachineLetter, usedBlank bool) {
+	if s.rack == nil {
+		return
+	} else if usedBlank {
+		s.blanks++
+	} else {
+		s.rack[ml]++
+	}
+}
+
+func (s *wordSearch) hasRequired() bool {
+	if s.numRequired == 0 {
+		return true
+	}
+	counts := make([]int, len(s.required))
+	found := 0
+	for _, ml := range s.word {
+		if counts[ml] < s.required[ml] {
+			counts[ml]++
+			found++
+		}
+	}
+	return found == s.numRequired
+}
+
+// setLetterQuery limits the search to anagrams, subanagrams or build-ups
+// of the letters.
+func (s *wordSearch) setLetterQuery(query pb.LetterQuery, letters string) error {
+	if query == pb.LetterQuery_NO_LETTER_QUERY {
+		return nil
+	}
+	alph := s.gd.GetAlphabet()
+	letters = strings.ToUpper(letters)
+	blanks := strings.Count(letters, string(tilemapping.BlankToken))
+	mls, err := tilemapping.ToMachineLetters(strings.ReplaceAll(letters, string(tilemapping.BlankToken), ""), alph)
+	if err != nil {
+		return err
+	}
+	counts := make([]int, alph.NumLetters())
+	for _, ml := range mls {
+		if ml == 0 || ml.IsBlanked() {
+			return fmt.Errorf("invalid letters: %s", letters)
+		}
+		counts[ml]++
+	}
+	numLetters := len(mls) + blanks
+	if numLetters == 0 {
+		return errors.New("no letters to search for")
+	}
+
+	switch query {
+	case pb.LetterQuery_ANAGRAM, pb.LetterQuery_SUBANAGRAM:
+		s.rack = counts
+		s.blanks = blanks
+		s.maxLen = min(s.maxLen, numLetters)
+		if query == pb.LetterQuery_ANAGRAM {
+			s.minLen = max(s.minLen, numLetters)
+		}
+	case pb.LetterQuery_BUILD_UP:
+		// Blanks stand for any extra letter, so they only make the words
+		// longer.
+		s.required = counts
+		s.numRequired = len(mls)
+		s.minLen = max(s.minLen, numLetters+1)
+	default:
+		return fmt.Errorf("unknown letter query: %v", query)
+	}
+	return nil
+}
+
+// drawCombinations is the number of ways to draw the word's letters from a
+// full bag.
+func drawCombinations(word tilemapping.MachineWord, dist []uint8) uint64 {
+	counts := map[tilemapping.MachineLetter]int{}
+	for _, ml := range word {
+		counts[ml]++
+	}
+	combinations := uint64(1)
+	for ml, n := range counts {
+		if int(ml) >= len(dist) {
+			return 0
+		}
+		combinations *= binomial(int(dist[ml]), n)
+	}
+	return combinations
+}
+
+func binomial(n, k int) uint64 {
+	if k > n {
+		return 0
+	}
+	c := uint64(1)
+	for i := 1; i <= k; i++ {
+		c = c * uint64(n-k+i) / uint64(i)
+	}
+	return c
+}
+
+// probabilityRanker ranks words by their draw combinations against all
+// words of the same length in the lexicon.
+type probabilityRanker struct {
+	sync.Mutex
+	gd   *kwg.KWG
+	dist []uint8
+	// the combinations of every word of each length, most likely first
+	byLength map[int][]uint64
+}
+
+func newProbabilityRanker(gd *kwg.KWG, ld *tilemapping.LetterDistribution) *probabilityRanker {
+	return &probabilityRanker{gd: gd, dist: ld.Distribution(), byLength: map[int][]uint64{}}
+}
+
+func (r *probabilityRanker) rank(word tilemapping.MachineWord) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+	combinations, ok := r.byLength[len(word)]
+	if !ok {
+		s := &wordSearch{
+			gd:      r.gd,
+			pattern: anyWordPattern,
+			minLen:  len(word),
+			maxLen:  len(word),
+			found: func(w tilemapping.MachineWord) error {
+				combinations = append(combinations, drawCombinations(w, r.dist))
+				return nil
+			},
+		}
+		if err := s.run(); err != nil {
+			return 0, err
+		}
+		sort.Slice(combinations, func(i, j int) bool { return combinations[i] > combinations[j] })
+		r.byLength[len(word)] = combinations
+	}
+	c := drawCombinations(word, r.dist)
+	return sort.Search(len(combinations), func(i int) bool { return combinations[i] <= c }) + 1, nil
+}
+
+// probabilityRanker returns the ranker for the lexicon and distribution.
+// Rankers are cached since ranking needs every word of a length.
+func (ws *WordService) probabilityRanker(gd *kwg.KWG, lexicon string, ld *tilemapping.LetterDistribution, distName string) *probabilityRanker {
+	key := lexicon + "/" + distName
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if r, ok := ws.rankers[key]; ok {
+		return r
+	}
+	r := newProbabilityRanker(gd, ld)
+	ws.rankers[key] = r
+	return r
+}
+
+// letterDistribution returns the distribution used for probability ranks,
+// which is the lexicon's usual distribution if distName is empty.
+func (ws *WordService) letterDistribution(lexicon string, distName string, alph *tilemapping.TileMapping) (*tilemapping.LetterDistribution, string, error) {
+	var err error
+	if distName == "" {
+		distName, err = tilemapping.ProbableLetterDistributionName(lexicon)
+		if err != nil {
+			return nil, "", apiserver.InvalidArg(err.Error())
+		}
+	}
+	ld, err := tilemapping.GetDistribution(ws.cfg.WGLConfig(), distName)
+	if err != nil {
+		return nil, "", apiserver.InvalidArg(err.Error())
+	}
+	if ld.TileMapping().NumLetters() != alph.NumLetters() {
+		return nil, "", apiserver.InvalidArg("letter distribution " + distName + " does not match lexicon " + lexicon)
+	}
+	return ld, distName, nil
+}
+
+func (ws *WordService) SearchWords(ctx context.Context, req *connect.Request[pb.SearchWordsRequest],
+) (*connect.Response[pb.SearchWordsResponse], error) {
+	gd, err := ws.getKWG(req.Msg.Lexicon)
+	if err != nil {
+		return nil, err
+	}
+	alph := gd.GetAlphabet()
+
+	ld, distName, err := ws.letterDistribution(req.Msg.Lexicon, req.Msg.LetterDistribution, alph)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, err := parseWordPattern(req.Msg.Pattern, alph)
+	if err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	minLen, maxLen := int(req.Msg.MinLength), int(req.Msg.MaxLength)
+	if maxLen <= 0 {
+		maxLen = math.MaxInt
+	}
+	type match struct {
+		word tilemapping.MachineWord
+		rank int
+	}
+	var matches []match
+	search := &wordSearch{
+		gd:      gd,
+		pattern: pattern,
+		minLen:  minLen,
+		maxLen:  maxLen,
+		found: func(w tilemapping.MachineWord) error {
+			if len(matches) == MaxSearchMatches {
+				return errTooManyMatches
+			}
+			matches = append(matches, match{word: append(tilemapping.MachineWord(nil), w...)})
+			return nil
+		},
+	}
+	if err := search.setLetterQuery(req.Msg.LetterQuery, req.Msg.Letters); err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+	if err := search.run(); err != nil {
+		return nil, apiserver.InvalidArg(err.Error())
+	}
+
+	// Matches are found in alphabetical order, which the stable sort keeps
+	// for words of the same length and rank.
+	ranker := ws.probabilityRanker(gd, req.Msg.Lexicon, ld, distName)
+	minRank, maxRank := int(req.Msg.MinProbabilityRank), int(req.Msg.MaxProbabilityRank)
+	ranked := matches[:0]
+	for _, m := range matches {
+		m.rank, err = ranker.rank(m.word)
+		if err != nil {
+			return nil, err
+		}
+		if (minRank > 0 && m.rank < minRank) || (maxRank > 0 && m.rank > maxRank) {
+			continue
+		}
+		ranked = append(ranked, m)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if len(ranked[i].word) != len(ranked[j].word) {
+			return len(ranked[i].word) < len(ranked[j].word)
+		}
+		return ranked[i].rank < ranked[j].rank
+	})
+
+	total := len(ranked)
+	limit, offset := int(req.Msg.Limit), int(req.Msg.Offset)
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	} else if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+	if offset < 0 || offset > total {
+		offset = total
+	}
+
+	results := []*pb.SearchWordsResult{}
+	for _, m := range ranked[offset:min(offset+limit, total)] {
+		results = append(results, &pb.SearchWordsResult{
+			Word:            m.word.UserVisible(alph),
+			ProbabilityRank: int32(m.rank),
+			FrontHooks:      tilemapping.MachineWord(kwg.FindHooks(gd, m.word, kwg.FrontHooks)).UserVisible(alph),
+			BackHooks:       tilemapping.MachineWord(kwg.FindHooks(gd, m.word, kwg.BackHooks)).UserVisible(alph),
+		})
+	}
+
+	return connect.NewResponse(&pb.SearchWordsResponse{Results: results, Total: int32(total)}), nil
+}
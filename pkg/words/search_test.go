@@ -0,0 +1,235 @@
+package words
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/domino14/word-golib/tilemapping"
+	"github.com/matryer/is"
+
+	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/lexica"
+	pb "github.com/woogles-io/liwords/rpc/api/proto/word_service"
+)
+
+const englishDistribution = `?,2,0,0
+A,9,1,1
+B,2,3,0
+C,2,3,0
+D,4,2,0
+E,12,1,1
+F,2,4,0
+G,3,2,0
+H,2,4,0
+I,9,1,1
+J,1,8,0
+K,1,5,0
+L,4,1,0
+M,2,3,0
+N,6,1,0
+O,8,1,1
+P,2,3,0
+Q,1,10,0
+R,6,1,0
+S,4,1,0
+T,6,1,0
+U,4,1,1
+V,2,4,0
+W,2,4,0
+X,1,8,0
+Y,2,4,0
+Z,1,10,0
+`
+
+// searchTestLexicon is a tiny English lexicon for the search tests.
+var searchTestLexicon = []string{
+	"AA", "AB", "AE", "AN", "AT", "BA",
+	"ATE", "EAT", "ETA", "TAE", "TEA", "QAT", "QIS", "ZZZ",
+	"AEON", "EATS", "SEAT", "TEAS", "QATS", "SING", "BUZZ", "FIZZ", "JAZZ", "RAZZ",
+	"BEING", "BRING", "DOING", "STING",
+	"QI",
+}
+
+// newTestWordService makes a word service whose data path has only the
+// English distribution and the given lexica, which have no definitions.
+// Lexica are cached by name for the whole test binary, so every test must
+// use its own names.
+func newTestWordService(t *testing.T, lexicaWords map[string][]string) *WordService {
+	is := is.New(t)
+	dir := t.TempDir()
+	is.NoErr(os.MkdirAll(filepath.Join(dir, "letterdistributions"), 0o755))
+	is.NoErr(os.MkdirAll(filepath.Join(dir, "lexica", "gaddag"), 0o755))
+	is.NoErr(os.WriteFile(filepath.Join(dir, "letterdistributions", "english"), []byte(englishDistribution), 0o644))
+
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(englishDistribution))
+	is.NoErr(err)
+	for lexicon, words := range lexicaWords {
+		mws, err := lexica.ParseWordList(strings.Join(words, "\n"), ld.TileMapping())
+		is.NoErr(err)
+		bts, err := lexica.BuildKWG(mws)
+		is.NoErr(err)
+		is.NoErr(os.WriteFile(filepath.Join(dir, "lexica", "gaddag", lexicon+".kwg"), bts, 0o644))
+	}
+
+	t.Setenv("MACONDO_DATA_PATH", dir)
+	cfg := &config.Config{}
+	is.NoErr(cfg.Load(nil))
+	return NewWordService(cfg, nil, nil, nil, nil)
+}
+
+func searchWords(ws *WordService, req *pb.SearchWordsRequest) ([]string, int32, error) {
+	resp, err := ws.SearchWords(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return nil, 0, err
+	}
+	var words []string
+	for _, r := range resp.Msg.Results {
+		words = append(words, r.Word)
+	}
+	return words, resp.Msg.Total, nil
+}
+
+func TestSearchWords(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{"NWLSEARCH": searchTestLexicon})
+
+	for _, tc := range []struct {
+		name     string
+		req      *pb.SearchWordsRequest
+		expected []string
+	}{
+		{"blank", &pb.SearchWordsRequest{Pattern: "Q?"}, []string{"QI"}},
+		{"blanks", &pb.SearchWordsRequest{Pattern: "q??"}, []string{"QAT", "QIS"}},
+		{"wildcard", &pb.SearchWordsRequest{Pattern: "*ZZ"}, []string{"BUZZ", "FIZZ", "JAZZ", "RAZZ", "ZZZ"}},
+		{"wildcards", &pb.SearchWordsRequest{Pattern: "*A**T*"}, []string{"AT", "ATE", "EAT", "EATS", "QAT", "QATS", "SEAT"}},
+		{"letter class", &pb.SearchWordsRequest{Pattern: "[QZ]*"}, []string{"QAT", "QATS", "QI", "QIS", "ZZZ"}},
+		{"negated class", &pb.SearchWordsRequest{Pattern: "[^AEIOU]*ING"}, []string{"BEING", "BRING", "DOING", "SING", "STING"}},
+		{"lengths", &pb.SearchWordsRequest{MinLength: 4, MaxLength: 4, Pattern: "[AEIOU]*"}, []string{"AEON", "EATS"}},
+		{"anagram", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_ANAGRAM, Letters: "tae"}, []string{"ATE", "EAT", "ETA", "TAE", "TEA"}},
+		{"anagram with blank", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_ANAGRAM, Letters: "Q?"}, []string{"QI"}},
+		{"anagram and pattern", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_ANAGRAM, Letters: "AET", Pattern: "T??"}, []string{"TAE", "TEA"}},
+		{"subanagram", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_SUBANAGRAM, Letters: "AET"}, []string{"AE", "AT", "ATE", "EAT", "ETA", "TAE", "TEA"}},
+		{"subanagram with blank", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_SUBANAGRAM, Letters: "Q?", MinLength: 1}, []string{"QI"}},
+		{"build-up", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_BUILD_UP, Letters: "QI"}, []string{"QIS"}},
+		{"build-up with blank", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_BUILD_UP, Letters: "AT?"}, []string{"EATS", "QATS", "SEAT", "TEAS"}},
+		{"repeated letters", &pb.SearchWordsRequest{LetterQuery: pb.LetterQuery_BUILD_UP, Letters: "ZZ"}, []string{"BUZZ", "FIZZ", "JAZZ", "RAZZ", "ZZZ"}},
+		{"no matches", &pb.SearchWordsRequest{Pattern: "X*"}, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			is := is.New(t)
+			tc.req.Lexicon = "NWLSEARCH"
+			words, total, err := searchWords(ws, tc.req)
+			is.NoErr(err)
+			slices.Sort(words)
+			is.Equal(words, tc.expected)
+			is.Equal(int(total), len(tc.expected))
+		})
+	}
+}
+
+func TestSearchWordsErrors(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{"NWLSEARCHERR": searchTestLexicon})
+
+	for _, req := range []*pb.SearchWordsRequest{
+		{Pattern: "[AB"},
+		{Pattern: "AB]"},
+		{Pattern: "[1]"},
+		{Pattern: "A#"},
+		{Pattern: strings.Repeat("?", maxPatternTokens+1)},
+		{LetterQuery: pb.LetterQuery_ANAGRAM},
+		{LetterQuery: pb.LetterQuery_SUBANAGRAM, Letters: "A1"},
+	} {
+		req.Lexicon = "NWLSEARCHERR"
+		_, _, err := searchWords(ws, req)
+		is.True(err != nil)
+	}
+
+	_, _, err := searchWords(ws, &pb.SearchWordsRequest{Lexicon: "NWL404"})
+	is.True(err != nil)
+}
+
+func TestSearchWordsOrderAndPaging(t *testing.T) {
+	is := is.New(t)
+	ws := newTestWordService(t, map[string][]string{"NWLSEARCHPAGE": searchTestLexicon})
+
+	// Shorter words come first, then likelier ones, then alphabetical
+	// order.
+	all, total, err := searchWords(ws, &pb.SearchWordsRequest{Lexicon: "NWLSEARCHPAGE", Pattern: "???"})
+	is.NoErr(err)
+	is.Equal(all, []string{"ATE", "EAT", "ETA", "TAE", "TEA", "QAT", "QIS", "ZZZ"})
+	is.Equal(total, int32(8))
+
+	resp, err := ws.SearchWords(context.Background(), connect.NewRequest(&pb.SearchWordsRequest{
+		Lexicon: "NWLSEARCHPAGE", Pattern: "???"}))
+	is.NoErr(err)
+	var ranks []int32
+	for _, r := range resp.Msg.Results {
+		ranks = append(ranks, r.ProbabilityRank)
+	}
+	// Anagrams have the same probability.
+	is.Equal(ranks, []int32{1, 1, 1, 1, 1, 6, 7, 8})
+	is.Equal(resp.Msg.Results[5].FrontHooks, "")
+	is.Equal(resp.Msg.Results[5].BackHooks, "S")
+	is.Equal(resp.Msg.Results[1].FrontHooks, "S")
+	is.Equal(resp.Msg.Results[1].BackHooks, "S")
+
+	for _, tc := range []struct {
+		limit, offset int32
+		expected      []string
+	}{
+		{3, 0, all[:3]},
+		{3, 3, all[3:6]},
+		{3, 6, all[6:]},
+		{3, 8, nil},
+		{3, 100, nil},
+		{3, -1, nil},
+		{0, 2, all[2:]},
+		{MaxSearchLimit + 1, 0, all},
+	} {
+		words, total, err := searchWords(ws, &pb.SearchWordsRequest{
+			Lexicon: "NWLSEARCHPAGE", Pattern: "???", Limit: tc.limit, Offset: tc.offset})
+		is.NoErr(err)
+		is.Equal(words, tc.expected)
+		is.Equal(total, int32(8))
+	}
+
+	// Probability ranks are of all words of the same length, not just the
+	// matching ones.
+	words, total, err := searchWords(ws, &pb.SearchWordsRequest{
+		Lexicon: "NWLSEARCHPAGE", Pattern: "Q??", MinProbabilityRank: 7})
+	is.NoErr(err)
+	is.Equal(words, []string{"QIS"})
+	is.Equal(total, int32(1))
+	words, _, err = searchWords(ws, &pb.SearchWordsRequest{
+		Lexicon: "NWLSEARCHPAGE", Pattern: "???", MinProbabilityRank: 2, MaxProbabilityRank: 7})
+	is.NoErr(err)
+	is.Equal(words, []string{"QAT", "QIS"})
+}
+
+func TestDrawCombinations(t *testing.T) {
+	is := is.New(t)
+	ld, err := tilemapping.ScanLetterDistribution(strings.NewReader(englishDistribution))
+	is.NoErr(err)
+	dist := ld.Distribution()
+	for _, tc := range []struct {
+		word         string
+		combinations uint64
+	}{
+		{"AA", 36},   // 9 choose 2
+		{"EAT", 648}, // 12 * 9 * 6
+		{"QI", 9},
+		{"ZZZ", 0},
+	} {
+		mw, err := tilemapping.ToMachineWord(tc.word, ld.TileMapping())
+		is.NoErr(err)
+		is.Equal(drawCombinations(mw, dist), tc.combinations)
+	}
+	is.Equal(binomial(12, 3), uint64(220))
+	is.Equal(binomial(2, 3), uint64(0))
+}
@@ -10,8 +10,12 @@ import (
 
 	"connectrpc.com/connect"
 	macondoconfig "github.com/domino14/macondo/config"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/woogles-io/liwords/pkg/apiserver"
 	"github.com/woogles-io/liwords/pkg/config"
+	"github.com/woogles-io/liwords/pkg/stores/models"
+	"github.com/woogles-io/liwords/pkg/user"
 
 	"github.com/domino14/word-golib/kwg"
 	"github.com/domino14/word-golib/tilemapping"
@@ -21,11 +25,19 @@ import (
 
 type WordService struct {
 	cfg               *config.Config
-	definitionSources map[string]*defSource
+	definitionSources map[string]definitionSource
+	gameStore         GameHistoryStore
+	userStore         user.Store
+	queries           *models.Queries
+	dbPool            *pgxpool.Pool
+
+	mu      sync.Mutex
+	rankers map[string]*probabilityRanker
+	diffs   *lru.Cache[string, *lexiconDiff]
 }
 
 // NewWordService creates a WordService
-func NewWordService(cfg *config.Config) *WordService {
+func NewWordService(cfg *config.Config, gameStore GameHistoryStore, userStore user.Store, queries *models.Queries, dbPool *pgxpool.Pool) *WordService {
 
 	lexPath := filepath.Join(cfg.MacondoConfig().GetString(macondoconfig.ConfigDataPath), "lexica")
 	kwgPath := filepath.Join(lexPath, "gaddag")
@@ -46,24 +58,35 @@ func NewWordService(cfg *config.Config) *WordService {
 		kwgDir.Close()
 	}
 
-	definitionSources := make(map[string]*defSource)
+	definitionSources := make(map[string]definitionSource)
 	dictionaryPath := filepath.Join(lexPath, "words")
 	for _, filename := range filenames {
 		lexicon := strings.TrimSuffix(filename, ".kwg")
 		if len(lexicon) == len(filename) {
 			continue
 		}
-		definitionSource, err := loadDefinitionSource(filepath.Join(dictionaryPath, lexicon+".txt"))
+		definitionSource, err := loadDefinitionSources(dictionaryPath, lexicon)
 		if err != nil {
 			definitionSources[lexicon] = nil // still whitelist the file.
 			log.Warn().Err(err).Msgf("bad definition source for %s", lexicon)
 		} else {
 			definitionSources[lexicon] = definitionSource
-			log.Info().Msgf("found definition source for %s", lexicon)
+			if definitionSource == nil {
+				log.Info().Msgf("no definition source for %s", lexicon)
+			}
 		}
 	}
 
-	return &WordService{cfg, definitionSources}
+	return &WordService{
+		cfg:               cfg,
+		definitionSources: definitionSources,
+		gameStore:         gameStore,
+		userStore:         userStore,
+		queries:           queries,
+		dbPool:            dbPool,
+		rankers:           make(map[string]*probabilityRanker),
+		diffs:             newLexiconDiffCache(),
+	}
 }
 
 var daPool = sync.Pool{
@@ -168,8 +191,9 @@ func (ws *WordService) DefineWords(ctx context.Context, req *connect.Request[pb.
 			log.Warn().Err(err).Msgf("cannot read %s definition", req.Msg.Lexicon)
 		} else {
 			for _, word := range wordsToDefine {
-				if definition, ok := definitions[word]; ok && definition != "" {
-					results[word].D = definition
+				if defs := definitions[word]; len(defs) > 0 {
+					results[word].D = joinDefinitions(defs)
+					results[word].Definitions = definitionsToPB(word, defs)
 				}
 			}
 		}
@@ -185,6 +209,7 @@ func (ws *WordService) DefineWords(ctx context.Context, req *connect.Request[pb.
 
 			if words, found := anagrams[query]; found && len(words) > 0 {
 				definitions := ""
+				var definitionList []*pb.Definition
 				if req.Msg.Definitions {
 					var definitionBytes []byte
 					for _, word := range words {
@@ -194,10 +219,11 @@ func (ws *WordService) DefineWords(ctx context.Context, req *connect.Request[pb.
 						definitionBytes = append(definitionBytes, word...)
 						definitionBytes = append(definitionBytes, " - "...)
 						definitionBytes = append(definitionBytes, originalResults[word].D...)
+						definitionList = append(definitionList, originalResults[word].Definitions...)
 					}
 					definitions = string(definitionBytes)
 				}
-				results[query] = &pb.DefineWordsResult{D: definitions, V: true}
+				results[query] = &pb.DefineWordsResult{D: definitions, V: true, Definitions: definitionList}
 			} else {
 				results[query] = &pb.DefineWordsResult{D: "", V: false}
 			}
@@ -234,5 +260,13 @@ func (ws *WordService) Define(lexicon, word string) (string, bool) {
 	if err != nil {
 		return "", true // valid but definition unavailable
 	}
-	return defs[strings.ToUpper(word)], true
+	return joinDefinitions(defs[strings.ToUpper(word)]), true
+}
+
+func definitionsToPB(word string, defs []definition) []*pb.Definition {
+	ret := make([]*pb.Definition, len(defs))
+	for i, d := range defs {
+		ret[i] = &pb.Definition{Word: word, Text: d.text, Source: d.source, Lemma: d.lemma}
+	}
+	return ret
 }
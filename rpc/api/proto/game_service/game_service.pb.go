@@ -11,6 +11,7 @@ import (
 	ipc "github.com/woogles-io/liwords/rpc/api/proto/ipc"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -740,6 +741,596 @@ func (x *UnfreezeBotResponse) GetErrors() int32 {
 	return 0
 }
 
+type ScoutingReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user being scouted.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// If set, the head-to-head record between the scouted user and this
+	// user is returned too, from the scouted user's point of view.
+	OpponentUsername string `protobuf:"bytes,2,opt,name=opponent_username,json=opponentUsername,proto3" json:"opponent_username,omitempty"`
+	// The number of most recent games in the recent records. Defaults to 10.
+	NumRecent     int32 `protobuf:"varint,3,opt,name=num_recent,json=numRecent,proto3" json:"num_recent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoutingReportRequest) Reset() {
+	*x = ScoutingReportRequest{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoutingReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoutingReportRequest) ProtoMessage() {}
+
+func (x *ScoutingReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoutingReportRequest.ProtoReflect.Descriptor instead.
+func (*ScoutingReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScoutingReportRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ScoutingReportRequest) GetOpponentUsername() string {
+	if x != nil {
+		return x.OpponentUsername
+	}
+	return ""
+}
+
+func (x *ScoutingReportRequest) GetNumRecent() int32 {
+	if x != nil {
+		return x.NumRecent
+	}
+	return 0
+}
+
+// A record over a set of rated games, from one player's point of view.
+type GameRecord struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Games                int32                  `protobuf:"varint,1,opt,name=games,proto3" json:"games,omitempty"`
+	Wins                 int32                  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	Losses               int32                  `protobuf:"varint,3,opt,name=losses,proto3" json:"losses,omitempty"`
+	Draws                int32                  `protobuf:"varint,4,opt,name=draws,proto3" json:"draws,omitempty"`
+	AverageScore         float64                `protobuf:"fixed64,5,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	AverageOpponentScore float64                `protobuf:"fixed64,6,opt,name=average_opponent_score,json=averageOpponentScore,proto3" json:"average_opponent_score,omitempty"`
+	Spread               int32                  `protobuf:"varint,7,opt,name=spread,proto3" json:"spread,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GameRecord) Reset() {
+	*x = GameRecord{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameRecord) ProtoMessage() {}
+
+func (x *GameRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameRecord.ProtoReflect.Descriptor instead.
+func (*GameRecord) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GameRecord) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *GameRecord) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *GameRecord) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *GameRecord) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+func (x *GameRecord) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *GameRecord) GetAverageOpponentScore() float64 {
+	if x != nil {
+		return x.AverageOpponentScore
+	}
+	return 0
+}
+
+func (x *GameRecord) GetSpread() int32 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+type TimeControlRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeControl   string                 `protobuf:"bytes,1,opt,name=time_control,json=timeControl,proto3" json:"time_control,omitempty"` // ultrablitz, blitz, rapid, regular, corres
+	Record        *GameRecord            `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeControlRecord) Reset() {
+	*x = TimeControlRecord{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeControlRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeControlRecord) ProtoMessage() {}
+
+func (x *TimeControlRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeControlRecord.ProtoReflect.Descriptor instead.
+func (*TimeControlRecord) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TimeControlRecord) GetTimeControl() string {
+	if x != nil {
+		return x.TimeControl
+	}
+	return ""
+}
+
+func (x *TimeControlRecord) GetRecord() *GameRecord {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+type NotableGame struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// What makes this game notable, e.g. "highest_score" or "biggest_win".
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	OpponentScore int32                  `protobuf:"varint,4,opt,name=opponent_score,json=opponentScore,proto3" json:"opponent_score,omitempty"`
+	PlayedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotableGame) Reset() {
+	*x = NotableGame{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotableGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotableGame) ProtoMessage() {}
+
+func (x *NotableGame) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotableGame.ProtoReflect.Descriptor instead.
+func (*NotableGame) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NotableGame) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *NotableGame) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NotableGame) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *NotableGame) GetOpponentScore() int32 {
+	if x != nil {
+		return x.OpponentScore
+	}
+	return 0
+}
+
+func (x *NotableGame) GetPlayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return nil
+}
+
+type HeadToHead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lifetime      *GameRecord            `protobuf:"bytes,1,opt,name=lifetime,proto3" json:"lifetime,omitempty"`
+	Recent        *GameRecord            `protobuf:"bytes,2,opt,name=recent,proto3" json:"recent,omitempty"`
+	TimeControls  []*TimeControlRecord   `protobuf:"bytes,3,rep,name=time_controls,json=timeControls,proto3" json:"time_controls,omitempty"`
+	NotableGames  []*NotableGame         `protobuf:"bytes,4,rep,name=notable_games,json=notableGames,proto3" json:"notable_games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeadToHead) Reset() {
+	*x = HeadToHead{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeadToHead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadToHead) ProtoMessage() {}
+
+func (x *HeadToHead) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadToHead.ProtoReflect.Descriptor instead.
+func (*HeadToHead) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HeadToHead) GetLifetime() *GameRecord {
+	if x != nil {
+		return x.Lifetime
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetRecent() *GameRecord {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetTimeControls() []*TimeControlRecord {
+	if x != nil {
+		return x.TimeControls
+	}
+	return nil
+}
+
+func (x *HeadToHead) GetNotableGames() []*NotableGame {
+	if x != nil {
+		return x.NotableGames
+	}
+	return nil
+}
+
+type OpeningCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Opening       string                 `protobuf:"bytes,1,opt,name=opening,proto3" json:"opening,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpeningCount) Reset() {
+	*x = OpeningCount{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpeningCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningCount) ProtoMessage() {}
+
+func (x *OpeningCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningCount.ProtoReflect.Descriptor instead.
+func (*OpeningCount) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OpeningCount) GetOpening() string {
+	if x != nil {
+		return x.Opening
+	}
+	return ""
+}
+
+func (x *OpeningCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// A summary of a user's rated games, from their profile stats across all
+// variants.
+type ScoutingSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recent record against anyone.
+	Recent *GameRecord `protobuf:"bytes,1,opt,name=recent,proto3" json:"recent,omitempty"`
+	Games  int32       `protobuf:"varint,2,opt,name=games,proto3" json:"games,omitempty"`
+	// The most common opening lengths ("5" for a five tile opening, or
+	// "exchange" or "pass") and positions ("8D"), most common first.
+	OpeningLengths      []*OpeningCount `protobuf:"bytes,3,rep,name=opening_lengths,json=openingLengths,proto3" json:"opening_lengths,omitempty"`
+	OpeningPositions    []*OpeningCount `protobuf:"bytes,4,rep,name=opening_positions,json=openingPositions,proto3" json:"opening_positions,omitempty"`
+	AverageOpeningScore float64         `protobuf:"fixed64,5,opt,name=average_opening_score,json=averageOpeningScore,proto3" json:"average_opening_score,omitempty"`
+	// Exchanges per turn.
+	ExchangeRate         float64 `protobuf:"fixed64,6,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	ChallengesWon        int32   `protobuf:"varint,7,opt,name=challenges_won,json=challengesWon,proto3" json:"challenges_won,omitempty"`
+	ChallengesLost       int32   `protobuf:"varint,8,opt,name=challenges_lost,json=challengesLost,proto3" json:"challenges_lost,omitempty"`
+	PhoniesPlayed        int32   `protobuf:"varint,9,opt,name=phonies_played,json=phoniesPlayed,proto3" json:"phonies_played,omitempty"`
+	PhoniesChallengedOff int32   `protobuf:"varint,10,opt,name=phonies_challenged_off,json=phoniesChallengedOff,proto3" json:"phonies_challenged_off,omitempty"`
+	// Valid plays the user made that their opponent challenged.
+	ValidPlaysChallenged int32 `protobuf:"varint,11,opt,name=valid_plays_challenged,json=validPlaysChallenged,proto3" json:"valid_plays_challenged,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ScoutingSummary) Reset() {
+	*x = ScoutingSummary{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoutingSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoutingSummary) ProtoMessage() {}
+
+func (x *ScoutingSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoutingSummary.ProtoReflect.Descriptor instead.
+func (*ScoutingSummary) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScoutingSummary) GetRecent() *GameRecord {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
+func (x *ScoutingSummary) GetGames() int32 {
+	if x != nil {
+		return x.Games
+	}
+	return 0
+}
+
+func (x *ScoutingSummary) GetOpeningLengths() []*OpeningCount {
+	if x != nil {
+		return x.OpeningLengths
+	}
+	return nil
+}
+
+func (x *ScoutingSummary) GetOpeningPositions() []*OpeningCount {
+	if x != nil {
+		return x.OpeningPositions
+	}
+	return nil
+}
+
+func (x *ScoutingSummary) GetAverageOpeningScore() float64 {
+	if x != nil {
+		return x.AverageOpeningScore
+	}
+	return 0
+}
+
+func (x *ScoutingSummary) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *ScoutingSummary) GetChallengesWon() int32 {
+	if x != nil {
+		return x.ChallengesWon
+	}
+	return 0
+}
+
+func (x *ScoutingSummary) GetChallengesLost() int32 {
+	if x != nil {
+		return x.ChallengesLost
+	}
+	return 0
+}
+
+func (x *ScoutingSummary) GetPhoniesPlayed() int32 {
+	if x != nil {
+		return x.PhoniesPlayed
+	}
+	return 0
+}
+
+func (x *ScoutingSummary) GetPhoniesChallengedOff() int32 {
+	if x != nil {
+		return x.PhoniesChallengedOff
+	}
+	return 0
+}
+
+func (x *ScoutingSummary) GetValidPlaysChallenged() int32 {
+	if x != nil {
+		return x.ValidPlaysChallenged
+	}
+	return 0
+}
+
+type ScoutingReportResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Summary *ScoutingSummary       `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	// Only set if an opponent_username was given.
+	HeadToHead    *HeadToHead `protobuf:"bytes,2,opt,name=head_to_head,json=headToHead,proto3" json:"head_to_head,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoutingReportResponse) Reset() {
+	*x = ScoutingReportResponse{}
+	mi := &file_proto_game_service_game_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoutingReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoutingReportResponse) ProtoMessage() {}
+
+func (x *ScoutingReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_service_game_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoutingReportResponse.ProtoReflect.Descriptor instead.
+func (*ScoutingReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_service_game_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ScoutingReportResponse) GetSummary() *ScoutingSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *ScoutingReportResponse) GetHeadToHead() *HeadToHead {
+	if x != nil {
+		return x.HeadToHead
+	}
+	return nil
+}
+
 type StreakInfoResponse_SingleGameInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
@@ -750,7 +1341,7 @@ type StreakInfoResponse_SingleGameInfo struct {
 
 func (x *StreakInfoResponse_SingleGameInfo) Reset() {
 	*x = StreakInfoResponse_SingleGameInfo{}
-	mi := &file_proto_game_service_game_service_proto_msgTypes[14]
+	mi := &file_proto_game_service_game_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +1353,7 @@ func (x *StreakInfoResponse_SingleGameInfo) String() string {
 func (*StreakInfoResponse_SingleGameInfo) ProtoMessage() {}
 
 func (x *StreakInfoResponse_SingleGameInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_game_service_proto_msgTypes[14]
+	mi := &file_proto_game_service_game_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,7 +1393,7 @@ type StreakInfoResponse_PlayerInfo struct {
 
 func (x *StreakInfoResponse_PlayerInfo) Reset() {
 	*x = StreakInfoResponse_PlayerInfo{}
-	mi := &file_proto_game_service_game_service_proto_msgTypes[15]
+	mi := &file_proto_game_service_game_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1405,7 @@ func (x *StreakInfoResponse_PlayerInfo) String() string {
 func (*StreakInfoResponse_PlayerInfo) ProtoMessage() {}
 
 func (x *StreakInfoResponse_PlayerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_game_service_game_service_proto_msgTypes[15]
+	mi := &file_proto_game_service_game_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +1439,7 @@ var File_proto_game_service_game_service_proto protoreflect.FileDescriptor
 
 const file_proto_game_service_game_service_proto_rawDesc = "" +
 	"\n" +
-	"%proto/game_service/game_service.proto\x12\fgame_service\x1a\x18proto/ipc/omgwords.proto\x1a$proto/vendored/macondo/macondo.proto\"*\n" +
+	"%proto/game_service/game_service.proto\x12\fgame_service\x1a\x18proto/ipc/omgwords.proto\x1a$proto/vendored/macondo/macondo.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"*\n" +
 	"\x0fGameInfoRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\"%\n" +
 	"\n" +
@@ -890,12 +1481,61 @@ const file_proto_game_service_game_service_proto_rawDesc = "" +
 	"\x13UnfreezeBotResponse\x12'\n" +
 	"\x0fgames_processed\x18\x01 \x01(\x05R\x0egamesProcessed\x12#\n" +
 	"\rrequests_sent\x18\x02 \x01(\x05R\frequestsSent\x12\x16\n" +
-	"\x06errors\x18\x03 \x01(\x05R\x06errors*\xa7\x01\n" +
+	"\x06errors\x18\x03 \x01(\x05R\x06errors\"\x7f\n" +
+	"\x15ScoutingReportRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12+\n" +
+	"\x11opponent_username\x18\x02 \x01(\tR\x10opponentUsername\x12\x1d\n" +
+	"\n" +
+	"num_recent\x18\x03 \x01(\x05R\tnumRecent\"\xd7\x01\n" +
+	"\n" +
+	"GameRecord\x12\x14\n" +
+	"\x05games\x18\x01 \x01(\x05R\x05games\x12\x12\n" +
+	"\x04wins\x18\x02 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x03 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\x04 \x01(\x05R\x05draws\x12#\n" +
+	"\raverage_score\x18\x05 \x01(\x01R\faverageScore\x124\n" +
+	"\x16average_opponent_score\x18\x06 \x01(\x01R\x14averageOpponentScore\x12\x16\n" +
+	"\x06spread\x18\a \x01(\x05R\x06spread\"h\n" +
+	"\x11TimeControlRecord\x12!\n" +
+	"\ftime_control\x18\x01 \x01(\tR\vtimeControl\x120\n" +
+	"\x06record\x18\x02 \x01(\v2\x18.game_service.GameRecordR\x06record\"\xb4\x01\n" +
+	"\vNotableGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12%\n" +
+	"\x0eopponent_score\x18\x04 \x01(\x05R\ropponentScore\x127\n" +
+	"\tplayed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bplayedAt\"\xfa\x01\n" +
+	"\n" +
+	"HeadToHead\x124\n" +
+	"\blifetime\x18\x01 \x01(\v2\x18.game_service.GameRecordR\blifetime\x120\n" +
+	"\x06recent\x18\x02 \x01(\v2\x18.game_service.GameRecordR\x06recent\x12D\n" +
+	"\rtime_controls\x18\x03 \x03(\v2\x1f.game_service.TimeControlRecordR\ftimeControls\x12>\n" +
+	"\rnotable_games\x18\x04 \x03(\v2\x19.game_service.NotableGameR\fnotableGames\">\n" +
+	"\fOpeningCount\x12\x18\n" +
+	"\aopening\x18\x01 \x01(\tR\aopening\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xa3\x04\n" +
+	"\x0fScoutingSummary\x120\n" +
+	"\x06recent\x18\x01 \x01(\v2\x18.game_service.GameRecordR\x06recent\x12\x14\n" +
+	"\x05games\x18\x02 \x01(\x05R\x05games\x12C\n" +
+	"\x0fopening_lengths\x18\x03 \x03(\v2\x1a.game_service.OpeningCountR\x0eopeningLengths\x12G\n" +
+	"\x11opening_positions\x18\x04 \x03(\v2\x1a.game_service.OpeningCountR\x10openingPositions\x122\n" +
+	"\x15average_opening_score\x18\x05 \x01(\x01R\x13averageOpeningScore\x12#\n" +
+	"\rexchange_rate\x18\x06 \x01(\x01R\fexchangeRate\x12%\n" +
+	"\x0echallenges_won\x18\a \x01(\x05R\rchallengesWon\x12'\n" +
+	"\x0fchallenges_lost\x18\b \x01(\x05R\x0echallengesLost\x12%\n" +
+	"\x0ephonies_played\x18\t \x01(\x05R\rphoniesPlayed\x124\n" +
+	"\x16phonies_challenged_off\x18\n" +
+	" \x01(\x05R\x14phoniesChallengedOff\x124\n" +
+	"\x16valid_plays_challenged\x18\v \x01(\x05R\x14validPlaysChallenged\"\x8d\x01\n" +
+	"\x16ScoutingReportResponse\x127\n" +
+	"\asummary\x18\x01 \x01(\v2\x1d.game_service.ScoutingSummaryR\asummary\x12:\n" +
+	"\fhead_to_head\x18\x02 \x01(\v2\x18.game_service.HeadToHeadR\n" +
+	"headToHead*\xa7\x01\n" +
 	"\x0fUnfreezeBotMode\x12!\n" +
 	"\x1dUNFREEZE_BOT_MODE_UNSPECIFIED\x10\x00\x12(\n" +
 	"$UNFREEZE_BOT_MODE_ALL_CORRESPONDENCE\x10\x01\x12\"\n" +
 	"\x1eUNFREEZE_BOT_MODE_ALL_REALTIME\x10\x02\x12#\n" +
-	"\x1fUNFREEZE_BOT_MODE_SPECIFIC_GAME\x10\x032\x94\x06\n" +
+	"\x1fUNFREEZE_BOT_MODE_SPECIFIC_GAME\x10\x032\xf4\x06\n" +
 	"\x13GameMetadataService\x12C\n" +
 	"\vGetMetadata\x12\x1d.game_service.GameInfoRequest\x1a\x15.ipc.GameInfoResponse\x12=\n" +
 	"\x06GetGCG\x12\x18.game_service.GCGRequest\x1a\x19.game_service.GCGResponse\x12U\n" +
@@ -905,7 +1545,8 @@ const file_proto_game_service_game_service_proto_rawDesc = "" +
 	"\x0fGetGameDocument\x12!.game_service.GameDocumentRequest\x1a\".game_service.GameDocumentResponse\x12f\n" +
 	"\x1cGetActiveCorrespondenceGames\x12..game_service.ActiveCorrespondenceGamesRequest\x1a\x16.ipc.GameInfoResponses\x12f\n" +
 	"\x1cGetRecentCorrespondenceGames\x12..game_service.RecentCorrespondenceGamesRequest\x1a\x16.ipc.GameInfoResponses\x12R\n" +
-	"\vUnfreezeBot\x12 .game_service.UnfreezeBotRequest\x1a!.game_service.UnfreezeBotResponseB\xaa\x01\n" +
+	"\vUnfreezeBot\x12 .game_service.UnfreezeBotRequest\x1a!.game_service.UnfreezeBotResponse\x12^\n" +
+	"\x11GetScoutingReport\x12#.game_service.ScoutingReportRequest\x1a$.game_service.ScoutingReportResponseB\xaa\x01\n" +
 	"\x10com.game_serviceB\x10GameServiceProtoP\x01Z8github.com/woogles-io/liwords/rpc/api/proto/game_service\xa2\x02\x03GXX\xaa\x02\vGameService\xca\x02\vGameService\xe2\x02\x17GameService\\GPBMetadata\xea\x02\vGameServiceb\x06proto3"
 
 var (
@@ -921,7 +1562,7 @@ func file_proto_game_service_game_service_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_game_service_game_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_game_service_game_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_game_service_game_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_game_service_game_service_proto_goTypes = []any{
 	(UnfreezeBotMode)(0),                      // 0: game_service.UnfreezeBotMode
 	(*GameInfoRequest)(nil),                   // 1: game_service.GameInfoRequest
@@ -938,42 +1579,64 @@ var file_proto_game_service_game_service_proto_goTypes = []any{
 	(*RecentCorrespondenceGamesRequest)(nil),  // 12: game_service.RecentCorrespondenceGamesRequest
 	(*UnfreezeBotRequest)(nil),                // 13: game_service.UnfreezeBotRequest
 	(*UnfreezeBotResponse)(nil),               // 14: game_service.UnfreezeBotResponse
-	(*StreakInfoResponse_SingleGameInfo)(nil), // 15: game_service.StreakInfoResponse.SingleGameInfo
-	(*StreakInfoResponse_PlayerInfo)(nil),     // 16: game_service.StreakInfoResponse.PlayerInfo
-	(*macondo.GameHistory)(nil),               // 17: macondo.GameHistory
-	(*ipc.GameDocument)(nil),                  // 18: ipc.GameDocument
-	(*ipc.GameInfoResponse)(nil),              // 19: ipc.GameInfoResponse
-	(*ipc.GameInfoResponses)(nil),             // 20: ipc.GameInfoResponses
+	(*ScoutingReportRequest)(nil),             // 15: game_service.ScoutingReportRequest
+	(*GameRecord)(nil),                        // 16: game_service.GameRecord
+	(*TimeControlRecord)(nil),                 // 17: game_service.TimeControlRecord
+	(*NotableGame)(nil),                       // 18: game_service.NotableGame
+	(*HeadToHead)(nil),                        // 19: game_service.HeadToHead
+	(*OpeningCount)(nil),                      // 20: game_service.OpeningCount
+	(*ScoutingSummary)(nil),                   // 21: game_service.ScoutingSummary
+	(*ScoutingReportResponse)(nil),            // 22: game_service.ScoutingReportResponse
+	(*StreakInfoResponse_SingleGameInfo)(nil), // 23: game_service.StreakInfoResponse.SingleGameInfo
+	(*StreakInfoResponse_PlayerInfo)(nil),     // 24: game_service.StreakInfoResponse.PlayerInfo
+	(*macondo.GameHistory)(nil),               // 25: macondo.GameHistory
+	(*ipc.GameDocument)(nil),                  // 26: ipc.GameDocument
+	(*timestamppb.Timestamp)(nil),             // 27: google.protobuf.Timestamp
+	(*ipc.GameInfoResponse)(nil),              // 28: ipc.GameInfoResponse
+	(*ipc.GameInfoResponses)(nil),             // 29: ipc.GameInfoResponses
 }
 var file_proto_game_service_game_service_proto_depIdxs = []int32{
-	17, // 0: game_service.GameHistoryResponse.history:type_name -> macondo.GameHistory
-	18, // 1: game_service.GameDocumentResponse.document:type_name -> ipc.GameDocument
-	15, // 2: game_service.StreakInfoResponse.streak:type_name -> game_service.StreakInfoResponse.SingleGameInfo
-	16, // 3: game_service.StreakInfoResponse.playersInfo:type_name -> game_service.StreakInfoResponse.PlayerInfo
+	25, // 0: game_service.GameHistoryResponse.history:type_name -> macondo.GameHistory
+	26, // 1: game_service.GameDocumentResponse.document:type_name -> ipc.GameDocument
+	23, // 2: game_service.StreakInfoResponse.streak:type_name -> game_service.StreakInfoResponse.SingleGameInfo
+	24, // 3: game_service.StreakInfoResponse.playersInfo:type_name -> game_service.StreakInfoResponse.PlayerInfo
 	0,  // 4: game_service.UnfreezeBotRequest.mode:type_name -> game_service.UnfreezeBotMode
-	1,  // 5: game_service.GameMetadataService.GetMetadata:input_type -> game_service.GameInfoRequest
-	2,  // 6: game_service.GameMetadataService.GetGCG:input_type -> game_service.GCGRequest
-	3,  // 7: game_service.GameMetadataService.GetGameHistory:input_type -> game_service.GameHistoryRequest
-	8,  // 8: game_service.GameMetadataService.GetRecentGames:input_type -> game_service.RecentGamesRequest
-	10, // 9: game_service.GameMetadataService.GetRematchStreak:input_type -> game_service.RematchStreakRequest
-	4,  // 10: game_service.GameMetadataService.GetGameDocument:input_type -> game_service.GameDocumentRequest
-	11, // 11: game_service.GameMetadataService.GetActiveCorrespondenceGames:input_type -> game_service.ActiveCorrespondenceGamesRequest
-	12, // 12: game_service.GameMetadataService.GetRecentCorrespondenceGames:input_type -> game_service.RecentCorrespondenceGamesRequest
-	13, // 13: game_service.GameMetadataService.UnfreezeBot:input_type -> game_service.UnfreezeBotRequest
-	19, // 14: game_service.GameMetadataService.GetMetadata:output_type -> ipc.GameInfoResponse
-	5,  // 15: game_service.GameMetadataService.GetGCG:output_type -> game_service.GCGResponse
-	6,  // 16: game_service.GameMetadataService.GetGameHistory:output_type -> game_service.GameHistoryResponse
-	20, // 17: game_service.GameMetadataService.GetRecentGames:output_type -> ipc.GameInfoResponses
-	9,  // 18: game_service.GameMetadataService.GetRematchStreak:output_type -> game_service.StreakInfoResponse
-	7,  // 19: game_service.GameMetadataService.GetGameDocument:output_type -> game_service.GameDocumentResponse
-	20, // 20: game_service.GameMetadataService.GetActiveCorrespondenceGames:output_type -> ipc.GameInfoResponses
-	20, // 21: game_service.GameMetadataService.GetRecentCorrespondenceGames:output_type -> ipc.GameInfoResponses
-	14, // 22: game_service.GameMetadataService.UnfreezeBot:output_type -> game_service.UnfreezeBotResponse
-	14, // [14:23] is the sub-list for method output_type
-	5,  // [5:14] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	16, // 5: game_service.TimeControlRecord.record:type_name -> game_service.GameRecord
+	27, // 6: game_service.NotableGame.played_at:type_name -> google.protobuf.Timestamp
+	16, // 7: game_service.HeadToHead.lifetime:type_name -> game_service.GameRecord
+	16, // 8: game_service.HeadToHead.recent:type_name -> game_service.GameRecord
+	17, // 9: game_service.HeadToHead.time_controls:type_name -> game_service.TimeControlRecord
+	18, // 10: game_service.HeadToHead.notable_games:type_name -> game_service.NotableGame
+	16, // 11: game_service.ScoutingSummary.recent:type_name -> game_service.GameRecord
+	20, // 12: game_service.ScoutingSummary.opening_lengths:type_name -> game_service.OpeningCount
+	20, // 13: game_service.ScoutingSummary.opening_positions:type_name -> game_service.OpeningCount
+	21, // 14: game_service.ScoutingReportResponse.summary:type_name -> game_service.ScoutingSummary
+	19, // 15: game_service.ScoutingReportResponse.head_to_head:type_name -> game_service.HeadToHead
+	1,  // 16: game_service.GameMetadataService.GetMetadata:input_type -> game_service.GameInfoRequest
+	2,  // 17: game_service.GameMetadataService.GetGCG:input_type -> game_service.GCGRequest
+	3,  // 18: game_service.GameMetadataService.GetGameHistory:input_type -> game_service.GameHistoryRequest
+	8,  // 19: game_service.GameMetadataService.GetRecentGames:input_type -> game_service.RecentGamesRequest
+	10, // 20: game_service.GameMetadataService.GetRematchStreak:input_type -> game_service.RematchStreakRequest
+	4,  // 21: game_service.GameMetadataService.GetGameDocument:input_type -> game_service.GameDocumentRequest
+	11, // 22: game_service.GameMetadataService.GetActiveCorrespondenceGames:input_type -> game_service.ActiveCorrespondenceGamesRequest
+	12, // 23: game_service.GameMetadataService.GetRecentCorrespondenceGames:input_type -> game_service.RecentCorrespondenceGamesRequest
+	13, // 24: game_service.GameMetadataService.UnfreezeBot:input_type -> game_service.UnfreezeBotRequest
+	15, // 25: game_service.GameMetadataService.GetScoutingReport:input_type -> game_service.ScoutingReportRequest
+	28, // 26: game_service.GameMetadataService.GetMetadata:output_type -> ipc.GameInfoResponse
+	5,  // 27: game_service.GameMetadataService.GetGCG:output_type -> game_service.GCGResponse
+	6,  // 28: game_service.GameMetadataService.GetGameHistory:output_type -> game_service.GameHistoryResponse
+	29, // 29: game_service.GameMetadataService.GetRecentGames:output_type -> ipc.GameInfoResponses
+	9,  // 30: game_service.GameMetadataService.GetRematchStreak:output_type -> game_service.StreakInfoResponse
+	7,  // 31: game_service.GameMetadataService.GetGameDocument:output_type -> game_service.GameDocumentResponse
+	29, // 32: game_service.GameMetadataService.GetActiveCorrespondenceGames:output_type -> ipc.GameInfoResponses
+	29, // 33: game_service.GameMetadataService.GetRecentCorrespondenceGames:output_type -> ipc.GameInfoResponses
+	14, // 34: game_service.GameMetadataService.UnfreezeBot:output_type -> game_service.UnfreezeBotResponse
+	22, // 35: game_service.GameMetadataService.GetScoutingReport:output_type -> game_service.ScoutingReportResponse
+	26, // [26:36] is the sub-list for method output_type
+	16, // [16:26] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_proto_game_service_game_service_proto_init() }
@@ -987,7 +1650,7 @@ func file_proto_game_service_game_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_game_service_game_service_proto_rawDesc), len(file_proto_game_service_game_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -61,6 +61,9 @@ const (
 	// GameMetadataServiceUnfreezeBotProcedure is the fully-qualified name of the GameMetadataService's
 	// UnfreezeBot RPC.
 	GameMetadataServiceUnfreezeBotProcedure = "/game_service.GameMetadataService/UnfreezeBot"
+	// GameMetadataServiceGetScoutingReportProcedure is the fully-qualified name of the
+	// GameMetadataService's GetScoutingReport RPC.
+	GameMetadataServiceGetScoutingReportProcedure = "/game_service.GameMetadataService/GetScoutingReport"
 )
 
 // GameMetadataServiceClient is a client for the game_service.GameMetadataService service.
@@ -83,6 +86,9 @@ type GameMetadataServiceClient interface {
 	GetRecentCorrespondenceGames(context.Context, *connect.Request[game_service.RecentCorrespondenceGamesRequest]) (*connect.Response[ipc.GameInfoResponses], error)
 	// UnfreezeBot re-sends bot move requests for stuck games (admin only)
 	UnfreezeBot(context.Context, *connect.Request[game_service.UnfreezeBotRequest]) (*connect.Response[game_service.UnfreezeBotResponse], error)
+	// GetScoutingReport gets a summary of a user's rated games and, if an
+	// opponent is given, their head-to-head record against that opponent.
+	GetScoutingReport(context.Context, *connect.Request[game_service.ScoutingReportRequest]) (*connect.Response[game_service.ScoutingReportResponse], error)
 }
 
 // NewGameMetadataServiceClient constructs a client for the game_service.GameMetadataService
@@ -150,6 +156,12 @@ func NewGameMetadataServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(gameMetadataServiceMethods.ByName("UnfreezeBot")),
 			connect.WithClientOptions(opts...),
 		),
+		getScoutingReport: connect.NewClient[game_service.ScoutingReportRequest, game_service.ScoutingReportResponse](
+			httpClient,
+			baseURL+GameMetadataServiceGetScoutingReportProcedure,
+			connect.WithSchema(gameMetadataServiceMethods.ByName("GetScoutingReport")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -164,6 +176,7 @@ type gameMetadataServiceClient struct {
 	getActiveCorrespondenceGames *connect.Client[game_service.ActiveCorrespondenceGamesRequest, ipc.GameInfoResponses]
 	getRecentCorrespondenceGames *connect.Client[game_service.RecentCorrespondenceGamesRequest, ipc.GameInfoResponses]
 	unfreezeBot                  *connect.Client[game_service.UnfreezeBotRequest, game_service.UnfreezeBotResponse]
+	getScoutingReport            *connect.Client[game_service.ScoutingReportRequest, game_service.ScoutingReportResponse]
 }
 
 // GetMetadata calls game_service.GameMetadataService.GetMetadata.
@@ -211,6 +224,11 @@ func (c *gameMetadataServiceClient) UnfreezeBot(ctx context.Context, req *connec
 	return c.unfreezeBot.CallUnary(ctx, req)
 }
 
+// GetScoutingReport calls game_service.GameMetadataService.GetScoutingReport.
+func (c *gameMetadataServiceClient) GetScoutingReport(ctx context.Context, req *connect.Request[game_service.ScoutingReportRequest]) (*connect.Response[game_service.ScoutingReportResponse], error) {
+	return c.getScoutingReport.CallUnary(ctx, req)
+}
+
 // GameMetadataServiceHandler is an implementation of the game_service.GameMetadataService service.
 type GameMetadataServiceHandler interface {
 	GetMetadata(context.Context, *connect.Request[game_service.GameInfoRequest]) (*connect.Response[ipc.GameInfoResponse], error)
@@ -231,6 +249,9 @@ type GameMetadataServiceHandler interface {
 	GetRecentCorrespondenceGames(context.Context, *connect.Request[game_service.RecentCorrespondenceGamesRequest]) (*connect.Response[ipc.GameInfoResponses], error)
 	// UnfreezeBot re-sends bot move requests for stuck games (admin only)
 	UnfreezeBot(context.Context, *connect.Request[game_service.UnfreezeBotRequest]) (*connect.Response[game_service.UnfreezeBotResponse], error)
+	// GetScoutingReport gets a summary of a user's rated games and, if an
+	// opponent is given, their head-to-head record against that opponent.
+	GetScoutingReport(context.Context, *connect.Request[game_service.ScoutingReportRequest]) (*connect.Response[game_service.ScoutingReportResponse], error)
 }
 
 // NewGameMetadataServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -294,6 +315,12 @@ func NewGameMetadataServiceHandler(svc GameMetadataServiceHandler, opts ...conne
 		connect.WithSchema(gameMetadataServiceMethods.ByName("UnfreezeBot")),
 		connect.WithHandlerOptions(opts...),
 	)
+	gameMetadataServiceGetScoutingReportHandler := connect.NewUnaryHandler(
+		GameMetadataServiceGetScoutingReportProcedure,
+		svc.GetScoutingReport,
+		connect.WithSchema(gameMetadataServiceMethods.ByName("GetScoutingReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/game_service.GameMetadataService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case GameMetadataServiceGetMetadataProcedure:
@@ -314,6 +341,8 @@ func NewGameMetadataServiceHandler(svc GameMetadataServiceHandler, opts ...conne
 			gameMetadataServiceGetRecentCorrespondenceGamesHandler.ServeHTTP(w, r)
 		case GameMetadataServiceUnfreezeBotProcedure:
 			gameMetadataServiceUnfreezeBotHandler.ServeHTTP(w, r)
+		case GameMetadataServiceGetScoutingReportProcedure:
+			gameMetadataServiceGetScoutingReportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -358,3 +387,7 @@ func (UnimplementedGameMetadataServiceHandler) GetRecentCorrespondenceGames(cont
 func (UnimplementedGameMetadataServiceHandler) UnfreezeBot(context.Context, *connect.Request[game_service.UnfreezeBotRequest]) (*connect.Response[game_service.UnfreezeBotResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game_service.GameMetadataService.UnfreezeBot is not implemented"))
 }
+
+func (UnimplementedGameMetadataServiceHandler) GetScoutingReport(context.Context, *connect.Request[game_service.ScoutingReportRequest]) (*connect.Response[game_service.ScoutingReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game_service.GameMetadataService.GetScoutingReport is not implemented"))
+}
@@ -129,6 +129,18 @@ const (
 	WooglesError_PUZZLE_GET_ANSWER_NOT_YET_RATED                        WooglesError = 1087
 	WooglesError_USER_UPDATE_NOT_FOUND                                  WooglesError = 1088
 	WooglesError_GAME_NO_LONGER_AVAILABLE                               WooglesError = 1089
+	WooglesError_PUZZLE_REVIEW_NOT_FOUND                                WooglesError = 1105
+	WooglesError_PUZZLE_SET_NOT_FOUND                                   WooglesError = 1106
+	WooglesError_PUZZLE_SET_INVALID_PUZZLES                             WooglesError = 1107
+	WooglesError_PUZZLE_RUSH_NOT_FOUND                                  WooglesError = 1108
+	WooglesError_PUZZLE_RUSH_ENDED                                      WooglesError = 1109
+	WooglesError_PUZZLE_RUSH_INVALID_DURATION                           WooglesError = 1110
+	WooglesError_PUZZLE_RUSH_NO_PUZZLES                                 WooglesError = 1111
+	WooglesError_PUZZLE_RUSH_ALREADY_ANSWERED                           WooglesError = 1112
+	WooglesError_DAILY_PUZZLE_NOT_FOUND                                 WooglesError = 1113
+	WooglesError_DAILY_PUZZLE_ALREADY_ANSWERED                          WooglesError = 1114
+	WooglesError_DAILY_PUZZLE_INVALID_DATE                              WooglesError = 1115
+	WooglesError_DAILY_PUZZLE_INVALID_PUZZLE                            WooglesError = 1116
 )
 
 // Enum value maps for WooglesError.
@@ -239,6 +251,18 @@ var (
 		1087: "PUZZLE_GET_ANSWER_NOT_YET_RATED",
 		1088: "USER_UPDATE_NOT_FOUND",
 		1089: "GAME_NO_LONGER_AVAILABLE",
+		1105: "PUZZLE_REVIEW_NOT_FOUND",
+		1106: "PUZZLE_SET_NOT_FOUND",
+		1107: "PUZZLE_SET_INVALID_PUZZLES",
+		1108: "PUZZLE_RUSH_NOT_FOUND",
+		1109: "PUZZLE_RUSH_ENDED",
+		1110: "PUZZLE_RUSH_INVALID_DURATION",
+		1111: "PUZZLE_RUSH_NO_PUZZLES",
+		1112: "PUZZLE_RUSH_ALREADY_ANSWERED",
+		1113: "DAILY_PUZZLE_NOT_FOUND",
+		1114: "DAILY_PUZZLE_ALREADY_ANSWERED",
+		1115: "DAILY_PUZZLE_INVALID_DATE",
+		1116: "DAILY_PUZZLE_INVALID_PUZZLE",
 	}
 	WooglesError_value = map[string]int32{
 		"DEFAULT":                                                0,
@@ -346,6 +370,18 @@ var (
 		"PUZZLE_GET_ANSWER_NOT_YET_RATED":                        1087,
 		"USER_UPDATE_NOT_FOUND":                                  1088,
 		"GAME_NO_LONGER_AVAILABLE":                               1089,
+		"PUZZLE_REVIEW_NOT_FOUND":                                1105,
+		"PUZZLE_SET_NOT_FOUND":                                   1106,
+		"PUZZLE_SET_INVALID_PUZZLES":                             1107,
+		"PUZZLE_RUSH_NOT_FOUND":                                  1108,
+		"PUZZLE_RUSH_ENDED":                                      1109,
+		"PUZZLE_RUSH_INVALID_DURATION":                           1110,
+		"PUZZLE_RUSH_NO_PUZZLES":                                 1111,
+		"PUZZLE_RUSH_ALREADY_ANSWERED":                           1112,
+		"DAILY_PUZZLE_NOT_FOUND":                                 1113,
+		"DAILY_PUZZLE_ALREADY_ANSWERED":                          1114,
+		"DAILY_PUZZLE_INVALID_DATE":                              1115,
+		"DAILY_PUZZLE_INVALID_PUZZLE":                            1116,
 	}
 )
 
@@ -426,7 +462,7 @@ const file_proto_ipc_errors_proto_rawDesc = "" +
 	"\n" +
 	"\x16proto/ipc/errors.proto\x12\x03ipc\"(\n" +
 	"\fErrorMessage\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage*\xb5 \n" +
+	"\amessage\x18\x01 \x01(\tR\amessage*\xa9#\n" +
 	"\fWooglesError\x12\v\n" +
 	"\aDEFAULT\x10\x00\x12*\n" +
 	"%TOURNAMENT_NEGATIVE_MAX_BYE_PLACEMENT\x10\xe9\a\x12&\n" +
@@ -532,7 +568,19 @@ const file_proto_ipc_errors_proto_rawDesc = "" +
 	" PUZZLE_GET_PUZZLE_UPDATE_ATTEMPT\x10\xbe\b\x12$\n" +
 	"\x1fPUZZLE_GET_ANSWER_NOT_YET_RATED\x10\xbf\b\x12\x1a\n" +
 	"\x15USER_UPDATE_NOT_FOUND\x10\xc0\b\x12\x1d\n" +
-	"\x18GAME_NO_LONGER_AVAILABLE\x10\xc1\bBs\n" +
+	"\x18GAME_NO_LONGER_AVAILABLE\x10\xc1\b\x12\x1c\n" +
+	"\x17PUZZLE_REVIEW_NOT_FOUND\x10\xd1\b\x12\x19\n" +
+	"\x14PUZZLE_SET_NOT_FOUND\x10\xd2\b\x12\x1f\n" +
+	"\x1aPUZZLE_SET_INVALID_PUZZLES\x10\xd3\b\x12\x1a\n" +
+	"\x15PUZZLE_RUSH_NOT_FOUND\x10\xd4\b\x12\x16\n" +
+	"\x11PUZZLE_RUSH_ENDED\x10\xd5\b\x12!\n" +
+	"\x1cPUZZLE_RUSH_INVALID_DURATION\x10\xd6\b\x12\x1b\n" +
+	"\x16PUZZLE_RUSH_NO_PUZZLES\x10\xd7\b\x12!\n" +
+	"\x1cPUZZLE_RUSH_ALREADY_ANSWERED\x10\xd8\b\x12\x1b\n" +
+	"\x16DAILY_PUZZLE_NOT_FOUND\x10\xd9\b\x12\"\n" +
+	"\x1dDAILY_PUZZLE_ALREADY_ANSWERED\x10\xda\b\x12\x1e\n" +
+	"\x19DAILY_PUZZLE_INVALID_DATE\x10\xdb\b\x12 \n" +
+	"\x1bDAILY_PUZZLE_INVALID_PUZZLE\x10\xdc\bBs\n" +
 	"\acom.ipcB\vErrorsProtoP\x01Z/github.com/woogles-io/liwords/rpc/api/proto/ipc\xa2\x02\x03IXX\xaa\x02\x03Ipc\xca\x02\x03Ipc\xe2\x02\x0fIpc\\GPBMetadata\xea\x02\x03Ipcb\x06proto3"
 
 var (
@@ -247,6 +247,57 @@ func (PlacementStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_ipc_league_proto_rawDescGZIP(), []int{3}
 }
 
+// SubstituteResultHandling decides how the completed games of a player who
+// was replaced by a substitute count in the division standings
+type SubstituteResultHandling int32
+
+const (
+	SubstituteResultHandling_SUB_KEEP_RESULTS     SubstituteResultHandling = 0 // Opponents keep their results; the substitute starts afresh
+	SubstituteResultHandling_SUB_VOID_RESULTS     SubstituteResultHandling = 1 // The games no longer count for anyone
+	SubstituteResultHandling_SUB_TRANSFER_RESULTS SubstituteResultHandling = 2 // The substitute inherits the dropped player's record
+)
+
+// Enum value maps for SubstituteResultHandling.
+var (
+	SubstituteResultHandling_name = map[int32]string{
+		0: "SUB_KEEP_RESULTS",
+		1: "SUB_VOID_RESULTS",
+		2: "SUB_TRANSFER_RESULTS",
+	}
+	SubstituteResultHandling_value = map[string]int32{
+		"SUB_KEEP_RESULTS":     0,
+		"SUB_VOID_RESULTS":     1,
+		"SUB_TRANSFER_RESULTS": 2,
+	}
+)
+
+func (x SubstituteResultHandling) Enum() *SubstituteResultHandling {
+	p := new(SubstituteResultHandling)
+	*p = x
+	return p
+}
+
+func (x SubstituteResultHandling) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubstituteResultHandling) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ipc_league_proto_enumTypes[4].Descriptor()
+}
+
+func (SubstituteResultHandling) Type() protoreflect.EnumType {
+	return &file_proto_ipc_league_proto_enumTypes[4]
+}
+
+func (x SubstituteResultHandling) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubstituteResultHandling.Descriptor instead.
+func (SubstituteResultHandling) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{4}
+}
+
 type League struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
@@ -347,8 +398,23 @@ type LeagueSettings struct {
 	Variant           string                 `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`
 	IdealDivisionSize int32                  `protobuf:"varint,6,opt,name=ideal_division_size,json=idealDivisionSize,proto3" json:"ideal_division_size,omitempty"`
 	ChallengeRule     ChallengeRule          `protobuf:"varint,9,opt,name=challenge_rule,json=challengeRule,proto3,enum=ipc.ChallengeRule" json:"challenge_rule,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// How many times the division's round robin is played; 0 or 1 is a single
+	// round robin and 2 a double round robin. Later cycles swap who goes first.
+	RoundRobins int32 `protobuf:"varint,10,opt,name=round_robins,json=roundRobins,proto3" json:"round_robins,omitempty"`
+	// How many games each pairing plays per round robin, alternating who goes
+	// first. 0 means 1.
+	GamesPerPairing int32 `protobuf:"varint,11,opt,name=games_per_pairing,json=gamesPerPairing,proto3" json:"games_per_pairing,omitempty"`
+	// If set, the top playoff_size players of each division (2, 4 or 8) play
+	// a single-elimination playoff that decides their final order.
+	PlayoffSize int32 `protobuf:"varint,12,opt,name=playoff_size,json=playoffSize,proto3" json:"playoff_size,omitempty"`
+	// How many days at the end of the season are set aside for the playoff.
+	PlayoffDays int32 `protobuf:"varint,13,opt,name=playoff_days,json=playoffDays,proto3" json:"playoff_days,omitempty"`
+	// If set, the league is played live: instead of creating every game at
+	// the start of the season as a correspondence game, players are given
+	// matchups in weekly windows and schedule a time to play each one.
+	Live          *LiveSettings `protobuf:"bytes,14,opt,name=live,proto3" json:"live,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LeagueSettings) Reset() {
@@ -423,6 +489,120 @@ func (x *LeagueSettings) GetChallengeRule() ChallengeRule {
 	return ChallengeRule_ChallengeRule_VOID
 }
 
+func (x *LeagueSettings) GetRoundRobins() int32 {
+	if x != nil {
+		return x.RoundRobins
+	}
+	return 0
+}
+
+func (x *LeagueSettings) GetGamesPerPairing() int32 {
+	if x != nil {
+		return x.GamesPerPairing
+	}
+	return 0
+}
+
+func (x *LeagueSettings) GetPlayoffSize() int32 {
+	if x != nil {
+		return x.PlayoffSize
+	}
+	return 0
+}
+
+func (x *LeagueSettings) GetPlayoffDays() int32 {
+	if x != nil {
+		return x.PlayoffDays
+	}
+	return 0
+}
+
+func (x *LeagueSettings) GetLive() *LiveSettings {
+	if x != nil {
+		return x.Live
+	}
+	return nil
+}
+
+type LiveSettings struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	InitialTimeMinutes int32                  `protobuf:"varint,1,opt,name=initial_time_minutes,json=initialTimeMinutes,proto3" json:"initial_time_minutes,omitempty"`
+	IncrementSeconds   int32                  `protobuf:"varint,2,opt,name=increment_seconds,json=incrementSeconds,proto3" json:"increment_seconds,omitempty"`
+	MaxOvertimeMinutes int32                  `protobuf:"varint,3,opt,name=max_overtime_minutes,json=maxOvertimeMinutes,proto3" json:"max_overtime_minutes,omitempty"`
+	// Length of each match window; 0 means 7 days.
+	MatchWindowDays int32 `protobuf:"varint,4,opt,name=match_window_days,json=matchWindowDays,proto3" json:"match_window_days,omitempty"`
+	// How many hours before a window closes, or before an agreed time, the
+	// players are reminded; 0 means 24.
+	ReminderHours int32 `protobuf:"varint,5,opt,name=reminder_hours,json=reminderHours,proto3" json:"reminder_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiveSettings) Reset() {
+	*x = LiveSettings{}
+	mi := &file_proto_ipc_league_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveSettings) ProtoMessage() {}
+
+func (x *LiveSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ipc_league_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveSettings.ProtoReflect.Descriptor instead.
+func (*LiveSettings) Descriptor() ([]byte, []int) {
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LiveSettings) GetInitialTimeMinutes() int32 {
+	if x != nil {
+		return x.InitialTimeMinutes
+	}
+	return 0
+}
+
+func (x *LiveSettings) GetIncrementSeconds() int32 {
+	if x != nil {
+		return x.IncrementSeconds
+	}
+	return 0
+}
+
+func (x *LiveSettings) GetMaxOvertimeMinutes() int32 {
+	if x != nil {
+		return x.MaxOvertimeMinutes
+	}
+	return 0
+}
+
+func (x *LiveSettings) GetMatchWindowDays() int32 {
+	if x != nil {
+		return x.MatchWindowDays
+	}
+	return 0
+}
+
+func (x *LiveSettings) GetReminderHours() int32 {
+	if x != nil {
+		return x.ReminderHours
+	}
+	return 0
+}
+
 type TimeControl struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	IncrementSeconds int32                  `protobuf:"varint,1,opt,name=increment_seconds,json=incrementSeconds,proto3" json:"increment_seconds,omitempty"`
@@ -433,7 +613,7 @@ type TimeControl struct {
 
 func (x *TimeControl) Reset() {
 	*x = TimeControl{}
-	mi := &file_proto_ipc_league_proto_msgTypes[2]
+	mi := &file_proto_ipc_league_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +625,7 @@ func (x *TimeControl) String() string {
 func (*TimeControl) ProtoMessage() {}
 
 func (x *TimeControl) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[2]
+	mi := &file_proto_ipc_league_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +638,7 @@ func (x *TimeControl) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeControl.ProtoReflect.Descriptor instead.
 func (*TimeControl) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{2}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TimeControl) GetIncrementSeconds() int32 {
@@ -492,7 +672,7 @@ type Season struct {
 
 func (x *Season) Reset() {
 	*x = Season{}
-	mi := &file_proto_ipc_league_proto_msgTypes[3]
+	mi := &file_proto_ipc_league_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -504,7 +684,7 @@ func (x *Season) String() string {
 func (*Season) ProtoMessage() {}
 
 func (x *Season) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[3]
+	mi := &file_proto_ipc_league_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -517,7 +697,7 @@ func (x *Season) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Season.ProtoReflect.Descriptor instead.
 func (*Season) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{3}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Season) GetUuid() string {
@@ -599,7 +779,7 @@ type Division struct {
 
 func (x *Division) Reset() {
 	*x = Division{}
-	mi := &file_proto_ipc_league_proto_msgTypes[4]
+	mi := &file_proto_ipc_league_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +791,7 @@ func (x *Division) String() string {
 func (*Division) ProtoMessage() {}
 
 func (x *Division) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[4]
+	mi := &file_proto_ipc_league_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +804,7 @@ func (x *Division) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Division.ProtoReflect.Descriptor instead.
 func (*Division) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{4}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Division) GetUuid() string {
@@ -697,7 +877,7 @@ type PlayerRegistration struct {
 
 func (x *PlayerRegistration) Reset() {
 	*x = PlayerRegistration{}
-	mi := &file_proto_ipc_league_proto_msgTypes[5]
+	mi := &file_proto_ipc_league_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -709,7 +889,7 @@ func (x *PlayerRegistration) String() string {
 func (*PlayerRegistration) ProtoMessage() {}
 
 func (x *PlayerRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[5]
+	mi := &file_proto_ipc_league_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,7 +902,7 @@ func (x *PlayerRegistration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerRegistration.ProtoReflect.Descriptor instead.
 func (*PlayerRegistration) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{5}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PlayerRegistration) GetUserId() string {
@@ -796,13 +976,21 @@ type LeaguePlayerStanding struct {
 	GamesAnalyzed            int32           `protobuf:"varint,24,opt,name=games_analyzed,json=gamesAnalyzed,proto3" json:"games_analyzed,omitempty"`                                      // Number of games with completed BestBot analysis
 	BestRank                 int32           `protobuf:"varint,25,opt,name=best_rank,json=bestRank,proto3" json:"best_rank,omitempty"`                                                     // Best possible finishing rank (computed from remaining pairings)
 	WorstRank                int32           `protobuf:"varint,26,opt,name=worst_rank,json=worstRank,proto3" json:"worst_rank,omitempty"`                                                  // Worst possible finishing rank (computed from remaining pairings)
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	PlayoffSeed              int32           `protobuf:"varint,27,opt,name=playoff_seed,json=playoffSeed,proto3" json:"playoff_seed,omitempty"`                                            // Seed in the division playoff; 0 if not in it
+	PlayoffEliminated        bool            `protobuf:"varint,28,opt,name=playoff_eliminated,json=playoffEliminated,proto3" json:"playoff_eliminated,omitempty"`                          // Whether the player is out of the playoff
+	// Forecast chances of each season outcome, simulated over the remaining
+	// games. They add up to 1 and are all 0 for a finished season.
+	PromotionProbability  float64 `protobuf:"fixed64,29,opt,name=promotion_probability,json=promotionProbability,proto3" json:"promotion_probability,omitempty"`
+	StayProbability       float64 `protobuf:"fixed64,30,opt,name=stay_probability,json=stayProbability,proto3" json:"stay_probability,omitempty"`
+	RelegationProbability float64 `protobuf:"fixed64,31,opt,name=relegation_probability,json=relegationProbability,proto3" json:"relegation_probability,omitempty"`
+	ChampionProbability   float64 `protobuf:"fixed64,32,opt,name=champion_probability,json=championProbability,proto3" json:"champion_probability,omitempty"` // Only in the top division
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *LeaguePlayerStanding) Reset() {
 	*x = LeaguePlayerStanding{}
-	mi := &file_proto_ipc_league_proto_msgTypes[6]
+	mi := &file_proto_ipc_league_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -814,7 +1002,7 @@ func (x *LeaguePlayerStanding) String() string {
 func (*LeaguePlayerStanding) ProtoMessage() {}
 
 func (x *LeaguePlayerStanding) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[6]
+	mi := &file_proto_ipc_league_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1015,7 @@ func (x *LeaguePlayerStanding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaguePlayerStanding.ProtoReflect.Descriptor instead.
 func (*LeaguePlayerStanding) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{6}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LeaguePlayerStanding) GetUserId() string {
@@ -1012,6 +1200,48 @@ func (x *LeaguePlayerStanding) GetWorstRank() int32 {
 	return 0
 }
 
+func (x *LeaguePlayerStanding) GetPlayoffSeed() int32 {
+	if x != nil {
+		return x.PlayoffSeed
+	}
+	return 0
+}
+
+func (x *LeaguePlayerStanding) GetPlayoffEliminated() bool {
+	if x != nil {
+		return x.PlayoffEliminated
+	}
+	return false
+}
+
+func (x *LeaguePlayerStanding) GetPromotionProbability() float64 {
+	if x != nil {
+		return x.PromotionProbability
+	}
+	return 0
+}
+
+func (x *LeaguePlayerStanding) GetStayProbability() float64 {
+	if x != nil {
+		return x.StayProbability
+	}
+	return 0
+}
+
+func (x *LeaguePlayerStanding) GetRelegationProbability() float64 {
+	if x != nil {
+		return x.RelegationProbability
+	}
+	return 0
+}
+
+func (x *LeaguePlayerStanding) GetChampionProbability() float64 {
+	if x != nil {
+		return x.ChampionProbability
+	}
+	return 0
+}
+
 type TimeBankWarning struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1023,7 +1253,7 @@ type TimeBankWarning struct {
 
 func (x *TimeBankWarning) Reset() {
 	*x = TimeBankWarning{}
-	mi := &file_proto_ipc_league_proto_msgTypes[7]
+	mi := &file_proto_ipc_league_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1035,7 +1265,7 @@ func (x *TimeBankWarning) String() string {
 func (*TimeBankWarning) ProtoMessage() {}
 
 func (x *TimeBankWarning) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[7]
+	mi := &file_proto_ipc_league_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,7 +1278,7 @@ func (x *TimeBankWarning) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeBankWarning.ProtoReflect.Descriptor instead.
 func (*TimeBankWarning) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{7}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TimeBankWarning) GetUserId() string {
@@ -1082,7 +1312,7 @@ type GetDivisionTimeBankWarningsRequest struct {
 
 func (x *GetDivisionTimeBankWarningsRequest) Reset() {
 	*x = GetDivisionTimeBankWarningsRequest{}
-	mi := &file_proto_ipc_league_proto_msgTypes[8]
+	mi := &file_proto_ipc_league_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1094,7 +1324,7 @@ func (x *GetDivisionTimeBankWarningsRequest) String() string {
 func (*GetDivisionTimeBankWarningsRequest) ProtoMessage() {}
 
 func (x *GetDivisionTimeBankWarningsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[8]
+	mi := &file_proto_ipc_league_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1337,7 @@ func (x *GetDivisionTimeBankWarningsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetDivisionTimeBankWarningsRequest.ProtoReflect.Descriptor instead.
 func (*GetDivisionTimeBankWarningsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{8}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetDivisionTimeBankWarningsRequest) GetDivisionId() string {
@@ -1133,7 +1363,7 @@ type GetDivisionTimeBankWarningsResponse struct {
 
 func (x *GetDivisionTimeBankWarningsResponse) Reset() {
 	*x = GetDivisionTimeBankWarningsResponse{}
-	mi := &file_proto_ipc_league_proto_msgTypes[9]
+	mi := &file_proto_ipc_league_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1145,7 +1375,7 @@ func (x *GetDivisionTimeBankWarningsResponse) String() string {
 func (*GetDivisionTimeBankWarningsResponse) ProtoMessage() {}
 
 func (x *GetDivisionTimeBankWarningsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ipc_league_proto_msgTypes[9]
+	mi := &file_proto_ipc_league_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1388,7 @@ func (x *GetDivisionTimeBankWarningsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetDivisionTimeBankWarningsResponse.ProtoReflect.Descriptor instead.
 func (*GetDivisionTimeBankWarningsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_ipc_league_proto_rawDescGZIP(), []int{9}
+	return file_proto_ipc_league_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetDivisionTimeBankWarningsResponse) GetWarnings() []*TimeBankWarning {
@@ -1180,14 +1410,26 @@ const file_proto_ipc_league_proto_rawDesc = "" +
 	"\x04slug\x18\x04 \x01(\tR\x04slug\x12/\n" +
 	"\bsettings\x18\x05 \x01(\v2\x13.ipc.LeagueSettingsR\bsettings\x12*\n" +
 	"\x11current_season_id\x18\x06 \x01(\tR\x0fcurrentSeasonId\x12\x1b\n" +
-	"\tis_active\x18\a \x01(\bR\bisActive\"\x92\x02\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\"\xce\x03\n" +
 	"\x0eLeagueSettings\x12,\n" +
 	"\x12season_length_days\x18\x01 \x01(\x05R\x10seasonLengthDays\x123\n" +
 	"\ftime_control\x18\x03 \x01(\v2\x10.ipc.TimeControlR\vtimeControl\x12\x18\n" +
 	"\alexicon\x18\x04 \x01(\tR\alexicon\x12\x18\n" +
 	"\avariant\x18\x05 \x01(\tR\avariant\x12.\n" +
 	"\x13ideal_division_size\x18\x06 \x01(\x05R\x11idealDivisionSize\x129\n" +
-	"\x0echallenge_rule\x18\t \x01(\x0e2\x12.ipc.ChallengeRuleR\rchallengeRule\"f\n" +
+	"\x0echallenge_rule\x18\t \x01(\x0e2\x12.ipc.ChallengeRuleR\rchallengeRule\x12!\n" +
+	"\fround_robins\x18\n" +
+	" \x01(\x05R\vroundRobins\x12*\n" +
+	"\x11games_per_pairing\x18\v \x01(\x05R\x0fgamesPerPairing\x12!\n" +
+	"\fplayoff_size\x18\f \x01(\x05R\vplayoffSize\x12!\n" +
+	"\fplayoff_days\x18\r \x01(\x05R\vplayoffDays\x12%\n" +
+	"\x04live\x18\x0e \x01(\v2\x11.ipc.LiveSettingsR\x04live\"\xf2\x01\n" +
+	"\fLiveSettings\x120\n" +
+	"\x14initial_time_minutes\x18\x01 \x01(\x05R\x12initialTimeMinutes\x12+\n" +
+	"\x11increment_seconds\x18\x02 \x01(\x05R\x10incrementSeconds\x120\n" +
+	"\x14max_overtime_minutes\x18\x03 \x01(\x05R\x12maxOvertimeMinutes\x12*\n" +
+	"\x11match_window_days\x18\x04 \x01(\x05R\x0fmatchWindowDays\x12%\n" +
+	"\x0ereminder_hours\x18\x05 \x01(\x05R\rreminderHours\"f\n" +
 	"\vTimeControl\x12+\n" +
 	"\x11increment_seconds\x18\x01 \x01(\x05R\x10incrementSeconds\x12*\n" +
 	"\x11time_bank_minutes\x18\x02 \x01(\x05R\x0ftimeBankMinutes\"\xb0\x03\n" +
@@ -1219,7 +1461,7 @@ const file_proto_ipc_league_proto_rawDesc = "" +
 	"divisionId\x12G\n" +
 	"\x11registration_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12!\n" +
 	"\ffirsts_count\x18\x05 \x01(\x05R\vfirstsCount\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\"\xb5\a\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"\xd1\t\n" +
 	"\x14LeaguePlayerStanding\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
@@ -1250,7 +1492,13 @@ const file_proto_ipc_league_proto_rawDesc = "" +
 	"\x0egames_analyzed\x18\x18 \x01(\x05R\rgamesAnalyzed\x12\x1b\n" +
 	"\tbest_rank\x18\x19 \x01(\x05R\bbestRank\x12\x1d\n" +
 	"\n" +
-	"worst_rank\x18\x1a \x01(\x05R\tworstRank\"}\n" +
+	"worst_rank\x18\x1a \x01(\x05R\tworstRank\x12!\n" +
+	"\fplayoff_seed\x18\x1b \x01(\x05R\vplayoffSeed\x12-\n" +
+	"\x12playoff_eliminated\x18\x1c \x01(\bR\x11playoffEliminated\x123\n" +
+	"\x15promotion_probability\x18\x1d \x01(\x01R\x14promotionProbability\x12)\n" +
+	"\x10stay_probability\x18\x1e \x01(\x01R\x0fstayProbability\x125\n" +
+	"\x16relegation_probability\x18\x1f \x01(\x01R\x15relegationProbability\x121\n" +
+	"\x14champion_probability\x18  \x01(\x01R\x13championProbability\"}\n" +
 	"\x0fTimeBankWarning\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x125\n" +
@@ -1285,7 +1533,11 @@ const file_proto_ipc_league_proto_rawDesc = "" +
 	"\x13PLACEMENT_RELEGATED\x10\x04\x12\x14\n" +
 	"\x10PLACEMENT_STAYED\x10\x05\x12$\n" +
 	" PLACEMENT_SHORT_HIATUS_RETURNING\x10\x06\x12#\n" +
-	"\x1fPLACEMENT_LONG_HIATUS_RETURNING\x10\aBs\n" +
+	"\x1fPLACEMENT_LONG_HIATUS_RETURNING\x10\a*`\n" +
+	"\x18SubstituteResultHandling\x12\x14\n" +
+	"\x10SUB_KEEP_RESULTS\x10\x00\x12\x14\n" +
+	"\x10SUB_VOID_RESULTS\x10\x01\x12\x18\n" +
+	"\x14SUB_TRANSFER_RESULTS\x10\x02Bs\n" +
 	"\acom.ipcB\vLeagueProtoP\x01Z/github.com/woogles-io/liwords/rpc/api/proto/ipc\xa2\x02\x03IXX\xaa\x02\x03Ipc\xca\x02\x03Ipc\xe2\x02\x0fIpc\\GPBMetadata\xea\x02\x03Ipcb\x06proto3"
 
 var (
@@ -1300,47 +1552,50 @@ func file_proto_ipc_league_proto_rawDescGZIP() []byte {
 	return file_proto_ipc_league_proto_rawDescData
 }
 
-var file_proto_ipc_league_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_ipc_league_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_ipc_league_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_ipc_league_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_proto_ipc_league_proto_goTypes = []any{
 	(SeasonStatus)(0),                           // 0: ipc.SeasonStatus
 	(PromotionFormula)(0),                       // 1: ipc.PromotionFormula
 	(StandingResult)(0),                         // 2: ipc.StandingResult
 	(PlacementStatus)(0),                        // 3: ipc.PlacementStatus
-	(*League)(nil),                              // 4: ipc.League
-	(*LeagueSettings)(nil),                      // 5: ipc.LeagueSettings
-	(*TimeControl)(nil),                         // 6: ipc.TimeControl
-	(*Season)(nil),                              // 7: ipc.Season
-	(*Division)(nil),                            // 8: ipc.Division
-	(*PlayerRegistration)(nil),                  // 9: ipc.PlayerRegistration
-	(*LeaguePlayerStanding)(nil),                // 10: ipc.LeaguePlayerStanding
-	(*TimeBankWarning)(nil),                     // 11: ipc.TimeBankWarning
-	(*GetDivisionTimeBankWarningsRequest)(nil),  // 12: ipc.GetDivisionTimeBankWarningsRequest
-	(*GetDivisionTimeBankWarningsResponse)(nil), // 13: ipc.GetDivisionTimeBankWarningsResponse
-	(ChallengeRule)(0),                          // 14: ipc.ChallengeRule
-	(*timestamppb.Timestamp)(nil),               // 15: google.protobuf.Timestamp
+	(SubstituteResultHandling)(0),               // 4: ipc.SubstituteResultHandling
+	(*League)(nil),                              // 5: ipc.League
+	(*LeagueSettings)(nil),                      // 6: ipc.LeagueSettings
+	(*LiveSettings)(nil),                        // 7: ipc.LiveSettings
+	(*TimeControl)(nil),                         // 8: ipc.TimeControl
+	(*Season)(nil),                              // 9: ipc.Season
+	(*Division)(nil),                            // 10: ipc.Division
+	(*PlayerRegistration)(nil),                  // 11: ipc.PlayerRegistration
+	(*LeaguePlayerStanding)(nil),                // 12: ipc.LeaguePlayerStanding
+	(*TimeBankWarning)(nil),                     // 13: ipc.TimeBankWarning
+	(*GetDivisionTimeBankWarningsRequest)(nil),  // 14: ipc.GetDivisionTimeBankWarningsRequest
+	(*GetDivisionTimeBankWarningsResponse)(nil), // 15: ipc.GetDivisionTimeBankWarningsResponse
+	(ChallengeRule)(0),                          // 16: ipc.ChallengeRule
+	(*timestamppb.Timestamp)(nil),               // 17: google.protobuf.Timestamp
 }
 var file_proto_ipc_league_proto_depIdxs = []int32{
-	5,  // 0: ipc.League.settings:type_name -> ipc.LeagueSettings
-	6,  // 1: ipc.LeagueSettings.time_control:type_name -> ipc.TimeControl
-	14, // 2: ipc.LeagueSettings.challenge_rule:type_name -> ipc.ChallengeRule
-	15, // 3: ipc.Season.start_date:type_name -> google.protobuf.Timestamp
-	15, // 4: ipc.Season.end_date:type_name -> google.protobuf.Timestamp
-	15, // 5: ipc.Season.actual_end_date:type_name -> google.protobuf.Timestamp
-	0,  // 6: ipc.Season.status:type_name -> ipc.SeasonStatus
-	8,  // 7: ipc.Season.divisions:type_name -> ipc.Division
-	1,  // 8: ipc.Season.promotion_formula:type_name -> ipc.PromotionFormula
-	9,  // 9: ipc.Division.players:type_name -> ipc.PlayerRegistration
-	10, // 10: ipc.Division.standings:type_name -> ipc.LeaguePlayerStanding
-	15, // 11: ipc.PlayerRegistration.registration_date:type_name -> google.protobuf.Timestamp
-	2,  // 12: ipc.LeaguePlayerStanding.result:type_name -> ipc.StandingResult
-	3,  // 13: ipc.LeaguePlayerStanding.placement_status:type_name -> ipc.PlacementStatus
-	11, // 14: ipc.GetDivisionTimeBankWarningsResponse.warnings:type_name -> ipc.TimeBankWarning
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	6,  // 0: ipc.League.settings:type_name -> ipc.LeagueSettings
+	8,  // 1: ipc.LeagueSettings.time_control:type_name -> ipc.TimeControl
+	16, // 2: ipc.LeagueSettings.challenge_rule:type_name -> ipc.ChallengeRule
+	7,  // 3: ipc.LeagueSettings.live:type_name -> ipc.LiveSettings
+	17, // 4: ipc.Season.start_date:type_name -> google.protobuf.Timestamp
+	17, // 5: ipc.Season.end_date:type_name -> google.protobuf.Timestamp
+	17, // 6: ipc.Season.actual_end_date:type_name -> google.protobuf.Timestamp
+	0,  // 7: ipc.Season.status:type_name -> ipc.SeasonStatus
+	10, // 8: ipc.Season.divisions:type_name -> ipc.Division
+	1,  // 9: ipc.Season.promotion_formula:type_name -> ipc.PromotionFormula
+	11, // 10: ipc.Division.players:type_name -> ipc.PlayerRegistration
+	12, // 11: ipc.Division.standings:type_name -> ipc.LeaguePlayerStanding
+	17, // 12: ipc.PlayerRegistration.registration_date:type_name -> google.protobuf.Timestamp
+	2,  // 13: ipc.LeaguePlayerStanding.result:type_name -> ipc.StandingResult
+	3,  // 14: ipc.LeaguePlayerStanding.placement_status:type_name -> ipc.PlacementStatus
+	13, // 15: ipc.GetDivisionTimeBankWarningsResponse.warnings:type_name -> ipc.TimeBankWarning
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_proto_ipc_league_proto_init() }
@@ -1354,8 +1609,8 @@ func file_proto_ipc_league_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ipc_league_proto_rawDesc), len(file_proto_ipc_league_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   10,
+			NumEnums:      5,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -73,6 +73,113 @@ func (TimeBankScope) EnumDescriptor() ([]byte, []int) {
 	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{0}
 }
 
+type LeagueMatchStatus int32
+
+const (
+	LeagueMatchStatus_MATCH_PENDING   LeagueMatchStatus = 0 // No time agreed yet
+	LeagueMatchStatus_MATCH_SCHEDULED LeagueMatchStatus = 1 // A time slot was agreed
+	LeagueMatchStatus_MATCH_STARTED   LeagueMatchStatus = 2 // The game was created
+	LeagueMatchStatus_MATCH_PLAYED    LeagueMatchStatus = 3 // The game is over
+	LeagueMatchStatus_MATCH_FORFEITED LeagueMatchStatus = 4 // The window closed before the game was played
+)
+
+// Enum value maps for LeagueMatchStatus.
+var (
+	LeagueMatchStatus_name = map[int32]string{
+		0: "MATCH_PENDING",
+		1: "MATCH_SCHEDULED",
+		2: "MATCH_STARTED",
+		3: "MATCH_PLAYED",
+		4: "MATCH_FORFEITED",
+	}
+	LeagueMatchStatus_value = map[string]int32{
+		"MATCH_PENDING":   0,
+		"MATCH_SCHEDULED": 1,
+		"MATCH_STARTED":   2,
+		"MATCH_PLAYED":    3,
+		"MATCH_FORFEITED": 4,
+	}
+)
+
+func (x LeagueMatchStatus) Enum() *LeagueMatchStatus {
+	p := new(LeagueMatchStatus)
+	*p = x
+	return p
+}
+
+func (x LeagueMatchStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeagueMatchStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_league_service_league_service_proto_enumTypes[1].Descriptor()
+}
+
+func (LeagueMatchStatus) Type() protoreflect.EnumType {
+	return &file_proto_league_service_league_service_proto_enumTypes[1]
+}
+
+func (x LeagueMatchStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeagueMatchStatus.Descriptor instead.
+func (LeagueMatchStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{1}
+}
+
+type MatchProposalStatus int32
+
+const (
+	MatchProposalStatus_PROPOSAL_OPEN       MatchProposalStatus = 0
+	MatchProposalStatus_PROPOSAL_ACCEPTED   MatchProposalStatus = 1
+	MatchProposalStatus_PROPOSAL_DECLINED   MatchProposalStatus = 2
+	MatchProposalStatus_PROPOSAL_SUPERSEDED MatchProposalStatus = 3 // Another proposal for the match was accepted
+)
+
+// Enum value maps for MatchProposalStatus.
+var (
+	MatchProposalStatus_name = map[int32]string{
+		0: "PROPOSAL_OPEN",
+		1: "PROPOSAL_ACCEPTED",
+		2: "PROPOSAL_DECLINED",
+		3: "PROPOSAL_SUPERSEDED",
+	}
+	MatchProposalStatus_value = map[string]int32{
+		"PROPOSAL_OPEN":       0,
+		"PROPOSAL_ACCEPTED":   1,
+		"PROPOSAL_DECLINED":   2,
+		"PROPOSAL_SUPERSEDED": 3,
+	}
+)
+
+func (x MatchProposalStatus) Enum() *MatchProposalStatus {
+	p := new(MatchProposalStatus)
+	*p = x
+	return p
+}
+
+func (x MatchProposalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchProposalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_league_service_league_service_proto_enumTypes[2].Descriptor()
+}
+
+func (MatchProposalStatus) Type() protoreflect.EnumType {
+	return &file_proto_league_service_league_service_proto_enumTypes[2]
+}
+
+func (x MatchProposalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchProposalStatus.Descriptor instead.
+func (MatchProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{2}
+}
+
 type CreateLeagueRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -3424,209 +3531,1419 @@ func (x *H2HSeasonGame) GetGameEndReason() int32 {
 	return 0
 }
 
-var File_proto_league_service_league_service_proto protoreflect.FileDescriptor
+type MatchTimeProposal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProposerId       string                 `protobuf:"bytes,2,opt,name=proposer_id,json=proposerId,proto3" json:"proposer_id,omitempty"`
+	ProposerUsername string                 `protobuf:"bytes,3,opt,name=proposer_username,json=proposerUsername,proto3" json:"proposer_username,omitempty"`
+	Slot             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Status           MatchProposalStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=league_service.MatchProposalStatus" json:"status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
 
-const file_proto_league_service_league_service_proto_rawDesc = "" +
-	"\n" +
-	")proto/league_service/league_service.proto\x12\x0eleague_service\x1a\x18proto/ipc/omgwords.proto\x1a\x16proto/ipc/league.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\x01\n" +
-	"\x13CreateLeagueRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04slug\x18\x03 \x01(\tR\x04slug\x12/\n" +
-	"\bsettings\x18\x04 \x01(\v2\x13.ipc.LeagueSettingsR\bsettings\",\n" +
-	"\rLeagueRequest\x12\x1b\n" +
-	"\tleague_id\x18\x01 \x01(\tR\bleagueId\"7\n" +
-	"\x14GetAllLeaguesRequest\x12\x1f\n" +
-	"\vactive_only\x18\x01 \x01(\bR\n" +
-	"activeOnly\">\n" +
-	"\x15GetAllLeaguesResponse\x12%\n" +
-	"\aleagues\x18\x01 \x03(\v2\v.ipc.LeagueR\aleagues\"5\n" +
-	"\x0eLeagueResponse\x12#\n" +
-	"\x06league\x18\x01 \x01(\v2\v.ipc.LeagueR\x06league\"k\n" +
-	"\x1bUpdateLeagueSettingsRequest\x12\x1b\n" +
-	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12/\n" +
-	"\bsettings\x18\x02 \x01(\v2\x13.ipc.LeagueSettingsR\bsettings\"p\n" +
-	"\x1bUpdateLeagueMetadataRequest\x12\x1b\n" +
-	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\",\n" +
-	"\rSeasonRequest\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\"5\n" +
-	"\x0eSeasonResponse\x12#\n" +
-	"\x06season\x18\x01 \x01(\v2\v.ipc.SeasonR\x06season\"<\n" +
-	"\x13PastSeasonsResponse\x12%\n" +
-	"\aseasons\x18\x01 \x03(\v2\v.ipc.SeasonR\aseasons\";\n" +
-	"\x12AllSeasonsResponse\x12%\n" +
-	"\aseasons\x18\x01 \x03(\v2\v.ipc.SeasonR\aseasons\"L\n" +
-	"\x17GetRecentSeasonsRequest\x12\x1b\n" +
-	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\">\n" +
-	"\x15RecentSeasonsResponse\x12%\n" +
-	"\aseasons\x18\x01 \x03(\v2\v.ipc.SeasonR\aseasons\"\xd2\x01\n" +
-	"\x16BootstrapSeasonRequest\x12\x1b\n" +
-	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x129\n" +
-	"\n" +
-	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12)\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x11.ipc.SeasonStatusR\x06status\"S\n" +
-	"\x17OpenRegistrationRequest\x12\x1b\n" +
-	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x1b\n" +
-	"\tseason_id\x18\x02 \x01(\tR\bseasonId\"2\n" +
-	"\x0fDivisionRequest\x12\x1f\n" +
-	"\vdivision_id\x18\x01 \x01(\tR\n" +
-	"divisionId\"F\n" +
-	"\x19DivisionStandingsResponse\x12)\n" +
-	"\bdivision\x18\x01 \x01(\v2\r.ipc.DivisionR\bdivision\"K\n" +
-	"\x1cAllDivisionStandingsResponse\x12+\n" +
-	"\tdivisions\x18\x01 \x03(\v2\r.ipc.DivisionR\tdivisions\"d\n" +
-	"\x0fRegisterRequest\x12\x1b\n" +
-	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tseason_id\x18\x03 \x01(\tR\bseasonId\"I\n" +
-	"\x10RegisterResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
-	"\tseason_id\x18\x02 \x01(\tR\bseasonId\"I\n" +
-	"\x11UnregisterRequest\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\".\n" +
-	"\x12UnregisterResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"g\n" +
-	"\x1bSeasonRegistrationsResponse\x12H\n" +
-	"\rregistrations\x18\x01 \x03(\v2\".league_service.SeasonRegistrationR\rregistrations\"\xb0\x01\n" +
-	"\x12SeasonRegistration\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
-	"\tseason_id\x18\x03 \x01(\tR\bseasonId\x12\x1f\n" +
-	"\vdivision_id\x18\x04 \x01(\tR\n" +
-	"divisionId\x12'\n" +
-	"\x0fdivision_number\x18\x05 \x01(\x05R\x0edivisionNumber\"L\n" +
-	"\x14PlayerHistoryRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tleague_id\x18\x02 \x01(\tR\bleagueId\"P\n" +
-	"\x15PlayerHistoryResponse\x127\n" +
-	"\aseasons\x18\x01 \x03(\v2\x1d.league_service.SeasonSummaryR\aseasons\"\xd2\x01\n" +
-	"\rSeasonSummary\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12#\n" +
-	"\rseason_number\x18\x02 \x01(\x05R\fseasonNumber\x12\x1f\n" +
-	"\vleague_name\x18\x03 \x01(\tR\n" +
-	"leagueName\x12'\n" +
-	"\x0fdivision_number\x18\x04 \x01(\x05R\x0edivisionNumber\x125\n" +
-	"\bstanding\x18\x05 \x01(\v2\x19.ipc.LeaguePlayerStandingR\bstanding\"{\n" +
-	"\x14LeagueRosterResponse\x12<\n" +
-	"\aplayers\x18\x01 \x03(\v2\".league_service.LeagueRosterPlayerR\aplayers\x12%\n" +
-	"\x0eseason_numbers\x18\x02 \x03(\x05R\rseasonNumbers\"\x87\x01\n" +
-	"\x12LeagueRosterPlayer\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12<\n" +
-	"\aseasons\x18\x03 \x03(\v2\".league_service.LeagueRosterSeasonR\aseasons\"\xfd\x01\n" +
-	"\x12LeagueRosterSeason\x12#\n" +
-	"\rseason_number\x18\x01 \x01(\x05R\fseasonNumber\x12'\n" +
-	"\x0fdivision_number\x18\x02 \x01(\x05R\x0edivisionNumber\x12\x12\n" +
-	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x12\n" +
-	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x16\n" +
-	"\x06losses\x18\x05 \x01(\x05R\x06losses\x12\x14\n" +
-	"\x05draws\x18\x06 \x01(\x05R\x05draws\x12\x16\n" +
-	"\x06spread\x18\a \x01(\x05R\x06spread\x12+\n" +
-	"\x06result\x18\b \x01(\x0e2\x13.ipc.StandingResultR\x06result\"L\n" +
-	"\x18LeagueStatisticsResponse\x120\n" +
-	"\x05stats\x18\x01 \x03(\v2\x1a.league_service.LeagueStatR\x05stats\"\xb6\x01\n" +
-	"\n" +
-	"LeagueStat\x12\x1b\n" +
-	"\tstat_type\x18\x01 \x01(\tR\bstatType\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
-	"\x05value\x18\x04 \x01(\x05R\x05value\x12\x17\n" +
-	"\agame_id\x18\x05 \x01(\tR\x06gameId\x12'\n" +
-	"\x0fdivision_number\x18\x06 \x01(\x05R\x0edivisionNumber\"S\n" +
-	"\x1bGetPlayerSeasonGamesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tseason_id\x18\x02 \x01(\tR\bseasonId\"V\n" +
-	"\x1cGetPlayerSeasonGamesResponse\x126\n" +
-	"\x05games\x18\x01 \x03(\v2 .league_service.PlayerSeasonGameR\x05games\"\xbf\x04\n" +
-	"\x10PlayerSeasonGame\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12(\n" +
-	"\x10opponent_user_id\x18\x02 \x01(\tR\x0eopponentUserId\x12+\n" +
-	"\x11opponent_username\x18\x03 \x01(\tR\x10opponentUsername\x12!\n" +
-	"\fplayer_score\x18\x04 \x01(\x05R\vplayerScore\x12%\n" +
-	"\x0eopponent_score\x18\x05 \x01(\x05R\ropponentScore\x12\x16\n" +
-	"\x06result\x18\x06 \x01(\tR\x06result\x127\n" +
-	"\tgame_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bgameDate\x12\x14\n" +
-	"\x05round\x18\b \x01(\x05R\x05round\x12:\n" +
-	"\x0fgame_end_reason\x18\t \x01(\x0e2\x12.ipc.GameEndReasonR\rgameEndReason\x12;\n" +
-	"\vlast_update\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastUpdate\x12%\n" +
-	"\x0eincrement_secs\x18\v \x01(\x05R\rincrementSecs\x12.\n" +
-	"\x14on_turn_time_bank_ms\x18\f \x01(\x03R\x10onTurnTimeBankMs\x12(\n" +
-	"\rmistake_index\x18\r \x01(\x01H\x00R\fmistakeIndex\x88\x01\x01B\x10\n" +
-	"\x0e_mistake_index\",\n" +
-	"\x11InviteUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
-	"\x12InviteUserResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
-	"\x1bMovePlayerToDivisionRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12(\n" +
-	"\x10from_division_id\x18\x03 \x01(\tR\x0efromDivisionId\x12$\n" +
-	"\x0eto_division_id\x18\x04 \x01(\tR\ftoDivisionId\"R\n" +
-	"\x1cMovePlayerToDivisionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x82\x01\n" +
-	"\x15CreateDivisionRequest\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12'\n" +
-	"\x0fdivision_number\x18\x02 \x01(\x05R\x0edivisionNumber\x12#\n" +
-	"\rdivision_name\x18\x03 \x01(\tR\fdivisionName\"m\n" +
-	"\x16CreateDivisionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
-	"\vdivision_id\x18\x03 \x01(\tR\n" +
-	"divisionId\"U\n" +
-	"\x15DeleteDivisionRequest\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x1f\n" +
-	"\vdivision_id\x18\x02 \x01(\tR\n" +
-	"divisionId\"\x7f\n" +
-	"\x16DeleteDivisionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
-	"\x14divisions_renumbered\x18\x03 \x01(\x05R\x13divisionsRenumbered\"Q\n" +
-	"\x1bSeasonZeroMoveGamesResponse\x122\n" +
-	"\x05games\x18\x01 \x03(\v2\x1c.league_service.ZeroMoveGameR\x05games\"\x97\x02\n" +
-	"\fZeroMoveGame\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\tR\x06gameId\x129\n" +
-	"\n" +
-	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"player0_id\x18\x03 \x01(\tR\tplayer0Id\x12)\n" +
-	"\x10player0_username\x18\x04 \x01(\tR\x0fplayer0Username\x12\x1d\n" +
-	"\n" +
-	"player1_id\x18\x05 \x01(\tR\tplayer1Id\x12)\n" +
-	"\x10player1_username\x18\x06 \x01(\tR\x0fplayer1Username\x12\x1f\n" +
-	"\vdivision_id\x18\a \x01(\tR\n" +
-	"divisionId\"m\n" +
-	"'SeasonPlayersWithUnstartedGamesResponse\x12B\n" +
-	"\aplayers\x18\x01 \x03(\v2(.league_service.PlayerWithUnstartedGamesR\aplayers\"\x81\x01\n" +
-	"\x18PlayerWithUnstartedGames\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x120\n" +
-	"\x14unstarted_game_count\x18\x03 \x01(\x05R\x12unstartedGameCount\"\xa9\x01\n" +
-	"\x18UpdateSeasonDatesRequest\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x129\n" +
-	"\n" +
-	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x86\x01\n" +
-	"#UpdateSeasonPromotionFormulaRequest\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12B\n" +
-	"\x11promotion_formula\x18\x02 \x01(\x0e2\x15.ipc.PromotionFormulaR\x10promotionFormula\"\x87\x01\n" +
-	" RecalculateExtendedStatsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12/\n" +
-	"\x13divisions_processed\x18\x02 \x01(\x05R\x12divisionsProcessed\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xb4\x01\n" +
-	"\x18AddSeasonTimeBankRequest\x12\x1b\n" +
-	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12-\n" +
-	"\x12additional_minutes\x18\x03 \x01(\x05R\x11additionalMinutes\x123\n" +
-	"\x05scope\x18\x04 \x01(\x0e2\x1d.league_service.TimeBankScopeR\x05scope\"t\n" +
+func (x *MatchTimeProposal) Reset() {
+	*x = MatchTimeProposal{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTimeProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTimeProposal) ProtoMessage() {}
+
+func (x *MatchTimeProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTimeProposal.ProtoReflect.Descriptor instead.
+func (*MatchTimeProposal) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MatchTimeProposal) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MatchTimeProposal) GetProposerId() string {
+	if x != nil {
+		return x.ProposerId
+	}
+	return ""
+}
+
+func (x *MatchTimeProposal) GetProposerUsername() string {
+	if x != nil {
+		return x.ProposerUsername
+	}
+	return ""
+}
+
+func (x *MatchTimeProposal) GetSlot() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+func (x *MatchTimeProposal) GetStatus() MatchProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MatchProposalStatus_PROPOSAL_OPEN
+}
+
+// A matchup in a live league that has to be played within its window.
+// player0 goes first.
+type LeagueMatch struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Uuid            string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	SeasonId        string                 `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	DivisionId      string                 `protobuf:"bytes,3,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	WindowNumber    int32                  `protobuf:"varint,4,opt,name=window_number,json=windowNumber,proto3" json:"window_number,omitempty"`
+	WindowStart     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	Player0Id       string                 `protobuf:"bytes,7,opt,name=player0_id,json=player0Id,proto3" json:"player0_id,omitempty"`
+	Player0Username string                 `protobuf:"bytes,8,opt,name=player0_username,json=player0Username,proto3" json:"player0_username,omitempty"`
+	Player1Id       string                 `protobuf:"bytes,9,opt,name=player1_id,json=player1Id,proto3" json:"player1_id,omitempty"`
+	Player1Username string                 `protobuf:"bytes,10,opt,name=player1_username,json=player1Username,proto3" json:"player1_username,omitempty"`
+	Status          LeagueMatchStatus      `protobuf:"varint,11,opt,name=status,proto3,enum=league_service.LeagueMatchStatus" json:"status,omitempty"`
+	ScheduledTime   *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=scheduled_time,json=scheduledTime,proto3" json:"scheduled_time,omitempty"`
+	GameId          string                 `protobuf:"bytes,13,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Proposals       []*MatchTimeProposal   `protobuf:"bytes,14,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LeagueMatch) Reset() {
+	*x = LeagueMatch{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueMatch) ProtoMessage() {}
+
+func (x *LeagueMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueMatch.ProtoReflect.Descriptor instead.
+func (*LeagueMatch) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LeagueMatch) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetDivisionId() string {
+	if x != nil {
+		return x.DivisionId
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetWindowNumber() int32 {
+	if x != nil {
+		return x.WindowNumber
+	}
+	return 0
+}
+
+func (x *LeagueMatch) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *LeagueMatch) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *LeagueMatch) GetPlayer0Id() string {
+	if x != nil {
+		return x.Player0Id
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetPlayer0Username() string {
+	if x != nil {
+		return x.Player0Username
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetPlayer1Id() string {
+	if x != nil {
+		return x.Player1Id
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetPlayer1Username() string {
+	if x != nil {
+		return x.Player1Username
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetStatus() LeagueMatchStatus {
+	if x != nil {
+		return x.Status
+	}
+	return LeagueMatchStatus_MATCH_PENDING
+}
+
+func (x *LeagueMatch) GetScheduledTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledTime
+	}
+	return nil
+}
+
+func (x *LeagueMatch) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LeagueMatch) GetProposals() []*MatchTimeProposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type GetLeagueMatchesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// Optional filters
+	DivisionId    string `protobuf:"bytes,2,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WindowNumber  int32  `protobuf:"varint,4,opt,name=window_number,json=windowNumber,proto3" json:"window_number,omitempty"` // 0 means all windows; windows are numbered from 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueMatchesRequest) Reset() {
+	*x = GetLeagueMatchesRequest{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueMatchesRequest) ProtoMessage() {}
+
+func (x *GetLeagueMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueMatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetLeagueMatchesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *GetLeagueMatchesRequest) GetDivisionId() string {
+	if x != nil {
+		return x.DivisionId
+	}
+	return ""
+}
+
+func (x *GetLeagueMatchesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLeagueMatchesRequest) GetWindowNumber() int32 {
+	if x != nil {
+		return x.WindowNumber
+	}
+	return 0
+}
+
+type LeagueMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*LeagueMatch         `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeagueMatchesResponse) Reset() {
+	*x = LeagueMatchesResponse{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueMatchesResponse) ProtoMessage() {}
+
+func (x *LeagueMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueMatchesResponse.ProtoReflect.Descriptor instead.
+func (*LeagueMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LeagueMatchesResponse) GetMatches() []*LeagueMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type LeagueMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeagueMatchRequest) Reset() {
+	*x = LeagueMatchRequest{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueMatchRequest) ProtoMessage() {}
+
+func (x *LeagueMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueMatchRequest.ProtoReflect.Descriptor instead.
+func (*LeagueMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LeagueMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type LeagueMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *LeagueMatch           `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeagueMatchResponse) Reset() {
+	*x = LeagueMatchResponse{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueMatchResponse) ProtoMessage() {}
+
+func (x *LeagueMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueMatchResponse.ProtoReflect.Descriptor instead.
+func (*LeagueMatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *LeagueMatchResponse) GetMatch() *LeagueMatch {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type ProposeMatchTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Slot          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeMatchTimeRequest) Reset() {
+	*x = ProposeMatchTimeRequest{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeMatchTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeMatchTimeRequest) ProtoMessage() {}
+
+func (x *ProposeMatchTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeMatchTimeRequest.ProtoReflect.Descriptor instead.
+func (*ProposeMatchTimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ProposeMatchTimeRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *ProposeMatchTimeRequest) GetSlot() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Slot
+	}
+	return nil
+}
+
+type RespondToMatchProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    int64                  `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Accept        bool                   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToMatchProposalRequest) Reset() {
+	*x = RespondToMatchProposalRequest{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToMatchProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToMatchProposalRequest) ProtoMessage() {}
+
+func (x *RespondToMatchProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToMatchProposalRequest.ProtoReflect.Descriptor instead.
+func (*RespondToMatchProposalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RespondToMatchProposalRequest) GetProposalId() int64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *RespondToMatchProposalRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type StartLeagueMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartLeagueMatchResponse) Reset() {
+	*x = StartLeagueMatchResponse{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartLeagueMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartLeagueMatchResponse) ProtoMessage() {}
+
+func (x *StartLeagueMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartLeagueMatchResponse.ProtoReflect.Descriptor instead.
+func (*StartLeagueMatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StartLeagueMatchResponse) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+// A player who is available to replace league players who drop out
+type LeagueSubstitute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	JoinedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeagueSubstitute) Reset() {
+	*x = LeagueSubstitute{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueSubstitute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueSubstitute) ProtoMessage() {}
+
+func (x *LeagueSubstitute) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueSubstitute.ProtoReflect.Descriptor instead.
+func (*LeagueSubstitute) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LeagueSubstitute) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeagueSubstitute) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LeagueSubstitute) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+type SubstitutePoolResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Substitutes   []*LeagueSubstitute    `protobuf:"bytes,1,rep,name=substitutes,proto3" json:"substitutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubstitutePoolResponse) Reset() {
+	*x = SubstitutePoolResponse{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubstitutePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstitutePoolResponse) ProtoMessage() {}
+
+func (x *SubstitutePoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstitutePoolResponse.ProtoReflect.Descriptor instead.
+func (*SubstitutePoolResponse) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SubstitutePoolResponse) GetSubstitutes() []*LeagueSubstitute {
+	if x != nil {
+		return x.Substitutes
+	}
+	return nil
+}
+
+type SubstitutePlayerRequest struct {
+	state            protoimpl.MessageState       `protogen:"open.v1"`
+	SeasonId         string                       `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	DroppedUserId    string                       `protobuf:"bytes,2,opt,name=dropped_user_id,json=droppedUserId,proto3" json:"dropped_user_id,omitempty"`          // UUID of the player who dropped out
+	SubstituteUserId string                       `protobuf:"bytes,3,opt,name=substitute_user_id,json=substituteUserId,proto3" json:"substitute_user_id,omitempty"` // UUID of a player in the substitute pool
+	Handling         ipc.SubstituteResultHandling `protobuf:"varint,4,opt,name=handling,proto3,enum=ipc.SubstituteResultHandling" json:"handling,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SubstitutePlayerRequest) Reset() {
+	*x = SubstitutePlayerRequest{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubstitutePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstitutePlayerRequest) ProtoMessage() {}
+
+func (x *SubstitutePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstitutePlayerRequest.ProtoReflect.Descriptor instead.
+func (*SubstitutePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubstitutePlayerRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *SubstitutePlayerRequest) GetDroppedUserId() string {
+	if x != nil {
+		return x.DroppedUserId
+	}
+	return ""
+}
+
+func (x *SubstitutePlayerRequest) GetSubstituteUserId() string {
+	if x != nil {
+		return x.SubstituteUserId
+	}
+	return ""
+}
+
+func (x *SubstitutePlayerRequest) GetHandling() ipc.SubstituteResultHandling {
+	if x != nil {
+		return x.Handling
+	}
+	return ipc.SubstituteResultHandling(0)
+}
+
+type SubstitutePlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GamesReplaced int32                  `protobuf:"varint,2,opt,name=games_replaced,json=gamesReplaced,proto3" json:"games_replaced,omitempty"` // Unplayed games handed to the substitute
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubstitutePlayerResponse) Reset() {
+	*x = SubstitutePlayerResponse{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubstitutePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstitutePlayerResponse) ProtoMessage() {}
+
+func (x *SubstitutePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstitutePlayerResponse.ProtoReflect.Descriptor instead.
+func (*SubstitutePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SubstitutePlayerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubstitutePlayerResponse) GetGamesReplaced() int32 {
+	if x != nil {
+		return x.GamesReplaced
+	}
+	return 0
+}
+
+func (x *SubstitutePlayerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetLeagueRatingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId      string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"` // UUID or slug
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeagueRatingHistoryRequest) Reset() {
+	*x = GetLeagueRatingHistoryRequest{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeagueRatingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeagueRatingHistoryRequest) ProtoMessage() {}
+
+func (x *GetLeagueRatingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeagueRatingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLeagueRatingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetLeagueRatingHistoryRequest) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *GetLeagueRatingHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// A player's league rating after one of their league games
+type LeagueRatingPoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GameId           string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	SeasonNumber     int32                  `protobuf:"varint,2,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"` // 0 if the season has been deleted
+	OpponentUserId   string                 `protobuf:"bytes,3,opt,name=opponent_user_id,json=opponentUserId,proto3" json:"opponent_user_id,omitempty"`
+	OpponentUsername string                 `protobuf:"bytes,4,opt,name=opponent_username,json=opponentUsername,proto3" json:"opponent_username,omitempty"`
+	RatingBefore     float64                `protobuf:"fixed64,5,opt,name=rating_before,json=ratingBefore,proto3" json:"rating_before,omitempty"`
+	RatingAfter      float64                `protobuf:"fixed64,6,opt,name=rating_after,json=ratingAfter,proto3" json:"rating_after,omitempty"`
+	RatingDeviation  float64                `protobuf:"fixed64,7,opt,name=rating_deviation,json=ratingDeviation,proto3" json:"rating_deviation,omitempty"`
+	PlayedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeagueRatingPoint) Reset() {
+	*x = LeagueRatingPoint{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueRatingPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueRatingPoint) ProtoMessage() {}
+
+func (x *LeagueRatingPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueRatingPoint.ProtoReflect.Descriptor instead.
+func (*LeagueRatingPoint) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *LeagueRatingPoint) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LeagueRatingPoint) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *LeagueRatingPoint) GetOpponentUserId() string {
+	if x != nil {
+		return x.OpponentUserId
+	}
+	return ""
+}
+
+func (x *LeagueRatingPoint) GetOpponentUsername() string {
+	if x != nil {
+		return x.OpponentUsername
+	}
+	return ""
+}
+
+func (x *LeagueRatingPoint) GetRatingBefore() float64 {
+	if x != nil {
+		return x.RatingBefore
+	}
+	return 0
+}
+
+func (x *LeagueRatingPoint) GetRatingAfter() float64 {
+	if x != nil {
+		return x.RatingAfter
+	}
+	return 0
+}
+
+func (x *LeagueRatingPoint) GetRatingDeviation() float64 {
+	if x != nil {
+		return x.RatingDeviation
+	}
+	return 0
+}
+
+func (x *LeagueRatingPoint) GetPlayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return nil
+}
+
+type LeagueRatingHistoryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Rating          float64                `protobuf:"fixed64,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingDeviation float64                `protobuf:"fixed64,2,opt,name=rating_deviation,json=ratingDeviation,proto3" json:"rating_deviation,omitempty"`
+	GamesPlayed     int32                  `protobuf:"varint,3,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	History         []*LeagueRatingPoint   `protobuf:"bytes,4,rep,name=history,proto3" json:"history,omitempty"` // Oldest first
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LeagueRatingHistoryResponse) Reset() {
+	*x = LeagueRatingHistoryResponse{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeagueRatingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeagueRatingHistoryResponse) ProtoMessage() {}
+
+func (x *LeagueRatingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeagueRatingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*LeagueRatingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *LeagueRatingHistoryResponse) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *LeagueRatingHistoryResponse) GetRatingDeviation() float64 {
+	if x != nil {
+		return x.RatingDeviation
+	}
+	return 0
+}
+
+func (x *LeagueRatingHistoryResponse) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *LeagueRatingHistoryResponse) GetHistory() []*LeagueRatingPoint {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type GetPlayerLeagueRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerLeagueRatingsRequest) Reset() {
+	*x = GetPlayerLeagueRatingsRequest{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerLeagueRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerLeagueRatingsRequest) ProtoMessage() {}
+
+func (x *GetPlayerLeagueRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerLeagueRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerLeagueRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetPlayerLeagueRatingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// A player's current rating in one league
+type PlayerLeagueRating struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LeagueId        string                 `protobuf:"bytes,1,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	LeagueName      string                 `protobuf:"bytes,2,opt,name=league_name,json=leagueName,proto3" json:"league_name,omitempty"`
+	LeagueSlug      string                 `protobuf:"bytes,3,opt,name=league_slug,json=leagueSlug,proto3" json:"league_slug,omitempty"`
+	Rating          float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingDeviation float64                `protobuf:"fixed64,5,opt,name=rating_deviation,json=ratingDeviation,proto3" json:"rating_deviation,omitempty"`
+	GamesPlayed     int32                  `protobuf:"varint,6,opt,name=games_played,json=gamesPlayed,proto3" json:"games_played,omitempty"`
+	LastGameAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_game_at,json=lastGameAt,proto3" json:"last_game_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlayerLeagueRating) Reset() {
+	*x = PlayerLeagueRating{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLeagueRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLeagueRating) ProtoMessage() {}
+
+func (x *PlayerLeagueRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLeagueRating.ProtoReflect.Descriptor instead.
+func (*PlayerLeagueRating) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PlayerLeagueRating) GetLeagueId() string {
+	if x != nil {
+		return x.LeagueId
+	}
+	return ""
+}
+
+func (x *PlayerLeagueRating) GetLeagueName() string {
+	if x != nil {
+		return x.LeagueName
+	}
+	return ""
+}
+
+func (x *PlayerLeagueRating) GetLeagueSlug() string {
+	if x != nil {
+		return x.LeagueSlug
+	}
+	return ""
+}
+
+func (x *PlayerLeagueRating) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PlayerLeagueRating) GetRatingDeviation() float64 {
+	if x != nil {
+		return x.RatingDeviation
+	}
+	return 0
+}
+
+func (x *PlayerLeagueRating) GetGamesPlayed() int32 {
+	if x != nil {
+		return x.GamesPlayed
+	}
+	return 0
+}
+
+func (x *PlayerLeagueRating) GetLastGameAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastGameAt
+	}
+	return nil
+}
+
+type PlayerLeagueRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*PlayerLeagueRating  `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerLeagueRatingsResponse) Reset() {
+	*x = PlayerLeagueRatingsResponse{}
+	mi := &file_proto_league_service_league_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerLeagueRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerLeagueRatingsResponse) ProtoMessage() {}
+
+func (x *PlayerLeagueRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_league_service_league_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerLeagueRatingsResponse.ProtoReflect.Descriptor instead.
+func (*PlayerLeagueRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_league_service_league_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PlayerLeagueRatingsResponse) GetRatings() []*PlayerLeagueRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+var File_proto_league_service_league_service_proto protoreflect.FileDescriptor
+
+const file_proto_league_service_league_service_proto_rawDesc = "" +
+	"\n" +
+	")proto/league_service/league_service.proto\x12\x0eleague_service\x1a\x18proto/ipc/omgwords.proto\x1a\x16proto/ipc/league.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x90\x01\n" +
+	"\x13CreateLeagueRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12/\n" +
+	"\bsettings\x18\x04 \x01(\v2\x13.ipc.LeagueSettingsR\bsettings\",\n" +
+	"\rLeagueRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\"7\n" +
+	"\x14GetAllLeaguesRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\">\n" +
+	"\x15GetAllLeaguesResponse\x12%\n" +
+	"\aleagues\x18\x01 \x03(\v2\v.ipc.LeagueR\aleagues\"5\n" +
+	"\x0eLeagueResponse\x12#\n" +
+	"\x06league\x18\x01 \x01(\v2\v.ipc.LeagueR\x06league\"k\n" +
+	"\x1bUpdateLeagueSettingsRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12/\n" +
+	"\bsettings\x18\x02 \x01(\v2\x13.ipc.LeagueSettingsR\bsettings\"p\n" +
+	"\x1bUpdateLeagueMetadataRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\",\n" +
+	"\rSeasonRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\"5\n" +
+	"\x0eSeasonResponse\x12#\n" +
+	"\x06season\x18\x01 \x01(\v2\v.ipc.SeasonR\x06season\"<\n" +
+	"\x13PastSeasonsResponse\x12%\n" +
+	"\aseasons\x18\x01 \x03(\v2\v.ipc.SeasonR\aseasons\";\n" +
+	"\x12AllSeasonsResponse\x12%\n" +
+	"\aseasons\x18\x01 \x03(\v2\v.ipc.SeasonR\aseasons\"L\n" +
+	"\x17GetRecentSeasonsRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\">\n" +
+	"\x15RecentSeasonsResponse\x12%\n" +
+	"\aseasons\x18\x01 \x03(\v2\v.ipc.SeasonR\aseasons\"\xd2\x01\n" +
+	"\x16BootstrapSeasonRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12)\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x11.ipc.SeasonStatusR\x06status\"S\n" +
+	"\x17OpenRegistrationRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\"2\n" +
+	"\x0fDivisionRequest\x12\x1f\n" +
+	"\vdivision_id\x18\x01 \x01(\tR\n" +
+	"divisionId\"F\n" +
+	"\x19DivisionStandingsResponse\x12)\n" +
+	"\bdivision\x18\x01 \x01(\v2\r.ipc.DivisionR\bdivision\"K\n" +
+	"\x1cAllDivisionStandingsResponse\x12+\n" +
+	"\tdivisions\x18\x01 \x03(\v2\r.ipc.DivisionR\tdivisions\"d\n" +
+	"\x0fRegisterRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\tR\bseasonId\"I\n" +
+	"\x10RegisterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\"I\n" +
+	"\x11UnregisterRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\".\n" +
+	"\x12UnregisterResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"g\n" +
+	"\x1bSeasonRegistrationsResponse\x12H\n" +
+	"\rregistrations\x18\x01 \x03(\v2\".league_service.SeasonRegistrationR\rregistrations\"\xb0\x01\n" +
+	"\x12SeasonRegistration\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tseason_id\x18\x03 \x01(\tR\bseasonId\x12\x1f\n" +
+	"\vdivision_id\x18\x04 \x01(\tR\n" +
+	"divisionId\x12'\n" +
+	"\x0fdivision_number\x18\x05 \x01(\x05R\x0edivisionNumber\"L\n" +
+	"\x14PlayerHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tleague_id\x18\x02 \x01(\tR\bleagueId\"P\n" +
+	"\x15PlayerHistoryResponse\x127\n" +
+	"\aseasons\x18\x01 \x03(\v2\x1d.league_service.SeasonSummaryR\aseasons\"\xd2\x01\n" +
+	"\rSeasonSummary\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12#\n" +
+	"\rseason_number\x18\x02 \x01(\x05R\fseasonNumber\x12\x1f\n" +
+	"\vleague_name\x18\x03 \x01(\tR\n" +
+	"leagueName\x12'\n" +
+	"\x0fdivision_number\x18\x04 \x01(\x05R\x0edivisionNumber\x125\n" +
+	"\bstanding\x18\x05 \x01(\v2\x19.ipc.LeaguePlayerStandingR\bstanding\"{\n" +
+	"\x14LeagueRosterResponse\x12<\n" +
+	"\aplayers\x18\x01 \x03(\v2\".league_service.LeagueRosterPlayerR\aplayers\x12%\n" +
+	"\x0eseason_numbers\x18\x02 \x03(\x05R\rseasonNumbers\"\x87\x01\n" +
+	"\x12LeagueRosterPlayer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12<\n" +
+	"\aseasons\x18\x03 \x03(\v2\".league_service.LeagueRosterSeasonR\aseasons\"\xfd\x01\n" +
+	"\x12LeagueRosterSeason\x12#\n" +
+	"\rseason_number\x18\x01 \x01(\x05R\fseasonNumber\x12'\n" +
+	"\x0fdivision_number\x18\x02 \x01(\x05R\x0edivisionNumber\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x16\n" +
+	"\x06losses\x18\x05 \x01(\x05R\x06losses\x12\x14\n" +
+	"\x05draws\x18\x06 \x01(\x05R\x05draws\x12\x16\n" +
+	"\x06spread\x18\a \x01(\x05R\x06spread\x12+\n" +
+	"\x06result\x18\b \x01(\x0e2\x13.ipc.StandingResultR\x06result\"L\n" +
+	"\x18LeagueStatisticsResponse\x120\n" +
+	"\x05stats\x18\x01 \x03(\v2\x1a.league_service.LeagueStatR\x05stats\"\xb6\x01\n" +
+	"\n" +
+	"LeagueStat\x12\x1b\n" +
+	"\tstat_type\x18\x01 \x01(\tR\bstatType\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x05R\x05value\x12\x17\n" +
+	"\agame_id\x18\x05 \x01(\tR\x06gameId\x12'\n" +
+	"\x0fdivision_number\x18\x06 \x01(\x05R\x0edivisionNumber\"S\n" +
+	"\x1bGetPlayerSeasonGamesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\"V\n" +
+	"\x1cGetPlayerSeasonGamesResponse\x126\n" +
+	"\x05games\x18\x01 \x03(\v2 .league_service.PlayerSeasonGameR\x05games\"\xbf\x04\n" +
+	"\x10PlayerSeasonGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12(\n" +
+	"\x10opponent_user_id\x18\x02 \x01(\tR\x0eopponentUserId\x12+\n" +
+	"\x11opponent_username\x18\x03 \x01(\tR\x10opponentUsername\x12!\n" +
+	"\fplayer_score\x18\x04 \x01(\x05R\vplayerScore\x12%\n" +
+	"\x0eopponent_score\x18\x05 \x01(\x05R\ropponentScore\x12\x16\n" +
+	"\x06result\x18\x06 \x01(\tR\x06result\x127\n" +
+	"\tgame_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bgameDate\x12\x14\n" +
+	"\x05round\x18\b \x01(\x05R\x05round\x12:\n" +
+	"\x0fgame_end_reason\x18\t \x01(\x0e2\x12.ipc.GameEndReasonR\rgameEndReason\x12;\n" +
+	"\vlast_update\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUpdate\x12%\n" +
+	"\x0eincrement_secs\x18\v \x01(\x05R\rincrementSecs\x12.\n" +
+	"\x14on_turn_time_bank_ms\x18\f \x01(\x03R\x10onTurnTimeBankMs\x12(\n" +
+	"\rmistake_index\x18\r \x01(\x01H\x00R\fmistakeIndex\x88\x01\x01B\x10\n" +
+	"\x0e_mistake_index\",\n" +
+	"\x11InviteUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\x12InviteUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
+	"\x1bMovePlayerToDivisionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12(\n" +
+	"\x10from_division_id\x18\x03 \x01(\tR\x0efromDivisionId\x12$\n" +
+	"\x0eto_division_id\x18\x04 \x01(\tR\ftoDivisionId\"R\n" +
+	"\x1cMovePlayerToDivisionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x82\x01\n" +
+	"\x15CreateDivisionRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12'\n" +
+	"\x0fdivision_number\x18\x02 \x01(\x05R\x0edivisionNumber\x12#\n" +
+	"\rdivision_name\x18\x03 \x01(\tR\fdivisionName\"m\n" +
+	"\x16CreateDivisionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vdivision_id\x18\x03 \x01(\tR\n" +
+	"divisionId\"U\n" +
+	"\x15DeleteDivisionRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x1f\n" +
+	"\vdivision_id\x18\x02 \x01(\tR\n" +
+	"divisionId\"\x7f\n" +
+	"\x16DeleteDivisionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x14divisions_renumbered\x18\x03 \x01(\x05R\x13divisionsRenumbered\"Q\n" +
+	"\x1bSeasonZeroMoveGamesResponse\x122\n" +
+	"\x05games\x18\x01 \x03(\v2\x1c.league_service.ZeroMoveGameR\x05games\"\x97\x02\n" +
+	"\fZeroMoveGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"player0_id\x18\x03 \x01(\tR\tplayer0Id\x12)\n" +
+	"\x10player0_username\x18\x04 \x01(\tR\x0fplayer0Username\x12\x1d\n" +
+	"\n" +
+	"player1_id\x18\x05 \x01(\tR\tplayer1Id\x12)\n" +
+	"\x10player1_username\x18\x06 \x01(\tR\x0fplayer1Username\x12\x1f\n" +
+	"\vdivision_id\x18\a \x01(\tR\n" +
+	"divisionId\"m\n" +
+	"'SeasonPlayersWithUnstartedGamesResponse\x12B\n" +
+	"\aplayers\x18\x01 \x03(\v2(.league_service.PlayerWithUnstartedGamesR\aplayers\"\x81\x01\n" +
+	"\x18PlayerWithUnstartedGames\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x120\n" +
+	"\x14unstarted_game_count\x18\x03 \x01(\x05R\x12unstartedGameCount\"\xa9\x01\n" +
+	"\x18UpdateSeasonDatesRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\x86\x01\n" +
+	"#UpdateSeasonPromotionFormulaRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12B\n" +
+	"\x11promotion_formula\x18\x02 \x01(\x0e2\x15.ipc.PromotionFormulaR\x10promotionFormula\"\x87\x01\n" +
+	" RecalculateExtendedStatsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12/\n" +
+	"\x13divisions_processed\x18\x02 \x01(\x05R\x12divisionsProcessed\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xb4\x01\n" +
+	"\x18AddSeasonTimeBankRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12-\n" +
+	"\x12additional_minutes\x18\x03 \x01(\x05R\x11additionalMinutes\x123\n" +
+	"\x05scope\x18\x04 \x01(\x0e2\x1d.league_service.TimeBankScopeR\x05scope\"t\n" +
 	"\x19AddSeasonTimeBankResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rgames_updated\x18\x02 \x01(\x05R\fgamesUpdated\x12\x18\n" +
@@ -3658,11 +4975,117 @@ const file_proto_league_service_league_service_proto_rawDesc = "" +
 	"\x04draw\x18\x03 \x01(\bR\x04draw\x12!\n" +
 	"\fplayer_score\x18\x04 \x01(\x05R\vplayerScore\x12%\n" +
 	"\x0eopponent_score\x18\x05 \x01(\x05R\ropponentScore\x12&\n" +
-	"\x0fgame_end_reason\x18\x06 \x01(\x05R\rgameEndReason*y\n" +
+	"\x0fgame_end_reason\x18\x06 \x01(\x05R\rgameEndReason\"\xde\x01\n" +
+	"\x11MatchTimeProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vproposer_id\x18\x02 \x01(\tR\n" +
+	"proposerId\x12+\n" +
+	"\x11proposer_username\x18\x03 \x01(\tR\x10proposerUsername\x12.\n" +
+	"\x04slot\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04slot\x12;\n" +
+	"\x06status\x18\x05 \x01(\x0e2#.league_service.MatchProposalStatusR\x06status\"\xea\x04\n" +
+	"\vLeagueMatch\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12\x1f\n" +
+	"\vdivision_id\x18\x03 \x01(\tR\n" +
+	"divisionId\x12#\n" +
+	"\rwindow_number\x18\x04 \x01(\x05R\fwindowNumber\x12=\n" +
+	"\fwindow_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x1d\n" +
+	"\n" +
+	"player0_id\x18\a \x01(\tR\tplayer0Id\x12)\n" +
+	"\x10player0_username\x18\b \x01(\tR\x0fplayer0Username\x12\x1d\n" +
+	"\n" +
+	"player1_id\x18\t \x01(\tR\tplayer1Id\x12)\n" +
+	"\x10player1_username\x18\n" +
+	" \x01(\tR\x0fplayer1Username\x129\n" +
+	"\x06status\x18\v \x01(\x0e2!.league_service.LeagueMatchStatusR\x06status\x12A\n" +
+	"\x0escheduled_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rscheduledTime\x12\x17\n" +
+	"\agame_id\x18\r \x01(\tR\x06gameId\x12?\n" +
+	"\tproposals\x18\x0e \x03(\v2!.league_service.MatchTimeProposalR\tproposals\"\x95\x01\n" +
+	"\x17GetLeagueMatchesRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x1f\n" +
+	"\vdivision_id\x18\x02 \x01(\tR\n" +
+	"divisionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12#\n" +
+	"\rwindow_number\x18\x04 \x01(\x05R\fwindowNumber\"N\n" +
+	"\x15LeagueMatchesResponse\x125\n" +
+	"\amatches\x18\x01 \x03(\v2\x1b.league_service.LeagueMatchR\amatches\"/\n" +
+	"\x12LeagueMatchRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"H\n" +
+	"\x13LeagueMatchResponse\x121\n" +
+	"\x05match\x18\x01 \x01(\v2\x1b.league_service.LeagueMatchR\x05match\"d\n" +
+	"\x17ProposeMatchTimeRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12.\n" +
+	"\x04slot\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04slot\"X\n" +
+	"\x1dRespondToMatchProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\x03R\n" +
+	"proposalId\x12\x16\n" +
+	"\x06accept\x18\x02 \x01(\bR\x06accept\"3\n" +
+	"\x18StartLeagueMatchResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\x80\x01\n" +
+	"\x10LeagueSubstitute\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x127\n" +
+	"\tjoined_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bjoinedAt\"\\\n" +
+	"\x16SubstitutePoolResponse\x12B\n" +
+	"\vsubstitutes\x18\x01 \x03(\v2 .league_service.LeagueSubstituteR\vsubstitutes\"\xc7\x01\n" +
+	"\x17SubstitutePlayerRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12&\n" +
+	"\x0fdropped_user_id\x18\x02 \x01(\tR\rdroppedUserId\x12,\n" +
+	"\x12substitute_user_id\x18\x03 \x01(\tR\x10substituteUserId\x129\n" +
+	"\bhandling\x18\x04 \x01(\x0e2\x1d.ipc.SubstituteResultHandlingR\bhandling\"u\n" +
+	"\x18SubstitutePlayerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12%\n" +
+	"\x0egames_replaced\x18\x02 \x01(\x05R\rgamesReplaced\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"U\n" +
+	"\x1dGetLeagueRatingHistoryRequest\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xd4\x02\n" +
+	"\x11LeagueRatingPoint\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12#\n" +
+	"\rseason_number\x18\x02 \x01(\x05R\fseasonNumber\x12(\n" +
+	"\x10opponent_user_id\x18\x03 \x01(\tR\x0eopponentUserId\x12+\n" +
+	"\x11opponent_username\x18\x04 \x01(\tR\x10opponentUsername\x12#\n" +
+	"\rrating_before\x18\x05 \x01(\x01R\fratingBefore\x12!\n" +
+	"\frating_after\x18\x06 \x01(\x01R\vratingAfter\x12)\n" +
+	"\x10rating_deviation\x18\a \x01(\x01R\x0fratingDeviation\x127\n" +
+	"\tplayed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bplayedAt\"\xc0\x01\n" +
+	"\x1bLeagueRatingHistoryResponse\x12\x16\n" +
+	"\x06rating\x18\x01 \x01(\x01R\x06rating\x12)\n" +
+	"\x10rating_deviation\x18\x02 \x01(\x01R\x0fratingDeviation\x12!\n" +
+	"\fgames_played\x18\x03 \x01(\x05R\vgamesPlayed\x12;\n" +
+	"\ahistory\x18\x04 \x03(\v2!.league_service.LeagueRatingPointR\ahistory\"8\n" +
+	"\x1dGetPlayerLeagueRatingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x97\x02\n" +
+	"\x12PlayerLeagueRating\x12\x1b\n" +
+	"\tleague_id\x18\x01 \x01(\tR\bleagueId\x12\x1f\n" +
+	"\vleague_name\x18\x02 \x01(\tR\n" +
+	"leagueName\x12\x1f\n" +
+	"\vleague_slug\x18\x03 \x01(\tR\n" +
+	"leagueSlug\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x01R\x06rating\x12)\n" +
+	"\x10rating_deviation\x18\x05 \x01(\x01R\x0fratingDeviation\x12!\n" +
+	"\fgames_played\x18\x06 \x01(\x05R\vgamesPlayed\x12<\n" +
+	"\flast_game_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastGameAt\"[\n" +
+	"\x1bPlayerLeagueRatingsResponse\x12<\n" +
+	"\aratings\x18\x01 \x03(\v2\".league_service.PlayerLeagueRatingR\aratings*y\n" +
 	"\rTimeBankScope\x12 \n" +
 	"\x1cTIMEBANK_SCOPE_SINGLE_PLAYER\x10\x00\x12&\n" +
 	"\"TIMEBANK_SCOPE_PLAYER_AND_OPPONENT\x10\x01\x12\x1e\n" +
-	"\x1aTIMEBANK_SCOPE_ALL_PLAYERS\x10\x022\x8f\x1b\n" +
+	"\x1aTIMEBANK_SCOPE_ALL_PLAYERS\x10\x02*u\n" +
+	"\x11LeagueMatchStatus\x12\x11\n" +
+	"\rMATCH_PENDING\x10\x00\x12\x13\n" +
+	"\x0fMATCH_SCHEDULED\x10\x01\x12\x11\n" +
+	"\rMATCH_STARTED\x10\x02\x12\x10\n" +
+	"\fMATCH_PLAYED\x10\x03\x12\x13\n" +
+	"\x0fMATCH_FORFEITED\x10\x04*o\n" +
+	"\x13MatchProposalStatus\x12\x11\n" +
+	"\rPROPOSAL_OPEN\x10\x00\x12\x15\n" +
+	"\x11PROPOSAL_ACCEPTED\x10\x01\x12\x15\n" +
+	"\x11PROPOSAL_DECLINED\x10\x02\x12\x17\n" +
+	"\x13PROPOSAL_SUPERSEDED\x10\x032\x8f#\n" +
 	"\rLeagueService\x12S\n" +
 	"\fCreateLeague\x12#.league_service.CreateLeagueRequest\x1a\x1e.league_service.LeagueResponse\x12J\n" +
 	"\tGetLeague\x12\x1d.league_service.LeagueRequest\x1a\x1e.league_service.LeagueResponse\x12\\\n" +
@@ -3698,7 +5121,17 @@ const file_proto_league_service_league_service_proto_rawDesc = "" +
 	"\x1cUpdateSeasonPromotionFormula\x123.league_service.UpdateSeasonPromotionFormulaRequest\x1a\x1e.league_service.SeasonResponse\x12q\n" +
 	"\x1eRecalculateSeasonExtendedStats\x12\x1d.league_service.SeasonRequest\x1a0.league_service.RecalculateExtendedStatsResponse\x12h\n" +
 	"\x11AddSeasonTimeBank\x12(.league_service.AddSeasonTimeBankRequest\x1a).league_service.AddSeasonTimeBankResponse\x12n\n" +
-	"\x13CancelPlayerResults\x12*.league_service.CancelPlayerResultsRequest\x1a+.league_service.CancelPlayerResultsResponseB\xb8\x01\n" +
+	"\x13CancelPlayerResults\x12*.league_service.CancelPlayerResultsRequest\x1a+.league_service.CancelPlayerResultsResponse\x12b\n" +
+	"\x10GetLeagueMatches\x12'.league_service.GetLeagueMatchesRequest\x1a%.league_service.LeagueMatchesResponse\x12`\n" +
+	"\x10ProposeMatchTime\x12'.league_service.ProposeMatchTimeRequest\x1a#.league_service.LeagueMatchResponse\x12l\n" +
+	"\x16RespondToMatchProposal\x12-.league_service.RespondToMatchProposalRequest\x1a#.league_service.LeagueMatchResponse\x12`\n" +
+	"\x10StartLeagueMatch\x12\".league_service.LeagueMatchRequest\x1a(.league_service.StartLeagueMatchResponse\x12[\n" +
+	"\x12JoinSubstitutePool\x12\x1d.league_service.LeagueRequest\x1a&.league_service.SubstitutePoolResponse\x12\\\n" +
+	"\x13LeaveSubstitutePool\x12\x1d.league_service.LeagueRequest\x1a&.league_service.SubstitutePoolResponse\x12Z\n" +
+	"\x11GetSubstitutePool\x12\x1d.league_service.LeagueRequest\x1a&.league_service.SubstitutePoolResponse\x12e\n" +
+	"\x10SubstitutePlayer\x12'.league_service.SubstitutePlayerRequest\x1a(.league_service.SubstitutePlayerResponse\x12t\n" +
+	"\x16GetLeagueRatingHistory\x12-.league_service.GetLeagueRatingHistoryRequest\x1a+.league_service.LeagueRatingHistoryResponse\x12t\n" +
+	"\x16GetPlayerLeagueRatings\x12-.league_service.GetPlayerLeagueRatingsRequest\x1a+.league_service.PlayerLeagueRatingsResponseB\xb8\x01\n" +
 	"\x12com.league_serviceB\x12LeagueServiceProtoP\x01Z:github.com/woogles-io/liwords/rpc/api/proto/league_service\xa2\x02\x03LXX\xaa\x02\rLeagueService\xca\x02\rLeagueService\xe2\x02\x19LeagueService\\GPBMetadata\xea\x02\rLeagueServiceb\x06proto3"
 
 var (
@@ -3713,190 +5146,249 @@ func file_proto_league_service_league_service_proto_rawDescGZIP() []byte {
 	return file_proto_league_service_league_service_proto_rawDescData
 }
 
-var file_proto_league_service_league_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_league_service_league_service_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_proto_league_service_league_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_league_service_league_service_proto_msgTypes = make([]protoimpl.MessageInfo, 77)
 var file_proto_league_service_league_service_proto_goTypes = []any{
 	(TimeBankScope)(0),                              // 0: league_service.TimeBankScope
-	(*CreateLeagueRequest)(nil),                     // 1: league_service.CreateLeagueRequest
-	(*LeagueRequest)(nil),                           // 2: league_service.LeagueRequest
-	(*GetAllLeaguesRequest)(nil),                    // 3: league_service.GetAllLeaguesRequest
-	(*GetAllLeaguesResponse)(nil),                   // 4: league_service.GetAllLeaguesResponse
-	(*LeagueResponse)(nil),                          // 5: league_service.LeagueResponse
-	(*UpdateLeagueSettingsRequest)(nil),             // 6: league_service.UpdateLeagueSettingsRequest
-	(*UpdateLeagueMetadataRequest)(nil),             // 7: league_service.UpdateLeagueMetadataRequest
-	(*SeasonRequest)(nil),                           // 8: league_service.SeasonRequest
-	(*SeasonResponse)(nil),                          // 9: league_service.SeasonResponse
-	(*PastSeasonsResponse)(nil),                     // 10: league_service.PastSeasonsResponse
-	(*AllSeasonsResponse)(nil),                      // 11: league_service.AllSeasonsResponse
-	(*GetRecentSeasonsRequest)(nil),                 // 12: league_service.GetRecentSeasonsRequest
-	(*RecentSeasonsResponse)(nil),                   // 13: league_service.RecentSeasonsResponse
-	(*BootstrapSeasonRequest)(nil),                  // 14: league_service.BootstrapSeasonRequest
-	(*OpenRegistrationRequest)(nil),                 // 15: league_service.OpenRegistrationRequest
-	(*DivisionRequest)(nil),                         // 16: league_service.DivisionRequest
-	(*DivisionStandingsResponse)(nil),               // 17: league_service.DivisionStandingsResponse
-	(*AllDivisionStandingsResponse)(nil),            // 18: league_service.AllDivisionStandingsResponse
-	(*RegisterRequest)(nil),                         // 19: league_service.RegisterRequest
-	(*RegisterResponse)(nil),                        // 20: league_service.RegisterResponse
-	(*UnregisterRequest)(nil),                       // 21: league_service.UnregisterRequest
-	(*UnregisterResponse)(nil),                      // 22: league_service.UnregisterResponse
-	(*SeasonRegistrationsResponse)(nil),             // 23: league_service.SeasonRegistrationsResponse
-	(*SeasonRegistration)(nil),                      // 24: league_service.SeasonRegistration
-	(*PlayerHistoryRequest)(nil),                    // 25: league_service.PlayerHistoryRequest
-	(*PlayerHistoryResponse)(nil),                   // 26: league_service.PlayerHistoryResponse
-	(*SeasonSummary)(nil),                           // 27: league_service.SeasonSummary
-	(*LeagueRosterResponse)(nil),                    // 28: league_service.LeagueRosterResponse
-	(*LeagueRosterPlayer)(nil),                      // 29: league_service.LeagueRosterPlayer
-	(*LeagueRosterSeason)(nil),                      // 30: league_service.LeagueRosterSeason
-	(*LeagueStatisticsResponse)(nil),                // 31: league_service.LeagueStatisticsResponse
-	(*LeagueStat)(nil),                              // 32: league_service.LeagueStat
-	(*GetPlayerSeasonGamesRequest)(nil),             // 33: league_service.GetPlayerSeasonGamesRequest
-	(*GetPlayerSeasonGamesResponse)(nil),            // 34: league_service.GetPlayerSeasonGamesResponse
-	(*PlayerSeasonGame)(nil),                        // 35: league_service.PlayerSeasonGame
-	(*InviteUserRequest)(nil),                       // 36: league_service.InviteUserRequest
-	(*InviteUserResponse)(nil),                      // 37: league_service.InviteUserResponse
-	(*MovePlayerToDivisionRequest)(nil),             // 38: league_service.MovePlayerToDivisionRequest
-	(*MovePlayerToDivisionResponse)(nil),            // 39: league_service.MovePlayerToDivisionResponse
-	(*CreateDivisionRequest)(nil),                   // 40: league_service.CreateDivisionRequest
-	(*CreateDivisionResponse)(nil),                  // 41: league_service.CreateDivisionResponse
-	(*DeleteDivisionRequest)(nil),                   // 42: league_service.DeleteDivisionRequest
-	(*DeleteDivisionResponse)(nil),                  // 43: league_service.DeleteDivisionResponse
-	(*SeasonZeroMoveGamesResponse)(nil),             // 44: league_service.SeasonZeroMoveGamesResponse
-	(*ZeroMoveGame)(nil),                            // 45: league_service.ZeroMoveGame
-	(*SeasonPlayersWithUnstartedGamesResponse)(nil), // 46: league_service.SeasonPlayersWithUnstartedGamesResponse
-	(*PlayerWithUnstartedGames)(nil),                // 47: league_service.PlayerWithUnstartedGames
-	(*UpdateSeasonDatesRequest)(nil),                // 48: league_service.UpdateSeasonDatesRequest
-	(*UpdateSeasonPromotionFormulaRequest)(nil),     // 49: league_service.UpdateSeasonPromotionFormulaRequest
-	(*RecalculateExtendedStatsResponse)(nil),        // 50: league_service.RecalculateExtendedStatsResponse
-	(*AddSeasonTimeBankRequest)(nil),                // 51: league_service.AddSeasonTimeBankRequest
-	(*AddSeasonTimeBankResponse)(nil),               // 52: league_service.AddSeasonTimeBankResponse
-	(*CancelPlayerResultsRequest)(nil),              // 53: league_service.CancelPlayerResultsRequest
-	(*CancelPlayerResultsResponse)(nil),             // 54: league_service.CancelPlayerResultsResponse
-	(*GetPlayerLeagueH2HRequest)(nil),               // 55: league_service.GetPlayerLeagueH2HRequest
-	(*GetPlayerLeagueH2HResponse)(nil),              // 56: league_service.GetPlayerLeagueH2HResponse
-	(*H2HRecord)(nil),                               // 57: league_service.H2HRecord
-	(*H2HSeasonGame)(nil),                           // 58: league_service.H2HSeasonGame
-	(*ipc.LeagueSettings)(nil),                      // 59: ipc.LeagueSettings
-	(*ipc.League)(nil),                              // 60: ipc.League
-	(*ipc.Season)(nil),                              // 61: ipc.Season
-	(*timestamppb.Timestamp)(nil),                   // 62: google.protobuf.Timestamp
-	(ipc.SeasonStatus)(0),                           // 63: ipc.SeasonStatus
-	(*ipc.Division)(nil),                            // 64: ipc.Division
-	(*ipc.LeaguePlayerStanding)(nil),                // 65: ipc.LeaguePlayerStanding
-	(ipc.StandingResult)(0),                         // 66: ipc.StandingResult
-	(ipc.GameEndReason)(0),                          // 67: ipc.GameEndReason
-	(ipc.PromotionFormula)(0),                       // 68: ipc.PromotionFormula
-	(*ipc.GetDivisionTimeBankWarningsRequest)(nil),  // 69: ipc.GetDivisionTimeBankWarningsRequest
-	(*ipc.GetDivisionTimeBankWarningsResponse)(nil), // 70: ipc.GetDivisionTimeBankWarningsResponse
+	(LeagueMatchStatus)(0),                          // 1: league_service.LeagueMatchStatus
+	(MatchProposalStatus)(0),                        // 2: league_service.MatchProposalStatus
+	(*CreateLeagueRequest)(nil),                     // 3: league_service.CreateLeagueRequest
+	(*LeagueRequest)(nil),                           // 4: league_service.LeagueRequest
+	(*GetAllLeaguesRequest)(nil),                    // 5: league_service.GetAllLeaguesRequest
+	(*GetAllLeaguesResponse)(nil),                   // 6: league_service.GetAllLeaguesResponse
+	(*LeagueResponse)(nil),                          // 7: league_service.LeagueResponse
+	(*UpdateLeagueSettingsRequest)(nil),             // 8: league_service.UpdateLeagueSettingsRequest
+	(*UpdateLeagueMetadataRequest)(nil),             // 9: league_service.UpdateLeagueMetadataRequest
+	(*SeasonRequest)(nil),                           // 10: league_service.SeasonRequest
+	(*SeasonResponse)(nil),                          // 11: league_service.SeasonResponse
+	(*PastSeasonsResponse)(nil),                     // 12: league_service.PastSeasonsResponse
+	(*AllSeasonsResponse)(nil),                      // 13: league_service.AllSeasonsResponse
+	(*GetRecentSeasonsRequest)(nil),                 // 14: league_service.GetRecentSeasonsRequest
+	(*RecentSeasonsResponse)(nil),                   // 15: league_service.RecentSeasonsResponse
+	(*BootstrapSeasonRequest)(nil),                  // 16: league_service.BootstrapSeasonRequest
+	(*OpenRegistrationRequest)(nil),                 // 17: league_service.OpenRegistrationRequest
+	(*DivisionRequest)(nil),                         // 18: league_service.DivisionRequest
+	(*DivisionStandingsResponse)(nil),               // 19: league_service.DivisionStandingsResponse
+	(*AllDivisionStandingsResponse)(nil),            // 20: league_service.AllDivisionStandingsResponse
+	(*RegisterRequest)(nil),                         // 21: league_service.RegisterRequest
+	(*RegisterResponse)(nil),                        // 22: league_service.RegisterResponse
+	(*UnregisterRequest)(nil),                       // 23: league_service.UnregisterRequest
+	(*UnregisterResponse)(nil),                      // 24: league_service.UnregisterResponse
+	(*SeasonRegistrationsResponse)(nil),             // 25: league_service.SeasonRegistrationsResponse
+	(*SeasonRegistration)(nil),                      // 26: league_service.SeasonRegistration
+	(*PlayerHistoryRequest)(nil),                    // 27: league_service.PlayerHistoryRequest
+	(*PlayerHistoryResponse)(nil),                   // 28: league_service.PlayerHistoryResponse
+	(*SeasonSummary)(nil),                           // 29: league_service.SeasonSummary
+	(*LeagueRosterResponse)(nil),                    // 30: league_service.LeagueRosterResponse
+	(*LeagueRosterPlayer)(nil),                      // 31: league_service.LeagueRosterPlayer
+	(*LeagueRosterSeason)(nil),                      // 32: league_service.LeagueRosterSeason
+	(*LeagueStatisticsResponse)(nil),                // 33: league_service.LeagueStatisticsResponse
+	(*LeagueStat)(nil),                              // 34: league_service.LeagueStat
+	(*GetPlayerSeasonGamesRequest)(nil),             // 35: league_service.GetPlayerSeasonGamesRequest
+	(*GetPlayerSeasonGamesResponse)(nil),            // 36: league_service.GetPlayerSeasonGamesResponse
+	(*PlayerSeasonGame)(nil),                        // 37: league_service.PlayerSeasonGame
+	(*InviteUserRequest)(nil),                       // 38: league_service.InviteUserRequest
+	(*InviteUserResponse)(nil),                      // 39: league_service.InviteUserResponse
+	(*MovePlayerToDivisionRequest)(nil),             // 40: league_service.MovePlayerToDivisionRequest
+	(*MovePlayerToDivisionResponse)(nil),            // 41: league_service.MovePlayerToDivisionResponse
+	(*CreateDivisionRequest)(nil),                   // 42: league_service.CreateDivisionRequest
+	(*CreateDivisionResponse)(nil),                  // 43: league_service.CreateDivisionResponse
+	(*DeleteDivisionRequest)(nil),                   // 44: league_service.DeleteDivisionRequest
+	(*DeleteDivisionResponse)(nil),                  // 45: league_service.DeleteDivisionResponse
+	(*SeasonZeroMoveGamesResponse)(nil),             // 46: league_service.SeasonZeroMoveGamesResponse
+	(*ZeroMoveGame)(nil),                            // 47: league_service.ZeroMoveGame
+	(*SeasonPlayersWithUnstartedGamesResponse)(nil), // 48: league_service.SeasonPlayersWithUnstartedGamesResponse
+	(*PlayerWithUnstartedGames)(nil),                // 49: league_service.PlayerWithUnstartedGames
+	(*UpdateSeasonDatesRequest)(nil),                // 50: league_service.UpdateSeasonDatesRequest
+	(*UpdateSeasonPromotionFormulaRequest)(nil),     // 51: league_service.UpdateSeasonPromotionFormulaRequest
+	(*RecalculateExtendedStatsResponse)(nil),        // 52: league_service.RecalculateExtendedStatsResponse
+	(*AddSeasonTimeBankRequest)(nil),                // 53: league_service.AddSeasonTimeBankRequest
+	(*AddSeasonTimeBankResponse)(nil),               // 54: league_service.AddSeasonTimeBankResponse
+	(*CancelPlayerResultsRequest)(nil),              // 55: league_service.CancelPlayerResultsRequest
+	(*CancelPlayerResultsResponse)(nil),             // 56: league_service.CancelPlayerResultsResponse
+	(*GetPlayerLeagueH2HRequest)(nil),               // 57: league_service.GetPlayerLeagueH2HRequest
+	(*GetPlayerLeagueH2HResponse)(nil),              // 58: league_service.GetPlayerLeagueH2HResponse
+	(*H2HRecord)(nil),                               // 59: league_service.H2HRecord
+	(*H2HSeasonGame)(nil),                           // 60: league_service.H2HSeasonGame
+	(*MatchTimeProposal)(nil),                       // 61: league_service.MatchTimeProposal
+	(*LeagueMatch)(nil),                             // 62: league_service.LeagueMatch
+	(*GetLeagueMatchesRequest)(nil),                 // 63: league_service.GetLeagueMatchesRequest
+	(*LeagueMatchesResponse)(nil),                   // 64: league_service.LeagueMatchesResponse
+	(*LeagueMatchRequest)(nil),                      // 65: league_service.LeagueMatchRequest
+	(*LeagueMatchResponse)(nil),                     // 66: league_service.LeagueMatchResponse
+	(*ProposeMatchTimeRequest)(nil),                 // 67: league_service.ProposeMatchTimeRequest
+	(*RespondToMatchProposalRequest)(nil),           // 68: league_service.RespondToMatchProposalRequest
+	(*StartLeagueMatchResponse)(nil),                // 69: league_service.StartLeagueMatchResponse
+	(*LeagueSubstitute)(nil),                        // 70: league_service.LeagueSubstitute
+	(*SubstitutePoolResponse)(nil),                  // 71: league_service.SubstitutePoolResponse
+	(*SubstitutePlayerRequest)(nil),                 // 72: league_service.SubstitutePlayerRequest
+	(*SubstitutePlayerResponse)(nil),                // 73: league_service.SubstitutePlayerResponse
+	(*GetLeagueRatingHistoryRequest)(nil),           // 74: league_service.GetLeagueRatingHistoryRequest
+	(*LeagueRatingPoint)(nil),                       // 75: league_service.LeagueRatingPoint
+	(*LeagueRatingHistoryResponse)(nil),             // 76: league_service.LeagueRatingHistoryResponse
+	(*GetPlayerLeagueRatingsRequest)(nil),           // 77: league_service.GetPlayerLeagueRatingsRequest
+	(*PlayerLeagueRating)(nil),                      // 78: league_service.PlayerLeagueRating
+	(*PlayerLeagueRatingsResponse)(nil),             // 79: league_service.PlayerLeagueRatingsResponse
+	(*ipc.LeagueSettings)(nil),                      // 80: ipc.LeagueSettings
+	(*ipc.League)(nil),                              // 81: ipc.League
+	(*ipc.Season)(nil),                              // 82: ipc.Season
+	(*timestamppb.Timestamp)(nil),                   // 83: google.protobuf.Timestamp
+	(ipc.SeasonStatus)(0),                           // 84: ipc.SeasonStatus
+	(*ipc.Division)(nil),                            // 85: ipc.Division
+	(*ipc.LeaguePlayerStanding)(nil),                // 86: ipc.LeaguePlayerStanding
+	(ipc.StandingResult)(0),                         // 87: ipc.StandingResult
+	(ipc.GameEndReason)(0),                          // 88: ipc.GameEndReason
+	(ipc.PromotionFormula)(0),                       // 89: ipc.PromotionFormula
+	(ipc.SubstituteResultHandling)(0),               // 90: ipc.SubstituteResultHandling
+	(*ipc.GetDivisionTimeBankWarningsRequest)(nil),  // 91: ipc.GetDivisionTimeBankWarningsRequest
+	(*ipc.GetDivisionTimeBankWarningsResponse)(nil), // 92: ipc.GetDivisionTimeBankWarningsResponse
 }
 var file_proto_league_service_league_service_proto_depIdxs = []int32{
-	59, // 0: league_service.CreateLeagueRequest.settings:type_name -> ipc.LeagueSettings
-	60, // 1: league_service.GetAllLeaguesResponse.leagues:type_name -> ipc.League
-	60, // 2: league_service.LeagueResponse.league:type_name -> ipc.League
-	59, // 3: league_service.UpdateLeagueSettingsRequest.settings:type_name -> ipc.LeagueSettings
-	61, // 4: league_service.SeasonResponse.season:type_name -> ipc.Season
-	61, // 5: league_service.PastSeasonsResponse.seasons:type_name -> ipc.Season
-	61, // 6: league_service.AllSeasonsResponse.seasons:type_name -> ipc.Season
-	61, // 7: league_service.RecentSeasonsResponse.seasons:type_name -> ipc.Season
-	62, // 8: league_service.BootstrapSeasonRequest.start_date:type_name -> google.protobuf.Timestamp
-	62, // 9: league_service.BootstrapSeasonRequest.end_date:type_name -> google.protobuf.Timestamp
-	63, // 10: league_service.BootstrapSeasonRequest.status:type_name -> ipc.SeasonStatus
-	64, // 11: league_service.DivisionStandingsResponse.division:type_name -> ipc.Division
-	64, // 12: league_service.AllDivisionStandingsResponse.divisions:type_name -> ipc.Division
-	24, // 13: league_service.SeasonRegistrationsResponse.registrations:type_name -> league_service.SeasonRegistration
-	27, // 14: league_service.PlayerHistoryResponse.seasons:type_name -> league_service.SeasonSummary
-	65, // 15: league_service.SeasonSummary.standing:type_name -> ipc.LeaguePlayerStanding
-	29, // 16: league_service.LeagueRosterResponse.players:type_name -> league_service.LeagueRosterPlayer
-	30, // 17: league_service.LeagueRosterPlayer.seasons:type_name -> league_service.LeagueRosterSeason
-	66, // 18: league_service.LeagueRosterSeason.result:type_name -> ipc.StandingResult
-	32, // 19: league_service.LeagueStatisticsResponse.stats:type_name -> league_service.LeagueStat
-	35, // 20: league_service.GetPlayerSeasonGamesResponse.games:type_name -> league_service.PlayerSeasonGame
-	62, // 21: league_service.PlayerSeasonGame.game_date:type_name -> google.protobuf.Timestamp
-	67, // 22: league_service.PlayerSeasonGame.game_end_reason:type_name -> ipc.GameEndReason
-	62, // 23: league_service.PlayerSeasonGame.last_update:type_name -> google.protobuf.Timestamp
-	45, // 24: league_service.SeasonZeroMoveGamesResponse.games:type_name -> league_service.ZeroMoveGame
-	62, // 25: league_service.ZeroMoveGame.created_at:type_name -> google.protobuf.Timestamp
-	47, // 26: league_service.SeasonPlayersWithUnstartedGamesResponse.players:type_name -> league_service.PlayerWithUnstartedGames
-	62, // 27: league_service.UpdateSeasonDatesRequest.start_date:type_name -> google.protobuf.Timestamp
-	62, // 28: league_service.UpdateSeasonDatesRequest.end_date:type_name -> google.protobuf.Timestamp
-	68, // 29: league_service.UpdateSeasonPromotionFormulaRequest.promotion_formula:type_name -> ipc.PromotionFormula
+	80, // 0: league_service.CreateLeagueRequest.settings:type_name -> ipc.LeagueSettings
+	81, // 1: league_service.GetAllLeaguesResponse.leagues:type_name -> ipc.League
+	81, // 2: league_service.LeagueResponse.league:type_name -> ipc.League
+	80, // 3: league_service.UpdateLeagueSettingsRequest.settings:type_name -> ipc.LeagueSettings
+	82, // 4: league_service.SeasonResponse.season:type_name -> ipc.Season
+	82, // 5: league_service.PastSeasonsResponse.seasons:type_name -> ipc.Season
+	82, // 6: league_service.AllSeasonsResponse.seasons:type_name -> ipc.Season
+	82, // 7: league_service.RecentSeasonsResponse.seasons:type_name -> ipc.Season
+	83, // 8: league_service.BootstrapSeasonRequest.start_date:type_name -> google.protobuf.Timestamp
+	83, // 9: league_service.BootstrapSeasonRequest.end_date:type_name -> google.protobuf.Timestamp
+	84, // 10: league_service.BootstrapSeasonRequest.status:type_name -> ipc.SeasonStatus
+	85, // 11: league_service.DivisionStandingsResponse.division:type_name -> ipc.Division
+	85, // 12: league_service.AllDivisionStandingsResponse.divisions:type_name -> ipc.Division
+	26, // 13: league_service.SeasonRegistrationsResponse.registrations:type_name -> league_service.SeasonRegistration
+	29, // 14: league_service.PlayerHistoryResponse.seasons:type_name -> league_service.SeasonSummary
+	86, // 15: league_service.SeasonSummary.standing:type_name -> ipc.LeaguePlayerStanding
+	31, // 16: league_service.LeagueRosterResponse.players:type_name -> league_service.LeagueRosterPlayer
+	32, // 17: league_service.LeagueRosterPlayer.seasons:type_name -> league_service.LeagueRosterSeason
+	87, // 18: league_service.LeagueRosterSeason.result:type_name -> ipc.StandingResult
+	34, // 19: league_service.LeagueStatisticsResponse.stats:type_name -> league_service.LeagueStat
+	37, // 20: league_service.GetPlayerSeasonGamesResponse.games:type_name -> league_service.PlayerSeasonGame
+	83, // 21: league_service.PlayerSeasonGame.game_date:type_name -> google.protobuf.Timestamp
+	88, // 22: league_service.PlayerSeasonGame.game_end_reason:type_name -> ipc.GameEndReason
+	83, // 23: league_service.PlayerSeasonGame.last_update:type_name -> google.protobuf.Timestamp
+	47, // 24: league_service.SeasonZeroMoveGamesResponse.games:type_name -> league_service.ZeroMoveGame
+	83, // 25: league_service.ZeroMoveGame.created_at:type_name -> google.protobuf.Timestamp
+	49, // 26: league_service.SeasonPlayersWithUnstartedGamesResponse.players:type_name -> league_service.PlayerWithUnstartedGames
+	83, // 27: league_service.UpdateSeasonDatesRequest.start_date:type_name -> google.protobuf.Timestamp
+	83, // 28: league_service.UpdateSeasonDatesRequest.end_date:type_name -> google.protobuf.Timestamp
+	89, // 29: league_service.UpdateSeasonPromotionFormulaRequest.promotion_formula:type_name -> ipc.PromotionFormula
 	0,  // 30: league_service.AddSeasonTimeBankRequest.scope:type_name -> league_service.TimeBankScope
-	57, // 31: league_service.GetPlayerLeagueH2HResponse.records:type_name -> league_service.H2HRecord
-	58, // 32: league_service.H2HRecord.season_games:type_name -> league_service.H2HSeasonGame
-	1,  // 33: league_service.LeagueService.CreateLeague:input_type -> league_service.CreateLeagueRequest
-	2,  // 34: league_service.LeagueService.GetLeague:input_type -> league_service.LeagueRequest
-	3,  // 35: league_service.LeagueService.GetAllLeagues:input_type -> league_service.GetAllLeaguesRequest
-	6,  // 36: league_service.LeagueService.UpdateLeagueSettings:input_type -> league_service.UpdateLeagueSettingsRequest
-	7,  // 37: league_service.LeagueService.UpdateLeagueMetadata:input_type -> league_service.UpdateLeagueMetadataRequest
-	14, // 38: league_service.LeagueService.BootstrapSeason:input_type -> league_service.BootstrapSeasonRequest
-	8,  // 39: league_service.LeagueService.GetSeason:input_type -> league_service.SeasonRequest
-	2,  // 40: league_service.LeagueService.GetCurrentSeason:input_type -> league_service.LeagueRequest
-	2,  // 41: league_service.LeagueService.GetPastSeasons:input_type -> league_service.LeagueRequest
-	2,  // 42: league_service.LeagueService.GetAllSeasons:input_type -> league_service.LeagueRequest
-	12, // 43: league_service.LeagueService.GetRecentSeasons:input_type -> league_service.GetRecentSeasonsRequest
-	15, // 44: league_service.LeagueService.OpenRegistration:input_type -> league_service.OpenRegistrationRequest
-	16, // 45: league_service.LeagueService.GetDivisionStandings:input_type -> league_service.DivisionRequest
-	8,  // 46: league_service.LeagueService.GetAllDivisionStandings:input_type -> league_service.SeasonRequest
-	69, // 47: league_service.LeagueService.GetDivisionTimeBankWarnings:input_type -> ipc.GetDivisionTimeBankWarningsRequest
-	19, // 48: league_service.LeagueService.RegisterForSeason:input_type -> league_service.RegisterRequest
-	21, // 49: league_service.LeagueService.UnregisterFromSeason:input_type -> league_service.UnregisterRequest
-	8,  // 50: league_service.LeagueService.GetSeasonRegistrations:input_type -> league_service.SeasonRequest
-	25, // 51: league_service.LeagueService.GetPlayerLeagueHistory:input_type -> league_service.PlayerHistoryRequest
-	33, // 52: league_service.LeagueService.GetPlayerSeasonGames:input_type -> league_service.GetPlayerSeasonGamesRequest
-	36, // 53: league_service.LeagueService.InviteUserToLeagues:input_type -> league_service.InviteUserRequest
-	36, // 54: league_service.LeagueService.RevokeUserFromLeagues:input_type -> league_service.InviteUserRequest
-	2,  // 55: league_service.LeagueService.GetLeagueRoster:input_type -> league_service.LeagueRequest
-	55, // 56: league_service.LeagueService.GetPlayerLeagueH2H:input_type -> league_service.GetPlayerLeagueH2HRequest
-	2,  // 57: league_service.LeagueService.GetLeagueStatistics:input_type -> league_service.LeagueRequest
-	38, // 58: league_service.LeagueService.MovePlayerToDivision:input_type -> league_service.MovePlayerToDivisionRequest
-	40, // 59: league_service.LeagueService.CreateDivision:input_type -> league_service.CreateDivisionRequest
-	42, // 60: league_service.LeagueService.DeleteDivision:input_type -> league_service.DeleteDivisionRequest
-	8,  // 61: league_service.LeagueService.GetSeasonZeroMoveGames:input_type -> league_service.SeasonRequest
-	8,  // 62: league_service.LeagueService.GetSeasonPlayersWithUnstartedGames:input_type -> league_service.SeasonRequest
-	48, // 63: league_service.LeagueService.UpdateSeasonDates:input_type -> league_service.UpdateSeasonDatesRequest
-	49, // 64: league_service.LeagueService.UpdateSeasonPromotionFormula:input_type -> league_service.UpdateSeasonPromotionFormulaRequest
-	8,  // 65: league_service.LeagueService.RecalculateSeasonExtendedStats:input_type -> league_service.SeasonRequest
-	51, // 66: league_service.LeagueService.AddSeasonTimeBank:input_type -> league_service.AddSeasonTimeBankRequest
-	53, // 67: league_service.LeagueService.CancelPlayerResults:input_type -> league_service.CancelPlayerResultsRequest
-	5,  // 68: league_service.LeagueService.CreateLeague:output_type -> league_service.LeagueResponse
-	5,  // 69: league_service.LeagueService.GetLeague:output_type -> league_service.LeagueResponse
-	4,  // 70: league_service.LeagueService.GetAllLeagues:output_type -> league_service.GetAllLeaguesResponse
-	5,  // 71: league_service.LeagueService.UpdateLeagueSettings:output_type -> league_service.LeagueResponse
-	5,  // 72: league_service.LeagueService.UpdateLeagueMetadata:output_type -> league_service.LeagueResponse
-	9,  // 73: league_service.LeagueService.BootstrapSeason:output_type -> league_service.SeasonResponse
-	9,  // 74: league_service.LeagueService.GetSeason:output_type -> league_service.SeasonResponse
-	9,  // 75: league_service.LeagueService.GetCurrentSeason:output_type -> league_service.SeasonResponse
-	10, // 76: league_service.LeagueService.GetPastSeasons:output_type -> league_service.PastSeasonsResponse
-	11, // 77: league_service.LeagueService.GetAllSeasons:output_type -> league_service.AllSeasonsResponse
-	13, // 78: league_service.LeagueService.GetRecentSeasons:output_type -> league_service.RecentSeasonsResponse
-	9,  // 79: league_service.LeagueService.OpenRegistration:output_type -> league_service.SeasonResponse
-	17, // 80: league_service.LeagueService.GetDivisionStandings:output_type -> league_service.DivisionStandingsResponse
-	18, // 81: league_service.LeagueService.GetAllDivisionStandings:output_type -> league_service.AllDivisionStandingsResponse
-	70, // 82: league_service.LeagueService.GetDivisionTimeBankWarnings:output_type -> ipc.GetDivisionTimeBankWarningsResponse
-	20, // 83: league_service.LeagueService.RegisterForSeason:output_type -> league_service.RegisterResponse
-	22, // 84: league_service.LeagueService.UnregisterFromSeason:output_type -> league_service.UnregisterResponse
-	23, // 85: league_service.LeagueService.GetSeasonRegistrations:output_type -> league_service.SeasonRegistrationsResponse
-	26, // 86: league_service.LeagueService.GetPlayerLeagueHistory:output_type -> league_service.PlayerHistoryResponse
-	34, // 87: league_service.LeagueService.GetPlayerSeasonGames:output_type -> league_service.GetPlayerSeasonGamesResponse
-	37, // 88: league_service.LeagueService.InviteUserToLeagues:output_type -> league_service.InviteUserResponse
-	37, // 89: league_service.LeagueService.RevokeUserFromLeagues:output_type -> league_service.InviteUserResponse
-	28, // 90: league_service.LeagueService.GetLeagueRoster:output_type -> league_service.LeagueRosterResponse
-	56, // 91: league_service.LeagueService.GetPlayerLeagueH2H:output_type -> league_service.GetPlayerLeagueH2HResponse
-	31, // 92: league_service.LeagueService.GetLeagueStatistics:output_type -> league_service.LeagueStatisticsResponse
-	39, // 93: league_service.LeagueService.MovePlayerToDivision:output_type -> league_service.MovePlayerToDivisionResponse
-	41, // 94: league_service.LeagueService.CreateDivision:output_type -> league_service.CreateDivisionResponse
-	43, // 95: league_service.LeagueService.DeleteDivision:output_type -> league_service.DeleteDivisionResponse
-	44, // 96: league_service.LeagueService.GetSeasonZeroMoveGames:output_type -> league_service.SeasonZeroMoveGamesResponse
-	46, // 97: league_service.LeagueService.GetSeasonPlayersWithUnstartedGames:output_type -> league_service.SeasonPlayersWithUnstartedGamesResponse
-	9,  // 98: league_service.LeagueService.UpdateSeasonDates:output_type -> league_service.SeasonResponse
-	9,  // 99: league_service.LeagueService.UpdateSeasonPromotionFormula:output_type -> league_service.SeasonResponse
-	50, // 100: league_service.LeagueService.RecalculateSeasonExtendedStats:output_type -> league_service.RecalculateExtendedStatsResponse
-	52, // 101: league_service.LeagueService.AddSeasonTimeBank:output_type -> league_service.AddSeasonTimeBankResponse
-	54, // 102: league_service.LeagueService.CancelPlayerResults:output_type -> league_service.CancelPlayerResultsResponse
-	68, // [68:103] is the sub-list for method output_type
-	33, // [33:68] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	59, // 31: league_service.GetPlayerLeagueH2HResponse.records:type_name -> league_service.H2HRecord
+	60, // 32: league_service.H2HRecord.season_games:type_name -> league_service.H2HSeasonGame
+	83, // 33: league_service.MatchTimeProposal.slot:type_name -> google.protobuf.Timestamp
+	2,  // 34: league_service.MatchTimeProposal.status:type_name -> league_service.MatchProposalStatus
+	83, // 35: league_service.LeagueMatch.window_start:type_name -> google.protobuf.Timestamp
+	83, // 36: league_service.LeagueMatch.window_end:type_name -> google.protobuf.Timestamp
+	1,  // 37: league_service.LeagueMatch.status:type_name -> league_service.LeagueMatchStatus
+	83, // 38: league_service.LeagueMatch.scheduled_time:type_name -> google.protobuf.Timestamp
+	61, // 39: league_service.LeagueMatch.proposals:type_name -> league_service.MatchTimeProposal
+	62, // 40: league_service.LeagueMatchesResponse.matches:type_name -> league_service.LeagueMatch
+	62, // 41: league_service.LeagueMatchResponse.match:type_name -> league_service.LeagueMatch
+	83, // 42: league_service.ProposeMatchTimeRequest.slot:type_name -> google.protobuf.Timestamp
+	83, // 43: league_service.LeagueSubstitute.joined_at:type_name -> google.protobuf.Timestamp
+	70, // 44: league_service.SubstitutePoolResponse.substitutes:type_name -> league_service.LeagueSubstitute
+	90, // 45: league_service.SubstitutePlayerRequest.handling:type_name -> ipc.SubstituteResultHandling
+	83, // 46: league_service.LeagueRatingPoint.played_at:type_name -> google.protobuf.Timestamp
+	75, // 47: league_service.LeagueRatingHistoryResponse.history:type_name -> league_service.LeagueRatingPoint
+	83, // 48: league_service.PlayerLeagueRating.last_game_at:type_name -> google.protobuf.Timestamp
+	78, // 49: league_service.PlayerLeagueRatingsResponse.ratings:type_name -> league_service.PlayerLeagueRating
+	3,  // 50: league_service.LeagueService.CreateLeague:input_type -> league_service.CreateLeagueRequest
+	4,  // 51: league_service.LeagueService.GetLeague:input_type -> league_service.LeagueRequest
+	5,  // 52: league_service.LeagueService.GetAllLeagues:input_type -> league_service.GetAllLeaguesRequest
+	8,  // 53: league_service.LeagueService.UpdateLeagueSettings:input_type -> league_service.UpdateLeagueSettingsRequest
+	9,  // 54: league_service.LeagueService.UpdateLeagueMetadata:input_type -> league_service.UpdateLeagueMetadataRequest
+	16, // 55: league_service.LeagueService.BootstrapSeason:input_type -> league_service.BootstrapSeasonRequest
+	10, // 56: league_service.LeagueService.GetSeason:input_type -> league_service.SeasonRequest
+	4,  // 57: league_service.LeagueService.GetCurrentSeason:input_type -> league_service.LeagueRequest
+	4,  // 58: league_service.LeagueService.GetPastSeasons:input_type -> league_service.LeagueRequest
+	4,  // 59: league_service.LeagueService.GetAllSeasons:input_type -> league_service.LeagueRequest
+	14, // 60: league_service.LeagueService.GetRecentSeasons:input_type -> league_service.GetRecentSeasonsRequest
+	17, // 61: league_service.LeagueService.OpenRegistration:input_type -> league_service.OpenRegistrationRequest
+	18, // 62: league_service.LeagueService.GetDivisionStandings:input_type -> league_service.DivisionRequest
+	10, // 63: league_service.LeagueService.GetAllDivisionStandings:input_type -> league_service.SeasonRequest
+	91, // 64: league_service.LeagueService.GetDivisionTimeBankWarnings:input_type -> ipc.GetDivisionTimeBankWarningsRequest
+	21, // 65: league_service.LeagueService.RegisterForSeason:input_type -> league_service.RegisterRequest
+	23, // 66: league_service.LeagueService.UnregisterFromSeason:input_type -> league_service.UnregisterRequest
+	10, // 67: league_service.LeagueService.GetSeasonRegistrations:input_type -> league_service.SeasonRequest
+	27, // 68: league_service.LeagueService.GetPlayerLeagueHistory:input_type -> league_service.PlayerHistoryRequest
+	35, // 69: league_service.LeagueService.GetPlayerSeasonGames:input_type -> league_service.GetPlayerSeasonGamesRequest
+	38, // 70: league_service.LeagueService.InviteUserToLeagues:input_type -> league_service.InviteUserRequest
+	38, // 71: league_service.LeagueService.RevokeUserFromLeagues:input_type -> league_service.InviteUserRequest
+	4,  // 72: league_service.LeagueService.GetLeagueRoster:input_type -> league_service.LeagueRequest
+	57, // 73: league_service.LeagueService.GetPlayerLeagueH2H:input_type -> league_service.GetPlayerLeagueH2HRequest
+	4,  // 74: league_service.LeagueService.GetLeagueStatistics:input_type -> league_service.LeagueRequest
+	40, // 75: league_service.LeagueService.MovePlayerToDivision:input_type -> league_service.MovePlayerToDivisionRequest
+	42, // 76: league_service.LeagueService.CreateDivision:input_type -> league_service.CreateDivisionRequest
+	44, // 77: league_service.LeagueService.DeleteDivision:input_type -> league_service.DeleteDivisionRequest
+	10, // 78: league_service.LeagueService.GetSeasonZeroMoveGames:input_type -> league_service.SeasonRequest
+	10, // 79: league_service.LeagueService.GetSeasonPlayersWithUnstartedGames:input_type -> league_service.SeasonRequest
+	50, // 80: league_service.LeagueService.UpdateSeasonDates:input_type -> league_service.UpdateSeasonDatesRequest
+	51, // 81: league_service.LeagueService.UpdateSeasonPromotionFormula:input_type -> league_service.UpdateSeasonPromotionFormulaRequest
+	10, // 82: league_service.LeagueService.RecalculateSeasonExtendedStats:input_type -> league_service.SeasonRequest
+	53, // 83: league_service.LeagueService.AddSeasonTimeBank:input_type -> league_service.AddSeasonTimeBankRequest
+	55, // 84: league_service.LeagueService.CancelPlayerResults:input_type -> league_service.CancelPlayerResultsRequest
+	63, // 85: league_service.LeagueService.GetLeagueMatches:input_type -> league_service.GetLeagueMatchesRequest
+	67, // 86: league_service.LeagueService.ProposeMatchTime:input_type -> league_service.ProposeMatchTimeRequest
+	68, // 87: league_service.LeagueService.RespondToMatchProposal:input_type -> league_service.RespondToMatchProposalRequest
+	65, // 88: league_service.LeagueService.StartLeagueMatch:input_type -> league_service.LeagueMatchRequest
+	4,  // 89: league_service.LeagueService.JoinSubstitutePool:input_type -> league_service.LeagueRequest
+	4,  // 90: league_service.LeagueService.LeaveSubstitutePool:input_type -> league_service.LeagueRequest
+	4,  // 91: league_service.LeagueService.GetSubstitutePool:input_type -> league_service.LeagueRequest
+	72, // 92: league_service.LeagueService.SubstitutePlayer:input_type -> league_service.SubstitutePlayerRequest
+	74, // 93: league_service.LeagueService.GetLeagueRatingHistory:input_type -> league_service.GetLeagueRatingHistoryRequest
+	77, // 94: league_service.LeagueService.GetPlayerLeagueRatings:input_type -> league_service.GetPlayerLeagueRatingsRequest
+	7,  // 95: league_service.LeagueService.CreateLeague:output_type -> league_service.LeagueResponse
+	7,  // 96: league_service.LeagueService.GetLeague:output_type -> league_service.LeagueResponse
+	6,  // 97: league_service.LeagueService.GetAllLeagues:output_type -> league_service.GetAllLeaguesResponse
+	7,  // 98: league_service.LeagueService.UpdateLeagueSettings:output_type -> league_service.LeagueResponse
+	7,  // 99: league_service.LeagueService.UpdateLeagueMetadata:output_type -> league_service.LeagueResponse
+	11, // 100: league_service.LeagueService.BootstrapSeason:output_type -> league_service.SeasonResponse
+	11, // 101: league_service.LeagueService.GetSeason:output_type -> league_service.SeasonResponse
+	11, // 102: league_service.LeagueService.GetCurrentSeason:output_type -> league_service.SeasonResponse
+	12, // 103: league_service.LeagueService.GetPastSeasons:output_type -> league_service.PastSeasonsResponse
+	13, // 104: league_service.LeagueService.GetAllSeasons:output_type -> league_service.AllSeasonsResponse
+	15, // 105: league_service.LeagueService.GetRecentSeasons:output_type -> league_service.RecentSeasonsResponse
+	11, // 106: league_service.LeagueService.OpenRegistration:output_type -> league_service.SeasonResponse
+	19, // 107: league_service.LeagueService.GetDivisionStandings:output_type -> league_service.DivisionStandingsResponse
+	20, // 108: league_service.LeagueService.GetAllDivisionStandings:output_type -> league_service.AllDivisionStandingsResponse
+	92, // 109: league_service.LeagueService.GetDivisionTimeBankWarnings:output_type -> ipc.GetDivisionTimeBankWarningsResponse
+	22, // 110: league_service.LeagueService.RegisterForSeason:output_type -> league_service.RegisterResponse
+	24, // 111: league_service.LeagueService.UnregisterFromSeason:output_type -> league_service.UnregisterResponse
+	25, // 112: league_service.LeagueService.GetSeasonRegistrations:output_type -> league_service.SeasonRegistrationsResponse
+	28, // 113: league_service.LeagueService.GetPlayerLeagueHistory:output_type -> league_service.PlayerHistoryResponse
+	36, // 114: league_service.LeagueService.GetPlayerSeasonGames:output_type -> league_service.GetPlayerSeasonGamesResponse
+	39, // 115: league_service.LeagueService.InviteUserToLeagues:output_type -> league_service.InviteUserResponse
+	39, // 116: league_service.LeagueService.RevokeUserFromLeagues:output_type -> league_service.InviteUserResponse
+	30, // 117: league_service.LeagueService.GetLeagueRoster:output_type -> league_service.LeagueRosterResponse
+	58, // 118: league_service.LeagueService.GetPlayerLeagueH2H:output_type -> league_service.GetPlayerLeagueH2HResponse
+	33, // 119: league_service.LeagueService.GetLeagueStatistics:output_type -> league_service.LeagueStatisticsResponse
+	41, // 120: league_service.LeagueService.MovePlayerToDivision:output_type -> league_service.MovePlayerToDivisionResponse
+	43, // 121: league_service.LeagueService.CreateDivision:output_type -> league_service.CreateDivisionResponse
+	45, // 122: league_service.LeagueService.DeleteDivision:output_type -> league_service.DeleteDivisionResponse
+	46, // 123: league_service.LeagueService.GetSeasonZeroMoveGames:output_type -> league_service.SeasonZeroMoveGamesResponse
+	48, // 124: league_service.LeagueService.GetSeasonPlayersWithUnstartedGames:output_type -> league_service.SeasonPlayersWithUnstartedGamesResponse
+	11, // 125: league_service.LeagueService.UpdateSeasonDates:output_type -> league_service.SeasonResponse
+	11, // 126: league_service.LeagueService.UpdateSeasonPromotionFormula:output_type -> league_service.SeasonResponse
+	52, // 127: league_service.LeagueService.RecalculateSeasonExtendedStats:output_type -> league_service.RecalculateExtendedStatsResponse
+	54, // 128: league_service.LeagueService.AddSeasonTimeBank:output_type -> league_service.AddSeasonTimeBankResponse
+	56, // 129: league_service.LeagueService.CancelPlayerResults:output_type -> league_service.CancelPlayerResultsResponse
+	64, // 130: league_service.LeagueService.GetLeagueMatches:output_type -> league_service.LeagueMatchesResponse
+	66, // 131: league_service.LeagueService.ProposeMatchTime:output_type -> league_service.LeagueMatchResponse
+	66, // 132: league_service.LeagueService.RespondToMatchProposal:output_type -> league_service.LeagueMatchResponse
+	69, // 133: league_service.LeagueService.StartLeagueMatch:output_type -> league_service.StartLeagueMatchResponse
+	71, // 134: league_service.LeagueService.JoinSubstitutePool:output_type -> league_service.SubstitutePoolResponse
+	71, // 135: league_service.LeagueService.LeaveSubstitutePool:output_type -> league_service.SubstitutePoolResponse
+	71, // 136: league_service.LeagueService.GetSubstitutePool:output_type -> league_service.SubstitutePoolResponse
+	73, // 137: league_service.LeagueService.SubstitutePlayer:output_type -> league_service.SubstitutePlayerResponse
+	76, // 138: league_service.LeagueService.GetLeagueRatingHistory:output_type -> league_service.LeagueRatingHistoryResponse
+	79, // 139: league_service.LeagueService.GetPlayerLeagueRatings:output_type -> league_service.PlayerLeagueRatingsResponse
+	95, // [95:140] is the sub-list for method output_type
+	50, // [50:95] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_proto_league_service_league_service_proto_init() }
@@ -3910,8 +5402,8 @@ func file_proto_league_service_league_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_league_service_league_service_proto_rawDesc), len(file_proto_league_service_league_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   58,
+			NumEnums:      3,
+			NumMessages:   77,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
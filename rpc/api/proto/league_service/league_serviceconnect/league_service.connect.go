@@ -137,6 +137,36 @@ const (
 	// LeagueServiceCancelPlayerResultsProcedure is the fully-qualified name of the LeagueService's
 	// CancelPlayerResults RPC.
 	LeagueServiceCancelPlayerResultsProcedure = "/league_service.LeagueService/CancelPlayerResults"
+	// LeagueServiceGetLeagueMatchesProcedure is the fully-qualified name of the LeagueService's
+	// GetLeagueMatches RPC.
+	LeagueServiceGetLeagueMatchesProcedure = "/league_service.LeagueService/GetLeagueMatches"
+	// LeagueServiceProposeMatchTimeProcedure is the fully-qualified name of the LeagueService's
+	// ProposeMatchTime RPC.
+	LeagueServiceProposeMatchTimeProcedure = "/league_service.LeagueService/ProposeMatchTime"
+	// LeagueServiceRespondToMatchProposalProcedure is the fully-qualified name of the LeagueService's
+	// RespondToMatchProposal RPC.
+	LeagueServiceRespondToMatchProposalProcedure = "/league_service.LeagueService/RespondToMatchProposal"
+	// LeagueServiceStartLeagueMatchProcedure is the fully-qualified name of the LeagueService's
+	// StartLeagueMatch RPC.
+	LeagueServiceStartLeagueMatchProcedure = "/league_service.LeagueService/StartLeagueMatch"
+	// LeagueServiceJoinSubstitutePoolProcedure is the fully-qualified name of the LeagueService's
+	// JoinSubstitutePool RPC.
+	LeagueServiceJoinSubstitutePoolProcedure = "/league_service.LeagueService/JoinSubstitutePool"
+	// LeagueServiceLeaveSubstitutePoolProcedure is the fully-qualified name of the LeagueService's
+	// LeaveSubstitutePool RPC.
+	LeagueServiceLeaveSubstitutePoolProcedure = "/league_service.LeagueService/LeaveSubstitutePool"
+	// LeagueServiceGetSubstitutePoolProcedure is the fully-qualified name of the LeagueService's
+	// GetSubstitutePool RPC.
+	LeagueServiceGetSubstitutePoolProcedure = "/league_service.LeagueService/GetSubstitutePool"
+	// LeagueServiceSubstitutePlayerProcedure is the fully-qualified name of the LeagueService's
+	// SubstitutePlayer RPC.
+	LeagueServiceSubstitutePlayerProcedure = "/league_service.LeagueService/SubstitutePlayer"
+	// LeagueServiceGetLeagueRatingHistoryProcedure is the fully-qualified name of the LeagueService's
+	// GetLeagueRatingHistory RPC.
+	LeagueServiceGetLeagueRatingHistoryProcedure = "/league_service.LeagueService/GetLeagueRatingHistory"
+	// LeagueServiceGetPlayerLeagueRatingsProcedure is the fully-qualified name of the LeagueService's
+	// GetPlayerLeagueRatings RPC.
+	LeagueServiceGetPlayerLeagueRatingsProcedure = "/league_service.LeagueService/GetPlayerLeagueRatings"
 )
 
 // LeagueServiceClient is a client for the league_service.LeagueService service.
@@ -184,6 +214,19 @@ type LeagueServiceClient interface {
 	RecalculateSeasonExtendedStats(context.Context, *connect.Request[league_service.SeasonRequest]) (*connect.Response[league_service.RecalculateExtendedStatsResponse], error)
 	AddSeasonTimeBank(context.Context, *connect.Request[league_service.AddSeasonTimeBankRequest]) (*connect.Response[league_service.AddSeasonTimeBankResponse], error)
 	CancelPlayerResults(context.Context, *connect.Request[league_service.CancelPlayerResultsRequest]) (*connect.Response[league_service.CancelPlayerResultsResponse], error)
+	// Live league scheduling
+	GetLeagueMatches(context.Context, *connect.Request[league_service.GetLeagueMatchesRequest]) (*connect.Response[league_service.LeagueMatchesResponse], error)
+	ProposeMatchTime(context.Context, *connect.Request[league_service.ProposeMatchTimeRequest]) (*connect.Response[league_service.LeagueMatchResponse], error)
+	RespondToMatchProposal(context.Context, *connect.Request[league_service.RespondToMatchProposalRequest]) (*connect.Response[league_service.LeagueMatchResponse], error)
+	StartLeagueMatch(context.Context, *connect.Request[league_service.LeagueMatchRequest]) (*connect.Response[league_service.StartLeagueMatchResponse], error)
+	// Substitutes
+	JoinSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error)
+	LeaveSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error)
+	GetSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error)
+	SubstitutePlayer(context.Context, *connect.Request[league_service.SubstitutePlayerRequest]) (*connect.Response[league_service.SubstitutePlayerResponse], error)
+	// League ratings
+	GetLeagueRatingHistory(context.Context, *connect.Request[league_service.GetLeagueRatingHistoryRequest]) (*connect.Response[league_service.LeagueRatingHistoryResponse], error)
+	GetPlayerLeagueRatings(context.Context, *connect.Request[league_service.GetPlayerLeagueRatingsRequest]) (*connect.Response[league_service.PlayerLeagueRatingsResponse], error)
 }
 
 // NewLeagueServiceClient constructs a client for the league_service.LeagueService service. By
@@ -407,6 +450,66 @@ func NewLeagueServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(leagueServiceMethods.ByName("CancelPlayerResults")),
 			connect.WithClientOptions(opts...),
 		),
+		getLeagueMatches: connect.NewClient[league_service.GetLeagueMatchesRequest, league_service.LeagueMatchesResponse](
+			httpClient,
+			baseURL+LeagueServiceGetLeagueMatchesProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("GetLeagueMatches")),
+			connect.WithClientOptions(opts...),
+		),
+		proposeMatchTime: connect.NewClient[league_service.ProposeMatchTimeRequest, league_service.LeagueMatchResponse](
+			httpClient,
+			baseURL+LeagueServiceProposeMatchTimeProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("ProposeMatchTime")),
+			connect.WithClientOptions(opts...),
+		),
+		respondToMatchProposal: connect.NewClient[league_service.RespondToMatchProposalRequest, league_service.LeagueMatchResponse](
+			httpClient,
+			baseURL+LeagueServiceRespondToMatchProposalProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("RespondToMatchProposal")),
+			connect.WithClientOptions(opts...),
+		),
+		startLeagueMatch: connect.NewClient[league_service.LeagueMatchRequest, league_service.StartLeagueMatchResponse](
+			httpClient,
+			baseURL+LeagueServiceStartLeagueMatchProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("StartLeagueMatch")),
+			connect.WithClientOptions(opts...),
+		),
+		joinSubstitutePool: connect.NewClient[league_service.LeagueRequest, league_service.SubstitutePoolResponse](
+			httpClient,
+			baseURL+LeagueServiceJoinSubstitutePoolProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("JoinSubstitutePool")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveSubstitutePool: connect.NewClient[league_service.LeagueRequest, league_service.SubstitutePoolResponse](
+			httpClient,
+			baseURL+LeagueServiceLeaveSubstitutePoolProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("LeaveSubstitutePool")),
+			connect.WithClientOptions(opts...),
+		),
+		getSubstitutePool: connect.NewClient[league_service.LeagueRequest, league_service.SubstitutePoolResponse](
+			httpClient,
+			baseURL+LeagueServiceGetSubstitutePoolProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("GetSubstitutePool")),
+			connect.WithClientOptions(opts...),
+		),
+		substitutePlayer: connect.NewClient[league_service.SubstitutePlayerRequest, league_service.SubstitutePlayerResponse](
+			httpClient,
+			baseURL+LeagueServiceSubstitutePlayerProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("SubstitutePlayer")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeagueRatingHistory: connect.NewClient[league_service.GetLeagueRatingHistoryRequest, league_service.LeagueRatingHistoryResponse](
+			httpClient,
+			baseURL+LeagueServiceGetLeagueRatingHistoryProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("GetLeagueRatingHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlayerLeagueRatings: connect.NewClient[league_service.GetPlayerLeagueRatingsRequest, league_service.PlayerLeagueRatingsResponse](
+			httpClient,
+			baseURL+LeagueServiceGetPlayerLeagueRatingsProcedure,
+			connect.WithSchema(leagueServiceMethods.ByName("GetPlayerLeagueRatings")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -447,6 +550,16 @@ type leagueServiceClient struct {
 	recalculateSeasonExtendedStats     *connect.Client[league_service.SeasonRequest, league_service.RecalculateExtendedStatsResponse]
 	addSeasonTimeBank                  *connect.Client[league_service.AddSeasonTimeBankRequest, league_service.AddSeasonTimeBankResponse]
 	cancelPlayerResults                *connect.Client[league_service.CancelPlayerResultsRequest, league_service.CancelPlayerResultsResponse]
+	getLeagueMatches                   *connect.Client[league_service.GetLeagueMatchesRequest, league_service.LeagueMatchesResponse]
+	proposeMatchTime                   *connect.Client[league_service.ProposeMatchTimeRequest, league_service.LeagueMatchResponse]
+	respondToMatchProposal             *connect.Client[league_service.RespondToMatchProposalRequest, league_service.LeagueMatchResponse]
+	startLeagueMatch                   *connect.Client[league_service.LeagueMatchRequest, league_service.StartLeagueMatchResponse]
+	joinSubstitutePool                 *connect.Client[league_service.LeagueRequest, league_service.SubstitutePoolResponse]
+	leaveSubstitutePool                *connect.Client[league_service.LeagueRequest, league_service.SubstitutePoolResponse]
+	getSubstitutePool                  *connect.Client[league_service.LeagueRequest, league_service.SubstitutePoolResponse]
+	substitutePlayer                   *connect.Client[league_service.SubstitutePlayerRequest, league_service.SubstitutePlayerResponse]
+	getLeagueRatingHistory             *connect.Client[league_service.GetLeagueRatingHistoryRequest, league_service.LeagueRatingHistoryResponse]
+	getPlayerLeagueRatings             *connect.Client[league_service.GetPlayerLeagueRatingsRequest, league_service.PlayerLeagueRatingsResponse]
 }
 
 // CreateLeague calls league_service.LeagueService.CreateLeague.
@@ -625,6 +738,56 @@ func (c *leagueServiceClient) CancelPlayerResults(ctx context.Context, req *conn
 	return c.cancelPlayerResults.CallUnary(ctx, req)
 }
 
+// GetLeagueMatches calls league_service.LeagueService.GetLeagueMatches.
+func (c *leagueServiceClient) GetLeagueMatches(ctx context.Context, req *connect.Request[league_service.GetLeagueMatchesRequest]) (*connect.Response[league_service.LeagueMatchesResponse], error) {
+	return c.getLeagueMatches.CallUnary(ctx, req)
+}
+
+// ProposeMatchTime calls league_service.LeagueService.ProposeMatchTime.
+func (c *leagueServiceClient) ProposeMatchTime(ctx context.Context, req *connect.Request[league_service.ProposeMatchTimeRequest]) (*connect.Response[league_service.LeagueMatchResponse], error) {
+	return c.proposeMatchTime.CallUnary(ctx, req)
+}
+
+// RespondToMatchProposal calls league_service.LeagueService.RespondToMatchProposal.
+func (c *leagueServiceClient) RespondToMatchProposal(ctx context.Context, req *connect.Request[league_service.RespondToMatchProposalRequest]) (*connect.Response[league_service.LeagueMatchResponse], error) {
+	return c.respondToMatchProposal.CallUnary(ctx, req)
+}
+
+// StartLeagueMatch calls league_service.LeagueService.StartLeagueMatch.
+func (c *leagueServiceClient) StartLeagueMatch(ctx context.Context, req *connect.Request[league_service.LeagueMatchRequest]) (*connect.Response[league_service.StartLeagueMatchResponse], error) {
+	return c.startLeagueMatch.CallUnary(ctx, req)
+}
+
+// JoinSubstitutePool calls league_service.LeagueService.JoinSubstitutePool.
+func (c *leagueServiceClient) JoinSubstitutePool(ctx context.Context, req *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error) {
+	return c.joinSubstitutePool.CallUnary(ctx, req)
+}
+
+// LeaveSubstitutePool calls league_service.LeagueService.LeaveSubstitutePool.
+func (c *leagueServiceClient) LeaveSubstitutePool(ctx context.Context, req *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error) {
+	return c.leaveSubstitutePool.CallUnary(ctx, req)
+}
+
+// GetSubstitutePool calls league_service.LeagueService.GetSubstitutePool.
+func (c *leagueServiceClient) GetSubstitutePool(ctx context.Context, req *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error) {
+	return c.getSubstitutePool.CallUnary(ctx, req)
+}
+
+// SubstitutePlayer calls league_service.LeagueService.SubstitutePlayer.
+func (c *leagueServiceClient) SubstitutePlayer(ctx context.Context, req *connect.Request[league_service.SubstitutePlayerRequest]) (*connect.Response[league_service.SubstitutePlayerResponse], error) {
+	return c.substitutePlayer.CallUnary(ctx, req)
+}
+
+// GetLeagueRatingHistory calls league_service.LeagueService.GetLeagueRatingHistory.
+func (c *leagueServiceClient) GetLeagueRatingHistory(ctx context.Context, req *connect.Request[league_service.GetLeagueRatingHistoryRequest]) (*connect.Response[league_service.LeagueRatingHistoryResponse], error) {
+	return c.getLeagueRatingHistory.CallUnary(ctx, req)
+}
+
+// GetPlayerLeagueRatings calls league_service.LeagueService.GetPlayerLeagueRatings.
+func (c *leagueServiceClient) GetPlayerLeagueRatings(ctx context.Context, req *connect.Request[league_service.GetPlayerLeagueRatingsRequest]) (*connect.Response[league_service.PlayerLeagueRatingsResponse], error) {
+	return c.getPlayerLeagueRatings.CallUnary(ctx, req)
+}
+
 // LeagueServiceHandler is an implementation of the league_service.LeagueService service.
 type LeagueServiceHandler interface {
 	// League management
@@ -670,6 +833,19 @@ type LeagueServiceHandler interface {
 	RecalculateSeasonExtendedStats(context.Context, *connect.Request[league_service.SeasonRequest]) (*connect.Response[league_service.RecalculateExtendedStatsResponse], error)
 	AddSeasonTimeBank(context.Context, *connect.Request[league_service.AddSeasonTimeBankRequest]) (*connect.Response[league_service.AddSeasonTimeBankResponse], error)
 	CancelPlayerResults(context.Context, *connect.Request[league_service.CancelPlayerResultsRequest]) (*connect.Response[league_service.CancelPlayerResultsResponse], error)
+	// Live league scheduling
+	GetLeagueMatches(context.Context, *connect.Request[league_service.GetLeagueMatchesRequest]) (*connect.Response[league_service.LeagueMatchesResponse], error)
+	ProposeMatchTime(context.Context, *connect.Request[league_service.ProposeMatchTimeRequest]) (*connect.Response[league_service.LeagueMatchResponse], error)
+	RespondToMatchProposal(context.Context, *connect.Request[league_service.RespondToMatchProposalRequest]) (*connect.Response[league_service.LeagueMatchResponse], error)
+	StartLeagueMatch(context.Context, *connect.Request[league_service.LeagueMatchRequest]) (*connect.Response[league_service.StartLeagueMatchResponse], error)
+	// Substitutes
+	JoinSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error)
+	LeaveSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error)
+	GetSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error)
+	SubstitutePlayer(context.Context, *connect.Request[league_service.SubstitutePlayerRequest]) (*connect.Response[league_service.SubstitutePlayerResponse], error)
+	// League ratings
+	GetLeagueRatingHistory(context.Context, *connect.Request[league_service.GetLeagueRatingHistoryRequest]) (*connect.Response[league_service.LeagueRatingHistoryResponse], error)
+	GetPlayerLeagueRatings(context.Context, *connect.Request[league_service.GetPlayerLeagueRatingsRequest]) (*connect.Response[league_service.PlayerLeagueRatingsResponse], error)
 }
 
 // NewLeagueServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -889,6 +1065,66 @@ func NewLeagueServiceHandler(svc LeagueServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(leagueServiceMethods.ByName("CancelPlayerResults")),
 		connect.WithHandlerOptions(opts...),
 	)
+	leagueServiceGetLeagueMatchesHandler := connect.NewUnaryHandler(
+		LeagueServiceGetLeagueMatchesProcedure,
+		svc.GetLeagueMatches,
+		connect.WithSchema(leagueServiceMethods.ByName("GetLeagueMatches")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceProposeMatchTimeHandler := connect.NewUnaryHandler(
+		LeagueServiceProposeMatchTimeProcedure,
+		svc.ProposeMatchTime,
+		connect.WithSchema(leagueServiceMethods.ByName("ProposeMatchTime")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceRespondToMatchProposalHandler := connect.NewUnaryHandler(
+		LeagueServiceRespondToMatchProposalProcedure,
+		svc.RespondToMatchProposal,
+		connect.WithSchema(leagueServiceMethods.ByName("RespondToMatchProposal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceStartLeagueMatchHandler := connect.NewUnaryHandler(
+		LeagueServiceStartLeagueMatchProcedure,
+		svc.StartLeagueMatch,
+		connect.WithSchema(leagueServiceMethods.ByName("StartLeagueMatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceJoinSubstitutePoolHandler := connect.NewUnaryHandler(
+		LeagueServiceJoinSubstitutePoolProcedure,
+		svc.JoinSubstitutePool,
+		connect.WithSchema(leagueServiceMethods.ByName("JoinSubstitutePool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceLeaveSubstitutePoolHandler := connect.NewUnaryHandler(
+		LeagueServiceLeaveSubstitutePoolProcedure,
+		svc.LeaveSubstitutePool,
+		connect.WithSchema(leagueServiceMethods.ByName("LeaveSubstitutePool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceGetSubstitutePoolHandler := connect.NewUnaryHandler(
+		LeagueServiceGetSubstitutePoolProcedure,
+		svc.GetSubstitutePool,
+		connect.WithSchema(leagueServiceMethods.ByName("GetSubstitutePool")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceSubstitutePlayerHandler := connect.NewUnaryHandler(
+		LeagueServiceSubstitutePlayerProcedure,
+		svc.SubstitutePlayer,
+		connect.WithSchema(leagueServiceMethods.ByName("SubstitutePlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceGetLeagueRatingHistoryHandler := connect.NewUnaryHandler(
+		LeagueServiceGetLeagueRatingHistoryProcedure,
+		svc.GetLeagueRatingHistory,
+		connect.WithSchema(leagueServiceMethods.ByName("GetLeagueRatingHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	leagueServiceGetPlayerLeagueRatingsHandler := connect.NewUnaryHandler(
+		LeagueServiceGetPlayerLeagueRatingsProcedure,
+		svc.GetPlayerLeagueRatings,
+		connect.WithSchema(leagueServiceMethods.ByName("GetPlayerLeagueRatings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/league_service.LeagueService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case LeagueServiceCreateLeagueProcedure:
@@ -961,6 +1197,26 @@ func NewLeagueServiceHandler(svc LeagueServiceHandler, opts ...connect.HandlerOp
 			leagueServiceAddSeasonTimeBankHandler.ServeHTTP(w, r)
 		case LeagueServiceCancelPlayerResultsProcedure:
 			leagueServiceCancelPlayerResultsHandler.ServeHTTP(w, r)
+		case LeagueServiceGetLeagueMatchesProcedure:
+			leagueServiceGetLeagueMatchesHandler.ServeHTTP(w, r)
+		case LeagueServiceProposeMatchTimeProcedure:
+			leagueServiceProposeMatchTimeHandler.ServeHTTP(w, r)
+		case LeagueServiceRespondToMatchProposalProcedure:
+			leagueServiceRespondToMatchProposalHandler.ServeHTTP(w, r)
+		case LeagueServiceStartLeagueMatchProcedure:
+			leagueServiceStartLeagueMatchHandler.ServeHTTP(w, r)
+		case LeagueServiceJoinSubstitutePoolProcedure:
+			leagueServiceJoinSubstitutePoolHandler.ServeHTTP(w, r)
+		case LeagueServiceLeaveSubstitutePoolProcedure:
+			leagueServiceLeaveSubstitutePoolHandler.ServeHTTP(w, r)
+		case LeagueServiceGetSubstitutePoolProcedure:
+			leagueServiceGetSubstitutePoolHandler.ServeHTTP(w, r)
+		case LeagueServiceSubstitutePlayerProcedure:
+			leagueServiceSubstitutePlayerHandler.ServeHTTP(w, r)
+		case LeagueServiceGetLeagueRatingHistoryProcedure:
+			leagueServiceGetLeagueRatingHistoryHandler.ServeHTTP(w, r)
+		case LeagueServiceGetPlayerLeagueRatingsProcedure:
+			leagueServiceGetPlayerLeagueRatingsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1109,3 +1365,43 @@ func (UnimplementedLeagueServiceHandler) AddSeasonTimeBank(context.Context, *con
 func (UnimplementedLeagueServiceHandler) CancelPlayerResults(context.Context, *connect.Request[league_service.CancelPlayerResultsRequest]) (*connect.Response[league_service.CancelPlayerResultsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.CancelPlayerResults is not implemented"))
 }
+
+func (UnimplementedLeagueServiceHandler) GetLeagueMatches(context.Context, *connect.Request[league_service.GetLeagueMatchesRequest]) (*connect.Response[league_service.LeagueMatchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.GetLeagueMatches is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) ProposeMatchTime(context.Context, *connect.Request[league_service.ProposeMatchTimeRequest]) (*connect.Response[league_service.LeagueMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.ProposeMatchTime is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) RespondToMatchProposal(context.Context, *connect.Request[league_service.RespondToMatchProposalRequest]) (*connect.Response[league_service.LeagueMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.RespondToMatchProposal is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) StartLeagueMatch(context.Context, *connect.Request[league_service.LeagueMatchRequest]) (*connect.Response[league_service.StartLeagueMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.StartLeagueMatch is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) JoinSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.JoinSubstitutePool is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) LeaveSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.LeaveSubstitutePool is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) GetSubstitutePool(context.Context, *connect.Request[league_service.LeagueRequest]) (*connect.Response[league_service.SubstitutePoolResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.GetSubstitutePool is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) SubstitutePlayer(context.Context, *connect.Request[league_service.SubstitutePlayerRequest]) (*connect.Response[league_service.SubstitutePlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.SubstitutePlayer is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) GetLeagueRatingHistory(context.Context, *connect.Request[league_service.GetLeagueRatingHistoryRequest]) (*connect.Response[league_service.LeagueRatingHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.GetLeagueRatingHistory is not implemented"))
+}
+
+func (UnimplementedLeagueServiceHandler) GetPlayerLeagueRatings(context.Context, *connect.Request[league_service.GetPlayerLeagueRatingsRequest]) (*connect.Response[league_service.PlayerLeagueRatingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("league_service.LeagueService.GetPlayerLeagueRatings is not implemented"))
+}
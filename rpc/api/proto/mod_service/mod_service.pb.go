@@ -34,6 +34,8 @@ const (
 	ModActionType_RESET_STATS_AND_RATINGS ModActionType = 6
 	ModActionType_REMOVE_CHAT             ModActionType = 7
 	ModActionType_DELETE_ACCOUNT          ModActionType = 8
+	// The user's chat messages are only shown to themselves.
+	ModActionType_SHADOW_MUTE ModActionType = 9
 )
 
 // Enum value maps for ModActionType.
@@ -48,6 +50,7 @@ var (
 		6: "RESET_STATS_AND_RATINGS",
 		7: "REMOVE_CHAT",
 		8: "DELETE_ACCOUNT",
+		9: "SHADOW_MUTE",
 	}
 	ModActionType_value = map[string]int32{
 		"MUTE":                    0,
@@ -59,6 +62,7 @@ var (
 		"RESET_STATS_AND_RATINGS": 6,
 		"REMOVE_CHAT":             7,
 		"DELETE_ACCOUNT":          8,
+		"SHADOW_MUTE":             9,
 	}
 )
 
@@ -147,6 +151,8 @@ const (
 	NotoriousGameType_SANDBAG              NotoriousGameType = 3
 	NotoriousGameType_NO_PLAY_DENIED_NUDGE NotoriousGameType = 4
 	NotoriousGameType_EXCESSIVE_PHONIES    NotoriousGameType = 5
+	// Flagged by the statistical cheat detection; needs moderator review.
+	NotoriousGameType_ENGINE_CORRELATION NotoriousGameType = 6
 )
 
 // Enum value maps for NotoriousGameType.
@@ -158,6 +164,7 @@ var (
 		3: "SANDBAG",
 		4: "NO_PLAY_DENIED_NUDGE",
 		5: "EXCESSIVE_PHONIES",
+		6: "ENGINE_CORRELATION",
 	}
 	NotoriousGameType_value = map[string]int32{
 		"GOOD":                 0,
@@ -166,6 +173,7 @@ var (
 		"SANDBAG":              3,
 		"NO_PLAY_DENIED_NUDGE": 4,
 		"EXCESSIVE_PHONIES":    5,
+		"ENGINE_CORRELATION":   6,
 	}
 )
 
@@ -196,6 +204,376 @@ func (NotoriousGameType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{2}
 }
 
+type ReportTargetType int32
+
+const (
+	ReportTargetType_REPORT_PLAYER ReportTargetType = 0
+	ReportTargetType_REPORT_GAME   ReportTargetType = 1
+	ReportTargetType_REPORT_CHAT   ReportTargetType = 2
+)
+
+// Enum value maps for ReportTargetType.
+var (
+	ReportTargetType_name = map[int32]string{
+		0: "REPORT_PLAYER",
+		1: "REPORT_GAME",
+		2: "REPORT_CHAT",
+	}
+	ReportTargetType_value = map[string]int32{
+		"REPORT_PLAYER": 0,
+		"REPORT_GAME":   1,
+		"REPORT_CHAT":   2,
+	}
+)
+
+func (x ReportTargetType) Enum() *ReportTargetType {
+	p := new(ReportTargetType)
+	*p = x
+	return p
+}
+
+func (x ReportTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mod_service_mod_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ReportTargetType) Type() protoreflect.EnumType {
+	return &file_proto_mod_service_mod_service_proto_enumTypes[3]
+}
+
+func (x ReportTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportTargetType.Descriptor instead.
+func (ReportTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{3}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_REASON_OTHER              ReportReason = 0
+	ReportReason_REASON_CHEATING           ReportReason = 1
+	ReportReason_REASON_HARASSMENT         ReportReason = 2
+	ReportReason_REASON_OFFENSIVE_USERNAME ReportReason = 3
+	ReportReason_REASON_SPAM               ReportReason = 4
+	ReportReason_REASON_STALLING           ReportReason = 5
+	ReportReason_REASON_SANDBAGGING        ReportReason = 6
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REASON_OTHER",
+		1: "REASON_CHEATING",
+		2: "REASON_HARASSMENT",
+		3: "REASON_OFFENSIVE_USERNAME",
+		4: "REASON_SPAM",
+		5: "REASON_STALLING",
+		6: "REASON_SANDBAGGING",
+	}
+	ReportReason_value = map[string]int32{
+		"REASON_OTHER":              0,
+		"REASON_CHEATING":           1,
+		"REASON_HARASSMENT":         2,
+		"REASON_OFFENSIVE_USERNAME": 3,
+		"REASON_SPAM":               4,
+		"REASON_STALLING":           5,
+		"REASON_SANDBAGGING":        6,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mod_service_mod_service_proto_enumTypes[4].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_proto_mod_service_mod_service_proto_enumTypes[4]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{4}
+}
+
+type CaseStatus int32
+
+const (
+	CaseStatus_CASE_OPEN         CaseStatus = 0
+	CaseStatus_CASE_ASSIGNED     CaseStatus = 1
+	CaseStatus_CASE_ACTION_TAKEN CaseStatus = 2
+	CaseStatus_CASE_DISMISSED    CaseStatus = 3
+	// The sanctioned user has a pending appeal.
+	CaseStatus_CASE_APPEALED CaseStatus = 4
+	// An appeal was granted and the case's actions were lifted.
+	CaseStatus_CASE_OVERTURNED CaseStatus = 5
+)
+
+// Enum value maps for CaseStatus.
+var (
+	CaseStatus_name = map[int32]string{
+		0: "CASE_OPEN",
+		1: "CASE_ASSIGNED",
+		2: "CASE_ACTION_TAKEN",
+		3: "CASE_DISMISSED",
+		4: "CASE_APPEALED",
+		5: "CASE_OVERTURNED",
+	}
+	CaseStatus_value = map[string]int32{
+		"CASE_OPEN":         0,
+		"CASE_ASSIGNED":     1,
+		"CASE_ACTION_TAKEN": 2,
+		"CASE_DISMISSED":    3,
+		"CASE_APPEALED":     4,
+		"CASE_OVERTURNED":   5,
+	}
+)
+
+func (x CaseStatus) Enum() *CaseStatus {
+	p := new(CaseStatus)
+	*p = x
+	return p
+}
+
+func (x CaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mod_service_mod_service_proto_enumTypes[5].Descriptor()
+}
+
+func (CaseStatus) Type() protoreflect.EnumType {
+	return &file_proto_mod_service_mod_service_proto_enumTypes[5]
+}
+
+func (x CaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CaseStatus.Descriptor instead.
+func (CaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{5}
+}
+
+type EvidenceType int32
+
+const (
+	EvidenceType_EVIDENCE_GAME EvidenceType = 0
+	EvidenceType_EVIDENCE_CHAT EvidenceType = 1
+)
+
+// Enum value maps for EvidenceType.
+var (
+	EvidenceType_name = map[int32]string{
+		0: "EVIDENCE_GAME",
+		1: "EVIDENCE_CHAT",
+	}
+	EvidenceType_value = map[string]int32{
+		"EVIDENCE_GAME": 0,
+		"EVIDENCE_CHAT": 1,
+	}
+)
+
+func (x EvidenceType) Enum() *EvidenceType {
+	p := new(EvidenceType)
+	*p = x
+	return p
+}
+
+func (x EvidenceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvidenceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mod_service_mod_service_proto_enumTypes[6].Descriptor()
+}
+
+func (EvidenceType) Type() protoreflect.EnumType {
+	return &file_proto_mod_service_mod_service_proto_enumTypes[6]
+}
+
+func (x EvidenceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvidenceType.Descriptor instead.
+func (EvidenceType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{6}
+}
+
+type AppealStatus int32
+
+const (
+	AppealStatus_APPEAL_PENDING AppealStatus = 0
+	AppealStatus_APPEAL_GRANTED AppealStatus = 1
+	AppealStatus_APPEAL_DENIED  AppealStatus = 2
+)
+
+// Enum value maps for AppealStatus.
+var (
+	AppealStatus_name = map[int32]string{
+		0: "APPEAL_PENDING",
+		1: "APPEAL_GRANTED",
+		2: "APPEAL_DENIED",
+	}
+	AppealStatus_value = map[string]int32{
+		"APPEAL_PENDING": 0,
+		"APPEAL_GRANTED": 1,
+		"APPEAL_DENIED":  2,
+	}
+)
+
+func (x AppealStatus) Enum() *AppealStatus {
+	p := new(AppealStatus)
+	*p = x
+	return p
+}
+
+func (x AppealStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppealStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mod_service_mod_service_proto_enumTypes[7].Descriptor()
+}
+
+func (AppealStatus) Type() protoreflect.EnumType {
+	return &file_proto_mod_service_mod_service_proto_enumTypes[7]
+}
+
+func (x AppealStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppealStatus.Descriptor instead.
+func (AppealStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ChatChannelType int32
+
+const (
+	ChatChannelType_CHANNEL_LOBBY ChatChannelType = 0
+	// Tournament and league channels.
+	ChatChannelType_CHANNEL_TOURNAMENT ChatChannelType = 1
+	ChatChannelType_CHANNEL_GAME       ChatChannelType = 2
+	ChatChannelType_CHANNEL_PM         ChatChannelType = 3
+)
+
+// Enum value maps for ChatChannelType.
+var (
+	ChatChannelType_name = map[int32]string{
+		0: "CHANNEL_LOBBY",
+		1: "CHANNEL_TOURNAMENT",
+		2: "CHANNEL_GAME",
+		3: "CHANNEL_PM",
+	}
+	ChatChannelType_value = map[string]int32{
+		"CHANNEL_LOBBY":      0,
+		"CHANNEL_TOURNAMENT": 1,
+		"CHANNEL_GAME":       2,
+		"CHANNEL_PM":         3,
+	}
+)
+
+func (x ChatChannelType) Enum() *ChatChannelType {
+	p := new(ChatChannelType)
+	*p = x
+	return p
+}
+
+func (x ChatChannelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mod_service_mod_service_proto_enumTypes[8].Descriptor()
+}
+
+func (ChatChannelType) Type() protoreflect.EnumType {
+	return &file_proto_mod_service_mod_service_proto_enumTypes[8]
+}
+
+func (x ChatChannelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannelType.Descriptor instead.
+func (ChatChannelType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{8}
+}
+
+type ChatFilterAction int32
+
+const (
+	// The message is refused and the user is told why.
+	ChatFilterAction_CHAT_REJECT ChatFilterAction = 0
+	// The message is only shown to the sender, and they are shadow-muted.
+	ChatFilterAction_CHAT_SHADOW_MUTE ChatFilterAction = 1
+	// The message is refused and the user is muted.
+	ChatFilterAction_CHAT_MUTE ChatFilterAction = 2
+)
+
+// Enum value maps for ChatFilterAction.
+var (
+	ChatFilterAction_name = map[int32]string{
+		0: "CHAT_REJECT",
+		1: "CHAT_SHADOW_MUTE",
+		2: "CHAT_MUTE",
+	}
+	ChatFilterAction_value = map[string]int32{
+		"CHAT_REJECT":      0,
+		"CHAT_SHADOW_MUTE": 1,
+		"CHAT_MUTE":        2,
+	}
+)
+
+func (x ChatFilterAction) Enum() *ChatFilterAction {
+	p := new(ChatFilterAction)
+	*p = x
+	return p
+}
+
+func (x ChatFilterAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatFilterAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mod_service_mod_service_proto_enumTypes[9].Descriptor()
+}
+
+func (ChatFilterAction) Type() protoreflect.EnumType {
+	return &file_proto_mod_service_mod_service_proto_enumTypes[9]
+}
+
+func (x ChatFilterAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatFilterAction.Descriptor instead.
+func (ChatFilterAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{9}
+}
+
 type ModAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -741,82 +1119,2341 @@ func (x *NotorietyReport) GetGames() []*NotoriousGame {
 	return nil
 }
 
-var File_proto_mod_service_mod_service_proto protoreflect.FileDescriptor
+// A report filed by a user. Reports about the same player are merged into
+// that player's open case.
+type FileReportRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TargetType ReportTargetType       `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=mod_service.ReportTargetType" json:"target_type,omitempty"`
+	// The reported player. For chat reports this is derived from the message.
+	UserId        string       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GameId        string       `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Channel       string       `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string       `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Reason        ReportReason `protobuf:"varint,6,opt,name=reason,proto3,enum=mod_service.ReportReason" json:"reason,omitempty"`
+	Description   string       `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_mod_service_mod_service_proto_rawDesc = "" +
-	"\n" +
-	"#proto/mod_service/mod_service.proto\x12\vmod_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x04\n" +
-	"\tModAction\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x1a.mod_service.ModActionTypeR\x04type\x12\x1a\n" +
-	"\bduration\x18\x03 \x01(\x05R\bduration\x129\n" +
-	"\n" +
-	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
-	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12=\n" +
-	"\fremoved_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vremovedTime\x12\x18\n" +
-	"\achannel\x18\a \x01(\tR\achannel\x12\x1d\n" +
-	"\n" +
-	"message_id\x18\b \x01(\tR\tmessageId\x12&\n" +
-	"\x0fapplier_user_id\x18\t \x01(\tR\rapplierUserId\x12&\n" +
-	"\x0fremover_user_id\x18\n" +
-	" \x01(\tR\rremoverUserId\x12\x1b\n" +
-	"\tchat_text\x18\v \x01(\tR\bchatText\x12\x12\n" +
-	"\x04note\x18\f \x01(\tR\x04note\x125\n" +
-	"\n" +
-	"email_type\x18\r \x01(\x0e2\x16.mod_service.EmailTypeR\temailType\"\xa6\x01\n" +
-	"\rModActionsMap\x12A\n" +
-	"\aactions\x18\x01 \x03(\v2'.mod_service.ModActionsMap.ActionsEntryR\aactions\x1aR\n" +
-	"\fActionsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.mod_service.ModActionR\x05value:\x028\x01\"B\n" +
-	"\x0eModActionsList\x120\n" +
-	"\aactions\x18\x01 \x03(\v2\x16.mod_service.ModActionR\aactions\",\n" +
-	"\x11GetActionsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x13\n" +
-	"\x11ModActionResponse\"\x8e\x01\n" +
-	"\rNotoriousGame\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
-	"\x04type\x18\x02 \x01(\x0e2\x1e.mod_service.NotoriousGameTypeR\x04type\x129\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"0\n" +
-	"\x15ResetNotorietyRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x18\n" +
-	"\x16ResetNotorietyResponse\"4\n" +
-	"\x19GetNotorietyReportRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"Y\n" +
-	"\x0fNotorietyReport\x12\x14\n" +
-	"\x05score\x18\x01 \x01(\x05R\x05score\x120\n" +
-	"\x05games\x18\x02 \x03(\v2\x1a.mod_service.NotoriousGameR\x05games*\xc0\x01\n" +
-	"\rModActionType\x12\b\n" +
-	"\x04MUTE\x10\x00\x12\x13\n" +
-	"\x0fSUSPEND_ACCOUNT\x10\x01\x12\x17\n" +
-	"\x13SUSPEND_RATED_GAMES\x10\x02\x12\x11\n" +
-	"\rSUSPEND_GAMES\x10\x03\x12\x11\n" +
-	"\rRESET_RATINGS\x10\x04\x12\x0f\n" +
-	"\vRESET_STATS\x10\x05\x12\x1b\n" +
-	"\x17RESET_STATS_AND_RATINGS\x10\x06\x12\x0f\n" +
-	"\vREMOVE_CHAT\x10\a\x12\x12\n" +
-	"\x0eDELETE_ACCOUNT\x10\b*4\n" +
-	"\tEmailType\x12\v\n" +
-	"\aDEFAULT\x10\x00\x12\f\n" +
-	"\bCHEATING\x10\x01\x12\f\n" +
-	"\bDELETION\x10\x02*u\n" +
-	"\x11NotoriousGameType\x12\b\n" +
-	"\x04GOOD\x10\x00\x12\v\n" +
-	"\aNO_PLAY\x10\x01\x12\v\n" +
-	"\aSITTING\x10\x02\x12\v\n" +
-	"\aSANDBAG\x10\x03\x12\x18\n" +
-	"\x14NO_PLAY_DENIED_NUDGE\x10\x04\x12\x15\n" +
-	"\x11EXCESSIVE_PHONIES\x10\x052\xf9\x03\n" +
-	"\n" +
-	"ModService\x12K\n" +
-	"\fApplyActions\x12\x1b.mod_service.ModActionsList\x1a\x1e.mod_service.ModActionResponse\x12L\n" +
-	"\rRemoveActions\x12\x1b.mod_service.ModActionsList\x1a\x1e.mod_service.ModActionResponse\x12H\n" +
-	"\n" +
-	"GetActions\x12\x1e.mod_service.GetActionsRequest\x1a\x1a.mod_service.ModActionsMap\x12O\n" +
-	"\x10GetActionHistory\x12\x1e.mod_service.GetActionsRequest\x1a\x1b.mod_service.ModActionsList\x12Z\n" +
-	"\x12GetNotorietyReport\x12&.mod_service.GetNotorietyReportRequest\x1a\x1c.mod_service.NotorietyReport\x12Y\n" +
-	"\x0eResetNotoriety\x12\".mod_service.ResetNotorietyRequest\x1a#.mod_service.ResetNotorietyResponseB\xa3\x01\n" +
+func (x *FileReportRequest) Reset() {
+	*x = FileReportRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileReportRequest) ProtoMessage() {}
+
+func (x *FileReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileReportRequest.ProtoReflect.Descriptor instead.
+func (*FileReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FileReportRequest) GetTargetType() ReportTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ReportTargetType_REPORT_PLAYER
+}
+
+func (x *FileReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FileReportRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *FileReportRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *FileReportRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *FileReportRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REASON_OTHER
+}
+
+func (x *FileReportRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type FileReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileReportResponse) Reset() {
+	*x = FileReportResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileReportResponse) ProtoMessage() {}
+
+func (x *FileReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileReportResponse.ProtoReflect.Descriptor instead.
+func (*FileReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{11}
+}
+
+type Report struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReporterUserId   string                 `protobuf:"bytes,1,opt,name=reporter_user_id,json=reporterUserId,proto3" json:"reporter_user_id,omitempty"`
+	ReporterUsername string                 `protobuf:"bytes,2,opt,name=reporter_username,json=reporterUsername,proto3" json:"reporter_username,omitempty"`
+	TargetType       ReportTargetType       `protobuf:"varint,3,opt,name=target_type,json=targetType,proto3,enum=mod_service.ReportTargetType" json:"target_type,omitempty"`
+	Reason           ReportReason           `protobuf:"varint,4,opt,name=reason,proto3,enum=mod_service.ReportReason" json:"reason,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Report) GetReporterUserId() string {
+	if x != nil {
+		return x.ReporterUserId
+	}
+	return ""
+}
+
+func (x *Report) GetReporterUsername() string {
+	if x != nil {
+		return x.ReporterUsername
+	}
+	return ""
+}
+
+func (x *Report) GetTargetType() ReportTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ReportTargetType_REPORT_PLAYER
+}
+
+func (x *Report) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REASON_OTHER
+}
+
+func (x *Report) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Evidence struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Type      EvidenceType           `protobuf:"varint,1,opt,name=type,proto3,enum=mod_service.EvidenceType" json:"type,omitempty"`
+	GameId    string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Channel   string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId string                 `protobuf:"bytes,4,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// For chat evidence, the message text at the time it was added, since
+	// chat messages expire or may be deleted.
+	Snapshot        string                 `protobuf:"bytes,5,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Note            string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	AddedByUsername string                 `protobuf:"bytes,7,opt,name=added_by_username,json=addedByUsername,proto3" json:"added_by_username,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Evidence) Reset() {
+	*x = Evidence{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Evidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Evidence) ProtoMessage() {}
+
+func (x *Evidence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Evidence.ProtoReflect.Descriptor instead.
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Evidence) GetType() EvidenceType {
+	if x != nil {
+		return x.Type
+	}
+	return EvidenceType_EVIDENCE_GAME
+}
+
+func (x *Evidence) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Evidence) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Evidence) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *Evidence) GetSnapshot() string {
+	if x != nil {
+		return x.Snapshot
+	}
+	return ""
+}
+
+func (x *Evidence) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Evidence) GetAddedByUsername() string {
+	if x != nil {
+		return x.AddedByUsername
+	}
+	return ""
+}
+
+func (x *Evidence) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Appeal struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status           AppealStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=mod_service.AppealStatus" json:"status,omitempty"`
+	Response         string                 `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	ReviewerUsername string                 `protobuf:"bytes,5,opt,name=reviewer_username,json=reviewerUsername,proto3" json:"reviewer_username,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Appeal) Reset() {
+	*x = Appeal{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Appeal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appeal) ProtoMessage() {}
+
+func (x *Appeal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appeal.ProtoReflect.Descriptor instead.
+func (*Appeal) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Appeal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Appeal) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Appeal) GetStatus() AppealStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AppealStatus_APPEAL_PENDING
+}
+
+func (x *Appeal) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+func (x *Appeal) GetReviewerUsername() string {
+	if x != nil {
+		return x.ReviewerUsername
+	}
+	return ""
+}
+
+func (x *Appeal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Appeal) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+type ModCase struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username         string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Status           CaseStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=mod_service.CaseStatus" json:"status,omitempty"`
+	AssigneeUserId   string                 `protobuf:"bytes,5,opt,name=assignee_user_id,json=assigneeUserId,proto3" json:"assignee_user_id,omitempty"`
+	AssigneeUsername string                 `protobuf:"bytes,6,opt,name=assignee_username,json=assigneeUsername,proto3" json:"assignee_username,omitempty"`
+	NumReports       int32                  `protobuf:"varint,7,opt,name=num_reports,json=numReports,proto3" json:"num_reports,omitempty"`
+	ResolutionNote   string                 `protobuf:"bytes,8,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	ResolverUsername string                 `protobuf:"bytes,9,opt,name=resolver_username,json=resolverUsername,proto3" json:"resolver_username,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ResolvedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	// The following are only filled in by GetCase.
+	Reports       []*Report    `protobuf:"bytes,13,rep,name=reports,proto3" json:"reports,omitempty"`
+	Evidence      []*Evidence  `protobuf:"bytes,14,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Actions       []*ModAction `protobuf:"bytes,15,rep,name=actions,proto3" json:"actions,omitempty"`
+	Appeals       []*Appeal    `protobuf:"bytes,16,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModCase) Reset() {
+	*x = ModCase{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModCase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModCase) ProtoMessage() {}
+
+func (x *ModCase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModCase.ProtoReflect.Descriptor instead.
+func (*ModCase) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ModCase) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModCase) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ModCase) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ModCase) GetStatus() CaseStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CaseStatus_CASE_OPEN
+}
+
+func (x *ModCase) GetAssigneeUserId() string {
+	if x != nil {
+		return x.AssigneeUserId
+	}
+	return ""
+}
+
+func (x *ModCase) GetAssigneeUsername() string {
+	if x != nil {
+		return x.AssigneeUsername
+	}
+	return ""
+}
+
+func (x *ModCase) GetNumReports() int32 {
+	if x != nil {
+		return x.NumReports
+	}
+	return 0
+}
+
+func (x *ModCase) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *ModCase) GetResolverUsername() string {
+	if x != nil {
+		return x.ResolverUsername
+	}
+	return ""
+}
+
+func (x *ModCase) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModCase) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ModCase) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *ModCase) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ModCase) GetEvidence() []*Evidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *ModCase) GetActions() []*ModAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ModCase) GetAppeals() []*Appeal {
+	if x != nil {
+		return x.Appeals
+	}
+	return nil
+}
+
+type ListCasesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If empty, cases that need attention (open, assigned or appealed) are
+	// returned.
+	Statuses []CaseStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=mod_service.CaseStatus" json:"statuses,omitempty"`
+	// Only cases assigned to this moderator, if set.
+	AssigneeUserId string `protobuf:"bytes,2,opt,name=assignee_user_id,json=assigneeUserId,proto3" json:"assignee_user_id,omitempty"`
+	Limit          int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCasesRequest) Reset() {
+	*x = ListCasesRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCasesRequest) ProtoMessage() {}
+
+func (x *ListCasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCasesRequest.ProtoReflect.Descriptor instead.
+func (*ListCasesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCasesRequest) GetStatuses() []CaseStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListCasesRequest) GetAssigneeUserId() string {
+	if x != nil {
+		return x.AssigneeUserId
+	}
+	return ""
+}
+
+func (x *ListCasesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCasesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListCasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cases         []*ModCase             `protobuf:"bytes,1,rep,name=cases,proto3" json:"cases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCasesResponse) Reset() {
+	*x = ListCasesResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCasesResponse) ProtoMessage() {}
+
+func (x *ListCasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCasesResponse.ProtoReflect.Descriptor instead.
+func (*ListCasesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCasesResponse) GetCases() []*ModCase {
+	if x != nil {
+		return x.Cases
+	}
+	return nil
+}
+
+type GetCaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseId        string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaseRequest) Reset() {
+	*x = GetCaseRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaseRequest) ProtoMessage() {}
+
+func (x *GetCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaseRequest.ProtoReflect.Descriptor instead.
+func (*GetCaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCaseRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+type AssignCaseRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CaseId string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	// Defaults to the requesting moderator.
+	AssigneeUserId string `protobuf:"bytes,2,opt,name=assignee_user_id,json=assigneeUserId,proto3" json:"assignee_user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignCaseRequest) Reset() {
+	*x = AssignCaseRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCaseRequest) ProtoMessage() {}
+
+func (x *AssignCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCaseRequest.ProtoReflect.Descriptor instead.
+func (*AssignCaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AssignCaseRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *AssignCaseRequest) GetAssigneeUserId() string {
+	if x != nil {
+		return x.AssigneeUserId
+	}
+	return ""
+}
+
+type AssignCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignCaseResponse) Reset() {
+	*x = AssignCaseResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCaseResponse) ProtoMessage() {}
+
+func (x *AssignCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCaseResponse.ProtoReflect.Descriptor instead.
+func (*AssignCaseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{20}
+}
+
+type AddCaseEvidenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseId        string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Type          EvidenceType           `protobuf:"varint,2,opt,name=type,proto3,enum=mod_service.EvidenceType" json:"type,omitempty"`
+	GameId        string                 `protobuf:"bytes,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	MessageId     string                 `protobuf:"bytes,5,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCaseEvidenceRequest) Reset() {
+	*x = AddCaseEvidenceRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCaseEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCaseEvidenceRequest) ProtoMessage() {}
+
+func (x *AddCaseEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCaseEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*AddCaseEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddCaseEvidenceRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *AddCaseEvidenceRequest) GetType() EvidenceType {
+	if x != nil {
+		return x.Type
+	}
+	return EvidenceType_EVIDENCE_GAME
+}
+
+func (x *AddCaseEvidenceRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AddCaseEvidenceRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AddCaseEvidenceRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *AddCaseEvidenceRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AddCaseEvidenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCaseEvidenceResponse) Reset() {
+	*x = AddCaseEvidenceResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCaseEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCaseEvidenceResponse) ProtoMessage() {}
+
+func (x *AddCaseEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCaseEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*AddCaseEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{22}
+}
+
+type ResolveCaseRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	CaseId string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	// If no actions are given the case is dismissed.
+	Actions       []*ModAction `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	Note          string       `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCaseRequest) Reset() {
+	*x = ResolveCaseRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCaseRequest) ProtoMessage() {}
+
+func (x *ResolveCaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCaseRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ResolveCaseRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *ResolveCaseRequest) GetActions() []*ModAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ResolveCaseRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveCaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveCaseResponse) Reset() {
+	*x = ResolveCaseResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveCaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCaseResponse) ProtoMessage() {}
+
+func (x *ResolveCaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCaseResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCaseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{24}
+}
+
+type SubmitAppealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaseId        string                 `protobuf:"bytes,1,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAppealRequest) Reset() {
+	*x = SubmitAppealRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAppealRequest) ProtoMessage() {}
+
+func (x *SubmitAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAppealRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SubmitAppealRequest) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+func (x *SubmitAppealRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SubmitAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealId      string                 `protobuf:"bytes,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAppealResponse) Reset() {
+	*x = SubmitAppealResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAppealResponse) ProtoMessage() {}
+
+func (x *SubmitAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAppealResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubmitAppealResponse) GetAppealId() string {
+	if x != nil {
+		return x.AppealId
+	}
+	return ""
+}
+
+type DecideAppealRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	AppealId string                 `protobuf:"bytes,1,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	Grant    bool                   `protobuf:"varint,2,opt,name=grant,proto3" json:"grant,omitempty"`
+	// Sent to the user by email.
+	Response      string `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideAppealRequest) Reset() {
+	*x = DecideAppealRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideAppealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAppealRequest) ProtoMessage() {}
+
+func (x *DecideAppealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAppealRequest.ProtoReflect.Descriptor instead.
+func (*DecideAppealRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DecideAppealRequest) GetAppealId() string {
+	if x != nil {
+		return x.AppealId
+	}
+	return ""
+}
+
+func (x *DecideAppealRequest) GetGrant() bool {
+	if x != nil {
+		return x.Grant
+	}
+	return false
+}
+
+func (x *DecideAppealRequest) GetResponse() string {
+	if x != nil {
+		return x.Response
+	}
+	return ""
+}
+
+type DecideAppealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideAppealResponse) Reset() {
+	*x = DecideAppealResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideAppealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideAppealResponse) ProtoMessage() {}
+
+func (x *DecideAppealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideAppealResponse.ProtoReflect.Descriptor instead.
+func (*DecideAppealResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{28}
+}
+
+// GameCheatSignals are the signals computed for one player in one analyzed,
+// rated game.
+type GameCheatSignals struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	// Fraction of the player's turns where they played the engine's best move.
+	EngineAgreement float64 `protobuf:"fixed64,2,opt,name=engine_agreement,json=engineAgreement,proto3" json:"engine_agreement,omitempty"`
+	Turns           int32   `protobuf:"varint,3,opt,name=turns,proto3" json:"turns,omitempty"`
+	MistakeIndex    float64 `protobuf:"fixed64,4,opt,name=mistake_index,json=mistakeIndex,proto3" json:"mistake_index,omitempty"`
+	// The rating the analyzer estimates from the player's accuracy.
+	EstimatedRating float64 `protobuf:"fixed64,5,opt,name=estimated_rating,json=estimatedRating,proto3" json:"estimated_rating,omitempty"`
+	// The player's rating when the game started.
+	Rating float64 `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Coefficient of variation of the player's think times; 0 if unknown.
+	MoveTimeCv    float64                `protobuf:"fixed64,7,opt,name=move_time_cv,json=moveTimeCv,proto3" json:"move_time_cv,omitempty"`
+	Flagged       bool                   `protobuf:"varint,8,opt,name=flagged,proto3" json:"flagged,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameCheatSignals) Reset() {
+	*x = GameCheatSignals{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameCheatSignals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameCheatSignals) ProtoMessage() {}
+
+func (x *GameCheatSignals) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameCheatSignals.ProtoReflect.Descriptor instead.
+func (*GameCheatSignals) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GameCheatSignals) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameCheatSignals) GetEngineAgreement() float64 {
+	if x != nil {
+		return x.EngineAgreement
+	}
+	return 0
+}
+
+func (x *GameCheatSignals) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *GameCheatSignals) GetMistakeIndex() float64 {
+	if x != nil {
+		return x.MistakeIndex
+	}
+	return 0
+}
+
+func (x *GameCheatSignals) GetEstimatedRating() float64 {
+	if x != nil {
+		return x.EstimatedRating
+	}
+	return 0
+}
+
+func (x *GameCheatSignals) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *GameCheatSignals) GetMoveTimeCv() float64 {
+	if x != nil {
+		return x.MoveTimeCv
+	}
+	return 0
+}
+
+func (x *GameCheatSignals) GetFlagged() bool {
+	if x != nil {
+		return x.Flagged
+	}
+	return false
+}
+
+func (x *GameCheatSignals) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetCheatSignalReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheatSignalReportRequest) Reset() {
+	*x = GetCheatSignalReportRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheatSignalReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheatSignalReportRequest) ProtoMessage() {}
+
+func (x *GetCheatSignalReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheatSignalReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCheatSignalReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCheatSignalReportRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// CheatSignalReport summarizes a player's most recent analyzed rated games
+// and compares them to players of a similar rating.
+type CheatSignalReport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GamesAnalyzed   int32                  `protobuf:"varint,2,opt,name=games_analyzed,json=gamesAnalyzed,proto3" json:"games_analyzed,omitempty"`
+	EngineAgreement float64                `protobuf:"fixed64,3,opt,name=engine_agreement,json=engineAgreement,proto3" json:"engine_agreement,omitempty"`
+	// How many standard errors the player's engine agreement is above
+	// players of a similar rating.
+	AgreementZscore float64 `protobuf:"fixed64,4,opt,name=agreement_zscore,json=agreementZscore,proto3" json:"agreement_zscore,omitempty"`
+	MistakeIndex    float64 `protobuf:"fixed64,5,opt,name=mistake_index,json=mistakeIndex,proto3" json:"mistake_index,omitempty"`
+	// How many standard errors the player's mistake index is below players
+	// of a similar rating.
+	AccuracyZscore float64 `protobuf:"fixed64,6,opt,name=accuracy_zscore,json=accuracyZscore,proto3" json:"accuracy_zscore,omitempty"`
+	// Mean of the estimated rating minus the actual rating.
+	RatingGap  float64 `protobuf:"fixed64,7,opt,name=rating_gap,json=ratingGap,proto3" json:"rating_gap,omitempty"`
+	MoveTimeCv float64 `protobuf:"fixed64,8,opt,name=move_time_cv,json=moveTimeCv,proto3" json:"move_time_cv,omitempty"`
+	// The number of games of similar-rated players used for comparison.
+	BaselineGames int32               `protobuf:"varint,9,opt,name=baseline_games,json=baselineGames,proto3" json:"baseline_games,omitempty"`
+	Outlier       bool                `protobuf:"varint,10,opt,name=outlier,proto3" json:"outlier,omitempty"`
+	Reasons       []string            `protobuf:"bytes,11,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Games         []*GameCheatSignals `protobuf:"bytes,12,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheatSignalReport) Reset() {
+	*x = CheatSignalReport{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheatSignalReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheatSignalReport) ProtoMessage() {}
+
+func (x *CheatSignalReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheatSignalReport.ProtoReflect.Descriptor instead.
+func (*CheatSignalReport) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CheatSignalReport) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheatSignalReport) GetGamesAnalyzed() int32 {
+	if x != nil {
+		return x.GamesAnalyzed
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetEngineAgreement() float64 {
+	if x != nil {
+		return x.EngineAgreement
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetAgreementZscore() float64 {
+	if x != nil {
+		return x.AgreementZscore
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetMistakeIndex() float64 {
+	if x != nil {
+		return x.MistakeIndex
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetAccuracyZscore() float64 {
+	if x != nil {
+		return x.AccuracyZscore
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetRatingGap() float64 {
+	if x != nil {
+		return x.RatingGap
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetMoveTimeCv() float64 {
+	if x != nil {
+		return x.MoveTimeCv
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetBaselineGames() int32 {
+	if x != nil {
+		return x.BaselineGames
+	}
+	return 0
+}
+
+func (x *CheatSignalReport) GetOutlier() bool {
+	if x != nil {
+		return x.Outlier
+	}
+	return false
+}
+
+func (x *CheatSignalReport) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *CheatSignalReport) GetGames() []*GameCheatSignals {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+// ChatPolicy configures how chat is regulated in one type of channel.
+// Moderators and tournament directors are exempt.
+type ChatPolicy struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ChannelType ChatChannelType        `protobuf:"varint,1,opt,name=channel_type,json=channelType,proto3,enum=mod_service.ChatChannelType" json:"channel_type,omitempty"`
+	// Minimum number of seconds between a user's messages; 0 disables slow
+	// mode. Slow mode also refuses repeating the previous message.
+	SlowModeSeconds int32 `protobuf:"varint,2,opt,name=slow_mode_seconds,json=slowModeSeconds,proto3" json:"slow_mode_seconds,omitempty"`
+	// Sending more than flood_max_messages within flood_window_seconds is
+	// flooding; 0 disables flood detection.
+	FloodMaxMessages   int32            `protobuf:"varint,3,opt,name=flood_max_messages,json=floodMaxMessages,proto3" json:"flood_max_messages,omitempty"`
+	FloodWindowSeconds int32            `protobuf:"varint,4,opt,name=flood_window_seconds,json=floodWindowSeconds,proto3" json:"flood_window_seconds,omitempty"`
+	FloodAction        ChatFilterAction `protobuf:"varint,5,opt,name=flood_action,json=floodAction,proto3,enum=mod_service.ChatFilterAction" json:"flood_action,omitempty"`
+	// Accounts younger than this many days can't post links; 0 allows links.
+	BlockLinksDays int32 `protobuf:"varint,6,opt,name=block_links_days,json=blockLinksDays,proto3" json:"block_links_days,omitempty"`
+	// Which languages' blocklists apply; empty means all of them.
+	BlocklistLanguages []string         `protobuf:"bytes,7,rep,name=blocklist_languages,json=blocklistLanguages,proto3" json:"blocklist_languages,omitempty"`
+	BlocklistAction    ChatFilterAction `protobuf:"varint,8,opt,name=blocklist_action,json=blocklistAction,proto3,enum=mod_service.ChatFilterAction" json:"blocklist_action,omitempty"`
+	// How long automatic mutes and shadow-mutes last.
+	MuteDurationSeconds int32 `protobuf:"varint,9,opt,name=mute_duration_seconds,json=muteDurationSeconds,proto3" json:"mute_duration_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ChatPolicy) Reset() {
+	*x = ChatPolicy{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatPolicy) ProtoMessage() {}
+
+func (x *ChatPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatPolicy.ProtoReflect.Descriptor instead.
+func (*ChatPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChatPolicy) GetChannelType() ChatChannelType {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ChatChannelType_CHANNEL_LOBBY
+}
+
+func (x *ChatPolicy) GetSlowModeSeconds() int32 {
+	if x != nil {
+		return x.SlowModeSeconds
+	}
+	return 0
+}
+
+func (x *ChatPolicy) GetFloodMaxMessages() int32 {
+	if x != nil {
+		return x.FloodMaxMessages
+	}
+	return 0
+}
+
+func (x *ChatPolicy) GetFloodWindowSeconds() int32 {
+	if x != nil {
+		return x.FloodWindowSeconds
+	}
+	return 0
+}
+
+func (x *ChatPolicy) GetFloodAction() ChatFilterAction {
+	if x != nil {
+		return x.FloodAction
+	}
+	return ChatFilterAction_CHAT_REJECT
+}
+
+func (x *ChatPolicy) GetBlockLinksDays() int32 {
+	if x != nil {
+		return x.BlockLinksDays
+	}
+	return 0
+}
+
+func (x *ChatPolicy) GetBlocklistLanguages() []string {
+	if x != nil {
+		return x.BlocklistLanguages
+	}
+	return nil
+}
+
+func (x *ChatPolicy) GetBlocklistAction() ChatFilterAction {
+	if x != nil {
+		return x.BlocklistAction
+	}
+	return ChatFilterAction_CHAT_REJECT
+}
+
+func (x *ChatPolicy) GetMuteDurationSeconds() int32 {
+	if x != nil {
+		return x.MuteDurationSeconds
+	}
+	return 0
+}
+
+type GetChatPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatPoliciesRequest) Reset() {
+	*x = GetChatPoliciesRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatPoliciesRequest) ProtoMessage() {}
+
+func (x *GetChatPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*GetChatPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{33}
+}
+
+type ChatPolicies struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*ChatPolicy          `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatPolicies) Reset() {
+	*x = ChatPolicies{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatPolicies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatPolicies) ProtoMessage() {}
+
+func (x *ChatPolicies) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatPolicies.ProtoReflect.Descriptor instead.
+func (*ChatPolicies) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChatPolicies) GetPolicies() []*ChatPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SetChatPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChatPolicyResponse) Reset() {
+	*x = SetChatPolicyResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatPolicyResponse) ProtoMessage() {}
+
+func (x *SetChatPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetChatPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{35}
+}
+
+type ChatBlocklistEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Language string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Pattern  string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Patterns are matched as whole words unless they are regular expressions.
+	IsRegex       bool `protobuf:"varint,4,opt,name=is_regex,json=isRegex,proto3" json:"is_regex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatBlocklistEntry) Reset() {
+	*x = ChatBlocklistEntry{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatBlocklistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatBlocklistEntry) ProtoMessage() {}
+
+func (x *ChatBlocklistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatBlocklistEntry.ProtoReflect.Descriptor instead.
+func (*ChatBlocklistEntry) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ChatBlocklistEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChatBlocklistEntry) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ChatBlocklistEntry) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ChatBlocklistEntry) GetIsRegex() bool {
+	if x != nil {
+		return x.IsRegex
+	}
+	return false
+}
+
+type GetChatBlocklistRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty for all languages.
+	Language      string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatBlocklistRequest) Reset() {
+	*x = GetChatBlocklistRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatBlocklistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatBlocklistRequest) ProtoMessage() {}
+
+func (x *GetChatBlocklistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatBlocklistRequest.ProtoReflect.Descriptor instead.
+func (*GetChatBlocklistRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetChatBlocklistRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type ChatBlocklist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*ChatBlocklistEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatBlocklist) Reset() {
+	*x = ChatBlocklist{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatBlocklist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatBlocklist) ProtoMessage() {}
+
+func (x *ChatBlocklist) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatBlocklist.ProtoReflect.Descriptor instead.
+func (*ChatBlocklist) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChatBlocklist) GetEntries() []*ChatBlocklistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type AddChatBlocklistEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChatBlocklistEntryResponse) Reset() {
+	*x = AddChatBlocklistEntryResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChatBlocklistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChatBlocklistEntryResponse) ProtoMessage() {}
+
+func (x *AddChatBlocklistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChatBlocklistEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddChatBlocklistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddChatBlocklistEntryResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveChatBlocklistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveChatBlocklistEntryRequest) Reset() {
+	*x = RemoveChatBlocklistEntryRequest{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveChatBlocklistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChatBlocklistEntryRequest) ProtoMessage() {}
+
+func (x *RemoveChatBlocklistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChatBlocklistEntryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveChatBlocklistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RemoveChatBlocklistEntryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveChatBlocklistEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveChatBlocklistEntryResponse) Reset() {
+	*x = RemoveChatBlocklistEntryResponse{}
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveChatBlocklistEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveChatBlocklistEntryResponse) ProtoMessage() {}
+
+func (x *RemoveChatBlocklistEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mod_service_mod_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveChatBlocklistEntryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveChatBlocklistEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mod_service_mod_service_proto_rawDescGZIP(), []int{41}
+}
+
+var File_proto_mod_service_mod_service_proto protoreflect.FileDescriptor
+
+const file_proto_mod_service_mod_service_proto_rawDesc = "" +
+	"\n" +
+	"#proto/mod_service/mod_service.proto\x12\vmod_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x04\n" +
+	"\tModAction\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.mod_service.ModActionTypeR\x04type\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x05R\bduration\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12=\n" +
+	"\fremoved_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vremovedTime\x12\x18\n" +
+	"\achannel\x18\a \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\b \x01(\tR\tmessageId\x12&\n" +
+	"\x0fapplier_user_id\x18\t \x01(\tR\rapplierUserId\x12&\n" +
+	"\x0fremover_user_id\x18\n" +
+	" \x01(\tR\rremoverUserId\x12\x1b\n" +
+	"\tchat_text\x18\v \x01(\tR\bchatText\x12\x12\n" +
+	"\x04note\x18\f \x01(\tR\x04note\x125\n" +
+	"\n" +
+	"email_type\x18\r \x01(\x0e2\x16.mod_service.EmailTypeR\temailType\"\xa6\x01\n" +
+	"\rModActionsMap\x12A\n" +
+	"\aactions\x18\x01 \x03(\v2'.mod_service.ModActionsMap.ActionsEntryR\aactions\x1aR\n" +
+	"\fActionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.mod_service.ModActionR\x05value:\x028\x01\"B\n" +
+	"\x0eModActionsList\x120\n" +
+	"\aactions\x18\x01 \x03(\v2\x16.mod_service.ModActionR\aactions\",\n" +
+	"\x11GetActionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x13\n" +
+	"\x11ModActionResponse\"\x8e\x01\n" +
+	"\rNotoriousGame\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1e.mod_service.NotoriousGameTypeR\x04type\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"0\n" +
+	"\x15ResetNotorietyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x18\n" +
+	"\x16ResetNotorietyResponse\"4\n" +
+	"\x19GetNotorietyReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"Y\n" +
+	"\x0fNotorietyReport\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x120\n" +
+	"\x05games\x18\x02 \x03(\v2\x1a.mod_service.NotoriousGameR\x05games\"\x93\x02\n" +
+	"\x11FileReportRequest\x12>\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2\x1d.mod_service.ReportTargetTypeR\n" +
+	"targetType\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\x121\n" +
+	"\x06reason\x18\x06 \x01(\x0e2\x19.mod_service.ReportReasonR\x06reason\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\"\x14\n" +
+	"\x12FileReportResponse\"\xaf\x02\n" +
+	"\x06Report\x12(\n" +
+	"\x10reporter_user_id\x18\x01 \x01(\tR\x0ereporterUserId\x12+\n" +
+	"\x11reporter_username\x18\x02 \x01(\tR\x10reporterUsername\x12>\n" +
+	"\vtarget_type\x18\x03 \x01(\x0e2\x1d.mod_service.ReportTargetTypeR\n" +
+	"targetType\x121\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x19.mod_service.ReportReasonR\x06reason\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa2\x02\n" +
+	"\bEvidence\x12-\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x19.mod_service.EvidenceTypeR\x04type\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x04 \x01(\tR\tmessageId\x12\x1a\n" +
+	"\bsnapshot\x18\x05 \x01(\tR\bsnapshot\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12*\n" +
+	"\x11added_by_username\x18\a \x01(\tR\x0faddedByUsername\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa4\x02\n" +
+	"\x06Appeal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.mod_service.AppealStatusR\x06status\x12\x1a\n" +
+	"\bresponse\x18\x04 \x01(\tR\bresponse\x12+\n" +
+	"\x11reviewer_username\x18\x05 \x01(\tR\x10reviewerUsername\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"decided_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"\xc3\x05\n" +
+	"\aModCase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.mod_service.CaseStatusR\x06status\x12(\n" +
+	"\x10assignee_user_id\x18\x05 \x01(\tR\x0eassigneeUserId\x12+\n" +
+	"\x11assignee_username\x18\x06 \x01(\tR\x10assigneeUsername\x12\x1f\n" +
+	"\vnum_reports\x18\a \x01(\x05R\n" +
+	"numReports\x12'\n" +
+	"\x0fresolution_note\x18\b \x01(\tR\x0eresolutionNote\x12+\n" +
+	"\x11resolver_username\x18\t \x01(\tR\x10resolverUsername\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vresolved_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\x12-\n" +
+	"\areports\x18\r \x03(\v2\x13.mod_service.ReportR\areports\x121\n" +
+	"\bevidence\x18\x0e \x03(\v2\x15.mod_service.EvidenceR\bevidence\x120\n" +
+	"\aactions\x18\x0f \x03(\v2\x16.mod_service.ModActionR\aactions\x12-\n" +
+	"\aappeals\x18\x10 \x03(\v2\x13.mod_service.AppealR\aappeals\"\x9f\x01\n" +
+	"\x10ListCasesRequest\x123\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x17.mod_service.CaseStatusR\bstatuses\x12(\n" +
+	"\x10assignee_user_id\x18\x02 \x01(\tR\x0eassigneeUserId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"?\n" +
+	"\x11ListCasesResponse\x12*\n" +
+	"\x05cases\x18\x01 \x03(\v2\x14.mod_service.ModCaseR\x05cases\")\n" +
+	"\x0eGetCaseRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\"V\n" +
+	"\x11AssignCaseRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x12(\n" +
+	"\x10assignee_user_id\x18\x02 \x01(\tR\x0eassigneeUserId\"\x14\n" +
+	"\x12AssignCaseResponse\"\xc6\x01\n" +
+	"\x16AddCaseEvidenceRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x12-\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x19.mod_service.EvidenceTypeR\x04type\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\tR\x06gameId\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x05 \x01(\tR\tmessageId\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"\x19\n" +
+	"\x17AddCaseEvidenceResponse\"s\n" +
+	"\x12ResolveCaseRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x120\n" +
+	"\aactions\x18\x02 \x03(\v2\x16.mod_service.ModActionR\aactions\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\x15\n" +
+	"\x13ResolveCaseResponse\"H\n" +
+	"\x13SubmitAppealRequest\x12\x17\n" +
+	"\acase_id\x18\x01 \x01(\tR\x06caseId\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
+	"\x14SubmitAppealResponse\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\tR\bappealId\"d\n" +
+	"\x13DecideAppealRequest\x12\x1b\n" +
+	"\tappeal_id\x18\x01 \x01(\tR\bappealId\x12\x14\n" +
+	"\x05grant\x18\x02 \x01(\bR\x05grant\x12\x1a\n" +
+	"\bresponse\x18\x03 \x01(\tR\bresponse\"\x16\n" +
+	"\x14DecideAppealResponse\"\xcb\x02\n" +
+	"\x10GameCheatSignals\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12)\n" +
+	"\x10engine_agreement\x18\x02 \x01(\x01R\x0fengineAgreement\x12\x14\n" +
+	"\x05turns\x18\x03 \x01(\x05R\x05turns\x12#\n" +
+	"\rmistake_index\x18\x04 \x01(\x01R\fmistakeIndex\x12)\n" +
+	"\x10estimated_rating\x18\x05 \x01(\x01R\x0festimatedRating\x12\x16\n" +
+	"\x06rating\x18\x06 \x01(\x01R\x06rating\x12 \n" +
+	"\fmove_time_cv\x18\a \x01(\x01R\n" +
+	"moveTimeCv\x12\x18\n" +
+	"\aflagged\x18\b \x01(\bR\aflagged\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"6\n" +
+	"\x1bGetCheatSignalReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xc8\x03\n" +
+	"\x11CheatSignalReport\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0egames_analyzed\x18\x02 \x01(\x05R\rgamesAnalyzed\x12)\n" +
+	"\x10engine_agreement\x18\x03 \x01(\x01R\x0fengineAgreement\x12)\n" +
+	"\x10agreement_zscore\x18\x04 \x01(\x01R\x0fagreementZscore\x12#\n" +
+	"\rmistake_index\x18\x05 \x01(\x01R\fmistakeIndex\x12'\n" +
+	"\x0faccuracy_zscore\x18\x06 \x01(\x01R\x0eaccuracyZscore\x12\x1d\n" +
+	"\n" +
+	"rating_gap\x18\a \x01(\x01R\tratingGap\x12 \n" +
+	"\fmove_time_cv\x18\b \x01(\x01R\n" +
+	"moveTimeCv\x12%\n" +
+	"\x0ebaseline_games\x18\t \x01(\x05R\rbaselineGames\x12\x18\n" +
+	"\aoutlier\x18\n" +
+	" \x01(\bR\aoutlier\x12\x18\n" +
+	"\areasons\x18\v \x03(\tR\areasons\x123\n" +
+	"\x05games\x18\f \x03(\v2\x1d.mod_service.GameCheatSignalsR\x05games\"\xf4\x03\n" +
+	"\n" +
+	"ChatPolicy\x12?\n" +
+	"\fchannel_type\x18\x01 \x01(\x0e2\x1c.mod_service.ChatChannelTypeR\vchannelType\x12*\n" +
+	"\x11slow_mode_seconds\x18\x02 \x01(\x05R\x0fslowModeSeconds\x12,\n" +
+	"\x12flood_max_messages\x18\x03 \x01(\x05R\x10floodMaxMessages\x120\n" +
+	"\x14flood_window_seconds\x18\x04 \x01(\x05R\x12floodWindowSeconds\x12@\n" +
+	"\fflood_action\x18\x05 \x01(\x0e2\x1d.mod_service.ChatFilterActionR\vfloodAction\x12(\n" +
+	"\x10block_links_days\x18\x06 \x01(\x05R\x0eblockLinksDays\x12/\n" +
+	"\x13blocklist_languages\x18\a \x03(\tR\x12blocklistLanguages\x12H\n" +
+	"\x10blocklist_action\x18\b \x01(\x0e2\x1d.mod_service.ChatFilterActionR\x0fblocklistAction\x122\n" +
+	"\x15mute_duration_seconds\x18\t \x01(\x05R\x13muteDurationSeconds\"\x18\n" +
+	"\x16GetChatPoliciesRequest\"C\n" +
+	"\fChatPolicies\x123\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x17.mod_service.ChatPolicyR\bpolicies\"\x17\n" +
+	"\x15SetChatPolicyResponse\"u\n" +
+	"\x12ChatBlocklistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\x12\x19\n" +
+	"\bis_regex\x18\x04 \x01(\bR\aisRegex\"5\n" +
+	"\x17GetChatBlocklistRequest\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\"J\n" +
+	"\rChatBlocklist\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.mod_service.ChatBlocklistEntryR\aentries\"/\n" +
+	"\x1dAddChatBlocklistEntryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x1fRemoveChatBlocklistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\"\n" +
+	" RemoveChatBlocklistEntryResponse*\xd1\x01\n" +
+	"\rModActionType\x12\b\n" +
+	"\x04MUTE\x10\x00\x12\x13\n" +
+	"\x0fSUSPEND_ACCOUNT\x10\x01\x12\x17\n" +
+	"\x13SUSPEND_RATED_GAMES\x10\x02\x12\x11\n" +
+	"\rSUSPEND_GAMES\x10\x03\x12\x11\n" +
+	"\rRESET_RATINGS\x10\x04\x12\x0f\n" +
+	"\vRESET_STATS\x10\x05\x12\x1b\n" +
+	"\x17RESET_STATS_AND_RATINGS\x10\x06\x12\x0f\n" +
+	"\vREMOVE_CHAT\x10\a\x12\x12\n" +
+	"\x0eDELETE_ACCOUNT\x10\b\x12\x0f\n" +
+	"\vSHADOW_MUTE\x10\t*4\n" +
+	"\tEmailType\x12\v\n" +
+	"\aDEFAULT\x10\x00\x12\f\n" +
+	"\bCHEATING\x10\x01\x12\f\n" +
+	"\bDELETION\x10\x02*\x8d\x01\n" +
+	"\x11NotoriousGameType\x12\b\n" +
+	"\x04GOOD\x10\x00\x12\v\n" +
+	"\aNO_PLAY\x10\x01\x12\v\n" +
+	"\aSITTING\x10\x02\x12\v\n" +
+	"\aSANDBAG\x10\x03\x12\x18\n" +
+	"\x14NO_PLAY_DENIED_NUDGE\x10\x04\x12\x15\n" +
+	"\x11EXCESSIVE_PHONIES\x10\x05\x12\x16\n" +
+	"\x12ENGINE_CORRELATION\x10\x06*G\n" +
+	"\x10ReportTargetType\x12\x11\n" +
+	"\rREPORT_PLAYER\x10\x00\x12\x0f\n" +
+	"\vREPORT_GAME\x10\x01\x12\x0f\n" +
+	"\vREPORT_CHAT\x10\x02*\xa9\x01\n" +
+	"\fReportReason\x12\x10\n" +
+	"\fREASON_OTHER\x10\x00\x12\x13\n" +
+	"\x0fREASON_CHEATING\x10\x01\x12\x15\n" +
+	"\x11REASON_HARASSMENT\x10\x02\x12\x1d\n" +
+	"\x19REASON_OFFENSIVE_USERNAME\x10\x03\x12\x0f\n" +
+	"\vREASON_SPAM\x10\x04\x12\x13\n" +
+	"\x0fREASON_STALLING\x10\x05\x12\x16\n" +
+	"\x12REASON_SANDBAGGING\x10\x06*\x81\x01\n" +
+	"\n" +
+	"CaseStatus\x12\r\n" +
+	"\tCASE_OPEN\x10\x00\x12\x11\n" +
+	"\rCASE_ASSIGNED\x10\x01\x12\x15\n" +
+	"\x11CASE_ACTION_TAKEN\x10\x02\x12\x12\n" +
+	"\x0eCASE_DISMISSED\x10\x03\x12\x11\n" +
+	"\rCASE_APPEALED\x10\x04\x12\x13\n" +
+	"\x0fCASE_OVERTURNED\x10\x05*4\n" +
+	"\fEvidenceType\x12\x11\n" +
+	"\rEVIDENCE_GAME\x10\x00\x12\x11\n" +
+	"\rEVIDENCE_CHAT\x10\x01*I\n" +
+	"\fAppealStatus\x12\x12\n" +
+	"\x0eAPPEAL_PENDING\x10\x00\x12\x12\n" +
+	"\x0eAPPEAL_GRANTED\x10\x01\x12\x11\n" +
+	"\rAPPEAL_DENIED\x10\x02*^\n" +
+	"\x0fChatChannelType\x12\x11\n" +
+	"\rCHANNEL_LOBBY\x10\x00\x12\x16\n" +
+	"\x12CHANNEL_TOURNAMENT\x10\x01\x12\x10\n" +
+	"\fCHANNEL_GAME\x10\x02\x12\x0e\n" +
+	"\n" +
+	"CHANNEL_PM\x10\x03*H\n" +
+	"\x10ChatFilterAction\x12\x0f\n" +
+	"\vCHAT_REJECT\x10\x00\x12\x14\n" +
+	"\x10CHAT_SHADOW_MUTE\x10\x01\x12\r\n" +
+	"\tCHAT_MUTE\x10\x022\xb3\r\n" +
+	"\n" +
+	"ModService\x12K\n" +
+	"\fApplyActions\x12\x1b.mod_service.ModActionsList\x1a\x1e.mod_service.ModActionResponse\x12L\n" +
+	"\rRemoveActions\x12\x1b.mod_service.ModActionsList\x1a\x1e.mod_service.ModActionResponse\x12H\n" +
+	"\n" +
+	"GetActions\x12\x1e.mod_service.GetActionsRequest\x1a\x1a.mod_service.ModActionsMap\x12O\n" +
+	"\x10GetActionHistory\x12\x1e.mod_service.GetActionsRequest\x1a\x1b.mod_service.ModActionsList\x12Z\n" +
+	"\x12GetNotorietyReport\x12&.mod_service.GetNotorietyReportRequest\x1a\x1c.mod_service.NotorietyReport\x12Y\n" +
+	"\x0eResetNotoriety\x12\".mod_service.ResetNotorietyRequest\x1a#.mod_service.ResetNotorietyResponse\x12M\n" +
+	"\n" +
+	"FileReport\x12\x1e.mod_service.FileReportRequest\x1a\x1f.mod_service.FileReportResponse\x12J\n" +
+	"\tListCases\x12\x1d.mod_service.ListCasesRequest\x1a\x1e.mod_service.ListCasesResponse\x12<\n" +
+	"\aGetCase\x12\x1b.mod_service.GetCaseRequest\x1a\x14.mod_service.ModCase\x12M\n" +
+	"\n" +
+	"AssignCase\x12\x1e.mod_service.AssignCaseRequest\x1a\x1f.mod_service.AssignCaseResponse\x12\\\n" +
+	"\x0fAddCaseEvidence\x12#.mod_service.AddCaseEvidenceRequest\x1a$.mod_service.AddCaseEvidenceResponse\x12P\n" +
+	"\vResolveCase\x12\x1f.mod_service.ResolveCaseRequest\x1a .mod_service.ResolveCaseResponse\x12S\n" +
+	"\fSubmitAppeal\x12 .mod_service.SubmitAppealRequest\x1a!.mod_service.SubmitAppealResponse\x12S\n" +
+	"\fDecideAppeal\x12 .mod_service.DecideAppealRequest\x1a!.mod_service.DecideAppealResponse\x12`\n" +
+	"\x14GetCheatSignalReport\x12(.mod_service.GetCheatSignalReportRequest\x1a\x1e.mod_service.CheatSignalReport\x12Q\n" +
+	"\x0fGetChatPolicies\x12#.mod_service.GetChatPoliciesRequest\x1a\x19.mod_service.ChatPolicies\x12L\n" +
+	"\rSetChatPolicy\x12\x17.mod_service.ChatPolicy\x1a\".mod_service.SetChatPolicyResponse\x12T\n" +
+	"\x10GetChatBlocklist\x12$.mod_service.GetChatBlocklistRequest\x1a\x1a.mod_service.ChatBlocklist\x12d\n" +
+	"\x15AddChatBlocklistEntry\x12\x1f.mod_service.ChatBlocklistEntry\x1a*.mod_service.AddChatBlocklistEntryResponse\x12w\n" +
+	"\x18RemoveChatBlocklistEntry\x12,.mod_service.RemoveChatBlocklistEntryRequest\x1a-.mod_service.RemoveChatBlocklistEntryResponseB\xa3\x01\n" +
 	"\x0fcom.mod_serviceB\x0fModServiceProtoP\x01Z7github.com/woogles-io/liwords/rpc/api/proto/mod_service\xa2\x02\x03MXX\xaa\x02\n" +
 	"ModService\xca\x02\n" +
 	"ModService\xe2\x02\x16ModService\\GPBMetadata\xea\x02\n" +
@@ -834,54 +3471,150 @@ func file_proto_mod_service_mod_service_proto_rawDescGZIP() []byte {
 	return file_proto_mod_service_mod_service_proto_rawDescData
 }
 
-var file_proto_mod_service_mod_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_mod_service_mod_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_mod_service_mod_service_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_mod_service_mod_service_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_proto_mod_service_mod_service_proto_goTypes = []any{
-	(ModActionType)(0),                // 0: mod_service.ModActionType
-	(EmailType)(0),                    // 1: mod_service.EmailType
-	(NotoriousGameType)(0),            // 2: mod_service.NotoriousGameType
-	(*ModAction)(nil),                 // 3: mod_service.ModAction
-	(*ModActionsMap)(nil),             // 4: mod_service.ModActionsMap
-	(*ModActionsList)(nil),            // 5: mod_service.ModActionsList
-	(*GetActionsRequest)(nil),         // 6: mod_service.GetActionsRequest
-	(*ModActionResponse)(nil),         // 7: mod_service.ModActionResponse
-	(*NotoriousGame)(nil),             // 8: mod_service.NotoriousGame
-	(*ResetNotorietyRequest)(nil),     // 9: mod_service.ResetNotorietyRequest
-	(*ResetNotorietyResponse)(nil),    // 10: mod_service.ResetNotorietyResponse
-	(*GetNotorietyReportRequest)(nil), // 11: mod_service.GetNotorietyReportRequest
-	(*NotorietyReport)(nil),           // 12: mod_service.NotorietyReport
-	nil,                               // 13: mod_service.ModActionsMap.ActionsEntry
-	(*timestamppb.Timestamp)(nil),     // 14: google.protobuf.Timestamp
+	(ModActionType)(0),                       // 0: mod_service.ModActionType
+	(EmailType)(0),                           // 1: mod_service.EmailType
+	(NotoriousGameType)(0),                   // 2: mod_service.NotoriousGameType
+	(ReportTargetType)(0),                    // 3: mod_service.ReportTargetType
+	(ReportReason)(0),                        // 4: mod_service.ReportReason
+	(CaseStatus)(0),                          // 5: mod_service.CaseStatus
+	(EvidenceType)(0),                        // 6: mod_service.EvidenceType
+	(AppealStatus)(0),                        // 7: mod_service.AppealStatus
+	(ChatChannelType)(0),                     // 8: mod_service.ChatChannelType
+	(ChatFilterAction)(0),                    // 9: mod_service.ChatFilterAction
+	(*ModAction)(nil),                        // 10: mod_service.ModAction
+	(*ModActionsMap)(nil),                    // 11: mod_service.ModActionsMap
+	(*ModActionsList)(nil),                   // 12: mod_service.ModActionsList
+	(*GetActionsRequest)(nil),                // 13: mod_service.GetActionsRequest
+	(*ModActionResponse)(nil),                // 14: mod_service.ModActionResponse
+	(*NotoriousGame)(nil),                    // 15: mod_service.NotoriousGame
+	(*ResetNotorietyRequest)(nil),            // 16: mod_service.ResetNotorietyRequest
+	(*ResetNotorietyResponse)(nil),           // 17: mod_service.ResetNotorietyResponse
+	(*GetNotorietyReportRequest)(nil),        // 18: mod_service.GetNotorietyReportRequest
+	(*NotorietyReport)(nil),                  // 19: mod_service.NotorietyReport
+	(*FileReportRequest)(nil),                // 20: mod_service.FileReportRequest
+	(*FileReportResponse)(nil),               // 21: mod_service.FileReportResponse
+	(*Report)(nil),                           // 22: mod_service.Report
+	(*Evidence)(nil),                         // 23: mod_service.Evidence
+	(*Appeal)(nil),                           // 24: mod_service.Appeal
+	(*ModCase)(nil),                          // 25: mod_service.ModCase
+	(*ListCasesRequest)(nil),                 // 26: mod_service.ListCasesRequest
+	(*ListCasesResponse)(nil),                // 27: mod_service.ListCasesResponse
+	(*GetCaseRequest)(nil),                   // 28: mod_service.GetCaseRequest
+	(*AssignCaseRequest)(nil),                // 29: mod_service.AssignCaseRequest
+	(*AssignCaseResponse)(nil),               // 30: mod_service.AssignCaseResponse
+	(*AddCaseEvidenceRequest)(nil),           // 31: mod_service.AddCaseEvidenceRequest
+	(*AddCaseEvidenceResponse)(nil),          // 32: mod_service.AddCaseEvidenceResponse
+	(*ResolveCaseRequest)(nil),               // 33: mod_service.ResolveCaseRequest
+	(*ResolveCaseResponse)(nil),              // 34: mod_service.ResolveCaseResponse
+	(*SubmitAppealRequest)(nil),              // 35: mod_service.SubmitAppealRequest
+	(*SubmitAppealResponse)(nil),             // 36: mod_service.SubmitAppealResponse
+	(*DecideAppealRequest)(nil),              // 37: mod_service.DecideAppealRequest
+	(*DecideAppealResponse)(nil),             // 38: mod_service.DecideAppealResponse
+	(*GameCheatSignals)(nil),                 // 39: mod_service.GameCheatSignals
+	(*GetCheatSignalReportRequest)(nil),      // 40: mod_service.GetCheatSignalReportRequest
+	(*CheatSignalReport)(nil),                // 41: mod_service.CheatSignalReport
+	(*ChatPolicy)(nil),                       // 42: mod_service.ChatPolicy
+	(*GetChatPoliciesRequest)(nil),           // 43: mod_service.GetChatPoliciesRequest
+	(*ChatPolicies)(nil),                     // 44: mod_service.ChatPolicies
+	(*SetChatPolicyResponse)(nil),            // 45: mod_service.SetChatPolicyResponse
+	(*ChatBlocklistEntry)(nil),               // 46: mod_service.ChatBlocklistEntry
+	(*GetChatBlocklistRequest)(nil),          // 47: mod_service.GetChatBlocklistRequest
+	(*ChatBlocklist)(nil),                    // 48: mod_service.ChatBlocklist
+	(*AddChatBlocklistEntryResponse)(nil),    // 49: mod_service.AddChatBlocklistEntryResponse
+	(*RemoveChatBlocklistEntryRequest)(nil),  // 50: mod_service.RemoveChatBlocklistEntryRequest
+	(*RemoveChatBlocklistEntryResponse)(nil), // 51: mod_service.RemoveChatBlocklistEntryResponse
+	nil,                                      // 52: mod_service.ModActionsMap.ActionsEntry
+	(*timestamppb.Timestamp)(nil),            // 53: google.protobuf.Timestamp
 }
 var file_proto_mod_service_mod_service_proto_depIdxs = []int32{
 	0,  // 0: mod_service.ModAction.type:type_name -> mod_service.ModActionType
-	14, // 1: mod_service.ModAction.start_time:type_name -> google.protobuf.Timestamp
-	14, // 2: mod_service.ModAction.end_time:type_name -> google.protobuf.Timestamp
-	14, // 3: mod_service.ModAction.removed_time:type_name -> google.protobuf.Timestamp
+	53, // 1: mod_service.ModAction.start_time:type_name -> google.protobuf.Timestamp
+	53, // 2: mod_service.ModAction.end_time:type_name -> google.protobuf.Timestamp
+	53, // 3: mod_service.ModAction.removed_time:type_name -> google.protobuf.Timestamp
 	1,  // 4: mod_service.ModAction.email_type:type_name -> mod_service.EmailType
-	13, // 5: mod_service.ModActionsMap.actions:type_name -> mod_service.ModActionsMap.ActionsEntry
-	3,  // 6: mod_service.ModActionsList.actions:type_name -> mod_service.ModAction
+	52, // 5: mod_service.ModActionsMap.actions:type_name -> mod_service.ModActionsMap.ActionsEntry
+	10, // 6: mod_service.ModActionsList.actions:type_name -> mod_service.ModAction
 	2,  // 7: mod_service.NotoriousGame.type:type_name -> mod_service.NotoriousGameType
-	14, // 8: mod_service.NotoriousGame.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 9: mod_service.NotorietyReport.games:type_name -> mod_service.NotoriousGame
-	3,  // 10: mod_service.ModActionsMap.ActionsEntry.value:type_name -> mod_service.ModAction
-	5,  // 11: mod_service.ModService.ApplyActions:input_type -> mod_service.ModActionsList
-	5,  // 12: mod_service.ModService.RemoveActions:input_type -> mod_service.ModActionsList
-	6,  // 13: mod_service.ModService.GetActions:input_type -> mod_service.GetActionsRequest
-	6,  // 14: mod_service.ModService.GetActionHistory:input_type -> mod_service.GetActionsRequest
-	11, // 15: mod_service.ModService.GetNotorietyReport:input_type -> mod_service.GetNotorietyReportRequest
-	9,  // 16: mod_service.ModService.ResetNotoriety:input_type -> mod_service.ResetNotorietyRequest
-	7,  // 17: mod_service.ModService.ApplyActions:output_type -> mod_service.ModActionResponse
-	7,  // 18: mod_service.ModService.RemoveActions:output_type -> mod_service.ModActionResponse
-	4,  // 19: mod_service.ModService.GetActions:output_type -> mod_service.ModActionsMap
-	5,  // 20: mod_service.ModService.GetActionHistory:output_type -> mod_service.ModActionsList
-	12, // 21: mod_service.ModService.GetNotorietyReport:output_type -> mod_service.NotorietyReport
-	10, // 22: mod_service.ModService.ResetNotoriety:output_type -> mod_service.ResetNotorietyResponse
-	17, // [17:23] is the sub-list for method output_type
-	11, // [11:17] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	53, // 8: mod_service.NotoriousGame.created_at:type_name -> google.protobuf.Timestamp
+	15, // 9: mod_service.NotorietyReport.games:type_name -> mod_service.NotoriousGame
+	3,  // 10: mod_service.FileReportRequest.target_type:type_name -> mod_service.ReportTargetType
+	4,  // 11: mod_service.FileReportRequest.reason:type_name -> mod_service.ReportReason
+	3,  // 12: mod_service.Report.target_type:type_name -> mod_service.ReportTargetType
+	4,  // 13: mod_service.Report.reason:type_name -> mod_service.ReportReason
+	53, // 14: mod_service.Report.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 15: mod_service.Evidence.type:type_name -> mod_service.EvidenceType
+	53, // 16: mod_service.Evidence.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 17: mod_service.Appeal.status:type_name -> mod_service.AppealStatus
+	53, // 18: mod_service.Appeal.created_at:type_name -> google.protobuf.Timestamp
+	53, // 19: mod_service.Appeal.decided_at:type_name -> google.protobuf.Timestamp
+	5,  // 20: mod_service.ModCase.status:type_name -> mod_service.CaseStatus
+	53, // 21: mod_service.ModCase.created_at:type_name -> google.protobuf.Timestamp
+	53, // 22: mod_service.ModCase.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 23: mod_service.ModCase.resolved_at:type_name -> google.protobuf.Timestamp
+	22, // 24: mod_service.ModCase.reports:type_name -> mod_service.Report
+	23, // 25: mod_service.ModCase.evidence:type_name -> mod_service.Evidence
+	10, // 26: mod_service.ModCase.actions:type_name -> mod_service.ModAction
+	24, // 27: mod_service.ModCase.appeals:type_name -> mod_service.Appeal
+	5,  // 28: mod_service.ListCasesRequest.statuses:type_name -> mod_service.CaseStatus
+	25, // 29: mod_service.ListCasesResponse.cases:type_name -> mod_service.ModCase
+	6,  // 30: mod_service.AddCaseEvidenceRequest.type:type_name -> mod_service.EvidenceType
+	10, // 31: mod_service.ResolveCaseRequest.actions:type_name -> mod_service.ModAction
+	53, // 32: mod_service.GameCheatSignals.created_at:type_name -> google.protobuf.Timestamp
+	39, // 33: mod_service.CheatSignalReport.games:type_name -> mod_service.GameCheatSignals
+	8,  // 34: mod_service.ChatPolicy.channel_type:type_name -> mod_service.ChatChannelType
+	9,  // 35: mod_service.ChatPolicy.flood_action:type_name -> mod_service.ChatFilterAction
+	9,  // 36: mod_service.ChatPolicy.blocklist_action:type_name -> mod_service.ChatFilterAction
+	42, // 37: mod_service.ChatPolicies.policies:type_name -> mod_service.ChatPolicy
+	46, // 38: mod_service.ChatBlocklist.entries:type_name -> mod_service.ChatBlocklistEntry
+	10, // 39: mod_service.ModActionsMap.ActionsEntry.value:type_name -> mod_service.ModAction
+	12, // 40: mod_service.ModService.ApplyActions:input_type -> mod_service.ModActionsList
+	12, // 41: mod_service.ModService.RemoveActions:input_type -> mod_service.ModActionsList
+	13, // 42: mod_service.ModService.GetActions:input_type -> mod_service.GetActionsRequest
+	13, // 43: mod_service.ModService.GetActionHistory:input_type -> mod_service.GetActionsRequest
+	18, // 44: mod_service.ModService.GetNotorietyReport:input_type -> mod_service.GetNotorietyReportRequest
+	16, // 45: mod_service.ModService.ResetNotoriety:input_type -> mod_service.ResetNotorietyRequest
+	20, // 46: mod_service.ModService.FileReport:input_type -> mod_service.FileReportRequest
+	26, // 47: mod_service.ModService.ListCases:input_type -> mod_service.ListCasesRequest
+	28, // 48: mod_service.ModService.GetCase:input_type -> mod_service.GetCaseRequest
+	29, // 49: mod_service.ModService.AssignCase:input_type -> mod_service.AssignCaseRequest
+	31, // 50: mod_service.ModService.AddCaseEvidence:input_type -> mod_service.AddCaseEvidenceRequest
+	33, // 51: mod_service.ModService.ResolveCase:input_type -> mod_service.ResolveCaseRequest
+	35, // 52: mod_service.ModService.SubmitAppeal:input_type -> mod_service.SubmitAppealRequest
+	37, // 53: mod_service.ModService.DecideAppeal:input_type -> mod_service.DecideAppealRequest
+	40, // 54: mod_service.ModService.GetCheatSignalReport:input_type -> mod_service.GetCheatSignalReportRequest
+	43, // 55: mod_service.ModService.GetChatPolicies:input_type -> mod_service.GetChatPoliciesRequest
+	42, // 56: mod_service.ModService.SetChatPolicy:input_type -> mod_service.ChatPolicy
+	47, // 57: mod_service.ModService.GetChatBlocklist:input_type -> mod_service.GetChatBlocklistRequest
+	46, // 58: mod_service.ModService.AddChatBlocklistEntry:input_type -> mod_service.ChatBlocklistEntry
+	50, // 59: mod_service.ModService.RemoveChatBlocklistEntry:input_type -> mod_service.RemoveChatBlocklistEntryRequest
+	14, // 60: mod_service.ModService.ApplyActions:output_type -> mod_service.ModActionResponse
+	14, // 61: mod_service.ModService.RemoveActions:output_type -> mod_service.ModActionResponse
+	11, // 62: mod_service.ModService.GetActions:output_type -> mod_service.ModActionsMap
+	12, // 63: mod_service.ModService.GetActionHistory:output_type -> mod_service.ModActionsList
+	19, // 64: mod_service.ModService.GetNotorietyReport:output_type -> mod_service.NotorietyReport
+	17, // 65: mod_service.ModService.ResetNotoriety:output_type -> mod_service.ResetNotorietyResponse
+	21, // 66: mod_service.ModService.FileReport:output_type -> mod_service.FileReportResponse
+	27, // 67: mod_service.ModService.ListCases:output_type -> mod_service.ListCasesResponse
+	25, // 68: mod_service.ModService.GetCase:output_type -> mod_service.ModCase
+	30, // 69: mod_service.ModService.AssignCase:output_type -> mod_service.AssignCaseResponse
+	32, // 70: mod_service.ModService.AddCaseEvidence:output_type -> mod_service.AddCaseEvidenceResponse
+	34, // 71: mod_service.ModService.ResolveCase:output_type -> mod_service.ResolveCaseResponse
+	36, // 72: mod_service.ModService.SubmitAppeal:output_type -> mod_service.SubmitAppealResponse
+	38, // 73: mod_service.ModService.DecideAppeal:output_type -> mod_service.DecideAppealResponse
+	41, // 74: mod_service.ModService.GetCheatSignalReport:output_type -> mod_service.CheatSignalReport
+	44, // 75: mod_service.ModService.GetChatPolicies:output_type -> mod_service.ChatPolicies
+	45, // 76: mod_service.ModService.SetChatPolicy:output_type -> mod_service.SetChatPolicyResponse
+	48, // 77: mod_service.ModService.GetChatBlocklist:output_type -> mod_service.ChatBlocklist
+	49, // 78: mod_service.ModService.AddChatBlocklistEntry:output_type -> mod_service.AddChatBlocklistEntryResponse
+	51, // 79: mod_service.ModService.RemoveChatBlocklistEntry:output_type -> mod_service.RemoveChatBlocklistEntryResponse
+	60, // [60:80] is the sub-list for method output_type
+	40, // [40:60] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_proto_mod_service_mod_service_proto_init() }
@@ -894,8 +3627,8 @@ func file_proto_mod_service_mod_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_mod_service_mod_service_proto_rawDesc), len(file_proto_mod_service_mod_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   11,
+			NumEnums:      10,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -49,6 +49,41 @@ const (
 	// ModServiceResetNotorietyProcedure is the fully-qualified name of the ModService's ResetNotoriety
 	// RPC.
 	ModServiceResetNotorietyProcedure = "/mod_service.ModService/ResetNotoriety"
+	// ModServiceFileReportProcedure is the fully-qualified name of the ModService's FileReport RPC.
+	ModServiceFileReportProcedure = "/mod_service.ModService/FileReport"
+	// ModServiceListCasesProcedure is the fully-qualified name of the ModService's ListCases RPC.
+	ModServiceListCasesProcedure = "/mod_service.ModService/ListCases"
+	// ModServiceGetCaseProcedure is the fully-qualified name of the ModService's GetCase RPC.
+	ModServiceGetCaseProcedure = "/mod_service.ModService/GetCase"
+	// ModServiceAssignCaseProcedure is the fully-qualified name of the ModService's AssignCase RPC.
+	ModServiceAssignCaseProcedure = "/mod_service.ModService/AssignCase"
+	// ModServiceAddCaseEvidenceProcedure is the fully-qualified name of the ModService's
+	// AddCaseEvidence RPC.
+	ModServiceAddCaseEvidenceProcedure = "/mod_service.ModService/AddCaseEvidence"
+	// ModServiceResolveCaseProcedure is the fully-qualified name of the ModService's ResolveCase RPC.
+	ModServiceResolveCaseProcedure = "/mod_service.ModService/ResolveCase"
+	// ModServiceSubmitAppealProcedure is the fully-qualified name of the ModService's SubmitAppeal RPC.
+	ModServiceSubmitAppealProcedure = "/mod_service.ModService/SubmitAppeal"
+	// ModServiceDecideAppealProcedure is the fully-qualified name of the ModService's DecideAppeal RPC.
+	ModServiceDecideAppealProcedure = "/mod_service.ModService/DecideAppeal"
+	// ModServiceGetCheatSignalReportProcedure is the fully-qualified name of the ModService's
+	// GetCheatSignalReport RPC.
+	ModServiceGetCheatSignalReportProcedure = "/mod_service.ModService/GetCheatSignalReport"
+	// ModServiceGetChatPoliciesProcedure is the fully-qualified name of the ModService's
+	// GetChatPolicies RPC.
+	ModServiceGetChatPoliciesProcedure = "/mod_service.ModService/GetChatPolicies"
+	// ModServiceSetChatPolicyProcedure is the fully-qualified name of the ModService's SetChatPolicy
+	// RPC.
+	ModServiceSetChatPolicyProcedure = "/mod_service.ModService/SetChatPolicy"
+	// ModServiceGetChatBlocklistProcedure is the fully-qualified name of the ModService's
+	// GetChatBlocklist RPC.
+	ModServiceGetChatBlocklistProcedure = "/mod_service.ModService/GetChatBlocklist"
+	// ModServiceAddChatBlocklistEntryProcedure is the fully-qualified name of the ModService's
+	// AddChatBlocklistEntry RPC.
+	ModServiceAddChatBlocklistEntryProcedure = "/mod_service.ModService/AddChatBlocklistEntry"
+	// ModServiceRemoveChatBlocklistEntryProcedure is the fully-qualified name of the ModService's
+	// RemoveChatBlocklistEntry RPC.
+	ModServiceRemoveChatBlocklistEntryProcedure = "/mod_service.ModService/RemoveChatBlocklistEntry"
 )
 
 // ModServiceClient is a client for the mod_service.ModService service.
@@ -59,6 +94,20 @@ type ModServiceClient interface {
 	GetActionHistory(context.Context, *connect.Request[mod_service.GetActionsRequest]) (*connect.Response[mod_service.ModActionsList], error)
 	GetNotorietyReport(context.Context, *connect.Request[mod_service.GetNotorietyReportRequest]) (*connect.Response[mod_service.NotorietyReport], error)
 	ResetNotoriety(context.Context, *connect.Request[mod_service.ResetNotorietyRequest]) (*connect.Response[mod_service.ResetNotorietyResponse], error)
+	FileReport(context.Context, *connect.Request[mod_service.FileReportRequest]) (*connect.Response[mod_service.FileReportResponse], error)
+	ListCases(context.Context, *connect.Request[mod_service.ListCasesRequest]) (*connect.Response[mod_service.ListCasesResponse], error)
+	GetCase(context.Context, *connect.Request[mod_service.GetCaseRequest]) (*connect.Response[mod_service.ModCase], error)
+	AssignCase(context.Context, *connect.Request[mod_service.AssignCaseRequest]) (*connect.Response[mod_service.AssignCaseResponse], error)
+	AddCaseEvidence(context.Context, *connect.Request[mod_service.AddCaseEvidenceRequest]) (*connect.Response[mod_service.AddCaseEvidenceResponse], error)
+	ResolveCase(context.Context, *connect.Request[mod_service.ResolveCaseRequest]) (*connect.Response[mod_service.ResolveCaseResponse], error)
+	SubmitAppeal(context.Context, *connect.Request[mod_service.SubmitAppealRequest]) (*connect.Response[mod_service.SubmitAppealResponse], error)
+	DecideAppeal(context.Context, *connect.Request[mod_service.DecideAppealRequest]) (*connect.Response[mod_service.DecideAppealResponse], error)
+	GetCheatSignalReport(context.Context, *connect.Request[mod_service.GetCheatSignalReportRequest]) (*connect.Response[mod_service.CheatSignalReport], error)
+	GetChatPolicies(context.Context, *connect.Request[mod_service.GetChatPoliciesRequest]) (*connect.Response[mod_service.ChatPolicies], error)
+	SetChatPolicy(context.Context, *connect.Request[mod_service.ChatPolicy]) (*connect.Response[mod_service.SetChatPolicyResponse], error)
+	GetChatBlocklist(context.Context, *connect.Request[mod_service.GetChatBlocklistRequest]) (*connect.Response[mod_service.ChatBlocklist], error)
+	AddChatBlocklistEntry(context.Context, *connect.Request[mod_service.ChatBlocklistEntry]) (*connect.Response[mod_service.AddChatBlocklistEntryResponse], error)
+	RemoveChatBlocklistEntry(context.Context, *connect.Request[mod_service.RemoveChatBlocklistEntryRequest]) (*connect.Response[mod_service.RemoveChatBlocklistEntryResponse], error)
 }
 
 // NewModServiceClient constructs a client for the mod_service.ModService service. By default, it
@@ -108,17 +157,115 @@ func NewModServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(modServiceMethods.ByName("ResetNotoriety")),
 			connect.WithClientOptions(opts...),
 		),
+		fileReport: connect.NewClient[mod_service.FileReportRequest, mod_service.FileReportResponse](
+			httpClient,
+			baseURL+ModServiceFileReportProcedure,
+			connect.WithSchema(modServiceMethods.ByName("FileReport")),
+			connect.WithClientOptions(opts...),
+		),
+		listCases: connect.NewClient[mod_service.ListCasesRequest, mod_service.ListCasesResponse](
+			httpClient,
+			baseURL+ModServiceListCasesProcedure,
+			connect.WithSchema(modServiceMethods.ByName("ListCases")),
+			connect.WithClientOptions(opts...),
+		),
+		getCase: connect.NewClient[mod_service.GetCaseRequest, mod_service.ModCase](
+			httpClient,
+			baseURL+ModServiceGetCaseProcedure,
+			connect.WithSchema(modServiceMethods.ByName("GetCase")),
+			connect.WithClientOptions(opts...),
+		),
+		assignCase: connect.NewClient[mod_service.AssignCaseRequest, mod_service.AssignCaseResponse](
+			httpClient,
+			baseURL+ModServiceAssignCaseProcedure,
+			connect.WithSchema(modServiceMethods.ByName("AssignCase")),
+			connect.WithClientOptions(opts...),
+		),
+		addCaseEvidence: connect.NewClient[mod_service.AddCaseEvidenceRequest, mod_service.AddCaseEvidenceResponse](
+			httpClient,
+			baseURL+ModServiceAddCaseEvidenceProcedure,
+			connect.WithSchema(modServiceMethods.ByName("AddCaseEvidence")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveCase: connect.NewClient[mod_service.ResolveCaseRequest, mod_service.ResolveCaseResponse](
+			httpClient,
+			baseURL+ModServiceResolveCaseProcedure,
+			connect.WithSchema(modServiceMethods.ByName("ResolveCase")),
+			connect.WithClientOptions(opts...),
+		),
+		submitAppeal: connect.NewClient[mod_service.SubmitAppealRequest, mod_service.SubmitAppealResponse](
+			httpClient,
+			baseURL+ModServiceSubmitAppealProcedure,
+			connect.WithSchema(modServiceMethods.ByName("SubmitAppeal")),
+			connect.WithClientOptions(opts...),
+		),
+		decideAppeal: connect.NewClient[mod_service.DecideAppealRequest, mod_service.DecideAppealResponse](
+			httpClient,
+			baseURL+ModServiceDecideAppealProcedure,
+			connect.WithSchema(modServiceMethods.ByName("DecideAppeal")),
+			connect.WithClientOptions(opts...),
+		),
+		getCheatSignalReport: connect.NewClient[mod_service.GetCheatSignalReportRequest, mod_service.CheatSignalReport](
+			httpClient,
+			baseURL+ModServiceGetCheatSignalReportProcedure,
+			connect.WithSchema(modServiceMethods.ByName("GetCheatSignalReport")),
+			connect.WithClientOptions(opts...),
+		),
+		getChatPolicies: connect.NewClient[mod_service.GetChatPoliciesRequest, mod_service.ChatPolicies](
+			httpClient,
+			baseURL+ModServiceGetChatPoliciesProcedure,
+			connect.WithSchema(modServiceMethods.ByName("GetChatPolicies")),
+			connect.WithClientOptions(opts...),
+		),
+		setChatPolicy: connect.NewClient[mod_service.ChatPolicy, mod_service.SetChatPolicyResponse](
+			httpClient,
+			baseURL+ModServiceSetChatPolicyProcedure,
+			connect.WithSchema(modServiceMethods.ByName("SetChatPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		getChatBlocklist: connect.NewClient[mod_service.GetChatBlocklistRequest, mod_service.ChatBlocklist](
+			httpClient,
+			baseURL+ModServiceGetChatBlocklistProcedure,
+			connect.WithSchema(modServiceMethods.ByName("GetChatBlocklist")),
+			connect.WithClientOptions(opts...),
+		),
+		addChatBlocklistEntry: connect.NewClient[mod_service.ChatBlocklistEntry, mod_service.AddChatBlocklistEntryResponse](
+			httpClient,
+			baseURL+ModServiceAddChatBlocklistEntryProcedure,
+			connect.WithSchema(modServiceMethods.ByName("AddChatBlocklistEntry")),
+			connect.WithClientOptions(opts...),
+		),
+		removeChatBlocklistEntry: connect.NewClient[mod_service.RemoveChatBlocklistEntryRequest, mod_service.RemoveChatBlocklistEntryResponse](
+			httpClient,
+			baseURL+ModServiceRemoveChatBlocklistEntryProcedure,
+			connect.WithSchema(modServiceMethods.ByName("RemoveChatBlocklistEntry")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // modServiceClient implements ModServiceClient.
 type modServiceClient struct {
-	applyActions       *connect.Client[mod_service.ModActionsList, mod_service.ModActionResponse]
-	removeActions      *connect.Client[mod_service.ModActionsList, mod_service.ModActionResponse]
-	getActions         *connect.Client[mod_service.GetActionsRequest, mod_service.ModActionsMap]
-	getActionHistory   *connect.Client[mod_service.GetActionsRequest, mod_service.ModActionsList]
-	getNotorietyReport *connect.Client[mod_service.GetNotorietyReportRequest, mod_service.NotorietyReport]
-	resetNotoriety     *connect.Client[mod_service.ResetNotorietyRequest, mod_service.ResetNotorietyResponse]
+	applyActions             *connect.Client[mod_service.ModActionsList, mod_service.ModActionResponse]
+	removeActions            *connect.Client[mod_service.ModActionsList, mod_service.ModActionResponse]
+	getActions               *connect.Client[mod_service.GetActionsRequest, mod_service.ModActionsMap]
+	getActionHistory         *connect.Client[mod_service.GetActionsRequest, mod_service.ModActionsList]
+	getNotorietyReport       *connect.Client[mod_service.GetNotorietyReportRequest, mod_service.NotorietyReport]
+	resetNotoriety           *connect.Client[mod_service.ResetNotorietyRequest, mod_service.ResetNotorietyResponse]
+	fileReport               *connect.Client[mod_service.FileReportRequest, mod_service.FileReportResponse]
+	listCases                *connect.Client[mod_service.ListCasesRequest, mod_service.ListCasesResponse]
+	getCase                  *connect.Client[mod_service.GetCaseRequest, mod_service.ModCase]
+	assignCase               *connect.Client[mod_service.AssignCaseRequest, mod_service.AssignCaseResponse]
+	addCaseEvidence          *connect.Client[mod_service.AddCaseEvidenceRequest, mod_service.AddCaseEvidenceResponse]
+	resolveCase              *connect.Client[mod_service.ResolveCaseRequest, mod_service.ResolveCaseResponse]
+	submitAppeal             *connect.Client[mod_service.SubmitAppealRequest, mod_service.SubmitAppealResponse]
+	decideAppeal             *connect.Client[mod_service.DecideAppealRequest, mod_service.DecideAppealResponse]
+	getCheatSignalReport     *connect.Client[mod_service.GetCheatSignalReportRequest, mod_service.CheatSignalReport]
+	getChatPolicies          *connect.Client[mod_service.GetChatPoliciesRequest, mod_service.ChatPolicies]
+	setChatPolicy            *connect.Client[mod_service.ChatPolicy, mod_service.SetChatPolicyResponse]
+	getChatBlocklist         *connect.Client[mod_service.GetChatBlocklistRequest, mod_service.ChatBlocklist]
+	addChatBlocklistEntry    *connect.Client[mod_service.ChatBlocklistEntry, mod_service.AddChatBlocklistEntryResponse]
+	removeChatBlocklistEntry *connect.Client[mod_service.RemoveChatBlocklistEntryRequest, mod_service.RemoveChatBlocklistEntryResponse]
 }
 
 // ApplyActions calls mod_service.ModService.ApplyActions.
@@ -151,6 +298,76 @@ func (c *modServiceClient) ResetNotoriety(ctx context.Context, req *connect.Requ
 	return c.resetNotoriety.CallUnary(ctx, req)
 }
 
+// FileReport calls mod_service.ModService.FileReport.
+func (c *modServiceClient) FileReport(ctx context.Context, req *connect.Request[mod_service.FileReportRequest]) (*connect.Response[mod_service.FileReportResponse], error) {
+	return c.fileReport.CallUnary(ctx, req)
+}
+
+// ListCases calls mod_service.ModService.ListCases.
+func (c *modServiceClient) ListCases(ctx context.Context, req *connect.Request[mod_service.ListCasesRequest]) (*connect.Response[mod_service.ListCasesResponse], error) {
+	return c.listCases.CallUnary(ctx, req)
+}
+
+// GetCase calls mod_service.ModService.GetCase.
+func (c *modServiceClient) GetCase(ctx context.Context, req *connect.Request[mod_service.GetCaseRequest]) (*connect.Response[mod_service.ModCase], error) {
+	return c.getCase.CallUnary(ctx, req)
+}
+
+// AssignCase calls mod_service.ModService.AssignCase.
+func (c *modServiceClient) AssignCase(ctx context.Context, req *connect.Request[mod_service.AssignCaseRequest]) (*connect.Response[mod_service.AssignCaseResponse], error) {
+	return c.assignCase.CallUnary(ctx, req)
+}
+
+// AddCaseEvidence calls mod_service.ModService.AddCaseEvidence.
+func (c *modServiceClient) AddCaseEvidence(ctx context.Context, req *connect.Request[mod_service.AddCaseEvidenceRequest]) (*connect.Response[mod_service.AddCaseEvidenceResponse], error) {
+	return c.addCaseEvidence.CallUnary(ctx, req)
+}
+
+// ResolveCase calls mod_service.ModService.ResolveCase.
+func (c *modServiceClient) ResolveCase(ctx context.Context, req *connect.Request[mod_service.ResolveCaseRequest]) (*connect.Response[mod_service.ResolveCaseResponse], error) {
+	return c.resolveCase.CallUnary(ctx, req)
+}
+
+// SubmitAppeal calls mod_service.ModService.SubmitAppeal.
+func (c *modServiceClient) SubmitAppeal(ctx context.Context, req *connect.Request[mod_service.SubmitAppealRequest]) (*connect.Response[mod_service.SubmitAppealResponse], error) {
+	return c.submitAppeal.CallUnary(ctx, req)
+}
+
+// DecideAppeal calls mod_service.ModService.DecideAppeal.
+func (c *modServiceClient) DecideAppeal(ctx context.Context, req *connect.Request[mod_service.DecideAppealRequest]) (*connect.Response[mod_service.DecideAppealResponse], error) {
+	return c.decideAppeal.CallUnary(ctx, req)
+}
+
+// GetCheatSignalReport calls mod_service.ModService.GetCheatSignalReport.
+func (c *modServiceClient) GetCheatSignalReport(ctx context.Context, req *connect.Request[mod_service.GetCheatSignalReportRequest]) (*connect.Response[mod_service.CheatSignalReport], error) {
+	return c.getCheatSignalReport.CallUnary(ctx, req)
+}
+
+// GetChatPolicies calls mod_service.ModService.GetChatPolicies.
+func (c *modServiceClient) GetChatPolicies(ctx context.Context, req *connect.Request[mod_service.GetChatPoliciesRequest]) (*connect.Response[mod_service.ChatPolicies], error) {
+	return c.getChatPolicies.CallUnary(ctx, req)
+}
+
+// SetChatPolicy calls mod_service.ModService.SetChatPolicy.
+func (c *modServiceClient) SetChatPolicy(ctx context.Context, req *connect.Request[mod_service.ChatPolicy]) (*connect.Response[mod_service.SetChatPolicyResponse], error) {
+	return c.setChatPolicy.CallUnary(ctx, req)
+}
+
+// GetChatBlocklist calls mod_service.ModService.GetChatBlocklist.
+func (c *modServiceClient) GetChatBlocklist(ctx context.Context, req *connect.Request[mod_service.GetChatBlocklistRequest]) (*connect.Response[mod_service.ChatBlocklist], error) {
+	return c.getChatBlocklist.CallUnary(ctx, req)
+}
+
+// AddChatBlocklistEntry calls mod_service.ModService.AddChatBlocklistEntry.
+func (c *modServiceClient) AddChatBlocklistEntry(ctx context.Context, req *connect.Request[mod_service.ChatBlocklistEntry]) (*connect.Response[mod_service.AddChatBlocklistEntryResponse], error) {
+	return c.addChatBlocklistEntry.CallUnary(ctx, req)
+}
+
+// RemoveChatBlocklistEntry calls mod_service.ModService.RemoveChatBlocklistEntry.
+func (c *modServiceClient) RemoveChatBlocklistEntry(ctx context.Context, req *connect.Request[mod_service.RemoveChatBlocklistEntryRequest]) (*connect.Response[mod_service.RemoveChatBlocklistEntryResponse], error) {
+	return c.removeChatBlocklistEntry.CallUnary(ctx, req)
+}
+
 // ModServiceHandler is an implementation of the mod_service.ModService service.
 type ModServiceHandler interface {
 	ApplyActions(context.Context, *connect.Request[mod_service.ModActionsList]) (*connect.Response[mod_service.ModActionResponse], error)
@@ -159,6 +376,20 @@ type ModServiceHandler interface {
 	GetActionHistory(context.Context, *connect.Request[mod_service.GetActionsRequest]) (*connect.Response[mod_service.ModActionsList], error)
 	GetNotorietyReport(context.Context, *connect.Request[mod_service.GetNotorietyReportRequest]) (*connect.Response[mod_service.NotorietyReport], error)
 	ResetNotoriety(context.Context, *connect.Request[mod_service.ResetNotorietyRequest]) (*connect.Response[mod_service.ResetNotorietyResponse], error)
+	FileReport(context.Context, *connect.Request[mod_service.FileReportRequest]) (*connect.Response[mod_service.FileReportResponse], error)
+	ListCases(context.Context, *connect.Request[mod_service.ListCasesRequest]) (*connect.Response[mod_service.ListCasesResponse], error)
+	GetCase(context.Context, *connect.Request[mod_service.GetCaseRequest]) (*connect.Response[mod_service.ModCase], error)
+	AssignCase(context.Context, *connect.Request[mod_service.AssignCaseRequest]) (*connect.Response[mod_service.AssignCaseResponse], error)
+	AddCaseEvidence(context.Context, *connect.Request[mod_service.AddCaseEvidenceRequest]) (*connect.Response[mod_service.AddCaseEvidenceResponse], error)
+	ResolveCase(context.Context, *connect.Request[mod_service.ResolveCaseRequest]) (*connect.Response[mod_service.ResolveCaseResponse], error)
+	SubmitAppeal(context.Context, *connect.Request[mod_service.SubmitAppealRequest]) (*connect.Response[mod_service.SubmitAppealResponse], error)
+	DecideAppeal(context.Context, *connect.Request[mod_service.DecideAppealRequest]) (*connect.Response[mod_service.DecideAppealResponse], error)
+	GetCheatSignalReport(context.Context, *connect.Request[mod_service.GetCheatSignalReportRequest]) (*connect.Response[mod_service.CheatSignalReport], error)
+	GetChatPolicies(context.Context, *connect.Request[mod_service.GetChatPoliciesRequest]) (*connect.Response[mod_service.ChatPolicies], error)
+	SetChatPolicy(context.Context, *connect.Request[mod_service.ChatPolicy]) (*connect.Response[mod_service.SetChatPolicyResponse], error)
+	GetChatBlocklist(context.Context, *connect.Request[mod_service.GetChatBlocklistRequest]) (*connect.Response[mod_service.ChatBlocklist], error)
+	AddChatBlocklistEntry(context.Context, *connect.Request[mod_service.ChatBlocklistEntry]) (*connect.Response[mod_service.AddChatBlocklistEntryResponse], error)
+	RemoveChatBlocklistEntry(context.Context, *connect.Request[mod_service.RemoveChatBlocklistEntryRequest]) (*connect.Response[mod_service.RemoveChatBlocklistEntryResponse], error)
 }
 
 // NewModServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -204,6 +435,90 @@ func NewModServiceHandler(svc ModServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(modServiceMethods.ByName("ResetNotoriety")),
 		connect.WithHandlerOptions(opts...),
 	)
+	modServiceFileReportHandler := connect.NewUnaryHandler(
+		ModServiceFileReportProcedure,
+		svc.FileReport,
+		connect.WithSchema(modServiceMethods.ByName("FileReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceListCasesHandler := connect.NewUnaryHandler(
+		ModServiceListCasesProcedure,
+		svc.ListCases,
+		connect.WithSchema(modServiceMethods.ByName("ListCases")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceGetCaseHandler := connect.NewUnaryHandler(
+		ModServiceGetCaseProcedure,
+		svc.GetCase,
+		connect.WithSchema(modServiceMethods.ByName("GetCase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceAssignCaseHandler := connect.NewUnaryHandler(
+		ModServiceAssignCaseProcedure,
+		svc.AssignCase,
+		connect.WithSchema(modServiceMethods.ByName("AssignCase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceAddCaseEvidenceHandler := connect.NewUnaryHandler(
+		ModServiceAddCaseEvidenceProcedure,
+		svc.AddCaseEvidence,
+		connect.WithSchema(modServiceMethods.ByName("AddCaseEvidence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceResolveCaseHandler := connect.NewUnaryHandler(
+		ModServiceResolveCaseProcedure,
+		svc.ResolveCase,
+		connect.WithSchema(modServiceMethods.ByName("ResolveCase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceSubmitAppealHandler := connect.NewUnaryHandler(
+		ModServiceSubmitAppealProcedure,
+		svc.SubmitAppeal,
+		connect.WithSchema(modServiceMethods.ByName("SubmitAppeal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceDecideAppealHandler := connect.NewUnaryHandler(
+		ModServiceDecideAppealProcedure,
+		svc.DecideAppeal,
+		connect.WithSchema(modServiceMethods.ByName("DecideAppeal")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceGetCheatSignalReportHandler := connect.NewUnaryHandler(
+		ModServiceGetCheatSignalReportProcedure,
+		svc.GetCheatSignalReport,
+		connect.WithSchema(modServiceMethods.ByName("GetCheatSignalReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceGetChatPoliciesHandler := connect.NewUnaryHandler(
+		ModServiceGetChatPoliciesProcedure,
+		svc.GetChatPolicies,
+		connect.WithSchema(modServiceMethods.ByName("GetChatPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceSetChatPolicyHandler := connect.NewUnaryHandler(
+		ModServiceSetChatPolicyProcedure,
+		svc.SetChatPolicy,
+		connect.WithSchema(modServiceMethods.ByName("SetChatPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceGetChatBlocklistHandler := connect.NewUnaryHandler(
+		ModServiceGetChatBlocklistProcedure,
+		svc.GetChatBlocklist,
+		connect.WithSchema(modServiceMethods.ByName("GetChatBlocklist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceAddChatBlocklistEntryHandler := connect.NewUnaryHandler(
+		ModServiceAddChatBlocklistEntryProcedure,
+		svc.AddChatBlocklistEntry,
+		connect.WithSchema(modServiceMethods.ByName("AddChatBlocklistEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modServiceRemoveChatBlocklistEntryHandler := connect.NewUnaryHandler(
+		ModServiceRemoveChatBlocklistEntryProcedure,
+		svc.RemoveChatBlocklistEntry,
+		connect.WithSchema(modServiceMethods.ByName("RemoveChatBlocklistEntry")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mod_service.ModService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ModServiceApplyActionsProcedure:
@@ -218,6 +533,34 @@ func NewModServiceHandler(svc ModServiceHandler, opts ...connect.HandlerOption)
 			modServiceGetNotorietyReportHandler.ServeHTTP(w, r)
 		case ModServiceResetNotorietyProcedure:
 			modServiceResetNotorietyHandler.ServeHTTP(w, r)
+		case ModServiceFileReportProcedure:
+			modServiceFileReportHandler.ServeHTTP(w, r)
+		case ModServiceListCasesProcedure:
+			modServiceListCasesHandler.ServeHTTP(w, r)
+		case ModServiceGetCaseProcedure:
+			modServiceGetCaseHandler.ServeHTTP(w, r)
+		case ModServiceAssignCaseProcedure:
+			modServiceAssignCaseHandler.ServeHTTP(w, r)
+		case ModServiceAddCaseEvidenceProcedure:
+			modServiceAddCaseEvidenceHandler.ServeHTTP(w, r)
+		case ModServiceResolveCaseProcedure:
+			modServiceResolveCaseHandler.ServeHTTP(w, r)
+		case ModServiceSubmitAppealProcedure:
+			modServiceSubmitAppealHandler.ServeHTTP(w, r)
+		case ModServiceDecideAppealProcedure:
+			modServiceDecideAppealHandler.ServeHTTP(w, r)
+		case ModServiceGetCheatSignalReportProcedure:
+			modServiceGetCheatSignalReportHandler.ServeHTTP(w, r)
+		case ModServiceGetChatPoliciesProcedure:
+			modServiceGetChatPoliciesHandler.ServeHTTP(w, r)
+		case ModServiceSetChatPolicyProcedure:
+			modServiceSetChatPolicyHandler.ServeHTTP(w, r)
+		case ModServiceGetChatBlocklistProcedure:
+			modServiceGetChatBlocklistHandler.ServeHTTP(w, r)
+		case ModServiceAddChatBlocklistEntryProcedure:
+			modServiceAddChatBlocklistEntryHandler.ServeHTTP(w, r)
+		case ModServiceRemoveChatBlocklistEntryProcedure:
+			modServiceRemoveChatBlocklistEntryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -250,3 +593,59 @@ func (UnimplementedModServiceHandler) GetNotorietyReport(context.Context, *conne
 func (UnimplementedModServiceHandler) ResetNotoriety(context.Context, *connect.Request[mod_service.ResetNotorietyRequest]) (*connect.Response[mod_service.ResetNotorietyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.ResetNotoriety is not implemented"))
 }
+
+func (UnimplementedModServiceHandler) FileReport(context.Context, *connect.Request[mod_service.FileReportRequest]) (*connect.Response[mod_service.FileReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.FileReport is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) ListCases(context.Context, *connect.Request[mod_service.ListCasesRequest]) (*connect.Response[mod_service.ListCasesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.ListCases is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) GetCase(context.Context, *connect.Request[mod_service.GetCaseRequest]) (*connect.Response[mod_service.ModCase], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.GetCase is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) AssignCase(context.Context, *connect.Request[mod_service.AssignCaseRequest]) (*connect.Response[mod_service.AssignCaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.AssignCase is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) AddCaseEvidence(context.Context, *connect.Request[mod_service.AddCaseEvidenceRequest]) (*connect.Response[mod_service.AddCaseEvidenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.AddCaseEvidence is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) ResolveCase(context.Context, *connect.Request[mod_service.ResolveCaseRequest]) (*connect.Response[mod_service.ResolveCaseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.ResolveCase is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) SubmitAppeal(context.Context, *connect.Request[mod_service.SubmitAppealRequest]) (*connect.Response[mod_service.SubmitAppealResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.SubmitAppeal is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) DecideAppeal(context.Context, *connect.Request[mod_service.DecideAppealRequest]) (*connect.Response[mod_service.DecideAppealResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.DecideAppeal is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) GetCheatSignalReport(context.Context, *connect.Request[mod_service.GetCheatSignalReportRequest]) (*connect.Response[mod_service.CheatSignalReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.GetCheatSignalReport is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) GetChatPolicies(context.Context, *connect.Request[mod_service.GetChatPoliciesRequest]) (*connect.Response[mod_service.ChatPolicies], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.GetChatPolicies is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) SetChatPolicy(context.Context, *connect.Request[mod_service.ChatPolicy]) (*connect.Response[mod_service.SetChatPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.SetChatPolicy is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) GetChatBlocklist(context.Context, *connect.Request[mod_service.GetChatBlocklistRequest]) (*connect.Response[mod_service.ChatBlocklist], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.GetChatBlocklist is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) AddChatBlocklistEntry(context.Context, *connect.Request[mod_service.ChatBlocklistEntry]) (*connect.Response[mod_service.AddChatBlocklistEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.AddChatBlocklistEntry is not implemented"))
+}
+
+func (UnimplementedModServiceHandler) RemoveChatBlocklistEntry(context.Context, *connect.Request[mod_service.RemoveChatBlocklistEntryRequest]) (*connect.Response[mod_service.RemoveChatBlocklistEntryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mod_service.ModService.RemoveChatBlocklistEntry is not implemented"))
+}
@@ -131,9 +131,60 @@ func (PuzzleStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{1}
 }
 
+type PuzzleRushPeriod int32
+
+const (
+	PuzzleRushPeriod_DAILY    PuzzleRushPeriod = 0
+	PuzzleRushPeriod_WEEKLY   PuzzleRushPeriod = 1
+	PuzzleRushPeriod_ALL_TIME PuzzleRushPeriod = 2
+)
+
+// Enum value maps for PuzzleRushPeriod.
+var (
+	PuzzleRushPeriod_name = map[int32]string{
+		0: "DAILY",
+		1: "WEEKLY",
+		2: "ALL_TIME",
+	}
+	PuzzleRushPeriod_value = map[string]int32{
+		"DAILY":    0,
+		"WEEKLY":   1,
+		"ALL_TIME": 2,
+	}
+)
+
+func (x PuzzleRushPeriod) Enum() *PuzzleRushPeriod {
+	p := new(PuzzleRushPeriod)
+	*p = x
+	return p
+}
+
+func (x PuzzleRushPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PuzzleRushPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_puzzle_service_puzzle_service_proto_enumTypes[2].Descriptor()
+}
+
+func (PuzzleRushPeriod) Type() protoreflect.EnumType {
+	return &file_proto_puzzle_service_puzzle_service_proto_enumTypes[2]
+}
+
+func (x PuzzleRushPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PuzzleRushPeriod.Descriptor instead.
+func (PuzzleRushPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{2}
+}
+
 type StartPuzzleIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// Only puzzles that have all of these tags.
+	Tags          []macondo.PuzzleTag `protobuf:"varint,2,rep,packed,name=tags,proto3,enum=macondo.PuzzleTag" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -175,6 +226,13 @@ func (x *StartPuzzleIdRequest) GetLexicon() string {
 	return ""
 }
 
+func (x *StartPuzzleIdRequest) GetTags() []macondo.PuzzleTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type StartPuzzleIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PuzzleId      string                 `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
@@ -228,8 +286,10 @@ func (x *StartPuzzleIdResponse) GetQueryResult() PuzzleQueryResult {
 }
 
 type NextPuzzleIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// Only puzzles that have all of these tags.
+	Tags          []macondo.PuzzleTag `protobuf:"varint,2,rep,packed,name=tags,proto3,enum=macondo.PuzzleTag" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -271,6 +331,13 @@ func (x *NextPuzzleIdRequest) GetLexicon() string {
 	return ""
 }
 
+func (x *NextPuzzleIdRequest) GetTags() []macondo.PuzzleTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type NextPuzzleIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PuzzleId      string                 `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
@@ -324,8 +391,10 @@ func (x *NextPuzzleIdResponse) GetQueryResult() PuzzleQueryResult {
 }
 
 type NextClosestRatingPuzzleIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// Only puzzles that have all of these tags.
+	Tags          []macondo.PuzzleTag `protobuf:"varint,2,rep,packed,name=tags,proto3,enum=macondo.PuzzleTag" json:"tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -367,6 +436,13 @@ func (x *NextClosestRatingPuzzleIdRequest) GetLexicon() string {
 	return ""
 }
 
+func (x *NextClosestRatingPuzzleIdRequest) GetTags() []macondo.PuzzleTag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type NextClosestRatingPuzzleIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PuzzleId      string                 `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
@@ -944,8 +1020,15 @@ type PuzzleGenerationJobRequest struct {
 	EquityLossTotalLimit uint32 `protobuf:"varint,9,opt,name=equity_loss_total_limit,json=equityLossTotalLimit,proto3" json:"equity_loss_total_limit,omitempty"`
 	AvoidBotGames        bool   `protobuf:"varint,10,opt,name=avoid_bot_games,json=avoidBotGames,proto3" json:"avoid_bot_games,omitempty"`
 	DaysPerChunk         uint32 `protobuf:"varint,11,opt,name=days_per_chunk,json=daysPerChunk,proto3" json:"days_per_chunk,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// If set, puzzles are generated from these annotated games instead, and
+	// attributed to author_id.
+	AnnotatedGameIds []string `protobuf:"bytes,12,rep,name=annotated_game_ids,json=annotatedGameIds,proto3" json:"annotated_game_ids,omitempty"`
+	AuthorId         string   `protobuf:"bytes,13,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Set for jobs queued by users who can't create puzzles for the site.
+	// Their puzzles aren't served until the job is approved.
+	NeedsApproval bool `protobuf:"varint,14,opt,name=needs_approval,json=needsApproval,proto3" json:"needs_approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PuzzleGenerationJobRequest) Reset() {
@@ -1056,6 +1139,27 @@ func (x *PuzzleGenerationJobRequest) GetDaysPerChunk() uint32 {
 	return 0
 }
 
+func (x *PuzzleGenerationJobRequest) GetAnnotatedGameIds() []string {
+	if x != nil {
+		return x.AnnotatedGameIds
+	}
+	return nil
+}
+
+func (x *PuzzleGenerationJobRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PuzzleGenerationJobRequest) GetNeedsApproval() bool {
+	if x != nil {
+		return x.NeedsApproval
+	}
+	return false
+}
+
 type APIPuzzleGenerationJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Started       bool                   `protobuf:"varint,1,opt,name=started,proto3" json:"started,omitempty"`
@@ -1332,112 +1436,2934 @@ func (x *PuzzleJobLogsResponse) GetLogs() []*PuzzleJobLog {
 	return nil
 }
 
-var File_proto_puzzle_service_puzzle_service_proto protoreflect.FileDescriptor
+// The spaced-repetition schedule of a puzzle in a user's review queue.
+type PuzzleReview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PuzzleId       string                 `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	DueAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	IntervalDays   int32                  `protobuf:"varint,3,opt,name=interval_days,json=intervalDays,proto3" json:"interval_days,omitempty"`
+	Repetitions    int32                  `protobuf:"varint,4,opt,name=repetitions,proto3" json:"repetitions,omitempty"`
+	Lapses         int32                  `protobuf:"varint,5,opt,name=lapses,proto3" json:"lapses,omitempty"`
+	LastReviewedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_reviewed_at,json=lastReviewedAt,proto3" json:"last_reviewed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_proto_puzzle_service_puzzle_service_proto_rawDesc = "" +
-	"\n" +
-	")proto/puzzle_service/puzzle_service.proto\x12\x0epuzzle_service\x1a$proto/vendored/macondo/macondo.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18proto/ipc/omgwords.proto\"0\n" +
-	"\x14StartPuzzleIdRequest\x12\x18\n" +
-	"\alexicon\x18\x01 \x01(\tR\alexicon\"z\n" +
-	"\x15StartPuzzleIdResponse\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12D\n" +
-	"\fquery_result\x18\x02 \x01(\x0e2!.puzzle_service.PuzzleQueryResultR\vqueryResult\"/\n" +
-	"\x13NextPuzzleIdRequest\x12\x18\n" +
-	"\alexicon\x18\x01 \x01(\tR\alexicon\"y\n" +
-	"\x14NextPuzzleIdResponse\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12D\n" +
-	"\fquery_result\x18\x02 \x01(\x0e2!.puzzle_service.PuzzleQueryResultR\vqueryResult\"<\n" +
-	" NextClosestRatingPuzzleIdRequest\x12\x18\n" +
-	"\alexicon\x18\x01 \x01(\tR\alexicon\"\x86\x01\n" +
-	"!NextClosestRatingPuzzleIdResponse\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12D\n" +
-	"\fquery_result\x18\x02 \x01(\x0e2!.puzzle_service.PuzzleQueryResultR\vqueryResult\",\n" +
-	"\rPuzzleRequest\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"\xdc\x03\n" +
-	"\x0eAnswerResponse\x129\n" +
-	"\x0ecorrect_answer\x18\x01 \x01(\v2\x12.macondo.GameEventR\rcorrectAnswer\x124\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1c.puzzle_service.PuzzleStatusR\x06status\x12\x1a\n" +
-	"\battempts\x18\x03 \x01(\x05R\battempts\x12\x17\n" +
-	"\agame_id\x18\x04 \x01(\tR\x06gameId\x12\x1f\n" +
-	"\vturn_number\x18\x05 \x01(\x05R\n" +
-	"turnNumber\x12\x1d\n" +
-	"\n" +
-	"after_text\x18\x06 \x01(\tR\tafterText\x12&\n" +
-	"\x0fnew_user_rating\x18\a \x01(\x05R\rnewUserRating\x12*\n" +
-	"\x11new_puzzle_rating\x18\b \x01(\x05R\x0fnewPuzzleRating\x12H\n" +
-	"\x12first_attempt_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x10firstAttemptTime\x12F\n" +
-	"\x11last_attempt_time\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\x0flastAttemptTime\"\x99\x01\n" +
-	"\x0ePuzzleResponse\x12.\n" +
-	"\ahistory\x18\x01 \x01(\v2\x14.macondo.GameHistoryR\ahistory\x12\x1f\n" +
-	"\vbefore_text\x18\x02 \x01(\tR\n" +
-	"beforeText\x126\n" +
-	"\x06answer\x18\x03 \x01(\v2\x1e.puzzle_service.AnswerResponseR\x06answer\"\x87\x01\n" +
-	"\x11SubmissionRequest\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x120\n" +
-	"\x06answer\x18\x02 \x01(\v2\x18.ipc.ClientGameplayEventR\x06answer\x12#\n" +
-	"\rshow_solution\x18\x03 \x01(\bR\fshowSolution\"t\n" +
-	"\x12SubmissionResponse\x12&\n" +
-	"\x0fuser_is_correct\x18\x01 \x01(\bR\ruserIsCorrect\x126\n" +
-	"\x06answer\x18\x02 \x01(\v2\x1e.puzzle_service.AnswerResponseR\x06answer\"4\n" +
-	"\x15PreviousPuzzleRequest\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"5\n" +
-	"\x16PreviousPuzzleResponse\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"D\n" +
-	"\x11PuzzleVoteRequest\x12\x1b\n" +
-	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12\x12\n" +
-	"\x04vote\x18\x02 \x01(\x05R\x04vote\"\x14\n" +
-	"\x12PuzzleVoteResponse\"\xf2\x03\n" +
-	"\x1aPuzzleGenerationJobRequest\x12\x1c\n" +
-	"\n" +
-	"bot_vs_bot\x18\x01 \x01(\bR\bbotVsBot\x12\x18\n" +
-	"\alexicon\x18\x02 \x01(\tR\alexicon\x12/\n" +
-	"\x13letter_distribution\x18\x03 \x01(\tR\x12letterDistribution\x12!\n" +
-	"\n" +
-	"sql_offset\x18\x04 \x01(\x05B\x02\x18\x01R\tsqlOffset\x128\n" +
-	"\x18game_consideration_limit\x18\x05 \x01(\x05R\x16gameConsiderationLimit\x12.\n" +
-	"\x13game_creation_limit\x18\x06 \x01(\x05R\x11gameCreationLimit\x12:\n" +
-	"\arequest\x18\a \x01(\v2 .macondo.PuzzleGenerationRequestR\arequest\x12\x1d\n" +
-	"\n" +
-	"start_date\x18\b \x01(\tR\tstartDate\x125\n" +
-	"\x17equity_loss_total_limit\x18\t \x01(\rR\x14equityLossTotalLimit\x12&\n" +
-	"\x0favoid_bot_games\x18\n" +
-	" \x01(\bR\ravoidBotGames\x12$\n" +
-	"\x0edays_per_chunk\x18\v \x01(\rR\fdaysPerChunk\":\n" +
-	"\x1eAPIPuzzleGenerationJobResponse\x12\x18\n" +
-	"\astarted\x18\x01 \x01(\bR\astarted\"\x84\x01\n" +
-	"\x1dAPIPuzzleGenerationJobRequest\x12D\n" +
-	"\arequest\x18\x01 \x01(\v2*.puzzle_service.PuzzleGenerationJobRequestR\arequest\x12\x1d\n" +
-	"\n" +
-	"secret_key\x18\x02 \x01(\tR\tsecretKey\"D\n" +
-	"\x14PuzzleJobLogsRequest\x12\x16\n" +
-	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x9f\x02\n" +
-	"\fPuzzleJobLog\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12D\n" +
-	"\arequest\x18\x02 \x01(\v2*.puzzle_service.PuzzleGenerationJobRequestR\arequest\x12\x1c\n" +
-	"\tfulfilled\x18\x03 \x01(\bR\tfulfilled\x12!\n" +
-	"\ferror_status\x18\x04 \x01(\tR\verrorStatus\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
-	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"I\n" +
-	"\x15PuzzleJobLogsResponse\x120\n" +
-	"\x04logs\x18\x01 \x03(\v2\x1c.puzzle_service.PuzzleJobLogR\x04logs*b\n" +
-	"\x11PuzzleQueryResult\x12\n" +
-	"\n" +
-	"\x06UNSEEN\x10\x00\x12\v\n" +
-	"\aUNRATED\x10\x01\x12\x0e\n" +
-	"\n" +
-	"UNFINISHED\x10\x02\x12\r\n" +
-	"\tEXHAUSTED\x10\x03\x12\n" +
-	"\n" +
-	"\x06RANDOM\x10\x04\x12\t\n" +
-	"\x05START\x10\x05*:\n" +
-	"\fPuzzleStatus\x12\x0e\n" +
-	"\n" +
-	"UNANSWERED\x10\x00\x12\v\n" +
-	"\aCORRECT\x10\x01\x12\r\n" +
-	"\tINCORRECT\x10\x022\xdc\a\n" +
+func (x *PuzzleReview) Reset() {
+	*x = PuzzleReview{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleReview) ProtoMessage() {}
+
+func (x *PuzzleReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleReview.ProtoReflect.Descriptor instead.
+func (*PuzzleReview) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PuzzleReview) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *PuzzleReview) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *PuzzleReview) GetIntervalDays() int32 {
+	if x != nil {
+		return x.IntervalDays
+	}
+	return 0
+}
+
+func (x *PuzzleReview) GetRepetitions() int32 {
+	if x != nil {
+		return x.Repetitions
+	}
+	return 0
+}
+
+func (x *PuzzleReview) GetLapses() int32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *PuzzleReview) GetLastReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReviewedAt
+	}
+	return nil
+}
+
+type ReviewQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewQueueRequest) Reset() {
+	*x = ReviewQueueRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewQueueRequest) ProtoMessage() {}
+
+func (x *ReviewQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewQueueRequest.ProtoReflect.Descriptor instead.
+func (*ReviewQueueRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReviewQueueRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *ReviewQueueRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReviewQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*PuzzleReview        `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	DueCount      int32                  `protobuf:"varint,2,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewQueueResponse) Reset() {
+	*x = ReviewQueueResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewQueueResponse) ProtoMessage() {}
+
+func (x *ReviewQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewQueueResponse.ProtoReflect.Descriptor instead.
+func (*ReviewQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReviewQueueResponse) GetReviews() []*PuzzleReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ReviewQueueResponse) GetDueCount() int32 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *ReviewQueueResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type StudySessionRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// The maximum number of puzzles in the session.
+	Size          int32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudySessionRequest) Reset() {
+	*x = StudySessionRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudySessionRequest) ProtoMessage() {}
+
+func (x *StudySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudySessionRequest.ProtoReflect.Descriptor instead.
+func (*StudySessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StudySessionRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *StudySessionRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type StudySessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The due puzzles, most overdue first.
+	PuzzleIds []string `protobuf:"bytes,1,rep,name=puzzle_ids,json=puzzleIds,proto3" json:"puzzle_ids,omitempty"`
+	DueCount  int32    `protobuf:"varint,2,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`
+	// When the next puzzle is due, if none are due now.
+	NextDueAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_due_at,json=nextDueAt,proto3" json:"next_due_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudySessionResponse) Reset() {
+	*x = StudySessionResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudySessionResponse) ProtoMessage() {}
+
+func (x *StudySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudySessionResponse.ProtoReflect.Descriptor instead.
+func (*StudySessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StudySessionResponse) GetPuzzleIds() []string {
+	if x != nil {
+		return x.PuzzleIds
+	}
+	return nil
+}
+
+func (x *StudySessionResponse) GetDueCount() int32 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *StudySessionResponse) GetNextDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextDueAt
+	}
+	return nil
+}
+
+type ReviewSubmissionRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	PuzzleId      string                   `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	Answer        *ipc.ClientGameplayEvent `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	SecondsTaken  int32                    `protobuf:"varint,3,opt,name=seconds_taken,json=secondsTaken,proto3" json:"seconds_taken,omitempty"`
+	ShowSolution  bool                     `protobuf:"varint,4,opt,name=show_solution,json=showSolution,proto3" json:"show_solution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewSubmissionRequest) Reset() {
+	*x = ReviewSubmissionRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewSubmissionRequest) ProtoMessage() {}
+
+func (x *ReviewSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReviewSubmissionRequest) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *ReviewSubmissionRequest) GetAnswer() *ipc.ClientGameplayEvent {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+func (x *ReviewSubmissionRequest) GetSecondsTaken() int32 {
+	if x != nil {
+		return x.SecondsTaken
+	}
+	return 0
+}
+
+func (x *ReviewSubmissionRequest) GetShowSolution() bool {
+	if x != nil {
+		return x.ShowSolution
+	}
+	return false
+}
+
+type ReviewSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIsCorrect bool                   `protobuf:"varint,1,opt,name=user_is_correct,json=userIsCorrect,proto3" json:"user_is_correct,omitempty"`
+	CorrectAnswer *macondo.GameEvent     `protobuf:"bytes,2,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	Review        *PuzzleReview          `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewSubmissionResponse) Reset() {
+	*x = ReviewSubmissionResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewSubmissionResponse) ProtoMessage() {}
+
+func (x *ReviewSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReviewSubmissionResponse) GetUserIsCorrect() bool {
+	if x != nil {
+		return x.UserIsCorrect
+	}
+	return false
+}
+
+func (x *ReviewSubmissionResponse) GetCorrectAnswer() *macondo.GameEvent {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return nil
+}
+
+func (x *ReviewSubmissionResponse) GetReview() *PuzzleReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type RemoveFromReviewQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromReviewQueueResponse) Reset() {
+	*x = RemoveFromReviewQueueResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromReviewQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromReviewQueueResponse) ProtoMessage() {}
+
+func (x *RemoveFromReviewQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromReviewQueueResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromReviewQueueResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{28}
+}
+
+type PuzzleSetEntry struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PuzzleId string                 `protobuf:"bytes,1,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	// The requesting user's result on this puzzle.
+	Status        PuzzleStatus `protobuf:"varint,2,opt,name=status,proto3,enum=puzzle_service.PuzzleStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleSetEntry) Reset() {
+	*x = PuzzleSetEntry{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleSetEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleSetEntry) ProtoMessage() {}
+
+func (x *PuzzleSetEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleSetEntry.ProtoReflect.Descriptor instead.
+func (*PuzzleSetEntry) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PuzzleSetEntry) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *PuzzleSetEntry) GetStatus() PuzzleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PuzzleStatus_UNANSWERED
+}
+
+type PuzzleSet struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SetId       string                 `protobuf:"bytes,1,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Lexicon     string                 `protobuf:"bytes,4,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Creator     string                 `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	PuzzleCount int32                  `protobuf:"varint,6,opt,name=puzzle_count,json=puzzleCount,proto3" json:"puzzle_count,omitempty"`
+	// The puzzles in order. Only set when getting a single puzzle set.
+	Puzzles       []*PuzzleSetEntry      `protobuf:"bytes,7,rep,name=puzzles,proto3" json:"puzzles,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleSet) Reset() {
+	*x = PuzzleSet{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleSet) ProtoMessage() {}
+
+func (x *PuzzleSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleSet.ProtoReflect.Descriptor instead.
+func (*PuzzleSet) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PuzzleSet) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *PuzzleSet) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PuzzleSet) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PuzzleSet) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *PuzzleSet) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *PuzzleSet) GetPuzzleCount() int32 {
+	if x != nil {
+		return x.PuzzleCount
+	}
+	return 0
+}
+
+func (x *PuzzleSet) GetPuzzles() []*PuzzleSetEntry {
+	if x != nil {
+		return x.Puzzles
+	}
+	return nil
+}
+
+func (x *PuzzleSet) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreatePuzzleSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Lexicon       string                 `protobuf:"bytes,3,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	PuzzleIds     []string               `protobuf:"bytes,4,rep,name=puzzle_ids,json=puzzleIds,proto3" json:"puzzle_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePuzzleSetRequest) Reset() {
+	*x = CreatePuzzleSetRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePuzzleSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePuzzleSetRequest) ProtoMessage() {}
+
+func (x *CreatePuzzleSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePuzzleSetRequest.ProtoReflect.Descriptor instead.
+func (*CreatePuzzleSetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePuzzleSetRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreatePuzzleSetRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePuzzleSetRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *CreatePuzzleSetRequest) GetPuzzleIds() []string {
+	if x != nil {
+		return x.PuzzleIds
+	}
+	return nil
+}
+
+type UpdatePuzzleSetRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SetId       string                 `protobuf:"bytes,1,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Replaces the puzzles in the set, in this order.
+	PuzzleIds     []string `protobuf:"bytes,4,rep,name=puzzle_ids,json=puzzleIds,proto3" json:"puzzle_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePuzzleSetRequest) Reset() {
+	*x = UpdatePuzzleSetRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePuzzleSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePuzzleSetRequest) ProtoMessage() {}
+
+func (x *UpdatePuzzleSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePuzzleSetRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePuzzleSetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdatePuzzleSetRequest) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+func (x *UpdatePuzzleSetRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePuzzleSetRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePuzzleSetRequest) GetPuzzleIds() []string {
+	if x != nil {
+		return x.PuzzleIds
+	}
+	return nil
+}
+
+type PuzzleSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetId         string                 `protobuf:"bytes,1,opt,name=set_id,json=setId,proto3" json:"set_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleSetRequest) Reset() {
+	*x = PuzzleSetRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleSetRequest) ProtoMessage() {}
+
+func (x *PuzzleSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleSetRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleSetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PuzzleSetRequest) GetSetId() string {
+	if x != nil {
+		return x.SetId
+	}
+	return ""
+}
+
+type PuzzleSetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleSetsRequest) Reset() {
+	*x = PuzzleSetsRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleSetsRequest) ProtoMessage() {}
+
+func (x *PuzzleSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleSetsRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleSetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PuzzleSetsRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+type PuzzleSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PuzzleSet     *PuzzleSet             `protobuf:"bytes,1,opt,name=puzzle_set,json=puzzleSet,proto3" json:"puzzle_set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleSetResponse) Reset() {
+	*x = PuzzleSetResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleSetResponse) ProtoMessage() {}
+
+func (x *PuzzleSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleSetResponse.ProtoReflect.Descriptor instead.
+func (*PuzzleSetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PuzzleSetResponse) GetPuzzleSet() *PuzzleSet {
+	if x != nil {
+		return x.PuzzleSet
+	}
+	return nil
+}
+
+type PuzzleSetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PuzzleSets    []*PuzzleSet           `protobuf:"bytes,1,rep,name=puzzle_sets,json=puzzleSets,proto3" json:"puzzle_sets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleSetsResponse) Reset() {
+	*x = PuzzleSetsResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleSetsResponse) ProtoMessage() {}
+
+func (x *PuzzleSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleSetsResponse.ProtoReflect.Descriptor instead.
+func (*PuzzleSetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PuzzleSetsResponse) GetPuzzleSets() []*PuzzleSet {
+	if x != nil {
+		return x.PuzzleSets
+	}
+	return nil
+}
+
+type DeletePuzzleSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePuzzleSetResponse) Reset() {
+	*x = DeletePuzzleSetResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePuzzleSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePuzzleSetResponse) ProtoMessage() {}
+
+func (x *DeletePuzzleSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePuzzleSetResponse.ProtoReflect.Descriptor instead.
+func (*DeletePuzzleSetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{37}
+}
+
+type PuzzleTagRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleTagRatingsRequest) Reset() {
+	*x = PuzzleTagRatingsRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleTagRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleTagRatingsRequest) ProtoMessage() {}
+
+func (x *PuzzleTagRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleTagRatingsRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleTagRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PuzzleTagRatingsRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+type PuzzleTagRating struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Tag             macondo.PuzzleTag      `protobuf:"varint,1,opt,name=tag,proto3,enum=macondo.PuzzleTag" json:"tag,omitempty"`
+	Rating          int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	RatingDeviation int32                  `protobuf:"varint,3,opt,name=rating_deviation,json=ratingDeviation,proto3" json:"rating_deviation,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PuzzleTagRating) Reset() {
+	*x = PuzzleTagRating{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleTagRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleTagRating) ProtoMessage() {}
+
+func (x *PuzzleTagRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleTagRating.ProtoReflect.Descriptor instead.
+func (*PuzzleTagRating) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PuzzleTagRating) GetTag() macondo.PuzzleTag {
+	if x != nil {
+		return x.Tag
+	}
+	return macondo.PuzzleTag(0)
+}
+
+func (x *PuzzleTagRating) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *PuzzleTagRating) GetRatingDeviation() int32 {
+	if x != nil {
+		return x.RatingDeviation
+	}
+	return 0
+}
+
+type PuzzleTagRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ratings       []*PuzzleTagRating     `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleTagRatingsResponse) Reset() {
+	*x = PuzzleTagRatingsResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleTagRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleTagRatingsResponse) ProtoMessage() {}
+
+func (x *PuzzleTagRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleTagRatingsResponse.ProtoReflect.Descriptor instead.
+func (*PuzzleTagRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PuzzleTagRatingsResponse) GetRatings() []*PuzzleTagRating {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+type QueuePuzzleGenerationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exactly one of game_id or collection_uuid must be set. A collection
+	// queues all of its annotated games.
+	GameId         string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	CollectionUuid string `protobuf:"bytes,2,opt,name=collection_uuid,json=collectionUuid,proto3" json:"collection_uuid,omitempty"`
+	// Defaults to every puzzle found in the games.
+	Request       *macondo.PuzzleGenerationRequest `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuePuzzleGenerationRequest) Reset() {
+	*x = QueuePuzzleGenerationRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuePuzzleGenerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuePuzzleGenerationRequest) ProtoMessage() {}
+
+func (x *QueuePuzzleGenerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuePuzzleGenerationRequest.ProtoReflect.Descriptor instead.
+func (*QueuePuzzleGenerationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *QueuePuzzleGenerationRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *QueuePuzzleGenerationRequest) GetCollectionUuid() string {
+	if x != nil {
+		return x.CollectionUuid
+	}
+	return ""
+}
+
+func (x *QueuePuzzleGenerationRequest) GetRequest() *macondo.PuzzleGenerationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type QueuePuzzleGenerationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         int64                  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	NumGames      int32                  `protobuf:"varint,2,opt,name=num_games,json=numGames,proto3" json:"num_games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueuePuzzleGenerationResponse) Reset() {
+	*x = QueuePuzzleGenerationResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueuePuzzleGenerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuePuzzleGenerationResponse) ProtoMessage() {}
+
+func (x *QueuePuzzleGenerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueuePuzzleGenerationResponse.ProtoReflect.Descriptor instead.
+func (*QueuePuzzleGenerationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *QueuePuzzleGenerationResponse) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *QueuePuzzleGenerationResponse) GetNumGames() int32 {
+	if x != nil {
+		return x.NumGames
+	}
+	return 0
+}
+
+type ApprovePuzzleGenerationJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         int64                  `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePuzzleGenerationJobRequest) Reset() {
+	*x = ApprovePuzzleGenerationJobRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePuzzleGenerationJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePuzzleGenerationJobRequest) ProtoMessage() {}
+
+func (x *ApprovePuzzleGenerationJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePuzzleGenerationJobRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePuzzleGenerationJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ApprovePuzzleGenerationJobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type ApprovePuzzleGenerationJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NumPuzzles    int32                  `protobuf:"varint,1,opt,name=num_puzzles,json=numPuzzles,proto3" json:"num_puzzles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePuzzleGenerationJobResponse) Reset() {
+	*x = ApprovePuzzleGenerationJobResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePuzzleGenerationJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePuzzleGenerationJobResponse) ProtoMessage() {}
+
+func (x *ApprovePuzzleGenerationJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePuzzleGenerationJobResponse.ProtoReflect.Descriptor instead.
+func (*ApprovePuzzleGenerationJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ApprovePuzzleGenerationJobResponse) GetNumPuzzles() int32 {
+	if x != nil {
+		return x.NumPuzzles
+	}
+	return 0
+}
+
+type DailyPuzzleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleRequest) Reset() {
+	*x = DailyPuzzleRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleRequest) ProtoMessage() {}
+
+func (x *DailyPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DailyPuzzleRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+type DailyPuzzleWrongAnswer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The answer, e.g. "8H QI" or "-EIU" for an exchange.
+	Answer        string `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	Times         int32  `protobuf:"varint,2,opt,name=times,proto3" json:"times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleWrongAnswer) Reset() {
+	*x = DailyPuzzleWrongAnswer{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleWrongAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleWrongAnswer) ProtoMessage() {}
+
+func (x *DailyPuzzleWrongAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleWrongAnswer.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleWrongAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DailyPuzzleWrongAnswer) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *DailyPuzzleWrongAnswer) GetTimes() int32 {
+	if x != nil {
+		return x.Times
+	}
+	return 0
+}
+
+type DailyPuzzleStats struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Answers int32                  `protobuf:"varint,1,opt,name=answers,proto3" json:"answers,omitempty"`
+	Solves  int32                  `protobuf:"varint,2,opt,name=solves,proto3" json:"solves,omitempty"`
+	// The fraction of answers that were correct, from 0 to 1.
+	SolveRate           float64                   `protobuf:"fixed64,3,opt,name=solve_rate,json=solveRate,proto3" json:"solve_rate,omitempty"`
+	AverageSolveSeconds float64                   `protobuf:"fixed64,4,opt,name=average_solve_seconds,json=averageSolveSeconds,proto3" json:"average_solve_seconds,omitempty"`
+	CommonWrongAnswers  []*DailyPuzzleWrongAnswer `protobuf:"bytes,5,rep,name=common_wrong_answers,json=commonWrongAnswers,proto3" json:"common_wrong_answers,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleStats) Reset() {
+	*x = DailyPuzzleStats{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleStats) ProtoMessage() {}
+
+func (x *DailyPuzzleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleStats.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleStats) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DailyPuzzleStats) GetAnswers() int32 {
+	if x != nil {
+		return x.Answers
+	}
+	return 0
+}
+
+func (x *DailyPuzzleStats) GetSolves() int32 {
+	if x != nil {
+		return x.Solves
+	}
+	return 0
+}
+
+func (x *DailyPuzzleStats) GetSolveRate() float64 {
+	if x != nil {
+		return x.SolveRate
+	}
+	return 0
+}
+
+func (x *DailyPuzzleStats) GetAverageSolveSeconds() float64 {
+	if x != nil {
+		return x.AverageSolveSeconds
+	}
+	return 0
+}
+
+func (x *DailyPuzzleStats) GetCommonWrongAnswers() []*DailyPuzzleWrongAnswer {
+	if x != nil {
+		return x.CommonWrongAnswers
+	}
+	return nil
+}
+
+type DailyPuzzleStreak struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Consecutive days up to today (or yesterday, if today's puzzle is not
+	// solved yet) on which the daily puzzle was solved.
+	Current       int32 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Best          int32 `protobuf:"varint,2,opt,name=best,proto3" json:"best,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleStreak) Reset() {
+	*x = DailyPuzzleStreak{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleStreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleStreak) ProtoMessage() {}
+
+func (x *DailyPuzzleStreak) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleStreak.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleStreak) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DailyPuzzleStreak) GetCurrent() int32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *DailyPuzzleStreak) GetBest() int32 {
+	if x != nil {
+		return x.Best
+	}
+	return 0
+}
+
+type DailyPuzzleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UTC date of the puzzle, as YYYY-MM-DD.
+	Date       string               `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	PuzzleId   string               `protobuf:"bytes,2,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	History    *macondo.GameHistory `protobuf:"bytes,3,opt,name=history,proto3" json:"history,omitempty"`
+	BeforeText string               `protobuf:"bytes,4,opt,name=before_text,json=beforeText,proto3" json:"before_text,omitempty"`
+	// The user's result. The answer and stats are only sent once the user
+	// has answered.
+	Status        PuzzleStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=puzzle_service.PuzzleStatus" json:"status,omitempty"`
+	CorrectAnswer *macondo.GameEvent `protobuf:"bytes,6,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	Stats         *DailyPuzzleStats  `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	Streak        *DailyPuzzleStreak `protobuf:"bytes,8,opt,name=streak,proto3" json:"streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleResponse) Reset() {
+	*x = DailyPuzzleResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleResponse) ProtoMessage() {}
+
+func (x *DailyPuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleResponse.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DailyPuzzleResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyPuzzleResponse) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *DailyPuzzleResponse) GetHistory() *macondo.GameHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *DailyPuzzleResponse) GetBeforeText() string {
+	if x != nil {
+		return x.BeforeText
+	}
+	return ""
+}
+
+func (x *DailyPuzzleResponse) GetStatus() PuzzleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PuzzleStatus_UNANSWERED
+}
+
+func (x *DailyPuzzleResponse) GetCorrectAnswer() *macondo.GameEvent {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return nil
+}
+
+func (x *DailyPuzzleResponse) GetStats() *DailyPuzzleStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *DailyPuzzleResponse) GetStreak() *DailyPuzzleStreak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+type DailyPuzzleAnswerRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// A nil answer gives up on the puzzle.
+	Answer        *ipc.ClientGameplayEvent `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleAnswerRequest) Reset() {
+	*x = DailyPuzzleAnswerRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleAnswerRequest) ProtoMessage() {}
+
+func (x *DailyPuzzleAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleAnswerRequest.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DailyPuzzleAnswerRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *DailyPuzzleAnswerRequest) GetAnswer() *ipc.ClientGameplayEvent {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type DailyPuzzleAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIsCorrect bool                   `protobuf:"varint,1,opt,name=user_is_correct,json=userIsCorrect,proto3" json:"user_is_correct,omitempty"`
+	CorrectAnswer *macondo.GameEvent     `protobuf:"bytes,2,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	Stats         *DailyPuzzleStats      `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	Streak        *DailyPuzzleStreak     `protobuf:"bytes,4,opt,name=streak,proto3" json:"streak,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleAnswerResponse) Reset() {
+	*x = DailyPuzzleAnswerResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleAnswerResponse) ProtoMessage() {}
+
+func (x *DailyPuzzleAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleAnswerResponse.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DailyPuzzleAnswerResponse) GetUserIsCorrect() bool {
+	if x != nil {
+		return x.UserIsCorrect
+	}
+	return false
+}
+
+func (x *DailyPuzzleAnswerResponse) GetCorrectAnswer() *macondo.GameEvent {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return nil
+}
+
+func (x *DailyPuzzleAnswerResponse) GetStats() *DailyPuzzleStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *DailyPuzzleAnswerResponse) GetStreak() *DailyPuzzleStreak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+type DailyPuzzleArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleArchiveRequest) Reset() {
+	*x = DailyPuzzleArchiveRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleArchiveRequest) ProtoMessage() {}
+
+func (x *DailyPuzzleArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleArchiveRequest.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DailyPuzzleArchiveRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *DailyPuzzleArchiveRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *DailyPuzzleArchiveRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type DailyPuzzleArchiveEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	PuzzleId      string                 `protobuf:"bytes,2,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	Answers       int32                  `protobuf:"varint,3,opt,name=answers,proto3" json:"answers,omitempty"`
+	Solves        int32                  `protobuf:"varint,4,opt,name=solves,proto3" json:"solves,omitempty"`
+	Status        PuzzleStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=puzzle_service.PuzzleStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleArchiveEntry) Reset() {
+	*x = DailyPuzzleArchiveEntry{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleArchiveEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleArchiveEntry) ProtoMessage() {}
+
+func (x *DailyPuzzleArchiveEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleArchiveEntry.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleArchiveEntry) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DailyPuzzleArchiveEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyPuzzleArchiveEntry) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *DailyPuzzleArchiveEntry) GetAnswers() int32 {
+	if x != nil {
+		return x.Answers
+	}
+	return 0
+}
+
+func (x *DailyPuzzleArchiveEntry) GetSolves() int32 {
+	if x != nil {
+		return x.Solves
+	}
+	return 0
+}
+
+func (x *DailyPuzzleArchiveEntry) GetStatus() PuzzleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PuzzleStatus_UNANSWERED
+}
+
+type DailyPuzzleArchiveResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Puzzles       []*DailyPuzzleArchiveEntry `protobuf:"bytes,1,rep,name=puzzles,proto3" json:"puzzles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyPuzzleArchiveResponse) Reset() {
+	*x = DailyPuzzleArchiveResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyPuzzleArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyPuzzleArchiveResponse) ProtoMessage() {}
+
+func (x *DailyPuzzleArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyPuzzleArchiveResponse.ProtoReflect.Descriptor instead.
+func (*DailyPuzzleArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DailyPuzzleArchiveResponse) GetPuzzles() []*DailyPuzzleArchiveEntry {
+	if x != nil {
+		return x.Puzzles
+	}
+	return nil
+}
+
+type SetDailyPuzzleRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// A future UTC date, as YYYY-MM-DD.
+	Date          string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	PuzzleId      string `protobuf:"bytes,3,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDailyPuzzleRequest) Reset() {
+	*x = SetDailyPuzzleRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDailyPuzzleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDailyPuzzleRequest) ProtoMessage() {}
+
+func (x *SetDailyPuzzleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDailyPuzzleRequest.ProtoReflect.Descriptor instead.
+func (*SetDailyPuzzleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetDailyPuzzleRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *SetDailyPuzzleRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SetDailyPuzzleRequest) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+type SetDailyPuzzleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDailyPuzzleResponse) Reset() {
+	*x = SetDailyPuzzleResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDailyPuzzleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDailyPuzzleResponse) ProtoMessage() {}
+
+func (x *SetDailyPuzzleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDailyPuzzleResponse.ProtoReflect.Descriptor instead.
+func (*SetDailyPuzzleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{56}
+}
+
+type PuzzleRushState struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SessionId  string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Lexicon    string                 `protobuf:"bytes,2,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Minutes    int32                  `protobuf:"varint,3,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndsAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Score      int32                  `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"`
+	Strikes    int32                  `protobuf:"varint,7,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	MaxStrikes int32                  `protobuf:"varint,8,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	Finished   bool                   `protobuf:"varint,9,opt,name=finished,proto3" json:"finished,omitempty"`
+	// The puzzle to solve now. Empty once the session is finished.
+	PuzzleId      string               `protobuf:"bytes,10,opt,name=puzzle_id,json=puzzleId,proto3" json:"puzzle_id,omitempty"`
+	History       *macondo.GameHistory `protobuf:"bytes,11,opt,name=history,proto3" json:"history,omitempty"`
+	BeforeText    string               `protobuf:"bytes,12,opt,name=before_text,json=beforeText,proto3" json:"before_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushState) Reset() {
+	*x = PuzzleRushState{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushState) ProtoMessage() {}
+
+func (x *PuzzleRushState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushState.ProtoReflect.Descriptor instead.
+func (*PuzzleRushState) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PuzzleRushState) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PuzzleRushState) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *PuzzleRushState) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *PuzzleRushState) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *PuzzleRushState) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PuzzleRushState) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PuzzleRushState) GetStrikes() int32 {
+	if x != nil {
+		return x.Strikes
+	}
+	return 0
+}
+
+func (x *PuzzleRushState) GetMaxStrikes() int32 {
+	if x != nil {
+		return x.MaxStrikes
+	}
+	return 0
+}
+
+func (x *PuzzleRushState) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *PuzzleRushState) GetPuzzleId() string {
+	if x != nil {
+		return x.PuzzleId
+	}
+	return ""
+}
+
+func (x *PuzzleRushState) GetHistory() *macondo.GameHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *PuzzleRushState) GetBeforeText() string {
+	if x != nil {
+		return x.BeforeText
+	}
+	return ""
+}
+
+type StartPuzzleRushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Minutes       int32                  `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPuzzleRushRequest) Reset() {
+	*x = StartPuzzleRushRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPuzzleRushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPuzzleRushRequest) ProtoMessage() {}
+
+func (x *StartPuzzleRushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPuzzleRushRequest.ProtoReflect.Descriptor instead.
+func (*StartPuzzleRushRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *StartPuzzleRushRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *StartPuzzleRushRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type PuzzleRushRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushRequest) Reset() {
+	*x = PuzzleRushRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushRequest) ProtoMessage() {}
+
+func (x *PuzzleRushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleRushRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PuzzleRushRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type PuzzleRushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *PuzzleRushState       `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushResponse) Reset() {
+	*x = PuzzleRushResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushResponse) ProtoMessage() {}
+
+func (x *PuzzleRushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushResponse.ProtoReflect.Descriptor instead.
+func (*PuzzleRushResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PuzzleRushResponse) GetState() *PuzzleRushState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type PuzzleRushAnswerRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// A nil answer skips the puzzle, which counts as a strike.
+	Answer        *ipc.ClientGameplayEvent `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushAnswerRequest) Reset() {
+	*x = PuzzleRushAnswerRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushAnswerRequest) ProtoMessage() {}
+
+func (x *PuzzleRushAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushAnswerRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleRushAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PuzzleRushAnswerRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PuzzleRushAnswerRequest) GetAnswer() *ipc.ClientGameplayEvent {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type PuzzleRushAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIsCorrect bool                   `protobuf:"varint,1,opt,name=user_is_correct,json=userIsCorrect,proto3" json:"user_is_correct,omitempty"`
+	CorrectAnswer *macondo.GameEvent     `protobuf:"bytes,2,opt,name=correct_answer,json=correctAnswer,proto3" json:"correct_answer,omitempty"`
+	State         *PuzzleRushState       `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushAnswerResponse) Reset() {
+	*x = PuzzleRushAnswerResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushAnswerResponse) ProtoMessage() {}
+
+func (x *PuzzleRushAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushAnswerResponse.ProtoReflect.Descriptor instead.
+func (*PuzzleRushAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PuzzleRushAnswerResponse) GetUserIsCorrect() bool {
+	if x != nil {
+		return x.UserIsCorrect
+	}
+	return false
+}
+
+func (x *PuzzleRushAnswerResponse) GetCorrectAnswer() *macondo.GameEvent {
+	if x != nil {
+		return x.CorrectAnswer
+	}
+	return nil
+}
+
+func (x *PuzzleRushAnswerResponse) GetState() *PuzzleRushState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type PuzzleRushLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Minutes       int32                  `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	Period        PuzzleRushPeriod       `protobuf:"varint,3,opt,name=period,proto3,enum=puzzle_service.PuzzleRushPeriod" json:"period,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushLeaderboardRequest) Reset() {
+	*x = PuzzleRushLeaderboardRequest{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushLeaderboardRequest) ProtoMessage() {}
+
+func (x *PuzzleRushLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*PuzzleRushLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PuzzleRushLeaderboardRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *PuzzleRushLeaderboardRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *PuzzleRushLeaderboardRequest) GetPeriod() PuzzleRushPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return PuzzleRushPeriod_DAILY
+}
+
+func (x *PuzzleRushLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PuzzleRushLeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushLeaderboardEntry) Reset() {
+	*x = PuzzleRushLeaderboardEntry{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushLeaderboardEntry) ProtoMessage() {}
+
+func (x *PuzzleRushLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*PuzzleRushLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PuzzleRushLeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PuzzleRushLeaderboardEntry) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *PuzzleRushLeaderboardEntry) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PuzzleRushLeaderboardEntry) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+type PuzzleRushLeaderboardResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Entries       []*PuzzleRushLeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PuzzleRushLeaderboardResponse) Reset() {
+	*x = PuzzleRushLeaderboardResponse{}
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PuzzleRushLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PuzzleRushLeaderboardResponse) ProtoMessage() {}
+
+func (x *PuzzleRushLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_puzzle_service_puzzle_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PuzzleRushLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*PuzzleRushLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PuzzleRushLeaderboardResponse) GetEntries() []*PuzzleRushLeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_proto_puzzle_service_puzzle_service_proto protoreflect.FileDescriptor
+
+const file_proto_puzzle_service_puzzle_service_proto_rawDesc = "" +
+	"\n" +
+	")proto/puzzle_service/puzzle_service.proto\x12\x0epuzzle_service\x1a$proto/vendored/macondo/macondo.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18proto/ipc/omgwords.proto\"X\n" +
+	"\x14StartPuzzleIdRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12&\n" +
+	"\x04tags\x18\x02 \x03(\x0e2\x12.macondo.PuzzleTagR\x04tags\"z\n" +
+	"\x15StartPuzzleIdResponse\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12D\n" +
+	"\fquery_result\x18\x02 \x01(\x0e2!.puzzle_service.PuzzleQueryResultR\vqueryResult\"W\n" +
+	"\x13NextPuzzleIdRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12&\n" +
+	"\x04tags\x18\x02 \x03(\x0e2\x12.macondo.PuzzleTagR\x04tags\"y\n" +
+	"\x14NextPuzzleIdResponse\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12D\n" +
+	"\fquery_result\x18\x02 \x01(\x0e2!.puzzle_service.PuzzleQueryResultR\vqueryResult\"d\n" +
+	" NextClosestRatingPuzzleIdRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12&\n" +
+	"\x04tags\x18\x02 \x03(\x0e2\x12.macondo.PuzzleTagR\x04tags\"\x86\x01\n" +
+	"!NextClosestRatingPuzzleIdResponse\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12D\n" +
+	"\fquery_result\x18\x02 \x01(\x0e2!.puzzle_service.PuzzleQueryResultR\vqueryResult\",\n" +
+	"\rPuzzleRequest\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"\xdc\x03\n" +
+	"\x0eAnswerResponse\x129\n" +
+	"\x0ecorrect_answer\x18\x01 \x01(\v2\x12.macondo.GameEventR\rcorrectAnswer\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.puzzle_service.PuzzleStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\x05R\battempts\x12\x17\n" +
+	"\agame_id\x18\x04 \x01(\tR\x06gameId\x12\x1f\n" +
+	"\vturn_number\x18\x05 \x01(\x05R\n" +
+	"turnNumber\x12\x1d\n" +
+	"\n" +
+	"after_text\x18\x06 \x01(\tR\tafterText\x12&\n" +
+	"\x0fnew_user_rating\x18\a \x01(\x05R\rnewUserRating\x12*\n" +
+	"\x11new_puzzle_rating\x18\b \x01(\x05R\x0fnewPuzzleRating\x12H\n" +
+	"\x12first_attempt_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x10firstAttemptTime\x12F\n" +
+	"\x11last_attempt_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0flastAttemptTime\"\x99\x01\n" +
+	"\x0ePuzzleResponse\x12.\n" +
+	"\ahistory\x18\x01 \x01(\v2\x14.macondo.GameHistoryR\ahistory\x12\x1f\n" +
+	"\vbefore_text\x18\x02 \x01(\tR\n" +
+	"beforeText\x126\n" +
+	"\x06answer\x18\x03 \x01(\v2\x1e.puzzle_service.AnswerResponseR\x06answer\"\x87\x01\n" +
+	"\x11SubmissionRequest\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x120\n" +
+	"\x06answer\x18\x02 \x01(\v2\x18.ipc.ClientGameplayEventR\x06answer\x12#\n" +
+	"\rshow_solution\x18\x03 \x01(\bR\fshowSolution\"t\n" +
+	"\x12SubmissionResponse\x12&\n" +
+	"\x0fuser_is_correct\x18\x01 \x01(\bR\ruserIsCorrect\x126\n" +
+	"\x06answer\x18\x02 \x01(\v2\x1e.puzzle_service.AnswerResponseR\x06answer\"4\n" +
+	"\x15PreviousPuzzleRequest\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"5\n" +
+	"\x16PreviousPuzzleResponse\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\"D\n" +
+	"\x11PuzzleVoteRequest\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x12\x12\n" +
+	"\x04vote\x18\x02 \x01(\x05R\x04vote\"\x14\n" +
+	"\x12PuzzleVoteResponse\"\xe4\x04\n" +
+	"\x1aPuzzleGenerationJobRequest\x12\x1c\n" +
+	"\n" +
+	"bot_vs_bot\x18\x01 \x01(\bR\bbotVsBot\x12\x18\n" +
+	"\alexicon\x18\x02 \x01(\tR\alexicon\x12/\n" +
+	"\x13letter_distribution\x18\x03 \x01(\tR\x12letterDistribution\x12!\n" +
+	"\n" +
+	"sql_offset\x18\x04 \x01(\x05B\x02\x18\x01R\tsqlOffset\x128\n" +
+	"\x18game_consideration_limit\x18\x05 \x01(\x05R\x16gameConsiderationLimit\x12.\n" +
+	"\x13game_creation_limit\x18\x06 \x01(\x05R\x11gameCreationLimit\x12:\n" +
+	"\arequest\x18\a \x01(\v2 .macondo.PuzzleGenerationRequestR\arequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\b \x01(\tR\tstartDate\x125\n" +
+	"\x17equity_loss_total_limit\x18\t \x01(\rR\x14equityLossTotalLimit\x12&\n" +
+	"\x0favoid_bot_games\x18\n" +
+	" \x01(\bR\ravoidBotGames\x12$\n" +
+	"\x0edays_per_chunk\x18\v \x01(\rR\fdaysPerChunk\x12,\n" +
+	"\x12annotated_game_ids\x18\f \x03(\tR\x10annotatedGameIds\x12\x1b\n" +
+	"\tauthor_id\x18\r \x01(\tR\bauthorId\x12%\n" +
+	"\x0eneeds_approval\x18\x0e \x01(\bR\rneedsApproval\":\n" +
+	"\x1eAPIPuzzleGenerationJobResponse\x12\x18\n" +
+	"\astarted\x18\x01 \x01(\bR\astarted\"\x84\x01\n" +
+	"\x1dAPIPuzzleGenerationJobRequest\x12D\n" +
+	"\arequest\x18\x01 \x01(\v2*.puzzle_service.PuzzleGenerationJobRequestR\arequest\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\"D\n" +
+	"\x14PuzzleJobLogsRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x9f\x02\n" +
+	"\fPuzzleJobLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12D\n" +
+	"\arequest\x18\x02 \x01(\v2*.puzzle_service.PuzzleGenerationJobRequestR\arequest\x12\x1c\n" +
+	"\tfulfilled\x18\x03 \x01(\bR\tfulfilled\x12!\n" +
+	"\ferror_status\x18\x04 \x01(\tR\verrorStatus\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"I\n" +
+	"\x15PuzzleJobLogsResponse\x120\n" +
+	"\x04logs\x18\x01 \x03(\v2\x1c.puzzle_service.PuzzleJobLogR\x04logs\"\x83\x02\n" +
+	"\fPuzzleReview\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x121\n" +
+	"\x06due_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12#\n" +
+	"\rinterval_days\x18\x03 \x01(\x05R\fintervalDays\x12 \n" +
+	"\vrepetitions\x18\x04 \x01(\x05R\vrepetitions\x12\x16\n" +
+	"\x06lapses\x18\x05 \x01(\x05R\x06lapses\x12D\n" +
+	"\x10last_reviewed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastReviewedAt\"D\n" +
+	"\x12ReviewQueueRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x8b\x01\n" +
+	"\x13ReviewQueueResponse\x126\n" +
+	"\areviews\x18\x01 \x03(\v2\x1c.puzzle_service.PuzzleReviewR\areviews\x12\x1b\n" +
+	"\tdue_count\x18\x02 \x01(\x05R\bdueCount\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"C\n" +
+	"\x13StudySessionRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"\x8e\x01\n" +
+	"\x14StudySessionResponse\x12\x1d\n" +
+	"\n" +
+	"puzzle_ids\x18\x01 \x03(\tR\tpuzzleIds\x12\x1b\n" +
+	"\tdue_count\x18\x02 \x01(\x05R\bdueCount\x12:\n" +
+	"\vnext_due_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tnextDueAt\"\xb2\x01\n" +
+	"\x17ReviewSubmissionRequest\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x120\n" +
+	"\x06answer\x18\x02 \x01(\v2\x18.ipc.ClientGameplayEventR\x06answer\x12#\n" +
+	"\rseconds_taken\x18\x03 \x01(\x05R\fsecondsTaken\x12#\n" +
+	"\rshow_solution\x18\x04 \x01(\bR\fshowSolution\"\xb3\x01\n" +
+	"\x18ReviewSubmissionResponse\x12&\n" +
+	"\x0fuser_is_correct\x18\x01 \x01(\bR\ruserIsCorrect\x129\n" +
+	"\x0ecorrect_answer\x18\x02 \x01(\v2\x12.macondo.GameEventR\rcorrectAnswer\x124\n" +
+	"\x06review\x18\x03 \x01(\v2\x1c.puzzle_service.PuzzleReviewR\x06review\"\x1f\n" +
+	"\x1dRemoveFromReviewQueueResponse\"c\n" +
+	"\x0ePuzzleSetEntry\x12\x1b\n" +
+	"\tpuzzle_id\x18\x01 \x01(\tR\bpuzzleId\x124\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.puzzle_service.PuzzleStatusR\x06status\"\xa6\x02\n" +
+	"\tPuzzleSet\x12\x15\n" +
+	"\x06set_id\x18\x01 \x01(\tR\x05setId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\alexicon\x18\x04 \x01(\tR\alexicon\x12\x18\n" +
+	"\acreator\x18\x05 \x01(\tR\acreator\x12!\n" +
+	"\fpuzzle_count\x18\x06 \x01(\x05R\vpuzzleCount\x128\n" +
+	"\apuzzles\x18\a \x03(\v2\x1e.puzzle_service.PuzzleSetEntryR\apuzzles\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x89\x01\n" +
+	"\x16CreatePuzzleSetRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\alexicon\x18\x03 \x01(\tR\alexicon\x12\x1d\n" +
+	"\n" +
+	"puzzle_ids\x18\x04 \x03(\tR\tpuzzleIds\"\x86\x01\n" +
+	"\x16UpdatePuzzleSetRequest\x12\x15\n" +
+	"\x06set_id\x18\x01 \x01(\tR\x05setId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"puzzle_ids\x18\x04 \x03(\tR\tpuzzleIds\")\n" +
+	"\x10PuzzleSetRequest\x12\x15\n" +
+	"\x06set_id\x18\x01 \x01(\tR\x05setId\"-\n" +
+	"\x11PuzzleSetsRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\"M\n" +
+	"\x11PuzzleSetResponse\x128\n" +
+	"\n" +
+	"puzzle_set\x18\x01 \x01(\v2\x19.puzzle_service.PuzzleSetR\tpuzzleSet\"P\n" +
+	"\x12PuzzleSetsResponse\x12:\n" +
+	"\vpuzzle_sets\x18\x01 \x03(\v2\x19.puzzle_service.PuzzleSetR\n" +
+	"puzzleSets\"\x19\n" +
+	"\x17DeletePuzzleSetResponse\"3\n" +
+	"\x17PuzzleTagRatingsRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\"z\n" +
+	"\x0fPuzzleTagRating\x12$\n" +
+	"\x03tag\x18\x01 \x01(\x0e2\x12.macondo.PuzzleTagR\x03tag\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12)\n" +
+	"\x10rating_deviation\x18\x03 \x01(\x05R\x0fratingDeviation\"U\n" +
+	"\x18PuzzleTagRatingsResponse\x129\n" +
+	"\aratings\x18\x01 \x03(\v2\x1f.puzzle_service.PuzzleTagRatingR\aratings\"\x9c\x01\n" +
+	"\x1cQueuePuzzleGenerationRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12'\n" +
+	"\x0fcollection_uuid\x18\x02 \x01(\tR\x0ecollectionUuid\x12:\n" +
+	"\arequest\x18\x03 \x01(\v2 .macondo.PuzzleGenerationRequestR\arequest\"S\n" +
+	"\x1dQueuePuzzleGenerationResponse\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x03R\x05jobId\x12\x1b\n" +
+	"\tnum_games\x18\x02 \x01(\x05R\bnumGames\":\n" +
+	"!ApprovePuzzleGenerationJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\x03R\x05jobId\"E\n" +
+	"\"ApprovePuzzleGenerationJobResponse\x12\x1f\n" +
+	"\vnum_puzzles\x18\x01 \x01(\x05R\n" +
+	"numPuzzles\".\n" +
+	"\x12DailyPuzzleRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\"F\n" +
+	"\x16DailyPuzzleWrongAnswer\x12\x16\n" +
+	"\x06answer\x18\x01 \x01(\tR\x06answer\x12\x14\n" +
+	"\x05times\x18\x02 \x01(\x05R\x05times\"\xf1\x01\n" +
+	"\x10DailyPuzzleStats\x12\x18\n" +
+	"\aanswers\x18\x01 \x01(\x05R\aanswers\x12\x16\n" +
+	"\x06solves\x18\x02 \x01(\x05R\x06solves\x12\x1d\n" +
+	"\n" +
+	"solve_rate\x18\x03 \x01(\x01R\tsolveRate\x122\n" +
+	"\x15average_solve_seconds\x18\x04 \x01(\x01R\x13averageSolveSeconds\x12X\n" +
+	"\x14common_wrong_answers\x18\x05 \x03(\v2&.puzzle_service.DailyPuzzleWrongAnswerR\x12commonWrongAnswers\"A\n" +
+	"\x11DailyPuzzleStreak\x12\x18\n" +
+	"\acurrent\x18\x01 \x01(\x05R\acurrent\x12\x12\n" +
+	"\x04best\x18\x02 \x01(\x05R\x04best\"\xfb\x02\n" +
+	"\x13DailyPuzzleResponse\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
+	"\tpuzzle_id\x18\x02 \x01(\tR\bpuzzleId\x12.\n" +
+	"\ahistory\x18\x03 \x01(\v2\x14.macondo.GameHistoryR\ahistory\x12\x1f\n" +
+	"\vbefore_text\x18\x04 \x01(\tR\n" +
+	"beforeText\x124\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1c.puzzle_service.PuzzleStatusR\x06status\x129\n" +
+	"\x0ecorrect_answer\x18\x06 \x01(\v2\x12.macondo.GameEventR\rcorrectAnswer\x126\n" +
+	"\x05stats\x18\a \x01(\v2 .puzzle_service.DailyPuzzleStatsR\x05stats\x129\n" +
+	"\x06streak\x18\b \x01(\v2!.puzzle_service.DailyPuzzleStreakR\x06streak\"f\n" +
+	"\x18DailyPuzzleAnswerRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x120\n" +
+	"\x06answer\x18\x02 \x01(\v2\x18.ipc.ClientGameplayEventR\x06answer\"\xf1\x01\n" +
+	"\x19DailyPuzzleAnswerResponse\x12&\n" +
+	"\x0fuser_is_correct\x18\x01 \x01(\bR\ruserIsCorrect\x129\n" +
+	"\x0ecorrect_answer\x18\x02 \x01(\v2\x12.macondo.GameEventR\rcorrectAnswer\x126\n" +
+	"\x05stats\x18\x03 \x01(\v2 .puzzle_service.DailyPuzzleStatsR\x05stats\x129\n" +
+	"\x06streak\x18\x04 \x01(\v2!.puzzle_service.DailyPuzzleStreakR\x06streak\"c\n" +
+	"\x19DailyPuzzleArchiveRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\xb2\x01\n" +
+	"\x17DailyPuzzleArchiveEntry\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1b\n" +
+	"\tpuzzle_id\x18\x02 \x01(\tR\bpuzzleId\x12\x18\n" +
+	"\aanswers\x18\x03 \x01(\x05R\aanswers\x12\x16\n" +
+	"\x06solves\x18\x04 \x01(\x05R\x06solves\x124\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1c.puzzle_service.PuzzleStatusR\x06status\"_\n" +
+	"\x1aDailyPuzzleArchiveResponse\x12A\n" +
+	"\apuzzles\x18\x01 \x03(\v2'.puzzle_service.DailyPuzzleArchiveEntryR\apuzzles\"b\n" +
+	"\x15SetDailyPuzzleRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\tpuzzle_id\x18\x03 \x01(\tR\bpuzzleId\"\x18\n" +
+	"\x16SetDailyPuzzleResponse\"\xaf\x03\n" +
+	"\x0fPuzzleRushState\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x18\n" +
+	"\alexicon\x18\x02 \x01(\tR\alexicon\x12\x18\n" +
+	"\aminutes\x18\x03 \x01(\x05R\aminutes\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x05R\x05score\x12\x18\n" +
+	"\astrikes\x18\a \x01(\x05R\astrikes\x12\x1f\n" +
+	"\vmax_strikes\x18\b \x01(\x05R\n" +
+	"maxStrikes\x12\x1a\n" +
+	"\bfinished\x18\t \x01(\bR\bfinished\x12\x1b\n" +
+	"\tpuzzle_id\x18\n" +
+	" \x01(\tR\bpuzzleId\x12.\n" +
+	"\ahistory\x18\v \x01(\v2\x14.macondo.GameHistoryR\ahistory\x12\x1f\n" +
+	"\vbefore_text\x18\f \x01(\tR\n" +
+	"beforeText\"L\n" +
+	"\x16StartPuzzleRushRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x18\n" +
+	"\aminutes\x18\x02 \x01(\x05R\aminutes\"2\n" +
+	"\x11PuzzleRushRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"K\n" +
+	"\x12PuzzleRushResponse\x125\n" +
+	"\x05state\x18\x01 \x01(\v2\x1f.puzzle_service.PuzzleRushStateR\x05state\"j\n" +
+	"\x17PuzzleRushAnswerRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x120\n" +
+	"\x06answer\x18\x02 \x01(\v2\x18.ipc.ClientGameplayEventR\x06answer\"\xb4\x01\n" +
+	"\x18PuzzleRushAnswerResponse\x12&\n" +
+	"\x0fuser_is_correct\x18\x01 \x01(\bR\ruserIsCorrect\x129\n" +
+	"\x0ecorrect_answer\x18\x02 \x01(\v2\x12.macondo.GameEventR\rcorrectAnswer\x125\n" +
+	"\x05state\x18\x03 \x01(\v2\x1f.puzzle_service.PuzzleRushStateR\x05state\"\xa2\x01\n" +
+	"\x1cPuzzleRushLeaderboardRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x18\n" +
+	"\aminutes\x18\x02 \x01(\x05R\aminutes\x128\n" +
+	"\x06period\x18\x03 \x01(\x0e2 .puzzle_service.PuzzleRushPeriodR\x06period\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x9d\x01\n" +
+	"\x1aPuzzleRushLeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\"e\n" +
+	"\x1dPuzzleRushLeaderboardResponse\x12D\n" +
+	"\aentries\x18\x01 \x03(\v2*.puzzle_service.PuzzleRushLeaderboardEntryR\aentries*b\n" +
+	"\x11PuzzleQueryResult\x12\n" +
+	"\n" +
+	"\x06UNSEEN\x10\x00\x12\v\n" +
+	"\aUNRATED\x10\x01\x12\x0e\n" +
+	"\n" +
+	"UNFINISHED\x10\x02\x12\r\n" +
+	"\tEXHAUSTED\x10\x03\x12\n" +
+	"\n" +
+	"\x06RANDOM\x10\x04\x12\t\n" +
+	"\x05START\x10\x05*:\n" +
+	"\fPuzzleStatus\x12\x0e\n" +
+	"\n" +
+	"UNANSWERED\x10\x00\x12\v\n" +
+	"\aCORRECT\x10\x01\x12\r\n" +
+	"\tINCORRECT\x10\x02*7\n" +
+	"\x10PuzzleRushPeriod\x12\t\n" +
+	"\x05DAILY\x10\x00\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x01\x12\f\n" +
+	"\bALL_TIME\x10\x022\x9f\x18\n" +
 	"\rPuzzleService\x12_\n" +
 	"\x10GetStartPuzzleId\x12$.puzzle_service.StartPuzzleIdRequest\x1a%.puzzle_service.StartPuzzleIdResponse\x12\\\n" +
 	"\x0fGetNextPuzzleId\x12#.puzzle_service.NextPuzzleIdRequest\x1a$.puzzle_service.NextPuzzleIdResponse\x12\x83\x01\n" +
@@ -1448,7 +4374,28 @@ const file_proto_puzzle_service_puzzle_service_proto_rawDesc = "" +
 	"\x13GetPreviousPuzzleId\x12%.puzzle_service.PreviousPuzzleRequest\x1a&.puzzle_service.PreviousPuzzleResponse\x12V\n" +
 	"\rSetPuzzleVote\x12!.puzzle_service.PuzzleVoteRequest\x1a\".puzzle_service.PuzzleVoteResponse\x12r\n" +
 	"\x11StartPuzzleGenJob\x12-.puzzle_service.APIPuzzleGenerationJobRequest\x1a..puzzle_service.APIPuzzleGenerationJobResponse\x12_\n" +
-	"\x10GetPuzzleJobLogs\x12$.puzzle_service.PuzzleJobLogsRequest\x1a%.puzzle_service.PuzzleJobLogsResponseB\xb8\x01\n" +
+	"\x10GetPuzzleJobLogs\x12$.puzzle_service.PuzzleJobLogsRequest\x1a%.puzzle_service.PuzzleJobLogsResponse\x12t\n" +
+	"\x15QueuePuzzleGeneration\x12,.puzzle_service.QueuePuzzleGenerationRequest\x1a-.puzzle_service.QueuePuzzleGenerationResponse\x12\x83\x01\n" +
+	"\x1aApprovePuzzleGenerationJob\x121.puzzle_service.ApprovePuzzleGenerationJobRequest\x1a2.puzzle_service.ApprovePuzzleGenerationJobResponse\x12Y\n" +
+	"\x0eGetReviewQueue\x12\".puzzle_service.ReviewQueueRequest\x1a#.puzzle_service.ReviewQueueResponse\x12^\n" +
+	"\x11StartStudySession\x12#.puzzle_service.StudySessionRequest\x1a$.puzzle_service.StudySessionResponse\x12a\n" +
+	"\fSubmitReview\x12'.puzzle_service.ReviewSubmissionRequest\x1a(.puzzle_service.ReviewSubmissionResponse\x12e\n" +
+	"\x15RemoveFromReviewQueue\x12\x1d.puzzle_service.PuzzleRequest\x1a-.puzzle_service.RemoveFromReviewQueueResponse\x12\\\n" +
+	"\x0fCreatePuzzleSet\x12&.puzzle_service.CreatePuzzleSetRequest\x1a!.puzzle_service.PuzzleSetResponse\x12\\\n" +
+	"\x0fUpdatePuzzleSet\x12&.puzzle_service.UpdatePuzzleSetRequest\x1a!.puzzle_service.PuzzleSetResponse\x12\\\n" +
+	"\x0fDeletePuzzleSet\x12 .puzzle_service.PuzzleSetRequest\x1a'.puzzle_service.DeletePuzzleSetResponse\x12V\n" +
+	"\rGetPuzzleSets\x12!.puzzle_service.PuzzleSetsRequest\x1a\".puzzle_service.PuzzleSetsResponse\x12S\n" +
+	"\fGetPuzzleSet\x12 .puzzle_service.PuzzleSetRequest\x1a!.puzzle_service.PuzzleSetResponse\x12h\n" +
+	"\x13GetPuzzleTagRatings\x12'.puzzle_service.PuzzleTagRatingsRequest\x1a(.puzzle_service.PuzzleTagRatingsResponse\x12Y\n" +
+	"\x0eGetDailyPuzzle\x12\".puzzle_service.DailyPuzzleRequest\x1a#.puzzle_service.DailyPuzzleResponse\x12n\n" +
+	"\x17SubmitDailyPuzzleAnswer\x12(.puzzle_service.DailyPuzzleAnswerRequest\x1a).puzzle_service.DailyPuzzleAnswerResponse\x12n\n" +
+	"\x15GetDailyPuzzleArchive\x12).puzzle_service.DailyPuzzleArchiveRequest\x1a*.puzzle_service.DailyPuzzleArchiveResponse\x12_\n" +
+	"\x0eSetDailyPuzzle\x12%.puzzle_service.SetDailyPuzzleRequest\x1a&.puzzle_service.SetDailyPuzzleResponse\x12]\n" +
+	"\x0fStartPuzzleRush\x12&.puzzle_service.StartPuzzleRushRequest\x1a\".puzzle_service.PuzzleRushResponse\x12V\n" +
+	"\rGetPuzzleRush\x12!.puzzle_service.PuzzleRushRequest\x1a\".puzzle_service.PuzzleRushResponse\x12k\n" +
+	"\x16SubmitPuzzleRushAnswer\x12'.puzzle_service.PuzzleRushAnswerRequest\x1a(.puzzle_service.PuzzleRushAnswerResponse\x12V\n" +
+	"\rEndPuzzleRush\x12!.puzzle_service.PuzzleRushRequest\x1a\".puzzle_service.PuzzleRushResponse\x12w\n" +
+	"\x18GetPuzzleRushLeaderboard\x12,.puzzle_service.PuzzleRushLeaderboardRequest\x1a-.puzzle_service.PuzzleRushLeaderboardResponseB\xb8\x01\n" +
 	"\x12com.puzzle_serviceB\x12PuzzleServiceProtoP\x01Z:github.com/woogles-io/liwords/rpc/api/proto/puzzle_service\xa2\x02\x03PXX\xaa\x02\rPuzzleService\xca\x02\rPuzzleService\xe2\x02\x19PuzzleService\\GPBMetadata\xea\x02\rPuzzleServiceb\x06proto3"
 
 var (
@@ -1463,81 +4410,210 @@ func file_proto_puzzle_service_puzzle_service_proto_rawDescGZIP() []byte {
 	return file_proto_puzzle_service_puzzle_service_proto_rawDescData
 }
 
-var file_proto_puzzle_service_puzzle_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_puzzle_service_puzzle_service_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_puzzle_service_puzzle_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_puzzle_service_puzzle_service_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_proto_puzzle_service_puzzle_service_proto_goTypes = []any{
-	(PuzzleQueryResult)(0),                    // 0: puzzle_service.PuzzleQueryResult
-	(PuzzleStatus)(0),                         // 1: puzzle_service.PuzzleStatus
-	(*StartPuzzleIdRequest)(nil),              // 2: puzzle_service.StartPuzzleIdRequest
-	(*StartPuzzleIdResponse)(nil),             // 3: puzzle_service.StartPuzzleIdResponse
-	(*NextPuzzleIdRequest)(nil),               // 4: puzzle_service.NextPuzzleIdRequest
-	(*NextPuzzleIdResponse)(nil),              // 5: puzzle_service.NextPuzzleIdResponse
-	(*NextClosestRatingPuzzleIdRequest)(nil),  // 6: puzzle_service.NextClosestRatingPuzzleIdRequest
-	(*NextClosestRatingPuzzleIdResponse)(nil), // 7: puzzle_service.NextClosestRatingPuzzleIdResponse
-	(*PuzzleRequest)(nil),                     // 8: puzzle_service.PuzzleRequest
-	(*AnswerResponse)(nil),                    // 9: puzzle_service.AnswerResponse
-	(*PuzzleResponse)(nil),                    // 10: puzzle_service.PuzzleResponse
-	(*SubmissionRequest)(nil),                 // 11: puzzle_service.SubmissionRequest
-	(*SubmissionResponse)(nil),                // 12: puzzle_service.SubmissionResponse
-	(*PreviousPuzzleRequest)(nil),             // 13: puzzle_service.PreviousPuzzleRequest
-	(*PreviousPuzzleResponse)(nil),            // 14: puzzle_service.PreviousPuzzleResponse
-	(*PuzzleVoteRequest)(nil),                 // 15: puzzle_service.PuzzleVoteRequest
-	(*PuzzleVoteResponse)(nil),                // 16: puzzle_service.PuzzleVoteResponse
-	(*PuzzleGenerationJobRequest)(nil),        // 17: puzzle_service.PuzzleGenerationJobRequest
-	(*APIPuzzleGenerationJobResponse)(nil),    // 18: puzzle_service.APIPuzzleGenerationJobResponse
-	(*APIPuzzleGenerationJobRequest)(nil),     // 19: puzzle_service.APIPuzzleGenerationJobRequest
-	(*PuzzleJobLogsRequest)(nil),              // 20: puzzle_service.PuzzleJobLogsRequest
-	(*PuzzleJobLog)(nil),                      // 21: puzzle_service.PuzzleJobLog
-	(*PuzzleJobLogsResponse)(nil),             // 22: puzzle_service.PuzzleJobLogsResponse
-	(*macondo.GameEvent)(nil),                 // 23: macondo.GameEvent
-	(*timestamppb.Timestamp)(nil),             // 24: google.protobuf.Timestamp
-	(*macondo.GameHistory)(nil),               // 25: macondo.GameHistory
-	(*ipc.ClientGameplayEvent)(nil),           // 26: ipc.ClientGameplayEvent
-	(*macondo.PuzzleGenerationRequest)(nil),   // 27: macondo.PuzzleGenerationRequest
+	(PuzzleQueryResult)(0),                     // 0: puzzle_service.PuzzleQueryResult
+	(PuzzleStatus)(0),                          // 1: puzzle_service.PuzzleStatus
+	(PuzzleRushPeriod)(0),                      // 2: puzzle_service.PuzzleRushPeriod
+	(*StartPuzzleIdRequest)(nil),               // 3: puzzle_service.StartPuzzleIdRequest
+	(*StartPuzzleIdResponse)(nil),              // 4: puzzle_service.StartPuzzleIdResponse
+	(*NextPuzzleIdRequest)(nil),                // 5: puzzle_service.NextPuzzleIdRequest
+	(*NextPuzzleIdResponse)(nil),               // 6: puzzle_service.NextPuzzleIdResponse
+	(*NextClosestRatingPuzzleIdRequest)(nil),   // 7: puzzle_service.NextClosestRatingPuzzleIdRequest
+	(*NextClosestRatingPuzzleIdResponse)(nil),  // 8: puzzle_service.NextClosestRatingPuzzleIdResponse
+	(*PuzzleRequest)(nil),                      // 9: puzzle_service.PuzzleRequest
+	(*AnswerResponse)(nil),                     // 10: puzzle_service.AnswerResponse
+	(*PuzzleResponse)(nil),                     // 11: puzzle_service.PuzzleResponse
+	(*SubmissionRequest)(nil),                  // 12: puzzle_service.SubmissionRequest
+	(*SubmissionResponse)(nil),                 // 13: puzzle_service.SubmissionResponse
+	(*PreviousPuzzleRequest)(nil),              // 14: puzzle_service.PreviousPuzzleRequest
+	(*PreviousPuzzleResponse)(nil),             // 15: puzzle_service.PreviousPuzzleResponse
+	(*PuzzleVoteRequest)(nil),                  // 16: puzzle_service.PuzzleVoteRequest
+	(*PuzzleVoteResponse)(nil),                 // 17: puzzle_service.PuzzleVoteResponse
+	(*PuzzleGenerationJobRequest)(nil),         // 18: puzzle_service.PuzzleGenerationJobRequest
+	(*APIPuzzleGenerationJobResponse)(nil),     // 19: puzzle_service.APIPuzzleGenerationJobResponse
+	(*APIPuzzleGenerationJobRequest)(nil),      // 20: puzzle_service.APIPuzzleGenerationJobRequest
+	(*PuzzleJobLogsRequest)(nil),               // 21: puzzle_service.PuzzleJobLogsRequest
+	(*PuzzleJobLog)(nil),                       // 22: puzzle_service.PuzzleJobLog
+	(*PuzzleJobLogsResponse)(nil),              // 23: puzzle_service.PuzzleJobLogsResponse
+	(*PuzzleReview)(nil),                       // 24: puzzle_service.PuzzleReview
+	(*ReviewQueueRequest)(nil),                 // 25: puzzle_service.ReviewQueueRequest
+	(*ReviewQueueResponse)(nil),                // 26: puzzle_service.ReviewQueueResponse
+	(*StudySessionRequest)(nil),                // 27: puzzle_service.StudySessionRequest
+	(*StudySessionResponse)(nil),               // 28: puzzle_service.StudySessionResponse
+	(*ReviewSubmissionRequest)(nil),            // 29: puzzle_service.ReviewSubmissionRequest
+	(*ReviewSubmissionResponse)(nil),           // 30: puzzle_service.ReviewSubmissionResponse
+	(*RemoveFromReviewQueueResponse)(nil),      // 31: puzzle_service.RemoveFromReviewQueueResponse
+	(*PuzzleSetEntry)(nil),                     // 32: puzzle_service.PuzzleSetEntry
+	(*PuzzleSet)(nil),                          // 33: puzzle_service.PuzzleSet
+	(*CreatePuzzleSetRequest)(nil),             // 34: puzzle_service.CreatePuzzleSetRequest
+	(*UpdatePuzzleSetRequest)(nil),             // 35: puzzle_service.UpdatePuzzleSetRequest
+	(*PuzzleSetRequest)(nil),                   // 36: puzzle_service.PuzzleSetRequest
+	(*PuzzleSetsRequest)(nil),                  // 37: puzzle_service.PuzzleSetsRequest
+	(*PuzzleSetResponse)(nil),                  // 38: puzzle_service.PuzzleSetResponse
+	(*PuzzleSetsResponse)(nil),                 // 39: puzzle_service.PuzzleSetsResponse
+	(*DeletePuzzleSetResponse)(nil),            // 40: puzzle_service.DeletePuzzleSetResponse
+	(*PuzzleTagRatingsRequest)(nil),            // 41: puzzle_service.PuzzleTagRatingsRequest
+	(*PuzzleTagRating)(nil),                    // 42: puzzle_service.PuzzleTagRating
+	(*PuzzleTagRatingsResponse)(nil),           // 43: puzzle_service.PuzzleTagRatingsResponse
+	(*QueuePuzzleGenerationRequest)(nil),       // 44: puzzle_service.QueuePuzzleGenerationRequest
+	(*QueuePuzzleGenerationResponse)(nil),      // 45: puzzle_service.QueuePuzzleGenerationResponse
+	(*ApprovePuzzleGenerationJobRequest)(nil),  // 46: puzzle_service.ApprovePuzzleGenerationJobRequest
+	(*ApprovePuzzleGenerationJobResponse)(nil), // 47: puzzle_service.ApprovePuzzleGenerationJobResponse
+	(*DailyPuzzleRequest)(nil),                 // 48: puzzle_service.DailyPuzzleRequest
+	(*DailyPuzzleWrongAnswer)(nil),             // 49: puzzle_service.DailyPuzzleWrongAnswer
+	(*DailyPuzzleStats)(nil),                   // 50: puzzle_service.DailyPuzzleStats
+	(*DailyPuzzleStreak)(nil),                  // 51: puzzle_service.DailyPuzzleStreak
+	(*DailyPuzzleResponse)(nil),                // 52: puzzle_service.DailyPuzzleResponse
+	(*DailyPuzzleAnswerRequest)(nil),           // 53: puzzle_service.DailyPuzzleAnswerRequest
+	(*DailyPuzzleAnswerResponse)(nil),          // 54: puzzle_service.DailyPuzzleAnswerResponse
+	(*DailyPuzzleArchiveRequest)(nil),          // 55: puzzle_service.DailyPuzzleArchiveRequest
+	(*DailyPuzzleArchiveEntry)(nil),            // 56: puzzle_service.DailyPuzzleArchiveEntry
+	(*DailyPuzzleArchiveResponse)(nil),         // 57: puzzle_service.DailyPuzzleArchiveResponse
+	(*SetDailyPuzzleRequest)(nil),              // 58: puzzle_service.SetDailyPuzzleRequest
+	(*SetDailyPuzzleResponse)(nil),             // 59: puzzle_service.SetDailyPuzzleResponse
+	(*PuzzleRushState)(nil),                    // 60: puzzle_service.PuzzleRushState
+	(*StartPuzzleRushRequest)(nil),             // 61: puzzle_service.StartPuzzleRushRequest
+	(*PuzzleRushRequest)(nil),                  // 62: puzzle_service.PuzzleRushRequest
+	(*PuzzleRushResponse)(nil),                 // 63: puzzle_service.PuzzleRushResponse
+	(*PuzzleRushAnswerRequest)(nil),            // 64: puzzle_service.PuzzleRushAnswerRequest
+	(*PuzzleRushAnswerResponse)(nil),           // 65: puzzle_service.PuzzleRushAnswerResponse
+	(*PuzzleRushLeaderboardRequest)(nil),       // 66: puzzle_service.PuzzleRushLeaderboardRequest
+	(*PuzzleRushLeaderboardEntry)(nil),         // 67: puzzle_service.PuzzleRushLeaderboardEntry
+	(*PuzzleRushLeaderboardResponse)(nil),      // 68: puzzle_service.PuzzleRushLeaderboardResponse
+	(macondo.PuzzleTag)(0),                     // 69: macondo.PuzzleTag
+	(*macondo.GameEvent)(nil),                  // 70: macondo.GameEvent
+	(*timestamppb.Timestamp)(nil),              // 71: google.protobuf.Timestamp
+	(*macondo.GameHistory)(nil),                // 72: macondo.GameHistory
+	(*ipc.ClientGameplayEvent)(nil),            // 73: ipc.ClientGameplayEvent
+	(*macondo.PuzzleGenerationRequest)(nil),    // 74: macondo.PuzzleGenerationRequest
 }
 var file_proto_puzzle_service_puzzle_service_proto_depIdxs = []int32{
-	0,  // 0: puzzle_service.StartPuzzleIdResponse.query_result:type_name -> puzzle_service.PuzzleQueryResult
-	0,  // 1: puzzle_service.NextPuzzleIdResponse.query_result:type_name -> puzzle_service.PuzzleQueryResult
-	0,  // 2: puzzle_service.NextClosestRatingPuzzleIdResponse.query_result:type_name -> puzzle_service.PuzzleQueryResult
-	23, // 3: puzzle_service.AnswerResponse.correct_answer:type_name -> macondo.GameEvent
-	1,  // 4: puzzle_service.AnswerResponse.status:type_name -> puzzle_service.PuzzleStatus
-	24, // 5: puzzle_service.AnswerResponse.first_attempt_time:type_name -> google.protobuf.Timestamp
-	24, // 6: puzzle_service.AnswerResponse.last_attempt_time:type_name -> google.protobuf.Timestamp
-	25, // 7: puzzle_service.PuzzleResponse.history:type_name -> macondo.GameHistory
-	9,  // 8: puzzle_service.PuzzleResponse.answer:type_name -> puzzle_service.AnswerResponse
-	26, // 9: puzzle_service.SubmissionRequest.answer:type_name -> ipc.ClientGameplayEvent
-	9,  // 10: puzzle_service.SubmissionResponse.answer:type_name -> puzzle_service.AnswerResponse
-	27, // 11: puzzle_service.PuzzleGenerationJobRequest.request:type_name -> macondo.PuzzleGenerationRequest
-	17, // 12: puzzle_service.APIPuzzleGenerationJobRequest.request:type_name -> puzzle_service.PuzzleGenerationJobRequest
-	17, // 13: puzzle_service.PuzzleJobLog.request:type_name -> puzzle_service.PuzzleGenerationJobRequest
-	24, // 14: puzzle_service.PuzzleJobLog.created_at:type_name -> google.protobuf.Timestamp
-	24, // 15: puzzle_service.PuzzleJobLog.completed_at:type_name -> google.protobuf.Timestamp
-	21, // 16: puzzle_service.PuzzleJobLogsResponse.logs:type_name -> puzzle_service.PuzzleJobLog
-	2,  // 17: puzzle_service.PuzzleService.GetStartPuzzleId:input_type -> puzzle_service.StartPuzzleIdRequest
-	4,  // 18: puzzle_service.PuzzleService.GetNextPuzzleId:input_type -> puzzle_service.NextPuzzleIdRequest
-	6,  // 19: puzzle_service.PuzzleService.GetNextClosestRatingPuzzleId:input_type -> puzzle_service.NextClosestRatingPuzzleIdRequest
-	8,  // 20: puzzle_service.PuzzleService.GetPuzzle:input_type -> puzzle_service.PuzzleRequest
-	11, // 21: puzzle_service.PuzzleService.SubmitAnswer:input_type -> puzzle_service.SubmissionRequest
-	8,  // 22: puzzle_service.PuzzleService.GetPuzzleAnswer:input_type -> puzzle_service.PuzzleRequest
-	13, // 23: puzzle_service.PuzzleService.GetPreviousPuzzleId:input_type -> puzzle_service.PreviousPuzzleRequest
-	15, // 24: puzzle_service.PuzzleService.SetPuzzleVote:input_type -> puzzle_service.PuzzleVoteRequest
-	19, // 25: puzzle_service.PuzzleService.StartPuzzleGenJob:input_type -> puzzle_service.APIPuzzleGenerationJobRequest
-	20, // 26: puzzle_service.PuzzleService.GetPuzzleJobLogs:input_type -> puzzle_service.PuzzleJobLogsRequest
-	3,  // 27: puzzle_service.PuzzleService.GetStartPuzzleId:output_type -> puzzle_service.StartPuzzleIdResponse
-	5,  // 28: puzzle_service.PuzzleService.GetNextPuzzleId:output_type -> puzzle_service.NextPuzzleIdResponse
-	7,  // 29: puzzle_service.PuzzleService.GetNextClosestRatingPuzzleId:output_type -> puzzle_service.NextClosestRatingPuzzleIdResponse
-	10, // 30: puzzle_service.PuzzleService.GetPuzzle:output_type -> puzzle_service.PuzzleResponse
-	12, // 31: puzzle_service.PuzzleService.SubmitAnswer:output_type -> puzzle_service.SubmissionResponse
-	9,  // 32: puzzle_service.PuzzleService.GetPuzzleAnswer:output_type -> puzzle_service.AnswerResponse
-	14, // 33: puzzle_service.PuzzleService.GetPreviousPuzzleId:output_type -> puzzle_service.PreviousPuzzleResponse
-	16, // 34: puzzle_service.PuzzleService.SetPuzzleVote:output_type -> puzzle_service.PuzzleVoteResponse
-	18, // 35: puzzle_service.PuzzleService.StartPuzzleGenJob:output_type -> puzzle_service.APIPuzzleGenerationJobResponse
-	22, // 36: puzzle_service.PuzzleService.GetPuzzleJobLogs:output_type -> puzzle_service.PuzzleJobLogsResponse
-	27, // [27:37] is the sub-list for method output_type
-	17, // [17:27] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	69, // 0: puzzle_service.StartPuzzleIdRequest.tags:type_name -> macondo.PuzzleTag
+	0,  // 1: puzzle_service.StartPuzzleIdResponse.query_result:type_name -> puzzle_service.PuzzleQueryResult
+	69, // 2: puzzle_service.NextPuzzleIdRequest.tags:type_name -> macondo.PuzzleTag
+	0,  // 3: puzzle_service.NextPuzzleIdResponse.query_result:type_name -> puzzle_service.PuzzleQueryResult
+	69, // 4: puzzle_service.NextClosestRatingPuzzleIdRequest.tags:type_name -> macondo.PuzzleTag
+	0,  // 5: puzzle_service.NextClosestRatingPuzzleIdResponse.query_result:type_name -> puzzle_service.PuzzleQueryResult
+	70, // 6: puzzle_service.AnswerResponse.correct_answer:type_name -> macondo.GameEvent
+	1,  // 7: puzzle_service.AnswerResponse.status:type_name -> puzzle_service.PuzzleStatus
+	71, // 8: puzzle_service.AnswerResponse.first_attempt_time:type_name -> google.protobuf.Timestamp
+	71, // 9: puzzle_service.AnswerResponse.last_attempt_time:type_name -> google.protobuf.Timestamp
+	72, // 10: puzzle_service.PuzzleResponse.history:type_name -> macondo.GameHistory
+	10, // 11: puzzle_service.PuzzleResponse.answer:type_name -> puzzle_service.AnswerResponse
+	73, // 12: puzzle_service.SubmissionRequest.answer:type_name -> ipc.ClientGameplayEvent
+	10, // 13: puzzle_service.SubmissionResponse.answer:type_name -> puzzle_service.AnswerResponse
+	74, // 14: puzzle_service.PuzzleGenerationJobRequest.request:type_name -> macondo.PuzzleGenerationRequest
+	18, // 15: puzzle_service.APIPuzzleGenerationJobRequest.request:type_name -> puzzle_service.PuzzleGenerationJobRequest
+	18, // 16: puzzle_service.PuzzleJobLog.request:type_name -> puzzle_service.PuzzleGenerationJobRequest
+	71, // 17: puzzle_service.PuzzleJobLog.created_at:type_name -> google.protobuf.Timestamp
+	71, // 18: puzzle_service.PuzzleJobLog.completed_at:type_name -> google.protobuf.Timestamp
+	22, // 19: puzzle_service.PuzzleJobLogsResponse.logs:type_name -> puzzle_service.PuzzleJobLog
+	71, // 20: puzzle_service.PuzzleReview.due_at:type_name -> google.protobuf.Timestamp
+	71, // 21: puzzle_service.PuzzleReview.last_reviewed_at:type_name -> google.protobuf.Timestamp
+	24, // 22: puzzle_service.ReviewQueueResponse.reviews:type_name -> puzzle_service.PuzzleReview
+	71, // 23: puzzle_service.StudySessionResponse.next_due_at:type_name -> google.protobuf.Timestamp
+	73, // 24: puzzle_service.ReviewSubmissionRequest.answer:type_name -> ipc.ClientGameplayEvent
+	70, // 25: puzzle_service.ReviewSubmissionResponse.correct_answer:type_name -> macondo.GameEvent
+	24, // 26: puzzle_service.ReviewSubmissionResponse.review:type_name -> puzzle_service.PuzzleReview
+	1,  // 27: puzzle_service.PuzzleSetEntry.status:type_name -> puzzle_service.PuzzleStatus
+	32, // 28: puzzle_service.PuzzleSet.puzzles:type_name -> puzzle_service.PuzzleSetEntry
+	71, // 29: puzzle_service.PuzzleSet.updated_at:type_name -> google.protobuf.Timestamp
+	33, // 30: puzzle_service.PuzzleSetResponse.puzzle_set:type_name -> puzzle_service.PuzzleSet
+	33, // 31: puzzle_service.PuzzleSetsResponse.puzzle_sets:type_name -> puzzle_service.PuzzleSet
+	69, // 32: puzzle_service.PuzzleTagRating.tag:type_name -> macondo.PuzzleTag
+	42, // 33: puzzle_service.PuzzleTagRatingsResponse.ratings:type_name -> puzzle_service.PuzzleTagRating
+	74, // 34: puzzle_service.QueuePuzzleGenerationRequest.request:type_name -> macondo.PuzzleGenerationRequest
+	49, // 35: puzzle_service.DailyPuzzleStats.common_wrong_answers:type_name -> puzzle_service.DailyPuzzleWrongAnswer
+	72, // 36: puzzle_service.DailyPuzzleResponse.history:type_name -> macondo.GameHistory
+	1,  // 37: puzzle_service.DailyPuzzleResponse.status:type_name -> puzzle_service.PuzzleStatus
+	70, // 38: puzzle_service.DailyPuzzleResponse.correct_answer:type_name -> macondo.GameEvent
+	50, // 39: puzzle_service.DailyPuzzleResponse.stats:type_name -> puzzle_service.DailyPuzzleStats
+	51, // 40: puzzle_service.DailyPuzzleResponse.streak:type_name -> puzzle_service.DailyPuzzleStreak
+	73, // 41: puzzle_service.DailyPuzzleAnswerRequest.answer:type_name -> ipc.ClientGameplayEvent
+	70, // 42: puzzle_service.DailyPuzzleAnswerResponse.correct_answer:type_name -> macondo.GameEvent
+	50, // 43: puzzle_service.DailyPuzzleAnswerResponse.stats:type_name -> puzzle_service.DailyPuzzleStats
+	51, // 44: puzzle_service.DailyPuzzleAnswerResponse.streak:type_name -> puzzle_service.DailyPuzzleStreak
+	1,  // 45: puzzle_service.DailyPuzzleArchiveEntry.status:type_name -> puzzle_service.PuzzleStatus
+	56, // 46: puzzle_service.DailyPuzzleArchiveResponse.puzzles:type_name -> puzzle_service.DailyPuzzleArchiveEntry
+	71, // 47: puzzle_service.PuzzleRushState.started_at:type_name -> google.protobuf.Timestamp
+	71, // 48: puzzle_service.PuzzleRushState.ends_at:type_name -> google.protobuf.Timestamp
+	72, // 49: puzzle_service.PuzzleRushState.history:type_name -> macondo.GameHistory
+	60, // 50: puzzle_service.PuzzleRushResponse.state:type_name -> puzzle_service.PuzzleRushState
+	73, // 51: puzzle_service.PuzzleRushAnswerRequest.answer:type_name -> ipc.ClientGameplayEvent
+	70, // 52: puzzle_service.PuzzleRushAnswerResponse.correct_answer:type_name -> macondo.GameEvent
+	60, // 53: puzzle_service.PuzzleRushAnswerResponse.state:type_name -> puzzle_service.PuzzleRushState
+	2,  // 54: puzzle_service.PuzzleRushLeaderboardRequest.period:type_name -> puzzle_service.PuzzleRushPeriod
+	71, // 55: puzzle_service.PuzzleRushLeaderboardEntry.started_at:type_name -> google.protobuf.Timestamp
+	67, // 56: puzzle_service.PuzzleRushLeaderboardResponse.entries:type_name -> puzzle_service.PuzzleRushLeaderboardEntry
+	3,  // 57: puzzle_service.PuzzleService.GetStartPuzzleId:input_type -> puzzle_service.StartPuzzleIdRequest
+	5,  // 58: puzzle_service.PuzzleService.GetNextPuzzleId:input_type -> puzzle_service.NextPuzzleIdRequest
+	7,  // 59: puzzle_service.PuzzleService.GetNextClosestRatingPuzzleId:input_type -> puzzle_service.NextClosestRatingPuzzleIdRequest
+	9,  // 60: puzzle_service.PuzzleService.GetPuzzle:input_type -> puzzle_service.PuzzleRequest
+	12, // 61: puzzle_service.PuzzleService.SubmitAnswer:input_type -> puzzle_service.SubmissionRequest
+	9,  // 62: puzzle_service.PuzzleService.GetPuzzleAnswer:input_type -> puzzle_service.PuzzleRequest
+	14, // 63: puzzle_service.PuzzleService.GetPreviousPuzzleId:input_type -> puzzle_service.PreviousPuzzleRequest
+	16, // 64: puzzle_service.PuzzleService.SetPuzzleVote:input_type -> puzzle_service.PuzzleVoteRequest
+	20, // 65: puzzle_service.PuzzleService.StartPuzzleGenJob:input_type -> puzzle_service.APIPuzzleGenerationJobRequest
+	21, // 66: puzzle_service.PuzzleService.GetPuzzleJobLogs:input_type -> puzzle_service.PuzzleJobLogsRequest
+	44, // 67: puzzle_service.PuzzleService.QueuePuzzleGeneration:input_type -> puzzle_service.QueuePuzzleGenerationRequest
+	46, // 68: puzzle_service.PuzzleService.ApprovePuzzleGenerationJob:input_type -> puzzle_service.ApprovePuzzleGenerationJobRequest
+	25, // 69: puzzle_service.PuzzleService.GetReviewQueue:input_type -> puzzle_service.ReviewQueueRequest
+	27, // 70: puzzle_service.PuzzleService.StartStudySession:input_type -> puzzle_service.StudySessionRequest
+	29, // 71: puzzle_service.PuzzleService.SubmitReview:input_type -> puzzle_service.ReviewSubmissionRequest
+	9,  // 72: puzzle_service.PuzzleService.RemoveFromReviewQueue:input_type -> puzzle_service.PuzzleRequest
+	34, // 73: puzzle_service.PuzzleService.CreatePuzzleSet:input_type -> puzzle_service.CreatePuzzleSetRequest
+	35, // 74: puzzle_service.PuzzleService.UpdatePuzzleSet:input_type -> puzzle_service.UpdatePuzzleSetRequest
+	36, // 75: puzzle_service.PuzzleService.DeletePuzzleSet:input_type -> puzzle_service.PuzzleSetRequest
+	37, // 76: puzzle_service.PuzzleService.GetPuzzleSets:input_type -> puzzle_service.PuzzleSetsRequest
+	36, // 77: puzzle_service.PuzzleService.GetPuzzleSet:input_type -> puzzle_service.PuzzleSetRequest
+	41, // 78: puzzle_service.PuzzleService.GetPuzzleTagRatings:input_type -> puzzle_service.PuzzleTagRatingsRequest
+	48, // 79: puzzle_service.PuzzleService.GetDailyPuzzle:input_type -> puzzle_service.DailyPuzzleRequest
+	53, // 80: puzzle_service.PuzzleService.SubmitDailyPuzzleAnswer:input_type -> puzzle_service.DailyPuzzleAnswerRequest
+	55, // 81: puzzle_service.PuzzleService.GetDailyPuzzleArchive:input_type -> puzzle_service.DailyPuzzleArchiveRequest
+	58, // 82: puzzle_service.PuzzleService.SetDailyPuzzle:input_type -> puzzle_service.SetDailyPuzzleRequest
+	61, // 83: puzzle_service.PuzzleService.StartPuzzleRush:input_type -> puzzle_service.StartPuzzleRushRequest
+	62, // 84: puzzle_service.PuzzleService.GetPuzzleRush:input_type -> puzzle_service.PuzzleRushRequest
+	64, // 85: puzzle_service.PuzzleService.SubmitPuzzleRushAnswer:input_type -> puzzle_service.PuzzleRushAnswerRequest
+	62, // 86: puzzle_service.PuzzleService.EndPuzzleRush:input_type -> puzzle_service.PuzzleRushRequest
+	66, // 87: puzzle_service.PuzzleService.GetPuzzleRushLeaderboard:input_type -> puzzle_service.PuzzleRushLeaderboardRequest
+	4,  // 88: puzzle_service.PuzzleService.GetStartPuzzleId:output_type -> puzzle_service.StartPuzzleIdResponse
+	6,  // 89: puzzle_service.PuzzleService.GetNextPuzzleId:output_type -> puzzle_service.NextPuzzleIdResponse
+	8,  // 90: puzzle_service.PuzzleService.GetNextClosestRatingPuzzleId:output_type -> puzzle_service.NextClosestRatingPuzzleIdResponse
+	11, // 91: puzzle_service.PuzzleService.GetPuzzle:output_type -> puzzle_service.PuzzleResponse
+	13, // 92: puzzle_service.PuzzleService.SubmitAnswer:output_type -> puzzle_service.SubmissionResponse
+	10, // 93: puzzle_service.PuzzleService.GetPuzzleAnswer:output_type -> puzzle_service.AnswerResponse
+	15, // 94: puzzle_service.PuzzleService.GetPreviousPuzzleId:output_type -> puzzle_service.PreviousPuzzleResponse
+	17, // 95: puzzle_service.PuzzleService.SetPuzzleVote:output_type -> puzzle_service.PuzzleVoteResponse
+	19, // 96: puzzle_service.PuzzleService.StartPuzzleGenJob:output_type -> puzzle_service.APIPuzzleGenerationJobResponse
+	23, // 97: puzzle_service.PuzzleService.GetPuzzleJobLogs:output_type -> puzzle_service.PuzzleJobLogsResponse
+	45, // 98: puzzle_service.PuzzleService.QueuePuzzleGeneration:output_type -> puzzle_service.QueuePuzzleGenerationResponse
+	47, // 99: puzzle_service.PuzzleService.ApprovePuzzleGenerationJob:output_type -> puzzle_service.ApprovePuzzleGenerationJobResponse
+	26, // 100: puzzle_service.PuzzleService.GetReviewQueue:output_type -> puzzle_service.ReviewQueueResponse
+	28, // 101: puzzle_service.PuzzleService.StartStudySession:output_type -> puzzle_service.StudySessionResponse
+	30, // 102: puzzle_service.PuzzleService.SubmitReview:output_type -> puzzle_service.ReviewSubmissionResponse
+	31, // 103: puzzle_service.PuzzleService.RemoveFromReviewQueue:output_type -> puzzle_service.RemoveFromReviewQueueResponse
+	38, // 104: puzzle_service.PuzzleService.CreatePuzzleSet:output_type -> puzzle_service.PuzzleSetResponse
+	38, // 105: puzzle_service.PuzzleService.UpdatePuzzleSet:output_type -> puzzle_service.PuzzleSetResponse
+	40, // 106: puzzle_service.PuzzleService.DeletePuzzleSet:output_type -> puzzle_service.DeletePuzzleSetResponse
+	39, // 107: puzzle_service.PuzzleService.GetPuzzleSets:output_type -> puzzle_service.PuzzleSetsResponse
+	38, // 108: puzzle_service.PuzzleService.GetPuzzleSet:output_type -> puzzle_service.PuzzleSetResponse
+	43, // 109: puzzle_service.PuzzleService.GetPuzzleTagRatings:output_type -> puzzle_service.PuzzleTagRatingsResponse
+	52, // 110: puzzle_service.PuzzleService.GetDailyPuzzle:output_type -> puzzle_service.DailyPuzzleResponse
+	54, // 111: puzzle_service.PuzzleService.SubmitDailyPuzzleAnswer:output_type -> puzzle_service.DailyPuzzleAnswerResponse
+	57, // 112: puzzle_service.PuzzleService.GetDailyPuzzleArchive:output_type -> puzzle_service.DailyPuzzleArchiveResponse
+	59, // 113: puzzle_service.PuzzleService.SetDailyPuzzle:output_type -> puzzle_service.SetDailyPuzzleResponse
+	63, // 114: puzzle_service.PuzzleService.StartPuzzleRush:output_type -> puzzle_service.PuzzleRushResponse
+	63, // 115: puzzle_service.PuzzleService.GetPuzzleRush:output_type -> puzzle_service.PuzzleRushResponse
+	65, // 116: puzzle_service.PuzzleService.SubmitPuzzleRushAnswer:output_type -> puzzle_service.PuzzleRushAnswerResponse
+	63, // 117: puzzle_service.PuzzleService.EndPuzzleRush:output_type -> puzzle_service.PuzzleRushResponse
+	68, // 118: puzzle_service.PuzzleService.GetPuzzleRushLeaderboard:output_type -> puzzle_service.PuzzleRushLeaderboardResponse
+	88, // [88:119] is the sub-list for method output_type
+	57, // [57:88] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_proto_puzzle_service_puzzle_service_proto_init() }
@@ -1550,8 +4626,8 @@ func file_proto_puzzle_service_puzzle_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_puzzle_service_puzzle_service_proto_rawDesc), len(file_proto_puzzle_service_puzzle_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   21,
+			NumEnums:      3,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -62,6 +62,69 @@ const (
 	// PuzzleServiceGetPuzzleJobLogsProcedure is the fully-qualified name of the PuzzleService's
 	// GetPuzzleJobLogs RPC.
 	PuzzleServiceGetPuzzleJobLogsProcedure = "/puzzle_service.PuzzleService/GetPuzzleJobLogs"
+	// PuzzleServiceQueuePuzzleGenerationProcedure is the fully-qualified name of the PuzzleService's
+	// QueuePuzzleGeneration RPC.
+	PuzzleServiceQueuePuzzleGenerationProcedure = "/puzzle_service.PuzzleService/QueuePuzzleGeneration"
+	// PuzzleServiceApprovePuzzleGenerationJobProcedure is the fully-qualified name of the
+	// PuzzleService's ApprovePuzzleGenerationJob RPC.
+	PuzzleServiceApprovePuzzleGenerationJobProcedure = "/puzzle_service.PuzzleService/ApprovePuzzleGenerationJob"
+	// PuzzleServiceGetReviewQueueProcedure is the fully-qualified name of the PuzzleService's
+	// GetReviewQueue RPC.
+	PuzzleServiceGetReviewQueueProcedure = "/puzzle_service.PuzzleService/GetReviewQueue"
+	// PuzzleServiceStartStudySessionProcedure is the fully-qualified name of the PuzzleService's
+	// StartStudySession RPC.
+	PuzzleServiceStartStudySessionProcedure = "/puzzle_service.PuzzleService/StartStudySession"
+	// PuzzleServiceSubmitReviewProcedure is the fully-qualified name of the PuzzleService's
+	// SubmitReview RPC.
+	PuzzleServiceSubmitReviewProcedure = "/puzzle_service.PuzzleService/SubmitReview"
+	// PuzzleServiceRemoveFromReviewQueueProcedure is the fully-qualified name of the PuzzleService's
+	// RemoveFromReviewQueue RPC.
+	PuzzleServiceRemoveFromReviewQueueProcedure = "/puzzle_service.PuzzleService/RemoveFromReviewQueue"
+	// PuzzleServiceCreatePuzzleSetProcedure is the fully-qualified name of the PuzzleService's
+	// CreatePuzzleSet RPC.
+	PuzzleServiceCreatePuzzleSetProcedure = "/puzzle_service.PuzzleService/CreatePuzzleSet"
+	// PuzzleServiceUpdatePuzzleSetProcedure is the fully-qualified name of the PuzzleService's
+	// UpdatePuzzleSet RPC.
+	PuzzleServiceUpdatePuzzleSetProcedure = "/puzzle_service.PuzzleService/UpdatePuzzleSet"
+	// PuzzleServiceDeletePuzzleSetProcedure is the fully-qualified name of the PuzzleService's
+	// DeletePuzzleSet RPC.
+	PuzzleServiceDeletePuzzleSetProcedure = "/puzzle_service.PuzzleService/DeletePuzzleSet"
+	// PuzzleServiceGetPuzzleSetsProcedure is the fully-qualified name of the PuzzleService's
+	// GetPuzzleSets RPC.
+	PuzzleServiceGetPuzzleSetsProcedure = "/puzzle_service.PuzzleService/GetPuzzleSets"
+	// PuzzleServiceGetPuzzleSetProcedure is the fully-qualified name of the PuzzleService's
+	// GetPuzzleSet RPC.
+	PuzzleServiceGetPuzzleSetProcedure = "/puzzle_service.PuzzleService/GetPuzzleSet"
+	// PuzzleServiceGetPuzzleTagRatingsProcedure is the fully-qualified name of the PuzzleService's
+	// GetPuzzleTagRatings RPC.
+	PuzzleServiceGetPuzzleTagRatingsProcedure = "/puzzle_service.PuzzleService/GetPuzzleTagRatings"
+	// PuzzleServiceGetDailyPuzzleProcedure is the fully-qualified name of the PuzzleService's
+	// GetDailyPuzzle RPC.
+	PuzzleServiceGetDailyPuzzleProcedure = "/puzzle_service.PuzzleService/GetDailyPuzzle"
+	// PuzzleServiceSubmitDailyPuzzleAnswerProcedure is the fully-qualified name of the PuzzleService's
+	// SubmitDailyPuzzleAnswer RPC.
+	PuzzleServiceSubmitDailyPuzzleAnswerProcedure = "/puzzle_service.PuzzleService/SubmitDailyPuzzleAnswer"
+	// PuzzleServiceGetDailyPuzzleArchiveProcedure is the fully-qualified name of the PuzzleService's
+	// GetDailyPuzzleArchive RPC.
+	PuzzleServiceGetDailyPuzzleArchiveProcedure = "/puzzle_service.PuzzleService/GetDailyPuzzleArchive"
+	// PuzzleServiceSetDailyPuzzleProcedure is the fully-qualified name of the PuzzleService's
+	// SetDailyPuzzle RPC.
+	PuzzleServiceSetDailyPuzzleProcedure = "/puzzle_service.PuzzleService/SetDailyPuzzle"
+	// PuzzleServiceStartPuzzleRushProcedure is the fully-qualified name of the PuzzleService's
+	// StartPuzzleRush RPC.
+	PuzzleServiceStartPuzzleRushProcedure = "/puzzle_service.PuzzleService/StartPuzzleRush"
+	// PuzzleServiceGetPuzzleRushProcedure is the fully-qualified name of the PuzzleService's
+	// GetPuzzleRush RPC.
+	PuzzleServiceGetPuzzleRushProcedure = "/puzzle_service.PuzzleService/GetPuzzleRush"
+	// PuzzleServiceSubmitPuzzleRushAnswerProcedure is the fully-qualified name of the PuzzleService's
+	// SubmitPuzzleRushAnswer RPC.
+	PuzzleServiceSubmitPuzzleRushAnswerProcedure = "/puzzle_service.PuzzleService/SubmitPuzzleRushAnswer"
+	// PuzzleServiceEndPuzzleRushProcedure is the fully-qualified name of the PuzzleService's
+	// EndPuzzleRush RPC.
+	PuzzleServiceEndPuzzleRushProcedure = "/puzzle_service.PuzzleService/EndPuzzleRush"
+	// PuzzleServiceGetPuzzleRushLeaderboardProcedure is the fully-qualified name of the PuzzleService's
+	// GetPuzzleRushLeaderboard RPC.
+	PuzzleServiceGetPuzzleRushLeaderboardProcedure = "/puzzle_service.PuzzleService/GetPuzzleRushLeaderboard"
 )
 
 // PuzzleServiceClient is a client for the puzzle_service.PuzzleService service.
@@ -78,6 +141,44 @@ type PuzzleServiceClient interface {
 	SetPuzzleVote(context.Context, *connect.Request[puzzle_service.PuzzleVoteRequest]) (*connect.Response[puzzle_service.PuzzleVoteResponse], error)
 	StartPuzzleGenJob(context.Context, *connect.Request[puzzle_service.APIPuzzleGenerationJobRequest]) (*connect.Response[puzzle_service.APIPuzzleGenerationJobResponse], error)
 	GetPuzzleJobLogs(context.Context, *connect.Request[puzzle_service.PuzzleJobLogsRequest]) (*connect.Response[puzzle_service.PuzzleJobLogsResponse], error)
+	// QueuePuzzleGeneration queues generation from the user's own annotated
+	// games for the local puzzle worker (cmd/puzzle-worker). Unless the user
+	// can create puzzles, the job needs approval.
+	QueuePuzzleGeneration(context.Context, *connect.Request[puzzle_service.QueuePuzzleGenerationRequest]) (*connect.Response[puzzle_service.QueuePuzzleGenerationResponse], error)
+	// ApprovePuzzleGenerationJob makes the puzzles of a job that needed
+	// approval available to everyone.
+	ApprovePuzzleGenerationJob(context.Context, *connect.Request[puzzle_service.ApprovePuzzleGenerationJobRequest]) (*connect.Response[puzzle_service.ApprovePuzzleGenerationJobResponse], error)
+	// Spaced-repetition training over the puzzles a user failed or was slow
+	// to solve. Reviews do not change puzzle or user ratings.
+	GetReviewQueue(context.Context, *connect.Request[puzzle_service.ReviewQueueRequest]) (*connect.Response[puzzle_service.ReviewQueueResponse], error)
+	StartStudySession(context.Context, *connect.Request[puzzle_service.StudySessionRequest]) (*connect.Response[puzzle_service.StudySessionResponse], error)
+	SubmitReview(context.Context, *connect.Request[puzzle_service.ReviewSubmissionRequest]) (*connect.Response[puzzle_service.ReviewSubmissionResponse], error)
+	RemoveFromReviewQueue(context.Context, *connect.Request[puzzle_service.PuzzleRequest]) (*connect.Response[puzzle_service.RemoveFromReviewQueueResponse], error)
+	// Curated puzzle sets. Only puzzle creators can create, update or
+	// delete them.
+	CreatePuzzleSet(context.Context, *connect.Request[puzzle_service.CreatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error)
+	UpdatePuzzleSet(context.Context, *connect.Request[puzzle_service.UpdatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error)
+	DeletePuzzleSet(context.Context, *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.DeletePuzzleSetResponse], error)
+	GetPuzzleSets(context.Context, *connect.Request[puzzle_service.PuzzleSetsRequest]) (*connect.Response[puzzle_service.PuzzleSetsResponse], error)
+	GetPuzzleSet(context.Context, *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error)
+	// The user's rating on each puzzle tag they have attempted.
+	GetPuzzleTagRatings(context.Context, *connect.Request[puzzle_service.PuzzleTagRatingsRequest]) (*connect.Response[puzzle_service.PuzzleTagRatingsResponse], error)
+	// The daily puzzle is the same for everyone playing a lexicon. Each user
+	// gets one attempt, which does not change any ratings.
+	GetDailyPuzzle(context.Context, *connect.Request[puzzle_service.DailyPuzzleRequest]) (*connect.Response[puzzle_service.DailyPuzzleResponse], error)
+	SubmitDailyPuzzleAnswer(context.Context, *connect.Request[puzzle_service.DailyPuzzleAnswerRequest]) (*connect.Response[puzzle_service.DailyPuzzleAnswerResponse], error)
+	GetDailyPuzzleArchive(context.Context, *connect.Request[puzzle_service.DailyPuzzleArchiveRequest]) (*connect.Response[puzzle_service.DailyPuzzleArchiveResponse], error)
+	// Schedules a puzzle as a future daily puzzle. Days without a scheduled
+	// puzzle get the best voted puzzle that hasn't been a daily puzzle yet.
+	SetDailyPuzzle(context.Context, *connect.Request[puzzle_service.SetDailyPuzzleRequest]) (*connect.Response[puzzle_service.SetDailyPuzzleResponse], error)
+	// Puzzle rush: solve as many puzzles as possible before time runs out or
+	// the user makes too many mistakes. Puzzles get harder as the score goes
+	// up, and rush puzzles do not change any ratings.
+	StartPuzzleRush(context.Context, *connect.Request[puzzle_service.StartPuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error)
+	GetPuzzleRush(context.Context, *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error)
+	SubmitPuzzleRushAnswer(context.Context, *connect.Request[puzzle_service.PuzzleRushAnswerRequest]) (*connect.Response[puzzle_service.PuzzleRushAnswerResponse], error)
+	EndPuzzleRush(context.Context, *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error)
+	GetPuzzleRushLeaderboard(context.Context, *connect.Request[puzzle_service.PuzzleRushLeaderboardRequest]) (*connect.Response[puzzle_service.PuzzleRushLeaderboardResponse], error)
 }
 
 // NewPuzzleServiceClient constructs a client for the puzzle_service.PuzzleService service. By
@@ -151,6 +252,132 @@ func NewPuzzleServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleJobLogs")),
 			connect.WithClientOptions(opts...),
 		),
+		queuePuzzleGeneration: connect.NewClient[puzzle_service.QueuePuzzleGenerationRequest, puzzle_service.QueuePuzzleGenerationResponse](
+			httpClient,
+			baseURL+PuzzleServiceQueuePuzzleGenerationProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("QueuePuzzleGeneration")),
+			connect.WithClientOptions(opts...),
+		),
+		approvePuzzleGenerationJob: connect.NewClient[puzzle_service.ApprovePuzzleGenerationJobRequest, puzzle_service.ApprovePuzzleGenerationJobResponse](
+			httpClient,
+			baseURL+PuzzleServiceApprovePuzzleGenerationJobProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("ApprovePuzzleGenerationJob")),
+			connect.WithClientOptions(opts...),
+		),
+		getReviewQueue: connect.NewClient[puzzle_service.ReviewQueueRequest, puzzle_service.ReviewQueueResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetReviewQueueProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetReviewQueue")),
+			connect.WithClientOptions(opts...),
+		),
+		startStudySession: connect.NewClient[puzzle_service.StudySessionRequest, puzzle_service.StudySessionResponse](
+			httpClient,
+			baseURL+PuzzleServiceStartStudySessionProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("StartStudySession")),
+			connect.WithClientOptions(opts...),
+		),
+		submitReview: connect.NewClient[puzzle_service.ReviewSubmissionRequest, puzzle_service.ReviewSubmissionResponse](
+			httpClient,
+			baseURL+PuzzleServiceSubmitReviewProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("SubmitReview")),
+			connect.WithClientOptions(opts...),
+		),
+		removeFromReviewQueue: connect.NewClient[puzzle_service.PuzzleRequest, puzzle_service.RemoveFromReviewQueueResponse](
+			httpClient,
+			baseURL+PuzzleServiceRemoveFromReviewQueueProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("RemoveFromReviewQueue")),
+			connect.WithClientOptions(opts...),
+		),
+		createPuzzleSet: connect.NewClient[puzzle_service.CreatePuzzleSetRequest, puzzle_service.PuzzleSetResponse](
+			httpClient,
+			baseURL+PuzzleServiceCreatePuzzleSetProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("CreatePuzzleSet")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePuzzleSet: connect.NewClient[puzzle_service.UpdatePuzzleSetRequest, puzzle_service.PuzzleSetResponse](
+			httpClient,
+			baseURL+PuzzleServiceUpdatePuzzleSetProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("UpdatePuzzleSet")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePuzzleSet: connect.NewClient[puzzle_service.PuzzleSetRequest, puzzle_service.DeletePuzzleSetResponse](
+			httpClient,
+			baseURL+PuzzleServiceDeletePuzzleSetProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("DeletePuzzleSet")),
+			connect.WithClientOptions(opts...),
+		),
+		getPuzzleSets: connect.NewClient[puzzle_service.PuzzleSetsRequest, puzzle_service.PuzzleSetsResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetPuzzleSetsProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleSets")),
+			connect.WithClientOptions(opts...),
+		),
+		getPuzzleSet: connect.NewClient[puzzle_service.PuzzleSetRequest, puzzle_service.PuzzleSetResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetPuzzleSetProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleSet")),
+			connect.WithClientOptions(opts...),
+		),
+		getPuzzleTagRatings: connect.NewClient[puzzle_service.PuzzleTagRatingsRequest, puzzle_service.PuzzleTagRatingsResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetPuzzleTagRatingsProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleTagRatings")),
+			connect.WithClientOptions(opts...),
+		),
+		getDailyPuzzle: connect.NewClient[puzzle_service.DailyPuzzleRequest, puzzle_service.DailyPuzzleResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetDailyPuzzleProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetDailyPuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		submitDailyPuzzleAnswer: connect.NewClient[puzzle_service.DailyPuzzleAnswerRequest, puzzle_service.DailyPuzzleAnswerResponse](
+			httpClient,
+			baseURL+PuzzleServiceSubmitDailyPuzzleAnswerProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("SubmitDailyPuzzleAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+		getDailyPuzzleArchive: connect.NewClient[puzzle_service.DailyPuzzleArchiveRequest, puzzle_service.DailyPuzzleArchiveResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetDailyPuzzleArchiveProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetDailyPuzzleArchive")),
+			connect.WithClientOptions(opts...),
+		),
+		setDailyPuzzle: connect.NewClient[puzzle_service.SetDailyPuzzleRequest, puzzle_service.SetDailyPuzzleResponse](
+			httpClient,
+			baseURL+PuzzleServiceSetDailyPuzzleProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("SetDailyPuzzle")),
+			connect.WithClientOptions(opts...),
+		),
+		startPuzzleRush: connect.NewClient[puzzle_service.StartPuzzleRushRequest, puzzle_service.PuzzleRushResponse](
+			httpClient,
+			baseURL+PuzzleServiceStartPuzzleRushProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("StartPuzzleRush")),
+			connect.WithClientOptions(opts...),
+		),
+		getPuzzleRush: connect.NewClient[puzzle_service.PuzzleRushRequest, puzzle_service.PuzzleRushResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetPuzzleRushProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleRush")),
+			connect.WithClientOptions(opts...),
+		),
+		submitPuzzleRushAnswer: connect.NewClient[puzzle_service.PuzzleRushAnswerRequest, puzzle_service.PuzzleRushAnswerResponse](
+			httpClient,
+			baseURL+PuzzleServiceSubmitPuzzleRushAnswerProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("SubmitPuzzleRushAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+		endPuzzleRush: connect.NewClient[puzzle_service.PuzzleRushRequest, puzzle_service.PuzzleRushResponse](
+			httpClient,
+			baseURL+PuzzleServiceEndPuzzleRushProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("EndPuzzleRush")),
+			connect.WithClientOptions(opts...),
+		),
+		getPuzzleRushLeaderboard: connect.NewClient[puzzle_service.PuzzleRushLeaderboardRequest, puzzle_service.PuzzleRushLeaderboardResponse](
+			httpClient,
+			baseURL+PuzzleServiceGetPuzzleRushLeaderboardProcedure,
+			connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleRushLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -166,6 +393,27 @@ type puzzleServiceClient struct {
 	setPuzzleVote                *connect.Client[puzzle_service.PuzzleVoteRequest, puzzle_service.PuzzleVoteResponse]
 	startPuzzleGenJob            *connect.Client[puzzle_service.APIPuzzleGenerationJobRequest, puzzle_service.APIPuzzleGenerationJobResponse]
 	getPuzzleJobLogs             *connect.Client[puzzle_service.PuzzleJobLogsRequest, puzzle_service.PuzzleJobLogsResponse]
+	queuePuzzleGeneration        *connect.Client[puzzle_service.QueuePuzzleGenerationRequest, puzzle_service.QueuePuzzleGenerationResponse]
+	approvePuzzleGenerationJob   *connect.Client[puzzle_service.ApprovePuzzleGenerationJobRequest, puzzle_service.ApprovePuzzleGenerationJobResponse]
+	getReviewQueue               *connect.Client[puzzle_service.ReviewQueueRequest, puzzle_service.ReviewQueueResponse]
+	startStudySession            *connect.Client[puzzle_service.StudySessionRequest, puzzle_service.StudySessionResponse]
+	submitReview                 *connect.Client[puzzle_service.ReviewSubmissionRequest, puzzle_service.ReviewSubmissionResponse]
+	removeFromReviewQueue        *connect.Client[puzzle_service.PuzzleRequest, puzzle_service.RemoveFromReviewQueueResponse]
+	createPuzzleSet              *connect.Client[puzzle_service.CreatePuzzleSetRequest, puzzle_service.PuzzleSetResponse]
+	updatePuzzleSet              *connect.Client[puzzle_service.UpdatePuzzleSetRequest, puzzle_service.PuzzleSetResponse]
+	deletePuzzleSet              *connect.Client[puzzle_service.PuzzleSetRequest, puzzle_service.DeletePuzzleSetResponse]
+	getPuzzleSets                *connect.Client[puzzle_service.PuzzleSetsRequest, puzzle_service.PuzzleSetsResponse]
+	getPuzzleSet                 *connect.Client[puzzle_service.PuzzleSetRequest, puzzle_service.PuzzleSetResponse]
+	getPuzzleTagRatings          *connect.Client[puzzle_service.PuzzleTagRatingsRequest, puzzle_service.PuzzleTagRatingsResponse]
+	getDailyPuzzle               *connect.Client[puzzle_service.DailyPuzzleRequest, puzzle_service.DailyPuzzleResponse]
+	submitDailyPuzzleAnswer      *connect.Client[puzzle_service.DailyPuzzleAnswerRequest, puzzle_service.DailyPuzzleAnswerResponse]
+	getDailyPuzzleArchive        *connect.Client[puzzle_service.DailyPuzzleArchiveRequest, puzzle_service.DailyPuzzleArchiveResponse]
+	setDailyPuzzle               *connect.Client[puzzle_service.SetDailyPuzzleRequest, puzzle_service.SetDailyPuzzleResponse]
+	startPuzzleRush              *connect.Client[puzzle_service.StartPuzzleRushRequest, puzzle_service.PuzzleRushResponse]
+	getPuzzleRush                *connect.Client[puzzle_service.PuzzleRushRequest, puzzle_service.PuzzleRushResponse]
+	submitPuzzleRushAnswer       *connect.Client[puzzle_service.PuzzleRushAnswerRequest, puzzle_service.PuzzleRushAnswerResponse]
+	endPuzzleRush                *connect.Client[puzzle_service.PuzzleRushRequest, puzzle_service.PuzzleRushResponse]
+	getPuzzleRushLeaderboard     *connect.Client[puzzle_service.PuzzleRushLeaderboardRequest, puzzle_service.PuzzleRushLeaderboardResponse]
 }
 
 // GetStartPuzzleId calls puzzle_service.PuzzleService.GetStartPuzzleId.
@@ -218,6 +466,111 @@ func (c *puzzleServiceClient) GetPuzzleJobLogs(ctx context.Context, req *connect
 	return c.getPuzzleJobLogs.CallUnary(ctx, req)
 }
 
+// QueuePuzzleGeneration calls puzzle_service.PuzzleService.QueuePuzzleGeneration.
+func (c *puzzleServiceClient) QueuePuzzleGeneration(ctx context.Context, req *connect.Request[puzzle_service.QueuePuzzleGenerationRequest]) (*connect.Response[puzzle_service.QueuePuzzleGenerationResponse], error) {
+	return c.queuePuzzleGeneration.CallUnary(ctx, req)
+}
+
+// ApprovePuzzleGenerationJob calls puzzle_service.PuzzleService.ApprovePuzzleGenerationJob.
+func (c *puzzleServiceClient) ApprovePuzzleGenerationJob(ctx context.Context, req *connect.Request[puzzle_service.ApprovePuzzleGenerationJobRequest]) (*connect.Response[puzzle_service.ApprovePuzzleGenerationJobResponse], error) {
+	return c.approvePuzzleGenerationJob.CallUnary(ctx, req)
+}
+
+// GetReviewQueue calls puzzle_service.PuzzleService.GetReviewQueue.
+func (c *puzzleServiceClient) GetReviewQueue(ctx context.Context, req *connect.Request[puzzle_service.ReviewQueueRequest]) (*connect.Response[puzzle_service.ReviewQueueResponse], error) {
+	return c.getReviewQueue.CallUnary(ctx, req)
+}
+
+// StartStudySession calls puzzle_service.PuzzleService.StartStudySession.
+func (c *puzzleServiceClient) StartStudySession(ctx context.Context, req *connect.Request[puzzle_service.StudySessionRequest]) (*connect.Response[puzzle_service.StudySessionResponse], error) {
+	return c.startStudySession.CallUnary(ctx, req)
+}
+
+// SubmitReview calls puzzle_service.PuzzleService.SubmitReview.
+func (c *puzzleServiceClient) SubmitReview(ctx context.Context, req *connect.Request[puzzle_service.ReviewSubmissionRequest]) (*connect.Response[puzzle_service.ReviewSubmissionResponse], error) {
+	return c.submitReview.CallUnary(ctx, req)
+}
+
+// RemoveFromReviewQueue calls puzzle_service.PuzzleService.RemoveFromReviewQueue.
+func (c *puzzleServiceClient) RemoveFromReviewQueue(ctx context.Context, req *connect.Request[puzzle_service.PuzzleRequest]) (*connect.Response[puzzle_service.RemoveFromReviewQueueResponse], error) {
+	return c.removeFromReviewQueue.CallUnary(ctx, req)
+}
+
+// CreatePuzzleSet calls puzzle_service.PuzzleService.CreatePuzzleSet.
+func (c *puzzleServiceClient) CreatePuzzleSet(ctx context.Context, req *connect.Request[puzzle_service.CreatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error) {
+	return c.createPuzzleSet.CallUnary(ctx, req)
+}
+
+// UpdatePuzzleSet calls puzzle_service.PuzzleService.UpdatePuzzleSet.
+func (c *puzzleServiceClient) UpdatePuzzleSet(ctx context.Context, req *connect.Request[puzzle_service.UpdatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error) {
+	return c.updatePuzzleSet.CallUnary(ctx, req)
+}
+
+// DeletePuzzleSet calls puzzle_service.PuzzleService.DeletePuzzleSet.
+func (c *puzzleServiceClient) DeletePuzzleSet(ctx context.Context, req *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.DeletePuzzleSetResponse], error) {
+	return c.deletePuzzleSet.CallUnary(ctx, req)
+}
+
+// GetPuzzleSets calls puzzle_service.PuzzleService.GetPuzzleSets.
+func (c *puzzleServiceClient) GetPuzzleSets(ctx context.Context, req *connect.Request[puzzle_service.PuzzleSetsRequest]) (*connect.Response[puzzle_service.PuzzleSetsResponse], error) {
+	return c.getPuzzleSets.CallUnary(ctx, req)
+}
+
+// GetPuzzleSet calls puzzle_service.PuzzleService.GetPuzzleSet.
+func (c *puzzleServiceClient) GetPuzzleSet(ctx context.Context, req *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error) {
+	return c.getPuzzleSet.CallUnary(ctx, req)
+}
+
+// GetPuzzleTagRatings calls puzzle_service.PuzzleService.GetPuzzleTagRatings.
+func (c *puzzleServiceClient) GetPuzzleTagRatings(ctx context.Context, req *connect.Request[puzzle_service.PuzzleTagRatingsRequest]) (*connect.Response[puzzle_service.PuzzleTagRatingsResponse], error) {
+	return c.getPuzzleTagRatings.CallUnary(ctx, req)
+}
+
+// GetDailyPuzzle calls puzzle_service.PuzzleService.GetDailyPuzzle.
+func (c *puzzleServiceClient) GetDailyPuzzle(ctx context.Context, req *connect.Request[puzzle_service.DailyPuzzleRequest]) (*connect.Response[puzzle_service.DailyPuzzleResponse], error) {
+	return c.getDailyPuzzle.CallUnary(ctx, req)
+}
+
+// SubmitDailyPuzzleAnswer calls puzzle_service.PuzzleService.SubmitDailyPuzzleAnswer.
+func (c *puzzleServiceClient) SubmitDailyPuzzleAnswer(ctx context.Context, req *connect.Request[puzzle_service.DailyPuzzleAnswerRequest]) (*connect.Response[puzzle_service.DailyPuzzleAnswerResponse], error) {
+	return c.submitDailyPuzzleAnswer.CallUnary(ctx, req)
+}
+
+// GetDailyPuzzleArchive calls puzzle_service.PuzzleService.GetDailyPuzzleArchive.
+func (c *puzzleServiceClient) GetDailyPuzzleArchive(ctx context.Context, req *connect.Request[puzzle_service.DailyPuzzleArchiveRequest]) (*connect.Response[puzzle_service.DailyPuzzleArchiveResponse], error) {
+	return c.getDailyPuzzleArchive.CallUnary(ctx, req)
+}
+
+// SetDailyPuzzle calls puzzle_service.PuzzleService.SetDailyPuzzle.
+func (c *puzzleServiceClient) SetDailyPuzzle(ctx context.Context, req *connect.Request[puzzle_service.SetDailyPuzzleRequest]) (*connect.Response[puzzle_service.SetDailyPuzzleResponse], error) {
+	return c.setDailyPuzzle.CallUnary(ctx, req)
+}
+
+// StartPuzzleRush calls puzzle_service.PuzzleService.StartPuzzleRush.
+func (c *puzzleServiceClient) StartPuzzleRush(ctx context.Context, req *connect.Request[puzzle_service.StartPuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error) {
+	return c.startPuzzleRush.CallUnary(ctx, req)
+}
+
+// GetPuzzleRush calls puzzle_service.PuzzleService.GetPuzzleRush.
+func (c *puzzleServiceClient) GetPuzzleRush(ctx context.Context, req *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error) {
+	return c.getPuzzleRush.CallUnary(ctx, req)
+}
+
+// SubmitPuzzleRushAnswer calls puzzle_service.PuzzleService.SubmitPuzzleRushAnswer.
+func (c *puzzleServiceClient) SubmitPuzzleRushAnswer(ctx context.Context, req *connect.Request[puzzle_service.PuzzleRushAnswerRequest]) (*connect.Response[puzzle_service.PuzzleRushAnswerResponse], error) {
+	return c.submitPuzzleRushAnswer.CallUnary(ctx, req)
+}
+
+// EndPuzzleRush calls puzzle_service.PuzzleService.EndPuzzleRush.
+func (c *puzzleServiceClient) EndPuzzleRush(ctx context.Context, req *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error) {
+	return c.endPuzzleRush.CallUnary(ctx, req)
+}
+
+// GetPuzzleRushLeaderboard calls puzzle_service.PuzzleService.GetPuzzleRushLeaderboard.
+func (c *puzzleServiceClient) GetPuzzleRushLeaderboard(ctx context.Context, req *connect.Request[puzzle_service.PuzzleRushLeaderboardRequest]) (*connect.Response[puzzle_service.PuzzleRushLeaderboardResponse], error) {
+	return c.getPuzzleRushLeaderboard.CallUnary(ctx, req)
+}
+
 // PuzzleServiceHandler is an implementation of the puzzle_service.PuzzleService service.
 type PuzzleServiceHandler interface {
 	GetStartPuzzleId(context.Context, *connect.Request[puzzle_service.StartPuzzleIdRequest]) (*connect.Response[puzzle_service.StartPuzzleIdResponse], error)
@@ -232,6 +585,44 @@ type PuzzleServiceHandler interface {
 	SetPuzzleVote(context.Context, *connect.Request[puzzle_service.PuzzleVoteRequest]) (*connect.Response[puzzle_service.PuzzleVoteResponse], error)
 	StartPuzzleGenJob(context.Context, *connect.Request[puzzle_service.APIPuzzleGenerationJobRequest]) (*connect.Response[puzzle_service.APIPuzzleGenerationJobResponse], error)
 	GetPuzzleJobLogs(context.Context, *connect.Request[puzzle_service.PuzzleJobLogsRequest]) (*connect.Response[puzzle_service.PuzzleJobLogsResponse], error)
+	// QueuePuzzleGeneration queues generation from the user's own annotated
+	// games for the local puzzle worker (cmd/puzzle-worker). Unless the user
+	// can create puzzles, the job needs approval.
+	QueuePuzzleGeneration(context.Context, *connect.Request[puzzle_service.QueuePuzzleGenerationRequest]) (*connect.Response[puzzle_service.QueuePuzzleGenerationResponse], error)
+	// ApprovePuzzleGenerationJob makes the puzzles of a job that needed
+	// approval available to everyone.
+	ApprovePuzzleGenerationJob(context.Context, *connect.Request[puzzle_service.ApprovePuzzleGenerationJobRequest]) (*connect.Response[puzzle_service.ApprovePuzzleGenerationJobResponse], error)
+	// Spaced-repetition training over the puzzles a user failed or was slow
+	// to solve. Reviews do not change puzzle or user ratings.
+	GetReviewQueue(context.Context, *connect.Request[puzzle_service.ReviewQueueRequest]) (*connect.Response[puzzle_service.ReviewQueueResponse], error)
+	StartStudySession(context.Context, *connect.Request[puzzle_service.StudySessionRequest]) (*connect.Response[puzzle_service.StudySessionResponse], error)
+	SubmitReview(context.Context, *connect.Request[puzzle_service.ReviewSubmissionRequest]) (*connect.Response[puzzle_service.ReviewSubmissionResponse], error)
+	RemoveFromReviewQueue(context.Context, *connect.Request[puzzle_service.PuzzleRequest]) (*connect.Response[puzzle_service.RemoveFromReviewQueueResponse], error)
+	// Curated puzzle sets. Only puzzle creators can create, update or
+	// delete them.
+	CreatePuzzleSet(context.Context, *connect.Request[puzzle_service.CreatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error)
+	UpdatePuzzleSet(context.Context, *connect.Request[puzzle_service.UpdatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error)
+	DeletePuzzleSet(context.Context, *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.DeletePuzzleSetResponse], error)
+	GetPuzzleSets(context.Context, *connect.Request[puzzle_service.PuzzleSetsRequest]) (*connect.Response[puzzle_service.PuzzleSetsResponse], error)
+	GetPuzzleSet(context.Context, *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error)
+	// The user's rating on each puzzle tag they have attempted.
+	GetPuzzleTagRatings(context.Context, *connect.Request[puzzle_service.PuzzleTagRatingsRequest]) (*connect.Response[puzzle_service.PuzzleTagRatingsResponse], error)
+	// The daily puzzle is the same for everyone playing a lexicon. Each user
+	// gets one attempt, which does not change any ratings.
+	GetDailyPuzzle(context.Context, *connect.Request[puzzle_service.DailyPuzzleRequest]) (*connect.Response[puzzle_service.DailyPuzzleResponse], error)
+	SubmitDailyPuzzleAnswer(context.Context, *connect.Request[puzzle_service.DailyPuzzleAnswerRequest]) (*connect.Response[puzzle_service.DailyPuzzleAnswerResponse], error)
+	GetDailyPuzzleArchive(context.Context, *connect.Request[puzzle_service.DailyPuzzleArchiveRequest]) (*connect.Response[puzzle_service.DailyPuzzleArchiveResponse], error)
+	// Schedules a puzzle as a future daily puzzle. Days without a scheduled
+	// puzzle get the best voted puzzle that hasn't been a daily puzzle yet.
+	SetDailyPuzzle(context.Context, *connect.Request[puzzle_service.SetDailyPuzzleRequest]) (*connect.Response[puzzle_service.SetDailyPuzzleResponse], error)
+	// Puzzle rush: solve as many puzzles as possible before time runs out or
+	// the user makes too many mistakes. Puzzles get harder as the score goes
+	// up, and rush puzzles do not change any ratings.
+	StartPuzzleRush(context.Context, *connect.Request[puzzle_service.StartPuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error)
+	GetPuzzleRush(context.Context, *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error)
+	SubmitPuzzleRushAnswer(context.Context, *connect.Request[puzzle_service.PuzzleRushAnswerRequest]) (*connect.Response[puzzle_service.PuzzleRushAnswerResponse], error)
+	EndPuzzleRush(context.Context, *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error)
+	GetPuzzleRushLeaderboard(context.Context, *connect.Request[puzzle_service.PuzzleRushLeaderboardRequest]) (*connect.Response[puzzle_service.PuzzleRushLeaderboardResponse], error)
 }
 
 // NewPuzzleServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -301,6 +692,132 @@ func NewPuzzleServiceHandler(svc PuzzleServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleJobLogs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	puzzleServiceQueuePuzzleGenerationHandler := connect.NewUnaryHandler(
+		PuzzleServiceQueuePuzzleGenerationProcedure,
+		svc.QueuePuzzleGeneration,
+		connect.WithSchema(puzzleServiceMethods.ByName("QueuePuzzleGeneration")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceApprovePuzzleGenerationJobHandler := connect.NewUnaryHandler(
+		PuzzleServiceApprovePuzzleGenerationJobProcedure,
+		svc.ApprovePuzzleGenerationJob,
+		connect.WithSchema(puzzleServiceMethods.ByName("ApprovePuzzleGenerationJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetReviewQueueHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetReviewQueueProcedure,
+		svc.GetReviewQueue,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetReviewQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceStartStudySessionHandler := connect.NewUnaryHandler(
+		PuzzleServiceStartStudySessionProcedure,
+		svc.StartStudySession,
+		connect.WithSchema(puzzleServiceMethods.ByName("StartStudySession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceSubmitReviewHandler := connect.NewUnaryHandler(
+		PuzzleServiceSubmitReviewProcedure,
+		svc.SubmitReview,
+		connect.WithSchema(puzzleServiceMethods.ByName("SubmitReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceRemoveFromReviewQueueHandler := connect.NewUnaryHandler(
+		PuzzleServiceRemoveFromReviewQueueProcedure,
+		svc.RemoveFromReviewQueue,
+		connect.WithSchema(puzzleServiceMethods.ByName("RemoveFromReviewQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceCreatePuzzleSetHandler := connect.NewUnaryHandler(
+		PuzzleServiceCreatePuzzleSetProcedure,
+		svc.CreatePuzzleSet,
+		connect.WithSchema(puzzleServiceMethods.ByName("CreatePuzzleSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceUpdatePuzzleSetHandler := connect.NewUnaryHandler(
+		PuzzleServiceUpdatePuzzleSetProcedure,
+		svc.UpdatePuzzleSet,
+		connect.WithSchema(puzzleServiceMethods.ByName("UpdatePuzzleSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceDeletePuzzleSetHandler := connect.NewUnaryHandler(
+		PuzzleServiceDeletePuzzleSetProcedure,
+		svc.DeletePuzzleSet,
+		connect.WithSchema(puzzleServiceMethods.ByName("DeletePuzzleSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetPuzzleSetsHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetPuzzleSetsProcedure,
+		svc.GetPuzzleSets,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleSets")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetPuzzleSetHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetPuzzleSetProcedure,
+		svc.GetPuzzleSet,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetPuzzleTagRatingsHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetPuzzleTagRatingsProcedure,
+		svc.GetPuzzleTagRatings,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleTagRatings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetDailyPuzzleHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetDailyPuzzleProcedure,
+		svc.GetDailyPuzzle,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetDailyPuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceSubmitDailyPuzzleAnswerHandler := connect.NewUnaryHandler(
+		PuzzleServiceSubmitDailyPuzzleAnswerProcedure,
+		svc.SubmitDailyPuzzleAnswer,
+		connect.WithSchema(puzzleServiceMethods.ByName("SubmitDailyPuzzleAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetDailyPuzzleArchiveHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetDailyPuzzleArchiveProcedure,
+		svc.GetDailyPuzzleArchive,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetDailyPuzzleArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceSetDailyPuzzleHandler := connect.NewUnaryHandler(
+		PuzzleServiceSetDailyPuzzleProcedure,
+		svc.SetDailyPuzzle,
+		connect.WithSchema(puzzleServiceMethods.ByName("SetDailyPuzzle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceStartPuzzleRushHandler := connect.NewUnaryHandler(
+		PuzzleServiceStartPuzzleRushProcedure,
+		svc.StartPuzzleRush,
+		connect.WithSchema(puzzleServiceMethods.ByName("StartPuzzleRush")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetPuzzleRushHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetPuzzleRushProcedure,
+		svc.GetPuzzleRush,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleRush")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceSubmitPuzzleRushAnswerHandler := connect.NewUnaryHandler(
+		PuzzleServiceSubmitPuzzleRushAnswerProcedure,
+		svc.SubmitPuzzleRushAnswer,
+		connect.WithSchema(puzzleServiceMethods.ByName("SubmitPuzzleRushAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceEndPuzzleRushHandler := connect.NewUnaryHandler(
+		PuzzleServiceEndPuzzleRushProcedure,
+		svc.EndPuzzleRush,
+		connect.WithSchema(puzzleServiceMethods.ByName("EndPuzzleRush")),
+		connect.WithHandlerOptions(opts...),
+	)
+	puzzleServiceGetPuzzleRushLeaderboardHandler := connect.NewUnaryHandler(
+		PuzzleServiceGetPuzzleRushLeaderboardProcedure,
+		svc.GetPuzzleRushLeaderboard,
+		connect.WithSchema(puzzleServiceMethods.ByName("GetPuzzleRushLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/puzzle_service.PuzzleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PuzzleServiceGetStartPuzzleIdProcedure:
@@ -323,6 +840,48 @@ func NewPuzzleServiceHandler(svc PuzzleServiceHandler, opts ...connect.HandlerOp
 			puzzleServiceStartPuzzleGenJobHandler.ServeHTTP(w, r)
 		case PuzzleServiceGetPuzzleJobLogsProcedure:
 			puzzleServiceGetPuzzleJobLogsHandler.ServeHTTP(w, r)
+		case PuzzleServiceQueuePuzzleGenerationProcedure:
+			puzzleServiceQueuePuzzleGenerationHandler.ServeHTTP(w, r)
+		case PuzzleServiceApprovePuzzleGenerationJobProcedure:
+			puzzleServiceApprovePuzzleGenerationJobHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetReviewQueueProcedure:
+			puzzleServiceGetReviewQueueHandler.ServeHTTP(w, r)
+		case PuzzleServiceStartStudySessionProcedure:
+			puzzleServiceStartStudySessionHandler.ServeHTTP(w, r)
+		case PuzzleServiceSubmitReviewProcedure:
+			puzzleServiceSubmitReviewHandler.ServeHTTP(w, r)
+		case PuzzleServiceRemoveFromReviewQueueProcedure:
+			puzzleServiceRemoveFromReviewQueueHandler.ServeHTTP(w, r)
+		case PuzzleServiceCreatePuzzleSetProcedure:
+			puzzleServiceCreatePuzzleSetHandler.ServeHTTP(w, r)
+		case PuzzleServiceUpdatePuzzleSetProcedure:
+			puzzleServiceUpdatePuzzleSetHandler.ServeHTTP(w, r)
+		case PuzzleServiceDeletePuzzleSetProcedure:
+			puzzleServiceDeletePuzzleSetHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetPuzzleSetsProcedure:
+			puzzleServiceGetPuzzleSetsHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetPuzzleSetProcedure:
+			puzzleServiceGetPuzzleSetHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetPuzzleTagRatingsProcedure:
+			puzzleServiceGetPuzzleTagRatingsHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetDailyPuzzleProcedure:
+			puzzleServiceGetDailyPuzzleHandler.ServeHTTP(w, r)
+		case PuzzleServiceSubmitDailyPuzzleAnswerProcedure:
+			puzzleServiceSubmitDailyPuzzleAnswerHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetDailyPuzzleArchiveProcedure:
+			puzzleServiceGetDailyPuzzleArchiveHandler.ServeHTTP(w, r)
+		case PuzzleServiceSetDailyPuzzleProcedure:
+			puzzleServiceSetDailyPuzzleHandler.ServeHTTP(w, r)
+		case PuzzleServiceStartPuzzleRushProcedure:
+			puzzleServiceStartPuzzleRushHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetPuzzleRushProcedure:
+			puzzleServiceGetPuzzleRushHandler.ServeHTTP(w, r)
+		case PuzzleServiceSubmitPuzzleRushAnswerProcedure:
+			puzzleServiceSubmitPuzzleRushAnswerHandler.ServeHTTP(w, r)
+		case PuzzleServiceEndPuzzleRushProcedure:
+			puzzleServiceEndPuzzleRushHandler.ServeHTTP(w, r)
+		case PuzzleServiceGetPuzzleRushLeaderboardProcedure:
+			puzzleServiceGetPuzzleRushLeaderboardHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -371,3 +930,87 @@ func (UnimplementedPuzzleServiceHandler) StartPuzzleGenJob(context.Context, *con
 func (UnimplementedPuzzleServiceHandler) GetPuzzleJobLogs(context.Context, *connect.Request[puzzle_service.PuzzleJobLogsRequest]) (*connect.Response[puzzle_service.PuzzleJobLogsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetPuzzleJobLogs is not implemented"))
 }
+
+func (UnimplementedPuzzleServiceHandler) QueuePuzzleGeneration(context.Context, *connect.Request[puzzle_service.QueuePuzzleGenerationRequest]) (*connect.Response[puzzle_service.QueuePuzzleGenerationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.QueuePuzzleGeneration is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) ApprovePuzzleGenerationJob(context.Context, *connect.Request[puzzle_service.ApprovePuzzleGenerationJobRequest]) (*connect.Response[puzzle_service.ApprovePuzzleGenerationJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.ApprovePuzzleGenerationJob is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetReviewQueue(context.Context, *connect.Request[puzzle_service.ReviewQueueRequest]) (*connect.Response[puzzle_service.ReviewQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetReviewQueue is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) StartStudySession(context.Context, *connect.Request[puzzle_service.StudySessionRequest]) (*connect.Response[puzzle_service.StudySessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.StartStudySession is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) SubmitReview(context.Context, *connect.Request[puzzle_service.ReviewSubmissionRequest]) (*connect.Response[puzzle_service.ReviewSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.SubmitReview is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) RemoveFromReviewQueue(context.Context, *connect.Request[puzzle_service.PuzzleRequest]) (*connect.Response[puzzle_service.RemoveFromReviewQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.RemoveFromReviewQueue is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) CreatePuzzleSet(context.Context, *connect.Request[puzzle_service.CreatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.CreatePuzzleSet is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) UpdatePuzzleSet(context.Context, *connect.Request[puzzle_service.UpdatePuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.UpdatePuzzleSet is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) DeletePuzzleSet(context.Context, *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.DeletePuzzleSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.DeletePuzzleSet is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetPuzzleSets(context.Context, *connect.Request[puzzle_service.PuzzleSetsRequest]) (*connect.Response[puzzle_service.PuzzleSetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetPuzzleSets is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetPuzzleSet(context.Context, *connect.Request[puzzle_service.PuzzleSetRequest]) (*connect.Response[puzzle_service.PuzzleSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetPuzzleSet is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetPuzzleTagRatings(context.Context, *connect.Request[puzzle_service.PuzzleTagRatingsRequest]) (*connect.Response[puzzle_service.PuzzleTagRatingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetPuzzleTagRatings is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetDailyPuzzle(context.Context, *connect.Request[puzzle_service.DailyPuzzleRequest]) (*connect.Response[puzzle_service.DailyPuzzleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetDailyPuzzle is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) SubmitDailyPuzzleAnswer(context.Context, *connect.Request[puzzle_service.DailyPuzzleAnswerRequest]) (*connect.Response[puzzle_service.DailyPuzzleAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.SubmitDailyPuzzleAnswer is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetDailyPuzzleArchive(context.Context, *connect.Request[puzzle_service.DailyPuzzleArchiveRequest]) (*connect.Response[puzzle_service.DailyPuzzleArchiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetDailyPuzzleArchive is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) SetDailyPuzzle(context.Context, *connect.Request[puzzle_service.SetDailyPuzzleRequest]) (*connect.Response[puzzle_service.SetDailyPuzzleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.SetDailyPuzzle is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) StartPuzzleRush(context.Context, *connect.Request[puzzle_service.StartPuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.StartPuzzleRush is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetPuzzleRush(context.Context, *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetPuzzleRush is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) SubmitPuzzleRushAnswer(context.Context, *connect.Request[puzzle_service.PuzzleRushAnswerRequest]) (*connect.Response[puzzle_service.PuzzleRushAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.SubmitPuzzleRushAnswer is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) EndPuzzleRush(context.Context, *connect.Request[puzzle_service.PuzzleRushRequest]) (*connect.Response[puzzle_service.PuzzleRushResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.EndPuzzleRush is not implemented"))
+}
+
+func (UnimplementedPuzzleServiceHandler) GetPuzzleRushLeaderboard(context.Context, *connect.Request[puzzle_service.PuzzleRushLeaderboardRequest]) (*connect.Response[puzzle_service.PuzzleRushLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("puzzle_service.PuzzleService.GetPuzzleRushLeaderboard is not implemented"))
+}
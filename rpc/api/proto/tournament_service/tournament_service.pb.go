@@ -3171,6 +3171,234 @@ func (x *GetTournamentMonitoringResponse) GetParticipants() []*ipc.MonitoringDat
 	return nil
 }
 
+type UploadPrivateLexiconRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	// name may have up to 16 letters and digits. The lexicon is called
+	// PRIV_<name>.
+	Name               string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LetterDistribution string `protobuf:"bytes,3,opt,name=letter_distribution,json=letterDistribution,proto3" json:"letter_distribution,omitempty"`
+	// word_list has one word per line. Anything after the word on a line is
+	// ignored, as are empty lines and lines starting with #.
+	WordList      string `protobuf:"bytes,4,opt,name=word_list,json=wordList,proto3" json:"word_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPrivateLexiconRequest) Reset() {
+	*x = UploadPrivateLexiconRequest{}
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPrivateLexiconRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPrivateLexiconRequest) ProtoMessage() {}
+
+func (x *UploadPrivateLexiconRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPrivateLexiconRequest.ProtoReflect.Descriptor instead.
+func (*UploadPrivateLexiconRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UploadPrivateLexiconRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+func (x *UploadPrivateLexiconRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadPrivateLexiconRequest) GetLetterDistribution() string {
+	if x != nil {
+		return x.LetterDistribution
+	}
+	return ""
+}
+
+func (x *UploadPrivateLexiconRequest) GetWordList() string {
+	if x != nil {
+		return x.WordList
+	}
+	return ""
+}
+
+type PrivateLexicon struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LetterDistribution string                 `protobuf:"bytes,2,opt,name=letter_distribution,json=letterDistribution,proto3" json:"letter_distribution,omitempty"`
+	WordCount          int32                  `protobuf:"varint,3,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PrivateLexicon) Reset() {
+	*x = PrivateLexicon{}
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateLexicon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateLexicon) ProtoMessage() {}
+
+func (x *PrivateLexicon) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateLexicon.ProtoReflect.Descriptor instead.
+func (*PrivateLexicon) Descriptor() ([]byte, []int) {
+	return file_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PrivateLexicon) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrivateLexicon) GetLetterDistribution() string {
+	if x != nil {
+		return x.LetterDistribution
+	}
+	return ""
+}
+
+func (x *PrivateLexicon) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *PrivateLexicon) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPrivateLexicaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId  string                 `protobuf:"bytes,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivateLexicaRequest) Reset() {
+	*x = GetPrivateLexicaRequest{}
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivateLexicaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivateLexicaRequest) ProtoMessage() {}
+
+func (x *GetPrivateLexicaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivateLexicaRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivateLexicaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetPrivateLexicaRequest) GetTournamentId() string {
+	if x != nil {
+		return x.TournamentId
+	}
+	return ""
+}
+
+type GetPrivateLexicaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexica        []*PrivateLexicon      `protobuf:"bytes,1,rep,name=lexica,proto3" json:"lexica,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivateLexicaResponse) Reset() {
+	*x = GetPrivateLexicaResponse{}
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivateLexicaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivateLexicaResponse) ProtoMessage() {}
+
+func (x *GetPrivateLexicaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tournament_service_tournament_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivateLexicaResponse.ProtoReflect.Descriptor instead.
+func (*GetPrivateLexicaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_tournament_service_tournament_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetPrivateLexicaResponse) GetLexica() []*PrivateLexicon {
+	if x != nil {
+		return x.Lexica
+	}
+	return nil
+}
+
 var File_proto_tournament_service_tournament_service_proto protoreflect.FileDescriptor
 
 const file_proto_tournament_service_tournament_service_proto_rawDesc = "" +
@@ -3385,13 +3613,29 @@ const file_proto_tournament_service_tournament_service_proto_rawDesc = "" +
 	"\x1eGetTournamentMonitoringRequest\x12#\n" +
 	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\"Z\n" +
 	"\x1fGetTournamentMonitoringResponse\x127\n" +
-	"\fparticipants\x18\x01 \x03(\v2\x13.ipc.MonitoringDataR\fparticipants*6\n" +
+	"\fparticipants\x18\x01 \x03(\v2\x13.ipc.MonitoringDataR\fparticipants\"\xa4\x01\n" +
+	"\x1bUploadPrivateLexiconRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12/\n" +
+	"\x13letter_distribution\x18\x03 \x01(\tR\x12letterDistribution\x12\x1b\n" +
+	"\tword_list\x18\x04 \x01(\tR\bwordList\"\xaf\x01\n" +
+	"\x0ePrivateLexicon\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x13letter_distribution\x18\x02 \x01(\tR\x12letterDistribution\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x03 \x01(\x05R\twordCount\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\x17GetPrivateLexicaRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\tR\ftournamentId\"V\n" +
+	"\x18GetPrivateLexicaResponse\x12:\n" +
+	"\x06lexica\x18\x01 \x03(\v2\".tournament_service.PrivateLexiconR\x06lexica*6\n" +
 	"\x05TType\x12\f\n" +
 	"\bSTANDARD\x10\x00\x12\b\n" +
 	"\x04CLUB\x10\x01\x12\t\n" +
 	"\x05CHILD\x10\x02\x12\n" +
 	"\n" +
-	"\x06LEGACY\x10\x032\xc2#\n" +
+	"\x06LEGACY\x10\x032\xa3%\n" +
 	"\x11TournamentService\x12d\n" +
 	"\rNewTournament\x12(.tournament_service.NewTournamentRequest\x1a).tournament_service.NewTournamentResponse\x12~\n" +
 	"\x15GetTournamentMetadata\x120.tournament_service.GetTournamentMetadataRequest\x1a..tournament_service.TournamentMetadataResponse\"\x03\x90\x02\x01\x12\\\n" +
@@ -3438,7 +3682,9 @@ const file_proto_tournament_service_tournament_service_proto_rawDesc = "" +
 	"\x18InitializeMonitoringKeys\x123.tournament_service.InitializeMonitoringKeysRequest\x1a&.tournament_service.TournamentResponse\x12u\n" +
 	"\x17RequestMonitoringStream\x122.tournament_service.RequestMonitoringStreamRequest\x1a&.tournament_service.TournamentResponse\x12q\n" +
 	"\x15ResetMonitoringStream\x120.tournament_service.ResetMonitoringStreamRequest\x1a&.tournament_service.TournamentResponse\x12\x87\x01\n" +
-	"\x17GetTournamentMonitoring\x122.tournament_service.GetTournamentMonitoringRequest\x1a3.tournament_service.GetTournamentMonitoringResponse\"\x03\x90\x02\x01B\xd4\x01\n" +
+	"\x17GetTournamentMonitoring\x122.tournament_service.GetTournamentMonitoringRequest\x1a3.tournament_service.GetTournamentMonitoringResponse\"\x03\x90\x02\x01\x12k\n" +
+	"\x14UploadPrivateLexicon\x12/.tournament_service.UploadPrivateLexiconRequest\x1a\".tournament_service.PrivateLexicon\x12r\n" +
+	"\x10GetPrivateLexica\x12+.tournament_service.GetPrivateLexicaRequest\x1a,.tournament_service.GetPrivateLexicaResponse\"\x03\x90\x02\x01B\xd4\x01\n" +
 	"\x16com.tournament_serviceB\x16TournamentServiceProtoP\x01Z>github.com/woogles-io/liwords/rpc/api/proto/tournament_service\xa2\x02\x03TXX\xaa\x02\x11TournamentService\xca\x02\x11TournamentService\xe2\x02\x1dTournamentService\\GPBMetadata\xea\x02\x11TournamentServiceb\x06proto3"
 
 var (
@@ -3454,7 +3700,7 @@ func file_proto_tournament_service_tournament_service_proto_rawDescGZIP() []byte
 }
 
 var file_proto_tournament_service_tournament_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_tournament_service_tournament_service_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_proto_tournament_service_tournament_service_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_proto_tournament_service_tournament_service_proto_goTypes = []any{
 	(TType)(0),                                      // 0: tournament_service.TType
 	(*StartRoundRequest)(nil),                       // 1: tournament_service.StartRoundRequest
@@ -3509,136 +3755,146 @@ var file_proto_tournament_service_tournament_service_proto_goTypes = []any{
 	(*ResetMonitoringStreamRequest)(nil),            // 50: tournament_service.ResetMonitoringStreamRequest
 	(*GetTournamentMonitoringRequest)(nil),          // 51: tournament_service.GetTournamentMonitoringRequest
 	(*GetTournamentMonitoringResponse)(nil),         // 52: tournament_service.GetTournamentMonitoringResponse
-	(*timestamppb.Timestamp)(nil),                   // 53: google.protobuf.Timestamp
-	(*ipc.GameRequest)(nil),                         // 54: ipc.GameRequest
-	(*ipc.RoundControl)(nil),                        // 55: ipc.RoundControl
-	(ipc.TournamentGameResult)(0),                   // 56: ipc.TournamentGameResult
-	(ipc.GameEndReason)(0),                          // 57: ipc.GameEndReason
-	(*ipc.TournamentGameEndedEvent)(nil),            // 58: ipc.TournamentGameEndedEvent
-	(*ipc.MonitoringData)(nil),                      // 59: ipc.MonitoringData
-	(*ipc.DivisionRoundControls)(nil),               // 60: ipc.DivisionRoundControls
-	(*ipc.DivisionControls)(nil),                    // 61: ipc.DivisionControls
-	(*ipc.TournamentPersons)(nil),                   // 62: ipc.TournamentPersons
-	(*ipc.FullTournamentDivisions)(nil),             // 63: ipc.FullTournamentDivisions
-	(*ipc.PairResponse)(nil),                        // 64: ipc.PairResponse
+	(*UploadPrivateLexiconRequest)(nil),             // 53: tournament_service.UploadPrivateLexiconRequest
+	(*PrivateLexicon)(nil),                          // 54: tournament_service.PrivateLexicon
+	(*GetPrivateLexicaRequest)(nil),                 // 55: tournament_service.GetPrivateLexicaRequest
+	(*GetPrivateLexicaResponse)(nil),                // 56: tournament_service.GetPrivateLexicaResponse
+	(*timestamppb.Timestamp)(nil),                   // 57: google.protobuf.Timestamp
+	(*ipc.GameRequest)(nil),                         // 58: ipc.GameRequest
+	(*ipc.RoundControl)(nil),                        // 59: ipc.RoundControl
+	(ipc.TournamentGameResult)(0),                   // 60: ipc.TournamentGameResult
+	(ipc.GameEndReason)(0),                          // 61: ipc.GameEndReason
+	(*ipc.TournamentGameEndedEvent)(nil),            // 62: ipc.TournamentGameEndedEvent
+	(*ipc.MonitoringData)(nil),                      // 63: ipc.MonitoringData
+	(*ipc.DivisionRoundControls)(nil),               // 64: ipc.DivisionRoundControls
+	(*ipc.DivisionControls)(nil),                    // 65: ipc.DivisionControls
+	(*ipc.TournamentPersons)(nil),                   // 66: ipc.TournamentPersons
+	(*ipc.FullTournamentDivisions)(nil),             // 67: ipc.FullTournamentDivisions
+	(*ipc.PairResponse)(nil),                        // 68: ipc.PairResponse
 }
 var file_proto_tournament_service_tournament_service_proto_depIdxs = []int32{
 	0,  // 0: tournament_service.NewTournamentRequest.type:type_name -> tournament_service.TType
-	53, // 1: tournament_service.NewTournamentRequest.scheduled_start_time:type_name -> google.protobuf.Timestamp
-	53, // 2: tournament_service.NewTournamentRequest.scheduled_end_time:type_name -> google.protobuf.Timestamp
+	57, // 1: tournament_service.NewTournamentRequest.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	57, // 2: tournament_service.NewTournamentRequest.scheduled_end_time:type_name -> google.protobuf.Timestamp
 	0,  // 3: tournament_service.TournamentMetadata.type:type_name -> tournament_service.TType
-	54, // 4: tournament_service.TournamentMetadata.default_club_settings:type_name -> ipc.GameRequest
-	53, // 5: tournament_service.TournamentMetadata.scheduled_start_time:type_name -> google.protobuf.Timestamp
-	53, // 6: tournament_service.TournamentMetadata.scheduled_end_time:type_name -> google.protobuf.Timestamp
+	58, // 4: tournament_service.TournamentMetadata.default_club_settings:type_name -> ipc.GameRequest
+	57, // 5: tournament_service.TournamentMetadata.scheduled_start_time:type_name -> google.protobuf.Timestamp
+	57, // 6: tournament_service.TournamentMetadata.scheduled_end_time:type_name -> google.protobuf.Timestamp
 	4,  // 7: tournament_service.TournamentMetadata.divisions:type_name -> tournament_service.TournamentDivisionSummary
-	54, // 8: tournament_service.TournamentDivisionSummary.game_request:type_name -> ipc.GameRequest
-	55, // 9: tournament_service.TournamentDivisionSummary.round_controls:type_name -> ipc.RoundControl
+	58, // 8: tournament_service.TournamentDivisionSummary.game_request:type_name -> ipc.GameRequest
+	59, // 9: tournament_service.TournamentDivisionSummary.round_controls:type_name -> ipc.RoundControl
 	3,  // 10: tournament_service.SetTournamentMetadataRequest.metadata:type_name -> tournament_service.TournamentMetadata
-	55, // 11: tournament_service.SingleRoundControlsRequest.round_controls:type_name -> ipc.RoundControl
-	56, // 12: tournament_service.TournamentPairingRequest.self_play_result:type_name -> ipc.TournamentGameResult
+	59, // 11: tournament_service.SingleRoundControlsRequest.round_controls:type_name -> ipc.RoundControl
+	60, // 12: tournament_service.TournamentPairingRequest.self_play_result:type_name -> ipc.TournamentGameResult
 	9,  // 13: tournament_service.TournamentPairingsRequest.pairings:type_name -> tournament_service.TournamentPairingRequest
-	56, // 14: tournament_service.TournamentResultOverrideRequest.player_one_result:type_name -> ipc.TournamentGameResult
-	56, // 15: tournament_service.TournamentResultOverrideRequest.player_two_result:type_name -> ipc.TournamentGameResult
-	57, // 16: tournament_service.TournamentResultOverrideRequest.game_end_reason:type_name -> ipc.GameEndReason
+	60, // 14: tournament_service.TournamentResultOverrideRequest.player_one_result:type_name -> ipc.TournamentGameResult
+	60, // 15: tournament_service.TournamentResultOverrideRequest.player_two_result:type_name -> ipc.TournamentGameResult
+	61, // 16: tournament_service.TournamentResultOverrideRequest.game_end_reason:type_name -> ipc.GameEndReason
 	3,  // 17: tournament_service.TournamentMetadataResponse.metadata:type_name -> tournament_service.TournamentMetadata
-	58, // 18: tournament_service.RecentGamesResponse.games:type_name -> ipc.TournamentGameEndedEvent
+	62, // 18: tournament_service.RecentGamesResponse.games:type_name -> ipc.TournamentGameEndedEvent
 	3,  // 19: tournament_service.GetRecentAndUpcomingTournamentsResponse.tournaments:type_name -> tournament_service.TournamentMetadata
 	3,  // 20: tournament_service.GetPastTournamentsResponse.tournaments:type_name -> tournament_service.TournamentMetadata
 	3,  // 21: tournament_service.GetMyTournamentsResponse.tournaments:type_name -> tournament_service.TournamentMetadata
-	53, // 22: tournament_service.NewClubSessionRequest.date:type_name -> google.protobuf.Timestamp
+	57, // 22: tournament_service.NewClubSessionRequest.date:type_name -> google.protobuf.Timestamp
 	45, // 23: tournament_service.ClubSessionsResponse.sessions:type_name -> tournament_service.ClubSessionResponse
-	59, // 24: tournament_service.GetTournamentMonitoringResponse.participants:type_name -> ipc.MonitoringData
-	2,  // 25: tournament_service.TournamentService.NewTournament:input_type -> tournament_service.NewTournamentRequest
-	17, // 26: tournament_service.TournamentService.GetTournamentMetadata:input_type -> tournament_service.GetTournamentMetadataRequest
-	18, // 27: tournament_service.TournamentService.GetTournament:input_type -> tournament_service.GetTournamentRequest
-	20, // 28: tournament_service.TournamentService.UnfinishTournament:input_type -> tournament_service.UnfinishTournamentRequest
-	19, // 29: tournament_service.TournamentService.FinishTournament:input_type -> tournament_service.FinishTournamentRequest
-	5,  // 30: tournament_service.TournamentService.SetTournamentMetadata:input_type -> tournament_service.SetTournamentMetadataRequest
-	7,  // 31: tournament_service.TournamentService.PairRound:input_type -> tournament_service.PairRoundRequest
-	6,  // 32: tournament_service.TournamentService.SetSingleRoundControls:input_type -> tournament_service.SingleRoundControlsRequest
-	60, // 33: tournament_service.TournamentService.SetRoundControls:input_type -> ipc.DivisionRoundControls
-	61, // 34: tournament_service.TournamentService.SetDivisionControls:input_type -> ipc.DivisionControls
-	62, // 35: tournament_service.TournamentService.AddDirectors:input_type -> ipc.TournamentPersons
-	62, // 36: tournament_service.TournamentService.RemoveDirectors:input_type -> ipc.TournamentPersons
-	8,  // 37: tournament_service.TournamentService.AddDivision:input_type -> tournament_service.TournamentDivisionRequest
-	10, // 38: tournament_service.TournamentService.RenameDivision:input_type -> tournament_service.DivisionRenameRequest
-	8,  // 39: tournament_service.TournamentService.RemoveDivision:input_type -> tournament_service.TournamentDivisionRequest
-	62, // 40: tournament_service.TournamentService.AddPlayers:input_type -> ipc.TournamentPersons
-	62, // 41: tournament_service.TournamentService.RemovePlayers:input_type -> ipc.TournamentPersons
-	11, // 42: tournament_service.TournamentService.MovePlayer:input_type -> tournament_service.MovePlayerRequest
-	12, // 43: tournament_service.TournamentService.SetPairing:input_type -> tournament_service.TournamentPairingsRequest
-	13, // 44: tournament_service.TournamentService.SetResult:input_type -> tournament_service.TournamentResultOverrideRequest
-	14, // 45: tournament_service.TournamentService.StartRoundCountdown:input_type -> tournament_service.TournamentStartRoundCountdownRequest
-	22, // 46: tournament_service.TournamentService.RecentGames:input_type -> tournament_service.RecentGamesRequest
-	44, // 47: tournament_service.TournamentService.CreateClubSession:input_type -> tournament_service.NewClubSessionRequest
-	46, // 48: tournament_service.TournamentService.GetRecentClubSessions:input_type -> tournament_service.RecentClubSessionsRequest
-	24, // 49: tournament_service.TournamentService.UnstartTournament:input_type -> tournament_service.UnstartTournamentRequest
-	29, // 50: tournament_service.TournamentService.OpenRegistration:input_type -> tournament_service.OpenRegistrationRequest
-	30, // 51: tournament_service.TournamentService.CloseRegistration:input_type -> tournament_service.CloseRegistrationRequest
-	31, // 52: tournament_service.TournamentService.OpenCheckins:input_type -> tournament_service.OpenCheckinsRequest
-	32, // 53: tournament_service.TournamentService.CloseCheckins:input_type -> tournament_service.CloseCheckinsRequest
-	25, // 54: tournament_service.TournamentService.UncheckAllIn:input_type -> tournament_service.UncheckAllInRequest
-	26, // 55: tournament_service.TournamentService.RemoveAllPlayersNotCheckedIn:input_type -> tournament_service.RemoveAllPlayersNotCheckedInRequest
-	27, // 56: tournament_service.TournamentService.CheckIn:input_type -> tournament_service.CheckinRequest
-	28, // 57: tournament_service.TournamentService.Register:input_type -> tournament_service.RegisterRequest
-	42, // 58: tournament_service.TournamentService.ExportTournament:input_type -> tournament_service.ExportTournamentRequest
-	33, // 59: tournament_service.TournamentService.GetTournamentScorecards:input_type -> tournament_service.TournamentScorecardRequest
-	35, // 60: tournament_service.TournamentService.GetRecentAndUpcomingTournaments:input_type -> tournament_service.GetRecentAndUpcomingTournamentsRequest
-	37, // 61: tournament_service.TournamentService.GetPastTournaments:input_type -> tournament_service.GetPastTournamentsRequest
-	39, // 62: tournament_service.TournamentService.GetMyTournaments:input_type -> tournament_service.GetMyTournamentsRequest
-	41, // 63: tournament_service.TournamentService.RunCOP:input_type -> tournament_service.RunCopRequest
-	48, // 64: tournament_service.TournamentService.InitializeMonitoringKeys:input_type -> tournament_service.InitializeMonitoringKeysRequest
-	49, // 65: tournament_service.TournamentService.RequestMonitoringStream:input_type -> tournament_service.RequestMonitoringStreamRequest
-	50, // 66: tournament_service.TournamentService.ResetMonitoringStream:input_type -> tournament_service.ResetMonitoringStreamRequest
-	51, // 67: tournament_service.TournamentService.GetTournamentMonitoring:input_type -> tournament_service.GetTournamentMonitoringRequest
-	16, // 68: tournament_service.TournamentService.NewTournament:output_type -> tournament_service.NewTournamentResponse
-	21, // 69: tournament_service.TournamentService.GetTournamentMetadata:output_type -> tournament_service.TournamentMetadataResponse
-	63, // 70: tournament_service.TournamentService.GetTournament:output_type -> ipc.FullTournamentDivisions
-	15, // 71: tournament_service.TournamentService.UnfinishTournament:output_type -> tournament_service.TournamentResponse
-	15, // 72: tournament_service.TournamentService.FinishTournament:output_type -> tournament_service.TournamentResponse
-	15, // 73: tournament_service.TournamentService.SetTournamentMetadata:output_type -> tournament_service.TournamentResponse
-	15, // 74: tournament_service.TournamentService.PairRound:output_type -> tournament_service.TournamentResponse
-	15, // 75: tournament_service.TournamentService.SetSingleRoundControls:output_type -> tournament_service.TournamentResponse
-	15, // 76: tournament_service.TournamentService.SetRoundControls:output_type -> tournament_service.TournamentResponse
-	15, // 77: tournament_service.TournamentService.SetDivisionControls:output_type -> tournament_service.TournamentResponse
-	15, // 78: tournament_service.TournamentService.AddDirectors:output_type -> tournament_service.TournamentResponse
-	15, // 79: tournament_service.TournamentService.RemoveDirectors:output_type -> tournament_service.TournamentResponse
-	15, // 80: tournament_service.TournamentService.AddDivision:output_type -> tournament_service.TournamentResponse
-	15, // 81: tournament_service.TournamentService.RenameDivision:output_type -> tournament_service.TournamentResponse
-	15, // 82: tournament_service.TournamentService.RemoveDivision:output_type -> tournament_service.TournamentResponse
-	15, // 83: tournament_service.TournamentService.AddPlayers:output_type -> tournament_service.TournamentResponse
-	15, // 84: tournament_service.TournamentService.RemovePlayers:output_type -> tournament_service.TournamentResponse
-	15, // 85: tournament_service.TournamentService.MovePlayer:output_type -> tournament_service.TournamentResponse
-	15, // 86: tournament_service.TournamentService.SetPairing:output_type -> tournament_service.TournamentResponse
-	15, // 87: tournament_service.TournamentService.SetResult:output_type -> tournament_service.TournamentResponse
-	15, // 88: tournament_service.TournamentService.StartRoundCountdown:output_type -> tournament_service.TournamentResponse
-	23, // 89: tournament_service.TournamentService.RecentGames:output_type -> tournament_service.RecentGamesResponse
-	45, // 90: tournament_service.TournamentService.CreateClubSession:output_type -> tournament_service.ClubSessionResponse
-	47, // 91: tournament_service.TournamentService.GetRecentClubSessions:output_type -> tournament_service.ClubSessionsResponse
-	15, // 92: tournament_service.TournamentService.UnstartTournament:output_type -> tournament_service.TournamentResponse
-	15, // 93: tournament_service.TournamentService.OpenRegistration:output_type -> tournament_service.TournamentResponse
-	15, // 94: tournament_service.TournamentService.CloseRegistration:output_type -> tournament_service.TournamentResponse
-	15, // 95: tournament_service.TournamentService.OpenCheckins:output_type -> tournament_service.TournamentResponse
-	15, // 96: tournament_service.TournamentService.CloseCheckins:output_type -> tournament_service.TournamentResponse
-	15, // 97: tournament_service.TournamentService.UncheckAllIn:output_type -> tournament_service.TournamentResponse
-	15, // 98: tournament_service.TournamentService.RemoveAllPlayersNotCheckedIn:output_type -> tournament_service.TournamentResponse
-	15, // 99: tournament_service.TournamentService.CheckIn:output_type -> tournament_service.TournamentResponse
-	15, // 100: tournament_service.TournamentService.Register:output_type -> tournament_service.TournamentResponse
-	43, // 101: tournament_service.TournamentService.ExportTournament:output_type -> tournament_service.ExportTournamentResponse
-	34, // 102: tournament_service.TournamentService.GetTournamentScorecards:output_type -> tournament_service.TournamentScorecardResponse
-	36, // 103: tournament_service.TournamentService.GetRecentAndUpcomingTournaments:output_type -> tournament_service.GetRecentAndUpcomingTournamentsResponse
-	38, // 104: tournament_service.TournamentService.GetPastTournaments:output_type -> tournament_service.GetPastTournamentsResponse
-	40, // 105: tournament_service.TournamentService.GetMyTournaments:output_type -> tournament_service.GetMyTournamentsResponse
-	64, // 106: tournament_service.TournamentService.RunCOP:output_type -> ipc.PairResponse
-	15, // 107: tournament_service.TournamentService.InitializeMonitoringKeys:output_type -> tournament_service.TournamentResponse
-	15, // 108: tournament_service.TournamentService.RequestMonitoringStream:output_type -> tournament_service.TournamentResponse
-	15, // 109: tournament_service.TournamentService.ResetMonitoringStream:output_type -> tournament_service.TournamentResponse
-	52, // 110: tournament_service.TournamentService.GetTournamentMonitoring:output_type -> tournament_service.GetTournamentMonitoringResponse
-	68, // [68:111] is the sub-list for method output_type
-	25, // [25:68] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	63, // 24: tournament_service.GetTournamentMonitoringResponse.participants:type_name -> ipc.MonitoringData
+	57, // 25: tournament_service.PrivateLexicon.created_at:type_name -> google.protobuf.Timestamp
+	54, // 26: tournament_service.GetPrivateLexicaResponse.lexica:type_name -> tournament_service.PrivateLexicon
+	2,  // 27: tournament_service.TournamentService.NewTournament:input_type -> tournament_service.NewTournamentRequest
+	17, // 28: tournament_service.TournamentService.GetTournamentMetadata:input_type -> tournament_service.GetTournamentMetadataRequest
+	18, // 29: tournament_service.TournamentService.GetTournament:input_type -> tournament_service.GetTournamentRequest
+	20, // 30: tournament_service.TournamentService.UnfinishTournament:input_type -> tournament_service.UnfinishTournamentRequest
+	19, // 31: tournament_service.TournamentService.FinishTournament:input_type -> tournament_service.FinishTournamentRequest
+	5,  // 32: tournament_service.TournamentService.SetTournamentMetadata:input_type -> tournament_service.SetTournamentMetadataRequest
+	7,  // 33: tournament_service.TournamentService.PairRound:input_type -> tournament_service.PairRoundRequest
+	6,  // 34: tournament_service.TournamentService.SetSingleRoundControls:input_type -> tournament_service.SingleRoundControlsRequest
+	64, // 35: tournament_service.TournamentService.SetRoundControls:input_type -> ipc.DivisionRoundControls
+	65, // 36: tournament_service.TournamentService.SetDivisionControls:input_type -> ipc.DivisionControls
+	66, // 37: tournament_service.TournamentService.AddDirectors:input_type -> ipc.TournamentPersons
+	66, // 38: tournament_service.TournamentService.RemoveDirectors:input_type -> ipc.TournamentPersons
+	8,  // 39: tournament_service.TournamentService.AddDivision:input_type -> tournament_service.TournamentDivisionRequest
+	10, // 40: tournament_service.TournamentService.RenameDivision:input_type -> tournament_service.DivisionRenameRequest
+	8,  // 41: tournament_service.TournamentService.RemoveDivision:input_type -> tournament_service.TournamentDivisionRequest
+	66, // 42: tournament_service.TournamentService.AddPlayers:input_type -> ipc.TournamentPersons
+	66, // 43: tournament_service.TournamentService.RemovePlayers:input_type -> ipc.TournamentPersons
+	11, // 44: tournament_service.TournamentService.MovePlayer:input_type -> tournament_service.MovePlayerRequest
+	12, // 45: tournament_service.TournamentService.SetPairing:input_type -> tournament_service.TournamentPairingsRequest
+	13, // 46: tournament_service.TournamentService.SetResult:input_type -> tournament_service.TournamentResultOverrideRequest
+	14, // 47: tournament_service.TournamentService.StartRoundCountdown:input_type -> tournament_service.TournamentStartRoundCountdownRequest
+	22, // 48: tournament_service.TournamentService.RecentGames:input_type -> tournament_service.RecentGamesRequest
+	44, // 49: tournament_service.TournamentService.CreateClubSession:input_type -> tournament_service.NewClubSessionRequest
+	46, // 50: tournament_service.TournamentService.GetRecentClubSessions:input_type -> tournament_service.RecentClubSessionsRequest
+	24, // 51: tournament_service.TournamentService.UnstartTournament:input_type -> tournament_service.UnstartTournamentRequest
+	29, // 52: tournament_service.TournamentService.OpenRegistration:input_type -> tournament_service.OpenRegistrationRequest
+	30, // 53: tournament_service.TournamentService.CloseRegistration:input_type -> tournament_service.CloseRegistrationRequest
+	31, // 54: tournament_service.TournamentService.OpenCheckins:input_type -> tournament_service.OpenCheckinsRequest
+	32, // 55: tournament_service.TournamentService.CloseCheckins:input_type -> tournament_service.CloseCheckinsRequest
+	25, // 56: tournament_service.TournamentService.UncheckAllIn:input_type -> tournament_service.UncheckAllInRequest
+	26, // 57: tournament_service.TournamentService.RemoveAllPlayersNotCheckedIn:input_type -> tournament_service.RemoveAllPlayersNotCheckedInRequest
+	27, // 58: tournament_service.TournamentService.CheckIn:input_type -> tournament_service.CheckinRequest
+	28, // 59: tournament_service.TournamentService.Register:input_type -> tournament_service.RegisterRequest
+	42, // 60: tournament_service.TournamentService.ExportTournament:input_type -> tournament_service.ExportTournamentRequest
+	33, // 61: tournament_service.TournamentService.GetTournamentScorecards:input_type -> tournament_service.TournamentScorecardRequest
+	35, // 62: tournament_service.TournamentService.GetRecentAndUpcomingTournaments:input_type -> tournament_service.GetRecentAndUpcomingTournamentsRequest
+	37, // 63: tournament_service.TournamentService.GetPastTournaments:input_type -> tournament_service.GetPastTournamentsRequest
+	39, // 64: tournament_service.TournamentService.GetMyTournaments:input_type -> tournament_service.GetMyTournamentsRequest
+	41, // 65: tournament_service.TournamentService.RunCOP:input_type -> tournament_service.RunCopRequest
+	48, // 66: tournament_service.TournamentService.InitializeMonitoringKeys:input_type -> tournament_service.InitializeMonitoringKeysRequest
+	49, // 67: tournament_service.TournamentService.RequestMonitoringStream:input_type -> tournament_service.RequestMonitoringStreamRequest
+	50, // 68: tournament_service.TournamentService.ResetMonitoringStream:input_type -> tournament_service.ResetMonitoringStreamRequest
+	51, // 69: tournament_service.TournamentService.GetTournamentMonitoring:input_type -> tournament_service.GetTournamentMonitoringRequest
+	53, // 70: tournament_service.TournamentService.UploadPrivateLexicon:input_type -> tournament_service.UploadPrivateLexiconRequest
+	55, // 71: tournament_service.TournamentService.GetPrivateLexica:input_type -> tournament_service.GetPrivateLexicaRequest
+	16, // 72: tournament_service.TournamentService.NewTournament:output_type -> tournament_service.NewTournamentResponse
+	21, // 73: tournament_service.TournamentService.GetTournamentMetadata:output_type -> tournament_service.TournamentMetadataResponse
+	67, // 74: tournament_service.TournamentService.GetTournament:output_type -> ipc.FullTournamentDivisions
+	15, // 75: tournament_service.TournamentService.UnfinishTournament:output_type -> tournament_service.TournamentResponse
+	15, // 76: tournament_service.TournamentService.FinishTournament:output_type -> tournament_service.TournamentResponse
+	15, // 77: tournament_service.TournamentService.SetTournamentMetadata:output_type -> tournament_service.TournamentResponse
+	15, // 78: tournament_service.TournamentService.PairRound:output_type -> tournament_service.TournamentResponse
+	15, // 79: tournament_service.TournamentService.SetSingleRoundControls:output_type -> tournament_service.TournamentResponse
+	15, // 80: tournament_service.TournamentService.SetRoundControls:output_type -> tournament_service.TournamentResponse
+	15, // 81: tournament_service.TournamentService.SetDivisionControls:output_type -> tournament_service.TournamentResponse
+	15, // 82: tournament_service.TournamentService.AddDirectors:output_type -> tournament_service.TournamentResponse
+	15, // 83: tournament_service.TournamentService.RemoveDirectors:output_type -> tournament_service.TournamentResponse
+	15, // 84: tournament_service.TournamentService.AddDivision:output_type -> tournament_service.TournamentResponse
+	15, // 85: tournament_service.TournamentService.RenameDivision:output_type -> tournament_service.TournamentResponse
+	15, // 86: tournament_service.TournamentService.RemoveDivision:output_type -> tournament_service.TournamentResponse
+	15, // 87: tournament_service.TournamentService.AddPlayers:output_type -> tournament_service.TournamentResponse
+	15, // 88: tournament_service.TournamentService.RemovePlayers:output_type -> tournament_service.TournamentResponse
+	15, // 89: tournament_service.TournamentService.MovePlayer:output_type -> tournament_service.TournamentResponse
+	15, // 90: tournament_service.TournamentService.SetPairing:output_type -> tournament_service.TournamentResponse
+	15, // 91: tournament_service.TournamentService.SetResult:output_type -> tournament_service.TournamentResponse
+	15, // 92: tournament_service.TournamentService.StartRoundCountdown:output_type -> tournament_service.TournamentResponse
+	23, // 93: tournament_service.TournamentService.RecentGames:output_type -> tournament_service.RecentGamesResponse
+	45, // 94: tournament_service.TournamentService.CreateClubSession:output_type -> tournament_service.ClubSessionResponse
+	47, // 95: tournament_service.TournamentService.GetRecentClubSessions:output_type -> tournament_service.ClubSessionsResponse
+	15, // 96: tournament_service.TournamentService.UnstartTournament:output_type -> tournament_service.TournamentResponse
+	15, // 97: tournament_service.TournamentService.OpenRegistration:output_type -> tournament_service.TournamentResponse
+	15, // 98: tournament_service.TournamentService.CloseRegistration:output_type -> tournament_service.TournamentResponse
+	15, // 99: tournament_service.TournamentService.OpenCheckins:output_type -> tournament_service.TournamentResponse
+	15, // 100: tournament_service.TournamentService.CloseCheckins:output_type -> tournament_service.TournamentResponse
+	15, // 101: tournament_service.TournamentService.UncheckAllIn:output_type -> tournament_service.TournamentResponse
+	15, // 102: tournament_service.TournamentService.RemoveAllPlayersNotCheckedIn:output_type -> tournament_service.TournamentResponse
+	15, // 103: tournament_service.TournamentService.CheckIn:output_type -> tournament_service.TournamentResponse
+	15, // 104: tournament_service.TournamentService.Register:output_type -> tournament_service.TournamentResponse
+	43, // 105: tournament_service.TournamentService.ExportTournament:output_type -> tournament_service.ExportTournamentResponse
+	34, // 106: tournament_service.TournamentService.GetTournamentScorecards:output_type -> tournament_service.TournamentScorecardResponse
+	36, // 107: tournament_service.TournamentService.GetRecentAndUpcomingTournaments:output_type -> tournament_service.GetRecentAndUpcomingTournamentsResponse
+	38, // 108: tournament_service.TournamentService.GetPastTournaments:output_type -> tournament_service.GetPastTournamentsResponse
+	40, // 109: tournament_service.TournamentService.GetMyTournaments:output_type -> tournament_service.GetMyTournamentsResponse
+	68, // 110: tournament_service.TournamentService.RunCOP:output_type -> ipc.PairResponse
+	15, // 111: tournament_service.TournamentService.InitializeMonitoringKeys:output_type -> tournament_service.TournamentResponse
+	15, // 112: tournament_service.TournamentService.RequestMonitoringStream:output_type -> tournament_service.TournamentResponse
+	15, // 113: tournament_service.TournamentService.ResetMonitoringStream:output_type -> tournament_service.TournamentResponse
+	52, // 114: tournament_service.TournamentService.GetTournamentMonitoring:output_type -> tournament_service.GetTournamentMonitoringResponse
+	54, // 115: tournament_service.TournamentService.UploadPrivateLexicon:output_type -> tournament_service.PrivateLexicon
+	56, // 116: tournament_service.TournamentService.GetPrivateLexica:output_type -> tournament_service.GetPrivateLexicaResponse
+	72, // [72:117] is the sub-list for method output_type
+	27, // [27:72] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_proto_tournament_service_tournament_service_proto_init() }
@@ -3652,7 +3908,7 @@ func file_proto_tournament_service_tournament_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_tournament_service_tournament_service_proto_rawDesc), len(file_proto_tournament_service_tournament_service_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   52,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -163,6 +163,12 @@ const (
 	// TournamentServiceGetTournamentMonitoringProcedure is the fully-qualified name of the
 	// TournamentService's GetTournamentMonitoring RPC.
 	TournamentServiceGetTournamentMonitoringProcedure = "/tournament_service.TournamentService/GetTournamentMonitoring"
+	// TournamentServiceUploadPrivateLexiconProcedure is the fully-qualified name of the
+	// TournamentService's UploadPrivateLexicon RPC.
+	TournamentServiceUploadPrivateLexiconProcedure = "/tournament_service.TournamentService/UploadPrivateLexicon"
+	// TournamentServiceGetPrivateLexicaProcedure is the fully-qualified name of the TournamentService's
+	// GetPrivateLexica RPC.
+	TournamentServiceGetPrivateLexicaProcedure = "/tournament_service.TournamentService/GetPrivateLexica"
 )
 
 // TournamentServiceClient is a client for the tournament_service.TournamentService service.
@@ -219,6 +225,13 @@ type TournamentServiceClient interface {
 	RequestMonitoringStream(context.Context, *connect.Request[tournament_service.RequestMonitoringStreamRequest]) (*connect.Response[tournament_service.TournamentResponse], error)
 	ResetMonitoringStream(context.Context, *connect.Request[tournament_service.ResetMonitoringStreamRequest]) (*connect.Response[tournament_service.TournamentResponse], error)
 	GetTournamentMonitoring(context.Context, *connect.Request[tournament_service.GetTournamentMonitoringRequest]) (*connect.Response[tournament_service.GetTournamentMonitoringResponse], error)
+	// UploadPrivateLexicon builds a lexicon out of a word list. It can only be
+	// played in the tournament or club it was uploaded for, and in the sessions
+	// of that club.
+	UploadPrivateLexicon(context.Context, *connect.Request[tournament_service.UploadPrivateLexiconRequest]) (*connect.Response[tournament_service.PrivateLexicon], error)
+	// GetPrivateLexica returns the private lexica that can be played in a
+	// tournament.
+	GetPrivateLexica(context.Context, *connect.Request[tournament_service.GetPrivateLexicaRequest]) (*connect.Response[tournament_service.GetPrivateLexicaResponse], error)
 }
 
 // NewTournamentServiceClient constructs a client for the tournament_service.TournamentService
@@ -500,6 +513,19 @@ func NewTournamentServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		uploadPrivateLexicon: connect.NewClient[tournament_service.UploadPrivateLexiconRequest, tournament_service.PrivateLexicon](
+			httpClient,
+			baseURL+TournamentServiceUploadPrivateLexiconProcedure,
+			connect.WithSchema(tournamentServiceMethods.ByName("UploadPrivateLexicon")),
+			connect.WithClientOptions(opts...),
+		),
+		getPrivateLexica: connect.NewClient[tournament_service.GetPrivateLexicaRequest, tournament_service.GetPrivateLexicaResponse](
+			httpClient,
+			baseURL+TournamentServiceGetPrivateLexicaProcedure,
+			connect.WithSchema(tournamentServiceMethods.ByName("GetPrivateLexica")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -548,6 +574,8 @@ type tournamentServiceClient struct {
 	requestMonitoringStream         *connect.Client[tournament_service.RequestMonitoringStreamRequest, tournament_service.TournamentResponse]
 	resetMonitoringStream           *connect.Client[tournament_service.ResetMonitoringStreamRequest, tournament_service.TournamentResponse]
 	getTournamentMonitoring         *connect.Client[tournament_service.GetTournamentMonitoringRequest, tournament_service.GetTournamentMonitoringResponse]
+	uploadPrivateLexicon            *connect.Client[tournament_service.UploadPrivateLexiconRequest, tournament_service.PrivateLexicon]
+	getPrivateLexica                *connect.Client[tournament_service.GetPrivateLexicaRequest, tournament_service.GetPrivateLexicaResponse]
 }
 
 // NewTournament calls tournament_service.TournamentService.NewTournament.
@@ -767,6 +795,16 @@ func (c *tournamentServiceClient) GetTournamentMonitoring(ctx context.Context, r
 	return c.getTournamentMonitoring.CallUnary(ctx, req)
 }
 
+// UploadPrivateLexicon calls tournament_service.TournamentService.UploadPrivateLexicon.
+func (c *tournamentServiceClient) UploadPrivateLexicon(ctx context.Context, req *connect.Request[tournament_service.UploadPrivateLexiconRequest]) (*connect.Response[tournament_service.PrivateLexicon], error) {
+	return c.uploadPrivateLexicon.CallUnary(ctx, req)
+}
+
+// GetPrivateLexica calls tournament_service.TournamentService.GetPrivateLexica.
+func (c *tournamentServiceClient) GetPrivateLexica(ctx context.Context, req *connect.Request[tournament_service.GetPrivateLexicaRequest]) (*connect.Response[tournament_service.GetPrivateLexicaResponse], error) {
+	return c.getPrivateLexica.CallUnary(ctx, req)
+}
+
 // TournamentServiceHandler is an implementation of the tournament_service.TournamentService
 // service.
 type TournamentServiceHandler interface {
@@ -822,6 +860,13 @@ type TournamentServiceHandler interface {
 	RequestMonitoringStream(context.Context, *connect.Request[tournament_service.RequestMonitoringStreamRequest]) (*connect.Response[tournament_service.TournamentResponse], error)
 	ResetMonitoringStream(context.Context, *connect.Request[tournament_service.ResetMonitoringStreamRequest]) (*connect.Response[tournament_service.TournamentResponse], error)
 	GetTournamentMonitoring(context.Context, *connect.Request[tournament_service.GetTournamentMonitoringRequest]) (*connect.Response[tournament_service.GetTournamentMonitoringResponse], error)
+	// UploadPrivateLexicon builds a lexicon out of a word list. It can only be
+	// played in the tournament or club it was uploaded for, and in the sessions
+	// of that club.
+	UploadPrivateLexicon(context.Context, *connect.Request[tournament_service.UploadPrivateLexiconRequest]) (*connect.Response[tournament_service.PrivateLexicon], error)
+	// GetPrivateLexica returns the private lexica that can be played in a
+	// tournament.
+	GetPrivateLexica(context.Context, *connect.Request[tournament_service.GetPrivateLexicaRequest]) (*connect.Response[tournament_service.GetPrivateLexicaResponse], error)
 }
 
 // NewTournamentServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -1099,6 +1144,19 @@ func NewTournamentServiceHandler(svc TournamentServiceHandler, opts ...connect.H
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	tournamentServiceUploadPrivateLexiconHandler := connect.NewUnaryHandler(
+		TournamentServiceUploadPrivateLexiconProcedure,
+		svc.UploadPrivateLexicon,
+		connect.WithSchema(tournamentServiceMethods.ByName("UploadPrivateLexicon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tournamentServiceGetPrivateLexicaHandler := connect.NewUnaryHandler(
+		TournamentServiceGetPrivateLexicaProcedure,
+		svc.GetPrivateLexica,
+		connect.WithSchema(tournamentServiceMethods.ByName("GetPrivateLexica")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/tournament_service.TournamentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TournamentServiceNewTournamentProcedure:
@@ -1187,6 +1245,10 @@ func NewTournamentServiceHandler(svc TournamentServiceHandler, opts ...connect.H
 			tournamentServiceResetMonitoringStreamHandler.ServeHTTP(w, r)
 		case TournamentServiceGetTournamentMonitoringProcedure:
 			tournamentServiceGetTournamentMonitoringHandler.ServeHTTP(w, r)
+		case TournamentServiceUploadPrivateLexiconProcedure:
+			tournamentServiceUploadPrivateLexiconHandler.ServeHTTP(w, r)
+		case TournamentServiceGetPrivateLexicaProcedure:
+			tournamentServiceGetPrivateLexicaHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1367,3 +1429,11 @@ func (UnimplementedTournamentServiceHandler) ResetMonitoringStream(context.Conte
 func (UnimplementedTournamentServiceHandler) GetTournamentMonitoring(context.Context, *connect.Request[tournament_service.GetTournamentMonitoringRequest]) (*connect.Response[tournament_service.GetTournamentMonitoringResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tournament_service.TournamentService.GetTournamentMonitoring is not implemented"))
 }
+
+func (UnimplementedTournamentServiceHandler) UploadPrivateLexicon(context.Context, *connect.Request[tournament_service.UploadPrivateLexiconRequest]) (*connect.Response[tournament_service.PrivateLexicon], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tournament_service.TournamentService.UploadPrivateLexicon is not implemented"))
+}
+
+func (UnimplementedTournamentServiceHandler) GetPrivateLexica(context.Context, *connect.Request[tournament_service.GetPrivateLexicaRequest]) (*connect.Response[tournament_service.GetPrivateLexicaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tournament_service.TournamentService.GetPrivateLexica is not implemented"))
+}
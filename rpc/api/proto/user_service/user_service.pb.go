@@ -1178,6 +1178,114 @@ func (x *ResendVerificationEmailResponse) GetMessage() string {
 	return ""
 }
 
+// CompleteOAuthSignupRequest finishes creating an account for someone who
+// signed in with an external identity provider that isn't linked to any
+// Woogles account yet. The signup_token is issued by the OAuth login
+// callback and carries the verified external identity.
+type CompleteOAuthSignupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SignupToken   string                 `protobuf:"bytes,1,opt,name=signup_token,json=signupToken,proto3" json:"signup_token,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,3,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	CountryCode   string                 `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOAuthSignupRequest) Reset() {
+	*x = CompleteOAuthSignupRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOAuthSignupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOAuthSignupRequest) ProtoMessage() {}
+
+func (x *CompleteOAuthSignupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOAuthSignupRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOAuthSignupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CompleteOAuthSignupRequest) GetSignupToken() string {
+	if x != nil {
+		return x.SignupToken
+	}
+	return ""
+}
+
+func (x *CompleteOAuthSignupRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CompleteOAuthSignupRequest) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *CompleteOAuthSignupRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type CompleteOAuthSignupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOAuthSignupResponse) Reset() {
+	*x = CompleteOAuthSignupResponse{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOAuthSignupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOAuthSignupResponse) ProtoMessage() {}
+
+func (x *CompleteOAuthSignupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOAuthSignupResponse.ProtoReflect.Descriptor instead.
+func (*CompleteOAuthSignupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{26}
+}
+
 type RatingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -1187,7 +1295,7 @@ type RatingsRequest struct {
 
 func (x *RatingsRequest) Reset() {
 	*x = RatingsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[25]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1199,7 +1307,7 @@ func (x *RatingsRequest) String() string {
 func (*RatingsRequest) ProtoMessage() {}
 
 func (x *RatingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[25]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,7 +1320,7 @@ func (x *RatingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RatingsRequest.ProtoReflect.Descriptor instead.
 func (*RatingsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{25}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RatingsRequest) GetUsername() string {
@@ -1233,7 +1341,7 @@ type RatingsResponse struct {
 
 func (x *RatingsResponse) Reset() {
 	*x = RatingsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[26]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1245,7 +1353,7 @@ func (x *RatingsResponse) String() string {
 func (*RatingsResponse) ProtoMessage() {}
 
 func (x *RatingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[26]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1258,7 +1366,7 @@ func (x *RatingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RatingsResponse.ProtoReflect.Descriptor instead.
 func (*RatingsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{26}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RatingsResponse) GetJson() string {
@@ -1277,7 +1385,7 @@ type StatsRequest struct {
 
 func (x *StatsRequest) Reset() {
 	*x = StatsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[27]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1289,7 +1397,7 @@ func (x *StatsRequest) String() string {
 func (*StatsRequest) ProtoMessage() {}
 
 func (x *StatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[27]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,7 +1410,7 @@ func (x *StatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{27}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *StatsRequest) GetUsername() string {
@@ -1322,7 +1430,7 @@ type StatsResponse struct {
 
 func (x *StatsResponse) Reset() {
 	*x = StatsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[28]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1442,7 @@ func (x *StatsResponse) String() string {
 func (*StatsResponse) ProtoMessage() {}
 
 func (x *StatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[28]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1455,7 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
 func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{28}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *StatsResponse) GetJson() string {
@@ -1357,6 +1465,357 @@ func (x *StatsResponse) GetJson() string {
 	return ""
 }
 
+type RatingHistoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The variant key, e.g. "NWL18.classic.rapid". If empty, no points are
+	// returned, only the variants the user has a rating history in.
+	Variant string `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	// Only return rating changes from this time on.
+	Since *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	// The maximum number of most recent rating changes. Defaults to and is
+	// capped at 1000.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingHistoryRequest) Reset() {
+	*x = RatingHistoryRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingHistoryRequest) ProtoMessage() {}
+
+func (x *RatingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*RatingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RatingHistoryRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RatingHistoryRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *RatingHistoryRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *RatingHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// The terms of a rating calculation. The rating change is the multiplier
+// times (actual_result - expected_result).
+type RatingChangeBreakdown struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From 0 for a certain loss to 1 for a certain win.
+	ExpectedResult float64 `protobuf:"fixed64,1,opt,name=expected_result,json=expectedResult,proto3" json:"expected_result,omitempty"`
+	// What the spread was worth on the same scale. Any win is worth at least
+	// 0.5 plus the win boost.
+	ActualResult float64 `protobuf:"fixed64,2,opt,name=actual_result,json=actualResult,proto3" json:"actual_result,omitempty"`
+	WinBoost     float64 `protobuf:"fixed64,3,opt,name=win_boost,json=winBoost,proto3" json:"win_boost,omitempty"`
+	// The rating deviation once it was raised for the time since the
+	// previous game.
+	InactiveRatingDeviation float64 `protobuf:"fixed64,4,opt,name=inactive_rating_deviation,json=inactiveRatingDeviation,proto3" json:"inactive_rating_deviation,omitempty"`
+	Multiplier              float64 `protobuf:"fixed64,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RatingChangeBreakdown) Reset() {
+	*x = RatingChangeBreakdown{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingChangeBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingChangeBreakdown) ProtoMessage() {}
+
+func (x *RatingChangeBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingChangeBreakdown.ProtoReflect.Descriptor instead.
+func (*RatingChangeBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RatingChangeBreakdown) GetExpectedResult() float64 {
+	if x != nil {
+		return x.ExpectedResult
+	}
+	return 0
+}
+
+func (x *RatingChangeBreakdown) GetActualResult() float64 {
+	if x != nil {
+		return x.ActualResult
+	}
+	return 0
+}
+
+func (x *RatingChangeBreakdown) GetWinBoost() float64 {
+	if x != nil {
+		return x.WinBoost
+	}
+	return 0
+}
+
+func (x *RatingChangeBreakdown) GetInactiveRatingDeviation() float64 {
+	if x != nil {
+		return x.InactiveRatingDeviation
+	}
+	return 0
+}
+
+func (x *RatingChangeBreakdown) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type RatingHistoryPoint struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	GameId                  string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	OpponentUsername        string                 `protobuf:"bytes,2,opt,name=opponent_username,json=opponentUsername,proto3" json:"opponent_username,omitempty"`
+	PlayedAt                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	RatingBefore            float64                `protobuf:"fixed64,4,opt,name=rating_before,json=ratingBefore,proto3" json:"rating_before,omitempty"`
+	RatingAfter             float64                `protobuf:"fixed64,5,opt,name=rating_after,json=ratingAfter,proto3" json:"rating_after,omitempty"`
+	RatingDeviationBefore   float64                `protobuf:"fixed64,6,opt,name=rating_deviation_before,json=ratingDeviationBefore,proto3" json:"rating_deviation_before,omitempty"`
+	RatingDeviationAfter    float64                `protobuf:"fixed64,7,opt,name=rating_deviation_after,json=ratingDeviationAfter,proto3" json:"rating_deviation_after,omitempty"`
+	VolatilityBefore        float64                `protobuf:"fixed64,8,opt,name=volatility_before,json=volatilityBefore,proto3" json:"volatility_before,omitempty"`
+	VolatilityAfter         float64                `protobuf:"fixed64,9,opt,name=volatility_after,json=volatilityAfter,proto3" json:"volatility_after,omitempty"`
+	OpponentRating          float64                `protobuf:"fixed64,10,opt,name=opponent_rating,json=opponentRating,proto3" json:"opponent_rating,omitempty"`
+	OpponentRatingDeviation float64                `protobuf:"fixed64,11,opt,name=opponent_rating_deviation,json=opponentRatingDeviation,proto3" json:"opponent_rating_deviation,omitempty"`
+	// The spread used for rating, from this user's point of view. Resigned,
+	// timed out and forfeited games use the maximum spread.
+	Spread        int32                  `protobuf:"varint,12,opt,name=spread,proto3" json:"spread,omitempty"`
+	Breakdown     *RatingChangeBreakdown `protobuf:"bytes,13,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingHistoryPoint) Reset() {
+	*x = RatingHistoryPoint{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingHistoryPoint) ProtoMessage() {}
+
+func (x *RatingHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingHistoryPoint.ProtoReflect.Descriptor instead.
+func (*RatingHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RatingHistoryPoint) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RatingHistoryPoint) GetOpponentUsername() string {
+	if x != nil {
+		return x.OpponentUsername
+	}
+	return ""
+}
+
+func (x *RatingHistoryPoint) GetPlayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return nil
+}
+
+func (x *RatingHistoryPoint) GetRatingBefore() float64 {
+	if x != nil {
+		return x.RatingBefore
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetRatingAfter() float64 {
+	if x != nil {
+		return x.RatingAfter
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetRatingDeviationBefore() float64 {
+	if x != nil {
+		return x.RatingDeviationBefore
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetRatingDeviationAfter() float64 {
+	if x != nil {
+		return x.RatingDeviationAfter
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetVolatilityBefore() float64 {
+	if x != nil {
+		return x.VolatilityBefore
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetVolatilityAfter() float64 {
+	if x != nil {
+		return x.VolatilityAfter
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetOpponentRating() float64 {
+	if x != nil {
+		return x.OpponentRating
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetOpponentRatingDeviation() float64 {
+	if x != nil {
+		return x.OpponentRatingDeviation
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetSpread() int32 {
+	if x != nil {
+		return x.Spread
+	}
+	return 0
+}
+
+func (x *RatingHistoryPoint) GetBreakdown() *RatingChangeBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type RatingHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first.
+	Points        []*RatingHistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	Variants      []string              `protobuf:"bytes,2,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingHistoryResponse) Reset() {
+	*x = RatingHistoryResponse{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingHistoryResponse) ProtoMessage() {}
+
+func (x *RatingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*RatingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RatingHistoryResponse) GetPoints() []*RatingHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *RatingHistoryResponse) GetVariants() []string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
 // Organization Title (defined early for use in ProfileResponse)
 type OrganizationTitle struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -1375,7 +1834,7 @@ type OrganizationTitle struct {
 
 func (x *OrganizationTitle) Reset() {
 	*x = OrganizationTitle{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[29]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1387,7 +1846,7 @@ func (x *OrganizationTitle) String() string {
 func (*OrganizationTitle) ProtoMessage() {}
 
 func (x *OrganizationTitle) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[29]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,7 +1859,7 @@ func (x *OrganizationTitle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrganizationTitle.ProtoReflect.Descriptor instead.
 func (*OrganizationTitle) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{29}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *OrganizationTitle) GetOrganizationCode() string {
@@ -1475,7 +1934,7 @@ type ProfileRequest struct {
 
 func (x *ProfileRequest) Reset() {
 	*x = ProfileRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[30]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1487,7 +1946,7 @@ func (x *ProfileRequest) String() string {
 func (*ProfileRequest) ProtoMessage() {}
 
 func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[30]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1500,7 +1959,7 @@ func (x *ProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileRequest.ProtoReflect.Descriptor instead.
 func (*ProfileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{30}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProfileRequest) GetUsername() string {
@@ -1532,7 +1991,7 @@ type ProfileResponse struct {
 
 func (x *ProfileResponse) Reset() {
 	*x = ProfileResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[31]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1544,7 +2003,7 @@ func (x *ProfileResponse) String() string {
 func (*ProfileResponse) ProtoMessage() {}
 
 func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[31]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1557,7 +2016,7 @@ func (x *ProfileResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProfileResponse.ProtoReflect.Descriptor instead.
 func (*ProfileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{31}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ProfileResponse) GetFirstName() string {
@@ -1666,7 +2125,7 @@ type PersonalInfoRequest struct {
 
 func (x *PersonalInfoRequest) Reset() {
 	*x = PersonalInfoRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[32]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1678,7 +2137,7 @@ func (x *PersonalInfoRequest) String() string {
 func (*PersonalInfoRequest) ProtoMessage() {}
 
 func (x *PersonalInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[32]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1691,7 +2150,7 @@ func (x *PersonalInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonalInfoRequest.ProtoReflect.Descriptor instead.
 func (*PersonalInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{32}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{38}
 }
 
 type PersonalInfoResponse struct {
@@ -1710,7 +2169,7 @@ type PersonalInfoResponse struct {
 
 func (x *PersonalInfoResponse) Reset() {
 	*x = PersonalInfoResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[33]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1722,7 +2181,7 @@ func (x *PersonalInfoResponse) String() string {
 func (*PersonalInfoResponse) ProtoMessage() {}
 
 func (x *PersonalInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[33]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,7 +2194,7 @@ func (x *PersonalInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonalInfoResponse.ProtoReflect.Descriptor instead.
 func (*PersonalInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{33}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *PersonalInfoResponse) GetEmail() string {
@@ -1810,7 +2269,7 @@ type UpdatePersonalInfoRequest struct {
 
 func (x *UpdatePersonalInfoRequest) Reset() {
 	*x = UpdatePersonalInfoRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[34]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1822,7 +2281,7 @@ func (x *UpdatePersonalInfoRequest) String() string {
 func (*UpdatePersonalInfoRequest) ProtoMessage() {}
 
 func (x *UpdatePersonalInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[34]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1835,7 +2294,7 @@ func (x *UpdatePersonalInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePersonalInfoRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePersonalInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{34}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *UpdatePersonalInfoRequest) GetEmail() string {
@@ -1902,7 +2361,7 @@ type UpdatePersonalInfoResponse struct {
 
 func (x *UpdatePersonalInfoResponse) Reset() {
 	*x = UpdatePersonalInfoResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[35]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1914,7 +2373,7 @@ func (x *UpdatePersonalInfoResponse) String() string {
 func (*UpdatePersonalInfoResponse) ProtoMessage() {}
 
 func (x *UpdatePersonalInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[35]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1927,7 +2386,7 @@ func (x *UpdatePersonalInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePersonalInfoResponse.ProtoReflect.Descriptor instead.
 func (*UpdatePersonalInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{35}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{41}
 }
 
 type UpdateAvatarRequest struct {
@@ -1939,7 +2398,7 @@ type UpdateAvatarRequest struct {
 
 func (x *UpdateAvatarRequest) Reset() {
 	*x = UpdateAvatarRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[36]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1951,7 +2410,7 @@ func (x *UpdateAvatarRequest) String() string {
 func (*UpdateAvatarRequest) ProtoMessage() {}
 
 func (x *UpdateAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[36]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,7 +2423,7 @@ func (x *UpdateAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAvatarRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{36}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *UpdateAvatarRequest) GetJpgData() []byte {
@@ -1983,7 +2442,7 @@ type UpdateAvatarResponse struct {
 
 func (x *UpdateAvatarResponse) Reset() {
 	*x = UpdateAvatarResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[37]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1995,7 +2454,7 @@ func (x *UpdateAvatarResponse) String() string {
 func (*UpdateAvatarResponse) ProtoMessage() {}
 
 func (x *UpdateAvatarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[37]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2467,7 @@ func (x *UpdateAvatarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAvatarResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAvatarResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{37}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UpdateAvatarResponse) GetAvatarUrl() string {
@@ -2026,7 +2485,7 @@ type RemoveAvatarRequest struct {
 
 func (x *RemoveAvatarRequest) Reset() {
 	*x = RemoveAvatarRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[38]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2038,7 +2497,7 @@ func (x *RemoveAvatarRequest) String() string {
 func (*RemoveAvatarRequest) ProtoMessage() {}
 
 func (x *RemoveAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[38]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +2510,7 @@ func (x *RemoveAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAvatarRequest.ProtoReflect.Descriptor instead.
 func (*RemoveAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{38}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{44}
 }
 
 type RemoveAvatarResponse struct {
@@ -2062,7 +2521,7 @@ type RemoveAvatarResponse struct {
 
 func (x *RemoveAvatarResponse) Reset() {
 	*x = RemoveAvatarResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[39]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2074,7 +2533,7 @@ func (x *RemoveAvatarResponse) String() string {
 func (*RemoveAvatarResponse) ProtoMessage() {}
 
 func (x *RemoveAvatarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[39]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2087,7 +2546,7 @@ func (x *RemoveAvatarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAvatarResponse.ProtoReflect.Descriptor instead.
 func (*RemoveAvatarResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{39}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{45}
 }
 
 type BriefProfilesRequest struct {
@@ -2099,7 +2558,7 @@ type BriefProfilesRequest struct {
 
 func (x *BriefProfilesRequest) Reset() {
 	*x = BriefProfilesRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[40]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2111,7 +2570,7 @@ func (x *BriefProfilesRequest) String() string {
 func (*BriefProfilesRequest) ProtoMessage() {}
 
 func (x *BriefProfilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[40]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2124,7 +2583,7 @@ func (x *BriefProfilesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BriefProfilesRequest.ProtoReflect.Descriptor instead.
 func (*BriefProfilesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{40}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *BriefProfilesRequest) GetUserIds() []string {
@@ -2151,7 +2610,7 @@ type BriefProfile struct {
 
 func (x *BriefProfile) Reset() {
 	*x = BriefProfile{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[41]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2163,7 +2622,7 @@ func (x *BriefProfile) String() string {
 func (*BriefProfile) ProtoMessage() {}
 
 func (x *BriefProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[41]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2176,7 +2635,7 @@ func (x *BriefProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BriefProfile.ProtoReflect.Descriptor instead.
 func (*BriefProfile) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{41}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *BriefProfile) GetUsername() string {
@@ -2244,7 +2703,7 @@ type BriefProfilesResponse struct {
 
 func (x *BriefProfilesResponse) Reset() {
 	*x = BriefProfilesResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[42]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2256,7 +2715,7 @@ func (x *BriefProfilesResponse) String() string {
 func (*BriefProfilesResponse) ProtoMessage() {}
 
 func (x *BriefProfilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[42]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2269,7 +2728,7 @@ func (x *BriefProfilesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BriefProfilesResponse.ProtoReflect.Descriptor instead.
 func (*BriefProfilesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{42}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *BriefProfilesResponse) GetResponse() map[string]*BriefProfile {
@@ -2287,7 +2746,7 @@ type BadgeMetadataRequest struct {
 
 func (x *BadgeMetadataRequest) Reset() {
 	*x = BadgeMetadataRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[43]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2299,7 +2758,7 @@ func (x *BadgeMetadataRequest) String() string {
 func (*BadgeMetadataRequest) ProtoMessage() {}
 
 func (x *BadgeMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[43]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2312,7 +2771,7 @@ func (x *BadgeMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BadgeMetadataRequest.ProtoReflect.Descriptor instead.
 func (*BadgeMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{43}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{49}
 }
 
 type BadgeMetadataResponse struct {
@@ -2324,7 +2783,7 @@ type BadgeMetadataResponse struct {
 
 func (x *BadgeMetadataResponse) Reset() {
 	*x = BadgeMetadataResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[44]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2336,7 +2795,7 @@ func (x *BadgeMetadataResponse) String() string {
 func (*BadgeMetadataResponse) ProtoMessage() {}
 
 func (x *BadgeMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[44]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2349,7 +2808,7 @@ func (x *BadgeMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BadgeMetadataResponse.ProtoReflect.Descriptor instead.
 func (*BadgeMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{44}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *BadgeMetadataResponse) GetBadges() map[string]string {
@@ -2368,7 +2827,7 @@ type UsernameSearchRequest struct {
 
 func (x *UsernameSearchRequest) Reset() {
 	*x = UsernameSearchRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[45]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2380,7 +2839,7 @@ func (x *UsernameSearchRequest) String() string {
 func (*UsernameSearchRequest) ProtoMessage() {}
 
 func (x *UsernameSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[45]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,7 +2852,7 @@ func (x *UsernameSearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameSearchRequest.ProtoReflect.Descriptor instead.
 func (*UsernameSearchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{45}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *UsernameSearchRequest) GetPrefix() string {
@@ -2412,7 +2871,7 @@ type UsernameSearchResponse struct {
 
 func (x *UsernameSearchResponse) Reset() {
 	*x = UsernameSearchResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[46]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2424,7 +2883,7 @@ func (x *UsernameSearchResponse) String() string {
 func (*UsernameSearchResponse) ProtoMessage() {}
 
 func (x *UsernameSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[46]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2437,7 +2896,7 @@ func (x *UsernameSearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameSearchResponse.ProtoReflect.Descriptor instead.
 func (*UsernameSearchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{46}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *UsernameSearchResponse) GetUsers() []*BasicUser {
@@ -2456,7 +2915,7 @@ type AddFollowRequest struct {
 
 func (x *AddFollowRequest) Reset() {
 	*x = AddFollowRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[47]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2468,7 +2927,7 @@ func (x *AddFollowRequest) String() string {
 func (*AddFollowRequest) ProtoMessage() {}
 
 func (x *AddFollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[47]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2481,7 +2940,7 @@ func (x *AddFollowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddFollowRequest.ProtoReflect.Descriptor instead.
 func (*AddFollowRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{47}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AddFollowRequest) GetUuid() string {
@@ -2500,7 +2959,7 @@ type RemoveFollowRequest struct {
 
 func (x *RemoveFollowRequest) Reset() {
 	*x = RemoveFollowRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[48]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2512,7 +2971,7 @@ func (x *RemoveFollowRequest) String() string {
 func (*RemoveFollowRequest) ProtoMessage() {}
 
 func (x *RemoveFollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[48]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,7 +2984,7 @@ func (x *RemoveFollowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveFollowRequest.ProtoReflect.Descriptor instead.
 func (*RemoveFollowRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{48}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *RemoveFollowRequest) GetUuid() string {
@@ -2543,7 +3002,7 @@ type GetFollowsRequest struct {
 
 func (x *GetFollowsRequest) Reset() {
 	*x = GetFollowsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[49]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2555,7 +3014,7 @@ func (x *GetFollowsRequest) String() string {
 func (*GetFollowsRequest) ProtoMessage() {}
 
 func (x *GetFollowsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[49]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,7 +3027,7 @@ func (x *GetFollowsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowsRequest.ProtoReflect.Descriptor instead.
 func (*GetFollowsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{49}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{55}
 }
 
 type AddBlockRequest struct {
@@ -2580,7 +3039,7 @@ type AddBlockRequest struct {
 
 func (x *AddBlockRequest) Reset() {
 	*x = AddBlockRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[50]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2592,7 +3051,7 @@ func (x *AddBlockRequest) String() string {
 func (*AddBlockRequest) ProtoMessage() {}
 
 func (x *AddBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[50]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2605,7 +3064,7 @@ func (x *AddBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddBlockRequest.ProtoReflect.Descriptor instead.
 func (*AddBlockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{50}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AddBlockRequest) GetUuid() string {
@@ -2624,7 +3083,7 @@ type RemoveBlockRequest struct {
 
 func (x *RemoveBlockRequest) Reset() {
 	*x = RemoveBlockRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[51]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2636,7 +3095,7 @@ func (x *RemoveBlockRequest) String() string {
 func (*RemoveBlockRequest) ProtoMessage() {}
 
 func (x *RemoveBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[51]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2649,7 +3108,7 @@ func (x *RemoveBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveBlockRequest.ProtoReflect.Descriptor instead.
 func (*RemoveBlockRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{51}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *RemoveBlockRequest) GetUuid() string {
@@ -2667,7 +3126,7 @@ type GetBlocksRequest struct {
 
 func (x *GetBlocksRequest) Reset() {
 	*x = GetBlocksRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[52]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2679,7 +3138,7 @@ func (x *GetBlocksRequest) String() string {
 func (*GetBlocksRequest) ProtoMessage() {}
 
 func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[52]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2692,7 +3151,7 @@ func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlocksRequest.ProtoReflect.Descriptor instead.
 func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{52}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{58}
 }
 
 type GetFullBlocksRequest struct {
@@ -2703,7 +3162,7 @@ type GetFullBlocksRequest struct {
 
 func (x *GetFullBlocksRequest) Reset() {
 	*x = GetFullBlocksRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[53]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2715,7 +3174,7 @@ func (x *GetFullBlocksRequest) String() string {
 func (*GetFullBlocksRequest) ProtoMessage() {}
 
 func (x *GetFullBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[53]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2728,7 +3187,7 @@ func (x *GetFullBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFullBlocksRequest.ProtoReflect.Descriptor instead.
 func (*GetFullBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{53}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{59}
 }
 
 type OKResponse struct {
@@ -2739,7 +3198,7 @@ type OKResponse struct {
 
 func (x *OKResponse) Reset() {
 	*x = OKResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[54]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2751,7 +3210,7 @@ func (x *OKResponse) String() string {
 func (*OKResponse) ProtoMessage() {}
 
 func (x *OKResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[54]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2764,7 +3223,7 @@ func (x *OKResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OKResponse.ProtoReflect.Descriptor instead.
 func (*OKResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{54}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{60}
 }
 
 type BasicUser struct {
@@ -2777,7 +3236,7 @@ type BasicUser struct {
 
 func (x *BasicUser) Reset() {
 	*x = BasicUser{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[55]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2789,7 +3248,7 @@ func (x *BasicUser) String() string {
 func (*BasicUser) ProtoMessage() {}
 
 func (x *BasicUser) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[55]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2802,7 +3261,7 @@ func (x *BasicUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicUser.ProtoReflect.Descriptor instead.
 func (*BasicUser) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{55}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *BasicUser) GetUuid() string {
@@ -2830,7 +3289,7 @@ type BasicFollowedUser struct {
 
 func (x *BasicFollowedUser) Reset() {
 	*x = BasicFollowedUser{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[56]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2842,7 +3301,7 @@ func (x *BasicFollowedUser) String() string {
 func (*BasicFollowedUser) ProtoMessage() {}
 
 func (x *BasicFollowedUser) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[56]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2855,7 +3314,7 @@ func (x *BasicFollowedUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicFollowedUser.ProtoReflect.Descriptor instead.
 func (*BasicFollowedUser) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{56}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *BasicFollowedUser) GetUuid() string {
@@ -2895,7 +3354,7 @@ type GetActiveChatChannelsRequest struct {
 
 func (x *GetActiveChatChannelsRequest) Reset() {
 	*x = GetActiveChatChannelsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[57]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2907,7 +3366,7 @@ func (x *GetActiveChatChannelsRequest) String() string {
 func (*GetActiveChatChannelsRequest) ProtoMessage() {}
 
 func (x *GetActiveChatChannelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[57]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2920,7 +3379,7 @@ func (x *GetActiveChatChannelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActiveChatChannelsRequest.ProtoReflect.Descriptor instead.
 func (*GetActiveChatChannelsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{57}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *GetActiveChatChannelsRequest) GetNumber() int32 {
@@ -2960,7 +3419,7 @@ type ActiveChatChannels struct {
 
 func (x *ActiveChatChannels) Reset() {
 	*x = ActiveChatChannels{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[58]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2972,7 +3431,185 @@ func (x *ActiveChatChannels) String() string {
 func (*ActiveChatChannels) ProtoMessage() {}
 
 func (x *ActiveChatChannels) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[58]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveChatChannels.ProtoReflect.Descriptor instead.
+func (*ActiveChatChannels) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ActiveChatChannels) GetChannels() []*ActiveChatChannels_Channel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type GetChatsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Channel string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// If set, only messages older than this message ID are returned, for
+	// paging back through a channel's history.
+	BeforeId      string `protobuf:"bytes,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChatsRequest) Reset() {
+	*x = GetChatsRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatsRequest) ProtoMessage() {}
+
+func (x *GetChatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatsRequest.ProtoReflect.Descriptor instead.
+func (*GetChatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetChatsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *GetChatsRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+type MarkChatChannelReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkChatChannelReadRequest) Reset() {
+	*x = MarkChatChannelReadRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkChatChannelReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkChatChannelReadRequest) ProtoMessage() {}
+
+func (x *MarkChatChannelReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkChatChannelReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkChatChannelReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MarkChatChannelReadRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type GetUnreadMessageCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadMessageCountRequest) Reset() {
+	*x = GetUnreadMessageCountRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadMessageCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadMessageCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadMessageCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadMessageCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadMessageCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{67}
+}
+
+type UnreadMessageCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnreadMessageCount) Reset() {
+	*x = UnreadMessageCount{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreadMessageCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadMessageCount) ProtoMessage() {}
+
+func (x *UnreadMessageCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2983,40 +3620,42 @@ func (x *ActiveChatChannels) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveChatChannels.ProtoReflect.Descriptor instead.
-func (*ActiveChatChannels) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use UnreadMessageCount.ProtoReflect.Descriptor instead.
+func (*UnreadMessageCount) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ActiveChatChannels) GetChannels() []*ActiveChatChannels_Channel {
+func (x *UnreadMessageCount) GetCount() int32 {
 	if x != nil {
-		return x.Channels
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type GetChatsRequest struct {
+type SearchPrivateMessagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetChatsRequest) Reset() {
-	*x = GetChatsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[59]
+func (x *SearchPrivateMessagesRequest) Reset() {
+	*x = SearchPrivateMessagesRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetChatsRequest) String() string {
+func (x *SearchPrivateMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChatsRequest) ProtoMessage() {}
+func (*SearchPrivateMessagesRequest) ProtoMessage() {}
 
-func (x *GetChatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[59]
+func (x *SearchPrivateMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,18 +3666,32 @@ func (x *GetChatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChatsRequest.ProtoReflect.Descriptor instead.
-func (*GetChatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use SearchPrivateMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchPrivateMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetChatsRequest) GetChannel() string {
+func (x *SearchPrivateMessagesRequest) GetQuery() string {
 	if x != nil {
-		return x.Channel
+		return x.Query
 	}
 	return ""
 }
 
+func (x *SearchPrivateMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchPrivateMessagesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type GetFollowsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*BasicFollowedUser   `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
@@ -3048,7 +3701,7 @@ type GetFollowsResponse struct {
 
 func (x *GetFollowsResponse) Reset() {
 	*x = GetFollowsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[60]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3060,7 +3713,7 @@ func (x *GetFollowsResponse) String() string {
 func (*GetFollowsResponse) ProtoMessage() {}
 
 func (x *GetFollowsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[60]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3073,7 +3726,7 @@ func (x *GetFollowsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFollowsResponse.ProtoReflect.Descriptor instead.
 func (*GetFollowsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{60}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *GetFollowsResponse) GetUsers() []*BasicFollowedUser {
@@ -3092,7 +3745,7 @@ type GetBlocksResponse struct {
 
 func (x *GetBlocksResponse) Reset() {
 	*x = GetBlocksResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[61]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3104,7 +3757,7 @@ func (x *GetBlocksResponse) String() string {
 func (*GetBlocksResponse) ProtoMessage() {}
 
 func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[61]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3117,7 +3770,7 @@ func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlocksResponse.ProtoReflect.Descriptor instead.
 func (*GetBlocksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{61}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetBlocksResponse) GetUsers() []*BasicUser {
@@ -3138,7 +3791,7 @@ type GetFullBlocksResponse struct {
 
 func (x *GetFullBlocksResponse) Reset() {
 	*x = GetFullBlocksResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[62]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3150,7 +3803,7 @@ func (x *GetFullBlocksResponse) String() string {
 func (*GetFullBlocksResponse) ProtoMessage() {}
 
 func (x *GetFullBlocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[62]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3163,7 +3816,7 @@ func (x *GetFullBlocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFullBlocksResponse.ProtoReflect.Descriptor instead.
 func (*GetFullBlocksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{62}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetFullBlocksResponse) GetUserIds() []string {
@@ -3184,7 +3837,7 @@ type Integration struct {
 
 func (x *Integration) Reset() {
 	*x = Integration{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[63]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3196,7 +3849,7 @@ func (x *Integration) String() string {
 func (*Integration) ProtoMessage() {}
 
 func (x *Integration) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[63]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3209,7 +3862,7 @@ func (x *Integration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Integration.ProtoReflect.Descriptor instead.
 func (*Integration) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{63}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *Integration) GetUuid() string {
@@ -3241,7 +3894,7 @@ type GetIntegrationsRequest struct {
 
 func (x *GetIntegrationsRequest) Reset() {
 	*x = GetIntegrationsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[64]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3253,7 +3906,7 @@ func (x *GetIntegrationsRequest) String() string {
 func (*GetIntegrationsRequest) ProtoMessage() {}
 
 func (x *GetIntegrationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[64]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3266,7 +3919,7 @@ func (x *GetIntegrationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIntegrationsRequest.ProtoReflect.Descriptor instead.
 func (*GetIntegrationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{64}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{74}
 }
 
 type IntegrationsResponse struct {
@@ -3278,7 +3931,7 @@ type IntegrationsResponse struct {
 
 func (x *IntegrationsResponse) Reset() {
 	*x = IntegrationsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[65]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3290,7 +3943,7 @@ func (x *IntegrationsResponse) String() string {
 func (*IntegrationsResponse) ProtoMessage() {}
 
 func (x *IntegrationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[65]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3303,7 +3956,7 @@ func (x *IntegrationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegrationsResponse.ProtoReflect.Descriptor instead.
 func (*IntegrationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{65}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *IntegrationsResponse) GetIntegrations() []*Integration {
@@ -3322,7 +3975,7 @@ type DeleteIntegrationRequest struct {
 
 func (x *DeleteIntegrationRequest) Reset() {
 	*x = DeleteIntegrationRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[66]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3334,7 +3987,7 @@ func (x *DeleteIntegrationRequest) String() string {
 func (*DeleteIntegrationRequest) ProtoMessage() {}
 
 func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[66]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3347,7 +4000,7 @@ func (x *DeleteIntegrationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntegrationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{66}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *DeleteIntegrationRequest) GetUuid() string {
@@ -3365,7 +4018,7 @@ type DeleteIntegrationResponse struct {
 
 func (x *DeleteIntegrationResponse) Reset() {
 	*x = DeleteIntegrationResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[67]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3377,7 +4030,7 @@ func (x *DeleteIntegrationResponse) String() string {
 func (*DeleteIntegrationResponse) ProtoMessage() {}
 
 func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[67]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3390,7 +4043,237 @@ func (x *DeleteIntegrationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteIntegrationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteIntegrationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{67}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{77}
+}
+
+// LoginIdentity is an external identity (Google, Discord, ...) that can be
+// used to log in to this account.
+type LoginIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	LinkedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	LastLoginAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginIdentity) Reset() {
+	*x = LoginIdentity{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginIdentity) ProtoMessage() {}
+
+func (x *LoginIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginIdentity.ProtoReflect.Descriptor instead.
+func (*LoginIdentity) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *LoginIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LoginIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginIdentity) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+func (x *LoginIdentity) GetLastLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return nil
+}
+
+type GetLoginIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginIdentitiesRequest) Reset() {
+	*x = GetLoginIdentitiesRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginIdentitiesRequest) ProtoMessage() {}
+
+func (x *GetLoginIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{79}
+}
+
+type LoginIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*LoginIdentity       `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginIdentitiesResponse) Reset() {
+	*x = LoginIdentitiesResponse{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginIdentitiesResponse) ProtoMessage() {}
+
+func (x *LoginIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*LoginIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *LoginIdentitiesResponse) GetIdentities() []*LoginIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type UnlinkLoginIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkLoginIdentityRequest) Reset() {
+	*x = UnlinkLoginIdentityRequest{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkLoginIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkLoginIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkLoginIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkLoginIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkLoginIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UnlinkLoginIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+type UnlinkLoginIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkLoginIdentityResponse) Reset() {
+	*x = UnlinkLoginIdentityResponse{}
+	mi := &file_proto_user_service_user_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkLoginIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkLoginIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkLoginIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_service_user_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkLoginIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkLoginIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{82}
 }
 
 type GetSubscriptionCriteriaRequest struct {
@@ -3401,7 +4284,7 @@ type GetSubscriptionCriteriaRequest struct {
 
 func (x *GetSubscriptionCriteriaRequest) Reset() {
 	*x = GetSubscriptionCriteriaRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[68]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3413,7 +4296,7 @@ func (x *GetSubscriptionCriteriaRequest) String() string {
 func (*GetSubscriptionCriteriaRequest) ProtoMessage() {}
 
 func (x *GetSubscriptionCriteriaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[68]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3426,7 +4309,7 @@ func (x *GetSubscriptionCriteriaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionCriteriaRequest.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionCriteriaRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{68}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{83}
 }
 
 type GetSubscriptionCriteriaResponse struct {
@@ -3440,7 +4323,7 @@ type GetSubscriptionCriteriaResponse struct {
 
 func (x *GetSubscriptionCriteriaResponse) Reset() {
 	*x = GetSubscriptionCriteriaResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[69]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3452,7 +4335,7 @@ func (x *GetSubscriptionCriteriaResponse) String() string {
 func (*GetSubscriptionCriteriaResponse) ProtoMessage() {}
 
 func (x *GetSubscriptionCriteriaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[69]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3465,7 +4348,7 @@ func (x *GetSubscriptionCriteriaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubscriptionCriteriaResponse.ProtoReflect.Descriptor instead.
 func (*GetSubscriptionCriteriaResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{69}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetSubscriptionCriteriaResponse) GetTierName() string {
@@ -3497,7 +4380,7 @@ type GetModListRequest struct {
 
 func (x *GetModListRequest) Reset() {
 	*x = GetModListRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[70]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3509,7 +4392,7 @@ func (x *GetModListRequest) String() string {
 func (*GetModListRequest) ProtoMessage() {}
 
 func (x *GetModListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[70]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3522,7 +4405,7 @@ func (x *GetModListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModListRequest.ProtoReflect.Descriptor instead.
 func (*GetModListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{70}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{85}
 }
 
 type GetModListResponse struct {
@@ -3535,7 +4418,7 @@ type GetModListResponse struct {
 
 func (x *GetModListResponse) Reset() {
 	*x = GetModListResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[71]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3547,7 +4430,7 @@ func (x *GetModListResponse) String() string {
 func (*GetModListResponse) ProtoMessage() {}
 
 func (x *GetModListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[71]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3560,7 +4443,7 @@ func (x *GetModListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetModListResponse.ProtoReflect.Descriptor instead.
 func (*GetModListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{71}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetModListResponse) GetAdminUserIds() []string {
@@ -3587,7 +4470,7 @@ type AddRoleRequest struct {
 
 func (x *AddRoleRequest) Reset() {
 	*x = AddRoleRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[72]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3599,7 +4482,7 @@ func (x *AddRoleRequest) String() string {
 func (*AddRoleRequest) ProtoMessage() {}
 
 func (x *AddRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[72]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3612,7 +4495,7 @@ func (x *AddRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddRoleRequest.ProtoReflect.Descriptor instead.
 func (*AddRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{72}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *AddRoleRequest) GetName() string {
@@ -3637,7 +4520,7 @@ type AddRoleResponse struct {
 
 func (x *AddRoleResponse) Reset() {
 	*x = AddRoleResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[73]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3649,7 +4532,7 @@ func (x *AddRoleResponse) String() string {
 func (*AddRoleResponse) ProtoMessage() {}
 
 func (x *AddRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[73]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3662,7 +4545,7 @@ func (x *AddRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddRoleResponse.ProtoReflect.Descriptor instead.
 func (*AddRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{73}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{88}
 }
 
 type AddPermissionRequest struct {
@@ -3675,7 +4558,7 @@ type AddPermissionRequest struct {
 
 func (x *AddPermissionRequest) Reset() {
 	*x = AddPermissionRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[74]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3687,7 +4570,7 @@ func (x *AddPermissionRequest) String() string {
 func (*AddPermissionRequest) ProtoMessage() {}
 
 func (x *AddPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[74]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3700,7 +4583,7 @@ func (x *AddPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPermissionRequest.ProtoReflect.Descriptor instead.
 func (*AddPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{74}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *AddPermissionRequest) GetCode() string {
@@ -3725,7 +4608,7 @@ type AddPermissionResponse struct {
 
 func (x *AddPermissionResponse) Reset() {
 	*x = AddPermissionResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[75]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3737,7 +4620,7 @@ func (x *AddPermissionResponse) String() string {
 func (*AddPermissionResponse) ProtoMessage() {}
 
 func (x *AddPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[75]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3750,7 +4633,7 @@ func (x *AddPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPermissionResponse.ProtoReflect.Descriptor instead.
 func (*AddPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{75}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{90}
 }
 
 type LinkRoleAndPermissionRequest struct {
@@ -3763,7 +4646,7 @@ type LinkRoleAndPermissionRequest struct {
 
 func (x *LinkRoleAndPermissionRequest) Reset() {
 	*x = LinkRoleAndPermissionRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[76]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3775,7 +4658,7 @@ func (x *LinkRoleAndPermissionRequest) String() string {
 func (*LinkRoleAndPermissionRequest) ProtoMessage() {}
 
 func (x *LinkRoleAndPermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[76]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3788,7 +4671,7 @@ func (x *LinkRoleAndPermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkRoleAndPermissionRequest.ProtoReflect.Descriptor instead.
 func (*LinkRoleAndPermissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{76}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *LinkRoleAndPermissionRequest) GetRoleName() string {
@@ -3813,7 +4696,7 @@ type LinkRoleAndPermissionResponse struct {
 
 func (x *LinkRoleAndPermissionResponse) Reset() {
 	*x = LinkRoleAndPermissionResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[77]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3825,7 +4708,7 @@ func (x *LinkRoleAndPermissionResponse) String() string {
 func (*LinkRoleAndPermissionResponse) ProtoMessage() {}
 
 func (x *LinkRoleAndPermissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[77]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,7 +4721,7 @@ func (x *LinkRoleAndPermissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LinkRoleAndPermissionResponse.ProtoReflect.Descriptor instead.
 func (*LinkRoleAndPermissionResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{77}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{92}
 }
 
 type AssignRoleResponse struct {
@@ -3849,7 +4732,7 @@ type AssignRoleResponse struct {
 
 func (x *AssignRoleResponse) Reset() {
 	*x = AssignRoleResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[78]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3861,7 +4744,7 @@ func (x *AssignRoleResponse) String() string {
 func (*AssignRoleResponse) ProtoMessage() {}
 
 func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[78]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3874,7 +4757,7 @@ func (x *AssignRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignRoleResponse.ProtoReflect.Descriptor instead.
 func (*AssignRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{78}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{93}
 }
 
 type UserAndRole struct {
@@ -3887,7 +4770,7 @@ type UserAndRole struct {
 
 func (x *UserAndRole) Reset() {
 	*x = UserAndRole{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[79]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3899,7 +4782,7 @@ func (x *UserAndRole) String() string {
 func (*UserAndRole) ProtoMessage() {}
 
 func (x *UserAndRole) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[79]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3912,7 +4795,7 @@ func (x *UserAndRole) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAndRole.ProtoReflect.Descriptor instead.
 func (*UserAndRole) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{79}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *UserAndRole) GetUsername() string {
@@ -3937,7 +4820,7 @@ type UnassignRoleResponse struct {
 
 func (x *UnassignRoleResponse) Reset() {
 	*x = UnassignRoleResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[80]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3949,7 +4832,7 @@ func (x *UnassignRoleResponse) String() string {
 func (*UnassignRoleResponse) ProtoMessage() {}
 
 func (x *UnassignRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[80]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3962,7 +4845,7 @@ func (x *UnassignRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnassignRoleResponse.ProtoReflect.Descriptor instead.
 func (*UnassignRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{80}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{95}
 }
 
 type GetUserRolesRequest struct {
@@ -3974,7 +4857,7 @@ type GetUserRolesRequest struct {
 
 func (x *GetUserRolesRequest) Reset() {
 	*x = GetUserRolesRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[81]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3986,7 +4869,7 @@ func (x *GetUserRolesRequest) String() string {
 func (*GetUserRolesRequest) ProtoMessage() {}
 
 func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[81]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3999,7 +4882,7 @@ func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{81}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetUserRolesRequest) GetUsername() string {
@@ -4018,7 +4901,7 @@ type UserRolesResponse struct {
 
 func (x *UserRolesResponse) Reset() {
 	*x = UserRolesResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[82]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4030,7 +4913,7 @@ func (x *UserRolesResponse) String() string {
 func (*UserRolesResponse) ProtoMessage() {}
 
 func (x *UserRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[82]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4043,7 +4926,7 @@ func (x *UserRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserRolesResponse.ProtoReflect.Descriptor instead.
 func (*UserRolesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{82}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *UserRolesResponse) GetRoles() []string {
@@ -4061,7 +4944,7 @@ type GetSelfRolesRequest struct {
 
 func (x *GetSelfRolesRequest) Reset() {
 	*x = GetSelfRolesRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[83]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4073,7 +4956,7 @@ func (x *GetSelfRolesRequest) String() string {
 func (*GetSelfRolesRequest) ProtoMessage() {}
 
 func (x *GetSelfRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[83]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4086,7 +4969,7 @@ func (x *GetSelfRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSelfRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetSelfRolesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{83}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{98}
 }
 
 type GetSelfPermissionsRequest struct {
@@ -4097,7 +4980,7 @@ type GetSelfPermissionsRequest struct {
 
 func (x *GetSelfPermissionsRequest) Reset() {
 	*x = GetSelfPermissionsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[84]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4109,7 +4992,7 @@ func (x *GetSelfPermissionsRequest) String() string {
 func (*GetSelfPermissionsRequest) ProtoMessage() {}
 
 func (x *GetSelfPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[84]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4122,7 +5005,7 @@ func (x *GetSelfPermissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSelfPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*GetSelfPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{84}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{99}
 }
 
 type SelfPermissionsResponse struct {
@@ -4134,7 +5017,7 @@ type SelfPermissionsResponse struct {
 
 func (x *SelfPermissionsResponse) Reset() {
 	*x = SelfPermissionsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[85]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4146,7 +5029,7 @@ func (x *SelfPermissionsResponse) String() string {
 func (*SelfPermissionsResponse) ProtoMessage() {}
 
 func (x *SelfPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[85]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4159,7 +5042,7 @@ func (x *SelfPermissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*SelfPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{85}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *SelfPermissionsResponse) GetPermissions() []string {
@@ -4178,7 +5061,7 @@ type GetUsersWithRolesRequest struct {
 
 func (x *GetUsersWithRolesRequest) Reset() {
 	*x = GetUsersWithRolesRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[86]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4190,7 +5073,7 @@ func (x *GetUsersWithRolesRequest) String() string {
 func (*GetUsersWithRolesRequest) ProtoMessage() {}
 
 func (x *GetUsersWithRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[86]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4203,7 +5086,7 @@ func (x *GetUsersWithRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersWithRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetUsersWithRolesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{86}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *GetUsersWithRolesRequest) GetRoles() []string {
@@ -4222,7 +5105,7 @@ type GetUsersWithRolesResponse struct {
 
 func (x *GetUsersWithRolesResponse) Reset() {
 	*x = GetUsersWithRolesResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[87]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4234,7 +5117,7 @@ func (x *GetUsersWithRolesResponse) String() string {
 func (*GetUsersWithRolesResponse) ProtoMessage() {}
 
 func (x *GetUsersWithRolesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[87]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4247,7 +5130,7 @@ func (x *GetUsersWithRolesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsersWithRolesResponse.ProtoReflect.Descriptor instead.
 func (*GetUsersWithRolesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{87}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetUsersWithRolesResponse) GetUserAndRoleObjs() []*UserAndRole {
@@ -4265,7 +5148,7 @@ type GetRoleMetadataRequest struct {
 
 func (x *GetRoleMetadataRequest) Reset() {
 	*x = GetRoleMetadataRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[88]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4277,7 +5160,7 @@ func (x *GetRoleMetadataRequest) String() string {
 func (*GetRoleMetadataRequest) ProtoMessage() {}
 
 func (x *GetRoleMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[88]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4290,7 +5173,7 @@ func (x *GetRoleMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRoleMetadataRequest.ProtoReflect.Descriptor instead.
 func (*GetRoleMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{88}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{103}
 }
 
 type RoleWithPermissions struct {
@@ -4303,7 +5186,7 @@ type RoleWithPermissions struct {
 
 func (x *RoleWithPermissions) Reset() {
 	*x = RoleWithPermissions{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[89]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4315,7 +5198,7 @@ func (x *RoleWithPermissions) String() string {
 func (*RoleWithPermissions) ProtoMessage() {}
 
 func (x *RoleWithPermissions) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[89]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4328,7 +5211,7 @@ func (x *RoleWithPermissions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoleWithPermissions.ProtoReflect.Descriptor instead.
 func (*RoleWithPermissions) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{89}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *RoleWithPermissions) GetRoleName() string {
@@ -4354,7 +5237,7 @@ type RoleMetadataResponse struct {
 
 func (x *RoleMetadataResponse) Reset() {
 	*x = RoleMetadataResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[90]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4366,7 +5249,7 @@ func (x *RoleMetadataResponse) String() string {
 func (*RoleMetadataResponse) ProtoMessage() {}
 
 func (x *RoleMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[90]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4379,7 +5262,7 @@ func (x *RoleMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoleMetadataResponse.ProtoReflect.Descriptor instead.
 func (*RoleMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{90}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *RoleMetadataResponse) GetRolesWithPermissions() []*RoleWithPermissions {
@@ -4400,7 +5283,7 @@ type ConnectOrganizationRequest struct {
 
 func (x *ConnectOrganizationRequest) Reset() {
 	*x = ConnectOrganizationRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[91]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4412,7 +5295,7 @@ func (x *ConnectOrganizationRequest) String() string {
 func (*ConnectOrganizationRequest) ProtoMessage() {}
 
 func (x *ConnectOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[91]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4425,7 +5308,7 @@ func (x *ConnectOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*ConnectOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{91}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *ConnectOrganizationRequest) GetOrganizationCode() string {
@@ -4460,7 +5343,7 @@ type ConnectOrganizationResponse struct {
 
 func (x *ConnectOrganizationResponse) Reset() {
 	*x = ConnectOrganizationResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[92]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4472,7 +5355,7 @@ func (x *ConnectOrganizationResponse) String() string {
 func (*ConnectOrganizationResponse) ProtoMessage() {}
 
 func (x *ConnectOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[92]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4485,7 +5368,7 @@ func (x *ConnectOrganizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*ConnectOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{92}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *ConnectOrganizationResponse) GetSuccess() bool {
@@ -4520,7 +5403,7 @@ type DisconnectOrganizationRequest struct {
 
 func (x *DisconnectOrganizationRequest) Reset() {
 	*x = DisconnectOrganizationRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[93]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4532,7 +5415,7 @@ func (x *DisconnectOrganizationRequest) String() string {
 func (*DisconnectOrganizationRequest) ProtoMessage() {}
 
 func (x *DisconnectOrganizationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[93]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4545,7 +5428,7 @@ func (x *DisconnectOrganizationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisconnectOrganizationRequest.ProtoReflect.Descriptor instead.
 func (*DisconnectOrganizationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{93}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *DisconnectOrganizationRequest) GetOrganizationCode() string {
@@ -4571,7 +5454,7 @@ type DisconnectOrganizationResponse struct {
 
 func (x *DisconnectOrganizationResponse) Reset() {
 	*x = DisconnectOrganizationResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[94]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4583,7 +5466,7 @@ func (x *DisconnectOrganizationResponse) String() string {
 func (*DisconnectOrganizationResponse) ProtoMessage() {}
 
 func (x *DisconnectOrganizationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[94]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4596,7 +5479,7 @@ func (x *DisconnectOrganizationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisconnectOrganizationResponse.ProtoReflect.Descriptor instead.
 func (*DisconnectOrganizationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{94}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *DisconnectOrganizationResponse) GetSuccess() bool {
@@ -4614,7 +5497,7 @@ type RefreshTitlesRequest struct {
 
 func (x *RefreshTitlesRequest) Reset() {
 	*x = RefreshTitlesRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[95]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4626,7 +5509,7 @@ func (x *RefreshTitlesRequest) String() string {
 func (*RefreshTitlesRequest) ProtoMessage() {}
 
 func (x *RefreshTitlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[95]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4639,7 +5522,7 @@ func (x *RefreshTitlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTitlesRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTitlesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{95}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{110}
 }
 
 type RefreshTitlesResponse struct {
@@ -4652,7 +5535,7 @@ type RefreshTitlesResponse struct {
 
 func (x *RefreshTitlesResponse) Reset() {
 	*x = RefreshTitlesResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[96]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4664,7 +5547,7 @@ func (x *RefreshTitlesResponse) String() string {
 func (*RefreshTitlesResponse) ProtoMessage() {}
 
 func (x *RefreshTitlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[96]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4677,7 +5560,7 @@ func (x *RefreshTitlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTitlesResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTitlesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{96}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *RefreshTitlesResponse) GetTitles() []*OrganizationTitle {
@@ -4702,7 +5585,7 @@ type GetMyOrganizationsRequest struct {
 
 func (x *GetMyOrganizationsRequest) Reset() {
 	*x = GetMyOrganizationsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[97]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4714,7 +5597,7 @@ func (x *GetMyOrganizationsRequest) String() string {
 func (*GetMyOrganizationsRequest) ProtoMessage() {}
 
 func (x *GetMyOrganizationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[97]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4727,7 +5610,7 @@ func (x *GetMyOrganizationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyOrganizationsRequest.ProtoReflect.Descriptor instead.
 func (*GetMyOrganizationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{97}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{112}
 }
 
 type GetMyOrganizationsResponse struct {
@@ -4739,7 +5622,7 @@ type GetMyOrganizationsResponse struct {
 
 func (x *GetMyOrganizationsResponse) Reset() {
 	*x = GetMyOrganizationsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[98]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4751,7 +5634,7 @@ func (x *GetMyOrganizationsResponse) String() string {
 func (*GetMyOrganizationsResponse) ProtoMessage() {}
 
 func (x *GetMyOrganizationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[98]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4764,7 +5647,7 @@ func (x *GetMyOrganizationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMyOrganizationsResponse.ProtoReflect.Descriptor instead.
 func (*GetMyOrganizationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{98}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetMyOrganizationsResponse) GetTitles() []*OrganizationTitle {
@@ -4783,7 +5666,7 @@ type GetPublicOrganizationsRequest struct {
 
 func (x *GetPublicOrganizationsRequest) Reset() {
 	*x = GetPublicOrganizationsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[99]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4795,7 +5678,7 @@ func (x *GetPublicOrganizationsRequest) String() string {
 func (*GetPublicOrganizationsRequest) ProtoMessage() {}
 
 func (x *GetPublicOrganizationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[99]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4808,7 +5691,7 @@ func (x *GetPublicOrganizationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicOrganizationsRequest.ProtoReflect.Descriptor instead.
 func (*GetPublicOrganizationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{99}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GetPublicOrganizationsRequest) GetUsername() string {
@@ -4827,7 +5710,7 @@ type GetPublicOrganizationsResponse struct {
 
 func (x *GetPublicOrganizationsResponse) Reset() {
 	*x = GetPublicOrganizationsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[100]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4839,7 +5722,7 @@ func (x *GetPublicOrganizationsResponse) String() string {
 func (*GetPublicOrganizationsResponse) ProtoMessage() {}
 
 func (x *GetPublicOrganizationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[100]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4852,7 +5735,7 @@ func (x *GetPublicOrganizationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPublicOrganizationsResponse.ProtoReflect.Descriptor instead.
 func (*GetPublicOrganizationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{100}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetPublicOrganizationsResponse) GetTitles() []*OrganizationTitle {
@@ -4874,7 +5757,7 @@ type SubmitVerificationRequest struct {
 
 func (x *SubmitVerificationRequest) Reset() {
 	*x = SubmitVerificationRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[101]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4886,7 +5769,7 @@ func (x *SubmitVerificationRequest) String() string {
 func (*SubmitVerificationRequest) ProtoMessage() {}
 
 func (x *SubmitVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[101]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4899,7 +5782,7 @@ func (x *SubmitVerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitVerificationRequest.ProtoReflect.Descriptor instead.
 func (*SubmitVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{101}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *SubmitVerificationRequest) GetOrganizationCode() string {
@@ -4941,7 +5824,7 @@ type SubmitVerificationResponse struct {
 
 func (x *SubmitVerificationResponse) Reset() {
 	*x = SubmitVerificationResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[102]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4953,7 +5836,7 @@ func (x *SubmitVerificationResponse) String() string {
 func (*SubmitVerificationResponse) ProtoMessage() {}
 
 func (x *SubmitVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[102]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4966,7 +5849,7 @@ func (x *SubmitVerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitVerificationResponse.ProtoReflect.Descriptor instead.
 func (*SubmitVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{102}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *SubmitVerificationResponse) GetSuccess() bool {
@@ -4998,7 +5881,7 @@ type GetPendingVerificationsRequest struct {
 
 func (x *GetPendingVerificationsRequest) Reset() {
 	*x = GetPendingVerificationsRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[103]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5010,7 +5893,7 @@ func (x *GetPendingVerificationsRequest) String() string {
 func (*GetPendingVerificationsRequest) ProtoMessage() {}
 
 func (x *GetPendingVerificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[103]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5023,7 +5906,7 @@ func (x *GetPendingVerificationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPendingVerificationsRequest.ProtoReflect.Descriptor instead.
 func (*GetPendingVerificationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{103}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{118}
 }
 
 type VerificationRequestInfo struct {
@@ -5045,7 +5928,7 @@ type VerificationRequestInfo struct {
 
 func (x *VerificationRequestInfo) Reset() {
 	*x = VerificationRequestInfo{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[104]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5057,7 +5940,7 @@ func (x *VerificationRequestInfo) String() string {
 func (*VerificationRequestInfo) ProtoMessage() {}
 
 func (x *VerificationRequestInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[104]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5070,7 +5953,7 @@ func (x *VerificationRequestInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerificationRequestInfo.ProtoReflect.Descriptor instead.
 func (*VerificationRequestInfo) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{104}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *VerificationRequestInfo) GetRequestId() int64 {
@@ -5159,7 +6042,7 @@ type GetPendingVerificationsResponse struct {
 
 func (x *GetPendingVerificationsResponse) Reset() {
 	*x = GetPendingVerificationsResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[105]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5171,7 +6054,7 @@ func (x *GetPendingVerificationsResponse) String() string {
 func (*GetPendingVerificationsResponse) ProtoMessage() {}
 
 func (x *GetPendingVerificationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[105]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5184,7 +6067,7 @@ func (x *GetPendingVerificationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPendingVerificationsResponse.ProtoReflect.Descriptor instead.
 func (*GetPendingVerificationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{105}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *GetPendingVerificationsResponse) GetRequests() []*VerificationRequestInfo {
@@ -5204,7 +6087,7 @@ type ApproveVerificationRequest struct {
 
 func (x *ApproveVerificationRequest) Reset() {
 	*x = ApproveVerificationRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[106]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5216,7 +6099,7 @@ func (x *ApproveVerificationRequest) String() string {
 func (*ApproveVerificationRequest) ProtoMessage() {}
 
 func (x *ApproveVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[106]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5229,7 +6112,7 @@ func (x *ApproveVerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveVerificationRequest.ProtoReflect.Descriptor instead.
 func (*ApproveVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{106}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *ApproveVerificationRequest) GetRequestId() int64 {
@@ -5256,7 +6139,7 @@ type ApproveVerificationResponse struct {
 
 func (x *ApproveVerificationResponse) Reset() {
 	*x = ApproveVerificationResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[107]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5268,7 +6151,7 @@ func (x *ApproveVerificationResponse) String() string {
 func (*ApproveVerificationResponse) ProtoMessage() {}
 
 func (x *ApproveVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[107]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5281,7 +6164,7 @@ func (x *ApproveVerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveVerificationResponse.ProtoReflect.Descriptor instead.
 func (*ApproveVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{107}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *ApproveVerificationResponse) GetSuccess() bool {
@@ -5308,7 +6191,7 @@ type RejectVerificationRequest struct {
 
 func (x *RejectVerificationRequest) Reset() {
 	*x = RejectVerificationRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[108]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5320,7 +6203,7 @@ func (x *RejectVerificationRequest) String() string {
 func (*RejectVerificationRequest) ProtoMessage() {}
 
 func (x *RejectVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[108]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5333,7 +6216,7 @@ func (x *RejectVerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectVerificationRequest.ProtoReflect.Descriptor instead.
 func (*RejectVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{108}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *RejectVerificationRequest) GetRequestId() int64 {
@@ -5360,7 +6243,7 @@ type RejectVerificationResponse struct {
 
 func (x *RejectVerificationResponse) Reset() {
 	*x = RejectVerificationResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[109]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5372,7 +6255,7 @@ func (x *RejectVerificationResponse) String() string {
 func (*RejectVerificationResponse) ProtoMessage() {}
 
 func (x *RejectVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[109]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5385,7 +6268,7 @@ func (x *RejectVerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectVerificationResponse.ProtoReflect.Descriptor instead.
 func (*RejectVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{109}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *RejectVerificationResponse) GetSuccess() bool {
@@ -5411,7 +6294,7 @@ type GetVerificationImageUrlRequest struct {
 
 func (x *GetVerificationImageUrlRequest) Reset() {
 	*x = GetVerificationImageUrlRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[110]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5423,7 +6306,7 @@ func (x *GetVerificationImageUrlRequest) String() string {
 func (*GetVerificationImageUrlRequest) ProtoMessage() {}
 
 func (x *GetVerificationImageUrlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[110]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5436,7 +6319,7 @@ func (x *GetVerificationImageUrlRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVerificationImageUrlRequest.ProtoReflect.Descriptor instead.
 func (*GetVerificationImageUrlRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{110}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *GetVerificationImageUrlRequest) GetRequestId() int64 {
@@ -5455,7 +6338,7 @@ type GetVerificationImageUrlResponse struct {
 
 func (x *GetVerificationImageUrlResponse) Reset() {
 	*x = GetVerificationImageUrlResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[111]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5467,7 +6350,7 @@ func (x *GetVerificationImageUrlResponse) String() string {
 func (*GetVerificationImageUrlResponse) ProtoMessage() {}
 
 func (x *GetVerificationImageUrlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[111]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5480,7 +6363,7 @@ func (x *GetVerificationImageUrlResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVerificationImageUrlResponse.ProtoReflect.Descriptor instead.
 func (*GetVerificationImageUrlResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{111}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *GetVerificationImageUrlResponse) GetImageUrl() string {
@@ -5505,7 +6388,7 @@ type ManuallySetOrgMembershipRequest struct {
 
 func (x *ManuallySetOrgMembershipRequest) Reset() {
 	*x = ManuallySetOrgMembershipRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[112]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5517,7 +6400,7 @@ func (x *ManuallySetOrgMembershipRequest) String() string {
 func (*ManuallySetOrgMembershipRequest) ProtoMessage() {}
 
 func (x *ManuallySetOrgMembershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[112]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5530,7 +6413,7 @@ func (x *ManuallySetOrgMembershipRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManuallySetOrgMembershipRequest.ProtoReflect.Descriptor instead.
 func (*ManuallySetOrgMembershipRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{112}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *ManuallySetOrgMembershipRequest) GetUsername() string {
@@ -5571,7 +6454,7 @@ type ManuallySetOrgMembershipResponse struct {
 
 func (x *ManuallySetOrgMembershipResponse) Reset() {
 	*x = ManuallySetOrgMembershipResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[113]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5583,7 +6466,7 @@ func (x *ManuallySetOrgMembershipResponse) String() string {
 func (*ManuallySetOrgMembershipResponse) ProtoMessage() {}
 
 func (x *ManuallySetOrgMembershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[113]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5596,7 +6479,7 @@ func (x *ManuallySetOrgMembershipResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManuallySetOrgMembershipResponse.ProtoReflect.Descriptor instead.
 func (*ManuallySetOrgMembershipResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{113}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *ManuallySetOrgMembershipResponse) GetSuccess() bool {
@@ -5622,7 +6505,7 @@ type AdminRefreshUserTitlesRequest struct {
 
 func (x *AdminRefreshUserTitlesRequest) Reset() {
 	*x = AdminRefreshUserTitlesRequest{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[114]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5634,7 +6517,7 @@ func (x *AdminRefreshUserTitlesRequest) String() string {
 func (*AdminRefreshUserTitlesRequest) ProtoMessage() {}
 
 func (x *AdminRefreshUserTitlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[114]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5647,7 +6530,7 @@ func (x *AdminRefreshUserTitlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRefreshUserTitlesRequest.ProtoReflect.Descriptor instead.
 func (*AdminRefreshUserTitlesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{114}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *AdminRefreshUserTitlesRequest) GetUsername() string {
@@ -5667,7 +6550,7 @@ type AdminRefreshUserTitlesResponse struct {
 
 func (x *AdminRefreshUserTitlesResponse) Reset() {
 	*x = AdminRefreshUserTitlesResponse{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[115]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5679,7 +6562,7 @@ func (x *AdminRefreshUserTitlesResponse) String() string {
 func (*AdminRefreshUserTitlesResponse) ProtoMessage() {}
 
 func (x *AdminRefreshUserTitlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[115]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5692,7 +6575,7 @@ func (x *AdminRefreshUserTitlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminRefreshUserTitlesResponse.ProtoReflect.Descriptor instead.
 func (*AdminRefreshUserTitlesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{115}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *AdminRefreshUserTitlesResponse) GetTitles() []*OrganizationTitle {
@@ -5714,16 +6597,18 @@ type ActiveChatChannels_Channel struct {
 	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	// last_update is a unix timestamp, in seconds.
-	LastUpdate    int64  `protobuf:"varint,3,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
-	HasUpdate     bool   `protobuf:"varint,4,opt,name=has_update,json=hasUpdate,proto3" json:"has_update,omitempty"`
-	LastMessage   string `protobuf:"bytes,5,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	LastUpdate  int64  `protobuf:"varint,3,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	HasUpdate   bool   `protobuf:"varint,4,opt,name=has_update,json=hasUpdate,proto3" json:"has_update,omitempty"`
+	LastMessage string `protobuf:"bytes,5,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	// The number of unread messages, for private message channels.
+	UnreadCount   int32 `protobuf:"varint,6,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ActiveChatChannels_Channel) Reset() {
 	*x = ActiveChatChannels_Channel{}
-	mi := &file_proto_user_service_user_service_proto_msgTypes[118]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5735,7 +6620,7 @@ func (x *ActiveChatChannels_Channel) String() string {
 func (*ActiveChatChannels_Channel) ProtoMessage() {}
 
 func (x *ActiveChatChannels_Channel) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_service_user_service_proto_msgTypes[118]
+	mi := &file_proto_user_service_user_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5748,7 +6633,7 @@ func (x *ActiveChatChannels_Channel) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActiveChatChannels_Channel.ProtoReflect.Descriptor instead.
 func (*ActiveChatChannels_Channel) Descriptor() ([]byte, []int) {
-	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{58, 0}
+	return file_proto_user_service_user_service_proto_rawDescGZIP(), []int{64, 0}
 }
 
 func (x *ActiveChatChannels_Channel) GetName() string {
@@ -5786,6 +6671,13 @@ func (x *ActiveChatChannels_Channel) GetLastMessage() string {
 	return ""
 }
 
+func (x *ActiveChatChannels_Channel) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
 var File_proto_user_service_user_service_proto protoreflect.FileDescriptor
 
 const file_proto_user_service_user_service_proto_rawDesc = "" +
@@ -5850,7 +6742,14 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"\x1eResendVerificationEmailRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\";\n" +
 	"\x1fResendVerificationEmailResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\",\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\x9d\x01\n" +
+	"\x1aCompleteOAuthSignupRequest\x12!\n" +
+	"\fsignup_token\x18\x01 \x01(\tR\vsignupToken\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"birth_date\x18\x03 \x01(\tR\tbirthDate\x12!\n" +
+	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\"\x1d\n" +
+	"\x1bCompleteOAuthSignupResponse\",\n" +
 	"\x0eRatingsRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"%\n" +
 	"\x0fRatingsResponse\x12\x12\n" +
@@ -5858,7 +6757,38 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"\fStatsRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\"#\n" +
 	"\rStatsResponse\x12\x12\n" +
-	"\x04json\x18\x01 \x01(\tR\x04json\"\xf2\x02\n" +
+	"\x04json\x18\x01 \x01(\tR\x04json\"\x94\x01\n" +
+	"\x14RatingHistoryRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x18\n" +
+	"\avariant\x18\x02 \x01(\tR\avariant\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xde\x01\n" +
+	"\x15RatingChangeBreakdown\x12'\n" +
+	"\x0fexpected_result\x18\x01 \x01(\x01R\x0eexpectedResult\x12#\n" +
+	"\ractual_result\x18\x02 \x01(\x01R\factualResult\x12\x1b\n" +
+	"\twin_boost\x18\x03 \x01(\x01R\bwinBoost\x12:\n" +
+	"\x19inactive_rating_deviation\x18\x04 \x01(\x01R\x17inactiveRatingDeviation\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x05 \x01(\x01R\n" +
+	"multiplier\"\xe1\x04\n" +
+	"\x12RatingHistoryPoint\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12+\n" +
+	"\x11opponent_username\x18\x02 \x01(\tR\x10opponentUsername\x127\n" +
+	"\tplayed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bplayedAt\x12#\n" +
+	"\rrating_before\x18\x04 \x01(\x01R\fratingBefore\x12!\n" +
+	"\frating_after\x18\x05 \x01(\x01R\vratingAfter\x126\n" +
+	"\x17rating_deviation_before\x18\x06 \x01(\x01R\x15ratingDeviationBefore\x124\n" +
+	"\x16rating_deviation_after\x18\a \x01(\x01R\x14ratingDeviationAfter\x12+\n" +
+	"\x11volatility_before\x18\b \x01(\x01R\x10volatilityBefore\x12)\n" +
+	"\x10volatility_after\x18\t \x01(\x01R\x0fvolatilityAfter\x12'\n" +
+	"\x0fopponent_rating\x18\n" +
+	" \x01(\x01R\x0eopponentRating\x12:\n" +
+	"\x19opponent_rating_deviation\x18\v \x01(\x01R\x17opponentRatingDeviation\x12\x16\n" +
+	"\x06spread\x18\f \x01(\x05R\x06spread\x12A\n" +
+	"\tbreakdown\x18\r \x01(\v2#.user_service.RatingChangeBreakdownR\tbreakdown\"m\n" +
+	"\x15RatingHistoryResponse\x128\n" +
+	"\x06points\x18\x01 \x03(\v2 .user_service.RatingHistoryPointR\x06points\x12\x1a\n" +
+	"\bvariants\x18\x02 \x03(\tR\bvariants\"\xf2\x02\n" +
 	"\x11OrganizationTitle\x12+\n" +
 	"\x11organization_code\x18\x01 \x01(\tR\x10organizationCode\x12+\n" +
 	"\x11organization_name\x18\x02 \x01(\tR\x10organizationName\x12\x1b\n" +
@@ -5977,9 +6907,9 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12#\n" +
 	"\rtournament_id\x18\x03 \x01(\tR\ftournamentId\x12\x1b\n" +
-	"\tleague_id\x18\x04 \x01(\tR\bleagueId\"\x80\x02\n" +
+	"\tleague_id\x18\x04 \x01(\tR\bleagueId\"\xa3\x02\n" +
 	"\x12ActiveChatChannels\x12D\n" +
-	"\bchannels\x18\x01 \x03(\v2(.user_service.ActiveChatChannels.ChannelR\bchannels\x1a\xa3\x01\n" +
+	"\bchannels\x18\x01 \x03(\v2(.user_service.ActiveChatChannels.ChannelR\bchannels\x1a\xc6\x01\n" +
 	"\aChannel\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x1f\n" +
@@ -5987,9 +6917,20 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"lastUpdate\x12\x1d\n" +
 	"\n" +
 	"has_update\x18\x04 \x01(\bR\thasUpdate\x12!\n" +
-	"\flast_message\x18\x05 \x01(\tR\vlastMessage\"+\n" +
+	"\flast_message\x18\x05 \x01(\tR\vlastMessage\x12!\n" +
+	"\funread_count\x18\x06 \x01(\x05R\vunreadCount\"H\n" +
 	"\x0fGetChatsRequest\x12\x18\n" +
-	"\achannel\x18\x01 \x01(\tR\achannel\"K\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1b\n" +
+	"\tbefore_id\x18\x02 \x01(\tR\bbeforeId\"6\n" +
+	"\x1aMarkChatChannelReadRequest\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\"\x1e\n" +
+	"\x1cGetUnreadMessageCountRequest\"*\n" +
+	"\x12UnreadMessageCount\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"b\n" +
+	"\x1cSearchPrivateMessagesRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"K\n" +
 	"\x12GetFollowsResponse\x125\n" +
 	"\x05users\x18\x01 \x03(\v2\x1f.user_service.BasicFollowedUserR\x05users\"B\n" +
 	"\x11GetBlocksResponse\x12-\n" +
@@ -6008,7 +6949,20 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"\fintegrations\x18\x01 \x03(\v2\x19.user_service.IntegrationR\fintegrations\".\n" +
 	"\x18DeleteIntegrationRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1b\n" +
-	"\x19DeleteIntegrationResponse\" \n" +
+	"\x19DeleteIntegrationResponse\"\xba\x01\n" +
+	"\rLoginIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x127\n" +
+	"\tlinked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\x12>\n" +
+	"\rlast_login_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vlastLoginAt\"\x1b\n" +
+	"\x19GetLoginIdentitiesRequest\"V\n" +
+	"\x17LoginIdentitiesResponse\x12;\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x1b.user_service.LoginIdentityR\n" +
+	"identities\"8\n" +
+	"\x1aUnlinkLoginIdentityRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"\x1d\n" +
+	"\x1bUnlinkLoginIdentityResponse\" \n" +
 	"\x1eGetSubscriptionCriteriaRequest\"\xb7\x01\n" +
 	"\x1fGetSubscriptionCriteriaResponse\x12\x1b\n" +
 	"\ttier_name\x18\x01 \x01(\tR\btierName\x121\n" +
@@ -6155,15 +7109,17 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"\x14NotifyAccountClosure\x12).user_service.NotifyAccountClosureRequest\x1a*.user_service.NotifyAccountClosureResponse\x12[\n" +
 	"\x0fGetSignedCookie\x12$.user_service.GetSignedCookieRequest\x1a\".user_service.SignedCookieResponse\x12d\n" +
 	"\x13InstallSignedCookie\x12\".user_service.SignedCookieResponse\x1a).user_service.InstallSignedCookieResponse\x12L\n" +
-	"\tGetAPIKey\x12\x1e.user_service.GetAPIKeyRequest\x1a\x1f.user_service.GetAPIKeyResponse2\xb8\x02\n" +
+	"\tGetAPIKey\x12\x1e.user_service.GetAPIKeyRequest\x1a\x1f.user_service.GetAPIKeyResponse2\xa4\x03\n" +
 	"\x13RegistrationService\x12U\n" +
 	"\bRegister\x12%.user_service.UserRegistrationRequest\x1a\".user_service.RegistrationResponse\x12R\n" +
 	"\vVerifyEmail\x12 .user_service.VerifyEmailRequest\x1a!.user_service.VerifyEmailResponse\x12v\n" +
-	"\x17ResendVerificationEmail\x12,.user_service.ResendVerificationEmailRequest\x1a-.user_service.ResendVerificationEmailResponse2\xa1\x06\n" +
+	"\x17ResendVerificationEmail\x12,.user_service.ResendVerificationEmailRequest\x1a-.user_service.ResendVerificationEmailResponse\x12j\n" +
+	"\x13CompleteOAuthSignup\x12(.user_service.CompleteOAuthSignupRequest\x1a).user_service.CompleteOAuthSignupResponse2\xfe\x06\n" +
 	"\x0eProfileService\x12I\n" +
 	"\n" +
 	"GetRatings\x12\x1c.user_service.RatingsRequest\x1a\x1d.user_service.RatingsResponse\x12C\n" +
-	"\bGetStats\x12\x1a.user_service.StatsRequest\x1a\x1b.user_service.StatsResponse\x12I\n" +
+	"\bGetStats\x12\x1a.user_service.StatsRequest\x1a\x1b.user_service.StatsResponse\x12[\n" +
+	"\x10GetRatingHistory\x12\".user_service.RatingHistoryRequest\x1a#.user_service.RatingHistoryResponse\x12I\n" +
 	"\n" +
 	"GetProfile\x12\x1c.user_service.ProfileRequest\x1a\x1d.user_service.ProfileResponse\x12X\n" +
 	"\x0fGetPersonalInfo\x12!.user_service.PersonalInfoRequest\x1a\".user_service.PersonalInfoResponse\x12g\n" +
@@ -6173,7 +7129,7 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"\x10GetBriefProfiles\x12\".user_service.BriefProfilesRequest\x1a#.user_service.BriefProfilesResponse\"\x03\x90\x02\x01\x12a\n" +
 	"\x11GetBadgesMetadata\x12\".user_service.BadgeMetadataRequest\x1a#.user_service.BadgeMetadataResponse\"\x03\x90\x02\x012q\n" +
 	"\x13AutocompleteService\x12Z\n" +
-	"\rGetCompletion\x12#.user_service.UsernameSearchRequest\x1a$.user_service.UsernameSearchResponse2\xde\x05\n" +
+	"\rGetCompletion\x12#.user_service.UsernameSearchRequest\x1a$.user_service.UsernameSearchResponse2\xf8\a\n" +
 	"\x10SocializeService\x12E\n" +
 	"\tAddFollow\x12\x1e.user_service.AddFollowRequest\x1a\x18.user_service.OKResponse\x12K\n" +
 	"\fRemoveFollow\x12!.user_service.RemoveFollowRequest\x1a\x18.user_service.OKResponse\x12O\n" +
@@ -6184,10 +7140,15 @@ const file_proto_user_service_user_service_proto_rawDesc = "" +
 	"\tGetBlocks\x12\x1e.user_service.GetBlocksRequest\x1a\x1f.user_service.GetBlocksResponse\x12X\n" +
 	"\rGetFullBlocks\x12\".user_service.GetFullBlocksRequest\x1a#.user_service.GetFullBlocksResponse\x12e\n" +
 	"\x15GetActiveChatChannels\x12*.user_service.GetActiveChatChannelsRequest\x1a .user_service.ActiveChatChannels\x12F\n" +
-	"\x12GetChatsForChannel\x12\x1d.user_service.GetChatsRequest\x1a\x11.ipc.ChatMessages2\xdc\x01\n" +
+	"\x12GetChatsForChannel\x12\x1d.user_service.GetChatsRequest\x1a\x11.ipc.ChatMessages\x12Y\n" +
+	"\x13MarkChatChannelRead\x12(.user_service.MarkChatChannelReadRequest\x1a\x18.user_service.OKResponse\x12e\n" +
+	"\x15GetUnreadMessageCount\x12*.user_service.GetUnreadMessageCountRequest\x1a .user_service.UnreadMessageCount\x12V\n" +
+	"\x15SearchPrivateMessages\x12*.user_service.SearchPrivateMessagesRequest\x1a\x11.ipc.ChatMessages2\xb3\x03\n" +
 	"\x12IntegrationService\x12`\n" +
 	"\x0fGetIntegrations\x12$.user_service.GetIntegrationsRequest\x1a\".user_service.IntegrationsResponse\"\x03\x90\x02\x01\x12d\n" +
-	"\x11DeleteIntegration\x12&.user_service.DeleteIntegrationRequest\x1a'.user_service.DeleteIntegrationResponse2\xf0\t\n" +
+	"\x11DeleteIntegration\x12&.user_service.DeleteIntegrationRequest\x1a'.user_service.DeleteIntegrationResponse\x12i\n" +
+	"\x12GetLoginIdentities\x12'.user_service.GetLoginIdentitiesRequest\x1a%.user_service.LoginIdentitiesResponse\"\x03\x90\x02\x01\x12j\n" +
+	"\x13UnlinkLoginIdentity\x12(.user_service.UnlinkLoginIdentityRequest\x1a).user_service.UnlinkLoginIdentityResponse2\xf0\t\n" +
 	"\x14AuthorizationService\x12T\n" +
 	"\n" +
 	"GetModList\x12\x1f.user_service.GetModListRequest\x1a .user_service.GetModListResponse\"\x03\x90\x02\x01\x12v\n" +
@@ -6232,7 +7193,7 @@ func file_proto_user_service_user_service_proto_rawDescGZIP() []byte {
 	return file_proto_user_service_user_service_proto_rawDescData
 }
 
-var file_proto_user_service_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
+var file_proto_user_service_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 137)
 var file_proto_user_service_user_service_proto_goTypes = []any{
 	(*UserLoginRequest)(nil),                 // 0: user_service.UserLoginRequest
 	(*ChangePasswordRequest)(nil),            // 1: user_service.ChangePasswordRequest
@@ -6259,253 +7220,289 @@ var file_proto_user_service_user_service_proto_goTypes = []any{
 	(*VerifyEmailResponse)(nil),              // 22: user_service.VerifyEmailResponse
 	(*ResendVerificationEmailRequest)(nil),   // 23: user_service.ResendVerificationEmailRequest
 	(*ResendVerificationEmailResponse)(nil),  // 24: user_service.ResendVerificationEmailResponse
-	(*RatingsRequest)(nil),                   // 25: user_service.RatingsRequest
-	(*RatingsResponse)(nil),                  // 26: user_service.RatingsResponse
-	(*StatsRequest)(nil),                     // 27: user_service.StatsRequest
-	(*StatsResponse)(nil),                    // 28: user_service.StatsResponse
-	(*OrganizationTitle)(nil),                // 29: user_service.OrganizationTitle
-	(*ProfileRequest)(nil),                   // 30: user_service.ProfileRequest
-	(*ProfileResponse)(nil),                  // 31: user_service.ProfileResponse
-	(*PersonalInfoRequest)(nil),              // 32: user_service.PersonalInfoRequest
-	(*PersonalInfoResponse)(nil),             // 33: user_service.PersonalInfoResponse
-	(*UpdatePersonalInfoRequest)(nil),        // 34: user_service.UpdatePersonalInfoRequest
-	(*UpdatePersonalInfoResponse)(nil),       // 35: user_service.UpdatePersonalInfoResponse
-	(*UpdateAvatarRequest)(nil),              // 36: user_service.UpdateAvatarRequest
-	(*UpdateAvatarResponse)(nil),             // 37: user_service.UpdateAvatarResponse
-	(*RemoveAvatarRequest)(nil),              // 38: user_service.RemoveAvatarRequest
-	(*RemoveAvatarResponse)(nil),             // 39: user_service.RemoveAvatarResponse
-	(*BriefProfilesRequest)(nil),             // 40: user_service.BriefProfilesRequest
-	(*BriefProfile)(nil),                     // 41: user_service.BriefProfile
-	(*BriefProfilesResponse)(nil),            // 42: user_service.BriefProfilesResponse
-	(*BadgeMetadataRequest)(nil),             // 43: user_service.BadgeMetadataRequest
-	(*BadgeMetadataResponse)(nil),            // 44: user_service.BadgeMetadataResponse
-	(*UsernameSearchRequest)(nil),            // 45: user_service.UsernameSearchRequest
-	(*UsernameSearchResponse)(nil),           // 46: user_service.UsernameSearchResponse
-	(*AddFollowRequest)(nil),                 // 47: user_service.AddFollowRequest
-	(*RemoveFollowRequest)(nil),              // 48: user_service.RemoveFollowRequest
-	(*GetFollowsRequest)(nil),                // 49: user_service.GetFollowsRequest
-	(*AddBlockRequest)(nil),                  // 50: user_service.AddBlockRequest
-	(*RemoveBlockRequest)(nil),               // 51: user_service.RemoveBlockRequest
-	(*GetBlocksRequest)(nil),                 // 52: user_service.GetBlocksRequest
-	(*GetFullBlocksRequest)(nil),             // 53: user_service.GetFullBlocksRequest
-	(*OKResponse)(nil),                       // 54: user_service.OKResponse
-	(*BasicUser)(nil),                        // 55: user_service.BasicUser
-	(*BasicFollowedUser)(nil),                // 56: user_service.BasicFollowedUser
-	(*GetActiveChatChannelsRequest)(nil),     // 57: user_service.GetActiveChatChannelsRequest
-	(*ActiveChatChannels)(nil),               // 58: user_service.ActiveChatChannels
-	(*GetChatsRequest)(nil),                  // 59: user_service.GetChatsRequest
-	(*GetFollowsResponse)(nil),               // 60: user_service.GetFollowsResponse
-	(*GetBlocksResponse)(nil),                // 61: user_service.GetBlocksResponse
-	(*GetFullBlocksResponse)(nil),            // 62: user_service.GetFullBlocksResponse
-	(*Integration)(nil),                      // 63: user_service.Integration
-	(*GetIntegrationsRequest)(nil),           // 64: user_service.GetIntegrationsRequest
-	(*IntegrationsResponse)(nil),             // 65: user_service.IntegrationsResponse
-	(*DeleteIntegrationRequest)(nil),         // 66: user_service.DeleteIntegrationRequest
-	(*DeleteIntegrationResponse)(nil),        // 67: user_service.DeleteIntegrationResponse
-	(*GetSubscriptionCriteriaRequest)(nil),   // 68: user_service.GetSubscriptionCriteriaRequest
-	(*GetSubscriptionCriteriaResponse)(nil),  // 69: user_service.GetSubscriptionCriteriaResponse
-	(*GetModListRequest)(nil),                // 70: user_service.GetModListRequest
-	(*GetModListResponse)(nil),               // 71: user_service.GetModListResponse
-	(*AddRoleRequest)(nil),                   // 72: user_service.AddRoleRequest
-	(*AddRoleResponse)(nil),                  // 73: user_service.AddRoleResponse
-	(*AddPermissionRequest)(nil),             // 74: user_service.AddPermissionRequest
-	(*AddPermissionResponse)(nil),            // 75: user_service.AddPermissionResponse
-	(*LinkRoleAndPermissionRequest)(nil),     // 76: user_service.LinkRoleAndPermissionRequest
-	(*LinkRoleAndPermissionResponse)(nil),    // 77: user_service.LinkRoleAndPermissionResponse
-	(*AssignRoleResponse)(nil),               // 78: user_service.AssignRoleResponse
-	(*UserAndRole)(nil),                      // 79: user_service.UserAndRole
-	(*UnassignRoleResponse)(nil),             // 80: user_service.UnassignRoleResponse
-	(*GetUserRolesRequest)(nil),              // 81: user_service.GetUserRolesRequest
-	(*UserRolesResponse)(nil),                // 82: user_service.UserRolesResponse
-	(*GetSelfRolesRequest)(nil),              // 83: user_service.GetSelfRolesRequest
-	(*GetSelfPermissionsRequest)(nil),        // 84: user_service.GetSelfPermissionsRequest
-	(*SelfPermissionsResponse)(nil),          // 85: user_service.SelfPermissionsResponse
-	(*GetUsersWithRolesRequest)(nil),         // 86: user_service.GetUsersWithRolesRequest
-	(*GetUsersWithRolesResponse)(nil),        // 87: user_service.GetUsersWithRolesResponse
-	(*GetRoleMetadataRequest)(nil),           // 88: user_service.GetRoleMetadataRequest
-	(*RoleWithPermissions)(nil),              // 89: user_service.RoleWithPermissions
-	(*RoleMetadataResponse)(nil),             // 90: user_service.RoleMetadataResponse
-	(*ConnectOrganizationRequest)(nil),       // 91: user_service.ConnectOrganizationRequest
-	(*ConnectOrganizationResponse)(nil),      // 92: user_service.ConnectOrganizationResponse
-	(*DisconnectOrganizationRequest)(nil),    // 93: user_service.DisconnectOrganizationRequest
-	(*DisconnectOrganizationResponse)(nil),   // 94: user_service.DisconnectOrganizationResponse
-	(*RefreshTitlesRequest)(nil),             // 95: user_service.RefreshTitlesRequest
-	(*RefreshTitlesResponse)(nil),            // 96: user_service.RefreshTitlesResponse
-	(*GetMyOrganizationsRequest)(nil),        // 97: user_service.GetMyOrganizationsRequest
-	(*GetMyOrganizationsResponse)(nil),       // 98: user_service.GetMyOrganizationsResponse
-	(*GetPublicOrganizationsRequest)(nil),    // 99: user_service.GetPublicOrganizationsRequest
-	(*GetPublicOrganizationsResponse)(nil),   // 100: user_service.GetPublicOrganizationsResponse
-	(*SubmitVerificationRequest)(nil),        // 101: user_service.SubmitVerificationRequest
-	(*SubmitVerificationResponse)(nil),       // 102: user_service.SubmitVerificationResponse
-	(*GetPendingVerificationsRequest)(nil),   // 103: user_service.GetPendingVerificationsRequest
-	(*VerificationRequestInfo)(nil),          // 104: user_service.VerificationRequestInfo
-	(*GetPendingVerificationsResponse)(nil),  // 105: user_service.GetPendingVerificationsResponse
-	(*ApproveVerificationRequest)(nil),       // 106: user_service.ApproveVerificationRequest
-	(*ApproveVerificationResponse)(nil),      // 107: user_service.ApproveVerificationResponse
-	(*RejectVerificationRequest)(nil),        // 108: user_service.RejectVerificationRequest
-	(*RejectVerificationResponse)(nil),       // 109: user_service.RejectVerificationResponse
-	(*GetVerificationImageUrlRequest)(nil),   // 110: user_service.GetVerificationImageUrlRequest
-	(*GetVerificationImageUrlResponse)(nil),  // 111: user_service.GetVerificationImageUrlResponse
-	(*ManuallySetOrgMembershipRequest)(nil),  // 112: user_service.ManuallySetOrgMembershipRequest
-	(*ManuallySetOrgMembershipResponse)(nil), // 113: user_service.ManuallySetOrgMembershipResponse
-	(*AdminRefreshUserTitlesRequest)(nil),    // 114: user_service.AdminRefreshUserTitlesRequest
-	(*AdminRefreshUserTitlesResponse)(nil),   // 115: user_service.AdminRefreshUserTitlesResponse
-	nil,                                      // 116: user_service.BriefProfilesResponse.ResponseEntry
-	nil,                                      // 117: user_service.BadgeMetadataResponse.BadgesEntry
-	(*ActiveChatChannels_Channel)(nil),       // 118: user_service.ActiveChatChannels.Channel
-	nil,                                      // 119: user_service.Integration.IntegrationDetailsEntry
-	nil,                                      // 120: user_service.ConnectOrganizationRequest.CredentialsEntry
-	nil,                                      // 121: user_service.ManuallySetOrgMembershipRequest.CredentialsEntry
-	(*timestamppb.Timestamp)(nil),            // 122: google.protobuf.Timestamp
-	(*ipc.ChatMessages)(nil),                 // 123: ipc.ChatMessages
+	(*CompleteOAuthSignupRequest)(nil),       // 25: user_service.CompleteOAuthSignupRequest
+	(*CompleteOAuthSignupResponse)(nil),      // 26: user_service.CompleteOAuthSignupResponse
+	(*RatingsRequest)(nil),                   // 27: user_service.RatingsRequest
+	(*RatingsResponse)(nil),                  // 28: user_service.RatingsResponse
+	(*StatsRequest)(nil),                     // 29: user_service.StatsRequest
+	(*StatsResponse)(nil),                    // 30: user_service.StatsResponse
+	(*RatingHistoryRequest)(nil),             // 31: user_service.RatingHistoryRequest
+	(*RatingChangeBreakdown)(nil),            // 32: user_service.RatingChangeBreakdown
+	(*RatingHistoryPoint)(nil),               // 33: user_service.RatingHistoryPoint
+	(*RatingHistoryResponse)(nil),            // 34: user_service.RatingHistoryResponse
+	(*OrganizationTitle)(nil),                // 35: user_service.OrganizationTitle
+	(*ProfileRequest)(nil),                   // 36: user_service.ProfileRequest
+	(*ProfileResponse)(nil),                  // 37: user_service.ProfileResponse
+	(*PersonalInfoRequest)(nil),              // 38: user_service.PersonalInfoRequest
+	(*PersonalInfoResponse)(nil),             // 39: user_service.PersonalInfoResponse
+	(*UpdatePersonalInfoRequest)(nil),        // 40: user_service.UpdatePersonalInfoRequest
+	(*UpdatePersonalInfoResponse)(nil),       // 41: user_service.UpdatePersonalInfoResponse
+	(*UpdateAvatarRequest)(nil),              // 42: user_service.UpdateAvatarRequest
+	(*UpdateAvatarResponse)(nil),             // 43: user_service.UpdateAvatarResponse
+	(*RemoveAvatarRequest)(nil),              // 44: user_service.RemoveAvatarRequest
+	(*RemoveAvatarResponse)(nil),             // 45: user_service.RemoveAvatarResponse
+	(*BriefProfilesRequest)(nil),             // 46: user_service.BriefProfilesRequest
+	(*BriefProfile)(nil),                     // 47: user_service.BriefProfile
+	(*BriefProfilesResponse)(nil),            // 48: user_service.BriefProfilesResponse
+	(*BadgeMetadataRequest)(nil),             // 49: user_service.BadgeMetadataRequest
+	(*BadgeMetadataResponse)(nil),            // 50: user_service.BadgeMetadataResponse
+	(*UsernameSearchRequest)(nil),            // 51: user_service.UsernameSearchRequest
+	(*UsernameSearchResponse)(nil),           // 52: user_service.UsernameSearchResponse
+	(*AddFollowRequest)(nil),                 // 53: user_service.AddFollowRequest
+	(*RemoveFollowRequest)(nil),              // 54: user_service.RemoveFollowRequest
+	(*GetFollowsRequest)(nil),                // 55: user_service.GetFollowsRequest
+	(*AddBlockRequest)(nil),                  // 56: user_service.AddBlockRequest
+	(*RemoveBlockRequest)(nil),               // 57: user_service.RemoveBlockRequest
+	(*GetBlocksRequest)(nil),                 // 58: user_service.GetBlocksRequest
+	(*GetFullBlocksRequest)(nil),             // 59: user_service.GetFullBlocksRequest
+	(*OKResponse)(nil),                       // 60: user_service.OKResponse
+	(*BasicUser)(nil),                        // 61: user_service.BasicUser
+	(*BasicFollowedUser)(nil),                // 62: user_service.BasicFollowedUser
+	(*GetActiveChatChannelsRequest)(nil),     // 63: user_service.GetActiveChatChannelsRequest
+	(*ActiveChatChannels)(nil),               // 64: user_service.ActiveChatChannels
+	(*GetChatsRequest)(nil),                  // 65: user_service.GetChatsRequest
+	(*MarkChatChannelReadRequest)(nil),       // 66: user_service.MarkChatChannelReadRequest
+	(*GetUnreadMessageCountRequest)(nil),     // 67: user_service.GetUnreadMessageCountRequest
+	(*UnreadMessageCount)(nil),               // 68: user_service.UnreadMessageCount
+	(*SearchPrivateMessagesRequest)(nil),     // 69: user_service.SearchPrivateMessagesRequest
+	(*GetFollowsResponse)(nil),               // 70: user_service.GetFollowsResponse
+	(*GetBlocksResponse)(nil),                // 71: user_service.GetBlocksResponse
+	(*GetFullBlocksResponse)(nil),            // 72: user_service.GetFullBlocksResponse
+	(*Integration)(nil),                      // 73: user_service.Integration
+	(*GetIntegrationsRequest)(nil),           // 74: user_service.GetIntegrationsRequest
+	(*IntegrationsResponse)(nil),             // 75: user_service.IntegrationsResponse
+	(*DeleteIntegrationRequest)(nil),         // 76: user_service.DeleteIntegrationRequest
+	(*DeleteIntegrationResponse)(nil),        // 77: user_service.DeleteIntegrationResponse
+	(*LoginIdentity)(nil),                    // 78: user_service.LoginIdentity
+	(*GetLoginIdentitiesRequest)(nil),        // 79: user_service.GetLoginIdentitiesRequest
+	(*LoginIdentitiesResponse)(nil),          // 80: user_service.LoginIdentitiesResponse
+	(*UnlinkLoginIdentityRequest)(nil),       // 81: user_service.UnlinkLoginIdentityRequest
+	(*UnlinkLoginIdentityResponse)(nil),      // 82: user_service.UnlinkLoginIdentityResponse
+	(*GetSubscriptionCriteriaRequest)(nil),   // 83: user_service.GetSubscriptionCriteriaRequest
+	(*GetSubscriptionCriteriaResponse)(nil),  // 84: user_service.GetSubscriptionCriteriaResponse
+	(*GetModListRequest)(nil),                // 85: user_service.GetModListRequest
+	(*GetModListResponse)(nil),               // 86: user_service.GetModListResponse
+	(*AddRoleRequest)(nil),                   // 87: user_service.AddRoleRequest
+	(*AddRoleResponse)(nil),                  // 88: user_service.AddRoleResponse
+	(*AddPermissionRequest)(nil),             // 89: user_service.AddPermissionRequest
+	(*AddPermissionResponse)(nil),            // 90: user_service.AddPermissionResponse
+	(*LinkRoleAndPermissionRequest)(nil),     // 91: user_service.LinkRoleAndPermissionRequest
+	(*LinkRoleAndPermissionResponse)(nil),    // 92: user_service.LinkRoleAndPermissionResponse
+	(*AssignRoleResponse)(nil),               // 93: user_service.AssignRoleResponse
+	(*UserAndRole)(nil),                      // 94: user_service.UserAndRole
+	(*UnassignRoleResponse)(nil),             // 95: user_service.UnassignRoleResponse
+	(*GetUserRolesRequest)(nil),              // 96: user_service.GetUserRolesRequest
+	(*UserRolesResponse)(nil),                // 97: user_service.UserRolesResponse
+	(*GetSelfRolesRequest)(nil),              // 98: user_service.GetSelfRolesRequest
+	(*GetSelfPermissionsRequest)(nil),        // 99: user_service.GetSelfPermissionsRequest
+	(*SelfPermissionsResponse)(nil),          // 100: user_service.SelfPermissionsResponse
+	(*GetUsersWithRolesRequest)(nil),         // 101: user_service.GetUsersWithRolesRequest
+	(*GetUsersWithRolesResponse)(nil),        // 102: user_service.GetUsersWithRolesResponse
+	(*GetRoleMetadataRequest)(nil),           // 103: user_service.GetRoleMetadataRequest
+	(*RoleWithPermissions)(nil),              // 104: user_service.RoleWithPermissions
+	(*RoleMetadataResponse)(nil),             // 105: user_service.RoleMetadataResponse
+	(*ConnectOrganizationRequest)(nil),       // 106: user_service.ConnectOrganizationRequest
+	(*ConnectOrganizationResponse)(nil),      // 107: user_service.ConnectOrganizationResponse
+	(*DisconnectOrganizationRequest)(nil),    // 108: user_service.DisconnectOrganizationRequest
+	(*DisconnectOrganizationResponse)(nil),   // 109: user_service.DisconnectOrganizationResponse
+	(*RefreshTitlesRequest)(nil),             // 110: user_service.RefreshTitlesRequest
+	(*RefreshTitlesResponse)(nil),            // 111: user_service.RefreshTitlesResponse
+	(*GetMyOrganizationsRequest)(nil),        // 112: user_service.GetMyOrganizationsRequest
+	(*GetMyOrganizationsResponse)(nil),       // 113: user_service.GetMyOrganizationsResponse
+	(*GetPublicOrganizationsRequest)(nil),    // 114: user_service.GetPublicOrganizationsRequest
+	(*GetPublicOrganizationsResponse)(nil),   // 115: user_service.GetPublicOrganizationsResponse
+	(*SubmitVerificationRequest)(nil),        // 116: user_service.SubmitVerificationRequest
+	(*SubmitVerificationResponse)(nil),       // 117: user_service.SubmitVerificationResponse
+	(*GetPendingVerificationsRequest)(nil),   // 118: user_service.GetPendingVerificationsRequest
+	(*VerificationRequestInfo)(nil),          // 119: user_service.VerificationRequestInfo
+	(*GetPendingVerificationsResponse)(nil),  // 120: user_service.GetPendingVerificationsResponse
+	(*ApproveVerificationRequest)(nil),       // 121: user_service.ApproveVerificationRequest
+	(*ApproveVerificationResponse)(nil),      // 122: user_service.ApproveVerificationResponse
+	(*RejectVerificationRequest)(nil),        // 123: user_service.RejectVerificationRequest
+	(*RejectVerificationResponse)(nil),       // 124: user_service.RejectVerificationResponse
+	(*GetVerificationImageUrlRequest)(nil),   // 125: user_service.GetVerificationImageUrlRequest
+	(*GetVerificationImageUrlResponse)(nil),  // 126: user_service.GetVerificationImageUrlResponse
+	(*ManuallySetOrgMembershipRequest)(nil),  // 127: user_service.ManuallySetOrgMembershipRequest
+	(*ManuallySetOrgMembershipResponse)(nil), // 128: user_service.ManuallySetOrgMembershipResponse
+	(*AdminRefreshUserTitlesRequest)(nil),    // 129: user_service.AdminRefreshUserTitlesRequest
+	(*AdminRefreshUserTitlesResponse)(nil),   // 130: user_service.AdminRefreshUserTitlesResponse
+	nil,                                      // 131: user_service.BriefProfilesResponse.ResponseEntry
+	nil,                                      // 132: user_service.BadgeMetadataResponse.BadgesEntry
+	(*ActiveChatChannels_Channel)(nil),       // 133: user_service.ActiveChatChannels.Channel
+	nil,                                      // 134: user_service.Integration.IntegrationDetailsEntry
+	nil,                                      // 135: user_service.ConnectOrganizationRequest.CredentialsEntry
+	nil,                                      // 136: user_service.ManuallySetOrgMembershipRequest.CredentialsEntry
+	(*timestamppb.Timestamp)(nil),            // 137: google.protobuf.Timestamp
+	(*ipc.ChatMessages)(nil),                 // 138: ipc.ChatMessages
 }
 var file_proto_user_service_user_service_proto_depIdxs = []int32{
-	122, // 0: user_service.OrganizationTitle.last_fetched:type_name -> google.protobuf.Timestamp
-	29,  // 1: user_service.ProfileResponse.organization_titles:type_name -> user_service.OrganizationTitle
-	116, // 2: user_service.BriefProfilesResponse.response:type_name -> user_service.BriefProfilesResponse.ResponseEntry
-	117, // 3: user_service.BadgeMetadataResponse.badges:type_name -> user_service.BadgeMetadataResponse.BadgesEntry
-	55,  // 4: user_service.UsernameSearchResponse.users:type_name -> user_service.BasicUser
-	118, // 5: user_service.ActiveChatChannels.channels:type_name -> user_service.ActiveChatChannels.Channel
-	56,  // 6: user_service.GetFollowsResponse.users:type_name -> user_service.BasicFollowedUser
-	55,  // 7: user_service.GetBlocksResponse.users:type_name -> user_service.BasicUser
-	119, // 8: user_service.Integration.integration_details:type_name -> user_service.Integration.IntegrationDetailsEntry
-	63,  // 9: user_service.IntegrationsResponse.integrations:type_name -> user_service.Integration
-	122, // 10: user_service.GetSubscriptionCriteriaResponse.last_charge_date:type_name -> google.protobuf.Timestamp
-	79,  // 11: user_service.GetUsersWithRolesResponse.user_and_role_objs:type_name -> user_service.UserAndRole
-	89,  // 12: user_service.RoleMetadataResponse.roles_with_permissions:type_name -> user_service.RoleWithPermissions
-	120, // 13: user_service.ConnectOrganizationRequest.credentials:type_name -> user_service.ConnectOrganizationRequest.CredentialsEntry
-	29,  // 14: user_service.ConnectOrganizationResponse.title:type_name -> user_service.OrganizationTitle
-	29,  // 15: user_service.RefreshTitlesResponse.titles:type_name -> user_service.OrganizationTitle
-	29,  // 16: user_service.GetMyOrganizationsResponse.titles:type_name -> user_service.OrganizationTitle
-	29,  // 17: user_service.GetPublicOrganizationsResponse.titles:type_name -> user_service.OrganizationTitle
-	122, // 18: user_service.VerificationRequestInfo.submitted_at:type_name -> google.protobuf.Timestamp
-	104, // 19: user_service.GetPendingVerificationsResponse.requests:type_name -> user_service.VerificationRequestInfo
-	121, // 20: user_service.ManuallySetOrgMembershipRequest.credentials:type_name -> user_service.ManuallySetOrgMembershipRequest.CredentialsEntry
-	29,  // 21: user_service.AdminRefreshUserTitlesResponse.titles:type_name -> user_service.OrganizationTitle
-	41,  // 22: user_service.BriefProfilesResponse.ResponseEntry.value:type_name -> user_service.BriefProfile
-	0,   // 23: user_service.AuthenticationService.Login:input_type -> user_service.UserLoginRequest
-	10,  // 24: user_service.AuthenticationService.Logout:input_type -> user_service.UserLogoutRequest
-	8,   // 25: user_service.AuthenticationService.GetSocketToken:input_type -> user_service.SocketTokenRequest
-	4,   // 26: user_service.AuthenticationService.ResetPasswordStep1:input_type -> user_service.ResetPasswordRequestStep1
-	5,   // 27: user_service.AuthenticationService.ResetPasswordStep2:input_type -> user_service.ResetPasswordRequestStep2
-	1,   // 28: user_service.AuthenticationService.ChangePassword:input_type -> user_service.ChangePasswordRequest
-	12,  // 29: user_service.AuthenticationService.NotifyAccountClosure:input_type -> user_service.NotifyAccountClosureRequest
-	16,  // 30: user_service.AuthenticationService.GetSignedCookie:input_type -> user_service.GetSignedCookieRequest
-	17,  // 31: user_service.AuthenticationService.InstallSignedCookie:input_type -> user_service.SignedCookieResponse
-	14,  // 32: user_service.AuthenticationService.GetAPIKey:input_type -> user_service.GetAPIKeyRequest
-	19,  // 33: user_service.RegistrationService.Register:input_type -> user_service.UserRegistrationRequest
-	21,  // 34: user_service.RegistrationService.VerifyEmail:input_type -> user_service.VerifyEmailRequest
-	23,  // 35: user_service.RegistrationService.ResendVerificationEmail:input_type -> user_service.ResendVerificationEmailRequest
-	25,  // 36: user_service.ProfileService.GetRatings:input_type -> user_service.RatingsRequest
-	27,  // 37: user_service.ProfileService.GetStats:input_type -> user_service.StatsRequest
-	30,  // 38: user_service.ProfileService.GetProfile:input_type -> user_service.ProfileRequest
-	32,  // 39: user_service.ProfileService.GetPersonalInfo:input_type -> user_service.PersonalInfoRequest
-	34,  // 40: user_service.ProfileService.UpdatePersonalInfo:input_type -> user_service.UpdatePersonalInfoRequest
-	36,  // 41: user_service.ProfileService.UpdateAvatar:input_type -> user_service.UpdateAvatarRequest
-	38,  // 42: user_service.ProfileService.RemoveAvatar:input_type -> user_service.RemoveAvatarRequest
-	40,  // 43: user_service.ProfileService.GetBriefProfiles:input_type -> user_service.BriefProfilesRequest
-	43,  // 44: user_service.ProfileService.GetBadgesMetadata:input_type -> user_service.BadgeMetadataRequest
-	45,  // 45: user_service.AutocompleteService.GetCompletion:input_type -> user_service.UsernameSearchRequest
-	47,  // 46: user_service.SocializeService.AddFollow:input_type -> user_service.AddFollowRequest
-	48,  // 47: user_service.SocializeService.RemoveFollow:input_type -> user_service.RemoveFollowRequest
-	49,  // 48: user_service.SocializeService.GetFollows:input_type -> user_service.GetFollowsRequest
-	50,  // 49: user_service.SocializeService.AddBlock:input_type -> user_service.AddBlockRequest
-	51,  // 50: user_service.SocializeService.RemoveBlock:input_type -> user_service.RemoveBlockRequest
-	52,  // 51: user_service.SocializeService.GetBlocks:input_type -> user_service.GetBlocksRequest
-	53,  // 52: user_service.SocializeService.GetFullBlocks:input_type -> user_service.GetFullBlocksRequest
-	57,  // 53: user_service.SocializeService.GetActiveChatChannels:input_type -> user_service.GetActiveChatChannelsRequest
-	59,  // 54: user_service.SocializeService.GetChatsForChannel:input_type -> user_service.GetChatsRequest
-	64,  // 55: user_service.IntegrationService.GetIntegrations:input_type -> user_service.GetIntegrationsRequest
-	66,  // 56: user_service.IntegrationService.DeleteIntegration:input_type -> user_service.DeleteIntegrationRequest
-	70,  // 57: user_service.AuthorizationService.GetModList:input_type -> user_service.GetModListRequest
-	68,  // 58: user_service.AuthorizationService.GetSubscriptionCriteria:input_type -> user_service.GetSubscriptionCriteriaRequest
-	72,  // 59: user_service.AuthorizationService.AddRole:input_type -> user_service.AddRoleRequest
-	74,  // 60: user_service.AuthorizationService.AddPermission:input_type -> user_service.AddPermissionRequest
-	76,  // 61: user_service.AuthorizationService.LinkRoleAndPermission:input_type -> user_service.LinkRoleAndPermissionRequest
-	76,  // 62: user_service.AuthorizationService.UnlinkRoleAndPermission:input_type -> user_service.LinkRoleAndPermissionRequest
-	79,  // 63: user_service.AuthorizationService.AssignRole:input_type -> user_service.UserAndRole
-	79,  // 64: user_service.AuthorizationService.UnassignRole:input_type -> user_service.UserAndRole
-	81,  // 65: user_service.AuthorizationService.GetUserRoles:input_type -> user_service.GetUserRolesRequest
-	83,  // 66: user_service.AuthorizationService.GetSelfRoles:input_type -> user_service.GetSelfRolesRequest
-	84,  // 67: user_service.AuthorizationService.GetSelfPermissions:input_type -> user_service.GetSelfPermissionsRequest
-	86,  // 68: user_service.AuthorizationService.GetUsersWithRoles:input_type -> user_service.GetUsersWithRolesRequest
-	88,  // 69: user_service.AuthorizationService.GetRoleMetadata:input_type -> user_service.GetRoleMetadataRequest
-	91,  // 70: user_service.OrganizationService.ConnectOrganization:input_type -> user_service.ConnectOrganizationRequest
-	93,  // 71: user_service.OrganizationService.DisconnectOrganization:input_type -> user_service.DisconnectOrganizationRequest
-	95,  // 72: user_service.OrganizationService.RefreshTitles:input_type -> user_service.RefreshTitlesRequest
-	97,  // 73: user_service.OrganizationService.GetMyOrganizations:input_type -> user_service.GetMyOrganizationsRequest
-	99,  // 74: user_service.OrganizationService.GetPublicOrganizations:input_type -> user_service.GetPublicOrganizationsRequest
-	101, // 75: user_service.OrganizationService.SubmitVerification:input_type -> user_service.SubmitVerificationRequest
-	103, // 76: user_service.OrganizationService.GetPendingVerifications:input_type -> user_service.GetPendingVerificationsRequest
-	110, // 77: user_service.OrganizationService.GetVerificationImageUrl:input_type -> user_service.GetVerificationImageUrlRequest
-	106, // 78: user_service.OrganizationService.ApproveVerification:input_type -> user_service.ApproveVerificationRequest
-	108, // 79: user_service.OrganizationService.RejectVerification:input_type -> user_service.RejectVerificationRequest
-	112, // 80: user_service.OrganizationService.ManuallySetOrgMembership:input_type -> user_service.ManuallySetOrgMembershipRequest
-	114, // 81: user_service.OrganizationService.AdminRefreshUserTitles:input_type -> user_service.AdminRefreshUserTitlesRequest
-	2,   // 82: user_service.AuthenticationService.Login:output_type -> user_service.LoginResponse
-	11,  // 83: user_service.AuthenticationService.Logout:output_type -> user_service.LogoutResponse
-	9,   // 84: user_service.AuthenticationService.GetSocketToken:output_type -> user_service.SocketTokenResponse
-	6,   // 85: user_service.AuthenticationService.ResetPasswordStep1:output_type -> user_service.ResetPasswordResponse
-	6,   // 86: user_service.AuthenticationService.ResetPasswordStep2:output_type -> user_service.ResetPasswordResponse
-	3,   // 87: user_service.AuthenticationService.ChangePassword:output_type -> user_service.ChangePasswordResponse
-	13,  // 88: user_service.AuthenticationService.NotifyAccountClosure:output_type -> user_service.NotifyAccountClosureResponse
-	17,  // 89: user_service.AuthenticationService.GetSignedCookie:output_type -> user_service.SignedCookieResponse
-	18,  // 90: user_service.AuthenticationService.InstallSignedCookie:output_type -> user_service.InstallSignedCookieResponse
-	15,  // 91: user_service.AuthenticationService.GetAPIKey:output_type -> user_service.GetAPIKeyResponse
-	20,  // 92: user_service.RegistrationService.Register:output_type -> user_service.RegistrationResponse
-	22,  // 93: user_service.RegistrationService.VerifyEmail:output_type -> user_service.VerifyEmailResponse
-	24,  // 94: user_service.RegistrationService.ResendVerificationEmail:output_type -> user_service.ResendVerificationEmailResponse
-	26,  // 95: user_service.ProfileService.GetRatings:output_type -> user_service.RatingsResponse
-	28,  // 96: user_service.ProfileService.GetStats:output_type -> user_service.StatsResponse
-	31,  // 97: user_service.ProfileService.GetProfile:output_type -> user_service.ProfileResponse
-	33,  // 98: user_service.ProfileService.GetPersonalInfo:output_type -> user_service.PersonalInfoResponse
-	35,  // 99: user_service.ProfileService.UpdatePersonalInfo:output_type -> user_service.UpdatePersonalInfoResponse
-	37,  // 100: user_service.ProfileService.UpdateAvatar:output_type -> user_service.UpdateAvatarResponse
-	39,  // 101: user_service.ProfileService.RemoveAvatar:output_type -> user_service.RemoveAvatarResponse
-	42,  // 102: user_service.ProfileService.GetBriefProfiles:output_type -> user_service.BriefProfilesResponse
-	44,  // 103: user_service.ProfileService.GetBadgesMetadata:output_type -> user_service.BadgeMetadataResponse
-	46,  // 104: user_service.AutocompleteService.GetCompletion:output_type -> user_service.UsernameSearchResponse
-	54,  // 105: user_service.SocializeService.AddFollow:output_type -> user_service.OKResponse
-	54,  // 106: user_service.SocializeService.RemoveFollow:output_type -> user_service.OKResponse
-	60,  // 107: user_service.SocializeService.GetFollows:output_type -> user_service.GetFollowsResponse
-	54,  // 108: user_service.SocializeService.AddBlock:output_type -> user_service.OKResponse
-	54,  // 109: user_service.SocializeService.RemoveBlock:output_type -> user_service.OKResponse
-	61,  // 110: user_service.SocializeService.GetBlocks:output_type -> user_service.GetBlocksResponse
-	62,  // 111: user_service.SocializeService.GetFullBlocks:output_type -> user_service.GetFullBlocksResponse
-	58,  // 112: user_service.SocializeService.GetActiveChatChannels:output_type -> user_service.ActiveChatChannels
-	123, // 113: user_service.SocializeService.GetChatsForChannel:output_type -> ipc.ChatMessages
-	65,  // 114: user_service.IntegrationService.GetIntegrations:output_type -> user_service.IntegrationsResponse
-	67,  // 115: user_service.IntegrationService.DeleteIntegration:output_type -> user_service.DeleteIntegrationResponse
-	71,  // 116: user_service.AuthorizationService.GetModList:output_type -> user_service.GetModListResponse
-	69,  // 117: user_service.AuthorizationService.GetSubscriptionCriteria:output_type -> user_service.GetSubscriptionCriteriaResponse
-	73,  // 118: user_service.AuthorizationService.AddRole:output_type -> user_service.AddRoleResponse
-	75,  // 119: user_service.AuthorizationService.AddPermission:output_type -> user_service.AddPermissionResponse
-	77,  // 120: user_service.AuthorizationService.LinkRoleAndPermission:output_type -> user_service.LinkRoleAndPermissionResponse
-	77,  // 121: user_service.AuthorizationService.UnlinkRoleAndPermission:output_type -> user_service.LinkRoleAndPermissionResponse
-	78,  // 122: user_service.AuthorizationService.AssignRole:output_type -> user_service.AssignRoleResponse
-	80,  // 123: user_service.AuthorizationService.UnassignRole:output_type -> user_service.UnassignRoleResponse
-	82,  // 124: user_service.AuthorizationService.GetUserRoles:output_type -> user_service.UserRolesResponse
-	82,  // 125: user_service.AuthorizationService.GetSelfRoles:output_type -> user_service.UserRolesResponse
-	85,  // 126: user_service.AuthorizationService.GetSelfPermissions:output_type -> user_service.SelfPermissionsResponse
-	87,  // 127: user_service.AuthorizationService.GetUsersWithRoles:output_type -> user_service.GetUsersWithRolesResponse
-	90,  // 128: user_service.AuthorizationService.GetRoleMetadata:output_type -> user_service.RoleMetadataResponse
-	92,  // 129: user_service.OrganizationService.ConnectOrganization:output_type -> user_service.ConnectOrganizationResponse
-	94,  // 130: user_service.OrganizationService.DisconnectOrganization:output_type -> user_service.DisconnectOrganizationResponse
-	96,  // 131: user_service.OrganizationService.RefreshTitles:output_type -> user_service.RefreshTitlesResponse
-	98,  // 132: user_service.OrganizationService.GetMyOrganizations:output_type -> user_service.GetMyOrganizationsResponse
-	100, // 133: user_service.OrganizationService.GetPublicOrganizations:output_type -> user_service.GetPublicOrganizationsResponse
-	102, // 134: user_service.OrganizationService.SubmitVerification:output_type -> user_service.SubmitVerificationResponse
-	105, // 135: user_service.OrganizationService.GetPendingVerifications:output_type -> user_service.GetPendingVerificationsResponse
-	111, // 136: user_service.OrganizationService.GetVerificationImageUrl:output_type -> user_service.GetVerificationImageUrlResponse
-	107, // 137: user_service.OrganizationService.ApproveVerification:output_type -> user_service.ApproveVerificationResponse
-	109, // 138: user_service.OrganizationService.RejectVerification:output_type -> user_service.RejectVerificationResponse
-	113, // 139: user_service.OrganizationService.ManuallySetOrgMembership:output_type -> user_service.ManuallySetOrgMembershipResponse
-	115, // 140: user_service.OrganizationService.AdminRefreshUserTitles:output_type -> user_service.AdminRefreshUserTitlesResponse
-	82,  // [82:141] is the sub-list for method output_type
-	23,  // [23:82] is the sub-list for method input_type
-	23,  // [23:23] is the sub-list for extension type_name
-	23,  // [23:23] is the sub-list for extension extendee
-	0,   // [0:23] is the sub-list for field type_name
+	137, // 0: user_service.RatingHistoryRequest.since:type_name -> google.protobuf.Timestamp
+	137, // 1: user_service.RatingHistoryPoint.played_at:type_name -> google.protobuf.Timestamp
+	32,  // 2: user_service.RatingHistoryPoint.breakdown:type_name -> user_service.RatingChangeBreakdown
+	33,  // 3: user_service.RatingHistoryResponse.points:type_name -> user_service.RatingHistoryPoint
+	137, // 4: user_service.OrganizationTitle.last_fetched:type_name -> google.protobuf.Timestamp
+	35,  // 5: user_service.ProfileResponse.organization_titles:type_name -> user_service.OrganizationTitle
+	131, // 6: user_service.BriefProfilesResponse.response:type_name -> user_service.BriefProfilesResponse.ResponseEntry
+	132, // 7: user_service.BadgeMetadataResponse.badges:type_name -> user_service.BadgeMetadataResponse.BadgesEntry
+	61,  // 8: user_service.UsernameSearchResponse.users:type_name -> user_service.BasicUser
+	133, // 9: user_service.ActiveChatChannels.channels:type_name -> user_service.ActiveChatChannels.Channel
+	62,  // 10: user_service.GetFollowsResponse.users:type_name -> user_service.BasicFollowedUser
+	61,  // 11: user_service.GetBlocksResponse.users:type_name -> user_service.BasicUser
+	134, // 12: user_service.Integration.integration_details:type_name -> user_service.Integration.IntegrationDetailsEntry
+	73,  // 13: user_service.IntegrationsResponse.integrations:type_name -> user_service.Integration
+	137, // 14: user_service.LoginIdentity.linked_at:type_name -> google.protobuf.Timestamp
+	137, // 15: user_service.LoginIdentity.last_login_at:type_name -> google.protobuf.Timestamp
+	78,  // 16: user_service.LoginIdentitiesResponse.identities:type_name -> user_service.LoginIdentity
+	137, // 17: user_service.GetSubscriptionCriteriaResponse.last_charge_date:type_name -> google.protobuf.Timestamp
+	94,  // 18: user_service.GetUsersWithRolesResponse.user_and_role_objs:type_name -> user_service.UserAndRole
+	104, // 19: user_service.RoleMetadataResponse.roles_with_permissions:type_name -> user_service.RoleWithPermissions
+	135, // 20: user_service.ConnectOrganizationRequest.credentials:type_name -> user_service.ConnectOrganizationRequest.CredentialsEntry
+	35,  // 21: user_service.ConnectOrganizationResponse.title:type_name -> user_service.OrganizationTitle
+	35,  // 22: user_service.RefreshTitlesResponse.titles:type_name -> user_service.OrganizationTitle
+	35,  // 23: user_service.GetMyOrganizationsResponse.titles:type_name -> user_service.OrganizationTitle
+	35,  // 24: user_service.GetPublicOrganizationsResponse.titles:type_name -> user_service.OrganizationTitle
+	137, // 25: user_service.VerificationRequestInfo.submitted_at:type_name -> google.protobuf.Timestamp
+	119, // 26: user_service.GetPendingVerificationsResponse.requests:type_name -> user_service.VerificationRequestInfo
+	136, // 27: user_service.ManuallySetOrgMembershipRequest.credentials:type_name -> user_service.ManuallySetOrgMembershipRequest.CredentialsEntry
+	35,  // 28: user_service.AdminRefreshUserTitlesResponse.titles:type_name -> user_service.OrganizationTitle
+	47,  // 29: user_service.BriefProfilesResponse.ResponseEntry.value:type_name -> user_service.BriefProfile
+	0,   // 30: user_service.AuthenticationService.Login:input_type -> user_service.UserLoginRequest
+	10,  // 31: user_service.AuthenticationService.Logout:input_type -> user_service.UserLogoutRequest
+	8,   // 32: user_service.AuthenticationService.GetSocketToken:input_type -> user_service.SocketTokenRequest
+	4,   // 33: user_service.AuthenticationService.ResetPasswordStep1:input_type -> user_service.ResetPasswordRequestStep1
+	5,   // 34: user_service.AuthenticationService.ResetPasswordStep2:input_type -> user_service.ResetPasswordRequestStep2
+	1,   // 35: user_service.AuthenticationService.ChangePassword:input_type -> user_service.ChangePasswordRequest
+	12,  // 36: user_service.AuthenticationService.NotifyAccountClosure:input_type -> user_service.NotifyAccountClosureRequest
+	16,  // 37: user_service.AuthenticationService.GetSignedCookie:input_type -> user_service.GetSignedCookieRequest
+	17,  // 38: user_service.AuthenticationService.InstallSignedCookie:input_type -> user_service.SignedCookieResponse
+	14,  // 39: user_service.AuthenticationService.GetAPIKey:input_type -> user_service.GetAPIKeyRequest
+	19,  // 40: user_service.RegistrationService.Register:input_type -> user_service.UserRegistrationRequest
+	21,  // 41: user_service.RegistrationService.VerifyEmail:input_type -> user_service.VerifyEmailRequest
+	23,  // 42: user_service.RegistrationService.ResendVerificationEmail:input_type -> user_service.ResendVerificationEmailRequest
+	25,  // 43: user_service.RegistrationService.CompleteOAuthSignup:input_type -> user_service.CompleteOAuthSignupRequest
+	27,  // 44: user_service.ProfileService.GetRatings:input_type -> user_service.RatingsRequest
+	29,  // 45: user_service.ProfileService.GetStats:input_type -> user_service.StatsRequest
+	31,  // 46: user_service.ProfileService.GetRatingHistory:input_type -> user_service.RatingHistoryRequest
+	36,  // 47: user_service.ProfileService.GetProfile:input_type -> user_service.ProfileRequest
+	38,  // 48: user_service.ProfileService.GetPersonalInfo:input_type -> user_service.PersonalInfoRequest
+	40,  // 49: user_service.ProfileService.UpdatePersonalInfo:input_type -> user_service.UpdatePersonalInfoRequest
+	42,  // 50: user_service.ProfileService.UpdateAvatar:input_type -> user_service.UpdateAvatarRequest
+	44,  // 51: user_service.ProfileService.RemoveAvatar:input_type -> user_service.RemoveAvatarRequest
+	46,  // 52: user_service.ProfileService.GetBriefProfiles:input_type -> user_service.BriefProfilesRequest
+	49,  // 53: user_service.ProfileService.GetBadgesMetadata:input_type -> user_service.BadgeMetadataRequest
+	51,  // 54: user_service.AutocompleteService.GetCompletion:input_type -> user_service.UsernameSearchRequest
+	53,  // 55: user_service.SocializeService.AddFollow:input_type -> user_service.AddFollowRequest
+	54,  // 56: user_service.SocializeService.RemoveFollow:input_type -> user_service.RemoveFollowRequest
+	55,  // 57: user_service.SocializeService.GetFollows:input_type -> user_service.GetFollowsRequest
+	56,  // 58: user_service.SocializeService.AddBlock:input_type -> user_service.AddBlockRequest
+	57,  // 59: user_service.SocializeService.RemoveBlock:input_type -> user_service.RemoveBlockRequest
+	58,  // 60: user_service.SocializeService.GetBlocks:input_type -> user_service.GetBlocksRequest
+	59,  // 61: user_service.SocializeService.GetFullBlocks:input_type -> user_service.GetFullBlocksRequest
+	63,  // 62: user_service.SocializeService.GetActiveChatChannels:input_type -> user_service.GetActiveChatChannelsRequest
+	65,  // 63: user_service.SocializeService.GetChatsForChannel:input_type -> user_service.GetChatsRequest
+	66,  // 64: user_service.SocializeService.MarkChatChannelRead:input_type -> user_service.MarkChatChannelReadRequest
+	67,  // 65: user_service.SocializeService.GetUnreadMessageCount:input_type -> user_service.GetUnreadMessageCountRequest
+	69,  // 66: user_service.SocializeService.SearchPrivateMessages:input_type -> user_service.SearchPrivateMessagesRequest
+	74,  // 67: user_service.IntegrationService.GetIntegrations:input_type -> user_service.GetIntegrationsRequest
+	76,  // 68: user_service.IntegrationService.DeleteIntegration:input_type -> user_service.DeleteIntegrationRequest
+	79,  // 69: user_service.IntegrationService.GetLoginIdentities:input_type -> user_service.GetLoginIdentitiesRequest
+	81,  // 70: user_service.IntegrationService.UnlinkLoginIdentity:input_type -> user_service.UnlinkLoginIdentityRequest
+	85,  // 71: user_service.AuthorizationService.GetModList:input_type -> user_service.GetModListRequest
+	83,  // 72: user_service.AuthorizationService.GetSubscriptionCriteria:input_type -> user_service.GetSubscriptionCriteriaRequest
+	87,  // 73: user_service.AuthorizationService.AddRole:input_type -> user_service.AddRoleRequest
+	89,  // 74: user_service.AuthorizationService.AddPermission:input_type -> user_service.AddPermissionRequest
+	91,  // 75: user_service.AuthorizationService.LinkRoleAndPermission:input_type -> user_service.LinkRoleAndPermissionRequest
+	91,  // 76: user_service.AuthorizationService.UnlinkRoleAndPermission:input_type -> user_service.LinkRoleAndPermissionRequest
+	94,  // 77: user_service.AuthorizationService.AssignRole:input_type -> user_service.UserAndRole
+	94,  // 78: user_service.AuthorizationService.UnassignRole:input_type -> user_service.UserAndRole
+	96,  // 79: user_service.AuthorizationService.GetUserRoles:input_type -> user_service.GetUserRolesRequest
+	98,  // 80: user_service.AuthorizationService.GetSelfRoles:input_type -> user_service.GetSelfRolesRequest
+	99,  // 81: user_service.AuthorizationService.GetSelfPermissions:input_type -> user_service.GetSelfPermissionsRequest
+	101, // 82: user_service.AuthorizationService.GetUsersWithRoles:input_type -> user_service.GetUsersWithRolesRequest
+	103, // 83: user_service.AuthorizationService.GetRoleMetadata:input_type -> user_service.GetRoleMetadataRequest
+	106, // 84: user_service.OrganizationService.ConnectOrganization:input_type -> user_service.ConnectOrganizationRequest
+	108, // 85: user_service.OrganizationService.DisconnectOrganization:input_type -> user_service.DisconnectOrganizationRequest
+	110, // 86: user_service.OrganizationService.RefreshTitles:input_type -> user_service.RefreshTitlesRequest
+	112, // 87: user_service.OrganizationService.GetMyOrganizations:input_type -> user_service.GetMyOrganizationsRequest
+	114, // 88: user_service.OrganizationService.GetPublicOrganizations:input_type -> user_service.GetPublicOrganizationsRequest
+	116, // 89: user_service.OrganizationService.SubmitVerification:input_type -> user_service.SubmitVerificationRequest
+	118, // 90: user_service.OrganizationService.GetPendingVerifications:input_type -> user_service.GetPendingVerificationsRequest
+	125, // 91: user_service.OrganizationService.GetVerificationImageUrl:input_type -> user_service.GetVerificationImageUrlRequest
+	121, // 92: user_service.OrganizationService.ApproveVerification:input_type -> user_service.ApproveVerificationRequest
+	123, // 93: user_service.OrganizationService.RejectVerification:input_type -> user_service.RejectVerificationRequest
+	127, // 94: user_service.OrganizationService.ManuallySetOrgMembership:input_type -> user_service.ManuallySetOrgMembershipRequest
+	129, // 95: user_service.OrganizationService.AdminRefreshUserTitles:input_type -> user_service.AdminRefreshUserTitlesRequest
+	2,   // 96: user_service.AuthenticationService.Login:output_type -> user_service.LoginResponse
+	11,  // 97: user_service.AuthenticationService.Logout:output_type -> user_service.LogoutResponse
+	9,   // 98: user_service.AuthenticationService.GetSocketToken:output_type -> user_service.SocketTokenResponse
+	6,   // 99: user_service.AuthenticationService.ResetPasswordStep1:output_type -> user_service.ResetPasswordResponse
+	6,   // 100: user_service.AuthenticationService.ResetPasswordStep2:output_type -> user_service.ResetPasswordResponse
+	3,   // 101: user_service.AuthenticationService.ChangePassword:output_type -> user_service.ChangePasswordResponse
+	13,  // 102: user_service.AuthenticationService.NotifyAccountClosure:output_type -> user_service.NotifyAccountClosureResponse
+	17,  // 103: user_service.AuthenticationService.GetSignedCookie:output_type -> user_service.SignedCookieResponse
+	18,  // 104: user_service.AuthenticationService.InstallSignedCookie:output_type -> user_service.InstallSignedCookieResponse
+	15,  // 105: user_service.AuthenticationService.GetAPIKey:output_type -> user_service.GetAPIKeyResponse
+	20,  // 106: user_service.RegistrationService.Register:output_type -> user_service.RegistrationResponse
+	22,  // 107: user_service.RegistrationService.VerifyEmail:output_type -> user_service.VerifyEmailResponse
+	24,  // 108: user_service.RegistrationService.ResendVerificationEmail:output_type -> user_service.ResendVerificationEmailResponse
+	26,  // 109: user_service.RegistrationService.CompleteOAuthSignup:output_type -> user_service.CompleteOAuthSignupResponse
+	28,  // 110: user_service.ProfileService.GetRatings:output_type -> user_service.RatingsResponse
+	30,  // 111: user_service.ProfileService.GetStats:output_type -> user_service.StatsResponse
+	34,  // 112: user_service.ProfileService.GetRatingHistory:output_type -> user_service.RatingHistoryResponse
+	37,  // 113: user_service.ProfileService.GetProfile:output_type -> user_service.ProfileResponse
+	39,  // 114: user_service.ProfileService.GetPersonalInfo:output_type -> user_service.PersonalInfoResponse
+	41,  // 115: user_service.ProfileService.UpdatePersonalInfo:output_type -> user_service.UpdatePersonalInfoResponse
+	43,  // 116: user_service.ProfileService.UpdateAvatar:output_type -> user_service.UpdateAvatarResponse
+	45,  // 117: user_service.ProfileService.RemoveAvatar:output_type -> user_service.RemoveAvatarResponse
+	48,  // 118: user_service.ProfileService.GetBriefProfiles:output_type -> user_service.BriefProfilesResponse
+	50,  // 119: user_service.ProfileService.GetBadgesMetadata:output_type -> user_service.BadgeMetadataResponse
+	52,  // 120: user_service.AutocompleteService.GetCompletion:output_type -> user_service.UsernameSearchResponse
+	60,  // 121: user_service.SocializeService.AddFollow:output_type -> user_service.OKResponse
+	60,  // 122: user_service.SocializeService.RemoveFollow:output_type -> user_service.OKResponse
+	70,  // 123: user_service.SocializeService.GetFollows:output_type -> user_service.GetFollowsResponse
+	60,  // 124: user_service.SocializeService.AddBlock:output_type -> user_service.OKResponse
+	60,  // 125: user_service.SocializeService.RemoveBlock:output_type -> user_service.OKResponse
+	71,  // 126: user_service.SocializeService.GetBlocks:output_type -> user_service.GetBlocksResponse
+	72,  // 127: user_service.SocializeService.GetFullBlocks:output_type -> user_service.GetFullBlocksResponse
+	64,  // 128: user_service.SocializeService.GetActiveChatChannels:output_type -> user_service.ActiveChatChannels
+	138, // 129: user_service.SocializeService.GetChatsForChannel:output_type -> ipc.ChatMessages
+	60,  // 130: user_service.SocializeService.MarkChatChannelRead:output_type -> user_service.OKResponse
+	68,  // 131: user_service.SocializeService.GetUnreadMessageCount:output_type -> user_service.UnreadMessageCount
+	138, // 132: user_service.SocializeService.SearchPrivateMessages:output_type -> ipc.ChatMessages
+	75,  // 133: user_service.IntegrationService.GetIntegrations:output_type -> user_service.IntegrationsResponse
+	77,  // 134: user_service.IntegrationService.DeleteIntegration:output_type -> user_service.DeleteIntegrationResponse
+	80,  // 135: user_service.IntegrationService.GetLoginIdentities:output_type -> user_service.LoginIdentitiesResponse
+	82,  // 136: user_service.IntegrationService.UnlinkLoginIdentity:output_type -> user_service.UnlinkLoginIdentityResponse
+	86,  // 137: user_service.AuthorizationService.GetModList:output_type -> user_service.GetModListResponse
+	84,  // 138: user_service.AuthorizationService.GetSubscriptionCriteria:output_type -> user_service.GetSubscriptionCriteriaResponse
+	88,  // 139: user_service.AuthorizationService.AddRole:output_type -> user_service.AddRoleResponse
+	90,  // 140: user_service.AuthorizationService.AddPermission:output_type -> user_service.AddPermissionResponse
+	92,  // 141: user_service.AuthorizationService.LinkRoleAndPermission:output_type -> user_service.LinkRoleAndPermissionResponse
+	92,  // 142: user_service.AuthorizationService.UnlinkRoleAndPermission:output_type -> user_service.LinkRoleAndPermissionResponse
+	93,  // 143: user_service.AuthorizationService.AssignRole:output_type -> user_service.AssignRoleResponse
+	95,  // 144: user_service.AuthorizationService.UnassignRole:output_type -> user_service.UnassignRoleResponse
+	97,  // 145: user_service.AuthorizationService.GetUserRoles:output_type -> user_service.UserRolesResponse
+	97,  // 146: user_service.AuthorizationService.GetSelfRoles:output_type -> user_service.UserRolesResponse
+	100, // 147: user_service.AuthorizationService.GetSelfPermissions:output_type -> user_service.SelfPermissionsResponse
+	102, // 148: user_service.AuthorizationService.GetUsersWithRoles:output_type -> user_service.GetUsersWithRolesResponse
+	105, // 149: user_service.AuthorizationService.GetRoleMetadata:output_type -> user_service.RoleMetadataResponse
+	107, // 150: user_service.OrganizationService.ConnectOrganization:output_type -> user_service.ConnectOrganizationResponse
+	109, // 151: user_service.OrganizationService.DisconnectOrganization:output_type -> user_service.DisconnectOrganizationResponse
+	111, // 152: user_service.OrganizationService.RefreshTitles:output_type -> user_service.RefreshTitlesResponse
+	113, // 153: user_service.OrganizationService.GetMyOrganizations:output_type -> user_service.GetMyOrganizationsResponse
+	115, // 154: user_service.OrganizationService.GetPublicOrganizations:output_type -> user_service.GetPublicOrganizationsResponse
+	117, // 155: user_service.OrganizationService.SubmitVerification:output_type -> user_service.SubmitVerificationResponse
+	120, // 156: user_service.OrganizationService.GetPendingVerifications:output_type -> user_service.GetPendingVerificationsResponse
+	126, // 157: user_service.OrganizationService.GetVerificationImageUrl:output_type -> user_service.GetVerificationImageUrlResponse
+	122, // 158: user_service.OrganizationService.ApproveVerification:output_type -> user_service.ApproveVerificationResponse
+	124, // 159: user_service.OrganizationService.RejectVerification:output_type -> user_service.RejectVerificationResponse
+	128, // 160: user_service.OrganizationService.ManuallySetOrgMembership:output_type -> user_service.ManuallySetOrgMembershipResponse
+	130, // 161: user_service.OrganizationService.AdminRefreshUserTitles:output_type -> user_service.AdminRefreshUserTitlesResponse
+	96,  // [96:162] is the sub-list for method output_type
+	30,  // [30:96] is the sub-list for method input_type
+	30,  // [30:30] is the sub-list for extension type_name
+	30,  // [30:30] is the sub-list for extension extendee
+	0,   // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_service_user_service_proto_init() }
@@ -6513,14 +7510,14 @@ func file_proto_user_service_user_service_proto_init() {
 	if File_proto_user_service_user_service_proto != nil {
 		return
 	}
-	file_proto_user_service_user_service_proto_msgTypes[93].OneofWrappers = []any{}
+	file_proto_user_service_user_service_proto_msgTypes[108].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_service_user_service_proto_rawDesc), len(file_proto_user_service_user_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   122,
+			NumMessages:   137,
 			NumExtensions: 0,
 			NumServices:   8,
 		},
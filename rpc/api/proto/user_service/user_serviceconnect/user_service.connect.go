@@ -87,11 +87,17 @@ const (
 	// RegistrationServiceResendVerificationEmailProcedure is the fully-qualified name of the
 	// RegistrationService's ResendVerificationEmail RPC.
 	RegistrationServiceResendVerificationEmailProcedure = "/user_service.RegistrationService/ResendVerificationEmail"
+	// RegistrationServiceCompleteOAuthSignupProcedure is the fully-qualified name of the
+	// RegistrationService's CompleteOAuthSignup RPC.
+	RegistrationServiceCompleteOAuthSignupProcedure = "/user_service.RegistrationService/CompleteOAuthSignup"
 	// ProfileServiceGetRatingsProcedure is the fully-qualified name of the ProfileService's GetRatings
 	// RPC.
 	ProfileServiceGetRatingsProcedure = "/user_service.ProfileService/GetRatings"
 	// ProfileServiceGetStatsProcedure is the fully-qualified name of the ProfileService's GetStats RPC.
 	ProfileServiceGetStatsProcedure = "/user_service.ProfileService/GetStats"
+	// ProfileServiceGetRatingHistoryProcedure is the fully-qualified name of the ProfileService's
+	// GetRatingHistory RPC.
+	ProfileServiceGetRatingHistoryProcedure = "/user_service.ProfileService/GetRatingHistory"
 	// ProfileServiceGetProfileProcedure is the fully-qualified name of the ProfileService's GetProfile
 	// RPC.
 	ProfileServiceGetProfileProcedure = "/user_service.ProfileService/GetProfile"
@@ -143,12 +149,27 @@ const (
 	// SocializeServiceGetChatsForChannelProcedure is the fully-qualified name of the SocializeService's
 	// GetChatsForChannel RPC.
 	SocializeServiceGetChatsForChannelProcedure = "/user_service.SocializeService/GetChatsForChannel"
+	// SocializeServiceMarkChatChannelReadProcedure is the fully-qualified name of the
+	// SocializeService's MarkChatChannelRead RPC.
+	SocializeServiceMarkChatChannelReadProcedure = "/user_service.SocializeService/MarkChatChannelRead"
+	// SocializeServiceGetUnreadMessageCountProcedure is the fully-qualified name of the
+	// SocializeService's GetUnreadMessageCount RPC.
+	SocializeServiceGetUnreadMessageCountProcedure = "/user_service.SocializeService/GetUnreadMessageCount"
+	// SocializeServiceSearchPrivateMessagesProcedure is the fully-qualified name of the
+	// SocializeService's SearchPrivateMessages RPC.
+	SocializeServiceSearchPrivateMessagesProcedure = "/user_service.SocializeService/SearchPrivateMessages"
 	// IntegrationServiceGetIntegrationsProcedure is the fully-qualified name of the
 	// IntegrationService's GetIntegrations RPC.
 	IntegrationServiceGetIntegrationsProcedure = "/user_service.IntegrationService/GetIntegrations"
 	// IntegrationServiceDeleteIntegrationProcedure is the fully-qualified name of the
 	// IntegrationService's DeleteIntegration RPC.
 	IntegrationServiceDeleteIntegrationProcedure = "/user_service.IntegrationService/DeleteIntegration"
+	// IntegrationServiceGetLoginIdentitiesProcedure is the fully-qualified name of the
+	// IntegrationService's GetLoginIdentities RPC.
+	IntegrationServiceGetLoginIdentitiesProcedure = "/user_service.IntegrationService/GetLoginIdentities"
+	// IntegrationServiceUnlinkLoginIdentityProcedure is the fully-qualified name of the
+	// IntegrationService's UnlinkLoginIdentity RPC.
+	IntegrationServiceUnlinkLoginIdentityProcedure = "/user_service.IntegrationService/UnlinkLoginIdentity"
 	// AuthorizationServiceGetModListProcedure is the fully-qualified name of the AuthorizationService's
 	// GetModList RPC.
 	AuthorizationServiceGetModListProcedure = "/user_service.AuthorizationService/GetModList"
@@ -538,6 +559,7 @@ type RegistrationServiceClient interface {
 	Register(context.Context, *connect.Request[user_service.UserRegistrationRequest]) (*connect.Response[user_service.RegistrationResponse], error)
 	VerifyEmail(context.Context, *connect.Request[user_service.VerifyEmailRequest]) (*connect.Response[user_service.VerifyEmailResponse], error)
 	ResendVerificationEmail(context.Context, *connect.Request[user_service.ResendVerificationEmailRequest]) (*connect.Response[user_service.ResendVerificationEmailResponse], error)
+	CompleteOAuthSignup(context.Context, *connect.Request[user_service.CompleteOAuthSignupRequest]) (*connect.Response[user_service.CompleteOAuthSignupResponse], error)
 }
 
 // NewRegistrationServiceClient constructs a client for the user_service.RegistrationService
@@ -569,6 +591,12 @@ func NewRegistrationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(registrationServiceMethods.ByName("ResendVerificationEmail")),
 			connect.WithClientOptions(opts...),
 		),
+		completeOAuthSignup: connect.NewClient[user_service.CompleteOAuthSignupRequest, user_service.CompleteOAuthSignupResponse](
+			httpClient,
+			baseURL+RegistrationServiceCompleteOAuthSignupProcedure,
+			connect.WithSchema(registrationServiceMethods.ByName("CompleteOAuthSignup")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -577,6 +605,7 @@ type registrationServiceClient struct {
 	register                *connect.Client[user_service.UserRegistrationRequest, user_service.RegistrationResponse]
 	verifyEmail             *connect.Client[user_service.VerifyEmailRequest, user_service.VerifyEmailResponse]
 	resendVerificationEmail *connect.Client[user_service.ResendVerificationEmailRequest, user_service.ResendVerificationEmailResponse]
+	completeOAuthSignup     *connect.Client[user_service.CompleteOAuthSignupRequest, user_service.CompleteOAuthSignupResponse]
 }
 
 // Register calls user_service.RegistrationService.Register.
@@ -594,11 +623,17 @@ func (c *registrationServiceClient) ResendVerificationEmail(ctx context.Context,
 	return c.resendVerificationEmail.CallUnary(ctx, req)
 }
 
+// CompleteOAuthSignup calls user_service.RegistrationService.CompleteOAuthSignup.
+func (c *registrationServiceClient) CompleteOAuthSignup(ctx context.Context, req *connect.Request[user_service.CompleteOAuthSignupRequest]) (*connect.Response[user_service.CompleteOAuthSignupResponse], error) {
+	return c.completeOAuthSignup.CallUnary(ctx, req)
+}
+
 // RegistrationServiceHandler is an implementation of the user_service.RegistrationService service.
 type RegistrationServiceHandler interface {
 	Register(context.Context, *connect.Request[user_service.UserRegistrationRequest]) (*connect.Response[user_service.RegistrationResponse], error)
 	VerifyEmail(context.Context, *connect.Request[user_service.VerifyEmailRequest]) (*connect.Response[user_service.VerifyEmailResponse], error)
 	ResendVerificationEmail(context.Context, *connect.Request[user_service.ResendVerificationEmailRequest]) (*connect.Response[user_service.ResendVerificationEmailResponse], error)
+	CompleteOAuthSignup(context.Context, *connect.Request[user_service.CompleteOAuthSignupRequest]) (*connect.Response[user_service.CompleteOAuthSignupResponse], error)
 }
 
 // NewRegistrationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -626,6 +661,12 @@ func NewRegistrationServiceHandler(svc RegistrationServiceHandler, opts ...conne
 		connect.WithSchema(registrationServiceMethods.ByName("ResendVerificationEmail")),
 		connect.WithHandlerOptions(opts...),
 	)
+	registrationServiceCompleteOAuthSignupHandler := connect.NewUnaryHandler(
+		RegistrationServiceCompleteOAuthSignupProcedure,
+		svc.CompleteOAuthSignup,
+		connect.WithSchema(registrationServiceMethods.ByName("CompleteOAuthSignup")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user_service.RegistrationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RegistrationServiceRegisterProcedure:
@@ -634,6 +675,8 @@ func NewRegistrationServiceHandler(svc RegistrationServiceHandler, opts ...conne
 			registrationServiceVerifyEmailHandler.ServeHTTP(w, r)
 		case RegistrationServiceResendVerificationEmailProcedure:
 			registrationServiceResendVerificationEmailHandler.ServeHTTP(w, r)
+		case RegistrationServiceCompleteOAuthSignupProcedure:
+			registrationServiceCompleteOAuthSignupHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -655,10 +698,17 @@ func (UnimplementedRegistrationServiceHandler) ResendVerificationEmail(context.C
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.RegistrationService.ResendVerificationEmail is not implemented"))
 }
 
+func (UnimplementedRegistrationServiceHandler) CompleteOAuthSignup(context.Context, *connect.Request[user_service.CompleteOAuthSignupRequest]) (*connect.Response[user_service.CompleteOAuthSignupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.RegistrationService.CompleteOAuthSignup is not implemented"))
+}
+
 // ProfileServiceClient is a client for the user_service.ProfileService service.
 type ProfileServiceClient interface {
 	GetRatings(context.Context, *connect.Request[user_service.RatingsRequest]) (*connect.Response[user_service.RatingsResponse], error)
 	GetStats(context.Context, *connect.Request[user_service.StatsRequest]) (*connect.Response[user_service.StatsResponse], error)
+	// GetRatingHistory gets a user's rating changes in a variant, with the
+	// breakdown of each change.
+	GetRatingHistory(context.Context, *connect.Request[user_service.RatingHistoryRequest]) (*connect.Response[user_service.RatingHistoryResponse], error)
 	GetProfile(context.Context, *connect.Request[user_service.ProfileRequest]) (*connect.Response[user_service.ProfileResponse], error)
 	GetPersonalInfo(context.Context, *connect.Request[user_service.PersonalInfoRequest]) (*connect.Response[user_service.PersonalInfoResponse], error)
 	UpdatePersonalInfo(context.Context, *connect.Request[user_service.UpdatePersonalInfoRequest]) (*connect.Response[user_service.UpdatePersonalInfoResponse], error)
@@ -691,6 +741,12 @@ func NewProfileServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(profileServiceMethods.ByName("GetStats")),
 			connect.WithClientOptions(opts...),
 		),
+		getRatingHistory: connect.NewClient[user_service.RatingHistoryRequest, user_service.RatingHistoryResponse](
+			httpClient,
+			baseURL+ProfileServiceGetRatingHistoryProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("GetRatingHistory")),
+			connect.WithClientOptions(opts...),
+		),
 		getProfile: connect.NewClient[user_service.ProfileRequest, user_service.ProfileResponse](
 			httpClient,
 			baseURL+ProfileServiceGetProfileProcedure,
@@ -742,6 +798,7 @@ func NewProfileServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type profileServiceClient struct {
 	getRatings         *connect.Client[user_service.RatingsRequest, user_service.RatingsResponse]
 	getStats           *connect.Client[user_service.StatsRequest, user_service.StatsResponse]
+	getRatingHistory   *connect.Client[user_service.RatingHistoryRequest, user_service.RatingHistoryResponse]
 	getProfile         *connect.Client[user_service.ProfileRequest, user_service.ProfileResponse]
 	getPersonalInfo    *connect.Client[user_service.PersonalInfoRequest, user_service.PersonalInfoResponse]
 	updatePersonalInfo *connect.Client[user_service.UpdatePersonalInfoRequest, user_service.UpdatePersonalInfoResponse]
@@ -761,6 +818,11 @@ func (c *profileServiceClient) GetStats(ctx context.Context, req *connect.Reques
 	return c.getStats.CallUnary(ctx, req)
 }
 
+// GetRatingHistory calls user_service.ProfileService.GetRatingHistory.
+func (c *profileServiceClient) GetRatingHistory(ctx context.Context, req *connect.Request[user_service.RatingHistoryRequest]) (*connect.Response[user_service.RatingHistoryResponse], error) {
+	return c.getRatingHistory.CallUnary(ctx, req)
+}
+
 // GetProfile calls user_service.ProfileService.GetProfile.
 func (c *profileServiceClient) GetProfile(ctx context.Context, req *connect.Request[user_service.ProfileRequest]) (*connect.Response[user_service.ProfileResponse], error) {
 	return c.getProfile.CallUnary(ctx, req)
@@ -800,6 +862,9 @@ func (c *profileServiceClient) GetBadgesMetadata(ctx context.Context, req *conne
 type ProfileServiceHandler interface {
 	GetRatings(context.Context, *connect.Request[user_service.RatingsRequest]) (*connect.Response[user_service.RatingsResponse], error)
 	GetStats(context.Context, *connect.Request[user_service.StatsRequest]) (*connect.Response[user_service.StatsResponse], error)
+	// GetRatingHistory gets a user's rating changes in a variant, with the
+	// breakdown of each change.
+	GetRatingHistory(context.Context, *connect.Request[user_service.RatingHistoryRequest]) (*connect.Response[user_service.RatingHistoryResponse], error)
 	GetProfile(context.Context, *connect.Request[user_service.ProfileRequest]) (*connect.Response[user_service.ProfileResponse], error)
 	GetPersonalInfo(context.Context, *connect.Request[user_service.PersonalInfoRequest]) (*connect.Response[user_service.PersonalInfoResponse], error)
 	UpdatePersonalInfo(context.Context, *connect.Request[user_service.UpdatePersonalInfoRequest]) (*connect.Response[user_service.UpdatePersonalInfoResponse], error)
@@ -828,6 +893,12 @@ func NewProfileServiceHandler(svc ProfileServiceHandler, opts ...connect.Handler
 		connect.WithSchema(profileServiceMethods.ByName("GetStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	profileServiceGetRatingHistoryHandler := connect.NewUnaryHandler(
+		ProfileServiceGetRatingHistoryProcedure,
+		svc.GetRatingHistory,
+		connect.WithSchema(profileServiceMethods.ByName("GetRatingHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
 	profileServiceGetProfileHandler := connect.NewUnaryHandler(
 		ProfileServiceGetProfileProcedure,
 		svc.GetProfile,
@@ -878,6 +949,8 @@ func NewProfileServiceHandler(svc ProfileServiceHandler, opts ...connect.Handler
 			profileServiceGetRatingsHandler.ServeHTTP(w, r)
 		case ProfileServiceGetStatsProcedure:
 			profileServiceGetStatsHandler.ServeHTTP(w, r)
+		case ProfileServiceGetRatingHistoryProcedure:
+			profileServiceGetRatingHistoryHandler.ServeHTTP(w, r)
 		case ProfileServiceGetProfileProcedure:
 			profileServiceGetProfileHandler.ServeHTTP(w, r)
 		case ProfileServiceGetPersonalInfoProcedure:
@@ -909,6 +982,10 @@ func (UnimplementedProfileServiceHandler) GetStats(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.ProfileService.GetStats is not implemented"))
 }
 
+func (UnimplementedProfileServiceHandler) GetRatingHistory(context.Context, *connect.Request[user_service.RatingHistoryRequest]) (*connect.Response[user_service.RatingHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.ProfileService.GetRatingHistory is not implemented"))
+}
+
 func (UnimplementedProfileServiceHandler) GetProfile(context.Context, *connect.Request[user_service.ProfileRequest]) (*connect.Response[user_service.ProfileResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.ProfileService.GetProfile is not implemented"))
 }
@@ -1020,6 +1097,10 @@ type SocializeServiceClient interface {
 	GetFullBlocks(context.Context, *connect.Request[user_service.GetFullBlocksRequest]) (*connect.Response[user_service.GetFullBlocksResponse], error)
 	GetActiveChatChannels(context.Context, *connect.Request[user_service.GetActiveChatChannelsRequest]) (*connect.Response[user_service.ActiveChatChannels], error)
 	GetChatsForChannel(context.Context, *connect.Request[user_service.GetChatsRequest]) (*connect.Response[ipc.ChatMessages], error)
+	MarkChatChannelRead(context.Context, *connect.Request[user_service.MarkChatChannelReadRequest]) (*connect.Response[user_service.OKResponse], error)
+	GetUnreadMessageCount(context.Context, *connect.Request[user_service.GetUnreadMessageCountRequest]) (*connect.Response[user_service.UnreadMessageCount], error)
+	// SearchPrivateMessages searches the user's own private messages.
+	SearchPrivateMessages(context.Context, *connect.Request[user_service.SearchPrivateMessagesRequest]) (*connect.Response[ipc.ChatMessages], error)
 }
 
 // NewSocializeServiceClient constructs a client for the user_service.SocializeService service. By
@@ -1087,6 +1168,24 @@ func NewSocializeServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(socializeServiceMethods.ByName("GetChatsForChannel")),
 			connect.WithClientOptions(opts...),
 		),
+		markChatChannelRead: connect.NewClient[user_service.MarkChatChannelReadRequest, user_service.OKResponse](
+			httpClient,
+			baseURL+SocializeServiceMarkChatChannelReadProcedure,
+			connect.WithSchema(socializeServiceMethods.ByName("MarkChatChannelRead")),
+			connect.WithClientOptions(opts...),
+		),
+		getUnreadMessageCount: connect.NewClient[user_service.GetUnreadMessageCountRequest, user_service.UnreadMessageCount](
+			httpClient,
+			baseURL+SocializeServiceGetUnreadMessageCountProcedure,
+			connect.WithSchema(socializeServiceMethods.ByName("GetUnreadMessageCount")),
+			connect.WithClientOptions(opts...),
+		),
+		searchPrivateMessages: connect.NewClient[user_service.SearchPrivateMessagesRequest, ipc.ChatMessages](
+			httpClient,
+			baseURL+SocializeServiceSearchPrivateMessagesProcedure,
+			connect.WithSchema(socializeServiceMethods.ByName("SearchPrivateMessages")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -1101,6 +1200,9 @@ type socializeServiceClient struct {
 	getFullBlocks         *connect.Client[user_service.GetFullBlocksRequest, user_service.GetFullBlocksResponse]
 	getActiveChatChannels *connect.Client[user_service.GetActiveChatChannelsRequest, user_service.ActiveChatChannels]
 	getChatsForChannel    *connect.Client[user_service.GetChatsRequest, ipc.ChatMessages]
+	markChatChannelRead   *connect.Client[user_service.MarkChatChannelReadRequest, user_service.OKResponse]
+	getUnreadMessageCount *connect.Client[user_service.GetUnreadMessageCountRequest, user_service.UnreadMessageCount]
+	searchPrivateMessages *connect.Client[user_service.SearchPrivateMessagesRequest, ipc.ChatMessages]
 }
 
 // AddFollow calls user_service.SocializeService.AddFollow.
@@ -1148,6 +1250,21 @@ func (c *socializeServiceClient) GetChatsForChannel(ctx context.Context, req *co
 	return c.getChatsForChannel.CallUnary(ctx, req)
 }
 
+// MarkChatChannelRead calls user_service.SocializeService.MarkChatChannelRead.
+func (c *socializeServiceClient) MarkChatChannelRead(ctx context.Context, req *connect.Request[user_service.MarkChatChannelReadRequest]) (*connect.Response[user_service.OKResponse], error) {
+	return c.markChatChannelRead.CallUnary(ctx, req)
+}
+
+// GetUnreadMessageCount calls user_service.SocializeService.GetUnreadMessageCount.
+func (c *socializeServiceClient) GetUnreadMessageCount(ctx context.Context, req *connect.Request[user_service.GetUnreadMessageCountRequest]) (*connect.Response[user_service.UnreadMessageCount], error) {
+	return c.getUnreadMessageCount.CallUnary(ctx, req)
+}
+
+// SearchPrivateMessages calls user_service.SocializeService.SearchPrivateMessages.
+func (c *socializeServiceClient) SearchPrivateMessages(ctx context.Context, req *connect.Request[user_service.SearchPrivateMessagesRequest]) (*connect.Response[ipc.ChatMessages], error) {
+	return c.searchPrivateMessages.CallUnary(ctx, req)
+}
+
 // SocializeServiceHandler is an implementation of the user_service.SocializeService service.
 type SocializeServiceHandler interface {
 	AddFollow(context.Context, *connect.Request[user_service.AddFollowRequest]) (*connect.Response[user_service.OKResponse], error)
@@ -1161,6 +1278,10 @@ type SocializeServiceHandler interface {
 	GetFullBlocks(context.Context, *connect.Request[user_service.GetFullBlocksRequest]) (*connect.Response[user_service.GetFullBlocksResponse], error)
 	GetActiveChatChannels(context.Context, *connect.Request[user_service.GetActiveChatChannelsRequest]) (*connect.Response[user_service.ActiveChatChannels], error)
 	GetChatsForChannel(context.Context, *connect.Request[user_service.GetChatsRequest]) (*connect.Response[ipc.ChatMessages], error)
+	MarkChatChannelRead(context.Context, *connect.Request[user_service.MarkChatChannelReadRequest]) (*connect.Response[user_service.OKResponse], error)
+	GetUnreadMessageCount(context.Context, *connect.Request[user_service.GetUnreadMessageCountRequest]) (*connect.Response[user_service.UnreadMessageCount], error)
+	// SearchPrivateMessages searches the user's own private messages.
+	SearchPrivateMessages(context.Context, *connect.Request[user_service.SearchPrivateMessagesRequest]) (*connect.Response[ipc.ChatMessages], error)
 }
 
 // NewSocializeServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -1224,6 +1345,24 @@ func NewSocializeServiceHandler(svc SocializeServiceHandler, opts ...connect.Han
 		connect.WithSchema(socializeServiceMethods.ByName("GetChatsForChannel")),
 		connect.WithHandlerOptions(opts...),
 	)
+	socializeServiceMarkChatChannelReadHandler := connect.NewUnaryHandler(
+		SocializeServiceMarkChatChannelReadProcedure,
+		svc.MarkChatChannelRead,
+		connect.WithSchema(socializeServiceMethods.ByName("MarkChatChannelRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	socializeServiceGetUnreadMessageCountHandler := connect.NewUnaryHandler(
+		SocializeServiceGetUnreadMessageCountProcedure,
+		svc.GetUnreadMessageCount,
+		connect.WithSchema(socializeServiceMethods.ByName("GetUnreadMessageCount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	socializeServiceSearchPrivateMessagesHandler := connect.NewUnaryHandler(
+		SocializeServiceSearchPrivateMessagesProcedure,
+		svc.SearchPrivateMessages,
+		connect.WithSchema(socializeServiceMethods.ByName("SearchPrivateMessages")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user_service.SocializeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case SocializeServiceAddFollowProcedure:
@@ -1244,6 +1383,12 @@ func NewSocializeServiceHandler(svc SocializeServiceHandler, opts ...connect.Han
 			socializeServiceGetActiveChatChannelsHandler.ServeHTTP(w, r)
 		case SocializeServiceGetChatsForChannelProcedure:
 			socializeServiceGetChatsForChannelHandler.ServeHTTP(w, r)
+		case SocializeServiceMarkChatChannelReadProcedure:
+			socializeServiceMarkChatChannelReadHandler.ServeHTTP(w, r)
+		case SocializeServiceGetUnreadMessageCountProcedure:
+			socializeServiceGetUnreadMessageCountHandler.ServeHTTP(w, r)
+		case SocializeServiceSearchPrivateMessagesProcedure:
+			socializeServiceSearchPrivateMessagesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1289,10 +1434,24 @@ func (UnimplementedSocializeServiceHandler) GetChatsForChannel(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.SocializeService.GetChatsForChannel is not implemented"))
 }
 
+func (UnimplementedSocializeServiceHandler) MarkChatChannelRead(context.Context, *connect.Request[user_service.MarkChatChannelReadRequest]) (*connect.Response[user_service.OKResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.SocializeService.MarkChatChannelRead is not implemented"))
+}
+
+func (UnimplementedSocializeServiceHandler) GetUnreadMessageCount(context.Context, *connect.Request[user_service.GetUnreadMessageCountRequest]) (*connect.Response[user_service.UnreadMessageCount], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.SocializeService.GetUnreadMessageCount is not implemented"))
+}
+
+func (UnimplementedSocializeServiceHandler) SearchPrivateMessages(context.Context, *connect.Request[user_service.SearchPrivateMessagesRequest]) (*connect.Response[ipc.ChatMessages], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.SocializeService.SearchPrivateMessages is not implemented"))
+}
+
 // IntegrationServiceClient is a client for the user_service.IntegrationService service.
 type IntegrationServiceClient interface {
 	GetIntegrations(context.Context, *connect.Request[user_service.GetIntegrationsRequest]) (*connect.Response[user_service.IntegrationsResponse], error)
 	DeleteIntegration(context.Context, *connect.Request[user_service.DeleteIntegrationRequest]) (*connect.Response[user_service.DeleteIntegrationResponse], error)
+	GetLoginIdentities(context.Context, *connect.Request[user_service.GetLoginIdentitiesRequest]) (*connect.Response[user_service.LoginIdentitiesResponse], error)
+	UnlinkLoginIdentity(context.Context, *connect.Request[user_service.UnlinkLoginIdentityRequest]) (*connect.Response[user_service.UnlinkLoginIdentityResponse], error)
 }
 
 // NewIntegrationServiceClient constructs a client for the user_service.IntegrationService service.
@@ -1319,13 +1478,28 @@ func NewIntegrationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(integrationServiceMethods.ByName("DeleteIntegration")),
 			connect.WithClientOptions(opts...),
 		),
+		getLoginIdentities: connect.NewClient[user_service.GetLoginIdentitiesRequest, user_service.LoginIdentitiesResponse](
+			httpClient,
+			baseURL+IntegrationServiceGetLoginIdentitiesProcedure,
+			connect.WithSchema(integrationServiceMethods.ByName("GetLoginIdentities")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkLoginIdentity: connect.NewClient[user_service.UnlinkLoginIdentityRequest, user_service.UnlinkLoginIdentityResponse](
+			httpClient,
+			baseURL+IntegrationServiceUnlinkLoginIdentityProcedure,
+			connect.WithSchema(integrationServiceMethods.ByName("UnlinkLoginIdentity")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // integrationServiceClient implements IntegrationServiceClient.
 type integrationServiceClient struct {
-	getIntegrations   *connect.Client[user_service.GetIntegrationsRequest, user_service.IntegrationsResponse]
-	deleteIntegration *connect.Client[user_service.DeleteIntegrationRequest, user_service.DeleteIntegrationResponse]
+	getIntegrations     *connect.Client[user_service.GetIntegrationsRequest, user_service.IntegrationsResponse]
+	deleteIntegration   *connect.Client[user_service.DeleteIntegrationRequest, user_service.DeleteIntegrationResponse]
+	getLoginIdentities  *connect.Client[user_service.GetLoginIdentitiesRequest, user_service.LoginIdentitiesResponse]
+	unlinkLoginIdentity *connect.Client[user_service.UnlinkLoginIdentityRequest, user_service.UnlinkLoginIdentityResponse]
 }
 
 // GetIntegrations calls user_service.IntegrationService.GetIntegrations.
@@ -1338,10 +1512,22 @@ func (c *integrationServiceClient) DeleteIntegration(ctx context.Context, req *c
 	return c.deleteIntegration.CallUnary(ctx, req)
 }
 
+// GetLoginIdentities calls user_service.IntegrationService.GetLoginIdentities.
+func (c *integrationServiceClient) GetLoginIdentities(ctx context.Context, req *connect.Request[user_service.GetLoginIdentitiesRequest]) (*connect.Response[user_service.LoginIdentitiesResponse], error) {
+	return c.getLoginIdentities.CallUnary(ctx, req)
+}
+
+// UnlinkLoginIdentity calls user_service.IntegrationService.UnlinkLoginIdentity.
+func (c *integrationServiceClient) UnlinkLoginIdentity(ctx context.Context, req *connect.Request[user_service.UnlinkLoginIdentityRequest]) (*connect.Response[user_service.UnlinkLoginIdentityResponse], error) {
+	return c.unlinkLoginIdentity.CallUnary(ctx, req)
+}
+
 // IntegrationServiceHandler is an implementation of the user_service.IntegrationService service.
 type IntegrationServiceHandler interface {
 	GetIntegrations(context.Context, *connect.Request[user_service.GetIntegrationsRequest]) (*connect.Response[user_service.IntegrationsResponse], error)
 	DeleteIntegration(context.Context, *connect.Request[user_service.DeleteIntegrationRequest]) (*connect.Response[user_service.DeleteIntegrationResponse], error)
+	GetLoginIdentities(context.Context, *connect.Request[user_service.GetLoginIdentitiesRequest]) (*connect.Response[user_service.LoginIdentitiesResponse], error)
+	UnlinkLoginIdentity(context.Context, *connect.Request[user_service.UnlinkLoginIdentityRequest]) (*connect.Response[user_service.UnlinkLoginIdentityResponse], error)
 }
 
 // NewIntegrationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -1364,12 +1550,29 @@ func NewIntegrationServiceHandler(svc IntegrationServiceHandler, opts ...connect
 		connect.WithSchema(integrationServiceMethods.ByName("DeleteIntegration")),
 		connect.WithHandlerOptions(opts...),
 	)
+	integrationServiceGetLoginIdentitiesHandler := connect.NewUnaryHandler(
+		IntegrationServiceGetLoginIdentitiesProcedure,
+		svc.GetLoginIdentities,
+		connect.WithSchema(integrationServiceMethods.ByName("GetLoginIdentities")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	integrationServiceUnlinkLoginIdentityHandler := connect.NewUnaryHandler(
+		IntegrationServiceUnlinkLoginIdentityProcedure,
+		svc.UnlinkLoginIdentity,
+		connect.WithSchema(integrationServiceMethods.ByName("UnlinkLoginIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/user_service.IntegrationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case IntegrationServiceGetIntegrationsProcedure:
 			integrationServiceGetIntegrationsHandler.ServeHTTP(w, r)
 		case IntegrationServiceDeleteIntegrationProcedure:
 			integrationServiceDeleteIntegrationHandler.ServeHTTP(w, r)
+		case IntegrationServiceGetLoginIdentitiesProcedure:
+			integrationServiceGetLoginIdentitiesHandler.ServeHTTP(w, r)
+		case IntegrationServiceUnlinkLoginIdentityProcedure:
+			integrationServiceUnlinkLoginIdentityHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1387,6 +1590,14 @@ func (UnimplementedIntegrationServiceHandler) DeleteIntegration(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.IntegrationService.DeleteIntegration is not implemented"))
 }
 
+func (UnimplementedIntegrationServiceHandler) GetLoginIdentities(context.Context, *connect.Request[user_service.GetLoginIdentitiesRequest]) (*connect.Response[user_service.LoginIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.IntegrationService.GetLoginIdentities is not implemented"))
+}
+
+func (UnimplementedIntegrationServiceHandler) UnlinkLoginIdentity(context.Context, *connect.Request[user_service.UnlinkLoginIdentityRequest]) (*connect.Response[user_service.UnlinkLoginIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("user_service.IntegrationService.UnlinkLoginIdentity is not implemented"))
+}
+
 // AuthorizationServiceClient is a client for the user_service.AuthorizationService service.
 type AuthorizationServiceClient interface {
 	GetModList(context.Context, *connect.Request[user_service.GetModListRequest]) (*connect.Response[user_service.GetModListResponse], error)
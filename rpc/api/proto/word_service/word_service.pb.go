@@ -9,6 +9,7 @@ package word_service
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type LetterQuery int32
+
+const (
+	LetterQuery_NO_LETTER_QUERY LetterQuery = 0
+	// Words that use all of the letters
+	LetterQuery_ANAGRAM LetterQuery = 1
+	// Words that use some of the letters
+	LetterQuery_SUBANAGRAM LetterQuery = 2
+	// Words that use all of the letters and more
+	LetterQuery_BUILD_UP LetterQuery = 3
+)
+
+// Enum value maps for LetterQuery.
+var (
+	LetterQuery_name = map[int32]string{
+		0: "NO_LETTER_QUERY",
+		1: "ANAGRAM",
+		2: "SUBANAGRAM",
+		3: "BUILD_UP",
+	}
+	LetterQuery_value = map[string]int32{
+		"NO_LETTER_QUERY": 0,
+		"ANAGRAM":         1,
+		"SUBANAGRAM":      2,
+		"BUILD_UP":        3,
+	}
+)
+
+func (x LetterQuery) Enum() *LetterQuery {
+	p := new(LetterQuery)
+	*p = x
+	return p
+}
+
+func (x LetterQuery) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LetterQuery) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_word_service_word_service_proto_enumTypes[0].Descriptor()
+}
+
+func (LetterQuery) Type() protoreflect.EnumType {
+	return &file_proto_word_service_word_service_proto_enumTypes[0]
+}
+
+func (x LetterQuery) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LetterQuery.Descriptor instead.
+func (LetterQuery) EnumDescriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{0}
+}
+
+type WordQuizGuessResponse_Result int32
+
+const (
+	WordQuizGuessResponse_WRONG         WordQuizGuessResponse_Result = 0
+	WordQuizGuessResponse_CORRECT       WordQuizGuessResponse_Result = 1
+	WordQuizGuessResponse_ALREADY_FOUND WordQuizGuessResponse_Result = 2
+)
+
+// Enum value maps for WordQuizGuessResponse_Result.
+var (
+	WordQuizGuessResponse_Result_name = map[int32]string{
+		0: "WRONG",
+		1: "CORRECT",
+		2: "ALREADY_FOUND",
+	}
+	WordQuizGuessResponse_Result_value = map[string]int32{
+		"WRONG":         0,
+		"CORRECT":       1,
+		"ALREADY_FOUND": 2,
+	}
+)
+
+func (x WordQuizGuessResponse_Result) Enum() *WordQuizGuessResponse_Result {
+	p := new(WordQuizGuessResponse_Result)
+	*p = x
+	return p
+}
+
+func (x WordQuizGuessResponse_Result) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WordQuizGuessResponse_Result) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_word_service_word_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WordQuizGuessResponse_Result) Type() protoreflect.EnumType {
+	return &file_proto_word_service_word_service_proto_enumTypes[1]
+}
+
+func (x WordQuizGuessResponse_Result) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WordQuizGuessResponse_Result.Descriptor instead.
+func (WordQuizGuessResponse_Result) EnumDescriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{19, 0}
+}
+
 type DefineWordsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
@@ -89,17 +194,92 @@ func (x *DefineWordsRequest) GetAnagrams() bool {
 	return false
 }
 
+type Definition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// word is the word that is defined. With anagrams, it is one of the
+	// anagrams of the query.
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// source names the dictionary that the definition is from.
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// lemma is set if the word has no definitions of its own, and this is a
+	// definition of the word that it is an inflection of.
+	Lemma         string `protobuf:"bytes,4,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Definition) Reset() {
+	*x = Definition{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Definition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Definition) ProtoMessage() {}
+
+func (x *Definition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Definition.ProtoReflect.Descriptor instead.
+func (*Definition) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Definition) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *Definition) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Definition) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Definition) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
 type DefineWordsResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	D             string                 `protobuf:"bytes,1,opt,name=d,proto3" json:"d,omitempty"`  // definitions, not "" iff (valid and requesting definitions)
-	V             bool                   `protobuf:"varint,2,opt,name=v,proto3" json:"v,omitempty"` // true iff valid
+	state protoimpl.MessageState `protogen:"open.v1"`
+	D     string                 `protobuf:"bytes,1,opt,name=d,proto3" json:"d,omitempty"`  // definitions, not "" iff (valid and requesting definitions)
+	V     bool                   `protobuf:"varint,2,opt,name=v,proto3" json:"v,omitempty"` // true iff valid
+	// definitions are what d is made of, one per sense, with their sources.
+	Definitions   []*Definition `protobuf:"bytes,3,rep,name=definitions,proto3" json:"definitions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DefineWordsResult) Reset() {
 	*x = DefineWordsResult{}
-	mi := &file_proto_word_service_word_service_proto_msgTypes[1]
+	mi := &file_proto_word_service_word_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -111,7 +291,7 @@ func (x *DefineWordsResult) String() string {
 func (*DefineWordsResult) ProtoMessage() {}
 
 func (x *DefineWordsResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_word_service_word_service_proto_msgTypes[1]
+	mi := &file_proto_word_service_word_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -124,7 +304,7 @@ func (x *DefineWordsResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefineWordsResult.ProtoReflect.Descriptor instead.
 func (*DefineWordsResult) Descriptor() ([]byte, []int) {
-	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{1}
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DefineWordsResult) GetD() string {
@@ -141,6 +321,13 @@ func (x *DefineWordsResult) GetV() bool {
 	return false
 }
 
+func (x *DefineWordsResult) GetDefinitions() []*Definition {
+	if x != nil {
+		return x.Definitions
+	}
+	return nil
+}
+
 type DefineWordsResponse struct {
 	state         protoimpl.MessageState        `protogen:"open.v1"`
 	Results       map[string]*DefineWordsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
@@ -150,7 +337,7 @@ type DefineWordsResponse struct {
 
 func (x *DefineWordsResponse) Reset() {
 	*x = DefineWordsResponse{}
-	mi := &file_proto_word_service_word_service_proto_msgTypes[2]
+	mi := &file_proto_word_service_word_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +349,7 @@ func (x *DefineWordsResponse) String() string {
 func (*DefineWordsResponse) ProtoMessage() {}
 
 func (x *DefineWordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_word_service_word_service_proto_msgTypes[2]
+	mi := &file_proto_word_service_word_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +362,7 @@ func (x *DefineWordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefineWordsResponse.ProtoReflect.Descriptor instead.
 func (*DefineWordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{2}
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DefineWordsResponse) GetResults() map[string]*DefineWordsResult {
@@ -185,26 +372,1496 @@ func (x *DefineWordsResponse) GetResults() map[string]*DefineWordsResult {
 	return nil
 }
 
-var File_proto_word_service_word_service_proto protoreflect.FileDescriptor
+type SearchWordsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// A pattern the whole word must match. ? matches any letter, * matches
+	// any number of letters, and [AEIOU] or [^AEIOU] match one letter that
+	// is or isn't in the brackets. An empty pattern matches every word.
+	Pattern     string      `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	LetterQuery LetterQuery `protobuf:"varint,3,opt,name=letter_query,json=letterQuery,proto3,enum=word_service.LetterQuery" json:"letter_query,omitempty"`
+	// The letters for the letter query. ? is a blank.
+	Letters string `protobuf:"bytes,4,opt,name=letters,proto3" json:"letters,omitempty"`
+	// Lengths are not limited if 0.
+	MinLength int32 `protobuf:"varint,5,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength int32 `protobuf:"varint,6,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	// Probability ranks are not limited if 0.
+	MinProbabilityRank int32 `protobuf:"varint,7,opt,name=min_probability_rank,json=minProbabilityRank,proto3" json:"min_probability_rank,omitempty"`
+	MaxProbabilityRank int32 `protobuf:"varint,8,opt,name=max_probability_rank,json=maxProbabilityRank,proto3" json:"max_probability_rank,omitempty"`
+	// The letter distribution for probability ranks. Defaults to the
+	// lexicon's usual distribution.
+	LetterDistribution string `protobuf:"bytes,9,opt,name=letter_distribution,json=letterDistribution,proto3" json:"letter_distribution,omitempty"`
+	Limit              int32  `protobuf:"varint,10,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset             int32  `protobuf:"varint,11,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
 
-const file_proto_word_service_word_service_proto_rawDesc = "" +
-	"\n" +
-	"%proto/word_service/word_service.proto\x12\fword_service\"\x82\x01\n" +
-	"\x12DefineWordsRequest\x12\x18\n" +
-	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x14\n" +
-	"\x05words\x18\x02 \x03(\tR\x05words\x12 \n" +
-	"\vdefinitions\x18\x03 \x01(\bR\vdefinitions\x12\x1a\n" +
-	"\banagrams\x18\x04 \x01(\bR\banagrams\"/\n" +
-	"\x11DefineWordsResult\x12\f\n" +
-	"\x01d\x18\x01 \x01(\tR\x01d\x12\f\n" +
-	"\x01v\x18\x02 \x01(\bR\x01v\"\xbc\x01\n" +
-	"\x13DefineWordsResponse\x12H\n" +
-	"\aresults\x18\x01 \x03(\v2..word_service.DefineWordsResponse.ResultsEntryR\aresults\x1a[\n" +
-	"\fResultsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
-	"\x05value\x18\x02 \x01(\v2\x1f.word_service.DefineWordsResultR\x05value:\x028\x012a\n" +
+func (x *SearchWordsRequest) Reset() {
+	*x = SearchWordsRequest{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWordsRequest) ProtoMessage() {}
+
+func (x *SearchWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWordsRequest.ProtoReflect.Descriptor instead.
+func (*SearchWordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchWordsRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *SearchWordsRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *SearchWordsRequest) GetLetterQuery() LetterQuery {
+	if x != nil {
+		return x.LetterQuery
+	}
+	return LetterQuery_NO_LETTER_QUERY
+}
+
+func (x *SearchWordsRequest) GetLetters() string {
+	if x != nil {
+		return x.Letters
+	}
+	return ""
+}
+
+func (x *SearchWordsRequest) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *SearchWordsRequest) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *SearchWordsRequest) GetMinProbabilityRank() int32 {
+	if x != nil {
+		return x.MinProbabilityRank
+	}
+	return 0
+}
+
+func (x *SearchWordsRequest) GetMaxProbabilityRank() int32 {
+	if x != nil {
+		return x.MaxProbabilityRank
+	}
+	return 0
+}
+
+func (x *SearchWordsRequest) GetLetterDistribution() string {
+	if x != nil {
+		return x.LetterDistribution
+	}
+	return ""
+}
+
+func (x *SearchWordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchWordsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchWordsResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Word  string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// Words of the same length are ranked by how likely their letters are to
+	// be drawn from a full bag, not counting blanks. Anagrams share a rank.
+	ProbabilityRank int32  `protobuf:"varint,2,opt,name=probability_rank,json=probabilityRank,proto3" json:"probability_rank,omitempty"`
+	FrontHooks      string `protobuf:"bytes,3,opt,name=front_hooks,json=frontHooks,proto3" json:"front_hooks,omitempty"`
+	BackHooks       string `protobuf:"bytes,4,opt,name=back_hooks,json=backHooks,proto3" json:"back_hooks,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchWordsResult) Reset() {
+	*x = SearchWordsResult{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWordsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWordsResult) ProtoMessage() {}
+
+func (x *SearchWordsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWordsResult.ProtoReflect.Descriptor instead.
+func (*SearchWordsResult) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchWordsResult) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *SearchWordsResult) GetProbabilityRank() int32 {
+	if x != nil {
+		return x.ProbabilityRank
+	}
+	return 0
+}
+
+func (x *SearchWordsResult) GetFrontHooks() string {
+	if x != nil {
+		return x.FrontHooks
+	}
+	return ""
+}
+
+func (x *SearchWordsResult) GetBackHooks() string {
+	if x != nil {
+		return x.BackHooks
+	}
+	return ""
+}
+
+type SearchWordsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by length, then probability rank, then alphabetically.
+	Results []*SearchWordsResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// The number of matches before paging
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchWordsResponse) Reset() {
+	*x = SearchWordsResponse{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWordsResponse) ProtoMessage() {}
+
+func (x *SearchWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWordsResponse.ProtoReflect.Descriptor instead.
+func (*SearchWordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchWordsResponse) GetResults() []*SearchWordsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchWordsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type LexiconDiffRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	FromLexicon string                 `protobuf:"bytes,1,opt,name=from_lexicon,json=fromLexicon,proto3" json:"from_lexicon,omitempty"`
+	ToLexicon   string                 `protobuf:"bytes,2,opt,name=to_lexicon,json=toLexicon,proto3" json:"to_lexicon,omitempty"`
+	// Lengths are not limited if 0.
+	MinLength int32 `protobuf:"varint,3,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength int32 `protobuf:"varint,4,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	// Both added and removed are paged with these. The limit defaults to
+	// 1000 words and is at most 10000.
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LexiconDiffRequest) Reset() {
+	*x = LexiconDiffRequest{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LexiconDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconDiffRequest) ProtoMessage() {}
+
+func (x *LexiconDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconDiffRequest.ProtoReflect.Descriptor instead.
+func (*LexiconDiffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LexiconDiffRequest) GetFromLexicon() string {
+	if x != nil {
+		return x.FromLexicon
+	}
+	return ""
+}
+
+func (x *LexiconDiffRequest) GetToLexicon() string {
+	if x != nil {
+		return x.ToLexicon
+	}
+	return ""
+}
+
+func (x *LexiconDiffRequest) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *LexiconDiffRequest) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *LexiconDiffRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LexiconDiffRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type LexiconDiffResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words in to_lexicon but not from_lexicon, in alphabetical order
+	Added []string `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	// Words in from_lexicon but not to_lexicon, in alphabetical order
+	Removed []string `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	// The number of words of the requested lengths in each list
+	TotalAdded    int32 `protobuf:"varint,3,opt,name=total_added,json=totalAdded,proto3" json:"total_added,omitempty"`
+	TotalRemoved  int32 `protobuf:"varint,4,opt,name=total_removed,json=totalRemoved,proto3" json:"total_removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LexiconDiffResponse) Reset() {
+	*x = LexiconDiffResponse{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LexiconDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LexiconDiffResponse) ProtoMessage() {}
+
+func (x *LexiconDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LexiconDiffResponse.ProtoReflect.Descriptor instead.
+func (*LexiconDiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LexiconDiffResponse) GetAdded() []string {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *LexiconDiffResponse) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *LexiconDiffResponse) GetTotalAdded() int32 {
+	if x != nil {
+		return x.TotalAdded
+	}
+	return 0
+}
+
+func (x *LexiconDiffResponse) GetTotalRemoved() int32 {
+	if x != nil {
+		return x.TotalRemoved
+	}
+	return 0
+}
+
+type ValidityChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The lexicon to check the words against
+	Lexicon string `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// A single game to check. If empty, the user's recent games are checked.
+	GameId        string `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Username      string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	NumGames      int32  `protobuf:"varint,4,opt,name=num_games,json=numGames,proto3" json:"num_games,omitempty"`
+	Offset        int32  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidityChangesRequest) Reset() {
+	*x = ValidityChangesRequest{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidityChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidityChangesRequest) ProtoMessage() {}
+
+func (x *ValidityChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidityChangesRequest.ProtoReflect.Descriptor instead.
+func (*ValidityChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidityChangesRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *ValidityChangesRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ValidityChangesRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ValidityChangesRequest) GetNumGames() int32 {
+	if x != nil {
+		return x.NumGames
+	}
+	return 0
+}
+
+func (x *ValidityChangesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type WordValidityChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Word  string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// The index of the event that formed the word
+	EventIndex     int32  `protobuf:"varint,2,opt,name=event_index,json=eventIndex,proto3" json:"event_index,omitempty"`
+	PlayerNickname string `protobuf:"bytes,3,opt,name=player_nickname,json=playerNickname,proto3" json:"player_nickname,omitempty"`
+	// Whether the word is valid in the game's lexicon
+	WasValid bool `protobuf:"varint,4,opt,name=was_valid,json=wasValid,proto3" json:"was_valid,omitempty"`
+	// Whether the word is valid in the requested lexicon
+	IsValid       bool `protobuf:"varint,5,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordValidityChange) Reset() {
+	*x = WordValidityChange{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordValidityChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordValidityChange) ProtoMessage() {}
+
+func (x *WordValidityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordValidityChange.ProtoReflect.Descriptor instead.
+func (*WordValidityChange) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WordValidityChange) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *WordValidityChange) GetEventIndex() int32 {
+	if x != nil {
+		return x.EventIndex
+	}
+	return 0
+}
+
+func (x *WordValidityChange) GetPlayerNickname() string {
+	if x != nil {
+		return x.PlayerNickname
+	}
+	return ""
+}
+
+func (x *WordValidityChange) GetWasValid() bool {
+	if x != nil {
+		return x.WasValid
+	}
+	return false
+}
+
+func (x *WordValidityChange) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+type GameValidityChanges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Lexicon       string                 `protobuf:"bytes,2,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	Changes       []*WordValidityChange  `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameValidityChanges) Reset() {
+	*x = GameValidityChanges{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameValidityChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameValidityChanges) ProtoMessage() {}
+
+func (x *GameValidityChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameValidityChanges.ProtoReflect.Descriptor instead.
+func (*GameValidityChanges) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GameValidityChanges) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameValidityChanges) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *GameValidityChanges) GetChanges() []*WordValidityChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ValidityChangesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Games in lexica with a different alphabet are left out, and so are
+	// games with no changes.
+	Games         []*GameValidityChanges `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidityChangesResponse) Reset() {
+	*x = ValidityChangesResponse{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidityChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidityChangesResponse) ProtoMessage() {}
+
+func (x *ValidityChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidityChangesResponse.ProtoReflect.Descriptor instead.
+func (*ValidityChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidityChangesResponse) GetGames() []*GameValidityChanges {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type StartWordQuizRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	// New alphagrams are picked from words of these lengths and probability
+	// ranks (see SearchWordsResult). Lengths default to 7 and probability
+	// ranks are not limited if 0.
+	MinLength          int32 `protobuf:"varint,2,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	MaxLength          int32 `protobuf:"varint,3,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`
+	MinProbabilityRank int32 `protobuf:"varint,4,opt,name=min_probability_rank,json=minProbabilityRank,proto3" json:"min_probability_rank,omitempty"`
+	MaxProbabilityRank int32 `protobuf:"varint,5,opt,name=max_probability_rank,json=maxProbabilityRank,proto3" json:"max_probability_rank,omitempty"`
+	NumQuestions       int32 `protobuf:"varint,6,opt,name=num_questions,json=numQuestions,proto3" json:"num_questions,omitempty"`
+	Minutes            int32 `protobuf:"varint,7,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	// Quiz the alphagrams in the user's cardbox that are due before picking
+	// new ones.
+	IncludeDue    bool `protobuf:"varint,8,opt,name=include_due,json=includeDue,proto3" json:"include_due,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartWordQuizRequest) Reset() {
+	*x = StartWordQuizRequest{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWordQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWordQuizRequest) ProtoMessage() {}
+
+func (x *StartWordQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWordQuizRequest.ProtoReflect.Descriptor instead.
+func (*StartWordQuizRequest) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StartWordQuizRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *StartWordQuizRequest) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *StartWordQuizRequest) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *StartWordQuizRequest) GetMinProbabilityRank() int32 {
+	if x != nil {
+		return x.MinProbabilityRank
+	}
+	return 0
+}
+
+func (x *StartWordQuizRequest) GetMaxProbabilityRank() int32 {
+	if x != nil {
+		return x.MaxProbabilityRank
+	}
+	return 0
+}
+
+func (x *StartWordQuizRequest) GetNumQuestions() int32 {
+	if x != nil {
+		return x.NumQuestions
+	}
+	return 0
+}
+
+func (x *StartWordQuizRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *StartWordQuizRequest) GetIncludeDue() bool {
+	if x != nil {
+		return x.IncludeDue
+	}
+	return false
+}
+
+type WordQuizQuestion struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Position   int32                  `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Alphagram  string                 `protobuf:"bytes,2,opt,name=alphagram,proto3" json:"alphagram,omitempty"`
+	NumAnswers int32                  `protobuf:"varint,3,opt,name=num_answers,json=numAnswers,proto3" json:"num_answers,omitempty"`
+	Found      []string               `protobuf:"bytes,4,rep,name=found,proto3" json:"found,omitempty"`
+	// All of the answers, once the quiz is finished
+	Answers []string `protobuf:"bytes,5,rep,name=answers,proto3" json:"answers,omitempty"`
+	// The alphagram's cardbox, or -1 if it was never quizzed
+	Cardbox       int32 `protobuf:"varint,6,opt,name=cardbox,proto3" json:"cardbox,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordQuizQuestion) Reset() {
+	*x = WordQuizQuestion{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordQuizQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordQuizQuestion) ProtoMessage() {}
+
+func (x *WordQuizQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordQuizQuestion.ProtoReflect.Descriptor instead.
+func (*WordQuizQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WordQuizQuestion) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WordQuizQuestion) GetAlphagram() string {
+	if x != nil {
+		return x.Alphagram
+	}
+	return ""
+}
+
+func (x *WordQuizQuestion) GetNumAnswers() int32 {
+	if x != nil {
+		return x.NumAnswers
+	}
+	return 0
+}
+
+func (x *WordQuizQuestion) GetFound() []string {
+	if x != nil {
+		return x.Found
+	}
+	return nil
+}
+
+func (x *WordQuizQuestion) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *WordQuizQuestion) GetCardbox() int32 {
+	if x != nil {
+		return x.Cardbox
+	}
+	return 0
+}
+
+type WordQuizState struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Lexicon   string                 `protobuf:"bytes,2,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndsAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Finished  bool                   `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	Questions []*WordQuizQuestion    `protobuf:"bytes,6,rep,name=questions,proto3" json:"questions,omitempty"`
+	// The number of questions with every answer found
+	Solved        int32 `protobuf:"varint,7,opt,name=solved,proto3" json:"solved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordQuizState) Reset() {
+	*x = WordQuizState{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordQuizState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordQuizState) ProtoMessage() {}
+
+func (x *WordQuizState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordQuizState.ProtoReflect.Descriptor instead.
+func (*WordQuizState) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WordQuizState) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *WordQuizState) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+func (x *WordQuizState) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *WordQuizState) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *WordQuizState) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *WordQuizState) GetQuestions() []*WordQuizQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *WordQuizState) GetSolved() int32 {
+	if x != nil {
+		return x.Solved
+	}
+	return 0
+}
+
+type WordQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordQuizRequest) Reset() {
+	*x = WordQuizRequest{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordQuizRequest) ProtoMessage() {}
+
+func (x *WordQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordQuizRequest.ProtoReflect.Descriptor instead.
+func (*WordQuizRequest) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WordQuizRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type WordQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *WordQuizState         `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordQuizResponse) Reset() {
+	*x = WordQuizResponse{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordQuizResponse) ProtoMessage() {}
+
+func (x *WordQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordQuizResponse.ProtoReflect.Descriptor instead.
+func (*WordQuizResponse) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WordQuizResponse) GetState() *WordQuizState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type WordQuizGuessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Guess         string                 `protobuf:"bytes,2,opt,name=guess,proto3" json:"guess,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordQuizGuessRequest) Reset() {
+	*x = WordQuizGuessRequest{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordQuizGuessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordQuizGuessRequest) ProtoMessage() {}
+
+func (x *WordQuizGuessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordQuizGuessRequest.ProtoReflect.Descriptor instead.
+func (*WordQuizGuessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WordQuizGuessRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *WordQuizGuessRequest) GetGuess() string {
+	if x != nil {
+		return x.Guess
+	}
+	return ""
+}
+
+type WordQuizGuessResponse struct {
+	state  protoimpl.MessageState       `protogen:"open.v1"`
+	Result WordQuizGuessResponse_Result `protobuf:"varint,1,opt,name=result,proto3,enum=word_service.WordQuizGuessResponse_Result" json:"result,omitempty"`
+	// The position of the guessed question if the guess is correct
+	Position       int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	QuestionSolved bool  `protobuf:"varint,3,opt,name=question_solved,json=questionSolved,proto3" json:"question_solved,omitempty"`
+	// True if the quiz is finished, either because every question is solved
+	// or because the time ran out. Guesses after the time ran out are not
+	// counted.
+	Finished      bool `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordQuizGuessResponse) Reset() {
+	*x = WordQuizGuessResponse{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordQuizGuessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordQuizGuessResponse) ProtoMessage() {}
+
+func (x *WordQuizGuessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordQuizGuessResponse.ProtoReflect.Descriptor instead.
+func (*WordQuizGuessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WordQuizGuessResponse) GetResult() WordQuizGuessResponse_Result {
+	if x != nil {
+		return x.Result
+	}
+	return WordQuizGuessResponse_WRONG
+}
+
+func (x *WordQuizGuessResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WordQuizGuessResponse) GetQuestionSolved() bool {
+	if x != nil {
+		return x.QuestionSolved
+	}
+	return false
+}
+
+func (x *WordQuizGuessResponse) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+type CardboxStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lexicon       string                 `protobuf:"bytes,1,opt,name=lexicon,proto3" json:"lexicon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardboxStatsRequest) Reset() {
+	*x = CardboxStatsRequest{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardboxStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardboxStatsRequest) ProtoMessage() {}
+
+func (x *CardboxStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardboxStatsRequest.ProtoReflect.Descriptor instead.
+func (*CardboxStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CardboxStatsRequest) GetLexicon() string {
+	if x != nil {
+		return x.Lexicon
+	}
+	return ""
+}
+
+type CardboxLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cardbox       int32                  `protobuf:"varint,1,opt,name=cardbox,proto3" json:"cardbox,omitempty"`
+	Alphagrams    int32                  `protobuf:"varint,2,opt,name=alphagrams,proto3" json:"alphagrams,omitempty"`
+	Due           int32                  `protobuf:"varint,3,opt,name=due,proto3" json:"due,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardboxLevel) Reset() {
+	*x = CardboxLevel{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardboxLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardboxLevel) ProtoMessage() {}
+
+func (x *CardboxLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardboxLevel.ProtoReflect.Descriptor instead.
+func (*CardboxLevel) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CardboxLevel) GetCardbox() int32 {
+	if x != nil {
+		return x.Cardbox
+	}
+	return 0
+}
+
+func (x *CardboxLevel) GetAlphagrams() int32 {
+	if x != nil {
+		return x.Alphagrams
+	}
+	return 0
+}
+
+func (x *CardboxLevel) GetDue() int32 {
+	if x != nil {
+		return x.Due
+	}
+	return 0
+}
+
+type CardboxStatsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Levels []*CardboxLevel        `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	// The number of alphagrams due now across all cardboxes
+	Due           int32 `protobuf:"varint,2,opt,name=due,proto3" json:"due,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CardboxStatsResponse) Reset() {
+	*x = CardboxStatsResponse{}
+	mi := &file_proto_word_service_word_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CardboxStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardboxStatsResponse) ProtoMessage() {}
+
+func (x *CardboxStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_word_service_word_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardboxStatsResponse.ProtoReflect.Descriptor instead.
+func (*CardboxStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_word_service_word_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CardboxStatsResponse) GetLevels() []*CardboxLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *CardboxStatsResponse) GetDue() int32 {
+	if x != nil {
+		return x.Due
+	}
+	return 0
+}
+
+var File_proto_word_service_word_service_proto protoreflect.FileDescriptor
+
+const file_proto_word_service_word_service_proto_rawDesc = "" +
+	"\n" +
+	"%proto/word_service/word_service.proto\x12\fword_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\x01\n" +
+	"\x12DefineWordsRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x14\n" +
+	"\x05words\x18\x02 \x03(\tR\x05words\x12 \n" +
+	"\vdefinitions\x18\x03 \x01(\bR\vdefinitions\x12\x1a\n" +
+	"\banagrams\x18\x04 \x01(\bR\banagrams\"b\n" +
+	"\n" +
+	"Definition\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x14\n" +
+	"\x05lemma\x18\x04 \x01(\tR\x05lemma\"k\n" +
+	"\x11DefineWordsResult\x12\f\n" +
+	"\x01d\x18\x01 \x01(\tR\x01d\x12\f\n" +
+	"\x01v\x18\x02 \x01(\bR\x01v\x12:\n" +
+	"\vdefinitions\x18\x03 \x03(\v2\x18.word_service.DefinitionR\vdefinitions\"\xbc\x01\n" +
+	"\x13DefineWordsResponse\x12H\n" +
+	"\aresults\x18\x01 \x03(\v2..word_service.DefineWordsResponse.ResultsEntryR\aresults\x1a[\n" +
+	"\fResultsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x125\n" +
+	"\x05value\x18\x02 \x01(\v2\x1f.word_service.DefineWordsResultR\x05value:\x028\x01\"\xa1\x03\n" +
+	"\x12SearchWordsRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x12<\n" +
+	"\fletter_query\x18\x03 \x01(\x0e2\x19.word_service.LetterQueryR\vletterQuery\x12\x18\n" +
+	"\aletters\x18\x04 \x01(\tR\aletters\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x05 \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x06 \x01(\x05R\tmaxLength\x120\n" +
+	"\x14min_probability_rank\x18\a \x01(\x05R\x12minProbabilityRank\x120\n" +
+	"\x14max_probability_rank\x18\b \x01(\x05R\x12maxProbabilityRank\x12/\n" +
+	"\x13letter_distribution\x18\t \x01(\tR\x12letterDistribution\x12\x14\n" +
+	"\x05limit\x18\n" +
+	" \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\v \x01(\x05R\x06offset\"\x92\x01\n" +
+	"\x11SearchWordsResult\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12)\n" +
+	"\x10probability_rank\x18\x02 \x01(\x05R\x0fprobabilityRank\x12\x1f\n" +
+	"\vfront_hooks\x18\x03 \x01(\tR\n" +
+	"frontHooks\x12\x1d\n" +
+	"\n" +
+	"back_hooks\x18\x04 \x01(\tR\tbackHooks\"f\n" +
+	"\x13SearchWordsResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.word_service.SearchWordsResultR\aresults\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xc2\x01\n" +
+	"\x12LexiconDiffRequest\x12!\n" +
+	"\ffrom_lexicon\x18\x01 \x01(\tR\vfromLexicon\x12\x1d\n" +
+	"\n" +
+	"to_lexicon\x18\x02 \x01(\tR\ttoLexicon\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x03 \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x04 \x01(\x05R\tmaxLength\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"\x8b\x01\n" +
+	"\x13LexiconDiffResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x03(\tR\x05added\x12\x18\n" +
+	"\aremoved\x18\x02 \x03(\tR\aremoved\x12\x1f\n" +
+	"\vtotal_added\x18\x03 \x01(\x05R\n" +
+	"totalAdded\x12#\n" +
+	"\rtotal_removed\x18\x04 \x01(\x05R\ftotalRemoved\"\x9c\x01\n" +
+	"\x16ValidityChangesRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x17\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1b\n" +
+	"\tnum_games\x18\x04 \x01(\x05R\bnumGames\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"\xaa\x01\n" +
+	"\x12WordValidityChange\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x1f\n" +
+	"\vevent_index\x18\x02 \x01(\x05R\n" +
+	"eventIndex\x12'\n" +
+	"\x0fplayer_nickname\x18\x03 \x01(\tR\x0eplayerNickname\x12\x1b\n" +
+	"\twas_valid\x18\x04 \x01(\bR\bwasValid\x12\x19\n" +
+	"\bis_valid\x18\x05 \x01(\bR\aisValid\"\x84\x01\n" +
+	"\x13GameValidityChanges\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x18\n" +
+	"\alexicon\x18\x02 \x01(\tR\alexicon\x12:\n" +
+	"\achanges\x18\x03 \x03(\v2 .word_service.WordValidityChangeR\achanges\"R\n" +
+	"\x17ValidityChangesResponse\x127\n" +
+	"\x05games\x18\x01 \x03(\v2!.word_service.GameValidityChangesR\x05games\"\xb2\x02\n" +
+	"\x14StartWordQuizRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x02 \x01(\x05R\tminLength\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x03 \x01(\x05R\tmaxLength\x120\n" +
+	"\x14min_probability_rank\x18\x04 \x01(\x05R\x12minProbabilityRank\x120\n" +
+	"\x14max_probability_rank\x18\x05 \x01(\x05R\x12maxProbabilityRank\x12#\n" +
+	"\rnum_questions\x18\x06 \x01(\x05R\fnumQuestions\x12\x18\n" +
+	"\aminutes\x18\a \x01(\x05R\aminutes\x12\x1f\n" +
+	"\vinclude_due\x18\b \x01(\bR\n" +
+	"includeDue\"\xb7\x01\n" +
+	"\x10WordQuizQuestion\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\x12\x1c\n" +
+	"\talphagram\x18\x02 \x01(\tR\talphagram\x12\x1f\n" +
+	"\vnum_answers\x18\x03 \x01(\x05R\n" +
+	"numAnswers\x12\x14\n" +
+	"\x05found\x18\x04 \x03(\tR\x05found\x12\x18\n" +
+	"\aanswers\x18\x05 \x03(\tR\aanswers\x12\x18\n" +
+	"\acardbox\x18\x06 \x01(\x05R\acardbox\"\xaa\x02\n" +
+	"\rWordQuizState\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x18\n" +
+	"\alexicon\x18\x02 \x01(\tR\alexicon\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1a\n" +
+	"\bfinished\x18\x05 \x01(\bR\bfinished\x12<\n" +
+	"\tquestions\x18\x06 \x03(\v2\x1e.word_service.WordQuizQuestionR\tquestions\x12\x16\n" +
+	"\x06solved\x18\a \x01(\x05R\x06solved\"0\n" +
+	"\x0fWordQuizRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"E\n" +
+	"\x10WordQuizResponse\x121\n" +
+	"\x05state\x18\x01 \x01(\v2\x1b.word_service.WordQuizStateR\x05state\"K\n" +
+	"\x14WordQuizGuessRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05guess\x18\x02 \x01(\tR\x05guess\"\xf1\x01\n" +
+	"\x15WordQuizGuessResponse\x12B\n" +
+	"\x06result\x18\x01 \x01(\x0e2*.word_service.WordQuizGuessResponse.ResultR\x06result\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12'\n" +
+	"\x0fquestion_solved\x18\x03 \x01(\bR\x0equestionSolved\x12\x1a\n" +
+	"\bfinished\x18\x04 \x01(\bR\bfinished\"3\n" +
+	"\x06Result\x12\t\n" +
+	"\x05WRONG\x10\x00\x12\v\n" +
+	"\aCORRECT\x10\x01\x12\x11\n" +
+	"\rALREADY_FOUND\x10\x02\"/\n" +
+	"\x13CardboxStatsRequest\x12\x18\n" +
+	"\alexicon\x18\x01 \x01(\tR\alexicon\"Z\n" +
+	"\fCardboxLevel\x12\x18\n" +
+	"\acardbox\x18\x01 \x01(\x05R\acardbox\x12\x1e\n" +
+	"\n" +
+	"alphagrams\x18\x02 \x01(\x05R\n" +
+	"alphagrams\x12\x10\n" +
+	"\x03due\x18\x03 \x01(\x05R\x03due\"\\\n" +
+	"\x14CardboxStatsResponse\x122\n" +
+	"\x06levels\x18\x01 \x03(\v2\x1a.word_service.CardboxLevelR\x06levels\x12\x10\n" +
+	"\x03due\x18\x02 \x01(\x05R\x03due*M\n" +
+	"\vLetterQuery\x12\x13\n" +
+	"\x0fNO_LETTER_QUERY\x10\x00\x12\v\n" +
+	"\aANAGRAM\x10\x01\x12\x0e\n" +
+	"\n" +
+	"SUBANAGRAM\x10\x02\x12\f\n" +
+	"\bBUILD_UP\x10\x032\x9a\x06\n" +
 	"\vWordService\x12R\n" +
-	"\vDefineWords\x12 .word_service.DefineWordsRequest\x1a!.word_service.DefineWordsResponseB\xaa\x01\n" +
+	"\vDefineWords\x12 .word_service.DefineWordsRequest\x1a!.word_service.DefineWordsResponse\x12R\n" +
+	"\vSearchWords\x12 .word_service.SearchWordsRequest\x1a!.word_service.SearchWordsResponse\x12U\n" +
+	"\x0eGetLexiconDiff\x12 .word_service.LexiconDiffRequest\x1a!.word_service.LexiconDiffResponse\x12a\n" +
+	"\x12GetValidityChanges\x12$.word_service.ValidityChangesRequest\x1a%.word_service.ValidityChangesResponse\x12S\n" +
+	"\rStartWordQuiz\x12\".word_service.StartWordQuizRequest\x1a\x1e.word_service.WordQuizResponse\x12L\n" +
+	"\vGetWordQuiz\x12\x1d.word_service.WordQuizRequest\x1a\x1e.word_service.WordQuizResponse\x12^\n" +
+	"\x13SubmitWordQuizGuess\x12\".word_service.WordQuizGuessRequest\x1a#.word_service.WordQuizGuessResponse\x12L\n" +
+	"\vEndWordQuiz\x12\x1d.word_service.WordQuizRequest\x1a\x1e.word_service.WordQuizResponse\x12X\n" +
+	"\x0fGetCardboxStats\x12!.word_service.CardboxStatsRequest\x1a\".word_service.CardboxStatsResponseB\xaa\x01\n" +
 	"\x10com.word_serviceB\x10WordServiceProtoP\x01Z8github.com/woogles-io/liwords/rpc/api/proto/word_service\xa2\x02\x03WXX\xaa\x02\vWordService\xca\x02\vWordService\xe2\x02\x17WordService\\GPBMetadata\xea\x02\vWordServiceb\x06proto3"
 
 var (
@@ -219,23 +1876,74 @@ func file_proto_word_service_word_service_proto_rawDescGZIP() []byte {
 	return file_proto_word_service_word_service_proto_rawDescData
 }
 
-var file_proto_word_service_word_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_word_service_word_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_word_service_word_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_word_service_word_service_proto_goTypes = []any{
-	(*DefineWordsRequest)(nil),  // 0: word_service.DefineWordsRequest
-	(*DefineWordsResult)(nil),   // 1: word_service.DefineWordsResult
-	(*DefineWordsResponse)(nil), // 2: word_service.DefineWordsResponse
-	nil,                         // 3: word_service.DefineWordsResponse.ResultsEntry
+	(LetterQuery)(0),                  // 0: word_service.LetterQuery
+	(WordQuizGuessResponse_Result)(0), // 1: word_service.WordQuizGuessResponse.Result
+	(*DefineWordsRequest)(nil),        // 2: word_service.DefineWordsRequest
+	(*Definition)(nil),                // 3: word_service.Definition
+	(*DefineWordsResult)(nil),         // 4: word_service.DefineWordsResult
+	(*DefineWordsResponse)(nil),       // 5: word_service.DefineWordsResponse
+	(*SearchWordsRequest)(nil),        // 6: word_service.SearchWordsRequest
+	(*SearchWordsResult)(nil),         // 7: word_service.SearchWordsResult
+	(*SearchWordsResponse)(nil),       // 8: word_service.SearchWordsResponse
+	(*LexiconDiffRequest)(nil),        // 9: word_service.LexiconDiffRequest
+	(*LexiconDiffResponse)(nil),       // 10: word_service.LexiconDiffResponse
+	(*ValidityChangesRequest)(nil),    // 11: word_service.ValidityChangesRequest
+	(*WordValidityChange)(nil),        // 12: word_service.WordValidityChange
+	(*GameValidityChanges)(nil),       // 13: word_service.GameValidityChanges
+	(*ValidityChangesResponse)(nil),   // 14: word_service.ValidityChangesResponse
+	(*StartWordQuizRequest)(nil),      // 15: word_service.StartWordQuizRequest
+	(*WordQuizQuestion)(nil),          // 16: word_service.WordQuizQuestion
+	(*WordQuizState)(nil),             // 17: word_service.WordQuizState
+	(*WordQuizRequest)(nil),           // 18: word_service.WordQuizRequest
+	(*WordQuizResponse)(nil),          // 19: word_service.WordQuizResponse
+	(*WordQuizGuessRequest)(nil),      // 20: word_service.WordQuizGuessRequest
+	(*WordQuizGuessResponse)(nil),     // 21: word_service.WordQuizGuessResponse
+	(*CardboxStatsRequest)(nil),       // 22: word_service.CardboxStatsRequest
+	(*CardboxLevel)(nil),              // 23: word_service.CardboxLevel
+	(*CardboxStatsResponse)(nil),      // 24: word_service.CardboxStatsResponse
+	nil,                               // 25: word_service.DefineWordsResponse.ResultsEntry
+	(*timestamppb.Timestamp)(nil),     // 26: google.protobuf.Timestamp
 }
 var file_proto_word_service_word_service_proto_depIdxs = []int32{
-	3, // 0: word_service.DefineWordsResponse.results:type_name -> word_service.DefineWordsResponse.ResultsEntry
-	1, // 1: word_service.DefineWordsResponse.ResultsEntry.value:type_name -> word_service.DefineWordsResult
-	0, // 2: word_service.WordService.DefineWords:input_type -> word_service.DefineWordsRequest
-	2, // 3: word_service.WordService.DefineWords:output_type -> word_service.DefineWordsResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3,  // 0: word_service.DefineWordsResult.definitions:type_name -> word_service.Definition
+	25, // 1: word_service.DefineWordsResponse.results:type_name -> word_service.DefineWordsResponse.ResultsEntry
+	0,  // 2: word_service.SearchWordsRequest.letter_query:type_name -> word_service.LetterQuery
+	7,  // 3: word_service.SearchWordsResponse.results:type_name -> word_service.SearchWordsResult
+	12, // 4: word_service.GameValidityChanges.changes:type_name -> word_service.WordValidityChange
+	13, // 5: word_service.ValidityChangesResponse.games:type_name -> word_service.GameValidityChanges
+	26, // 6: word_service.WordQuizState.started_at:type_name -> google.protobuf.Timestamp
+	26, // 7: word_service.WordQuizState.ends_at:type_name -> google.protobuf.Timestamp
+	16, // 8: word_service.WordQuizState.questions:type_name -> word_service.WordQuizQuestion
+	17, // 9: word_service.WordQuizResponse.state:type_name -> word_service.WordQuizState
+	1,  // 10: word_service.WordQuizGuessResponse.result:type_name -> word_service.WordQuizGuessResponse.Result
+	23, // 11: word_service.CardboxStatsResponse.levels:type_name -> word_service.CardboxLevel
+	4,  // 12: word_service.DefineWordsResponse.ResultsEntry.value:type_name -> word_service.DefineWordsResult
+	2,  // 13: word_service.WordService.DefineWords:input_type -> word_service.DefineWordsRequest
+	6,  // 14: word_service.WordService.SearchWords:input_type -> word_service.SearchWordsRequest
+	9,  // 15: word_service.WordService.GetLexiconDiff:input_type -> word_service.LexiconDiffRequest
+	11, // 16: word_service.WordService.GetValidityChanges:input_type -> word_service.ValidityChangesRequest
+	15, // 17: word_service.WordService.StartWordQuiz:input_type -> word_service.StartWordQuizRequest
+	18, // 18: word_service.WordService.GetWordQuiz:input_type -> word_service.WordQuizRequest
+	20, // 19: word_service.WordService.SubmitWordQuizGuess:input_type -> word_service.WordQuizGuessRequest
+	18, // 20: word_service.WordService.EndWordQuiz:input_type -> word_service.WordQuizRequest
+	22, // 21: word_service.WordService.GetCardboxStats:input_type -> word_service.CardboxStatsRequest
+	5,  // 22: word_service.WordService.DefineWords:output_type -> word_service.DefineWordsResponse
+	8,  // 23: word_service.WordService.SearchWords:output_type -> word_service.SearchWordsResponse
+	10, // 24: word_service.WordService.GetLexiconDiff:output_type -> word_service.LexiconDiffResponse
+	14, // 25: word_service.WordService.GetValidityChanges:output_type -> word_service.ValidityChangesResponse
+	19, // 26: word_service.WordService.StartWordQuiz:output_type -> word_service.WordQuizResponse
+	19, // 27: word_service.WordService.GetWordQuiz:output_type -> word_service.WordQuizResponse
+	21, // 28: word_service.WordService.SubmitWordQuizGuess:output_type -> word_service.WordQuizGuessResponse
+	19, // 29: word_service.WordService.EndWordQuiz:output_type -> word_service.WordQuizResponse
+	24, // 30: word_service.WordService.GetCardboxStats:output_type -> word_service.CardboxStatsResponse
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_word_service_word_service_proto_init() }
@@ -248,13 +1956,14 @@ func file_proto_word_service_word_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_word_service_word_service_proto_rawDesc), len(file_proto_word_service_word_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_word_service_word_service_proto_goTypes,
 		DependencyIndexes: file_proto_word_service_word_service_proto_depIdxs,
+		EnumInfos:         file_proto_word_service_word_service_proto_enumTypes,
 		MessageInfos:      file_proto_word_service_word_service_proto_msgTypes,
 	}.Build()
 	File_proto_word_service_word_service_proto = out.File
@@ -35,11 +35,46 @@ const (
 const (
 	// WordServiceDefineWordsProcedure is the fully-qualified name of the WordService's DefineWords RPC.
 	WordServiceDefineWordsProcedure = "/word_service.WordService/DefineWords"
+	// WordServiceSearchWordsProcedure is the fully-qualified name of the WordService's SearchWords RPC.
+	WordServiceSearchWordsProcedure = "/word_service.WordService/SearchWords"
+	// WordServiceGetLexiconDiffProcedure is the fully-qualified name of the WordService's
+	// GetLexiconDiff RPC.
+	WordServiceGetLexiconDiffProcedure = "/word_service.WordService/GetLexiconDiff"
+	// WordServiceGetValidityChangesProcedure is the fully-qualified name of the WordService's
+	// GetValidityChanges RPC.
+	WordServiceGetValidityChangesProcedure = "/word_service.WordService/GetValidityChanges"
+	// WordServiceStartWordQuizProcedure is the fully-qualified name of the WordService's StartWordQuiz
+	// RPC.
+	WordServiceStartWordQuizProcedure = "/word_service.WordService/StartWordQuiz"
+	// WordServiceGetWordQuizProcedure is the fully-qualified name of the WordService's GetWordQuiz RPC.
+	WordServiceGetWordQuizProcedure = "/word_service.WordService/GetWordQuiz"
+	// WordServiceSubmitWordQuizGuessProcedure is the fully-qualified name of the WordService's
+	// SubmitWordQuizGuess RPC.
+	WordServiceSubmitWordQuizGuessProcedure = "/word_service.WordService/SubmitWordQuizGuess"
+	// WordServiceEndWordQuizProcedure is the fully-qualified name of the WordService's EndWordQuiz RPC.
+	WordServiceEndWordQuizProcedure = "/word_service.WordService/EndWordQuiz"
+	// WordServiceGetCardboxStatsProcedure is the fully-qualified name of the WordService's
+	// GetCardboxStats RPC.
+	WordServiceGetCardboxStatsProcedure = "/word_service.WordService/GetCardboxStats"
 )
 
 // WordServiceClient is a client for the word_service.WordService service.
 type WordServiceClient interface {
 	DefineWords(context.Context, *connect.Request[word_service.DefineWordsRequest]) (*connect.Response[word_service.DefineWordsResponse], error)
+	SearchWords(context.Context, *connect.Request[word_service.SearchWordsRequest]) (*connect.Response[word_service.SearchWordsResponse], error)
+	// Lexicon diffs are computed once and cached.
+	GetLexiconDiff(context.Context, *connect.Request[word_service.LexiconDiffRequest]) (*connect.Response[word_service.LexiconDiffResponse], error)
+	// Finds the words played in past games whose validity would be different
+	// in another lexicon.
+	GetValidityChanges(context.Context, *connect.Request[word_service.ValidityChangesRequest]) (*connect.Response[word_service.ValidityChangesResponse], error)
+	// Anagram quizzes for the logged-in user. Finishing a quiz, either by
+	// ending it or running out of time, moves its alphagrams between
+	// cardboxes.
+	StartWordQuiz(context.Context, *connect.Request[word_service.StartWordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error)
+	GetWordQuiz(context.Context, *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error)
+	SubmitWordQuizGuess(context.Context, *connect.Request[word_service.WordQuizGuessRequest]) (*connect.Response[word_service.WordQuizGuessResponse], error)
+	EndWordQuiz(context.Context, *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error)
+	GetCardboxStats(context.Context, *connect.Request[word_service.CardboxStatsRequest]) (*connect.Response[word_service.CardboxStatsResponse], error)
 }
 
 // NewWordServiceClient constructs a client for the word_service.WordService service. By default, it
@@ -59,12 +94,68 @@ func NewWordServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(wordServiceMethods.ByName("DefineWords")),
 			connect.WithClientOptions(opts...),
 		),
+		searchWords: connect.NewClient[word_service.SearchWordsRequest, word_service.SearchWordsResponse](
+			httpClient,
+			baseURL+WordServiceSearchWordsProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("SearchWords")),
+			connect.WithClientOptions(opts...),
+		),
+		getLexiconDiff: connect.NewClient[word_service.LexiconDiffRequest, word_service.LexiconDiffResponse](
+			httpClient,
+			baseURL+WordServiceGetLexiconDiffProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("GetLexiconDiff")),
+			connect.WithClientOptions(opts...),
+		),
+		getValidityChanges: connect.NewClient[word_service.ValidityChangesRequest, word_service.ValidityChangesResponse](
+			httpClient,
+			baseURL+WordServiceGetValidityChangesProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("GetValidityChanges")),
+			connect.WithClientOptions(opts...),
+		),
+		startWordQuiz: connect.NewClient[word_service.StartWordQuizRequest, word_service.WordQuizResponse](
+			httpClient,
+			baseURL+WordServiceStartWordQuizProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("StartWordQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		getWordQuiz: connect.NewClient[word_service.WordQuizRequest, word_service.WordQuizResponse](
+			httpClient,
+			baseURL+WordServiceGetWordQuizProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("GetWordQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		submitWordQuizGuess: connect.NewClient[word_service.WordQuizGuessRequest, word_service.WordQuizGuessResponse](
+			httpClient,
+			baseURL+WordServiceSubmitWordQuizGuessProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("SubmitWordQuizGuess")),
+			connect.WithClientOptions(opts...),
+		),
+		endWordQuiz: connect.NewClient[word_service.WordQuizRequest, word_service.WordQuizResponse](
+			httpClient,
+			baseURL+WordServiceEndWordQuizProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("EndWordQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		getCardboxStats: connect.NewClient[word_service.CardboxStatsRequest, word_service.CardboxStatsResponse](
+			httpClient,
+			baseURL+WordServiceGetCardboxStatsProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("GetCardboxStats")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // wordServiceClient implements WordServiceClient.
 type wordServiceClient struct {
-	defineWords *connect.Client[word_service.DefineWordsRequest, word_service.DefineWordsResponse]
+	defineWords         *connect.Client[word_service.DefineWordsRequest, word_service.DefineWordsResponse]
+	searchWords         *connect.Client[word_service.SearchWordsRequest, word_service.SearchWordsResponse]
+	getLexiconDiff      *connect.Client[word_service.LexiconDiffRequest, word_service.LexiconDiffResponse]
+	getValidityChanges  *connect.Client[word_service.ValidityChangesRequest, word_service.ValidityChangesResponse]
+	startWordQuiz       *connect.Client[word_service.StartWordQuizRequest, word_service.WordQuizResponse]
+	getWordQuiz         *connect.Client[word_service.WordQuizRequest, word_service.WordQuizResponse]
+	submitWordQuizGuess *connect.Client[word_service.WordQuizGuessRequest, word_service.WordQuizGuessResponse]
+	endWordQuiz         *connect.Client[word_service.WordQuizRequest, word_service.WordQuizResponse]
+	getCardboxStats     *connect.Client[word_service.CardboxStatsRequest, word_service.CardboxStatsResponse]
 }
 
 // DefineWords calls word_service.WordService.DefineWords.
@@ -72,9 +163,63 @@ func (c *wordServiceClient) DefineWords(ctx context.Context, req *connect.Reques
 	return c.defineWords.CallUnary(ctx, req)
 }
 
+// SearchWords calls word_service.WordService.SearchWords.
+func (c *wordServiceClient) SearchWords(ctx context.Context, req *connect.Request[word_service.SearchWordsRequest]) (*connect.Response[word_service.SearchWordsResponse], error) {
+	return c.searchWords.CallUnary(ctx, req)
+}
+
+// GetLexiconDiff calls word_service.WordService.GetLexiconDiff.
+func (c *wordServiceClient) GetLexiconDiff(ctx context.Context, req *connect.Request[word_service.LexiconDiffRequest]) (*connect.Response[word_service.LexiconDiffResponse], error) {
+	return c.getLexiconDiff.CallUnary(ctx, req)
+}
+
+// GetValidityChanges calls word_service.WordService.GetValidityChanges.
+func (c *wordServiceClient) GetValidityChanges(ctx context.Context, req *connect.Request[word_service.ValidityChangesRequest]) (*connect.Response[word_service.ValidityChangesResponse], error) {
+	return c.getValidityChanges.CallUnary(ctx, req)
+}
+
+// StartWordQuiz calls word_service.WordService.StartWordQuiz.
+func (c *wordServiceClient) StartWordQuiz(ctx context.Context, req *connect.Request[word_service.StartWordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error) {
+	return c.startWordQuiz.CallUnary(ctx, req)
+}
+
+// GetWordQuiz calls word_service.WordService.GetWordQuiz.
+func (c *wordServiceClient) GetWordQuiz(ctx context.Context, req *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error) {
+	return c.getWordQuiz.CallUnary(ctx, req)
+}
+
+// SubmitWordQuizGuess calls word_service.WordService.SubmitWordQuizGuess.
+func (c *wordServiceClient) SubmitWordQuizGuess(ctx context.Context, req *connect.Request[word_service.WordQuizGuessRequest]) (*connect.Response[word_service.WordQuizGuessResponse], error) {
+	return c.submitWordQuizGuess.CallUnary(ctx, req)
+}
+
+// EndWordQuiz calls word_service.WordService.EndWordQuiz.
+func (c *wordServiceClient) EndWordQuiz(ctx context.Context, req *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error) {
+	return c.endWordQuiz.CallUnary(ctx, req)
+}
+
+// GetCardboxStats calls word_service.WordService.GetCardboxStats.
+func (c *wordServiceClient) GetCardboxStats(ctx context.Context, req *connect.Request[word_service.CardboxStatsRequest]) (*connect.Response[word_service.CardboxStatsResponse], error) {
+	return c.getCardboxStats.CallUnary(ctx, req)
+}
+
 // WordServiceHandler is an implementation of the word_service.WordService service.
 type WordServiceHandler interface {
 	DefineWords(context.Context, *connect.Request[word_service.DefineWordsRequest]) (*connect.Response[word_service.DefineWordsResponse], error)
+	SearchWords(context.Context, *connect.Request[word_service.SearchWordsRequest]) (*connect.Response[word_service.SearchWordsResponse], error)
+	// Lexicon diffs are computed once and cached.
+	GetLexiconDiff(context.Context, *connect.Request[word_service.LexiconDiffRequest]) (*connect.Response[word_service.LexiconDiffResponse], error)
+	// Finds the words played in past games whose validity would be different
+	// in another lexicon.
+	GetValidityChanges(context.Context, *connect.Request[word_service.ValidityChangesRequest]) (*connect.Response[word_service.ValidityChangesResponse], error)
+	// Anagram quizzes for the logged-in user. Finishing a quiz, either by
+	// ending it or running out of time, moves its alphagrams between
+	// cardboxes.
+	StartWordQuiz(context.Context, *connect.Request[word_service.StartWordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error)
+	GetWordQuiz(context.Context, *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error)
+	SubmitWordQuizGuess(context.Context, *connect.Request[word_service.WordQuizGuessRequest]) (*connect.Response[word_service.WordQuizGuessResponse], error)
+	EndWordQuiz(context.Context, *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error)
+	GetCardboxStats(context.Context, *connect.Request[word_service.CardboxStatsRequest]) (*connect.Response[word_service.CardboxStatsResponse], error)
 }
 
 // NewWordServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -90,10 +235,74 @@ func NewWordServiceHandler(svc WordServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(wordServiceMethods.ByName("DefineWords")),
 		connect.WithHandlerOptions(opts...),
 	)
+	wordServiceSearchWordsHandler := connect.NewUnaryHandler(
+		WordServiceSearchWordsProcedure,
+		svc.SearchWords,
+		connect.WithSchema(wordServiceMethods.ByName("SearchWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceGetLexiconDiffHandler := connect.NewUnaryHandler(
+		WordServiceGetLexiconDiffProcedure,
+		svc.GetLexiconDiff,
+		connect.WithSchema(wordServiceMethods.ByName("GetLexiconDiff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceGetValidityChangesHandler := connect.NewUnaryHandler(
+		WordServiceGetValidityChangesProcedure,
+		svc.GetValidityChanges,
+		connect.WithSchema(wordServiceMethods.ByName("GetValidityChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceStartWordQuizHandler := connect.NewUnaryHandler(
+		WordServiceStartWordQuizProcedure,
+		svc.StartWordQuiz,
+		connect.WithSchema(wordServiceMethods.ByName("StartWordQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceGetWordQuizHandler := connect.NewUnaryHandler(
+		WordServiceGetWordQuizProcedure,
+		svc.GetWordQuiz,
+		connect.WithSchema(wordServiceMethods.ByName("GetWordQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceSubmitWordQuizGuessHandler := connect.NewUnaryHandler(
+		WordServiceSubmitWordQuizGuessProcedure,
+		svc.SubmitWordQuizGuess,
+		connect.WithSchema(wordServiceMethods.ByName("SubmitWordQuizGuess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceEndWordQuizHandler := connect.NewUnaryHandler(
+		WordServiceEndWordQuizProcedure,
+		svc.EndWordQuiz,
+		connect.WithSchema(wordServiceMethods.ByName("EndWordQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceGetCardboxStatsHandler := connect.NewUnaryHandler(
+		WordServiceGetCardboxStatsProcedure,
+		svc.GetCardboxStats,
+		connect.WithSchema(wordServiceMethods.ByName("GetCardboxStats")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/word_service.WordService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WordServiceDefineWordsProcedure:
 			wordServiceDefineWordsHandler.ServeHTTP(w, r)
+		case WordServiceSearchWordsProcedure:
+			wordServiceSearchWordsHandler.ServeHTTP(w, r)
+		case WordServiceGetLexiconDiffProcedure:
+			wordServiceGetLexiconDiffHandler.ServeHTTP(w, r)
+		case WordServiceGetValidityChangesProcedure:
+			wordServiceGetValidityChangesHandler.ServeHTTP(w, r)
+		case WordServiceStartWordQuizProcedure:
+			wordServiceStartWordQuizHandler.ServeHTTP(w, r)
+		case WordServiceGetWordQuizProcedure:
+			wordServiceGetWordQuizHandler.ServeHTTP(w, r)
+		case WordServiceSubmitWordQuizGuessProcedure:
+			wordServiceSubmitWordQuizGuessHandler.ServeHTTP(w, r)
+		case WordServiceEndWordQuizProcedure:
+			wordServiceEndWordQuizHandler.ServeHTTP(w, r)
+		case WordServiceGetCardboxStatsProcedure:
+			wordServiceGetCardboxStatsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -106,3 +315,35 @@ type UnimplementedWordServiceHandler struct{}
 func (UnimplementedWordServiceHandler) DefineWords(context.Context, *connect.Request[word_service.DefineWordsRequest]) (*connect.Response[word_service.DefineWordsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.DefineWords is not implemented"))
 }
+
+func (UnimplementedWordServiceHandler) SearchWords(context.Context, *connect.Request[word_service.SearchWordsRequest]) (*connect.Response[word_service.SearchWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.SearchWords is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) GetLexiconDiff(context.Context, *connect.Request[word_service.LexiconDiffRequest]) (*connect.Response[word_service.LexiconDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.GetLexiconDiff is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) GetValidityChanges(context.Context, *connect.Request[word_service.ValidityChangesRequest]) (*connect.Response[word_service.ValidityChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.GetValidityChanges is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) StartWordQuiz(context.Context, *connect.Request[word_service.StartWordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.StartWordQuiz is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) GetWordQuiz(context.Context, *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.GetWordQuiz is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) SubmitWordQuizGuess(context.Context, *connect.Request[word_service.WordQuizGuessRequest]) (*connect.Response[word_service.WordQuizGuessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.SubmitWordQuizGuess is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) EndWordQuiz(context.Context, *connect.Request[word_service.WordQuizRequest]) (*connect.Response[word_service.WordQuizResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.EndWordQuiz is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) GetCardboxStats(context.Context, *connect.Request[word_service.CardboxStatsRequest]) (*connect.Response[word_service.CardboxStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("word_service.WordService.GetCardboxStats is not implemented"))
+}